@@ -20,11 +20,13 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/tuplecodec"
 	"math"
+	"path/filepath"
 	"strconv"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/sql/handler"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 
 	"github.com/matrixorigin/matrixcube/storage/kv"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
@@ -49,6 +51,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 
@@ -187,6 +190,7 @@ func main() {
 	logutil.Infof("Shutdown The Server With Ctrl+C | Ctrl+\\.")
 
 	config.HostMmu = host.New(config.GlobalSystemVariables.GetHostMmuLimitation())
+	mmu.PressureRatio = config.GlobalSystemVariables.GetMmuPressureRatio()
 
 	log.SetLevelByString(config.GlobalSystemVariables.GetCubeLogLevel())
 
@@ -203,6 +207,10 @@ func main() {
 		logutil.Infof("Recreate dir error:%v\n", err)
 		os.Exit(RecreateDirExit)
 	}
+	if err := spill.InitPath(filepath.Join(targetDir, "spill")); err != nil {
+		logutil.Infof("Recreate dir error:%v\n", err)
+		os.Exit(RecreateDirExit)
+	}
 
 	kvs, err := cPebble.NewStorage(targetDir+"/pebble/data", nil, &pebble.Options{
 		FS:                          vfs.NewPebbleFS(vfs.Default),
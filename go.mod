@@ -15,6 +15,7 @@ require (
 	github.com/golang/mock v1.3.1
 	github.com/golang/protobuf v1.5.2
 	github.com/google/btree v1.0.1
+	github.com/klauspost/compress v1.13.6
 	github.com/lni/goutils v1.3.0
 	github.com/matrixorigin/matrixcube v0.2.1-0.20220302113502-f2e738c9b890
 	github.com/matrixorigin/simdcsv v0.0.0-20210926114300-591bf748a770
@@ -61,7 +62,6 @@ require (
 	github.com/json-iterator/go v1.1.11 // indirect
 	github.com/jtolds/gls v4.20.0+incompatible // indirect
 	github.com/juju/ratelimit v1.0.1 // indirect
-	github.com/klauspost/compress v1.13.6 // indirect
 	github.com/klauspost/cpuid/v2 v2.0.3 // indirect
 	github.com/kr/pretty v0.3.0 // indirect
 	github.com/kr/text v0.2.0 // indirect
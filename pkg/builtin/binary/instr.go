@@ -0,0 +1,69 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package binary
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/instr"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["instr"] = builtin.Instr
+	extend.BinaryReturnTypes[builtin.Instr] = func(_ extend.Extend, _ extend.Extend) types.T {
+		return types.T_int64
+	}
+	extend.BinaryStrings[builtin.Instr] = func(l extend.Extend, r extend.Extend) string {
+		return fmt.Sprintf("instr(%s, %s)", l, r)
+	}
+	overload.OpTypes[builtin.Instr] = overload.Binary
+	for _, lt := range stringTypes {
+		for _, rt := range stringTypes {
+			overload.BinOps[builtin.Instr] = append(overload.BinOps[builtin.Instr], &overload.BinOp{
+				LeftType:   lt,
+				RightType:  rt,
+				ReturnType: types.T_int64,
+				Fn:         instrFn,
+			})
+		}
+	}
+}
+
+func instrFn(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+	vecs, cs := []*vector.Vector{lv, rv}, []bool{lc, rc}
+	defer builtin.Free(proc, vecs)
+	n := builtin.Rows(vecs, cs)
+	vec, err := process.Get(proc, 8*int64(n), types.Type{Oid: types.T_int64, Size: 8})
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeInt64Slice(vec.Data)
+	rs = rs[:n]
+	xs := builtin.BytesArg(lv, lc, n)
+	if rc {
+		rs = instr.Instr(xs, rv.Col.(*types.Bytes).Get(0), rs)
+	} else {
+		rs = instr.InstrSlice(xs, rv.Col.(*types.Bytes), rs)
+	}
+	vector.SetCol(vec, rs)
+	builtin.Nulls(vec, vecs, cs)
+	return vec, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package binary
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/substring"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["left"] = builtin.Left
+	extend.BinaryReturnTypes[builtin.Left] = func(_ extend.Extend, _ extend.Extend) types.T {
+		return types.T_varchar
+	}
+	extend.BinaryStrings[builtin.Left] = func(l extend.Extend, r extend.Extend) string {
+		return fmt.Sprintf("left(%s, %s)", l, r)
+	}
+	overload.OpTypes[builtin.Left] = overload.Binary
+	fn := charsFn(substring.Left, substring.LeftSlice)
+	for _, lt := range stringTypes {
+		for _, rt := range integerTypes {
+			overload.BinOps[builtin.Left] = append(overload.BinOps[builtin.Left], &overload.BinOp{
+				LeftType:   lt,
+				RightType:  rt,
+				ReturnType: types.T_varchar,
+				Fn:         fn,
+			})
+		}
+	}
+}
+
+// charsFn returns the function taking a number of characters of strings,
+// fn is its fast path for a constant number.
+func charsFn(fn func(*types.Bytes, int64, *types.Bytes) *types.Bytes,
+	sliceFn func(*types.Bytes, []int64, *types.Bytes) *types.Bytes) func(*vector.Vector, *vector.Vector, *process.Process, bool, bool) (*vector.Vector, error) {
+	return func(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+		vecs, cs := []*vector.Vector{lv, rv}, []bool{lc, rc}
+		defer builtin.Free(proc, vecs)
+		n := builtin.Rows(vecs, cs)
+		xs := builtin.BytesArg(lv, lc, n)
+		rs := builtin.NewBytes(n, len(xs.Data))
+		if rc {
+			k, err := builtin.Int64Const(rv)
+			if err != nil {
+				return nil, err
+			}
+			rs = fn(xs, k, rs)
+		} else {
+			ks, err := builtin.Int64Arg(rv, rc, n)
+			if err != nil {
+				return nil, err
+			}
+			rs = sliceFn(xs, ks, rs)
+		}
+		vec, err := builtin.NewBytesVector(proc, rs)
+		if err != nil {
+			return nil, err
+		}
+		builtin.Nulls(vec, vecs, cs)
+		return vec, nil
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package binary
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/regexp"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// init registers regexp_like which is a condition like the LIKE operator,
+// it returns the selected rows.
+func init() {
+	extend.FunctionRegistry["regexp_like"] = builtin.RegexpLike
+	extend.BinaryReturnTypes[builtin.RegexpLike] = func(_ extend.Extend, _ extend.Extend) types.T {
+		return types.T_sel
+	}
+	extend.BinaryStrings[builtin.RegexpLike] = func(l extend.Extend, r extend.Extend) string {
+		return fmt.Sprintf("regexp_like(%s, %s)", l, r)
+	}
+	overload.OpTypes[builtin.RegexpLike] = overload.Binary
+	overload.LogicalOps[builtin.RegexpLike] = overload.MustLogical
+	for _, lt := range stringTypes {
+		for _, rt := range stringTypes {
+			overload.BinOps[builtin.RegexpLike] = append(overload.BinOps[builtin.RegexpLike], &overload.BinOp{
+				LeftType:   lt,
+				RightType:  rt,
+				ReturnType: types.T_sel,
+				Fn:         regexpLikeFn,
+			})
+		}
+	}
+}
+
+func regexpLikeFn(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+	vecs, cs := []*vector.Vector{lv, rv}, []bool{lc, rc}
+	defer builtin.Free(proc, vecs)
+	n := builtin.Rows(vecs, cs)
+	vec, err := process.Get(proc, 8*int64(n), overload.SelsType)
+	if err != nil {
+		return nil, err
+	}
+	ns := new(nulls.Nulls)
+	if !lc {
+		nulls.Set(ns, lv.Nsp)
+	}
+	if !rc {
+		nulls.Set(ns, rv.Nsp)
+	}
+	rs := encoding.DecodeInt64Slice(vec.Data)
+	xs := builtin.BytesArg(lv, lc, n)
+	if rc {
+		rs, err = regexp.Like(xs, rv.Col.(*types.Bytes).Get(0), ns, rs)
+	} else {
+		rs, err = regexp.LikeSlice(xs, rv.Col.(*types.Bytes), ns, rs)
+	}
+	if err != nil {
+		process.Put(proc, vec)
+		return nil, err
+	}
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
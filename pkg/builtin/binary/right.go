@@ -0,0 +1,46 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package binary
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/substring"
+)
+
+func init() {
+	extend.FunctionRegistry["right"] = builtin.Right
+	extend.BinaryReturnTypes[builtin.Right] = func(_ extend.Extend, _ extend.Extend) types.T {
+		return types.T_varchar
+	}
+	extend.BinaryStrings[builtin.Right] = func(l extend.Extend, r extend.Extend) string {
+		return fmt.Sprintf("right(%s, %s)", l, r)
+	}
+	overload.OpTypes[builtin.Right] = overload.Binary
+	fn := charsFn(substring.Right, substring.RightSlice)
+	for _, lt := range stringTypes {
+		for _, rt := range integerTypes {
+			overload.BinOps[builtin.Right] = append(overload.BinOps[builtin.Right], &overload.BinOp{
+				LeftType:   lt,
+				RightType:  rt,
+				ReturnType: types.T_varchar,
+				Fn:         fn,
+			})
+		}
+	}
+}
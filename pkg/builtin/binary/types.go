@@ -20,6 +20,16 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 )
 
+var (
+	// stringTypes are the types of the string arguments
+	stringTypes = []types.T{types.T_char, types.T_varchar}
+	// integerTypes are the types of the integer arguments
+	integerTypes = []types.T{
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	}
+)
+
 type argsAndRet struct {
 	args []types.T
 	ret  types.T
@@ -0,0 +1,183 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtin
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// IsString returns true if typ is a char or varchar type.
+func IsString(typ types.T) bool {
+	return typ == types.T_char || typ == types.T_varchar
+}
+
+// Rows returns the number of rows of a function result, which is the length
+// of its non constant arguments, a function of constants returns one row.
+func Rows(vecs []*vector.Vector, cs []bool) int {
+	for i, vec := range vecs {
+		if !cs[i] {
+			return vector.Length(vec)
+		}
+	}
+	return 1
+}
+
+// Nulls merges the nulls of the non constant arguments into vec.
+func Nulls(vec *vector.Vector, vecs []*vector.Vector, cs []bool) {
+	for i, v := range vecs {
+		if !cs[i] {
+			nulls.Set(vec.Nsp, v.Nsp)
+		}
+	}
+}
+
+// Free gives back the intermediate results among vecs to proc.
+func Free(proc *process.Process, vecs []*vector.Vector) {
+	for _, vec := range vecs {
+		if vec.Ref == 0 {
+			process.Put(proc, vec)
+		}
+	}
+}
+
+// BytesArg returns n strings of vec, a constant is repeated n times
+// without copying its data.
+func BytesArg(vec *vector.Vector, c bool, n int) *types.Bytes {
+	vs := vec.Col.(*types.Bytes)
+	if !c {
+		return vs
+	}
+	rs := &types.Bytes{
+		Data:    vs.Get(0),
+		Offsets: make([]uint32, n),
+		Lengths: make([]uint32, n),
+	}
+	for i := range rs.Lengths {
+		rs.Lengths[i] = vs.Lengths[0]
+	}
+	return rs
+}
+
+// Int64Arg returns n integers of vec converted to int64, a constant is
+// repeated n times.
+func Int64Arg(vec *vector.Vector, c bool, n int) ([]int64, error) {
+	if c {
+		v, err := Int64Const(vec)
+		if err != nil {
+			return nil, err
+		}
+		rs := make([]int64, n)
+		for i := range rs {
+			rs[i] = v
+		}
+		return rs, nil
+	}
+	switch vs := vec.Col.(type) {
+	case []int64:
+		return vs, nil
+	case []int8:
+		rs := make([]int64, len(vs))
+		for i, v := range vs {
+			rs[i] = int64(v)
+		}
+		return rs, nil
+	case []int16:
+		rs := make([]int64, len(vs))
+		for i, v := range vs {
+			rs[i] = int64(v)
+		}
+		return rs, nil
+	case []int32:
+		rs := make([]int64, len(vs))
+		for i, v := range vs {
+			rs[i] = int64(v)
+		}
+		return rs, nil
+	case []uint8:
+		rs := make([]int64, len(vs))
+		for i, v := range vs {
+			rs[i] = int64(v)
+		}
+		return rs, nil
+	case []uint16:
+		rs := make([]int64, len(vs))
+		for i, v := range vs {
+			rs[i] = int64(v)
+		}
+		return rs, nil
+	case []uint32:
+		rs := make([]int64, len(vs))
+		for i, v := range vs {
+			rs[i] = int64(v)
+		}
+		return rs, nil
+	case []uint64:
+		rs := make([]int64, len(vs))
+		for i, v := range vs {
+			rs[i] = int64(v)
+		}
+		return rs, nil
+	}
+	return nil, fmt.Errorf("an integer argument is expected instead of %s", vec.Typ)
+}
+
+// Int64Const returns the integer constant of vec as int64.
+func Int64Const(vec *vector.Vector) (int64, error) {
+	switch vs := vec.Col.(type) {
+	case []int64:
+		return vs[0], nil
+	case []int8:
+		return int64(vs[0]), nil
+	case []int16:
+		return int64(vs[0]), nil
+	case []int32:
+		return int64(vs[0]), nil
+	case []uint8:
+		return int64(vs[0]), nil
+	case []uint16:
+		return int64(vs[0]), nil
+	case []uint32:
+		return int64(vs[0]), nil
+	case []uint64:
+		return int64(vs[0]), nil
+	}
+	return 0, fmt.Errorf("an integer argument is expected instead of %s", vec.Typ)
+}
+
+// NewBytes returns an empty column for n strings of size bytes in total.
+func NewBytes(n, size int) *types.Bytes {
+	return &types.Bytes{
+		Data:    make([]byte, 0, size),
+		Offsets: make([]uint32, 0, n),
+		Lengths: make([]uint32, 0, n),
+	}
+}
+
+// NewBytesVector returns a varchar vector of col, the memory of col is
+// accounted to proc.
+func NewBytesVector(proc *process.Process, col *types.Bytes) (*vector.Vector, error) {
+	if err := proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	vec.Data = col.Data
+	vector.SetCol(vec, col)
+	return vec, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtin
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/typecast"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// IsDatetime returns true if typ is a date, datetime or timestamp type, or
+// a string which can be parsed as a datetime.
+func IsDatetime(typ types.T) bool {
+	switch typ {
+	case types.T_date, types.T_datetime, types.T_timestamp:
+		return true
+	}
+	return IsString(typ)
+}
+
+// DatetimeArg returns n datetimes of vec, a date is at its midnight, a timestamp
+// is in the session time zone of proc and a string is parsed as a datetime.
+// A constant is repeated n times and the null rows are zero.
+func DatetimeArg(vec *vector.Vector, c bool, n int, proc *process.Process) ([]types.Datetime, error) {
+	var xs []types.Datetime
+
+	switch vs := vec.Col.(type) {
+	case []types.Date:
+		xs = typecast.DateToDatetime(vs, make([]types.Datetime, len(vs)))
+	case []types.Datetime:
+		xs = vs
+	case []types.Timestamp:
+		xs = typecast.TimestampToDatetime(vs, process.TimeZone(proc), make([]types.Datetime, len(vs)))
+	case *types.Bytes:
+		xs = make([]types.Datetime, len(vs.Offsets))
+		for i := range vs.Offsets {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				continue
+			}
+			x, err := types.ParseDatetime(string(vs.Get(int64(i))))
+			if err != nil {
+				return nil, err
+			}
+			xs[i] = x
+		}
+	default:
+		return nil, fmt.Errorf("a date or time argument is expected instead of %s", vec.Typ)
+	}
+	if !c {
+		return xs, nil
+	}
+	rs := make([]types.Datetime, n)
+	if len(xs) > 0 {
+		for i := range rs {
+			rs[i] = xs[0]
+		}
+	}
+	return rs, nil
+}
+
+// NewDatetimeVector returns a vector of the datetimes or the dates xs, the memory
+// of xs is accounted to proc.
+func NewDatetimeVector(proc *process.Process, typ types.Type, xs []types.Datetime) (*vector.Vector, error) {
+	vec, err := process.Get(proc, int64(typ.Size)*int64(len(xs)), typ)
+	if err != nil {
+		return nil, err
+	}
+	switch typ.Oid {
+	case types.T_date:
+		rs := encoding.DecodeDateSlice(vec.Data)[:len(xs)]
+		vector.SetCol(vec, typecast.DatetimeToDate(xs, rs))
+	case types.T_timestamp:
+		rs := encoding.DecodeTimestampSlice(vec.Data)[:len(xs)]
+		vector.SetCol(vec, typecast.DatetimeToTimestamp(xs, process.TimeZone(proc), rs))
+	default:
+		rs := encoding.DecodeDatetimeSlice(vec.Data)[:len(xs)]
+		copy(rs, xs)
+		vector.SetCol(vec, rs)
+	}
+	return vec, nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtin
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// IsJson returns true if typ is a json type, or a string which can be
+// parsed as a json text.
+func IsJson(typ types.T) bool {
+	return typ == types.T_json || IsString(typ)
+}
+
+// JsonArg returns n json values of vec which is a json or a json text, the null
+// rows are nil. A constant is parsed once and repeated n times.
+func JsonArg(vec *vector.Vector, c bool, n int) ([]bytejson.ByteJson, error) {
+	if !IsJson(vec.Typ.Oid) {
+		return nil, fmt.Errorf("a json argument is expected instead of %s", vec.Typ)
+	}
+	vs := vec.Col.(*types.Bytes)
+	rs := make([]bytejson.ByteJson, n)
+	if c {
+		if nulls.Contains(vec.Nsp, 0) {
+			return rs, nil
+		}
+		v, err := jsonValue(vec.Typ.Oid, vs.Get(0))
+		if err != nil {
+			return nil, err
+		}
+		for i := range rs {
+			rs[i] = v
+		}
+		return rs, nil
+	}
+	for i := range rs {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			continue
+		}
+		v, err := jsonValue(vec.Typ.Oid, vs.Get(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = v
+	}
+	return rs, nil
+}
+
+func jsonValue(typ types.T, data []byte) (bytejson.ByteJson, error) {
+	if typ == types.T_json {
+		return bytejson.ByteJson(data), nil
+	}
+	return bytejson.Parse(data)
+}
+
+// JsonValues returns n json values converted from the rows of vec, a string is
+// a json string, a date or time is the json string of its text, and a null row
+// is the json null. A constant is repeated n times.
+func JsonValues(vec *vector.Vector, c bool, n int, proc *process.Process) ([]bytejson.ByteJson, error) {
+	var fn func(int) bytejson.ByteJson
+
+	switch vs := vec.Col.(type) {
+	case []int8:
+		fn = func(i int) bytejson.ByteJson { return bytejson.FromInt64(int64(vs[i])) }
+	case []int16:
+		fn = func(i int) bytejson.ByteJson { return bytejson.FromInt64(int64(vs[i])) }
+	case []int32:
+		fn = func(i int) bytejson.ByteJson { return bytejson.FromInt64(int64(vs[i])) }
+	case []int64:
+		fn = func(i int) bytejson.ByteJson { return bytejson.FromInt64(vs[i]) }
+	case []uint8:
+		fn = func(i int) bytejson.ByteJson { return bytejson.FromInt64(int64(vs[i])) }
+	case []uint16:
+		fn = func(i int) bytejson.ByteJson { return bytejson.FromInt64(int64(vs[i])) }
+	case []uint32:
+		fn = func(i int) bytejson.ByteJson { return bytejson.FromInt64(int64(vs[i])) }
+	case []uint64:
+		fn = func(i int) bytejson.ByteJson {
+			if vs[i] > math.MaxInt64 {
+				return bytejson.FromFloat64(float64(vs[i]))
+			}
+			return bytejson.FromInt64(int64(vs[i]))
+		}
+	case []float32:
+		fn = func(i int) bytejson.ByteJson { return bytejson.FromFloat64(float64(vs[i])) }
+	case []float64:
+		fn = func(i int) bytejson.ByteJson { return bytejson.FromFloat64(vs[i]) }
+	case []types.Decimal64:
+		fn = func(i int) bytejson.ByteJson {
+			v, _ := strconv.ParseFloat(vs[i].ToString(vec.Typ.Precision), 64)
+			return bytejson.FromFloat64(v)
+		}
+	case []types.Decimal128:
+		fn = func(i int) bytejson.ByteJson {
+			v, _ := strconv.ParseFloat(vs[i].ToString(vec.Typ.Precision), 64)
+			return bytejson.FromFloat64(v)
+		}
+	case []types.Date:
+		fn = func(i int) bytejson.ByteJson { return bytejson.FromString([]byte(vs[i].String())) }
+	case []types.Datetime:
+		fn = func(i int) bytejson.ByteJson { return bytejson.FromString([]byte(vs[i].String())) }
+	case []types.Time:
+		fn = func(i int) bytejson.ByteJson { return bytejson.FromString([]byte(vs[i].String())) }
+	case []types.Timestamp:
+		loc := process.TimeZone(proc)
+		fn = func(i int) bytejson.ByteJson {
+			return bytejson.FromString([]byte(vs[i].ToDatetime(loc).String()))
+		}
+	case *types.Bytes:
+		if vec.Typ.Oid == types.T_json {
+			fn = func(i int) bytejson.ByteJson { return bytejson.ByteJson(vs.Get(int64(i))) }
+		} else {
+			fn = func(i int) bytejson.ByteJson { return bytejson.FromString(vs.Get(int64(i))) }
+		}
+	default:
+		return nil, fmt.Errorf("%s can not be converted to json", vec.Typ)
+	}
+	rs := make([]bytejson.ByteJson, n)
+	for i := range rs {
+		j := i
+		if c {
+			j = 0
+		}
+		if nulls.Contains(vec.Nsp, uint64(j)) {
+			rs[i] = bytejson.Null()
+		} else {
+			rs[i] = fn(j)
+		}
+	}
+	return rs, nil
+}
+
+// NewJsonVector returns a json vector of xs whose nil rows are null, the
+// memory of the vector is accounted to proc.
+func NewJsonVector(proc *process.Process, xs []bytejson.ByteJson) (*vector.Vector, error) {
+	size := 0
+	for _, x := range xs {
+		size += len(x)
+	}
+	col := NewBytes(len(xs), size)
+	for _, x := range xs {
+		col.Offsets = append(col.Offsets, uint32(len(col.Data)))
+		col.Lengths = append(col.Lengths, uint32(len(x)))
+		col.Data = append(col.Data, x...)
+	}
+	if err := proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(types.Type{Oid: types.T_json, Size: 24})
+	vec.Data = col.Data
+	vector.SetCol(vec, col)
+	for i, x := range xs {
+		if x == nil {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	return vec, nil
+}
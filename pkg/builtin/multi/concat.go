@@ -0,0 +1,57 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/concat"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["concat"] = builtin.Concat
+	extend.MultiReturnTypes[builtin.Concat] = func(_ []extend.Extend) types.T {
+		return types.T_varchar
+	}
+	extend.MultiStrings[builtin.Concat] = func(es []extend.Extend) string {
+		return funcString("concat", es)
+	}
+	overload.OpTypes[builtin.Concat] = overload.Multi
+	overload.MultiOps[builtin.Concat] = stringOps(1, -1, types.T_varchar, concatFn)
+}
+
+// concatFn returns null if one of its arguments is null.
+func concatFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	if err := checkStrings("concat", vecs); err != nil {
+		return nil, err
+	}
+	n := builtin.Rows(vecs, cs)
+	size := 0
+	xs := make([]*types.Bytes, len(vecs))
+	for i, vec := range vecs {
+		xs[i] = vec.Col.(*types.Bytes)
+		size += len(xs[i].Data)
+	}
+	vec, err := builtin.NewBytesVector(proc, concat.Concat(xs, cs, n, builtin.NewBytes(n, size)))
+	if err != nil {
+		return nil, err
+	}
+	builtin.Nulls(vec, vecs, cs)
+	return vec, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/concat"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["concat_ws"] = builtin.ConcatWs
+	extend.MultiReturnTypes[builtin.ConcatWs] = func(_ []extend.Extend) types.T {
+		return types.T_varchar
+	}
+	extend.MultiStrings[builtin.ConcatWs] = func(es []extend.Extend) string {
+		return funcString("concat_ws", es)
+	}
+	overload.OpTypes[builtin.ConcatWs] = overload.Multi
+	overload.MultiOps[builtin.ConcatWs] = stringOps(2, -1, types.T_varchar, concatWsFn)
+}
+
+// concatWsFn returns null if its separator is null, and skips the other
+// null arguments.
+func concatWsFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	if err := checkStrings("concat_ws", vecs); err != nil {
+		return nil, err
+	}
+	n := builtin.Rows(vecs, cs)
+	size := 0
+	xs := make([]*types.Bytes, len(vecs))
+	nsps := make([]*nulls.Nulls, len(vecs))
+	for i, vec := range vecs {
+		xs[i], nsps[i] = vec.Col.(*types.Bytes), vec.Nsp
+		size += len(xs[i].Data)
+	}
+	vec, err := builtin.NewBytesVector(proc, concat.ConcatWs(xs, cs, nsps, n, builtin.NewBytes(n, size)))
+	if err != nil {
+		return nil, err
+	}
+	if !cs[0] {
+		nulls.Set(vec.Nsp, vecs[0].Nsp)
+	}
+	return vec, nil
+}
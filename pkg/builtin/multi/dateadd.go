@@ -0,0 +1,102 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/dateadd"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/extract"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// date_add(x, n, unit) and date_sub(x, n, unit) are built by the planner from
+// DATE_ADD(x, INTERVAL n unit) and x + INTERVAL n unit.
+func init() {
+	for _, f := range []struct {
+		op   int
+		name string
+	}{
+		{builtin.DateAdd, "date_add"},
+		{builtin.DateSub, "date_sub"},
+	} {
+		op, name := f.op, f.name
+		extend.FunctionRegistry[name] = op
+		extend.MultiReturnTypes[op] = func(es []extend.Extend) types.T {
+			unit := ""
+			if v, ok := es[2].(*extend.ValueExtend); ok && builtin.IsString(v.V.Typ.Oid) {
+				unit = string(v.V.Col.(*types.Bytes).Get(0))
+			}
+			return dateAddReturnType(es[0].ReturnType(), unit)
+		}
+		extend.MultiStrings[op] = func(es []extend.Extend) string {
+			return funcString(name, es)
+		}
+		overload.OpTypes[op] = overload.Multi
+		overload.MultiOps[op] = datetimeOps(3, 3, types.T_datetime, dateAddFn(name, op == builtin.DateSub))
+	}
+}
+
+// dateAddReturnType returns date if a date is added days or longer, or datetime
+func dateAddReturnType(typ types.T, unit string) types.T {
+	if typ == types.T_date {
+		switch strings.ToLower(unit) {
+		case extract.Day, extract.Week, extract.Month, extract.Quarter, extract.Year:
+			return types.T_date
+		}
+	}
+	return types.T_datetime
+}
+
+func dateAddFn(name string, sub bool) func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error) {
+	return func(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		defer builtin.Free(proc, vecs)
+		unit, err := constString(name, vecs, cs, 2)
+		if err != nil {
+			return nil, err
+		}
+		n := builtin.Rows(vecs, cs)
+		xs, err := builtin.DatetimeArg(vecs[0], cs[0], n, proc)
+		if err != nil {
+			return nil, err
+		}
+		ns, err := builtin.Int64Arg(vecs[1], cs[1], n)
+		if err != nil {
+			return nil, err
+		}
+		if sub {
+			ys := make([]int64, n)
+			for i, v := range ns {
+				ys[i] = -v
+			}
+			ns = ys
+		}
+		rs, err := dateadd.DateAdd(xs, ns, unit, make([]types.Datetime, n))
+		if err != nil {
+			return nil, err
+		}
+		vec, err := builtin.NewDatetimeVector(proc, dateAddReturnType(vecs[0].Typ.Oid, unit).ToType(), rs)
+		if err != nil {
+			return nil, err
+		}
+		builtin.Nulls(vec, vecs, cs)
+		return vec, nil
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/datediff"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/typecast"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["datediff"] = builtin.DateDiff
+	extend.MultiReturnTypes[builtin.DateDiff] = func(_ []extend.Extend) types.T {
+		return types.T_int64
+	}
+	extend.MultiStrings[builtin.DateDiff] = func(es []extend.Extend) string {
+		return funcString("datediff", es)
+	}
+	overload.OpTypes[builtin.DateDiff] = overload.Multi
+	overload.MultiOps[builtin.DateDiff] = datetimeOps(2, 2, types.T_int64, dateDiffFn)
+}
+
+func dateDiffFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	if !builtin.IsDatetime(vecs[1].Typ.Oid) {
+		return nil, fmt.Errorf("the argument 2 of datediff must be a date")
+	}
+	n := builtin.Rows(vecs, cs)
+	xs, err := builtin.DatetimeArg(vecs[0], cs[0], n, proc)
+	if err != nil {
+		return nil, err
+	}
+	ys, err := builtin.DatetimeArg(vecs[1], cs[1], n, proc)
+	if err != nil {
+		return nil, err
+	}
+	vec, err := process.Get(proc, 8*int64(n), types.Type{Oid: types.T_int64, Size: 8})
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeInt64Slice(vec.Data)
+	lds := typecast.DatetimeToDate(xs, make([]types.Date, n))
+	rds := typecast.DatetimeToDate(ys, make([]types.Date, n))
+	vector.SetCol(vec, datediff.DateDiff(lds, rds, rs[:n]))
+	builtin.Nulls(vec, vecs, cs)
+	return vec, nil
+}
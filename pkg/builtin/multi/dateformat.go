@@ -0,0 +1,106 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/dateformat"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["date_format"] = builtin.DateFormat
+	extend.MultiReturnTypes[builtin.DateFormat] = func(_ []extend.Extend) types.T {
+		return types.T_varchar
+	}
+	extend.MultiStrings[builtin.DateFormat] = func(es []extend.Extend) string {
+		return funcString("date_format", es)
+	}
+	overload.OpTypes[builtin.DateFormat] = overload.Multi
+	overload.MultiOps[builtin.DateFormat] = datetimeOps(2, 2, types.T_varchar, dateFormatFn)
+
+	extend.FunctionRegistry["str_to_date"] = builtin.StrToDate
+	extend.MultiReturnTypes[builtin.StrToDate] = func(_ []extend.Extend) types.T {
+		return types.T_datetime
+	}
+	extend.MultiStrings[builtin.StrToDate] = func(es []extend.Extend) string {
+		return funcString("str_to_date", es)
+	}
+	overload.OpTypes[builtin.StrToDate] = overload.Multi
+	overload.MultiOps[builtin.StrToDate] = stringOps(2, 2, types.T_datetime, strToDateFn)
+}
+
+func dateFormatFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	if err := checkStrings("date_format", vecs, 1); err != nil {
+		return nil, err
+	}
+	n := builtin.Rows(vecs, cs)
+	xs, err := builtin.DatetimeArg(vecs[0], cs[0], n, proc)
+	if err != nil {
+		return nil, err
+	}
+	rs := builtin.NewBytes(n, 20*n)
+	if cs[1] {
+		rs = dateformat.DateFormat(xs, string(vecs[1].Col.(*types.Bytes).Get(0)), rs)
+	} else {
+		var buf strings.Builder
+
+		formats := vecs[1].Col.(*types.Bytes)
+		for i, x := range xs {
+			buf.Reset()
+			dateformat.Format(&buf, x, string(formats.Get(int64(i))))
+			o := uint32(len(rs.Data))
+			rs.Data = append(rs.Data, buf.String()...)
+			rs.Offsets = append(rs.Offsets, o)
+			rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-o)
+		}
+	}
+	vec, err := builtin.NewBytesVector(proc, rs)
+	if err != nil {
+		return nil, err
+	}
+	builtin.Nulls(vec, vecs, cs)
+	return vec, nil
+}
+
+// strToDateFn returns null for the strings which can not be parsed, the same as mysql does.
+func strToDateFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	format, err := constString("str_to_date", vecs, cs, 1)
+	if err != nil {
+		return nil, err
+	}
+	n := builtin.Rows(vecs, cs)
+	nsp := new(nulls.Nulls)
+	if !cs[0] {
+		nulls.Set(nsp, vecs[0].Nsp)
+	}
+	xs := builtin.BytesArg(vecs[0], cs[0], n)
+	rs := dateformat.StrToDate(xs, format, nsp, make([]types.Datetime, n))
+	vec, err := builtin.NewDatetimeVector(proc, types.Type{Oid: types.T_datetime, Size: 8}, rs)
+	if err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, nsp)
+	return vec, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/datetrunc"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["date_trunc"] = builtin.DateTrunc
+	extend.MultiReturnTypes[builtin.DateTrunc] = func(es []extend.Extend) types.T {
+		return dateTruncReturnType(es[1].ReturnType())
+	}
+	extend.MultiStrings[builtin.DateTrunc] = func(es []extend.Extend) string {
+		return funcString("date_trunc", es)
+	}
+	overload.OpTypes[builtin.DateTrunc] = overload.Multi
+	overload.MultiOps[builtin.DateTrunc] = stringOps(2, 2, types.T_datetime, dateTruncFn)
+}
+
+// dateTruncReturnType returns timestamp for a timestamp, and datetime for the others
+func dateTruncReturnType(typ types.T) types.T {
+	if typ == types.T_timestamp {
+		return types.T_timestamp
+	}
+	return types.T_datetime
+}
+
+func dateTruncFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	unit, err := constString("date_trunc", vecs, cs, 0)
+	if err != nil {
+		return nil, err
+	}
+	n := builtin.Rows(vecs, cs)
+	xs, err := builtin.DatetimeArg(vecs[1], cs[1], n, proc)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := datetrunc.DateTrunc(unit, xs, make([]types.Datetime, n))
+	if err != nil {
+		return nil, err
+	}
+	vec, err := builtin.NewDatetimeVector(proc, dateTruncReturnType(vecs[1].Typ.Oid).ToType(), rs)
+	if err != nil {
+		return nil, err
+	}
+	builtin.Nulls(vec, vecs, cs)
+	return vec, nil
+}
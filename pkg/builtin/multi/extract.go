@@ -0,0 +1,63 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/extract"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["extract"] = builtin.Extract
+	extend.MultiReturnTypes[builtin.Extract] = func(_ []extend.Extend) types.T {
+		return types.T_int64
+	}
+	extend.MultiStrings[builtin.Extract] = func(es []extend.Extend) string {
+		return funcString("extract", es)
+	}
+	overload.OpTypes[builtin.Extract] = overload.Multi
+	overload.MultiOps[builtin.Extract] = stringOps(2, 2, types.T_int64, extractFn)
+}
+
+func extractFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	unit, err := constString("extract", vecs, cs, 0)
+	if err != nil {
+		return nil, err
+	}
+	n := builtin.Rows(vecs, cs)
+	xs, err := builtin.DatetimeArg(vecs[1], cs[1], n, proc)
+	if err != nil {
+		return nil, err
+	}
+	vec, err := process.Get(proc, 8*int64(n), types.Type{Oid: types.T_int64, Size: 8})
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeInt64Slice(vec.Data)
+	if rs, err = extract.Extract(unit, xs, rs[:n]); err != nil {
+		process.Put(proc, vec)
+		return nil, err
+	}
+	vector.SetCol(vec, rs)
+	builtin.Nulls(vec, vecs, cs)
+	return vec, nil
+}
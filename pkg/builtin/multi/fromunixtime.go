@@ -0,0 +1,84 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/dateformat"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/typecast"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/unixtime"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// from_unixtime(n) returns the datetime of n seconds since 1970-01-01 00:00:00 UTC
+// in the session time zone, and from_unixtime(n, format) formats it.
+func init() {
+	extend.FunctionRegistry["from_unixtime"] = builtin.FromUnixtime
+	extend.MultiReturnTypes[builtin.FromUnixtime] = func(es []extend.Extend) types.T {
+		if len(es) == 2 {
+			return types.T_varchar
+		}
+		return types.T_datetime
+	}
+	extend.MultiStrings[builtin.FromUnixtime] = func(es []extend.Extend) string {
+		return funcString("from_unixtime", es)
+	}
+	overload.OpTypes[builtin.FromUnixtime] = overload.Multi
+	for _, typ := range []types.T{
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	} {
+		overload.MultiOps[builtin.FromUnixtime] = append(overload.MultiOps[builtin.FromUnixtime], &overload.MultiOp{
+			Min:        1,
+			Max:        2,
+			Typ:        typ,
+			ReturnType: types.T_datetime,
+			Fn:         fromUnixtimeFn,
+		})
+	}
+}
+
+func fromUnixtimeFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	n := builtin.Rows(vecs, cs)
+	secs, err := builtin.Int64Arg(vecs[0], cs[0], n)
+	if err != nil {
+		return nil, err
+	}
+	ts := unixtime.FromUnixtime(secs, make([]types.Timestamp, n))
+	xs := typecast.TimestampToDatetime(ts, process.TimeZone(proc), make([]types.Datetime, n))
+	if len(vecs) == 1 {
+		vec, err := builtin.NewDatetimeVector(proc, types.Type{Oid: types.T_datetime, Size: 8}, xs)
+		if err != nil {
+			return nil, err
+		}
+		builtin.Nulls(vec, vecs, cs)
+		return vec, nil
+	}
+	format, err := constString("from_unixtime", vecs, cs, 1)
+	if err != nil {
+		return nil, err
+	}
+	vec, err := builtin.NewBytesVector(proc, dateformat.DateFormat(xs, format, builtin.NewBytes(n, 20*n)))
+	if err != nil {
+		return nil, err
+	}
+	builtin.Nulls(vec, vecs, cs)
+	return vec, nil
+}
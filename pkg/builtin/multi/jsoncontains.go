@@ -0,0 +1,92 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// init registers json_contains(target, candidate[, path]) which is a condition like
+// regexp_like, it returns the rows whose target, or its value at the path, contains
+// the candidate.
+func init() {
+	extend.FunctionRegistry["json_contains"] = builtin.JsonContains
+	extend.MultiReturnTypes[builtin.JsonContains] = func(_ []extend.Extend) types.T {
+		return types.T_sel
+	}
+	extend.MultiStrings[builtin.JsonContains] = func(es []extend.Extend) string {
+		return funcString("json_contains", es)
+	}
+	overload.OpTypes[builtin.JsonContains] = overload.Multi
+	overload.LogicalOps[builtin.JsonContains] = overload.MustLogical
+	overload.MultiOps[builtin.JsonContains] = jsonOps(2, 3, types.T_sel, jsonContainsFn)
+}
+
+func jsonContainsFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	n := builtin.Rows(vecs, cs)
+	targets, err := builtin.JsonArg(vecs[0], cs[0], n)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := builtin.JsonArg(vecs[1], cs[1], n)
+	if err != nil {
+		return nil, err
+	}
+	nsp := new(nulls.Nulls)
+	var paths [][]*bytejson.Path
+	if len(vecs) == 3 {
+		if paths, err = jsonPaths("json_contains", vecs[2:], cs[2:], n, nsp); err != nil {
+			return nil, err
+		}
+		for _, ps := range paths {
+			if ps[0] != nil && ps[0].HasWildcard() {
+				return nil, fmt.Errorf("the path of json_contains may not contain the * and ** tokens")
+			}
+		}
+	}
+	vec, err := process.Get(proc, 8*int64(n), overload.SelsType)
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeInt64Slice(vec.Data)
+	rs = rs[:0]
+	for i, target := range targets {
+		if target == nil || candidates[i] == nil || nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		if paths != nil {
+			var ok bool
+			if target, ok = target.Extract(paths[i]); !ok {
+				continue
+			}
+		}
+		if bytejson.Contains(target, candidates[i]) {
+			rs = append(rs, int64(i))
+		}
+	}
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
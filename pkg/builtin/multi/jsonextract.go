@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// init registers json_extract(doc, path, ...), it returns the value matched by
+// the path, or an array of the values if there are several paths or wildcards.
+func init() {
+	extend.FunctionRegistry["json_extract"] = builtin.JsonExtract
+	extend.MultiReturnTypes[builtin.JsonExtract] = func(_ []extend.Extend) types.T {
+		return types.T_json
+	}
+	extend.MultiStrings[builtin.JsonExtract] = func(es []extend.Extend) string {
+		return funcString("json_extract", es)
+	}
+	overload.OpTypes[builtin.JsonExtract] = overload.Multi
+	overload.MultiOps[builtin.JsonExtract] = jsonOps(2, -1, types.T_json, jsonExtractFn)
+}
+
+func jsonExtractFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	n := builtin.Rows(vecs, cs)
+	docs, err := builtin.JsonArg(vecs[0], cs[0], n)
+	if err != nil {
+		return nil, err
+	}
+	nsp := new(nulls.Nulls)
+	paths, err := jsonPaths("json_extract", vecs[1:], cs[1:], n, nsp)
+	if err != nil {
+		return nil, err
+	}
+	rs := make([]bytejson.ByteJson, n)
+	for i, doc := range docs {
+		if doc == nil || nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		rs[i], _ = doc.Extract(paths[i])
+	}
+	return builtin.NewJsonVector(proc, rs)
+}
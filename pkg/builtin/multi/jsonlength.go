@@ -0,0 +1,79 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// init registers json_length(doc[, path]), it returns the number of the elements
+// of an array, the members of an object, or 1 for a scalar.
+func init() {
+	extend.FunctionRegistry["json_length"] = builtin.JsonLength
+	extend.MultiReturnTypes[builtin.JsonLength] = func(_ []extend.Extend) types.T {
+		return types.T_int64
+	}
+	extend.MultiStrings[builtin.JsonLength] = func(es []extend.Extend) string {
+		return funcString("json_length", es)
+	}
+	overload.OpTypes[builtin.JsonLength] = overload.Multi
+	overload.MultiOps[builtin.JsonLength] = jsonOps(1, 2, types.T_int64, jsonLengthFn)
+}
+
+func jsonLengthFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	n := builtin.Rows(vecs, cs)
+	docs, err := builtin.JsonArg(vecs[0], cs[0], n)
+	if err != nil {
+		return nil, err
+	}
+	nsp := new(nulls.Nulls)
+	var paths [][]*bytejson.Path
+	if len(vecs) == 2 {
+		if paths, err = jsonPaths("json_length", vecs[1:], cs[1:], n, nsp); err != nil {
+			return nil, err
+		}
+	}
+	vec, err := process.Get(proc, 8*int64(n), types.Type{Oid: types.T_int64, Size: 8})
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeInt64Slice(vec.Data)
+	rs = rs[:n]
+	for i, doc := range docs {
+		if doc == nil || nulls.Contains(nsp, uint64(i)) {
+			nulls.Add(vec.Nsp, uint64(i))
+			continue
+		}
+		if paths != nil {
+			var ok bool
+			if doc, ok = doc.Extract(paths[i]); !ok {
+				nulls.Add(vec.Nsp, uint64(i))
+				continue
+			}
+		}
+		rs[i] = int64(doc.Count())
+	}
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
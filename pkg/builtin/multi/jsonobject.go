@@ -0,0 +1,129 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// init registers json_object(key, value, ...) and json_array(value, ...), a value
+// of any type is converted to json, and json_object() returns an empty object.
+func init() {
+	extend.FunctionRegistry["json_object"] = builtin.JsonObject
+	extend.MultiReturnTypes[builtin.JsonObject] = func(_ []extend.Extend) types.T {
+		return types.T_json
+	}
+	extend.MultiStrings[builtin.JsonObject] = func(es []extend.Extend) string {
+		return funcString("json_object", es)
+	}
+	overload.OpTypes[builtin.JsonObject] = overload.Multi
+	overload.MultiOps[builtin.JsonObject] = append(stringOps(0, -1, types.T_json, jsonObjectFn), &overload.MultiOp{
+		Min:        0,
+		Max:        -1,
+		Typ:        types.T_any,
+		ReturnType: types.T_json,
+		Fn:         jsonObjectFn,
+	})
+
+	extend.FunctionRegistry["json_array"] = builtin.JsonArray
+	extend.MultiReturnTypes[builtin.JsonArray] = func(_ []extend.Extend) types.T {
+		return types.T_json
+	}
+	extend.MultiStrings[builtin.JsonArray] = func(es []extend.Extend) string {
+		return funcString("json_array", es)
+	}
+	overload.OpTypes[builtin.JsonArray] = overload.Multi
+	for _, typ := range []types.T{
+		types.T_any,
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+		types.T_date, types.T_time, types.T_datetime, types.T_timestamp,
+		types.T_char, types.T_varchar, types.T_json,
+	} {
+		overload.MultiOps[builtin.JsonArray] = append(overload.MultiOps[builtin.JsonArray], &overload.MultiOp{
+			Min:        0,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: types.T_json,
+			Fn:         jsonArrayFn,
+		})
+	}
+}
+
+func jsonObjectFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	if len(vecs)%2 != 0 {
+		return nil, fmt.Errorf("incorrect parameter count in the call to json_object")
+	}
+	n := builtin.Rows(vecs, cs)
+	keys := make([]*types.Bytes, 0, len(vecs)/2)
+	vals := make([][]bytejson.ByteJson, 0, len(vecs)/2)
+	for i := 0; i < len(vecs); i += 2 {
+		if !builtin.IsString(vecs[i].Typ.Oid) {
+			return nil, fmt.Errorf("the key of json_object must be a string")
+		}
+		if nulls.Any(vecs[i].Nsp) {
+			return nil, fmt.Errorf("the key of json_object can not be null")
+		}
+		vs, err := builtin.JsonValues(vecs[i+1], cs[i+1], n, proc)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, builtin.BytesArg(vecs[i], cs[i], n))
+		vals = append(vals, vs)
+	}
+	rs := make([]bytejson.ByteJson, n)
+	ks := make([][]byte, len(keys))
+	vs := make([]bytejson.ByteJson, len(vals))
+	for i := range rs {
+		for j := range keys {
+			ks[j], vs[j] = keys[j].Get(int64(i)), vals[j][i]
+		}
+		rs[i] = bytejson.NewObject(ks, vs)
+	}
+	return builtin.NewJsonVector(proc, rs)
+}
+
+func jsonArrayFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	n := builtin.Rows(vecs, cs)
+	vals := make([][]bytejson.ByteJson, len(vecs))
+	for i, vec := range vecs {
+		vs, err := builtin.JsonValues(vec, cs[i], n, proc)
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = vs
+	}
+	rs := make([]bytejson.ByteJson, n)
+	elems := make([]bytejson.ByteJson, len(vals))
+	for i := range rs {
+		for j := range vals {
+			elems[j] = vals[j][i]
+		}
+		rs[i] = bytejson.NewArray(elems)
+	}
+	return builtin.NewJsonVector(proc, rs)
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// init registers json_unquote(x), it returns the content of a json string, the
+// text of the other json values, and a string as it is unless it is a quoted json string.
+func init() {
+	extend.FunctionRegistry["json_unquote"] = builtin.JsonUnquote
+	extend.MultiReturnTypes[builtin.JsonUnquote] = func(_ []extend.Extend) types.T {
+		return types.T_varchar
+	}
+	extend.MultiStrings[builtin.JsonUnquote] = func(es []extend.Extend) string {
+		return funcString("json_unquote", es)
+	}
+	overload.OpTypes[builtin.JsonUnquote] = overload.Multi
+	overload.MultiOps[builtin.JsonUnquote] = jsonOps(1, 1, types.T_varchar, jsonUnquoteFn)
+}
+
+func jsonUnquoteFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	n := builtin.Rows(vecs, cs)
+	xs := builtin.BytesArg(vecs[0], cs[0], n)
+	rs := builtin.NewBytes(n, len(xs.Data))
+	for i := range xs.Offsets {
+		start := uint32(len(rs.Data))
+		if cs[0] || !nulls.Contains(vecs[0].Nsp, uint64(i)) {
+			rs.Data = append(rs.Data, jsonUnquote(vecs[0].Typ.Oid, xs.Get(int64(i)))...)
+		}
+		rs.Offsets = append(rs.Offsets, start)
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-start)
+	}
+	vec, err := builtin.NewBytesVector(proc, rs)
+	if err != nil {
+		return nil, err
+	}
+	builtin.Nulls(vec, vecs, cs)
+	return vec, nil
+}
+
+func jsonUnquote(typ types.T, x []byte) []byte {
+	if typ == types.T_json {
+		return bytejson.ByteJson(x).Unquote()
+	}
+	if len(x) >= 2 && x[0] == '"' && x[len(x)-1] == '"' {
+		if bj, err := bytejson.Parse(x); err == nil {
+			return bj.Str()
+		}
+	}
+	return x
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/instr"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["locate"] = builtin.Locate
+	extend.MultiReturnTypes[builtin.Locate] = func(_ []extend.Extend) types.T {
+		return types.T_int64
+	}
+	extend.MultiStrings[builtin.Locate] = func(es []extend.Extend) string {
+		return funcString("locate", es)
+	}
+	overload.OpTypes[builtin.Locate] = overload.Multi
+	overload.MultiOps[builtin.Locate] = stringOps(2, 3, types.T_int64, locateFn)
+}
+
+func locateFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, vecs)
+	if err := checkStrings("locate", vecs, 0, 1); err != nil {
+		return nil, err
+	}
+	n := builtin.Rows(vecs, cs)
+	vec, err := process.Get(proc, 8*int64(n), types.Type{Oid: types.T_int64, Size: 8})
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeInt64Slice(vec.Data)
+	rs = rs[:n]
+	xs := builtin.BytesArg(vecs[1], cs[1], n)
+	if cs[0] && (len(vecs) == 2 || cs[2]) {
+		pos := int64(1)
+		if len(vecs) == 3 {
+			if pos, err = builtin.Int64Const(vecs[2]); err != nil {
+				process.Put(proc, vec)
+				return nil, err
+			}
+		}
+		rs = instr.Locate(vecs[0].Col.(*types.Bytes).Get(0), xs, pos, rs)
+	} else {
+		poss := make([]int64, n)
+		for i := range poss {
+			poss[i] = 1
+		}
+		if len(vecs) == 3 {
+			if poss, err = builtin.Int64Arg(vecs[2], cs[2], n); err != nil {
+				process.Put(proc, vec)
+				return nil, err
+			}
+		}
+		rs = instr.LocateSlice(builtin.BytesArg(vecs[0], cs[0], n), xs, poss, rs)
+	}
+	vector.SetCol(vec, rs)
+	builtin.Nulls(vec, vecs, cs)
+	return vec, nil
+}
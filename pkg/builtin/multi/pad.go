@@ -0,0 +1,84 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/pad"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	for _, f := range []struct {
+		name    string
+		op      int
+		fn      func(*types.Bytes, int64, []byte, *types.Bytes) pad.PadResult
+		sliceFn func(*types.Bytes, []int64, *types.Bytes, *types.Bytes) pad.PadResult
+	}{
+		{"lpad", builtin.Lpad, pad.Lpad, pad.LpadSlice},
+		{"rpad", builtin.Rpad, pad.Rpad, pad.RpadSlice},
+	} {
+		name := f.name
+		extend.FunctionRegistry[name] = f.op
+		extend.MultiReturnTypes[f.op] = func(_ []extend.Extend) types.T {
+			return types.T_varchar
+		}
+		extend.MultiStrings[f.op] = func(es []extend.Extend) string {
+			return funcString(name, es)
+		}
+		overload.OpTypes[f.op] = overload.Multi
+		overload.MultiOps[f.op] = stringOps(3, 3, types.T_varchar, padFn(name, f.fn, f.sliceFn))
+	}
+}
+
+// padFn returns the pad function, fn is its fast path for a constant length
+// and pad.
+func padFn(name string, fn func(*types.Bytes, int64, []byte, *types.Bytes) pad.PadResult,
+	sliceFn func(*types.Bytes, []int64, *types.Bytes, *types.Bytes) pad.PadResult) func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error) {
+	return func(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		var r pad.PadResult
+
+		defer builtin.Free(proc, vecs)
+		if err := checkStrings(name, vecs, 2); err != nil {
+			return nil, err
+		}
+		n := builtin.Rows(vecs, cs)
+		xs := builtin.BytesArg(vecs[0], cs[0], n)
+		if cs[1] && cs[2] {
+			length, err := builtin.Int64Const(vecs[1])
+			if err != nil {
+				return nil, err
+			}
+			r = fn(xs, length, vecs[2].Col.(*types.Bytes).Get(0), builtin.NewBytes(n, len(xs.Data)))
+		} else {
+			lengths, err := builtin.Int64Arg(vecs[1], cs[1], n)
+			if err != nil {
+				return nil, err
+			}
+			r = sliceFn(xs, lengths, builtin.BytesArg(vecs[2], cs[2], n), builtin.NewBytes(n, len(xs.Data)))
+		}
+		vec, err := builtin.NewBytesVector(proc, r.Result)
+		if err != nil {
+			return nil, err
+		}
+		builtin.Nulls(vec, vecs, cs)
+		nulls.Set(vec.Nsp, r.Nsp)
+		return vec, nil
+	}
+}
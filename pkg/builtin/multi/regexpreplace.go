@@ -0,0 +1,64 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/regexp"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["regexp_replace"] = builtin.RegexpReplace
+	extend.MultiReturnTypes[builtin.RegexpReplace] = func(_ []extend.Extend) types.T {
+		return types.T_varchar
+	}
+	extend.MultiStrings[builtin.RegexpReplace] = func(es []extend.Extend) string {
+		return funcString("regexp_replace", es)
+	}
+	overload.OpTypes[builtin.RegexpReplace] = overload.Multi
+	overload.MultiOps[builtin.RegexpReplace] = stringOps(3, 3, types.T_varchar, regexpReplaceFn)
+}
+
+func regexpReplaceFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	var err error
+	var rs *types.Bytes
+
+	defer builtin.Free(proc, vecs)
+	if err = checkStrings("regexp_replace", vecs); err != nil {
+		return nil, err
+	}
+	n := builtin.Rows(vecs, cs)
+	xs := builtin.BytesArg(vecs[0], cs[0], n)
+	if cs[1] && cs[2] {
+		pattern, repl := vecs[1].Col.(*types.Bytes).Get(0), vecs[2].Col.(*types.Bytes).Get(0)
+		rs, err = regexp.Replace(xs, pattern, repl, builtin.NewBytes(n, len(xs.Data)))
+	} else {
+		patterns, repls := builtin.BytesArg(vecs[1], cs[1], n), builtin.BytesArg(vecs[2], cs[2], n)
+		rs, err = regexp.ReplaceSlice(xs, patterns, repls, builtin.NewBytes(n, len(xs.Data)))
+	}
+	if err != nil {
+		return nil, err
+	}
+	vec, err := builtin.NewBytesVector(proc, rs)
+	if err != nil {
+		return nil, err
+	}
+	builtin.Nulls(vec, vecs, cs)
+	return vec, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/replace"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["replace"] = builtin.Replace
+	extend.MultiReturnTypes[builtin.Replace] = func(_ []extend.Extend) types.T {
+		return types.T_varchar
+	}
+	extend.MultiStrings[builtin.Replace] = func(es []extend.Extend) string {
+		return funcString("replace", es)
+	}
+	overload.OpTypes[builtin.Replace] = overload.Multi
+	overload.MultiOps[builtin.Replace] = stringOps(3, 3, types.T_varchar, replaceFn)
+}
+
+func replaceFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	var rs *types.Bytes
+
+	defer builtin.Free(proc, vecs)
+	if err := checkStrings("replace", vecs); err != nil {
+		return nil, err
+	}
+	n := builtin.Rows(vecs, cs)
+	xs := builtin.BytesArg(vecs[0], cs[0], n)
+	if cs[1] && cs[2] {
+		from, to := vecs[1].Col.(*types.Bytes).Get(0), vecs[2].Col.(*types.Bytes).Get(0)
+		rs = replace.Replace(xs, from, to, builtin.NewBytes(n, len(xs.Data)))
+	} else {
+		froms, tos := builtin.BytesArg(vecs[1], cs[1], n), builtin.BytesArg(vecs[2], cs[2], n)
+		rs = replace.ReplaceSlice(xs, froms, tos, builtin.NewBytes(n, len(xs.Data)))
+	}
+	vec, err := builtin.NewBytesVector(proc, rs)
+	if err != nil {
+		return nil, err
+	}
+	builtin.Nulls(vec, vecs, cs)
+	return vec, nil
+}
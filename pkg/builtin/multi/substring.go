@@ -0,0 +1,80 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package multi
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/substring"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["substring"] = builtin.Substring
+	extend.FunctionRegistry["substr"] = builtin.Substring
+	extend.FunctionRegistry["mid"] = builtin.Substring
+	extend.MultiReturnTypes[builtin.Substring] = func(_ []extend.Extend) types.T {
+		return types.T_varchar
+	}
+	extend.MultiStrings[builtin.Substring] = func(es []extend.Extend) string {
+		return funcString("substring", es)
+	}
+	overload.OpTypes[builtin.Substring] = overload.Multi
+	overload.MultiOps[builtin.Substring] = stringOps(2, 3, types.T_varchar, substringFn)
+}
+
+func substringFn(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+	var rs *types.Bytes
+
+	defer builtin.Free(proc, vecs)
+	n := builtin.Rows(vecs, cs)
+	xs := builtin.BytesArg(vecs[0], cs[0], n)
+	if cs[1] && (len(vecs) == 2 || cs[2]) {
+		start, err := builtin.Int64Const(vecs[1])
+		if err != nil {
+			return nil, err
+		}
+		length := int64(math.MaxInt64)
+		if len(vecs) == 3 {
+			if length, err = builtin.Int64Const(vecs[2]); err != nil {
+				return nil, err
+			}
+		}
+		rs = substring.Substring(xs, start, length, builtin.NewBytes(n, len(xs.Data)))
+	} else {
+		var lengths []int64
+
+		starts, err := builtin.Int64Arg(vecs[1], cs[1], n)
+		if err != nil {
+			return nil, err
+		}
+		if len(vecs) == 3 {
+			if lengths, err = builtin.Int64Arg(vecs[2], cs[2], n); err != nil {
+				return nil, err
+			}
+		}
+		rs = substring.SubstringSlice(xs, starts, lengths, builtin.NewBytes(n, len(xs.Data)))
+	}
+	vec, err := builtin.NewBytesVector(proc, rs)
+	if err != nil {
+		return nil, err
+	}
+	builtin.Nulls(vec, vecs, cs)
+	return vec, nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/trim"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// space is the default cut of the trim functions
+var space = []byte(" ")
+
+func init() {
+	for _, f := range []struct {
+		name    string
+		op      int
+		fn      func(*types.Bytes, []byte, *types.Bytes) *types.Bytes
+		sliceFn func(*types.Bytes, *types.Bytes, *types.Bytes) *types.Bytes
+	}{
+		{"trim", builtin.Trim, trim.Trim, trim.TrimSlice},
+		{"ltrim", builtin.Ltrim, trim.Ltrim, trim.LtrimSlice},
+		{"rtrim", builtin.Rtrim, trim.Rtrim, trim.RtrimSlice},
+	} {
+		name := f.name
+		extend.FunctionRegistry[name] = f.op
+		extend.MultiReturnTypes[f.op] = func(_ []extend.Extend) types.T {
+			return types.T_varchar
+		}
+		extend.MultiStrings[f.op] = func(es []extend.Extend) string {
+			return funcString(name, es)
+		}
+		overload.OpTypes[f.op] = overload.Multi
+		overload.MultiOps[f.op] = stringOps(1, 2, types.T_varchar, trimFn(name, f.fn, f.sliceFn))
+	}
+}
+
+// trimFn returns the trim function removing spaces or its second argument,
+// fn is its fast path for a constant cut.
+func trimFn(name string, fn func(*types.Bytes, []byte, *types.Bytes) *types.Bytes,
+	sliceFn func(*types.Bytes, *types.Bytes, *types.Bytes) *types.Bytes) func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error) {
+	return func(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+		var rs *types.Bytes
+
+		defer builtin.Free(proc, vecs)
+		if err := checkStrings(name, vecs); err != nil {
+			return nil, err
+		}
+		n := builtin.Rows(vecs, cs)
+		xs := builtin.BytesArg(vecs[0], cs[0], n)
+		switch {
+		case len(vecs) == 1:
+			rs = fn(xs, space, builtin.NewBytes(n, len(xs.Data)))
+		case cs[1]:
+			rs = fn(xs, vecs[1].Col.(*types.Bytes).Get(0), builtin.NewBytes(n, len(xs.Data)))
+		default:
+			rs = sliceFn(xs, builtin.BytesArg(vecs[1], cs[1], n), builtin.NewBytes(n, len(xs.Data)))
+		}
+		vec, err := builtin.NewBytesVector(proc, rs)
+		if err != nil {
+			return nil, err
+		}
+		builtin.Nulls(vec, vecs, cs)
+		return vec, nil
+	}
+}
@@ -15,9 +15,17 @@
 package multi
 
 import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 type argsAndRet struct {
@@ -32,3 +40,137 @@ func getMultiReturnType(op int, es []extend.Extend) types.T {
 	}
 	return overload.GetMultiReturnType(op, ts)
 }
+
+// stringOps returns the operators of a function whose first argument is a
+// string, the types of the other arguments are checked by fn.
+func stringOps(min, max int, ret types.T, fn func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error)) []*overload.MultiOp {
+	return []*overload.MultiOp{
+		{
+			Min:        min,
+			Max:        max,
+			Typ:        types.T_char,
+			ReturnType: ret,
+			Fn:         fn,
+		},
+		{
+			Min:        min,
+			Max:        max,
+			Typ:        types.T_varchar,
+			ReturnType: ret,
+			Fn:         fn,
+		},
+	}
+}
+
+// datetimeOps returns the operators of a function whose first argument is a
+// date, datetime, timestamp or a string of them.
+func datetimeOps(min, max int, ret types.T, fn func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error)) []*overload.MultiOp {
+	ops := stringOps(min, max, ret, fn)
+	for _, typ := range []types.T{types.T_date, types.T_datetime, types.T_timestamp} {
+		ops = append(ops, &overload.MultiOp{
+			Min:        min,
+			Max:        max,
+			Typ:        typ,
+			ReturnType: ret,
+			Fn:         fn,
+		})
+	}
+	return ops
+}
+
+// jsonOps returns the operators of a function whose first argument is a
+// json or a json text.
+func jsonOps(min, max int, ret types.T, fn func([]*vector.Vector, *process.Process, []bool) (*vector.Vector, error)) []*overload.MultiOp {
+	return append(stringOps(min, max, ret, fn), &overload.MultiOp{
+		Min:        min,
+		Max:        max,
+		Typ:        types.T_json,
+		ReturnType: ret,
+		Fn:         fn,
+	})
+}
+
+// jsonPaths parses the path arguments of n rows of function name, a constant
+// path is parsed only once, and the rows of a null path are added to nsp.
+func jsonPaths(name string, vecs []*vector.Vector, cs []bool, n int, nsp *nulls.Nulls) ([][]*bytejson.Path, error) {
+	rs := make([][]*bytejson.Path, n)
+	for i := range rs {
+		rs[i] = make([]*bytejson.Path, len(vecs))
+	}
+	for j, vec := range vecs {
+		if !builtin.IsString(vec.Typ.Oid) {
+			return nil, fmt.Errorf("the path of %s must be a string", name)
+		}
+		xs := vec.Col.(*types.Bytes)
+		if cs[j] {
+			if nulls.Contains(vec.Nsp, 0) {
+				for i := range rs {
+					nulls.Add(nsp, uint64(i))
+				}
+				continue
+			}
+			p, err := bytejson.ParsePath(string(xs.Get(0)))
+			if err != nil {
+				return nil, err
+			}
+			for i := range rs {
+				rs[i][j] = p
+			}
+			continue
+		}
+		for i := range rs {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				nulls.Add(nsp, uint64(i))
+				continue
+			}
+			p, err := bytejson.ParsePath(string(xs.Get(int64(i))))
+			if err != nil {
+				return nil, err
+			}
+			rs[i][j] = p
+		}
+	}
+	return rs, nil
+}
+
+// constString returns the constant string argument at idx of function name.
+func constString(name string, vecs []*vector.Vector, cs []bool, idx int) (string, error) {
+	if !cs[idx] || !builtin.IsString(vecs[idx].Typ.Oid) {
+		return "", fmt.Errorf("the argument %v of %s must be a constant string", idx+1, name)
+	}
+	return string(vecs[idx].Col.(*types.Bytes).Get(0)), nil
+}
+
+// checkStrings returns an error if one of the arguments at idxs of function
+// name is not a string, all the arguments are checked if idxs is empty.
+func checkStrings(name string, vecs []*vector.Vector, idxs ...int) error {
+	if len(idxs) == 0 {
+		for i, vec := range vecs {
+			if !builtin.IsString(vec.Typ.Oid) {
+				return fmt.Errorf("the argument %v of %s must be a string", i+1, name)
+			}
+		}
+		return nil
+	}
+	for _, i := range idxs {
+		if !builtin.IsString(vecs[i].Typ.Oid) {
+			return fmt.Errorf("the argument %v of %s must be a string", i+1, name)
+		}
+	}
+	return nil
+}
+
+func funcString(name string, es []extend.Extend) string {
+	var buf bytes.Buffer
+
+	buf.WriteString(name)
+	buf.WriteByte('(')
+	for i, e := range es {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(e.String())
+	}
+	buf.WriteByte(')')
+	return buf.String()
+}
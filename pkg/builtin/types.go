@@ -25,4 +25,44 @@ const (
 	Floor
 	Abs
 	Ln
+	Concat
+	ConcatWs
+	Substring
+	Left
+	Right
+	Lower
+	Upper
+	Trim
+	Ltrim
+	Rtrim
+	Replace
+	Lpad
+	Rpad
+	Instr
+	Locate
+	Reverse
+	SplitPart
+	RegexpLike
+	RegexpReplace
+	Month
+	Day
+	Hour
+	Minute
+	Second
+	Weekday
+	UnixTimestamp
+	Extract
+	DateAdd
+	DateSub
+	DateDiff
+	DateFormat
+	StrToDate
+	FromUnixtime
+	DateTrunc
+	JsonExtract
+	JsonUnquote
+	JsonContains
+	JsonLength
+	JsonObject
+	JsonArray
 )
@@ -0,0 +1,137 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/extract"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/typecast"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/unixtime"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// init registers the functions returning a part of a date or time
+func init() {
+	parts := []struct {
+		op    int
+		names []string
+		fn    func([]types.Datetime, []int64) ([]int64, error)
+	}{
+		{builtin.Month, []string{"month"}, datePart(extract.Month)},
+		{builtin.Day, []string{"day", "dayofmonth"}, datePart(extract.Day)},
+		{builtin.Hour, []string{"hour"}, datePart(extract.Hour)},
+		{builtin.Minute, []string{"minute"}, datePart(extract.Minute)},
+		{builtin.Second, []string{"second"}, datePart(extract.Second)},
+		{builtin.Weekday, []string{"weekday"}, func(xs []types.Datetime, rs []int64) ([]int64, error) {
+			return extract.Weekday(xs, rs), nil
+		}},
+	}
+	for _, part := range parts {
+		name := part.names[0]
+		for _, n := range part.names {
+			extend.FunctionRegistry[n] = part.op
+		}
+		extend.UnaryReturnTypes[part.op] = func(_ extend.Extend) types.T {
+			return types.T_int64
+		}
+		extend.UnaryStrings[part.op] = func(e extend.Extend) string {
+			return fmt.Sprintf("%s(%s)", name, e)
+		}
+		overload.OpTypes[part.op] = overload.Unary
+		for _, typ := range []types.T{types.T_date, types.T_datetime, types.T_timestamp, types.T_char, types.T_varchar} {
+			overload.UnaryOps[part.op] = append(overload.UnaryOps[part.op], &overload.UnaryOp{
+				Typ:        typ,
+				ReturnType: types.T_int64,
+				Fn:         datePartFn(part.fn),
+			})
+		}
+	}
+
+	extend.FunctionRegistry["unix_timestamp"] = builtin.UnixTimestamp
+	extend.UnaryReturnTypes[builtin.UnixTimestamp] = func(_ extend.Extend) types.T {
+		return types.T_int64
+	}
+	extend.UnaryStrings[builtin.UnixTimestamp] = func(e extend.Extend) string {
+		return fmt.Sprintf("unix_timestamp(%s)", e)
+	}
+	overload.OpTypes[builtin.UnixTimestamp] = overload.Unary
+	for _, typ := range []types.T{types.T_date, types.T_datetime, types.T_timestamp, types.T_char, types.T_varchar} {
+		overload.UnaryOps[builtin.UnixTimestamp] = append(overload.UnaryOps[builtin.UnixTimestamp], &overload.UnaryOp{
+			Typ:        typ,
+			ReturnType: types.T_int64,
+			Fn:         unixTimestampFn,
+		})
+	}
+}
+
+func datePart(unit string) func([]types.Datetime, []int64) ([]int64, error) {
+	return func(xs []types.Datetime, rs []int64) ([]int64, error) {
+		return extract.Extract(unit, xs, rs)
+	}
+}
+
+func datePartFn(fn func([]types.Datetime, []int64) ([]int64, error)) func(*vector.Vector, *process.Process, bool) (*vector.Vector, error) {
+	return func(lv *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+		defer builtin.Free(proc, []*vector.Vector{lv})
+		n := vector.Length(lv)
+		xs, err := builtin.DatetimeArg(lv, false, n, proc)
+		if err != nil {
+			return nil, err
+		}
+		vec, err := process.Get(proc, 8*int64(n), types.Type{Oid: types.T_int64, Size: 8})
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeInt64Slice(vec.Data)
+		if rs, err = fn(xs, rs[:n]); err != nil {
+			process.Put(proc, vec)
+			return nil, err
+		}
+		nulls.Set(vec.Nsp, lv.Nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+}
+
+// unixTimestampFn returns the seconds since 1970-01-01 00:00:00 UTC, a date,
+// a datetime or a string is in the session time zone.
+func unixTimestampFn(lv *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, []*vector.Vector{lv})
+	n := vector.Length(lv)
+	ts, ok := lv.Col.([]types.Timestamp)
+	if !ok {
+		xs, err := builtin.DatetimeArg(lv, false, n, proc)
+		if err != nil {
+			return nil, err
+		}
+		ts = typecast.DatetimeToTimestamp(xs, process.TimeZone(proc), make([]types.Timestamp, n))
+	}
+	vec, err := process.Get(proc, 8*int64(n), types.Type{Oid: types.T_int64, Size: 8})
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeInt64Slice(vec.Data)
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, unixtime.UnixTimestamp(ts, rs[:n]))
+	return vec, nil
+}
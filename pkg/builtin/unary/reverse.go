@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/reverse"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["reverse"] = builtin.Reverse
+	extend.UnaryReturnTypes[builtin.Reverse] = func(_ extend.Extend) types.T {
+		return types.T_varchar
+	}
+	extend.UnaryStrings[builtin.Reverse] = func(e extend.Extend) string {
+		return fmt.Sprintf("reverse(%s)", e)
+	}
+	overload.OpTypes[builtin.Reverse] = overload.Unary
+	overload.UnaryOps[builtin.Reverse] = []*overload.UnaryOp{
+		{
+			Typ:        types.T_char,
+			ReturnType: types.T_varchar,
+			Fn:         reverseBytes,
+		},
+		{
+			Typ:        types.T_varchar,
+			ReturnType: types.T_varchar,
+			Fn:         reverseBytes,
+		},
+	}
+}
+
+func reverseBytes(lv *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+	defer builtin.Free(proc, []*vector.Vector{lv})
+	lvs := lv.Col.(*types.Bytes)
+	vec, err := builtin.NewBytesVector(proc, reverse.Reverse(lvs, builtin.NewBytes(len(lvs.Lengths), len(lvs.Data))))
+	if err != nil {
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	return vec, nil
+}
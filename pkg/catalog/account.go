@@ -0,0 +1,90 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
+
+// CreateAccount creates a user or role.
+func (c *Catalog) CreateAccount(a privilege.Account) error {
+	if value, err := c.Driver.Get(c.accountKey(a.Name)); err == nil && value != nil {
+		return privilege.ErrAccountExists
+	}
+	value, _ := json.Marshal(a)
+	return c.Driver.SetIfNotExist(c.accountKey(a.Name), value)
+}
+
+// DropAccount drops a user or role.
+func (c *Catalog) DropAccount(name string) error {
+	if _, err := c.GetAccount(name); err != nil {
+		return err
+	}
+	return c.Driver.Delete(c.accountKey(name))
+}
+
+// GetAccount returns the user or role with the name.
+func (c *Catalog) GetAccount(name string) (*privilege.Account, error) {
+	value, err := c.Driver.Get(c.accountKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, privilege.ErrAccountNotExists
+	}
+	a := new(privilege.Account)
+	if err = json.Unmarshal(value, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// UpdateAccount replaces the user or role with the same name.
+func (c *Catalog) UpdateAccount(a privilege.Account) error {
+	if _, err := c.GetAccount(a.Name); err != nil {
+		return err
+	}
+	value, _ := json.Marshal(a)
+	return c.Driver.Set(c.accountKey(a.Name), value)
+}
+
+// ListAccounts returns all users and roles.
+func (c *Catalog) ListAccounts() ([]privilege.Account, error) {
+	values, err := c.Driver.PrefixScan(c.accountPrefix(), 0)
+	if err != nil {
+		return nil, err
+	}
+	var as []privilege.Account
+	for i := 1; i < len(values); i = i + 2 {
+		var a privilege.Account
+		if err = json.Unmarshal(values[i], &a); err != nil {
+			return nil, err
+		}
+		as = append(as, a)
+	}
+	return as, nil
+}
+
+//accountKey returns the encoded name with prefix "meta1Account"
+func (c *Catalog) accountKey(name string) []byte {
+	return EncodeKey(cPrefix, defaultCatalogId, cAccountPrefix, name)
+}
+
+//accountPrefix returns the prefix "meta1Account"
+func (c *Catalog) accountPrefix() []byte {
+	return EncodeKey(cPrefix, defaultCatalogId, cAccountPrefix)
+}
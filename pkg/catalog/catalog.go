@@ -30,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/pb"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v1"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/event"
@@ -45,9 +46,11 @@ const (
 	cTablePrefix          = "Table"
 	cTableIDPrefix        = "TID"
 	cRoutePrefix          = "Route"
+	cAlterPrefix          = "Alter"
 	cPreSplitPrefix       = "PreSplit"
 	cSplitPrefix          = "Split"
 	cDeletedTablePrefix   = "DeletedTableQueue"
+	cAccountPrefix        = "Account"
 	cRuleName             = "RuleTable"
 	cLabelName            = "LabelTable"
 	timeout               = 2000 * time.Millisecond
@@ -502,6 +505,9 @@ func (c *Catalog) DropTable(epoch, dbId uint64, tableName string) (tid uint64, e
 	if err = c.Driver.Delete(c.tableKey(dbId, tb.Id)); err != nil {
 		return tid, err
 	}
+	if err = c.Driver.Delete(c.alterKey(dbId, tb.Id)); err != nil {
+		return tid, err
+	}
 	return tb.Id, err
 }
 
@@ -525,7 +531,7 @@ func (c *Catalog) CreateIndex(epoch uint64, idxInfo aoe.IndexInfo) error {
 	if err != nil {
 		return err
 	}
-	tbl, err := c.checkTableExists(idxInfo.SchemaId, idxInfo.TableId)
+	tbl, err := c.checkTableToAlter(idxInfo.SchemaId, idxInfo.TableId)
 	if err != nil {
 		return err
 	}
@@ -541,7 +547,7 @@ func (c *Catalog) CreateIndex(epoch uint64, idxInfo aoe.IndexInfo) error {
 	for _, idx := range idxInfo.ColumnNames {
 		columnExist := false
 		for _, col := range tbl.Columns {
-			if idx == col.Name {
+			if idx == col.Name && !col.Dropped {
 				columnExist = true
 				idxInfo.Columns = append(idxInfo.Columns, col.Id)
 				if idxInfo.Type == aoe.Bsi {
@@ -594,7 +600,7 @@ func (c *Catalog) DropIndex(epoch, tid, dbid uint64, idxName string) error {
 	if err != nil {
 		return err
 	}
-	tbl, err := c.checkTableExists(dbid, tid)
+	tbl, err := c.checkTableToAlter(dbid, tid)
 	if err != nil {
 		return err
 	}
@@ -629,6 +635,328 @@ func (c *Catalog) DropIndex(epoch, tid, dbid uint64, idxName string) error {
 	return ErrIndexNotExist
 }
 
+//AddColumn appends a column to the table, the rows written before
+//read its default value.
+func (c *Catalog) AddColumn(epoch, dbId, tid uint64, col aoe.ColumnInfo) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("AddColumn cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.checkTableToAlter(dbId, tid)
+	if err != nil {
+		return err
+	}
+	if err = addColumn(epoch, tbl, col); err != nil {
+		return err
+	}
+	return c.alterTable(epoch, dbId, tbl)
+}
+
+//DropColumn drops a column of the table. The column is only marked as
+//dropped, so that the columns of the tablets keep their places.
+func (c *Catalog) DropColumn(epoch, dbId, tid uint64, name string) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("DropColumn cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.checkTableToAlter(dbId, tid)
+	if err != nil {
+		return err
+	}
+	if err = dropColumn(tbl, name); err != nil {
+		return err
+	}
+	return c.alterTable(epoch, dbId, tbl)
+}
+
+//RenameColumn renames a column of the table and its indices.
+func (c *Catalog) RenameColumn(epoch, dbId, tid uint64, name, newName string) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("RenameColumn cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.checkTableToAlter(dbId, tid)
+	if err != nil {
+		return err
+	}
+	if err = renameColumn(tbl, name, newName); err != nil {
+		return err
+	}
+	return c.alterTable(epoch, dbId, tbl)
+}
+
+//ChangeDefault changes the default value of a column for the rows
+//inserted from now on, the tablets are not changed.
+func (c *Catalog) ChangeDefault(epoch, dbId, tid uint64, name string, def engine.DefaultExpr) error {
+	tbl, err := c.checkTableToAlter(dbId, tid)
+	if err != nil {
+		return err
+	}
+	if err = changeDefault(tbl, name, def); err != nil {
+		return err
+	}
+	tbl.Epoch = epoch
+	return c.updateTableInfo(dbId, tbl)
+}
+
+//AlterTable applies the changes of the columns of the table in order,
+//and then alters the tablets once to the resulting columns. None of the
+//changes is applied if any of them is invalid.
+func (c *Catalog) AlterTable(epoch, dbId, tid uint64, opts []engine.AlterOption) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("AlterTable cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.checkTableToAlter(dbId, tid)
+	if err != nil {
+		return err
+	}
+	changed := false // whether the columns of the tablets are changed
+	for _, opt := range opts {
+		switch opt.Type {
+		case engine.AlterAdd:
+			err = addColumn(epoch, tbl, aoe.ColumnInfo{
+				Name:    opt.Attr.Name,
+				Alg:     int(opt.Attr.Alg),
+				Type:    opt.Attr.Type,
+				Default: opt.Attr.Default,
+			})
+		case engine.AlterDrop:
+			err = dropColumn(tbl, opt.Name)
+		case engine.AlterRename:
+			err = renameColumn(tbl, opt.Name, opt.NewName)
+		case engine.AlterDefault:
+			err = changeDefault(tbl, opt.Attr.Name, opt.Attr.Default)
+		}
+		if err != nil {
+			return err
+		}
+		changed = changed || opt.Type != engine.AlterDefault
+	}
+	if !changed {
+		tbl.Epoch = epoch
+		return c.updateTableInfo(dbId, tbl)
+	}
+	return c.alterTable(epoch, dbId, tbl)
+}
+
+func addColumn(epoch uint64, tbl *aoe.TableInfo, col aoe.ColumnInfo) error {
+	if findColumn(tbl, col.Name) >= 0 {
+		return ErrColumnExist
+	}
+	col.SchemaId = tbl.SchemaId
+	col.TableID = tbl.Id
+	col.Id = uint64(len(tbl.Columns))
+	col.Epoch = epoch
+	tbl.Columns = append(tbl.Columns, col)
+	return nil
+}
+
+func dropColumn(tbl *aoe.TableInfo, name string) error {
+	i := findColumn(tbl, name)
+	if i < 0 {
+		return ErrColumnNotExist
+	}
+	if i == sortKey(tbl) {
+		return ErrColumnInUse
+	}
+	for _, idx := range tbl.Indices {
+		for _, colName := range idx.ColumnNames {
+			if colName == name {
+				return ErrColumnInUse
+			}
+		}
+	}
+	tbl.Columns[i].Dropped = true
+	return nil
+}
+
+func renameColumn(tbl *aoe.TableInfo, name, newName string) error {
+	i := findColumn(tbl, name)
+	if i < 0 {
+		return ErrColumnNotExist
+	}
+	if findColumn(tbl, newName) >= 0 {
+		return ErrColumnExist
+	}
+	tbl.Columns[i].Name = newName
+	for _, idx := range tbl.Indices {
+		for j, colName := range idx.ColumnNames {
+			if colName == name {
+				idx.ColumnNames[j] = newName
+			}
+		}
+	}
+	return nil
+}
+
+func changeDefault(tbl *aoe.TableInfo, name string, def engine.DefaultExpr) error {
+	i := findColumn(tbl, name)
+	if i < 0 {
+		return ErrColumnNotExist
+	}
+	tbl.Columns[i].Default = def
+	return nil
+}
+
+//RenameTable renames a table in database, the tablets are named by
+//the id of the table and are not changed.
+func (c *Catalog) RenameTable(epoch, dbId uint64, name, newName string) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("RenameTable cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	if _, err := c.checkDBExists(dbId); err != nil {
+		return err
+	}
+	tbl, _ := c.checkTableNotExists(dbId, name)
+	if tbl == nil || tbl.State != aoe.StatePublic {
+		return ErrTableNotExists
+	}
+	if err := c.resumeAlter(dbId, tbl); err != nil {
+		return err
+	}
+	if err := c.Driver.SetIfNotExist(c.tableIDKey(dbId, newName), Uint642Bytes(tbl.Id)); err != nil {
+		return ErrTableCreateExists
+	}
+	tbl.Name = newName
+	tbl.Epoch = epoch
+	if err := c.updateTableInfo(dbId, tbl); err != nil {
+		return err
+	}
+	return c.Driver.Delete(c.tableIDKey(dbId, name))
+}
+
+//OptimizeTable merge-sorts the closed segments of all the tablets of
+//the table, whatever the compaction policy of the table is.
+func (c *Catalog) OptimizeTable(dbId, tid uint64) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("OptimizeTable cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	tbl, err := c.checkTableExists(dbId, tid)
+	if err != nil {
+		return err
+	}
+	shardIds, err := c.Driver.PrefixKeys(c.routePrefix(tbl.Id), 0)
+	if err != nil {
+		return err
+	}
+	for _, shardId := range shardIds {
+		sid, err := Bytes2Uint64(shardId[len(c.routePrefix(tbl.Id)):])
+		if err != nil {
+			logutil.Errorf("convert shardid failed, %v", err)
+			return err
+		}
+		aoeTableName := c.encodeTabletName(sid, tbl.Id)
+		if err = c.Driver.OptimizeTablet(aoeTableName, sid); err != nil {
+			logutil.Errorf("call local optimize tablet failed %d, %d, %v", sid, tbl.Id, err)
+			return err
+		}
+	}
+	return nil
+}
+
+//alterTable replaces the columns of all the tablets of the table with
+//the columns of tbl as the next version of its schema, and then updates
+//the meta of the table. The alter is recorded before any tablet is
+//altered, so that if it fails, the tablets catch up with it before the
+//next change of the table, see resumeAlter.
+func (c *Catalog) alterTable(epoch, dbId uint64, tbl *aoe.TableInfo) error {
+	tbl.Epoch = epoch
+	tbl.SchemaVersion++
+	value, err := EncodeTable(*tbl)
+	if err != nil {
+		return err
+	}
+	if err = c.Driver.Set(c.alterKey(dbId, tbl.Id), value); err != nil {
+		return err
+	}
+	return c.applyAlter(dbId, tbl)
+}
+
+//applyAlter alters all the tablets of the table to the version of the
+//schema of tbl, the tablets already at that version are not changed.
+//Then it updates the meta of the table and removes the record of the
+//alter.
+func (c *Catalog) applyAlter(dbId uint64, tbl *aoe.TableInfo) error {
+	shardIds, err := c.Driver.PrefixKeys(c.routePrefix(tbl.Id), 0)
+	if err != nil {
+		return err
+	}
+	for _, shardId := range shardIds {
+		sid, err := Bytes2Uint64(shardId[len(c.routePrefix(tbl.Id)):])
+		if err != nil {
+			logutil.Errorf("convert shardid failed, %v", err)
+			return err
+		}
+		aoeTableName := c.encodeTabletName(sid, tbl.Id)
+		if err = c.Driver.AlterTablet(aoeTableName, tbl, sid); err != nil {
+			logutil.Errorf("call local alter tablet failed %d, %d, %v", sid, tbl.Id, err)
+			return fmt.Errorf("%w, the tablets of table '%s' catch up with version %d of its schema before its next change",
+				err, tbl.Name, tbl.SchemaVersion)
+		}
+	}
+	if err = c.updateTableInfo(dbId, tbl); err != nil {
+		return err
+	}
+	return c.Driver.Delete(c.alterKey(dbId, tbl.Id))
+}
+
+//resumeAlter finishes the alter of the table which failed to alter some
+//of its tablets, and replaces tbl with the table info of the alter. It
+//does nothing if no alter of the table is recorded.
+func (c *Catalog) resumeAlter(dbId uint64, tbl *aoe.TableInfo) error {
+	value, err := c.Driver.Get(c.alterKey(dbId, tbl.Id))
+	if err != nil || value == nil {
+		return err
+	}
+	altered, err := DecodeTable(value)
+	if err != nil {
+		return err
+	}
+	if err = c.applyAlter(dbId, &altered); err != nil {
+		return err
+	}
+	*tbl = altered
+	return nil
+}
+
+//checkTableToAlter returns the table like checkTableExists, after the
+//tablets of the table catch up with its last alter.
+func (c *Catalog) checkTableToAlter(dbId, id uint64) (*aoe.TableInfo, error) {
+	tbl, err := c.checkTableExists(dbId, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.resumeAlter(dbId, tbl); err != nil {
+		return nil, err
+	}
+	return tbl, nil
+}
+
+//findColumn returns the position of the column which is not dropped
+//in the table, or -1.
+func findColumn(tbl *aoe.TableInfo, name string) int {
+	for i, col := range tbl.Columns {
+		if col.Name == name && !col.Dropped {
+			return i
+		}
+	}
+	return -1
+}
+
+//sortKey returns the position of the column by which the segments of
+//the tablets are sorted, the primary key or the first column.
+func sortKey(tbl *aoe.TableInfo) int {
+	for i, col := range tbl.Columns {
+		if col.PrimaryKey {
+			return i
+		}
+	}
+	return 0
+}
+
 // ListTables returns all tables meta in database.
 func (c *Catalog) ListTables(dbId uint64) ([]aoe.TableInfo, error) {
 	t0 := time.Now()
@@ -862,6 +1190,9 @@ func (c *Catalog) dropTables(epoch, dbId uint64) (err error) {
 		if err = c.Driver.Delete(c.tableKey(dbId, tbl.Id)); err != nil {
 			return err
 		}
+		if err = c.Driver.Delete(c.alterKey(dbId, tbl.Id)); err != nil {
+			return err
+		}
 	}
 	return err
 }
@@ -1000,6 +1331,11 @@ func (c *Catalog) routeKey(tId, gId uint64) []byte {
 }
 
 //routePrefix returns the prefix "meta1Route$$tId"
+//alterKey returns the key of the table info recorded by the alter of the table
+func (c *Catalog) alterKey(dbId, tId uint64) []byte {
+	return EncodeKey(cPrefix, defaultCatalogId, cAlterPrefix, dbId, tId)
+}
+
 func (c *Catalog) routePrefix(tId uint64) []byte {
 	return EncodeKey(cPrefix, defaultCatalogId, cRoutePrefix, tId)
 }
@@ -27,11 +27,14 @@ import (
 
 	cconfig "github.com/matrixorigin/matrixcube/config"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	// "github.com/matrixorigin/matrixone/pkg/logutil"
 	aoe3 "github.com/matrixorigin/matrixone/pkg/vm/driver/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/config"
 	"github.com/matrixorigin/matrixone/pkg/vm/driver/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
 	// "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v1"
 
@@ -310,6 +313,87 @@ func TestCatalogWithUtil(t *testing.T) {
 	err = catalog.DropIndex(0, idxTableInfo.Id, idxTableInfo.SchemaId, "mock_idx")
 	require.Equal(t, ErrIndexNotExist, err)
 
+	//Test AlterTable
+	altTable := testTables[1]
+	altTableInfo, _ := catalog.GetTable(dbids[0], altTable.Name)
+	newCol := aoe.ColumnInfo{
+		Name:    "mock_new",
+		Type:    types.Type{Oid: types.T_int32, Size: 4, Width: 4},
+		Default: engine.MakeDefaultExpr(true, int64(1), false),
+	}
+	err = catalog.AddColumn(0, dbids[0], altTableInfo.Id, newCol)
+	require.NoError(t, err)
+	err = catalog.AddColumn(0, dbids[0], altTableInfo.Id, newCol)
+	require.Equal(t, ErrColumnExist, err)
+	err = catalog.RenameColumn(0, dbids[0], altTableInfo.Id, newCol.Name, "mock_renamed")
+	require.NoError(t, err)
+	err = catalog.DropColumn(0, dbids[0], altTableInfo.Id, "mock_0")
+	require.Equal(t, ErrColumnInUse, err)
+	err = catalog.DropColumn(0, dbids[0], altTableInfo.Id, "mock_2")
+	require.NoError(t, err)
+	err = catalog.DropColumn(0, dbids[0], altTableInfo.Id, "mock_2")
+	require.Equal(t, ErrColumnNotExist, err)
+	err = catalog.ChangeDefault(0, dbids[0], altTableInfo.Id, "mock_renamed", engine.MakeDefaultExpr(true, int64(2), false))
+	require.NoError(t, err)
+	altTableInfo, _ = catalog.GetTable(dbids[0], altTable.Name)
+	require.Equal(t, colCnt+1, len(altTableInfo.Columns))
+	require.True(t, altTableInfo.Columns[2].Dropped)
+	require.Equal(t, "mock_renamed", altTableInfo.Columns[colCnt].Name)
+	require.Equal(t, uint64(colCnt), altTableInfo.Columns[colCnt].Id)
+	require.Equal(t, colCnt, len(helper.Attribute(*altTableInfo)))
+	require.Equal(t, uint32(3), altTableInfo.SchemaVersion)
+
+	//Test AlterTable applies all the changes or none of them
+	err = catalog.AlterTable(0, dbids[0], altTableInfo.Id, []engine.AlterOption{
+		{Type: engine.AlterRename, Name: "mock_renamed", NewName: "mock_renamed_again"},
+		{Type: engine.AlterDrop, Name: "mock_2"},
+	})
+	require.Equal(t, ErrColumnNotExist, err)
+	err = catalog.AlterTable(0, dbids[0], altTableInfo.Id, []engine.AlterOption{
+		{Type: engine.AlterRename, Name: "mock_renamed", NewName: "mock_renamed_again"},
+		{Type: engine.AlterAdd, Attr: engine.Attribute{Name: "mock_added", Type: newCol.Type, Default: newCol.Default}},
+	})
+	require.NoError(t, err)
+	altTableInfo, _ = catalog.GetTable(dbids[0], altTable.Name)
+	require.Equal(t, uint32(4), altTableInfo.SchemaVersion)
+	require.Equal(t, "mock_renamed_again", altTableInfo.Columns[colCnt].Name)
+	require.Equal(t, "mock_added", altTableInfo.Columns[colCnt+1].Name)
+
+	//Test the tablets catch up with an alter which failed to alter some of them
+	altered := *altTableInfo
+	altered.Columns = append([]aoe.ColumnInfo{}, altTableInfo.Columns...)
+	altered.Columns[colCnt+1].Name = "mock_resumed"
+	altered.SchemaVersion++
+	value, err := EncodeTable(altered)
+	require.NoError(t, err)
+	require.NoError(t, catalog.Driver.Set(catalog.alterKey(dbids[0], altered.Id), value))
+	err = catalog.RenameColumn(0, dbids[0], altTableInfo.Id, "mock_resumed", "mock_added")
+	require.NoError(t, err)
+	altTableInfo, _ = catalog.GetTable(dbids[0], altTable.Name)
+	require.Equal(t, uint32(6), altTableInfo.SchemaVersion)
+	require.Equal(t, "mock_added", altTableInfo.Columns[colCnt+1].Name)
+	value, err = catalog.Driver.Get(catalog.alterKey(dbids[0], altered.Id))
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	//Test RenameTable
+	err = catalog.RenameTable(0, dbids[0], altTable.Name, altTable.Name+"_renamed")
+	require.NoError(t, err)
+	_, err = catalog.GetTable(dbids[0], altTable.Name)
+	require.Equal(t, ErrTableNotExists, err)
+	_, err = catalog.GetTable(dbids[0], altTable.Name+"_renamed")
+	require.NoError(t, err)
+	err = catalog.RenameTable(0, dbids[0], altTable.Name+"_renamed", testTables[2].Name)
+	require.Equal(t, ErrTableCreateExists, err)
+	err = catalog.RenameTable(0, dbids[0], altTable.Name+"_renamed", altTable.Name)
+	require.NoError(t, err)
+
+	//Test OptimizeTable
+	err = catalog.OptimizeTable(dbids[0], altTableInfo.Id)
+	require.NoError(t, err)
+	err = catalog.OptimizeTable(dbids[0], altTableInfo.Id+1000)
+	require.Equal(t, ErrTableNotExists, err)
+
 	//Test CreateTableExists
 	_, err = catalog.CreateTable(0, dbids[0], *testTables[0])
 	require.Equal(t, ErrTableCreateExists, err, "CreateTable: wrong err")
@@ -393,4 +477,40 @@ func TestCatalogWithUtil(t *testing.T) {
 	err = catalog.DropDatabase(0, testDatabaceName+strconv.Itoa(0))
 	require.Equal(t, ErrDBNotExists, err, "DropDatabase: DropDatabase wrong err")
 
+	//test CreateAccount
+	user := privilege.Account{Name: "u1", Password: privilege.HashPassword("111")}
+	err = catalog.CreateAccount(user)
+	require.NoError(t, err, "CreateAccount Fail")
+	err = catalog.CreateAccount(privilege.Account{Name: "r1", IsRole: true})
+	require.NoError(t, err, "CreateAccount Fail")
+	err = catalog.CreateAccount(user)
+	require.Equal(t, privilege.ErrAccountExists, err, "CreateAccount: wrong err")
+
+	//test UpdateAccount
+	user.Grant(testDatabaceName, privilege.Any, privilege.Select)
+	user.GrantRole("r1")
+	err = catalog.UpdateAccount(user)
+	require.NoError(t, err, "UpdateAccount Fail")
+	err = catalog.UpdateAccount(privilege.Account{Name: "u2"})
+	require.Equal(t, privilege.ErrAccountNotExists, err, "UpdateAccount: wrong err")
+
+	//test GetAccount
+	account, err := catalog.GetAccount("u1")
+	require.NoError(t, err, "GetAccount Fail")
+	require.Equal(t, user, *account, "GetAccount: Wrong account")
+	_, err = catalog.GetAccount("u2")
+	require.Equal(t, privilege.ErrAccountNotExists, err, "GetAccount: wrong err")
+
+	//test ListAccounts
+	accounts, err := catalog.ListAccounts()
+	require.NoError(t, err, "ListAccounts Fail")
+	require.Equal(t, 2, len(accounts), "ListAccounts: Wrong len")
+
+	//test DropAccount
+	for _, name := range []string{"u1", "r1"} {
+		err = catalog.DropAccount(name)
+		require.NoError(t, err, "DropAccount Fail")
+	}
+	err = catalog.DropAccount("u1")
+	require.Equal(t, privilege.ErrAccountNotExists, err, "DropAccount: wrong err")
 }
@@ -53,4 +53,8 @@ var (
 	ErrIndexNotExist = errors.New("index not exist")
 	//ErrShardPending is for pending shards
 	ErrShardPending = errors.New("shard is pending")
+	//ErrColumnExist is the error for duplicated column name.
+	ErrColumnExist = errors.New("column already exist")
+	//ErrColumnInUse is the error for trying to drop a column of the sort key or an index.
+	ErrColumnInUse = errors.New("column is used by the sort key or an index")
 )
@@ -17,12 +17,16 @@ package compare
 import (
 	adates "github.com/matrixorigin/matrixone/pkg/compare/asc/dates"
 	adatetimes "github.com/matrixorigin/matrixone/pkg/compare/asc/datetimes"
+	adecimal128s "github.com/matrixorigin/matrixone/pkg/compare/asc/decimal128s"
+	adecimal64s "github.com/matrixorigin/matrixone/pkg/compare/asc/decimal64s"
 	afloat32s "github.com/matrixorigin/matrixone/pkg/compare/asc/float32s"
 	afloat64s "github.com/matrixorigin/matrixone/pkg/compare/asc/float64s"
 	aint16s "github.com/matrixorigin/matrixone/pkg/compare/asc/int16s"
 	aint32s "github.com/matrixorigin/matrixone/pkg/compare/asc/int32s"
 	aint64s "github.com/matrixorigin/matrixone/pkg/compare/asc/int64s"
 	aint8s "github.com/matrixorigin/matrixone/pkg/compare/asc/int8s"
+	atimes "github.com/matrixorigin/matrixone/pkg/compare/asc/times"
+	atimestamps "github.com/matrixorigin/matrixone/pkg/compare/asc/timestamps"
 	auint16s "github.com/matrixorigin/matrixone/pkg/compare/asc/uint16s"
 	auint32s "github.com/matrixorigin/matrixone/pkg/compare/asc/uint32s"
 	auint64s "github.com/matrixorigin/matrixone/pkg/compare/asc/uint64s"
@@ -30,12 +34,16 @@ import (
 	avarchar "github.com/matrixorigin/matrixone/pkg/compare/asc/varchar"
 	ddates "github.com/matrixorigin/matrixone/pkg/compare/desc/dates"
 	ddatetimes "github.com/matrixorigin/matrixone/pkg/compare/desc/datetimes"
+	ddecimal128s "github.com/matrixorigin/matrixone/pkg/compare/desc/decimal128s"
+	ddecimal64s "github.com/matrixorigin/matrixone/pkg/compare/desc/decimal64s"
 	dfloat32s "github.com/matrixorigin/matrixone/pkg/compare/desc/float32s"
 	dfloat64s "github.com/matrixorigin/matrixone/pkg/compare/desc/float64s"
 	dint16s "github.com/matrixorigin/matrixone/pkg/compare/desc/int16s"
 	dint32s "github.com/matrixorigin/matrixone/pkg/compare/desc/int32s"
 	dint64s "github.com/matrixorigin/matrixone/pkg/compare/desc/int64s"
 	dint8s "github.com/matrixorigin/matrixone/pkg/compare/desc/int8s"
+	dtimes "github.com/matrixorigin/matrixone/pkg/compare/desc/times"
+	dtimestamps "github.com/matrixorigin/matrixone/pkg/compare/desc/timestamps"
 	duint16s "github.com/matrixorigin/matrixone/pkg/compare/desc/uint16s"
 	duint32s "github.com/matrixorigin/matrixone/pkg/compare/desc/uint32s"
 	duint64s "github.com/matrixorigin/matrixone/pkg/compare/desc/uint64s"
@@ -96,6 +104,16 @@ func New(typ types.T, desc bool) Compare {
 			return dfloat64s.New()
 		}
 		return afloat64s.New()
+	case types.T_decimal64:
+		if desc {
+			return ddecimal64s.New()
+		}
+		return adecimal64s.New()
+	case types.T_decimal128:
+		if desc {
+			return ddecimal128s.New()
+		}
+		return adecimal128s.New()
 	case types.T_char, types.T_json, types.T_varchar:
 		if desc {
 			return dvarchar.New()
@@ -111,6 +129,16 @@ func New(typ types.T, desc bool) Compare {
 			return ddatetimes.New()
 		}
 		return adatetimes.New()
+	case types.T_time:
+		if desc {
+			return dtimes.New()
+		}
+		return atimes.New()
+	case types.T_timestamp:
+		if desc {
+			return dtimestamps.New()
+		}
+		return atimestamps.New()
 	}
 	return nil
 }
@@ -15,14 +15,41 @@
 package compress
 
 import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4"
 )
 
 var Algorithms map[string]int = map[string]int{
 	"lz4":  Lz4,
+	"zlib": Zlib,
+	"zstd": Zstd,
 	"none": None,
 }
 
+// ErrTooLong is returned by Decompress if the decompressed data does not
+// fit in dst.
+var ErrTooLong = errors.New("the decompressed data is longer than the buffer")
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+)
+
+// zstdEncode returns the zstd encoder shared by all goroutines, it is
+// created at the first use.
+func zstdEncode() *zstd.Encoder {
+	zstdOnce.Do(func() {
+		zstdEncoder, _ = zstd.NewWriter(nil)
+	})
+	return zstdEncoder
+}
+
 func Compress(src, dst []byte, typ int) ([]byte, error) {
 	switch typ {
 	case Lz4:
@@ -31,10 +58,25 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zlib:
+		buf := bytes.NewBuffer(dst[:0])
+		w := zlib.NewWriter(buf)
+		if _, err := w.Write(src); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case Zstd:
+		return zstdEncode().EncodeAll(src, dst[:0]), nil
 	}
 	return nil, nil
 }
 
+// Decompress decompresses src into dst, which must be as long as the
+// decompressed data. It fails if the data is longer than dst, and it stops
+// decompressing early enough that a small src cannot expand without limit.
 func Decompress(src, dst []byte, typ int) ([]byte, error) {
 	switch typ {
 	case Lz4:
@@ -43,6 +85,41 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zlib:
+		r, err := zlib.NewReader(bytes.NewReader(src))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		buf := bytes.NewBuffer(dst[:0])
+		if _, err := buf.ReadFrom(io.LimitReader(r, int64(len(dst))+1)); err != nil {
+			return nil, err
+		}
+		if buf.Len() > len(dst) {
+			return nil, ErrTooLong
+		}
+		return buf.Bytes(), nil
+	case Zstd:
+		// the limit of the memory of a decoder bounds both the window and
+		// the decompressed data, and a window is up to twice as long as
+		// the data, so the decoder stops at twice the length of dst
+		limit := uint64(2 * len(dst))
+		if limit < zstd.MinWindowSize {
+			limit = zstd.MinWindowSize
+		}
+		dec, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(limit))
+		if err != nil {
+			return nil, err
+		}
+		defer dec.Close()
+		data, err := dec.DecodeAll(src, dst[:0])
+		if err == zstd.ErrDecoderSizeExceeded || err == zstd.ErrWindowSizeExceeded || len(data) > len(dst) {
+			return nil, ErrTooLong
+		}
+		if err != nil {
+			return nil, err
+		}
+		return data, nil
 	}
 	return nil, nil
 }
@@ -15,6 +15,7 @@
 package compress
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
@@ -40,3 +41,42 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestZlibAndZstd(t *testing.T) {
+	raw := bytes.Repeat([]byte("matrixone "), 100)
+	for _, typ := range []int{Zlib, Zstd} {
+		buf, err := Compress(raw, nil, typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(buf) >= len(raw) {
+			t.Fatalf("%v: compressed %d bytes into %d bytes", T(typ), len(raw), len(buf))
+		}
+		data, err := Decompress(buf, make([]byte, len(raw)), typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(raw, data) {
+			t.Fatalf("%v: unexpected data after decompression", T(typ))
+		}
+	}
+}
+
+func TestDecompressionBomb(t *testing.T) {
+	//64MB of zeros are compressed into a few KB
+	raw := make([]byte, 64<<20)
+	for _, typ := range []int{Zlib, Zstd} {
+		buf, err := Compress(raw, nil, typ)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dst := make([]byte, 1024)
+		if _, err = Decompress(buf, dst, typ); err != ErrTooLong {
+			t.Fatalf("%v: expect %v, but got %v", T(typ), ErrTooLong, err)
+		}
+		//the data as long as the buffer is decompressed
+		if _, err = Decompress(buf, make([]byte, len(raw)), typ); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -19,6 +19,8 @@ import "fmt"
 const (
 	None = iota
 	Lz4
+	Zlib
+	Zstd
 )
 
 type T uint8
@@ -29,6 +31,10 @@ func (t T) String() string {
 		return "None"
 	case Lz4:
 		return "LZ4"
+	case Zlib:
+		return "ZLIB"
+	case Zstd:
+		return "ZSTD"
 	}
 	return fmt.Sprintf("unexpected compress type: %d", t)
 }
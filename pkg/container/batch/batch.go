@@ -221,3 +221,31 @@ func (bat *Batch) InitZsOne(len int) {
 		bat.Zs[i]++
 	}
 }
+
+// RowKey appends the key of the i-th row of bat to key, two rows of
+// batches with the same attributes have the same key iff they are equal
+func RowKey(key []byte, bat *Batch, i int64) ([]byte, error) {
+	var err error
+
+	for _, vec := range bat.Vecs {
+		if key, err = vector.AppendKey(key, vec, i); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// RowKeys returns the set of the keys of all rows of bat
+func RowKeys(bat *Batch) (map[string]struct{}, error) {
+	var err error
+	var key []byte
+
+	keys := make(map[string]struct{})
+	for i, n := int64(0), int64(vector.Length(bat.Vecs[0])); i < n; i++ {
+		if key, err = RowKey(key[:0], bat, i); err != nil {
+			return nil, err
+		}
+		keys[string(key)] = struct{}{}
+	}
+	return keys, nil
+}
@@ -0,0 +1,282 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"sort"
+	"strconv"
+)
+
+func Null() ByteJson {
+	return ByteJson{TpNull}
+}
+
+func FromBool(v bool) ByteJson {
+	if v {
+		return ByteJson{TpTrue}
+	}
+	return ByteJson{TpFalse}
+}
+
+func FromInt64(v int64) ByteJson {
+	bj := make(ByteJson, 9)
+	bj[0] = TpInt
+	binary.LittleEndian.PutUint64(bj[1:], uint64(v))
+	return bj
+}
+
+func FromFloat64(v float64) ByteJson {
+	bj := make(ByteJson, 9)
+	bj[0] = TpFloat
+	binary.LittleEndian.PutUint64(bj[1:], math.Float64bits(v))
+	return bj
+}
+
+func FromString(v []byte) ByteJson {
+	bj := make(ByteJson, 1, 1+binary.MaxVarintLen64+len(v))
+	bj[0] = TpString
+	bj = appendString(bj, v)
+	return bj
+}
+
+// NewArray returns an array of the elements
+func NewArray(elems []ByteJson) ByteJson {
+	size := headerSize + len(elems)*offsetSize
+	for _, e := range elems {
+		size += len(e)
+	}
+	bj := make(ByteJson, headerSize+len(elems)*offsetSize, size)
+	bj[0] = TpArray
+	binary.LittleEndian.PutUint32(bj[1:], uint32(len(elems)))
+	binary.LittleEndian.PutUint32(bj[5:], uint32(size))
+	for i, e := range elems {
+		binary.LittleEndian.PutUint32(bj[headerSize+i*offsetSize:], uint32(len(bj)))
+		bj = append(bj, e...)
+	}
+	return bj
+}
+
+// NewObject returns an object of the members, the last one wins
+// if a key appears more than once.
+func NewObject(keys [][]byte, vals []ByteJson) ByteJson {
+	idxs := make([]int, 0, len(keys))
+	{
+		mp := make(map[string]int, len(keys))
+		for i, k := range keys {
+			if j, ok := mp[string(k)]; ok {
+				idxs[j] = i
+				continue
+			}
+			mp[string(k)] = len(idxs)
+			idxs = append(idxs, i)
+		}
+		sort.Slice(idxs, func(i, j int) bool {
+			return bytes.Compare(keys[idxs[i]], keys[idxs[j]]) < 0
+		})
+	}
+	n := len(idxs)
+	size := headerSize + n*offsetSize*3
+	for _, i := range idxs {
+		size += len(keys[i]) + len(vals[i])
+	}
+	bj := make(ByteJson, headerSize+n*offsetSize*3, size)
+	bj[0] = TpObject
+	binary.LittleEndian.PutUint32(bj[1:], uint32(n))
+	binary.LittleEndian.PutUint32(bj[5:], uint32(size))
+	for j, i := range idxs {
+		binary.LittleEndian.PutUint32(bj[headerSize+j*offsetSize*2:], uint32(len(bj)))
+		binary.LittleEndian.PutUint32(bj[headerSize+j*offsetSize*2+offsetSize:], uint32(len(keys[i])))
+		bj = append(bj, keys[i]...)
+	}
+	for j, i := range idxs {
+		binary.LittleEndian.PutUint32(bj[headerSize+n*offsetSize*2+j*offsetSize:], uint32(len(bj)))
+		bj = append(bj, vals[i]...)
+	}
+	return bj
+}
+
+func (bj ByteJson) Type() int {
+	return int(bj[0])
+}
+
+func (bj ByteJson) Int64() int64 {
+	return int64(binary.LittleEndian.Uint64(bj[1:]))
+}
+
+func (bj ByteJson) Float64() float64 {
+	return math.Float64frombits(binary.LittleEndian.Uint64(bj[1:]))
+}
+
+// Str returns the content of a string
+func (bj ByteJson) Str() []byte {
+	n, w := binary.Uvarint(bj[1:])
+	return bj[1+w : 1+w+int(n)]
+}
+
+// Count returns the number of the elements of an array or the members
+// of an object, it is 1 for a scalar.
+func (bj ByteJson) Count() int {
+	switch bj[0] {
+	case TpArray, TpObject:
+		return int(binary.LittleEndian.Uint32(bj[1:]))
+	}
+	return 1
+}
+
+// Elem returns the ith element of an array
+func (bj ByteJson) Elem(i int) ByteJson {
+	start := binary.LittleEndian.Uint32(bj[headerSize+i*offsetSize:])
+	end := bj.size()
+	if i+1 < bj.Count() {
+		end = binary.LittleEndian.Uint32(bj[headerSize+(i+1)*offsetSize:])
+	}
+	return bj[start:end]
+}
+
+// Key returns the key of the ith member of an object
+func (bj ByteJson) Key(i int) []byte {
+	off := binary.LittleEndian.Uint32(bj[headerSize+i*offsetSize*2:])
+	n := binary.LittleEndian.Uint32(bj[headerSize+i*offsetSize*2+offsetSize:])
+	return bj[off : off+n]
+}
+
+// Value returns the value of the ith member of an object
+func (bj ByteJson) Value(i int) ByteJson {
+	n := bj.Count()
+	start := binary.LittleEndian.Uint32(bj[headerSize+n*offsetSize*2+i*offsetSize:])
+	end := bj.size()
+	if i+1 < n {
+		end = binary.LittleEndian.Uint32(bj[headerSize+n*offsetSize*2+(i+1)*offsetSize:])
+	}
+	return bj[start:end]
+}
+
+// Lookup returns the value of the key of an object
+func (bj ByteJson) Lookup(key []byte) (ByteJson, bool) {
+	n := bj.Count()
+	i := sort.Search(n, func(i int) bool {
+		return bytes.Compare(bj.Key(i), key) >= 0
+	})
+	if i < n && bytes.Equal(bj.Key(i), key) {
+		return bj.Value(i), true
+	}
+	return nil, false
+}
+
+func (bj ByteJson) size() uint32 {
+	return binary.LittleEndian.Uint32(bj[5:])
+}
+
+// String returns the json text of bj
+func (bj ByteJson) String() string {
+	return string(bj.Format(nil))
+}
+
+// Unquote returns the content of a string, and the json text of the others
+func (bj ByteJson) Unquote() []byte {
+	if bj[0] == TpString {
+		return bj.Str()
+	}
+	return bj.Format(nil)
+}
+
+// Format appends the json text of bj to buf
+func (bj ByteJson) Format(buf []byte) []byte {
+	switch bj[0] {
+	case TpNull:
+		return append(buf, "null"...)
+	case TpFalse:
+		return append(buf, "false"...)
+	case TpTrue:
+		return append(buf, "true"...)
+	case TpInt:
+		return strconv.AppendInt(buf, bj.Int64(), 10)
+	case TpFloat:
+		return appendFloat(buf, bj.Float64())
+	case TpString:
+		return appendQuoted(buf, bj.Str())
+	case TpArray:
+		buf = append(buf, '[')
+		for i, n := 0, bj.Count(); i < n; i++ {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = bj.Elem(i).Format(buf)
+		}
+		return append(buf, ']')
+	case TpObject:
+		buf = append(buf, '{')
+		for i, n := 0, bj.Count(); i < n; i++ {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = appendQuoted(buf, bj.Key(i))
+			buf = append(buf, ": "...)
+			buf = bj.Value(i).Format(buf)
+		}
+		return append(buf, '}')
+	}
+	return buf
+}
+
+func appendString(buf []byte, v []byte) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+
+	n := binary.PutUvarint(tmp[:], uint64(len(v)))
+	buf = append(buf, tmp[:n]...)
+	return append(buf, v...)
+}
+
+// appendFloat appends a float which keeps a fractional part like mysql, such as 1.0
+func appendFloat(buf []byte, v float64) []byte {
+	start := len(buf)
+	buf = strconv.AppendFloat(buf, v, 'g', -1, 64)
+	if bytes.IndexAny(buf[start:], ".eEnI") < 0 {
+		buf = append(buf, ".0"...)
+	}
+	return buf
+}
+
+func appendQuoted(buf []byte, s []byte) []byte {
+	const hex = "0123456789abcdef"
+
+	buf = append(buf, '"')
+	for _, c := range s {
+		switch c {
+		case '"', '\\':
+			buf = append(buf, '\\', c)
+		case '\n':
+			buf = append(buf, '\\', 'n')
+		case '\r':
+			buf = append(buf, '\\', 'r')
+		case '\t':
+			buf = append(buf, '\\', 't')
+		case '\b':
+			buf = append(buf, '\\', 'b')
+		case '\f':
+			buf = append(buf, '\\', 'f')
+		default:
+			if c < 0x20 {
+				buf = append(buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			} else {
+				buf = append(buf, c)
+			}
+		}
+	}
+	return append(buf, '"')
+}
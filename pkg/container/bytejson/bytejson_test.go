@@ -0,0 +1,124 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantErr bool
+	}{
+		{args: "null", want: "null"},
+		{args: " true ", want: "true"},
+		{args: "-12", want: "-12"},
+		{args: "1.50", want: "1.5"},
+		{args: "1e2", want: "100.0"},
+		{args: "18446744073709551616", want: "1.8446744073709552e+19"},
+		{args: `"a\"b\n"`, want: `"a\"b\n"`},
+		{args: `[1, "x", [], {}]`, want: `[1, "x", [], {}]`},
+		{args: `{"b": 1, "a": [true, null], "b": 2}`, want: `{"a": [true, null], "b": 2}`},
+		{args: "", wantErr: true},
+		{args: "[1,", wantErr: true},
+		{args: "{1: 2}", wantErr: true},
+		{args: "1 2", wantErr: true},
+		{args: "abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		bj, err := Parse([]byte(tt.args))
+		if tt.wantErr {
+			require.Error(t, err, tt.args)
+			require.False(t, Valid([]byte(tt.args)), tt.args)
+			continue
+		}
+		require.NoError(t, err, tt.args)
+		require.Equal(t, tt.want, bj.String(), tt.args)
+	}
+
+	bj, err := Parse([]byte(`"abc"`))
+	require.NoError(t, err)
+	require.Equal(t, "abc", string(bj.Unquote()))
+}
+
+func TestExtract(t *testing.T) {
+	doc, err := Parse([]byte(`{"a": 1, "b": [10, {"c": "x"}, 30], "d": {"c": "y"}, "e f": true}`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		paths []string
+		want  string
+	}{
+		{paths: []string{"$"}, want: doc.String()},
+		{paths: []string{"$.a"}, want: "1"},
+		{paths: []string{"$.b[1].c"}, want: `"x"`},
+		{paths: []string{`$."e f"`}, want: "true"},
+		{paths: []string{"$.a[0]"}, want: "1"},
+		{paths: []string{"$.b[*]"}, want: `[10, {"c": "x"}, 30]`},
+		{paths: []string{"$.*.c"}, want: `["y"]`},
+		{paths: []string{"$**.c"}, want: `["x", "y"]`},
+		{paths: []string{"$.a", "$.z", "$.b[2]"}, want: "[1, 30]"},
+		{paths: []string{"$.z"}},
+		{paths: []string{"$.b[3]"}},
+	}
+	for _, tt := range tests {
+		paths := make([]*Path, len(tt.paths))
+		for i, s := range tt.paths {
+			paths[i], err = ParsePath(s)
+			require.NoError(t, err, s)
+		}
+		bj, ok := doc.Extract(paths)
+		if len(tt.want) == 0 {
+			require.False(t, ok, tt.paths)
+			continue
+		}
+		require.True(t, ok, tt.paths)
+		require.Equal(t, tt.want, bj.String(), tt.paths)
+	}
+
+	for _, s := range []string{"", "a", "$.", "$[", "$[-1]", "$**", "$.a b"} {
+		_, err := ParsePath(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		target    string
+		candidate string
+		want      bool
+	}{
+		{target: "1", candidate: "1.0", want: true},
+		{target: "1", candidate: `"1"`, want: false},
+		{target: "[1, 2, [3]]", candidate: "2", want: true},
+		{target: "[1, 2, [3]]", candidate: "[1, 3]", want: true},
+		{target: "[1, 2, [3]]", candidate: "[1, 4]", want: false},
+		{target: `{"a": 1, "b": [1, 2]}`, candidate: `{"b": 2}`, want: true},
+		{target: `{"a": 1, "b": [1, 2]}`, candidate: `{"c": 1}`, want: false},
+		{target: `{"a": 1}`, candidate: "1", want: false},
+		{target: "null", candidate: "null", want: true},
+	}
+	for _, tt := range tests {
+		target, err := Parse([]byte(tt.target))
+		require.NoError(t, err)
+		candidate, err := Parse([]byte(tt.candidate))
+		require.NoError(t, err)
+		require.Equal(t, tt.want, Contains(target, candidate), tt.target+" "+tt.candidate)
+	}
+}
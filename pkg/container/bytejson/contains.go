@@ -0,0 +1,82 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import "bytes"
+
+// Contains returns true if the candidate is contained in the target like mysql
+//	1. a scalar is contained in an equal scalar
+//	2. a non-array is contained in an array if it is contained in an element of the array
+//	3. an array is contained in an array if every element of it is contained in the array
+//	4. an object is contained in an object if the target has every key of it, and the
+//	   value of the key in the candidate is contained in the one in the target
+func Contains(target, candidate ByteJson) bool {
+	switch target[0] {
+	case TpArray:
+		if candidate[0] == TpArray {
+			for i, n := 0, candidate.Count(); i < n; i++ {
+				if !Contains(target, candidate.Elem(i)) {
+					return false
+				}
+			}
+			return true
+		}
+		for i, n := 0, target.Count(); i < n; i++ {
+			if Contains(target.Elem(i), candidate) {
+				return true
+			}
+		}
+		return false
+	case TpObject:
+		if candidate[0] != TpObject {
+			return false
+		}
+		for i, n := 0, candidate.Count(); i < n; i++ {
+			v, ok := target.Lookup(candidate.Key(i))
+			if !ok || !Contains(v, candidate.Value(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return scalarEqual(target, candidate)
+}
+
+func scalarEqual(x, y ByteJson) bool {
+	switch {
+	case x[0] == TpInt && y[0] == TpInt:
+		return x.Int64() == y.Int64()
+	case isNumber(x) && isNumber(y):
+		return number(x) == number(y)
+	case x[0] != y[0]:
+		return false
+	case x[0] == TpString:
+		return bytes.Equal(x.Str(), y.Str())
+	case x[0] == TpArray || x[0] == TpObject:
+		return false
+	}
+	return true
+}
+
+func isNumber(bj ByteJson) bool {
+	return bj[0] == TpInt || bj[0] == TpFloat
+}
+
+func number(bj ByteJson) float64 {
+	if bj[0] == TpInt {
+		return float64(bj.Int64())
+	}
+	return bj.Float64()
+}
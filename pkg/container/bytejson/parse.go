@@ -0,0 +1,109 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+)
+
+// Parse parses a json text to be a ByteJson, the numbers without
+// fractional parts and exponents are kept as integers if they fit.
+func Parse(data []byte) (ByteJson, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	bj, err := parseValue(dec)
+	if err != nil {
+		return nil, ErrInvalidJson
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, ErrInvalidJson
+	}
+	return bj, nil
+}
+
+// Valid returns true if data is a json text
+func Valid(data []byte) bool {
+	return json.Valid(data)
+}
+
+func parseValue(dec *json.Decoder) (ByteJson, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch v := tok.(type) {
+	case nil:
+		return Null(), nil
+	case bool:
+		return FromBool(v), nil
+	case json.Number:
+		return parseNumber(string(v))
+	case string:
+		return FromString([]byte(v)), nil
+	case json.Delim:
+		if v == '[' {
+			var elems []ByteJson
+			for dec.More() {
+				elem, err := parseValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				elems = append(elems, elem)
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+			return NewArray(elems), nil
+		}
+		var keys [][]byte
+		var vals []ByteJson
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := tok.(string)
+			if !ok {
+				return nil, ErrInvalidJson
+			}
+			val, err := parseValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, []byte(key))
+			vals = append(vals, val)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return NewObject(keys, vals), nil
+	}
+	return nil, ErrInvalidJson
+}
+
+func parseNumber(s string) (ByteJson, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return FromInt64(v), nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(v, 0) {
+		return nil, ErrInvalidJson
+	}
+	return FromFloat64(v), nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// ParsePath parses a path expression which starts with $, and consists of
+// the legs below
+//	.key, ."key"	the member of an object
+//	[n]				the nth element of an array, a scalar is the 0th element of itself
+//	.*, [*]			all the members of an object, all the elements of an array
+//	**				all the paths, it must be followed by another leg
+func ParsePath(s string) (*Path, error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 || s[0] != '$' {
+		return nil, ErrInvalidPath
+	}
+	p := new(Path)
+	for s = strings.TrimLeft(s[1:], " \t"); len(s) > 0; s = strings.TrimLeft(s, " \t") {
+		var leg pathLeg
+		var err error
+
+		switch {
+		case s[0] == '.':
+			leg, s, err = parseKeyLeg(strings.TrimLeft(s[1:], " \t"))
+		case s[0] == '[':
+			leg, s, err = parseIndexLeg(strings.TrimLeft(s[1:], " \t"))
+		case strings.HasPrefix(s, "**"):
+			leg, s = pathLeg{typ: legDoubleWildcard}, s[2:]
+		default:
+			err = ErrInvalidPath
+		}
+		if err != nil {
+			return nil, err
+		}
+		p.legs = append(p.legs, leg)
+	}
+	if n := len(p.legs); n > 0 && p.legs[n-1].typ == legDoubleWildcard {
+		return nil, ErrInvalidPath
+	}
+	return p, nil
+}
+
+func parseKeyLeg(s string) (pathLeg, string, error) {
+	if len(s) == 0 {
+		return pathLeg{}, s, ErrInvalidPath
+	}
+	switch s[0] {
+	case '*':
+		return pathLeg{typ: legKeyWildcard}, s[1:], nil
+	case '"':
+		i := 1
+		for ; i < len(s) && s[i] != '"'; i++ {
+			if s[i] == '\\' {
+				i++
+			}
+		}
+		if i >= len(s) {
+			return pathLeg{}, s, ErrInvalidPath
+		}
+		var key string
+		if err := json.Unmarshal([]byte(s[:i+1]), &key); err != nil {
+			return pathLeg{}, s, ErrInvalidPath
+		}
+		return pathLeg{typ: legKey, key: key}, s[i+1:], nil
+	}
+	i := strings.IndexAny(s, ".[*\" \t")
+	if i < 0 {
+		i = len(s)
+	}
+	if i == 0 {
+		return pathLeg{}, s, ErrInvalidPath
+	}
+	return pathLeg{typ: legKey, key: s[:i]}, s[i:], nil
+}
+
+func parseIndexLeg(s string) (pathLeg, string, error) {
+	i := strings.IndexByte(s, ']')
+	if i < 0 {
+		return pathLeg{}, s, ErrInvalidPath
+	}
+	v := strings.TrimSpace(s[:i])
+	if v == "*" {
+		return pathLeg{typ: legIndexWildcard}, s[i+1:], nil
+	}
+	n, err := strconv.ParseUint(v, 10, 31)
+	if err != nil {
+		return pathLeg{}, s, ErrInvalidPath
+	}
+	return pathLeg{typ: legIndex, index: int(n)}, s[i+1:], nil
+}
+
+// HasWildcard returns true if the path may match more than one value
+func (p *Path) HasWildcard() bool {
+	for _, leg := range p.legs {
+		if leg.typ != legKey && leg.typ != legIndex {
+			return true
+		}
+	}
+	return false
+}
+
+// Extract returns the values of bj matched by the paths, and false if nothing is
+// matched. The value is returned as it is if there is only one path without wildcards,
+// otherwise the values are wrapped into an array.
+func (bj ByteJson) Extract(paths []*Path) (ByteJson, bool) {
+	var rs []ByteJson
+
+	for _, p := range paths {
+		rs = find(bj, p.legs, rs)
+	}
+	if len(rs) == 0 {
+		return nil, false
+	}
+	if len(paths) == 1 && !paths[0].HasWildcard() {
+		return rs[0], true
+	}
+	return NewArray(rs), true
+}
+
+// find appends the values of bj matched by the legs to rs
+func find(bj ByteJson, legs []pathLeg, rs []ByteJson) []ByteJson {
+	if len(legs) == 0 {
+		return append(rs, bj)
+	}
+	leg, rest := legs[0], legs[1:]
+	switch leg.typ {
+	case legKey:
+		if bj[0] == TpObject {
+			if v, ok := bj.Lookup([]byte(leg.key)); ok {
+				rs = find(v, rest, rs)
+			}
+		}
+	case legIndex:
+		if bj[0] == TpArray {
+			if leg.index < bj.Count() {
+				rs = find(bj.Elem(leg.index), rest, rs)
+			}
+		} else if leg.index == 0 {
+			rs = find(bj, rest, rs)
+		}
+	case legKeyWildcard:
+		if bj[0] == TpObject {
+			for i, n := 0, bj.Count(); i < n; i++ {
+				rs = find(bj.Value(i), rest, rs)
+			}
+		}
+	case legIndexWildcard:
+		if bj[0] == TpArray {
+			for i, n := 0, bj.Count(); i < n; i++ {
+				rs = find(bj.Elem(i), rest, rs)
+			}
+		}
+	case legDoubleWildcard:
+		rs = find(bj, rest, rs)
+		switch bj[0] {
+		case TpArray:
+			for i, n := 0, bj.Count(); i < n; i++ {
+				rs = find(bj.Elem(i), legs, rs)
+			}
+		case TpObject:
+			for i, n := 0, bj.Count(); i < n; i++ {
+				rs = find(bj.Value(i), legs, rs)
+			}
+		}
+	}
+	return rs
+}
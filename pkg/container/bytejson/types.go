@@ -0,0 +1,76 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// ByteJson is a json value in the binary format, it starts with the type of the value
+//	null, false, true:	[type]
+//	int:	[type][int64 (8 bytes)]
+//	float:	[type][float64 (8 bytes)]
+//	string:	[type][uvarint length][bytes]
+//	array:	[type][count (4 bytes)][size (4 bytes)][offsets of elements (4 bytes each)][elements]
+//	object:	[type][count (4 bytes)][size (4 bytes)][offset, length of keys (8 bytes each)][offsets of values (4 bytes each)][keys][values]
+// the size is the length of the whole array or object, the offsets are counted from its beginning,
+// and the keys of an object are sorted so that a member is found by binary search.
+type ByteJson []byte
+
+const (
+	TpNull = iota
+	TpFalse
+	TpTrue
+	TpInt
+	TpFloat
+	TpString
+	TpArray
+	TpObject
+)
+
+const (
+	// headerSize is the size of the type, the count and the size of an array or object
+	headerSize = 1 + 4 + 4
+	// offsetSize is the size of an offset or a length
+	offsetSize = 4
+)
+
+type pathLegType int
+
+const (
+	legKey pathLegType = iota
+	legIndex
+	legKeyWildcard
+	legIndexWildcard
+	legDoubleWildcard
+)
+
+// pathLeg is a step of a path, such as .key, [index], .*, [*] and **
+type pathLeg struct {
+	typ   pathLegType
+	key   string
+	index int
+}
+
+// Path is a json path expression, such as $.a[0].b
+type Path struct {
+	legs []pathLeg
+}
+
+var (
+	ErrInvalidJson = errors.New(errno.DataException, "Invalid JSON text")
+	ErrInvalidPath = errors.New(errno.DataException, "Invalid JSON path expression")
+)
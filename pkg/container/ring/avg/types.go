@@ -22,3 +22,11 @@ type AvgRing struct {
 	Vs  []float64
 	Typ types.Type
 }
+
+type DecimalAvgRing struct {
+	Da  []byte
+	Ns  []int64
+	Vs  []types.Decimal128
+	Typ types.Type
+	err error // the sum of a group is out of range
+}
@@ -0,0 +1,201 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitagg
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// NewBit returns the ring of bit_and, bit_or or bit_xor
+func NewBit(op int, typ types.Type) *BitRing {
+	return &BitRing{Op: op, Typ: typ}
+}
+
+var _ ring.Ring = (*BitRing)(nil)
+
+func (r *BitRing) String() string {
+	return fmt.Sprintf("%v", r.Vs)
+}
+
+func (r *BitRing) Free(m *mheap.Mheap) {
+	if r.Da != nil {
+		mheap.Free(m, r.Da)
+		r.Da = nil
+		r.Vs = nil
+	}
+}
+
+func (r *BitRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *BitRing) Size() int {
+	return cap(r.Da)
+}
+
+func (r *BitRing) Dup() ring.Ring {
+	return &BitRing{
+		Op:  r.Op,
+		Typ: r.Typ,
+	}
+}
+
+func (r *BitRing) Type() types.Type {
+	return r.Typ
+}
+
+func (r *BitRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+}
+
+func (r *BitRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+	}
+	r.Vs = r.Vs[:len(sels)]
+}
+
+func (r *BitRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *BitRing) Grow(m *mheap.Mheap) error {
+	return r.Grows(1, m)
+}
+
+func (r *BitRing) Grows(size int, m *mheap.Mheap) error {
+	n := len(r.Vs)
+	if n == 0 {
+		data, err := mheap.Alloc(m, int64(size*8))
+		if err != nil {
+			return err
+		}
+		r.Da = data
+		r.Vs = encoding.DecodeUint64Slice(data)
+	} else if n+size >= cap(r.Vs) {
+		r.Da = r.Da[:n*8]
+		data, err := mheap.Grow(m, r.Da, int64(n+size)*8)
+		if err != nil {
+			return err
+		}
+		mheap.Free(m, r.Da)
+		r.Da = data
+		r.Vs = encoding.DecodeUint64Slice(data)
+	}
+	r.Vs = r.Vs[:n+size]
+	for i := n; i < n+size; i++ {
+		if r.Op == And {
+			r.Vs[i] = ^uint64(0)
+		} else {
+			r.Vs[i] = 0
+		}
+	}
+	return nil
+}
+
+func (r *BitRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		return
+	}
+	r.merge(i, value(vec, sel), z)
+}
+
+func (r *BitRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		r.Fill(int64(vps[i]-1), int64(i)+start, zs[int64(i)+start], vec)
+	}
+}
+
+func (r *BitRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		r.Fill(i, int64(j), z, vec)
+	}
+}
+
+func (r *BitRing) Add(a interface{}, x, y int64) {
+	ar := a.(*BitRing)
+	r.merge(x, ar.Vs[y], 1)
+}
+
+func (r *BitRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	ar := a.(*BitRing)
+	for i := range os {
+		r.merge(int64(vps[i]-1), ar.Vs[int64(i)+start], 1)
+	}
+}
+
+func (r *BitRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*BitRing)
+	r.merge(x, ar.Vs[y], z)
+}
+
+func (r *BitRing) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		r.Da = nil
+		r.Vs = nil
+	}()
+	return &vector.Vector{
+		Nsp:  new(nulls.Nulls),
+		Data: r.Da,
+		Col:  r.Vs,
+		Or:   false,
+		Typ:  types.Type{Oid: types.T_uint64, Size: 8},
+	}
+}
+
+// merge adds z copies of v to the i-th group, the copies of
+// a value cancel each other out for xor.
+func (r *BitRing) merge(i int64, v uint64, z int64) {
+	switch r.Op {
+	case And:
+		r.Vs[i] &= v
+	case Or:
+		r.Vs[i] |= v
+	case Xor:
+		if z%2 == 1 {
+			r.Vs[i] ^= v
+		}
+	}
+}
+
+// value returns the sel-th value of an integer vector as uint64
+func value(vec *vector.Vector, sel int64) uint64 {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return uint64(vs[sel])
+	case []int16:
+		return uint64(vs[sel])
+	case []int32:
+		return uint64(vs[sel])
+	case []int64:
+		return uint64(vs[sel])
+	case []uint8:
+		return uint64(vs[sel])
+	case []uint16:
+		return uint64(vs[sel])
+	case []uint32:
+		return uint64(vs[sel])
+	case []uint64:
+		return vs[sel]
+	}
+	return 0
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitagg
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitRing(t *testing.T) {
+	m := mheap.New(guest.New(1<<20, host.New(1<<20)))
+	// the third value is null
+	vec := vector.New(types.Type{Oid: types.T_int8, Size: 1})
+	vec.Col = []int8{6, 3, 1, 12}
+	nulls.Add(vec.Nsp, 2)
+
+	// the groups are {6, 3, 12}, {12, 12, 6, 3}, {} and {6, 3, 6, 3}
+	kases := []struct {
+		op int
+		rs []uint64
+	}{
+		{And, []uint64{0, 0, ^uint64(0), 2}},
+		{Or, []uint64{15, 15, 0, 7}},
+		{Xor, []uint64{9, 5, 0, 0}},
+	}
+	for _, kase := range kases {
+		r := NewBit(kase.op, vec.Typ)
+		require.NoError(t, r.Grows(4, m))
+		require.NoError(t, r.Grow(m))
+		r.BulkFill(0, []int64{1, 1, 1, 1}, vec)
+		r.Fill(1, 3, 2, vec)
+		r.Fill(4, 0, 1, vec)
+
+		a := r.Dup().(*BitRing)
+		require.NoError(t, a.Grows(1, m))
+		a.BulkFill(0, []int64{1, 1}, vec)
+		r.Add(a, 1, 0)
+		r.Mul(a, 3, 0, 2)
+		a.Free(m)
+
+		// the last group is removed
+		r.Shrink([]int64{0, 1, 2, 3})
+		require.Equal(t, 4, r.Count())
+		rs, err := ring.Eval(r, nil, m)
+		require.NoError(t, err)
+		require.Equal(t, kase.rs, rs.Col.([]uint64))
+		require.False(t, nulls.Any(rs.Nsp))
+		vector.Clean(rs, m)
+	}
+	require.Equal(t, int64(0), mheap.Size(m))
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bitagg
+
+import (
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	And = iota
+	Or
+	Xor
+)
+
+// BitRing computes the bitwise and, or or xor of the values of each group as
+// uint64, the result of a group without values is all ones for and, and zero
+// for the others.
+type BitRing struct {
+	Op  int
+	Da  []byte
+	Vs  []uint64
+	Typ types.Type
+}
+
+// impl Serialize & Deserialize for sql/protocol
+
+func (r *BitRing) Marshal(w io.Writer) error {
+	w.Write(encoding.EncodeUint32(uint32(r.Op)))
+	n := len(r.Vs)
+	w.Write(encoding.EncodeUint32(uint32(n)))
+	if n > 0 {
+		w.Write(encoding.EncodeUint64Slice(r.Vs))
+	}
+	w.Write(encoding.EncodeType(r.Typ))
+	return nil
+}
+
+// Unmarshal builds BitRing from `data` and bytes in `data` is allowed to be reused directly
+func (r *BitRing) Unmarshal(data []byte) ([]byte, error) {
+	return r.unmarshal(data, nil)
+}
+
+// UnmarshalWithProc builds BitRing from `data` and bytes in `data` is *not* allowed to be reused directly
+func (r *BitRing) UnmarshalWithProc(data []byte, proc *process.Process) ([]byte, error) {
+	return r.unmarshal(data, proc)
+}
+
+func (r *BitRing) unmarshal(data []byte, proc *process.Process) ([]byte, error) {
+	r.Op = int(encoding.DecodeUint32(data[:4]))
+	data = data[4:]
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if n > 0 {
+		if proc == nil {
+			r.Da = data[:n*8]
+		} else {
+			var err error
+
+			if r.Da, err = mheap.Alloc(proc.Mp, int64(n*8)); err != nil {
+				return nil, err
+			}
+			copy(r.Da, data[:n*8])
+		}
+		r.Vs = encoding.DecodeUint64Slice(r.Da)
+		data = data[n*8:]
+	}
+	r.Typ = encoding.DecodeType(data[:encoding.TypeSize])
+	return data[encoding.TypeSize:], nil
+}
@@ -0,0 +1,234 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distinct
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+func NewDistinct(r ring.Ring, typ types.Type) *DistinctRing {
+	return &DistinctRing{
+		R:   r,
+		Vec: vector.New(typ),
+	}
+}
+
+var _ ring.Ring = (*DistinctRing)(nil)
+
+// Rebuild returns a distinct ring whose i-th group consists of the values of
+// vec at ss[i], it is used to restore a ring which is decoded.
+func Rebuild(r ring.Ring, vec *vector.Vector, ss [][]int64) *DistinctRing {
+	dr := NewDistinct(r, vec.Typ)
+	for i, sels := range ss {
+		dr.Ss = append(dr.Ss, nil)
+		dr.Ms = append(dr.Ms, make(map[string]struct{}))
+		for _, sel := range sels {
+			dr.fill(int64(i), sel, vec)
+		}
+	}
+	return dr
+}
+
+func (r *DistinctRing) String() string {
+	return fmt.Sprintf("distinct(%v)-%v", r.R, r.Ss)
+}
+
+func (r *DistinctRing) Free(m *mheap.Mheap) {
+	r.R.Free(m)
+	r.Ss = nil
+	r.Ms = nil
+}
+
+func (r *DistinctRing) Count() int {
+	return len(r.Ss)
+}
+
+func (r *DistinctRing) Size() int {
+	return r.R.Size()
+}
+
+func (r *DistinctRing) Dup() ring.Ring {
+	return NewDistinct(r.R.Dup(), r.Vec.Typ)
+}
+
+func (r *DistinctRing) Type() types.Type {
+	return r.R.Type()
+}
+
+func (r *DistinctRing) SetLength(n int) {
+	r.R.SetLength(n)
+	r.Ss = r.Ss[:n]
+	r.Ms = r.Ms[:n]
+}
+
+func (r *DistinctRing) Shrink(sels []int64) {
+	r.R.Shrink(sels)
+	for i, sel := range sels {
+		r.Ss[i] = r.Ss[sel]
+		r.Ms[i] = r.Ms[sel]
+	}
+	r.Ss = r.Ss[:len(sels)]
+	r.Ms = r.Ms[:len(sels)]
+}
+
+func (r *DistinctRing) Shuffle(sels []int64, m *mheap.Mheap) error {
+	return r.R.Shuffle(sels, m)
+}
+
+func (r *DistinctRing) Grow(m *mheap.Mheap) error {
+	if err := r.R.Grow(m); err != nil {
+		return err
+	}
+	r.Ss = append(r.Ss, nil)
+	r.Ms = append(r.Ms, make(map[string]struct{}))
+	return nil
+}
+
+func (r *DistinctRing) Grows(size int, m *mheap.Mheap) error {
+	if err := r.R.Grows(size, m); err != nil {
+		return err
+	}
+	for i := 0; i < size; i++ {
+		r.Ss = append(r.Ss, nil)
+		r.Ms = append(r.Ms, make(map[string]struct{}))
+	}
+	return nil
+}
+
+func (r *DistinctRing) Fill(i int64, sel, _ int64, vec *vector.Vector) {
+	r.fill(i, sel, vec)
+}
+
+func (r *DistinctRing) BatchFill(start int64, os []uint8, vps []uint64, _ []int64, vec *vector.Vector) {
+	for i := range os {
+		r.fill(int64(vps[i]-1), int64(i)+start, vec)
+	}
+}
+
+func (r *DistinctRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j := range zs {
+		r.fill(i, int64(j), vec)
+	}
+}
+
+func (r *DistinctRing) Add(a interface{}, x, y int64) {
+	ar := a.(*DistinctRing)
+	for _, sel := range ar.Ss[y] {
+		r.fill(x, sel, ar.Vec)
+	}
+}
+
+func (r *DistinctRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	for i := range os {
+		r.Add(a, int64(vps[i]-1), int64(i)+start)
+	}
+}
+
+// Mul is the same as Add, the multiplicity of a value does not matter
+func (r *DistinctRing) Mul(a interface{}, x, y, _ int64) {
+	r.Add(a, x, y)
+}
+
+func (r *DistinctRing) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		r.Vec = nil
+		r.Ss = nil
+		r.Ms = nil
+	}()
+	zs := make([]int64, len(r.Ss))
+	for i, sels := range r.Ss {
+		for _, sel := range sels {
+			r.R.Fill(int64(i), sel, 1, r.Vec)
+		}
+		zs[i] = int64(len(sels))
+	}
+	return r.R.Eval(zs)
+}
+
+// Err returns the error of the evaluation of the aggregation function.
+func (r *DistinctRing) Err() error {
+	if fr, ok := r.R.(interface{ Err() error }); ok {
+		return fr.Err()
+	}
+	return nil
+}
+
+// fill adds the sel-th value of vec to the i-th group if the group
+// does not contain it yet, null values are ignored.
+func (r *DistinctRing) fill(i int64, sel int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		return
+	}
+	key, err := vector.AppendKey(r.key[:0], vec, sel)
+	if err != nil {
+		// the values of a ring are of a type made by vector.New, which
+		// are all encodable
+		panic(err)
+	}
+	r.key = key
+	if _, ok := r.Ms[i][string(r.key)]; ok {
+		return
+	}
+	r.Ms[i][string(r.key)] = struct{}{}
+	r.Ss[i] = append(r.Ss[i], int64(vector.Length(r.Vec)))
+	appendValue(r.Vec, vec, sel)
+}
+
+func appendValue(v, w *vector.Vector, sel int64) {
+	switch ws := w.Col.(type) {
+	case []int8:
+		v.Col = append(v.Col.([]int8), ws[sel])
+	case []int16:
+		v.Col = append(v.Col.([]int16), ws[sel])
+	case []int32:
+		v.Col = append(v.Col.([]int32), ws[sel])
+	case []int64:
+		v.Col = append(v.Col.([]int64), ws[sel])
+	case []uint8:
+		v.Col = append(v.Col.([]uint8), ws[sel])
+	case []uint16:
+		v.Col = append(v.Col.([]uint16), ws[sel])
+	case []uint32:
+		v.Col = append(v.Col.([]uint32), ws[sel])
+	case []uint64:
+		v.Col = append(v.Col.([]uint64), ws[sel])
+	case []float32:
+		v.Col = append(v.Col.([]float32), ws[sel])
+	case []float64:
+		v.Col = append(v.Col.([]float64), ws[sel])
+	case []types.Decimal64:
+		v.Col = append(v.Col.([]types.Decimal64), ws[sel])
+	case []types.Decimal128:
+		v.Col = append(v.Col.([]types.Decimal128), ws[sel])
+	case []types.Date:
+		v.Col = append(v.Col.([]types.Date), ws[sel])
+	case []types.Datetime:
+		v.Col = append(v.Col.([]types.Datetime), ws[sel])
+	case []types.Time:
+		v.Col = append(v.Col.([]types.Time), ws[sel])
+	case []types.Timestamp:
+		v.Col = append(v.Col.([]types.Timestamp), ws[sel])
+	case *types.Bytes:
+		v.Col.(*types.Bytes).Append([][]byte{ws.Get(sel)})
+	default:
+		panic(fmt.Sprintf("unexpect type %s for distinct aggregation", w.Typ))
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distinct
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// DistinctRing wraps the ring of an aggregation function such as sum or count
+// so that each distinct value of a group is aggregated only once. The distinct
+// values are collected first and fed into R when the ring is evaluated.
+type DistinctRing struct {
+	R   ring.Ring             // ring of the aggregation function
+	Vec *vector.Vector        // distinct values of all groups
+	Ss  [][]int64             // rows of Vec that belong to each group
+	Ms  []map[string]struct{} // keys of the distinct values of each group
+	key []byte
+}
@@ -0,0 +1,349 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// NewGroupConcat returns the ring of group_concat, n is the number of the values
+// of a row and descs are the directions of the keys of order by.
+func NewGroupConcat(n int, distinct bool, descs []bool, sep string, typ types.Type) *GroupConcatRing {
+	return &GroupConcatRing{
+		N:        n,
+		Distinct: distinct,
+		Descs:    descs,
+		Sep:      sep,
+		Typ:      typ,
+	}
+}
+
+var _ ring.Ring = (*GroupConcatRing)(nil)
+
+func (r *GroupConcatRing) String() string {
+	return fmt.Sprintf("%v", r.Vs)
+}
+
+func (r *GroupConcatRing) Free(_ *mheap.Mheap) {
+	r.Vs = nil
+	r.release()
+}
+
+func (r *GroupConcatRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *GroupConcatRing) Size() int {
+	return int(r.size)
+}
+
+func (r *GroupConcatRing) Dup() ring.Ring {
+	return NewGroupConcat(r.N, r.Distinct, r.Descs, r.Sep, r.Typ)
+}
+
+func (r *GroupConcatRing) Type() types.Type {
+	return r.Typ
+}
+
+func (r *GroupConcatRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+}
+
+func (r *GroupConcatRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+	}
+	r.Vs = r.Vs[:len(sels)]
+}
+
+func (r *GroupConcatRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *GroupConcatRing) Grow(m *mheap.Mheap) error {
+	return r.Grows(1, m)
+}
+
+func (r *GroupConcatRing) Grows(size int, m *mheap.Mheap) error {
+	if r.Mp == nil {
+		r.Mp = m
+	}
+	if len(r.Vs) == 0 {
+		r.Vs = make([][]bytejson.ByteJson, 0, size)
+	}
+	for i := 0; i < size; i++ {
+		r.Vs = append(r.Vs, nil)
+	}
+	return nil
+}
+
+func (r *GroupConcatRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		return
+	}
+	// the value is copied because the memory of vec is reused by the next batch
+	v := vec.Col.(*types.Bytes).Get(sel)
+	if r.alloc(int64(len(v))) != nil {
+		return
+	}
+	v = append(bytejson.ByteJson{}, v...)
+	for ; z > 0; z-- {
+		r.Vs[i] = append(r.Vs[i], v)
+	}
+}
+
+func (r *GroupConcatRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		r.Fill(int64(vps[i]-1), int64(i)+start, zs[int64(i)+start], vec)
+	}
+}
+
+func (r *GroupConcatRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		r.Fill(i, int64(j), z, vec)
+	}
+}
+
+// Add shares the rows of a, they are accounted again as they are kept after
+// a is freed, and so does Mul, once whatever z is.
+func (r *GroupConcatRing) Add(a interface{}, x, y int64) {
+	ar := a.(*GroupConcatRing)
+	if r.alloc(rowsSize(ar.Vs[y])) != nil {
+		return
+	}
+	r.Vs[x] = append(r.Vs[x], ar.Vs[y]...)
+}
+
+func (r *GroupConcatRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	for i := range os {
+		r.Add(a, int64(vps[i]-1), int64(i)+start)
+	}
+}
+
+func (r *GroupConcatRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*GroupConcatRing)
+	if r.alloc(rowsSize(ar.Vs[y])) != nil {
+		return
+	}
+	for ; z > 0; z-- {
+		r.Vs[x] = append(r.Vs[x], ar.Vs[y]...)
+	}
+}
+
+func (r *GroupConcatRing) Eval(_ []int64) *vector.Vector {
+	var data []byte
+	var os, ns []uint32
+
+	defer func() {
+		r.Vs = nil
+		r.release()
+	}()
+	nsp := new(nulls.Nulls)
+	for i, vs := range r.Vs {
+		var ok bool
+
+		start := len(data)
+		if data, ok = r.concat(data, vs); !ok {
+			nulls.Add(nsp, uint64(i))
+		}
+		os = append(os, uint32(start))
+		ns = append(ns, uint32(len(data)-start))
+	}
+	if r.Mp != nil && r.err == nil {
+		r.err = r.Mp.Gm.Alloc(int64(cap(data)))
+	}
+	if r.err != nil {
+		// the result is not accounted if it fails, Err reports the error
+		data, os, ns = nil, make([]uint32, len(os)), make([]uint32, len(ns))
+	}
+	// the data is the memory of the vector so that the memory accounted
+	// is given back when the vector is freed
+	return &vector.Vector{
+		Nsp:  nsp,
+		Or:   false,
+		Data: data,
+		Typ:  types.Type{Oid: types.T_varchar, Size: 24},
+		Col: &types.Bytes{
+			Offsets: os,
+			Lengths: ns,
+			Data:    data,
+		},
+	}
+}
+
+// Err returns the error of the rows which are not collected as the memory
+// limit is exceeded, or of the result which is not accounted.
+func (r *GroupConcatRing) Err() error {
+	return r.err
+}
+
+// alloc accounts size bytes of rows in the guest memory before they are
+// kept, it fails if the rows of the ring exceed the memory limit.
+func (r *GroupConcatRing) alloc(size int64) error {
+	if r.err != nil {
+		return r.err
+	}
+	if r.Mp != nil {
+		if r.err = r.Mp.Gm.Alloc(size); r.err != nil {
+			return r.err
+		}
+		r.size += size
+	}
+	return nil
+}
+
+// release gives the memory of the rows back.
+func (r *GroupConcatRing) release() {
+	if r.size > 0 {
+		r.Mp.Gm.Free(r.size)
+		r.size = 0
+	}
+}
+
+// rowsSize returns the size of the rows
+func rowsSize(vs []bytejson.ByteJson) int64 {
+	var size int64
+
+	for _, v := range vs {
+		size += int64(len(v))
+	}
+	return size
+}
+
+// concat appends the values of the rows which contain no null value to data,
+// the rows are sorted by the keys of order by, and it returns false if there
+// is no such row.
+func (r *GroupConcatRing) concat(data []byte, vs []bytejson.ByteJson) ([]byte, bool) {
+	rows := make([]bytejson.ByteJson, 0, len(vs))
+	for _, v := range vs {
+		if !r.hasNull(v) {
+			rows = append(rows, v)
+		}
+	}
+	if len(r.Descs) > 0 {
+		sort.SliceStable(rows, func(i, j int) bool {
+			for k, desc := range r.Descs {
+				if c := compare(rows[i].Elem(r.N+k), rows[j].Elem(r.N+k)); c != 0 {
+					return (c < 0) != desc
+				}
+			}
+			return false
+		})
+	}
+	var seen map[string]struct{}
+	if r.Distinct {
+		seen = make(map[string]struct{})
+	}
+	first := true
+	for _, row := range rows {
+		if seen != nil {
+			var key []byte
+			for k := 0; k < r.N; k++ {
+				key = append(key, row.Elem(k)...)
+			}
+			if _, ok := seen[string(key)]; ok {
+				continue
+			}
+			seen[string(key)] = struct{}{}
+		}
+		if !first {
+			data = append(data, r.Sep...)
+		}
+		first = false
+		for k := 0; k < r.N; k++ {
+			data = append(data, row.Elem(k).Unquote()...)
+		}
+	}
+	return data, len(rows) > 0
+}
+
+// hasNull returns true if one of the values of a row is null
+func (r *GroupConcatRing) hasNull(v bytejson.ByteJson) bool {
+	for k := 0; k < r.N; k++ {
+		if v.Elem(k).Type() == bytejson.TpNull {
+			return true
+		}
+	}
+	return false
+}
+
+// compare compares two keys of order by, null is the smallest
+// and numbers are smaller than strings.
+func compare(x, y bytejson.ByteJson) int {
+	xt, yt := rank(x), rank(y)
+	switch {
+	case xt != yt:
+		if xt < yt {
+			return -1
+		}
+		return 1
+	case x.Type() == bytejson.TpInt && y.Type() == bytejson.TpInt:
+		a, b := x.Int64(), y.Int64()
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case xt == rankNumber:
+		a, b := number(x), number(y)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case xt == rankString:
+		return bytes.Compare(x.Str(), y.Str())
+	}
+	return bytes.Compare(x, y)
+}
+
+const (
+	rankNull = iota
+	rankNumber
+	rankString
+	rankOther
+)
+
+func rank(v bytejson.ByteJson) int {
+	switch v.Type() {
+	case bytejson.TpNull:
+		return rankNull
+	case bytejson.TpInt, bytejson.TpFloat:
+		return rankNumber
+	case bytejson.TpString:
+		return rankString
+	}
+	return rankOther
+}
+
+func number(v bytejson.ByteJson) float64 {
+	if v.Type() == bytejson.TpInt {
+		return float64(v.Int64())
+	}
+	return v.Float64()
+}
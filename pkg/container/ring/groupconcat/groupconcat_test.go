@@ -0,0 +1,117 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupConcatRing(t *testing.T) {
+	m := mheap.New(guest.New(1<<20, host.New(1<<20)))
+	vec := newTestVector()
+
+	// the groups are {a, b, d}, {d, d, a, b}, {} and {a, b, a, b}
+	kases := []struct {
+		distinct bool
+		descs    []bool
+		sep      string
+		rs       []string
+	}{
+		{false, nil, ",", []string{"a,b,d", "d,d,a,b", "", "a,b,a,b"}},
+		{true, nil, ",", []string{"a,b,d", "d,a,b", "", "a,b"}},
+		{false, []bool{false}, "-", []string{"a-b-d", "a-b-d-d", "", "a-a-b-b"}},
+		{true, []bool{true}, "", []string{"dba", "dba", "", "ba"}},
+	}
+	for _, kase := range kases {
+		r := NewGroupConcat(1, kase.distinct, kase.descs, kase.sep, types.Type{Oid: types.T_varchar, Size: 24})
+		require.NoError(t, r.Grows(4, m))
+		require.NoError(t, r.Grow(m))
+		r.BulkFill(0, []int64{1, 1, 1, 1}, vec)
+		r.Fill(1, 3, 2, vec)
+		r.Fill(4, 0, 1, vec)
+
+		a := r.Dup().(*GroupConcatRing)
+		require.NoError(t, a.Grows(1, m))
+		a.BulkFill(0, []int64{1, 1}, vec)
+		r.Add(a, 1, 0)
+		r.Mul(a, 3, 0, 2)
+		a.Free(m)
+
+		// the last group is removed
+		r.Shrink([]int64{0, 1, 2, 3})
+		require.Equal(t, 4, r.Count())
+		// the rows shared are accounted once, including the one of the
+		// group removed
+		require.Equal(t, 9*len(vec.Col.(*types.Bytes).Get(0)), r.Size())
+		rs, err := ring.Eval(r, nil, m)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{2}, rs.Nsp.Np.ToArray())
+		col := rs.Col.(*types.Bytes)
+		for i, s := range kase.rs {
+			require.Equal(t, s, string(col.Get(int64(i))))
+		}
+		vector.Clean(rs, m)
+	}
+	require.Equal(t, int64(0), mheap.Size(m))
+}
+
+func TestGroupConcatRingMemoryLimit(t *testing.T) {
+	m := mheap.New(guest.New(128, host.New(1<<20)))
+	vec := newTestVector()
+
+	r := NewGroupConcat(1, false, nil, ",", types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, r.Grows(2, m))
+	r.BulkFill(0, []int64{1, 1, 1, 1}, vec)
+	// the rows exceed the limit and are not kept
+	for i := 0; i < 16; i++ {
+		r.Fill(1, 0, 1, vec)
+	}
+	require.Len(t, r.Vs[0], 3)
+	require.Less(t, len(r.Vs[1]), 16)
+	_, err := ring.Eval(r, nil, m)
+	require.Equal(t, mmu.OutOfMemory, err)
+	require.Equal(t, int64(0), mheap.Size(m))
+}
+
+// newTestVector returns the vector of the rows ["a", "a"], ["b", "b"], null
+// and ["d", "d"], whose second values are the keys of order by
+func newTestVector() *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_json, Size: 24})
+	col := vec.Col.(*types.Bytes)
+	for _, v := range []bytejson.ByteJson{
+		bytejson.FromString([]byte("a")),
+		bytejson.FromString([]byte("b")),
+		bytejson.Null(),
+		bytejson.FromString([]byte("d")),
+	} {
+		row := bytejson.NewArray([]bytejson.ByteJson{v, v})
+		col.Offsets = append(col.Offsets, uint32(len(col.Data)))
+		col.Lengths = append(col.Lengths, uint32(len(row)))
+		col.Data = append(col.Data, row...)
+	}
+	nulls.Add(vec.Nsp, 2)
+	return vec
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// GroupConcatRing collects the rows of each group for group_concat, a row is
+// a json array whose first N elements are the values to be concatenated and
+// the others are the keys of order by, whose directions are Descs. The rows
+// are sorted and concatenated by Eval, and the rows of the same values are
+// concatenated once if Distinct is set. The rows are accounted in the guest
+// memory of Mp as they are collected, so they count against the memory limit
+// of the query, and the ring stops collecting them and fails with the error
+// of Err once the limit is exceeded.
+type GroupConcatRing struct {
+	N        int
+	Distinct bool
+	Descs    []bool
+	Sep      string
+	Vs       [][]bytejson.ByteJson
+	Typ      types.Type
+	Mp       *mheap.Mheap
+	size     int64 // the size of the rows of Vs accounted in Mp
+	err      error
+}
+
+// impl Serialize & Deserialize for sql/protocol
+
+func (r *GroupConcatRing) Marshal(w io.Writer) error {
+	w.Write(encoding.EncodeUint32(uint32(r.N)))
+	w.Write(encodeBool(r.Distinct))
+	w.Write(encoding.EncodeUint32(uint32(len(r.Descs))))
+	for _, desc := range r.Descs {
+		w.Write(encodeBool(desc))
+	}
+	w.Write(encoding.EncodeUint32(uint32(len(r.Sep))))
+	io.WriteString(w, r.Sep)
+	w.Write(encoding.EncodeUint32(uint32(len(r.Vs))))
+	for _, vs := range r.Vs {
+		w.Write(encoding.EncodeUint32(uint32(len(vs))))
+		for _, v := range vs {
+			w.Write(encoding.EncodeUint32(uint32(len(v))))
+			w.Write(v)
+		}
+	}
+	w.Write(encoding.EncodeType(r.Typ))
+	return nil
+}
+
+// Unmarshal builds GroupConcatRing from `data`, the rows are always
+// copied because they are appended to the groups.
+func (r *GroupConcatRing) Unmarshal(data []byte) ([]byte, error) {
+	r.N = int(encoding.DecodeUint32(data[:4]))
+	data = data[4:]
+	r.Distinct = data[0] == 1
+	data = data[1:]
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	r.Descs = make([]bool, n)
+	for i := range r.Descs {
+		r.Descs[i] = data[i] == 1
+	}
+	data = data[n:]
+	n = encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	r.Sep = string(data[:n])
+	data = data[n:]
+	n = encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	r.Vs = make([][]bytejson.ByteJson, n)
+	for i := range r.Vs {
+		m := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		for ; m > 0; m-- {
+			size := encoding.DecodeUint32(data[:4])
+			data = data[4:]
+			r.Vs[i] = append(r.Vs[i], append(bytejson.ByteJson{}, data[:size]...))
+			data = data[size:]
+		}
+	}
+	r.Typ = encoding.DecodeType(data[:encoding.TypeSize])
+	return data[encoding.TypeSize:], nil
+}
+
+func encodeBool(v bool) []byte {
+	if v {
+		return []byte{1}
+	}
+	return []byte{0}
+}
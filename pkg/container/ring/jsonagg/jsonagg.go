@@ -0,0 +1,210 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonagg
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// NewArray returns the ring of json_arrayagg
+func NewArray(typ types.Type) *JsonRing {
+	return &JsonRing{Typ: typ}
+}
+
+// NewObject returns the ring of json_objectagg
+func NewObject(typ types.Type) *JsonRing {
+	return &JsonRing{Typ: typ, IsObject: true}
+}
+
+func (r *JsonRing) String() string {
+	return fmt.Sprintf("%v", r.Vs)
+}
+
+func (r *JsonRing) Free(_ *mheap.Mheap) {
+	r.Vs = nil
+}
+
+func (r *JsonRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *JsonRing) Size() int {
+	return 0
+}
+
+func (r *JsonRing) Dup() ring.Ring {
+	return &JsonRing{
+		IsObject: r.IsObject,
+		Typ:      r.Typ,
+	}
+}
+
+func (r *JsonRing) Type() types.Type {
+	return r.Typ
+}
+
+func (r *JsonRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+}
+
+func (r *JsonRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+	}
+	r.Vs = r.Vs[:len(sels)]
+}
+
+func (r *JsonRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *JsonRing) Grow(m *mheap.Mheap) error {
+	if r.Mp == nil {
+		r.Mp = m
+	}
+	if len(r.Vs) == 0 {
+		r.Vs = make([][]bytejson.ByteJson, 0, 8)
+	}
+	r.Vs = append(r.Vs, nil)
+	return nil
+}
+
+func (r *JsonRing) Grows(size int, m *mheap.Mheap) error {
+	if r.Mp == nil {
+		r.Mp = m
+	}
+	if len(r.Vs) == 0 {
+		r.Vs = make([][]bytejson.ByteJson, 0, size)
+	}
+	for i := 0; i < size; i++ {
+		r.Vs = append(r.Vs, nil)
+	}
+	return nil
+}
+
+func (r *JsonRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		return
+	}
+	// the value is copied because the memory of vec is reused by the next batch
+	v := append(bytejson.ByteJson{}, vec.Col.(*types.Bytes).Get(sel)...)
+	for ; z > 0; z-- {
+		r.Vs[i] = append(r.Vs[i], v)
+	}
+}
+
+func (r *JsonRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		r.Fill(int64(vps[i]-1), int64(i)+start, zs[int64(i)+start], vec)
+	}
+}
+
+func (r *JsonRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		r.Fill(i, int64(j), z, vec)
+	}
+}
+
+func (r *JsonRing) Add(a interface{}, x, y int64) {
+	ar := a.(*JsonRing)
+	r.Vs[x] = append(r.Vs[x], ar.Vs[y]...)
+}
+
+func (r *JsonRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	ar := a.(*JsonRing)
+	for i := range os {
+		j := vps[i] - 1
+		r.Vs[j] = append(r.Vs[j], ar.Vs[int64(i)+start]...)
+	}
+}
+
+func (r *JsonRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*JsonRing)
+	for ; z > 0; z-- {
+		r.Vs[x] = append(r.Vs[x], ar.Vs[y]...)
+	}
+}
+
+func (r *JsonRing) Eval(_ []int64) *vector.Vector {
+	var data []byte
+	var os, ns []uint32
+
+	defer func() {
+		r.Vs = nil
+	}()
+	nsp := new(nulls.Nulls)
+	for i, vs := range r.Vs {
+		var v bytejson.ByteJson
+
+		switch {
+		case len(vs) == 0:
+			nulls.Add(nsp, uint64(i))
+		case r.IsObject:
+			v = mergeObjects(vs)
+		default:
+			v = mergeArrays(vs)
+		}
+		os = append(os, uint32(len(data)))
+		ns = append(ns, uint32(len(v)))
+		data = append(data, v...)
+	}
+	if err := r.Mp.Gm.Alloc(int64(cap(data))); err != nil {
+		return nil
+	}
+	return &vector.Vector{
+		Nsp: nsp,
+		Or:  false,
+		Typ: r.Typ,
+		Col: &types.Bytes{
+			Offsets: os,
+			Lengths: ns,
+			Data:    data,
+		},
+	}
+}
+
+// mergeArrays returns an array of the elements of the arrays
+func mergeArrays(vs []bytejson.ByteJson) bytejson.ByteJson {
+	var elems []bytejson.ByteJson
+
+	for _, v := range vs {
+		for i, n := 0, v.Count(); i < n; i++ {
+			elems = append(elems, v.Elem(i))
+		}
+	}
+	return bytejson.NewArray(elems)
+}
+
+// mergeObjects returns an object of the members of the objects,
+// the last one wins if a key appears more than once.
+func mergeObjects(vs []bytejson.ByteJson) bytejson.ByteJson {
+	var keys [][]byte
+	var vals []bytejson.ByteJson
+
+	for _, v := range vs {
+		for i, n := 0, v.Count(); i < n; i++ {
+			keys = append(keys, v.Key(i))
+			vals = append(vals, v.Value(i))
+		}
+	}
+	return bytejson.NewObject(keys, vals)
+}
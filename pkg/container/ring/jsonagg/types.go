@@ -0,0 +1,31 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonagg
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// JsonRing collects the json values of each group, the values of json_arrayagg
+// are the arrays of one element, and those of json_objectagg are the objects of
+// one member, which are merged into an array or an object by Eval.
+type JsonRing struct {
+	IsObject bool
+	Vs       [][]bytejson.ByteJson
+	Typ      types.Type
+	Mp       *mheap.Mheap
+}
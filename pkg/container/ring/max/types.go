@@ -91,6 +91,20 @@ type Float64Ring struct {
 	Typ types.Type
 }
 
+type Decimal64Ring struct {
+	Da  []byte
+	Vs  []types.Decimal64
+	Ns  []int64
+	Typ types.Type
+}
+
+type Decimal128Ring struct {
+	Da  []byte
+	Vs  []types.Decimal128
+	Ns  []int64
+	Typ types.Type
+}
+
 type StrRing struct {
 	Ns  []int64
 	Vs  [][]byte
@@ -111,3 +125,17 @@ type DatetimeRing struct {
 	Ns  []int64
 	Typ types.Type
 }
+
+type TimeRing struct {
+	Da  []byte
+	Vs  []types.Time
+	Ns  []int64
+	Typ types.Type
+}
+
+type TimestampRing struct {
+	Da  []byte
+	Vs  []types.Timestamp
+	Ns  []int64
+	Typ types.Type
+}
@@ -0,0 +1,240 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// NewPercentile returns the ring of percentile_cont(x, p), median(x) is percentile_cont(x, 0.5)
+func NewPercentile(p float64, typ types.Type) *PercentileRing {
+	return &PercentileRing{P: p, Typ: typ}
+}
+
+var _ ring.Ring = (*PercentileRing)(nil)
+
+func (r *PercentileRing) String() string {
+	return fmt.Sprintf("percentile(%v)-%v", r.P, r.Xs)
+}
+
+func (r *PercentileRing) Free(m *mheap.Mheap) {
+	if r.Da != nil {
+		mheap.Free(m, r.Da)
+		r.Da = nil
+		r.Vs = nil
+		r.Xs = nil
+	}
+	r.release()
+}
+
+func (r *PercentileRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *PercentileRing) Size() int {
+	return cap(r.Da) + int(r.size)
+}
+
+func (r *PercentileRing) Dup() ring.Ring {
+	return &PercentileRing{
+		P:   r.P,
+		Typ: r.Typ,
+	}
+}
+
+func (r *PercentileRing) Type() types.Type {
+	return r.Typ
+}
+
+func (r *PercentileRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+	r.Xs = r.Xs[:n]
+}
+
+func (r *PercentileRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+		r.Xs[i] = r.Xs[sel]
+	}
+	r.Vs = r.Vs[:len(sels)]
+	r.Xs = r.Xs[:len(sels)]
+}
+
+func (r *PercentileRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *PercentileRing) Grow(m *mheap.Mheap) error {
+	return r.Grows(1, m)
+}
+
+func (r *PercentileRing) Grows(size int, m *mheap.Mheap) error {
+	var err error
+
+	if r.Mp == nil {
+		r.Mp = m
+	}
+	if r.Da, r.Vs, err = grows(r.Da, r.Vs, size, m); err != nil {
+		return err
+	}
+	for i := 0; i < size; i++ {
+		r.Xs = append(r.Xs, nil)
+	}
+	return nil
+}
+
+func (r *PercentileRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		return
+	}
+	v := ring.Float64(vec, sel)
+	if r.alloc(z*8) != nil {
+		return
+	}
+	for ; z > 0; z-- {
+		r.Xs[i] = append(r.Xs[i], v)
+	}
+}
+
+func (r *PercentileRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		r.Fill(int64(vps[i]-1), int64(i)+start, zs[int64(i)+start], vec)
+	}
+}
+
+func (r *PercentileRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		r.Fill(i, int64(j), z, vec)
+	}
+}
+
+func (r *PercentileRing) Add(a interface{}, x, y int64) {
+	ar := a.(*PercentileRing)
+	if r.alloc(int64(len(ar.Xs[y]))*8) != nil {
+		return
+	}
+	r.Xs[x] = append(r.Xs[x], ar.Xs[y]...)
+}
+
+func (r *PercentileRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	for i := range os {
+		r.Add(a, int64(vps[i]-1), int64(i)+start)
+	}
+}
+
+func (r *PercentileRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*PercentileRing)
+	if r.alloc(z*int64(len(ar.Xs[y]))*8) != nil {
+		return
+	}
+	for ; z > 0; z-- {
+		r.Xs[x] = append(r.Xs[x], ar.Xs[y]...)
+	}
+}
+
+func (r *PercentileRing) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		r.Da = nil
+		r.Vs = nil
+		r.Xs = nil
+		r.release()
+	}()
+	nsp := new(nulls.Nulls)
+	for i, xs := range r.Xs {
+		if len(xs) == 0 {
+			nulls.Add(nsp, uint64(i))
+			r.Vs[i] = 0
+			continue
+		}
+		sort.Float64s(xs)
+		pos := r.P * float64(len(xs)-1)
+		lo := math.Floor(pos)
+		hi := math.Ceil(pos)
+		r.Vs[i] = xs[int(lo)] + (xs[int(hi)]-xs[int(lo)])*(pos-lo)
+	}
+	return &vector.Vector{
+		Nsp:  nsp,
+		Data: r.Da,
+		Col:  r.Vs,
+		Or:   false,
+		Typ:  types.Type{Oid: types.T_float64, Size: 8},
+	}
+}
+
+// Err returns the error of the values which are not kept as the memory
+// limit is exceeded.
+func (r *PercentileRing) Err() error {
+	return r.err
+}
+
+// alloc accounts size bytes of values in the guest memory before they are
+// kept, it fails if the values of the ring exceed the memory limit.
+func (r *PercentileRing) alloc(size int64) error {
+	if r.err != nil {
+		return r.err
+	}
+	if r.Mp != nil {
+		if r.err = r.Mp.Gm.Alloc(size); r.err != nil {
+			return r.err
+		}
+		r.size += size
+	}
+	return nil
+}
+
+// release gives the memory of the values back, the groups removed by
+// Shrink or SetLength are accounted until then.
+func (r *PercentileRing) release() {
+	if r.size > 0 {
+		r.Mp.Gm.Free(r.size)
+		r.size = 0
+	}
+}
+
+// grows appends size results to vs whose memory is da
+func grows(da []byte, vs []float64, size int, m *mheap.Mheap) ([]byte, []float64, error) {
+	n := len(vs)
+	if n == 0 {
+		data, err := mheap.Alloc(m, int64(size*8))
+		if err != nil {
+			return nil, nil, err
+		}
+		da = data
+		vs = encoding.DecodeFloat64Slice(data)
+	} else if n+size >= cap(vs) {
+		da = da[:n*8]
+		data, err := mheap.Grow(m, da, int64(n+size)*8)
+		if err != nil {
+			return nil, nil, err
+		}
+		mheap.Free(m, da)
+		da = data
+		vs = encoding.DecodeFloat64Slice(data)
+	}
+	vs = vs[:n+size]
+	for i := n; i < n+size; i++ {
+		vs[i] = 0
+	}
+	return da, vs, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentileRing(t *testing.T) {
+	m := mheap.New(guest.New(1<<20, host.New(1<<20)))
+	vec := newTestVector()
+
+	// the groups are {1, 2, 4}, {4, 4, 1, 2}, {} and {1, 2, 1, 2}
+	kases := []struct {
+		p  float64
+		rs []float64
+	}{
+		{0.5, []float64{2, 3, 0, 1.5}},
+		{0.25, []float64{1.5, 1.75, 0, 1}},
+		{1, []float64{4, 4, 0, 2}},
+	}
+	for _, kase := range kases {
+		for _, r := range []ring.Ring{NewPercentile(kase.p, vec.Typ), NewTDigest(kase.p, vec.Typ)} {
+			fillTestRing(t, r, vec, m)
+			if pr, ok := r.(*PercentileRing); ok {
+				// the values are accounted, including the one of the
+				// group removed
+				require.Equal(t, cap(pr.Da)+12*8, r.Size())
+			}
+			rs, err := ring.Eval(r, nil, m)
+			require.NoError(t, err)
+			require.Equal(t, []uint64{2}, rs.Nsp.Np.ToArray(), r.String())
+			if _, ok := r.(*PercentileRing); ok {
+				require.Equal(t, kase.rs, rs.Col.([]float64))
+			} else {
+				require.InDeltaSlice(t, kase.rs, rs.Col.([]float64), 0.5)
+			}
+			vector.Clean(rs, m)
+		}
+	}
+	require.Equal(t, int64(0), mheap.Size(m))
+}
+
+func TestPercentileRingMemoryLimit(t *testing.T) {
+	m := mheap.New(guest.New(64, host.New(1<<20)))
+	vec := newTestVector()
+
+	r := NewPercentile(0.5, vec.Typ)
+	require.NoError(t, r.Grows(2, m))
+	r.Fill(0, 0, 4, vec)
+	// the values exceed the limit and are not kept
+	r.Fill(1, 1, 4, vec)
+	require.Equal(t, 1, len(r.Xs[0])/4)
+	require.Empty(t, r.Xs[1])
+	_, err := ring.Eval(r, nil, m)
+	require.Equal(t, mmu.OutOfMemory, err)
+	require.Equal(t, int64(0), mheap.Size(m))
+}
+
+// newTestVector returns the vector of 1, 2, null and 4
+func newTestVector() *vector.Vector {
+	vec := vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	vec.Col = []float64{1, 2, 3, 4}
+	nulls.Add(vec.Nsp, 2)
+	return vec
+}
+
+// fillTestRing fills the groups {1, 2, 4}, {4, 4, 1, 2}, {} and {1, 2, 1, 2}
+func fillTestRing(t *testing.T, r ring.Ring, vec *vector.Vector, m *mheap.Mheap) {
+	require.NoError(t, r.Grows(4, m))
+	require.NoError(t, r.Grow(m))
+	r.BulkFill(0, []int64{1, 1, 1, 1}, vec)
+	r.Fill(1, 3, 2, vec)
+	r.Fill(4, 0, 1, vec)
+
+	a := r.Dup()
+	require.NoError(t, a.Grows(1, m))
+	a.BulkFill(0, []int64{1, 1}, vec)
+	r.Add(a, 1, 0)
+	r.Mul(a, 3, 0, 2)
+	a.Free(m)
+
+	// the last group is removed
+	r.Shrink([]int64{0, 1, 2, 3})
+	require.Equal(t, 4, r.Count())
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/tdigest"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// NewTDigest returns the ring of approx_percentile(x, p)
+func NewTDigest(p float64, typ types.Type) *TDigestRing {
+	return &TDigestRing{P: p, Typ: typ}
+}
+
+var _ ring.Ring = (*TDigestRing)(nil)
+
+func (r *TDigestRing) String() string {
+	return fmt.Sprintf("tdigest-ring(%v, %d digests)", r.P, len(r.Ds))
+}
+
+func (r *TDigestRing) Free(m *mheap.Mheap) {
+	if r.Da != nil {
+		mheap.Free(m, r.Da)
+		r.Da = nil
+		r.Vs = nil
+		r.Ds = nil
+	}
+}
+
+func (r *TDigestRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *TDigestRing) Size() int {
+	return cap(r.Da)
+}
+
+func (r *TDigestRing) Dup() ring.Ring {
+	return &TDigestRing{
+		P:   r.P,
+		Typ: r.Typ,
+	}
+}
+
+func (r *TDigestRing) Type() types.Type {
+	return r.Typ
+}
+
+func (r *TDigestRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+	r.Ds = r.Ds[:n]
+}
+
+func (r *TDigestRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+		r.Ds[i] = r.Ds[sel]
+	}
+	r.Vs = r.Vs[:len(sels)]
+	r.Ds = r.Ds[:len(sels)]
+}
+
+func (r *TDigestRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *TDigestRing) Grow(m *mheap.Mheap) error {
+	return r.Grows(1, m)
+}
+
+func (r *TDigestRing) Grows(size int, m *mheap.Mheap) error {
+	var err error
+
+	if r.Da, r.Vs, err = grows(r.Da, r.Vs, size, m); err != nil {
+		return err
+	}
+	for i := 0; i < size; i++ {
+		r.Ds = append(r.Ds, tdigest.New(tdigest.DefaultCompression))
+	}
+	return nil
+}
+
+func (r *TDigestRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		return
+	}
+	r.Ds[i].Add(ring.Float64(vec, sel), float64(z))
+}
+
+func (r *TDigestRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		r.Fill(int64(vps[i]-1), int64(i)+start, zs[int64(i)+start], vec)
+	}
+}
+
+func (r *TDigestRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		r.Fill(i, int64(j), z, vec)
+	}
+}
+
+func (r *TDigestRing) Add(a interface{}, x, y int64) {
+	ar := a.(*TDigestRing)
+	r.Ds[x].Merge(ar.Ds[y])
+}
+
+func (r *TDigestRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	for i := range os {
+		r.Add(a, int64(vps[i]-1), int64(i)+start)
+	}
+}
+
+func (r *TDigestRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*TDigestRing)
+	d := tdigest.New(ar.Ds[y].Compression)
+	d.Merge(ar.Ds[y])
+	d.Scale(float64(z))
+	r.Ds[x].Merge(d)
+}
+
+func (r *TDigestRing) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		r.Da = nil
+		r.Vs = nil
+		r.Ds = nil
+	}()
+	nsp := new(nulls.Nulls)
+	for i, d := range r.Ds {
+		if d.Count() == 0 {
+			nulls.Add(nsp, uint64(i))
+			r.Vs[i] = 0
+			continue
+		}
+		r.Vs[i] = d.Quantile(r.P)
+	}
+	return &vector.Vector{
+		Nsp:  nsp,
+		Data: r.Da,
+		Col:  r.Vs,
+		Or:   false,
+		Typ:  types.Type{Oid: types.T_float64, Size: 8},
+	}
+}
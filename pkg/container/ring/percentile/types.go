@@ -0,0 +1,185 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+import (
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/container/tdigest"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// PercentileRing computes the exact percentile P of each group, such as the
+// median, by keeping all the non-null values of the group in Xs, the result
+// is interpolated linearly between the two nearest values. The values are
+// accounted in the guest memory of Mp as they are kept, so they count against
+// the memory limit of the query, and the ring stops keeping them and fails
+// with the error of Err once the limit is exceeded.
+type PercentileRing struct {
+	P    float64
+	Da   []byte
+	Vs   []float64
+	Xs   [][]float64
+	Typ  types.Type
+	Mp   *mheap.Mheap
+	size int64 // the size of the values of Xs accounted in Mp
+	err  error
+}
+
+// TDigestRing estimates the percentile P of each group by a t-digest of its
+// non-null values, whose memory does not grow with the number of values.
+type TDigestRing struct {
+	P   float64
+	Da  []byte
+	Vs  []float64
+	Ds  []*tdigest.TDigest
+	Typ types.Type
+}
+
+// impl Serialize & Deserialize for sql/protocol
+
+func (r *PercentileRing) Marshal(w io.Writer) error {
+	w.Write(encoding.EncodeFloat64(r.P))
+	n := len(r.Vs)
+	w.Write(encoding.EncodeUint32(uint32(n)))
+	if n > 0 {
+		w.Write(encoding.EncodeFloat64Slice(r.Vs))
+	}
+	for _, xs := range r.Xs {
+		w.Write(encoding.EncodeUint32(uint32(len(xs))))
+		if len(xs) > 0 {
+			w.Write(encoding.EncodeFloat64Slice(xs))
+		}
+	}
+	w.Write(encoding.EncodeType(r.Typ))
+	return nil
+}
+
+// Unmarshal builds PercentileRing from `data` and bytes in `data` is allowed to be reused directly
+func (r *PercentileRing) Unmarshal(data []byte) ([]byte, error) {
+	return r.unmarshal(data, nil)
+}
+
+// UnmarshalWithProc builds PercentileRing from `data` and bytes in `data` is *not* allowed to be reused directly
+func (r *PercentileRing) UnmarshalWithProc(data []byte, proc *process.Process) ([]byte, error) {
+	return r.unmarshal(data, proc)
+}
+
+func (r *PercentileRing) unmarshal(data []byte, proc *process.Process) ([]byte, error) {
+	var err error
+
+	r.P = encoding.DecodeFloat64(data[:8])
+	data = data[8:]
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if n > 0 {
+		if r.Da, r.Vs, err = decodeData(data[:n*8], proc); err != nil {
+			return nil, err
+		}
+		data = data[n*8:]
+	}
+	if proc != nil {
+		r.Mp = proc.Mp
+	}
+	r.Xs = make([][]float64, n)
+	for i := range r.Xs {
+		m := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if m > 0 {
+			if err = r.alloc(int64(m) * 8); err != nil {
+				return nil, err
+			}
+			// the values are appended, so they are always copied
+			r.Xs[i] = make([]float64, m)
+			copy(r.Xs[i], encoding.DecodeFloat64Slice(data[:m*8]))
+			data = data[m*8:]
+		}
+	}
+	r.Typ = encoding.DecodeType(data[:encoding.TypeSize])
+	return data[encoding.TypeSize:], nil
+}
+
+func (r *TDigestRing) Marshal(w io.Writer) error {
+	w.Write(encoding.EncodeFloat64(r.P))
+	n := len(r.Vs)
+	w.Write(encoding.EncodeUint32(uint32(n)))
+	if n > 0 {
+		w.Write(encoding.EncodeFloat64Slice(r.Vs))
+	}
+	for _, d := range r.Ds {
+		buf, err := d.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		w.Write(encoding.EncodeUint32(uint32(len(buf))))
+		w.Write(buf)
+	}
+	w.Write(encoding.EncodeType(r.Typ))
+	return nil
+}
+
+// Unmarshal builds TDigestRing from `data` and bytes in `data` is allowed to be reused directly
+func (r *TDigestRing) Unmarshal(data []byte) ([]byte, error) {
+	return r.unmarshal(data, nil)
+}
+
+// UnmarshalWithProc builds TDigestRing from `data` and bytes in `data` is *not* allowed to be reused directly
+func (r *TDigestRing) UnmarshalWithProc(data []byte, proc *process.Process) ([]byte, error) {
+	return r.unmarshal(data, proc)
+}
+
+func (r *TDigestRing) unmarshal(data []byte, proc *process.Process) ([]byte, error) {
+	var err error
+
+	r.P = encoding.DecodeFloat64(data[:8])
+	data = data[8:]
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if n > 0 {
+		if r.Da, r.Vs, err = decodeData(data[:n*8], proc); err != nil {
+			return nil, err
+		}
+		data = data[n*8:]
+	}
+	r.Ds = make([]*tdigest.TDigest, n)
+	for i := range r.Ds {
+		m := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		r.Ds[i] = tdigest.New(tdigest.DefaultCompression)
+		if err = r.Ds[i].UnmarshalBinary(data[:m]); err != nil {
+			return nil, err
+		}
+		data = data[m:]
+	}
+	r.Typ = encoding.DecodeType(data[:encoding.TypeSize])
+	return data[encoding.TypeSize:], nil
+}
+
+// decodeData returns the results of the groups, which are copied into the memory
+// of proc if proc is not nil.
+func decodeData(data []byte, proc *process.Process) ([]byte, []float64, error) {
+	if proc != nil {
+		da, err := mheap.Alloc(proc.Mp, int64(len(data)))
+		if err != nil {
+			return nil, nil, err
+		}
+		copy(da, data)
+		data = da
+	}
+	return data, encoding.DecodeFloat64Slice(data), nil
+}
@@ -0,0 +1,240 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sum
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// NewDecimal returns a ring summing decimals of typ, the sums are decimal128s
+// of the same scale as typ.
+func NewDecimal(typ types.Type) *DecimalRing {
+	return &DecimalRing{Typ: typ}
+}
+
+func (r *DecimalRing) String() string {
+	return fmt.Sprintf("%v-%v", r.Vs, r.Ns)
+}
+
+func (r *DecimalRing) Free(m *mheap.Mheap) {
+	if r.Da != nil {
+		mheap.Free(m, r.Da)
+		r.Da = nil
+		r.Vs = nil
+		r.Ns = nil
+	}
+}
+
+func (r *DecimalRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *DecimalRing) Size() int {
+	return cap(r.Da)
+}
+
+func (r *DecimalRing) Dup() ring.Ring {
+	return &DecimalRing{
+		Typ: r.Typ,
+	}
+}
+
+func (r *DecimalRing) Type() types.Type {
+	return r.Typ
+}
+
+func (r *DecimalRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+	r.Ns = r.Ns[:n]
+}
+
+func (r *DecimalRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+		r.Ns[i] = r.Ns[sel]
+	}
+	r.Vs = r.Vs[:len(sels)]
+	r.Ns = r.Ns[:len(sels)]
+}
+
+func (r *DecimalRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *DecimalRing) Grow(m *mheap.Mheap) error {
+	n := len(r.Vs)
+	if n == 0 {
+		data, err := mheap.Alloc(m, 8*16)
+		if err != nil {
+			return err
+		}
+		r.Da = data
+		r.Ns = make([]int64, 0, 8)
+		r.Vs = encoding.DecodeDecimal128Slice(data)
+	} else if n+1 >= cap(r.Vs) {
+		r.Da = r.Da[:n*16]
+		data, err := mheap.Grow(m, r.Da, int64(n+1)*16)
+		if err != nil {
+			return err
+		}
+		mheap.Free(m, r.Da)
+		r.Da = data
+		r.Vs = encoding.DecodeDecimal128Slice(data)
+	}
+	r.Vs = r.Vs[:n+1]
+	r.Vs[n] = types.Decimal128{}
+	r.Ns = append(r.Ns, 0)
+	return nil
+}
+
+func (r *DecimalRing) Grows(size int, m *mheap.Mheap) error {
+	n := len(r.Vs)
+	if n == 0 {
+		data, err := mheap.Alloc(m, int64(size*16))
+		if err != nil {
+			return err
+		}
+		r.Da = data
+		r.Ns = make([]int64, 0, size)
+		r.Vs = encoding.DecodeDecimal128Slice(data)
+	} else if n+size >= cap(r.Vs) {
+		r.Da = r.Da[:n*16]
+		data, err := mheap.Grow(m, r.Da, int64(n+size)*16)
+		if err != nil {
+			return err
+		}
+		mheap.Free(m, r.Da)
+		r.Da = data
+		r.Vs = encoding.DecodeDecimal128Slice(data)
+	}
+	r.Vs = r.Vs[:n+size]
+	for i := 0; i < size; i++ {
+		r.Ns = append(r.Ns, 0)
+		r.Vs[i+n] = types.Decimal128{}
+	}
+	return nil
+}
+
+func (r *DecimalRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		r.Ns[i] += z
+		return
+	}
+	r.Vs[i] = addDecimal(r.Vs[i], decimal128At(vec, sel), z)
+}
+
+func (r *DecimalRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		j, sel := vps[i]-1, int64(i)+start
+		if nulls.Contains(vec.Nsp, uint64(sel)) {
+			r.Ns[j] += zs[sel]
+			continue
+		}
+		r.Vs[j] = addDecimal(r.Vs[j], decimal128At(vec, sel), zs[sel])
+	}
+}
+
+func (r *DecimalRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		if nulls.Contains(vec.Nsp, uint64(j)) {
+			r.Ns[i] += z
+			continue
+		}
+		r.Vs[i] = addDecimal(r.Vs[i], decimal128At(vec, int64(j)), z)
+	}
+}
+
+func (r *DecimalRing) Add(a interface{}, x, y int64) {
+	ar := a.(*DecimalRing)
+	r.Vs[x] = addDecimal(r.Vs[x], ar.Vs[y], 1)
+	r.Ns[x] += ar.Ns[y]
+}
+
+func (r *DecimalRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	ar := a.(*DecimalRing)
+	for i := range os {
+		r.Vs[vps[i]-1] = addDecimal(r.Vs[vps[i]-1], ar.Vs[int64(i)+start], 1)
+		r.Ns[vps[i]-1] += ar.Ns[int64(i)+start]
+	}
+}
+
+// r[x] += a[y] * z
+func (r *DecimalRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*DecimalRing)
+	r.Ns[x] += ar.Ns[y] * z
+	r.Vs[x] = addDecimal(r.Vs[x], ar.Vs[y], z)
+}
+
+func (r *DecimalRing) Eval(zs []int64) *vector.Vector {
+	defer func() {
+		r.Da = nil
+		r.Vs = nil
+		r.Ns = nil
+	}()
+	nsp := new(nulls.Nulls)
+	for i, z := range zs {
+		if z-r.Ns[i] == 0 {
+			nulls.Add(nsp, uint64(i))
+		} else if r.Vs[i] == decimalOverflow {
+			r.err = types.ErrDecimalOverflow
+		}
+	}
+	return &vector.Vector{
+		Nsp:  nsp,
+		Data: r.Da,
+		Col:  r.Vs,
+		Or:   false,
+		Typ:  types.DecimalType(types.MaxDecimal128Precision, r.Typ.Precision),
+	}
+}
+
+// Err returns the error of the last evaluation if a sum is out of range.
+func (r *DecimalRing) Err() error {
+	return r.err
+}
+
+func decimal128At(vec *vector.Vector, i int64) types.Decimal128 {
+	if vs, ok := vec.Col.([]types.Decimal64); ok {
+		return vs[i].ToDecimal128()
+	}
+	return vec.Col.([]types.Decimal128)[i]
+}
+
+// decimalOverflow is the sum out of the range of 38 digits
+var decimalOverflow = types.Decimal128{Lo: -1, Hi: math.MaxInt64}
+
+// addDecimal returns x + y * z, and the sums out of the range of 38 digits
+// stay out of the range so that their evaluation fails.
+func addDecimal(x, y types.Decimal128, z int64) types.Decimal128 {
+	if x == decimalOverflow {
+		return x
+	}
+	y, err := y.MulInt64(z)
+	if err != nil {
+		return decimalOverflow
+	}
+	if x, err = x.Add(y); err != nil {
+		return decimalOverflow
+	}
+	return x
+}
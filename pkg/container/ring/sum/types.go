@@ -36,3 +36,11 @@ type FloatRing struct {
 	Vs  []float64
 	Typ types.Type
 }
+
+type DecimalRing struct {
+	Da  []byte
+	Ns  []int64
+	Vs  []types.Decimal128
+	Typ types.Type
+	err error // the sum of a group is out of range
+}
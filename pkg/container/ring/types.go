@@ -20,6 +20,20 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
 
+// Eval evaluates the ring r, and returns the error of a ring whose
+// aggregation has failed, such as a sum of decimals out of range.
+func Eval(r Ring, zs []int64, m *mheap.Mheap) (*vector.Vector, error) {
+	vec := r.Eval(zs)
+	if fr, ok := r.(interface{ Err() error }); ok {
+		if err := fr.Err(); err != nil {
+			// the vector is not referred to yet
+			vector.Clean(vec, m)
+			return nil, err
+		}
+	}
+	return vec, nil
+}
+
 type Ring interface {
 	Count() int
 
@@ -0,0 +1,63 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ring
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// Float64 returns the sel-th value of a numeric vector as float64, it is
+// used by the statistical aggregations which compute in float64.
+func Float64(vec *vector.Vector, sel int64) float64 {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return float64(vs[sel])
+	case []int16:
+		return float64(vs[sel])
+	case []int32:
+		return float64(vs[sel])
+	case []int64:
+		return float64(vs[sel])
+	case []uint8:
+		return float64(vs[sel])
+	case []uint16:
+		return float64(vs[sel])
+	case []uint32:
+		return float64(vs[sel])
+	case []uint64:
+		return float64(vs[sel])
+	case []float32:
+		return float64(vs[sel])
+	case []float64:
+		return vs[sel]
+	case []types.Decimal64:
+		return vs[sel].ToFloat64(vec.Typ.Precision)
+	case []types.Decimal128:
+		return vs[sel].ToFloat64(vec.Typ.Precision)
+	}
+	return 0
+}
+
+// IsNumeric returns true if the values of typ can be read by Float64
+func IsNumeric(typ types.T) bool {
+	switch typ {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128:
+		return true
+	}
+	return false
+}
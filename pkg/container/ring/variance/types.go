@@ -0,0 +1,98 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package variance
+
+import (
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	VarPop = iota
+	VarSamp
+	StdDevPop
+	StdDevSamp
+)
+
+// VarianceRing computes the variance or the standard deviation of each group,
+// Vs is the mean of the non-null values, Ns is their number and Ms is the sum
+// of squares of their differences from the mean, which are updated by Welford's
+// algorithm so that the groups can be merged without losing precision.
+type VarianceRing struct {
+	Kind int
+	Da   []byte
+	Ns   []int64
+	Vs   []float64
+	Ms   []float64
+	Typ  types.Type
+}
+
+// impl Serialize & Deserialize for sql/protocol
+
+func (r *VarianceRing) Marshal(w io.Writer) error {
+	w.Write(encoding.EncodeUint32(uint32(r.Kind)))
+	n := len(r.Vs)
+	w.Write(encoding.EncodeUint32(uint32(n)))
+	if n > 0 {
+		w.Write(encoding.EncodeFloat64Slice(r.Vs))
+		w.Write(encoding.EncodeInt64Slice(r.Ns))
+		w.Write(encoding.EncodeFloat64Slice(r.Ms))
+	}
+	w.Write(encoding.EncodeType(r.Typ))
+	return nil
+}
+
+// Unmarshal builds VarianceRing from `data` and bytes in `data` is allowed to be reused directly
+func (r *VarianceRing) Unmarshal(data []byte) ([]byte, error) {
+	return r.unmarshal(data, nil)
+}
+
+// UnmarshalWithProc builds VarianceRing from `data` and bytes in `data` is *not* allowed to be reused directly
+func (r *VarianceRing) UnmarshalWithProc(data []byte, proc *process.Process) ([]byte, error) {
+	return r.unmarshal(data, proc)
+}
+
+func (r *VarianceRing) unmarshal(data []byte, proc *process.Process) ([]byte, error) {
+	r.Kind = int(encoding.DecodeUint32(data[:4]))
+	data = data[4:]
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	if n > 0 {
+		if proc == nil {
+			r.Da = data[:n*8]
+		} else {
+			var err error
+
+			if r.Da, err = mheap.Alloc(proc.Mp, int64(n*8)); err != nil {
+				return nil, err
+			}
+			copy(r.Da, data[:n*8])
+		}
+		r.Vs = encoding.DecodeFloat64Slice(r.Da)
+		data = data[n*8:]
+		r.Ns = make([]int64, n)
+		copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+		data = data[n*8:]
+		r.Ms = make([]float64, n)
+		copy(r.Ms, encoding.DecodeFloat64Slice(data[:n*8]))
+		data = data[n*8:]
+	}
+	r.Typ = encoding.DecodeType(data[:encoding.TypeSize])
+	return data[encoding.TypeSize:], nil
+}
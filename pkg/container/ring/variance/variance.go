@@ -0,0 +1,202 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package variance
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// NewVariance returns the ring of var_pop, var_samp, stddev_pop or stddev_samp
+func NewVariance(kind int, typ types.Type) *VarianceRing {
+	return &VarianceRing{Kind: kind, Typ: typ}
+}
+
+var _ ring.Ring = (*VarianceRing)(nil)
+
+func (r *VarianceRing) String() string {
+	return fmt.Sprintf("%v-%v-%v", r.Vs, r.Ns, r.Ms)
+}
+
+func (r *VarianceRing) Free(m *mheap.Mheap) {
+	if r.Da != nil {
+		mheap.Free(m, r.Da)
+		r.Da = nil
+		r.Vs = nil
+		r.Ns = nil
+		r.Ms = nil
+	}
+}
+
+func (r *VarianceRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *VarianceRing) Size() int {
+	return cap(r.Da)
+}
+
+func (r *VarianceRing) Dup() ring.Ring {
+	return &VarianceRing{
+		Kind: r.Kind,
+		Typ:  r.Typ,
+	}
+}
+
+func (r *VarianceRing) Type() types.Type {
+	return r.Typ
+}
+
+func (r *VarianceRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+	r.Ns = r.Ns[:n]
+	r.Ms = r.Ms[:n]
+}
+
+func (r *VarianceRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+		r.Ns[i] = r.Ns[sel]
+		r.Ms[i] = r.Ms[sel]
+	}
+	r.Vs = r.Vs[:len(sels)]
+	r.Ns = r.Ns[:len(sels)]
+	r.Ms = r.Ms[:len(sels)]
+}
+
+func (r *VarianceRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *VarianceRing) Grow(m *mheap.Mheap) error {
+	return r.Grows(1, m)
+}
+
+func (r *VarianceRing) Grows(size int, m *mheap.Mheap) error {
+	n := len(r.Vs)
+	if n == 0 {
+		data, err := mheap.Alloc(m, int64(size*8))
+		if err != nil {
+			return err
+		}
+		r.Da = data
+		r.Ns = make([]int64, 0, size)
+		r.Ms = make([]float64, 0, size)
+		r.Vs = encoding.DecodeFloat64Slice(data)
+	} else if n+size >= cap(r.Vs) {
+		r.Da = r.Da[:n*8]
+		data, err := mheap.Grow(m, r.Da, int64(n+size)*8)
+		if err != nil {
+			return err
+		}
+		mheap.Free(m, r.Da)
+		r.Da = data
+		r.Vs = encoding.DecodeFloat64Slice(data)
+	}
+	r.Vs = r.Vs[:n+size]
+	for i := 0; i < size; i++ {
+		r.Vs[n+i] = 0
+		r.Ns = append(r.Ns, 0)
+		r.Ms = append(r.Ms, 0)
+	}
+	return nil
+}
+
+func (r *VarianceRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		return
+	}
+	r.merge(i, z, ring.Float64(vec, sel), 0)
+}
+
+func (r *VarianceRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		r.Fill(int64(vps[i]-1), int64(i)+start, zs[int64(i)+start], vec)
+	}
+}
+
+func (r *VarianceRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		r.Fill(i, int64(j), z, vec)
+	}
+}
+
+func (r *VarianceRing) Add(a interface{}, x, y int64) {
+	ar := a.(*VarianceRing)
+	r.merge(x, ar.Ns[y], ar.Vs[y], ar.Ms[y])
+}
+
+func (r *VarianceRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	for i := range os {
+		r.Add(a, int64(vps[i]-1), int64(i)+start)
+	}
+}
+
+// r[x] += a[y] * z, the z copies of a group have the same mean
+// and z times the sum of squares.
+func (r *VarianceRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*VarianceRing)
+	r.merge(x, ar.Ns[y]*z, ar.Vs[y], ar.Ms[y]*float64(z))
+}
+
+func (r *VarianceRing) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		r.Da = nil
+		r.Vs = nil
+		r.Ns = nil
+		r.Ms = nil
+	}()
+	nsp := new(nulls.Nulls)
+	for i, n := range r.Ns {
+		if r.Kind == VarSamp || r.Kind == StdDevSamp {
+			n--
+		}
+		if n <= 0 {
+			nulls.Add(nsp, uint64(i))
+			r.Vs[i] = 0
+			continue
+		}
+		r.Vs[i] = r.Ms[i] / float64(n)
+		if r.Kind == StdDevPop || r.Kind == StdDevSamp {
+			r.Vs[i] = math.Sqrt(r.Vs[i])
+		}
+	}
+	return &vector.Vector{
+		Nsp:  nsp,
+		Data: r.Da,
+		Col:  r.Vs,
+		Or:   false,
+		Typ:  types.Type{Oid: types.T_float64, Size: 8},
+	}
+}
+
+// merge adds n values whose mean is mean and sum of squares is m2 to the i-th group
+func (r *VarianceRing) merge(i int64, n int64, mean, m2 float64) {
+	if n == 0 {
+		return
+	}
+	total := r.Ns[i] + n
+	d := mean - r.Vs[i]
+	r.Ms[i] += m2 + d*d*float64(r.Ns[i])*float64(n)/float64(total)
+	r.Vs[i] += d * float64(n) / float64(total)
+	r.Ns[i] = total
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package variance
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVarianceRing(t *testing.T) {
+	m := mheap.New(guest.New(1<<20, host.New(1<<20)))
+	// the third value is null
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	vec.Col = []int64{1, 2, 3, 4}
+	nulls.Add(vec.Nsp, 2)
+
+	// the groups are {1, 2, 4}, {4, 4, 1, 2}, {}, {1, 2, 1, 2} and {4}
+	pops := []float64{14.0 / 9, 27.0 / 16, 0, 1.0 / 4, 0}
+	samps := []float64{7.0 / 3, 9.0 / 4, 0, 1.0 / 3, 0}
+	kases := []struct {
+		kind  int
+		rs    []float64
+		nulls []uint64
+	}{
+		{VarPop, pops, []uint64{2}},
+		{VarSamp, samps, []uint64{2, 4}},
+		{StdDevPop, sqrts(pops), []uint64{2}},
+		{StdDevSamp, sqrts(samps), []uint64{2, 4}},
+	}
+	for _, kase := range kases {
+		r := NewVariance(kase.kind, vec.Typ)
+		require.NoError(t, r.Grows(4, m))
+		require.NoError(t, r.Grow(m))
+		require.NoError(t, r.Grow(m))
+		r.BulkFill(0, []int64{1, 1, 1, 1}, vec)
+		r.Fill(1, 3, 2, vec)
+		r.Fill(4, 3, 1, vec)
+		r.Fill(5, 0, 1, vec)
+
+		a := r.Dup().(*VarianceRing)
+		require.NoError(t, a.Grows(1, m))
+		a.BulkFill(0, []int64{1, 1}, vec)
+		r.Add(a, 1, 0)
+		r.Mul(a, 3, 0, 2)
+		a.Free(m)
+
+		// the last group is removed
+		r.Shrink([]int64{0, 1, 2, 3, 4})
+		require.Equal(t, 5, r.Count())
+		rs, err := ring.Eval(r, nil, m)
+		require.NoError(t, err)
+		require.InDeltaSlice(t, kase.rs, rs.Col.([]float64), 1e-9)
+		require.Equal(t, kase.nulls, rs.Nsp.Np.ToArray())
+		vector.Clean(rs, m)
+	}
+	require.Equal(t, int64(0), mheap.Size(m))
+}
+
+func sqrts(xs []float64) []float64 {
+	rs := make([]float64, len(xs))
+	for i, x := range xs {
+		rs[i] = math.Sqrt(x)
+	}
+	return rs
+}
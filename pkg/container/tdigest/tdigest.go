@@ -0,0 +1,189 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tdigest
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+// New returns an empty digest of the given compression, a digest keeps about
+// compression centroids.
+func New(compression float64) *TDigest {
+	if compression <= 0 {
+		compression = DefaultCompression
+	}
+	return &TDigest{
+		Compression: compression,
+		Min:         math.Inf(1),
+		Max:         math.Inf(-1),
+	}
+}
+
+// Count returns the total weight of the digest
+func (t *TDigest) Count() float64 {
+	t.compress()
+	return t.count
+}
+
+// Add adds x of weight w to the digest
+func (t *TDigest) Add(x float64, w float64) {
+	if w <= 0 || math.IsNaN(x) {
+		return
+	}
+	if x < t.Min {
+		t.Min = x
+	}
+	if x > t.Max {
+		t.Max = x
+	}
+	t.buf = append(t.buf, Centroid{Mean: x, Weight: w})
+	if float64(len(t.buf)) > t.Compression*bufferFactor {
+		t.compress()
+	}
+}
+
+// Merge adds the centroids of o to the digest
+func (t *TDigest) Merge(o *TDigest) {
+	o.compress()
+	if o.Min < t.Min {
+		t.Min = o.Min
+	}
+	if o.Max > t.Max {
+		t.Max = o.Max
+	}
+	t.buf = append(t.buf, o.cs...)
+	t.compress()
+}
+
+// Scale multiplies the weights of the digest by z, it is the digest of
+// z copies of the values.
+func (t *TDigest) Scale(z float64) {
+	t.compress()
+	for i := range t.cs {
+		t.cs[i].Weight *= z
+	}
+	t.count *= z
+}
+
+// Quantile returns the estimated q quantile of the values, it is NaN
+// if the digest is empty.
+func (t *TDigest) Quantile(q float64) float64 {
+	t.compress()
+	switch {
+	case len(t.cs) == 0:
+		return math.NaN()
+	case len(t.cs) == 1 || q <= 0:
+		if q >= 1 {
+			return t.Max
+		}
+		if len(t.cs) == 1 {
+			return t.cs[0].Mean
+		}
+		return t.Min
+	case q >= 1:
+		return t.Max
+	}
+	// the mean of a centroid is taken as the value at the center of its weight,
+	// values between two centers are interpolated linearly.
+	index := q * t.count
+	if first := t.cs[0]; index < first.Weight/2 {
+		return t.Min + (first.Mean-t.Min)*index/(first.Weight/2)
+	}
+	cum := 0.0
+	for i := 0; i < len(t.cs)-1; i++ {
+		c, next := t.cs[i], t.cs[i+1]
+		left, right := cum+c.Weight/2, cum+c.Weight+next.Weight/2
+		if index <= right {
+			return c.Mean + (next.Mean-c.Mean)*(index-left)/(right-left)
+		}
+		cum += c.Weight
+	}
+	last := t.cs[len(t.cs)-1]
+	if rest := t.count - index; rest < last.Weight/2 {
+		return t.Max - (t.Max-last.Mean)*rest/(last.Weight/2)
+	}
+	return last.Mean
+}
+
+// compress merges the buffered values into the centroids, two adjacent
+// centroids are merged if the weight of the result is under the bound of
+// its quantile, so the centroids near the tails are kept small.
+func (t *TDigest) compress() {
+	if len(t.buf) == 0 {
+		return
+	}
+	cs := append(t.cs, t.buf...)
+	sort.Slice(cs, func(i, j int) bool { return cs[i].Mean < cs[j].Mean })
+	total := 0.0
+	for _, c := range cs {
+		total += c.Weight
+	}
+	rs := make([]Centroid, 0, len(cs))
+	cur, sofar := cs[0], 0.0
+	for _, c := range cs[1:] {
+		q := (sofar + (cur.Weight+c.Weight)/2) / total
+		if cur.Weight+c.Weight <= 4*total*q*(1-q)/t.Compression {
+			cur.Weight += c.Weight
+			cur.Mean += (c.Mean - cur.Mean) * c.Weight / cur.Weight
+			continue
+		}
+		sofar += cur.Weight
+		rs = append(rs, cur)
+		cur = c
+	}
+	t.cs, t.buf, t.count = append(rs, cur), nil, total
+}
+
+// MarshalBinary returns the binary form of the digest
+func (t *TDigest) MarshalBinary() ([]byte, error) {
+	t.compress()
+	data := make([]byte, 0, 28+len(t.cs)*16)
+	data = append(data, encoding.EncodeFloat64(t.Compression)...)
+	data = append(data, encoding.EncodeFloat64(t.Min)...)
+	data = append(data, encoding.EncodeFloat64(t.Max)...)
+	data = append(data, encoding.EncodeUint32(uint32(len(t.cs)))...)
+	for _, c := range t.cs {
+		data = append(data, encoding.EncodeFloat64(c.Mean)...)
+		data = append(data, encoding.EncodeFloat64(c.Weight)...)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary restores the digest from data returned by MarshalBinary
+func (t *TDigest) UnmarshalBinary(data []byte) error {
+	if len(data) < 28 {
+		return errors.New("tdigest: data is too short")
+	}
+	t.Compression = encoding.DecodeFloat64(data[:8])
+	t.Min = encoding.DecodeFloat64(data[8:16])
+	t.Max = encoding.DecodeFloat64(data[16:24])
+	n := int(encoding.DecodeUint32(data[24:28]))
+	data = data[28:]
+	if len(data) != n*16 {
+		return errors.New("tdigest: data is corrupted")
+	}
+	t.cs, t.buf, t.count = make([]Centroid, n), nil, 0
+	for i := range t.cs {
+		t.cs[i].Mean = encoding.DecodeFloat64(data[:8])
+		t.cs[i].Weight = encoding.DecodeFloat64(data[8:16])
+		t.count += t.cs[i].Weight
+		data = data[16:]
+	}
+	return nil
+}
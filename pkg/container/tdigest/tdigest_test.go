@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tdigest
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuantile(t *testing.T) {
+	d := New(DefaultCompression)
+	require.True(t, math.IsNaN(d.Quantile(0.5)))
+	d.Add(7, 1)
+	require.Equal(t, float64(7), d.Quantile(0.5))
+
+	// two digests of the halves are merged
+	x, y := New(DefaultCompression), New(DefaultCompression)
+	for _, i := range rand.New(rand.NewSource(1)).Perm(100000) {
+		if i%2 == 0 {
+			x.Add(float64(i), 1)
+		} else {
+			y.Add(float64(i), 1)
+		}
+	}
+	x.Merge(y)
+	require.Equal(t, float64(100000), x.Count())
+	require.Equal(t, float64(0), x.Quantile(0))
+	require.Equal(t, float64(99999), x.Quantile(1))
+	for _, q := range []float64{0.01, 0.25, 0.5, 0.75, 0.99} {
+		require.InDelta(t, q*100000, x.Quantile(q), 100000*0.005)
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	d := New(50)
+	for i := 0; i < 1000; i++ {
+		d.Add(float64(i%100), 2)
+	}
+	data, err := d.MarshalBinary()
+	require.NoError(t, err)
+	e := New(DefaultCompression)
+	require.NoError(t, e.UnmarshalBinary(data))
+	require.Equal(t, d.Count(), e.Count())
+	require.Equal(t, d.Compression, e.Compression)
+	require.Equal(t, d.Quantile(0.3), e.Quantile(0.3))
+	require.Error(t, e.UnmarshalBinary(data[:10]))
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tdigest
+
+const (
+	// DefaultCompression is the compression used if none is given
+	DefaultCompression = 100
+	// the values are buffered until there are bufferFactor * compression of them
+	bufferFactor = 5
+)
+
+// Centroid is the mean of Weight values
+type Centroid struct {
+	Mean   float64
+	Weight float64
+}
+
+// TDigest is a merging t-digest which estimates the quantiles of a stream
+// of values with a bounded memory, the estimation is more accurate near the
+// tails. Digests can be merged, so one is built for each part of the data
+// and the results are merged.
+type TDigest struct {
+	Compression float64
+	Min         float64
+	Max         float64
+	count       float64
+	cs          []Centroid
+	buf         []Centroid
+}
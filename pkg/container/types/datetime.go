@@ -18,7 +18,6 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
-	"strconv"
 	"time"
 	"unsafe"
 )
@@ -100,11 +99,11 @@ func ParseDatetime(s string) (Datetime, error) {
 		}
 		if len(s) > 19 {
 			if len(s) > 20 && s[19] == '.' {
-				m, err := strconv.ParseUint(s[20:], 10, 32)
+				m, err := parseMicrosecond(s[20:])
 				if err != nil {
 					return -1, errIncorrectDatetimeValue
 				}
-				msec = uint32(m)
+				msec = m
 			} else {
 				return -1, errIncorrectDatetimeValue
 			}
@@ -117,11 +116,11 @@ func ParseDatetime(s string) (Datetime, error) {
 		second = (s[12]-'0') * 10 + (s[13]-'0')
 		if len(s) > 14 {
 			if len(s) > 15 && s[14] == '.' {
-				m, err := strconv.ParseUint(s[15:], 10, 32)
+				m, err := parseMicrosecond(s[15:])
 				if err != nil {
 					return -1, errIncorrectDatetimeValue
 				}
-				msec = uint32(m)
+				msec = m
 			} else {
 				return -1, errIncorrectDatetimeValue
 			}
@@ -130,6 +129,31 @@ func ParseDatetime(s string) (Datetime, error) {
 	return FromClock(year, month, day, hour, minute, second, msec), nil
 }
 
+// parseMicrosecond parses the fractional part of seconds, digits after
+// the sixth are truncated
+func parseMicrosecond(s string) (uint32, error) {
+	var m uint32
+
+	if len(s) == 0 {
+		return 0, errIncorrectDatetimeValue
+	}
+	for i := 0; i < 6; i++ {
+		m *= 10
+		if i < len(s) {
+			if s[i] < '0' || s[i] > '9' {
+				return 0, errIncorrectDatetimeValue
+			}
+			m += uint32(s[i] - '0')
+		}
+	}
+	for i := 6; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, errIncorrectDatetimeValue
+		}
+	}
+	return m, nil
+}
+
 // validTimeInDay return true if hour, minute and second can be a time during a day
 func validTimeInDay(h, m, s uint8) bool {
 	if h < minHourInDay || h > maxHourInDay {
@@ -184,3 +208,27 @@ func (dt Datetime) sec() int64 {
 func (dt Datetime) Year() uint16 {
 	return dt.ToDate().Year()
 }
+
+// Microsec returns the microseconds within the second of dt
+func (dt Datetime) Microsec() uint32 {
+	return uint32(dt & (1<<20 - 1))
+}
+
+// TimeOfDay returns the time elapsed since the midnight of dt
+func (dt Datetime) TimeOfDay() Time {
+	hour, min, sec := dt.Clock()
+	return FromTimeClock(false, uint32(hour), uint8(min), uint8(sec), dt.Microsec())
+}
+
+// GoTime returns a time.Time in UTC whose calendar and clock are the same as dt
+func (dt Datetime) GoTime() time.Time {
+	y, m, d, _ := dt.ToDate().Calendar(true)
+	hour, min, sec := dt.Clock()
+	return time.Date(int(y), time.Month(m), int(d), int(hour), int(min), int(sec), int(dt.Microsec())*1000, time.UTC)
+}
+
+// FromGoTime returns the Datetime whose calendar and clock are the same as t in its location
+func FromGoTime(t time.Time) Datetime {
+	y, m, d := t.Date()
+	return FromClock(int32(y), uint8(m), uint8(d), uint8(t.Hour()), uint8(t.Minute()), uint8(t.Second()), uint32(t.Nanosecond()/1000))
+}
@@ -14,6 +14,520 @@
 
 package types
 
-func (a Decimal) String() string {
-	return ""
+import (
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+const (
+	MaxDecimal64Precision  = 18
+	MaxDecimal128Precision = 38
+
+	// DefaultDecimalWidth is the precision of DECIMAL without (M, D)
+	DefaultDecimalWidth = 10
+	// DecimalDivScaleIncrement is the number of digits added to the
+	// scale of the dividend, the same as mysql's div_precision_increment
+	DecimalDivScaleIncrement = 4
+)
+
+var (
+	ErrDecimalOverflow       = errors.New(errno.DataException, "Decimal value is out of range")
+	errIncorrectDecimalValue = errors.New(errno.DataException, "Incorrect decimal value")
+
+	// pow10s[i] = 10^i, i in [0, 38]
+	pow10s [MaxDecimal128Precision + 1]Decimal128
+)
+
+func init() {
+	pow10s[0] = Decimal128{Lo: 1}
+	for i := 1; i < len(pow10s); i++ {
+		hi, lo, _ := mulUint64(uint64(pow10s[i-1].Hi), uint64(pow10s[i-1].Lo), 10)
+		pow10s[i] = Decimal128{Lo: int64(lo), Hi: int64(hi)}
+	}
+}
+
+// DecimalType returns the type of DECIMAL(width, scale), decimal64 is used
+// if all the digits can be hold in 64 bits.
+func DecimalType(width, scale int32) Type {
+	if width <= MaxDecimal64Precision {
+		return Type{Oid: T_decimal64, Size: 8, Width: width, Precision: scale}
+	}
+	return Type{Oid: T_decimal128, Size: 16, Width: width, Precision: scale}
+}
+
+// ParseStringToDecimal64 parses a string like '-12.345' or '1.2e3'
+// to be a decimal64 of the given width and scale, redundant digits are rounded.
+func ParseStringToDecimal64(s string, width, scale int32) (Decimal64, error) {
+	v, err := ParseStringToDecimal128(s, width, scale)
+	if err != nil {
+		return 0, err
+	}
+	return v.ToDecimal64()
+}
+
+// ParseStringToDecimal128 parses a string to be a decimal128 of the given width and scale.
+func ParseStringToDecimal128(s string, width, scale int32) (Decimal128, error) {
+	s = strings.TrimSpace(s)
+	neg := false
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Decimal128{}, errIncorrectDecimalValue
+		}
+		s = s[:i]
+		v, err := parseDigits(s, scale+int32(e))
+		if err != nil {
+			return Decimal128{}, err
+		}
+		return makeDecimal128(v, neg, width)
+	}
+	v, err := parseDigits(s, scale)
+	if err != nil {
+		return Decimal128{}, err
+	}
+	return makeDecimal128(v, neg, width)
+}
+
+// parseDigits returns the unsigned digits of s multiplied by 10^scale.
+func parseDigits(s string, scale int32) (string, error) {
+	ip, fp := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		ip, fp = s[:i], s[i+1:]
+	}
+	if len(ip)+len(fp) == 0 {
+		return "", errIncorrectDecimalValue
+	}
+	for i := 0; i < len(ip); i++ {
+		if ip[i] < '0' || ip[i] > '9' {
+			return "", errIncorrectDecimalValue
+		}
+	}
+	for i := 0; i < len(fp); i++ {
+		if fp[i] < '0' || fp[i] > '9' {
+			return "", errIncorrectDecimalValue
+		}
+	}
+	ds := strings.TrimLeft(ip+fp, "0")
+	exp := int(scale) - len(fp)
+	switch {
+	case len(ds) == 0:
+		return "0", nil
+	case exp > 0:
+		if len(ds)+exp > MaxDecimal128Precision {
+			return "", ErrDecimalOverflow
+		}
+		ds += strings.Repeat("0", exp)
+	case exp < 0:
+		if -exp > len(ds) {
+			return "0", nil
+		}
+		dropped := ds[len(ds)+exp:]
+		if ds = ds[:len(ds)+exp]; dropped[0] >= '5' {
+			ds = roundUpDigits(ds)
+		}
+		if ds = strings.TrimLeft(ds, "0"); len(ds) == 0 {
+			return "0", nil
+		}
+	}
+	return ds, nil
+}
+
+func roundUpDigits(ds string) string {
+	bs := []byte(ds)
+	for i := len(bs) - 1; i >= 0; i-- {
+		if bs[i] < '9' {
+			bs[i]++
+			return string(bs)
+		}
+		bs[i] = '0'
+	}
+	return "1" + string(bs)
+}
+
+func makeDecimal128(ds string, neg bool, width int32) (Decimal128, error) {
+	if len(ds) > int(width) || len(ds) > MaxDecimal128Precision {
+		return Decimal128{}, ErrDecimalOverflow
+	}
+	var hi, lo uint64
+	for i := 0; i < len(ds); i++ {
+		hi, lo, _ = mulUint64(hi, lo, 10)
+		var c uint64
+		lo, c = bits.Add64(lo, uint64(ds[i]-'0'), 0)
+		hi += c
+	}
+	v := Decimal128{Lo: int64(lo), Hi: int64(hi)}
+	if neg {
+		v = v.Neg()
+	}
+	return v, nil
+}
+
+// Decimal64FromInt64 returns v as a decimal64 of the given width and scale
+func Decimal64FromInt64(v int64, width, scale int32) (Decimal64, error) {
+	r, err := Decimal128FromInt64(v, width, scale)
+	if err != nil {
+		return 0, err
+	}
+	return r.ToDecimal64()
+}
+
+// Decimal128FromInt64 returns v as a decimal128 of the given width and scale
+func Decimal128FromInt64(v int64, width, scale int32) (Decimal128, error) {
+	r, err := Decimal64(v).ToDecimal128().Scale(scale)
+	if err != nil {
+		return r, err
+	}
+	return r, r.checkWidth(width)
+}
+
+// Decimal128FromUint64 returns v as a decimal128 of the given width and scale
+func Decimal128FromUint64(v uint64, width, scale int32) (Decimal128, error) {
+	r, err := Decimal128{Lo: int64(v)}.Scale(scale)
+	if err != nil {
+		return r, err
+	}
+	return r, r.checkWidth(width)
+}
+
+// Decimal64FromFloat64 returns f as a decimal64 of the given width and scale
+func Decimal64FromFloat64(f float64, width, scale int32) (Decimal64, error) {
+	return ParseStringToDecimal64(strconv.FormatFloat(f, 'f', -1, 64), width, scale)
+}
+
+// Decimal128FromFloat64 returns f as a decimal128 of the given width and scale
+func Decimal128FromFloat64(f float64, width, scale int32) (Decimal128, error) {
+	return ParseStringToDecimal128(strconv.FormatFloat(f, 'f', -1, 64), width, scale)
+}
+
+func (a Decimal64) ToDecimal128() Decimal128 {
+	if a < 0 {
+		return Decimal128{Lo: int64(a), Hi: -1}
+	}
+	return Decimal128{Lo: int64(a)}
+}
+
+// Scale multiplies a by 10^n, a negative n means to divide a by 10^-n with rounding.
+func (a Decimal64) Scale(n int32) (Decimal64, error) {
+	r, err := a.ToDecimal128().Scale(n)
+	if err != nil {
+		return 0, err
+	}
+	return r.ToDecimal64()
+}
+
+// ToInt64 returns the integer part of a rounded half away from zero
+func (a Decimal64) ToInt64(scale int32) int64 {
+	r, _ := a.Scale(-scale)
+	return int64(r)
+}
+
+func (a Decimal64) ToFloat64(scale int32) float64 {
+	if scale == 0 {
+		return float64(a)
+	}
+	f, _ := strconv.ParseFloat(a.ToString(scale), 64)
+	return f
+}
+
+func (a Decimal64) ToString(scale int32) string {
+	return a.ToDecimal128().ToString(scale)
+}
+
+func (a Decimal64) Compare(b Decimal64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Mul returns the exact product of a and b, its scale is the sum of the scales
+func (a Decimal64) Mul(b Decimal64) Decimal128 {
+	neg := (a < 0) != (b < 0)
+	hi, lo := bits.Mul64(abs64(int64(a)), abs64(int64(b)))
+	r := Decimal128{Lo: int64(lo), Hi: int64(hi)}
+	if neg {
+		return r.Neg()
+	}
+	return r
+}
+
+// abs64 returns the absolute value of a, the one of math.MinInt64 is 1 << 63
+func abs64(a int64) uint64 {
+	if a < 0 {
+		return uint64(-(a + 1)) + 1
+	}
+	return uint64(a)
+}
+
+func (a Decimal128) ToDecimal64() (Decimal64, error) {
+	if (a.Hi == 0 && a.Lo >= 0) || (a.Hi == -1 && a.Lo < 0) {
+		return Decimal64(a.Lo), nil
+	}
+	return 0, ErrDecimalOverflow
+}
+
+// ToInt64 returns the integer part of a rounded half away from zero
+func (a Decimal128) ToInt64(scale int32) (int64, error) {
+	r, err := a.Scale(-scale)
+	if err != nil {
+		return 0, err
+	}
+	v, err := r.ToDecimal64()
+	return int64(v), err
+}
+
+func (a Decimal128) ToFloat64(scale int32) float64 {
+	f, _ := strconv.ParseFloat(a.ToString(scale), 64)
+	return f
+}
+
+func (a Decimal128) ToString(scale int32) string {
+	hi, lo := a.abs()
+	var buf [48]byte
+	i := len(buf)
+	for n := int32(0); hi != 0 || lo != 0 || n <= scale; n++ {
+		if n == scale && n > 0 {
+			i--
+			buf[i] = '.'
+		}
+		var r uint64
+		hi, lo, r = divUint64(hi, lo, 10)
+		i--
+		buf[i] = byte('0' + r)
+	}
+	if a.Hi < 0 {
+		i--
+		buf[i] = '-'
+	}
+	return string(buf[i:])
+}
+
+func (a Decimal128) Compare(b Decimal128) int {
+	switch {
+	case a.Hi < b.Hi:
+		return -1
+	case a.Hi > b.Hi:
+		return 1
+	case uint64(a.Lo) < uint64(b.Lo):
+		return -1
+	case uint64(a.Lo) > uint64(b.Lo):
+		return 1
+	}
+	return 0
+}
+
+func (a Decimal128) Neg() Decimal128 {
+	lo, c := bits.Add64(^uint64(a.Lo), 1, 0)
+	return Decimal128{Lo: int64(lo), Hi: ^a.Hi + int64(c)}
+}
+
+// Add returns a + b, an error is returned if the sum has more than 38 digits
+func (a Decimal128) Add(b Decimal128) (Decimal128, error) {
+	lo, c := bits.Add64(uint64(a.Lo), uint64(b.Lo), 0)
+	hi, _ := bits.Add64(uint64(a.Hi), uint64(b.Hi), c)
+	r := Decimal128{Lo: int64(lo), Hi: int64(hi)}
+	// the sum of two numbers of the same sign wraps around
+	if (a.Hi < 0) == (b.Hi < 0) && (r.Hi < 0) != (a.Hi < 0) {
+		return Decimal128{}, ErrDecimalOverflow
+	}
+	return r, r.checkWidth(MaxDecimal128Precision)
+}
+
+// Sub returns a - b, an error is returned if the difference has more than 38 digits
+func (a Decimal128) Sub(b Decimal128) (Decimal128, error) {
+	lo, c := bits.Sub64(uint64(a.Lo), uint64(b.Lo), 0)
+	hi, _ := bits.Sub64(uint64(a.Hi), uint64(b.Hi), c)
+	r := Decimal128{Lo: int64(lo), Hi: int64(hi)}
+	// the difference of two numbers of different signs wraps around
+	if (a.Hi < 0) != (b.Hi < 0) && (r.Hi < 0) != (a.Hi < 0) {
+		return Decimal128{}, ErrDecimalOverflow
+	}
+	return r, r.checkWidth(MaxDecimal128Precision)
+}
+
+// MulInt64 returns a * n, it is used to accumulate a value n times, and an
+// error is returned if the product has more than 38 digits
+func (a Decimal128) MulInt64(n int64) (Decimal128, error) {
+	if n == 1 {
+		return a, nil
+	}
+	neg := (a.Hi < 0) != (n < 0)
+	hi, lo := a.abs()
+	m := uint64(n)
+	if n < 0 {
+		m = uint64(-n)
+	}
+	hi, lo, overflow := mulUint64(hi, lo, m)
+	if overflow || hi>>63 != 0 {
+		return Decimal128{}, ErrDecimalOverflow
+	}
+	r := Decimal128{Lo: int64(lo), Hi: int64(hi)}
+	if err := r.checkWidth(MaxDecimal128Precision); err != nil {
+		return Decimal128{}, err
+	}
+	if neg {
+		return r.Neg(), nil
+	}
+	return r, nil
+}
+
+// Mul returns the product of a and b, its scale is the sum of the scales,
+// and an error is returned if the product has more than 38 digits
+func (a Decimal128) Mul(b Decimal128) (Decimal128, error) {
+	r, err := decimal128FromBig(new(big.Int).Mul(a.toBig(), b.toBig()))
+	if err != nil {
+		return r, err
+	}
+	return r, r.checkWidth(MaxDecimal128Precision)
+}
+
+// Div returns a * 10^n / b rounded half away from zero
+func (a Decimal128) Div(b Decimal128, n int32) (Decimal128, error) {
+	x, y := a.toBig(), b.toBig()
+	if n > 0 {
+		x.Mul(x, pow10s[n].toBig())
+	} else if n < 0 {
+		y.Mul(y, pow10s[-n].toBig())
+	}
+	return decimal128FromBig(quoRound(x, y))
+}
+
+// Scale multiplies a by 10^n, a negative n means to divide a by 10^-n with rounding.
+func (a Decimal128) Scale(n int32) (Decimal128, error) {
+	switch {
+	case n == 0:
+		return a, nil
+	case n > MaxDecimal128Precision:
+		if a.Hi == 0 && a.Lo == 0 {
+			return a, nil
+		}
+		return Decimal128{}, ErrDecimalOverflow
+	case n < -MaxDecimal128Precision:
+		return Decimal128{}, nil
+	}
+	neg := a.Hi < 0
+	hi, lo := a.abs()
+	if n > 0 {
+		for ; n > 0; n -= 19 {
+			m := n
+			if m > 19 {
+				m = 19
+			}
+			var overflow bool
+			if hi, lo, overflow = mulUint64(hi, lo, uint64(pow10s[m].Lo)); overflow {
+				return Decimal128{}, ErrDecimalOverflow
+			}
+		}
+		if hi>>63 != 0 {
+			return Decimal128{}, ErrDecimalOverflow
+		}
+	} else if n >= -19 {
+		d := uint64(pow10s[-n].Lo)
+		var r uint64
+		if hi, lo, r = divUint64(hi, lo, d); r >= d-r {
+			var c uint64
+			lo, c = bits.Add64(lo, 1, 0)
+			hi += c
+		}
+	} else {
+		return decimal128FromBig(quoRound(a.toBig(), pow10s[-n].toBig()))
+	}
+	r := Decimal128{Lo: int64(lo), Hi: int64(hi)}
+	if neg {
+		return r.Neg(), nil
+	}
+	return r, nil
+}
+
+func (a Decimal128) checkWidth(width int32) error {
+	if width <= 0 || width > MaxDecimal128Precision {
+		width = MaxDecimal128Precision
+	}
+	hi, lo := a.abs()
+	b := pow10s[width]
+	if hi > uint64(b.Hi) || (hi == uint64(b.Hi) && lo >= uint64(b.Lo)) {
+		return ErrDecimalOverflow
+	}
+	return nil
+}
+
+// CheckDecimal128Width returns an error if a has more than width digits
+func CheckDecimal128Width(a Decimal128, width int32) error {
+	return a.checkWidth(width)
+}
+
+// CheckDecimal64Width returns an error if a has more than width digits
+func CheckDecimal64Width(a Decimal64, width int32) error {
+	return a.ToDecimal128().checkWidth(width)
+}
+
+func (a Decimal128) abs() (uint64, uint64) {
+	if a.Hi < 0 {
+		a = a.Neg()
+	}
+	return uint64(a.Hi), uint64(a.Lo)
+}
+
+func (a Decimal128) toBig() *big.Int {
+	v := new(big.Int).SetInt64(a.Hi)
+	v.Lsh(v, 64)
+	return v.Add(v, new(big.Int).SetUint64(uint64(a.Lo)))
+}
+
+func decimal128FromBig(v *big.Int) (Decimal128, error) {
+	neg := v.Sign() < 0
+	abs := new(big.Int).Abs(v)
+	if abs.BitLen() > 127 {
+		return Decimal128{}, ErrDecimalOverflow
+	}
+	lo := new(big.Int).And(abs, new(big.Int).SetUint64(^uint64(0))).Uint64()
+	hi := abs.Rsh(abs, 64).Uint64()
+	r := Decimal128{Lo: int64(lo), Hi: int64(hi)}
+	if neg {
+		return r.Neg(), nil
+	}
+	return r, nil
+}
+
+// quoRound returns x / y rounded half away from zero
+func quoRound(x, y *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	if r.Abs(r).Lsh(r, 1).Cmp(new(big.Int).Abs(y)) >= 0 {
+		if x.Sign() == y.Sign() {
+			q.Add(q, big.NewInt(1))
+		} else {
+			q.Sub(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// mulUint64 returns the unsigned 128-bit product of (hi, lo) and m
+func mulUint64(hi, lo, m uint64) (uint64, uint64, bool) {
+	h0, l := bits.Mul64(lo, m)
+	h1, h := bits.Mul64(hi, m)
+	h, c := bits.Add64(h, h0, 0)
+	return h, l, h1 != 0 || c != 0
+}
+
+// divUint64 returns the unsigned 128-bit quotient and remainder of (hi, lo) / d
+func divUint64(hi, lo, d uint64) (uint64, uint64, uint64) {
+	qh, r := hi/d, hi%d
+	ql, r := bits.Div64(r, lo, d)
+	return qh, ql, r
 }
@@ -0,0 +1,220 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStringToDecimal(t *testing.T) {
+	tests := []struct {
+		s       string
+		width   int32
+		scale   int32
+		want    string
+		wantErr bool
+	}{
+		{s: "12.345", width: 10, scale: 3, want: "12.345"},
+		{s: "-12.345", width: 10, scale: 2, want: "-12.35"},
+		{s: "12.344", width: 10, scale: 2, want: "12.34"},
+		{s: "+0.5", width: 10, scale: 0, want: "1"},
+		{s: "1.2e3", width: 10, scale: 1, want: "1200.0"},
+		{s: "  7 ", width: 10, scale: 2, want: "7.00"},
+		{s: "-0.001", width: 10, scale: 3, want: "-0.001"},
+		{s: "12345678901234567890.12", width: 38, scale: 2, want: "12345678901234567890.12"},
+		{s: "123456", width: 5, scale: 0, wantErr: true},
+		{s: "99.99", width: 3, scale: 2, wantErr: true},
+		{s: "abc", width: 10, scale: 2, wantErr: true},
+	}
+	for _, tt := range tests {
+		d, err := ParseStringToDecimal128(tt.s, tt.width, tt.scale)
+		if tt.wantErr {
+			require.Error(t, err, tt.s)
+			continue
+		}
+		require.NoError(t, err, tt.s)
+		require.Equal(t, tt.want, d.ToString(tt.scale), tt.s)
+		if tt.width <= MaxDecimal64Precision {
+			d64, err := ParseStringToDecimal64(tt.s, tt.width, tt.scale)
+			require.NoError(t, err, tt.s)
+			require.Equal(t, tt.want, d64.ToString(tt.scale), tt.s)
+		}
+	}
+}
+
+func TestDecimalType(t *testing.T) {
+	require.Equal(t, Type{Oid: T_decimal64, Size: 8, Width: 18, Precision: 2}, DecimalType(18, 2))
+	require.Equal(t, Type{Oid: T_decimal128, Size: 16, Width: 19, Precision: 2}, DecimalType(19, 2))
+}
+
+func TestDecimal128Arith(t *testing.T) {
+	a, _ := ParseStringToDecimal128("-12.50", 38, 2)
+	b, _ := ParseStringToDecimal128("3.25", 38, 2)
+	r, err := a.Add(b)
+	require.NoError(t, err)
+	require.Equal(t, "-9.25", r.ToString(2))
+	r, err = a.Sub(b)
+	require.NoError(t, err)
+	require.Equal(t, "-15.75", r.ToString(2))
+	require.Equal(t, "12.50", a.Neg().ToString(2))
+	r, err = a.MulInt64(3)
+	require.NoError(t, err)
+	require.Equal(t, "-37.50", r.ToString(2))
+
+	p, err := a.Mul(b)
+	require.NoError(t, err)
+	require.Equal(t, "-40.6250", p.ToString(4))
+
+	// -12.50 / 3.25 at scale 6
+	q, err := a.Div(b, 6)
+	require.NoError(t, err)
+	require.Equal(t, "-3.846154", q.ToString(6))
+
+	require.Equal(t, -1, a.Compare(b))
+	require.Equal(t, 1, b.Compare(a))
+	require.Equal(t, 0, a.Compare(a))
+	require.Equal(t, -1, Decimal128{Lo: -1}.Compare(Decimal128{Hi: 1}))
+
+	// the values in ascending order, the low words of some are negative as int64
+	var ds []Decimal128
+	for _, s := range []string{
+		"-99999999999999999999999999999999999999",
+		"-18446744073709551617",
+		"-18446744073709551616",
+		"-9223372036854775809",
+		"-9223372036854775808",
+		"-1",
+		"0",
+		"1",
+		"9223372036854775807",
+		"9223372036854775808",
+		"18446744073709551615",
+		"18446744073709551616",
+		"99999999999999999999999999999999999999",
+	} {
+		d, err := ParseStringToDecimal128(s, 38, 0)
+		require.NoError(t, err)
+		require.Equal(t, s, d.ToString(0))
+		ds = append(ds, d)
+	}
+	for i := range ds {
+		for j := range ds {
+			expected := 0
+			if i < j {
+				expected = -1
+			} else if i > j {
+				expected = 1
+			}
+			require.Equal(t, expected, ds[i].Compare(ds[j]), "%s <=> %s", ds[i].ToString(0), ds[j].ToString(0))
+		}
+	}
+}
+
+func TestDecimal64Mul(t *testing.T) {
+	minInt64 := Decimal64(math.MinInt64)
+	require.Equal(t, "9223372036854775808", minInt64.Mul(-1).ToString(0))
+	require.Equal(t, "-9223372036854775808", minInt64.Mul(1).ToString(0))
+	require.Equal(t, "85070591730234615865843651857942052864", minInt64.Mul(minInt64).ToString(0))
+	require.Equal(t, "-85070591730234615856620279821087277056", minInt64.Mul(math.MaxInt64).ToString(0))
+	require.Equal(t, "-12", Decimal64(-3).Mul(4).ToString(0))
+}
+
+func TestDecimal128Overflow(t *testing.T) {
+	largest, _ := ParseStringToDecimal128("99999999999999999999999999999999999999", 38, 0)
+	smallest := largest.Neg()
+	one := Decimal128{Lo: 1}
+	two := Decimal128{Lo: 2}
+	// the largest number of 128 bits which has 39 digits
+	huge := Decimal128{Lo: -1, Hi: 1<<63 - 1}
+
+	r, err := largest.Add(Decimal128{})
+	require.NoError(t, err)
+	require.Equal(t, largest, r)
+	r, err = smallest.Sub(Decimal128{})
+	require.NoError(t, err)
+	require.Equal(t, smallest, r)
+	r, err = largest.Add(smallest)
+	require.NoError(t, err)
+	require.Equal(t, Decimal128{}, r)
+	r, err = largest.Sub(one)
+	require.NoError(t, err)
+	require.Equal(t, "99999999999999999999999999999999999998", r.ToString(0))
+	r, err = smallest.MulInt64(1)
+	require.NoError(t, err)
+	require.Equal(t, smallest, r)
+
+	for _, f := range []func() (Decimal128, error){
+		func() (Decimal128, error) { return largest.Add(one) },
+		func() (Decimal128, error) { return smallest.Add(one.Neg()) },
+		func() (Decimal128, error) { return largest.Sub(one.Neg()) },
+		func() (Decimal128, error) { return smallest.Sub(one) },
+		func() (Decimal128, error) { return largest.Add(largest) },  // wraps around in 128 bits
+		func() (Decimal128, error) { return smallest.Sub(largest) }, // wraps around in 128 bits
+		func() (Decimal128, error) { return huge.Add(one) },         // wraps around to be negative
+		func() (Decimal128, error) { return huge.Neg().Sub(two) },
+		func() (Decimal128, error) { return largest.MulInt64(2) },
+		func() (Decimal128, error) { return largest.MulInt64(-10) },
+		func() (Decimal128, error) { return largest.Mul(two) },
+		func() (Decimal128, error) { return smallest.Mul(largest) },
+	} {
+		_, err := f()
+		require.Equal(t, ErrDecimalOverflow, err)
+	}
+
+	// the product of two numbers of 19 digits has 38 digits
+	x, _ := ParseStringToDecimal128("9999999999999999999", 38, 0)
+	r, err = x.Mul(x.Neg())
+	require.NoError(t, err)
+	require.Equal(t, "-99999999999999999980000000000000000001", r.ToString(0))
+}
+
+func TestDecimal128Scale(t *testing.T) {
+	a, _ := ParseStringToDecimal128("1.25", 38, 2)
+	r, err := a.Scale(3)
+	require.NoError(t, err)
+	require.Equal(t, "1.25000", r.ToString(5))
+	r, err = a.Scale(-1)
+	require.NoError(t, err)
+	require.Equal(t, "1.3", r.ToString(1))
+	r, err = a.Neg().Scale(-1)
+	require.NoError(t, err)
+	require.Equal(t, "-1.3", r.ToString(1))
+	_, err = a.Scale(38)
+	require.Error(t, err)
+}
+
+func TestDecimalConvert(t *testing.T) {
+	d64, err := Decimal64FromInt64(-42, 10, 2)
+	require.NoError(t, err)
+	require.Equal(t, "-42.00", d64.ToString(2))
+	require.Equal(t, int64(-42), d64.ToInt64(2))
+	require.Equal(t, float64(-42), d64.ToFloat64(2))
+	require.Equal(t, Decimal128{Lo: -4200, Hi: -1}, d64.ToDecimal128())
+
+	d128, err := Decimal128FromFloat64(2.675, 20, 2)
+	require.NoError(t, err)
+	require.Equal(t, "2.68", d128.ToString(2))
+	v, err := d128.ToInt64(2)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), v)
+
+	_, err = Decimal64FromInt64(1000, 3, 0)
+	require.Error(t, err)
+	_, err = Decimal128{Hi: 1}.ToDecimal64()
+	require.Error(t, err)
+}
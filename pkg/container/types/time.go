@@ -0,0 +1,134 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+const (
+	microsecsPerSec = 1000000
+
+	// MaxTimeHour is the max hour of a Time, the same as mysql
+	MaxTimeHour = 838
+)
+
+var (
+	errIncorrectTimeValue = errors.New(errno.DataException, "Incorrect time value")
+)
+
+// ParseTime will parse a string to be a Time
+// Support Format:
+// 1. [-][d ]hh:mm[:ss][.usec]
+// 2. [-]hhmmss[.usec]
+// 3. a Datetime value, the clock of which is returned
+func ParseTime(s string) (Time, error) {
+	if len(s) >= 14 {
+		if dt, err := ParseDatetime(s); err == nil {
+			return dt.TimeOfDay(), nil
+		}
+	}
+	var neg bool
+	var days, hour, min, sec uint64
+	var usec uint32
+	var err error
+
+	if len(s) > 0 && s[0] == '-' {
+		neg = true
+		s = s[1:]
+	}
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		if usec, err = parseMicrosecond(s[i+1:]); err != nil {
+			return -1, errIncorrectTimeValue
+		}
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, ' '); i >= 0 {
+		if days, err = strconv.ParseUint(s[:i], 10, 32); err != nil {
+			return -1, errIncorrectTimeValue
+		}
+		s = s[i+1:]
+	}
+	if strings.IndexByte(s, ':') >= 0 {
+		parts := strings.Split(s, ":")
+		if len(parts) > 3 {
+			return -1, errIncorrectTimeValue
+		}
+		vs := make([]uint64, 3)
+		for i, part := range parts {
+			if len(part) == 0 {
+				return -1, errIncorrectTimeValue
+			}
+			if vs[i], err = strconv.ParseUint(part, 10, 32); err != nil {
+				return -1, errIncorrectTimeValue
+			}
+		}
+		hour, min, sec = vs[0], vs[1], vs[2]
+	} else {
+		// hhmmss, the leading parts can be omitted
+		v, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return -1, errIncorrectTimeValue
+		}
+		hour, min, sec = v/10000, v/100%100, v%100
+	}
+	hour += days * 24
+	if min > 59 || sec > 59 || hour > MaxTimeHour {
+		return -1, errIncorrectTimeValue
+	}
+	return FromTimeClock(neg, uint32(hour), uint8(min), uint8(sec), usec), nil
+}
+
+// FromTimeClock returns the Time of the clock
+func FromTimeClock(neg bool, hour uint32, min, sec uint8, usec uint32) Time {
+	t := ((int64(hour)*secsPerHour+int64(min)*secsPerMinute+int64(sec))*microsecsPerSec + int64(usec))
+	if neg {
+		return Time(-t)
+	}
+	return Time(t)
+}
+
+// Clock returns the sign, hour, minute, second and microsecond of t
+func (t Time) Clock() (neg bool, hour uint32, min, sec uint8, usec uint32) {
+	v := int64(t)
+	if v < 0 {
+		neg = true
+		v = -v
+	}
+	usec = uint32(v % microsecsPerSec)
+	v /= microsecsPerSec
+	hour = uint32(v / secsPerHour)
+	min = uint8(v % secsPerHour / secsPerMinute)
+	sec = uint8(v % secsPerMinute)
+	return
+}
+
+// Microseconds returns t as a number of microseconds
+func (t Time) Microseconds() int64 {
+	return int64(t)
+}
+
+func (t Time) String() string {
+	neg, hour, min, sec, _ := t.Clock()
+	if neg {
+		return fmt.Sprintf("-%02d:%02d:%02d", hour, min, sec)
+	}
+	return fmt.Sprintf("%02d:%02d:%02d", hour, min, sec)
+}
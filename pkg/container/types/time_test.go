@@ -0,0 +1,70 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantErr bool
+	}{
+		{args: "12:34:56", want: "12:34:56"},
+		{args: "12:34", want: "12:34:00"},
+		{args: "-838:59:59", want: "-838:59:59"},
+		{args: "1 02:03:04", want: "26:03:04"},
+		{args: "123456.789", want: "12:34:56"},
+		{args: "56", want: "00:00:56"},
+		{args: "2021-08-25 10:11:12", want: "10:11:12"},
+		{args: "839:00:00", wantErr: true},
+		{args: "12:60:00", wantErr: true},
+		{args: "12::00", wantErr: true},
+		{args: "ab:cd", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseTime(tt.args)
+		if tt.wantErr {
+			require.Error(t, err, tt.args)
+			continue
+		}
+		require.NoError(t, err, tt.args)
+		require.Equal(t, tt.want, got.String(), tt.args)
+	}
+
+	tm, err := ParseTime("-01:02:03.000004")
+	require.NoError(t, err)
+	neg, hour, min, sec, usec := tm.Clock()
+	require.Equal(t, true, neg)
+	require.Equal(t, uint32(1), hour)
+	require.Equal(t, uint8(2), min)
+	require.Equal(t, uint8(3), sec)
+	require.Equal(t, uint32(4), usec)
+	require.Equal(t, int64(-3723000004), tm.Microseconds())
+}
+
+func TestDatetimeGoTime(t *testing.T) {
+	dt, err := ParseDatetime("2021-08-25 10:11:12.000345")
+	require.NoError(t, err)
+	require.Equal(t, uint32(345), dt.Microsec())
+	require.Equal(t, "10:11:12", dt.TimeOfDay().String())
+	g := dt.GoTime()
+	require.Equal(t, "2021-08-25 10:11:12.000345", g.Format("2006-01-02 15:04:05.000000"))
+	require.Equal(t, dt, FromGoTime(g))
+}
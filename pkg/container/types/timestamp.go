@@ -0,0 +1,119 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+var (
+	errIncorrectTimestampValue = errors.New(errno.DataException, "Incorrect timestamp value")
+	errUnknownTimeZone         = errors.New(errno.DataException, "Unknown or incorrect time zone")
+)
+
+// ParseTimeZone returns the location of a time zone name, the name can be
+// 1. SYSTEM, the time zone of the server
+// 2. an offset from UTC, e.g. +08:00 or -05:30
+// 3. a name of the IANA Time Zone database, e.g. Asia/Shanghai
+func ParseTimeZone(name string) (*time.Location, error) {
+	if len(name) == 0 || strings.EqualFold(name, "SYSTEM") {
+		return time.Local, nil
+	}
+	if name[0] == '+' || name[0] == '-' {
+		parts := strings.Split(name[1:], ":")
+		if len(parts) != 2 {
+			return nil, errUnknownTimeZone
+		}
+		h, err := strconv.ParseUint(parts[0], 10, 8)
+		if err != nil {
+			return nil, errUnknownTimeZone
+		}
+		m, err := strconv.ParseUint(parts[1], 10, 8)
+		if err != nil || len(parts[1]) != 2 || m > 59 {
+			return nil, errUnknownTimeZone
+		}
+		offset := int(h*secsPerHour + m*secsPerMinute)
+		// the same range as mysql
+		if offset > 14*secsPerHour || (name[0] == '-' && offset > 13*secsPerHour+59*secsPerMinute) {
+			return nil, errUnknownTimeZone
+		}
+		if name[0] == '-' {
+			offset = -offset
+		}
+		return time.FixedZone(name, offset), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errUnknownTimeZone
+	}
+	return loc, nil
+}
+
+// ParseTimestamp parses a string in the time zone loc to be a Timestamp,
+// the formats are the same as datetime.
+func ParseTimestamp(s string, loc *time.Location) (Timestamp, error) {
+	dt, err := ParseDatetime(s)
+	if err != nil {
+		return -1, errIncorrectTimestampValue
+	}
+	return dt.ToTimestamp(loc), nil
+}
+
+// CurrentTimestamp returns the current time
+func CurrentTimestamp() Timestamp {
+	return Timestamp(time.Now().UnixNano() / 1000)
+}
+
+// UnixToTimestamp returns the Timestamp of the seconds since 1970-01-01 00:00:00 UTC
+func UnixToTimestamp(sec int64) Timestamp {
+	return Timestamp(sec * 1000000)
+}
+
+// ToTimestamp returns the Timestamp of the calendar and clock of dt in the time zone loc
+func (dt Datetime) ToTimestamp(loc *time.Location) Timestamp {
+	t := dt.GoTime()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+	return Timestamp(t.Unix()*1000000 + int64(t.Nanosecond()/1000))
+}
+
+// ToDatetime returns the calendar and clock of ts in the time zone loc
+func (ts Timestamp) ToDatetime(loc *time.Location) Datetime {
+	return FromGoTime(ts.GoTime().In(loc))
+}
+
+// GoTime returns ts as a time.Time in UTC
+func (ts Timestamp) GoTime() time.Time {
+	sec, usec := int64(ts)/1000000, int64(ts)%1000000
+	if usec < 0 {
+		sec, usec = sec-1, usec+1000000
+	}
+	return time.Unix(sec, usec*1000).UTC()
+}
+
+// Unix returns the seconds since 1970-01-01 00:00:00 UTC
+func (ts Timestamp) Unix() int64 {
+	return ts.GoTime().Unix()
+}
+
+// String returns ts in UTC, the time zone of a session is
+// applied by the caller.
+func (ts Timestamp) String() string {
+	return ts.ToDatetime(time.UTC).String()
+}
@@ -0,0 +1,68 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeZone(t *testing.T) {
+	loc, err := ParseTimeZone("SYSTEM")
+	require.NoError(t, err)
+	require.Equal(t, time.Local, loc)
+
+	loc, err = ParseTimeZone("+08:00")
+	require.NoError(t, err)
+	_, offset := time.Date(2021, 1, 1, 0, 0, 0, 0, loc).Zone()
+	require.Equal(t, 8*secsPerHour, offset)
+
+	loc, err = ParseTimeZone("-05:30")
+	require.NoError(t, err)
+	_, offset = time.Date(2021, 1, 1, 0, 0, 0, 0, loc).Zone()
+	require.Equal(t, -(5*secsPerHour + 30*secsPerMinute), offset)
+
+	loc, err = ParseTimeZone("UTC")
+	require.NoError(t, err)
+	require.Equal(t, "UTC", loc.String())
+
+	for _, name := range []string{"+8", "+08:0", "+15:00", "-14:00", "Mars/Olympus"} {
+		_, err = ParseTimeZone(name)
+		require.Error(t, err, name)
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	utc8, err := ParseTimeZone("+08:00")
+	require.NoError(t, err)
+
+	ts, err := ParseTimestamp("2021-10-01 08:00:00", utc8)
+	require.NoError(t, err)
+	require.Equal(t, "2021-10-01 00:00:00", ts.String())
+	require.Equal(t, int64(1633046400), ts.Unix())
+	require.Equal(t, "2021-10-01 08:00:00", ts.ToDatetime(utc8).String())
+	require.Equal(t, ts, UnixToTimestamp(1633046400))
+
+	ts, err = ParseTimestamp("1969-12-31 23:59:59.5", time.UTC)
+	require.NoError(t, err)
+	require.Equal(t, Timestamp(-500000), ts)
+	require.Equal(t, "1969-12-31 23:59:59", ts.String())
+	require.Equal(t, uint32(500000), ts.ToDatetime(time.UTC).Microsec())
+
+	_, err = ParseTimestamp("2021-13-01 00:00:00", utc8)
+	require.Error(t, err)
+}
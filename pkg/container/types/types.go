@@ -34,15 +34,18 @@ const (
 	T_uint64 = 10
 
 	// numeric/decimal family - unsigned attribute is deprecated
-	T_decimal = 11
+	T_decimal64  = 11
+	T_decimal128 = 14
 
 	// numeric/float family - unsigned attribute is deprecated
 	T_float32 = 12
 	T_float64 = 13
 
 	// date family
-	T_date     = 15 // 3 byte
-	T_datetime = 18 // 8 byte
+	T_date      = 15 // 3 byte
+	T_time      = 16 // 8 byte
+	T_datetime  = 18 // 8 byte
+	T_timestamp = 19 // 8 byte
 
 	// string family
 	T_char    = 20
@@ -63,9 +66,10 @@ type Type struct {
 	Size      int32 // e.g. int8.Size = 1, int16.Size = 2, char.Size = 24(SliceHeader size)
 
 	// Width means max Display width for float and double, char and varchar // todo: need to add new attribute DisplayWidth ?
+	// and the max number of digits for decimal
 	Width     int32
 
-	// Precision means dec (length of Fractional part) for float and double // todo: need to add new attribute Dec ?
+	// Precision means dec (length of Fractional part) for float, double and decimal // todo: need to add new attribute Dec ?
 	Precision int32
 }
 
@@ -79,7 +83,19 @@ type Date int32
 
 type Datetime int64
 
-type Decimal struct {
+// Time is a signed duration of microseconds, it may be out of a day
+type Time int64
+
+// Timestamp is the number of microseconds since 1970-01-01 00:00:00 UTC
+type Timestamp int64
+
+// Decimal64 is a fixed-point number whose scale is recorded in Type.Precision
+type Decimal64 int64
+
+// Decimal128 is a 128-bit fixed-point number in two's complement
+type Decimal128 struct {
+	Lo int64
+	Hi int64
 }
 
 var Types map[string]T = map[string]T{
@@ -95,13 +111,17 @@ var Types map[string]T = map[string]T{
 	"integer unsigned":  T_int32,
 	"bigint unsigned":   T_int64,
 
-	"decimal": T_decimal,
+	"decimal":    T_decimal64,
+	"decimal64":  T_decimal64,
+	"decimal128": T_decimal128,
 
 	"float":  T_float32,
 	"double": T_float64,
 
-	"date":     T_date,
-	"datetime": T_datetime,
+	"date":      T_date,
+	"time":      T_time,
+	"datetime":  T_datetime,
+	"timestamp": T_timestamp,
 
 	"char":    T_char,
 	"varchar": T_varchar,
@@ -128,7 +148,7 @@ func (t T) ToType() Type {
 		typ.Size = 2
 	case T_int32, T_date:
 		typ.Size = 4
-	case T_int64, T_datetime:
+	case T_int64, T_datetime, T_time, T_timestamp:
 		typ.Size = 8
 	case T_uint8:
 		typ.Size = 1
@@ -142,9 +162,13 @@ func (t T) ToType() Type {
 		typ.Size = 4
 	case T_float64:
 		typ.Size = 8
+	case T_decimal64:
+		typ.Size = 8
+	case T_decimal128:
+		typ.Size = 16
 	case T_char:
 		typ.Size = 24
-	case T_varchar:
+	case T_varchar, T_json:
 		typ.Size = 24
 	case T_sel:
 		typ.Size = 8
@@ -170,16 +194,22 @@ func (t T) String() string {
 		return "INT UNSIGNED"
 	case T_uint64:
 		return "BIGINT UNSIGNED"
-	case T_decimal:
-		return "DECIMAL"
+	case T_decimal64:
+		return "DECIMAL64"
+	case T_decimal128:
+		return "DECIMAL128"
 	case T_float32:
 		return "FLOAT"
 	case T_float64:
 		return "DOUBLE"
 	case T_date:
 		return "DATE"
+	case T_time:
+		return "TIME"
 	case T_datetime:
 		return "DATETIME"
+	case T_timestamp:
+		return "TIMESTAMP"
 	case T_char:
 		return "CHAR"
 	case T_varchar:
@@ -209,6 +239,10 @@ func (t T) OidString() string {
 		return "T_int8"
 	case T_float64:
 		return "T_float64"
+	case T_decimal64:
+		return "T_decimal64"
+	case T_decimal128:
+		return "T_decimal128"
 	case T_float32:
 		return "T_float32"
 	case T_uint8:
@@ -227,8 +261,12 @@ func (t T) OidString() string {
 		return "T_varchar"
 	case T_date:
 		return "T_date"
+	case T_time:
+		return "T_time"
 	case T_datetime:
 		return "T_datetime"
+	case T_timestamp:
+		return "T_timestamp"
 	}
 	return "unknown_type"
 }
@@ -248,6 +286,10 @@ func (t T) GoType() string {
 		return "float64"
 	case T_float32:
 		return "float32"
+	case T_decimal64:
+		return "decimal64"
+	case T_decimal128:
+		return "decimal128"
 	case T_uint8:
 		return "uint8"
 	case T_uint16:
@@ -264,8 +306,12 @@ func (t T) GoType() string {
 		return "string"
 	case T_date:
 		return "date"
+	case T_time:
+		return "time"
 	case T_datetime:
 		return "datetime"
+	case T_timestamp:
+		return "timestamp"
 	}
 	return "unknown type"
 }
@@ -288,7 +334,7 @@ func (t T) TypeLen() int {
 		return 2
 	case T_int32, T_date:
 		return 4
-	case T_int64, T_datetime:
+	case T_int64, T_datetime, T_time, T_timestamp:
 		return 8
 	case T_uint8:
 		return 1
@@ -302,9 +348,13 @@ func (t T) TypeLen() int {
 		return 4
 	case T_float64:
 		return 8
+	case T_decimal64:
+		return 8
+	case T_decimal128:
+		return 16
 	case T_char:
 		return 24
-	case T_varchar:
+	case T_varchar, T_json:
 		return 24
 	case T_sel:
 		return 8
@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"unsafe"
 
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
@@ -79,12 +80,6 @@ func New(typ types.Type) *Vector {
 			Col: []uint64{},
 			Nsp: &nulls.Nulls{},
 		}
-	case types.T_decimal:
-		return &Vector{
-			Typ: typ,
-			Col: []types.Decimal{},
-			Nsp: &nulls.Nulls{},
-		}
 	case types.T_float32:
 		return &Vector{
 			Typ: typ,
@@ -97,6 +92,18 @@ func New(typ types.Type) *Vector {
 			Col: []float64{},
 			Nsp: &nulls.Nulls{},
 		}
+	case types.T_decimal64:
+		return &Vector{
+			Typ: typ,
+			Col: []types.Decimal64{},
+			Nsp: &nulls.Nulls{},
+		}
+	case types.T_decimal128:
+		return &Vector{
+			Typ: typ,
+			Col: []types.Decimal128{},
+			Nsp: &nulls.Nulls{},
+		}
 	case types.T_date:
 		return &Vector{
 			Typ: typ,
@@ -109,6 +116,18 @@ func New(typ types.Type) *Vector {
 			Col: []types.Datetime{},
 			Nsp: &nulls.Nulls{},
 		}
+	case types.T_time:
+		return &Vector{
+			Typ: typ,
+			Col: []types.Time{},
+			Nsp: &nulls.Nulls{},
+		}
+	case types.T_timestamp:
+		return &Vector{
+			Typ: typ,
+			Col: []types.Timestamp{},
+			Nsp: &nulls.Nulls{},
+		}
 	case types.T_sel:
 		return &Vector{
 			Typ: typ,
@@ -223,6 +242,16 @@ func SetLength(v *Vector, n int) {
 		m := len(vs)
 		v.Col = vs[:n]
 		nulls.RemoveRange(v.Nsp, uint64(n), uint64(m))
+	case types.T_decimal64:
+		vs := v.Col.([]types.Decimal64)
+		m := len(vs)
+		v.Col = vs[:n]
+		nulls.RemoveRange(v.Nsp, uint64(n), uint64(m))
+	case types.T_decimal128:
+		vs := v.Col.([]types.Decimal128)
+		m := len(vs)
+		v.Col = vs[:n]
+		nulls.RemoveRange(v.Nsp, uint64(n), uint64(m))
 	case types.T_sel:
 		vs := v.Col.([]int64)
 		m := len(vs)
@@ -250,6 +279,16 @@ func SetLength(v *Vector, n int) {
 		m := len(vs)
 		v.Col = vs[:n]
 		nulls.RemoveRange(v.Nsp, uint64(n), uint64(m))
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		m := len(vs)
+		v.Col = vs[:n]
+		nulls.RemoveRange(v.Nsp, uint64(n), uint64(m))
+	case types.T_timestamp:
+		vs := v.Col.([]types.Timestamp)
+		m := len(vs)
+		v.Col = vs[:n]
+		nulls.RemoveRange(v.Nsp, uint64(n), uint64(m))
 	default:
 		panic(fmt.Sprintf("unexpect type %s for function vector.SetLength", v.Typ))
 	}
@@ -417,6 +456,38 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
+	case types.T_decimal64:
+		vs := v.Col.([]types.Decimal64)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeDecimal64Slice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+			Link: v.Link,
+		}, nil
+	case types.T_decimal128:
+		vs := v.Col.([]types.Decimal128)
+		data, err := mheap.Alloc(m, int64(len(vs)*16))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeDecimal128Slice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+			Link: v.Link,
+		}, nil
 	case types.T_char, types.T_varchar, types.T_json:
 		var err error
 		var data []byte
@@ -477,6 +548,38 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeTimeSlice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+			Link: v.Link,
+		}, nil
+	case types.T_timestamp:
+		vs := v.Col.([]types.Timestamp)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeTimestampSlice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+			Link: v.Link,
+		}, nil
 	}
 	return nil, fmt.Errorf("unsupport type %v", v.Typ)
 }
@@ -514,6 +617,12 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_float64:
 		w.Col = v.Col.([]float64)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
+	case types.T_decimal64:
+		w.Col = v.Col.([]types.Decimal64)[start:end]
+		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
+	case types.T_decimal128:
+		w.Col = v.Col.([]types.Decimal128)[start:end]
+		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_sel:
 		w.Col = v.Col.([]int64)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
@@ -529,6 +638,12 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_datetime:
 		w.Col = v.Col.([]types.Datetime)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
+	case types.T_time:
+		w.Col = v.Col.([]types.Time)[start:end]
+		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
+	case types.T_timestamp:
+		w.Col = v.Col.([]types.Timestamp)[start:end]
+		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	default:
 		panic(fmt.Sprintf("unexpect type %s for function vector.Window", v.Typ))
 	}
@@ -553,16 +668,22 @@ func Append(v *Vector, arg interface{}) error {
 		v.Col = append(v.Col.([]uint32), arg.([]uint32)...)
 	case types.T_uint64:
 		v.Col = append(v.Col.([]uint64), arg.([]uint64)...)
-	case types.T_decimal:
-		v.Col = append(v.Col.([]types.Decimal), arg.([]types.Decimal)...)
 	case types.T_float32:
 		v.Col = append(v.Col.([]float32), arg.([]float32)...)
 	case types.T_float64:
 		v.Col = append(v.Col.([]float64), arg.([]float64)...)
+	case types.T_decimal64:
+		v.Col = append(v.Col.([]types.Decimal64), arg.([]types.Decimal64)...)
+	case types.T_decimal128:
+		v.Col = append(v.Col.([]types.Decimal128), arg.([]types.Decimal128)...)
 	case types.T_date:
 		v.Col = append(v.Col.([]types.Date), arg.([]types.Date)...)
 	case types.T_datetime:
 		v.Col = append(v.Col.([]types.Datetime), arg.([]types.Datetime)...)
+	case types.T_time:
+		v.Col = append(v.Col.([]types.Time), arg.([]types.Time)...)
+	case types.T_timestamp:
+		v.Col = append(v.Col.([]types.Timestamp), arg.([]types.Timestamp)...)
 	case types.T_sel:
 		v.Col = append(v.Col.([]int64), arg.([]int64)...)
 	case types.T_tuple:
@@ -647,6 +768,20 @@ func Shrink(v *Vector, sels []int64) {
 		}
 		v.Col = vs[:len(sels)]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
+	case types.T_decimal64:
+		vs := v.Col.([]types.Decimal64)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+	case types.T_decimal128:
+		vs := v.Col.([]types.Decimal128)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
 	case types.T_sel:
 		vs := v.Col.([]int64)
 		for i, sel := range sels {
@@ -684,6 +819,20 @@ func Shrink(v *Vector, sels []int64) {
 		}
 		v.Col = vs[:len(sels)]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+	case types.T_timestamp:
+		vs := v.Col.([]types.Timestamp)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
 	}
 }
 
@@ -789,6 +938,26 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Col = shuffle.Float64Shuffle(vs, ws, sels)
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		mheap.Free(m, data)
+	case types.T_decimal64:
+		vs := v.Col.([]types.Decimal64)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return err
+		}
+		ws := encoding.DecodeDecimal64Slice(data)
+		v.Col = shuffle.Decimal64Shuffle(vs, ws, sels)
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+		mheap.Free(m, data)
+	case types.T_decimal128:
+		vs := v.Col.([]types.Decimal128)
+		data, err := mheap.Alloc(m, int64(len(vs)*16))
+		if err != nil {
+			return err
+		}
+		ws := encoding.DecodeDecimal128Slice(data)
+		v.Col = shuffle.Decimal128Shuffle(vs, ws, sels)
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+		mheap.Free(m, data)
 	case types.T_sel:
 		vs := v.Col.([]int64)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
@@ -841,6 +1010,26 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Col = shuffle.DatetimeShuffle(vs, ws, sels)
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		mheap.Free(m, data)
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return err
+		}
+		ws := encoding.DecodeTimeSlice(data)
+		v.Col = shuffle.TimeShuffle(vs, ws, sels)
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+		mheap.Free(m, data)
+	case types.T_timestamp:
+		vs := v.Col.([]types.Timestamp)
+		data, err := mheap.Alloc(m, int64(len(vs)*8))
+		if err != nil {
+			return err
+		}
+		ws := encoding.DecodeTimestampSlice(data)
+		v.Col = shuffle.TimestampShuffle(vs, ws, sels)
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+		mheap.Free(m, data)
 	default:
 		panic(fmt.Sprintf("unexpect type %s for function vector.Shuffle", v.Typ))
 	}
@@ -1150,6 +1339,60 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			vs = append(vs, w.Col.([]float64)[sel])
 			v.Col = vs
 		}
+	case types.T_decimal64:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeDecimal64Slice(data)
+			vs[0] = w.Col.([]types.Decimal64)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Decimal64)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+1)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeDecimal64Slice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Decimal64)[sel])
+			v.Col = vs
+		}
+	case types.T_decimal128:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*16)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeDecimal128Slice(data)
+			vs[0] = w.Col.([]types.Decimal128)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Decimal128)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*16], int64(n+1)*16)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeDecimal128Slice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Decimal128)[sel])
+			v.Col = vs
+		}
 	case types.T_tuple:
 		v.Ref = w.Ref
 		vs, ws := v.Col.([][]interface{}), w.Col.([][]interface{})
@@ -1241,6 +1484,60 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			vs = append(vs, w.Col.([]types.Datetime)[sel])
 			v.Col = vs
 		}
+	case types.T_time:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeTimeSlice(data)
+			vs[0] = w.Col.([]types.Time)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+1)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeTimeSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Time)[sel])
+			v.Col = vs
+		}
+	case types.T_timestamp:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 8*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeTimestampSlice(data)
+			vs[0] = w.Col.([]types.Timestamp)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Timestamp)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+1)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeTimestampSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Timestamp)[sel])
+			v.Col = vs
+		}
 	}
 	if nulls.Any(w.Nsp) && nulls.Contains(w.Nsp, uint64(sel)) {
 		nulls.Add(v.Nsp, uint64(Length(v)-1))
@@ -1686,52 +1983,136 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			}
 			v.Col = vs
 		}
-
-	case types.T_tuple:
-		v.Ref = w.Ref
-		vs, ws := v.Col.([][]interface{}), w.Col.([][]interface{})
-		for i, flag := range flags {
-			if flag > 0 {
-				vs = append(vs, ws[int(offset)+i])
-			}
-		}
-		v.Col = vs
-
-	case types.T_char, types.T_varchar, types.T_json:
-		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
-		incSize := 0
-		for i, flag := range flags {
-			if flag > 0 {
-				incSize += int(ws.Lengths[int(offset)+i])
-			}
-		}
-
+	case types.T_decimal64:
+		col := w.Col.([]types.Decimal64)
 		if len(v.Data) == 0 {
 			newSize := 8
-			for newSize < incSize {
+			for newSize < cnt {
 				newSize <<= 1
 			}
-			data, err := mheap.Alloc(m, int64(newSize))
+			data, err := mheap.Alloc(m, int64(newSize)*8)
 			if err != nil {
 				return err
 			}
 			v.Ref = w.Ref
-			v.Data = data
-			vs.Data = data[:0]
-		} else if n := len(vs.Data); n+incSize > cap(vs.Data) {
-			data, err := mheap.Grow(m, vs.Data, int64(n+incSize))
-			if err != nil {
-				return err
+			vs := encoding.DecodeDecimal64Slice(data)[:cnt]
+			for i, j := 0, 0; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
 			}
-			mheap.Free(m, v.Data)
+			v.Col = vs
 			v.Data = data
-			vs.Data = data[:n]
-		}
-
-		for i, flag := range flags {
-			if flag > 0 {
-				from := ws.Get(offset + int64(i))
-				vs.Lengths = append(vs.Lengths, uint32(len(from)))
+		} else {
+			vs := v.Col.([]types.Decimal64)
+			n := len(vs)
+			if n+cnt > cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+cnt)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeDecimal64Slice(data)
+				v.Data = data
+			}
+			vs = vs[:n+cnt]
+			for i, j := 0, n; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+		}
+	case types.T_decimal128:
+		col := w.Col.([]types.Decimal128)
+		if len(v.Data) == 0 {
+			newSize := 8
+			for newSize < cnt {
+				newSize <<= 1
+			}
+			data, err := mheap.Alloc(m, int64(newSize)*16)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeDecimal128Slice(data)[:cnt]
+			for i, j := 0, 0; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Decimal128)
+			n := len(vs)
+			if n+cnt > cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*16], int64(n+cnt)*16)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeDecimal128Slice(data)
+				v.Data = data
+			}
+			vs = vs[:n+cnt]
+			for i, j := 0, n; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+		}
+
+	case types.T_tuple:
+		v.Ref = w.Ref
+		vs, ws := v.Col.([][]interface{}), w.Col.([][]interface{})
+		for i, flag := range flags {
+			if flag > 0 {
+				vs = append(vs, ws[int(offset)+i])
+			}
+		}
+		v.Col = vs
+
+	case types.T_char, types.T_varchar, types.T_json:
+		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
+		incSize := 0
+		for i, flag := range flags {
+			if flag > 0 {
+				incSize += int(ws.Lengths[int(offset)+i])
+			}
+		}
+
+		if len(v.Data) == 0 {
+			newSize := 8
+			for newSize < incSize {
+				newSize <<= 1
+			}
+			data, err := mheap.Alloc(m, int64(newSize))
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			v.Data = data
+			vs.Data = data[:0]
+		} else if n := len(vs.Data); n+incSize > cap(vs.Data) {
+			data, err := mheap.Grow(m, vs.Data, int64(n+incSize))
+			if err != nil {
+				return err
+			}
+			mheap.Free(m, v.Data)
+			v.Data = data
+			vs.Data = data[:n]
+		}
+
+		for i, flag := range flags {
+			if flag > 0 {
+				from := ws.Get(offset + int64(i))
+				vs.Lengths = append(vs.Lengths, uint32(len(from)))
 				vs.Offsets = append(vs.Offsets, uint32(len(vs.Data)))
 				vs.Data = append(vs.Data, from...)
 			}
@@ -1823,6 +2204,90 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			}
 			v.Col = vs
 		}
+	case types.T_time:
+		col := w.Col.([]types.Time)
+		if len(v.Data) == 0 {
+			newSize := 8
+			for newSize < cnt {
+				newSize <<= 1
+			}
+			data, err := mheap.Alloc(m, int64(newSize)*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeTimeSlice(data)[:cnt]
+			for i, j := 0, 0; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Time)
+			n := len(vs)
+			if n+cnt > cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+cnt)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeTimeSlice(data)
+				v.Data = data
+			}
+			vs = vs[:n+cnt]
+			for i, j := 0, n; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+		}
+	case types.T_timestamp:
+		col := w.Col.([]types.Timestamp)
+		if len(v.Data) == 0 {
+			newSize := 8
+			for newSize < cnt {
+				newSize <<= 1
+			}
+			data, err := mheap.Alloc(m, int64(newSize)*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeTimestampSlice(data)[:cnt]
+			for i, j := 0, 0; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Timestamp)
+			n := len(vs)
+			if n+cnt > cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*8], int64(n+cnt)*8)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeTimestampSlice(data)
+				v.Data = data
+			}
+			vs = vs[:n+cnt]
+			for i, j := 0, n; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+		}
 
 	}
 
@@ -1837,6 +2302,55 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 	return nil
 }
 
+// AppendKey appends the encoding of the i-th value of v to key, two
+// values of the same type are encoded the same iff they are equal. It
+// returns an error if values of the type of v cannot be encoded.
+func AppendKey(key []byte, v *Vector, i int64) ([]byte, error) {
+	if nulls.Contains(v.Nsp, uint64(i)) {
+		return append(key, 0), nil
+	}
+	key = append(key, 1)
+	switch vs := v.Col.(type) {
+	case []int8:
+		return append(key, encoding.EncodeInt8(vs[i])...), nil
+	case []int16:
+		return append(key, encoding.EncodeInt16(vs[i])...), nil
+	case []int32:
+		return append(key, encoding.EncodeInt32(vs[i])...), nil
+	case []int64:
+		return append(key, encoding.EncodeInt64(vs[i])...), nil
+	case []uint8:
+		return append(key, encoding.EncodeUint8(vs[i])...), nil
+	case []uint16:
+		return append(key, encoding.EncodeUint16(vs[i])...), nil
+	case []uint32:
+		return append(key, encoding.EncodeUint32(vs[i])...), nil
+	case []uint64:
+		return append(key, encoding.EncodeUint64(vs[i])...), nil
+	case []float32:
+		return append(key, encoding.EncodeFloat32(vs[i])...), nil
+	case []float64:
+		return append(key, encoding.EncodeFloat64(vs[i])...), nil
+	case []types.Decimal64:
+		return append(key, encoding.EncodeDecimal64(vs[i])...), nil
+	case []types.Decimal128:
+		return append(key, encoding.EncodeDecimal128(vs[i])...), nil
+	case []types.Date:
+		return append(key, encoding.EncodeDate(vs[i])...), nil
+	case []types.Datetime:
+		return append(key, encoding.EncodeDatetime(vs[i])...), nil
+	case []types.Time:
+		return append(key, encoding.EncodeTime(vs[i])...), nil
+	case []types.Timestamp:
+		return append(key, encoding.EncodeTimestamp(vs[i])...), nil
+	case *types.Bytes:
+		data := vs.Get(i)
+		key = append(key, encoding.EncodeUint32(uint32(len(data)))...)
+		return append(key, data...), nil
+	}
+	return nil, errors.New(fmt.Sprintf("unexpect type %s for function vector.AppendKey", v.Typ))
+}
+
 func (v *Vector) Show() ([]byte, error) {
 	var buf bytes.Buffer
 
@@ -1937,7 +2451,7 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeUint64Slice(v.Col.([]uint64)))
 		return buf.Bytes(), nil
-	case types.T_decimal:
+	case types.T_float32:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -1947,9 +2461,9 @@ func (v *Vector) Show() ([]byte, error) {
 		if len(nb) > 0 {
 			buf.Write(nb)
 		}
-		buf.Write(encoding.EncodeDecimalSlice(v.Col.([]types.Decimal)))
+		buf.Write(encoding.EncodeFloat32Slice(v.Col.([]float32)))
 		return buf.Bytes(), nil
-	case types.T_float32:
+	case types.T_float64:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -1959,9 +2473,9 @@ func (v *Vector) Show() ([]byte, error) {
 		if len(nb) > 0 {
 			buf.Write(nb)
 		}
-		buf.Write(encoding.EncodeFloat32Slice(v.Col.([]float32)))
+		buf.Write(encoding.EncodeFloat64Slice(v.Col.([]float64)))
 		return buf.Bytes(), nil
-	case types.T_float64:
+	case types.T_decimal64:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -1971,7 +2485,19 @@ func (v *Vector) Show() ([]byte, error) {
 		if len(nb) > 0 {
 			buf.Write(nb)
 		}
-		buf.Write(encoding.EncodeFloat64Slice(v.Col.([]float64)))
+		buf.Write(encoding.EncodeDecimal64Slice(v.Col.([]types.Decimal64)))
+		return buf.Bytes(), nil
+	case types.T_decimal128:
+		buf.Write(encoding.EncodeType(v.Typ))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		buf.Write(encoding.EncodeDecimal128Slice(v.Col.([]types.Decimal128)))
 		return buf.Bytes(), nil
 	case types.T_date:
 		buf.Write(encoding.EncodeType(v.Typ))
@@ -1997,6 +2523,30 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeDatetimeSlice(v.Col.([]types.Datetime)))
 		return buf.Bytes(), nil
+	case types.T_time:
+		buf.Write(encoding.EncodeType(v.Typ))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		buf.Write(encoding.EncodeTimeSlice(v.Col.([]types.Time)))
+		return buf.Bytes(), nil
+	case types.T_timestamp:
+		buf.Write(encoding.EncodeType(v.Typ))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		buf.Write(encoding.EncodeTimestampSlice(v.Col.([]types.Timestamp)))
+		return buf.Bytes(), nil
 	case types.T_sel:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
@@ -2026,7 +2576,15 @@ func (v *Vector) Show() ([]byte, error) {
 			return buf.Bytes(), nil
 		}
 		buf.Write(encoding.EncodeUint32Slice(Col.Lengths))
-		buf.Write(Col.Data)
+		if isCompact(Col) {
+			buf.Write(Col.Data)
+		} else {
+			// offsets no longer describe Data sequentially (e.g. after Shrink),
+			// write each value so Read can rebuild them from the lengths
+			for i, o := range Col.Offsets {
+				buf.Write(Col.Data[o : o+Col.Lengths[i]])
+			}
+		}
 		return buf.Bytes(), nil
 	case types.T_tuple:
 		buf.Write(encoding.EncodeType(v.Typ))
@@ -2144,38 +2702,49 @@ func (v *Vector) Read(data []byte) error {
 			}
 			v.Col = encoding.DecodeUint64Slice(data[size:])
 		}
-	case types.T_decimal:
+	case types.T_float32:
 		size := encoding.DecodeUint32(data)
 		if size == 0 {
-			v.Col = encoding.DecodeDecimalSlice(data[4:])
+			v.Col = encoding.DecodeFloat32Slice(data[4:])
 		} else {
 			data = data[4:]
 			if err := v.Nsp.Read(data[:size]); err != nil {
 				return err
 			}
-			v.Col = encoding.DecodeDecimalSlice(data[size:])
+			v.Col = encoding.DecodeFloat32Slice(data[size:])
 		}
-	case types.T_float32:
+	case types.T_float64:
 		size := encoding.DecodeUint32(data)
 		if size == 0 {
-			v.Col = encoding.DecodeFloat32Slice(data[4:])
+			v.Col = encoding.DecodeFloat64Slice(data[4:])
 		} else {
 			data = data[4:]
 			if err := v.Nsp.Read(data[:size]); err != nil {
 				return err
 			}
-			v.Col = encoding.DecodeFloat32Slice(data[size:])
+			v.Col = encoding.DecodeFloat64Slice(data[size:])
 		}
-	case types.T_float64:
+	case types.T_decimal64:
 		size := encoding.DecodeUint32(data)
 		if size == 0 {
-			v.Col = encoding.DecodeFloat64Slice(data[4:])
+			v.Col = encoding.DecodeDecimal64Slice(data[4:])
 		} else {
 			data = data[4:]
 			if err := v.Nsp.Read(data[:size]); err != nil {
 				return err
 			}
-			v.Col = encoding.DecodeFloat64Slice(data[size:])
+			v.Col = encoding.DecodeDecimal64Slice(data[size:])
+		}
+	case types.T_decimal128:
+		size := encoding.DecodeUint32(data)
+		if size == 0 {
+			v.Col = encoding.DecodeDecimal128Slice(data[4:])
+		} else {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:size]); err != nil {
+				return err
+			}
+			v.Col = encoding.DecodeDecimal128Slice(data[size:])
 		}
 	case types.T_date:
 		size := encoding.DecodeUint32(data)
@@ -2199,6 +2768,28 @@ func (v *Vector) Read(data []byte) error {
 			}
 			v.Col = encoding.DecodeDatetimeSlice(data[size:])
 		}
+	case types.T_time:
+		size := encoding.DecodeUint32(data)
+		if size == 0 {
+			v.Col = encoding.DecodeTimeSlice(data[4:])
+		} else {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:size]); err != nil {
+				return err
+			}
+			v.Col = encoding.DecodeTimeSlice(data[size:])
+		}
+	case types.T_timestamp:
+		size := encoding.DecodeUint32(data)
+		if size == 0 {
+			v.Col = encoding.DecodeTimestampSlice(data[4:])
+		} else {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:size]); err != nil {
+				return err
+			}
+			v.Col = encoding.DecodeTimestampSlice(data[size:])
+		}
 	case types.T_char, types.T_varchar, types.T_json:
 		Col := v.Col.(*types.Bytes)
 		Col.Reset()
@@ -2321,8 +2912,8 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_decimal:
-		col := v.Col.([]types.Decimal)
+	case types.T_float32:
+		col := v.Col.([]float32)
 		if len(col) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
 				return "null"
@@ -2330,8 +2921,8 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_float32:
-		col := v.Col.([]float32)
+	case types.T_float64:
+		col := v.Col.([]float64)
 		if len(col) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
 				return "null"
@@ -2339,13 +2930,22 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_float64:
-		col := v.Col.([]float64)
+	case types.T_decimal64:
+		col := v.Col.([]types.Decimal64)
 		if len(col) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
 				return "null"
 			} else {
-				return fmt.Sprintf("%v", col[0])
+				return col[0].ToString(v.Typ.Precision)
+			}
+		}
+	case types.T_decimal128:
+		col := v.Col.([]types.Decimal128)
+		if len(col) == 1 {
+			if nulls.Contains(v.Nsp, 0) {
+				return "null"
+			} else {
+				return col[0].ToString(v.Typ.Precision)
 			}
 		}
 	case types.T_date:
@@ -2366,6 +2966,24 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
+	case types.T_time:
+		col := v.Col.([]types.Time)
+		if len(col) == 1 {
+			if nulls.Contains(v.Nsp, 0) {
+				return "null"
+			} else {
+				return fmt.Sprintf("%v", col[0])
+			}
+		}
+	case types.T_timestamp:
+		col := v.Col.([]types.Timestamp)
+		if len(col) == 1 {
+			if nulls.Contains(v.Nsp, 0) {
+				return "null"
+			} else {
+				return fmt.Sprintf("%v", col[0])
+			}
+		}
 	case types.T_sel:
 		col := v.Col.([]int64)
 		if len(col) == 1 {
@@ -2676,6 +3294,60 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
+	case types.T_decimal64:
+		vs := v.Col.([]types.Decimal64)
+		for i := 0; i < rows; i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = int(selectIndexs[i])
+			}
+			if allData {
+				rs[i] = vs[index].ToString(typ.Precision)
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = vs[index].ToString(typ.Precision)
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
+	case types.T_decimal128:
+		vs := v.Col.([]types.Decimal128)
+		for i := 0; i < rows; i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = int(selectIndexs[i])
+			}
+			if allData {
+				rs[i] = vs[index].ToString(typ.Precision)
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = vs[index].ToString(typ.Precision)
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
 	case types.T_char, types.T_varchar:
 		vs := v.Col.(*types.Bytes)
 		var i int64
@@ -2704,6 +3376,34 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
+	case types.T_json:
+		vs := v.Col.(*types.Bytes)
+		var i int64
+		for i = 0; i < int64(rows); i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = selectIndexs[i]
+			}
+			if allData {
+				rs[i] = bytejson.ByteJson(vs.Get(index)).String()
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = bytejson.ByteJson(vs.Get(index)).String()
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
 	case types.T_date:
 		vs := v.Col.([]types.Date)
 		for i := 0; i < rows; i++ {
@@ -2758,8 +3458,76 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
+	case types.T_time:
+		vs := v.Col.([]types.Time)
+		for i := 0; i < rows; i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = int(selectIndexs[i])
+			}
+			if allData {
+				rs[i] = fmt.Sprintf("%s", vs[index].String())
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = fmt.Sprintf("%s", vs[index].String())
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
+	case types.T_timestamp:
+		vs := v.Col.([]types.Timestamp)
+		for i := 0; i < rows; i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = int(selectIndexs[i])
+			}
+			if allData {
+				rs[i] = fmt.Sprintf("%s", vs[index].String())
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = fmt.Sprintf("%s", vs[index].String())
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
 	default:
 		return errors.New(fmt.Sprintf("unexpect type %v for function vector.GetColumnData", typ))
 	}
 	return nil
 }
+
+// isCompact reports whether the values of Col are laid out back to back
+// from the start of Col.Data.
+func isCompact(Col *types.Bytes) bool {
+	var o uint32
+
+	for i, off := range Col.Offsets {
+		if off != o {
+			return false
+		}
+		o += Col.Lengths[i]
+	}
+	return true
+}
@@ -1064,3 +1064,22 @@ func TestVector(t *testing.T) {
 	fmt.Printf("guest: %v, host: %v\n", gm.Size(), gm.HostSize())
 }
 */
+
+func TestAppendKey(t *testing.T) {
+	v := New(types.Type{Oid: types.T(types.T_int64)})
+	v.Col = []int64{1, 2, 1}
+	nulls.Add(v.Nsp, 1)
+	k0, err := AppendKey(nil, v, 0)
+	require.NoError(t, err)
+	k1, err := AppendKey(nil, v, 1)
+	require.NoError(t, err)
+	k2, err := AppendKey(nil, v, 2)
+	require.NoError(t, err)
+	require.Equal(t, k0, k2)
+	require.NotEqual(t, k0, k1)
+
+	tv := New(types.Type{Oid: types.T(types.T_tuple)})
+	tv.Col = [][]interface{}{{int64(1)}}
+	_, err = AppendKey(nil, tv, 0)
+	require.Error(t, err)
+}
@@ -29,13 +29,19 @@ import (
 var TypeSize int
 var DateSize int
 var DatetimeSize int
-var DecimalSize int
+var TimeSize int
+var TimestampSize int
+var Decimal64Size int
+var Decimal128Size int
 
 func init() {
 	TypeSize = int(unsafe.Sizeof(types.Type{}))
 	DateSize = int(unsafe.Sizeof(types.Date(0)))
 	DatetimeSize = int(unsafe.Sizeof(types.Datetime(0)))
-	DecimalSize = int(unsafe.Sizeof(types.Decimal{}))
+	TimeSize = int(unsafe.Sizeof(types.Time(0)))
+	TimestampSize = int(unsafe.Sizeof(types.Timestamp(0)))
+	Decimal64Size = int(unsafe.Sizeof(types.Decimal64(0)))
+	Decimal128Size = int(unsafe.Sizeof(types.Decimal128{}))
 }
 
 func Encode(v interface{}) ([]byte, error) {
@@ -185,6 +191,39 @@ func DecodeDatetime(v []byte) types.Datetime {
 	return types.Datetime(DecodeInt64(v))
 }
 
+func EncodeTime(v types.Time) []byte {
+	return EncodeInt64(int64(v))
+}
+
+func DecodeTime(v []byte) types.Time {
+	return types.Time(DecodeInt64(v))
+}
+
+func EncodeTimestamp(v types.Timestamp) []byte {
+	return EncodeInt64(int64(v))
+}
+
+func DecodeTimestamp(v []byte) types.Timestamp {
+	return types.Timestamp(DecodeInt64(v))
+}
+
+func EncodeDecimal64(v types.Decimal64) []byte {
+	return EncodeInt64(int64(v))
+}
+
+func DecodeDecimal64(v []byte) types.Decimal64 {
+	return types.Decimal64(DecodeInt64(v))
+}
+
+func EncodeDecimal128(v types.Decimal128) []byte {
+	hp := reflect.SliceHeader{Data: uintptr(unsafe.Pointer(&v)), Len: 16, Cap: 16}
+	return *(*[]byte)(unsafe.Pointer(&hp))
+}
+
+func DecodeDecimal128(v []byte) types.Decimal128 {
+	return *(*types.Decimal128)(unsafe.Pointer(&v[0]))
+}
+
 func EncodeInt8Slice(v []int8) []byte {
 	hp := *(*reflect.SliceHeader)(unsafe.Pointer(&v))
 	return *(*[]byte)(unsafe.Pointer(&hp))
@@ -345,18 +384,60 @@ func DecodeDatetimeSlice(v []byte) []types.Datetime {
 	return *(*[]types.Datetime)(unsafe.Pointer(&hp))
 }
 
-func EncodeDecimalSlice(v []types.Decimal) []byte {
+func EncodeTimeSlice(v []types.Time) []byte {
+	hp := *(*reflect.SliceHeader)(unsafe.Pointer(&v))
+	hp.Len *= TimeSize
+	hp.Cap *= TimeSize
+	return *(*[]byte)(unsafe.Pointer(&hp))
+}
+
+func DecodeTimeSlice(v []byte) []types.Time {
+	hp := *(*reflect.SliceHeader)(unsafe.Pointer(&v))
+	hp.Len /= TimeSize
+	hp.Cap /= TimeSize
+	return *(*[]types.Time)(unsafe.Pointer(&hp))
+}
+
+func EncodeTimestampSlice(v []types.Timestamp) []byte {
+	hp := *(*reflect.SliceHeader)(unsafe.Pointer(&v))
+	hp.Len *= TimestampSize
+	hp.Cap *= TimestampSize
+	return *(*[]byte)(unsafe.Pointer(&hp))
+}
+
+func DecodeTimestampSlice(v []byte) []types.Timestamp {
+	hp := *(*reflect.SliceHeader)(unsafe.Pointer(&v))
+	hp.Len /= TimestampSize
+	hp.Cap /= TimestampSize
+	return *(*[]types.Timestamp)(unsafe.Pointer(&hp))
+}
+
+func EncodeDecimal64Slice(v []types.Decimal64) []byte {
+	hp := *(*reflect.SliceHeader)(unsafe.Pointer(&v))
+	hp.Len *= Decimal64Size
+	hp.Cap *= Decimal64Size
+	return *(*[]byte)(unsafe.Pointer(&hp))
+}
+
+func DecodeDecimal64Slice(v []byte) []types.Decimal64 {
+	hp := *(*reflect.SliceHeader)(unsafe.Pointer(&v))
+	hp.Len /= Decimal64Size
+	hp.Cap /= Decimal64Size
+	return *(*[]types.Decimal64)(unsafe.Pointer(&hp))
+}
+
+func EncodeDecimal128Slice(v []types.Decimal128) []byte {
 	hp := *(*reflect.SliceHeader)(unsafe.Pointer(&v))
-	hp.Len *= DecimalSize
-	hp.Cap *= DecimalSize
+	hp.Len *= Decimal128Size
+	hp.Cap *= Decimal128Size
 	return *(*[]byte)(unsafe.Pointer(&hp))
 }
 
-func DecodeDecimalSlice(v []byte) []types.Decimal {
+func DecodeDecimal128Slice(v []byte) []types.Decimal128 {
 	hp := *(*reflect.SliceHeader)(unsafe.Pointer(&v))
-	hp.Len /= DecimalSize
-	hp.Cap /= DecimalSize
-	return *(*[]types.Decimal)(unsafe.Pointer(&hp))
+	hp.Len /= Decimal128Size
+	hp.Cap /= Decimal128Size
+	return *(*[]types.Decimal128)(unsafe.Pointer(&hp))
 }
 
 func EncodeStringSlice(vs []string) []byte {
@@ -262,6 +262,26 @@ func TestEncodeDatetimeSlice(t *testing.T) {
 	}
 }
 
+func TestEncodeTimeSlice(t *testing.T) {
+	times := []types.Time{math.MinInt64, 0, math.MaxInt64}
+	timesDecode := DecodeTimeSlice(EncodeTimeSlice(times))
+	for i, v := range times {
+		if timesDecode[i] != v {
+			t.Fatalf("Time Encoding Error\n")
+		}
+	}
+}
+
+func TestEncodeTimestampSlice(t *testing.T) {
+	timestamps := []types.Timestamp{math.MinInt64, 0, math.MaxInt64}
+	timestampsDecode := DecodeTimestampSlice(EncodeTimestampSlice(timestamps))
+	for i, v := range timestamps {
+		if timestampsDecode[i] != v {
+			t.Fatalf("Timestamp Encoding Error\n")
+		}
+	}
+}
+
 func TestStringSliceEncoding(t *testing.T) {
 	xs := []string{"a", "bc", "d"}
 	data := EncodeStringSlice(xs)
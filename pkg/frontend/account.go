@@ -0,0 +1,445 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
+
+//isSuperUser returns true if the user is the root or the dump user in the system variables.
+//they have all privileges and are not kept in the account catalog.
+func isSuperUser(SV *config.SystemVariables, username string) bool {
+	return username == SV.GetRootname() || username == SV.GetDumpuser()
+}
+
+//newAccountCatalog returns the catalog of the users and roles.
+//they are kept in memory if there is no cluster catalog.
+func newAccountCatalog(pu *config.ParameterUnit) privilege.Catalog {
+	if pu.ClusterCatalog != nil {
+		return pu.ClusterCatalog
+	}
+	return privilege.NewMemCatalog()
+}
+
+//accountName returns the name of the account in the SHOW GRANTS and errors.
+//the host is not distinguished, so it is always '%'.
+func accountName(name string) string {
+	return fmt.Sprintf("'%s'@'%%'", name)
+}
+
+//getAccountCatalog returns the catalog of the users and roles
+func (mce *MysqlCmdExecutor) getAccountCatalog() (privilege.Catalog, error) {
+	if mce.routineMgr == nil || mce.routineMgr.accounts == nil {
+		return nil, fmt.Errorf("need account catalog")
+	}
+	return mce.routineMgr.accounts, nil
+}
+
+//getPrivileges returns the privileges of the user of the session.
+//return nil if the user is not restricted.
+func (mce *MysqlCmdExecutor) getPrivileges() (*privilege.Privileges, error) {
+	ses := mce.GetSession()
+	username := ses.protocol.GetUserName()
+	if mce.routineMgr == nil || mce.routineMgr.accounts == nil || isSuperUser(ses.Pu.SV, username) {
+		return nil, nil
+	}
+	return privilege.Resolve(mce.routineMgr.accounts, username)
+}
+
+//checkPrivilege returns an error if the user of the session has not the privilege on the table
+func (mce *MysqlCmdExecutor) checkPrivilege(db, tbl string, typ privilege.Type) error {
+	privs, err := mce.getPrivileges()
+	if err != nil {
+		return err
+	}
+	if privs != nil && !privs.Check(db, tbl, typ) {
+		name := typ.String()
+		if typ&privilege.GrantOption != 0 {
+			name = "GRANT OPTION"
+		}
+		return NewMysqlError(ER_SPECIFIC_ACCESS_DENIED_ERROR, name)
+	}
+	return nil
+}
+
+//privilegeLevel returns the database and the table of the level in GRANT and REVOKE.
+//the current database is used if the database is omitted.
+func (mce *MysqlCmdExecutor) privilegeLevel(level *tree.PrivilegeLevel) (string, string, error) {
+	switch level.Level {
+	case tree.PRIVILEGE_LEVEL_TYPE_GLOBAL:
+		return privilege.Any, privilege.Any, nil
+	case tree.PRIVILEGE_LEVEL_TYPE_DATABASE, tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+		db := level.DbName
+		if len(db) == 0 {
+			if db = mce.GetSession().protocol.GetDatabaseName(); len(db) == 0 {
+				return "", "", NewMysqlError(ER_NO_DB_ERROR)
+			}
+		}
+		if level.Level == tree.PRIVILEGE_LEVEL_TYPE_DATABASE {
+			return db, privilege.Any, nil
+		}
+		return db, level.TabName, nil
+	}
+	return "", "", fmt.Errorf("privilege level '%s' is not support now", tree.String(level, dialect.MYSQL))
+}
+
+//privilegeType returns the privileges in GRANT and REVOKE
+func privilegeType(privs []*tree.Privilege) (privilege.Type, error) {
+	var typ privilege.Type
+
+	for _, p := range privs {
+		t, err := privilege.TypeOf(p)
+		if err != nil {
+			return 0, err
+		}
+		typ |= t
+	}
+	return typ, nil
+}
+
+//accountPassword returns SHA1(SHA1(password)) of the user.
+//the hash string is the one of mysql_native_password like '*2470C0C06DEE42FD1618BB99005ADCA2EC9D1E19'.
+func accountPassword(u *tree.User) ([]byte, error) {
+	if len(u.HashString) == 0 {
+		return privilege.HashPassword(u.AuthString), nil
+	}
+	hash, err := hex.DecodeString(strings.TrimPrefix(u.HashString, "*"))
+	if err != nil || len(hash) != 20 {
+		return nil, fmt.Errorf("the password hash of user %s is invalid", u.Username)
+	}
+	return hash, nil
+}
+
+//accountNames returns the names of users and roles in GRANT and REVOKE
+func accountNames(users []*tree.User, roles []*tree.Role) []string {
+	var names []string
+
+	for _, u := range users {
+		names = append(names, u.Username)
+	}
+	for _, r := range roles {
+		names = append(names, r.UserName)
+	}
+	return names
+}
+
+//sendOkResponse sends the OK packet for the statement handled by the executor
+func (mce *MysqlCmdExecutor) sendOkResponse() error {
+	resp := NewOkResponse(0, 0, 0, int(mce.GetSession().GetServerStatus()), int(COM_QUERY), "")
+	if err := mce.GetSession().protocol.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+//createAccounts creates the users or roles with CREATE USER and CREATE ROLE
+func (mce *MysqlCmdExecutor) createAccounts(op string, accounts []privilege.Account, ifNotExists bool) error {
+	catalog, err := mce.getAccountCatalog()
+	if err != nil {
+		return err
+	}
+	if err = mce.checkPrivilege(privilege.Any, privilege.Any, privilege.CreateUser); err != nil {
+		return err
+	}
+	SV := mce.GetSession().Pu.SV
+	for _, a := range accounts {
+		if isSuperUser(SV, a.Name) {
+			return NewMysqlError(ER_CANNOT_USER, op, accountName(a.Name))
+		}
+		if err = catalog.CreateAccount(a); err == privilege.ErrAccountExists {
+			if ifNotExists {
+				continue
+			}
+			return NewMysqlError(ER_CANNOT_USER, op, accountName(a.Name))
+		} else if err != nil {
+			return err
+		}
+	}
+	return mce.sendOkResponse()
+}
+
+//dropAccounts drops the users or roles with DROP USER and DROP ROLE
+func (mce *MysqlCmdExecutor) dropAccounts(op string, names []string, isRole bool, ifExists bool) error {
+	catalog, err := mce.getAccountCatalog()
+	if err != nil {
+		return err
+	}
+	if err = mce.checkPrivilege(privilege.Any, privilege.Any, privilege.CreateUser); err != nil {
+		return err
+	}
+	for _, name := range names {
+		a, err := catalog.GetAccount(name)
+		if err == privilege.ErrAccountNotExists || (err == nil && a.IsRole != isRole) {
+			if ifExists {
+				continue
+			}
+			return NewMysqlError(ER_CANNOT_USER, op, accountName(name))
+		} else if err != nil {
+			return err
+		}
+		if err = catalog.DropAccount(name); err != nil {
+			return err
+		}
+	}
+	return mce.sendOkResponse()
+}
+
+/*
+handle CREATE USER
+*/
+func (mce *MysqlCmdExecutor) handleCreateUser(st *tree.CreateUser) error {
+	accounts := make([]privilege.Account, len(st.Users))
+	for i, u := range st.Users {
+		password, err := accountPassword(u)
+		if err != nil {
+			return err
+		}
+		accounts[i] = privilege.Account{Name: u.Username, Password: password}
+	}
+	return mce.createAccounts("CREATE USER", accounts, st.IfNotExists)
+}
+
+/*
+handle DROP USER
+*/
+func (mce *MysqlCmdExecutor) handleDropUser(st *tree.DropUser) error {
+	return mce.dropAccounts("DROP USER", accountNames(st.Users, nil), false, st.IfExists)
+}
+
+/*
+handle CREATE ROLE
+*/
+func (mce *MysqlCmdExecutor) handleCreateRole(st *tree.CreateRole) error {
+	accounts := make([]privilege.Account, len(st.Roles))
+	for i, r := range st.Roles {
+		accounts[i] = privilege.Account{Name: r.UserName, IsRole: true}
+	}
+	return mce.createAccounts("CREATE ROLE", accounts, st.IfNotExists)
+}
+
+/*
+handle DROP ROLE
+*/
+func (mce *MysqlCmdExecutor) handleDropRole(st *tree.DropRole) error {
+	return mce.dropAccounts("DROP ROLE", accountNames(nil, st.Roles), true, st.IfExists)
+}
+
+//updateAccounts applies the change to the users and roles of GRANT and REVOKE
+func (mce *MysqlCmdExecutor) updateAccounts(names []string, change func(*privilege.Account)) error {
+	catalog, err := mce.getAccountCatalog()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		a, err := catalog.GetAccount(name)
+		if err == privilege.ErrAccountNotExists {
+			return NewMysqlError(ER_PASSWORD_NO_MATCH)
+		} else if err != nil {
+			return err
+		}
+		change(a)
+		if err = catalog.UpdateAccount(*a); err != nil {
+			return err
+		}
+	}
+	return mce.sendOkResponse()
+}
+
+//checkRoles returns an error if the role does not exist
+func (mce *MysqlCmdExecutor) checkRoles(roles []*tree.Role) error {
+	catalog, err := mce.getAccountCatalog()
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		if a, err := catalog.GetAccount(r.UserName); err != nil || !a.IsRole {
+			return fmt.Errorf("unknown role %s", accountName(r.UserName))
+		}
+	}
+	return nil
+}
+
+/*
+handle GRANT
+*/
+func (mce *MysqlCmdExecutor) handleGrant(st *tree.Grant) error {
+	if st.IsProxy {
+		return fmt.Errorf("GRANT PROXY is not support now")
+	}
+	names := accountNames(st.Users, st.Roles)
+
+	//grant roles to the users or roles
+	if st.IsGrantRole {
+		if err := mce.checkPrivilege(privilege.Any, privilege.Any, privilege.CreateUser); err != nil {
+			return err
+		}
+		if err := mce.checkRoles(st.RolesInGrantRole); err != nil {
+			return err
+		}
+		return mce.updateAccounts(names, func(a *privilege.Account) {
+			for _, r := range st.RolesInGrantRole {
+				a.GrantRole(r.UserName)
+			}
+		})
+	}
+
+	db, tbl, err := mce.privilegeLevel(st.Level)
+	if err != nil {
+		return err
+	}
+	typ, err := privilegeType(st.Privileges)
+	if err != nil {
+		return err
+	}
+	if st.GrantOption {
+		typ |= privilege.GrantOption
+	}
+	//only the privileges held with the grant option can be granted
+	if err = mce.checkPrivilege(db, tbl, typ|privilege.GrantOption); err != nil {
+		return err
+	}
+	return mce.updateAccounts(names, func(a *privilege.Account) {
+		a.Grant(db, tbl, typ)
+	})
+}
+
+/*
+handle REVOKE
+*/
+func (mce *MysqlCmdExecutor) handleRevoke(st *tree.Revoke) error {
+	names := accountNames(st.Users, st.Roles)
+
+	//revoke roles from the users or roles
+	if st.IsRevokeRole {
+		if err := mce.checkPrivilege(privilege.Any, privilege.Any, privilege.CreateUser); err != nil {
+			return err
+		}
+		return mce.updateAccounts(names, func(a *privilege.Account) {
+			for _, r := range st.RolesInRevokeRole {
+				a.RevokeRole(r.UserName)
+			}
+		})
+	}
+
+	db, tbl, err := mce.privilegeLevel(st.Level)
+	if err != nil {
+		return err
+	}
+	typ, err := privilegeType(st.Privileges)
+	if err != nil {
+		return err
+	}
+	if typ&privilege.All == privilege.All {
+		//REVOKE ALL removes the grant option too
+		typ |= privilege.GrantOption
+	}
+	if err = mce.checkPrivilege(db, tbl, typ|privilege.GrantOption); err != nil {
+		return err
+	}
+	return mce.updateAccounts(names, func(a *privilege.Account) {
+		a.Revoke(db, tbl, typ)
+	})
+}
+
+//grantStatements returns the GRANT statements which make the privileges and roles of the account
+func grantStatements(a *privilege.Account) []string {
+	var stmts []string
+
+	//the global privileges are always the first one
+	global := privilege.Grant{Database: privilege.Any, Table: privilege.Any}
+	var grants []privilege.Grant
+	for _, g := range a.Grants {
+		if g.Database == privilege.Any {
+			global = g
+		} else {
+			grants = append(grants, g)
+		}
+	}
+	for _, g := range append([]privilege.Grant{global}, grants...) {
+		stmt := fmt.Sprintf("GRANT %s ON %s TO %s", g.Privileges, g.Level(), accountName(a.Name))
+		if g.Privileges&privilege.GrantOption != 0 {
+			stmt += " WITH GRANT OPTION"
+		}
+		stmts = append(stmts, stmt)
+	}
+	if len(a.Roles) > 0 {
+		roles := make([]string, len(a.Roles))
+		for i, r := range a.Roles {
+			roles[i] = accountName(r)
+		}
+		stmts = append(stmts, fmt.Sprintf("GRANT %s TO %s", strings.Join(roles, ","), accountName(a.Name)))
+	}
+	return stmts
+}
+
+/*
+handle SHOW GRANTS
+*/
+func (mce *MysqlCmdExecutor) handleShowGrants(st *tree.ShowGrants) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	username := st.Username
+	if len(username) == 0 {
+		username = proto.GetUserName()
+	}
+	//the grants of other users are only visible to the user who can manage them
+	if username != proto.GetUserName() {
+		if err := mce.checkPrivilege(privilege.Any, privilege.Any, privilege.CreateUser); err != nil {
+			return err
+		}
+	}
+
+	var a *privilege.Account
+	if isSuperUser(ses.Pu.SV, username) {
+		a = &privilege.Account{
+			Name:   username,
+			Grants: []privilege.Grant{{Database: privilege.Any, Table: privilege.Any, Privileges: privilege.All | privilege.GrantOption}},
+		}
+	} else {
+		catalog, err := mce.getAccountCatalog()
+		if err != nil {
+			return err
+		}
+		if a, err = catalog.GetAccount(username); err == privilege.ErrAccountNotExists {
+			return NewMysqlError(ER_NONEXISTING_GRANT, username, "%")
+		} else if err != nil {
+			return err
+		}
+	}
+
+	col := new(MysqlColumn)
+	col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	col.SetName(fmt.Sprintf("Grants for %s@%%", username))
+	ses.Mrs.AddColumn(col)
+	for _, stmt := range grantStatements(a) {
+		ses.Mrs.AddRow([]interface{}{stmt})
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, int(ses.GetServerStatus()), ses.Cmd, mer)
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
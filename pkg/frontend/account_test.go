@@ -0,0 +1,153 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMysqlProtocol_checkPasswordHash(t *testing.T) {
+	mp := &MysqlProtocolImpl{}
+	salt := []byte("01234567890123456789")
+	auth := scramblePassword([]byte("111"), salt)
+	require.True(t, mp.checkPasswordHash(privilege.HashPassword("111"), salt, auth))
+	require.False(t, mp.checkPasswordHash(privilege.HashPassword("112"), salt, auth))
+	require.False(t, mp.checkPasswordHash(privilege.HashPassword("111"), salt, nil))
+	require.True(t, mp.checkPasswordHash(nil, salt, nil))
+	require.False(t, mp.checkPasswordHash(nil, salt, auth))
+}
+
+func TestMysqlCmdExecutor_Accounts(t *testing.T) {
+	var sv config.SystemVariables
+	require.NoError(t, sv.LoadInitialValues())
+	require.NoError(t, config.LoadvarsConfigFromFile("test/system_vars_config.toml", &sv))
+	addr := startTestServer(t, &sv)
+
+	open := func(user, password string) *sql.DB {
+		db, err := sql.Open("mysql", fmt.Sprintf("%s:%s@tcp(%s)/?timeout=10s", user, password, addr))
+		require.NoError(t, err)
+		db.SetMaxOpenConns(1)
+		t.Cleanup(func() { _ = db.Close() })
+		return db
+	}
+	showGrants := func(db *sql.DB, query string) []string {
+		rows, err := db.Query(query)
+		require.NoError(t, err)
+		defer rows.Close()
+		var grants []string
+		for rows.Next() {
+			var grant string
+			require.NoError(t, rows.Scan(&grant))
+			grants = append(grants, grant)
+		}
+		require.NoError(t, rows.Err())
+		return grants
+	}
+	errorCode := func(err error) uint16 {
+		require.Error(t, err)
+		merr, ok := err.(*mysql.MySQLError)
+		require.True(t, ok, "%v", err)
+		return merr.Number
+	}
+
+	//the root without password and the dump are the super users
+	root := open("root", "")
+	require.NoError(t, root.Ping())
+	dump := open("dump", "111")
+	_, err := dump.Exec("create user u1 identified by '123', u2")
+	require.NoError(t, err)
+	_, err = dump.Exec("create user u1")
+	require.Equal(t, ER_CANNOT_USER, errorCode(err))
+	_, err = dump.Exec("create user if not exists u1")
+	require.NoError(t, err)
+	_, err = dump.Exec("create role r1")
+	require.NoError(t, err)
+	_, err = dump.Exec("create user root")
+	require.Equal(t, ER_CANNOT_USER, errorCode(err))
+
+	//the password is checked
+	require.Error(t, open("u1", "").Ping())
+	require.Error(t, open("u1", "12").Ping())
+	require.Error(t, open("r1", "").Ping())
+	require.Error(t, open("u3", "").Ping())
+	u1 := open("u1", "123")
+	require.NoError(t, u1.Ping())
+	require.NoError(t, open("u2", "").Ping())
+
+	require.Equal(t, []string{"GRANT USAGE ON *.* TO 'u1'@'%'"}, showGrants(u1, "show grants"))
+	_, err = u1.Exec("create user u3")
+	require.Equal(t, ER_SPECIFIC_ACCESS_DENIED_ERROR, errorCode(err))
+	_, err = u1.Query("show grants for u2")
+	require.Equal(t, ER_SPECIFIC_ACCESS_DENIED_ERROR, errorCode(err))
+
+	_, err = dump.Exec("grant select, insert on db1.* to u1 with grant option")
+	require.NoError(t, err)
+	_, err = dump.Exec("grant create user on *.* to r1")
+	require.NoError(t, err)
+	_, err = dump.Exec("grant r1 to u1")
+	require.NoError(t, err)
+	_, err = dump.Exec("grant select on db1.t1 to u3")
+	require.Equal(t, ER_PASSWORD_NO_MATCH, errorCode(err))
+	require.Equal(t, []string{
+		"GRANT USAGE ON *.* TO 'u1'@'%'",
+		"GRANT SELECT, INSERT ON `db1`.* TO 'u1'@'%' WITH GRANT OPTION",
+		"GRANT 'r1'@'%' TO 'u1'@'%'",
+	}, showGrants(dump, "show grants for u1"))
+
+	//u1 has the privileges of r1
+	_, err = u1.Exec("create user u3")
+	require.NoError(t, err)
+	require.Equal(t, []string{"GRANT CREATE USER ON *.* TO 'r1'@'%'"}, showGrants(u1, "show grants for r1"))
+	_, err = u1.Exec("grant select on db1.t1 to u2")
+	require.NoError(t, err)
+	_, err = u1.Exec("grant delete on db1.t1 to u2")
+	require.Equal(t, ER_SPECIFIC_ACCESS_DENIED_ERROR, errorCode(err))
+	require.Equal(t, []string{
+		"GRANT USAGE ON *.* TO 'u2'@'%'",
+		"GRANT SELECT ON `db1`.`t1` TO 'u2'@'%'",
+	}, showGrants(u1, "show grants for u2"))
+
+	_, err = dump.Exec("revoke r1 from u1")
+	require.NoError(t, err)
+	_, err = u1.Exec("drop user u3")
+	require.Equal(t, ER_SPECIFIC_ACCESS_DENIED_ERROR, errorCode(err))
+	_, err = dump.Exec("revoke insert on db1.* from u1")
+	require.NoError(t, err)
+	_, err = u1.Exec("load data infile 'none.csv' into table db1.t1 fields terminated by ','")
+	require.Equal(t, ER_SPECIFIC_ACCESS_DENIED_ERROR, errorCode(err))
+	require.Equal(t, []string{
+		"GRANT USAGE ON *.* TO 'u1'@'%'",
+		"GRANT SELECT ON `db1`.* TO 'u1'@'%' WITH GRANT OPTION",
+	}, showGrants(u1, "show grants"))
+
+	_, err = dump.Exec("drop role u1")
+	require.Equal(t, ER_CANNOT_USER, errorCode(err))
+	_, err = dump.Exec("drop user u1, u3")
+	require.NoError(t, err)
+	_, err = dump.Exec("drop user if exists u1")
+	require.NoError(t, err)
+	_, err = dump.Exec("drop role r1")
+	require.NoError(t, err)
+	require.Error(t, open("u1", "123").Ping())
+	_, err = dump.Query("show grants for u1")
+	require.Equal(t, ER_NONEXISTING_GRANT, errorCode(err))
+}
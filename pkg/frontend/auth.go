@@ -0,0 +1,248 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
+
+const (
+	//the header of the AuthMoreData packet
+	authMoreDataHeader byte = 0x01
+
+	//the status of caching_sha2_password in the AuthMoreData packet
+	cachingSha2FastAuthSuccess           byte = 0x03
+	cachingSha2PerformFullAuthentication byte = 0x04
+
+	//the client asks for the RSA public key of the server
+	cachingSha2RequestPublicKey byte = 0x02
+	sha256RequestPublicKey      byte = 0x01
+
+	//the bits of the RSA key generated by the server
+	rsaKeyBits = 2048
+)
+
+//isSupportedAuthPlugin returns true if the server can authenticate the client with the plugin
+func isSupportedAuthPlugin(plugin string) bool {
+	switch plugin {
+	case AuthNativePassword, AuthCachingSha2Password, AuthSha256Password:
+		return true
+	}
+	return false
+}
+
+//loadRSAKey loads the RSA private key which decrypts the passwords sent without TLS.
+//a key is generated if there is no key file in the system variables.
+func loadRSAKey(SV *config.SystemVariables) (*rsa.PrivateKey, error) {
+	keyFile := SV.GetRsaPrivateKeyFile()
+	if len(keyFile) == 0 {
+		return rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	}
+	data, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("read RSA private key %s failed. error:%v", keyFile, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in RSA private key %s", keyFile)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse RSA private key %s failed. error:%v", keyFile, err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a RSA private key", keyFile)
+	}
+	return rsaKey, nil
+}
+
+//sha2Digest is the digest of the password kept by caching_sha2_password for the fast authentication
+type sha2Digest struct {
+	//SHA1(SHA1(password)) in the account when the digest is cached,
+	//the digest is out of date if the password of the account has been changed
+	hash []byte
+
+	//SHA256(SHA256(password))
+	digest []byte
+}
+
+//sha2Cache keeps the digests of the users who passed the full authentication of
+//caching_sha2_password, it is shared by the connections of the server.
+type sha2Cache struct {
+	sync.RWMutex
+	digests map[string]sha2Digest
+}
+
+func newSha2Cache() *sha2Cache {
+	return &sha2Cache{digests: make(map[string]sha2Digest)}
+}
+
+//add caches the digest of the password of the user
+func (c *sha2Cache) add(username string, hash []byte, password string) {
+	hash1 := sha256.Sum256([]byte(password))
+	hash2 := sha256.Sum256(hash1[:])
+
+	c.Lock()
+	defer c.Unlock()
+	c.digests[username] = sha2Digest{hash: hash, digest: hash2[:]}
+}
+
+//check checks the scramble from the client with the cached digest.
+//Algorithm: SHA256( digest + salt ) XOR auth = SHA256( password ), and SHA256( SHA256( password ) ) = digest
+func (c *sha2Cache) check(username string, hash, salt, auth []byte) bool {
+	c.RLock()
+	d, ok := c.digests[username]
+	c.RUnlock()
+	if !ok || !bytes.Equal(d.hash, hash) || len(auth) != sha256.Size {
+		return false
+	}
+
+	sha := sha256.New()
+	sha.Write(d.digest)
+	sha.Write(salt)
+	hash1 := sha.Sum(nil)
+	for i := range hash1 {
+		hash1[i] ^= auth[i]
+	}
+	hash2 := sha256.Sum256(hash1)
+	return bytes.Equal(hash2[:], d.digest)
+}
+
+//readAuthData reads the packet of the client during the authentication
+func (mp *MysqlProtocolImpl) readAuthData() ([]byte, error) {
+	read, err := mp.tcpConn.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	if read == nil {
+		return nil, fmt.Errorf("read nil from tcp conn")
+	}
+
+	pack, ok := read.(*Packet)
+	if !ok {
+		return nil, fmt.Errorf("It is not the Packet")
+	}
+
+	if pack == nil {
+		return nil, fmt.Errorf("packet is null")
+	}
+
+	mp.sequenceId = uint8(pack.SequenceID + 1)
+	return pack.Payload, nil
+}
+
+//readPassword gets the password of the full authentication from the data of the client.
+//the password is in plaintext over TLS, otherwise it is encrypted by the RSA public key
+//of the server which is sent to the client if it asks for it.
+func (mp *MysqlProtocolImpl) readPassword(data []byte, requestPublicKey byte) (string, error) {
+	var err error
+
+	//the empty password
+	if len(data) == 0 || (len(data) == 1 && data[0] == 0) {
+		return "", nil
+	}
+	if mp.isTLS {
+		return string(bytes.TrimRight(data, "\x00")), nil
+	}
+	if mp.rsaKey == nil {
+		return "", fmt.Errorf("the password must be sent with TLS")
+	}
+
+	if len(data) == 1 && data[0] == requestPublicKey {
+		der, err := x509.MarshalPKIXPublicKey(&mp.rsaKey.PublicKey)
+		if err != nil {
+			return "", err
+		}
+		key := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+		if err = mp.writePackets(append([]byte{authMoreDataHeader}, key...)); err != nil {
+			return "", err
+		}
+		if data, err = mp.readAuthData(); err != nil {
+			return "", err
+		}
+	}
+
+	//the password ended with NUL is XORed with the salt before the encryption
+	if data, err = rsa.DecryptOAEP(sha1.New(), nil, mp.rsaKey, data, nil); err != nil {
+		return "", fmt.Errorf("decrypt the password failed. error:%v", err)
+	}
+	for i := range data {
+		data[i] ^= mp.salt[i%len(mp.salt)]
+	}
+	return string(bytes.TrimRight(data, "\x00")), nil
+}
+
+//authenticateCachingSha2 authenticates the client with caching_sha2_password.
+//the scramble is checked with the cached digest in the fast authentication,
+//otherwise the client sends the password in the full authentication.
+func (mp *MysqlProtocolImpl) authenticateCachingSha2(hash, auth []byte) (bool, error) {
+	//the account without password
+	if len(hash) == 0 {
+		return len(auth) == 0, nil
+	}
+	if len(auth) == 0 {
+		return false, nil
+	}
+
+	if mp.sha2Cache != nil && mp.sha2Cache.check(mp.username, hash, mp.salt, auth) {
+		return true, mp.writePackets([]byte{authMoreDataHeader, cachingSha2FastAuthSuccess})
+	}
+
+	if err := mp.writePackets([]byte{authMoreDataHeader, cachingSha2PerformFullAuthentication}); err != nil {
+		return false, err
+	}
+	data, err := mp.readAuthData()
+	if err != nil {
+		return false, err
+	}
+	password, err := mp.readPassword(data, cachingSha2RequestPublicKey)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(privilege.HashPassword(password), hash) {
+		return false, nil
+	}
+	if mp.sha2Cache != nil {
+		mp.sha2Cache.add(mp.username, hash, password)
+	}
+	return true, nil
+}
+
+//authenticateSha256 authenticates the client with sha256_password.
+//the client sends the password in the handshake response or after receiving the RSA public key.
+func (mp *MysqlProtocolImpl) authenticateSha256(hash, auth []byte) (bool, error) {
+	password, err := mp.readPassword(auth, sha256RequestPublicKey)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(privilege.HashPassword(password), hash), nil
+}
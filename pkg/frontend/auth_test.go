@@ -0,0 +1,127 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/stretchr/testify/require"
+)
+
+//scrambleSha256Password makes the scramble of caching_sha2_password like the client
+func scrambleSha256Password(password, salt []byte) []byte {
+	hash1 := sha256.Sum256(password)
+	hash2 := sha256.Sum256(hash1[:])
+	hash3 := sha256.Sum256(append(hash2[:], salt...))
+	for i := range hash1 {
+		hash1[i] ^= hash3[i]
+	}
+	return hash1[:]
+}
+
+func TestSha2Cache(t *testing.T) {
+	c := newSha2Cache()
+	salt := []byte("01234567890123456789")
+	hash := privilege.HashPassword("111")
+	auth := scrambleSha256Password([]byte("111"), salt)
+	require.False(t, c.check("dump", hash, salt, auth))
+
+	c.add("dump", hash, "111")
+	require.True(t, c.check("dump", hash, salt, auth))
+	require.False(t, c.check("dump", hash, salt, scrambleSha256Password([]byte("112"), salt)))
+	require.False(t, c.check("dump", hash, []byte("98765432109876543210"), auth))
+	require.False(t, c.check("root", hash, salt, auth))
+
+	//the password has been changed
+	require.False(t, c.check("dump", privilege.HashPassword("112"), salt, auth))
+}
+
+func TestLoadRSAKey(t *testing.T) {
+	var sv config.SystemVariables
+	require.NoError(t, sv.LoadInitialValues())
+
+	key, err := loadRSAKey(&sv)
+	require.NoError(t, err)
+	require.Equal(t, rsaKeyBits, key.N.BitLen())
+
+	key, err = rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	keyFiles := []string{filepath.Join(dir, "pkcs1.pem"), filepath.Join(dir, "pkcs8.pem")}
+	require.NoError(t, ioutil.WriteFile(keyFiles[0], pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFiles[1], pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+	for _, keyFile := range keyFiles {
+		require.NoError(t, sv.SetRsaPrivateKeyFile(keyFile))
+		loaded, err := loadRSAKey(&sv)
+		require.NoError(t, err)
+		require.True(t, key.Equal(loaded))
+	}
+
+	certFile, _ := writeTestCertificate(t, dir)
+	require.NoError(t, sv.SetRsaPrivateKeyFile(certFile))
+	_, err = loadRSAKey(&sv)
+	require.Error(t, err)
+	require.NoError(t, sv.SetRsaPrivateKeyFile(filepath.Join(dir, "missing.pem")))
+	_, err = loadRSAKey(&sv)
+	require.Error(t, err)
+}
+
+func TestMysqlClientProtocol_Sha2Authentication(t *testing.T) {
+	require.NoError(t, mysql.RegisterTLSConfig("mo-auth-test", &tls.Config{InsecureSkipVerify: true}))
+	defer mysql.DeregisterTLSConfig("mo-auth-test")
+
+	for _, plugin := range []string{AuthCachingSha2Password, AuthSha256Password} {
+		var sv config.SystemVariables
+		require.NoError(t, sv.LoadInitialValues())
+		require.NoError(t, config.LoadvarsConfigFromFile("test/system_vars_config.toml", &sv))
+		certFile, keyFile := writeTestCertificate(t, t.TempDir())
+		require.NoError(t, sv.SetTlsCertFile(certFile))
+		require.NoError(t, sv.SetTlsKeyFile(keyFile))
+		require.NoError(t, sv.SetDefaultAuthenticationPlugin(plugin))
+		addr := startTestServer(t, &sv)
+
+		ping := func(user, password, param string) error {
+			dsn := fmt.Sprintf("%s:%s@tcp(%s)/?timeout=10s&tls=%s", user, password, addr, param)
+			db, err := sql.Open("mysql", dsn)
+			require.NoError(t, err)
+			defer db.Close()
+			return db.Ping()
+		}
+		for _, param := range []string{"false", "mo-auth-test"} {
+			//the full authentication of caching_sha2_password is followed by the fast one
+			require.NoError(t, ping("dump", "111", param), "%s tls=%s", plugin, param)
+			require.NoError(t, ping("dump", "111", param), "%s tls=%s", plugin, param)
+			require.Error(t, ping("dump", "112", param), "%s tls=%s", plugin, param)
+			require.Error(t, ping("dump", "", param), "%s tls=%s", plugin, param)
+			require.NoError(t, ping("root", "", param), "%s tls=%s", plugin, param)
+			require.Error(t, ping("root", "111", param), "%s tls=%s", plugin, param)
+		}
+	}
+}
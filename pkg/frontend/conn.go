@@ -0,0 +1,223 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+)
+
+const (
+	//the length of the header of the compressed packet
+	//int<3> length of the compressed payload
+	//int<1> sequence id of the compressed packet
+	//int<3> length of the payload before the compression, 0 if the payload is not compressed
+	compressedHeaderLength int = 7
+
+	//the payload shorter than it is sent without the compression
+	minCompressLength int = 50
+)
+
+//clientListener accepts the connections of the clients
+type clientListener struct {
+	net.Listener
+}
+
+func newClientListener(addr string) (net.Listener, error) {
+	listener, err := net.Listen("tcp4", addr)
+	if err != nil {
+		return nil, err
+	}
+	return &clientListener{Listener: listener}, nil
+}
+
+func (l *clientListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &clientConn{Conn: conn, alg: compress.None}, nil
+}
+
+//clientConn is the connection of the client.
+//It begins as a plain tcp connection and is upgraded to TLS
+//when the client sends the SSL request in the handshake.
+//The packets are compressed after the handshake if the client asks for it.
+type clientConn struct {
+	net.Conn
+
+	//the compression algorithm of the packets
+	alg int
+
+	//the sequence id of the next compressed packet to be sent
+	seq uint8
+
+	//the decompressed data which has not been read
+	rbuf []byte
+
+	//the buffer for the compressed payload to be sent
+	wbuf []byte
+}
+
+//getClientConn returns the clientConn of the session, nil if the session
+//is not accepted by the clientListener
+func getClientConn(rs goetty.IOSession) *clientConn {
+	conn, err := rs.RawConn()
+	if err != nil {
+		return nil
+	}
+	cc, _ := conn.(*clientConn)
+	return cc
+}
+
+//upgrade makes the TLS handshake with the client.
+//buffered is the data which has been read from the connection but not handled.
+func (c *clientConn) upgrade(cfg *tls.Config, buffered []byte) error {
+	conn := tls.Server(&bufferedConn{Conn: c.Conn, buf: buffered}, cfg)
+	if err := conn.SetDeadline(time.Now().Add(tlsHandshakeTimeout)); err != nil {
+		return err
+	}
+	if err := conn.Handshake(); err != nil {
+		return fmt.Errorf("TLS handshake failed. error:%v", err)
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return err
+	}
+	c.Conn = conn
+	return nil
+}
+
+//enableCompression makes the packets compressed by the algorithm from now on
+func (c *clientConn) enableCompression(alg int) {
+	c.alg = alg
+	c.seq = 0
+}
+
+func (c *clientConn) Read(p []byte) (int, error) {
+	if c.alg == compress.None {
+		return c.Conn.Read(p)
+	}
+	for len(c.rbuf) == 0 {
+		if err := c.readCompressedPacket(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.rbuf)
+	c.rbuf = c.rbuf[n:]
+	return n, nil
+}
+
+//readCompressedPacket reads a compressed packet and decompresses its payload into the rbuf
+func (c *clientConn) readCompressedPacket() error {
+	var header [compressedHeaderLength]byte
+	if _, err := io.ReadFull(c.Conn, header[:]); err != nil {
+		return err
+	}
+	length := readUint24(header[0:])
+	originLength := readUint24(header[4:])
+	//the response follows the sequence id of the request
+	c.seq = header[3] + 1
+	if originLength > int(MaxPayloadSize) {
+		return fmt.Errorf("the length of the decompressed packet is %d, more than %d", originLength, MaxPayloadSize)
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.Conn, payload); err != nil {
+		return err
+	}
+	if originLength == 0 {
+		c.rbuf = payload
+		return nil
+	}
+	data, err := compress.Decompress(payload, make([]byte, originLength), c.alg)
+	if err != nil {
+		return fmt.Errorf("decompress the packet failed. error:%v", err)
+	}
+	if len(data) != originLength {
+		return fmt.Errorf("the length of the decompressed packet is %d, but %d is expected", len(data), originLength)
+	}
+	c.rbuf = data
+	return nil
+}
+
+func (c *clientConn) Write(p []byte) (int, error) {
+	if c.alg == compress.None {
+		return c.Conn.Write(p)
+	}
+	for i := 0; i < len(p); {
+		n := Min(int(MaxPayloadSize), len(p)-i)
+		if err := c.writeCompressedPacket(p[i : i+n]); err != nil {
+			return i, err
+		}
+		i += n
+	}
+	return len(p), nil
+}
+
+//writeCompressedPacket sends the data in a compressed packet.
+//The data is sent as it is if it is short or can not be compressed.
+func (c *clientConn) writeCompressedPacket(data []byte) error {
+	payload, originLength := data, 0
+	if len(data) >= minCompressLength {
+		buf, err := compress.Compress(data, c.wbuf, c.alg)
+		if err != nil {
+			return fmt.Errorf("compress the packet failed. error:%v", err)
+		}
+		c.wbuf = buf
+		if len(buf) < len(data) {
+			payload, originLength = buf, len(data)
+		}
+	}
+	var header [compressedHeaderLength]byte
+	writeUint24(header[0:], len(payload))
+	header[3] = c.seq
+	writeUint24(header[4:], originLength)
+	c.seq++
+
+	bufs := net.Buffers{header[:], payload}
+	_, err := bufs.WriteTo(c.Conn)
+	return err
+}
+
+func readUint24(data []byte) int {
+	return int(data[0]) | int(data[1])<<8 | int(data[2])<<16
+}
+
+func writeUint24(data []byte, value int) {
+	data[0] = byte(value)
+	data[1] = byte(value >> 8)
+	data[2] = byte(value >> 16)
+}
+
+//bufferedConn reads the buffered data before the connection
+type bufferedConn struct {
+	net.Conn
+	buf []byte
+}
+
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	if len(c.buf) > 0 {
+		n := copy(p, c.buf)
+		c.buf = c.buf[n:]
+		return n, nil
+	}
+	return c.Conn.Read(p)
+}
@@ -0,0 +1,208 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"io"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+//startTestServer starts a server which accepts the connections by the clientListener
+//and returns its address
+func startTestServer(t *testing.T, sv *config.SystemVariables) string {
+	config.HostMmu = host.New(sv.GetHostMmuLimitation())
+	config.Mempool = mempool.New()
+	pu := config.NewParameterUnit(sv, config.HostMmu, config.Mempool, config.StorageEngine, config.ClusterNodes, nil)
+	ppu := NewPDCallbackParameterUnit(int(sv.GetPeriodOfEpochTimer()), int(sv.GetPeriodOfPersistence()), int(sv.GetPeriodOfDDLDeleteTimer()), int(sv.GetTimeoutOfHeartbeat()), sv.GetEnableEpochLogging(), math.MaxInt64)
+	rm := NewRoutineManager(pu, NewPDCallbackImpl(ppu))
+	tlsConfig, err := loadTLSConfig(sv)
+	require.NoError(t, err)
+	rm.tlsConfig = tlsConfig
+	rsaKey, err := loadRSAKey(sv)
+	require.NoError(t, err)
+	rm.rsaKey = rsaKey
+
+	listener, err := newClientListener("127.0.0.1:0")
+	require.NoError(t, err)
+	encoder, decoder := NewSqlCodec()
+	app, err := goetty.NewApplication(listener, rm.Handler,
+		goetty.WithAppSessionOptions(
+			goetty.WithCodec(encoder, decoder),
+			goetty.WithLogger(logutil.GetGlobalLogger())),
+		goetty.WithAppSessionAware(rm))
+	require.NoError(t, err)
+	require.NoError(t, app.Start())
+	t.Cleanup(func() {
+		require.NoError(t, app.Stop())
+	})
+	return listener.Addr().String()
+}
+
+func TestClientConn_Compression(t *testing.T) {
+	for _, alg := range []int{compress.Zlib, compress.Zstd} {
+		server, client := net.Pipe()
+		sc, cc := &clientConn{Conn: server}, &clientConn{Conn: client}
+		sc.enableCompression(alg)
+		cc.enableCompression(alg)
+
+		//short data is not compressed, and the data longer than 16MB is split
+		short := []byte("select 1")
+		long := bytes.Repeat([]byte("matrixone"), int(MaxPayloadSize)/9+100)
+		errs := make(chan error, 1)
+		go func() {
+			for _, data := range [][]byte{short, long} {
+				if _, err := cc.Write(data); err != nil {
+					errs <- err
+					return
+				}
+			}
+			errs <- nil
+		}()
+
+		for _, data := range [][]byte{short, long} {
+			buf := make([]byte, len(data))
+			_, err := io.ReadFull(sc, buf)
+			require.NoError(t, err)
+			require.Equal(t, data, buf)
+		}
+		require.NoError(t, <-errs)
+		//the long data is sent in two compressed packets, and
+		//the response follows the sequence id of the last one
+		require.Equal(t, uint8(3), cc.seq)
+		require.Equal(t, uint8(3), sc.seq)
+
+		require.NoError(t, server.Close())
+		require.NoError(t, client.Close())
+	}
+}
+
+func TestClientConn_DecompressionBomb(t *testing.T) {
+	raw := make([]byte, 64<<20)
+	for _, alg := range []int{compress.Zlib, compress.Zstd} {
+		payload, err := compress.Compress(raw, nil, alg)
+		require.NoError(t, err)
+		server, client := net.Pipe()
+		sc := &clientConn{Conn: server}
+		sc.enableCompression(alg)
+
+		//the packet declares 1KB of data, but its payload expands to 64MB
+		var header [compressedHeaderLength]byte
+		writeUint24(header[0:], len(payload))
+		writeUint24(header[4:], 1024)
+		go client.Write(append(header[:], payload...))
+		require.Error(t, sc.readCompressedPacket())
+
+		require.NoError(t, server.Close())
+		require.NoError(t, client.Close())
+	}
+}
+
+//writeTestPacket sends the payload in a packet with the sequence id
+func writeTestPacket(t *testing.T, conn net.Conn, seq uint8, payload []byte) {
+	header := []byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), seq}
+	_, err := conn.Write(append(header, payload...))
+	require.NoError(t, err)
+}
+
+//readTestPacket returns the payload of the packet
+func readTestPacket(t *testing.T, conn net.Conn) []byte {
+	var header [4]byte
+	_, err := io.ReadFull(conn, header[:])
+	require.NoError(t, err)
+	payload := make([]byte, readUint24(header[:]))
+	_, err = io.ReadFull(conn, payload)
+	require.NoError(t, err)
+	return payload
+}
+
+//scramblePassword makes the auth response of mysql_native_password
+func scramblePassword(password, salt []byte) []byte {
+	hash1 := sha1.Sum(password)
+	hash2 := sha1.Sum(hash1[:])
+	hash3 := sha1.Sum(append(append([]byte{}, salt...), hash2[:]...))
+	for i := range hash3 {
+		hash3[i] ^= hash1[i]
+	}
+	return hash3[:]
+}
+
+func TestMysqlClientProtocol_Compression(t *testing.T) {
+	var sv config.SystemVariables
+	require.NoError(t, sv.LoadInitialValues())
+	require.NoError(t, config.LoadvarsConfigFromFile("test/system_vars_config.toml", &sv))
+	addr := startTestServer(t, &sv)
+
+	kases := []struct {
+		capability uint32
+		alg        int
+	}{
+		{CLIENT_COMPRESS, compress.Zlib},
+		{CLIENT_ZSTD_COMPRESSION_ALGORITHM, compress.Zstd},
+	}
+	for _, kase := range kases {
+		conn, err := net.DialTimeout("tcp", addr, 10*time.Second)
+		require.NoError(t, err)
+		require.NoError(t, conn.SetDeadline(time.Now().Add(10*time.Second)))
+
+		//the handshake v10 packet
+		hs := readTestPacket(t, conn)
+		pos := bytes.IndexByte(hs, 0) + 1
+		salt := append(append([]byte{}, hs[pos+4:pos+12]...), hs[pos+31:pos+43]...)
+		capability := uint32(binary.LittleEndian.Uint16(hs[pos+13:])) | uint32(binary.LittleEndian.Uint16(hs[pos+18:]))<<16
+		require.NotZero(t, capability&kase.capability)
+
+		//the handshake response 41 asks for the compression
+		resp := make([]byte, 8)
+		binary.LittleEndian.PutUint32(resp, CLIENT_PROTOCOL_41|CLIENT_SECURE_CONNECTION|kase.capability)
+		binary.LittleEndian.PutUint32(resp[4:], MaxPayloadSize)
+		resp = append(resp, 45)
+		resp = append(resp, make([]byte, 23)...)
+		resp = append(resp, "dump"...)
+		resp = append(resp, 0)
+		auth := scramblePassword([]byte(sv.GetDumppassword()), salt)
+		resp = append(resp, byte(len(auth)))
+		resp = append(resp, auth...)
+		if kase.capability == CLIENT_ZSTD_COMPRESSION_ALGORITHM {
+			//the compression level of zstd
+			resp = append(resp, 3)
+		}
+		writeTestPacket(t, conn, 1, resp)
+
+		//the OK packet is not compressed
+		require.Equal(t, byte(0), readTestPacket(t, conn)[0])
+
+		cc := &clientConn{Conn: conn}
+		cc.enableCompression(kase.alg)
+		writeTestPacket(t, cc, 0, []byte{COM_PING})
+		require.Equal(t, byte(0), readTestPacket(t, cc)[0])
+		//the server responds with the next sequence id
+		require.Equal(t, uint8(2), cc.seq)
+		require.NoError(t, conn.Close())
+	}
+}
@@ -222,8 +222,6 @@ func exportDataToCSVFile(oq *outputQueue) error {
 			continue
 		}
 		switch mysqlColumn.ColumnType() {
-		case defines.MYSQL_TYPE_DECIMAL:
-			return fmt.Errorf("unsupported Decimal")
 		case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_YEAR:
 			if value, err2 := oq.mrs.GetInt64(0, i); err2 != nil {
 				return err2
@@ -270,7 +268,7 @@ func exportDataToCSVFile(oq *outputQueue) error {
 					}
 				}
 			}
-		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL, defines.MYSQL_TYPE_JSON:
 			if value, err2 := oq.mrs.GetString(0, i); err2 != nil {
 				return err2
 			} else {
@@ -286,7 +284,7 @@ func exportDataToCSVFile(oq *outputQueue) error {
 					return err
 				}
 			}
-		case defines.MYSQL_TYPE_DATETIME:
+		case defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
 			if value, err2 := oq.mrs.GetValue(0, i); err2 != nil {
 				return err2
 			} else {
@@ -294,8 +292,14 @@ func exportDataToCSVFile(oq *outputQueue) error {
 					return err
 				}
 			}
-		case defines.MYSQL_TYPE_TIMESTAMP, defines.MYSQL_TYPE_TIME:
-			return fmt.Errorf("unsupported DATE/DATETIME/TIMESTAMP/MYSQL_TYPE_TIME")
+		case defines.MYSQL_TYPE_TIME:
+			if value, err2 := oq.mrs.GetValue(0, i); err2 != nil {
+				return err2
+			} else {
+				if err := formatOutputString(oq, []byte(value.(types.Time).String()), []byte(oq.ep.Symbol[i]), oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+					return err
+				}
+			}
 		default:
 			return fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
 		}
@@ -260,7 +260,7 @@ func Test_exportDataToCSVFile(t *testing.T) {
 		var col []*MysqlColumn = []*MysqlColumn{
 			&MysqlColumn{},
 		}
-		var colType = []uint8{defines.MYSQL_TYPE_TIMESTAMP}
+		var colType = []uint8{defines.MYSQL_TYPE_BIT}
 		for i := 0; i < len(col); i++ {
 			col[i].SetColumnType(colType[i])
 			oq.mrs.AddColumn(col[i])
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -79,6 +80,8 @@ type SharePart struct {
 	load *tree.Load
 	//how to handle errors during converting field
 	ignoreFieldError bool
+	//time zone of the timestamps in the file
+	loc *time.Location
 
 	//index of line in line array
 	lineIdx     int
@@ -349,7 +352,11 @@ func makeBatch(handler *ParseLineHandler, id int) *PoolElement {
 			vec.Col = make([]float32, batchSize)
 		case types.T_float64:
 			vec.Col = make([]float64, batchSize)
-		case types.T_char, types.T_varchar:
+		case types.T_decimal64:
+			vec.Col = make([]types.Decimal64, batchSize)
+		case types.T_decimal128:
+			vec.Col = make([]types.Decimal128, batchSize)
+		case types.T_char, types.T_varchar, types.T_json:
 			vBytes := &types.Bytes{
 				Offsets: make([]uint32, batchSize),
 				Lengths: make([]uint32, batchSize),
@@ -360,6 +367,10 @@ func makeBatch(handler *ParseLineHandler, id int) *PoolElement {
 			vec.Col = make([]types.Date, batchSize)
 		case types.T_datetime:
 			vec.Col = make([]types.Datetime, batchSize)
+		case types.T_time:
+			vec.Col = make([]types.Time, batchSize)
+		case types.T_timestamp:
+			vec.Col = make([]types.Timestamp, batchSize)
 		default:
 			panic("unsupported vector type")
 		}
@@ -452,7 +463,7 @@ func releaseBatch(handler *ParseLineHandler, pl *PoolElement) {
 	for _, vec := range pl.bat.Vecs {
 		vec.Nsp = &nulls.Nulls{}
 		switch vec.Typ.Oid {
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json:
 			vBytes := vec.Col.(*types.Bytes)
 			vBytes.Data = vBytes.Data[:0]
 		}
@@ -465,6 +476,7 @@ it may be suspended, when the pool does not have enough batch
 */
 func initWriteBatchHandler(handler *ParseLineHandler, wHandler *WriteBatchHandler) error {
 	wHandler.ignoreFieldError = handler.ignoreFieldError
+	wHandler.loc = handler.loc
 	wHandler.cols = handler.cols
 	wHandler.dataColumnId2TableColumnId = handler.dataColumnId2TableColumnId
 	wHandler.batchSize = handler.batchSize
@@ -813,6 +825,44 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						}
 						cols[rowIdx] = d
 					}
+				case types.T_decimal64:
+					cols := vec.Col.([]types.Decimal64)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						fs := field
+						//logutil.Infof("==== > field string [%s] ",fs)
+						d, err := types.ParseStringToDecimal64(fs, vec.Typ.Width, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = 0
+							//break
+						}
+						cols[rowIdx] = d
+					}
+				case types.T_decimal128:
+					cols := vec.Col.([]types.Decimal128)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						fs := field
+						//logutil.Infof("==== > field string [%s] ",fs)
+						d, err := types.ParseStringToDecimal128(fs, vec.Typ.Width, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = types.Decimal128{}
+							//break
+						}
+						cols[rowIdx] = d
+					}
 				case types.T_char, types.T_varchar:
 					vBytes := vec.Col.(*types.Bytes)
 					if isNullOrEmpty {
@@ -824,6 +874,26 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						vBytes.Data = append(vBytes.Data, field...)
 						vBytes.Lengths[rowIdx] = uint32(len(field))
 					}
+				case types.T_json:
+					vBytes := vec.Col.(*types.Bytes)
+					vBytes.Offsets[rowIdx] = uint32(len(vBytes.Data))
+					vBytes.Lengths[rowIdx] = 0
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						//the json text is stored in the binary format
+						bj, err := bytejson.Parse([]byte(field))
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							nulls.Add(vec.Nsp, uint64(rowIdx))
+						}
+						vBytes.Data = append(vBytes.Data, bj...)
+						vBytes.Lengths[rowIdx] = uint32(len(bj))
+					}
 				case types.T_date:
 					cols := vec.Col.([]types.Date)
 					if isNullOrEmpty {
@@ -860,6 +930,38 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						}
 						cols[rowIdx] = d
 					}
+				case types.T_time:
+					cols := vec.Col.([]types.Time)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						d, err := types.ParseTime(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[rowIdx] = d
+					}
+				case types.T_timestamp:
+					cols := vec.Col.([]types.Timestamp)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						d, err := types.ParseTimestamp(field, handler.loc)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[rowIdx] = d
+					}
 				default:
 					panic("unsupported oid")
 				}
@@ -872,7 +974,7 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 				if 0 == columnFLags[k] {
 					vec := batchData.Vecs[k]
 					switch vec.Typ.Oid {
-					case types.T_char, types.T_varchar:
+					case types.T_char, types.T_varchar, types.T_json:
 						vBytes := vec.Col.(*types.Bytes)
 						vBytes.Offsets[rowIdx] = uint32(len(vBytes.Data))
 						vBytes.Lengths[rowIdx] = uint32(0)
@@ -1133,6 +1235,52 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						cols[i] = d
 					}
 				}
+			case types.T_decimal64:
+				cols := vec.Col.([]types.Decimal64)
+				//row
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						//logutil.Infof("==== > field string [%s] ",fs)
+						d, err := types.ParseStringToDecimal64(field, vec.Typ.Width, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+							//break
+						}
+						cols[i] = d
+					}
+				}
+			case types.T_decimal128:
+				cols := vec.Col.([]types.Decimal128)
+				//row
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						//logutil.Infof("==== > field string [%s] ",fs)
+						d, err := types.ParseStringToDecimal128(field, vec.Typ.Width, vec.Typ.Precision)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = types.Decimal128{}
+							//break
+						}
+						cols[i] = d
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vBytes := vec.Col.(*types.Bytes)
 				//row
@@ -1149,6 +1297,30 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						vBytes.Lengths[i] = uint32(len(field))
 					}
 				}
+			case types.T_json:
+				vBytes := vec.Col.(*types.Bytes)
+				//row
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					vBytes.Offsets[i] = uint32(len(vBytes.Data))
+					vBytes.Lengths[i] = 0
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						bj, err := bytejson.Parse([]byte(field))
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							nulls.Add(vec.Nsp, uint64(i))
+						}
+						vBytes.Data = append(vBytes.Data, bj...)
+						vBytes.Lengths[i] = uint32(len(bj))
+					}
+				}
 			case types.T_date:
 				cols := vec.Col.([]types.Date)
 				//row
@@ -1194,6 +1366,46 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						cols[i] = d
 					}
 				}
+			case types.T_time:
+				cols := vec.Col.([]types.Time)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						d, err := types.ParseTime(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[i] = d
+					}
+				}
+			case types.T_timestamp:
+				cols := vec.Col.([]types.Timestamp)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						d, err := types.ParseTimestamp(field, handler.loc)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							d = 0
+						}
+						cols[i] = d
+					}
+				}
 			default:
 				panic("unsupported oid")
 			}
@@ -1208,7 +1420,7 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 				//row
 				for i := 0; i < countOfLineArray; i++ {
 					switch vec.Typ.Oid {
-					case types.T_char, types.T_varchar:
+					case types.T_char, types.T_varchar, types.T_json:
 						vBytes := vec.Col.(*types.Bytes)
 						vBytes.Offsets[i] = uint32(len(vBytes.Data))
 						vBytes.Lengths[i] = uint32(0)
@@ -1316,7 +1528,7 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 		for _, vec := range handler.batchData.Vecs {
 			vec.Nsp = &nulls.Nulls{}
 			switch vec.Typ.Oid {
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_json:
 				vBytes := vec.Col.(*types.Bytes)
 				vBytes.Data = vBytes.Data[:0]
 			}
@@ -1368,7 +1580,13 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					case types.T_float64:
 						cols := vec.Col.([]float64)
 						vec.Col = cols[:needLen]
-					case types.T_char, types.T_varchar: //bytes is different
+					case types.T_decimal64:
+						cols := vec.Col.([]types.Decimal64)
+						vec.Col = cols[:needLen]
+					case types.T_decimal128:
+						cols := vec.Col.([]types.Decimal128)
+						vec.Col = cols[:needLen]
+					case types.T_char, types.T_varchar, types.T_json: //bytes is different
 						vBytes := vec.Col.(*types.Bytes)
 						//logutil.Infof("saveBatchToStorage before data %s ",vBytes.String())
 						if len(vBytes.Offsets) > needLen {
@@ -1382,6 +1600,12 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					case types.T_datetime:
 						cols := vec.Col.([]types.Datetime)
 						vec.Col = cols[:needLen]
+					case types.T_time:
+						cols := vec.Col.([]types.Time)
+						vec.Col = cols[:needLen]
+					case types.T_timestamp:
+						cols := vec.Col.([]types.Timestamp)
+						vec.Col = cols[:needLen]
 					}
 				}
 
@@ -1539,6 +1763,10 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 
 	//logutil.Infof("-----write concurrent count %d ",handler.simdCsvConcurrencyCountOfWriteBatch)
 
+	if handler.loc, err = types.ParseTimeZone(ses.getSessionVars().GetTimeZone()); err != nil {
+		return nil, err
+	}
+
 	handler.ignoreFieldError = true
 	dh := handler.load.DuplicateHandling
 	if dh != nil {
@@ -1559,7 +1787,7 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 	handler.closeRef = NewCloseLoadData()
 
 	//put closeRef into the executor
-	mce.loadDataClose = handler.closeRef
+	mce.setLoadDataClose(handler.closeRef)
 
 	handler.simdCsvReader = simdcsv.NewReaderWithOptions(dataFile,
 		rune(load.Fields.Terminated[0]),
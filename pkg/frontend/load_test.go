@@ -371,6 +371,51 @@ func Test_load(t *testing.T) {
 	})
 }
 
+func Test_loadInTransaction(t *testing.T) {
+	convey.Convey("load in a transaction", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//the engine is not touched
+		eng := mock_frontend.NewMockEngine(ctrl)
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		stmts, err := parsers.Parse(dialect.MYSQL, "load data "+
+			"infile 'test/loadfile5' "+
+			"INTO TABLE T.A "+
+			"FIELDS TERMINATED BY ',' ")
+		convey.So(err, convey.ShouldBeNil)
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+
+		epochgc := getPCI()
+
+		guestMmu := guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu)
+
+		ses := NewSession(proto, epochgc, guestMmu, pu.Mempool, pu)
+		ses.SetTxnHandler(NewTxnHandler())
+		ses.GetTxnHandler().Begin(nil, false)
+
+		mce := NewMysqlCmdExecutor()
+
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		//the load cannot be rolled back, so it is rejected
+		err = mce.handleLoadData(stmts[0].(*tree.Load))
+		convey.So(err, convey.ShouldBeError, "LOAD DATA is unsupported in a transaction now")
+		convey.So(ses.GetTxnHandler().IsInTransaction(), convey.ShouldBeTrue)
+		ses.GetTxnHandler().Rollback()
+	})
+}
+
 func getParsedLinesChan(simdCsvGetParsedLinesChan chan simdcsv.LineOut) {
 	var str [][]string = [][]string{{"123"}, {"456"}, {"789"}, {"78910"}}
 	for i := 0; i < len(str); i++ {
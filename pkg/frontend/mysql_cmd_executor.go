@@ -15,10 +15,10 @@
 package frontend
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"runtime/pprof"
-	"strconv"
 	"strings"
 	"time"
 
@@ -29,12 +29,18 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/txn"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -53,15 +59,24 @@ type MysqlCmdExecutor struct {
 	//the count of sql has been processed
 	sqlCount uint64
 
-	//for load data closing
+	//for load data closing, guarded by the lock of procState
 	loadDataClose *CloseLoadData
 
-	//for export data closing
+	//for export data closing, guarded by the lock of procState
 	exportDataClose *CloseExportData
 
 	ses *Session
 
 	routineMgr *RoutineManager
+
+	//the statements prepared by COM_STMT_PREPARE in the connection
+	prepareStmts map[uint32]*PrepareStmt
+
+	//the id of the last prepared statement
+	lastStmtId uint32
+
+	//the state of the connection shown by SHOW PROCESSLIST
+	procState processState
 }
 
 func (cei *MysqlCmdExecutor) PrepareSessionBeforeExecRequest(ses *Session) {
@@ -101,6 +116,9 @@ type outputQueue struct {
 	length uint64
 	ep *tree.ExportParam
 
+	//send rows in the binary protocol for COM_STMT_EXECUTE
+	binary bool
+
 	getEmptyRowTime time.Duration
 	flushTime       time.Duration
 }
@@ -159,7 +177,13 @@ func (o *outputQueue) flush() error {
 		}
 	} else {
 		//send group of row
-		if err := o.proto.SendResultSetTextBatchRowSpeedup(o.mrs, o.rowIdx); err != nil {
+		var err error
+		if o.binary {
+			err = o.proto.SendResultSetBinaryBatchRow(o.mrs, o.rowIdx)
+		} else {
+			err = o.proto.SendResultSetTextBatchRowSpeedup(o.mrs, o.rowIdx)
+		}
+		if err != nil {
 			//return err
 			logutil.Errorf("flush error %v \n", err)
 			return err
@@ -205,6 +229,11 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	proto := ses.GetMysqlProtocol()
 	proto.PrepareBeforeProcessingResultSet()
 
+	loc, err := types.ParseTimeZone(ses.getSessionVars().GetTimeZone())
+	if err != nil {
+		return err
+	}
+
 	//Create a new temporary resultset per pipeline thread.
 	mrs := &MysqlResultSet{}
 	//Warning: Don't change ResultColumns in this.
@@ -222,6 +251,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	allocateOutBufferTime := time.Since(begin3)
 
 	oq := NewOuputQueue(proto, mrs, uint64(countOfResultSet), ses.ep)
+	oq.binary = uint8(ses.Cmd) == COM_STMT_EXECUTE
 	oq.reset()
 
 	row2colTime := time.Duration(0)
@@ -378,6 +408,30 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 						row[i] = vs[rowIndex]
 					}
 				}
+			case types.T_decimal64:
+				if !nulls.Any(vec.Nsp) { //all data in this column are not null
+					vs := vec.Col.([]types.Decimal64)
+					row[i] = vs[rowIndex].ToString(vec.Typ.Precision)
+				} else {
+					if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+						row[i] = nil
+					} else {
+						vs := vec.Col.([]types.Decimal64)
+						row[i] = vs[rowIndex].ToString(vec.Typ.Precision)
+					}
+				}
+			case types.T_decimal128:
+				if !nulls.Any(vec.Nsp) { //all data in this column are not null
+					vs := vec.Col.([]types.Decimal128)
+					row[i] = vs[rowIndex].ToString(vec.Typ.Precision)
+				} else {
+					if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+						row[i] = nil
+					} else {
+						vs := vec.Col.([]types.Decimal128)
+						row[i] = vs[rowIndex].ToString(vec.Typ.Precision)
+					}
+				}
 			case types.T_char:
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.(*types.Bytes)
@@ -426,6 +480,29 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 						row[i] = vs[rowIndex]
 					}
 				}
+			case types.T_time:
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+					row[i] = nil
+				} else {
+					vs := vec.Col.([]types.Time)
+					row[i] = vs[rowIndex]
+				}
+			case types.T_timestamp:
+				//the timestamp is sent as the datetime in the time zone of the session
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+					row[i] = nil
+				} else {
+					vs := vec.Col.([]types.Timestamp)
+					row[i] = vs[rowIndex].ToDatetime(loc)
+				}
+			case types.T_json:
+				//the json is sent as its text
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+					row[i] = nil
+				} else {
+					vs := vec.Col.(*types.Bytes)
+					row[i] = bytejson.ByteJson(vs.Get(int64(rowIndex))).String()
+				}
 			default:
 				logutil.Errorf("getDataFromPipeline : unsupported type %d \n", vec.Typ.Oid)
 				return fmt.Errorf("getDataFromPipeline : unsupported type %d \n", vec.Typ.Oid)
@@ -446,7 +523,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 
 	//logutil.Infof("row group -+> %v ", oq.getData())
 
-	err := oq.flush()
+	err = oq.flush()
 	if err != nil {
 		return err
 	}
@@ -511,7 +588,7 @@ func (mce *MysqlCmdExecutor) handleSelectDatabase(sel *tree.Select) error {
 	ses.Mrs.AddRow([]interface{}{val})
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, int(mce.GetSession().GetServerStatus()), mce.GetSession().Cmd, mer)
 
 	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -538,7 +615,7 @@ func (mce *MysqlCmdExecutor) handleMaxAllowedPacket() error {
 	ses.Mrs.AddRow(data)
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, int(mce.GetSession().GetServerStatus()), mce.GetSession().Cmd, mer)
 
 	if err := proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -564,7 +641,7 @@ func (mce *MysqlCmdExecutor) handleVersionComment() error {
 	ses.Mrs.AddRow(data)
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, int(mce.GetSession().GetServerStatus()), mce.GetSession().Cmd, mer)
 
 	if err := proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -590,7 +667,7 @@ func (mce *MysqlCmdExecutor) handleTxIsolation() error {
 	ses.Mrs.AddRow(data)
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, int(mce.GetSession().GetServerStatus()), mce.GetSession().Cmd, mer)
 
 	if err := proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -620,7 +697,7 @@ func (mce *MysqlCmdExecutor) handleSelectVariables(v string) error {
 	}
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, int(mce.GetSession().GetServerStatus()), mce.GetSession().Cmd, mer)
 
 	if err := proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -637,6 +714,14 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 	proto := ses.protocol
 
 	logutil.Infof("+++++load data")
+	/*
+		the load writes the committed tuples of the table at once, so it
+		cannot be rolled back with the writes of the active transaction
+	*/
+	if ses.GetTxnHandler().IsInTransaction() {
+		return fmt.Errorf("LOAD DATA is unsupported in a transaction now")
+	}
+
 	/*
 		TODO:support LOCAL
 	*/
@@ -652,6 +737,23 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 		return fmt.Errorf("EscapedBy field is unsupported now")
 	}
 
+	loadDb := string(load.Table.Schema())
+	loadTable := string(load.Table.Name())
+	if loadDb == "" {
+		if proto.GetDatabaseName() == "" {
+			return fmt.Errorf("load data need database")
+		}
+
+		//then, it uses the database name in the session
+		loadDb = ses.protocol.GetDatabaseName()
+	}
+
+	//the load is an insert into the table, it is checked before the file
+	//and the table are looked up
+	if err = mce.checkPrivilege(loadDb, loadTable, privilege.Insert); err != nil {
+		return err
+	}
+
 	/*
 		check file
 	*/
@@ -667,17 +769,6 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 	/*
 		check database
 	*/
-	loadDb := string(load.Table.Schema())
-	loadTable := string(load.Table.Name())
-	if loadDb == "" {
-		if proto.GetDatabaseName() == "" {
-			return fmt.Errorf("load data need database")
-		}
-
-		//then, it uses the database name in the session
-		loadDb = ses.protocol.GetDatabaseName()
-	}
-
 	dbHandler, err := ses.Pu.StorageEngine.Database(loadDb)
 	if err != nil {
 		//echo client. no such database
@@ -703,7 +794,12 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 	/*
 		execute load data
 	*/
-	result, err := mce.LoadLoop(load, dbHandler, tableHandler)
+	var result *LoadResult
+	//the load takes turns with the commits and the other writes of the table
+	err = txn.Autocommit(loadDb, loadTable, func() error {
+		result, err = mce.LoadLoop(load, dbHandler, tableHandler)
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -712,7 +808,7 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 		response
 	*/
 	info := NewMysqlError(ER_LOAD_INFO, result.Records, result.Deleted, result.Skipped, result.Warnings, result.WriteTimeout).Error()
-	resp := NewOkResponse(result.Records, 0, uint16(result.Warnings), 0, int(COM_QUERY), info)
+	resp := NewOkResponse(result.Records, 0, uint16(result.Warnings), int(mce.GetSession().GetServerStatus()), int(COM_QUERY), info)
 	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
 	}
@@ -769,6 +865,9 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 		if err != nil {
 			return err
 		}
+		if c.Type.Oid == types.T_decimal64 || c.Type.Oid == types.T_decimal128 {
+			col.SetDecimal(uint8(c.Type.Precision))
+		}
 
 		/*
 			mysql CMD_FIELD_LIST response: send the column definition per column
@@ -783,7 +882,7 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 		mysql CMD_FIELD_LIST response: End after the column has been sent.
 		send EOF packet
 	*/
-	err = proto.SendEOFPacketIf(0, 0)
+	err = proto.SendEOFPacketIf(0, mce.GetSession().GetServerStatus())
 	if err != nil {
 		return err
 	}
@@ -794,11 +893,19 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 /*
 handle setvar
 */
-func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
+func (mce *MysqlCmdExecutor) handleSetVar(sv *tree.SetVar) error {
 	var err error = nil
 	proto := mce.GetSession().protocol
 
-	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
+	if sv != nil {
+		for _, assign := range sv.Assignments {
+			if err = mce.setVar(assign); err != nil {
+				return err
+			}
+		}
+	}
+
+	resp := NewOkResponse(0, 0, 0, int(mce.GetSession().GetServerStatus()), int(COM_QUERY), "")
 	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
 	}
@@ -808,7 +915,7 @@ func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
 /*
 handle show variables
 */
-func (mce *MysqlCmdExecutor) handleShowVariables(_ *tree.ShowVariables) error {
+func (mce *MysqlCmdExecutor) handleShowVariables(sv *tree.ShowVariables) error {
 	var err error = nil
 	ses := mce.GetSession()
 	proto := mce.GetSession().protocol
@@ -824,8 +931,20 @@ func (mce *MysqlCmdExecutor) handleShowVariables(_ *tree.ShowVariables) error {
 	ses.Mrs.AddColumn(col1)
 	ses.Mrs.AddColumn(col2)
 
+	var global bool
+	var pattern []byte
+	if sv != nil {
+		global = sv.Global
+		if sv.Like != nil {
+			pattern = []byte(sv.Like.Right.String())
+		}
+	}
+	for _, row := range mce.showVars(global, pattern) {
+		ses.Mrs.AddRow(row)
+	}
+
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, int(mce.GetSession().GetServerStatus()), mce.GetSession().Cmd, mer)
 
 	if err := proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -854,6 +973,48 @@ func (mce *MysqlCmdExecutor) handleAnalyzeStmt(stmt *tree.AnalyzeStmt) error {
 	return mce.doComQuery(sql)
 }
 
+//handleBeginTransaction starts a transaction, the active one is committed implicitly
+func (mce *MysqlCmdExecutor) handleBeginTransaction(st *tree.BeginTransaction, epoch uint64) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	txnHandler := ses.GetTxnHandler()
+	if err := txnHandler.Commit(ses.Pu.StorageEngine, epoch); err != nil {
+		return err
+	}
+	txnHandler.Begin(ses.GetEpochgc(), st.Modes.ReadWriteMode() == tree.READ_WRITE_MODE_READ_ONLY)
+	resp := NewOkResponse(0, 0, 0, int(ses.GetServerStatus()), int(COM_QUERY), "")
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+//handleCommitTransaction makes the writes of the transaction visible
+func (mce *MysqlCmdExecutor) handleCommitTransaction(epoch uint64) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	if err := ses.GetTxnHandler().Commit(ses.Pu.StorageEngine, epoch); err != nil {
+		return err
+	}
+	resp := NewOkResponse(0, 0, 0, int(ses.GetServerStatus()), int(COM_QUERY), "")
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+//handleRollbackTransaction discards the writes of the transaction
+func (mce *MysqlCmdExecutor) handleRollbackTransaction() error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	ses.GetTxnHandler().Rollback()
+	resp := NewOkResponse(0, 0, 0, int(ses.GetServerStatus()), int(COM_QUERY), "")
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
 type ComputationWrapperImpl struct {
 	exec *compile.Exec
 }
@@ -873,7 +1034,20 @@ func (cw *ComputationWrapperImpl) SetDatabaseName(db string) error {
 }
 
 func (cw *ComputationWrapperImpl) GetColumns() ([]interface{}, error) {
-	columns := cw.exec.Columns()
+	return convertColumns(cw.exec.Columns())
+}
+
+//Describe gets the columns of the statement without compiling it
+func (cw *ComputationWrapperImpl) Describe() ([]interface{}, error) {
+	columns, err := cw.exec.Describe()
+	if err != nil {
+		return nil, err
+	}
+	return convertColumns(columns)
+}
+
+//convertColumns converts the columns of the computation engine into the mysql columns
+func convertColumns(columns []*compile.Col) ([]interface{}, error) {
 	var mysqlCols []interface{} = nil
 	var err error = nil
 	for _, c := range columns {
@@ -898,14 +1072,29 @@ func (cw *ComputationWrapperImpl) Compile(u interface{},
 }
 
 func (cw *ComputationWrapperImpl) Run(ts uint64) error {
-	return cw.exec.Run(ts)
+	err := cw.exec.Run(ts)
+	switch err {
+	case process.QueryInterrupted:
+		return NewMysqlError(ER_QUERY_INTERRUPTED)
+	case process.QueryTimeout:
+		return NewMysqlError(ER_QUERY_TIMEOUT)
+	case mmu.OutOfMemory:
+		return NewMysqlError(ER_CAPACITY_EXCEEDED, cw.exec.Limitation().Size, "max_query_memory", "Query execution was interrupted.")
+	}
+	return err
+}
+
+//Kill stops the computation, it is called by other connections
+func (cw *ComputationWrapperImpl) Kill() {
+	cw.exec.Kill()
 }
 
 /*
 GetComputationWrapper gets the execs from the computation engine
 */
-var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *process.Process) ([]ComputationWrapper, error) {
-	comp := compile.New(db, sql, user, eng, proc)
+var GetComputationWrapper = func(db, sql string, params []tree.Expr, user string, privs *privilege.Privileges, eng engine.Engine, proc *process.Process) ([]ComputationWrapper, error) {
+	comp := compile.NewWithParams(db, sql, params, user, eng, proc)
+	comp.SetPrivileges(privs)
 	execs, err := comp.Build()
 	if err != nil {
 		return nil, err
@@ -918,8 +1107,62 @@ var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *
 	return cw, err
 }
 
+//getComputationWrappers gets the computations of the sql with the params bound to its placeholders.
+//the memory of their process is limited by the quota which is released by the caller.
+func (mce *MysqlCmdExecutor) getComputationWrappers(sql string, params []tree.Expr) ([]ComputationWrapper, *host.Mmu, error) {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+
+	var lim process.Limitation
+	if lim.Size = ses.getSessionVars().GetMaxQueryMemory(); lim.Size == 0 {
+		lim.Size = ses.Pu.SV.GetProcessLimitationSize()
+	}
+	lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
+	lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
+	lim.ExecutionTime = time.Duration(ses.getSessionVars().GetMaxExecutionTime()) * time.Millisecond
+
+	//the timestamps of the query are converted in the time zone of the session
+	loc, err := types.ParseTimeZone(ses.getSessionVars().GetTimeZone())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	//the privileges of the user are checked in the compilation
+	privs, err := mce.getPrivileges()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	//the memory of the query is limited by the quota shared by its processes
+	quota := host.NewQuota(lim.Size, ses.GuestMmu.Mmu)
+	proc := process.New(mheap.New(guest.New(ses.GuestMmu.Limit, quota)))
+	proc.Id = mce.getNextProcessId()
+	proc.Lim = lim
+	proc.Ctx = process.WithTimeZone(context.Background(), loc)
+
+	txnHandler := ses.GetTxnHandler()
+	cws, err := GetComputationWrapper(proto.GetDatabaseName(),
+		sql,
+		params,
+		proto.GetUserName(),
+		privs,
+		txn.NewEngine(ses.Pu.StorageEngine, txnHandler.GetTxn),
+		proc)
+	if err != nil {
+		quota.Release()
+		return nil, nil, NewMysqlError(ER_PARSE_ERROR, err,
+			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
+	}
+	return cws, quota, nil
+}
+
 //execute query
 func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
+	return mce.executeQuery(sql, nil)
+}
+
+//executeQuery executes the sql with the params bound to its placeholders
+func (mce *MysqlCmdExecutor) executeQuery(sql string, params []tree.Expr) error {
 	ses := mce.GetSession()
 	proto := ses.GetMysqlProtocol()
 	pdHook := ses.GetEpochgc()
@@ -930,23 +1173,15 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 	defer func() {
 		pdHook.DecQueryCountAtEpoch(epoch, statementCount)
 	}()
+	mce.setStatement(sql)
 
-	proc := process.New(mheap.New(ses.GuestMmu))
-	proc.Id = mce.getNextProcessId()
-	proc.Lim.Size = ses.Pu.SV.GetProcessLimitationSize()
-	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
-	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
-
-	cws, err := GetComputationWrapper(proto.GetDatabaseName(),
-		sql,
-		proto.GetUserName(),
-		ses.Pu.StorageEngine,
-		proc)
+	cws, quota, err := mce.getComputationWrappers(sql, params)
 	if err != nil {
-		return NewMysqlError(ER_PARSE_ERROR, err,
-			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
+		return err
 	}
+	defer quota.Release()
 
+	txnHandler := ses.GetTxnHandler()
 	defer func() {
 		ses.Mrs = nil
 	}()
@@ -961,9 +1196,9 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 		switch st := stmt.(type) {
 		case *tree.Select:
 			if st.Ep != nil {
-				mce.exportDataClose = NewCloseExportData()
 				ses.ep = st.Ep
-				ses.closeRef = mce.exportDataClose
+				ses.closeRef = NewCloseExportData()
+				mce.setExportDataClose(ses.closeRef)
 			}
 			if sc, ok := st.Select.(*tree.SelectClause); ok {
 				if len(sc.Exprs) == 1 {
@@ -1015,8 +1250,12 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			//if none database has been selected, database operations must be failed.
 			switch t := stmt.(type) {
 			case *tree.ShowDatabases, *tree.CreateDatabase, *tree.ShowCreateDatabase, *tree.ShowWarnings, *tree.ShowErrors,
-				*tree.ShowStatus, *tree.DropDatabase, *tree.Load,
-				*tree.Use, *tree.SetVar:
+				*tree.ShowStatus, *tree.ShowVariables, *tree.DropDatabase, *tree.Load,
+				*tree.Use, *tree.SetVar,
+				*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+				*tree.CreateUser, *tree.DropUser, *tree.CreateRole, *tree.DropRole,
+				*tree.Grant, *tree.Revoke, *tree.ShowGrants,
+				*tree.ShowProcessList, *tree.Kill:
 			case *tree.ShowColumns:
 				if t.Table.ToTableName().SchemaName == "" {
 					return NewMysqlError(ER_NO_DB_ERROR)
@@ -1025,6 +1264,12 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				if t.DBName == "" {
 					return NewMysqlError(ER_NO_DB_ERROR)
 				}
+			case *tree.OptimizeTable:
+				for _, tbl := range t.Tables {
+					if tbl.SchemaName == "" {
+						return NewMysqlError(ER_NO_DB_ERROR)
+					}
+				}
 			default:
 				return NewMysqlError(ER_NO_DB_ERROR)
 			}
@@ -1039,14 +1284,18 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			if err != nil {
 				return err
 			}
-			err = proto.sendOKPacket(0, 0, 0, 0, "")
+			err = proto.sendOKPacket(0, 0, ses.GetServerStatus(), 0, "")
 			if err != nil {
 				return err
 			}
 		case *tree.DropDatabase:
 			// if the droped database is the same as the one in use, database must be reseted to empty.
 			if string(st.Name) == proto.GetDatabaseName() {
-				proto.SetUserName("")
+				proto.SetDatabaseName("")
+			}
+			//the ddl commits the active transaction implicitly
+			if err = txnHandler.Commit(ses.Pu.StorageEngine, epoch); err != nil {
+				return err
 			}
 		case *tree.Load:
 			selfHandle = true
@@ -1071,12 +1320,81 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			if err = mce.handleAnalyzeStmt(st); err != nil {
 				return err
 			}
-
+		case *tree.BeginTransaction:
+			selfHandle = true
+			if err = mce.handleBeginTransaction(st, epoch); err != nil {
+				return err
+			}
+		case *tree.CommitTransaction:
+			selfHandle = true
+			if err = mce.handleCommitTransaction(epoch); err != nil {
+				return err
+			}
+		case *tree.RollbackTransaction:
+			selfHandle = true
+			if err = mce.handleRollbackTransaction(); err != nil {
+				return err
+			}
+		case *tree.CreateUser:
+			selfHandle = true
+			if err = mce.handleCreateUser(st); err != nil {
+				return err
+			}
+		case *tree.DropUser:
+			selfHandle = true
+			if err = mce.handleDropUser(st); err != nil {
+				return err
+			}
+		case *tree.CreateRole:
+			selfHandle = true
+			if err = mce.handleCreateRole(st); err != nil {
+				return err
+			}
+		case *tree.DropRole:
+			selfHandle = true
+			if err = mce.handleDropRole(st); err != nil {
+				return err
+			}
+		case *tree.Grant:
+			selfHandle = true
+			if err = mce.handleGrant(st); err != nil {
+				return err
+			}
+		case *tree.Revoke:
+			selfHandle = true
+			if err = mce.handleRevoke(st); err != nil {
+				return err
+			}
+		case *tree.ShowGrants:
+			selfHandle = true
+			if err = mce.handleShowGrants(st); err != nil {
+				return err
+			}
+		case *tree.ShowProcessList:
+			selfHandle = true
+			if err = mce.handleShowProcessList(st); err != nil {
+				return err
+			}
+		case *tree.Kill:
+			selfHandle = true
+			if err = mce.handleKill(st); err != nil {
+				return err
+			}
+		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase,
+			*tree.CreateIndex, *tree.DropIndex, *tree.AlterTable, *tree.OptimizeTable:
+			//the ddl commits the active transaction implicitly
+			if err = txnHandler.Commit(ses.Pu.StorageEngine, epoch); err != nil {
+				return err
+			}
 		}
 
 		if selfHandle {
 			continue
 		}
+		//the statement can be killed by other connections
+		if err = mce.startComputation(cw); err != nil {
+			return err
+		}
 		if err = cw.SetDatabaseName(proto.GetDatabaseName()); err != nil {
 			return err
 		}
@@ -1096,7 +1414,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 		case *tree.Select,
 			*tree.ShowCreateTable, *tree.ShowCreateDatabase, *tree.ShowTables, *tree.ShowDatabases, *tree.ShowColumns,
 			*tree.ShowProcessList, *tree.ShowErrors, *tree.ShowWarnings, *tree.ShowVariables, *tree.ShowStatus,
-			*tree.ShowIndex,
+			*tree.ShowIndex, *tree.OptimizeTable,
 			*tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt:
 			columns, err := cw.GetColumns()
 			if err != nil {
@@ -1132,7 +1450,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				mysql COM_QUERY response: End after the column has been sent.
 				send EOF packet
 			*/
-			err = proto.SendEOFPacketIf(0, 0)
+			err = proto.SendEOFPacketIf(0, ses.GetServerStatus())
 			if err != nil {
 				return err
 			}
@@ -1169,13 +1487,13 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				mysql COM_QUERY response: End after the data row has been sent.
 				After all row data has been sent, it sends the EOF or OK packet.
 			*/
-			err = proto.sendEOFOrOkPacket(0, 0)
+			err = proto.sendEOFOrOkPacket(0, ses.GetServerStatus())
 			if err != nil {
 				return err
 			}
 		//just status, no result set
 		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
-			*tree.CreateIndex, *tree.DropIndex,
+			*tree.CreateIndex, *tree.DropIndex, *tree.AlterTable,
 			*tree.Insert, *tree.Delete, *tree.Update,
 			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
 			*tree.SetVar,
@@ -1188,7 +1506,18 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			/*
 				Step 1: Start
 			*/
-			if er := cw.Run(epoch); er != nil {
+			run := func() error {
+				return cw.Run(epoch)
+			}
+			//the writes outside of a transaction take turns with the commits of the same table
+			if schema, name, ok := autocommitTable(stmt, proto.GetDatabaseName()); ok && !txnHandler.IsInTransaction() {
+				run = func() error {
+					return txn.Autocommit(schema, name, func() error {
+						return cw.Run(epoch)
+					})
+				}
+			}
+			if er := run(); er != nil {
 				return er
 			}
 			if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
@@ -1210,7 +1539,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				cw.GetAffectedRows(),
 				0,
 				0,
-				0,
+				int(ses.GetServerStatus()),
 				int(COM_QUERY),
 				nil,
 			)
@@ -1241,6 +1570,10 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 		}
 	}
 
+	ses.Cmd = req.GetCmd()
+	mce.setCommand(ses.GetMysqlProtocol(), uint8(req.GetCmd()))
+	defer mce.setCommand(ses.GetMysqlProtocol(), COM_SLEEP)
+
 	switch uint8(req.GetCmd()) {
 	case COM_QUIT:
 		/*resp = NewResponse(
@@ -1254,28 +1587,6 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 		var query = string(req.GetData().([]byte))
 		mce.addSqlCount(1)
 		logutil.Infof("query:%s", SubStringFromBegin(query, int(ses.Pu.SV.GetLengthOfQueryPrinted())))
-		seps := strings.Split(query, " ")
-		if len(seps) <= 0 {
-			resp = NewGeneralErrorResponse(COM_QUERY, fmt.Errorf("invalid query"))
-			return resp, nil
-		}
-
-		if strings.ToLower(seps[0]) == "kill" {
-			//last one is processID
-			procIdStr := seps[len(seps)-1]
-			procID, err := strconv.ParseUint(procIdStr, 10, 64)
-			if err != nil {
-				resp = NewGeneralErrorResponse(COM_QUERY, err)
-				return resp, nil
-			}
-			err = mce.GetRoutineManager().killStatement(procID)
-			if err != nil {
-				resp = NewGeneralErrorResponse(COM_QUERY, err)
-				return resp, err
-			}
-			resp = NewGeneralOkResponse(COM_QUERY)
-			return resp, nil
-		}
 
 		err := mce.doComQuery(query)
 		if err != nil {
@@ -1314,6 +1625,40 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 	case COM_PING:
 		resp = NewGeneralOkResponse(COM_PING)
 
+		return resp, nil
+	case COM_STMT_PREPARE:
+		var query = string(req.GetData().([]byte))
+		mce.addSqlCount(1)
+		logutil.Infof("prepare:%s", SubStringFromBegin(query, int(ses.Pu.SV.GetLengthOfQueryPrinted())))
+		err := mce.handleStmtPrepare(query)
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_PREPARE, err)
+		}
+		return resp, nil
+	case COM_STMT_EXECUTE:
+		err := mce.handleStmtExecute(req.GetData().([]byte))
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_EXECUTE, err)
+		}
+		return resp, nil
+	case COM_STMT_SEND_LONG_DATA:
+		//no response
+		err := mce.handleStmtSendLongData(req.GetData().([]byte))
+		if err != nil {
+			logutil.Errorf("send long data failed. error:%v", err)
+		}
+		return resp, nil
+	case COM_STMT_RESET:
+		err := mce.handleStmtReset(req.GetData().([]byte))
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_RESET, err)
+		} else {
+			resp = NewGeneralOkResponse(COM_STMT_RESET)
+		}
+		return resp, nil
+	case COM_STMT_CLOSE:
+		//no response
+		mce.handleStmtClose(req.GetData().([]byte))
 		return resp, nil
 	default:
 		err := fmt.Errorf("unsupported command. 0x%x \n", req.GetCmd())
@@ -1324,13 +1669,8 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 
 func (mce *MysqlCmdExecutor) Close() {
 	//logutil.Infof("close executor")
-	if mce.loadDataClose != nil {
-		//logutil.Infof("close process load data")
-		mce.loadDataClose.Close()
-	}
-	if mce.exportDataClose != nil {
-		mce.exportDataClose.Close()
-	}
+	//stop the statement being executed, loading or exporting data
+	mce.killQuery()
 }
 
 func NewMysqlCmdExecutor() *MysqlCmdExecutor {
@@ -1366,6 +1706,8 @@ func convertEngineTypeToMysqlType(engineType uint8, col *MysqlColumn) error {
 		col.SetColumnType(defines.MYSQL_TYPE_FLOAT)
 	case types.T_float64:
 		col.SetColumnType(defines.MYSQL_TYPE_DOUBLE)
+	case types.T_decimal64, types.T_decimal128:
+		col.SetColumnType(defines.MYSQL_TYPE_NEWDECIMAL)
 	case types.T_char:
 		col.SetColumnType(defines.MYSQL_TYPE_STRING)
 	case types.T_varchar:
@@ -1374,6 +1716,12 @@ func convertEngineTypeToMysqlType(engineType uint8, col *MysqlColumn) error {
 		col.SetColumnType(defines.MYSQL_TYPE_DATE)
 	case types.T_datetime:
 		col.SetColumnType(defines.MYSQL_TYPE_DATETIME)
+	case types.T_time:
+		col.SetColumnType(defines.MYSQL_TYPE_TIME)
+	case types.T_timestamp:
+		col.SetColumnType(defines.MYSQL_TYPE_TIMESTAMP)
+	case types.T_json:
+		col.SetColumnType(defines.MYSQL_TYPE_JSON)
 	default:
 		return fmt.Errorf("RunWhileSend : unsupported type %d \n", engineType)
 	}
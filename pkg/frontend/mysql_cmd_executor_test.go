@@ -487,22 +487,25 @@ func Test_mce(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 		convey.So(resp, convey.ShouldBeNil)
 
-		req = &Request{
-			cmd:  int(COM_QUERY),
-			data: []byte("kill"),
-		}
-		resp, err = mce.ExecRequest(req)
-		convey.So(err, convey.ShouldBeNil)
-		convey.So(resp, convey.ShouldNotBeNil)
+		//the connection 10 does not exist
+		for _, sql := range []string{"kill 10", "kill query 10"} {
+			kill := mock_frontend.NewMockComputationWrapper(ctrl)
+			stmts, err = parsers.Parse(dialect.MYSQL, sql)
+			if err != nil {
+				t.Error(err)
+			}
+			kill.EXPECT().GetAst().Return(stmts[0]).AnyTimes()
+			stubs.StubFunc(&GetComputationWrapper, []ComputationWrapper{kill}, nil)
 
-		req = &Request{
-			cmd:  int(COM_QUERY),
-			data: []byte("kill 10"),
+			req = &Request{
+				cmd:  int(COM_QUERY),
+				data: []byte(sql),
+			}
+			resp, err = mce.ExecRequest(req)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(resp, convey.ShouldNotBeNil)
+			mce.SetRoutineManager(&RoutineManager{})
 		}
-		mce.SetRoutineManager(&RoutineManager{})
-		resp, err = mce.ExecRequest(req)
-		convey.So(err, convey.ShouldBeNil)
-		convey.So(resp, convey.ShouldNotBeNil)
 
 		req = &Request{
 			cmd:  int(COM_INIT_DB),
@@ -809,6 +812,8 @@ func Test_typeconvert(t *testing.T) {
 			types.T_varchar,
 			types.T_date,
 			types.T_datetime,
+			types.T_decimal64,
+			types.T_decimal128,
 		}
 
 		type kase struct {
@@ -830,6 +835,8 @@ func Test_typeconvert(t *testing.T) {
 			{tp: defines.MYSQL_TYPE_VARCHAR, signed: true},
 			{tp: defines.MYSQL_TYPE_DATE, signed: true},
 			{tp: defines.MYSQL_TYPE_DATETIME, signed: true},
+			{tp: defines.MYSQL_TYPE_NEWDECIMAL, signed: true},
+			{tp: defines.MYSQL_TYPE_NEWDECIMAL, signed: true},
 		}
 
 		convey.So(len(input), convey.ShouldEqual, len(output))
@@ -978,7 +985,7 @@ func Test_GetComputationWrapper(t *testing.T) {
 		db, sql, user := "T", "SHOW TABLES", "root"
 		var eng engine.Engine
 		proc := &process.Process{}
-		cw, err := GetComputationWrapper(db, sql, user, eng, proc)
+		cw, err := GetComputationWrapper(db, sql, nil, user, nil, eng, proc)
 		convey.So(cw, convey.ShouldNotBeEmpty)
 		convey.So(err, convey.ShouldBeNil)
 	})
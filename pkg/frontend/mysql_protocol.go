@@ -16,18 +16,23 @@ package frontend
 
 import (
 	"bytes"
+	"crypto/rsa"
 	"crypto/sha1"
+	"crypto/tls"
 	"encoding/binary"
 	"fmt"
+	"math"
 	"math/rand"
 	"strconv"
 	"time"
 
 	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 )
 
 // DefaultCapability means default capabilities of the server
@@ -100,7 +105,9 @@ const (
 
 	Utf8mb4CollationID uint8 = 45
 
-	AuthNativePassword string = "mysql_native_password"
+	AuthNativePassword      string = "mysql_native_password"
+	AuthCachingSha2Password string = "caching_sha2_password"
+	AuthSha256Password      string = "sha256_password"
 
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
@@ -126,6 +133,12 @@ type MysqlProtocol interface {
 
 	SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet, cnt uint64) error
 
+	//the server send group row of the result set in the binary protocol for COM_STMT_EXECUTE
+	SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error
+
+	//SendPrepareResponse the server send the response of COM_STMT_PREPARE to the client
+	SendPrepareResponse(stmtID uint32, numParams uint16, columns []interface{}) error
+
 	//SendColumnDefinitionPacket the server send the column definition to the client
 	SendColumnDefinitionPacket(column Column, cmd int) error
 
@@ -261,6 +274,24 @@ type MysqlProtocolImpl struct {
 	rowHandler
 
 	SV *config.SystemVariables
+
+	//the tls config of the server, nil if TLS is disabled
+	tlsConfig *tls.Config
+
+	//the connection has been upgraded to TLS
+	isTLS bool
+
+	//the connection of the client, nil if it is not accepted by the clientListener
+	conn *clientConn
+
+	//the users and roles checked in the authentication
+	accounts privilege.Catalog
+
+	//the digests of the passwords for the fast authentication of caching_sha2_password
+	sha2Cache *sha2Cache
+
+	//the RSA key which decrypts the passwords sent without TLS
+	rsaKey *rsa.PrivateKey
 }
 
 func (mp *MysqlProtocolImpl) GetDatabaseName() string {
@@ -530,6 +561,21 @@ func (mp *MysqlProtocolImpl) appendUint8(data []byte, e uint8) []byte {
 	return mp.append(data, e)
 }
 
+func (mp *MysqlProtocolImpl) appendUint16(data []byte, e uint16) []byte {
+	mp.strconvBuffer = mp.io.AppendUint16(mp.strconvBuffer[:0], e)
+	return mp.append(data, mp.strconvBuffer...)
+}
+
+func (mp *MysqlProtocolImpl) appendUint32(data []byte, e uint32) []byte {
+	mp.strconvBuffer = mp.io.AppendUint32(mp.strconvBuffer[:0], e)
+	return mp.append(data, mp.strconvBuffer...)
+}
+
+func (mp *MysqlProtocolImpl) appendUint64(data []byte, e uint64) []byte {
+	mp.strconvBuffer = mp.io.AppendUint64(mp.strconvBuffer[:0], e)
+	return mp.append(data, mp.strconvBuffer...)
+}
+
 //write the count of zeros into the buffer at the position
 //return pos + count
 func (mp *MysqlProtocolImpl) writeZeros(data []byte, pos int, count int) int {
@@ -539,71 +585,131 @@ func (mp *MysqlProtocolImpl) writeZeros(data []byte, pos int, count int) int {
 	return pos + count
 }
 
-//the server calculates the hash value of the password with the algorithm
-//and judges it with the authentication data from the client.
-//Algorithm: SHA1( password ) XOR SHA1( slat + SHA1( SHA1( password ) ) )
-func (mp *MysqlProtocolImpl) checkPassword(password, salt, auth []byte) bool {
-	if len(password) == 0 {
-		return false
+//the server checks the authentication data with SHA1(SHA1(password)) stored in the account.
+//Algorithm: SHA1( salt + hash2 ) XOR auth = SHA1( password ), and SHA1( SHA1( password ) ) = hash2
+func (mp *MysqlProtocolImpl) checkPasswordHash(hash2, salt, auth []byte) bool {
+	//the account without password
+	if len(hash2) == 0 {
+		return len(auth) == 0
 	}
-	//hash1 = SHA1(password)
-	sha := sha1.New()
-	_, err := sha.Write(password)
-	if err != nil {
-		logutil.Errorf("SHA1(password) failed.")
-		return false
-	}
-	hash1 := sha.Sum(nil)
-
-	//hash2 = SHA1(SHA1(password))
-	sha.Reset()
-	_, err = sha.Write(hash1)
-	if err != nil {
-		logutil.Errorf("SHA1(SHA1(password)) failed.")
+	if len(auth) != sha1.Size {
 		return false
 	}
-	hash2 := sha.Sum(nil)
 
 	//hash3 = SHA1(salt + SHA1(SHA1(password)))
-	sha.Reset()
-	_, err = sha.Write(salt)
-	if err != nil {
-		logutil.Errorf("write salt failed.")
-		return false
-	}
-	_, err = sha.Write(hash2)
-	if err != nil {
-		logutil.Errorf("write SHA1(SHA1(password)) failed.")
-		return false
-	}
+	sha := sha1.New()
+	sha.Write(salt)
+	sha.Write(hash2)
 	hash3 := sha.Sum(nil)
 
-	//SHA1(password) XOR SHA1(salt + SHA1(SHA1(password)))
+	//hash1 = SHA1(password) from the client
+	hash1 := make([]byte, sha1.Size)
 	for i := range hash1 {
-		hash1[i] ^= hash3[i]
+		hash1[i] = auth[i] ^ hash3[i]
 	}
-
-	logutil.Infof("server calculated %v\n", hash1)
-	logutil.Infof("client calculated %v\n", auth)
-
-	return bytes.Equal(hash1, auth)
+	sha.Reset()
+	sha.Write(hash1)
+	return bytes.Equal(sha.Sum(nil), hash2)
+}
+
+//passwordHash returns SHA1(SHA1(password)) of the user,
+//return false if the user can not login
+func (mp *MysqlProtocolImpl) passwordHash() ([]byte, bool) {
+	switch mp.username {
+	case mp.SV.GetDumpuser(): //the user dump for test
+		return privilege.HashPassword(mp.SV.GetDumppassword()), true
+	case mp.SV.GetRootname():
+		return privilege.HashPassword(mp.SV.GetRootpassword()), true
+	}
+	//the roles can not login
+	if mp.accounts != nil {
+		if account, err := mp.accounts.GetAccount(mp.username); err == nil && !account.IsRole {
+			return account.Password, true
+		}
+	}
+	return nil, false
 }
 
 //the server authenticate that the client can connect and use the database
-func (mp *MysqlProtocolImpl) authenticateUser(authResponse []byte) error {
-	//TODO:check the user and the connection
-	//TODO:get the user's password
-	var psw []byte
-	if mp.username == mp.SV.GetDumpuser() { //the user dump for test
-		psw = []byte(mp.SV.GetDumppassword())
+//with the authentication plugin of the client
+func (mp *MysqlProtocolImpl) authenticateUser(plugin string, authResponse []byte) error {
+	var ok bool
+	var err error
+
+	if hash, exists := mp.passwordHash(); exists {
+		switch plugin {
+		case AuthCachingSha2Password:
+			ok, err = mp.authenticateCachingSha2(hash, authResponse)
+		case AuthSha256Password:
+			ok, err = mp.authenticateSha256(hash, authResponse)
+		default:
+			ok = mp.checkPasswordHash(hash, mp.salt, authResponse)
+		}
 	}
 
 	//TO Check password
-	if mp.checkPassword(psw, mp.salt, authResponse) {
+	if err != nil {
+		return fmt.Errorf("check password failed. error:%v", err)
+	} else if ok {
 		logutil.Infof("check password succeeded\n")
 	} else {
 		return fmt.Errorf("check password failed\n")
 	}
+
+	if !mp.isTLS && isTLSRequired(mp.SV, mp.username) {
+		return fmt.Errorf("user %s must connect with TLS", mp.username)
+	}
+	return nil
+}
+
+//defaultAuthPlugin returns the authentication plugin announced in the handshake
+func (mp *MysqlProtocolImpl) defaultAuthPlugin() string {
+	if plugin := mp.SV.GetDefaultAuthenticationPlugin(); isSupportedAuthPlugin(plugin) {
+		return plugin
+	}
+	return AuthNativePassword
+}
+
+//the capabilities of the server
+func (mp *MysqlProtocolImpl) serverCapability() uint32 {
+	var capability = DefaultCapability
+	if mp.conn != nil {
+		capability |= CLIENT_COMPRESS | CLIENT_ZSTD_COMPRESSION_ALGORITHM
+		if mp.tlsConfig != nil {
+			capability |= CLIENT_SSL
+		}
+	}
+	return capability
+}
+
+//the client sends the SSL request before the handshake response
+//if it wants to switch to TLS
+func (mp *MysqlProtocolImpl) isSSLRequest(payload []byte) bool {
+	if len(payload) != sslRequestLength {
+		return false
+	}
+	capabilities, _, ok := mp.io.ReadUint32(payload, 0)
+	return ok && capabilities&CLIENT_PROTOCOL_41 != 0 && capabilities&CLIENT_SSL != 0
+}
+
+//the server upgrades the connection to TLS after receiving the SSL request
+func (mp *MysqlProtocolImpl) upgradeToTLS() error {
+	if mp.tlsConfig == nil || mp.conn == nil || mp.isTLS {
+		return fmt.Errorf("received an unexpected SSL request")
+	}
+
+	//the client starts the TLS handshake right after the SSL request,
+	//so the data in the read buffer is the beginning of the handshake
+	_, buffered, err := mp.tcpConn.InBuf().ReadAll()
+	if err != nil {
+		return err
+	}
+	mp.tcpConn.InBuf().Clear()
+
+	if err = mp.conn.upgrade(mp.tlsConfig, buffered); err != nil {
+		return err
+	}
+	mp.isTLS = true
 	return nil
 }
 
@@ -617,6 +723,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 	}
 
 	var authResponse []byte
+	var authPlugin = AuthNativePassword
 	if capabilities, _, ok := mp.io.ReadUint16(payload, 0); !ok {
 		return fmt.Errorf("read capabilities from response packet failed")
 	} else if uint32(capabilities)&CLIENT_PROTOCOL_41 != 0 {
@@ -628,7 +735,10 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		}
 
 		authResponse = resp41.authResponse
-		mp.capability = DefaultCapability & resp41.capabilities
+		if len(resp41.clientPluginName) != 0 {
+			authPlugin = resp41.clientPluginName
+		}
+		mp.capability = mp.serverCapability() & resp41.capabilities
 
 		if nameAndCharset, ok := collationID2CharsetAndName[int(resp41.collationID)]; !ok {
 			return fmt.Errorf("get collationName and charset failed")
@@ -650,7 +760,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		}
 
 		authResponse = resp320.authResponse
-		mp.capability = DefaultCapability & resp320.capabilities
+		mp.capability = mp.serverCapability() & resp320.capabilities
 		mp.collationID = int(Utf8mb4CollationID)
 		mp.collationName = "utf8mb4_general_ci"
 		mp.charset = "utf8mb4"
@@ -660,7 +770,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		mp.database = resp320.database
 	}
 
-	if err := mp.authenticateUser(authResponse); err != nil {
+	if err := mp.authenticateUser(authPlugin, authResponse); err != nil {
 		fail := errorMsgRefer[ER_ACCESS_DENIED_ERROR]
 		_ = mp.sendErrPacket(fail.errorCode, fail.sqlStates[0], "Access denied for user")
 		return err
@@ -670,6 +780,15 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 	if err != nil {
 		return err
 	}
+
+	//the packets after the OK packet are compressed
+	if mp.conn != nil {
+		if mp.capability&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+			mp.conn.enableCompression(compress.Zstd)
+		} else if mp.capability&CLIENT_COMPRESS != 0 {
+			mp.conn.enableCompression(compress.Zlib)
+		}
+	}
 	return nil
 }
 
@@ -678,6 +797,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	var data = make([]byte, HeaderOffset+256)
 	var pos = HeaderOffset
+	var capability = mp.serverCapability()
 	//int<1> protocol version
 	pos = mp.io.WriteUint8(data, pos, clientProtocolVersion)
 
@@ -694,7 +814,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	pos = mp.io.WriteUint8(data, pos, 0)
 
 	//int<2>              capabilities flags (lower 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16(DefaultCapability&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16(capability&0xFFFF))
 
 	//int<1>              character set
 	pos = mp.io.WriteUint8(data, pos, utf8mb4BinCollationID)
@@ -703,9 +823,9 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	pos = mp.io.WriteUint16(data, pos, DefaultClientConnStatus)
 
 	//int<2>              capabilities flags (upper 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16((DefaultCapability>>16)&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16((capability>>16)&0xFFFF))
 
-	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
+	if (capability & CLIENT_PLUGIN_AUTH) != 0 {
 		//int<1>              length of auth-plugin-data
 		//set 21 always
 		pos = mp.io.WriteUint8(data, pos, uint8(len(mp.salt)+1))
@@ -718,15 +838,15 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	//string[10]     reserved (all [00])
 	pos = mp.writeZeros(data, pos, 10)
 
-	if (DefaultCapability & CLIENT_SECURE_CONNECTION) != 0 {
+	if (capability & CLIENT_SECURE_CONNECTION) != 0 {
 		//string[$len]   auth-plugin-data-part-2 ($len=MAX(13, length of auth-plugin-data - 8))
 		pos = mp.writeCountOfBytes(data, pos, mp.salt[8:])
 		pos = mp.io.WriteUint8(data, pos, 0)
 	}
 
-	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
+	if (capability & CLIENT_PLUGIN_AUTH) != 0 {
 		//string[NUL]    auth-plugin name
-		pos = mp.writeStringNUL(data, pos, AuthNativePassword)
+		pos = mp.writeStringNUL(data, pos, mp.defaultAuthPlugin())
 	}
 
 	return data[:pos]
@@ -830,12 +950,13 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(data []byte) (bool, resp
 		}
 
 		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
+		if !isSupportedAuthPlugin(info.clientPluginName) {
 			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(); err != nil {
+			plugin := mp.defaultAuthPlugin()
+			if info.authResponse, err = mp.negotiateAuthenticationMethod(plugin); err != nil {
 				return false, info, fmt.Errorf("negotiate authentication method failed. error:%v", err)
 			}
-			info.clientPluginName = AuthNativePassword
+			info.clientPluginName = plugin
 		}
 	}
 
@@ -974,35 +1095,14 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 //the server can send AuthSwitchRequest to ask client to use designated authentication method,
 //if both server and client support CLIENT_PLUGIN_AUTH capability.
 //return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod() ([]byte, error) {
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(plugin string) ([]byte, error) {
 	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
+	aswPkt := mp.makeAuthSwitchRequestPayload(plugin)
 	err = mp.writePackets(aswPkt)
 	if err != nil {
 		return nil, err
 	}
-
-	read, err := mp.tcpConn.Read()
-	if err != nil {
-		return nil, err
-	}
-
-	if read == nil {
-		return nil, fmt.Errorf("read nil from tcp conn")
-	}
-
-	pack, ok := read.(*Packet)
-	if !ok {
-		return nil, fmt.Errorf("It is not the Packet")
-	}
-
-	if pack == nil {
-		return nil, fmt.Errorf("packet is null")
-	}
-
-	data := pack.Payload
-	mp.sequenceId++
-	return data, nil
+	return mp.readAuthData()
 }
 
 //make a OK packet
@@ -1240,8 +1340,6 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 		}
 
 		switch mysqlColumn.ColumnType() {
-		case defines.MYSQL_TYPE_DECIMAL:
-			return nil, fmt.Errorf("unsupported Decimal")
 		case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_YEAR:
 			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
 				return nil, err2
@@ -1282,7 +1380,7 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 					data = mp.appendStringLenEncOfInt64(data, value)
 				}
 			}
-		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL, defines.MYSQL_TYPE_JSON:
 			if value, err2 := mrs.GetString(r, i); err2 != nil {
 				return nil, err2
 			} else {
@@ -1294,14 +1392,18 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 			} else {
 				data = mp.appendStringLenEnc(data, value.(types.Date).String())
 			}
-		case defines.MYSQL_TYPE_DATETIME:
+		case defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
 			if value, err2 := mrs.GetValue(r, i); err2 != nil {
 				return nil, err2
 			} else {
 				data = mp.appendStringLenEnc(data, value.(types.Datetime).String())
 			}
-		case defines.MYSQL_TYPE_TIMESTAMP, defines.MYSQL_TYPE_TIME:
-			return nil, fmt.Errorf("unsupported DATE/DATETIME/TIMESTAMP/MYSQL_TYPE_TIME")
+		case defines.MYSQL_TYPE_TIME:
+			if value, err2 := mrs.GetValue(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendStringLenEnc(data, value.(types.Time).String())
+			}
 		default:
 			return nil, fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
 		}
@@ -1366,6 +1468,242 @@ func (mp *MysqlProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSe
 	return err
 }
 
+//the server convert every row of the result set into the binary protocol row.
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/binary-protocol-resultset-row.html
+func (mp *MysqlProtocolImpl) makeResultSetBinaryRow(data []byte, mrs *MysqlResultSet, r uint64) ([]byte, error) {
+	columnCount := mrs.GetColumnCount()
+
+	//NULL-bitmap, length: (column_count + 7 + 2) / 8, the offset is 2
+	nullBitmap := make([]byte, (columnCount+7+2)/8)
+	for i := uint64(0); i < columnCount; i++ {
+		if isNil, err := mrs.ColumnIsNull(r, i); err != nil {
+			return nil, err
+		} else if isNil {
+			nullBitmap[(i+2)/8] |= 1 << ((i + 2) % 8)
+		}
+	}
+
+	//packet header [00]
+	data = mp.appendUint8(data, defines.OKHeader)
+	data = mp.append(data, nullBitmap...)
+
+	for i := uint64(0); i < columnCount; i++ {
+		if nullBitmap[(i+2)/8]&(1<<((i+2)%8)) != 0 {
+			continue
+		}
+		column, err := mrs.GetColumn(i)
+		if err != nil {
+			return nil, err
+		}
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return nil, fmt.Errorf("sendColumn need MysqlColumn")
+		}
+
+		switch mysqlColumn.ColumnType() {
+		case defines.MYSQL_TYPE_TINY:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint8(data, uint8(value))
+			}
+		case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint16(data, uint16(value))
+			}
+		case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint32(data, uint32(value))
+			}
+		case defines.MYSQL_TYPE_LONGLONG:
+			if uint32(mysqlColumn.Flag())&defines.UNSIGNED_FLAG != 0 {
+				if value, err2 := mrs.GetUint64(r, i); err2 != nil {
+					return nil, err2
+				} else {
+					data = mp.appendUint64(data, value)
+				}
+			} else {
+				if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+					return nil, err2
+				} else {
+					data = mp.appendUint64(data, uint64(value))
+				}
+			}
+		case defines.MYSQL_TYPE_FLOAT:
+			if value, err2 := mrs.GetFloat64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint32(data, math.Float32bits(float32(value)))
+			}
+		case defines.MYSQL_TYPE_DOUBLE:
+			if value, err2 := mrs.GetFloat64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint64(data, math.Float64bits(value))
+			}
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL, defines.MYSQL_TYPE_JSON:
+			if value, err2 := mrs.GetString(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendStringLenEnc(data, value)
+			}
+		case defines.MYSQL_TYPE_DATE:
+			if value, err2 := mrs.GetValue(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				year, month, day, _ := value.(types.Date).Calendar(true)
+				//length [04], year(2), month(1), day(1)
+				data = mp.appendUint8(data, 4)
+				data = mp.appendUint16(data, uint16(year))
+				data = mp.append(data, month, day)
+			}
+		case defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+			if value, err2 := mrs.GetValue(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				dt := value.(types.Datetime)
+				year, month, day, _ := dt.ToDate().Calendar(true)
+				hour, minute, second := dt.Clock()
+				//length [07], year(2), month(1), day(1), hour(1), minute(1), second(1)
+				data = mp.appendUint8(data, 7)
+				data = mp.appendUint16(data, uint16(year))
+				data = mp.append(data, month, day, uint8(hour), uint8(minute), uint8(second))
+			}
+		case defines.MYSQL_TYPE_TIME:
+			if value, err2 := mrs.GetValue(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				neg, hour, minute, second, usec := value.(types.Time).Clock()
+				var sign uint8
+				if neg {
+					sign = 1
+				}
+				if usec == 0 {
+					//length [08], is_negative(1), days(4), hour(1), minute(1), second(1)
+					data = mp.appendUint8(data, 8)
+				} else {
+					//length [12], is_negative(1), days(4), hour(1), minute(1), second(1), microsecond(4)
+					data = mp.appendUint8(data, 12)
+				}
+				data = mp.appendUint8(data, sign)
+				data = mp.appendUint32(data, hour/24)
+				data = mp.append(data, uint8(hour%24), minute, second)
+				if usec != 0 {
+					data = mp.appendUint32(data, usec)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
+		}
+	}
+	return data, nil
+}
+
+//the server send group row of the result set in the binary protocol
+//thread safe
+func (mp *MysqlProtocolImpl) SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	if cnt == 0 {
+		return nil
+	}
+
+	mp.GetLock().Lock()
+	defer mp.GetLock().Unlock()
+
+	for i := uint64(0); i < cnt; i++ {
+		if err := mp.sendResultSetBinaryRow(mrs, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//the server send every row of the result set in the binary protocol as an independent packet
+func (mp *MysqlProtocolImpl) sendResultSetBinaryRow(mrs *MysqlResultSet, r uint64) error {
+	err := mp.openRow(nil)
+	if err != nil {
+		return err
+	}
+	if _, err = mp.makeResultSetBinaryRow(nil, mrs, r); err != nil {
+		//ERR_Packet in case of error
+		err1 := mp.sendErrPacket(ER_UNKNOWN_ERROR, DefaultMySQLState, err.Error())
+		if err1 != nil {
+			return err1
+		}
+		return err
+	}
+	return mp.closeRow(nil)
+}
+
+//make the COM_STMT_PREPARE_OK payload
+func (mp *MysqlProtocolImpl) makePrepareOKPayload(stmtID uint32, numColumns, numParams, warnings uint16) []byte {
+	data := make([]byte, HeaderOffset+12)
+	var pos = HeaderOffset
+	//int<1> status [00]
+	pos = mp.io.WriteUint8(data, pos, defines.OKHeader)
+	//int<4> statement_id
+	pos = mp.io.WriteUint32(data, pos, stmtID)
+	//int<2> num_columns
+	pos = mp.io.WriteUint16(data, pos, numColumns)
+	//int<2> num_params
+	pos = mp.io.WriteUint16(data, pos, numParams)
+	//int<1> reserved_1 [00] filler
+	pos = mp.io.WriteUint8(data, pos, 0)
+	//int<2> warning_count
+	pos = mp.io.WriteUint16(data, pos, warnings)
+	return data[:pos]
+}
+
+//the server send the response of COM_STMT_PREPARE
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-prepare-response.html
+//The types of the parameters are unknown until the statement is executed,
+//so they are described as strings.
+func (mp *MysqlProtocolImpl) SendPrepareResponse(stmtID uint32, numParams uint16, columns []interface{}) error {
+	err := mp.writePackets(mp.makePrepareOKPayload(stmtID, uint16(len(columns)), numParams, 0))
+	if err != nil {
+		return err
+	}
+
+	//num_params * Protocol::ColumnDefinition packets
+	if numParams > 0 {
+		for i := uint16(0); i < numParams; i++ {
+			col := new(MysqlColumn)
+			col.SetName("?")
+			col.SetColumnType(defines.MYSQL_TYPE_VAR_STRING)
+			err = mp.SendColumnDefinitionPacket(col, int(COM_STMT_PREPARE))
+			if err != nil {
+				return err
+			}
+		}
+
+		//If the CLIENT_DEPRECATE_EOF client capabilities flag is not set, EOF_Packet
+		err = mp.SendEOFPacketIf(0, 0)
+		if err != nil {
+			return err
+		}
+	}
+
+	//num_columns * Protocol::ColumnDefinition packets
+	if len(columns) > 0 {
+		for _, c := range columns {
+			err = mp.SendColumnDefinitionPacket(c.(Column), int(COM_STMT_PREPARE))
+			if err != nil {
+				return err
+			}
+		}
+
+		//If the CLIENT_DEPRECATE_EOF client capabilities flag is not set, EOF_Packet
+		err = mp.SendEOFPacketIf(0, 0)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 //open a new row of the resultset
 func (mp *MysqlProtocolImpl) openRow(_ []byte) error {
 	if mp.enableLog {
@@ -1599,8 +1937,14 @@ func (mp *MysqlProtocolImpl) sendResultSet(set ResultSet, cmd int, warnings, sta
 	}
 
 	//One or more ProtocolText::ResultsetRow packets, each containing column_count values
+	//or ProtocolBinary::ResultsetRow packets for COM_STMT_EXECUTE
 	for i := uint64(0); i < mysqlRS.GetRowCount(); i++ {
-		if err = mp.sendResultSetTextRow(mysqlRS, i); err != nil {
+		if uint8(cmd) == COM_STMT_EXECUTE {
+			err = mp.sendResultSetBinaryRow(mysqlRS, i)
+		} else {
+			err = mp.sendResultSetTextRow(mysqlRS, i)
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -668,6 +668,7 @@ const (
 	CLIENT_CAN_HANDLE_EXPIRED_PASSWORDS   uint32 = 0x00400000
 	CLIENT_SESSION_TRACK                  uint32 = 0x00800000
 	CLIENT_DEPRECATE_EOF                  uint32 = 0x01000000
+	CLIENT_ZSTD_COMPRESSION_ALGORITHM     uint32 = 0x04000000
 )
 
 //server status
@@ -11515,7 +11516,8 @@ type MysqlError struct {
 
 func (me *MysqlError) Error() string {
 	cnt := strings.Count(me.Format, "%")
-	return fmt.Sprintf(me.Format, me.Args[:cnt]...)
+	//%lu and %llu in the messages of mysql are the unsigned long and the unsigned long long
+	return fmt.Sprintf(strings.NewReplacer("%llu", "%d", "%lu", "%d").Replace(me.Format), me.Args[:cnt]...)
 }
 
 func NewMysqlError(code uint16, args ...interface{}) *MysqlError {
@@ -1890,6 +1890,59 @@ func Test_resultset(t *testing.T) {
 		err = proto.SendResultSetTextRow(res, 0)
 		cvey.So(err, cvey.ShouldBeNil)
 	})
+
+	cvey.Convey("send result set binary batch row succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		res := make8ColumnsResultSet()
+		res.AddRow([]interface{}{nil, int16(1), nil, int64(2), nil, float32(3), nil, nil, nil})
+
+		err = proto.SendResultSetBinaryBatchRow(res, uint64(len(res.Data)))
+		cvey.So(err, cvey.ShouldBeNil)
+
+		err = proto.sendResultSet(res, int(COM_STMT_EXECUTE), 0, 0)
+		cvey.So(err, cvey.ShouldBeNil)
+	})
+
+	cvey.Convey("send prepare response succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		sv, err := getSystemVariables("test/system_vars_config.toml")
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+		want := []byte{0, 1, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0}
+		cvey.So(proto.makePrepareOKPayload(1, 0, 2, 0)[HeaderOffset:], cvey.ShouldResemble, want)
+
+		err = proto.SendPrepareResponse(1, 2, nil)
+		cvey.So(err, cvey.ShouldBeNil)
+
+		col := new(MysqlColumn)
+		col.SetName("a")
+		col.SetColumnType(defines.MYSQL_TYPE_LONG)
+		err = proto.SendPrepareResponse(1, 0, []interface{}{col})
+		cvey.So(err, cvey.ShouldBeNil)
+	})
 }
 
 func Test_send_packet(t *testing.T) {
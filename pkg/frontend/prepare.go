@@ -0,0 +1,414 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/binary"
+	"fmt"
+	"go/constant"
+	"go/token"
+	"math"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	//the max count of placeholders in a prepared statement
+	maxParamsOfPrepareStmt = math.MaxUint16
+
+	//the unsigned flag in the type of a parameter of COM_STMT_EXECUTE
+	paramUnsignedFlag = 0x80
+)
+
+/*
+PrepareStmt is the statement prepared by COM_STMT_PREPARE.
+It lives in the connection until COM_STMT_CLOSE or the connection is closed.
+*/
+type PrepareStmt struct {
+	id uint32
+
+	sql string
+
+	numParams int
+
+	//the types of the parameters.
+	//the client sends them in the first COM_STMT_EXECUTE or
+	//when they are rebound. 2 bytes per parameter.
+	paramTypes []byte
+
+	//the data of the parameters sent by COM_STMT_SEND_LONG_DATA
+	longData map[int][]byte
+}
+
+func (ps *PrepareStmt) reset() {
+	ps.longData = nil
+}
+
+// handle COM_STMT_PREPARE
+func (mce *MysqlCmdExecutor) handleStmtPrepare(sql string) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+
+	ast, numParams, err := parsers.Prepare(dialect.MYSQL, sql)
+	if err != nil {
+		return NewMysqlError(ER_PARSE_ERROR, err,
+			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
+	}
+	if numParams > maxParamsOfPrepareStmt {
+		return NewMysqlError(ER_PS_MANY_PARAM)
+	}
+
+	if mce.prepareStmts == nil {
+		mce.prepareStmts = make(map[uint32]*PrepareStmt)
+	}
+	mce.lastStmtId++
+	stmt := &PrepareStmt{
+		id:        mce.lastStmtId,
+		sql:       sql,
+		numParams: numParams,
+	}
+	mce.prepareStmts[stmt.id] = stmt
+
+	var columns []interface{}
+	if _, ok := ast.(*tree.Select); ok {
+		columns = mce.describeStmt(sql, numParams)
+	}
+	return proto.SendPrepareResponse(stmt.id, uint16(numParams), columns)
+}
+
+// describeStmt gets the columns of the result set of the prepared statement with
+// its placeholders bound to 0, as the plan does not support NULL values yet.
+// The columns only help the client to prepare the result set, so nil is returned
+// if they can not be decided before the execution which sends them again.
+func (mce *MysqlCmdExecutor) describeStmt(sql string, numParams int) []interface{} {
+	params := make([]tree.Expr, numParams)
+	for i := range params {
+		params[i] = tree.NewNumVal(constant.MakeInt64(0), "0", false)
+	}
+	cws, quota, err := mce.getComputationWrappers(sql, params)
+	if err != nil {
+		return nil
+	}
+	defer quota.Release()
+	if len(cws) != 1 {
+		return nil
+	}
+	columns, err := cws[0].Describe()
+	if err != nil {
+		return nil
+	}
+	return columns
+}
+
+// handle COM_STMT_EXECUTE
+func (mce *MysqlCmdExecutor) handleStmtExecute(data []byte) error {
+	stmt, err := mce.getPrepareStmt(data, "mysqld_stmt_execute")
+	if err != nil {
+		return err
+	}
+	params, err := parseStmtExecuteParams(stmt, data)
+	if err != nil {
+		return err
+	}
+	//the long data is only used by one execution
+	stmt.reset()
+
+	return mce.executeQuery(stmt.sql, params)
+}
+
+// handle COM_STMT_SEND_LONG_DATA
+// the server does not response to it.
+func (mce *MysqlCmdExecutor) handleStmtSendLongData(data []byte) error {
+	stmt, err := mce.getPrepareStmt(data, "mysqld_stmt_send_long_data")
+	if err != nil {
+		return err
+	}
+	if len(data) < 6 {
+		return NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	paramId := int(binary.LittleEndian.Uint16(data[4:]))
+	if paramId >= stmt.numParams {
+		return NewMysqlError(ER_WRONG_ARGUMENTS, "mysqld_stmt_send_long_data")
+	}
+	if stmt.longData == nil {
+		stmt.longData = make(map[int][]byte)
+	}
+	stmt.longData[paramId] = append(stmt.longData[paramId], data[6:]...)
+	return nil
+}
+
+// handle COM_STMT_RESET
+func (mce *MysqlCmdExecutor) handleStmtReset(data []byte) error {
+	stmt, err := mce.getPrepareStmt(data, "mysqld_stmt_reset")
+	if err != nil {
+		return err
+	}
+	stmt.reset()
+	return nil
+}
+
+// handle COM_STMT_CLOSE
+// the server does not response to it.
+func (mce *MysqlCmdExecutor) handleStmtClose(data []byte) {
+	if len(data) < 4 {
+		return
+	}
+	delete(mce.prepareStmts, binary.LittleEndian.Uint32(data))
+}
+
+// getPrepareStmt gets the prepared statement by the statement id at the beginning of the data
+func (mce *MysqlCmdExecutor) getPrepareStmt(data []byte, funcName string) (*PrepareStmt, error) {
+	if len(data) < 4 {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	stmtId := binary.LittleEndian.Uint32(data)
+	stmt, ok := mce.prepareStmts[stmtId]
+	if !ok {
+		id := strconv.FormatUint(uint64(stmtId), 10)
+		return nil, NewMysqlError(ER_UNKNOWN_STMT_HANDLER, len(id), id, funcName)
+	}
+	return stmt, nil
+}
+
+/*
+parseStmtExecuteParams converts the parameters in the payload of COM_STMT_EXECUTE into the exprs.
+the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-execute.html
+
+	int<4> stmt-id, int<1> flags, int<4> iteration-count,
+	if num-params > 0:
+		string<var> NULL-bitmap, length: (num-params+7)/8
+		int<1> new-params-bound-flag
+		if new-params-bound-flag == 1:
+			string<var> type of each parameter, length: num-params * 2
+		string<var> value of each parameter
+*/
+func parseStmtExecuteParams(stmt *PrepareStmt, data []byte) ([]tree.Expr, error) {
+	pos := 4 + 1 + 4
+	if len(data) < pos {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	if stmt.numParams == 0 {
+		return nil, nil
+	}
+
+	nullBitmapLen := (stmt.numParams + 7) / 8
+	if len(data) < pos+nullBitmapLen+1 {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	nullBitmap := data[pos : pos+nullBitmapLen]
+	pos += nullBitmapLen
+
+	newParamsBound := data[pos]
+	pos++
+	if newParamsBound == 1 {
+		if len(data) < pos+stmt.numParams*2 {
+			return nil, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		stmt.paramTypes = append(stmt.paramTypes[:0], data[pos:pos+stmt.numParams*2]...)
+		pos += stmt.numParams * 2
+	}
+	if len(stmt.paramTypes) != stmt.numParams*2 {
+		return nil, NewMysqlError(ER_WRONG_ARGUMENTS, "mysqld_stmt_execute")
+	}
+
+	var err error
+	params := make([]tree.Expr, stmt.numParams)
+	for i := range params {
+		if nullBitmap[i/8]&(1<<(i%8)) != 0 {
+			params[i] = tree.NewNumVal(constant.MakeUnknown(), "NULL", false)
+			continue
+		}
+		if v, ok := stmt.longData[i]; ok {
+			params[i] = tree.NewNumVal(constant.MakeString(string(v)), string(v), false)
+			continue
+		}
+		typ, unsigned := stmt.paramTypes[i*2], stmt.paramTypes[i*2+1]&paramUnsignedFlag != 0
+		if params[i], pos, err = parseBinaryValue(data, pos, typ, unsigned); err != nil {
+			return nil, err
+		}
+	}
+	return params, nil
+}
+
+// parseBinaryValue converts a value in the binary protocol into the expr
+func parseBinaryValue(data []byte, pos int, typ uint8, unsigned bool) (tree.Expr, int, error) {
+	var size int
+	switch typ {
+	case defines.MYSQL_TYPE_NULL:
+		return tree.NewNumVal(constant.MakeUnknown(), "NULL", false), pos, nil
+	case defines.MYSQL_TYPE_TINY:
+		size = 1
+	case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+		size = 2
+	case defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_FLOAT:
+		size = 4
+	case defines.MYSQL_TYPE_LONGLONG, defines.MYSQL_TYPE_DOUBLE:
+		size = 8
+	case defines.MYSQL_TYPE_DATE, defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP, defines.MYSQL_TYPE_TIME:
+		//int<1> length, then the fields
+		if pos >= len(data) {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		size = 1 + int(data[pos])
+	default:
+		//string<lenenc>
+		length, next, ok := readLenEncInt(data, pos)
+		//the length is checked before the conversion, a huge one wraps around
+		if !ok || length > uint64(len(data)-next) {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		end := next + int(length)
+		s := string(data[next:end])
+		switch typ {
+		case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL:
+			return makeNumericParam(constant.MakeFromLiteral(s, token.FLOAT, 0), s), end, nil
+		}
+		return tree.NewNumVal(constant.MakeString(s), s, false), end, nil
+	}
+	if pos+size > len(data) {
+		return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	buf := data[pos : pos+size]
+	pos += size
+
+	switch typ {
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR,
+		defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONGLONG:
+		var v uint64
+		var signed int64
+		switch size {
+		case 1:
+			v, signed = uint64(buf[0]), int64(int8(buf[0]))
+		case 2:
+			u := binary.LittleEndian.Uint16(buf)
+			v, signed = uint64(u), int64(int16(u))
+		case 4:
+			u := binary.LittleEndian.Uint32(buf)
+			v, signed = uint64(u), int64(int32(u))
+		default:
+			v = binary.LittleEndian.Uint64(buf)
+			signed = int64(v)
+		}
+		if unsigned {
+			return makeNumericParam(constant.MakeUint64(v), strconv.FormatUint(v, 10)), pos, nil
+		}
+		return makeNumericParam(constant.MakeInt64(signed), strconv.FormatInt(signed, 10)), pos, nil
+	case defines.MYSQL_TYPE_FLOAT:
+		f := float64(math.Float32frombits(binary.LittleEndian.Uint32(buf)))
+		return makeNumericParam(constant.MakeFloat64(f), strconv.FormatFloat(f, 'g', -1, 32)), pos, nil
+	case defines.MYSQL_TYPE_DOUBLE:
+		f := math.Float64frombits(binary.LittleEndian.Uint64(buf))
+		return makeNumericParam(constant.MakeFloat64(f), strconv.FormatFloat(f, 'g', -1, 64)), pos, nil
+	case defines.MYSQL_TYPE_TIME:
+		s, err := parseBinaryTime(buf[1:])
+		if err != nil {
+			return nil, 0, err
+		}
+		return tree.NewNumVal(constant.MakeString(s), s, false), pos, nil
+	default:
+		s, err := parseBinaryDatetime(buf[1:])
+		if err != nil {
+			return nil, 0, err
+		}
+		return tree.NewNumVal(constant.MakeString(s), s, false), pos, nil
+	}
+}
+
+// makeNumericParam makes the numeric expr as the parser does.
+// the negative number is the unary minus on its absolute value.
+func makeNumericParam(v constant.Value, s string) tree.Expr {
+	if v.Kind() == constant.Unknown {
+		return tree.NewNumVal(constant.MakeString(s), s, false)
+	}
+	if constant.Sign(v) >= 0 {
+		return tree.NewNumVal(v, s, false)
+	}
+	abs := constant.UnaryOp(token.SUB, v, 0)
+	if abs.Kind() == constant.Int {
+		if u, ok := constant.Uint64Val(abs); ok {
+			abs = constant.MakeUint64(u)
+		}
+	}
+	return tree.NewUnaryExpr(tree.UNARY_MINUS, tree.NewNumVal(abs, s[1:], false))
+}
+
+// parseBinaryDatetime converts the DATE/DATETIME/TIMESTAMP in the binary protocol into string.
+// the length is 0, 4, 7 or 11.
+func parseBinaryDatetime(buf []byte) (string, error) {
+	switch len(buf) {
+	case 0:
+		return "0000-00-00 00:00:00", nil
+	case 4:
+		return fmt.Sprintf("%04d-%02d-%02d", binary.LittleEndian.Uint16(buf), buf[2], buf[3]), nil
+	case 7:
+		return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", binary.LittleEndian.Uint16(buf), buf[2], buf[3],
+			buf[4], buf[5], buf[6]), nil
+	case 11:
+		return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d.%06d", binary.LittleEndian.Uint16(buf), buf[2], buf[3],
+			buf[4], buf[5], buf[6], binary.LittleEndian.Uint32(buf[7:])), nil
+	}
+	return "", NewMysqlError(ER_MALFORMED_PACKET)
+}
+
+// parseBinaryTime converts the TIME in the binary protocol into string.
+// the length is 0, 8 or 12.
+func parseBinaryTime(buf []byte) (string, error) {
+	switch len(buf) {
+	case 0:
+		return "00:00:00", nil
+	case 8, 12:
+		sign := ""
+		if buf[0] == 1 {
+			sign = "-"
+		}
+		hours := binary.LittleEndian.Uint32(buf[1:])*24 + uint32(buf[5])
+		s := fmt.Sprintf("%s%02d:%02d:%02d", sign, hours, buf[6], buf[7])
+		if len(buf) == 12 {
+			s += fmt.Sprintf(".%06d", binary.LittleEndian.Uint32(buf[8:]))
+		}
+		return s, nil
+	}
+	return "", NewMysqlError(ER_MALFORMED_PACKET)
+}
+
+// readLenEncInt reads an int with length encoded from the data at the position
+func readLenEncInt(data []byte, pos int) (uint64, int, bool) {
+	if pos >= len(data) {
+		return 0, 0, false
+	}
+	var size int
+	switch data[pos] {
+	case 0xfc:
+		size = 2
+	case 0xfd:
+		size = 3
+	case 0xfe:
+		size = 8
+	default:
+		return uint64(data[pos]), pos + 1, true
+	}
+	if pos+1+size > len(data) {
+		return 0, 0, false
+	}
+	var v uint64
+	for i := size; i > 0; i-- {
+		v = v<<8 | uint64(data[pos+i])
+	}
+	return v, pos + 1 + size, true
+}
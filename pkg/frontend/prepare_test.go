@@ -0,0 +1,230 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/fagongzi/goetty/buf"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/prashantv/gostub"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+// makeStmtExecutePayload makes the payload of COM_STMT_EXECUTE without the command
+func makeStmtExecutePayload(stmtId uint32, nullBitmap []byte, paramTypes []byte, values []byte) []byte {
+	data := make([]byte, 9)
+	binary.LittleEndian.PutUint32(data, stmtId)
+	data = append(data, nullBitmap...)
+	if paramTypes != nil {
+		data = append(data, 1)
+		data = append(data, paramTypes...)
+	} else {
+		data = append(data, 0)
+	}
+	return append(data, values...)
+}
+
+func Test_parseStmtExecuteParams(t *testing.T) {
+	convey.Convey("parse params succ", t, func() {
+		paramTypes := []byte{
+			defines.MYSQL_TYPE_TINY, 0,
+			defines.MYSQL_TYPE_LONGLONG, paramUnsignedFlag,
+			defines.MYSQL_TYPE_VAR_STRING, 0,
+			defines.MYSQL_TYPE_LONG, 0,
+			defines.MYSQL_TYPE_DOUBLE, 0,
+			defines.MYSQL_TYPE_DATETIME, 0,
+			defines.MYSQL_TYPE_NEWDECIMAL, 0,
+			defines.MYSQL_TYPE_TIME, 0,
+			defines.MYSQL_TYPE_STRING, 0,
+		}
+		var values []byte
+		values = append(values, 0xff)
+		values = append(values, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff)
+		values = append(values, 3, 'a', 'b', 'c')
+		//the 4th param is NULL
+		values = append(values, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.LittleEndian.PutUint64(values[len(values)-8:], math.Float64bits(-1.5))
+		values = append(values, 7, 0xe2, 0x07, 4, 28, 10, 21, 15)
+		values = append(values, 5, '-', '1', '.', '2', '5')
+		values = append(values, 8, 1, 1, 0, 0, 0, 2, 3, 4)
+
+		stmt := &PrepareStmt{id: 1, numParams: 9, longData: map[int][]byte{8: []byte("long data")}}
+		data := makeStmtExecutePayload(1, []byte{0x08, 0}, paramTypes, values)
+		params, err := parseStmtExecuteParams(stmt, data)
+		convey.So(err, convey.ShouldBeNil)
+
+		want := []string{"-1", "18446744073709551615", "abc", "NULL", "-1.5", "2018-04-28 10:21:15", "-1.25", "-26:03:04", "long data"}
+		convey.So(len(params), convey.ShouldEqual, len(want))
+		for i, p := range params {
+			convey.So(tree.String(p, dialect.MYSQL), convey.ShouldEqual, want[i])
+		}
+		_, ok := params[0].(*tree.UnaryExpr)
+		convey.So(ok, convey.ShouldBeTrue)
+
+		//the types are kept by the statement when they are not rebound
+		stmt.longData = nil
+		values = []byte{3, 'x', 'y', 'z'}
+		data = makeStmtExecutePayload(1, []byte{0xfb, 0x01}, nil, values)
+		params, err = parseStmtExecuteParams(stmt, data)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(tree.String(params[2], dialect.MYSQL), convey.ShouldEqual, "xyz")
+	})
+
+	convey.Convey("parse params failed", t, func() {
+		stmt := &PrepareStmt{id: 1, numParams: 1}
+
+		//no types of the params
+		_, err := parseStmtExecuteParams(stmt, makeStmtExecutePayload(1, []byte{0}, nil, nil))
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the value is too short
+		_, err = parseStmtExecuteParams(stmt, makeStmtExecutePayload(1, []byte{0},
+			[]byte{defines.MYSQL_TYPE_LONG, 0}, []byte{1, 2}))
+		convey.So(err, convey.ShouldNotBeNil)
+
+		_, err = parseStmtExecuteParams(stmt, []byte{1, 0, 0})
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the length of the string wraps around as an int
+		_, err = parseStmtExecuteParams(stmt, makeStmtExecutePayload(1, []byte{0},
+			[]byte{defines.MYSQL_TYPE_VAR_STRING, 0}, []byte{0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 'a'}))
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_mce_prepare(t *testing.T) {
+	convey.Convey("prepare and execute succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		eng.EXPECT().Database(gomock.Any()).Return(nil, nil).AnyTimes()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		var execSql string
+		var execParams []tree.Expr
+		stubs := gostub.Stub(&GetComputationWrapper, func(db, sql string, params []tree.Expr, user string, privs *privilege.Privileges, eng engine.Engine, proc *process.Process) ([]ComputationWrapper, error) {
+			execSql, execParams = sql, params
+			return nil, nil
+		})
+		defer stubs.Reset()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		guestMmu := guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu)
+		ses := NewSession(proto, getPCI(), guestMmu, pu.Mempool, pu)
+
+		mce := NewMysqlCmdExecutor()
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		sql := "select a from t where b = ? and c = ?"
+		resp, err := mce.ExecRequest(&Request{cmd: int(COM_STMT_PREPARE), data: []byte(sql)})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		convey.So(len(mce.prepareStmts), convey.ShouldEqual, 1)
+		convey.So(mce.prepareStmts[1].numParams, convey.ShouldEqual, 2)
+
+		resp, err = mce.ExecRequest(&Request{cmd: int(COM_STMT_PREPARE), data: []byte("select a from t where")})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, ErrorResponse)
+
+		//send long data of the second param
+		resp, err = mce.ExecRequest(&Request{cmd: int(COM_STMT_SEND_LONG_DATA), data: []byte{1, 0, 0, 0, 1, 0, 'x', 'y'}})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		convey.So(string(mce.prepareStmts[1].longData[1]), convey.ShouldEqual, "xy")
+
+		resp, err = mce.ExecRequest(&Request{cmd: int(COM_STMT_RESET), data: []byte{1, 0, 0, 0}})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, OkResponse)
+		convey.So(mce.prepareStmts[1].longData, convey.ShouldBeNil)
+
+		paramTypes := []byte{defines.MYSQL_TYPE_LONG, 0, defines.MYSQL_TYPE_VAR_STRING, 0}
+		values := []byte{10, 0, 0, 0, 2, 'a', 'b'}
+		resp, err = mce.ExecRequest(&Request{cmd: int(COM_STMT_EXECUTE), data: makeStmtExecutePayload(1, []byte{0}, paramTypes, values)})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		convey.So(ses.Cmd, convey.ShouldEqual, int(COM_STMT_EXECUTE))
+		convey.So(execSql, convey.ShouldEqual, sql)
+		convey.So(len(execParams), convey.ShouldEqual, 2)
+		convey.So(tree.String(execParams[0], dialect.MYSQL), convey.ShouldEqual, "10")
+		convey.So(tree.String(execParams[1], dialect.MYSQL), convey.ShouldEqual, "ab")
+
+		resp, err = mce.ExecRequest(&Request{cmd: int(COM_STMT_EXECUTE), data: makeStmtExecutePayload(2, []byte{0}, nil, nil)})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp.category, convey.ShouldEqual, ErrorResponse)
+
+		resp, err = mce.ExecRequest(&Request{cmd: int(COM_STMT_CLOSE), data: []byte{1, 0, 0, 0}})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(resp, convey.ShouldBeNil)
+		convey.So(len(mce.prepareStmts), convey.ShouldEqual, 0)
+	})
+
+	convey.Convey("describe prepared statement", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+
+		col := new(MysqlColumn)
+		col.SetName("a")
+		col.SetColumnType(defines.MYSQL_TYPE_LONG)
+		cw := mock_frontend.NewMockComputationWrapper(ctrl)
+		cw.EXPECT().Describe().Return([]interface{}{col}, nil)
+
+		var execParams []tree.Expr
+		stubs := gostub.Stub(&GetComputationWrapper, func(db, sql string, params []tree.Expr, user string, privs *privilege.Privileges, eng engine.Engine, proc *process.Process) ([]ComputationWrapper, error) {
+			execParams = params
+			return []ComputationWrapper{cw}, nil
+		})
+		defer stubs.Reset()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		guestMmu := guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu)
+		ses := NewSession(proto, getPCI(), guestMmu, pu.Mempool, pu)
+
+		mce := NewMysqlCmdExecutor()
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		columns := mce.describeStmt("select a from t where b = ?", 1)
+		convey.So(columns, convey.ShouldResemble, []interface{}{col})
+		convey.So(len(execParams), convey.ShouldEqual, 1)
+		convey.So(tree.String(execParams[0], dialect.MYSQL), convey.ShouldEqual, "0")
+	})
+}
@@ -0,0 +1,277 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
+
+//the max length of the statement shown by SHOW PROCESSLIST without FULL
+const processInfoLength = 100
+
+//commandNames are the names of the commands shown by SHOW PROCESSLIST
+var commandNames = map[uint8]string{
+	COM_SLEEP:               "Sleep",
+	COM_QUIT:                "Quit",
+	COM_INIT_DB:             "Init DB",
+	COM_QUERY:               "Query",
+	COM_FIELD_LIST:          "Field List",
+	COM_CONNECT:             "Connect",
+	COM_PING:                "Ping",
+	COM_STMT_PREPARE:        "Prepare",
+	COM_STMT_EXECUTE:        "Execute",
+	COM_STMT_SEND_LONG_DATA: "Long Data",
+	COM_STMT_CLOSE:          "Close stmt",
+	COM_STMT_RESET:          "Reset stmt",
+}
+
+//processState is the state of a connection shown by SHOW PROCESSLIST,
+//it is read and killed by other connections.
+type processState struct {
+	sync.Mutex
+
+	user string
+	host string
+	db   string
+
+	//the command of the client, it is COM_SLEEP if the connection is idle
+	command uint8
+
+	//the time when the connection began the command
+	start time.Time
+
+	//the statement being executed
+	info string
+
+	//the computation of the statement being executed, it is stopped by KILL
+	cw ComputationWrapper
+
+	//the statement being executed has been killed
+	killed bool
+}
+
+//setCommand records the command of the client. the statement killed before is forgotten.
+func (mce *MysqlCmdExecutor) setCommand(proto MysqlProtocol, cmd uint8) {
+	st := &mce.procState
+	st.Lock()
+	defer st.Unlock()
+	st.user = proto.GetUserName()
+	st.db = proto.GetDatabaseName()
+	st.command = cmd
+	st.start = time.Now()
+	st.info = ""
+	st.cw = nil
+	st.killed = false
+}
+
+//setStatement records the statement being executed
+func (mce *MysqlCmdExecutor) setStatement(sql string) {
+	st := &mce.procState
+	st.Lock()
+	defer st.Unlock()
+	st.info = sql
+}
+
+//startComputation records the computation of the statement being executed.
+//it returns ER_QUERY_INTERRUPTED if the statement has been killed.
+func (mce *MysqlCmdExecutor) startComputation(cw ComputationWrapper) error {
+	st := &mce.procState
+	st.Lock()
+	defer st.Unlock()
+	if st.killed {
+		return NewMysqlError(ER_QUERY_INTERRUPTED)
+	}
+	st.cw = cw
+	return nil
+}
+
+//killQuery stops the statement being executed by the connection
+func (mce *MysqlCmdExecutor) killQuery() {
+	st := &mce.procState
+	st.Lock()
+	st.killed = true
+	if st.cw != nil {
+		st.cw.Kill()
+	}
+	loadDataClose, exportDataClose := mce.loadDataClose, mce.exportDataClose
+	st.Unlock()
+
+	if loadDataClose != nil {
+		loadDataClose.Close()
+	}
+	if exportDataClose != nil {
+		exportDataClose.Close()
+	}
+}
+
+//setLoadDataClose records the closing of the load data being executed.
+//it is guarded by the lock of the state as it is read by KILL.
+func (mce *MysqlCmdExecutor) setLoadDataClose(c *CloseLoadData) {
+	st := &mce.procState
+	st.Lock()
+	defer st.Unlock()
+	mce.loadDataClose = c
+}
+
+//setExportDataClose records the closing of the export data being executed.
+//it is guarded by the lock of the state as it is read by KILL.
+func (mce *MysqlCmdExecutor) setExportDataClose(c *CloseExportData) {
+	st := &mce.procState
+	st.Lock()
+	defer st.Unlock()
+	mce.exportDataClose = c
+}
+
+//getUserName returns the user of the connection
+func (mce *MysqlCmdExecutor) getUserName() string {
+	st := &mce.procState
+	st.Lock()
+	defer st.Unlock()
+	return st.user
+}
+
+//processRow returns the row of the connection in SHOW PROCESSLIST
+func (mce *MysqlCmdExecutor) processRow(id uint32, full bool) []interface{} {
+	st := &mce.procState
+	st.Lock()
+	defer st.Unlock()
+
+	var db, state, info interface{}
+	if len(st.db) != 0 {
+		db = st.db
+	}
+	if st.command != COM_SLEEP {
+		state = "executing"
+	}
+	if len(st.info) != 0 {
+		if !full && len(st.info) > processInfoLength {
+			info = st.info[:processInfoLength]
+		} else {
+			info = st.info
+		}
+	}
+	command, ok := commandNames[st.command]
+	if !ok {
+		command = fmt.Sprintf("Command 0x%x", st.command)
+	}
+	return []interface{}{int64(id), st.user, st.host, db, command, int64(time.Since(st.start) / time.Second), state, info}
+}
+
+//hasSuperPrivilege returns true if the user can see and kill the connections of other users
+func (mce *MysqlCmdExecutor) hasSuperPrivilege() (bool, error) {
+	privs, err := mce.getPrivileges()
+	if err != nil {
+		return false, err
+	}
+	return privs == nil || privs.Check(privilege.Any, privilege.Any, privilege.Super), nil
+}
+
+/*
+handle SHOW [FULL] PROCESSLIST
+*/
+func (mce *MysqlCmdExecutor) handleShowProcessList(st *tree.ShowProcessList) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+
+	//the connections of other users are only visible to the user with the SUPER privilege
+	all, err := mce.hasSuperPrivilege()
+	if err != nil {
+		return err
+	}
+
+	columns := []struct {
+		name string
+		typ  uint8
+	}{
+		{"Id", defines.MYSQL_TYPE_LONGLONG},
+		{"User", defines.MYSQL_TYPE_VARCHAR},
+		{"Host", defines.MYSQL_TYPE_VARCHAR},
+		{"db", defines.MYSQL_TYPE_VARCHAR},
+		{"Command", defines.MYSQL_TYPE_VARCHAR},
+		{"Time", defines.MYSQL_TYPE_LONG},
+		{"State", defines.MYSQL_TYPE_VARCHAR},
+		{"Info", defines.MYSQL_TYPE_VARCHAR},
+	}
+	for _, c := range columns {
+		col := new(MysqlColumn)
+		col.SetColumnType(c.typ)
+		col.SetName(c.name)
+		ses.Mrs.AddColumn(col)
+	}
+
+	if mce.routineMgr == nil {
+		ses.Mrs.AddRow(mce.processRow(proto.ConnectionID(), st.Full))
+	} else {
+		for _, rt := range mce.routineMgr.getRoutines() {
+			exe, ok := rt.executor.(*MysqlCmdExecutor)
+			if !ok || (!all && exe.getUserName() != proto.GetUserName()) {
+				continue
+			}
+			ses.Mrs.AddRow(exe.processRow(rt.getConnID(), st.Full))
+		}
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, int(ses.GetServerStatus()), ses.Cmd, mer)
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+/*
+handle KILL [CONNECTION | QUERY]
+*/
+func (mce *MysqlCmdExecutor) handleKill(st *tree.Kill) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+
+	var rt *Routine
+	if mce.routineMgr != nil {
+		rt = mce.routineMgr.getRoutine(st.ConnectionId)
+	}
+	if rt == nil {
+		return NewMysqlError(ER_NO_SUCH_THREAD, st.ConnectionId)
+	}
+	exe, ok := rt.executor.(*MysqlCmdExecutor)
+	if !ok {
+		return NewMysqlError(ER_NO_SUCH_THREAD, st.ConnectionId)
+	}
+
+	//the connections of other users can only be killed by the user with the SUPER privilege
+	if exe.getUserName() != proto.GetUserName() {
+		all, err := mce.hasSuperPrivilege()
+		if err != nil {
+			return err
+		}
+		if !all {
+			return NewMysqlError(ER_KILL_DENIED_ERROR, st.ConnectionId)
+		}
+	}
+
+	if st.Query {
+		exe.killQuery()
+	} else {
+		//the statement being executed is stopped when the connection is closed
+		rt.Quit()
+	}
+	return mce.sendOkResponse()
+}
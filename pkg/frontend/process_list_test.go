@@ -0,0 +1,187 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+)
+
+//blockedComputation holds the result of the query until the query is killed
+type blockedComputation struct {
+	*ComputationWrapperImpl
+	killed chan struct{}
+	once   sync.Once
+}
+
+func (bc *blockedComputation) Compile(u interface{}, fill func(interface{}, *batch.Batch) error) error {
+	return bc.ComputationWrapperImpl.Compile(u, func(u interface{}, bat *batch.Batch) error {
+		<-bc.killed
+		return fill(u, bat)
+	})
+}
+
+func (bc *blockedComputation) Kill() {
+	bc.ComputationWrapperImpl.Kill()
+	bc.once.Do(func() { close(bc.killed) })
+}
+
+func TestMysqlCmdExecutor_Kill(t *testing.T) {
+	var sv config.SystemVariables
+	require.NoError(t, sv.LoadInitialValues())
+	require.NoError(t, config.LoadvarsConfigFromFile("test/system_vars_config.toml", &sv))
+	addr := startTestServer(t, &sv)
+
+	//the query on R is blocked until it is killed
+	compile.InitAddress("127.0.0.1")
+	getComputationWrapper := GetComputationWrapper
+	stubs := gostub.Stub(&GetComputationWrapper, func(db, sql string, params []tree.Expr, user string, privs *privilege.Privileges, eng engine.Engine, proc *process.Process) ([]ComputationWrapper, error) {
+		if sql != "select * from R" {
+			return getComputationWrapper(db, sql, params, user, privs, eng, proc)
+		}
+		execs, err := compile.New(db, sql, user, memEngine.NewTestEngine(), proc).Build()
+		if err != nil {
+			return nil, err
+		}
+		return []ComputationWrapper{&blockedComputation{
+			ComputationWrapperImpl: NewComputationWrapperImpl(execs[0]),
+			killed:                 make(chan struct{}),
+		}}, nil
+	})
+	defer stubs.Reset()
+
+	open := func(user, password, db string) *sql.Conn {
+		pool, err := sql.Open("mysql", fmt.Sprintf("%s:%s@tcp(%s)/%s?timeout=10s", user, password, addr, db))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = pool.Close() })
+		conn, err := pool.Conn(context.Background())
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+		return conn
+	}
+	type process struct {
+		id                  int64
+		user, host, command string
+		db, state, info     sql.NullString
+		time                int64
+	}
+	showProcessList := func(conn *sql.Conn, query string) []process {
+		rows, err := conn.QueryContext(context.Background(), query)
+		require.NoError(t, err)
+		defer rows.Close()
+		var ps []process
+		for rows.Next() {
+			var p process
+			require.NoError(t, rows.Scan(&p.id, &p.user, &p.host, &p.db, &p.command, &p.time, &p.state, &p.info))
+			ps = append(ps, p)
+		}
+		require.NoError(t, rows.Err())
+		return ps
+	}
+	errorCode := func(err error) uint16 {
+		require.Error(t, err)
+		merr, ok := err.(*mysql.MySQLError)
+		require.True(t, ok, "%v", err)
+		return merr.Number
+	}
+	exec := func(conn *sql.Conn, query string) error {
+		_, err := conn.ExecContext(context.Background(), query)
+		return err
+	}
+
+	dump := open("dump", "111", "")
+	require.NoError(t, exec(dump, "create user u1"))
+	u1 := open("u1", "", "")
+	victim := open("dump", "111", "test")
+
+	//the query of the victim is running
+	result := make(chan error, 1)
+	go func() {
+		rows, err := victim.QueryContext(context.Background(), "select * from R")
+		if err == nil {
+			for rows.Next() {
+			}
+			err = rows.Err()
+			rows.Close()
+		}
+		result <- err
+	}()
+	var running process
+	require.Eventually(t, func() bool {
+		for _, p := range showProcessList(dump, "show full processlist") {
+			if p.info.String == "select * from R" {
+				running = p
+				return true
+			}
+		}
+		return false
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, "dump", running.user)
+	require.Equal(t, "test", running.db.String)
+	require.Equal(t, "Query", running.command)
+	require.Equal(t, "executing", running.state.String)
+
+	//the connections of dump are invisible to u1
+	ps := showProcessList(u1, "show processlist")
+	require.Len(t, ps, 1)
+	require.Equal(t, "u1", ps[0].user)
+	require.Equal(t, "show processlist", ps[0].info.String)
+	require.Equal(t, ER_KILL_DENIED_ERROR, errorCode(exec(u1, fmt.Sprintf("kill query %d", running.id))))
+	require.Equal(t, ER_NO_SUCH_THREAD, errorCode(exec(dump, "kill query 1000000")))
+
+	//the victim gets the error and its connection is still alive
+	require.NoError(t, exec(dump, fmt.Sprintf("kill query %d", running.id)))
+	select {
+	case err := <-result:
+		require.Equal(t, ER_QUERY_INTERRUPTED, errorCode(err))
+	case <-time.After(10 * time.Second):
+		t.Fatal("the query is not killed")
+	}
+	require.NoError(t, victim.PingContext(context.Background()))
+	for _, p := range showProcessList(dump, "show processlist") {
+		if p.id == running.id {
+			require.Equal(t, "Sleep", p.command)
+			require.False(t, p.info.Valid)
+		}
+	}
+
+	//the connection of the victim is closed
+	require.NoError(t, exec(dump, fmt.Sprintf("kill %d", running.id)))
+	require.Error(t, victim.PingContext(context.Background()))
+	require.Eventually(t, func() bool {
+		for _, p := range showProcessList(dump, "show processlist") {
+			if p.id == running.id {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+}
@@ -46,6 +46,12 @@ type Routine struct {
 	onceCloseNotifyChan    sync.Once
 
 	routineMgr *RoutineManager
+
+	//transaction state shared by the sessions of the routine
+	txnHandler *TxnHandler
+
+	//session variables shared by the sessions of the routine
+	sessionVars *config.SystemVariables
 }
 
 func (routine *Routine) GetClientProtocol() Protocol {
@@ -75,6 +81,8 @@ func (routine *Routine) Loop() {
 	var err error
 	var resp *Response
 	defer routine.Quit()
+	//the uncommitted transaction is discarded when the connection is closed
+	defer routine.txnHandler.Rollback()
 	for{
 		quit := false
 		select {
@@ -94,6 +102,8 @@ func (routine *Routine) Loop() {
 
 		routine.protocol.(*MysqlProtocolImpl).sequenceId = req.seq
 		ses := NewSession(routine.protocol,mgr.getEpochgc(),routine.guestMmu,routine.mempool,mgr.getParameterUnit())
+		ses.SetTxnHandler(routine.txnHandler)
+		ses.SetSessionVars(routine.sessionVars)
 
 		routine.executor.PrepareSessionBeforeExecRequest(ses)
 
@@ -146,6 +156,8 @@ func NewRoutine(protocol MysqlProtocol, executor CmdExecutor,pu *config.Paramete
 		notifyChan:  make(chan interface{}),
 		guestMmu:    guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu),
 		mempool:     pu.Mempool,
+		txnHandler:  NewTxnHandler(),
+		sessionVars: newSessionVars(pu),
 	}
 
 	//async process request
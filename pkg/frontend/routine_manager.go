@@ -15,10 +15,14 @@
 package frontend
 
 import (
+	"crypto/rsa"
+	"crypto/tls"
 	"errors"
 	"github.com/fagongzi/goetty"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"sort"
 	"sync"
 )
 
@@ -30,6 +34,18 @@ type RoutineManager struct {
 	pdHook *PDCallbackImpl
 
 	pu *config.ParameterUnit
+
+	//the tls config of the server, nil if TLS is disabled
+	tlsConfig *tls.Config
+
+	//the users and roles of the server
+	accounts privilege.Catalog
+
+	//the digests of the passwords for the fast authentication of caching_sha2_password
+	sha2Cache *sha2Cache
+
+	//the RSA key which decrypts the passwords sent without TLS
+	rsaKey *rsa.PrivateKey
 }
 
 func (rm *RoutineManager) getEpochgc() *PDCallbackImpl {
@@ -52,6 +68,13 @@ func (rm *RoutineManager) Created(rs goetty.IOSession) {
 
 	routine := NewRoutine(pro, exe, rm.pu)
 	routine.SetRoutineMgr(rm)
+	pro.tlsConfig = rm.tlsConfig
+	pro.conn = getClientConn(rs)
+	pro.accounts = rm.accounts
+	pro.sha2Cache = rm.sha2Cache
+	pro.rsaKey = rm.rsaKey
+	exe.setCommand(pro, COM_CONNECT)
+	exe.procState.host = rs.RemoteAddr()
 
 	hsV10pkt := pro.makeHandshakeV10Payload()
 	err := pro.writePackets(hsV10pkt)
@@ -88,24 +111,34 @@ func (rm *RoutineManager) Closed(rs goetty.IOSession) {
 
 
 /*
-KILL statement
+getRoutine returns the routine of the connection, nil if it does not exist
  */
-func (rm *RoutineManager) killStatement(id uint64) error {
-	rm.rwlock.Lock()
-	defer rm.rwlock.Unlock()
-	var rt *Routine = nil
-	for _, value := range rm.clients {
-		if uint64(value.getConnID()) == id {
-			rt = value
-			break
+func (rm *RoutineManager) getRoutine(id uint64) *Routine {
+	rm.rwlock.RLock()
+	defer rm.rwlock.RUnlock()
+	for _, rt := range rm.clients {
+		if uint64(rt.getConnID()) == id {
+			return rt
 		}
 	}
+	return nil
+}
 
-	if rt != nil {
-		logutil.Infof("will close the statement %d",id)
-		rt.notifyClose()
+/*
+getRoutines returns the routines of the connections ordered by the connection id
+ */
+func (rm *RoutineManager) getRoutines() []*Routine {
+	rm.rwlock.RLock()
+	rts := make([]*Routine, 0, len(rm.clients))
+	for _, rt := range rm.clients {
+		rts = append(rts, rt)
 	}
-	return nil
+	rm.rwlock.RUnlock()
+
+	sort.Slice(rts, func(i, j int) bool {
+		return rts[i].getConnID() < rts[j].getConnID()
+	})
+	return rts
 }
 
 func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received uint64) error {
@@ -166,11 +199,19 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 		logutil.Infof("RP[%v] Payload80[%v]",rs.RemoteAddr(),di)
 		*/
 
+		//the handshake response follows the TLS handshake
+		if protocol.isSSLRequest(payload) {
+			return protocol.upgradeToTLS()
+		}
+
 		err := protocol.handleHandshake(payload)
 		if err != nil {
 			return err
 		}
 		protocol.SetEstablished()
+		if exe, ok := routine.executor.(*MysqlCmdExecutor); ok {
+			exe.setCommand(protocol, COM_SLEEP)
+		}
 		return nil
 	}
 
@@ -185,8 +226,10 @@ func NewRoutineManager(pu *config.ParameterUnit, pdHook *PDCallbackImpl) *Routin
 	rm := &RoutineManager{
 		clients: make(map[goetty.IOSession]*Routine),
 
-		pdHook: pdHook,
-		pu:     pu,
+		pdHook:    pdHook,
+		pu:        pu,
+		accounts:  newAccountCatalog(pu),
+		sha2Cache: newSha2Cache(),
 	}
 	return rm
 }
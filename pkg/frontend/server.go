@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"net"
 	"sync/atomic"
 
 	"github.com/fagongzi/goetty"
@@ -60,13 +61,30 @@ func nextConnectionID() uint32 {
 func NewMOServer(addr string, pu *config.ParameterUnit, pdHook *PDCallbackImpl) *MOServer {
 	encoder, decoder := NewSqlCodec()
 	rm := NewRoutineManager(pu, pdHook)
-	// TODO asyncFlushBatch
-	app, err := goetty.NewTCPApplication(addr, rm.Handler,
+	tlsConfig, err := loadTLSConfig(pu.SV)
+	if err != nil {
+		logutil.Panicf("load tls config failed with %+v", err)
+	}
+	rm.tlsConfig = tlsConfig
+	rsaKey, err := loadRSAKey(pu.SV)
+	if err != nil {
+		logutil.Panicf("load rsa key failed with %+v", err)
+	}
+	rm.rsaKey = rsaKey
+	opts := []goetty.AppOption{
 		goetty.WithAppSessionOptions(
 			goetty.WithCodec(encoder, decoder),
 			goetty.WithLogger(logutil.GetGlobalLogger()),
 			goetty.WithBufSize(1024*1024, 1024*1024)),
-		goetty.WithAppSessionAware(rm))
+		goetty.WithAppSessionAware(rm),
+	}
+	// TODO asyncFlushBatch
+	// the connections must be able to be upgraded to TLS and compressed
+	var app goetty.NetApplication
+	var listener net.Listener
+	if listener, err = newClientListener(addr); err == nil {
+		app, err = goetty.NewApplication(listener, rm.Handler, opts...)
+	}
 	if err != nil {
 		logutil.Panicf("start server failed with %+v", err)
 	}
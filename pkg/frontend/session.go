@@ -37,13 +37,17 @@ type Session struct {
 	GuestMmu *guest.Mmu
 	Mempool  *mempool.Mempool
 
-	sessionVars config.SystemVariables
+	//the session variables of the connection
+	sessionVars *config.SystemVariables
 
 	Pu *config.ParameterUnit
 
 	ep *tree.ExportParam
 
 	closeRef *CloseExportData
+
+	//transaction state of the connection
+	txnHandler *TxnHandler
 }
 
 func NewSession(proto Protocol,pdHook *PDCallbackImpl,
@@ -64,4 +68,20 @@ func NewSession(proto Protocol,pdHook *PDCallbackImpl,
 
 func (ses *Session) GetEpochgc() *PDCallbackImpl {
 	return ses.pdHook
-}
\ No newline at end of file
+}
+func (ses *Session) GetTxnHandler() *TxnHandler {
+	return ses.txnHandler
+}
+
+func (ses *Session) SetTxnHandler(th *TxnHandler) {
+	ses.txnHandler = th
+}
+
+func (ses *Session) SetSessionVars(sv *config.SystemVariables) {
+	ses.sessionVars = sv
+}
+
+//GetServerStatus returns the status flags in the OK/EOF packet
+func (ses *Session) GetServerStatus() uint16 {
+	return ses.txnHandler.GetServerStatus()
+}
@@ -176,6 +176,20 @@ func (mr *MockRelationMockRecorder) DelTableDef(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelTableDef", reflect.TypeOf((*MockRelation)(nil).DelTableDef), arg0, arg1)
 }
 
+// Delete mocks base method.
+func (m *MockRelation) Delete(arg0 uint64, arg1 *batch.Batch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRelationMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRelation)(nil).Delete), arg0, arg1)
+}
+
 // DropIndex mocks base method.
 func (m *MockRelation) DropIndex(epoch uint64, name string) error {
 	m.ctrl.T.Helper()
@@ -274,6 +288,20 @@ func (mr *MockRelationMockRecorder) TableDefs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TableDefs", reflect.TypeOf((*MockRelation)(nil).TableDefs))
 }
 
+// Update mocks base method.
+func (m *MockRelation) Update(arg0 uint64, arg1, arg2 *batch.Batch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRelationMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRelation)(nil).Update), arg0, arg1, arg2)
+}
+
 // Write mocks base method.
 func (m *MockRelation) Write(arg0 uint64, arg1 *batch.Batch) error {
 	m.ctrl.T.Helper()
@@ -678,6 +706,21 @@ func (mr *MockSparseFilterMockRecorder) Gt(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Gt", reflect.TypeOf((*MockSparseFilter)(nil).Gt), arg0, arg1)
 }
 
+// In mocks base method.
+func (m *MockSparseFilter) In(arg0 string, arg1 []interface{}) (engine.Reader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "In", arg0, arg1)
+	ret0, _ := ret[0].(engine.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// In indicates an expected call of In.
+func (mr *MockSparseFilterMockRecorder) In(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "In", reflect.TypeOf((*MockSparseFilter)(nil).In), arg0, arg1)
+}
+
 // Le mocks base method.
 func (m *MockSparseFilter) Le(arg0 string, arg1 interface{}) (engine.Reader, error) {
 	m.ctrl.T.Helper()
@@ -723,6 +766,21 @@ func (mr *MockSparseFilterMockRecorder) Ne(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ne", reflect.TypeOf((*MockSparseFilter)(nil).Ne), arg0, arg1)
 }
 
+// NotIn mocks base method.
+func (m *MockSparseFilter) NotIn(arg0 string, arg1 []interface{}) (engine.Reader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotIn", arg0, arg1)
+	ret0, _ := ret[0].(engine.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotIn indicates an expected call of NotIn.
+func (mr *MockSparseFilterMockRecorder) NotIn(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotIn", reflect.TypeOf((*MockSparseFilter)(nil).NotIn), arg0, arg1)
+}
+
 // MockDatabase is a mock of Database interface.
 type MockDatabase struct {
 	ctrl     *gomock.Controller
@@ -54,6 +54,21 @@ func (mr *MockComputationWrapperMockRecorder) Compile(u, fill interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compile", reflect.TypeOf((*MockComputationWrapper)(nil).Compile), u, fill)
 }
 
+// Describe mocks base method.
+func (m *MockComputationWrapper) Describe() ([]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].([]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockComputationWrapperMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockComputationWrapper)(nil).Describe))
+}
+
 // GetAffectedRows mocks base method.
 func (m *MockComputationWrapper) GetAffectedRows() uint64 {
 	m.ctrl.T.Helper()
@@ -97,6 +112,18 @@ func (mr *MockComputationWrapperMockRecorder) GetColumns() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumns", reflect.TypeOf((*MockComputationWrapper)(nil).GetColumns))
 }
 
+// Kill mocks base method.
+func (m *MockComputationWrapper) Kill() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Kill")
+}
+
+// Kill indicates an expected call of Kill.
+func (mr *MockComputationWrapperMockRecorder) Kill() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Kill", reflect.TypeOf((*MockComputationWrapper)(nil).Kill))
+}
+
 // Run mocks base method.
 func (m *MockComputationWrapper) Run(ts uint64) error {
 	m.ctrl.T.Helper()
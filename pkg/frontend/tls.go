@@ -0,0 +1,75 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+)
+
+const (
+	//the length of the payload of the SSL request packet
+	sslRequestLength int = 32
+
+	//the timeout of the TLS handshake with the client
+	tlsHandshakeTimeout = 10 * time.Second
+)
+
+//loadTLSConfig makes the tls config of the server with the system variables
+//return nil if the TLS is disabled
+func loadTLSConfig(SV *config.SystemVariables) (*tls.Config, error) {
+	certFile, keyFile := SV.GetTlsCertFile(), SV.GetTlsKeyFile()
+	if len(certFile) == 0 || len(keyFile) == 0 {
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load certificate %s and key %s failed. error:%v", certFile, keyFile, err)
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if caFile := SV.GetTlsCaFile(); len(caFile) != 0 {
+		data, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("read CA %s failed. error:%v", caFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no valid certificate in CA %s", caFile)
+		}
+		//the certificate of the client is verified if the client sends one
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return cfg, nil
+}
+
+//isTLSRequired returns true if the user must connect with TLS
+func isTLSRequired(SV *config.SystemVariables, username string) bool {
+	for _, name := range strings.Split(SV.GetTlsRequiredUsers(), ",") {
+		if strings.TrimSpace(name) == username {
+			return true
+		}
+	}
+	return false
+}
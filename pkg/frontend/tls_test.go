@@ -0,0 +1,128 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+//writeTestCertificate writes a self-signed certificate and its key into dir
+func writeTestCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "matrixone"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certFile, keyFile
+}
+
+func TestLoadTLSConfig(t *testing.T) {
+	var sv config.SystemVariables
+	require.NoError(t, sv.LoadInitialValues())
+
+	cfg, err := loadTLSConfig(&sv)
+	require.NoError(t, err)
+	require.Nil(t, cfg)
+
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCertificate(t, dir)
+	require.NoError(t, sv.SetTlsCertFile(certFile))
+	require.NoError(t, sv.SetTlsKeyFile(keyFile))
+	cfg, err = loadTLSConfig(&sv)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+	require.Equal(t, tls.NoClientCert, cfg.ClientAuth)
+
+	require.NoError(t, sv.SetTlsCaFile(certFile))
+	cfg, err = loadTLSConfig(&sv)
+	require.NoError(t, err)
+	require.Equal(t, tls.VerifyClientCertIfGiven, cfg.ClientAuth)
+
+	require.NoError(t, sv.SetTlsCaFile(keyFile))
+	_, err = loadTLSConfig(&sv)
+	require.Error(t, err)
+
+	require.NoError(t, sv.SetTlsKeyFile(filepath.Join(dir, "missing.pem")))
+	_, err = loadTLSConfig(&sv)
+	require.Error(t, err)
+
+	require.False(t, isTLSRequired(&sv, "dump"))
+	require.NoError(t, sv.SetTlsRequiredUsers("root, dump"))
+	require.True(t, isTLSRequired(&sv, "dump"))
+	require.False(t, isTLSRequired(&sv, "dum"))
+}
+
+func TestMysqlClientProtocol_TLS(t *testing.T) {
+	var sv config.SystemVariables
+	require.NoError(t, sv.LoadInitialValues())
+	require.NoError(t, config.LoadvarsConfigFromFile("test/system_vars_config.toml", &sv))
+	certFile, keyFile := writeTestCertificate(t, t.TempDir())
+	require.NoError(t, sv.SetTlsCertFile(certFile))
+	require.NoError(t, sv.SetTlsKeyFile(keyFile))
+
+	addr := startTestServer(t, &sv)
+
+	require.NoError(t, mysql.RegisterTLSConfig("mo-test", &tls.Config{InsecureSkipVerify: true}))
+	defer mysql.DeregisterTLSConfig("mo-test")
+
+	ping := func(param string) error {
+		dsn := fmt.Sprintf("dump:111@tcp(%s)/?timeout=10s&tls=%s", addr, param)
+		db, err := sql.Open("mysql", dsn)
+		require.NoError(t, err)
+		defer db.Close()
+		return db.Ping()
+	}
+	require.NoError(t, ping("mo-test"))
+	require.NoError(t, ping("false"))
+
+	//the user dump must connect with TLS
+	require.NoError(t, sv.SetTlsRequiredUsers("dump"))
+	require.NoError(t, ping("mo-test"))
+	require.Error(t, ping("false"))
+}
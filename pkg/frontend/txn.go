@@ -0,0 +1,131 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/txn"
+)
+
+/*
+TxnHandler holds the transaction state of a connection.
+The Session is created for every request, but the transaction
+started by BEGIN lives until COMMIT or ROLLBACK, so the handler
+belongs to the Routine and is shared by its sessions.
+*/
+type TxnHandler struct {
+	txn *txn.Txn
+
+	//epoch gc handler
+	pdHook *PDCallbackImpl
+
+	//the epoch pinned by the transaction
+	epoch uint64
+}
+
+func NewTxnHandler() *TxnHandler {
+	return &TxnHandler{}
+}
+
+//IsInTransaction checks if there is an active transaction
+func (th *TxnHandler) IsInTransaction() bool {
+	return th != nil && th.txn != nil
+}
+
+//GetTxn returns the active transaction or nil
+func (th *TxnHandler) GetTxn() *txn.Txn {
+	if th == nil {
+		return nil
+	}
+	return th.txn
+}
+
+//Begin starts a new transaction. The epoch at the beginning is pinned until
+//the end of the transaction, so the data it may read will not be collected.
+func (th *TxnHandler) Begin(pdHook *PDCallbackImpl, readOnly bool) {
+	th.txn = txn.New(readOnly)
+	th.pdHook = pdHook
+	if pdHook != nil {
+		th.epoch, _ = pdHook.IncQueryCountAtCurrentEpoch(1)
+	}
+}
+
+//Commit applies the writes of the active transaction to the engine
+func (th *TxnHandler) Commit(e engine.Engine, epoch uint64) error {
+	if !th.IsInTransaction() {
+		return nil
+	}
+	defer th.end()
+	return th.txn.Commit(e, epoch)
+}
+
+//Rollback discards the writes of the active transaction
+func (th *TxnHandler) Rollback() {
+	if !th.IsInTransaction() {
+		return
+	}
+	th.txn.Rollback()
+	th.end()
+}
+
+func (th *TxnHandler) end() {
+	if th.pdHook != nil {
+		th.pdHook.DecQueryCountAtEpoch(th.epoch, 1)
+	}
+	th.txn = nil
+	th.pdHook = nil
+	th.epoch = 0
+}
+
+//GetServerStatus returns the status flags of the transaction in the OK/EOF packet
+func (th *TxnHandler) GetServerStatus() uint16 {
+	status := SERVER_STATUS_AUTOCOMMIT
+	if th.IsInTransaction() {
+		status |= SERVER_STATUS_IN_TRANS
+		if th.txn.ReadOnly() {
+			status |= SERVER_STATUS_IN_TRANS_READONLY
+		}
+	}
+	return status
+}
+
+//autocommitTable returns the table changed by the INSERT, UPDATE or DELETE
+//statement, in the database db unless the statement names one. Outside of
+//a transaction, the statement writes the committed tuples of the table.
+func autocommitTable(stmt tree.Statement, db string) (string, string, bool) {
+	var tbl tree.TableExpr
+	switch st := stmt.(type) {
+	case *tree.Insert:
+		tbl = st.Table
+	case *tree.Update:
+		tbl = st.Table
+	case *tree.Delete:
+		tbl = st.Table
+	default:
+		return "", "", false
+	}
+	if at, ok := tbl.(*tree.AliasedTableExpr); ok {
+		tbl = at.Expr
+	}
+	tn, ok := tbl.(*tree.TableName)
+	if !ok {
+		return "", "", false
+	}
+	if schema := string(tn.Schema()); schema != "" {
+		db = schema
+	}
+	return db, string(tn.Name()), true
+}
@@ -13,10 +13,14 @@ type ComputationWrapper interface {
 
 	GetColumns() ([]interface{},error)
 
+	Describe() ([]interface{},error)
+
 	GetAffectedRows() uint64
 
 	Compile(u interface{},
 		fill func(interface{}, *batch.Batch) error) error
 
 	Run(ts uint64) error
+
+	Kill()
 }
\ No newline at end of file
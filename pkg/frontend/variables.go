@@ -0,0 +1,202 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"go/constant"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/like"
+)
+
+//sysVar is a system variable which has a global value and a value for each session
+type sysVar struct {
+	name string
+	//value converts the expression assigned to the variable into its value
+	value func(string, tree.Expr) (string, error)
+	get   func(*config.SystemVariables) string
+	set   func(*config.SystemVariables, string) error
+}
+
+//intVar returns the system variable whose value is an integer
+func intVar(name string, get func(*config.SystemVariables) int64, set func(*config.SystemVariables, int64) error) sysVar {
+	return sysVar{
+		name:  name,
+		value: sysVarValue,
+		get: func(sv *config.SystemVariables) string {
+			return strconv.FormatInt(get(sv), 10)
+		},
+		set: func(sv *config.SystemVariables, value string) error {
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return err
+			}
+			return set(sv, n)
+		},
+	}
+}
+
+//sysVars are the system variables which can be changed by SET
+var sysVars = []sysVar{
+	//the max execution time of a query in milliseconds, 0 means no limit
+	intVar("max_execution_time", (*config.SystemVariables).GetMaxExecutionTime, (*config.SystemVariables).SetMaxExecutionTime),
+	//the max memory of a query in bytes, 0 means the memory is limited by processLimitationSize
+	intVar("max_query_memory", (*config.SystemVariables).GetMaxQueryMemory, (*config.SystemVariables).SetMaxQueryMemory),
+	{
+		//the time zone of TIMESTAMP values, SYSTEM or empty means the time zone of the server
+		name:  "time_zone",
+		value: sysVarString,
+		get: func(sv *config.SystemVariables) string {
+			if tz := sv.GetTimeZone(); len(tz) > 0 {
+				return tz
+			}
+			return "SYSTEM"
+		},
+		set: func(sv *config.SystemVariables, value string) error {
+			if _, err := types.ParseTimeZone(value); err != nil {
+				return err
+			}
+			return sv.SetTimeZone(value)
+		},
+	},
+}
+
+//findSysVar returns the system variable with the name, it returns nil if there is no such variable
+func findSysVar(name string) *sysVar {
+	name = strings.ToLower(name)
+	for i := range sysVars {
+		if sysVars[i].name == name {
+			return &sysVars[i]
+		}
+	}
+	return nil
+}
+
+//newSessionVars returns the session variables with their global values
+func newSessionVars(pu *config.ParameterUnit) *config.SystemVariables {
+	sv := &config.SystemVariables{}
+	if pu == nil || pu.SV == nil {
+		return sv
+	}
+	for _, v := range sysVars {
+		_ = v.set(sv, v.get(pu.SV))
+	}
+	return sv
+}
+
+//getSessionVars returns the session variables, they are created with the global values if the session has none
+func (ses *Session) getSessionVars() *config.SystemVariables {
+	if ses.sessionVars == nil {
+		ses.sessionVars = newSessionVars(ses.Pu)
+	}
+	return ses.sessionVars
+}
+
+//sysVarValue returns the integer assigned to the system variable
+func sysVarValue(name string, e tree.Expr) (string, error) {
+	switch v := e.(type) {
+	case *tree.NumVal:
+		if v.Value.Kind() != constant.Int {
+			return "", NewMysqlError(ER_WRONG_TYPE_FOR_VAR, name)
+		}
+		n, ok := constant.Int64Val(v.Value)
+		if !ok {
+			return "", NewMysqlError(ER_WRONG_VALUE_FOR_VAR, name, v.String())
+		}
+		return strconv.FormatInt(n, 10), nil
+	case *tree.UnaryExpr:
+		return "", NewMysqlError(ER_WRONG_VALUE_FOR_VAR, name, tree.String(v, 0))
+	}
+	return "", NewMysqlError(ER_WRONG_TYPE_FOR_VAR, name)
+}
+
+//sysVarString returns the string assigned to the system variable
+func sysVarString(name string, e tree.Expr) (string, error) {
+	if v, ok := e.(*tree.NumVal); ok && v.Value.Kind() == constant.String {
+		return constant.StringVal(v.Value), nil
+	}
+	return "", NewMysqlError(ER_WRONG_TYPE_FOR_VAR, name)
+}
+
+//setVar assigns the value to the system variable of the session or the global one.
+//the variables unknown to the server are ignored for the compatibility with the clients.
+func (mce *MysqlCmdExecutor) setVar(assign *tree.VarAssignmentExpr) error {
+	ses := mce.GetSession()
+	v := findSysVar(assign.Name)
+	if v == nil || ses.Pu == nil || ses.Pu.SV == nil {
+		return nil
+	}
+
+	sv := ses.getSessionVars()
+	if assign.Global {
+		//the global variables can only be changed by the user with the SUPER privilege
+		super, err := mce.hasSuperPrivilege()
+		if err != nil {
+			return err
+		}
+		if !super {
+			return NewMysqlError(ER_SPECIFIC_ACCESS_DENIED_ERROR, "SUPER")
+		}
+		sv = ses.Pu.SV
+	}
+
+	var value string
+	if _, ok := assign.Value.(*tree.DefaultVal); ok {
+		//the session value is reset to the global one, and the global one to the initial one
+		if assign.Global {
+			var initial config.SystemVariables
+			if err := initial.LoadInitialValues(); err != nil {
+				return err
+			}
+			value = v.get(&initial)
+		} else {
+			value = v.get(ses.Pu.SV)
+		}
+	} else {
+		var err error
+		if value, err = v.value(v.name, assign.Value); err != nil {
+			return err
+		}
+	}
+	if err := v.set(sv, value); err != nil {
+		return NewMysqlError(ER_WRONG_VALUE_FOR_VAR, v.name, value)
+	}
+	return nil
+}
+
+//showVars returns the names and the values of the system variables matching the pattern
+func (mce *MysqlCmdExecutor) showVars(global bool, pattern []byte) [][]interface{} {
+	ses := mce.GetSession()
+	sv := ses.getSessionVars()
+	if global && ses.Pu != nil && ses.Pu.SV != nil {
+		sv = ses.Pu.SV
+	}
+
+	var rows [][]interface{}
+	tempSlice := make([]int64, 1)
+	for _, v := range sysVars {
+		if pattern != nil {
+			if k, _ := like.BtConstAndConst([]byte(v.name), pattern, tempSlice); k == nil {
+				continue
+			}
+		}
+		rows = append(rows, []interface{}{v.name, v.get(sv)})
+	}
+	return rows
+}
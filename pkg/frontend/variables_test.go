@@ -0,0 +1,157 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+)
+
+//slowComputation sends every batch of the result slowly
+type slowComputation struct {
+	*ComputationWrapperImpl
+	delay time.Duration
+}
+
+func (sc *slowComputation) Compile(u interface{}, fill func(interface{}, *batch.Batch) error) error {
+	return sc.ComputationWrapperImpl.Compile(u, func(u interface{}, bat *batch.Batch) error {
+		time.Sleep(sc.delay)
+		return fill(u, bat)
+	})
+}
+
+func TestMysqlCmdExecutor_Variables(t *testing.T) {
+	var sv config.SystemVariables
+	require.NoError(t, sv.LoadInitialValues())
+	require.NoError(t, config.LoadvarsConfigFromFile("test/system_vars_config.toml", &sv))
+	addr := startTestServer(t, &sv)
+
+	//the query on R is slow, and the query on S aggregates R without delay
+	compile.InitAddress("127.0.0.1")
+	getComputationWrapper := GetComputationWrapper
+	stubs := gostub.Stub(&GetComputationWrapper, func(db, sql string, params []tree.Expr, user string, privs *privilege.Privileges, eng engine.Engine, proc *process.Process) ([]ComputationWrapper, error) {
+		switch sql {
+		case "select * from R":
+			execs, err := compile.New(db, sql, user, memEngine.NewTestEngine(), proc).Build()
+			if err != nil {
+				return nil, err
+			}
+			return []ComputationWrapper{&slowComputation{
+				ComputationWrapperImpl: NewComputationWrapperImpl(execs[0]),
+				delay:                  200 * time.Millisecond,
+			}}, nil
+		case "select * from S":
+			execs, err := compile.New(db, "select uid, sum(price) from R group by uid order by uid", user, memEngine.NewTestEngine(), proc).Build()
+			if err != nil {
+				return nil, err
+			}
+			return []ComputationWrapper{NewComputationWrapperImpl(execs[0])}, nil
+		}
+		return getComputationWrapper(db, sql, params, user, privs, eng, proc)
+	})
+	defer stubs.Reset()
+
+	open := func(user, password, db string) *sql.Conn {
+		pool, err := sql.Open("mysql", fmt.Sprintf("%s:%s@tcp(%s)/%s?timeout=10s", user, password, addr, db))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = pool.Close() })
+		conn, err := pool.Conn(context.Background())
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+		return conn
+	}
+	errorCode := func(err error) uint16 {
+		require.Error(t, err)
+		merr, ok := err.(*mysql.MySQLError)
+		require.True(t, ok, "%v", err)
+		return merr.Number
+	}
+	exec := func(conn *sql.Conn, query string) error {
+		_, err := conn.ExecContext(context.Background(), query)
+		return err
+	}
+	query := func(conn *sql.Conn, query string) error {
+		rows, err := conn.QueryContext(context.Background(), query)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+		}
+		return rows.Err()
+	}
+	showVariables := func(conn *sql.Conn, query string) map[string]string {
+		rows, err := conn.QueryContext(context.Background(), query)
+		require.NoError(t, err)
+		defer rows.Close()
+		vars := make(map[string]string)
+		for rows.Next() {
+			var name, value string
+			require.NoError(t, rows.Scan(&name, &value))
+			vars[name] = value
+		}
+		require.NoError(t, rows.Err())
+		return vars
+	}
+
+	dump := open("dump", "111", "test")
+	require.NoError(t, exec(dump, "create user u1"))
+	u1 := open("u1", "", "")
+
+	//the session variables and the global ones
+	require.NoError(t, exec(dump, "set max_execution_time = 100"))
+	require.Equal(t, map[string]string{"max_execution_time": "100"}, showVariables(dump, "show variables like 'max_exec%'"))
+	require.Equal(t, map[string]string{"max_execution_time": "0", "max_query_memory": "0", "time_zone": "SYSTEM"}, showVariables(dump, "show global variables"))
+	require.Equal(t, map[string]string{"max_execution_time": "0", "max_query_memory": "0", "time_zone": "SYSTEM"}, showVariables(u1, "show variables"))
+	require.Equal(t, ER_SPECIFIC_ACCESS_DENIED_ERROR, errorCode(exec(u1, "set global max_execution_time = 100")))
+	require.Equal(t, ER_WRONG_VALUE_FOR_VAR, errorCode(exec(dump, "set max_query_memory = -1")))
+	require.Equal(t, ER_WRONG_TYPE_FOR_VAR, errorCode(exec(dump, "set max_query_memory = 'a'")))
+	require.NoError(t, exec(dump, "set unknown_variable = 1"))
+
+	//the time zone of the session
+	require.NoError(t, exec(dump, "set time_zone = '+08:00'"))
+	require.Equal(t, map[string]string{"time_zone": "+08:00"}, showVariables(dump, "show variables like 'time_zone'"))
+	require.Equal(t, ER_WRONG_VALUE_FOR_VAR, errorCode(exec(dump, "set time_zone = 'Mars/Olympus'")))
+	require.Equal(t, ER_WRONG_TYPE_FOR_VAR, errorCode(exec(dump, "set time_zone = 8")))
+	require.NoError(t, exec(dump, "set time_zone = default"))
+	require.Equal(t, map[string]string{"time_zone": "SYSTEM"}, showVariables(dump, "show variables like 'time_zone'"))
+
+	//the query runs out of its execution time
+	require.Equal(t, ER_QUERY_TIMEOUT, errorCode(query(dump, "select * from R")))
+	require.NoError(t, exec(dump, "set max_execution_time = default"))
+	require.NoError(t, query(dump, "select * from R"))
+
+	//the query runs out of its memory
+	require.NoError(t, query(dump, "select * from S"))
+	require.NoError(t, exec(dump, "set max_query_memory = 1"))
+	require.Equal(t, ER_CAPACITY_EXCEEDED, errorCode(query(dump, "select * from S")))
+	require.NoError(t, exec(dump, "set max_query_memory = default"))
+	require.NoError(t, query(dump, "select * from S"))
+}
@@ -169,6 +169,54 @@ func Partition(sels []int64, diffs []bool, partitions []int64, vec *vector.Vecto
 			diffs[i] = diffs[i] || (v != w)
 			v = w
 		}
+	case types.T_time:
+		var n bool
+		var v types.Time
+
+		vs := vec.Col.([]types.Time)
+		if nulls.Any(vec.Nsp) {
+			for i, sel := range sels {
+				w := vs[sel]
+				isNull := nulls.Contains(vec.Nsp, uint64(sel))
+				if n != isNull {
+					diffs[i] = true
+				} else {
+					diffs[i] = diffs[i] || (v != vs[sel])
+				}
+				v = w
+				n = isNull
+			}
+			break
+		}
+		for i, sel := range sels {
+			w := vs[sel]
+			diffs[i] = diffs[i] || (v != w)
+			v = w
+		}
+	case types.T_timestamp:
+		var n bool
+		var v types.Timestamp
+
+		vs := vec.Col.([]types.Timestamp)
+		if nulls.Any(vec.Nsp) {
+			for i, sel := range sels {
+				w := vs[sel]
+				isNull := nulls.Contains(vec.Nsp, uint64(sel))
+				if n != isNull {
+					diffs[i] = true
+				} else {
+					diffs[i] = diffs[i] || (v != vs[sel])
+				}
+				v = w
+				n = isNull
+			}
+			break
+		}
+		for i, sel := range sels {
+			w := vs[sel]
+			diffs[i] = diffs[i] || (v != w)
+			v = w
+		}
 	case types.T_uint8:
 		var n bool
 		var v uint8
@@ -313,6 +361,54 @@ func Partition(sels []int64, diffs []bool, partitions []int64, vec *vector.Vecto
 			diffs[i] = diffs[i] || (v != w)
 			v = w
 		}
+	case types.T_decimal64:
+		var n bool
+		var v types.Decimal64
+
+		vs := vec.Col.([]types.Decimal64)
+		if nulls.Any(vec.Nsp) {
+			for i, sel := range sels {
+				w := vs[sel]
+				isNull := nulls.Contains(vec.Nsp, uint64(sel))
+				if n != isNull {
+					diffs[i] = true
+				} else {
+					diffs[i] = diffs[i] || (v != vs[sel])
+				}
+				v = w
+				n = isNull
+			}
+			break
+		}
+		for i, sel := range sels {
+			w := vs[sel]
+			diffs[i] = diffs[i] || (v != w)
+			v = w
+		}
+	case types.T_decimal128:
+		var n bool
+		var v types.Decimal128
+
+		vs := vec.Col.([]types.Decimal128)
+		if nulls.Any(vec.Nsp) {
+			for i, sel := range sels {
+				w := vs[sel]
+				isNull := nulls.Contains(vec.Nsp, uint64(sel))
+				if n != isNull {
+					diffs[i] = true
+				} else {
+					diffs[i] = diffs[i] || (v != vs[sel])
+				}
+				v = w
+				n = isNull
+			}
+			break
+		}
+		for i, sel := range sels {
+			w := vs[sel]
+			diffs[i] = diffs[i] || (v != w)
+			v = w
+		}
 	case types.T_char, types.T_varchar:
 		var n bool
 		var v []byte
@@ -0,0 +1,74 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decimal128s
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"testing"
+)
+
+const (
+	Num   = 50
+	Limit = 100
+)
+
+func generate() ([]types.Decimal128, []int64) {
+	os := make([]int64, Num)
+	xs := make([]types.Decimal128, Num)
+	{
+		for i := 0; i < Num; i++ {
+			os[i] = int64(i)
+			// the high words are often equal, so the low words are compared
+			// as unsigned, some of them are negative as int64
+			xs[i] = types.Decimal128{Lo: int64(rand.Uint64()), Hi: rand.Int63()%3 - 1}
+		}
+	}
+	return xs, os
+}
+
+func TestSort(t *testing.T) {
+	vs, os := generate()
+	Sort(vs, os)
+	for i := 1; i < len(os); i++ {
+		require.True(t, vs[os[i]].Compare(vs[os[i-1]]) <= 0)
+	}
+}
+
+func TestHeapSort(t *testing.T) {
+	vs, os := generate()
+	heapSort(vs, os, 0, len(vs))
+	for i := 1; i < len(os); i++ {
+		require.True(t, vs[os[i]].Compare(vs[os[i-1]]) <= 0)
+	}
+}
+
+func TestMedianOfThree(t *testing.T) {
+	vs, os := generate()
+	medianOfThree(vs, os, 0, 1, 2)
+	assert.True(t, (vs[os[0]].Compare(vs[os[1]]) >= 0 && vs[os[0]].Compare(vs[os[2]]) <= 0) || (vs[os[0]].Compare(vs[os[1]]) <= 0 && vs[os[0]].Compare(vs[os[2]]) >= 0))
+	medianOfThree(vs, os, 5, 6, 7)
+	assert.True(t, (vs[os[5]].Compare(vs[os[6]]) >= 0 && vs[os[5]].Compare(vs[os[7]]) <= 0) || (vs[os[5]].Compare(vs[os[6]]) <= 0 && vs[os[5]].Compare(vs[os[7]]) >= 0))
+}
+
+func TestSwapRange(t *testing.T) {
+	vs, os := generate()
+	osOriginal := make([]int64, len(os))
+	copy(osOriginal, os)
+	swapRange(vs, os, 0, 10, 10)
+	require.Equal(t, osOriginal[:10], os[10:20])
+}
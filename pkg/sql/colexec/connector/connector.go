@@ -36,6 +36,9 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	bat := proc.Reg.InputBatch
 	if bat == nil {
 		select {
+		case <-proc.Ctx.Done():
+			process.FreeRegisters(proc)
+			return true, process.Interrupted(proc)
 		case <-reg.Ctx.Done():
 			process.FreeRegisters(proc)
 			return true, nil
@@ -64,13 +67,19 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 	size := mheap.Size(proc.Mp)
 	select {
+	case <-proc.Ctx.Done():
+		batch.Clean(bat, proc.Mp)
+		process.FreeRegisters(proc)
+		return true, process.Interrupted(proc)
 	case <-reg.Ctx.Done():
 		batch.Clean(bat, proc.Mp)
 		process.FreeRegisters(proc)
 		return true, nil
 	case reg.Ch <- bat:
-		n.Mmu.Alloc(size)
+		// the memory is freed before it is allocated by the receiver,
+		// so that it is not counted twice by the host they share
 		proc.Mp.Gm.Free(size)
+		n.Mmu.Alloc(size)
 		return false, nil
 	}
 }
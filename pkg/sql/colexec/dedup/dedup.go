@@ -63,8 +63,10 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				size += 2 + nullable
 			case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
 				size += 4 + nullable
-			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime:
+			case types.T_int64, types.T_uint64, types.T_float64, types.T_decimal64, types.T_datetime, types.T_time, types.T_timestamp:
 				size += 8 + nullable
+			case types.T_decimal128:
+				size += 16 + nullable
 			case types.T_char, types.T_varchar:
 				if width := vec.Typ.Width; width > 0 {
 					size += int(width) + nullable
@@ -353,6 +355,25 @@ func (ctr *Container) processH8(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -372,6 +393,44 @@ func (ctr *Container) processH8(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -643,6 +702,44 @@ func (ctr *Container) processH24(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -662,6 +759,44 @@ func (ctr *Container) processH24(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -932,6 +1067,44 @@ func (ctr *Container) processH32(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -951,6 +1124,44 @@ func (ctr *Container) processH32(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -1221,6 +1432,44 @@ func (ctr *Container) processH40(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1240,6 +1489,44 @@ func (ctr *Container) processH40(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -1486,6 +1773,40 @@ func (ctr *Container) processHStr(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						keys[k] = append(keys[k], data[(i+k)*8:(i+k+1)*8]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							keys[k] = append(keys[k], byte(1))
+						} else {
+							keys[k] = append(keys[k], byte(0))
+							keys[k] = append(keys[k], data[(i+k)*8:(i+k+1)*8]...)
+						}
+					}
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						keys[k] = append(keys[k], data[(i+k)*16:(i+k+1)*16]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							keys[k] = append(keys[k], byte(1))
+						} else {
+							keys[k] = append(keys[k], byte(0))
+							keys[k] = append(keys[k], data[(i+k)*16:(i+k+1)*16]...)
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
@@ -1503,6 +1824,40 @@ func (ctr *Container) processHStr(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						keys[k] = append(keys[k], data[(i+k)*8:(i+k+1)*8]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							keys[k] = append(keys[k], byte(1))
+						} else {
+							keys[k] = append(keys[k], byte(0))
+							keys[k] = append(keys[k], data[(i+k)*8:(i+k+1)*8]...)
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						keys[k] = append(keys[k], data[(i+k)*8:(i+k+1)*8]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							keys[k] = append(keys[k], byte(1))
+						} else {
+							keys[k] = append(keys[k], byte(0))
+							keys[k] = append(keys[k], data[(i+k)*8:(i+k+1)*8]...)
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
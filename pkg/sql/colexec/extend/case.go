@@ -0,0 +1,178 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extend
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func (_ *CaseExtend) IsLogical() bool {
+	return false
+}
+
+func (_ *CaseExtend) IsConstant() bool {
+	return false
+}
+
+func (e *CaseExtend) Attributes() []string {
+	var attrs []string
+	for i, cond := range e.Conds {
+		attrs = append(attrs, cond.Attributes()...)
+		if e.Vals[i] != nil {
+			attrs = append(attrs, e.Vals[i].Attributes()...)
+		}
+	}
+	if e.Else != nil {
+		attrs = append(attrs, e.Else.Attributes()...)
+	}
+	return attrs
+}
+
+func (e *CaseExtend) ReturnType() types.T {
+	return e.Type
+}
+
+func (e *CaseExtend) Eval(bat *batch.Batch, proc *process.Process) (*vector.Vector, types.T, error) {
+	n := batch.Length(bat)
+	es := append(append([]Extend{}, e.Vals...), e.Else)
+	flags := make([][]uint8, len(es))
+	cnts := make([]int, len(es))
+	rest, cnt := make([]uint8, n), n
+	for i := range rest {
+		rest[i] = 1
+	}
+	// the rows taking a branch are removed from the rest of rows, a condition is
+	// evaluated on the rest only
+	for i, cond := range e.Conds {
+		if cnt == 0 {
+			break
+		}
+		flags[i] = make([]uint8, n)
+		if isConstant(cond) {
+			vec, _, err := cond.Eval(bat, proc)
+			if err != nil {
+				return nil, 0, err
+			}
+			ok := isTrue(vec)
+			if owned(cond, vec) {
+				process.Put(proc, vec)
+			}
+			if ok {
+				flags[i], rest = rest, flags[i]
+				cnts[i], cnt = cnt, 0
+			}
+			continue
+		}
+		rows := make([]int64, 0, cnt)
+		for r, f := range rest {
+			if f > 0 {
+				rows = append(rows, int64(r))
+			}
+		}
+		vec, sub, err := evalRows(cond, bat, rest, cnt, proc)
+		if err != nil {
+			return nil, 0, err
+		}
+		if vec.Typ.Oid != types.T_sel {
+			if owned(cond, vec) {
+				process.Put(proc, vec)
+			}
+			cleanRows(sub, proc)
+			return nil, 0, fmt.Errorf("'%s' is not a condition", cond)
+		}
+		for _, sel := range vec.Col.([]int64) {
+			r := rows[sel]
+			flags[i][r], rest[r] = 1, 0
+		}
+		cnts[i] = len(vec.Col.([]int64))
+		cnt -= cnts[i]
+		process.Put(proc, vec)
+		cleanRows(sub, proc)
+	}
+	flags[len(es)-1], cnts[len(es)-1] = rest, cnt
+
+	b := newBranches(es, n)
+	defer b.clean(proc)
+	for i, val := range es {
+		if val == nil || cnts[i] == 0 {
+			continue
+		}
+		if _, err := b.eval(i, bat, flags[i], cnts[i], proc); err != nil {
+			return nil, 0, err
+		}
+		c := isConstant(val)
+		for r, j := 0, int64(0); r < n; r++ {
+			if flags[i][r] == 0 {
+				continue
+			}
+			if c {
+				b.take(i, r, 0)
+			} else {
+				b.take(i, r, j)
+			}
+			j++
+		}
+	}
+	vec, err := b.result(e.Type, proc)
+	if err != nil {
+		return nil, 0, err
+	}
+	return vec, e.Type, nil
+}
+
+func (a *CaseExtend) Eq(e Extend) bool {
+	b, ok := e.(*CaseExtend)
+	if !ok || a.Type != b.Type || len(a.Conds) != len(b.Conds) {
+		return false
+	}
+	for i, cond := range a.Conds {
+		if !cond.Eq(b.Conds[i]) || !eqExtend(a.Vals[i], b.Vals[i]) {
+			return false
+		}
+	}
+	return eqExtend(a.Else, b.Else)
+}
+
+func (e *CaseExtend) String() string {
+	r := "case"
+	for i, cond := range e.Conds {
+		r += fmt.Sprintf(" when %s then %s", cond, stringExtend(e.Vals[i]))
+	}
+	if e.Else != nil {
+		r += fmt.Sprintf(" else %s", e.Else)
+	}
+	return r + " end"
+}
+
+// eqExtend is Eq of a and b which may be nil
+func eqExtend(a, b Extend) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Eq(b)
+}
+
+// stringExtend is String of e which is null if it is nil
+func stringExtend(e Extend) string {
+	if e == nil {
+		return "null"
+	}
+	return e.String()
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extend
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func (_ *CoalesceExtend) IsLogical() bool {
+	return false
+}
+
+func (_ *CoalesceExtend) IsConstant() bool {
+	return false
+}
+
+func (e *CoalesceExtend) Attributes() []string {
+	var attrs []string
+	for _, arg := range e.Args {
+		attrs = append(attrs, arg.Attributes()...)
+	}
+	return attrs
+}
+
+func (e *CoalesceExtend) ReturnType() types.T {
+	return e.Type
+}
+
+func (e *CoalesceExtend) Eval(bat *batch.Batch, proc *process.Process) (*vector.Vector, types.T, error) {
+	n := batch.Length(bat)
+	rest, cnt := make([]uint8, n), n
+	for i := range rest {
+		rest[i] = 1
+	}
+	b := newBranches(e.Args, n)
+	defer b.clean(proc)
+	// an argument is evaluated on the rows which are null for the ones before it
+	for i, arg := range e.Args {
+		if cnt == 0 {
+			break
+		}
+		vec, err := b.eval(i, bat, rest, cnt, proc)
+		if err != nil {
+			return nil, 0, err
+		}
+		c := isConstant(arg)
+		for r, j := 0, int64(0); r < n; r++ {
+			if rest[r] == 0 {
+				continue
+			}
+			p := j
+			if c {
+				p = 0
+			}
+			if !nulls.Contains(vec.Nsp, uint64(p)) {
+				b.take(i, r, p)
+				rest[r] = 0
+				cnt--
+			}
+			j++
+		}
+	}
+	vec, err := b.result(e.Type, proc)
+	if err != nil {
+		return nil, 0, err
+	}
+	return vec, e.Type, nil
+}
+
+func (a *CoalesceExtend) Eq(e Extend) bool {
+	b, ok := e.(*CoalesceExtend)
+	if !ok || a.Type != b.Type || len(a.Args) != len(b.Args) {
+		return false
+	}
+	for i, arg := range a.Args {
+		if !arg.Eq(b.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *CoalesceExtend) String() string {
+	r := "coalesce("
+	for i, arg := range e.Args {
+		if i > 0 {
+			r += ", "
+		}
+		r += arg.String()
+	}
+	return r + ")"
+}
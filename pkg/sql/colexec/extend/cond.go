@@ -0,0 +1,486 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extend
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/choose"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// CommonType returns the type that the values of ts are converted to when they
+// are the results of one expression, T_any is the type of null and is ignored.
+func CommonType(ts []types.T) types.T {
+	var rt types.T = types.T_any
+	for _, t := range ts {
+		switch {
+		case t == types.T_any || t == rt:
+		case rt == types.T_any:
+			rt = t
+		case isString(rt) || isString(t):
+			rt = types.T_varchar
+		case isDatetime(rt) && isDatetime(t):
+			rt = types.T_datetime
+		case isNumeric(rt) && isNumeric(t):
+			rt = numericType(rt, t)
+		default:
+			rt = types.T_varchar
+		}
+	}
+	return rt
+}
+
+func isString(t types.T) bool {
+	return t == types.T_char || t == types.T_varchar
+}
+
+func isDatetime(t types.T) bool {
+	return t == types.T_date || t == types.T_datetime || t == types.T_timestamp
+}
+
+func isDecimal(t types.T) bool {
+	return t == types.T_decimal64 || t == types.T_decimal128
+}
+
+func isNumeric(t types.T) bool {
+	switch t {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128:
+		return true
+	}
+	return false
+}
+
+func isUnsigned(t types.T) bool {
+	return t == types.T_uint8 || t == types.T_uint16 || t == types.T_uint32 || t == types.T_uint64
+}
+
+func numericType(a, b types.T) types.T {
+	switch {
+	case a == types.T_float32 || a == types.T_float64 || b == types.T_float32 || b == types.T_float64:
+		return types.T_float64
+	case isDecimal(a) || isDecimal(b):
+		return types.T_decimal128
+	case isUnsigned(a) && isUnsigned(b):
+		return types.T_uint64
+	}
+	return types.T_int64
+}
+
+// isConstant returns true if e is evaluated to be a vector of one row.
+func isConstant(e Extend) bool {
+	return e.IsConstant() || len(e.Attributes()) == 0
+}
+
+// resultType returns the type of the result whose oid is typ, a decimal
+// has the max scale of vecs.
+func resultType(typ types.T, vecs []*vector.Vector) types.Type {
+	rt := typ.ToType()
+	if !isDecimal(typ) {
+		return rt
+	}
+	rt.Width = types.MaxDecimal64Precision
+	if typ == types.T_decimal128 {
+		rt.Width = types.MaxDecimal128Precision
+	}
+	for _, vec := range vecs {
+		if vec != nil && isDecimal(vec.Typ.Oid) && vec.Typ.Precision > rt.Precision {
+			rt.Precision = vec.Typ.Precision
+		}
+	}
+	return rt
+}
+
+// conform casts vec to be of type typ if it is not.
+func conform(vec *vector.Vector, typ types.Type, c bool, proc *process.Process) (*vector.Vector, error) {
+	if vec.Typ.Oid == typ.Oid && (!isDecimal(typ.Oid) || vec.Typ.Precision == typ.Precision) {
+		return vec, nil
+	}
+	return overload.BinaryEval(overload.Typecast, vec.Typ.Oid, typ.Oid, c, false, vec, vector.New(typ), proc)
+}
+
+// owned returns true if vec is a result of e that should be freed by its consumer.
+func owned(e Extend, vec *vector.Vector) bool {
+	if v, ok := e.(*ValueExtend); ok && v.V == vec {
+		return false
+	}
+	return vec.Ref == 0
+}
+
+// evalRows evaluates e on the rows of bat whose flags are set, cnt is the number of
+// them. A batch of these rows is built unless all rows are set, so that an expression
+// is never evaluated on a row which does not take it, the batch is returned to be
+// cleaned after the result is used.
+func evalRows(e Extend, bat *batch.Batch, flags []uint8, cnt int, proc *process.Process) (*vector.Vector, *batch.Batch, error) {
+	attrs := e.Attributes()
+	if cnt == len(flags) || len(attrs) == 0 {
+		vec, _, err := e.Eval(bat, proc)
+		return vec, nil, err
+	}
+	refs := make(map[string]uint64)
+	sub := batch.New(true, nil)
+	for _, attr := range attrs {
+		if refs[attr]++; refs[attr] > 1 {
+			continue
+		}
+		v := batch.GetVector(bat, attr)
+		w := vector.New(v.Typ)
+		if err := vector.UnionBatch(w, v, 0, cnt, flags, proc.Mp); err != nil {
+			vector.Clean(w, proc.Mp)
+			batch.Clean(sub, proc.Mp)
+			return nil, nil, err
+		}
+		sub.Attrs = append(sub.Attrs, attr)
+		sub.Vecs = append(sub.Vecs, w)
+	}
+	// the references of a vector are the ones of e only
+	for i, attr := range sub.Attrs {
+		sub.Vecs[i].Ref = refs[attr]
+	}
+	sub.Zs = make([]int64, cnt)
+	for i := range sub.Zs {
+		sub.Zs[i] = 1
+	}
+	vec, _, err := e.Eval(sub, proc)
+	if err != nil {
+		cleanRows(sub, proc)
+		return nil, nil, err
+	}
+	return vec, sub, nil
+}
+
+// cleanRows frees the vectors of a batch built by evalRows, except the ones taken
+// over by the operators of the expression.
+func cleanRows(sub *batch.Batch, proc *process.Process) {
+	if sub == nil {
+		return
+	}
+	for _, vec := range sub.Vecs {
+		if vec.Ref > 0 {
+			vector.Clean(vec, proc.Mp)
+		}
+	}
+}
+
+// isTrue returns true if the first row of vec, the result of a constant
+// condition, is true.
+func isTrue(vec *vector.Vector) bool {
+	if vec.Typ.Oid == types.T_sel {
+		return len(vec.Col.([]int64)) > 0
+	}
+	if nulls.Contains(vec.Nsp, 0) {
+		return false
+	}
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return vs[0] != 0
+	case []int16:
+		return vs[0] != 0
+	case []int32:
+		return vs[0] != 0
+	case []int64:
+		return vs[0] != 0
+	case []uint8:
+		return vs[0] != 0
+	case []uint16:
+		return vs[0] != 0
+	case []uint32:
+		return vs[0] != 0
+	case []uint64:
+		return vs[0] != 0
+	case []float32:
+		return vs[0] != 0
+	case []float64:
+		return vs[0] != 0
+	case []types.Decimal64:
+		return vs[0] != 0
+	case []types.Decimal128:
+		return vs[0] != types.Decimal128{}
+	}
+	return false
+}
+
+// newSels returns a vector of the selected rows.
+func newSels(sels []int64, proc *process.Process) (*vector.Vector, error) {
+	vec, err := process.Get(proc, int64(len(sels))*8, overload.SelsType)
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeInt64Slice(vec.Data)[:len(sels)]
+	copy(rs, sels)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// chooseVector returns a vector of n rows of type typ, the ith row is the
+// ps[i]th row of vecs[ks[i]], or null if ks[i] is negative.
+func chooseVector(typ types.Type, vecs []*vector.Vector, ks []int32, ps []int64, proc *process.Process) (*vector.Vector, error) {
+	var vec *vector.Vector
+	var err error
+
+	n := len(ks)
+	if typ.Oid == types.T_char || typ.Oid == types.T_varchar || typ.Oid == types.T_json {
+		xs := make([]*types.Bytes, len(vecs))
+		size := 0
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.(*types.Bytes)
+			}
+		}
+		for i, k := range ks {
+			if k >= 0 {
+				size += int(xs[k].Lengths[ps[i]])
+			}
+		}
+		if vec, err = process.Get(proc, int64(size), typ); err != nil {
+			return nil, err
+		}
+		rs := &types.Bytes{
+			Data:    vec.Data[:0],
+			Offsets: make([]uint32, 0, n),
+			Lengths: make([]uint32, 0, n),
+		}
+		rs = choose.BytesChoose(xs, ks, ps, rs)
+		vec.Data = rs.Data
+		vector.SetCol(vec, rs)
+	} else {
+		if vec, err = process.Get(proc, int64(n)*int64(typ.Size), typ); err != nil {
+			return nil, err
+		}
+		if err = chooseFixed(vec, vecs, ks, ps, n); err != nil {
+			process.Put(proc, vec)
+			return nil, err
+		}
+	}
+	for i, k := range ks {
+		if k < 0 || nulls.Contains(vecs[k].Nsp, uint64(ps[i])) {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	return vec, nil
+}
+
+func chooseFixed(vec *vector.Vector, vecs []*vector.Vector, ks []int32, ps []int64, n int) error {
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		xs := make([][]int8, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]int8)
+			}
+		}
+		vector.SetCol(vec, choose.I8Choose(xs, ks, ps, encoding.DecodeInt8Slice(vec.Data)[:n]))
+	case types.T_int16:
+		xs := make([][]int16, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]int16)
+			}
+		}
+		vector.SetCol(vec, choose.I16Choose(xs, ks, ps, encoding.DecodeInt16Slice(vec.Data)[:n]))
+	case types.T_int32:
+		xs := make([][]int32, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]int32)
+			}
+		}
+		vector.SetCol(vec, choose.I32Choose(xs, ks, ps, encoding.DecodeInt32Slice(vec.Data)[:n]))
+	case types.T_int64:
+		xs := make([][]int64, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]int64)
+			}
+		}
+		vector.SetCol(vec, choose.I64Choose(xs, ks, ps, encoding.DecodeInt64Slice(vec.Data)[:n]))
+	case types.T_uint8:
+		xs := make([][]uint8, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]uint8)
+			}
+		}
+		vector.SetCol(vec, choose.Ui8Choose(xs, ks, ps, encoding.DecodeUint8Slice(vec.Data)[:n]))
+	case types.T_uint16:
+		xs := make([][]uint16, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]uint16)
+			}
+		}
+		vector.SetCol(vec, choose.Ui16Choose(xs, ks, ps, encoding.DecodeUint16Slice(vec.Data)[:n]))
+	case types.T_uint32:
+		xs := make([][]uint32, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]uint32)
+			}
+		}
+		vector.SetCol(vec, choose.Ui32Choose(xs, ks, ps, encoding.DecodeUint32Slice(vec.Data)[:n]))
+	case types.T_uint64:
+		xs := make([][]uint64, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]uint64)
+			}
+		}
+		vector.SetCol(vec, choose.Ui64Choose(xs, ks, ps, encoding.DecodeUint64Slice(vec.Data)[:n]))
+	case types.T_float32:
+		xs := make([][]float32, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]float32)
+			}
+		}
+		vector.SetCol(vec, choose.Float32Choose(xs, ks, ps, encoding.DecodeFloat32Slice(vec.Data)[:n]))
+	case types.T_float64:
+		xs := make([][]float64, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]float64)
+			}
+		}
+		vector.SetCol(vec, choose.Float64Choose(xs, ks, ps, encoding.DecodeFloat64Slice(vec.Data)[:n]))
+	case types.T_decimal64:
+		xs := make([][]types.Decimal64, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]types.Decimal64)
+			}
+		}
+		vector.SetCol(vec, choose.Decimal64Choose(xs, ks, ps, encoding.DecodeDecimal64Slice(vec.Data)[:n]))
+	case types.T_decimal128:
+		xs := make([][]types.Decimal128, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]types.Decimal128)
+			}
+		}
+		vector.SetCol(vec, choose.Decimal128Choose(xs, ks, ps, encoding.DecodeDecimal128Slice(vec.Data)[:n]))
+	case types.T_date:
+		xs := make([][]types.Date, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]types.Date)
+			}
+		}
+		vector.SetCol(vec, choose.DateChoose(xs, ks, ps, encoding.DecodeDateSlice(vec.Data)[:n]))
+	case types.T_time:
+		xs := make([][]types.Time, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]types.Time)
+			}
+		}
+		vector.SetCol(vec, choose.TimeChoose(xs, ks, ps, encoding.DecodeTimeSlice(vec.Data)[:n]))
+	case types.T_datetime:
+		xs := make([][]types.Datetime, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]types.Datetime)
+			}
+		}
+		vector.SetCol(vec, choose.DatetimeChoose(xs, ks, ps, encoding.DecodeDatetimeSlice(vec.Data)[:n]))
+	case types.T_timestamp:
+		xs := make([][]types.Timestamp, len(vecs))
+		for i, v := range vecs {
+			if v != nil {
+				xs[i] = v.Col.([]types.Timestamp)
+			}
+		}
+		vector.SetCol(vec, choose.TimestampChoose(xs, ks, ps, encoding.DecodeTimestampSlice(vec.Data)[:n]))
+	default:
+		return fmt.Errorf("conditional expression not yet implemented for %s", vec.Typ)
+	}
+	return nil
+}
+
+// branches are the evaluated branches of a conditional expression, the ith row of
+// the result is the ps[i]th row of vecs[ks[i]], or null if ks[i] is negative.
+type branches struct {
+	es   []Extend
+	vecs []*vector.Vector
+	subs []*batch.Batch
+	ks   []int32
+	ps   []int64
+}
+
+func newBranches(es []Extend, n int) *branches {
+	b := &branches{
+		es:   es,
+		vecs: make([]*vector.Vector, len(es)),
+		subs: make([]*batch.Batch, len(es)),
+		ks:   make([]int32, n),
+		ps:   make([]int64, n),
+	}
+	for i := range b.ks {
+		b.ks[i] = -1
+	}
+	return b
+}
+
+// eval evaluates the ith branch on the rows whose flags are set.
+func (b *branches) eval(i int, bat *batch.Batch, flags []uint8, cnt int, proc *process.Process) (*vector.Vector, error) {
+	vec, sub, err := evalRows(b.es[i], bat, flags, cnt, proc)
+	if err != nil {
+		return nil, err
+	}
+	b.vecs[i], b.subs[i] = vec, sub
+	return vec, nil
+}
+
+// take makes the rth row of the result be the pth row of the ith branch.
+func (b *branches) take(i, r int, p int64) {
+	b.ks[r] = int32(i)
+	b.ps[r] = p
+}
+
+// result returns the result of type typ, the branches are converted to it.
+func (b *branches) result(typ types.T, proc *process.Process) (*vector.Vector, error) {
+	rt := resultType(typ, b.vecs)
+	for i, vec := range b.vecs {
+		if vec == nil {
+			continue
+		}
+		v, err := conform(vec, rt, isConstant(b.es[i]), proc)
+		if err != nil {
+			if owned(b.es[i], vec) {
+				// the cast has taken over the vector
+				b.vecs[i] = nil
+			}
+			return nil, err
+		}
+		b.vecs[i] = v
+	}
+	return chooseVector(rt, b.vecs, b.ks, b.ps, proc)
+}
+
+func (b *branches) clean(proc *process.Process) {
+	for i, vec := range b.vecs {
+		if vec != nil && owned(b.es[i], vec) {
+			process.Put(proc, vec)
+		}
+		cleanRows(b.subs[i], proc)
+	}
+}
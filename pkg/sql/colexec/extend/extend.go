@@ -162,6 +162,10 @@ func AndExtends(e Extend, es []Extend) []Extend {
 		return es
 	case *ValueExtend:
 		return es
+	case *IsNullExtend:
+		return es
+	case *InExtend:
+		return append(es, v)
 	case *BinaryExtend:
 		switch v.Op {
 		case overload.EQ:
@@ -0,0 +1,169 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extend
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func (_ *InExtend) IsLogical() bool {
+	return true
+}
+
+func (_ *InExtend) IsConstant() bool {
+	return false
+}
+
+func (e *InExtend) Attributes() []string {
+	return e.E.Attributes()
+}
+
+func (_ *InExtend) ReturnType() types.T {
+	return types.T_sel
+}
+
+// InType returns the type which the values of an in list are compared as, a
+// list of strings is converted to the type of the left expression.
+func InType(t types.T, ts []types.T) types.T {
+	if !isString(t) {
+		strs := true
+		for _, typ := range ts {
+			if typ != types.T_any && !isString(typ) {
+				strs = false
+				break
+			}
+		}
+		if strs {
+			return t
+		}
+	}
+	return CommonType(append([]types.T{t}, ts...))
+}
+
+func (e *InExtend) Eval(bat *batch.Batch, proc *process.Process) (*vector.Vector, types.T, error) {
+	vec, _, err := e.E.Eval(bat, proc)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		if vec != nil && owned(e.E, vec) {
+			process.Put(proc, vec)
+		}
+	}()
+	vs := make([]*vector.Vector, len(e.Args))
+	ts := make([]types.T, len(e.Args))
+	for i, arg := range e.Args {
+		if vs[i], _, err = arg.Eval(bat, proc); err != nil {
+			return nil, 0, err
+		}
+		ts[i] = vs[i].Typ.Oid
+	}
+	rt := resultType(InType(vec.Typ.Oid, ts), append([]*vector.Vector{vec}, vs...))
+	v, err := conform(vec, rt, isConstant(e.E), proc)
+	if err != nil {
+		if owned(e.E, vec) {
+			// the cast has taken over the vector
+			vec = nil
+		}
+		return nil, 0, err
+	}
+	vec = v
+	keys := make(map[string]struct{})
+	for i, arg := range e.Args {
+		v, err := conform(vs[i], rt, true, proc)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !nulls.Contains(v.Nsp, 0) {
+			key, err := vector.AppendKey(nil, v, 0)
+			if err != nil {
+				return nil, 0, err
+			}
+			keys[string(key)] = struct{}{}
+		}
+		if owned(arg, v) {
+			process.Put(proc, v)
+		}
+	}
+	var key []byte
+
+	n := batch.Length(bat)
+	sels := make([]int64, 0, n)
+	for i := 0; i < n; i++ {
+		j := int64(i)
+		if isConstant(e.E) {
+			j = 0
+		}
+		// a null is never selected, neither is a row which is none of
+		// the list with a null, as it is null either way
+		if nulls.Contains(vec.Nsp, uint64(j)) {
+			continue
+		}
+		if key, err = vector.AppendKey(key[:0], vec, j); err != nil {
+			return nil, 0, err
+		}
+		_, ok := keys[string(key)]
+		if !ok && e.Null {
+			continue
+		}
+		if ok != e.Not {
+			sels = append(sels, int64(i))
+		}
+	}
+	rs, err := newSels(sels, proc)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rs, types.T_sel, nil
+}
+
+func (a *InExtend) Eq(e Extend) bool {
+	b, ok := e.(*InExtend)
+	if !ok || a.Not != b.Not || a.Null != b.Null || !a.E.Eq(b.E) || len(a.Args) != len(b.Args) {
+		return false
+	}
+	for i, arg := range a.Args {
+		if !arg.Eq(b.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *InExtend) String() string {
+	r := fmt.Sprintf("%s in (", e.E)
+	if e.Not {
+		r = fmt.Sprintf("%s not in (", e.E)
+	}
+	for i, arg := range e.Args {
+		if i > 0 {
+			r += ", "
+		}
+		r += arg.String()
+	}
+	if e.Null {
+		if len(e.Args) > 0 {
+			r += ", "
+		}
+		r += "null"
+	}
+	return r + ")"
+}
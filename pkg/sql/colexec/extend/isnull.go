@@ -0,0 +1,87 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extend
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func (_ *IsNullExtend) IsLogical() bool {
+	return true
+}
+
+func (_ *IsNullExtend) IsConstant() bool {
+	return false
+}
+
+func (e *IsNullExtend) Attributes() []string {
+	return e.E.Attributes()
+}
+
+func (_ *IsNullExtend) ReturnType() types.T {
+	return types.T_sel
+}
+
+func (e *IsNullExtend) Eval(bat *batch.Batch, proc *process.Process) (*vector.Vector, types.T, error) {
+	vec, _, err := e.E.Eval(bat, proc)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		if owned(e.E, vec) {
+			process.Put(proc, vec)
+		}
+	}()
+	n := batch.Length(bat)
+	sels := make([]int64, 0, n)
+	if isConstant(e.E) {
+		if nulls.Contains(vec.Nsp, 0) != e.Not {
+			for i := 0; i < n; i++ {
+				sels = append(sels, int64(i))
+			}
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			if nulls.Contains(vec.Nsp, uint64(i)) != e.Not {
+				sels = append(sels, int64(i))
+			}
+		}
+	}
+	rs, err := newSels(sels, proc)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rs, types.T_sel, nil
+}
+
+func (a *IsNullExtend) Eq(e Extend) bool {
+	if b, ok := e.(*IsNullExtend); ok {
+		return a.Not == b.Not && a.E.Eq(b.E)
+	}
+	return false
+}
+
+func (e *IsNullExtend) String() string {
+	if e.Not {
+		return fmt.Sprintf("%s is not null", e.E)
+	}
+	return fmt.Sprintf("%s is null", e.E)
+}
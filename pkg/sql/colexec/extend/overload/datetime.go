@@ -0,0 +1,386 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/eq"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/ge"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/gt"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/le"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/lt"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/ne"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/typecast"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+)
+
+var (
+	chars     = []types.T{types.T_char, types.T_varchar}
+	datetimes = []types.T{types.T_date, types.T_datetime, types.T_time, types.T_timestamp}
+)
+
+// initDatetime registers the casts between the date family and strings, and the
+// comparisons of time and timestamp.
+// A timestamp is converted from and to a datetime in the session time zone of
+// the process, so these casts are implemented directly instead of by the templates.
+func initDatetime() {
+	for _, d := range datetimes {
+		for _, c := range chars {
+			BinOps[Typecast] = append(BinOps[Typecast], &BinOp{
+				LeftType:   d,
+				RightType:  c,
+				ReturnType: c,
+				Fn:         castDatetimeToBytes,
+			})
+		}
+	}
+	for _, c := range chars {
+		BinOps[Typecast] = append(BinOps[Typecast], &BinOp{
+			LeftType:   c,
+			RightType:  types.T_time,
+			ReturnType: types.T_time,
+			Fn:         castToTime,
+		}, &BinOp{
+			LeftType:   c,
+			RightType:  types.T_timestamp,
+			ReturnType: types.T_timestamp,
+			Fn:         castToTimestamp,
+		})
+	}
+	for _, t := range []types.T{types.T_datetime, types.T_timestamp} {
+		BinOps[Typecast] = append(BinOps[Typecast], &BinOp{
+			LeftType:   t,
+			RightType:  types.T_date,
+			ReturnType: types.T_date,
+			Fn:         castToDate,
+		}, &BinOp{
+			LeftType:   t,
+			RightType:  types.T_time,
+			ReturnType: types.T_time,
+			Fn:         castToTime,
+		})
+	}
+	for _, t := range []types.T{types.T_date, types.T_timestamp} {
+		BinOps[Typecast] = append(BinOps[Typecast], &BinOp{
+			LeftType:   t,
+			RightType:  types.T_datetime,
+			ReturnType: types.T_datetime,
+			Fn:         castToDatetime,
+		})
+	}
+	for _, t := range []types.T{types.T_date, types.T_datetime} {
+		BinOps[Typecast] = append(BinOps[Typecast], &BinOp{
+			LeftType:   t,
+			RightType:  types.T_timestamp,
+			ReturnType: types.T_timestamp,
+			Fn:         castToTimestamp,
+		})
+	}
+	for _, t := range []types.T{types.T_time, types.T_timestamp} {
+		BinOps[Typecast] = append(BinOps[Typecast], &BinOp{
+			LeftType:   t,
+			RightType:  t,
+			ReturnType: t,
+			Fn:         castDatetimeToSelf,
+		})
+		for _, op := range []int{EQ, NE, GT, GE, LT, LE} {
+			BinOps[op] = append(BinOps[op], &BinOp{
+				LeftType:   t,
+				RightType:  t,
+				ReturnType: types.T_sel,
+				Fn:         datetimeCompare(op),
+			})
+		}
+	}
+}
+
+// initCastRulesForDatetime casts a string to the type of the other side if it meets
+// a time or a timestamp, and compares a date or a datetime with a timestamp as timestamps.
+func initCastRulesForDatetime() {
+	for _, op := range []int{EQ, NE, GT, GE, LT, LE} {
+		for _, t := range []types.T{types.T_time, types.T_timestamp} {
+			targetType := []types.Type{
+				{Oid: t, Size: 8},
+				{Oid: t, Size: 8},
+			}
+			for _, c := range chars {
+				OperatorCastRules[op] = append(OperatorCastRules[op], []castRule{
+					{NumArgs: 2, sourceTypes: []types.T{c, t}, targetTypes: targetType},
+					{NumArgs: 2, sourceTypes: []types.T{t, c}, targetTypes: targetType},
+				}...)
+			}
+		}
+		targetType := []types.Type{
+			{Oid: types.T_timestamp, Size: 8},
+			{Oid: types.T_timestamp, Size: 8},
+		}
+		for _, t := range []types.T{types.T_date, types.T_datetime} {
+			OperatorCastRules[op] = append(OperatorCastRules[op], []castRule{
+				{NumArgs: 2, sourceTypes: []types.T{t, types.T_timestamp}, targetTypes: targetType},
+				{NumArgs: 2, sourceTypes: []types.T{types.T_timestamp, t}, targetTypes: targetType},
+			}...)
+		}
+	}
+}
+
+// datetimeBytes returns the strings of v whose null rows are empty
+func datetimeBytes(v *vector.Vector) *types.Bytes {
+	vs := v.Col.(*types.Bytes)
+	if !nulls.Any(v.Nsp) {
+		return vs
+	}
+	xs := &types.Bytes{Data: vs.Data, Offsets: vs.Offsets, Lengths: make([]uint32, len(vs.Lengths))}
+	for i, n := range vs.Lengths {
+		if !v.Nsp.Np.Contains(uint64(i)) {
+			xs.Lengths[i] = n
+		}
+	}
+	return xs
+}
+
+// datetimeColumn returns the rows of a date, datetime or timestamp vector as datetimes,
+// a timestamp is converted in the time zone of the process.
+func datetimeColumn(v *vector.Vector, proc *process.Process) []types.Datetime {
+	switch vs := v.Col.(type) {
+	case []types.Date:
+		return typecast.DateToDatetime(vs, make([]types.Datetime, len(vs)))
+	case []types.Timestamp:
+		return typecast.TimestampToDatetime(vs, process.TimeZone(proc), make([]types.Datetime, len(vs)))
+	}
+	return v.Col.([]types.Datetime)
+}
+
+func castToDate(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			process.Put(proc, lv)
+		}
+	}()
+	xs := datetimeColumn(lv, proc)
+	vec, err := process.Get(proc, int64(rv.Typ.Size)*int64(len(xs)), rv.Typ)
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeDateSlice(vec.Data)
+	vector.SetCol(vec, typecast.DatetimeToDate(xs, rs[:len(xs)]))
+	nulls.Set(vec.Nsp, lv.Nsp)
+	return vec, nil
+}
+
+func castToDatetime(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			process.Put(proc, lv)
+		}
+	}()
+	xs := datetimeColumn(lv, proc)
+	vec, err := process.Get(proc, int64(rv.Typ.Size)*int64(len(xs)), rv.Typ)
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeDatetimeSlice(vec.Data)
+	rs = rs[:len(xs)]
+	copy(rs, xs)
+	vector.SetCol(vec, rs)
+	nulls.Set(vec.Nsp, lv.Nsp)
+	return vec, nil
+}
+
+func castToTime(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			process.Put(proc, lv)
+		}
+	}()
+	n := vector.Length(lv)
+	vec, err := process.Get(proc, int64(rv.Typ.Size)*int64(n), rv.Typ)
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeTimeSlice(vec.Data)
+	rs = rs[:n]
+	if lv.Typ.Oid == types.T_char || lv.Typ.Oid == types.T_varchar {
+		if _, err := typecast.BytesToTime(datetimeBytes(lv), rs); err != nil {
+			process.Put(proc, vec)
+			return nil, err
+		}
+	} else {
+		typecast.DatetimeToTime(datetimeColumn(lv, proc), rs)
+	}
+	vector.SetCol(vec, rs)
+	nulls.Set(vec.Nsp, lv.Nsp)
+	return vec, nil
+}
+
+func castToTimestamp(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			process.Put(proc, lv)
+		}
+	}()
+	loc := process.TimeZone(proc)
+	n := vector.Length(lv)
+	vec, err := process.Get(proc, int64(rv.Typ.Size)*int64(n), rv.Typ)
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeTimestampSlice(vec.Data)
+	rs = rs[:n]
+	if lv.Typ.Oid == types.T_char || lv.Typ.Oid == types.T_varchar {
+		if _, err := typecast.BytesToTimestamp(datetimeBytes(lv), loc, rs); err != nil {
+			process.Put(proc, vec)
+			return nil, err
+		}
+	} else {
+		typecast.DatetimeToTimestamp(datetimeColumn(lv, proc), loc, rs)
+	}
+	vector.SetCol(vec, rs)
+	nulls.Set(vec.Nsp, lv.Nsp)
+	return vec, nil
+}
+
+func castDatetimeToSelf(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	if lv.Ref == 0 {
+		return lv, nil
+	}
+	n := vector.Length(lv)
+	vec, err := process.Get(proc, int64(rv.Typ.Size)*int64(n), rv.Typ)
+	if err != nil {
+		return nil, err
+	}
+	switch vs := lv.Col.(type) {
+	case []types.Time:
+		rs := encoding.DecodeTimeSlice(vec.Data)
+		rs = rs[:n]
+		copy(rs, vs)
+		vector.SetCol(vec, rs)
+	case []types.Timestamp:
+		rs := encoding.DecodeTimestampSlice(vec.Data)
+		rs = rs[:n]
+		copy(rs, vs)
+		vector.SetCol(vec, rs)
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	return vec, nil
+}
+
+func castDatetimeToBytes(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			process.Put(proc, lv)
+		}
+	}()
+	n := vector.Length(lv)
+	col := &types.Bytes{
+		Data:    make([]byte, 0, n),
+		Offsets: make([]uint32, 0, n),
+		Lengths: make([]uint32, 0, n),
+	}
+	switch vs := lv.Col.(type) {
+	case []types.Date:
+		col = typecast.DateToBytes(vs, col)
+	case []types.Time:
+		col = typecast.TimeToBytes(vs, col)
+	default:
+		col = typecast.DatetimeToBytes(datetimeColumn(lv, proc), col)
+	}
+	if err := proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(rv.Typ)
+	vec.Data = col.Data
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, col)
+	return vec, nil
+}
+
+// datetimeInt64s returns the rows of a time or timestamp operand as int64s,
+// a constant is extended to n rows.
+func datetimeInt64s(v *vector.Vector, c bool, n int) []int64 {
+	var xs []int64
+	switch vs := v.Col.(type) {
+	case []types.Time:
+		xs = encoding.DecodeInt64Slice(encoding.EncodeTimeSlice(vs))
+	case []types.Timestamp:
+		xs = encoding.DecodeInt64Slice(encoding.EncodeTimestampSlice(vs))
+	}
+	if c && len(xs) == 1 && n != 1 {
+		ys := make([]int64, n)
+		for i := range ys {
+			ys[i] = xs[0]
+		}
+		return ys
+	}
+	return xs
+}
+
+func datetimeCompare(op int) func(*vector.Vector, *vector.Vector, *process.Process, bool, bool) (*vector.Vector, error) {
+	return func(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+		length := decimalOperandLength(lv, rv, lc)
+		xs := datetimeInt64s(lv, lc, length)
+		ys := datetimeInt64s(rv, rc, length)
+		vec, err := process.Get(proc, 8*int64(length), SelsType)
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeInt64Slice(vec.Data)
+		rs = rs[:length]
+		if nsp := decimalOperandNulls(lv, rv, lc, rc); nulls.Any(nsp) {
+			rs = datetimeCompareNullable(op, xs, ys, nsp.Np, rs)
+		} else {
+			rs = datetimeCompareNotNull(op, xs, ys, rs)
+		}
+		vector.SetCol(vec, rs)
+		putDecimalOperands(proc, lv, rv, lc, rc)
+		return vec, nil
+	}
+}
+
+func datetimeCompareNotNull(op int, xs, ys []int64, rs []int64) []int64 {
+	switch op {
+	case EQ:
+		return eq.Int64Eq(xs, ys, rs)
+	case NE:
+		return ne.Int64Ne(xs, ys, rs)
+	case GT:
+		return gt.Int64Gt(xs, ys, rs)
+	case GE:
+		return ge.Int64Ge(xs, ys, rs)
+	case LT:
+		return lt.Int64Lt(xs, ys, rs)
+	}
+	return le.Int64Le(xs, ys, rs)
+}
+
+func datetimeCompareNullable(op int, xs, ys []int64, np *roaring.Bitmap, rs []int64) []int64 {
+	switch op {
+	case EQ:
+		return eq.Int64EqNullable(xs, ys, np, rs)
+	case NE:
+		return ne.Int64NeNullable(xs, ys, np, rs)
+	case GT:
+		return gt.Int64GtNullable(xs, ys, np, rs)
+	case GE:
+		return ge.Int64GeNullable(xs, ys, np, rs)
+	case LT:
+		return lt.Int64LtNullable(xs, ys, np, rs)
+	}
+	return le.Int64LeNullable(xs, ys, np, rs)
+}
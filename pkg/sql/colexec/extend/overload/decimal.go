@@ -0,0 +1,612 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/div"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/eq"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/ge"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/gt"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/le"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/lt"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/mul"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/ne"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/neg"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/sub"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/typecast"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+)
+
+var (
+	decimals = []types.T{types.T_decimal64, types.T_decimal128}
+	integers = []types.T{
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	}
+)
+
+// initDecimal registers the operators of decimal64 and decimal128.
+// The scale of a decimal is kept in the Precision of its vector type rather than
+// in types.T, so all the operators are implemented directly instead of by cast rules,
+// an integer operand is treated as a decimal whose scale is 0.
+func initDecimal() {
+	var numerics []types.T
+	numerics = append(numerics, integers...)
+	numerics = append(numerics, types.T_float32, types.T_float64)
+
+	for _, d := range decimals {
+		for _, t := range numerics {
+			BinOps[Typecast] = append(BinOps[Typecast], &BinOp{
+				LeftType:   t,
+				RightType:  d,
+				ReturnType: d,
+				Fn:         castToDecimal,
+			}, &BinOp{
+				LeftType:   d,
+				RightType:  t,
+				ReturnType: t,
+				Fn:         castDecimalToNumeric,
+			})
+		}
+		for _, t := range []types.T{types.T_char, types.T_varchar} {
+			BinOps[Typecast] = append(BinOps[Typecast], &BinOp{
+				LeftType:   t,
+				RightType:  d,
+				ReturnType: d,
+				Fn:         castToDecimal,
+			}, &BinOp{
+				LeftType:   d,
+				RightType:  t,
+				ReturnType: t,
+				Fn:         castDecimalToBytes,
+			})
+		}
+		for _, r := range decimals {
+			BinOps[Typecast] = append(BinOps[Typecast], &BinOp{
+				LeftType:   d,
+				RightType:  r,
+				ReturnType: r,
+				Fn:         castToDecimal,
+			})
+		}
+		UnaryOps[UnaryMinus] = append(UnaryOps[UnaryMinus], &UnaryOp{
+			Typ:        d,
+			ReturnType: d,
+			Fn:         decimalNeg,
+		})
+	}
+
+	var pairs [][2]types.T
+	for _, l := range decimals {
+		for _, r := range decimals {
+			pairs = append(pairs, [2]types.T{l, r})
+		}
+		for _, r := range integers {
+			pairs = append(pairs, [2]types.T{l, r}, [2]types.T{r, l})
+		}
+	}
+	for _, p := range pairs {
+		for _, op := range []int{Plus, Minus, Mult, Div} {
+			BinOps[op] = append(BinOps[op], &BinOp{
+				LeftType:   p[0],
+				RightType:  p[1],
+				ReturnType: types.T_decimal128,
+				Fn:         decimalArith(op),
+			})
+		}
+		for _, op := range []int{EQ, NE, GT, GE, LT, LE} {
+			BinOps[op] = append(BinOps[op], &BinOp{
+				LeftType:   p[0],
+				RightType:  p[1],
+				ReturnType: types.T_sel,
+				Fn:         decimalCompare(op),
+			})
+		}
+	}
+}
+
+// initCastRulesForDecimal casts both sides to float64 if a decimal meets
+// a float or a string, the same as mysql does.
+func initCastRulesForDecimal() {
+	targetType := []types.Type{
+		{Oid: types.T_float64, Size: 8},
+		{Oid: types.T_float64, Size: 8},
+	}
+	floats := []types.T{types.T_float32, types.T_float64}
+	chars := []types.T{types.T_char, types.T_varchar}
+	for _, op := range []int{Plus, Minus, Mult, Div, IntegerDiv, Mod, EQ, NE, GT, GE, LT, LE} {
+		others := floats
+		if OperatorType(op) == Binary && IsLogical(op) == MustLogical {
+			others = append(others, chars...)
+		}
+		for _, l := range decimals {
+			for _, r := range others {
+				OperatorCastRules[op] = append(OperatorCastRules[op], []castRule{
+					{NumArgs: 2, sourceTypes: []types.T{l, r}, targetTypes: targetType},
+					{NumArgs: 2, sourceTypes: []types.T{r, l}, targetTypes: targetType},
+				}...)
+			}
+		}
+	}
+	// integerDiv and mod between decimals are done in float64 too
+	for _, op := range []int{IntegerDiv, Mod} {
+		for _, l := range decimals {
+			for _, r := range decimals {
+				OperatorCastRules[op] = append(OperatorCastRules[op], castRule{
+					NumArgs: 2, sourceTypes: []types.T{l, r}, targetTypes: targetType,
+				})
+			}
+			for _, r := range integers {
+				OperatorCastRules[op] = append(OperatorCastRules[op], []castRule{
+					{NumArgs: 2, sourceTypes: []types.T{l, r}, targetTypes: targetType},
+					{NumArgs: 2, sourceTypes: []types.T{r, l}, targetTypes: targetType},
+				}...)
+			}
+		}
+	}
+}
+
+// decimalScale returns the scale of v, it is 0 for integers
+func decimalScale(v *vector.Vector) int32 {
+	switch v.Typ.Oid {
+	case types.T_decimal64, types.T_decimal128:
+		return v.Typ.Precision
+	}
+	return 0
+}
+
+// decimal128Column returns the rows of v as decimal128s of the given scale whose
+// digits are no more than width, null rows are returned as zero.
+func decimal128Column(v *vector.Vector, width, scale int32) ([]types.Decimal128, error) {
+	hasNull := nulls.Any(v.Nsp)
+	isNull := func(i int) bool {
+		return hasNull && v.Nsp.Np.Contains(uint64(i))
+	}
+	switch vs := v.Col.(type) {
+	case []types.Decimal64:
+		xs := make([]types.Decimal128, len(vs))
+		typecast.Decimal64ToDecimal128(vs, xs)
+		for i := range xs {
+			if isNull(i) {
+				xs[i] = types.Decimal128{}
+			}
+		}
+		return typecast.Decimal128ToDecimal128(xs, v.Typ.Precision, width, scale, xs)
+	case []types.Decimal128:
+		xs := make([]types.Decimal128, len(vs))
+		copy(xs, vs)
+		for i := range xs {
+			if isNull(i) {
+				xs[i] = types.Decimal128{}
+			}
+		}
+		return typecast.Decimal128ToDecimal128(xs, v.Typ.Precision, width, scale, xs)
+	case *types.Bytes:
+		xs := &types.Bytes{Data: vs.Data, Offsets: vs.Offsets, Lengths: vs.Lengths}
+		if hasNull {
+			xs.Lengths = make([]uint32, len(vs.Lengths))
+			for i, n := range vs.Lengths {
+				if !isNull(i) {
+					xs.Lengths[i] = n
+				}
+			}
+		}
+		return typecast.BytesToDecimal128(xs, width, scale, make([]types.Decimal128, len(vs.Offsets)))
+	case []float32, []float64:
+		fs := make([]float64, vector.Length(v))
+		for i := range fs {
+			if isNull(i) {
+				continue
+			}
+			if v.Typ.Oid == types.T_float32 {
+				fs[i] = float64(vs.([]float32)[i])
+			} else {
+				fs[i] = vs.([]float64)[i]
+			}
+		}
+		return typecast.Float64ToDecimal128(fs, width, scale, make([]types.Decimal128, len(fs)))
+	case []uint8, []uint16, []uint32, []uint64:
+		us := make([]uint64, vector.Length(v))
+		for i := range us {
+			if isNull(i) {
+				continue
+			}
+			switch vs := vs.(type) {
+			case []uint8:
+				us[i] = uint64(vs[i])
+			case []uint16:
+				us[i] = uint64(vs[i])
+			case []uint32:
+				us[i] = uint64(vs[i])
+			case []uint64:
+				us[i] = vs[i]
+			}
+		}
+		return typecast.Uint64ToDecimal128(us, width, scale, make([]types.Decimal128, len(us)))
+	}
+	is := make([]int64, vector.Length(v))
+	for i := range is {
+		if isNull(i) {
+			continue
+		}
+		switch vs := v.Col.(type) {
+		case []int8:
+			is[i] = int64(vs[i])
+		case []int16:
+			is[i] = int64(vs[i])
+		case []int32:
+			is[i] = int64(vs[i])
+		case []int64:
+			is[i] = vs[i]
+		}
+	}
+	return typecast.Int64ToDecimal128(is, width, scale, make([]types.Decimal128, len(is)))
+}
+
+// decimal128Operand returns the rows of an operand at the given scale,
+// a constant is extended to n rows.
+func decimal128Operand(v *vector.Vector, c bool, scale int32, n int) ([]types.Decimal128, error) {
+	xs, err := decimal128Column(v, types.MaxDecimal128Precision, scale)
+	if err != nil {
+		return nil, err
+	}
+	if c && len(xs) == 1 && n != 1 {
+		ys := make([]types.Decimal128, n)
+		for i := range ys {
+			ys[i] = xs[0]
+		}
+		return ys, nil
+	}
+	return xs, nil
+}
+
+// decimalOperandNulls returns the null rows of a binary operation
+func decimalOperandNulls(lv, rv *vector.Vector, lc, rc bool) *nulls.Nulls {
+	nsp := new(nulls.Nulls)
+	switch {
+	case lc && !rc:
+		nulls.Set(nsp, rv.Nsp)
+	case !lc && rc:
+		nulls.Set(nsp, lv.Nsp)
+	default:
+		nulls.Or(lv.Nsp, rv.Nsp, nsp)
+	}
+	return nsp
+}
+
+func decimalOperandLength(lv, rv *vector.Vector, lc bool) int {
+	if lc {
+		return vector.Length(rv)
+	}
+	return vector.Length(lv)
+}
+
+func putDecimalOperands(proc *process.Process, lv, rv *vector.Vector, lc, rc bool) {
+	if !lc && lv.Ref == 0 {
+		process.Put(proc, lv)
+	}
+	if !rc && rv.Ref == 0 {
+		process.Put(proc, rv)
+	}
+}
+
+func decimalArith(op int) func(*vector.Vector, *vector.Vector, *process.Process, bool, bool) (*vector.Vector, error) {
+	return func(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+		var ls, rs, scale, n int32
+
+		ls, rs = decimalScale(lv), decimalScale(rv)
+		switch op {
+		case Plus, Minus:
+			if ls < rs {
+				ls = rs
+			}
+			rs, scale = ls, ls
+		case Mult:
+			// round the operand with the larger scale if the product has too many decimal places
+			for ls+rs > types.MaxDecimal128Precision {
+				if ls > rs {
+					ls--
+				} else {
+					rs--
+				}
+			}
+			scale = ls + rs
+		case Div:
+			if scale = ls + types.DecimalDivScaleIncrement; scale > types.MaxDecimal128Precision {
+				scale = types.MaxDecimal128Precision
+			}
+			n = scale - ls + rs
+		}
+		length := decimalOperandLength(lv, rv, lc)
+		xs, err := decimal128Operand(lv, lc, ls, length)
+		if err != nil {
+			return nil, err
+		}
+		ys, err := decimal128Operand(rv, rc, rs, length)
+		if err != nil {
+			return nil, err
+		}
+		nsp := decimalOperandNulls(lv, rv, lc, rc)
+		if op == Div {
+			for i, y := range ys {
+				if y.Hi == 0 && y.Lo == 0 {
+					if !nulls.Contains(nsp, uint64(i)) {
+						return nil, ErrDivByZero
+					}
+					ys[i] = types.Decimal128{Lo: 1}
+				}
+			}
+		}
+		typ := types.DecimalType(types.MaxDecimal128Precision, scale)
+		vec, err := process.Get(proc, int64(typ.Size)*int64(length), typ)
+		if err != nil {
+			return nil, err
+		}
+		vs := encoding.DecodeDecimal128Slice(vec.Data)
+		vs = vs[:length]
+		switch op {
+		case Plus:
+			_, err = add.Decimal128Add(xs, ys, vs)
+		case Minus:
+			_, err = sub.Decimal128Sub(xs, ys, vs)
+		case Mult:
+			_, err = mul.Decimal128Mul(xs, ys, vs)
+		case Div:
+			_, err = div.Decimal128Div(xs, ys, vs, n)
+		}
+		if err != nil {
+			process.Put(proc, vec)
+			return nil, err
+		}
+		nulls.Set(vec.Nsp, nsp)
+		vector.SetCol(vec, vs)
+		putDecimalOperands(proc, lv, rv, lc, rc)
+		return vec, nil
+	}
+}
+
+func decimalCompare(op int) func(*vector.Vector, *vector.Vector, *process.Process, bool, bool) (*vector.Vector, error) {
+	return func(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) (*vector.Vector, error) {
+		scale := decimalScale(lv)
+		if s := decimalScale(rv); s > scale {
+			scale = s
+		}
+		length := decimalOperandLength(lv, rv, lc)
+		xs, err := decimal128Operand(lv, lc, scale, length)
+		if err != nil {
+			return nil, err
+		}
+		ys, err := decimal128Operand(rv, rc, scale, length)
+		if err != nil {
+			return nil, err
+		}
+		vec, err := process.Get(proc, 8*int64(length), SelsType)
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeInt64Slice(vec.Data)
+		rs = rs[:length]
+		if nsp := decimalOperandNulls(lv, rv, lc, rc); nulls.Any(nsp) {
+			rs = decimalCompareNullable(op, xs, ys, nsp.Np, rs)
+		} else {
+			rs = decimalCompareNotNull(op, xs, ys, rs)
+		}
+		vector.SetCol(vec, rs)
+		putDecimalOperands(proc, lv, rv, lc, rc)
+		return vec, nil
+	}
+}
+
+func decimalCompareNotNull(op int, xs, ys []types.Decimal128, rs []int64) []int64 {
+	switch op {
+	case EQ:
+		return eq.Decimal128Eq(xs, ys, rs)
+	case NE:
+		return ne.Decimal128Ne(xs, ys, rs)
+	case GT:
+		return gt.Decimal128Gt(xs, ys, rs)
+	case GE:
+		return ge.Decimal128Ge(xs, ys, rs)
+	case LT:
+		return lt.Decimal128Lt(xs, ys, rs)
+	}
+	return le.Decimal128Le(xs, ys, rs)
+}
+
+func decimalCompareNullable(op int, xs, ys []types.Decimal128, np *roaring.Bitmap, rs []int64) []int64 {
+	switch op {
+	case EQ:
+		return eq.Decimal128EqNullable(xs, ys, np, rs)
+	case NE:
+		return ne.Decimal128NeNullable(xs, ys, np, rs)
+	case GT:
+		return gt.Decimal128GtNullable(xs, ys, np, rs)
+	case GE:
+		return ge.Decimal128GeNullable(xs, ys, np, rs)
+	case LT:
+		return lt.Decimal128LtNullable(xs, ys, np, rs)
+	}
+	return le.Decimal128LeNullable(xs, ys, np, rs)
+}
+
+func decimalNeg(v *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+	if v.Ref == 1 || v.Ref == 0 {
+		v.Ref = 0
+		switch vs := v.Col.(type) {
+		case []types.Decimal64:
+			neg.Decimal64Neg(vs, vs)
+		case []types.Decimal128:
+			neg.Decimal128Neg(vs, vs)
+		}
+		return v, nil
+	}
+	vec, err := process.Get(proc, int64(v.Typ.Size)*int64(vector.Length(v)), v.Typ)
+	if err != nil {
+		return nil, err
+	}
+	switch vs := v.Col.(type) {
+	case []types.Decimal64:
+		rs := encoding.DecodeDecimal64Slice(vec.Data)
+		vector.SetCol(vec, neg.Decimal64Neg(vs, rs[:len(vs)]))
+	case []types.Decimal128:
+		rs := encoding.DecodeDecimal128Slice(vec.Data)
+		vector.SetCol(vec, neg.Decimal128Neg(vs, rs[:len(vs)]))
+	}
+	nulls.Set(vec.Nsp, v.Nsp)
+	return vec, nil
+}
+
+// castToDecimal casts numbers, strings or decimals to be decimals of
+// the width and scale of rv.
+func castToDecimal(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			process.Put(proc, lv)
+		}
+	}()
+	xs, err := decimal128Column(lv, rv.Typ.Width, rv.Typ.Precision)
+	if err != nil {
+		return nil, err
+	}
+	vec, err := process.Get(proc, int64(rv.Typ.Size)*int64(len(xs)), rv.Typ)
+	if err != nil {
+		return nil, err
+	}
+	if rv.Typ.Oid == types.T_decimal64 {
+		rs := encoding.DecodeDecimal64Slice(vec.Data)
+		rs = rs[:len(xs)]
+		if _, err := typecast.Decimal128ToDecimal64(xs, rs); err != nil {
+			process.Put(proc, vec)
+			return nil, err
+		}
+		vector.SetCol(vec, rs)
+	} else {
+		rs := encoding.DecodeDecimal128Slice(vec.Data)
+		rs = rs[:len(xs)]
+		copy(rs, xs)
+		vector.SetCol(vec, rs)
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	return vec, nil
+}
+
+func castDecimalToNumeric(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			process.Put(proc, lv)
+		}
+	}()
+	scale := lv.Typ.Precision
+	xs, err := decimal128Column(lv, types.MaxDecimal128Precision, scale)
+	if err != nil {
+		return nil, err
+	}
+	vec, err := process.Get(proc, int64(rv.Typ.Size)*int64(len(xs)), rv.Typ)
+	if err != nil {
+		return nil, err
+	}
+	switch rv.Typ.Oid {
+	case types.T_float32, types.T_float64:
+		fs := typecast.Decimal128ToFloat64(xs, scale, make([]float64, len(xs)))
+		if rv.Typ.Oid == types.T_float32 {
+			rs := encoding.DecodeFloat32Slice(vec.Data)
+			_, err = typecast.Float64ToFloat32(fs, rs[:len(xs)])
+			vector.SetCol(vec, rs[:len(xs)])
+		} else {
+			rs := encoding.DecodeFloat64Slice(vec.Data)
+			copy(rs, fs)
+			vector.SetCol(vec, rs[:len(xs)])
+		}
+	default:
+		var is []int64
+
+		if is, err = typecast.Decimal128ToInt64(xs, scale, make([]int64, len(xs))); err != nil {
+			break
+		}
+		switch rv.Typ.Oid {
+		case types.T_int8:
+			rs := encoding.DecodeInt8Slice(vec.Data)
+			_, err = typecast.Int64ToInt8(is, rs[:len(is)])
+			vector.SetCol(vec, rs[:len(is)])
+		case types.T_int16:
+			rs := encoding.DecodeInt16Slice(vec.Data)
+			_, err = typecast.Int64ToInt16(is, rs[:len(is)])
+			vector.SetCol(vec, rs[:len(is)])
+		case types.T_int32:
+			rs := encoding.DecodeInt32Slice(vec.Data)
+			_, err = typecast.Int64ToInt32(is, rs[:len(is)])
+			vector.SetCol(vec, rs[:len(is)])
+		case types.T_int64:
+			rs := encoding.DecodeInt64Slice(vec.Data)
+			copy(rs, is)
+			vector.SetCol(vec, rs[:len(is)])
+		case types.T_uint8:
+			rs := encoding.DecodeUint8Slice(vec.Data)
+			_, err = typecast.Int64ToUint8(is, rs[:len(is)])
+			vector.SetCol(vec, rs[:len(is)])
+		case types.T_uint16:
+			rs := encoding.DecodeUint16Slice(vec.Data)
+			_, err = typecast.Int64ToUint16(is, rs[:len(is)])
+			vector.SetCol(vec, rs[:len(is)])
+		case types.T_uint32:
+			rs := encoding.DecodeUint32Slice(vec.Data)
+			_, err = typecast.Int64ToUint32(is, rs[:len(is)])
+			vector.SetCol(vec, rs[:len(is)])
+		case types.T_uint64:
+			rs := encoding.DecodeUint64Slice(vec.Data)
+			_, err = typecast.Int64ToUint64(is, rs[:len(is)])
+			vector.SetCol(vec, rs[:len(is)])
+		}
+	}
+	if err != nil {
+		process.Put(proc, vec)
+		return nil, err
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	return vec, nil
+}
+
+func castDecimalToBytes(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			process.Put(proc, lv)
+		}
+	}()
+	xs, err := decimal128Column(lv, types.MaxDecimal128Precision, lv.Typ.Precision)
+	if err != nil {
+		return nil, err
+	}
+	col := &types.Bytes{
+		Data:    make([]byte, 0, len(xs)),
+		Offsets: make([]uint32, 0, len(xs)),
+		Lengths: make([]uint32, 0, len(xs)),
+	}
+	col = typecast.Decimal128ToBytes(xs, lv.Typ.Precision, col)
+	if err = proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(rv.Typ)
+	vec.Data = col.Data
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, col)
+	return vec, nil
+}
@@ -36,6 +36,8 @@ func init() {
 	initOperatorFunctions()
 	// init cast-rule from ops
 	initCastRulesForBinaryOps()
+	initCastRulesForDecimal()
+	initCastRulesForDatetime()
 	initCastRulesForUnaryOps()
 	initCastRulesForMulti()
 	// init return type map from ops and cast-rule
@@ -158,6 +160,10 @@ func initOperatorFunctions() {
 	// others
 	initCast()
 	initLike()
+	// decimal operators are appended to the generated ones
+	initDecimal()
+	initDatetime()
+	initJson()
 }
 
 func initReturnTypeFromBinary() {
@@ -0,0 +1,100 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/typecast"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// initJson registers the casts between json and strings, a string is
+// parsed as a json text and a json is formatted as its text.
+func initJson() {
+	for _, c := range chars {
+		BinOps[Typecast] = append(BinOps[Typecast], &BinOp{
+			LeftType:   c,
+			RightType:  types.T_json,
+			ReturnType: types.T_json,
+			Fn:         castJson,
+		}, &BinOp{
+			LeftType:   types.T_json,
+			RightType:  c,
+			ReturnType: c,
+			Fn:         castJson,
+		})
+	}
+	BinOps[Typecast] = append(BinOps[Typecast], &BinOp{
+		LeftType:   types.T_json,
+		RightType:  types.T_json,
+		ReturnType: types.T_json,
+		Fn:         castJsonToSelf,
+	})
+}
+
+func castJsonToSelf(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	if lv.Ref == 0 {
+		return lv, nil
+	}
+	vs := lv.Col.(*types.Bytes)
+	col := &types.Bytes{
+		Data:    make([]byte, len(vs.Data)),
+		Offsets: make([]uint32, len(vs.Offsets)),
+		Lengths: make([]uint32, len(vs.Lengths)),
+	}
+	copy(col.Data, vs.Data)
+	copy(col.Offsets, vs.Offsets)
+	copy(col.Lengths, vs.Lengths)
+	if err := proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(rv.Typ)
+	vec.Data = col.Data
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, col)
+	return vec, nil
+}
+
+func castJson(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+	defer func() {
+		if lv.Ref == 0 {
+			process.Put(proc, lv)
+		}
+	}()
+	vs := lv.Col.(*types.Bytes)
+	col := &types.Bytes{
+		Data:    make([]byte, 0, len(vs.Data)),
+		Offsets: make([]uint32, 0, len(vs.Offsets)),
+		Lengths: make([]uint32, 0, len(vs.Lengths)),
+	}
+	if rv.Typ.Oid == types.T_json {
+		var err error
+		if col, err = typecast.BytesToJson(vs, lv.Nsp, col); err != nil {
+			return nil, err
+		}
+	} else {
+		col = typecast.JsonToBytes(vs, lv.Nsp, col)
+	}
+	if err := proc.Mp.Gm.Alloc(int64(cap(col.Data))); err != nil {
+		return nil, err
+	}
+	vec := vector.New(rv.Typ)
+	vec.Data = col.Data
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, col)
+	return vec, nil
+}
@@ -62,6 +62,36 @@ type ValueExtend struct {
 	V *vector.Vector
 }
 
+// CaseExtend is the result of Vals[i] for the rows where Conds[i] is the first
+// true condition, and the result of Else for the others, a nil value is null.
+type CaseExtend struct {
+	Type  types.T
+	Conds []Extend
+	Vals  []Extend
+	Else  Extend
+}
+
+// CoalesceExtend is the first non null result of Args.
+type CoalesceExtend struct {
+	Type types.T
+	Args []Extend
+}
+
+type IsNullExtend struct {
+	Not bool
+	E   Extend
+}
+
+// InExtend selects the rows where E is one of Args, which are constants.
+// Null is true if the list also has a null, so that E in the list is null
+// rather than false if E is none of Args.
+type InExtend struct {
+	Not  bool
+	Null bool
+	E    Extend
+	Args []Extend
+}
+
 type Attribute struct {
 	Name string  `json:"name"`
 	Type types.T `json:"type"`
@@ -34,7 +34,10 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 		reg := proc.Reg.MergeReceivers[i]
-		bat := <-reg.Ch
+		bat, err := process.Receive(proc, reg)
+		if err != nil {
+			return true, err
+		}
 		if bat == nil {
 			proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
 			i--
@@ -39,12 +39,14 @@ func Prepare(_ *process.Process, arg interface{}) error {
 }
 
 func Call(proc *process.Process, arg interface{}) (bool, error) {
-	var err error
 	argument := arg.(*Argument)
 
 	if len(proc.Reg.MergeReceivers) == 1 {
 		reg := proc.Reg.MergeReceivers[0]
-		bat := <-reg.Ch
+		bat, err := process.Receive(proc, reg)
+		if err != nil {
+			return true, err
+		}
 		if bat == nil {
 			proc.Reg.MergeReceivers = nil
 		}
@@ -57,8 +59,12 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		case running:
 			for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 				reg := proc.Reg.MergeReceivers[i]
-				bat := <-reg.Ch
-
+				bat, err := process.Receive(proc, reg)
+				if err != nil {
+					batch.Clean(argument.ctr.bat, proc.Mp)
+					proc.Reg.InputBatch = nil
+					return false, err
+				}
 				if bat == nil {
 					proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
 					i--
@@ -135,6 +141,10 @@ func initHashTable(n *Argument, bat *batch.Batch) {
 			size += 4
 		case types.T_float64:
 			size += 8
+		case types.T_decimal64:
+			size += 8
+		case types.T_decimal128:
+			size += 16
 		case types.T_char:
 			if width := bat.Vecs[i].Typ.Width; width > 0 {
 				size += int(width)
@@ -151,6 +161,10 @@ func initHashTable(n *Argument, bat *batch.Batch) {
 			size += 4
 		case types.T_datetime:
 			size += 8
+		case types.T_time:
+			size += 8
+		case types.T_timestamp:
+			size += 8
 		}
 	}
 	n.ctr.keyOffs = make([]uint32, dedup.UnitLimit)
@@ -267,12 +281,30 @@ func (ctr *container) processH8(bat *batch.Batch, proc *process.Process) error {
 					*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				for k := int64(0); k < n; k++ {
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -388,12 +420,36 @@ func (ctr *container) processH24(bat *batch.Batch, proc *process.Process) error
 					*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				for k := int64(0); k < n; k++ {
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
@@ -506,12 +562,36 @@ func (ctr *container) processH32(bat *batch.Batch, proc *process.Process) error
 					*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				for k := int64(0); k < n; k++ {
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
@@ -624,12 +704,36 @@ func (ctr *container) processH40(bat *batch.Batch, proc *process.Process) error
 					*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				for k := int64(0); k < n; k++ {
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
@@ -740,12 +844,36 @@ func (ctr *container) processHStr(bat *batch.Batch, proc *process.Process) error
 				for k := int64(0); k < n; k++ {
 					keys[k] = append(keys[k], data[(i+k)*8:(i+k+1)*8]...)
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				for k := int64(0); k < n; k++ {
+					keys[k] = append(keys[k], data[(i+k)*8:(i+k+1)*8]...)
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				for k := int64(0); k < n; k++ {
+					keys[k] = append(keys[k], data[(i+k)*16:(i+k+1)*16]...)
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
 				for k := int64(0); k < n; k++ {
 					keys[k] = append(keys[k], data[(i+k)*8:(i+k+1)*8]...)
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				for k := int64(0); k < n; k++ {
+					keys[k] = append(keys[k], data[(i+k)*8:(i+k+1)*8]...)
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				for k := int64(0); k < n; k++ {
+					keys[k] = append(keys[k], data[(i+k)*8:(i+k+1)*8]...)
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
@@ -39,7 +39,10 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 		rec := proc.Reg.MergeReceivers[i]
-		bat := <-rec.Ch
+		bat, err := process.Receive(proc, rec)
+		if err != nil {
+			return false, err
+		}
 		// deal special case for bat
 		{
 			// 1. the last batch at this receiver
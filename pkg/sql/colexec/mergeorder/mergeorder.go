@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -55,22 +56,15 @@ func Prepare(_ *process.Process, arg interface{}) error {
 func Call(proc *process.Process, arg interface{}) (bool, error) {
 	argument := arg.(*Argument)
 
-	if len(proc.Reg.MergeReceivers) == 1 {
-		reg := proc.Reg.MergeReceivers[0]
-		bat := <-reg.Ch
-		if bat == nil {
-			proc.Reg.MergeReceivers = nil
-		}
-		proc.Reg.InputBatch = bat
-		return true, nil
-	}
-
 	for {
 		switch argument.ctr.state {
 		case running:
 			for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 				reg := proc.Reg.MergeReceivers[i]
-				bat := <-reg.Ch
+				bat, err := process.Receive(proc, reg)
+				if err != nil {
+					return false, err
+				}
 				if bat == nil {
 					proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
 					i--
@@ -80,13 +74,54 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 					i--
 					continue
 				}
-				err := mergeSort(proc.Mp, argument, bat)
+				// the sorted result is spilled as a run if the memory is under pressure,
+				// then the batch is merged into an empty result, or spilled as a run too
+				// if the memory is still under pressure
+				if argument.ctr.bat != nil && proc.Mp.Gm.Pressure() {
+					if err := spillRun(proc, argument, argument.ctr.bat); err != nil {
+						argument.ctr.bat = nil
+						batch.Clean(bat, proc.Mp)
+						clean(proc, argument)
+						return false, err
+					}
+					argument.ctr.bat = nil
+				}
+				if argument.ctr.bat == nil && proc.Mp.Gm.Pressure() {
+					if err := spillRun(proc, argument, bat); err != nil {
+						clean(proc, argument)
+						return false, err
+					}
+					i--
+					continue
+				}
+				err = mergeSort(proc.Mp, argument, bat)
 				if err != nil {
+					clean(proc, argument)
 					return false, err
 				}
 				i--
 			}
 			argument.ctr.state = end
+			if len(argument.ctr.runs) > 0 {
+				if err := openRuns(proc, argument); err != nil {
+					clean(proc, argument)
+					return false, err
+				}
+				argument.ctr.state = merging
+			}
+		case merging:
+			bat, err := mergeRuns(proc, argument)
+			if err != nil {
+				clean(proc, argument)
+				return false, err
+			}
+			if bat == nil {
+				clean(proc, argument)
+				argument.ctr.state = end
+				continue
+			}
+			proc.Reg.InputBatch = bat
+			return false, nil
 		case end:
 			proc.Reg.InputBatch = argument.ctr.bat
 			argument.ctr.bat = nil
@@ -111,9 +146,10 @@ func mergeSort(mp *mheap.Mheap, arg *Argument, b *batch.Batch) error {
 	}
 	bat1 := arg.ctr.bat
 	bat2 := b
+	batch.Reorder(bat2, bat1.Attrs)
 	// init structures to store result
-	result := batch.New(false, arg.ctr.attrs)
-	for i := 0; i < len(arg.ctr.attrs); i++ {
+	result := batch.New(false, bat1.Attrs)
+	for i := range result.Vecs {
 		result.Vecs[i] = vector.New(bat2.Vecs[i].Typ)
 	}
 	// init structures used to do compare work
@@ -184,5 +220,132 @@ func mergeSort(mp *mheap.Mheap, arg *Argument, b *batch.Batch) error {
 		result.Zs = append(result.Zs, bat2.Zs[j:]...)
 	}
 	arg.ctr.bat = result
+	batch.Clean(bat1, mp)
+	batch.Clean(bat2, mp)
 	return nil
 }
+
+// spillRun writes the sorted batch to a new run and frees it
+func spillRun(proc *process.Process, arg *Argument, bat *batch.Batch) error {
+	defer batch.Clean(bat, proc.Mp)
+
+	run, err := spill.Create()
+	if err != nil {
+		return err
+	}
+	arg.ctr.runs = append(arg.ctr.runs, run)
+	n := len(bat.Zs)
+	for i := 0; i < n; i += spill.BatchRows {
+		cnt := spill.BatchRows
+		if i+cnt > n {
+			cnt = n - i
+		}
+		rbat, err := spill.Slice(bat, int64(i), cnt, proc)
+		if err != nil {
+			return err
+		}
+		err = run.Write(rbat)
+		batch.Clean(rbat, proc.Mp)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// openRuns spills the rest of the sorted result and reads the first batch of each run
+func openRuns(proc *process.Process, arg *Argument) error {
+	if bat := arg.ctr.bat; bat != nil {
+		arg.ctr.bat = nil
+		if err := spillRun(proc, arg, bat); err != nil {
+			return err
+		}
+	}
+	for _, run := range arg.ctr.runs {
+		bat, err := run.Read(proc)
+		if err != nil {
+			return err
+		}
+		if bat != nil {
+			// the runs may have the attributes in different orders
+			if len(arg.ctr.srcs) > 0 {
+				batch.Reorder(bat, arg.ctr.srcs[0].bat.Attrs)
+			}
+			arg.ctr.srcs = append(arg.ctr.srcs, &source{bat: bat, run: run})
+		}
+	}
+	return nil
+}
+
+// mergeRuns returns the next batch merged from the runs, it returns nil if all the runs are merged
+func mergeRuns(proc *process.Process, arg *Argument) (*batch.Batch, error) {
+	if len(arg.ctr.srcs) == 0 {
+		return nil, nil
+	}
+	if arg.ctr.cmps[0] == nil {
+		for k := range arg.ctr.cmps {
+			arg.ctr.cmps[k] = compare.New(batch.GetVector(arg.ctr.srcs[0].bat, arg.ctr.attrs[k]).Typ.Oid, arg.ctr.ds[k])
+		}
+	}
+	attrs := append([]string{}, arg.ctr.srcs[0].bat.Attrs...)
+	result := batch.New(false, attrs)
+	for i := range result.Vecs {
+		result.Vecs[i] = vector.New(arg.ctr.srcs[0].bat.Vecs[i].Typ)
+	}
+	for len(result.Zs) < spill.BatchRows && len(arg.ctr.srcs) > 0 {
+		// the row of the earlier run goes first if the rows are equal
+		k := 0
+		for i := 1; i < len(arg.ctr.srcs); i++ {
+			if compareSources(arg, arg.ctr.srcs[i], arg.ctr.srcs[k]) < 0 {
+				k = i
+			}
+		}
+		src := arg.ctr.srcs[k]
+		for i := range result.Vecs {
+			if err := vector.UnionOne(result.Vecs[i], src.bat.Vecs[i], src.row, proc.Mp); err != nil {
+				batch.Clean(result, proc.Mp)
+				return nil, err
+			}
+		}
+		result.Zs = append(result.Zs, src.bat.Zs[src.row])
+		if src.row++; src.row < int64(len(src.bat.Zs)) {
+			continue
+		}
+		batch.Clean(src.bat, proc.Mp)
+		bat, err := src.run.Read(proc)
+		if err != nil {
+			src.bat = nil
+			batch.Clean(result, proc.Mp)
+			return nil, err
+		}
+		if src.bat, src.row = bat, 0; bat == nil {
+			arg.ctr.srcs = append(arg.ctr.srcs[:k], arg.ctr.srcs[k+1:]...)
+			continue
+		}
+		batch.Reorder(bat, attrs)
+	}
+	return result, nil
+}
+
+func compareSources(arg *Argument, s0, s1 *source) int {
+	for k := range arg.ctr.cmps {
+		arg.ctr.cmps[k].Set(0, batch.GetVector(s0.bat, arg.ctr.attrs[k]))
+		arg.ctr.cmps[k].Set(1, batch.GetVector(s1.bat, arg.ctr.attrs[k]))
+		if r := arg.ctr.cmps[k].Compare(0, 1, s0.row, s1.row); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// clean frees the batches being merged and removes the runs
+func clean(proc *process.Process, arg *Argument) {
+	for _, src := range arg.ctr.srcs {
+		if src.bat != nil {
+			batch.Clean(src.bat, proc.Mp)
+		}
+	}
+	arg.ctr.srcs = nil
+	spill.CloseFiles(arg.ctr.runs)
+	arg.ctr.runs = nil
+}
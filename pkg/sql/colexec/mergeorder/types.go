@@ -18,18 +18,21 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 )
 
 // state values
 const (
 	running = iota
+	merging
 	end
 )
 
 type container struct {
 	// state signs the statement of mergeOrder operator
 	//	1. if state is running, operator still range the mergeReceivers to do merge-sort.
-	//	2. if state is end, operator has done and should push data to next operator.
+	//	2. if state is merging, operator merges the sorted runs spilled to disk and pushes the result batch by batch.
+	//	3. if state is end, operator has done and should push data to next operator.
 	state uint8
 
 	attrs []string // sorted list of attributes
@@ -39,6 +42,19 @@ type container struct {
 
 	// bat store the result of merge-order
 	bat *batch.Batch
+
+	// runs store the sorted results spilled to disk when the memory is under pressure
+	runs []*spill.File
+	// srcs store the batches being merged of the runs
+	srcs []*source
+}
+
+// source is a run being merged
+type source struct {
+	// row is the next row of bat to merge
+	row int64
+	bat *batch.Batch
+	run *spill.File
 }
 
 type Argument struct {
@@ -60,7 +60,10 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 
 	if len(proc.Reg.MergeReceivers) == 1 {
 		reg := proc.Reg.MergeReceivers[0]
-		bat := <-reg.Ch
+		bat, err := process.Receive(proc, reg)
+		if err != nil {
+			return true, err
+		}
 		if bat == nil {
 			proc.Reg.MergeReceivers = nil
 		}
@@ -74,8 +77,10 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			{ // do merge-top work
 				for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 					reg := proc.Reg.MergeReceivers[i]
-					bat := <-reg.Ch
-
+					bat, err := process.Receive(proc, reg)
+					if err != nil {
+						return false, err
+					}
 					if bat == nil {
 						proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
 						i--
@@ -97,7 +102,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 						}
 					}
 
-					err := argument.ctr.mergeTop(proc, argument.Limit, bat)
+					err = argument.ctr.mergeTop(proc, argument.Limit, bat)
 					if err != nil {
 						return false, err
 					}
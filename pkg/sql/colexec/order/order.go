@@ -79,8 +79,7 @@ func (ctr *Container) process(bat *batch.Batch, proc *process.Process) (bool, er
 	if len(ctr.attrs) == 1 {
 		bat.Sels = sels
 		bat.SelsData = data
-		batch.Shuffle(bat, proc.Mp)
-		return false, nil
+		return false, shuffle(bat, proc)
 	}
 	ps := make([]int64, 0, 16)
 	ds := make([]bool, len(sels))
@@ -99,6 +98,15 @@ func (ctr *Container) process(bat *batch.Batch, proc *process.Process) (bool, er
 	}
 	bat.Sels = sels
 	bat.SelsData = data
-	batch.Shuffle(bat, proc.Mp)
-	return false, nil
+	return false, shuffle(bat, proc)
+}
+
+// shuffle reorders the rows of the batch by its sels, the batch is freed if it fails
+func shuffle(bat *batch.Batch, proc *process.Process) error {
+	if err := batch.Shuffle(bat, proc.Mp); err != nil {
+		batch.Clean(bat, proc.Mp)
+		proc.Reg.InputBatch = &batch.Batch{}
+		return err
+	}
+	return nil
 }
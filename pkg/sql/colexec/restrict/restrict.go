@@ -103,6 +103,18 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			} else {
 				proc.Reg.InputBatch = bat
 			}
+		case types.T_decimal64:
+			if v.Col.([]types.Decimal64)[0] == 0 {
+				proc.Reg.InputBatch = &batch.Batch{}
+			} else {
+				proc.Reg.InputBatch = bat
+			}
+		case types.T_decimal128:
+			if v.Col.([]types.Decimal128)[0] == (types.Decimal128{}) {
+				proc.Reg.InputBatch = &batch.Batch{}
+			} else {
+				proc.Reg.InputBatch = bat
+			}
 		case types.T_char, types.T_varchar:
 			if len(v.Data) == 0 {
 				proc.Reg.InputBatch = &batch.Batch{}
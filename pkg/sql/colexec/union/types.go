@@ -0,0 +1,75 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package union
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+const (
+	UnitLimit = 1024
+)
+
+// set operations
+const (
+	Union = iota
+	Intersect
+	Except
+)
+
+var SetNames = [...]string{
+	Union:     "∪",
+	Intersect: "∩",
+	Except:    "−",
+}
+
+type Container struct {
+	state int
+	rn    int      // number of distinct tuples of right input
+	is    []int    // inputs which are not over
+	marks []bool   // whether a distinct tuple has been sent
+	cnts  []int64  // multiplicity of the tuples of right input
+	zs    []int64  // multiplicity of the rows of result
+	key   []byte   // buffer of keys
+	keys  [][]byte // keys of rows
+	vs    []uint64
+	hs    [][3]uint64
+	bat   *batch.Batch // whole result
+	ht    *hashtable.StringHashMap
+}
+
+// Argument of set operation, the first input is the left query and the
+// second input is the right query for INTERSECT and EXCEPT, all inputs
+// are merged for UNION.
+type Argument struct {
+	Typ   int
+	All   bool
+	Attrs []string          // name of result attributes
+	Types []types.Type      // type of result attributes
+	Es    [][]extend.Extend // result attributes of each input
+	// Collect is true if the result is sent as one batch, which is
+	// needed by the sort of result
+	Collect bool
+	ctr     *Container
+}
@@ -0,0 +1,359 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package union
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString(SetNames[n.Typ])
+	if n.All {
+		buf.WriteString(" all")
+	}
+	buf.WriteString(fmt.Sprintf("(%v)", n.Attrs))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(Container)
+	ctr := n.ctr
+	if n.Typ == Union {
+		ctr.state = Probe
+		for i := range n.Es {
+			ctr.is = append(ctr.is, i)
+		}
+		if n.All {
+			return nil
+		}
+	} else {
+		ctr.state = Build
+		ctr.is = []int{0}
+	}
+	ctr.ht = &hashtable.StringHashMap{}
+	ctr.ht.Init()
+	ctr.keys = make([][]byte, UnitLimit)
+	ctr.hs = make([][3]uint64, UnitLimit)
+	ctr.vs = make([]uint64, UnitLimit)
+	return nil
+}
+
+// Call sends the result of set operation batch by batch, the right input of
+// INTERSECT and EXCEPT is received completely before the left input.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := n.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(n, proc); err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Probe
+		case Probe:
+			i, bat, err := ctr.receive(proc)
+			if err != nil {
+				ctr.state = End
+				return true, err
+			}
+			if bat == nil {
+				ctr.state = End
+				if n.Collect && ctr.bat != nil {
+					proc.Reg.InputBatch = ctr.bat
+					ctr.bat = nil
+					return true, nil
+				}
+				continue
+			}
+			rbat, err := ctr.probe(n, i, bat, proc)
+			if err != nil {
+				ctr.state = End
+				return true, err
+			}
+			proc.Reg.InputBatch = rbat
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+// receive returns the next batch and the input it comes from,
+// and it returns nil if all the inputs are over.
+func (ctr *Container) receive(proc *process.Process) (int, *batch.Batch, error) {
+	for k := 0; k < len(ctr.is); k++ {
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[ctr.is[k]])
+		if err != nil {
+			return -1, nil, err
+		}
+		if bat == nil {
+			ctr.is = append(ctr.is[:k], ctr.is[k+1:]...)
+			k--
+			continue
+		}
+		if len(bat.Zs) == 0 {
+			batch.Clean(bat, proc.Mp)
+			k--
+			continue
+		}
+		return ctr.is[k], bat, nil
+	}
+	return -1, nil, nil
+}
+
+// build receives the right input and counts its tuples, all batches
+// are received even if an error occurs so that the sender can finish
+// unless the query is aborted.
+func (ctr *Container) build(n *Argument, proc *process.Process) error {
+	var err error
+
+	reg := proc.Reg.MergeReceivers[1]
+	for {
+		bat, rerr := process.Receive(proc, reg)
+		if rerr != nil {
+			return rerr
+		}
+		if bat == nil {
+			break
+		}
+		if err == nil && len(bat.Zs) > 0 {
+			err = ctr.insert(n, bat, proc)
+		}
+		batch.Clean(bat, proc.Mp)
+	}
+	ctr.rn = int(ctr.ht.Cardinality())
+	return err
+}
+
+func (ctr *Container) insert(n *Argument, bat *batch.Batch, proc *process.Process) error {
+	if len(bat.Sels) > 0 {
+		batch.Shuffle(bat, proc.Mp)
+	}
+	vecs, err := eval(n.Es[1], bat, proc)
+	if err != nil {
+		return err
+	}
+	defer clean(vecs, bat, proc)
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		m := count - i
+		if m > UnitLimit {
+			m = UnitLimit
+		}
+		if err := ctr.fillKeys(vecs, i, m); err != nil {
+			return err
+		}
+		ctr.ht.InsertStringBatch(ctr.hs, ctr.keys[:m], ctr.vs)
+		if !n.All {
+			continue
+		}
+		for k := 0; k < m; k++ {
+			for uint64(len(ctr.cnts)) < ctr.vs[k] {
+				ctr.cnts = append(ctr.cnts, 0)
+			}
+			ctr.cnts[ctr.vs[k]-1] += bat.Zs[i+k]
+		}
+	}
+	return nil
+}
+
+// probe returns the tuples of the batch of input i which belong to the result
+func (ctr *Container) probe(n *Argument, i int, bat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	defer batch.Clean(bat, proc.Mp)
+	if len(bat.Sels) > 0 {
+		batch.Shuffle(bat, proc.Mp)
+	}
+	vecs, err := eval(n.Es[i], bat, proc)
+	if err != nil {
+		return nil, err
+	}
+	defer clean(vecs, bat, proc)
+	count := len(bat.Zs)
+	flags := make([]uint8, count)
+	ctr.zs = ctr.zs[:0]
+	if n.Typ == Union && n.All {
+		for k := range flags {
+			flags[k] = 1
+		}
+		ctr.zs = append(ctr.zs, bat.Zs...)
+		return ctr.result(n, vecs, flags, proc)
+	}
+	for i := 0; i < count; i += UnitLimit {
+		m := count - i
+		if m > UnitLimit {
+			m = UnitLimit
+		}
+		if err := ctr.fillKeys(vecs, i, m); err != nil {
+			return nil, err
+		}
+		if n.Typ == Union || (n.Typ == Except && !n.All) {
+			ctr.ht.InsertStringBatch(ctr.hs, ctr.keys[:m], ctr.vs)
+		} else {
+			ctr.ht.FindStringBatch(ctr.hs, ctr.keys[:m], ctr.vs)
+		}
+		for k := 0; k < m; k++ {
+			if z := ctr.multiplicity(n, ctr.vs[k], bat.Zs[i+k]); z > 0 {
+				flags[i+k] = 1
+				ctr.zs = append(ctr.zs, z)
+			}
+		}
+	}
+	return ctr.result(n, vecs, flags, proc)
+}
+
+// multiplicity returns the multiplicity in result of a tuple which appears z times,
+// v is the number of the tuple in hash table and 0 means the tuple is not found.
+func (ctr *Container) multiplicity(n *Argument, v uint64, z int64) int64 {
+	if !n.All {
+		switch {
+		case n.Typ == Intersect && v == 0:
+			return 0
+		case n.Typ == Except && v <= uint64(ctr.rn):
+			return 0
+		}
+		for uint64(len(ctr.marks)) < v {
+			ctr.marks = append(ctr.marks, false)
+		}
+		if ctr.marks[v-1] {
+			return 0
+		}
+		ctr.marks[v-1] = true
+		return 1
+	}
+	var c int64 // number of the matched tuples of right input
+
+	if v > 0 {
+		if c = ctr.cnts[v-1]; c > z {
+			c = z
+		}
+		ctr.cnts[v-1] -= c
+	}
+	if n.Typ == Intersect {
+		return c
+	}
+	return z - c
+}
+
+// result returns the selected rows of current batch, and the rows are
+// appended to the whole result if the result is sent as one batch.
+func (ctr *Container) result(n *Argument, vecs []*vector.Vector, flags []uint8, proc *process.Process) (*batch.Batch, error) {
+	if len(ctr.zs) == 0 {
+		return &batch.Batch{}, nil
+	}
+	rbat := ctr.bat
+	if rbat == nil {
+		rbat = batch.New(true, n.Attrs)
+		for j := range rbat.Vecs {
+			rbat.Vecs[j] = vector.New(n.Types[j])
+		}
+	}
+	for j, vec := range vecs {
+		if err := vector.UnionBatch(rbat.Vecs[j], vec, 0, len(ctr.zs), flags, proc.Mp); err != nil {
+			ctr.bat = nil
+			batch.Clean(rbat, proc.Mp)
+			return nil, err
+		}
+		rbat.Vecs[j].Ref = 1
+	}
+	rbat.Zs = append(rbat.Zs, ctr.zs...)
+	if n.Collect {
+		ctr.bat = rbat
+		return &batch.Batch{}, nil
+	}
+	return rbat, nil
+}
+
+// fillKeys encodes the tuples of rows [i, i + m) as the keys of hash table,
+// and a key is padded to 16 bytes at least.
+func (ctr *Container) fillKeys(vecs []*vector.Vector, i, m int) error {
+	var err error
+	var offs [UnitLimit + 1]int
+
+	ctr.key = ctr.key[:0]
+	for k := 0; k < m; k++ {
+		for _, vec := range vecs {
+			if ctr.key, err = vector.AppendKey(ctr.key, vec, int64(i+k)); err != nil {
+				return err
+			}
+		}
+		for len(ctr.key)-offs[k] < len(hashtable.StrKeyPadding) {
+			ctr.key = append(ctr.key, 0)
+		}
+		offs[k+1] = len(ctr.key)
+	}
+	for k := 0; k < m; k++ {
+		ctr.keys[k] = ctr.key[offs[k]:offs[k+1]]
+	}
+	return nil
+}
+
+// eval returns the result attributes of a batch of input
+func eval(es []extend.Extend, bat *batch.Batch, proc *process.Process) ([]*vector.Vector, error) {
+	var err error
+
+	vecs := make([]*vector.Vector, len(es))
+	for i, e := range es {
+		if vecs[i], _, err = e.Eval(bat, proc); err != nil {
+			clean(vecs[:i], bat, proc)
+			return nil, err
+		}
+		if n := len(bat.Zs); vector.Length(vecs[i]) < n { // constant
+			if vecs[i], err = expand(vecs[i], n, bat, proc); err != nil {
+				clean(vecs[:i], bat, proc)
+				return nil, err
+			}
+		}
+	}
+	return vecs, nil
+}
+
+// expand returns the vector whose rows are all the constant of vec
+func expand(vec *vector.Vector, n int, bat *batch.Batch, proc *process.Process) (*vector.Vector, error) {
+	defer clean([]*vector.Vector{vec}, bat, proc)
+	rvec := vector.New(vec.Typ)
+	for i := 0; i < n; i++ {
+		if err := vector.UnionOne(rvec, vec, 0, proc.Mp); err != nil {
+			vector.Clean(rvec, proc.Mp)
+			return nil, err
+		}
+	}
+	return rvec, nil
+}
+
+// clean frees the vectors which are computed by eval
+func clean(vecs []*vector.Vector, bat *batch.Batch, proc *process.Process) {
+	for _, vec := range vecs {
+		found := false
+		for _, v := range bat.Vecs {
+			if v == vec {
+				found = true
+				break
+			}
+		}
+		if !found {
+			vector.Clean(vec, proc.Mp)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+)
+
+// kinds of window function
+const (
+	RowNumber = iota
+	Rank
+	DenseRank
+	Lag
+	Lead
+	Aggregate // aggregation function over a frame, such as sum(x) over (...)
+)
+
+// units of frame
+const (
+	Rows = iota
+	Range
+)
+
+// types of frame bound
+const (
+	UnboundedPreceding = iota
+	Preceding
+	CurrentRow
+	Following
+	UnboundedFollowing
+)
+
+type Bound struct {
+	Type int
+	N    int64 // offset of Preceding and Following
+}
+
+type Frame struct {
+	Type  int
+	Start Bound
+	End   Bound
+}
+
+type Function struct {
+	Kind int
+	Op   int    // opcode of aggregation function, only used by Aggregate
+	N    int64  // offset of lag and lead
+	Ref  uint64 // reference count of result
+	Attr string // name of argument, empty for the ranking functions and count(*)
+	Name string // name of result
+	// Partitions is the list of attributes of partition by
+	Partitions []string
+	// Fs is the list of attributes of order by
+	Fs    []order.Field
+	Frame Frame
+}
+
+type Container struct {
+	bat *batch.Batch // all rows of the input
+}
+
+type Argument struct {
+	Fs  []Function
+	ctr *Container
+}
+
+var kindName = [...]string{
+	RowNumber: "row_number",
+	Rank:      "rank",
+	DenseRank: "dense_rank",
+	Lag:       "lag",
+	Lead:      "lead",
+	Aggregate: "aggregate",
+}
+
+var boundName = [...]string{
+	UnboundedPreceding: "unbounded preceding",
+	Preceding:          "preceding",
+	CurrentRow:         "current row",
+	Following:          "following",
+	UnboundedFollowing: "unbounded following",
+}
+
+func (b Bound) String() string {
+	if b.Type == Preceding || b.Type == Following {
+		return fmt.Sprintf("%v %s", b.N, boundName[b.Type])
+	}
+	return boundName[b.Type]
+}
+
+func (f Frame) String() string {
+	if f.Type == Rows {
+		return fmt.Sprintf("rows between %s and %s", f.Start, f.End)
+	}
+	return fmt.Sprintf("range between %s and %s", f.Start, f.End)
+}
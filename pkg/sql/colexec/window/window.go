@@ -0,0 +1,409 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/partition"
+	"github.com/matrixorigin/matrixone/pkg/sort"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString("ω([")
+	for i, f := range n.Fs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("%s <- ", f.Name))
+		if f.Kind == Aggregate {
+			buf.WriteString(fmt.Sprintf("%s(%s)", transformer.TransformerNames[f.Op], f.Attr))
+		} else {
+			buf.WriteString(fmt.Sprintf("%s(%s)", kindName[f.Kind], f.Attr))
+		}
+		buf.WriteString(fmt.Sprintf(" over (partition by %v order by %v %s)", f.Partitions, f.Fs, f.Frame))
+	}
+	buf.WriteString("])")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(Container)
+	return nil
+}
+
+// Call collects all the batches, and the window functions are evaluated
+// once the input is over because a partition may span many batches.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	if bat := proc.Reg.InputBatch; bat != nil {
+		if len(bat.Zs) == 0 {
+			return false, nil
+		}
+		if err := n.ctr.fill(bat, proc); err != nil {
+			batch.Clean(bat, proc.Mp)
+			proc.Reg.InputBatch = &batch.Batch{}
+			return false, err
+		}
+		batch.Clean(bat, proc.Mp)
+		proc.Reg.InputBatch = &batch.Batch{}
+		return false, nil
+	}
+	if n.ctr.bat == nil {
+		return false, nil
+	}
+	bat := n.ctr.bat
+	n.ctr.bat = nil
+	for _, f := range n.Fs {
+		vec, err := eval(f, bat, proc)
+		if err != nil {
+			batch.Clean(bat, proc.Mp)
+			proc.Reg.InputBatch = &batch.Batch{}
+			return false, err
+		}
+		vec.Ref = f.Ref
+		bat.Attrs = append(bat.Attrs, f.Name)
+		bat.Vecs = append(bat.Vecs, vec)
+	}
+	proc.Reg.InputBatch = bat
+	return false, nil
+}
+
+// fill appends the rows of bat to the container, and a row which
+// appears z times is appended z times.
+func (ctr *Container) fill(bat *batch.Batch, proc *process.Process) error {
+	if len(bat.Sels) > 0 {
+		batch.Shuffle(bat, proc.Mp)
+	}
+	if ctr.bat == nil {
+		ctr.bat = batch.New(true, bat.Attrs)
+		for i, vec := range bat.Vecs {
+			ctr.bat.Vecs[i] = vector.New(vec.Typ)
+			ctr.bat.Vecs[i].Ref = vec.Ref
+		}
+	}
+	flg := true
+	for _, z := range bat.Zs {
+		if z != 1 {
+			flg = false
+			break
+		}
+	}
+	if flg {
+		flags := make([]uint8, len(bat.Zs))
+		for i := range flags {
+			flags[i] = 1
+		}
+		for i, attr := range ctr.bat.Attrs {
+			if err := vector.UnionBatch(ctr.bat.Vecs[i], batch.GetVector(bat, attr), 0, len(flags), flags, proc.Mp); err != nil {
+				return err
+			}
+		}
+		ctr.bat.Zs = append(ctr.bat.Zs, bat.Zs...)
+		return nil
+	}
+	for i, attr := range ctr.bat.Attrs {
+		vec := batch.GetVector(bat, attr)
+		for j, z := range bat.Zs {
+			for k := int64(0); k < z; k++ {
+				if err := vector.UnionOne(ctr.bat.Vecs[i], vec, int64(j), proc.Mp); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for _, z := range bat.Zs {
+		for k := int64(0); k < z; k++ {
+			ctr.bat.Zs = append(ctr.bat.Zs, 1)
+		}
+	}
+	return nil
+}
+
+// eval sorts the rows by the partition and the order of f, and returns the result of f
+// which is in the original order of rows.
+func eval(f Function, bat *batch.Batch, proc *process.Process) (*vector.Vector, error) {
+	n := len(bat.Zs)
+	sels := make([]int64, n)
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+	pvecs := make([]*vector.Vector, len(f.Partitions))
+	for i, attr := range f.Partitions {
+		pvecs[i] = batch.GetVector(bat, attr)
+	}
+	ovecs := make([]*vector.Vector, len(f.Fs))
+	for i, fd := range f.Fs {
+		ovecs[i] = batch.GetVector(bat, fd.Attr)
+	}
+	{
+		vecs := append(append([]*vector.Vector{}, pvecs...), ovecs...)
+		ds := make([]bool, len(vecs))
+		for i, fd := range f.Fs {
+			ds[len(pvecs)+i] = fd.Type == order.Descending
+		}
+		sortSels(sels, vecs, ds)
+	}
+	// ps is the list of start of partitions, and peers is the list of start of peers
+	// which are the rows in the same partition with the same value of order by
+	diffs := make([]bool, n)
+	ps := []int64{0}
+	for _, vec := range pvecs {
+		ps = partition.Partition(sels, diffs, ps, vec)
+	}
+	peers := append([]int64{}, ps...)
+	for _, vec := range ovecs {
+		peers = partition.Partition(sels, diffs, peers, vec)
+	}
+	switch f.Kind {
+	case RowNumber, Rank, DenseRank:
+		return evalRank(f.Kind, sels, ps, peers, proc)
+	case Lag:
+		return evalOffset(-f.N, sels, ps, batch.GetVector(bat, f.Attr), proc)
+	case Lead:
+		return evalOffset(f.N, sels, ps, batch.GetVector(bat, f.Attr), proc)
+	}
+	vec := bat.Vecs[0]
+	if len(f.Attr) > 0 {
+		vec = batch.GetVector(bat, f.Attr)
+	}
+	return evalAggregate(f, sels, ps, peers, vec, proc)
+}
+
+// sortSels sorts the sels by vecs one by one, the rows with the same
+// value of vecs[i] are sorted by vecs[i+1].
+func sortSels(sels []int64, vecs []*vector.Vector, ds []bool) {
+	if len(vecs) == 0 {
+		return
+	}
+	sort.Sort(ds[0], sels, vecs[0])
+	ps := make([]int64, 0, 16)
+	diffs := make([]bool, len(sels))
+	for i := 1; i < len(vecs); i++ {
+		ps = partition.Partition(sels, diffs, ps, vecs[i-1])
+		for j, k := 0, len(ps); j < k; j++ {
+			if j == k-1 {
+				sort.Sort(ds[i], sels[ps[j]:], vecs[i])
+			} else {
+				sort.Sort(ds[i], sels[ps[j]:ps[j+1]], vecs[i])
+			}
+		}
+	}
+}
+
+func evalRank(kind int, sels, ps, peers []int64, proc *process.Process) (*vector.Vector, error) {
+	n := len(sels)
+	data, err := mheap.Alloc(proc.Mp, int64(n*8))
+	if err != nil {
+		return nil, err
+	}
+	vs := encoding.DecodeInt64Slice(data)[:n]
+	for i, j := 0, 0; i < len(ps); i++ {
+		start, end := bounds(ps, i, n)
+		var rank, denseRank int64
+		for k := start; k < end; k++ {
+			if j < len(peers) && peers[j] == k {
+				rank = k - start + 1
+				denseRank++
+				j++
+			}
+			switch kind {
+			case RowNumber:
+				vs[sels[k]] = k - start + 1
+			case Rank:
+				vs[sels[k]] = rank
+			case DenseRank:
+				vs[sels[k]] = denseRank
+			}
+		}
+	}
+	return &vector.Vector{
+		Nsp:  new(nulls.Nulls),
+		Data: data,
+		Col:  vs,
+		Typ:  types.Type{Oid: types.T_int64, Size: 8},
+	}, nil
+}
+
+// evalOffset returns the value of the row which is off rows behind the current row
+// in the same partition, and null if there is no such row.
+func evalOffset(off int64, sels, ps []int64, vec *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	n := len(sels)
+	srcs := make([]int64, n)
+	for i := 0; i < len(ps); i++ {
+		start, end := bounds(ps, i, n)
+		for k := start; k < end; k++ {
+			if j := k + off; j >= start && j < end {
+				srcs[sels[k]] = sels[j]
+			} else {
+				srcs[sels[k]] = -1
+			}
+		}
+	}
+	rvec := vector.New(vec.Typ)
+	for i, src := range srcs {
+		if src < 0 {
+			if err := vector.UnionOne(rvec, vec, 0, proc.Mp); err != nil {
+				vector.Clean(rvec, proc.Mp)
+				return nil, err
+			}
+			nulls.Add(rvec.Nsp, uint64(i))
+			continue
+		}
+		if err := vector.UnionOne(rvec, vec, src, proc.Mp); err != nil {
+			vector.Clean(rvec, proc.Mp)
+			return nil, err
+		}
+	}
+	return rvec, nil
+}
+
+// evalAggregate aggregates the rows of the frame of each row. If the start (end) of
+// the frame is unbounded, the frame of a row only grows (shrinks) from the frame of
+// the previous row, so the rows are aggregated once into an accumulator.
+func evalAggregate(f Function, sels, ps, peers []int64, vec *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	n := len(sels)
+	r, err := transformer.New(f.Op, vec.Typ)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Grows(n, proc.Mp); err != nil {
+		r.Free(proc.Mp)
+		return nil, err
+	}
+	acc := r.Dup()
+	defer acc.Free(proc.Mp)
+	zs := make([]int64, n)
+	for i, j := 0, 0; i < len(ps); i++ {
+		start, end := bounds(ps, i, n)
+		// pstarts[k-start] and pends[k-start] are the start and end of the peers of row k
+		pstarts := make([]int64, end-start)
+		pends := make([]int64, end-start)
+		for k := start; k < end; {
+			pend := end
+			if j+1 < len(peers) && peers[j+1] < end {
+				pend = peers[j+1]
+			}
+			for ; k < pend; k++ {
+				pstarts[k-start], pends[k-start] = peers[j], pend
+			}
+			j++
+		}
+		frame := func(k int64) (int64, int64) {
+			return frameStart(f.Frame, k, start, pstarts[k-start]), frameEnd(f.Frame, k, end, pends[k-start])
+		}
+		switch {
+		case f.Frame.Start.Type == UnboundedPreceding:
+			if err := acc.Grow(proc.Mp); err != nil {
+				r.Free(proc.Mp)
+				return nil, err
+			}
+			g, next := int64(acc.Count()-1), start
+			for k := start; k < end; k++ {
+				_, hi := frame(k)
+				for ; next <= hi; next++ {
+					acc.Fill(g, sels[next], 1, vec)
+				}
+				r.Add(acc, sels[k], g)
+				zs[sels[k]] = next - start
+			}
+		case f.Frame.End.Type == UnboundedFollowing:
+			if err := acc.Grow(proc.Mp); err != nil {
+				r.Free(proc.Mp)
+				return nil, err
+			}
+			g, next := int64(acc.Count()-1), end-1
+			for k := end - 1; k >= start; k-- {
+				lo, _ := frame(k)
+				for ; next >= lo; next-- {
+					acc.Fill(g, sels[next], 1, vec)
+				}
+				r.Add(acc, sels[k], g)
+				zs[sels[k]] = end - 1 - next
+			}
+		default:
+			for k := start; k < end; k++ {
+				lo, hi := frame(k)
+				for m := lo; m <= hi; m++ {
+					r.Fill(sels[k], sels[m], 1, vec)
+				}
+				if hi >= lo {
+					zs[sels[k]] = hi - lo + 1
+				}
+			}
+		}
+	}
+	return ring.Eval(r, zs, proc.Mp)
+}
+
+// frameStart returns the first row of the frame of row k, the frame is limited
+// to the partition [start, end), and pstart is the first peer of row k.
+func frameStart(fr Frame, k, start, pstart int64) int64 {
+	switch fr.Start.Type {
+	case UnboundedPreceding:
+		return start
+	case Preceding:
+		if k-fr.Start.N < start {
+			return start
+		}
+		return k - fr.Start.N
+	case Following:
+		return k + fr.Start.N
+	}
+	if fr.Type == Range {
+		return pstart
+	}
+	return k
+}
+
+// frameEnd returns the last row of the frame of row k, the frame is limited
+// to the partition [start, end), and pend-1 is the last peer of row k.
+func frameEnd(fr Frame, k, end, pend int64) int64 {
+	switch fr.End.Type {
+	case UnboundedFollowing:
+		return end - 1
+	case Following:
+		if k+fr.End.N >= end {
+			return end - 1
+		}
+		return k + fr.End.N
+	case Preceding:
+		return k - fr.End.N
+	}
+	if fr.Type == Range {
+		return pend - 1
+	}
+	return k
+}
+
+// bounds returns the start and end of the i-th partition
+func bounds(ps []int64, i, n int) (int64, int64) {
+	if i == len(ps)-1 {
+		return ps[i], int64(n)
+	}
+	return ps[i], ps[i+1]
+}
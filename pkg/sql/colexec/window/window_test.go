@@ -0,0 +1,135 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrame(t *testing.T) {
+	rows := func(start, end Bound) Frame { return Frame{Type: Rows, Start: start, End: end} }
+	kases := []struct {
+		fr     Frame
+		k      int64
+		lo, hi int64
+		why    string
+	}{
+		{rows(Bound{Type: UnboundedPreceding}, Bound{Type: CurrentRow}), 3, 2, 3, "from the start of partition"},
+		{rows(Bound{Type: Preceding, N: 1}, Bound{Type: CurrentRow}), 2, 2, 2, "clipped by the start of partition"},
+		{rows(Bound{Type: Preceding, N: 1}, Bound{Type: Following, N: 1}), 4, 3, 5, "inside of partition"},
+		{rows(Bound{Type: CurrentRow}, Bound{Type: Following, N: 2}), 5, 5, 5, "clipped by the end of partition"},
+		{rows(Bound{Type: CurrentRow}, Bound{Type: UnboundedFollowing}), 3, 3, 5, "to the end of partition"},
+		{rows(Bound{Type: Following, N: 1}, Bound{Type: Following, N: 1}), 5, 6, 5, "empty frame"},
+		{Frame{Type: Range, Start: Bound{Type: UnboundedPreceding}, End: Bound{Type: CurrentRow}}, 3, 2, 4, "to the last peer"},
+		{Frame{Type: Range, Start: Bound{Type: CurrentRow}, End: Bound{Type: UnboundedFollowing}}, 4, 3, 5, "from the first peer"},
+	}
+	// the partition is [2, 6), and the peers of rows 3 and 4 are [3, 5)
+	for _, kase := range kases {
+		lo, hi := frameStart(kase.fr, kase.k, 2, 3), frameEnd(kase.fr, kase.k, 6, 5)
+		require.Equal(t, [2]int64{kase.lo, kase.hi}, [2]int64{lo, hi}, kase.why)
+	}
+}
+
+func TestCall(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<20, host.New(1<<20))))
+	partitions := []string{"g"}
+	fs := []order.Field{{Attr: "v", Type: order.Ascending}}
+	arg := &Argument{Fs: []Function{
+		{Kind: RowNumber, Name: "rn", Partitions: partitions, Fs: fs},
+		{Kind: Rank, Name: "r", Partitions: partitions, Fs: fs},
+		{Kind: DenseRank, Name: "dr", Partitions: partitions, Fs: fs},
+		{Kind: Lag, N: 1, Attr: "v", Name: "lag", Partitions: partitions, Fs: fs},
+		{Kind: Aggregate, Op: transformer.Sum, Attr: "v", Name: "s", Partitions: partitions, Fs: fs,
+			Frame: Frame{Type: Rows, Start: Bound{Type: Preceding, N: 1}, End: Bound{Type: CurrentRow}}},
+		{Kind: Aggregate, Op: transformer.Sum, Attr: "v", Name: "rs", Partitions: partitions, Fs: fs,
+			Frame: Frame{Type: Range, Start: Bound{Type: UnboundedPreceding}, End: Bound{Type: CurrentRow}}},
+	}}
+	require.NoError(t, Prepare(proc, arg))
+	// the rows of a partition span two batches, and the last row appears twice
+	for _, rows := range [][][2]int64{
+		{{1, 20}, {2, 5}, {1, 10}},
+		{{1, 20}, {2, 7}, {1, 30}},
+	} {
+		proc.Reg.InputBatch = newBatch(t, rows, proc)
+		_, err := Call(proc, arg)
+		require.NoError(t, err)
+	}
+	proc.Reg.InputBatch = newBatch(t, [][2]int64{{2, 7}}, proc)
+	proc.Reg.InputBatch.Zs[0] = 2
+	_, err := Call(proc, arg)
+	require.NoError(t, err)
+	proc.Reg.InputBatch = nil
+	_, err = Call(proc, arg)
+	require.NoError(t, err)
+
+	bat := proc.Reg.InputBatch
+	var rows []string
+	for i := range bat.Zs {
+		row := ""
+		for _, vec := range bat.Vecs {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				row += " null"
+				continue
+			}
+			row += fmt.Sprintf(" %v", vec.Col.([]int64)[i])
+		}
+		rows = append(rows, row)
+	}
+	// the peers are in any order, so are the rows
+	sort.Strings(rows)
+	// g v rn r dr lag s rs
+	require.Equal(t, []string{
+		" 1 10 1 1 1 null 10 10",
+		" 1 20 2 2 2 10 30 50",
+		" 1 20 3 2 2 20 40 50",
+		" 1 30 4 4 3 20 50 80",
+		" 2 5 1 1 1 null 5 5",
+		" 2 7 2 2 2 5 12 26",
+		" 2 7 3 2 2 7 14 26",
+		" 2 7 4 2 2 7 14 26",
+	}, rows)
+}
+
+// newBatch returns a batch of rows whose columns are g and v
+func newBatch(t *testing.T, rows [][2]int64, proc *process.Process) *batch.Batch {
+	bat := batch.New(true, []string{"g", "v"})
+	for i := range bat.Vecs {
+		vs := make([]int64, len(rows))
+		for j, row := range rows {
+			vs[j] = row[i]
+		}
+		bat.Vecs[i] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		require.NoError(t, vector.Append(bat.Vecs[i], vs))
+	}
+	bat.Zs = make([]int64, len(rows))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat
+}
@@ -15,11 +15,15 @@
 package compile
 
 import (
+	"time"
+
 	_ "github.com/matrixorigin/matrixone/pkg/builtin/binary"
 	_ "github.com/matrixorigin/matrixone/pkg/builtin/multi"
 	_ "github.com/matrixorigin/matrixone/pkg/builtin/unary"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -35,13 +39,39 @@ func New(db string, sql string, uid string,
 		db:   db,
 		uid:  uid,
 		sql:  sql,
+		loc:  process.TimeZone(proc),
 		proc: proc,
 	}
 }
 
+// NewWithParams returns a compile which binds the params to the placeholders of the sql.
+func NewWithParams(db string, sql string, params []tree.Expr, uid string,
+	e engine.Engine, proc *process.Process) *compile {
+	c := New(db, sql, uid, e, proc)
+	c.params = params
+	return c
+}
+
+// SetPrivileges restricts the statements of the sql to the privileges of the user.
+func (c *compile) SetPrivileges(privs *privilege.Privileges) {
+	c.privs = privs
+}
+
+// SetTimeZone sets the session time zone in which timestamps are converted.
+func (c *compile) SetTimeZone(loc *time.Location) {
+	c.loc = loc
+}
+
 // Build generates query execution list based on the result of sql parser.
 func (c *compile) Build() ([]*Exec, error) {
-	stmts, err := parsers.Parse(dialect.MYSQL, c.sql)
+	var stmts []tree.Statement
+	var err error
+
+	if c.params != nil {
+		stmts, err = parsers.ParseWithParams(dialect.MYSQL, c.sql, c.params)
+	} else {
+		stmts, err = parsers.Parse(dialect.MYSQL, c.sql)
+	}
 	if err != nil {
 		return nil, err
 	}
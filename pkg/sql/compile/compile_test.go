@@ -15,15 +15,30 @@
 package compile
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"go/constant"
 	"log"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -59,17 +74,1121 @@ var querys = []string{
 	"select userID,MAX(score) from t1 where userID not between 2 and 3 group by userID order by userID desc;",
 	"select sum(score) as sum from t1 where spID=6 group by score order by sum desc;",
 	"select userID,MAX(score) max_score from t1 where userID <2 || userID > 3 group by userID order by max_score;",
+	"select count(distinct userID), sum(distinct score) from t1;",
+	"select userID, count(distinct spID), avg(distinct score) from t1 group by userID;",
+	"select userID % 2, count(*) from t1 group by userID % 2;",
+	"select userID from t1 group by userID having max(score) > 1 and count(*) > 1;",
+	"explain select R.uid, S.price from R left join S on R.uid = S.uid where S.price > 3;",
+	"select R.uid % 2, count(distinct S.price) from R join S on R.uid = S.uid group by R.uid % 2 having sum(S.price) > 1;",
+	"select R.uid, R.price from R where R.uid in (select uid from S where price > 10);",
+	"select count(*) from R where uid not in (select uid from S);",
+	"select orderId from R where exists (select * from S where S.uid = R.uid and S.price > R.price);",
+	"select orderId from R where not exists (select * from S where S.uid = R.uid);",
+	"select R.uid, (select max(price) from S where S.uid = R.uid) as max_price from R;",
+	"select * from R where price > (select avg(price) from S);",
+	"explain select uid from R where uid not in (select uid from S where S.price > R.price);",
+	"select R.uid, max(S.price) over (partition by R.uid order by S.price rows between current row and unbounded following) from R join S on R.uid = S.uid;",
+	"explain select uid, avg(price) over (order by uid) from R;",
+	"select uid, 'a' from R union select uid, 'b' from S;",
+	"explain select uid from R union select uid from S order by uid;",
+	"create table dec1 (a decimal(10, 2), b decimal(20, 3), c int);",
+	"insert into dec1 values (12.345, 1.5, 1), (-3.1, '100.001', 2), (7, 0.25, 1), (null, 2, 2);",
+	"show columns from dec1;",
+	"select a + b, a - b, a * b, a / b, -a, a + c, a * 2 from dec1;",
+	"select * from dec1 where a > b and a <> 7 order by b desc;",
+	"select c, sum(a), avg(b), max(b), min(a) from dec1 group by c order by c;",
+	"select cast(a as signed), cast(b as double), cast(c as decimal(5, 1)) from dec1 where a < 10.5;",
+	"drop table dec1;",
 }
 
 func TestCompile(t *testing.T) {
+	e, proc := newTestEngine()
+	for _, query := range querys {
+		processQuery(query, e, proc)
+	}
+}
+
+func TestCompileUpdateDelete(t *testing.T) {
+	e, proc := newTestEngine()
+
+	kases := []struct {
+		query string
+		rows  uint64 // affected rows
+		check rowsKase
+	}{
+		{"update t1 set score = score + 1 where userID = 2;", 1,
+			rowsKase{"select spID, score from t1 where userID = 2;", []string{"2,3"}}},
+		{"update t1 set spID = 10, score = 1 where userID < 2;", 3,
+			rowsKase{"select spID, userID, score from t1 where userID < 3;", []string{"10,1,1", "2,2,3", "10,1,1", "10,1,1"}}},
+		{"update t1 set score = 0 where userID > 100;", 0,
+			rowsKase{"select * from t1 where score = 0;", nil}},
+		{"delete from t1 where userID = 3;", 1,
+			rowsKase{"select count(*), sum(userID) from t1;", []string{"6,22"}}},
+		{"delete from t1 where userID = 3;", 0,
+			rowsKase{"select count(*) from t1;", []string{"6"}}},
+		{"delete from t1;", 6,
+			rowsKase{"select * from t1;", nil}},
+		{"create table dec1 (a decimal(10, 2), c int);", 0, rowsKase{}},
+		{"insert into dec1 values (12.345, 1), (-3.1, 2), (7, 1);", 3, rowsKase{}},
+		{"update dec1 set a = a * 2 where c = 1;", 2,
+			rowsKase{"select a from dec1 order by a;", []string{"-3.10", "14.00", "24.70"}}},
+	}
+	for _, kase := range kases {
+		if rows := execRows(t, kase.query, e, proc); rows != kase.rows {
+			t.Errorf("%s: expected %v affected rows, got %v", kase.query, kase.rows, rows)
+		}
+		if len(kase.check.query) > 0 {
+			checkRows(t, []rowsKase{kase.check}, true, e, proc)
+		}
+	}
+}
+
+func TestCompileExplain(t *testing.T) {
+	e, proc := newTestEngine()
+
+	// the time of operators differs from run to run
+	times := regexp.MustCompile(`, time: [^)]*\)`)
+	kases := []struct {
+		query string
+		lines []string
+	}{
+		{"explain select userID, MIN(score) FROM t1 GROUP BY userID;", []string{
+			"result attributes: [userID:INT:0 min(score):TINYINT:0]",
+			"'min(score)' = 1 -> min(score)",
+			"∐ ([userID])",
+			"source: test.t1 [userID score]",
+			"∏([userID], [min(score) <- min(score)])",
+		}},
+		{"explain analyze select userID, MAX(score) from t1 where userID > 1 group by userID order by userID;", []string{
+			"userID > 1",
+			"∐ ([userID]) (rows: 4, batches: 1)",
+			"τ([userID]) (rows: 4, batches: 1)",
+			"sql output (rows: 4, batches: 1)",
+			"source: test.t1 [userID score] (rows: 7, batches: 2)",
+			"filter: userID > 1",
+			"σ(userID > 1) -> ∏([userID], [max(score) <- max(score)]) (rows: 4, batches: 1)",
+		}},
+		{"explain analyze select * from t1 where userID < 3 limit 2;", []string{
+			"Limit 2",
+			"mergeLimit(2) (rows: 2, batches: 1)",
+			"σ(userID < 3) -> ∏([], []) (rows: 4, batches: 1)",
+			"limit(2) (rows: 2, batches: 1)",
+		}},
+		{"explain update t1 set score = score + 1 where userID = 2;", []string{
+			"update t1 set score = cast(score + 1 as TINYINT)",
+			"Update",
+			"filter: userID = 2",
+		}},
+		{"explain analyze select sum(lo_revenue) as revenue from lineorder join dates on lo_orderdate = d_datekey where d_year = 1993;", []string{
+			"π(sum(lo_revenue) -> revenue:1) (rows: 0, batches: 0)",
+			"source: test.lineorder [lo_orderdate lo_revenue] (rows: 0, batches: 0)",
+			"σ(d_year = 1993) -> ∏([d_datekey], []) (rows: 0, batches: 0)",
+		}},
+	}
+	for _, kase := range kases {
+		lines := make(map[string]struct{})
+		for _, row := range queryRows(t, kase.query, e, proc) {
+			lines[times.ReplaceAllString(strings.TrimSpace(row), ")")] = struct{}{}
+		}
+		for _, line := range kase.lines {
+			if _, ok := lines[line]; !ok {
+				t.Errorf("%s: line %q not found", kase.query, line)
+			}
+		}
+	}
+	// the update is only explained
+	checkRows(t, []rowsKase{{"select score from t1 where userID = 2;", []string{"2"}}}, false, e, proc)
+
+	// only the queries are analyzed, the writes are not run
+	for _, query := range []string{
+		"explain analyze delete from t1 where userID = 2;",
+		"explain analyze update t1 set score = 0;",
+		"explain analyze insert into t1 values (2, 2, 2);",
+	} {
+		es, err := New("test", query, "", e, proc).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := es[0].Compile(nil, sqlOutput); err == nil {
+			t.Errorf("%s: not rejected", query)
+		}
+	}
+	checkRows(t, []rowsKase{{"select count(*) from t1 where userID = 2;", []string{"1"}}}, false, e, proc)
+}
+
+func TestCompileJoins(t *testing.T) {
+	e, proc := newTestEngine()
+
+	processQuery("create table jl (a int, b int);", e, proc)
+	processQuery("create table jr (a int, c int);", e, proc)
+	processQuery("insert into jl values (1, 10), (2, 20), (3, 30), (null, 40);", e, proc)
+	processQuery("insert into jr values (2, 200), (3, 300), (3, 301), (4, 400), (null, 500);", e, proc)
+	kases := []rowsKase{
+		{"select jl.a, jl.b, jr.a, jr.c from jl left join jr on jl.a = jr.a;",
+			[]string{"2,20,2,200", "3,30,3,300", "3,30,3,301", "1,10,null,null", "null,40,null,null"}},
+		{"select jl.a, jl.b, jr.a, jr.c from jl right join jr on jl.a = jr.a;",
+			[]string{"2,20,2,200", "3,30,3,300", "3,30,3,301", "null,null,4,400", "null,null,null,500"}},
+		{"select jl.a, jr.c from jl left join jr on jl.a = jr.a and jr.c > 300;",
+			[]string{"3,301", "1,null", "2,null", "null,null"}},
+		{"select jl.a, jr.c from jl left join jr on jl.a = jr.a where jr.c is null;",
+			[]string{"1,null", "null,null"}},
+		{"select jl.b, count(jr.c) from jl left join jr on jl.a = jr.a group by jl.b;",
+			[]string{"10,0", "20,1", "30,2", "40,0"}},
+		{"select jl.a, jr.a from jl join jr on jl.a < jr.a;",
+			[]string{"1,2", "1,3", "1,3", "1,4", "2,3", "2,3", "2,4", "3,4"}},
+		{"select jl.a, jr.a from jl left join jr on jl.a > jr.a;",
+			[]string{"3,2", "1,null", "2,null", "null,null"}},
+		{"select jl.a, jr.a from jl right join jr on jl.a + 1 = jr.a;",
+			[]string{"1,2", "2,3", "2,3", "3,4", "null,null"}},
+		{"select count(*) from jl, jr where jl.a < jr.a;", []string{"8"}},
+		// the unmatched rows of both sides are padded with nulls
+		{"select jl.a, jl.b, jr.a, jr.c from jl full outer join jr on jl.a = jr.a;",
+			[]string{"2,20,2,200", "3,30,3,300", "3,30,3,301", "1,10,null,null", "null,40,null,null", "null,null,4,400", "null,null,null,500"}},
+		{"select jl.a, jr.c from jl full join jr on jl.a = jr.a and jr.c > 300;",
+			[]string{"3,301", "1,null", "2,null", "null,null", "null,200", "null,300", "null,400", "null,500"}},
+		{"select jl.b, jr.a from jl full join jr on jl.a = jr.a where jl.a is null;",
+			[]string{"40,null", "null,4", "null,null"}},
+		{"select count(*), count(jl.a), count(jr.a) from jl full join jr on jl.a = jr.a;", []string{"7,4,4"}},
+		{"select jl.a, jr.a from jl full join jr on jl.a > jr.a;",
+			[]string{"3,2", "1,null", "2,null", "null,null", "null,3", "null,3", "null,4", "null,null"}},
+	}
+	checkRows(t, kases, true, e, proc)
+}
+
+func TestCompileGroupBy(t *testing.T) {
+	e, proc := newTestEngine()
+
+	processQuery("create table gb (x int, y int);", e, proc)
+	processQuery("create table gj (x int, z int);", e, proc)
+	processQuery("insert into gb values (1, 1), (1, 2), (1, 2), (2, 3), (3, 3), (3, 3), (null, 3), (4, null);", e, proc)
+	processQuery("insert into gj values (1, 10), (3, 30), (3, 31);", e, proc)
+	kases := []rowsKase{
+		// the count(*) of the projection is shared with the having clause
+		{"select x, count(*) from gb group by x having count(*) > 1;", []string{"1,3", "3,2"}},
+		{"select x + 1, count(*) from gb group by x + 1 having count(*) > 1;", []string{"2,3", "4,2"}},
+		{"select count(*), x from gb group by x having count(*) < 2;", []string{"1,2", "1,4", "1,null"}},
+		{"select x from gb group by x having count(*) > 1;", []string{"1", "3"}},
+		// the distinct aggregations ignore the nulls
+		{"select count(distinct x), count(distinct y), sum(distinct y), count(x) from gb;", []string{"4,3,6,7"}},
+		{"select x, count(distinct y), avg(distinct y) from gb group by x;",
+			[]string{"1,2,1.5", "2,1,3", "3,1,3", "4,0,null", "null,1,3"}},
+		// a tuple with a null is not counted
+		{"select count(distinct x, y) from gb;", []string{"4"}},
+		{"select x, count(distinct x, y) from gb group by x;", []string{"1,2", "2,1", "3,1", "4,0", "null,0"}},
+		{"select x % 2, count(*), sum(y) from gb group by x % 2;", []string{"0,2,3", "1,5,11", "null,1,3"}},
+		{"select y * 2, max(x) from gb group by y * 2 having max(x) > 1;", []string{"6,3", "null,4"}},
+		{"select x from gb group by x having sum(y) > 3 and count(distinct y) = 1;", []string{"3"}},
+		{"select gb.x % 2, count(distinct gj.z) from gb join gj on gb.x = gj.x group by gb.x % 2 having sum(gj.z) > 1;",
+			[]string{"1,3"}},
+	}
+	checkRows(t, kases, true, e, proc)
+	processQuery("drop table gb;", e, proc)
+	processQuery("drop table gj;", e, proc)
+}
+
+func TestCompileWindowFunctions(t *testing.T) {
+	e, proc := newTestEngine()
+
+	processQuery("create table w (g int, v int);", e, proc)
+	processQuery("insert into w values (1, 10), (1, 20), (1, 20), (1, 30), (2, 5), (2, 5), (2, 7), (3, 1);", e, proc)
+	kases := []rowsKase{
+		// ties share the rank, and rank skips the ties but dense_rank not
+		{"select g, v, rank() over (partition by g order by v), dense_rank() over (partition by g order by v) from w;",
+			[]string{"1,10,1,1", "1,20,2,2", "1,20,2,2", "1,30,4,3", "2,5,1,1", "2,5,1,1", "2,7,3,2", "3,1,1,1"}},
+		{"select v, rank() over (order by v desc), dense_rank() over (order by v desc) from w;",
+			[]string{"30,1,1", "20,2,2", "20,2,2", "10,4,3", "7,5,4", "5,6,5", "5,6,5", "1,8,6"}},
+		// the numbers restart from 1 in each partition
+		{"select g, v, row_number() over (partition by g order by v) from w;",
+			[]string{"1,10,1", "1,20,2", "1,20,3", "1,30,4", "2,5,1", "2,5,2", "2,7,3", "3,1,1"}},
+		{"select g, v, count(*) over (partition by g), min(v) over (partition by g) from w;",
+			[]string{"1,10,4,10", "1,20,4,10", "1,20,4,10", "1,30,4,10", "2,5,3,5", "2,5,3,5", "2,7,3,5", "3,1,1,1"}},
+		{"select g, v, lag(v) over (partition by g order by v), lead(v, 2) over (partition by g order by v) from w;",
+			[]string{"1,10,null,20", "1,20,10,30", "1,20,20,null", "1,30,20,null", "2,5,null,7", "2,5,5,null", "2,7,5,null", "3,1,null,null"}},
+		// the frames are clipped by the partition
+		{"select g, v, sum(v) over (partition by g order by v rows between 1 preceding and current row) from w;",
+			[]string{"1,10,10", "1,20,30", "1,20,40", "1,30,50", "2,5,5", "2,5,10", "2,7,12", "3,1,1"}},
+		{"select g, v, sum(v) over (partition by g order by v rows between unbounded preceding and current row) from w;",
+			[]string{"1,10,10", "1,20,30", "1,20,50", "1,30,80", "2,5,5", "2,5,10", "2,7,17", "3,1,1"}},
+		{"select g, v, max(v) over (partition by g order by v rows between current row and 1 following) from w;",
+			[]string{"1,10,20", "1,20,20", "1,20,30", "1,30,30", "2,5,5", "2,5,7", "2,7,7", "3,1,1"}},
+		{"select g, v, min(v) over (partition by g order by v rows between current row and unbounded following) from w;",
+			[]string{"1,10,10", "1,20,20", "1,20,20", "1,30,30", "2,5,5", "2,5,5", "2,7,7", "3,1,1"}},
+	}
+	checkRows(t, kases, true, e, proc)
+}
+
+func TestCompileSetOperations(t *testing.T) {
+	e, proc := newTestEngine()
+
+	processQuery("create table sa (a int);", e, proc)
+	processQuery("create table sb (a int);", e, proc)
+	processQuery("insert into sa values (1), (1), (1), (2), (2), (3), (null), (null);", e, proc)
+	processQuery("insert into sb values (1), (1), (2), (4), (null);", e, proc)
+	unordered := []rowsKase{
+		{"select a from sa union select a from sb;", []string{"1", "2", "3", "4", "null"}},
+		{"select a from sa union all select a from sb;",
+			[]string{"1", "1", "1", "1", "1", "2", "2", "2", "3", "4", "null", "null", "null"}},
+		{"select a from sa intersect select a from sb;", []string{"1", "2", "null"}},
+		// a row appears min(m, n) times in the result of intersect all
+		{"select a from sa intersect all select a from sb;", []string{"1", "1", "2", "null"}},
+		{"select a from sa except select a from sb;", []string{"3"}},
+		// a row appears max(m - n, 0) times in the result of except all
+		{"select a from sa except all select a from sb;", []string{"1", "2", "3", "null"}},
+		{"select a from sb except all select a from sa;", []string{"4"}},
+		{"select a from sa except all select a from sb union all select a from sb where a > 1;",
+			[]string{"1", "2", "2", "3", "4", "null"}},
+		{"(select a from sa order by a limit 2) union all (select a from sb order by a desc limit 1);",
+			[]string{"null", "null", "4"}},
+	}
+	checkRows(t, unordered, true, e, proc)
+	ordered := []rowsKase{
+		{"select a from sa union select a from sb order by a limit 2;", []string{"null", "1"}},
+		{"select a from sa union select a from sb order by a limit 2 offset 1;", []string{"1", "2"}},
+		{"select a from sa union all select a from sb order by a desc limit 3 offset 2;", []string{"2", "2", "2"}},
+		{"select a from sa intersect all select a from sb order by a limit 1 offset 1;", []string{"1"}},
+		{"select a from sa except all select a from sb order by a desc limit 10 offset 1;", []string{"2", "1", "null"}},
+	}
+	checkRows(t, ordered, false, e, proc)
+}
+
+func TestCompileDecimalOverflow(t *testing.T) {
+	e, proc := newTestEngine()
+
+	processQuery("create table d5 (a decimal(5, 2), b decimal(38, 0));", e, proc)
+	processQuery("insert into d5 values (999.99, '99999999999999999999999999999999999999'), (-999.99, '-99999999999999999999999999999999999999');", e, proc)
+	// the values exceed the precision of the columns or 38 digits
+	for _, query := range []string{
+		"insert into d5 values (1000, 1);",
+		"insert into d5 values (1, '100000000000000000000000000000000000000');",
+		"update d5 set a = a + 0.01;",
+		"update d5 set a = a * 2 where a < 0;",
+		"select b + 1 from d5;",
+		"select b - 1 from d5;",
+		"select b * 2 from d5;",
+		"select b * b from d5;",
+	} {
+		es, err := New("test", query, "", e, proc).Build()
+		if err == nil {
+			if err = es[0].Compile(nil, sqlOutput); err == nil {
+				err = es[0].Run(0)
+			}
+		}
+		if err != types.ErrDecimalOverflow {
+			t.Errorf("%s: expected %v, got %v", query, types.ErrDecimalOverflow, err)
+		}
+	}
+	kases := []rowsKase{
+		{"select a, b from d5;", []string{"999.99,99999999999999999999999999999999999999", "-999.99,-99999999999999999999999999999999999999"}},
+		// the results of expressions are not limited by the precision of the columns
+		{"select a + a, a * a, b - 0 from d5;", []string{"1999.98,999980.0001,99999999999999999999999999999999999999", "-1999.98,999980.0001,-99999999999999999999999999999999999999"}},
+		{"select sum(a), sum(b) from d5;", []string{"0.00,0"}},
+	}
+	checkRows(t, kases, true, e, proc)
+	// the sums out of the range of 38 digits fail
+	processQuery("insert into d5 values (1, '99999999999999999999999999999999999999'), (2, 1);", e, proc)
+	for _, query := range []string{
+		"select sum(b) from d5 where b > 0;",
+		"select b, sum(b) from d5 where b > 1 group by b;",
+		"select avg(b) from d5 where b > 0;",
+		"select sum(distinct b) from d5 where b > 0;",
+	} {
+		es, err := New("test", query, "", e, proc).Build()
+		if err == nil {
+			if err = es[0].Compile(nil, sqlOutput); err == nil {
+				err = es[0].Run(0)
+			}
+		}
+		if err != types.ErrDecimalOverflow {
+			t.Errorf("%s: expected %v, got %v", query, types.ErrDecimalOverflow, err)
+		}
+	}
+}
+
+func TestCompileWithParams(t *testing.T) {
+	e, proc := newTestEngine()
+	params := []tree.Expr{
+		tree.NewNumVal(constant.MakeInt64(2), "2", false),
+		tree.NewUnaryExpr(tree.UNARY_MINUS, tree.NewNumVal(constant.MakeInt64(1), "1", false)),
+	}
+
+	processQuery("create table prep1 (a int, b int);", e, proc)
+	for _, query := range []string{
+		"insert into prep1 values (?, ?), (3, 4);",
+		"select a, b from prep1 where a = ? or b = ?;",
+		"update prep1 set b = ? where a > ?;",
+	} {
+		c := NewWithParams("test", query, params, "", e, proc)
+		es, err := c.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range es {
+			if err := e.Compile(nil, sqlOutput); err != nil {
+				t.Fatal(err)
+			}
+			if err := e.Run(0); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// the placeholder without param can not be executed
+	es, err := New("test", "select a from prep1 where a = ?;", "", e, proc).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := es[0].Compile(nil, sqlOutput); err == nil {
+		t.Errorf("placeholder without param should not be executed")
+	}
+
+	// the columns of a prepared statement are described before it is executed
+	zero := tree.NewNumVal(constant.MakeInt64(0), "0", false)
+	es, err = NewWithParams("test", "select a, b + ? as c from prep1 where a = ?;", []tree.Expr{zero, zero}, "", e, proc).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cols, err := es[0].Describe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cols) != 2 || cols[0].Name != "a" || cols[1].Name != "c" {
+		t.Errorf("unexpected columns of the prepared statement")
+	}
+	processQuery("drop table prep1;", e, proc)
+}
+
+func TestCompileScalarSubquery(t *testing.T) {
+	e, proc := newTestEngine()
+
+	// a scalar subquery returns more than one row
+	es, err := New("test", "select uid, (select price from S where S.uid = R.uid) from R;", "", e, proc).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := es[0].Compile(nil, sqlOutput); err != nil {
+		t.Fatal(err)
+	}
+	if err := es[0].Run(0); err == nil {
+		t.Errorf("scalar subquery with more than one row should fail")
+	}
+}
+
+func TestCompileSubqueries(t *testing.T) {
+	e, proc := newTestEngine()
+
+	processQuery("create table sq1 (a int, b int);", e, proc)
+	processQuery("insert into sq1 values (1, 10), (2, 20), (3, null), (null, 40);", e, proc)
+	processQuery("create table sq2 (c int, d int);", e, proc)
+	processQuery("insert into sq2 values (1, 100), (3, 300), (null, 500);", e, proc)
+	processQuery("create table sq3 (e int);", e, proc)
+	processQuery("insert into sq3 values (1), (2);", e, proc)
+
+	kases := []rowsKase{
+		{"select a from sq1 where a in (select c from sq2);", []string{"1", "3"}},
+		{"select b from sq1 where a in (select e from sq3);", []string{"10", "20"}},
+		{"select b from sq1 where b in (select b from sq1 where a > 1);", []string{"20"}},
+		{"select a from sq1 where a not in (select c from sq2);", nil},
+		{"select a from sq1 where a not in (select e from sq3);", []string{"3"}},
+		{"select b from sq1 where b not in (select b from sq1 where a = 1);", []string{"20", "40"}},
+		{"select a from sq1 where exists (select * from sq2 where sq2.c = sq1.a);", []string{"1", "3"}},
+		{"select a from sq1 where not exists (select * from sq2 where sq2.c = sq1.a);", []string{"2", "null"}},
+		{"select a from sq1 where exists (select * from sq3);", []string{"1", "2", "3", "null"}},
+		{"select a from sq1 where not exists (select * from sq3);", nil},
+		{"select a, (select d from sq2 where sq2.c = sq1.a) from sq1;", []string{"1,100", "2,null", "3,300", "null,null"}},
+		{"select a, (select d from sq2 where c = 9) from sq1;", []string{"1,null", "2,null", "3,null", "null,null"}},
+		{"select a from sq1 where b > (select max(e) from sq3) * 10;", []string{"null"}},
+	}
+	checkRows(t, kases, true, e, proc)
+
+	// a scalar subquery returns more than one row
+	es, err := New("test", "select a, (select d from sq2) from sq1;", "", e, proc).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := es[0].Compile(nil, sqlOutput); err != nil {
+		t.Fatal(err)
+	}
+	if err := es[0].Run(0); err == nil {
+		t.Errorf("scalar subquery with more than one row should fail")
+	}
+
+	processQuery("drop table sq1;", e, proc)
+	processQuery("drop table sq2;", e, proc)
+	processQuery("drop table sq3;", e, proc)
+}
+
+func TestCompilePrivileges(t *testing.T) {
+	e, proc := newTestEngine()
+
+	privs := &privilege.Privileges{Grants: []privilege.Grant{
+		{Database: "test", Table: "R", Privileges: privilege.Select | privilege.Update},
+		{Database: "test", Table: "S", Privileges: privilege.Delete},
+		{Database: "test", Table: "T", Privileges: privilege.Create},
+		{Database: "db", Table: privilege.Any, Privileges: privilege.Create | privilege.Drop},
+	}}
+	kases := []struct {
+		query string
+		allow bool
+	}{
+		{"select uid from R;", true},
+		{"select uid from S;", false},
+		{"select R.uid from R join S on R.uid = S.uid;", false},
+		{"select uid from R where uid in (select uid from S);", false},
+		{"(select uid from R) union (select uid from S);", false},
+		{"explain select uid from S;", false},
+		{"explain R;", true},
+		{"explain S;", false},
+		{"delete from S;", false},
+		{"delete from R;", false},
+		{"update R set price = 1;", true},
+		{"update R set price = 1 where uid in (select uid from S);", false},
+		{"insert into R values (1, 1);", false},
+		{"create database db;", true},
+		{"create table T(a int);", true},
+		{"create table U(a int);", false},
+		{"drop database test;", false},
+		{"show columns from R;", true},
+		{"show create table S;", false},
+	}
+	for _, kase := range kases {
+		c := New("test", kase.query, "u1", e, proc)
+		c.SetPrivileges(privs)
+		es, err := c.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = es[0].Compile(nil, sqlOutput); kase.allow && err != nil {
+			t.Errorf("%s: %v", kase.query, err)
+		} else if !kase.allow && err == nil {
+			t.Errorf("%s: should be denied", kase.query)
+		}
+	}
+}
+
+func TestCompileKill(t *testing.T) {
+	e, proc := newTestEngine()
+
+	kases := []string{
+		"select * from R;",
+		"select userID, count(*) from t1 group by userID;",
+		"select R.uid, count(S.price) from R join S on R.uid = S.uid group by R.uid;",
+		"select uid from R union select uid from S;",
+		"explain analyze select * from R where uid > 1;",
+	}
+	for _, kase := range kases {
+		// the query is killed before the compilation
+		es, err := New("test", kase, "", e, proc).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		es[0].Kill()
+		if err = es[0].Compile(nil, sqlOutput); err != nil {
+			t.Fatal(err)
+		}
+		if err = es[0].Run(0); err != process.QueryInterrupted {
+			t.Errorf("%s: %v", kase, err)
+		}
+
+		// the query is killed while sending its result
+		es, err = New("test", kase, "", e, proc).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = es[0].Compile(nil, func(_ interface{}, _ *batch.Batch) error {
+			es[0].Kill()
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if err = es[0].Run(0); err != process.QueryInterrupted {
+			t.Errorf("%s: %v", kase, err)
+		}
+	}
+
+	// the statement which does not return a result is finished
+	es, err := New("test", "create table kill1 (a int);", "", e, proc).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = es[0].Compile(nil, sqlOutput); err != nil {
+		t.Fatal(err)
+	}
+	es[0].Kill()
+	if err = es[0].Run(0); err != nil {
+		t.Error(err)
+	}
+	processQuery("select * from R;", e, proc)
+	processQuery("drop table kill1;", e, proc)
+}
+
+func TestCompileAsOf(t *testing.T) {
+	e, proc := newTestEngine()
+
+	// the memory engine does not keep the history of its tables
+	for _, query := range []string{
+		"select * from R as of timestamp '2021-10-01 12:00:00';",
+		"select x.uid from R as of epoch 1633060800 x join S on x.uid = S.uid;",
+	} {
+		es, err := New("test", query, "", e, proc).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = es[0].Compile(nil, sqlOutput); err == nil {
+			t.Errorf("%s: should not be supported", query)
+		}
+	}
+
+	for _, query := range []string{
+		"select * from R as of timestamp '2021-13-01';",
+		"select * from R as of epoch '2021-10-01';",
+		"delete from R as of epoch 1633060800 where uid = 1;",
+	} {
+		if es, err := New("test", query, "", e, proc).Build(); err == nil {
+			if err = es[0].Compile(nil, sqlOutput); err == nil {
+				t.Errorf("%s: should fail", query)
+			}
+		}
+	}
+}
+
+func TestCompileAlterTable(t *testing.T) {
+	e, proc := newTestEngine()
+
+	processQuery("create table alt1 (a bigint, b bigint);", e, proc)
+	processQuery("insert into alt1 values (1, 10), (2, 20);", e, proc)
+	processQuery("alter table alt1 add column c bigint default 5, drop column b;", e, proc)
+	processQuery("insert into alt1 values (3, 7);", e, proc)
+	if rows := queryRows(t, "select a, c from alt1 order by a;", e, proc); !reflect.DeepEqual(rows, []string{"1,5", "2,5", "3,7"}) {
+		t.Errorf("add column: %v", rows)
+	}
+	processQuery("alter table alt1 alter column c set default 9, rename column c to d;", e, proc)
+	processQuery("insert into alt1 (a) values (4);", e, proc)
+	processQuery("rename table alt1 to alt2;", e, proc)
+	if rows := queryRows(t, "select a, d from alt2 order by a;", e, proc); !reflect.DeepEqual(rows, []string{"1,5", "2,5", "3,7", "4,9"}) {
+		t.Errorf("rename: %v", rows)
+	}
+
+	for _, query := range []string{
+		"select * from alt1;",
+		"alter table alt2 add column a int;",
+		"alter table alt2 drop column b;",
+		"alter table alt2 drop column a, drop column d;",
+		"alter table alt2 rename column a to d;",
+		"alter table alt2 add column e int default 'x';",
+		"alter table alt2 alter column e set default 1;",
+		"alter table alt2 rename to R;",
+		"alter table alt2 rename to other.alt3;",
+	} {
+		if es, err := New("test", query, "", e, proc).Build(); err == nil {
+			if err = es[0].Compile(nil, sqlOutput); err == nil {
+				t.Errorf("%s: should fail", query)
+			}
+		}
+	}
+	processQuery("drop table alt2;", e, proc)
+}
+
+func TestCompileOptimizeTable(t *testing.T) {
+	e, proc := newTestEngine()
+
+	processQuery("create table opt1 (a bigint);", e, proc)
+	rows := queryRows(t, "optimize table opt1, test.opt1;", e, proc)
+	note := "optimize,note,The storage engine for the table doesn't support optimize"
+	if !reflect.DeepEqual(rows, []string{"test.opt1," + note, "test.opt1," + note}) {
+		t.Errorf("optimize table: %v", rows)
+	}
+	if es, err := New("test", "optimize table opt2;", "", e, proc).Build(); err == nil {
+		if err = es[0].Compile(nil, sqlOutput); err == nil {
+			t.Errorf("optimize table opt2: should fail")
+		}
+	}
+	processQuery("drop table opt1;", e, proc)
+}
+
+func TestCompileSpill(t *testing.T) {
+	e, proc := newTestEngine()
+
+	processQuery("create table spill1 (a bigint, b bigint, c bigint);", e, proc)
+	processQuery("create table spill2 (b bigint, c bigint);", e, proc)
+	for i := 0; i < 100; i++ {
+		var buf bytes.Buffer
+		buf.WriteString("insert into spill1 values ")
+		for j := 0; j < 1000; j++ {
+			if j > 0 {
+				buf.WriteString(", ")
+			}
+			a := i*1000 + j
+			fmt.Fprintf(&buf, "(%d, %d, %d)", a, a%10, a%50000)
+		}
+		processQuery(buf.String(), e, proc)
+	}
+	processQuery("insert into spill2 values (0, 1), (1, 2), (2, 3), (3, 4), (4, 5), (5, 6), (6, 7), (7, 8), (8, 9), (9, 10);", e, proc)
+	processQuery("create table spill3 (a bigint, d bigint);", e, proc)
+	{
+		var buf bytes.Buffer
+		buf.WriteString("insert into spill3 values ")
+		for i := 0; i < 5000; i++ {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			fmt.Fprintf(&buf, "(%d, %d)", i*20, i%100)
+		}
+		processQuery(buf.String(), e, proc)
+	}
+
+	kases := []struct {
+		query string
+		rows  int
+	}{
+		{"select a, b from spill1 order by a desc;", 100000},
+		{"select c, count(*), sum(b) from spill1 group by c order by c;", 50000},
+		// spill1 is probed, and the view of spill2 is kept in memory
+		{"select spill1.c, sum(spill2.c) from spill1 join spill2 on spill1.b = spill2.b group by spill1.c order by spill1.c;", 50000},
+		// spill3 is probed, and the view of spill1 is joined one partition at a time
+		{"select spill3.d, count(*), sum(spill1.c) from spill3 join spill1 on spill3.a = spill1.a group by spill3.d order by spill3.d;", 100},
+	}
+	for _, kase := range kases {
+		// the query runs with enough memory
+		expected := queryRows(t, kase.query, e, proc)
+		if len(expected) != kase.rows {
+			t.Fatalf("%s: %v rows", kase.query, len(expected))
+		}
+
+		// the query spills its working set to disk when the memory is under pressure
+		dir := t.TempDir() + "/spill"
+		if err := spill.InitPath(dir); err != nil {
+			t.Fatal(err)
+		}
+		hm := host.New(5 << 19)
+		rows := queryRows(t, kase.query, e, process.New(mheap.New(guest.New(5<<19, hm))))
+		if !reflect.DeepEqual(expected, rows) {
+			t.Errorf("%s: the results are different after spilling", kase.query)
+		}
+		fs, err := os.ReadDir(dir)
+		if err != nil {
+			t.Errorf("%s: nothing is spilled: %v", kase.query, err)
+		}
+		if len(fs) != 0 {
+			t.Errorf("%s: %v spill files are left", kase.query, len(fs))
+		}
+		if hm.Size() != 0 {
+			t.Errorf("%s: %v bytes are not freed", kase.query, hm.Size())
+		}
+	}
+	processQuery("drop table spill1;", e, proc)
+	processQuery("drop table spill2;", e, proc)
+	processQuery("drop table spill3;", e, proc)
+}
+
+func TestCompileStringFunctions(t *testing.T) {
+	e, proc := newTestEngine()
+
+	processQuery("create table str1 (a varchar(20), b varchar(20), c bigint);", e, proc)
+	processQuery("insert into str1 values ('Matrix', 'a,b,c', 1), ('  One  ', 'x', 2), ('数据库', 'p-q', -1);", e, proc)
+
+	kases := []rowsKase{
+		{"select concat(a, '|', b), concat_ws('-', a, b) from str1;", []string{"Matrix|a,b,c,Matrix-a,b,c", "  One  |x,  One  -x", "数据库|p-q,数据库-p-q"}},
+		{"select substring(a, 2), substr(a, 1, c), left(a, 2), right(a, c) from str1;", []string{"atrix,M,Ma,x", " One  ,  ,  ,  ", "据库,,数据,"}},
+		{"select substring(a from -2 for 1), mid(a, 2, 2) from str1;", []string{"i,at", " , O", "据,据库"}},
+		{"select lower(a), upper(a), reverse(a) from str1;", []string{"matrix,MATRIX,xirtaM", "  one  ,  ONE  ,  enO  ", "数据库,数据库,库据数"}},
+		{"select trim(a), ltrim(a), rtrim(a), trim(leading 'M' from a), trim(both ' ' from a) from str1;", []string{"Matrix,Matrix,Matrix,atrix,Matrix", "One,One  ,  One,  One  ,One", "数据库,数据库,数据库,数据库,数据库"}},
+		{"select replace(a, 'a', 'A'), lpad(b, 4, '*'), rpad(b, 4, '*') from str1;", []string{"MAtrix,a,b,,a,b,", "  One  ,***x,x***", "数据库,*p-q,p-q*"}},
+		{"select instr(a, 'r'), locate('r', a), locate('r', a, 5), position('据' in a) from str1;", []string{"4,4,0,0", "0,0,0,0", "0,0,0,2"}},
+		{"select split_part(b, ',', 2), split_part(b, '-', c), regexp_replace(a, '[aeiou]', '_') from str1;", []string{"b,a,b,c,M_tr_x", ",,  On_  ", ",q,数据库"}},
+		{"select a from str1 where regexp_like(b, '^[a-p]') and b regexp ',';", []string{"Matrix"}},
+	}
+	checkRows(t, kases, false, e, proc)
+	if _, err := New("test", "select substring(a) from str1;", "", e, proc).Build(); err == nil {
+		t.Errorf("wrong parameters of substring are not rejected")
+	}
+	processQuery("drop table str1;", e, proc)
+}
+
+func TestCompileDatetimeFunctions(t *testing.T) {
+	e, proc := newTestEngine()
+
+	// the timestamps are written and read in the time zone of the session
+	loc, err := types.ParseTimeZone("+08:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	proc.Ctx = process.WithTimeZone(context.Background(), loc)
+
+	processQuery("create table dt1 (a date, b datetime, c timestamp, d time, e varchar(30));", e, proc)
+	processQuery("insert into dt1 values ('2022-01-31', '2022-03-15 10:20:30', '2022-03-15 10:20:30', '12:34:56', '2021-12-25 08:00:00'), ('2020-02-29', '2021-12-31 23:59:59', '2021-12-31 23:59:59', '-01:02:03', '2020-01-01 00:00:01');", e, proc)
+
+	kases := []rowsKase{
+		{"select month(a), day(a), weekday(a), hour(b), minute(b), second(b) from dt1;", []string{"1,31,0,10,20,30", "2,29,5,23,59,59"}},
+		{"select date_add(a, interval 1 month), date_sub(b, interval 1 day), b + interval 2 hour from dt1;", []string{"2022-02-28,2022-03-14 10:20:30,2022-03-15 12:20:30", "2020-03-29,2021-12-30 23:59:59,2022-01-01 01:59:59"}},
+		{"select datediff(b, a), extract(year from b), extract(week from a) from dt1;", []string{"43,2022,5", "671,2021,8"}},
+		{"select date_format(b, '%Y/%m/%d %H:%i'), date_trunc('month', b), str_to_date(e, '%Y-%m-%d %H:%i:%s') from dt1;", []string{"2022/03/15 10:20,2022-03-01 00:00:00,2021-12-25 08:00:00", "2021/12/31 23:59,2021-12-01 00:00:00,2020-01-01 00:00:01"}},
+		{"select c, cast(c as datetime), unix_timestamp(c), from_unixtime(unix_timestamp(c) + 60) from dt1;", []string{"2022-03-15 02:20:30,2022-03-15 10:20:30,1647310830,2022-03-15 10:21:30", "2021-12-31 15:59:59,2021-12-31 23:59:59,1640966399,2022-01-01 00:00:59"}},
+		{"select d, cast(b as time) from dt1;", []string{"12:34:56,10:20:30", "-01:02:03,23:59:59"}},
+		{"select a from dt1 where c < '2022-01-01 00:00:00';", []string{"2020-02-29"}},
+		{"select a from dt1 where d > '00:00:00' and c < now();", []string{"2022-01-31"}},
+	}
+	checkRows(t, kases, false, e, proc)
+	es, err := New("test", "select date_trunc('century', b) from dt1;", "", e, proc).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = es[0].Compile(nil, func(_ interface{}, _ *batch.Batch) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if err = es[0].Run(0); err == nil {
+		t.Errorf("unknown unit of date_trunc is not rejected")
+	}
+	processQuery("drop table dt1;", e, proc)
+}
+
+func TestCompileJsonFunctions(t *testing.T) {
+	e, proc := newTestEngine()
+
+	processQuery("create table js1 (a int, b json, c varchar(30));", e, proc)
+	processQuery(`insert into js1 values (1, '{"id": 1, "tags": ["x", "y"], "user": {"name": "ann"}}', 'k1'), (2, '[1, 2, {"v": 3}]', 'k2'), (1, '"text"', 'k3');`, e, proc)
+
+	kases := []rowsKase{
+		{"select b from js1;", []string{`{"id": 1, "tags": ["x", "y"], "user": {"name": "ann"}}`, `[1, 2, {"v": 3}]`, `"text"`}},
+		{"select json_extract(b, '$.user.name'), b->'$.tags[1]', b->>'$.tags[0]', json_extract(b, '$[2].v', '$[0]') from js1;", []string{`"ann","y",x,[{"id": 1, "tags": ["x", "y"], "user": {"name": "ann"}}]`, `null,null,null,[3, 1]`, `null,null,null,["text"]`}},
+		{"select json_length(b), json_length(b, '$.tags'), json_unquote(b) from js1;", []string{`3,2,{"id": 1, "tags": ["x", "y"], "user": {"name": "ann"}}`, `3,null,[1, 2, {"v": 3}]`, "1,null,text"}},
+		{`select c from js1 where json_contains(b, '"y"', '$.tags');`, []string{"k1"}},
+		{`select c from js1 where json_contains(b, '{"v": 3}');`, []string{"k2"}},
+		{"select json_object('a', a, 'c', c), json_array(a, c, b->'$.id') from js1 where a = 2;", []string{`{"a": 2, "c": "k2"},[2, "k2", null]`}},
+		{`select cast(concat('{"k": "', c, '"}') as json), cast(b as char) from js1 where a = 2;`, []string{`{"k": "k2"},[1, 2, {"v": 3}]`}},
+		{"select json_arrayagg(c), json_objectagg(c, a) from js1;", []string{`["k1", "k2", "k3"],{"k1": 1, "k2": 2, "k3": 1}`}},
+	}
+	checkRows(t, kases, false, e, proc)
+	rows := queryRows(t, "select a, json_arrayagg(c) from js1 group by a;", e, proc)
+	sort.Strings(rows)
+	if expected := []string{`1,["k1", "k3"]`, `2,["k2"]`}; !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %q, got %q", expected, rows)
+	}
+	es, err := New("test", "insert into js1 values (3, '{bad', 'k4');", "", e, proc).Build()
+	if err == nil {
+		err = es[0].Compile(nil, func(_ interface{}, _ *batch.Batch) error { return nil })
+	}
+	if err == nil {
+		t.Errorf("invalid json text is inserted")
+	}
+	es, err = New("test", "select cast(c as json) from js1;", "", e, proc).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = es[0].Compile(nil, func(_ interface{}, _ *batch.Batch) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if err = es[0].Run(0); err == nil {
+		t.Errorf("invalid json text is cast to json")
+	}
+	processQuery("drop table js1;", e, proc)
+}
+
+func TestCompileConditionalExpressions(t *testing.T) {
+	e, proc := newTestEngine()
+
+	processQuery("create table cd1 (a int, b varchar(10), c double);", e, proc)
+	processQuery("insert into cd1 values (1, 'x', 1.5), (2, null, 2.5), (3, 'z', null), (null, 'w', 4.5);", e, proc)
+
+	kases := []rowsKase{
+		{"select case when a < 2 then 'small' when a < 3 then 'middle' else 'large' end from cd1;", []string{"small", "middle", "large", "large"}},
+		{"select case a when 1 then b when 2 then 'two' end from cd1;", []string{"x", "two", "null", "null"}},
+		{"select if(a > 1, c, a) from cd1;", []string{"1", "2.5", "null", "null"}},
+		{"select ifnull(b, 'none'), coalesce(c, a, 0) from cd1;", []string{"x,1.5", "none,2.5", "z,3", "w,4.5"}},
+		{"select nullif(a, 2) from cd1;", []string{"1", "null", "3", "null"}},
+		{"select b is null, c is not null from cd1;", []string{"0,1", "1,1", "0,0", "0,1"}},
+		{"select a in (1, 3), a not in (1, 3) from cd1;", []string{"1,0", "0,1", "1,0", "null,null"}},
+		{"select a in (1, null), a not in (1, null) from cd1;", []string{"1,0", "null,null", "null,null", "null,null"}},
+		{"select a in (null), b not in ('x', null) from cd1;", []string{"null,0", "null,null", "null,null", "null,null"}},
+		{"select if(a in (2, null), 'in', 'not in') from cd1;", []string{"not in", "in", "not in", "not in"}},
+		{"select coalesce(a in (2, null), -1) from cd1;", []string{"-1", "1", "-1", "-1"}},
+		{"select a from cd1 where a in (3, null);", []string{"3"}},
+		{"select a from cd1 where not (a in (1, null));", nil},
+		{"select a from cd1 where not (a not in (1, null));", []string{"1"}},
+		{"select a from cd1 where a in (c, 1, null);", []string{"1"}},
+		{"select a from cd1 where a not in (c, null);", nil},
+		{"select b from cd1 where a in (2, 3) or a is null;", []string{"null", "z", "w"}},
+		{"select a from cd1 where b not in ('x', 'z');", []string{"null"}},
+		{"select a from cd1 where b in ('x', null) and c is not null;", []string{"1"}},
+		{"select a from cd1 where a not in (1, null);", nil},
+		{"select a from cd1 where case when c > 2 then a else 0 end >= 2;", []string{"2"}},
+	}
+	checkRows(t, kases, false, e, proc)
+	processQuery("drop table cd1;", e, proc)
+}
+
+func TestCompileSparseFilters(t *testing.T) {
+	base, proc := newTestEngine()
+	e := &filterEngine{Engine: base}
+
+	processQuery("create table sf (a bigint, b varchar(10));", e, proc)
+	processQuery("insert into sf values (1, 'x'), (2, 'y'), (3, null), (null, 'z');", e, proc)
+	kases := []struct {
+		query   string
+		filters []string
+		rows    []string
+	}{
+		{"select spID, userID from t1 where spID in (1, 2) and score < 5;",
+			[]string{"In(spID, int32:1 int32:2)", "Lt(score, int8:5)"}, []string{"1,1", "2,1", "2,2"}},
+		{"select a from sf where a not in (1, 2);",
+			[]string{"NotIn(a, int64:1 int64:2)"}, []string{"3"}},
+		{"select count(*) from sf where b in ('x', 'z') and a in (1, 3);",
+			[]string{"In(a, int64:1 int64:3)", "In(b, []uint8:[120] []uint8:[122])"}, []string{"1"}},
+		{"select a from sf where a in (1) or b in ('y');", nil, []string{"1", "2"}},
+		{"select a from sf where a + 1 in (2);", nil, []string{"1"}},
+	}
+	for _, kase := range kases {
+		e.filters = nil
+		rows := queryRows(t, kase.query, e, proc)
+		sort.Strings(rows)
+		if !reflect.DeepEqual(kase.rows, rows) {
+			t.Errorf("%s: expected rows %q, got %q", kase.query, kase.rows, rows)
+		}
+		if filters := uniqueStrings(e.filters); !reflect.DeepEqual(kase.filters, filters) {
+			t.Errorf("%s: expected filters %q, got %q", kase.query, kase.filters, filters)
+		}
+	}
+	processQuery("drop table sf;", e, proc)
+}
+
+// filterEngine records the sparse filters set to the readers of its relations
+type filterEngine struct {
+	engine.Engine
+	sync.Mutex
+	filters []string
+}
+
+type filterDatabase struct {
+	engine.Database
+	e *filterEngine
+}
+
+type filterRelation struct {
+	engine.Relation
+	e *filterEngine
+}
+
+type filterReader struct {
+	engine.Reader
+	e *filterEngine
+}
+
+type recordFilter struct {
+	r *filterReader
+}
+
+func (e *filterEngine) Database(name string) (engine.Database, error) {
+	db, err := e.Engine.Database(name)
+	if err != nil {
+		return nil, err
+	}
+	return &filterDatabase{Database: db, e: e}, nil
+}
+
+func (db *filterDatabase) Relation(name string) (engine.Relation, error) {
+	r, err := db.Database.Relation(name)
+	if err != nil {
+		return nil, err
+	}
+	return &filterRelation{Relation: r, e: db.e}, nil
+}
+
+func (r *filterRelation) NewReader(n int) []engine.Reader {
+	rds := r.Relation.NewReader(n)
+	for i, rd := range rds {
+		rds[i] = &filterReader{Reader: rd, e: r.e}
+	}
+	return rds
+}
+
+func (r *filterReader) NewSparseFilter() engine.SparseFilter {
+	return &recordFilter{r: r}
+}
+
+func (f *recordFilter) record(op, attr string, vs ...interface{}) (engine.Reader, error) {
+	strs := make([]string, len(vs))
+	for i, v := range vs {
+		strs[i] = fmt.Sprintf("%T:%v", v, v)
+	}
+	f.r.e.Lock()
+	defer f.r.e.Unlock()
+	f.r.e.filters = append(f.r.e.filters, fmt.Sprintf("%s(%s, %s)", op, attr, strings.Join(strs, " ")))
+	return f.r, nil
+}
+
+func (f *recordFilter) Eq(attr string, v interface{}) (engine.Reader, error) {
+	return f.record("Eq", attr, v)
+}
+
+func (f *recordFilter) Ne(attr string, v interface{}) (engine.Reader, error) {
+	return f.record("Ne", attr, v)
+}
+
+func (f *recordFilter) Lt(attr string, v interface{}) (engine.Reader, error) {
+	return f.record("Lt", attr, v)
+}
+
+func (f *recordFilter) Le(attr string, v interface{}) (engine.Reader, error) {
+	return f.record("Le", attr, v)
+}
+
+func (f *recordFilter) Gt(attr string, v interface{}) (engine.Reader, error) {
+	return f.record("Gt", attr, v)
+}
+
+func (f *recordFilter) Ge(attr string, v interface{}) (engine.Reader, error) {
+	return f.record("Ge", attr, v)
+}
+
+func (f *recordFilter) Btw(attr string, v, w interface{}) (engine.Reader, error) {
+	return f.record("Btw", attr, v, w)
+}
+
+func (f *recordFilter) In(attr string, vs []interface{}) (engine.Reader, error) {
+	return f.record("In", attr, vs...)
+}
+
+func (f *recordFilter) NotIn(attr string, vs []interface{}) (engine.Reader, error) {
+	return f.record("NotIn", attr, vs...)
+}
+
+// uniqueStrings returns the sorted distinct strings of strs
+func uniqueStrings(strs []string) []string {
+	var rs []string
+
+	sort.Strings(strs)
+	for i, s := range strs {
+		if i == 0 || s != strs[i-1] {
+			rs = append(rs, s)
+		}
+	}
+	return rs
+}
+
+func TestCompileAggregates(t *testing.T) {
+	e, proc := newTestEngine()
+
+	processQuery("create table ag1 (g int, a int, b varchar(10));", e, proc)
+	processQuery("insert into ag1 values (1, 1, 'x'), (1, 2, 'y'), (1, 3, 'x'), (1, 4, null), (2, 5, 'z'), (2, 5, 'w'), (2, null, 'v');", e, proc)
+
+	kases := []rowsKase{
+		{"select var_pop(a), var_samp(a), stddev_pop(a), stddev_samp(a) from ag1 where g = 1;", []string{"1.25,1.6666666666666667,1.118033988749895,1.2909944487358056"}},
+		{"select variance(a), std(a), stddev(a) from ag1 where g = 2;", []string{"0,0,0"}},
+		{"select var_samp(a) from ag1 where a = 1;", []string{"null"}},
+		{"select bit_and(a), bit_or(a), bit_xor(a), bit_xor(distinct a) from ag1;", []string{"0,7,4,1"}},
+		{"select median(a), percentile_cont(a, 0.25), approx_percentile(a, 0.5) from ag1;", []string{"3.5,2.25,3.5"}},
+		{"select stddev_pop(distinct a), count(distinct a) from ag1;", []string{"1.4142135623730951,5"}},
+		{"select group_concat(b), group_concat(distinct b order by b separator '-') from ag1 where g = 1;", []string{"x,y,x,x-y"}},
+		{"select group_concat(a, b order by a desc, b) from ag1;", []string{"5w,5z,3x,2y,1x"}},
+		{"select g, count(distinct a), count(a), var_pop(a), group_concat(b order by b) from ag1 group by g;", []string{"1,4,4,1.25,x,x,y", "2,1,2,0,v,w,z"}},
+		{"select g from ag1 group by g having stddev_pop(a) > 0;", []string{"1"}},
+	}
+	checkRows(t, kases, true, e, proc)
+	processQuery("drop table ag1;", e, proc)
+}
+
+// newTestEngine returns the test engine and a process to run the queries
+func newTestEngine() (engine.Engine, *process.Process) {
 	InitAddress("127.0.0.1")
 	hm := host.New(1 << 30)
 	gm := guest.New(1<<30, hm)
-	proc := process.New(mheap.New(gm))
-	e := memEngine.NewTestEngine()
-	for _, query := range querys {
-		processQuery(query, e, proc)
+	return memEngine.NewTestEngine(), process.New(mheap.New(gm))
+}
+
+// rowsKase is a query and the rows of its result
+type rowsKase struct {
+	query string
+	rows  []string
+}
+
+// checkRows runs the queries of kases and compares the rows of their
+// results, regardless of their order if sorted is true
+func checkRows(t *testing.T, kases []rowsKase, sorted bool, e engine.Engine, proc *process.Process) {
+	for _, kase := range kases {
+		rows := queryRows(t, kase.query, e, proc)
+		expected := kase.rows
+		if sorted {
+			expected = append([]string(nil), expected...)
+			sort.Strings(expected)
+			sort.Strings(rows)
+		}
+		if !reflect.DeepEqual(expected, rows) {
+			t.Errorf("%s: expected %q, got %q", kase.query, expected, rows)
+		}
+	}
+}
+
+// execRows runs the statement and returns the number of rows it affected
+func execRows(t *testing.T, query string, e engine.Engine, proc *process.Process) uint64 {
+	es, err := New("test", query, "", e, proc).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = es[0].Compile(nil, sqlOutput); err != nil {
+		t.Fatal(err)
+	}
+	if err = es[0].Run(0); err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+	return es[0].GetAffectedRows()
+}
+
+// queryRows returns the rows of the result of the query, a row is
+// repeated as many times as its multiplicity in the batch
+func queryRows(t *testing.T, query string, e engine.Engine, proc *process.Process) []string {
+	var rows []string
+
+	es, err := New("test", query, "", e, proc).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = es[0].Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+		for j, z := range bat.Zs {
+			i := int64(j)
+			if len(bat.Sels) != 0 {
+				i = bat.Sels[j]
+			}
+			var row []string
+			for _, vec := range bat.Vecs {
+				if nulls.Contains(vec.Nsp, uint64(i)) {
+					row = append(row, "null")
+					continue
+				}
+				switch vs := vec.Col.(type) {
+				case []types.Decimal64:
+					row = append(row, vs[i].ToString(vec.Typ.Precision))
+				case []types.Decimal128:
+					row = append(row, vs[i].ToString(vec.Typ.Precision))
+				case *types.Bytes:
+					if vec.Typ.Oid == types.T_json {
+						row = append(row, bytejson.ByteJson(vs.Get(i)).String())
+					} else {
+						row = append(row, string(vs.Get(i)))
+					}
+				case []types.Date:
+					row = append(row, vs[i].String())
+				case []types.Datetime:
+					row = append(row, vs[i].String())
+				case []types.Time:
+					row = append(row, vs[i].String())
+				case []types.Timestamp:
+					row = append(row, vs[i].String())
+				default:
+					// the numbers
+					row = append(row, fmt.Sprint(reflect.ValueOf(vs).Index(int(i)).Interface()))
+				}
+			}
+			for ; z > 0; z-- {
+				rows = append(rows, strings.Join(row, ","))
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err = es[0].Run(0); err != nil {
+		t.Fatalf("%s: %v", query, err)
 	}
+	return rows
 }
 
 func sqlOutput(_ interface{}, bat *batch.Batch) error {
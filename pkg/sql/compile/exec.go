@@ -15,6 +15,7 @@
 package compile
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -25,35 +26,22 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/rewrite"
 	"github.com/matrixorigin/matrixone/pkg/sql/vtree"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 // Compile is the entrance of the compute-layer, it compiles AST tree to scope list.
 // A scope is an execution unit.
 func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error) error {
-	// do ast rewrite work
-	e.stmt = rewrite.Rewrite(e.stmt)
-	e.stmt = rewrite.AstRewrite(e.stmt)
-
-	// do semantic analysis and build plan for sql
-	pn, err := plan.New(e.c.db, e.c.sql, e.c.e).BuildStatement(e.stmt)
+	pn, err := e.buildPlan()
 	if err != nil {
 		return err
 	}
-
-	{
-		attrs := pn.ResultColumns()
-		cols := make([]*Col, len(attrs))
-		for i, attr := range attrs {
-			cols[i] = &Col{
-				Name: attr.Name,
-				Typ:  attr.Type.Oid,
-			}
-		}
-		e.resultCols = cols
-	}
 	e.u = u
 	e.e = e.c.e
 	e.fill = fill
+	// the processes of the query share the context which is done when the query
+	// is killed, runs out of its execution time or fails
+	e.c.proc.Ctx = e.newContext()
 
 	// build scope for a single sql
 	s, err := e.compileScope(pn)
@@ -64,50 +52,161 @@ func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error
 	return nil
 }
 
+// Describe builds the plan of the statement and returns its result columns
+// without compiling it, it is used to describe a prepared statement.
+func (e *Exec) Describe() ([]*Col, error) {
+	if _, err := e.buildPlan(); err != nil {
+		return nil, err
+	}
+	return e.resultCols, nil
+}
+
+// buildPlan rewrites the statement, builds its plan, checks the privileges
+// of the plan and records its result columns.
+func (e *Exec) buildPlan() (plan.Plan, error) {
+	// do ast rewrite work
+	e.stmt = rewrite.Rewrite(e.stmt)
+	e.stmt = rewrite.AstRewrite(e.stmt)
+
+	// do semantic analysis and build plan for sql
+	b := plan.New(e.c.db, e.c.sql, e.c.e)
+	b.SetTimeZone(e.c.loc)
+	pn, err := b.BuildStatement(e.stmt)
+	if err != nil {
+		return nil, err
+	}
+	if err = e.checkPrivileges(e.stmt, pn); err != nil {
+		return nil, err
+	}
+
+	attrs := pn.ResultColumns()
+	cols := make([]*Col, len(attrs))
+	for i, attr := range attrs {
+		cols[i] = &Col{
+			Name: attr.Name,
+			Typ:  attr.Type.Oid,
+		}
+	}
+	e.resultCols = cols
+	return pn, nil
+}
+
 // Run is an important function of the compute-layer, it executes a single sql according to its scope
 func (e *Exec) Run(ts uint64) error {
 	if e.scope == nil {
 		return nil
 	}
+	defer e.abort(nil)
+	err := e.run(e.scope, ts)
+	if ierr := process.Interrupted(e.c.proc); ierr != nil {
+		switch e.scope.Magic {
+		case Normal, Merge, Remote, Parallel, Explain:
+			// the result of an aborted query may be incomplete
+			process.FreeRegisters(e.c.proc)
+			return ierr
+		}
+		if err != nil {
+			return ierr
+		}
+	}
+	return err
+}
 
-	switch e.scope.Magic {
+// Kill stops the execution of the query, its pipelines return process.QueryInterrupted.
+// It can be called by another goroutine at any time.
+func (e *Exec) Kill() {
+	e.mu.Lock()
+	e.killed = true
+	e.mu.Unlock()
+	e.abort(process.QueryInterrupted)
+}
+
+// Limitation returns the limitation of the resources used by the query.
+func (e *Exec) Limitation() process.Limitation {
+	return e.c.proc.Lim
+}
+
+// newContext returns the context of the query which expires after the max execution
+// time of the query, it has been done if the query is killed before the compilation.
+func (e *Exec) newContext() context.Context {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ctx, abort := process.NewContext(e.c.proc.Lim.ExecutionTime)
+	if e.killed {
+		abort(process.QueryInterrupted)
+	}
+	e.cancel = abort
+	return process.WithTimeZone(ctx, e.c.loc)
+}
+
+// abort stops the processes of the query with the error.
+func (e *Exec) abort(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.cancel != nil {
+		e.cancel(err)
+	}
+}
+
+func (e *Exec) run(s *Scope, ts uint64) error {
+	switch s.Magic {
 	case Normal:
-		return e.scope.Run(e.c.e)
+		return s.Run(e.c.e)
 	case Merge:
-		return e.scope.MergeRun(e.c.e)
+		return s.MergeRun(e.c.e)
 	case Remote:
-		return e.scope.RemoteRun(e.c.e)
+		return s.RemoteRun(e.c.e)
 	case Parallel:
-		return e.scope.ParallelRun(e.c.e)
+		return s.ParallelRun(e.c.e)
 	case Insert:
-		affectedRows, err := e.scope.Insert(ts)
+		affectedRows, err := s.Insert(ts)
+		if err != nil {
+			return err
+		}
+		e.setAffectedRows(affectedRows)
+		return nil
+	case Delete:
+		affectedRows, err := s.Delete(ts, e.c.e)
+		if err != nil {
+			return err
+		}
+		e.setAffectedRows(affectedRows)
+		return nil
+	case Update:
+		affectedRows, err := s.Update(ts, e.c.e)
 		if err != nil {
 			return err
 		}
 		e.setAffectedRows(affectedRows)
 		return nil
 	case CreateDatabase:
-		return e.scope.CreateDatabase(ts)
+		return s.CreateDatabase(ts)
 	case CreateTable:
-		return e.scope.CreateTable(ts)
+		return s.CreateTable(ts)
 	case CreateIndex:
-		return e.scope.CreateIndex(ts)
+		return s.CreateIndex(ts)
 	case DropDatabase:
-		return e.scope.DropDatabase(ts)
+		return s.DropDatabase(ts)
 	case DropTable:
-		return e.scope.DropTable(ts)
+		return s.DropTable(ts)
 	case DropIndex:
-		return e.scope.DropIndex(ts)
+		return s.DropIndex(ts)
+	case AlterTable:
+		return s.AlterTable(ts)
+	case OptimizeTable:
+		return s.OptimizeTable(e.u, e.fill)
 	case ShowDatabases:
-		return e.scope.ShowDatabases(e.u, e.fill)
+		return s.ShowDatabases(e.u, e.fill)
 	case ShowTables:
-		return e.scope.ShowTables(e.u, e.fill)
+		return s.ShowTables(e.u, e.fill)
 	case ShowColumns:
-		return e.scope.ShowColumns(e.u, e.fill)
+		return s.ShowColumns(e.u, e.fill)
 	case ShowCreateTable:
-		return e.scope.ShowCreateTable(e.u, e.fill)
+		return s.ShowCreateTable(e.u, e.fill)
 	case ShowCreateDatabase:
-		return e.scope.ShowCreateDatabase(e.u, e.fill)
+		return s.ShowCreateDatabase(e.u, e.fill)
+	case Explain:
+		return e.explain(s, ts)
 	}
 	return nil
 }
@@ -115,11 +214,9 @@ func (e *Exec) Run(ts uint64) error {
 func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 	switch qry := pn.(type) {
 	case *plan.Query:
-		ft, err := ftree.New().Build(qry)
-		if err != nil {
-			return nil, err
-		}
-		return e.compileVTree(vtree.New().Build(ft), qry.VarsMap)
+		return e.compileQuery(qry)
+	case *plan.SetQuery:
+		return e.compileSetQuery(qry)
 	case *plan.Insert:
 		// todo: insert into tbl select a, b from tbl2 should deal next time.
 		return &Scope{
@@ -127,6 +224,39 @@ func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 			Plan:  pn,
 			Proc:  e.c.proc,
 		}, nil
+	case *plan.Delete:
+		s, err := e.compileTarget(qry.Qry)
+		if err != nil {
+			return nil, err
+		}
+		return &Scope{
+			Magic:     Delete,
+			Plan:      pn,
+			PreScopes: []*Scope{s},
+			Proc:      e.c.proc,
+		}, nil
+	case *plan.Update:
+		s, err := e.compileTarget(qry.Qry)
+		if err != nil {
+			return nil, err
+		}
+		return &Scope{
+			Magic:     Update,
+			Plan:      pn,
+			PreScopes: []*Scope{s},
+			Proc:      e.c.proc,
+		}, nil
+	case *plan.Explain:
+		s, err := e.compileExplain(qry)
+		if err != nil {
+			return nil, err
+		}
+		return &Scope{
+			Magic:     Explain,
+			Plan:      pn,
+			PreScopes: []*Scope{s},
+			Proc:      e.c.proc,
+		}, nil
 	case *plan.CreateDatabase:
 		return &Scope{
 			Magic: CreateDatabase,
@@ -166,6 +296,18 @@ func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 			Plan:  pn,
 			Proc:  e.c.proc,
 		}, nil
+	case *plan.AlterTable:
+		return &Scope{
+			Magic: AlterTable,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
+	case *plan.OptimizeTable:
+		return &Scope{
+			Magic: OptimizeTable,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
 	case *plan.ShowDatabases:
 		return &Scope{
 			Magic: ShowDatabases,
@@ -200,6 +342,84 @@ func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", pn))
 }
 
+// compileTarget builds the scope which fetches the tuples to be deleted or updated.
+func (e *Exec) compileTarget(qry *plan.Query) (*Scope, error) {
+	cols := e.resultCols
+	defer func() { e.resultCols = cols }()
+	e.resultCols = make([]*Col, len(qry.ResultAttributes))
+	for i, attr := range qry.ResultAttributes {
+		e.resultCols[i] = &Col{
+			Name: attr.Name,
+			Typ:  attr.Type.Oid,
+		}
+	}
+	return e.compileQuery(qry)
+}
+
+// compileQuery builds the scope of a query, the multi-table query which can not be
+// evaluated on its factorized representation is compiled to join its relations one by one.
+func (e *Exec) compileQuery(qry *plan.Query) (*Scope, error) {
+	if len(qry.Rels) > 1 {
+		if vt, ok := factorize(qry); ok {
+			return e.compileVTree(vt, qry.VarsMap)
+		}
+		return e.compileVTree(vtree.New().BuildJoin(qry), qry.VarsMap)
+	}
+	ft, err := ftree.New().Build(qry)
+	if err != nil {
+		return nil, err
+	}
+	return e.compileVTree(vtree.New().Build(ft), qry.VarsMap)
+}
+
+// factorize returns the view tree of the factorized representation of a multi-table query,
+// it fails for outer joins, non-equi joins, products and queries without aggregate functions.
+func factorize(qry *plan.Query) (*vtree.ViewTree, bool) {
+	if len(qry.Joins) > 0 || len(qry.JoinRestrictConds) > 0 {
+		return nil, false
+	}
+	q := *qry // join conditions are modified by the building of factorized tree
+	q.Conds = make([]*plan.JoinCondition, len(qry.Conds))
+	for i, cond := range qry.Conds {
+		c := *cond
+		q.Conds[i] = &c
+	}
+	ft, err := ftree.New().Build(&q)
+	if err != nil {
+		return nil, false
+	}
+	vt := vtree.New().Build(ft)
+	if vt != nil && (vt.IsBare() || depth(vt.Views) > 1) {
+		return nil, false
+	}
+	return vt, true
+}
+
+// compileExplain builds the scope of the explained statement, its result is
+// never sent to the client.
+func (e *Exec) compileExplain(p *plan.Explain) (*Scope, error) {
+	fill := e.fill
+	defer func() { e.fill = fill }()
+	e.fill = func(_ interface{}, _ *batch.Batch) error { return nil }
+	if qry, ok := p.Plan.(*plan.Query); ok {
+		return e.compileTarget(qry)
+	}
+	return e.compileScope(p.Plan)
+}
+
+// explain runs the explained statement for EXPLAIN ANALYZE, and then
+// fills the description of its scope tree.
+func (e *Exec) explain(s *Scope, ts uint64) error {
+	p, _ := s.Plan.(*plan.Explain)
+	if p.Analyze {
+		analyze(s.PreScopes[0])
+		if err := e.run(s.PreScopes[0], ts); err != nil {
+			return err
+		}
+	}
+	return s.Explain(e.u, e.fill)
+}
+
 func (e *Exec) Statement() tree.Statement {
 	return e.stmt
 }
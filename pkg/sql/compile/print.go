@@ -15,7 +15,16 @@
 package compile
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
+	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/pipeline"
 )
 
@@ -28,3 +37,96 @@ func Print(prefix []byte, ss []*Scope) {
 		fmt.Printf("%s:%v %v\n", prefix, s.Magic, p)
 	}
 }
+
+var magicNames = map[int]string{
+	Merge:    "Merge",
+	Normal:   "Normal",
+	Remote:   "Remote",
+	Parallel: "Parallel",
+	Insert:   "Insert",
+	Delete:   "Delete",
+	Update:   "Update",
+}
+
+// analyze makes the instructions of s and its children collect runtime
+// statistics, the scopes built during execution inherit it by s.Analyze.
+func analyze(s *Scope) {
+	s.Analyze = true
+	for i := range s.Instructions {
+		if s.Instructions[i].Stats == nil {
+			s.Instructions[i].Stats = new(vm.Stats)
+		}
+	}
+	for _, ps := range s.PreScopes {
+		analyze(ps)
+	}
+}
+
+// explainScope appends the description of the scope tree to lines,
+// one instruction per line with its statistics if analyze is true.
+func explainScope(lines []string, prefix string, s *Scope, analyze bool) []string {
+	name, ok := magicNames[s.Magic]
+	if !ok {
+		name = fmt.Sprintf("%v", s.Magic)
+	}
+	if s.Magic == Remote {
+		name = fmt.Sprintf("%s on %s", name, s.NodeInfo.Addr)
+	}
+	lines = append(lines, prefix+name)
+	prefix += "    "
+	// a merge scope may keep the data source of the parallel scope it was built from
+	if src := s.DataSource; src != nil && len(src.RelationName) > 0 && s.Magic != Merge {
+		line := fmt.Sprintf("%ssource: %s.%s %v", prefix, src.SchemaName, src.RelationName, src.Attributes)
+		if analyze && len(s.Instructions) > 0 {
+			if st := s.Instructions[0].Stats; st != nil {
+				line += fmt.Sprintf(" (rows: %v, batches: %v)",
+					atomic.LoadInt64(&st.InputRows), atomic.LoadInt64(&st.InputBatches))
+			}
+		}
+		lines = append(lines, line)
+		for _, e := range restricts(s.Instructions) {
+			lines = append(lines, fmt.Sprintf("%sfilter: %s", prefix, e))
+		}
+	}
+	for i, in := range s.Instructions {
+		var buf bytes.Buffer
+
+		vm.String(vm.Instructions{in}, &buf)
+		line := prefix + strings.TrimSpace(buf.String())
+		if analyze && in.Stats != nil {
+			// the output of an instruction is the input of the next one,
+			// the last instruction passes on all of its input
+			out := in.Stats
+			if i+1 < len(s.Instructions) {
+				out = s.Instructions[i+1].Stats
+			}
+			if out != nil {
+				line += fmt.Sprintf(" (rows: %v, batches: %v, time: %v)",
+					atomic.LoadInt64(&out.InputRows), atomic.LoadInt64(&out.InputBatches),
+					time.Duration(atomic.LoadInt64(&in.Stats.Elapsed)))
+			}
+		}
+		lines = append(lines, line)
+	}
+	for _, ps := range s.PreScopes {
+		lines = explainScope(lines, prefix, ps, analyze)
+	}
+	return lines
+}
+
+// restricts returns the conditions which can be pushed down to the data source.
+func restricts(ins vm.Instructions) []extend.Extend {
+	var es []extend.Extend
+
+	for _, in := range ins {
+		switch arg := in.Arg.(type) {
+		case *restrict.Argument:
+			es = extend.AndExtends(arg.E, es)
+		case *transform.Argument:
+			if arg.Restrict != nil {
+				es = extend.AndExtends(arg.Restrict.E, es)
+			}
+		}
+	}
+	return es
+}
@@ -0,0 +1,151 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
+)
+
+// checkPrivileges returns an error if the user has no privilege to execute the plan
+// of the statement, the statements of a user without privileges are not checked.
+func (e *Exec) checkPrivileges(stmt tree.Statement, pn plan.Plan) error {
+	if e.c.privs == nil {
+		return nil
+	}
+	switch p := pn.(type) {
+	case *plan.Query:
+		return e.checkQuery(p)
+	case *plan.SetQuery:
+		if err := e.checkPrivileges(stmt, p.Left); err != nil {
+			return err
+		}
+		return e.checkPrivileges(stmt, p.Right)
+	case *plan.Insert:
+		return e.checkTable(p.Db, p.Id, privilege.Insert)
+	case *plan.Delete:
+		if err := e.checkTable(p.Db, p.Id, privilege.Delete); err != nil {
+			return err
+		}
+		return e.checkQuery(p.Qry)
+	case *plan.Update:
+		if err := e.checkTable(p.Db, p.Id, privilege.Update); err != nil {
+			return err
+		}
+		return e.checkQuery(p.Qry)
+	case *plan.Explain:
+		return e.checkPrivileges(explained(stmt), p.Plan)
+	case *plan.CreateDatabase:
+		return e.checkDatabase(p.Id, privilege.Create)
+	case *plan.DropDatabase:
+		return e.checkDatabase(p.Id, privilege.Drop)
+	case *plan.DropTable:
+		for i := range p.Ids {
+			if err := e.checkTable(p.Dbs[i], p.Ids[i], privilege.Drop); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *plan.OptimizeTable:
+		for i := range p.Ids {
+			if err := e.checkTable(p.Dbs[i], p.Ids[i], privilege.Select); err != nil {
+				return err
+			}
+			if err := e.checkTable(p.Dbs[i], p.Ids[i], privilege.Insert); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	switch stmt := stmt.(type) {
+	case *tree.ExplainStmt:
+		// EXPLAIN tbl is planned as SHOW COLUMNS
+		return e.checkPrivileges(stmt.Statement, pn)
+	case *tree.CreateTable:
+		return e.checkTable(e.schemaName(stmt.Table), string(stmt.Table.ObjectName), privilege.Create)
+	case *tree.CreateIndex:
+		return e.checkTable(e.schemaName(stmt.Table), string(stmt.Table.ObjectName), privilege.Index)
+	case *tree.DropIndex:
+		return e.checkTable(e.schemaName(stmt.TableName), string(stmt.TableName.ObjectName), privilege.Index)
+	case *tree.AlterTable:
+		return e.checkTable(e.schemaName(stmt.Table), string(stmt.Table.ObjectName), privilege.Alter)
+	case *tree.ShowColumns:
+		tbl := stmt.Table.ToTableName()
+		if len(stmt.DBName) > 0 {
+			tbl.SchemaName = tree.Identifier(stmt.DBName)
+		}
+		return e.checkTable(e.schemaName(tbl), string(tbl.ObjectName), privilege.Select)
+	case *tree.ShowCreateTable:
+		tbl := stmt.Name.ToTableName()
+		return e.checkTable(e.schemaName(tbl), string(tbl.ObjectName), privilege.Select)
+	}
+	return nil
+}
+
+// checkQuery checks the SELECT privilege of the relations read by the query,
+// including the target table of DELETE and UPDATE.
+func (e *Exec) checkQuery(qry *plan.Query) error {
+	for _, name := range qry.Rels {
+		rel := qry.RelsMap[name]
+		if rel.Query != nil {
+			if err := e.checkQuery(rel.Query); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := e.checkTable(rel.Schema, rel.Name, privilege.Select); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// explained returns the statement explained by EXPLAIN [ANALYZE].
+func explained(stmt tree.Statement) tree.Statement {
+	switch stmt := stmt.(type) {
+	case *tree.ExplainStmt:
+		return stmt.Statement
+	case *tree.ExplainAnalyze:
+		return stmt.Statement
+	}
+	return stmt
+}
+
+func (e *Exec) checkDatabase(db string, typ privilege.Type) error {
+	if !e.c.privs.Check(db, privilege.Any, typ) {
+		return errors.New(errno.InsufficientPrivilege, fmt.Sprintf("Access denied for user '%s' to database '%s'", e.c.uid, db))
+	}
+	return nil
+}
+
+func (e *Exec) checkTable(db, tbl string, typ privilege.Type) error {
+	if !e.c.privs.Check(db, tbl, typ) {
+		return errors.New(errno.InsufficientPrivilege, fmt.Sprintf("%s command denied to user '%s' for table '%s'", typ, e.c.uid, tbl))
+	}
+	return nil
+}
+
+// schemaName returns the database of the table, which is the current database by default.
+func (e *Exec) schemaName(tbl tree.TableName) string {
+	if len(tbl.SchemaName) == 0 {
+		return e.c.db
+	}
+	return string(tbl.SchemaName)
+}
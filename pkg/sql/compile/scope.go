@@ -25,11 +25,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeorder"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergetop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
 	"math"
 	"net"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/fagongzi/goetty"
@@ -43,6 +45,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/plus"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/times"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
@@ -144,6 +147,118 @@ func (s *Scope) DropIndex(ts uint64) error {
 	return p.Relation.DropIndex(ts, p.Id)
 }
 
+// AlterTable applies the options of alter table plan, the table is renamed
+// after its columns are altered. Nothing is applied unless the relation
+// supports all the options, and its columns are altered at once if it is
+// an AtomicAlterRelation.
+func (s *Scope) AlterTable(ts uint64) error {
+	p, _ := s.Plan.(*plan.AlterTable)
+	defer p.Relation.Close()
+	var names []string
+	var opts []engine.AlterOption
+	for _, opt := range p.Options {
+		switch opt.Type {
+		case plan.AddColumn:
+			opts = append(opts, engine.AlterOption{Type: engine.AlterAdd, Attr: opt.Attr})
+		case plan.DropColumn:
+			opts = append(opts, engine.AlterOption{Type: engine.AlterDrop, Name: opt.Name})
+		case plan.RenameColumn:
+			opts = append(opts, engine.AlterOption{Type: engine.AlterRename, Name: opt.Name, NewName: opt.NewName})
+		case plan.ChangeDefault:
+			opts = append(opts, engine.AlterOption{Type: engine.AlterDefault, Attr: opt.Attr})
+		case plan.RenameTable:
+			names = append(names, opt.NewName)
+		}
+	}
+	r, ok := engine.Unwrap(p.Relation).(engine.AlterRelation)
+	if len(opts) > 0 && !ok {
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("the columns of table '%s' cannot be altered", p.Id))
+	}
+	db, ok := p.Database.(engine.RenameDatabase)
+	if len(names) > 0 && !ok {
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("table '%s' cannot be renamed", p.Id))
+	}
+	if err := alterRelation(r, ts, opts); err != nil {
+		return err
+	}
+	name := p.Id
+	for _, newName := range names {
+		if err := db.Rename(ts, name, newName); err != nil {
+			return err
+		}
+		name = newName
+	}
+	return nil
+}
+
+// alterRelation applies the changes of the attributes of r at once if r
+// is an AtomicAlterRelation, or one by one.
+func alterRelation(r engine.AlterRelation, ts uint64, opts []engine.AlterOption) error {
+	if len(opts) == 0 {
+		return nil
+	}
+	if ar, ok := r.(engine.AtomicAlterRelation); ok {
+		return ar.Alter(ts, opts)
+	}
+	for _, opt := range opts {
+		var err error
+
+		switch opt.Type {
+		case engine.AlterAdd:
+			err = r.AddTableDef(ts, &engine.AttributeDef{Attr: opt.Attr})
+		case engine.AlterDrop:
+			err = r.DelTableDef(ts, &engine.AttributeDef{Attr: engine.Attribute{Name: opt.Name}})
+		case engine.AlterRename:
+			err = r.RenameAttribute(ts, opt.Name, opt.NewName)
+		case engine.AlterDefault:
+			err = r.ChangeDefault(ts, opt.Attr.Name, opt.Attr.Default)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OptimizeTable compacts the relations one by one and fills batch with the
+// result of each relation, as mysql does.
+func (s *Scope) OptimizeTable(u interface{}, fill func(interface{}, *batch.Batch) error) error {
+	p, _ := s.Plan.(*plan.OptimizeTable)
+	defer func() {
+		for _, r := range p.Relations {
+			r.Close()
+		}
+	}()
+	results := p.ResultColumns()
+	names := make([]string, len(results))
+	for i, r := range results {
+		names[i] = r.Name
+	}
+	n := len(p.Relations)
+	tables, ops := make([][]byte, n), make([][]byte, n)
+	msgTypes, msgTexts := make([][]byte, n), make([][]byte, n)
+	for i, r := range p.Relations {
+		tables[i] = []byte(p.Dbs[i] + "." + p.Ids[i])
+		ops[i] = []byte("optimize")
+		msgType, msgText := "status", "OK"
+		if or, ok := engine.Unwrap(r).(engine.OptimizeRelation); !ok {
+			msgType, msgText = "note", "The storage engine for the table doesn't support optimize"
+		} else if err := or.Optimize(); err != nil {
+			msgType, msgText = "error", err.Error()
+		}
+		msgTypes[i], msgTexts[i] = []byte(msgType), []byte(msgText)
+	}
+	bat := batch.New(true, names)
+	for i, vs := range [][][]byte{tables, ops, msgTypes, msgTexts} {
+		bat.Vecs[i] = vector.New(results[i].Type)
+		if err := vector.Append(bat.Vecs[i], vs); err != nil {
+			return err
+		}
+	}
+	bat.InitZsOne(n)
+	return fill(u, bat)
+}
+
 // ShowDatabases fill batch with all database names
 func (s *Scope) ShowDatabases(u interface{}, fill func(interface{}, *batch.Batch) error) error {
 	p, _ := s.Plan.(*plan.ShowDatabases)
@@ -261,7 +376,7 @@ func (s *Scope) ShowColumns(u interface{}, fill func(interface{}, *batch.Batch)
 					attrs[count].dft = nullString
 				} else {
 					switch tableOption.Attr.Type.Oid {
-					case types.T_date, types.T_datetime:
+					case types.T_date, types.T_datetime, types.T_time, types.T_timestamp:
 						attrs[count].dft = fmt.Sprintf("%s", tableOption.Attr.Default.Value)
 					default:
 						attrs[count].dft = fmt.Sprintf("%v", tableOption.Attr.Default.Value)
@@ -287,7 +402,9 @@ func (s *Scope) ShowColumns(u interface{}, fill func(interface{}, *batch.Batch)
 	for i, attr := range attrs {
 		var typ, pri string
 
-		if attr.typ.Width > 0 {
+		if attr.typ.Oid == types.T_decimal64 || attr.typ.Oid == types.T_decimal128 {
+			typ = fmt.Sprintf("decimal(%v,%v)", attr.typ.Width, attr.typ.Precision)
+		} else if attr.typ.Width > 0 {
 			typ = fmt.Sprintf("%s(%v)", strings.ToLower(attr.typ.String()), attr.typ.Width)
 		} else {
 			typ = strings.ToLower(attr.typ.String())
@@ -423,6 +540,30 @@ func (s *Scope) ShowCreateDatabase(u interface{}, fill func(interface{}, *batch.
 	return fill(u, bat)
 }
 
+// Explain fill batch with the description of the plan and the scope tree
+func (s *Scope) Explain(u interface{}, fill func(interface{}, *batch.Batch) error) error {
+	p, _ := s.Plan.(*plan.Explain)
+	attrs := p.ResultColumns()
+	bat := batch.New(true, []string{attrs[0].Name})
+	lines := []string{"plan:"}
+	for _, line := range strings.Split(strings.TrimRight(p.Plan.String(), "\n"), "\n") {
+		lines = append(lines, "    "+line)
+	}
+	lines = append(lines, "scope:")
+	lines = explainScope(lines, "    ", s.PreScopes[0], p.Analyze)
+	vs := make([][]byte, len(lines))
+	for i, line := range lines {
+		vs[i] = []byte(line)
+	}
+	vec := vector.New(attrs[0].Type)
+	if err := vector.Append(vec, vs); err != nil {
+		return err
+	}
+	bat.Vecs[0] = vec
+	bat.InitZsOne(len(vs))
+	return fill(u, bat)
+}
+
 // Insert will insert a batch into relation and return numbers of affectedRow
 func (s *Scope) Insert(ts uint64) (uint64, error) {
 	p, _ := s.Plan.(*plan.Insert)
@@ -430,8 +571,155 @@ func (s *Scope) Insert(ts uint64) (uint64, error) {
 	return uint64(vector.Length(p.Bat.Vecs[0])), p.Relation.Write(ts, p.Bat)
 }
 
+// Delete will delete the tuples fetched by the pre-scope from relation and return numbers of affectedRow
+func (s *Scope) Delete(ts uint64, e engine.Engine) (uint64, error) {
+	p, _ := s.Plan.(*plan.Delete)
+	defer p.Relation.Close()
+	rs := s.PreScopes[0]
+	bats, err := rs.collect(e)
+	defer func() {
+		for _, bat := range bats {
+			batch.Clean(bat, rs.Proc.Mp)
+		}
+	}()
+	if err != nil {
+		return 0, err
+	}
+	affectedRows := uint64(0)
+	for _, bat := range bats {
+		if err := p.Relation.Delete(ts, bat); err != nil {
+			return 0, err
+		}
+		for _, z := range bat.Zs {
+			affectedRows += uint64(z)
+		}
+	}
+	return affectedRows, nil
+}
+
+// Update will replace the tuples fetched by the pre-scope with the new values and return numbers of affectedRow
+func (s *Scope) Update(ts uint64, e engine.Engine) (uint64, error) {
+	p, _ := s.Plan.(*plan.Update)
+	defer p.Relation.Close()
+	rs := s.PreScopes[0]
+	bats, err := rs.collect(e)
+	defer func() {
+		for _, bat := range bats {
+			batch.Clean(bat, rs.Proc.Mp)
+		}
+	}()
+	if err != nil {
+		return 0, err
+	}
+	affectedRows := uint64(0)
+	for _, bat := range bats {
+		if err := s.update(ts, p, bat, rs.Proc); err != nil {
+			return 0, err
+		}
+		for _, z := range bat.Zs {
+			affectedRows += uint64(z)
+		}
+	}
+	return affectedRows, nil
+}
+
+// update computes the new values of the tuples in bat and writes them back to the relation.
+func (s *Scope) update(ts uint64, p *plan.Update, bat *batch.Batch, proc *process.Process) error {
+	var vecs []*vector.Vector // vectors allocated for the new tuples
+
+	defer func() {
+		for _, vec := range vecs {
+			vector.Clean(vec, proc.Mp)
+		}
+	}()
+	n := batch.Length(bat)
+	for _, vec := range bat.Vecs {
+		vec.Ref = 2 // the old tuples must not be overwritten by the evaluation of extends
+	}
+	nbat := batch.New(true, nil) // the new tuples have no row ids
+	for i, attr := range bat.Attrs {
+		if attr != engine.RowId {
+			nbat.Attrs = append(nbat.Attrs, attr)
+			nbat.Vecs = append(nbat.Vecs, bat.Vecs[i])
+		}
+	}
+	nbat.Zs = bat.Zs
+	for i, attr := range p.Attrs {
+		vec, _, err := p.Extends[i].Eval(bat, proc)
+		if err != nil {
+			return err
+		}
+		switch {
+		case len(p.Extends[i].Attributes()) == 0: // constant
+			w := vector.New(vec.Typ)
+			for j := 0; j < n; j++ {
+				if err := vector.UnionOne(w, vec, 0, proc.Mp); err != nil {
+					vector.Clean(w, proc.Mp)
+					return err
+				}
+			}
+			vecs = append(vecs, w)
+			vec = w
+		case !containsVector(bat.Vecs, vec):
+			vecs = append(vecs, vec)
+		}
+		nbat.Vecs[batch.GetVectorIndex(nbat, attr)] = vec
+	}
+	return p.Relation.Update(ts, bat, nbat)
+}
+
+// collect runs the scope and gathers copies of all the result batches.
+func (s *Scope) collect(e engine.Engine) ([]*batch.Batch, error) {
+	var err error
+	var mu sync.Mutex
+	var bats []*batch.Batch
+
+	arg := s.Instructions[len(s.Instructions)-1].Arg.(*output.Argument)
+	arg.Data = nil
+	arg.Func = func(_ interface{}, bat *batch.Batch) error {
+		var err error
+
+		mu.Lock()
+		defer mu.Unlock()
+		rbat := batch.New(true, bat.Attrs)
+		for i, vec := range bat.Vecs {
+			if rbat.Vecs[i], err = vector.Dup(vec, s.Proc.Mp); err != nil {
+				rbat.Vecs = rbat.Vecs[:i]
+				batch.Clean(rbat, s.Proc.Mp)
+				return err
+			}
+		}
+		rbat.Zs = append([]int64{}, bat.Zs...)
+		bats = append(bats, rbat)
+		return nil
+	}
+	switch s.Magic {
+	case Normal:
+		err = s.Run(e)
+	case Merge:
+		err = s.MergeRun(e)
+	case Remote:
+		err = s.RemoteRun(e)
+	case Parallel:
+		err = s.ParallelRun(e)
+	}
+	return bats, err
+}
+
+func containsVector(vecs []*vector.Vector, vec *vector.Vector) bool {
+	for _, v := range vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
+
 // Run read data from storage engine and run the instructions of scope.
 func (s *Scope) Run(e engine.Engine) error {
+	if s.Analyze {
+		analyze(s)
+	}
 	p := pipeline.New(s.DataSource.RefCounts, s.DataSource.Attributes, s.Instructions)
 	if _, err := p.Run(s.DataSource.R, s.Proc); err != nil {
 		return err
@@ -443,6 +731,9 @@ func (s *Scope) Run(e engine.Engine) error {
 func (s *Scope) MergeRun(e engine.Engine) error {
 	var err error
 
+	if s.Analyze {
+		analyze(s)
+	}
 	for i := range s.PreScopes {
 		switch s.PreScopes[i].Magic {
 		case Normal:
@@ -548,6 +839,11 @@ func (s *Scope) RemoteRun(e engine.Engine) error {
 			continue
 		}
 		select {
+		case <-s.Proc.Ctx.Done():
+			if bat != nil {
+				batch.Clean(bat, s.Proc.Mp)
+			}
+			return process.Interrupted(s.Proc)
 		case <-arg.Reg.Ctx.Done():
 		case arg.Reg.Ch <- bat:
 		}
@@ -589,7 +885,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 		if err != nil {
 			return err
 		}
-		rel, err := db.Relation(s.DataSource.RelationName)
+		rel, err := openRelation(db, s.DataSource.RelationName, s.DataSource.AsOf)
 		if err != nil {
 			return err
 		}
@@ -607,6 +903,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 				IsMerge:      s.DataSource.IsMerge,
 				SchemaName:   s.DataSource.SchemaName,
 				RelationName: s.DataSource.RelationName,
+				AsOf:         s.DataSource.AsOf,
 				RefCounts:    s.DataSource.RefCounts,
 				Attributes:   s.DataSource.Attributes,
 			},
@@ -625,6 +922,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.Ctx = s.Proc.Ctx
 	}
 
 	opTyp := s.Instructions[len(s.Instructions)-2].Op  // push-down operator's type
@@ -735,7 +1033,7 @@ func (s *Scope) RunAQ(e engine.Engine) error {
 		if err != nil {
 			return err
 		}
-		rel, err := db.Relation(s.DataSource.RelationName)
+		rel, err := openRelation(db, s.DataSource.RelationName, s.DataSource.AsOf)
 		if err != nil {
 			return err
 		}
@@ -751,6 +1049,7 @@ func (s *Scope) RunAQ(e engine.Engine) error {
 				IsMerge:      s.DataSource.IsMerge,
 				SchemaName:   s.DataSource.SchemaName,
 				RelationName: s.DataSource.RelationName,
+				AsOf:         s.DataSource.AsOf,
 				RefCounts:    s.DataSource.RefCounts,
 				Attributes:   s.DataSource.Attributes,
 			},
@@ -769,6 +1068,7 @@ func (s *Scope) RunAQ(e engine.Engine) error {
 		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.Ctx = s.Proc.Ctx
 	}
 	for len(ss) > 3 {
 		ss = newMergeScope(ss, arg.Typ, s.Proc)
@@ -805,43 +1105,25 @@ func (s *Scope) RunAQ(e engine.Engine) error {
 // RunCAQ run the scope which sql is a query with both aggregate functions and join operators.
 func (s *Scope) RunCAQ(e engine.Engine) error {
 	var err error
+	var rel engine.Relation
 	var rds []engine.Reader
 	var arg *times.Argument
 
 	mcpu := runtime.NumCPU()
-	ss := make([]*Scope, mcpu)
 	{
 		s0 := s.PreScopes[0]
 		db, err := e.Database(s0.DataSource.SchemaName)
 		if err != nil {
 			return err
 		}
-		rel, err := db.Relation(s0.DataSource.RelationName)
-		if err != nil {
+		if rel, err = openRelation(db, s0.DataSource.RelationName, s0.DataSource.AsOf); err != nil {
 			return err
 		}
 		defer rel.Close()
-		rds = rel.NewReader(mcpu)
 		s.DataSource = s0.DataSource
 		arg = s.Instructions[0].Arg.(*times.Argument)
 		arg.Arg = s0.Instructions[0].Arg.(*transform.Argument)
 	}
-	for i := 0; i < mcpu; i++ {
-		ss[i] = &Scope{
-			Magic: Normal,
-			DataSource: &Source{
-				R:            rds[i],
-				IsMerge:      s.DataSource.IsMerge,
-				SchemaName:   s.DataSource.SchemaName,
-				RelationName: s.DataSource.RelationName,
-				RefCounts:    s.DataSource.RefCounts,
-				Attributes:   s.DataSource.Attributes,
-			},
-		}
-		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
-		ss[i].Proc.Id = s.Proc.Id
-		ss[i].Proc.Lim = s.Proc.Lim
-	}
 	s.PreScopes = s.PreScopes[1:]
 	ctx, cancel := context.WithCancel(context.Background())
 	s.Proc.Cancel = cancel
@@ -904,17 +1186,72 @@ func (s *Scope) RunCAQ(e engine.Engine) error {
 		}
 		return err
 	}
+	// the views and their partitions are freed after all the times are done
+	defer func() {
+		for _, bat := range arg.Bats {
+			if bat != nil {
+				batch.Clean(bat, s.Proc.Mp)
+			}
+		}
+		spill.CloseFiles(arg.Parts)
+	}()
 	for i := 0; i < len(s.Proc.Reg.MergeReceivers); i++ {
+		var view *batch.Batch
+		var parts []*spill.File
+
 		reg := s.Proc.Reg.MergeReceivers[i]
-		bat := <-reg.Ch
-		if bat == nil {
-			continue
+		for {
+			bat, err := process.Receive(s.Proc, reg)
+			if err != nil {
+				if view != nil {
+					batch.Clean(view, s.Proc.Mp)
+				}
+				spill.CloseFiles(parts)
+				return err
+			}
+			if bat == nil {
+				break
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if view == nil && parts == nil {
+				view = bat
+				continue
+			}
+			// the view is sent in several batches if its aggregation spilled,
+			// it is partitioned by its key and joined one partition at a time
+			if arg.Parts != nil {
+				batch.Clean(bat, s.Proc.Mp)
+				if view != nil {
+					batch.Clean(view, s.Proc.Mp)
+				}
+				spill.CloseFiles(parts)
+				return errors.New(errno.InsufficientResources, "more than one view of the join is too large to be kept in memory")
+			}
+			if view != nil {
+				attrs := append([]string{}, view.Attrs...)
+				parts, err = spill.WritePartitionsBy(parts, view, times.KeyPartition(batch.GetVector(view, arg.Svars[i])), s.Proc)
+				view = nil
+				if err != nil {
+					batch.Clean(bat, s.Proc.Mp)
+					spill.CloseFiles(parts)
+					return err
+				}
+				// the partitions of the view are read back in one batch
+				batch.Reorder(bat, attrs)
+			}
+			if parts, err = spill.WritePartitionsBy(parts, bat, times.KeyPartition(batch.GetVector(bat, arg.Svars[i])), s.Proc); err != nil {
+				spill.CloseFiles(parts)
+				return err
+			}
 		}
-		if len(bat.Zs) == 0 {
-			i--
-			continue
+		if parts != nil {
+			arg.Parts, arg.PartView = parts, i
+		}
+		if view != nil || parts != nil {
+			arg.Bats = append(arg.Bats, view)
 		}
-		arg.Bats = append(arg.Bats, bat)
 	}
 	if len(arg.Bats) != len(arg.Svars) {
 		for i, in := range s.Instructions {
@@ -930,7 +1267,30 @@ func (s *Scope) RunCAQ(e engine.Engine) error {
 		return nil
 	}
 	constructViews(arg.Bats, arg.Svars)
+	// the partitions of the view which spilled are joined by one times,
+	// so that only one of them is kept in memory at a time
+	if arg.Parts != nil {
+		mcpu = 1
+	}
+	rds = rel.NewReader(mcpu)
+	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
+		ss[i] = &Scope{
+			Magic: Normal,
+			DataSource: &Source{
+				R:            rds[i],
+				IsMerge:      s.DataSource.IsMerge,
+				SchemaName:   s.DataSource.SchemaName,
+				RelationName: s.DataSource.RelationName,
+				AsOf:         s.DataSource.AsOf,
+				RefCounts:    s.DataSource.RefCounts,
+				Attributes:   s.DataSource.Attributes,
+			},
+		}
+		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
+		ss[i].Proc.Id = s.Proc.Id
+		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.Ctx = s.Proc.Ctx
 		ss[i].Instructions = vm.Instructions{vm.Instruction{
 			Op: vm.Times,
 			Arg: &times.Argument{
@@ -941,6 +1301,8 @@ func (s *Scope) RunCAQ(e engine.Engine) error {
 				Svars:    arg.Svars,
 				VarsMap:  arg.VarsMap,
 				Bats:     arg.Bats,
+				Parts:    arg.Parts,
+				PartView: arg.PartView,
 				FreeVars: arg.FreeVars,
 				Arg: &transform.Argument{
 					Typ:        arg.Arg.Typ,
@@ -959,7 +1321,11 @@ func (s *Scope) RunCAQ(e engine.Engine) error {
 	rs := &Scope{
 		PreScopes: ss,
 		Magic:     Merge,
+		Analyze:   s.Analyze,
 	}
+	// rs takes over the instructions of s, its children are shown as
+	// the children of s by EXPLAIN ANALYZE
+	s.PreScopes = append(s.PreScopes, ss...)
 	rs.Instructions = s.Instructions
 	rs.Instructions[0] = vm.Instruction{
 		Op:  vm.Plus,
@@ -972,6 +1338,7 @@ func (s *Scope) RunCAQ(e engine.Engine) error {
 		rs.Proc.Cancel = cancel
 		rs.Proc.Id = s.Proc.Id
 		rs.Proc.Lim = s.Proc.Lim
+		rs.Proc.Ctx = s.Proc.Ctx
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1024,6 +1391,7 @@ func newMergeScope(ss []*Scope, typ int, proc *process.Process) []*Scope {
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Ctx = proc.Ctx
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1078,6 +1446,7 @@ func newMergeOrderScope(ss []*Scope, arg *mergeorder.Argument, proc *process.Pro
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Ctx = proc.Ctx
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1132,6 +1501,7 @@ func newMergeLimitScope(ss []*Scope, limit uint64, proc *process.Process) []*Sco
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Ctx = proc.Ctx
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1189,6 +1559,7 @@ func newMergeTopScope(ss []*Scope, arg *mergetop.Argument, proc *process.Process
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Ctx = proc.Ctx
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1243,6 +1614,7 @@ func newMergeDedupScope(ss []*Scope, proc *process.Process) []*Scope {
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Id = proc.Id
 			rs[i].Proc.Lim = proc.Lim
+			rs[i].Proc.Ctx = proc.Ctx
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1265,3 +1637,22 @@ func newMergeDedupScope(ss []*Scope, proc *process.Process) []*Scope {
 	}
 	return rs
 }
+
+// openRelation opens a relation of db, as it was at asOf if it is not zero.
+func openRelation(db engine.Database, name string, asOf int64) (engine.Relation, error) {
+	rel, err := db.Relation(name)
+	if err != nil || asOf == 0 {
+		return rel, err
+	}
+	hrel, ok := engine.Unwrap(rel).(engine.HistoryRelation)
+	if !ok {
+		rel.Close()
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("table '%s' does not keep its history", name))
+	}
+	r, err := hrel.AsOf(asOf)
+	if err != nil {
+		rel.Close()
+		return nil, errors.New(errno.DataException, err.Error())
+	}
+	return r, nil
+}
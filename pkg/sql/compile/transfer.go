@@ -39,6 +39,7 @@ func Transfer(s *Scope) protocol.Scope {
 		ps.DataSource.IsMerge = s.DataSource.IsMerge
 		ps.DataSource.SchemaName = s.DataSource.SchemaName
 		ps.DataSource.RelationName = s.DataSource.RelationName
+		ps.DataSource.AsOf = s.DataSource.AsOf
 		ps.DataSource.RefCounts = s.DataSource.RefCounts
 		ps.DataSource.Attributes = s.DataSource.Attributes
 	}
@@ -58,6 +59,7 @@ func Untransfer(s *Scope, ps protocol.Scope)  {
 		s.DataSource.IsMerge = ps.DataSource.IsMerge
 		s.DataSource.SchemaName = ps.DataSource.SchemaName
 		s.DataSource.RelationName = ps.DataSource.RelationName
+		s.DataSource.AsOf = ps.DataSource.AsOf
 		s.DataSource.RefCounts = ps.DataSource.RefCounts
 		s.DataSource.Attributes = ps.DataSource.Attributes
 	}
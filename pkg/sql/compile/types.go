@@ -15,10 +15,14 @@
 package compile
 
 import (
+	"sync"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/privilege"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -36,11 +40,16 @@ const (
 	DropDatabase
 	DropTable
 	DropIndex
+	AlterTable
+	OptimizeTable
 	ShowDatabases
 	ShowTables
 	ShowColumns
 	ShowCreateTable
 	ShowCreateDatabase
+	Delete
+	Update
+	Explain
 )
 
 var Address string
@@ -50,6 +59,7 @@ type Source struct {
 	IsMerge      bool
 	SchemaName   string
 	RelationName string
+	AsOf         int64 // unix time in nanoseconds the relation is read as of
 	RefCounts    []uint64
 	Attributes   []string
 	R            engine.Reader
@@ -75,6 +85,9 @@ type Scope struct {
 	Instructions vm.Instructions
 	// Proc contains the execution context.
 	Proc *process.Process
+	// Analyze, if true, the instructions of this scope and its children
+	// collect runtime statistics for EXPLAIN ANALYZE.
+	Analyze bool
 }
 
 type Col struct {
@@ -100,6 +113,12 @@ type Exec struct {
 	//fill is a result writer runs a callback function.
 	//fill will be called when result data is ready.
 	fill func(interface{}, *batch.Batch) error
+	//mu guards killed and cancel, the query may be killed by another goroutine.
+	mu sync.Mutex
+	//killed is true if the query has been killed.
+	killed bool
+	//cancel aborts the processes of the query with the error.
+	cancel func(error)
 }
 
 // compile contains all the information needed for compilation.
@@ -108,8 +127,14 @@ type compile struct {
 	db string
 	// uid the user who initiated the sql.
 	uid string
+	// privs the privileges of the user, nil if the user is not restricted.
+	privs *privilege.Privileges
+	// loc the time zone of the session.
+	loc *time.Location
 	// sql sql text.
 	sql string
+	// params the values bound to the placeholders of a prepared statement.
+	params []tree.Expr
 	// e db engine instance.
 	e engine.Engine
 	// proc stores the execution context.
@@ -0,0 +1,200 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/offset"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/union"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var setTypes = [...]int{
+	plan.Union:     union.Union,
+	plan.Intersect: union.Intersect,
+	plan.Except:    union.Except,
+}
+
+// compileSetQuery builds the scope of set operation whose pre-scopes are the scopes of
+// its operands, and the operands of successive UNIONs are merged by one scope.
+func (e *Exec) compileSetQuery(qry *plan.SetQuery) (*Scope, error) {
+	if qry.Limit == 0 {
+		return nil, nil
+	}
+	arg := &union.Argument{
+		Typ:     setTypes[qry.Type],
+		All:     qry.All,
+		Attrs:   make([]string, len(qry.ResultAttributes)),
+		Types:   make([]types.Type, len(qry.ResultAttributes)),
+		Collect: len(qry.Fields) > 0, // the result is sorted as a whole
+	}
+	for i, attr := range qry.ResultAttributes {
+		arg.Attrs[i] = attr.Name
+		arg.Types[i] = attr.Type
+	}
+	ps := setOperands(qry, nil)
+	rs := &Scope{Magic: Merge}
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+	rs.Proc.Cancel = cancel
+	rs.Proc.Id = e.c.proc.Id
+	rs.Proc.Lim = e.c.proc.Lim
+	rs.Proc.Ctx = e.c.proc.Ctx
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ps))
+	for i, p := range ps {
+		rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+			Ctx: ctx,
+			Ch:  make(chan *batch.Batch, 1),
+		}
+		s, attrs, err := e.compileSetOperand(p)
+		if err != nil {
+			return nil, err
+		}
+		if s == nil { // the operand is empty
+			rs.Proc.Reg.MergeReceivers[i].Ch <- nil
+			arg.Es = append(arg.Es, nil)
+			continue
+		}
+		s.Instructions = append(s.Instructions, vm.Instruction{
+			Op: vm.Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+		rs.PreScopes = append(rs.PreScopes, s)
+		es := make([]extend.Extend, len(attrs))
+		for j, attr := range p.ResultColumns() {
+			es[j] = &extend.Attribute{Name: attrs[j], Type: attr.Type.Oid}
+			if needCast(attr.Type.Oid, arg.Types[j].Oid) {
+				es[j] = &extend.BinaryExtend{
+					Op:    overload.Typecast,
+					Left:  es[j],
+					Right: &extend.ValueExtend{V: vector.New(arg.Types[j])},
+				}
+			}
+		}
+		arg.Es = append(arg.Es, es)
+	}
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  vm.Union,
+		Arg: arg,
+	})
+	if len(qry.Fields) > 0 && qry.Limit > 0 && qry.Offset == -1 {
+		arg := &top.Argument{Limit: qry.Limit, Fs: make([]top.Field, len(qry.Fields))}
+		for i, f := range qry.Fields {
+			arg.Fs[i].Attr = f.Attr
+			arg.Fs[i].Type = top.Direction(f.Type)
+		}
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op:  vm.Top,
+			Arg: arg,
+		})
+	} else {
+		if len(qry.Fields) > 0 {
+			arg := &order.Argument{Fs: make([]order.Field, len(qry.Fields))}
+			for i, f := range qry.Fields {
+				arg.Fs[i].Attr = f.Attr
+				arg.Fs[i].Type = order.Direction(f.Type)
+			}
+			rs.Instructions = append(rs.Instructions, vm.Instruction{
+				Op:  vm.Order,
+				Arg: arg,
+			})
+		}
+		if qry.Offset > 0 {
+			rs.Instructions = append(rs.Instructions, vm.Instruction{
+				Op:  vm.Offset,
+				Arg: &offset.Argument{Offset: uint64(qry.Offset)},
+			})
+		}
+		if qry.Limit > 0 {
+			rs.Instructions = append(rs.Instructions, vm.Instruction{
+				Op:  vm.Limit,
+				Arg: &limit.Argument{Limit: uint64(qry.Limit)},
+			})
+		}
+	}
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op: vm.Output,
+		Arg: &output.Argument{
+			Attrs: arg.Attrs,
+			Data:  e.u,
+			Func:  e.fill,
+		},
+	})
+	return rs, nil
+}
+
+// compileSetOperand returns the scope of an operand of set operation without
+// output, and the attributes of its result.
+func (e *Exec) compileSetOperand(p plan.Plan) (*Scope, []string, error) {
+	var s *Scope
+	var err error
+
+	switch p := p.(type) {
+	case *plan.Query:
+		s, err = e.compileTarget(p)
+	case *plan.SetQuery:
+		s, err = e.compileSetQuery(p)
+	}
+	if err != nil || s == nil {
+		return nil, nil, err
+	}
+	arg := s.Instructions[len(s.Instructions)-1].Arg.(*output.Argument)
+	s.Instructions = s.Instructions[:len(s.Instructions)-1] // drop the output
+	return s, arg.Attrs, nil
+}
+
+// setOperands returns the operands of set operation, the operands of UNIONs
+// without ORDER BY and LIMIT are merged into the operands of their parent.
+func setOperands(qry *plan.SetQuery, ps []plan.Plan) []plan.Plan {
+	if qry.Type != plan.Union {
+		return append(ps, qry.Left, qry.Right)
+	}
+	for _, p := range []plan.Plan{qry.Left, qry.Right} {
+		if q, ok := p.(*plan.SetQuery); ok && q.Type == plan.Union && (q.All || !qry.All) &&
+			len(q.Fields) == 0 && q.Limit == -1 && q.Offset == -1 {
+			ps = setOperands(q, ps)
+			continue
+		}
+		ps = append(ps, p)
+	}
+	return ps
+}
+
+// needCast returns true if the values of type x should be cast to type y,
+// char and varchar share the same representation.
+func needCast(x, y types.T) bool {
+	if x == y {
+		return false
+	}
+	return !((x == types.T_char || x == types.T_varchar) && (y == types.T_char || y == types.T_varchar))
+}
@@ -28,7 +28,10 @@ const (
 
 func constructViews(bats []*batch.Batch, fvars []string) {
 	for i, fvar := range fvars {
-		constructView(bats[i], fvar)
+		// the view which spilled has its hash tables built by times, one per partition
+		if bats[i] != nil {
+			constructView(bats[i], fvar)
+		}
 	}
 }
 
@@ -160,6 +163,46 @@ func constructView(bat *batch.Batch, fvar string) {
 			bat.Ht = ht
 			return
 		}
+	case types.T_time:
+		vs := vec.Col.([]types.Time)
+		count := int64(len(bat.Zs))
+		for i := int64(0); i < count; i += UnitLimit {
+			n := int(count - i)
+			if n > UnitLimit {
+				n = UnitLimit
+			}
+			{
+				for k := 0; k < n; k++ {
+					keys[k] = uint64(vs[int(i)+k])
+				}
+			}
+			hashes[0] = 0
+			ht.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
+		}
+		if len(bat.Zs) == int(ht.Cardinality()) {
+			bat.Ht = ht
+			return
+		}
+	case types.T_timestamp:
+		vs := vec.Col.([]types.Timestamp)
+		count := int64(len(bat.Zs))
+		for i := int64(0); i < count; i += UnitLimit {
+			n := int(count - i)
+			if n > UnitLimit {
+				n = UnitLimit
+			}
+			{
+				for k := 0; k < n; k++ {
+					keys[k] = uint64(vs[int(i)+k])
+				}
+			}
+			hashes[0] = 0
+			ht.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
+		}
+		if len(bat.Zs) == int(ht.Cardinality()) {
+			bat.Ht = ht
+			return
+		}
 	case types.T_uint8:
 		vs := vec.Col.([]uint8)
 		count := int64(len(bat.Zs))
@@ -280,7 +323,27 @@ func constructView(bat *batch.Batch, fvar string) {
 			bat.Ht = ht
 			return
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_decimal64:
+		vs := vec.Col.([]types.Decimal64)
+		count := int64(len(bat.Zs))
+		for i := int64(0); i < count; i += UnitLimit {
+			n := int(count - i)
+			if n > UnitLimit {
+				n = UnitLimit
+			}
+			{
+				for k := 0; k < n; k++ {
+					keys[k] = uint64(vs[int(i)+k])
+				}
+			}
+			hashes[0] = 0
+			ht.InsertBatch(n, hashes, unsafe.Pointer(&keys[0]), values)
+		}
+		if len(bat.Zs) == int(ht.Cardinality()) {
+			bat.Ht = ht
+			return
+		}
+	case types.T_char, types.T_varchar, types.T_json:
 		ht := &hashtable.StringHashMap{}
 		ht.Init()
 		var strKeys [UnitLimit][]byte
@@ -32,6 +32,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/untransform"
 	"github.com/matrixorigin/matrixone/pkg/sql/vtree"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -55,6 +56,11 @@ func (e *Exec) compileVTree(vt *vtree.ViewTree, varsMap map[string]int) (*Scope,
 	isB := vt.IsBare() // if true, it is a query without aggregate functions
 	d := depth(vt.Views)
 	switch {
+	case vt.Views[len(vt.Views)-1].Join != nil:
+		// Multi-table queries whose relations are joined one by one
+		if s, err = e.compileJoin(vt.Views[len(vt.Views)-1]); err != nil {
+			return nil, err
+		}
 	case d == 0 && isB:
 		// Single table queries without aggregate functions
 		if s, err = e.compileQ(vt, vt.Views[len(vt.Views)-1]); err != nil {
@@ -86,6 +92,7 @@ func (e *Exec) compileVTree(vt *vtree.ViewTree, varsMap map[string]int) (*Scope,
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = e.c.proc.Id
 	rs.Proc.Lim = e.c.proc.Lim
+	rs.Proc.Ctx = e.c.proc.Ctx
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 	rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 		Ctx: ctx,
@@ -116,6 +123,12 @@ func (e *Exec) compileVTree(vt *vtree.ViewTree, varsMap map[string]int) (*Scope,
 			},
 		})
 	}
+	if vt.Window != nil {
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op:  vm.Window,
+			Arg: vt.Window,
+		})
+	}
 	if vt.Projection != nil {
 		rs.Instructions = append(rs.Instructions, vm.Instruction{
 			Op:  vm.Projection,
@@ -158,9 +171,9 @@ func (e *Exec) compileVTree(vt *vtree.ViewTree, varsMap map[string]int) (*Scope,
 			Arg: vt.Limit,
 		})
 	}
-	attrs := make([]string, len(e.resultCols))
-	for i, col := range e.resultCols {
-		attrs[i] = col.Name
+	attrs := make([]string, len(vt.ResultVariables))
+	for i, rv := range vt.ResultVariables {
+		attrs[i] = rv.Name
 	}
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op: vm.Output,
@@ -173,6 +186,142 @@ func (e *Exec) compileVTree(vt *vtree.ViewTree, varsMap map[string]int) (*Scope,
 	return rs, nil
 }
 
+// compileJoin builds the scope which joins the children of view one by one,
+// each child is scanned by a merge scope which sends its result to the join scope.
+func (e *Exec) compileJoin(v *vtree.View) (*Scope, error) {
+	ss := make([]*Scope, len(v.Children))
+	for i, chd := range v.Children {
+		s, err := e.compileJoinRelation(chd)
+		if err != nil {
+			return nil, err
+		}
+		ss[i] = s
+	}
+	switch {
+	case len(v.Arg.BoundVars) == 0 && len(v.Arg.FreeVars) == 0:
+		v.Arg.Typ = transform.Bare
+	case len(v.Arg.FreeVars) == 0:
+		v.Arg.Typ = transform.BoundVars
+	default:
+		v.Arg.Typ = transform.FreeVarsAndBoundVars
+	}
+	rs := &Scope{
+		PreScopes: ss,
+		Magic:     Merge,
+		Instructions: vm.Instructions{
+			vm.Instruction{Op: vm.Join, Arg: v.Join},
+			vm.Instruction{Op: vm.Transform, Arg: v.Arg},
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+	rs.Proc.Cancel = cancel
+	rs.Proc.Id = e.c.proc.Id
+	rs.Proc.Lim = e.c.proc.Lim
+	rs.Proc.Ctx = e.c.proc.Ctx
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
+	{
+		for i := 0; i < len(ss); i++ {
+			rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+				Ctx: ctx,
+				Ch:  make(chan *batch.Batch, 1),
+			}
+		}
+	}
+	for i := range ss {
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op: vm.Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	return rs, nil
+}
+
+// compileJoinRelation builds the scope which scans a relation to be joined.
+func (e *Exec) compileJoinRelation(v *vtree.View) (*Scope, error) {
+	if v.Rel.Query != nil {
+		return e.compileSubquery(v)
+	}
+	db, err := e.c.e.Database(v.Rel.Schema)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := openRelation(db, v.Rel.Name, v.Rel.AsOf)
+	if err != nil {
+		return nil, err
+	}
+	defer rel.Close()
+	src := &Source{
+		RelationName: v.Rel.Name,
+		SchemaName:   v.Rel.Schema,
+		AsOf:         v.Rel.AsOf,
+		RefCounts:    make([]uint64, len(v.Rel.Vars)),
+		Attributes:   make([]string, len(v.Rel.Vars)),
+	}
+	for i := range v.Rel.Vars {
+		src.Attributes[i] = v.Rel.Vars[i].Name
+		src.RefCounts[i] = uint64(v.Rel.Vars[i].Ref)
+	}
+	if len(src.Attributes) == 0 { // only the number of tuples is needed
+		for _, def := range rel.TableDefs() {
+			if attr, ok := def.(*engine.AttributeDef); ok {
+				src.Attributes = append(src.Attributes, attr.Attr.Name)
+				src.RefCounts = append(src.RefCounts, 1)
+				break
+			}
+		}
+	}
+	v.Arg.Typ = transform.Bare
+	ins := vm.Instructions{vm.Instruction{Op: vm.Transform, Arg: v.Arg}}
+	ns := rel.Nodes()
+	ss := make([]*Scope, len(ns))
+	for i := range ns {
+		ss[i] = &Scope{
+			DataSource:   src,
+			Instructions: ins,
+			NodeInfo:     ns[i],
+			Magic:        Remote,
+		}
+		ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		ss[i].Proc.Id = e.c.proc.Id
+		ss[i].Proc.Lim = e.c.proc.Lim
+		ss[i].Proc.Ctx = e.c.proc.Ctx
+	}
+	rs := &Scope{
+		PreScopes:    ss,
+		Magic:        Merge,
+		Instructions: vm.Instructions{vm.Instruction{Op: vm.Merge}},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+	rs.Proc.Cancel = cancel
+	rs.Proc.Id = e.c.proc.Id
+	rs.Proc.Lim = e.c.proc.Lim
+	rs.Proc.Ctx = e.c.proc.Ctx
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
+	{
+		for i := 0; i < len(ss); i++ {
+			rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+				Ctx: ctx,
+				Ch:  make(chan *batch.Batch, 1),
+			}
+		}
+	}
+	for i := range ss {
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op: vm.Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	return rs, nil
+}
+
 // compileCAQ builds the scope which sql is a query with both aggregate functions and join operators.
 func (e *Exec) compileCAQ(freeVars []string, vs []*vtree.View, varsMap, fvarsMap map[string]int) (*Scope, error) {
 	var ss []*Scope
@@ -210,7 +359,7 @@ func (e *Exec) compileTimes(v *vtree.View, children []*Scope, arg *times.Argumen
 	if err != nil {
 		return nil, err
 	}
-	rel, err := db.Relation(v.Rel.Name)
+	rel, err := openRelation(db, v.Rel.Name, v.Rel.AsOf)
 	if err != nil {
 		return nil, err
 	}
@@ -219,6 +368,7 @@ func (e *Exec) compileTimes(v *vtree.View, children []*Scope, arg *times.Argumen
 	src := &Source{
 		RelationName: v.Rel.Name,
 		SchemaName:   v.Rel.Schema,
+		AsOf:         v.Rel.AsOf,
 		RefCounts:    make([]uint64, len(v.Rel.Vars)),
 		Attributes:   make([]string, len(v.Rel.Vars)),
 	}
@@ -247,6 +397,7 @@ func (e *Exec) compileTimes(v *vtree.View, children []*Scope, arg *times.Argumen
 		s.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 		s.Proc.Id = e.c.proc.Id
 		s.Proc.Lim = e.c.proc.Lim
+		s.Proc.Ctx = e.c.proc.Ctx
 		ss[i] = &Scope{
 			NodeInfo:  ns[i],
 			PreScopes: append([]*Scope{s}, children...),
@@ -259,6 +410,7 @@ func (e *Exec) compileTimes(v *vtree.View, children []*Scope, arg *times.Argumen
 		ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = e.c.proc.Id
 		ss[i].Proc.Lim = e.c.proc.Lim
+		ss[i].Proc.Ctx = e.c.proc.Ctx
 	}
 	rs := &Scope{
 		PreScopes: ss,
@@ -273,6 +425,7 @@ func (e *Exec) compileTimes(v *vtree.View, children []*Scope, arg *times.Argumen
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = e.c.proc.Id
 	rs.Proc.Lim = e.c.proc.Lim
+	rs.Proc.Ctx = e.c.proc.Ctx
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -294,6 +447,24 @@ func (e *Exec) compileTimes(v *vtree.View, children []*Scope, arg *times.Argumen
 	return rs, nil
 }
 
+// compileSubquery builds the scope of a relation which is a subquery, the
+// result of subquery is sent to the join scope instead of the output.
+func (e *Exec) compileSubquery(v *vtree.View) (*Scope, error) {
+	s, err := e.compileTarget(v.Rel.Query)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, errors.New(errno.FeatureNotSupported, "subquery with limit 0 is not support now")
+	}
+	s.Instructions = s.Instructions[:len(s.Instructions)-1] // drop the output
+	if v.Arg.Restrict != nil || v.Arg.Projection != nil {
+		v.Arg.Typ = transform.Bare
+		s.Instructions = append(s.Instructions, vm.Instruction{Op: vm.Transform, Arg: v.Arg})
+	}
+	return s, nil
+}
+
 // compileQ builds the scope which sql is a query for single table and without any aggregate functions
 // In this function, we push down an operator like order, deduplicate, transform, limit or top.
 // And do merge work for them at the top scope
@@ -321,7 +492,7 @@ func (e *Exec) compileQ(vt *vtree.ViewTree, v *vtree.View) (*Scope, error) {
 	if err != nil {
 		return nil, err
 	}
-	rel, err := db.Relation(v.Rel.Name)
+	rel, err := openRelation(db, v.Rel.Name, v.Rel.AsOf)
 	if err != nil {
 		return nil, err
 	}
@@ -331,6 +502,7 @@ func (e *Exec) compileQ(vt *vtree.ViewTree, v *vtree.View) (*Scope, error) {
 		IsMerge:      false,
 		RelationName: v.Rel.Name,
 		SchemaName:   v.Rel.Schema,
+		AsOf:         v.Rel.AsOf,
 		RefCounts:    make([]uint64, len(v.Rel.Vars)),
 		Attributes:   make([]string, len(v.Rel.Vars)),
 	}
@@ -345,6 +517,11 @@ func (e *Exec) compileQ(vt *vtree.ViewTree, v *vtree.View) (*Scope, error) {
 	// push down operators
 	ins = append(ins, vm.Instruction{Arg: v.Arg, Op: vm.Transform})
 	switch {
+	case vt.Window != nil:
+		// window functions need all the rows, so nothing can be pushed down
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op: vm.Merge,
+		})
 	case vt.Order != nil && vt.Dedup != nil:
 		// TODO: this case should push down a new operator to do both order and deduplication,
 		//   and we push down the order operator temporarily
@@ -418,6 +595,7 @@ func (e *Exec) compileQ(vt *vtree.ViewTree, v *vtree.View) (*Scope, error) {
 		ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = e.c.proc.Id
 		ss[i].Proc.Lim = e.c.proc.Lim
+		ss[i].Proc.Ctx = e.c.proc.Ctx
 	}
 
 	// init rs
@@ -427,6 +605,7 @@ func (e *Exec) compileQ(vt *vtree.ViewTree, v *vtree.View) (*Scope, error) {
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = e.c.proc.Id
 	rs.Proc.Lim = e.c.proc.Lim
+	rs.Proc.Ctx = e.c.proc.Ctx
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -447,6 +626,12 @@ func (e *Exec) compileQ(vt *vtree.ViewTree, v *vtree.View) (*Scope, error) {
 		})
 	}
 	// init instructions for top scope
+	if vt.Window != nil {
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op:  vm.Window,
+			Arg: vt.Window,
+		})
+	}
 	if vt.Projection != nil {
 		rs.Instructions = append(rs.Instructions, vm.Instruction{
 			Op:  vm.Projection,
@@ -512,7 +697,7 @@ func (e *Exec) compileAQ(v *vtree.View) (*Scope, error) {
 	if err != nil {
 		return nil, err
 	}
-	rel, err := db.Relation(v.Rel.Name)
+	rel, err := openRelation(db, v.Rel.Name, v.Rel.AsOf)
 	if err != nil {
 		return nil, err
 	}
@@ -521,6 +706,7 @@ func (e *Exec) compileAQ(v *vtree.View) (*Scope, error) {
 		IsMerge:      false,
 		RelationName: v.Rel.Name,
 		SchemaName:   v.Rel.Schema,
+		AsOf:         v.Rel.AsOf,
 		RefCounts:    make([]uint64, len(v.Rel.Vars)),
 		Attributes:   make([]string, len(v.Rel.Vars)),
 	}
@@ -548,6 +734,7 @@ func (e *Exec) compileAQ(v *vtree.View) (*Scope, error) {
 		ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
 		ss[i].Proc.Id = e.c.proc.Id
 		ss[i].Proc.Lim = e.c.proc.Lim
+		ss[i].Proc.Ctx = e.c.proc.Ctx
 	}
 	rs := &Scope{
 		PreScopes: ss,
@@ -562,6 +749,7 @@ func (e *Exec) compileAQ(v *vtree.View) (*Scope, error) {
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = e.c.proc.Id
 	rs.Proc.Lim = e.c.proc.Lim
+	rs.Proc.Ctx = e.c.proc.Ctx
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
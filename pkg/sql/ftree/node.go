@@ -37,8 +37,9 @@ func selectRoot(qry *plan.Query) (string, error) {
 		mp[cond.R]++
 		mp[cond.S]++
 	}
-	for k, v := range mp {
-		if v > cnt {
+	// the relations are visited in order, so that the root is the same for the same query
+	for _, k := range qry.Rels {
+		if v := mp[k]; v > cnt {
 			rel = k
 			cnt = v
 		}
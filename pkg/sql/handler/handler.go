@@ -23,6 +23,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/protocol"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 
 	"github.com/fagongzi/goetty"
@@ -40,7 +43,12 @@ func (hp *Handler) Process(_ uint64, val interface{}, conn goetty.IOSession) err
 	if err != nil {
 		return err
 	}
-	s := recoverScope(ps, hp.proc)
+	// the memory which is not freed by the scope is given back when it is done
+	quota := host.NewQuota(hp.proc.Mp.Gm.Limit, hp.proc.Mp.Gm.Mmu)
+	defer quota.Release()
+	proc := process.New(mheap.New(guest.New(hp.proc.Mp.Gm.Limit, quota)))
+	proc.Lim = hp.proc.Lim
+	s := recoverScope(ps, proc)
 	s.Instructions[len(s.Instructions)-1] = vm.Instruction{
 		Op: vm.Output,
 		Arg: &output.Argument{
@@ -50,6 +50,7 @@ func recoverScope(ps protocol.Scope, proc *process.Process) *compile.Scope {
 		s.DataSource.IsMerge = ps.DataSource.IsMerge
 		s.DataSource.SchemaName = ps.DataSource.SchemaName
 		s.DataSource.RelationName = ps.DataSource.RelationName
+		s.DataSource.AsOf = ps.DataSource.AsOf
 		s.DataSource.RefCounts = ps.DataSource.RefCounts
 		s.DataSource.Attributes = ps.DataSource.Attributes
 	}
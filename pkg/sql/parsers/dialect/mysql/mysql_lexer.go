@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/scanner"
@@ -33,6 +34,29 @@ func Parse(sql string) ([]tree.Statement, error) {
 	return lexer.stmts, nil
 }
 
+// ParseWithParams parses the sql and replaces the placeholders '?' with
+// the params in order, a placeholder without param is kept as tree.ParamExpr.
+func ParseWithParams(sql string, params []tree.Expr) ([]tree.Statement, error) {
+	lexer := NewLexer(dialect.MYSQL, sql)
+	lexer.params = params
+	if yyParse(lexer) != 0 {
+		return nil, lexer.scanner.LastError
+	}
+	return lexer.stmts, nil
+}
+
+// Prepare parses a statement to be prepared and returns the number of its placeholders.
+func Prepare(sql string) (tree.Statement, int, error) {
+	lexer := NewLexer(dialect.MYSQL, sql)
+	if yyParse(lexer) != 0 {
+		return nil, 0, lexer.scanner.LastError
+	}
+	if len(lexer.stmts) != 1 {
+		return nil, 0, errors.New("syntax error, or too many sql to prepare")
+	}
+	return lexer.stmts[0], lexer.paramCount, nil
+}
+
 func ParseOne(sql string) (tree.Statement, error) {
 	lexer := NewLexer(dialect.MYSQL, sql)
 	if yyParse(lexer) != 0 {
@@ -47,6 +71,9 @@ func ParseOne(sql string) (tree.Statement, error) {
 type Lexer struct {
 	scanner *scanner.Scanner
 	stmts   []tree.Statement
+	// params are the values bound to the placeholders
+	params     []tree.Expr
+	paramCount int
 }
 
 func NewLexer(dialectType dialect.DialectType, sql string) *Lexer {
@@ -70,6 +97,8 @@ func (l *Lexer) Lex(lval *yySymType) int {
 		return l.toHexNum(lval, str)
 	case BIT_LITERAL:
 		return l.toBit(lval, str)
+	case VALUE_ARG:
+		return l.toParam(lval, str)
 	}
 
 	lval.str = str
@@ -136,6 +165,28 @@ func (l *Lexer) toBit(lval *yySymType, str string) int {
 	return BIT_LITERAL
 }
 
+// toParam accepts the placeholder '?' only, which is scanned as ':v<offset>'
+func (l *Lexer) toParam(lval *yySymType, str string) int {
+	if _, err := strconv.Atoi(strings.TrimPrefix(str, ":v")); err != nil {
+		l.scanner.LastError = fmt.Errorf("bind variable '%s' is not supported", str)
+		return LEX_ERROR
+	}
+	lval.str = str
+	return VALUE_ARG
+}
+
+// paramExpr returns the value bound to the placeholder, or a tree.ParamExpr if it is not bound.
+func (l *Lexer) paramExpr(str string) tree.Expr {
+	offset, _ := strconv.Atoi(strings.TrimPrefix(str, ":v"))
+	if offset > l.paramCount {
+		l.paramCount = offset
+	}
+	if offset <= len(l.params) {
+		return l.params[offset-1]
+	}
+	return tree.NewParamExpr(offset)
+}
+
 func getUint64(num interface{}) uint64 {
 	switch v := num.(type) {
 	case int64:
@@ -21,404 +21,420 @@ import (
 
 const LEX_ERROR = 57346
 const UNION = 57347
-const SELECT = 57348
-const STREAM = 57349
-const INSERT = 57350
-const UPDATE = 57351
-const DELETE = 57352
-const FROM = 57353
-const WHERE = 57354
-const GROUP = 57355
-const HAVING = 57356
-const ORDER = 57357
-const BY = 57358
-const LIMIT = 57359
-const OFFSET = 57360
-const FOR = 57361
-const ALL = 57362
-const DISTINCT = 57363
-const DISTINCTROW = 57364
-const AS = 57365
-const EXISTS = 57366
-const ASC = 57367
-const DESC = 57368
-const INTO = 57369
-const DUPLICATE = 57370
-const DEFAULT = 57371
-const SET = 57372
-const LOCK = 57373
-const KEYS = 57374
-const VALUES = 57375
-const LAST_INSERT_ID = 57376
-const NEXT = 57377
-const VALUE = 57378
-const SHARE = 57379
-const MODE = 57380
-const SQL_NO_CACHE = 57381
-const SQL_CACHE = 57382
-const JOIN = 57383
-const STRAIGHT_JOIN = 57384
-const LEFT = 57385
-const RIGHT = 57386
-const INNER = 57387
-const OUTER = 57388
-const CROSS = 57389
-const NATURAL = 57390
-const USE = 57391
-const FORCE = 57392
-const ON = 57393
-const USING = 57394
-const SUBQUERY_AS_EXPR = 57395
-const ID = 57396
-const AT_ID = 57397
-const AT_AT_ID = 57398
-const STRING = 57399
-const VALUE_ARG = 57400
-const LIST_ARG = 57401
-const COMMENT = 57402
-const COMMENT_KEYWORD = 57403
-const INTEGRAL = 57404
-const HEX = 57405
-const HEXNUM = 57406
-const BIT_LITERAL = 57407
-const FLOAT = 57408
-const NULL = 57409
-const TRUE = 57410
-const FALSE = 57411
-const EMPTY_FROM_CLAUSE = 57412
-const LOWER_THAN_CHARSET = 57413
-const CHARSET = 57414
-const UNIQUE = 57415
-const KEY = 57416
-const OR = 57417
-const XOR = 57418
-const AND = 57419
-const NOT = 57420
-const BETWEEN = 57421
-const CASE = 57422
-const WHEN = 57423
-const THEN = 57424
-const ELSE = 57425
-const END = 57426
-const LE = 57427
-const GE = 57428
-const NE = 57429
-const NULL_SAFE_EQUAL = 57430
-const IS = 57431
-const LIKE = 57432
-const REGEXP = 57433
-const IN = 57434
-const ASSIGNMENT = 57435
-const SHIFT_LEFT = 57436
-const SHIFT_RIGHT = 57437
-const DIV = 57438
-const MOD = 57439
-const UNARY = 57440
-const COLLATE = 57441
-const BINARY = 57442
-const UNDERSCORE_BINARY = 57443
-const INTERVAL = 57444
-const BEGIN = 57445
-const START = 57446
-const TRANSACTION = 57447
-const COMMIT = 57448
-const ROLLBACK = 57449
-const WORK = 57450
-const CONSISTENT = 57451
-const SNAPSHOT = 57452
-const CHAIN = 57453
-const NO = 57454
-const RELEASE = 57455
-const BIT = 57456
-const TINYINT = 57457
-const SMALLINT = 57458
-const MEDIUMINT = 57459
-const INT = 57460
-const INTEGER = 57461
-const BIGINT = 57462
-const INTNUM = 57463
-const REAL = 57464
-const DOUBLE = 57465
-const FLOAT_TYPE = 57466
-const DECIMAL = 57467
-const NUMERIC = 57468
-const TIME = 57469
-const TIMESTAMP = 57470
-const DATETIME = 57471
-const YEAR = 57472
-const CHAR = 57473
-const VARCHAR = 57474
-const BOOL = 57475
-const CHARACTER = 57476
-const VARBINARY = 57477
-const NCHAR = 57478
-const TEXT = 57479
-const TINYTEXT = 57480
-const MEDIUMTEXT = 57481
-const LONGTEXT = 57482
-const BLOB = 57483
-const TINYBLOB = 57484
-const MEDIUMBLOB = 57485
-const LONGBLOB = 57486
-const JSON = 57487
-const ENUM = 57488
-const GEOMETRY = 57489
-const POINT = 57490
-const LINESTRING = 57491
-const POLYGON = 57492
-const GEOMETRYCOLLECTION = 57493
-const MULTIPOINT = 57494
-const MULTILINESTRING = 57495
-const MULTIPOLYGON = 57496
-const INT1 = 57497
-const INT2 = 57498
-const INT3 = 57499
-const INT4 = 57500
-const INT8 = 57501
-const CREATE = 57502
-const ALTER = 57503
-const DROP = 57504
-const RENAME = 57505
-const ANALYZE = 57506
-const ADD = 57507
-const SCHEMA = 57508
-const TABLE = 57509
-const INDEX = 57510
-const VIEW = 57511
-const TO = 57512
-const IGNORE = 57513
-const IF = 57514
-const PRIMARY = 57515
-const COLUMN = 57516
-const CONSTRAINT = 57517
-const SPATIAL = 57518
-const FULLTEXT = 57519
-const FOREIGN = 57520
-const KEY_BLOCK_SIZE = 57521
-const SHOW = 57522
-const DESCRIBE = 57523
-const EXPLAIN = 57524
-const DATE = 57525
-const ESCAPE = 57526
-const REPAIR = 57527
-const OPTIMIZE = 57528
-const TRUNCATE = 57529
-const MAXVALUE = 57530
-const PARTITION = 57531
-const REORGANIZE = 57532
-const LESS = 57533
-const THAN = 57534
-const PROCEDURE = 57535
-const TRIGGER = 57536
-const STATUS = 57537
-const VARIABLES = 57538
-const ROLE = 57539
-const PROXY = 57540
-const AVG_ROW_LENGTH = 57541
-const STORAGE = 57542
-const DISK = 57543
-const MEMORY = 57544
-const CHECKSUM = 57545
-const COMPRESSION = 57546
-const DATA = 57547
-const DIRECTORY = 57548
-const DELAY_KEY_WRITE = 57549
-const ENCRYPTION = 57550
-const ENGINE = 57551
-const MAX_ROWS = 57552
-const MIN_ROWS = 57553
-const PACK_KEYS = 57554
-const ROW_FORMAT = 57555
-const STATS_AUTO_RECALC = 57556
-const STATS_PERSISTENT = 57557
-const STATS_SAMPLE_PAGES = 57558
-const DYNAMIC = 57559
-const COMPRESSED = 57560
-const REDUNDANT = 57561
-const COMPACT = 57562
-const FIXED = 57563
-const COLUMN_FORMAT = 57564
-const AUTO_RANDOM = 57565
-const RESTRICT = 57566
-const CASCADE = 57567
-const ACTION = 57568
-const PARTIAL = 57569
-const SIMPLE = 57570
-const CHECK = 57571
-const ENFORCED = 57572
-const RANGE = 57573
-const LIST = 57574
-const ALGORITHM = 57575
-const LINEAR = 57576
-const PARTITIONS = 57577
-const SUBPARTITION = 57578
-const SUBPARTITIONS = 57579
-const TYPE = 57580
-const PROPERTIES = 57581
-const PARSER = 57582
-const VISIBLE = 57583
-const INVISIBLE = 57584
-const BTREE = 57585
-const HASH = 57586
-const RTREE = 57587
-const BSI = 57588
-const ZONEMAP = 57589
-const EXPIRE = 57590
-const ACCOUNT = 57591
-const UNLOCK = 57592
-const DAY = 57593
-const NEVER = 57594
-const SECOND = 57595
-const ASCII = 57596
-const COALESCE = 57597
-const COLLATION = 57598
-const HOUR = 57599
-const MICROSECOND = 57600
-const MINUTE = 57601
-const MONTH = 57602
-const QUARTER = 57603
-const REPEAT = 57604
-const REVERSE = 57605
-const ROW_COUNT = 57606
-const WEEK = 57607
-const REVOKE = 57608
-const FUNCTION = 57609
-const PRIVILEGES = 57610
-const TABLESPACE = 57611
-const EXECUTE = 57612
-const SUPER = 57613
-const GRANT = 57614
-const OPTION = 57615
-const REFERENCES = 57616
-const REPLICATION = 57617
-const SLAVE = 57618
-const CLIENT = 57619
-const USAGE = 57620
-const RELOAD = 57621
-const FILE = 57622
-const TEMPORARY = 57623
-const ROUTINE = 57624
-const EVENT = 57625
-const SHUTDOWN = 57626
-const NULLX = 57627
-const AUTO_INCREMENT = 57628
-const APPROXNUM = 57629
-const SIGNED = 57630
-const UNSIGNED = 57631
-const ZEROFILL = 57632
-const USER = 57633
-const IDENTIFIED = 57634
-const CIPHER = 57635
-const ISSUER = 57636
-const X509 = 57637
-const SUBJECT = 57638
-const SAN = 57639
-const REQUIRE = 57640
-const SSL = 57641
-const NONE = 57642
-const PASSWORD = 57643
-const MAX_QUERIES_PER_HOUR = 57644
-const MAX_UPDATES_PER_HOUR = 57645
-const MAX_CONNECTIONS_PER_HOUR = 57646
-const MAX_USER_CONNECTIONS = 57647
-const FORMAT = 57648
-const CONNECTION = 57649
-const LOAD = 57650
-const INFILE = 57651
-const TERMINATED = 57652
-const OPTIONALLY = 57653
-const ENCLOSED = 57654
-const ESCAPED = 57655
-const STARTING = 57656
-const LINES = 57657
-const DATABASES = 57658
-const TABLES = 57659
-const EXTENDED = 57660
-const FULL = 57661
-const PROCESSLIST = 57662
-const FIELDS = 57663
-const COLUMNS = 57664
-const OPEN = 57665
-const ERRORS = 57666
-const WARNINGS = 57667
-const INDEXES = 57668
-const NAMES = 57669
-const GLOBAL = 57670
-const SESSION = 57671
-const ISOLATION = 57672
-const LEVEL = 57673
-const READ = 57674
-const WRITE = 57675
-const ONLY = 57676
-const REPEATABLE = 57677
-const COMMITTED = 57678
-const UNCOMMITTED = 57679
-const SERIALIZABLE = 57680
-const LOCAL = 57681
-const EXCEPT = 57682
-const CURRENT_TIMESTAMP = 57683
-const DATABASE = 57684
-const CURRENT_TIME = 57685
-const LOCALTIME = 57686
-const LOCALTIMESTAMP = 57687
-const UTC_DATE = 57688
-const UTC_TIME = 57689
-const UTC_TIMESTAMP = 57690
-const REPLACE = 57691
-const CONVERT = 57692
-const SEPARATOR = 57693
-const CURRENT_DATE = 57694
-const CURRENT_USER = 57695
-const CURRENT_ROLE = 57696
-const MATCH = 57697
-const AGAINST = 57698
-const BOOLEAN = 57699
-const LANGUAGE = 57700
-const WITH = 57701
-const QUERY = 57702
-const EXPANSION = 57703
-const ADDDATE = 57704
-const BIT_AND = 57705
-const BIT_OR = 57706
-const BIT_XOR = 57707
-const CAST = 57708
-const COUNT = 57709
-const APPROX_COUNT_DISTINCT = 57710
-const APPROX_PERCENTILE = 57711
-const CURDATE = 57712
-const CURTIME = 57713
-const DATE_ADD = 57714
-const DATE_SUB = 57715
-const EXTRACT = 57716
-const GROUP_CONCAT = 57717
-const MAX = 57718
-const MID = 57719
-const MIN = 57720
-const NOW = 57721
-const POSITION = 57722
-const SESSION_USER = 57723
-const STD = 57724
-const STDDEV = 57725
-const STDDEV_POP = 57726
-const STDDEV_SAMP = 57727
-const SUBDATE = 57728
-const SUBSTR = 57729
-const SUBSTRING = 57730
-const SUM = 57731
-const SYSDATE = 57732
-const SYSTEM_USER = 57733
-const TRANSLATE = 57734
-const TRIM = 57735
-const VARIANCE = 57736
-const VAR_POP = 57737
-const VAR_SAMP = 57738
-const AVG = 57739
-const ROW = 57740
-const OUTFILE = 57741
-const HEADER = 57742
-const MAX_FILE_SIZE = 57743
-const FORCE_QUOTE = 57744
-const UNUSED = 57745
+const EXCEPT = 57348
+const INTERSECT = 57349
+const SELECT = 57350
+const STREAM = 57351
+const INSERT = 57352
+const UPDATE = 57353
+const DELETE = 57354
+const FROM = 57355
+const WHERE = 57356
+const GROUP = 57357
+const HAVING = 57358
+const ORDER = 57359
+const BY = 57360
+const LIMIT = 57361
+const OFFSET = 57362
+const FOR = 57363
+const ALL = 57364
+const DISTINCT = 57365
+const DISTINCTROW = 57366
+const AS = 57367
+const EXISTS = 57368
+const ASC = 57369
+const DESC = 57370
+const INTO = 57371
+const DUPLICATE = 57372
+const DEFAULT = 57373
+const SET = 57374
+const LOCK = 57375
+const KEYS = 57376
+const VALUES = 57377
+const LAST_INSERT_ID = 57378
+const NEXT = 57379
+const VALUE = 57380
+const SHARE = 57381
+const MODE = 57382
+const SQL_NO_CACHE = 57383
+const SQL_CACHE = 57384
+const JOIN = 57385
+const STRAIGHT_JOIN = 57386
+const LEFT = 57387
+const RIGHT = 57388
+const FULL = 57389
+const INNER = 57390
+const OUTER = 57391
+const CROSS = 57392
+const NATURAL = 57393
+const USE = 57394
+const FORCE = 57395
+const ON = 57396
+const USING = 57397
+const SUBQUERY_AS_EXPR = 57398
+const ID = 57399
+const AT_ID = 57400
+const AT_AT_ID = 57401
+const STRING = 57402
+const VALUE_ARG = 57403
+const LIST_ARG = 57404
+const COMMENT = 57405
+const COMMENT_KEYWORD = 57406
+const INTEGRAL = 57407
+const HEX = 57408
+const HEXNUM = 57409
+const BIT_LITERAL = 57410
+const FLOAT = 57411
+const NULL = 57412
+const TRUE = 57413
+const FALSE = 57414
+const EMPTY_FROM_CLAUSE = 57415
+const LOWER_THAN_CHARSET = 57416
+const CHARSET = 57417
+const UNIQUE = 57418
+const KEY = 57419
+const OR = 57420
+const XOR = 57421
+const AND = 57422
+const NOT = 57423
+const BETWEEN = 57424
+const CASE = 57425
+const WHEN = 57426
+const THEN = 57427
+const ELSE = 57428
+const END = 57429
+const LE = 57430
+const GE = 57431
+const NE = 57432
+const NULL_SAFE_EQUAL = 57433
+const IS = 57434
+const LIKE = 57435
+const REGEXP = 57436
+const IN = 57437
+const ASSIGNMENT = 57438
+const JSON_EXTRACT_OP = 57439
+const JSON_UNQUOTE_EXTRACT_OP = 57440
+const SHIFT_LEFT = 57441
+const SHIFT_RIGHT = 57442
+const DIV = 57443
+const MOD = 57444
+const UNARY = 57445
+const COLLATE = 57446
+const BINARY = 57447
+const UNDERSCORE_BINARY = 57448
+const INTERVAL = 57449
+const BEGIN = 57450
+const START = 57451
+const TRANSACTION = 57452
+const COMMIT = 57453
+const ROLLBACK = 57454
+const WORK = 57455
+const CONSISTENT = 57456
+const SNAPSHOT = 57457
+const CHAIN = 57458
+const NO = 57459
+const RELEASE = 57460
+const BIT = 57461
+const TINYINT = 57462
+const SMALLINT = 57463
+const MEDIUMINT = 57464
+const INT = 57465
+const INTEGER = 57466
+const BIGINT = 57467
+const INTNUM = 57468
+const REAL = 57469
+const DOUBLE = 57470
+const FLOAT_TYPE = 57471
+const DECIMAL = 57472
+const NUMERIC = 57473
+const TIME = 57474
+const TIMESTAMP = 57475
+const DATETIME = 57476
+const YEAR = 57477
+const CHAR = 57478
+const VARCHAR = 57479
+const BOOL = 57480
+const CHARACTER = 57481
+const VARBINARY = 57482
+const NCHAR = 57483
+const TEXT = 57484
+const TINYTEXT = 57485
+const MEDIUMTEXT = 57486
+const LONGTEXT = 57487
+const BLOB = 57488
+const TINYBLOB = 57489
+const MEDIUMBLOB = 57490
+const LONGBLOB = 57491
+const JSON = 57492
+const ENUM = 57493
+const GEOMETRY = 57494
+const POINT = 57495
+const LINESTRING = 57496
+const POLYGON = 57497
+const GEOMETRYCOLLECTION = 57498
+const MULTIPOINT = 57499
+const MULTILINESTRING = 57500
+const MULTIPOLYGON = 57501
+const INT1 = 57502
+const INT2 = 57503
+const INT3 = 57504
+const INT4 = 57505
+const INT8 = 57506
+const CREATE = 57507
+const ALTER = 57508
+const DROP = 57509
+const RENAME = 57510
+const ANALYZE = 57511
+const ADD = 57512
+const SCHEMA = 57513
+const TABLE = 57514
+const INDEX = 57515
+const VIEW = 57516
+const TO = 57517
+const IGNORE = 57518
+const IF = 57519
+const PRIMARY = 57520
+const COLUMN = 57521
+const CONSTRAINT = 57522
+const SPATIAL = 57523
+const FULLTEXT = 57524
+const FOREIGN = 57525
+const KEY_BLOCK_SIZE = 57526
+const SHOW = 57527
+const DESCRIBE = 57528
+const EXPLAIN = 57529
+const DATE = 57530
+const ESCAPE = 57531
+const REPAIR = 57532
+const OPTIMIZE = 57533
+const TRUNCATE = 57534
+const MAXVALUE = 57535
+const PARTITION = 57536
+const REORGANIZE = 57537
+const LESS = 57538
+const THAN = 57539
+const PROCEDURE = 57540
+const TRIGGER = 57541
+const STATUS = 57542
+const VARIABLES = 57543
+const ROLE = 57544
+const PROXY = 57545
+const AVG_ROW_LENGTH = 57546
+const STORAGE = 57547
+const DISK = 57548
+const MEMORY = 57549
+const CHECKSUM = 57550
+const COMPRESSION = 57551
+const DATA = 57552
+const DIRECTORY = 57553
+const DELAY_KEY_WRITE = 57554
+const ENCRYPTION = 57555
+const ENGINE = 57556
+const MAX_ROWS = 57557
+const MIN_ROWS = 57558
+const PACK_KEYS = 57559
+const ROW_FORMAT = 57560
+const STATS_AUTO_RECALC = 57561
+const STATS_PERSISTENT = 57562
+const STATS_SAMPLE_PAGES = 57563
+const DYNAMIC = 57564
+const COMPRESSED = 57565
+const REDUNDANT = 57566
+const COMPACT = 57567
+const FIXED = 57568
+const COLUMN_FORMAT = 57569
+const AUTO_RANDOM = 57570
+const RESTRICT = 57571
+const CASCADE = 57572
+const ACTION = 57573
+const PARTIAL = 57574
+const SIMPLE = 57575
+const CHECK = 57576
+const ENFORCED = 57577
+const RANGE = 57578
+const LIST = 57579
+const ALGORITHM = 57580
+const LINEAR = 57581
+const PARTITIONS = 57582
+const SUBPARTITION = 57583
+const SUBPARTITIONS = 57584
+const TYPE = 57585
+const PROPERTIES = 57586
+const PARSER = 57587
+const VISIBLE = 57588
+const INVISIBLE = 57589
+const BTREE = 57590
+const HASH = 57591
+const RTREE = 57592
+const BSI = 57593
+const ZONEMAP = 57594
+const EXPIRE = 57595
+const ACCOUNT = 57596
+const UNLOCK = 57597
+const DAY = 57598
+const NEVER = 57599
+const SECOND = 57600
+const ASCII = 57601
+const COALESCE = 57602
+const COLLATION = 57603
+const HOUR = 57604
+const MICROSECOND = 57605
+const MINUTE = 57606
+const MONTH = 57607
+const QUARTER = 57608
+const REPEAT = 57609
+const REVERSE = 57610
+const ROW_COUNT = 57611
+const WEEK = 57612
+const REVOKE = 57613
+const FUNCTION = 57614
+const PRIVILEGES = 57615
+const TABLESPACE = 57616
+const EXECUTE = 57617
+const SUPER = 57618
+const GRANT = 57619
+const OPTION = 57620
+const REFERENCES = 57621
+const REPLICATION = 57622
+const SLAVE = 57623
+const CLIENT = 57624
+const USAGE = 57625
+const RELOAD = 57626
+const FILE = 57627
+const TEMPORARY = 57628
+const ROUTINE = 57629
+const EVENT = 57630
+const SHUTDOWN = 57631
+const NULLX = 57632
+const AUTO_INCREMENT = 57633
+const APPROXNUM = 57634
+const SIGNED = 57635
+const UNSIGNED = 57636
+const ZEROFILL = 57637
+const USER = 57638
+const IDENTIFIED = 57639
+const CIPHER = 57640
+const ISSUER = 57641
+const X509 = 57642
+const SUBJECT = 57643
+const SAN = 57644
+const REQUIRE = 57645
+const SSL = 57646
+const NONE = 57647
+const PASSWORD = 57648
+const MAX_QUERIES_PER_HOUR = 57649
+const MAX_UPDATES_PER_HOUR = 57650
+const MAX_CONNECTIONS_PER_HOUR = 57651
+const MAX_USER_CONNECTIONS = 57652
+const FORMAT = 57653
+const CONNECTION = 57654
+const KILL = 57655
+const LOAD = 57656
+const INFILE = 57657
+const TERMINATED = 57658
+const OPTIONALLY = 57659
+const ENCLOSED = 57660
+const ESCAPED = 57661
+const STARTING = 57662
+const LINES = 57663
+const DATABASES = 57664
+const TABLES = 57665
+const EXTENDED = 57666
+const PROCESSLIST = 57667
+const FIELDS = 57668
+const COLUMNS = 57669
+const OPEN = 57670
+const ERRORS = 57671
+const WARNINGS = 57672
+const INDEXES = 57673
+const GRANTS = 57674
+const NAMES = 57675
+const GLOBAL = 57676
+const SESSION = 57677
+const ISOLATION = 57678
+const LEVEL = 57679
+const READ = 57680
+const WRITE = 57681
+const ONLY = 57682
+const REPEATABLE = 57683
+const COMMITTED = 57684
+const UNCOMMITTED = 57685
+const SERIALIZABLE = 57686
+const LOCAL = 57687
+const CURRENT_TIMESTAMP = 57688
+const DATABASE = 57689
+const CURRENT_TIME = 57690
+const LOCALTIME = 57691
+const LOCALTIMESTAMP = 57692
+const UTC_DATE = 57693
+const UTC_TIME = 57694
+const UTC_TIMESTAMP = 57695
+const REPLACE = 57696
+const CONVERT = 57697
+const SEPARATOR = 57698
+const CURRENT_DATE = 57699
+const CURRENT_USER = 57700
+const CURRENT_ROLE = 57701
+const MATCH = 57702
+const AGAINST = 57703
+const BOOLEAN = 57704
+const LANGUAGE = 57705
+const WITH = 57706
+const QUERY = 57707
+const EXPANSION = 57708
+const ADDDATE = 57709
+const BIT_AND = 57710
+const BIT_OR = 57711
+const BIT_XOR = 57712
+const CAST = 57713
+const COUNT = 57714
+const APPROX_COUNT_DISTINCT = 57715
+const APPROX_PERCENTILE = 57716
+const CURDATE = 57717
+const CURTIME = 57718
+const DATE_ADD = 57719
+const DATE_SUB = 57720
+const EXTRACT = 57721
+const GROUP_CONCAT = 57722
+const MAX = 57723
+const MID = 57724
+const MIN = 57725
+const NOW = 57726
+const POSITION = 57727
+const SESSION_USER = 57728
+const STD = 57729
+const STDDEV = 57730
+const STDDEV_POP = 57731
+const STDDEV_SAMP = 57732
+const SUBDATE = 57733
+const SUBSTR = 57734
+const SUBSTRING = 57735
+const SUM = 57736
+const SYSDATE = 57737
+const SYSTEM_USER = 57738
+const TRANSLATE = 57739
+const TRIM = 57740
+const VARIANCE = 57741
+const VAR_POP = 57742
+const VAR_SAMP = 57743
+const AVG = 57744
+const LEADING = 57745
+const TRAILING = 57746
+const BOTH = 57747
+const ROW = 57748
+const OUTFILE = 57749
+const HEADER = 57750
+const MAX_FILE_SIZE = 57751
+const FORCE_QUOTE = 57752
+const OVER = 57753
+const ROWS = 57754
+const PRECEDING = 57755
+const FOLLOWING = 57756
+const UNBOUNDED = 57757
+const CURRENT = 57758
+const OF = 57759
+const EPOCH = 57760
+const UNUSED = 57761
 
 var yyToknames = [...]string{
 	"$end",
@@ -426,6 +442,8 @@ var yyToknames = [...]string{
 	"$unk",
 	"LEX_ERROR",
 	"UNION",
+	"EXCEPT",
+	"INTERSECT",
 	"SELECT",
 	"STREAM",
 	"INSERT",
@@ -465,6 +483,7 @@ var yyToknames = [...]string{
 	"STRAIGHT_JOIN",
 	"LEFT",
 	"RIGHT",
+	"FULL",
 	"INNER",
 	"OUTER",
 	"CROSS",
@@ -521,6 +540,8 @@ var yyToknames = [...]string{
 	"REGEXP",
 	"IN",
 	"ASSIGNMENT",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
 	"'|'",
 	"'&'",
 	"SHIFT_LEFT",
@@ -745,6 +766,7 @@ var yyToknames = [...]string{
 	"MAX_USER_CONNECTIONS",
 	"FORMAT",
 	"CONNECTION",
+	"KILL",
 	"LOAD",
 	"INFILE",
 	"TERMINATED",
@@ -756,7 +778,6 @@ var yyToknames = [...]string{
 	"DATABASES",
 	"TABLES",
 	"EXTENDED",
-	"FULL",
 	"PROCESSLIST",
 	"FIELDS",
 	"COLUMNS",
@@ -764,6 +785,7 @@ var yyToknames = [...]string{
 	"ERRORS",
 	"WARNINGS",
 	"INDEXES",
+	"GRANTS",
 	"NAMES",
 	"GLOBAL",
 	"SESSION",
@@ -777,7 +799,6 @@ var yyToknames = [...]string{
 	"UNCOMMITTED",
 	"SERIALIZABLE",
 	"LOCAL",
-	"EXCEPT",
 	"CURRENT_TIMESTAMP",
 	"DATABASE",
 	"CURRENT_TIME",
@@ -835,11 +856,22 @@ var yyToknames = [...]string{
 	"VAR_POP",
 	"VAR_SAMP",
 	"AVG",
+	"LEADING",
+	"TRAILING",
+	"BOTH",
 	"ROW",
 	"OUTFILE",
 	"HEADER",
 	"MAX_FILE_SIZE",
 	"FORCE_QUOTE",
+	"OVER",
+	"ROWS",
+	"PRECEDING",
+	"FOLLOWING",
+	"UNBOUNDED",
+	"CURRENT",
+	"OF",
+	"EPOCH",
 	"UNUSED",
 	"';'",
 	"'@'",
@@ -851,1748 +883,1930 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:5946
+//line mysql_sql.y:6556
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 52,
-	17, 334,
-	-2, 308,
-	-1, 56,
-	185, 471,
-	-2, 507,
-	-1, 65,
-	212, 234,
-	213, 234,
-	-2, 254,
-	-1, 307,
-	58, 1215,
-	422, 1215,
-	-2, 92,
-	-1, 326,
-	58, 634,
-	422, 634,
-	-2, 469,
-	-1, 327,
-	58, 462,
-	422, 462,
-	-2, 470,
-	-1, 334,
-	17, 335,
-	-2, 308,
-	-1, 567,
-	54, 752,
-	-2, 1256,
-	-1, 568,
-	54, 753,
-	-2, 1257,
-	-1, 569,
-	54, 754,
-	-2, 1258,
-	-1, 576,
-	54, 811,
-	-2, 1220,
-	-1, 577,
-	54, 813,
-	-2, 1231,
-	-1, 718,
-	1, 497,
-	421, 497,
-	-2, 504,
-	-1, 828,
-	17, 334,
-	-2, 692,
-	-1, 870,
-	119, 935,
-	-2, 933,
-	-1, 872,
-	119, 416,
-	-2, 930,
-	-1, 873,
-	119, 417,
-	-2, 931,
-	-1, 1064,
-	1, 498,
-	421, 498,
-	-2, 504,
-	-1, 1444,
-	1, 544,
-	206, 544,
-	421, 544,
-	-2, 504,
-	-1, 1446,
-	246, 659,
-	-2, 640,
-	-1, 1547,
-	1, 545,
-	206, 545,
-	421, 545,
-	-2, 504,
-	-1, 1575,
-	246, 659,
-	-2, 641,
-	-1, 1949,
-	55, 519,
-	56, 519,
-	-2, 504,
-	-1, 1953,
-	55, 519,
-	56, 519,
-	-2, 504,
-	-1, 1965,
-	55, 523,
-	56, 523,
-	-2, 504,
-	-1, 1968,
-	55, 524,
-	56, 524,
-	-2, 504,
+	-1, 60,
+	19, 361,
+	-2, 335,
+	-1, 64,
+	190, 510,
+	-2, 546,
+	-1, 73,
+	217, 261,
+	218, 261,
+	-2, 281,
+	-1, 320,
+	61, 1323,
+	438, 1323,
+	-2, 99,
+	-1, 339,
+	61, 673,
+	438, 673,
+	-2, 508,
+	-1, 340,
+	61, 501,
+	438, 501,
+	-2, 509,
+	-1, 352,
+	19, 362,
+	-2, 335,
+	-1, 605,
+	57, 854,
+	-2, 1360,
+	-1, 609,
+	57, 817,
+	-2, 1365,
+	-1, 610,
+	57, 818,
+	-2, 1366,
+	-1, 611,
+	57, 819,
+	-2, 1367,
+	-1, 613,
+	57, 853,
+	-2, 1370,
+	-1, 614,
+	57, 852,
+	-2, 1371,
+	-1, 618,
+	57, 821,
+	-2, 1377,
+	-1, 619,
+	57, 820,
+	-2, 1378,
+	-1, 622,
+	57, 899,
+	-2, 1328,
+	-1, 623,
+	57, 901,
+	-2, 1340,
+	-1, 774,
+	1, 536,
+	437, 536,
+	-2, 543,
+	-1, 899,
+	19, 361,
+	-2, 731,
+	-1, 945,
+	124, 1034,
+	-2, 1032,
+	-1, 947,
+	124, 455,
+	-2, 1029,
+	-1, 948,
+	124, 456,
+	-2, 1030,
+	-1, 1151,
+	1, 537,
+	437, 537,
+	-2, 543,
+	-1, 1507,
+	251, 698,
+	-2, 679,
+	-1, 1650,
+	1, 583,
+	211, 583,
+	437, 583,
+	-2, 543,
+	-1, 1663,
+	251, 698,
+	-2, 680,
+	-1, 1769,
+	1, 584,
+	211, 584,
+	437, 584,
+	-2, 543,
+	-1, 2168,
+	58, 558,
+	59, 558,
+	-2, 543,
+	-1, 2172,
+	58, 558,
+	59, 558,
+	-2, 543,
+	-1, 2184,
+	58, 562,
+	59, 562,
+	-2, 543,
+	-1, 2187,
+	58, 563,
+	59, 563,
+	-2, 543,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 16237
+const yyLast = 17871
 
 var yyAct = [...]int{
-	709, 1112, 1955, 1953, 1952, 1960, 1926, 580, 1900, 1544,
-	699, 578, 1113, 1799, 597, 1872, 1915, 1587, 1856, 1773,
-	529, 1857, 1751, 1429, 81, 1710, 495, 283, 768, 1542,
-	1323, 1054, 1702, 1761, 527, 294, 84, 1543, 435, 1609,
-	81, 296, 1682, 1350, 1576, 1439, 385, 1509, 1243, 328,
-	328, 1608, 482, 1510, 1346, 755, 1512, 696, 1317, 80,
-	556, 1521, 1517, 1366, 1351, 1491, 1383, 1218, 1355, 1328,
-	1057, 852, 386, 1382, 1276, 289, 660, 537, 693, 499,
-	81, 1021, 861, 867, 870, 287, 19, 589, 1146, 862,
-	853, 51, 1212, 748, 1551, 1065, 712, 335, 668, 694,
-	1111, 549, 752, 579, 334, 725, 303, 303, 1114, 723,
-	1035, 724, 1027, 278, 281, 410, 770, 437, 520, 801,
-	685, 298, 378, 333, 77, 300, 423, 1042, 1538, 299,
-	1425, 452, 1322, 478, 855, 75, 1318, 1195, 1038, 506,
-	290, 1213, 1340, 1791, 379, 1816, 347, 1202, 502, 742,
-	472, 355, 400, 399, 737, 738, 494, 1844, 1052, 493,
-	496, 497, 1842, 19, 365, 395, 507, 496, 497, 727,
-	392, 330, 702, 1860, 1861, 538, 467, 394, 1876, 463,
-	1700, 1208, 398, 1703, 1704, 1705, 1706, 1209, 1781, 1210,
-	1784, 1541, 504, 1324, 606, 52, 706, 1329, 1330, 1331,
-	1332, 1181, 1367, 415, 1221, 1219, 1216, 1220, 1222, 749,
-	1215, 1214, 1221, 1219, 1038, 1220, 1222, 1384, 1370, 1040,
-	366, 52, 458, 1681, 1596, 1595, 454, 465, 466, 1592,
-	1535, 1333, 464, 1422, 453, 1693, 686, 1504, 1839, 1503,
-	1394, 1392, 1393, 1846, 1500, 1389, 1687, 1388, 1387, 1385,
-	459, 1945, 1961, 349, 1369, 1882, 779, 780, 778, 1841,
-	1801, 1889, 688, 346, 345, 1859, 1797, 1798, 396, 1801,
-	1356, 1359, 52, 1775, 1824, 1790, 397, 1676, 81, 414,
-	1224, 1225, 1226, 1227, 341, 1936, 1645, 1918, 413, 81,
-	1762, 1763, 1764, 1766, 1765, 1667, 516, 1644, 332, 1848,
-	1849, 1386, 1807, 492, 491, 1962, 461, 1736, 1956, 483,
-	1927, 1633, 409, 1277, 505, 439, 1779, 1199, 1088, 1046,
-	1359, 485, 456, 503, 419, 1203, 462, 401, 1501, 1423,
-	487, 440, 1671, 1241, 457, 460, 687, 1793, 1794, 288,
-	389, 1519, 1518, 370, 455, 1086, 1085, 1084, 510, 740,
-	449, 508, 509, 412, 741, 1083, 739, 367, 368, 441,
-	442, 443, 530, 1940, 1904, 1320, 1251, 1193, 350, 1192,
-	1180, 1174, 1078, 1050, 1020, 328, 762, 783, 340, 1360,
-	1639, 386, 386, 386, 1353, 813, 1919, 444, 1354, 1357,
-	662, 534, 372, 371, 484, 418, 486, 417, 411, 1116,
-	1115, 1310, 500, 552, 362, 389, 1390, 1391, 1922, 1913,
-	1341, 1811, 659, 391, 1176, 551, 1232, 1090, 531, 665,
-	532, 414, 81, 81, 81, 81, 1312, 1847, 1360, 348,
-	669, 489, 303, 521, 441, 442, 443, 1441, 1025, 1774,
-	1358, 496, 497, 416, 522, 1230, 496, 497, 1161, 328,
-	328, 414, 328, 439, 715, 1792, 778, 439, 473, 1318,
-	700, 1221, 1219, 488, 1220, 1222, 519, 750, 1678, 440,
-	328, 328, 476, 440, 1059, 683, 1311, 52, 391, 1502,
-	1499, 1232, 469, 328, 1037, 328, 1121, 718, 708, 81,
-	445, 1041, 713, 1442, 655, 451, 515, 1196, 1916, 1917,
-	1669, 1677, 526, 732, 1668, 328, 717, 303, 498, 701,
-	501, 474, 1737, 1739, 1740, 1741, 1738, 328, 386, 490,
-	328, 1672, 1673, 1258, 720, 523, 524, 525, 730, 539,
-	1108, 477, 1495, 756, 1036, 763, 518, 780, 778, 756,
-	719, 1109, 303, 3, 328, 328, 767, 81, 704, 359,
-	682, 1490, 781, 1662, 336, 1231, 733, 360, 681, 1252,
-	1935, 714, 670, 671, 672, 673, 784, 1951, 771, 1747,
-	705, 721, 722, 1932, 303, 698, 728, 689, 779, 780,
-	778, 1883, 769, 734, 772, 830, 286, 12, 540, 703,
-	543, 544, 545, 546, 547, 533, 829, 369, 707, 1879,
-	1933, 1934, 303, 52, 729, 1746, 716, 726, 821, 822,
-	814, 815, 816, 817, 818, 819, 820, 813, 837, 751,
-	1829, 407, 765, 441, 442, 443, 530, 393, 1777, 761,
-	814, 815, 816, 817, 818, 819, 820, 813, 746, 1776,
-	747, 528, 758, 759, 760, 812, 811, 821, 822, 814,
-	815, 816, 817, 818, 819, 820, 813, 859, 859, 864,
-	1753, 764, 1853, 1049, 12, 1153, 766, 1022, 373, 441,
-	442, 443, 530, 1731, 866, 395, 1730, 1430, 835, 1151,
-	1152, 1150, 531, 872, 779, 780, 778, 1713, 831, 832,
-	833, 834, 807, 816, 817, 818, 819, 820, 813, 873,
-	1048, 779, 780, 778, 1729, 357, 1745, 358, 365, 779,
-	780, 778, 356, 354, 353, 361, 1726, 363, 364, 1720,
-	81, 850, 1717, 779, 780, 778, 1716, 283, 531, 842,
-	1692, 284, 6, 1743, 1080, 1055, 1056, 313, 1733, 312,
-	316, 308, 1744, 328, 1023, 771, 858, 1124, 395, 1623,
-	1622, 304, 779, 780, 778, 1068, 1126, 1621, 865, 1620,
-	1617, 772, 323, 328, 1539, 394, 285, 5, 1435, 1742,
-	1434, 756, 756, 756, 1732, 552, 1433, 81, 828, 1019,
-	871, 1432, 1305, 1105, 1106, 1032, 663, 551, 779, 780,
-	778, 1102, 1103, 1104, 1413, 1877, 1852, 1069, 1070, 1071,
-	303, 1122, 1123, 1072, 1752, 1081, 441, 442, 443, 6,
-	1119, 1838, 1818, 1066, 1045, 1805, 779, 780, 778, 1804,
-	1095, 1734, 1727, 1134, 1135, 1136, 1137, 1138, 1139, 1140,
-	1141, 1142, 1143, 1144, 1145, 1074, 1077, 1076, 1155, 1156,
-	1075, 1073, 726, 1723, 5, 1164, 1722, 1110, 1721, 1159,
-	1098, 396, 1101, 1281, 1683, 1664, 1280, 1244, 850, 52,
-	1166, 1091, 1092, 1093, 1087, 1467, 824, 1540, 827, 1443,
-	787, 788, 789, 790, 791, 792, 1099, 785, 1408, 779,
-	780, 778, 825, 826, 823, 1428, 812, 811, 821, 822,
-	814, 815, 816, 817, 818, 819, 820, 813, 1402, 1426,
-	779, 780, 778, 1401, 1117, 1118, 1338, 1120, 306, 305,
-	309, 1148, 1127, 1128, 1129, 1130, 311, 1131, 1132, 1133,
-	779, 780, 778, 1400, 1337, 779, 780, 778, 315, 1154,
-	1399, 1336, 1335, 1398, 1921, 1047, 846, 1965, 845, 1162,
-	1397, 844, 690, 1179, 1943, 779, 780, 778, 1165, 710,
-	1167, 1455, 779, 780, 778, 779, 780, 778, 664, 1826,
-	1168, 1825, 779, 780, 778, 1812, 1474, 1478, 1480, 1482,
-	1484, 1485, 1487, 1695, 1394, 1392, 1393, 1694, 1396, 1469,
-	1470, 1471, 1472, 1453, 1454, 1475, 1529, 1456, 1528, 1457,
-	1458, 1459, 1460, 1461, 1462, 1463, 1464, 1465, 1466, 1473,
-	779, 780, 778, 1254, 1970, 1964, 1963, 1477, 1479, 1481,
-	1483, 1486, 1284, 1044, 1946, 1254, 1283, 1579, 310, 314,
-	691, 1182, 318, 692, 1527, 414, 320, 321, 322, 1942,
-	1941, 324, 325, 1381, 669, 1468, 339, 1508, 1187, 328,
-	1444, 1188, 328, 1414, 1190, 414, 338, 328, 1380, 1044,
-	1930, 1206, 1582, 1379, 1198, 779, 780, 778, 1577, 1157,
-	1371, 1204, 1205, 1287, 1590, 1591, 713, 1044, 1929, 1578,
-	779, 780, 778, 1903, 1902, 779, 780, 778, 76, 1238,
-	1285, 779, 780, 778, 1629, 1867, 1282, 542, 76, 328,
-	23, 39, 24, 1629, 1862, 1097, 1850, 81, 81, 1197,
-	1263, 1018, 657, 1583, 76, 654, 23, 39, 24, 1629,
-	1822, 1629, 1821, 1229, 1629, 1820, 1629, 1819, 1810, 1809,
-	1260, 1185, 1259, 1186, 1253, 1200, 656, 1255, 394, 1240,
-	1256, 1257, 1246, 1247, 1788, 1787, 73, 1163, 1194, 661,
-	1264, 1265, 1266, 1267, 1268, 1269, 1270, 1758, 1759, 1234,
-	1211, 1271, 73, 684, 1235, 541, 1236, 1758, 1757, 1066,
-	1228, 1698, 1697, 1696, 1274, 1275, 468, 1242, 1629, 1628,
-	447, 1279, 776, 859, 1239, 1297, 859, 76, 1589, 1300,
-	1352, 1288, 1024, 756, 1245, 1306, 448, 1237, 1254, 756,
-	1022, 446, 328, 1184, 1417, 447, 328, 328, 1254, 1403,
-	328, 1303, 1254, 1395, 1293, 1585, 1254, 1262, 1169, 1476,
-	1254, 1261, 1184, 1183, 1178, 1177, 774, 1304, 1172, 1171,
-	1044, 1043, 1445, 1038, 81, 73, 1292, 1584, 1586, 1415,
-	449, 1250, 1299, 449, 414, 1175, 1158, 1097, 1148, 1272,
-	1053, 395, 76, 1349, 517, 1296, 1966, 1912, 1906, 1890,
-	1887, 81, 1376, 1313, 1315, 1273, 1885, 1294, 1298, 1339,
-	1289, 1828, 1295, 1307, 1301, 1302, 1771, 1378, 1756, 1754,
-	1309, 1308, 1749, 1690, 1689, 1688, 1685, 1675, 1316, 1592,
-	1686, 1660, 1910, 1511, 1626, 1334, 1603, 1602, 1513, 1522,
-	73, 1580, 1524, 1496, 1437, 1149, 1233, 1189, 1412, 812,
-	811, 821, 822, 814, 815, 816, 817, 818, 819, 820,
-	813, 1170, 1361, 1362, 1089, 328, 1410, 1363, 1082, 1411,
-	851, 1376, 849, 848, 847, 843, 1375, 812, 811, 821,
-	822, 814, 815, 816, 817, 818, 819, 820, 813, 1407,
-	802, 840, 838, 836, 828, 1571, 73, 810, 1404, 809,
-	808, 806, 805, 1489, 1409, 425, 428, 429, 430, 426,
-	661, 427, 431, 1440, 804, 1416, 52, 803, 297, 1067,
-	800, 799, 1418, 1342, 1343, 1438, 1507, 798, 797, 796,
-	795, 1406, 794, 793, 666, 658, 1506, 1421, 450, 425,
-	428, 429, 430, 426, 1954, 427, 431, 1431, 1028, 1029,
-	1895, 1436, 1062, 1893, 1553, 1858, 1223, 1096, 1493, 1031,
-	470, 1034, 678, 1033, 676, 1488, 1452, 679, 329, 677,
-	328, 328, 1494, 1492, 81, 1492, 675, 674, 756, 1498,
-	680, 1950, 429, 430, 1173, 1514, 1515, 1516, 414, 1869,
-	1497, 535, 536, 1067, 1055, 1056, 414, 1548, 339, 1319,
-	420, 337, 1060, 1419, 1520, 1349, 1525, 736, 338, 1536,
-	1420, 425, 428, 429, 430, 426, 1526, 427, 431, 433,
-	337, 1531, 403, 405, 406, 1116, 1115, 1532, 1533, 475,
-	1534, 480, 481, 1907, 1833, 1831, 1786, 1785, 1783, 338,
-	1593, 1610, 1612, 1714, 1610, 1610, 1627, 1505, 1427, 1597,
-	1573, 1374, 1326, 1600, 1601, 1325, 339, 479, 1599, 1616,
-	1598, 1373, 1249, 432, 661, 1191, 338, 1604, 1605, 1606,
-	1607, 1897, 1896, 1897, 277, 1557, 1896, 351, 1, 1908,
-	854, 1611, 860, 1750, 1868, 1899, 1561, 1827, 1871, 596,
-	581, 1778, 1207, 1699, 1780, 1613, 1614, 1701, 1615, 1051,
-	1635, 1624, 1201, 471, 1619, 1290, 1550, 1291, 1631, 618,
-	1552, 1554, 1556, 1625, 1558, 1559, 1560, 1562, 1563, 1564,
-	1566, 1567, 1568, 1569, 812, 811, 821, 822, 814, 815,
-	816, 817, 818, 819, 820, 813, 608, 839, 609, 653,
-	404, 1663, 1630, 81, 607, 1618, 1572, 1368, 1638, 344,
-	402, 352, 1680, 1321, 1440, 811, 821, 822, 814, 815,
-	816, 817, 818, 819, 820, 813, 1612, 1594, 1523, 1593,
-	1661, 1125, 1160, 1959, 1949, 1530, 1570, 1708, 1679, 1665,
-	414, 1925, 1905, 1800, 1944, 1840, 1888, 1715, 1881, 1796,
-	1632, 301, 743, 1549, 511, 376, 1684, 1772, 383, 667,
-	1327, 1709, 1217, 1058, 1039, 1691, 695, 302, 1565, 1748,
-	1789, 1755, 1712, 342, 1555, 1061, 343, 1711, 1064, 439,
-	812, 811, 821, 822, 814, 815, 816, 817, 818, 819,
-	820, 813, 1063, 786, 1147, 440, 414, 1728, 841, 414,
-	414, 414, 1636, 1637, 554, 1640, 1641, 1642, 1643, 588,
-	582, 1646, 1647, 1648, 1649, 1650, 1651, 1652, 1653, 1654,
-	1655, 1656, 1657, 1658, 1659, 1760, 1365, 1364, 1768, 1769,
-	1770, 1767, 1588, 731, 26, 434, 777, 868, 83, 1079,
-	869, 1707, 1537, 1873, 1782, 595, 594, 593, 592, 424,
-	422, 421, 293, 292, 1248, 1795, 1372, 773, 775, 1855,
-	81, 1854, 1814, 1802, 1803, 1815, 1424, 414, 1674, 1735,
-	1670, 1666, 1806, 1547, 1546, 1574, 1575, 1813, 1581, 1451,
-	1447, 1449, 414, 1450, 1448, 1446, 1347, 1348, 1808, 1345,
-	1344, 1718, 1719, 1030, 1026, 769, 1817, 1724, 1725, 1836,
-	856, 1405, 863, 408, 711, 78, 291, 1100, 548, 72,
-	11, 1823, 18, 17, 16, 47, 46, 1832, 45, 1834,
-	1835, 1830, 812, 811, 821, 822, 814, 815, 816, 817,
-	818, 819, 820, 813, 1843, 1845, 44, 15, 8, 1875,
-	43, 42, 41, 1851, 14, 13, 37, 36, 35, 34,
-	1278, 33, 32, 1874, 31, 1863, 1864, 1865, 1866, 30,
-	29, 28, 27, 9, 1884, 55, 1886, 1878, 54, 53,
-	1880, 812, 811, 821, 822, 814, 815, 816, 817, 818,
-	819, 820, 813, 1891, 20, 21, 1894, 1901, 1892, 22,
-	61, 60, 59, 58, 57, 1898, 414, 25, 414, 10,
-	7, 4, 2, 0, 0, 700, 1909, 700, 1911, 0,
-	0, 0, 1914, 0, 1875, 1924, 0, 0, 0, 0,
-	0, 0, 0, 414, 1920, 0, 0, 0, 1874, 1923,
-	0, 1928, 700, 1931, 0, 0, 0, 0, 0, 1901,
-	1937, 0, 0, 1837, 0, 1939, 0, 0, 0, 0,
-	0, 1947, 0, 0, 0, 0, 0, 0, 0, 1948,
-	0, 0, 0, 0, 0, 0, 1958, 0, 1957, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1969, 1968,
-	1967, 1958, 986, 972, 0, 934, 988, 906, 922, 996,
-	924, 925, 960, 884, 943, 207, 920, 876, 909, 910,
-	878, 917, 879, 907, 936, 152, 905, 975, 946, 177,
-	994, 179, 0, 0, 236, 192, 0, 0, 939, 977,
-	941, 965, 933, 961, 892, 954, 989, 921, 958, 990,
-	0, 0, 0, 0, 441, 442, 443, 0, 0, 0,
-	0, 135, 0, 0, 0, 0, 0, 957, 982, 919,
-	0, 0, 893, 987, 940, 959, 0, 877, 955, 0,
-	882, 885, 995, 980, 914, 915, 0, 0, 0, 0,
-	0, 0, 0, 937, 942, 962, 930, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 911, 0, 950, 0,
-	0, 0, 887, 883, 0, 935, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 984, 985, 146, 272, 886, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 1006,
-	1007, 1008, 1009, 1010, 891, 0, 912, 963, 0, 875,
-	971, 978, 932, 265, 981, 929, 928, 1013, 0, 1012,
-	240, 1014, 1015, 176, 976, 908, 918, 913, 916, 226,
-	209, 983, 949, 214, 224, 180, 251, 218, 256, 242,
-	264, 966, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 1011, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 874, 260, 0, 205, 973, 880, 890,
-	888, 926, 951, 952, 953, 998, 968, 970, 969, 997,
-	229, 0, 0, 0, 0, 0, 170, 211, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	881, 0, 237, 258, 271, 261, 927, 899, 938, 270,
-	902, 900, 967, 901, 956, 999, 196, 197, 198, 199,
-	923, 139, 947, 931, 1000, 1001, 1002, 1003, 1004, 1005,
-	904, 979, 158, 164, 1286, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 898, 903, 897, 944, 945,
-	991, 992, 993, 964, 889, 974, 894, 896, 895, 948,
-	121, 0, 178, 266, 220, 157, 0, 0, 0, 0,
-	812, 811, 821, 822, 814, 815, 816, 817, 818, 819,
-	820, 813, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 614, 0,
-	0, 0, 1016, 1017, 274, 275, 276, 259, 207, 0,
-	0, 0, 0, 0, 590, 0, 0, 0, 152, 757,
-	0, 0, 177, 0, 179, 0, 0, 236, 192, 0,
-	0, 0, 0, 630, 638, 0, 0, 0, 0, 0,
-	0, 753, 0, 0, 583, 0, 0, 555, 620, 619,
-	598, 0, 0, 0, 135, 599, 0, 604, 0, 600,
-	603, 601, 602, 0, 0, 622, 0, 0, 0, 0,
-	0, 553, 587, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 584, 585, 0, 0, 0,
-	0, 615, 0, 586, 0, 0, 754, 0, 605, 0,
-	126, 241, 255, 136, 232, 269, 140, 239, 132, 206,
-	228, 128, 253, 238, 189, 171, 172, 127, 0, 223,
-	150, 163, 147, 204, 612, 613, 146, 577, 610, 263,
-	130, 131, 262, 203, 250, 254, 190, 184, 129, 252,
-	188, 183, 175, 154, 167, 216, 182, 217, 168, 194,
-	193, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 0, 0, 628,
-	0, 0, 0, 240, 0, 0, 176, 0, 0, 0,
-	611, 0, 226, 209, 641, 0, 214, 224, 180, 251,
-	218, 256, 242, 264, 0, 219, 122, 243, 149, 191,
-	133, 134, 145, 151, 153, 155, 156, 200, 201, 212,
-	231, 244, 245, 246, 148, 141, 225, 142, 165, 143,
-	123, 233, 144, 124, 213, 249, 0, 162, 221, 187,
-	125, 186, 215, 248, 247, 273, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 260, 626, 205,
-	640, 621, 623, 624, 627, 631, 632, 633, 634, 635,
-	637, 639, 642, 229, 0, 0, 0, 0, 0, 170,
-	211, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 258, 271, 576, 0,
-	0, 0, 270, 0, 0, 0, 0, 0, 616, 196,
-	197, 198, 199, 629, 139, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 158, 164, 0, 166, 138,
-	210, 161, 268, 173, 202, 169, 234, 174, 181, 222,
-	267, 208, 227, 137, 257, 235, 185, 160, 648, 625,
-	647, 649, 650, 646, 651, 652, 636, 591, 0, 644,
-	643, 645, 0, 121, 0, 178, 266, 220, 157, 85,
-	557, 558, 559, 560, 561, 562, 563, 93, 564, 95,
-	96, 97, 98, 565, 100, 566, 102, 103, 104, 567,
-	568, 569, 570, 109, 110, 111, 571, 572, 114, 115,
-	116, 117, 573, 574, 575, 614, 0, 274, 275, 276,
-	259, 0, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 590, 0, 0, 0, 152, 1938, 0, 0, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
-	630, 638, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 583, 0, 0, 555, 620, 619, 598, 0, 0,
-	0, 135, 599, 0, 604, 0, 600, 603, 601, 602,
-	0, 0, 622, 0, 0, 0, 0, 0, 553, 587,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 584, 585, 0, 0, 0, 0, 615, 0,
-	586, 0, 0, 617, 0, 605, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 612, 613, 146, 577, 610, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 0, 0, 628, 0, 0, 0,
-	240, 0, 0, 176, 0, 0, 0, 611, 0, 226,
-	209, 641, 0, 214, 224, 180, 251, 218, 256, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 260, 626, 205, 640, 621, 623,
-	624, 627, 631, 632, 633, 634, 635, 637, 639, 642,
-	229, 0, 0, 0, 0, 0, 170, 211, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 258, 271, 576, 0, 0, 0, 270,
-	0, 0, 0, 0, 0, 616, 196, 197, 198, 199,
-	629, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 0, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 648, 625, 647, 649, 650,
-	646, 651, 652, 636, 591, 0, 644, 643, 645, 0,
-	121, 0, 178, 266, 220, 157, 85, 557, 558, 559,
-	560, 561, 562, 563, 93, 564, 95, 96, 97, 98,
-	565, 100, 566, 102, 103, 104, 567, 568, 569, 570,
-	109, 110, 111, 571, 572, 114, 115, 116, 117, 573,
-	574, 575, 614, 0, 274, 275, 276, 259, 0, 0,
-	0, 0, 207, 0, 0, 0, 0, 0, 590, 0,
-	0, 0, 152, 757, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 630, 638, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 583, 0,
-	0, 555, 620, 619, 598, 0, 0, 0, 135, 599,
-	0, 604, 0, 600, 603, 601, 602, 0, 0, 622,
-	0, 0, 0, 0, 0, 553, 587, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 584,
-	585, 0, 0, 0, 0, 615, 0, 586, 0, 0,
-	617, 0, 605, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 612, 613,
-	146, 577, 610, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 0, 628, 0, 0, 0, 240, 0, 0,
-	176, 0, 0, 0, 611, 0, 226, 209, 641, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	0, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 626, 205, 640, 621, 623, 624, 627, 631,
-	632, 633, 634, 635, 637, 639, 642, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 576, 0, 0, 0, 270, 0, 0, 0,
-	0, 0, 616, 196, 197, 198, 199, 629, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 648, 625, 647, 649, 650, 646, 651, 652,
-	636, 591, 0, 644, 643, 645, 0, 121, 0, 178,
-	266, 220, 157, 85, 557, 558, 559, 560, 561, 562,
-	563, 93, 564, 95, 96, 97, 98, 565, 100, 566,
-	102, 103, 104, 567, 568, 569, 570, 109, 110, 111,
-	571, 572, 114, 115, 116, 117, 573, 574, 575, 0,
-	0, 274, 275, 276, 259, 76, 0, 614, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 207, 0, 0,
-	0, 0, 0, 590, 0, 0, 0, 152, 0, 0,
-	0, 177, 0, 179, 0, 0, 236, 192, 0, 0,
-	0, 0, 630, 638, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 583, 0, 0, 555, 620, 619, 598,
-	0, 0, 0, 135, 599, 0, 604, 0, 600, 603,
-	601, 602, 0, 0, 622, 0, 0, 0, 0, 0,
-	553, 587, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 584, 585, 0, 0, 0, 0,
-	615, 0, 586, 0, 0, 617, 0, 605, 0, 126,
-	241, 255, 136, 232, 269, 140, 239, 132, 206, 228,
-	128, 253, 238, 189, 171, 172, 127, 0, 223, 150,
-	163, 147, 204, 612, 613, 146, 577, 610, 263, 130,
-	131, 262, 203, 250, 254, 190, 184, 129, 252, 188,
-	183, 175, 154, 167, 216, 182, 217, 168, 194, 193,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 0, 628, 0,
-	0, 0, 240, 0, 0, 176, 0, 0, 0, 611,
-	0, 226, 209, 641, 0, 214, 224, 180, 251, 218,
-	256, 242, 264, 0, 219, 122, 243, 149, 191, 133,
-	134, 145, 151, 153, 155, 156, 200, 201, 212, 231,
-	244, 245, 246, 148, 141, 225, 142, 165, 143, 123,
-	233, 144, 124, 213, 249, 0, 162, 221, 187, 125,
-	186, 215, 248, 247, 273, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 159, 0, 260, 626, 205, 640,
-	621, 623, 624, 627, 631, 632, 633, 634, 635, 637,
-	639, 642, 229, 0, 0, 0, 0, 0, 170, 211,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 258, 271, 576, 0, 0,
-	0, 270, 0, 0, 0, 0, 0, 616, 196, 197,
-	198, 199, 629, 139, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 158, 164, 0, 166, 138, 210,
-	161, 268, 173, 202, 169, 234, 174, 181, 222, 267,
-	208, 227, 137, 257, 235, 185, 160, 648, 625, 647,
-	649, 650, 646, 651, 652, 636, 591, 0, 644, 643,
-	645, 0, 121, 0, 178, 266, 220, 157, 85, 557,
-	558, 559, 560, 561, 562, 563, 93, 564, 95, 96,
-	97, 98, 565, 100, 566, 102, 103, 104, 567, 568,
-	569, 570, 109, 110, 111, 571, 572, 114, 115, 116,
-	117, 573, 574, 575, 614, 0, 274, 275, 276, 259,
-	0, 0, 0, 0, 207, 0, 0, 0, 0, 0,
-	590, 0, 0, 0, 152, 0, 0, 0, 177, 0,
-	179, 0, 0, 236, 192, 0, 0, 0, 0, 630,
-	638, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	583, 0, 0, 555, 620, 619, 598, 0, 0, 0,
-	135, 599, 0, 604, 0, 600, 603, 601, 602, 0,
-	0, 622, 0, 0, 0, 0, 0, 553, 587, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 584, 585, 550, 0, 0, 0, 615, 0, 586,
-	0, 0, 617, 0, 605, 0, 126, 241, 255, 136,
-	232, 269, 140, 239, 132, 206, 228, 128, 253, 238,
-	189, 171, 172, 127, 0, 223, 150, 163, 147, 204,
-	612, 613, 146, 577, 610, 263, 130, 131, 262, 203,
-	250, 254, 190, 184, 129, 252, 188, 183, 175, 154,
-	167, 216, 182, 217, 168, 194, 193, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 628, 0, 0, 0, 240,
-	0, 0, 176, 0, 0, 0, 611, 0, 226, 209,
-	641, 0, 214, 224, 180, 251, 218, 256, 242, 264,
-	0, 219, 122, 243, 149, 191, 133, 134, 145, 151,
-	153, 155, 156, 200, 201, 212, 231, 244, 245, 246,
-	148, 141, 225, 142, 165, 143, 123, 233, 144, 124,
-	213, 249, 0, 162, 221, 187, 125, 186, 215, 248,
-	247, 273, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 0, 260, 626, 205, 640, 621, 623, 624,
-	627, 631, 632, 633, 634, 635, 637, 639, 642, 229,
-	0, 0, 0, 0, 0, 170, 211, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 258, 271, 576, 0, 0, 0, 270, 0,
-	0, 0, 0, 0, 616, 196, 197, 198, 199, 629,
-	139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 158, 164, 0, 166, 138, 210, 161, 268, 173,
-	202, 169, 234, 174, 181, 222, 267, 208, 227, 137,
-	257, 235, 185, 160, 648, 625, 647, 649, 650, 646,
-	651, 652, 636, 591, 0, 644, 643, 645, 0, 121,
-	0, 178, 266, 220, 157, 85, 557, 558, 559, 560,
-	561, 562, 563, 93, 564, 95, 96, 97, 98, 565,
-	100, 566, 102, 103, 104, 567, 568, 569, 570, 109,
-	110, 111, 571, 572, 114, 115, 116, 117, 573, 574,
-	575, 614, 0, 274, 275, 276, 259, 0, 0, 0,
-	0, 207, 0, 0, 0, 0, 0, 590, 0, 0,
-	0, 152, 0, 0, 0, 177, 0, 179, 0, 0,
-	236, 192, 0, 0, 0, 0, 630, 638, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 583, 0, 0,
-	555, 620, 619, 598, 0, 0, 0, 135, 599, 0,
-	604, 0, 600, 603, 601, 602, 0, 0, 622, 0,
-	0, 0, 0, 0, 553, 587, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 584, 585,
-	0, 0, 0, 0, 615, 0, 586, 0, 0, 617,
-	0, 605, 0, 126, 241, 255, 136, 232, 269, 140,
-	239, 132, 206, 228, 128, 253, 238, 189, 171, 172,
-	127, 0, 223, 150, 163, 147, 204, 612, 613, 146,
-	577, 610, 263, 130, 131, 262, 203, 250, 254, 190,
-	184, 129, 252, 188, 183, 175, 154, 167, 216, 182,
-	217, 168, 194, 193, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	0, 0, 628, 0, 0, 0, 240, 0, 0, 176,
-	0, 0, 0, 611, 0, 226, 209, 641, 0, 214,
-	224, 180, 251, 218, 256, 242, 264, 0, 219, 122,
-	243, 149, 191, 133, 134, 145, 151, 153, 155, 156,
-	200, 201, 212, 231, 244, 245, 246, 148, 141, 225,
-	142, 165, 143, 123, 233, 144, 124, 213, 249, 0,
-	162, 221, 187, 125, 186, 215, 248, 247, 273, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	260, 626, 205, 640, 621, 623, 624, 627, 631, 632,
-	633, 634, 635, 637, 639, 642, 229, 0, 0, 0,
-	0, 0, 170, 211, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 258,
-	271, 576, 0, 0, 0, 270, 0, 0, 0, 0,
-	0, 616, 196, 197, 198, 199, 629, 139, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 158, 164,
-	0, 166, 138, 210, 161, 268, 173, 202, 169, 234,
-	174, 181, 222, 267, 208, 227, 137, 257, 235, 185,
-	160, 648, 625, 647, 649, 650, 646, 651, 652, 636,
-	591, 0, 644, 643, 645, 0, 121, 0, 178, 266,
-	220, 157, 85, 557, 558, 559, 560, 561, 562, 563,
-	93, 564, 95, 96, 97, 98, 565, 100, 566, 102,
-	103, 104, 567, 568, 569, 570, 109, 110, 111, 571,
-	572, 114, 115, 116, 117, 573, 574, 575, 614, 0,
-	274, 275, 276, 259, 0, 0, 0, 0, 207, 0,
-	0, 0, 0, 0, 590, 0, 0, 0, 152, 0,
-	0, 0, 177, 0, 179, 0, 0, 236, 192, 0,
-	0, 0, 0, 630, 638, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 583, 0, 0, 555, 620, 619,
-	598, 0, 0, 0, 135, 599, 0, 604, 0, 600,
-	603, 601, 602, 0, 0, 622, 0, 0, 0, 0,
-	0, 0, 587, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 584, 585, 0, 0, 0,
-	0, 615, 0, 586, 0, 0, 617, 0, 605, 0,
-	126, 241, 255, 136, 232, 269, 140, 239, 132, 206,
-	228, 128, 253, 238, 189, 171, 172, 127, 0, 223,
-	150, 163, 147, 204, 612, 613, 146, 577, 610, 263,
-	130, 131, 262, 203, 250, 254, 190, 184, 129, 252,
-	188, 183, 175, 154, 167, 216, 182, 217, 168, 194,
-	193, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 0, 0, 628,
-	0, 0, 0, 240, 0, 0, 176, 0, 0, 0,
-	611, 0, 226, 209, 641, 0, 214, 224, 180, 251,
-	218, 256, 242, 264, 0, 219, 122, 243, 149, 191,
-	133, 134, 145, 151, 153, 155, 156, 200, 201, 212,
-	231, 244, 245, 246, 148, 141, 225, 142, 165, 143,
-	123, 233, 144, 124, 213, 249, 0, 162, 221, 187,
-	125, 186, 215, 248, 247, 273, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 260, 626, 205,
-	640, 621, 623, 624, 627, 631, 632, 633, 634, 635,
-	637, 639, 642, 229, 0, 0, 0, 0, 0, 170,
-	211, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 258, 271, 576, 0,
-	0, 0, 270, 0, 0, 0, 0, 0, 616, 196,
-	197, 198, 199, 629, 139, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 158, 164, 0, 166, 138,
-	210, 161, 268, 173, 202, 169, 234, 174, 181, 222,
-	267, 208, 227, 137, 257, 235, 185, 160, 648, 625,
-	647, 649, 650, 646, 651, 652, 636, 591, 0, 644,
-	643, 645, 0, 121, 0, 178, 266, 220, 157, 85,
-	557, 558, 559, 560, 561, 562, 563, 93, 564, 95,
-	96, 97, 98, 565, 100, 566, 102, 103, 104, 567,
-	568, 569, 570, 109, 110, 111, 571, 572, 114, 115,
-	116, 117, 573, 574, 575, 614, 0, 274, 275, 276,
-	259, 0, 0, 0, 0, 207, 0, 0, 0, 0,
-	0, 590, 0, 0, 0, 152, 0, 0, 0, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
-	630, 638, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 555, 620, 619, 598, 0, 0,
-	0, 135, 599, 0, 604, 0, 600, 603, 601, 602,
-	0, 0, 622, 0, 0, 0, 0, 0, 553, 587,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 584, 585, 0, 0, 0, 0, 615, 0,
-	586, 0, 0, 617, 0, 605, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 612, 613, 146, 577, 610, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 0, 0, 628, 0, 0, 0,
-	240, 0, 0, 176, 0, 0, 0, 611, 0, 226,
-	209, 641, 0, 214, 224, 180, 251, 218, 256, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 260, 626, 205, 640, 621, 623,
-	624, 627, 631, 632, 633, 634, 635, 637, 639, 642,
-	229, 0, 0, 0, 0, 0, 170, 211, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 258, 271, 576, 0, 0, 0, 270,
-	0, 0, 0, 0, 0, 616, 196, 197, 198, 199,
-	629, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 0, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 648, 625, 647, 649, 650,
-	646, 651, 652, 636, 591, 0, 644, 643, 645, 0,
-	121, 0, 178, 266, 220, 157, 85, 557, 558, 559,
-	560, 561, 562, 563, 93, 564, 95, 96, 97, 98,
-	565, 100, 566, 102, 103, 104, 567, 568, 569, 570,
-	109, 110, 111, 571, 572, 114, 115, 116, 117, 573,
-	574, 575, 0, 0, 274, 275, 276, 259, 313, 0,
-	312, 316, 308, 0, 0, 0, 0, 0, 0, 0,
-	207, 0, 304, 0, 0, 0, 0, 0, 0, 0,
-	152, 0, 0, 323, 177, 0, 179, 0, 0, 236,
-	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
-	0, 0, 327, 0, 0, 0, 135, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 126, 241, 255, 136, 232, 269, 140, 239,
-	132, 206, 228, 128, 253, 238, 189, 171, 172, 127,
-	0, 223, 150, 163, 147, 204, 0, 0, 146, 272,
-	0, 263, 130, 131, 262, 203, 250, 254, 190, 184,
-	129, 252, 188, 183, 175, 154, 167, 216, 182, 217,
-	168, 194, 193, 195, 0, 0, 0, 0, 0, 306,
-	305, 309, 0, 0, 0, 0, 0, 311, 265, 0,
-	0, 0, 0, 0, 0, 240, 0, 0, 176, 315,
-	0, 0, 0, 0, 226, 209, 0, 0, 214, 224,
-	180, 251, 218, 307, 242, 264, 0, 331, 122, 243,
-	149, 191, 133, 134, 145, 151, 153, 155, 156, 200,
-	201, 212, 231, 244, 245, 246, 148, 141, 225, 142,
-	165, 143, 123, 233, 144, 124, 213, 249, 0, 162,
-	221, 187, 125, 186, 215, 248, 247, 273, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 159, 0, 260,
-	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 310,
-	314, 317, 211, 318, 319, 0, 0, 320, 321, 322,
-	0, 0, 324, 325, 0, 0, 0, 237, 258, 271,
-	261, 0, 0, 0, 270, 0, 0, 0, 0, 0,
-	0, 196, 197, 198, 199, 0, 139, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 158, 164, 0,
-	166, 138, 210, 161, 268, 173, 202, 169, 234, 174,
-	181, 222, 267, 208, 227, 137, 257, 235, 185, 160,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 121, 0, 178, 266, 220,
-	157, 85, 86, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 0, 0, 274,
-	275, 276, 259, 313, 0, 312, 316, 308, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 304, 0, 0,
-	0, 0, 0, 0, 0, 152, 0, 0, 323, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 326, 0, 0, 327, 0, 0,
-	0, 135, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 0, 0, 146, 272, 0, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
-	0, 0, 0, 0, 306, 305, 309, 0, 0, 0,
-	0, 0, 311, 265, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 176, 315, 0, 0, 0, 0, 226,
-	209, 0, 0, 214, 224, 180, 251, 218, 307, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 260, 0, 205, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 310, 314, 317, 211, 318, 319,
-	0, 0, 320, 321, 322, 0, 0, 324, 325, 0,
-	0, 0, 237, 258, 271, 261, 0, 0, 0, 270,
-	0, 0, 0, 0, 0, 0, 196, 197, 198, 199,
-	0, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 0, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	121, 0, 178, 266, 220, 157, 85, 86, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	765, 1207, 2174, 2172, 2171, 2179, 2148, 626, 2124, 1766,
+	753, 624, 2011, 644, 2096, 628, 2043, 2116, 1675, 1635,
+	2033, 1973, 2034, 1958, 1484, 528, 1467, 89, 1764, 563,
+	296, 499, 561, 1909, 1961, 1797, 353, 460, 1765, 1917,
+	307, 1141, 1828, 1374, 1645, 89, 309, 407, 514, 1493,
+	1664, 92, 1490, 1685, 341, 341, 1461, 590, 818, 1796,
+	1726, 1688, 1559, 1701, 1699, 1686, 1498, 88, 1655, 1494,
+	1342, 1472, 1144, 1571, 927, 1577, 710, 1413, 532, 1578,
+	408, 1101, 942, 747, 571, 302, 1276, 928, 89, 936,
+	59, 625, 945, 811, 635, 1260, 1336, 1491, 1208, 750,
+	300, 22, 654, 60, 792, 937, 780, 1773, 1152, 768,
+	458, 718, 1206, 1209, 583, 1225, 1169, 414, 416, 748,
+	815, 1120, 782, 311, 1107, 294, 781, 864, 432, 351,
+	461, 291, 60, 400, 832, 554, 749, 739, 312, 313,
+	445, 85, 1118, 2090, 2091, 303, 1988, 1127, 476, 2087,
+	2088, 1572, 1760, 316, 316, 1631, 1466, 645, 652, 506,
+	2089, 930, 646, 347, 651, 2003, 647, 650, 648, 649,
+	1123, 1319, 417, 1462, 1337, 376, 540, 1980, 343, 352,
+	2044, 1735, 1326, 83, 418, 367, 22, 496, 60, 645,
+	652, 422, 421, 535, 646, 572, 651, 1139, 647, 650,
+	648, 649, 541, 800, 801, 527, 784, 526, 529, 530,
+	529, 530, 756, 491, 538, 401, 348, 2065, 2100, 2063,
+	1907, 420, 487, 1910, 1911, 1912, 1913, 1332, 1993, 1333,
+	1996, 1334, 1763, 1468, 760, 1473, 1474, 1475, 1476, 1301,
+	1580, 437, 1345, 1343, 1340, 1344, 1346, 812, 1339, 1338,
+	1123, 1560, 482, 1345, 1343, 1477, 1344, 1346, 1563, 1125,
+	387, 1825, 478, 1553, 1549, 1550, 1551, 1552, 1585, 1680,
+	1584, 1583, 1581, 1684, 1683, 489, 490, 1757, 488, 1628,
+	483, 740, 1901, 477, 1715, 1714, 1579, 2060, 1883, 2062,
+	1711, 89, 436, 2164, 369, 1348, 1349, 1350, 1351, 2002,
+	2180, 435, 89, 1562, 366, 365, 2067, 742, 1962, 1963,
+	1964, 1966, 1965, 2106, 2013, 419, 2009, 2010, 2029, 2013,
+	2113, 1820, 1987, 2141, 1582, 361, 2019, 1865, 1864, 345,
+	1975, 2119, 1234, 2069, 2070, 550, 1811, 463, 525, 524,
+	2036, 485, 441, 2181, 2175, 2149, 1853, 1838, 431, 1414,
+	89, 89, 480, 1170, 1991, 1943, 517, 515, 539, 464,
+	1327, 2005, 2006, 1554, 481, 484, 423, 473, 536, 1323,
+	486, 1184, 392, 1131, 479, 761, 434, 1712, 796, 794,
+	795, 741, 793, 1629, 388, 519, 350, 349, 89, 301,
+	498, 500, 1502, 411, 1372, 1728, 1727, 341, 60, 468,
+	1182, 1181, 1815, 408, 408, 408, 1180, 544, 803, 370,
+	516, 469, 518, 542, 543, 804, 1179, 802, 1555, 360,
+	389, 439, 390, 394, 393, 586, 2159, 1119, 537, 1586,
+	1587, 2128, 1464, 2120, 709, 1859, 825, 1381, 1317, 882,
+	566, 715, 1316, 436, 89, 89, 89, 89, 89, 1300,
+	1294, 1165, 719, 1230, 1137, 1227, 1175, 505, 1100, 1229,
+	1226, 1228, 1232, 1233, 841, 842, 840, 1231, 413, 845,
+	368, 1356, 712, 341, 341, 436, 341, 568, 440, 2004,
+	433, 1454, 501, 2144, 754, 522, 463, 1345, 1343, 463,
+	1344, 1346, 2068, 1462, 341, 341, 316, 737, 1974, 521,
+	533, 493, 1503, 529, 530, 529, 530, 813, 464, 1456,
+	2137, 464, 1146, 897, 898, 341, 1485, 341, 574, 774,
+	549, 341, 89, 585, 1126, 475, 2045, 2046, 1713, 1710,
+	1122, 705, 2023, 60, 1320, 1234, 789, 352, 504, 341,
+	1296, 560, 2037, 2038, 1813, 2117, 2118, 773, 1812, 502,
+	1186, 341, 408, 531, 341, 534, 1105, 438, 2045, 2046,
+	787, 1455, 777, 1944, 1946, 1947, 1948, 1945, 775, 826,
+	1556, 1499, 1502, 316, 523, 755, 1609, 758, 341, 341,
+	830, 89, 1121, 553, 1277, 790, 843, 735, 352, 720,
+	721, 722, 723, 724, 1816, 1817, 734, 411, 770, 573,
+	1211, 1210, 764, 778, 779, 1277, 769, 1419, 771, 785,
+	743, 833, 831, 752, 759, 567, 316, 555, 557, 558,
+	559, 500, 797, 1174, 2122, 786, 840, 1172, 556, 1822,
+	757, 901, 763, 834, 577, 578, 579, 580, 581, 1354,
+	772, 841, 842, 840, 465, 466, 467, 564, 3, 1611,
+	316, 842, 840, 819, 552, 783, 1230, 776, 1227, 819,
+	1203, 828, 1229, 1226, 1228, 1232, 1233, 354, 562, 1821,
+	1231, 1204, 413, 814, 809, 1356, 1659, 1654, 316, 1806,
+	1382, 824, 1503, 2170, 2154, 810, 846, 1496, 2107, 2103,
+	1216, 1497, 1500, 821, 822, 823, 1954, 465, 466, 467,
+	564, 827, 829, 299, 12, 565, 2050, 934, 934, 939,
+	885, 886, 887, 888, 889, 882, 391, 1102, 297, 6,
+	417, 1388, 902, 903, 904, 905, 1142, 1143, 900, 298,
+	5, 906, 899, 1953, 429, 872, 908, 465, 466, 467,
+	564, 2140, 947, 1501, 876, 1267, 1984, 940, 415, 1355,
+	1983, 1938, 910, 465, 466, 467, 1647, 923, 565, 1265,
+	1266, 1264, 89, 89, 948, 880, 890, 891, 883, 884,
+	885, 886, 887, 888, 889, 882, 89, 841, 842, 840,
+	841, 842, 840, 2139, 296, 915, 841, 842, 840, 12,
+	1752, 1167, 1404, 1937, 1103, 395, 2184, 417, 565, 1430,
+	1936, 1933, 1133, 1134, 6, 383, 341, 1750, 1749, 418,
+	941, 833, 933, 1927, 1648, 5, 1155, 60, 386, 1924,
+	849, 850, 851, 852, 853, 854, 341, 847, 1751, 1952,
+	841, 842, 840, 834, 1923, 1889, 1834, 586, 1403, 89,
+	1099, 1832, 1831, 946, 1429, 1200, 1201, 1112, 1113, 1950,
+	841, 842, 840, 1940, 1156, 1157, 1158, 1827, 1826, 1159,
+	841, 842, 840, 1217, 1218, 1761, 1951, 841, 842, 840,
+	1177, 883, 884, 885, 886, 887, 888, 889, 882, 1130,
+	1641, 1640, 1153, 1639, 1638, 1238, 1949, 1449, 713, 1161,
+	1939, 1163, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255,
+	1256, 1257, 1258, 1259, 497, 316, 1205, 1269, 1270, 923,
+	1164, 1171, 1193, 1176, 783, 1162, 1160, 1636, 1282, 1136,
+	819, 819, 819, 1220, 1196, 1191, 2030, 2042, 1422, 1183,
+	2162, 1421, 1222, 1284, 2101, 585, 2041, 2073, 1528, 1197,
+	1198, 1199, 1187, 1188, 1189, 1920, 1959, 2155, 841, 842,
+	840, 1194, 1600, 380, 841, 842, 840, 1135, 1214, 2059,
+	2017, 381, 465, 466, 467, 1223, 1224, 841, 842, 840,
+	2016, 1212, 1213, 1982, 1215, 1941, 1241, 1934, 1930, 1929,
+	841, 842, 840, 1235, 1236, 1237, 1268, 1262, 1239, 1240,
+	1905, 1928, 1246, 1247, 881, 880, 890, 891, 883, 884,
+	885, 886, 887, 888, 889, 882, 1904, 1840, 1278, 1375,
+	2040, 1829, 841, 842, 840, 1808, 1762, 1649, 352, 1634,
+	841, 842, 840, 1286, 1280, 1299, 1279, 1516, 841, 842,
+	840, 1632, 1482, 1283, 1481, 1285, 1480, 1479, 1272, 1287,
+	1288, 2134, 1535, 1539, 1541, 1543, 1545, 1546, 1548, 1271,
+	1553, 1549, 1550, 1551, 1552, 1530, 1531, 1532, 1533, 1514,
+	1515, 1536, 1132, 1517, 919, 1518, 1519, 1520, 1521, 1522,
+	1523, 1524, 1525, 1526, 1527, 1534, 918, 917, 766, 714,
+	1888, 1384, 2189, 1538, 1540, 1542, 1544, 1547, 881, 880,
+	890, 891, 883, 884, 885, 886, 887, 888, 889, 882,
+	1976, 1302, 841, 842, 840, 436, 2183, 2182, 1129, 2165,
+	378, 1529, 379, 1894, 719, 1893, 377, 375, 374, 382,
+	371, 1839, 384, 385, 341, 1747, 893, 341, 896, 1746,
+	436, 1745, 341, 1425, 1313, 1314, 1384, 1424, 1330, 1322,
+	1305, 1841, 894, 895, 892, 2161, 2160, 463, 881, 880,
+	890, 891, 883, 884, 885, 886, 887, 888, 889, 882,
+	1740, 1129, 2152, 841, 842, 840, 1362, 1738, 1720, 464,
+	436, 1650, 1366, 1367, 89, 1733, 1620, 1369, 1564, 1365,
+	1129, 2151, 841, 842, 840, 341, 2127, 2126, 1395, 841,
+	842, 840, 1428, 89, 1849, 2078, 1732, 841, 842, 840,
+	1731, 1353, 762, 2071, 1307, 1849, 2039, 1308, 1619, 1426,
+	1310, 841, 842, 840, 1368, 1324, 1306, 1389, 841, 842,
+	840, 1394, 841, 842, 840, 1618, 1321, 1423, 1311, 1608,
+	841, 842, 840, 1377, 1328, 1329, 1358, 1397, 1393, 769,
+	1359, 1390, 1360, 1318, 841, 842, 840, 841, 842, 840,
+	1335, 841, 842, 840, 1849, 2027, 1849, 2026, 1383, 1153,
+	1352, 1849, 2025, 356, 1602, 1408, 1371, 1364, 1373, 1849,
+	2024, 1361, 1281, 1363, 1370, 2022, 2021, 1219, 1411, 1412,
+	1376, 2000, 1999, 1900, 1899, 1537, 841, 842, 840, 1896,
+	1897, 1601, 838, 934, 738, 1441, 934, 1896, 1895, 1444,
+	1849, 1848, 575, 1598, 1384, 1450, 1304, 1623, 1385, 1597,
+	1102, 1386, 1387, 841, 842, 840, 341, 1384, 1603, 711,
+	341, 341, 1384, 1588, 341, 841, 842, 840, 1384, 1392,
+	1447, 841, 842, 840, 2143, 1398, 1399, 836, 1401, 1402,
+	1384, 1405, 463, 1384, 1391, 1406, 1407, 1595, 89, 1436,
+	737, 1898, 1448, 1289, 417, 1443, 1651, 1410, 436, 1262,
+	1409, 492, 1418, 1104, 464, 471, 899, 1365, 1440, 841,
+	842, 840, 1123, 1416, 1304, 1303, 1420, 1433, 1594, 1486,
+	1487, 89, 1569, 1445, 1621, 1442, 1431, 1432, 1483, 819,
+	1451, 1452, 1438, 1446, 60, 819, 1298, 1297, 1439, 1453,
+	841, 842, 840, 472, 645, 652, 470, 1460, 84, 646,
+	471, 651, 1478, 647, 650, 648, 649, 1292, 1291, 1457,
+	1459, 890, 891, 883, 884, 885, 886, 887, 888, 889,
+	882, 1380, 1667, 473, 1593, 1437, 1504, 1505, 1576, 1615,
+	1506, 1129, 1128, 1295, 1274, 1513, 2185, 1575, 473, 762,
+	1168, 1140, 84, 1574, 551, 1613, 2136, 81, 1614, 341,
+	841, 842, 840, 2130, 1589, 1569, 1568, 1273, 1670, 841,
+	842, 840, 2114, 1607, 1665, 841, 842, 840, 2111, 2109,
+	1678, 1679, 2049, 1573, 1971, 1666, 1956, 1892, 1604, 841,
+	842, 840, 1890, 1590, 1591, 1592, 1653, 1612, 1886, 1596,
+	1885, 81, 1884, 1599, 1881, 711, 1606, 357, 359, 358,
+	1880, 84, 1622, 26, 44, 27, 1646, 1644, 1610, 356,
+	1687, 1671, 1846, 84, 1098, 26, 44, 27, 1616, 1617,
+	1819, 1689, 1719, 1627, 448, 451, 452, 453, 454, 449,
+	1700, 450, 455, 1637, 1702, 1694, 1693, 1657, 1642, 1660,
+	1882, 1643, 2132, 1263, 1357, 1706, 1309, 1681, 1624, 1149,
+	81, 576, 1290, 1652, 1656, 84, 1656, 1185, 1658, 1718,
+	1691, 1692, 81, 1178, 926, 925, 924, 922, 921, 920,
+	916, 1690, 865, 1661, 1695, 1696, 1697, 1698, 913, 707,
+	911, 909, 704, 81, 879, 878, 1677, 877, 1495, 881,
+	880, 890, 891, 883, 884, 885, 886, 887, 888, 889,
+	882, 875, 874, 873, 706, 1703, 1704, 871, 1705, 870,
+	869, 1709, 868, 1673, 867, 866, 341, 341, 863, 862,
+	89, 861, 860, 859, 1721, 858, 857, 1723, 1724, 1725,
+	856, 855, 716, 1722, 436, 1672, 1674, 708, 1729, 474,
+	1108, 1109, 436, 1770, 2083, 1798, 1800, 310, 1798, 1798,
+	2081, 1365, 1730, 1743, 2035, 1717, 1347, 1192, 1736, 1737,
+	1758, 1111, 1739, 494, 442, 386, 1742, 731, 1116, 1807,
+	1753, 89, 1115, 732, 1756, 448, 451, 452, 453, 454,
+	449, 1799, 450, 455, 729, 1114, 727, 1680, 726, 1741,
+	730, 1646, 728, 733, 1795, 452, 453, 725, 1803, 1668,
+	1744, 2169, 342, 1681, 1805, 1293, 1809, 1801, 1802, 1833,
+	819, 1823, 2093, 1793, 569, 1754, 1755, 448, 451, 452,
+	453, 454, 449, 570, 450, 455, 1708, 1830, 357, 359,
+	358, 1707, 1154, 1142, 1143, 1625, 1147, 799, 1463, 1154,
+	356, 355, 1626, 425, 427, 428, 520, 457, 503, 1804,
+	1836, 356, 355, 1211, 1210, 512, 513, 510, 511, 508,
+	509, 2131, 1855, 2054, 2052, 2173, 1748, 357, 359, 358,
+	1998, 1997, 1995, 1921, 1845, 1775, 1903, 1847, 1716, 356,
+	2084, 1633, 1567, 1470, 1469, 507, 1856, 1857, 1566, 1860,
+	1861, 1862, 1863, 1379, 1800, 1866, 1867, 1868, 1869, 1870,
+	1871, 1872, 1873, 1874, 1875, 1876, 1877, 1878, 1879, 711,
+	1850, 1858, 1396, 881, 880, 890, 891, 883, 884, 885,
+	886, 887, 888, 889, 882, 2085, 2084, 2085, 1315, 1887,
+	290, 805, 456, 372, 1, 929, 935, 1915, 1957, 1842,
+	436, 1843, 2092, 2123, 2048, 2095, 643, 1922, 627, 1990,
+	1331, 1906, 1992, 1908, 1138, 1844, 1851, 1916, 1325, 346,
+	495, 1434, 1435, 1734, 1902, 668, 667, 656, 912, 1955,
+	657, 703, 436, 1925, 1926, 436, 436, 436, 1918, 1931,
+	1932, 426, 1919, 436, 655, 1835, 1561, 364, 463, 1605,
+	424, 373, 1824, 1465, 1682, 1989, 1935, 1221, 1779, 2178,
+	1960, 2168, 2147, 1968, 1969, 1970, 2129, 2012, 1967, 1783,
+	464, 1981, 881, 880, 890, 891, 883, 884, 885, 886,
+	887, 888, 889, 882, 2163, 2061, 2112, 2105, 2008, 1772,
+	1852, 314, 1994, 1774, 1776, 1778, 806, 1780, 1781, 1782,
+	1784, 1785, 1786, 1788, 1789, 1790, 1791, 89, 2014, 2015,
+	545, 398, 2007, 1972, 405, 717, 1471, 1341, 1145, 1124,
+	315, 2001, 436, 1891, 362, 1148, 363, 1151, 1150, 1794,
+	848, 1261, 914, 1275, 1417, 907, 588, 634, 2020, 1977,
+	1558, 1557, 1676, 788, 29, 839, 943, 500, 1242, 666,
+	2028, 2057, 2047, 91, 1166, 944, 1914, 1759, 2097, 1792,
+	1117, 1986, 1985, 1837, 642, 2053, 641, 2055, 2056, 2051,
+	640, 639, 447, 446, 444, 443, 1771, 2058, 306, 305,
+	1427, 1378, 1565, 835, 837, 2064, 2066, 2032, 2031, 1978,
+	1979, 1787, 1630, 1818, 1942, 1814, 2072, 1777, 1810, 2018,
+	2099, 1769, 2079, 1768, 1662, 2082, 2080, 1663, 1669, 1512,
+	2047, 2086, 1508, 2098, 2074, 2075, 2076, 2077, 1510, 1511,
+	1509, 1507, 1492, 1489, 2102, 2108, 1488, 2110, 881, 880,
+	890, 891, 883, 884, 885, 886, 887, 888, 889, 882,
+	2104, 1110, 1106, 931, 938, 430, 767, 2115, 86, 304,
+	1195, 2125, 582, 2121, 80, 1173, 791, 21, 20, 42,
+	436, 19, 436, 11, 18, 17, 16, 52, 51, 754,
+	2133, 754, 2135, 50, 49, 15, 2138, 8, 2099, 2146,
+	48, 47, 46, 14, 13, 41, 40, 436, 2047, 2142,
+	39, 2098, 2145, 38, 2150, 37, 754, 2153, 36, 35,
+	34, 2125, 2156, 33, 32, 31, 30, 9, 63, 62,
+	2166, 61, 23, 24, 25, 69, 68, 67, 2167, 66,
+	65, 28, 10, 7, 4, 2177, 2, 2176, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2188, 2187, 2186,
+	2177, 1064, 992, 1011, 1050, 0, 1010, 1066, 981, 998,
+	1074, 1000, 1001, 1038, 959, 1021, 219, 996, 951, 984,
+	985, 953, 993, 954, 982, 1013, 165, 980, 1053, 1024,
+	189, 1072, 191, 0, 0, 248, 204, 0, 0, 1016,
+	1055, 1019, 1043, 176, 1009, 1039, 967, 1032, 1067, 997,
+	1036, 1068, 0, 2158, 0, 0, 465, 466, 467, 0,
+	0, 0, 0, 148, 0, 0, 0, 0, 0, 1035,
+	1060, 995, 0, 0, 968, 1065, 1017, 1037, 0, 952,
+	1033, 0, 957, 960, 1073, 1058, 989, 990, 0, 0,
+	0, 0, 0, 0, 0, 1014, 1020, 1040, 1006, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	986, 0, 1028, 0, 0, 0, 962, 958, 0, 1012,
+	0, 139, 253, 267, 149, 244, 282, 153, 251, 145,
+	218, 240, 141, 265, 250, 201, 183, 184, 140, 0,
+	235, 163, 175, 160, 216, 1062, 1063, 159, 285, 961,
+	275, 143, 144, 274, 215, 262, 266, 202, 196, 142,
+	264, 200, 195, 187, 167, 179, 228, 194, 229, 180,
+	206, 205, 207, 1084, 1085, 1086, 1087, 1088, 966, 0,
+	987, 1041, 0, 950, 1049, 1056, 1008, 277, 1059, 1005,
+	1004, 1091, 0, 1090, 252, 1092, 1093, 188, 1054, 983,
+	994, 988, 991, 238, 221, 1061, 1027, 226, 236, 192,
+	263, 230, 268, 254, 276, 1044, 231, 135, 255, 162,
+	203, 146, 147, 158, 164, 166, 168, 169, 212, 213,
+	224, 243, 256, 257, 258, 161, 154, 237, 155, 177,
+	156, 136, 245, 157, 137, 225, 261, 1089, 174, 233,
+	199, 138, 198, 227, 260, 259, 286, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 172, 949, 272, 0,
+	217, 1051, 955, 965, 963, 1002, 1029, 1030, 1031, 1076,
+	1046, 1048, 1047, 1075, 241, 0, 0, 0, 0, 0,
+	182, 223, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 956, 0, 249, 270, 284, 273,
+	1003, 974, 1015, 283, 977, 975, 1045, 976, 1034, 1077,
+	208, 209, 210, 211, 999, 152, 1018, 1025, 1007, 1078,
+	1079, 1080, 1081, 1082, 1083, 979, 1057, 171, 0, 178,
+	151, 222, 173, 280, 185, 281, 214, 181, 246, 186,
+	193, 234, 279, 220, 239, 150, 269, 247, 197, 973,
+	978, 972, 1022, 1023, 1069, 1070, 1071, 1042, 964, 1052,
+	969, 971, 970, 1026, 129, 1415, 190, 278, 232, 170,
+	1400, 0, 0, 0, 881, 880, 890, 891, 883, 884,
+	885, 886, 887, 888, 889, 882, 0, 0, 881, 880,
+	890, 891, 883, 884, 885, 886, 887, 888, 889, 882,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1094,
+	1095, 287, 288, 289, 1096, 1097, 132, 131, 133, 130,
+	662, 134, 271, 0, 0, 0, 0, 0, 0, 0,
+	219, 0, 0, 0, 0, 0, 636, 0, 0, 0,
+	165, 0, 0, 0, 189, 0, 191, 0, 0, 248,
+	204, 0, 0, 0, 0, 680, 688, 176, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 629, 0, 0,
+	589, 670, 669, 645, 652, 0, 0, 148, 646, 0,
+	651, 0, 647, 650, 648, 649, 0, 0, 672, 0,
+	0, 0, 0, 0, 587, 633, 0, 637, 881, 880,
+	890, 891, 883, 884, 885, 886, 887, 888, 889, 882,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	630, 631, 0, 0, 0, 0, 663, 0, 632, 0,
+	0, 665, 0, 653, 0, 139, 253, 267, 149, 244,
+	282, 153, 251, 145, 218, 240, 141, 265, 250, 201,
+	183, 184, 140, 0, 235, 163, 175, 160, 216, 660,
+	661, 159, 623, 658, 275, 143, 144, 274, 215, 262,
+	266, 202, 196, 142, 264, 200, 195, 187, 167, 179,
+	228, 194, 229, 180, 206, 205, 207, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 277, 0, 0, 678, 0, 0, 0, 252, 0,
+	0, 188, 0, 0, 0, 659, 0, 238, 221, 691,
+	0, 226, 236, 192, 263, 230, 268, 254, 276, 0,
+	231, 135, 255, 162, 203, 146, 147, 158, 164, 166,
+	168, 169, 212, 213, 224, 243, 256, 257, 258, 161,
+	154, 237, 155, 177, 156, 136, 245, 157, 137, 225,
+	261, 0, 174, 233, 199, 138, 198, 227, 260, 259,
+	286, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 272, 676, 217, 690, 671, 673, 674, 677,
+	681, 682, 683, 684, 685, 687, 689, 692, 241, 0,
+	0, 0, 0, 0, 182, 223, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 270, 284, 622, 0, 0, 0, 283, 0, 0,
+	0, 0, 0, 664, 208, 209, 210, 211, 679, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 0, 178, 151, 222, 173, 280, 185, 281,
+	214, 181, 246, 186, 193, 234, 279, 220, 239, 150,
+	269, 247, 197, 698, 675, 697, 699, 700, 696, 701,
+	702, 686, 638, 0, 694, 693, 695, 0, 129, 0,
+	190, 278, 232, 170, 93, 591, 592, 593, 594, 595,
+	596, 597, 598, 599, 600, 601, 602, 603, 604, 605,
+	606, 607, 608, 112, 609, 610, 611, 612, 117, 613,
+	614, 615, 616, 122, 123, 617, 618, 619, 620, 621,
+	1244, 1245, 1243, 0, 662, 287, 288, 289, 0, 0,
+	132, 131, 133, 130, 219, 134, 271, 0, 0, 0,
+	636, 0, 0, 0, 165, 820, 0, 0, 189, 0,
+	191, 0, 0, 248, 204, 0, 0, 0, 0, 680,
+	688, 176, 0, 0, 0, 0, 0, 0, 816, 0,
+	0, 629, 0, 0, 589, 670, 669, 645, 652, 0,
+	0, 148, 646, 0, 651, 0, 647, 650, 648, 649,
+	0, 0, 672, 0, 0, 0, 0, 0, 587, 633,
+	0, 637, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 630, 631, 0, 0, 0, 0,
+	663, 0, 632, 0, 0, 817, 0, 653, 0, 139,
+	253, 267, 149, 244, 282, 153, 251, 145, 218, 240,
+	141, 265, 250, 201, 183, 184, 140, 0, 235, 163,
+	175, 160, 216, 660, 661, 159, 623, 658, 275, 143,
+	144, 274, 215, 262, 266, 202, 196, 142, 264, 200,
+	195, 187, 167, 179, 228, 194, 229, 180, 206, 205,
+	207, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 277, 0, 0, 678, 0,
+	0, 0, 252, 0, 0, 188, 0, 0, 0, 659,
+	0, 238, 221, 691, 0, 226, 236, 192, 263, 230,
+	268, 254, 276, 0, 231, 135, 255, 162, 203, 146,
+	147, 158, 164, 166, 168, 169, 212, 213, 224, 243,
+	256, 257, 258, 161, 154, 237, 155, 177, 156, 136,
+	245, 157, 137, 225, 261, 0, 174, 233, 199, 138,
+	198, 227, 260, 259, 286, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 0, 272, 676, 217, 690,
+	671, 673, 674, 677, 681, 682, 683, 684, 685, 687,
+	689, 692, 241, 0, 0, 0, 0, 0, 182, 223,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 249, 270, 284, 622, 0, 0,
+	0, 283, 0, 0, 0, 0, 0, 664, 208, 209,
+	210, 211, 679, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 171, 0, 178, 151, 222,
+	173, 280, 185, 281, 214, 181, 246, 186, 193, 234,
+	279, 220, 239, 150, 269, 247, 197, 698, 675, 697,
+	699, 700, 696, 701, 702, 686, 638, 0, 694, 693,
+	695, 0, 129, 0, 190, 278, 232, 170, 93, 591,
+	592, 593, 594, 595, 596, 597, 598, 599, 600, 601,
+	602, 603, 604, 605, 606, 607, 608, 112, 609, 610,
+	611, 612, 117, 613, 614, 615, 616, 122, 123, 617,
+	618, 619, 620, 621, 0, 0, 0, 0, 662, 287,
+	288, 289, 0, 0, 132, 131, 133, 130, 219, 134,
+	271, 0, 0, 0, 636, 0, 0, 0, 165, 2157,
+	0, 0, 189, 0, 191, 0, 0, 248, 204, 0,
+	0, 0, 0, 680, 688, 176, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 629, 0, 0, 589, 670,
+	669, 645, 652, 0, 0, 148, 646, 0, 651, 0,
+	647, 650, 648, 649, 0, 0, 672, 0, 0, 0,
+	0, 0, 587, 633, 0, 637, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 630, 631,
+	0, 0, 0, 0, 663, 0, 632, 0, 0, 665,
+	0, 653, 0, 139, 253, 267, 149, 244, 282, 153,
+	251, 145, 218, 240, 141, 265, 250, 201, 183, 184,
+	140, 0, 235, 163, 175, 160, 216, 660, 661, 159,
+	623, 658, 275, 143, 144, 274, 215, 262, 266, 202,
+	196, 142, 264, 200, 195, 187, 167, 179, 228, 194,
+	229, 180, 206, 205, 207, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
+	0, 0, 678, 0, 0, 0, 252, 0, 0, 188,
+	0, 0, 0, 659, 0, 238, 221, 691, 0, 226,
+	236, 192, 263, 230, 268, 254, 276, 0, 231, 135,
+	255, 162, 203, 146, 147, 158, 164, 166, 168, 169,
+	212, 213, 224, 243, 256, 257, 258, 161, 154, 237,
+	155, 177, 156, 136, 245, 157, 137, 225, 261, 0,
+	174, 233, 199, 138, 198, 227, 260, 259, 286, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 0,
+	272, 676, 217, 690, 671, 673, 674, 677, 681, 682,
+	683, 684, 685, 687, 689, 692, 241, 0, 0, 0,
+	0, 0, 182, 223, 0, 242, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 249, 270,
+	284, 622, 0, 0, 0, 283, 0, 0, 0, 0,
+	0, 664, 208, 209, 210, 211, 679, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	0, 178, 151, 222, 173, 280, 185, 281, 214, 181,
+	246, 186, 193, 234, 279, 220, 239, 150, 269, 247,
+	197, 698, 675, 697, 699, 700, 696, 701, 702, 686,
+	638, 0, 694, 693, 695, 0, 129, 0, 190, 278,
+	232, 170, 93, 591, 592, 593, 594, 595, 596, 597,
+	598, 599, 600, 601, 602, 603, 604, 605, 606, 607,
+	608, 112, 609, 610, 611, 612, 117, 613, 614, 615,
+	616, 122, 123, 617, 618, 619, 620, 621, 0, 0,
+	0, 0, 662, 287, 288, 289, 0, 0, 132, 131,
+	133, 130, 219, 134, 271, 0, 0, 0, 636, 0,
+	0, 0, 165, 820, 0, 0, 189, 0, 191, 0,
+	0, 248, 204, 0, 0, 0, 0, 680, 688, 176,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 629,
+	0, 0, 589, 670, 669, 645, 652, 0, 0, 148,
+	646, 0, 651, 0, 647, 650, 648, 649, 0, 0,
+	672, 0, 0, 0, 0, 0, 587, 633, 0, 637,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 630, 631, 0, 0, 0, 0, 663, 0,
+	632, 0, 0, 665, 0, 653, 0, 139, 253, 267,
+	149, 244, 282, 153, 251, 145, 218, 240, 141, 265,
+	250, 201, 183, 184, 140, 0, 235, 163, 175, 160,
+	216, 660, 661, 159, 623, 658, 275, 143, 144, 274,
+	215, 262, 266, 202, 196, 142, 264, 200, 195, 187,
+	167, 179, 228, 194, 229, 180, 206, 205, 207, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 277, 0, 0, 678, 0, 0, 0,
+	252, 0, 0, 188, 0, 0, 0, 659, 0, 238,
+	221, 691, 0, 226, 236, 192, 263, 230, 268, 254,
+	276, 0, 231, 135, 255, 162, 203, 146, 147, 158,
+	164, 166, 168, 169, 212, 213, 224, 243, 256, 257,
+	258, 161, 154, 237, 155, 177, 156, 136, 245, 157,
+	137, 225, 261, 0, 174, 233, 199, 138, 198, 227,
+	260, 259, 286, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 172, 0, 272, 676, 217, 690, 671, 673,
+	674, 677, 681, 682, 683, 684, 685, 687, 689, 692,
+	241, 0, 0, 0, 0, 0, 182, 223, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 249, 270, 284, 622, 0, 0, 0, 283,
+	0, 0, 0, 0, 0, 664, 208, 209, 210, 211,
+	679, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 171, 0, 178, 151, 222, 173, 280,
+	185, 281, 214, 181, 246, 186, 193, 234, 279, 220,
+	239, 150, 269, 247, 197, 698, 675, 697, 699, 700,
+	696, 701, 702, 686, 638, 0, 694, 693, 695, 0,
+	129, 0, 190, 278, 232, 170, 93, 591, 592, 593,
+	594, 595, 596, 597, 598, 599, 600, 601, 602, 603,
+	604, 605, 606, 607, 608, 112, 609, 610, 611, 612,
+	117, 613, 614, 615, 616, 122, 123, 617, 618, 619,
+	620, 621, 0, 0, 84, 0, 662, 287, 288, 289,
+	0, 0, 132, 131, 133, 130, 219, 134, 271, 0,
+	0, 0, 636, 0, 0, 0, 165, 0, 0, 0,
+	189, 0, 191, 0, 0, 248, 204, 0, 0, 0,
+	0, 680, 688, 176, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 629, 0, 0, 589, 670, 669, 645,
+	652, 0, 0, 148, 646, 0, 651, 0, 647, 650,
+	648, 649, 0, 0, 672, 0, 0, 0, 0, 0,
+	587, 633, 0, 637, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 630, 631, 0, 0,
+	0, 0, 663, 0, 632, 0, 0, 665, 0, 653,
+	0, 139, 253, 267, 149, 244, 282, 153, 251, 145,
+	218, 240, 141, 265, 250, 201, 183, 184, 140, 0,
+	235, 163, 175, 160, 216, 660, 661, 159, 623, 658,
+	275, 143, 144, 274, 215, 262, 266, 202, 196, 142,
+	264, 200, 195, 187, 167, 179, 228, 194, 229, 180,
+	206, 205, 207, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 277, 0, 0,
+	678, 0, 0, 0, 252, 0, 0, 188, 0, 0,
+	0, 659, 0, 238, 221, 691, 0, 226, 236, 192,
+	263, 230, 268, 254, 276, 0, 231, 135, 255, 162,
+	203, 146, 147, 158, 164, 166, 168, 169, 212, 213,
+	224, 243, 256, 257, 258, 161, 154, 237, 155, 177,
+	156, 136, 245, 157, 137, 225, 261, 0, 174, 233,
+	199, 138, 198, 227, 260, 259, 286, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 172, 0, 272, 676,
+	217, 690, 671, 673, 674, 677, 681, 682, 683, 684,
+	685, 687, 689, 692, 241, 0, 0, 0, 0, 0,
+	182, 223, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 249, 270, 284, 622,
+	0, 0, 0, 283, 0, 0, 0, 0, 0, 664,
+	208, 209, 210, 211, 679, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 171, 0, 178,
+	151, 222, 173, 280, 185, 281, 214, 181, 246, 186,
+	193, 234, 279, 220, 239, 150, 269, 247, 197, 698,
+	675, 697, 699, 700, 696, 701, 702, 686, 638, 0,
+	694, 693, 695, 0, 129, 0, 190, 278, 232, 170,
+	93, 591, 592, 593, 594, 595, 596, 597, 598, 599,
+	600, 601, 602, 603, 604, 605, 606, 607, 608, 112,
+	609, 610, 611, 612, 117, 613, 614, 615, 616, 122,
+	123, 617, 618, 619, 620, 621, 0, 0, 0, 0,
+	662, 287, 288, 289, 0, 0, 132, 131, 133, 130,
+	219, 134, 271, 0, 0, 0, 636, 0, 0, 0,
+	165, 0, 0, 0, 189, 0, 191, 0, 0, 248,
+	204, 0, 0, 0, 0, 680, 688, 176, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 629, 0, 0,
+	589, 670, 669, 645, 652, 0, 0, 148, 646, 0,
+	651, 0, 647, 650, 648, 649, 0, 0, 672, 0,
+	0, 0, 0, 0, 587, 633, 0, 637, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	630, 631, 584, 0, 0, 0, 663, 0, 632, 0,
+	0, 665, 0, 653, 0, 139, 253, 267, 149, 244,
+	282, 153, 251, 145, 218, 240, 141, 265, 250, 201,
+	183, 184, 140, 0, 235, 163, 175, 160, 216, 660,
+	661, 159, 623, 658, 275, 143, 144, 274, 215, 262,
+	266, 202, 196, 142, 264, 200, 195, 187, 167, 179,
+	228, 194, 229, 180, 206, 205, 207, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 277, 0, 0, 678, 0, 0, 0, 252, 0,
+	0, 188, 0, 0, 0, 659, 0, 238, 221, 691,
+	0, 226, 236, 192, 263, 230, 268, 254, 276, 0,
+	231, 135, 255, 162, 203, 146, 147, 158, 164, 166,
+	168, 169, 212, 213, 224, 243, 256, 257, 258, 161,
+	154, 237, 155, 177, 156, 136, 245, 157, 137, 225,
+	261, 0, 174, 233, 199, 138, 198, 227, 260, 259,
+	286, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 272, 676, 217, 690, 671, 673, 674, 677,
+	681, 682, 683, 684, 685, 687, 689, 692, 241, 0,
+	0, 0, 0, 0, 182, 223, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 270, 284, 622, 0, 0, 0, 283, 0, 0,
+	0, 0, 0, 664, 208, 209, 210, 211, 679, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 0, 178, 151, 222, 173, 280, 185, 281,
+	214, 181, 246, 186, 193, 234, 279, 220, 239, 150,
+	269, 247, 197, 698, 675, 697, 699, 700, 696, 701,
+	702, 686, 638, 0, 694, 693, 695, 0, 129, 0,
+	190, 278, 232, 170, 93, 591, 592, 593, 594, 595,
+	596, 597, 598, 599, 600, 601, 602, 603, 604, 605,
+	606, 607, 608, 112, 609, 610, 611, 612, 117, 613,
+	614, 615, 616, 122, 123, 617, 618, 619, 620, 621,
+	0, 0, 0, 0, 662, 287, 288, 289, 0, 0,
+	132, 131, 133, 130, 219, 134, 271, 0, 0, 0,
+	636, 0, 0, 0, 165, 0, 0, 0, 189, 0,
+	191, 0, 0, 248, 204, 0, 0, 0, 0, 680,
+	688, 176, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 629, 0, 0, 589, 670, 669, 645, 652, 0,
+	0, 148, 646, 0, 651, 0, 647, 650, 648, 649,
+	0, 0, 672, 0, 0, 0, 0, 0, 587, 633,
+	0, 637, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 630, 631, 0, 0, 0, 0,
+	663, 0, 632, 0, 0, 665, 0, 653, 0, 139,
+	253, 267, 149, 244, 282, 153, 251, 145, 218, 240,
+	141, 265, 250, 201, 183, 184, 140, 0, 235, 163,
+	175, 160, 216, 660, 661, 159, 623, 658, 275, 143,
+	144, 274, 215, 262, 266, 202, 196, 142, 264, 200,
+	195, 187, 167, 179, 228, 194, 229, 180, 206, 205,
+	207, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 277, 0, 0, 678, 0,
+	0, 0, 252, 0, 0, 188, 0, 0, 0, 659,
+	0, 238, 221, 691, 0, 226, 236, 192, 263, 230,
+	268, 254, 276, 0, 231, 135, 255, 162, 203, 146,
+	147, 158, 164, 166, 168, 169, 212, 213, 224, 243,
+	256, 257, 258, 161, 154, 237, 155, 177, 156, 136,
+	245, 157, 137, 225, 261, 0, 174, 233, 199, 138,
+	198, 227, 260, 259, 286, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 0, 272, 676, 217, 690,
+	671, 673, 674, 677, 681, 682, 683, 684, 685, 687,
+	689, 692, 241, 0, 0, 0, 0, 0, 182, 223,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 249, 270, 284, 622, 0, 0,
+	0, 283, 0, 0, 0, 0, 0, 664, 208, 209,
+	210, 211, 679, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 171, 0, 178, 151, 222,
+	173, 280, 185, 281, 214, 181, 246, 186, 193, 234,
+	279, 220, 239, 150, 269, 247, 197, 698, 675, 697,
+	699, 700, 696, 701, 702, 686, 638, 0, 694, 693,
+	695, 0, 129, 0, 190, 278, 232, 170, 93, 591,
+	592, 593, 594, 595, 596, 597, 598, 599, 600, 601,
+	602, 603, 604, 605, 606, 607, 608, 112, 609, 610,
+	611, 612, 117, 613, 614, 615, 616, 122, 123, 617,
+	618, 619, 620, 621, 0, 0, 0, 0, 662, 287,
+	288, 289, 0, 0, 132, 131, 133, 130, 219, 134,
+	271, 0, 0, 0, 636, 0, 0, 0, 165, 0,
+	0, 0, 189, 0, 191, 0, 0, 248, 204, 0,
+	0, 0, 0, 680, 688, 176, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 629, 0, 0, 589, 670,
+	669, 645, 652, 0, 0, 148, 646, 0, 651, 0,
+	647, 650, 648, 649, 0, 0, 672, 0, 0, 0,
+	0, 0, 0, 633, 0, 637, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 630, 631,
+	0, 0, 0, 0, 663, 0, 632, 0, 0, 665,
+	0, 653, 0, 139, 253, 267, 149, 244, 282, 153,
+	251, 145, 218, 240, 141, 265, 250, 201, 183, 184,
+	140, 0, 235, 163, 175, 160, 216, 660, 661, 159,
+	623, 658, 275, 143, 144, 274, 215, 262, 266, 202,
+	196, 142, 264, 200, 195, 187, 167, 179, 228, 194,
+	229, 180, 206, 205, 207, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
+	0, 0, 678, 0, 0, 0, 252, 0, 0, 188,
+	0, 0, 0, 659, 0, 238, 221, 691, 0, 226,
+	236, 192, 263, 230, 268, 254, 276, 0, 231, 135,
+	255, 162, 203, 146, 147, 158, 164, 166, 168, 169,
+	212, 213, 224, 243, 256, 257, 258, 161, 154, 237,
+	155, 177, 156, 136, 245, 157, 137, 225, 261, 0,
+	174, 233, 199, 138, 198, 227, 260, 259, 286, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 0,
+	272, 676, 217, 690, 671, 673, 674, 677, 681, 682,
+	683, 684, 685, 687, 689, 692, 241, 0, 0, 0,
+	0, 0, 182, 223, 0, 242, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 249, 270,
+	284, 622, 0, 0, 0, 283, 0, 0, 0, 0,
+	0, 664, 208, 209, 210, 211, 679, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	0, 178, 151, 222, 173, 280, 185, 281, 214, 181,
+	246, 186, 193, 234, 279, 220, 239, 150, 269, 247,
+	197, 698, 675, 697, 699, 700, 696, 701, 702, 686,
+	638, 0, 694, 693, 695, 0, 129, 0, 190, 278,
+	232, 170, 93, 591, 592, 593, 594, 595, 596, 597,
+	598, 599, 600, 601, 602, 603, 604, 605, 606, 607,
+	608, 112, 609, 610, 611, 612, 117, 613, 614, 615,
+	616, 122, 123, 617, 618, 619, 620, 621, 0, 0,
+	0, 0, 662, 287, 288, 289, 0, 0, 132, 131,
+	133, 130, 219, 134, 271, 0, 0, 0, 636, 0,
+	0, 0, 165, 0, 0, 0, 189, 0, 191, 0,
+	0, 248, 204, 0, 0, 0, 0, 680, 688, 176,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 589, 670, 669, 645, 652, 0, 0, 148,
+	646, 0, 651, 0, 647, 650, 648, 649, 0, 0,
+	672, 0, 0, 0, 0, 0, 587, 633, 0, 637,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 630, 631, 0, 0, 0, 0, 663, 0,
+	632, 0, 0, 665, 0, 653, 0, 139, 253, 267,
+	149, 244, 282, 153, 251, 145, 218, 240, 141, 265,
+	250, 201, 183, 184, 140, 0, 235, 163, 175, 160,
+	216, 660, 661, 159, 623, 658, 275, 143, 144, 274,
+	215, 262, 266, 202, 196, 142, 264, 200, 195, 187,
+	167, 179, 228, 194, 229, 180, 206, 205, 207, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 277, 0, 0, 678, 0, 0, 0,
+	252, 0, 0, 188, 0, 0, 0, 659, 0, 238,
+	221, 691, 0, 226, 236, 192, 263, 230, 268, 254,
+	276, 0, 231, 135, 255, 162, 203, 146, 147, 158,
+	164, 166, 168, 169, 212, 213, 224, 243, 256, 257,
+	258, 161, 154, 237, 155, 177, 156, 136, 245, 157,
+	137, 225, 261, 0, 174, 233, 199, 138, 198, 227,
+	260, 259, 286, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 172, 0, 272, 676, 217, 690, 671, 673,
+	674, 677, 681, 682, 683, 684, 685, 687, 689, 692,
+	241, 0, 0, 0, 0, 0, 182, 223, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 249, 270, 284, 622, 0, 0, 0, 283,
+	0, 0, 0, 0, 0, 664, 208, 209, 210, 211,
+	679, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 171, 0, 178, 151, 222, 173, 280,
+	185, 281, 214, 181, 246, 186, 193, 234, 279, 220,
+	239, 150, 269, 247, 197, 698, 675, 697, 699, 700,
+	696, 701, 702, 686, 638, 0, 694, 693, 695, 0,
+	129, 0, 190, 278, 232, 170, 93, 591, 592, 593,
+	594, 595, 596, 597, 598, 599, 600, 601, 602, 603,
+	604, 605, 606, 607, 608, 112, 609, 610, 611, 612,
+	117, 613, 614, 615, 616, 122, 123, 617, 618, 619,
+	620, 621, 0, 0, 0, 0, 0, 287, 288, 289,
+	0, 0, 132, 131, 133, 130, 0, 134, 271, 326,
+	0, 325, 329, 321, 0, 0, 0, 0, 0, 0,
+	0, 219, 0, 317, 0, 0, 0, 0, 0, 0,
+	0, 165, 0, 0, 336, 189, 0, 191, 0, 0,
+	248, 204, 0, 0, 0, 0, 0, 0, 176, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 339, 0, 0, 340, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 253, 267, 149,
+	244, 282, 153, 251, 145, 218, 240, 141, 265, 250,
+	201, 183, 184, 140, 0, 235, 163, 175, 160, 216,
+	0, 0, 159, 285, 0, 275, 143, 144, 274, 215,
+	262, 266, 202, 196, 142, 264, 200, 195, 187, 167,
+	179, 228, 194, 229, 180, 206, 205, 207, 0, 0,
+	0, 0, 0, 319, 318, 322, 0, 0, 0, 0,
+	0, 324, 277, 0, 0, 0, 0, 0, 0, 252,
+	0, 0, 188, 328, 0, 0, 0, 0, 238, 221,
+	0, 0, 226, 236, 192, 263, 230, 320, 254, 276,
+	0, 344, 135, 255, 162, 203, 146, 147, 158, 164,
+	166, 168, 169, 212, 213, 224, 243, 256, 257, 258,
+	161, 154, 237, 155, 177, 156, 136, 245, 157, 137,
+	225, 261, 0, 174, 233, 199, 138, 198, 227, 260,
+	259, 286, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 272, 0, 217, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 323, 327, 330, 223, 331, 332, 0,
+	0, 333, 334, 335, 0, 0, 337, 338, 0, 0,
+	0, 249, 270, 284, 273, 0, 0, 0, 283, 0,
+	0, 0, 0, 0, 0, 208, 209, 210, 211, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 178, 151, 222, 173, 280, 185,
+	281, 214, 181, 246, 186, 193, 234, 279, 220, 239,
+	150, 269, 247, 197, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	0, 190, 278, 232, 170, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 0, 0, 0, 0, 0, 287, 288, 289, 0,
+	0, 132, 131, 133, 130, 0, 134, 271, 326, 0,
+	325, 329, 321, 0, 0, 0, 0, 0, 0, 0,
+	219, 0, 317, 0, 0, 0, 0, 0, 0, 0,
+	165, 0, 0, 336, 189, 0, 191, 0, 0, 248,
+	204, 0, 0, 0, 0, 0, 0, 176, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	339, 0, 0, 340, 0, 0, 0, 148, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 253, 267, 149, 244,
+	282, 153, 251, 145, 218, 240, 141, 265, 250, 201,
+	183, 184, 140, 0, 235, 163, 175, 160, 216, 0,
+	0, 159, 285, 0, 275, 143, 144, 274, 215, 262,
+	266, 202, 196, 142, 264, 200, 195, 187, 167, 179,
+	228, 194, 229, 180, 206, 205, 207, 0, 0, 0,
+	0, 0, 319, 318, 322, 0, 0, 0, 0, 0,
+	324, 277, 0, 0, 0, 0, 0, 0, 252, 0,
+	0, 188, 328, 0, 0, 0, 0, 238, 221, 0,
+	0, 226, 236, 192, 263, 230, 320, 254, 276, 0,
+	231, 135, 255, 162, 203, 146, 147, 158, 164, 166,
+	168, 169, 212, 213, 224, 243, 256, 257, 258, 161,
+	154, 237, 155, 177, 156, 136, 245, 157, 137, 225,
+	261, 0, 174, 233, 199, 138, 198, 227, 260, 259,
+	286, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 272, 0, 217, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 241, 0,
+	0, 0, 323, 327, 330, 223, 331, 332, 0, 0,
+	333, 334, 335, 0, 0, 337, 338, 0, 0, 0,
+	249, 270, 284, 273, 0, 0, 0, 283, 0, 0,
+	0, 0, 0, 0, 208, 209, 210, 211, 0, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 0, 178, 151, 222, 173, 280, 185, 281,
+	214, 181, 246, 186, 193, 234, 279, 220, 239, 150,
+	269, 247, 197, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	190, 278, 232, 170, 93, 94, 95, 96, 97, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 207, 0, 274, 275, 276, 259, 0, 0,
-	0, 0, 152, 0, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 0, 0, 0, 0, 0, 0, 135, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1356, 1359, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 0, 0,
-	146, 272, 0, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1360,
-	265, 0, 0, 0, 1353, 0, 1352, 240, 1354, 1357,
-	176, 0, 0, 0, 0, 0, 226, 209, 0, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	1358, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 0, 205, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 261, 0, 0, 0, 270, 0, 0, 0,
-	0, 0, 0, 196, 197, 198, 199, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 121, 0, 178,
-	266, 220, 157, 85, 86, 87, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 0,
-	0, 274, 275, 276, 259, 76, 0, 23, 39, 24,
-	0, 0, 0, 0, 0, 0, 0, 207, 279, 0,
-	0, 0, 0, 0, 0, 0, 0, 152, 0, 0,
-	0, 177, 0, 179, 0, 0, 236, 192, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 73, 0, 0, 82, 0, 0, 0,
-	0, 0, 0, 135, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
-	241, 255, 136, 232, 269, 140, 239, 132, 206, 228,
-	128, 253, 238, 189, 171, 172, 127, 0, 223, 150,
-	163, 147, 204, 0, 0, 146, 272, 0, 263, 130,
-	131, 262, 203, 250, 254, 190, 184, 129, 252, 188,
-	183, 175, 154, 167, 216, 182, 217, 168, 194, 193,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	282, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 240, 0, 0, 176, 0, 0, 0, 0,
-	0, 226, 209, 0, 0, 214, 224, 180, 251, 218,
-	256, 242, 264, 0, 219, 122, 243, 149, 191, 133,
-	134, 145, 151, 153, 155, 156, 200, 201, 212, 231,
-	244, 245, 246, 148, 141, 225, 142, 165, 143, 123,
-	233, 144, 124, 213, 249, 0, 162, 221, 187, 125,
-	186, 215, 248, 247, 273, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 159, 0, 260, 0, 205, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 170, 211,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 258, 271, 261, 0, 0,
-	0, 270, 0, 0, 0, 0, 0, 0, 196, 197,
-	198, 199, 280, 139, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 158, 164, 0, 166, 138, 210,
-	161, 268, 173, 202, 169, 234, 174, 181, 222, 267,
-	208, 227, 137, 257, 235, 185, 160, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 121, 0, 178, 266, 220, 157, 85, 86,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 207, 0, 274, 275, 276, 259,
-	0, 0, 0, 0, 152, 375, 0, 0, 177, 0,
-	179, 0, 0, 236, 192, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 82, 387, 388, 0, 0, 0, 0,
-	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 389, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 126, 241, 255, 136,
-	232, 269, 140, 239, 132, 206, 228, 128, 253, 238,
-	189, 171, 172, 127, 0, 223, 150, 163, 147, 204,
-	0, 0, 146, 272, 391, 263, 130, 390, 262, 203,
-	250, 254, 190, 184, 129, 252, 188, 183, 175, 154,
-	167, 216, 182, 217, 168, 194, 193, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 0, 0, 240,
-	0, 0, 176, 0, 0, 0, 0, 0, 226, 209,
-	0, 0, 214, 224, 180, 251, 218, 256, 242, 264,
-	374, 219, 122, 243, 149, 191, 133, 134, 145, 151,
-	153, 155, 156, 200, 201, 212, 231, 244, 245, 246,
-	148, 141, 225, 142, 165, 143, 123, 233, 144, 124,
-	213, 249, 0, 162, 221, 187, 125, 186, 215, 248,
-	247, 273, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 0, 260, 0, 205, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 170, 211, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 258, 271, 261, 0, 0, 0, 270, 0,
-	0, 0, 0, 0, 377, 196, 197, 198, 199, 0,
-	139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 158, 164, 0, 166, 138, 210, 161, 268, 173,
-	384, 380, 381, 174, 181, 222, 267, 208, 227, 137,
-	257, 235, 382, 160, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 121,
-	0, 178, 266, 220, 157, 85, 86, 87, 88, 89,
-	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
-	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 0, 207, 274, 275, 276, 259, 782, 0, 0,
-	0, 0, 152, 0, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 0, 0, 0, 0, 0, 0, 135, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 779, 780, 778, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 0, 0,
-	146, 272, 0, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 0, 0, 240, 0, 0,
-	176, 0, 0, 0, 0, 0, 226, 209, 0, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	0, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 0, 205, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 261, 0, 0, 0, 270, 0, 0, 0,
-	0, 0, 0, 196, 197, 198, 199, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 121, 0, 178,
-	266, 220, 157, 85, 86, 87, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 207,
-	0, 274, 275, 276, 259, 0, 0, 0, 0, 152,
-	0, 0, 0, 177, 0, 179, 0, 0, 236, 192,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 387,
-	388, 0, 0, 0, 0, 135, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 389, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 126, 241, 255, 136, 232, 269, 140, 239, 132,
-	206, 228, 128, 253, 238, 189, 171, 172, 127, 0,
-	223, 150, 163, 147, 204, 0, 0, 146, 272, 391,
-	263, 130, 390, 262, 203, 250, 254, 190, 184, 129,
-	252, 188, 183, 175, 154, 167, 216, 182, 217, 168,
-	194, 193, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 0, 0,
-	0, 0, 0, 0, 240, 0, 0, 176, 0, 0,
-	0, 0, 0, 226, 209, 0, 0, 214, 224, 180,
-	251, 218, 256, 242, 264, 0, 219, 122, 243, 149,
-	191, 133, 134, 145, 151, 153, 155, 156, 200, 201,
-	212, 231, 244, 245, 246, 148, 141, 225, 142, 165,
-	143, 123, 233, 144, 124, 213, 249, 0, 162, 221,
-	187, 125, 186, 215, 248, 247, 273, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 260, 0,
-	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	170, 211, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 258, 271, 261,
-	0, 0, 0, 270, 0, 0, 0, 0, 0, 0,
-	196, 197, 198, 199, 0, 139, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 158, 164, 0, 166,
-	138, 210, 161, 268, 173, 384, 380, 381, 174, 181,
-	222, 267, 208, 227, 137, 257, 235, 382, 160, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 121, 0, 178, 266, 220, 157,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	0, 0, 0, 0, 219, 287, 288, 289, 0, 0,
+	132, 131, 133, 130, 165, 134, 271, 0, 189, 0,
+	191, 0, 0, 248, 204, 0, 0, 0, 0, 0,
+	0, 176, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 90, 0, 0, 0, 0, 0,
+	0, 148, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1499, 1502, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	253, 267, 149, 244, 282, 153, 251, 145, 218, 240,
+	141, 265, 250, 201, 183, 184, 140, 0, 235, 163,
+	175, 160, 216, 0, 0, 159, 285, 0, 275, 143,
+	144, 274, 215, 262, 266, 202, 196, 142, 264, 200,
+	195, 187, 167, 179, 228, 194, 229, 180, 206, 205,
+	207, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1503, 277, 0, 0, 0, 1496,
+	0, 1495, 252, 1497, 1500, 188, 0, 0, 0, 0,
+	0, 238, 221, 0, 0, 226, 236, 192, 263, 230,
+	268, 254, 276, 0, 231, 135, 255, 162, 203, 146,
+	147, 158, 164, 166, 168, 169, 212, 213, 224, 243,
+	256, 257, 258, 161, 154, 237, 155, 177, 156, 136,
+	245, 157, 137, 225, 261, 1501, 174, 233, 199, 138,
+	198, 227, 260, 259, 286, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 0, 272, 0, 217, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 241, 0, 0, 0, 0, 0, 182, 223,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 249, 270, 284, 273, 0, 0,
+	0, 283, 0, 0, 0, 0, 0, 0, 208, 209,
+	210, 211, 0, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 171, 0, 178, 151, 222,
+	173, 280, 185, 281, 214, 181, 246, 186, 193, 234,
+	279, 220, 239, 150, 269, 247, 197, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 129, 0, 190, 278, 232, 170, 93, 94,
 	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 0, 0, 274, 275,
-	276, 259, 207, 0, 512, 0, 0, 0, 0, 0,
-	0, 0, 152, 513, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 326, 0, 0, 327, 0, 0, 0, 135, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 0, 0,
-	146, 272, 0, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 0, 0, 240, 0, 0,
-	176, 0, 0, 0, 0, 0, 226, 209, 0, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	0, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 0, 205, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 261, 0, 0, 0, 270, 0, 0, 0,
-	0, 514, 0, 196, 197, 198, 199, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 121, 0, 178,
-	266, 220, 157, 85, 86, 87, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 76,
-	0, 274, 275, 276, 259, 0, 0, 0, 0, 0,
-	0, 207, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 152, 0, 0, 0, 177, 0, 179, 0, 0,
-	236, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 73, 0, 857,
-	82, 0, 0, 0, 0, 0, 0, 135, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 126, 241, 255, 136, 232, 269, 140,
-	239, 132, 206, 228, 128, 253, 238, 189, 171, 172,
-	127, 0, 223, 150, 163, 147, 204, 0, 0, 146,
-	272, 0, 263, 130, 131, 262, 203, 250, 254, 190,
-	184, 129, 252, 188, 183, 175, 154, 167, 216, 182,
-	217, 168, 194, 193, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	0, 0, 0, 0, 0, 0, 240, 0, 0, 176,
-	0, 0, 0, 0, 0, 226, 209, 0, 0, 214,
-	224, 180, 251, 218, 256, 242, 264, 0, 219, 122,
-	243, 149, 191, 133, 134, 145, 151, 153, 155, 156,
-	200, 201, 212, 231, 244, 245, 246, 148, 141, 225,
-	142, 165, 143, 123, 233, 144, 124, 213, 249, 0,
-	162, 221, 187, 125, 186, 215, 248, 247, 273, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	260, 0, 205, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 170, 211, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 258,
-	271, 261, 0, 0, 0, 270, 0, 0, 0, 0,
-	0, 0, 196, 197, 198, 199, 0, 139, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 158, 164,
-	0, 166, 138, 210, 161, 268, 173, 202, 169, 234,
-	174, 181, 222, 267, 208, 227, 137, 257, 235, 185,
-	160, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 121, 0, 178, 266,
-	220, 157, 85, 86, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 0, 0,
-	274, 275, 276, 259, 207, 0, 745, 0, 0, 0,
-	0, 0, 0, 0, 152, 0, 0, 0, 177, 0,
-	179, 0, 0, 236, 192, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 326, 0, 0, 327, 0, 0, 0,
-	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 126, 241, 255, 136,
-	232, 269, 140, 239, 132, 206, 228, 128, 253, 238,
-	189, 171, 172, 127, 0, 223, 150, 163, 147, 204,
-	0, 0, 146, 272, 0, 263, 130, 131, 262, 203,
-	250, 254, 190, 184, 129, 252, 188, 183, 175, 154,
-	167, 216, 182, 217, 168, 194, 193, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 0, 0, 240,
-	0, 0, 176, 0, 0, 0, 0, 0, 226, 209,
-	0, 0, 214, 224, 180, 251, 218, 256, 242, 264,
-	0, 219, 122, 243, 149, 191, 133, 134, 145, 151,
-	153, 155, 156, 200, 201, 212, 231, 244, 245, 246,
-	148, 141, 225, 142, 165, 143, 123, 233, 144, 124,
-	213, 249, 0, 162, 221, 187, 125, 186, 215, 248,
-	247, 273, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 0, 260, 0, 205, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 170, 211, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 258, 271, 261, 0, 0, 0, 270, 0,
-	0, 0, 0, 744, 0, 196, 197, 198, 199, 0,
-	139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 158, 164, 0, 166, 138, 210, 161, 268, 173,
-	202, 169, 234, 174, 181, 222, 267, 208, 227, 137,
-	257, 235, 185, 160, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 121,
-	0, 178, 266, 220, 157, 85, 86, 87, 88, 89,
-	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 0, 0, 0, 0, 0, 287,
+	288, 289, 0, 0, 132, 131, 133, 130, 0, 134,
+	271, 84, 0, 26, 44, 27, 0, 0, 0, 0,
+	0, 0, 0, 219, 292, 0, 0, 0, 0, 0,
+	0, 0, 0, 165, 0, 0, 0, 189, 0, 191,
+	0, 0, 248, 204, 0, 0, 0, 0, 0, 0,
+	176, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	81, 0, 0, 90, 0, 0, 0, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 253,
+	267, 149, 244, 282, 153, 251, 145, 218, 240, 141,
+	265, 250, 201, 183, 184, 140, 0, 235, 163, 175,
+	160, 216, 0, 0, 159, 285, 0, 275, 143, 144,
+	274, 215, 262, 266, 202, 196, 142, 264, 200, 195,
+	187, 167, 179, 228, 194, 229, 180, 206, 205, 207,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 295,
+	0, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 252, 0, 0, 188, 0, 0, 0, 0, 0,
+	238, 221, 0, 0, 226, 236, 192, 263, 230, 268,
+	254, 276, 0, 231, 135, 255, 162, 203, 146, 147,
+	158, 164, 166, 168, 169, 212, 213, 224, 243, 256,
+	257, 258, 161, 154, 237, 155, 177, 156, 136, 245,
+	157, 137, 225, 261, 0, 174, 233, 199, 138, 198,
+	227, 260, 259, 286, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 272, 0, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 182, 223, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 249, 270, 284, 273, 0, 0, 0,
+	283, 0, 0, 0, 0, 0, 0, 208, 209, 210,
+	211, 293, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 0, 178, 151, 222, 173,
+	280, 185, 281, 214, 181, 246, 186, 193, 234, 279,
+	220, 239, 150, 269, 247, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 0, 190, 278, 232, 170, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 0, 0, 0, 0, 0, 287, 288,
+	289, 219, 0, 132, 131, 133, 130, 0, 134, 271,
+	0, 165, 397, 0, 0, 189, 0, 191, 0, 0,
+	248, 204, 0, 0, 0, 0, 0, 0, 176, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 90, 409, 410, 0, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 253, 267, 149,
+	244, 282, 153, 251, 145, 218, 240, 141, 265, 250,
+	201, 183, 184, 140, 0, 235, 163, 175, 160, 216,
+	0, 0, 159, 285, 413, 275, 143, 412, 274, 215,
+	262, 266, 202, 196, 142, 264, 200, 195, 187, 167,
+	179, 228, 194, 229, 180, 206, 205, 207, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 0, 252,
+	0, 0, 188, 0, 0, 0, 0, 0, 238, 221,
+	0, 0, 226, 236, 192, 263, 230, 268, 254, 276,
+	396, 231, 135, 255, 162, 203, 146, 147, 158, 164,
+	166, 168, 169, 212, 213, 224, 243, 256, 257, 258,
+	161, 154, 237, 155, 177, 156, 136, 245, 157, 137,
+	225, 261, 0, 174, 233, 199, 138, 198, 227, 260,
+	259, 286, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 272, 0, 217, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 182, 223, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 249, 270, 284, 273, 0, 0, 0, 283, 0,
+	0, 0, 0, 0, 399, 208, 209, 210, 211, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 178, 151, 222, 173, 280, 185,
+	281, 406, 402, 403, 186, 193, 234, 279, 220, 239,
+	150, 269, 247, 404, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	0, 190, 278, 232, 170, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 0, 0, 0, 0, 219, 287, 288, 289, 0,
+	844, 132, 131, 133, 130, 165, 134, 271, 0, 189,
+	0, 191, 0, 0, 248, 204, 0, 0, 0, 0,
+	0, 0, 176, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 90, 0, 0, 0, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 841, 842, 840, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 253, 267, 149, 244, 282, 153, 251, 145, 218,
+	240, 141, 265, 250, 201, 183, 184, 140, 0, 235,
+	163, 175, 160, 216, 0, 0, 159, 285, 0, 275,
+	143, 144, 274, 215, 262, 266, 202, 196, 142, 264,
+	200, 195, 187, 167, 179, 228, 194, 229, 180, 206,
+	205, 207, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 0, 252, 0, 0, 188, 0, 0, 0,
+	0, 0, 238, 221, 0, 0, 226, 236, 192, 263,
+	230, 268, 254, 276, 0, 231, 135, 255, 162, 203,
+	146, 147, 158, 164, 166, 168, 169, 212, 213, 224,
+	243, 256, 257, 258, 161, 154, 237, 155, 177, 156,
+	136, 245, 157, 137, 225, 261, 0, 174, 233, 199,
+	138, 198, 227, 260, 259, 286, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 272, 0, 217,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 241, 0, 0, 0, 0, 0, 182,
+	223, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 270, 284, 273, 0,
+	0, 0, 283, 0, 0, 0, 0, 0, 0, 208,
+	209, 210, 211, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 0, 178, 151,
+	222, 173, 280, 185, 281, 214, 181, 246, 186, 193,
+	234, 279, 220, 239, 150, 269, 247, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 0, 190, 278, 232, 170, 93,
+	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 0, 0, 0, 0, 219,
+	287, 288, 289, 0, 0, 132, 131, 133, 130, 165,
+	134, 271, 0, 189, 0, 191, 0, 0, 248, 204,
+	0, 0, 0, 0, 0, 0, 176, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 90,
+	409, 410, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 139, 253, 267, 149, 244, 282,
+	153, 251, 145, 218, 240, 141, 265, 250, 201, 183,
+	184, 140, 0, 235, 163, 175, 160, 216, 0, 0,
+	159, 285, 413, 275, 143, 412, 274, 215, 262, 266,
+	202, 196, 142, 264, 200, 195, 187, 167, 179, 228,
+	194, 229, 180, 206, 205, 207, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	277, 0, 0, 0, 0, 0, 0, 252, 0, 0,
+	188, 0, 0, 0, 0, 0, 238, 221, 0, 0,
+	226, 236, 192, 263, 230, 268, 254, 276, 0, 231,
+	135, 255, 162, 203, 146, 147, 158, 164, 166, 168,
+	169, 212, 213, 224, 243, 256, 257, 258, 161, 154,
+	237, 155, 177, 156, 136, 245, 157, 137, 225, 261,
+	0, 174, 233, 199, 138, 198, 227, 260, 259, 286,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 272, 0, 217, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 241, 0, 0,
+	0, 0, 0, 182, 223, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 249,
+	270, 284, 273, 0, 0, 0, 283, 0, 0, 0,
+	0, 0, 0, 208, 209, 210, 211, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 0, 178, 151, 222, 173, 280, 185, 281, 406,
+	402, 403, 186, 193, 234, 279, 220, 239, 150, 269,
+	247, 404, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 0, 190,
+	278, 232, 170, 93, 94, 95, 96, 97, 98, 99,
 	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
 	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 207, 0, 274, 275, 276, 259, 0, 0, 0,
-	0, 152, 0, 0, 0, 177, 0, 179, 0, 0,
-	236, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1870,
-	82, 620, 0, 0, 0, 0, 0, 135, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 126, 241, 255, 136, 232, 269, 140,
-	239, 132, 206, 228, 128, 253, 238, 189, 171, 172,
-	127, 0, 223, 150, 163, 147, 204, 0, 0, 146,
-	272, 0, 263, 130, 131, 262, 203, 250, 254, 190,
-	184, 129, 252, 188, 183, 175, 154, 167, 216, 182,
-	217, 168, 194, 193, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	0, 0, 0, 0, 0, 0, 240, 0, 0, 176,
-	0, 0, 0, 0, 0, 226, 209, 0, 0, 214,
-	224, 180, 251, 218, 256, 242, 264, 0, 219, 122,
-	243, 149, 191, 133, 134, 145, 151, 153, 155, 156,
-	200, 201, 212, 231, 244, 245, 246, 148, 141, 225,
-	142, 165, 143, 123, 233, 144, 124, 213, 249, 0,
-	162, 221, 187, 125, 186, 215, 248, 247, 273, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	260, 0, 205, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 170, 211, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 258,
-	271, 261, 0, 0, 0, 270, 0, 0, 0, 0,
-	0, 0, 196, 197, 198, 199, 0, 139, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 158, 164,
-	0, 166, 138, 210, 161, 268, 173, 202, 169, 234,
-	174, 181, 222, 267, 208, 227, 137, 257, 235, 185,
-	160, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 121, 0, 178, 266,
-	220, 157, 85, 86, 87, 88, 89, 90, 91, 92,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 0,
+	0, 0, 0, 0, 287, 288, 289, 0, 0, 132,
+	131, 133, 130, 0, 134, 271, 219, 0, 546, 0,
+	0, 0, 0, 0, 0, 0, 165, 547, 0, 0,
+	189, 0, 191, 0, 0, 248, 204, 0, 0, 0,
+	0, 0, 0, 176, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 339, 0, 0, 340,
+	0, 0, 0, 148, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 253, 267, 149, 244, 282, 153, 251, 145,
+	218, 240, 141, 265, 250, 201, 183, 184, 140, 0,
+	235, 163, 175, 160, 216, 0, 0, 159, 285, 0,
+	275, 143, 144, 274, 215, 262, 266, 202, 196, 142,
+	264, 200, 195, 187, 167, 179, 228, 194, 229, 180,
+	206, 205, 207, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 277, 0, 0,
+	0, 0, 0, 0, 252, 0, 0, 188, 0, 0,
+	0, 0, 0, 238, 221, 0, 0, 226, 236, 192,
+	263, 230, 268, 254, 276, 0, 231, 135, 255, 162,
+	203, 146, 147, 158, 164, 166, 168, 169, 212, 213,
+	224, 243, 256, 257, 258, 161, 154, 237, 155, 177,
+	156, 136, 245, 157, 137, 225, 261, 0, 174, 233,
+	199, 138, 198, 227, 260, 259, 286, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 172, 0, 272, 0,
+	217, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 241, 0, 0, 0, 0, 0,
+	182, 223, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 249, 270, 284, 273,
+	0, 0, 0, 283, 0, 0, 0, 0, 548, 0,
+	208, 209, 210, 211, 0, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 171, 0, 178,
+	151, 222, 173, 280, 185, 281, 214, 181, 246, 186,
+	193, 234, 279, 220, 239, 150, 269, 247, 197, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 129, 0, 190, 278, 232, 170,
 	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
 	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 207, 0,
-	274, 275, 276, 259, 0, 0, 0, 0, 152, 0,
-	0, 0, 177, 0, 179, 0, 0, 236, 192, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
-	697, 0, 0, 0, 135, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	126, 241, 255, 136, 232, 269, 140, 239, 132, 206,
-	228, 128, 253, 238, 189, 171, 172, 127, 0, 223,
-	150, 163, 147, 204, 0, 0, 146, 272, 0, 263,
-	130, 131, 262, 203, 250, 254, 190, 184, 129, 252,
-	188, 183, 175, 154, 167, 216, 182, 217, 168, 194,
-	193, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 0, 0, 0,
-	0, 0, 0, 240, 0, 0, 176, 0, 0, 0,
-	0, 0, 226, 209, 0, 0, 214, 224, 180, 251,
-	218, 256, 242, 264, 0, 219, 122, 243, 149, 191,
-	133, 134, 145, 151, 153, 155, 156, 200, 201, 212,
-	231, 244, 245, 246, 148, 141, 225, 142, 165, 143,
-	123, 233, 144, 124, 213, 249, 0, 162, 221, 187,
-	125, 186, 215, 248, 247, 273, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 260, 0, 205,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 170,
-	211, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 258, 271, 261, 0,
-	0, 0, 270, 0, 0, 0, 0, 0, 1314, 196,
-	197, 198, 199, 0, 139, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 158, 164, 0, 166, 138,
-	210, 161, 268, 173, 202, 169, 234, 174, 181, 222,
-	267, 208, 227, 137, 257, 235, 185, 160, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 121, 0, 178, 266, 220, 157, 85,
-	86, 87, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 207, 0, 274, 275, 276,
-	259, 0, 0, 0, 0, 152, 1094, 0, 0, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 697, 0, 0,
-	0, 135, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 0, 0, 146, 272, 0, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 176, 0, 0, 0, 0, 0, 226,
-	209, 0, 0, 214, 224, 180, 251, 218, 256, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 260, 0, 205, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 170, 211, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 258, 271, 261, 0, 0, 0, 270,
-	0, 0, 0, 0, 0, 0, 196, 197, 198, 199,
-	0, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 0, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	121, 0, 178, 266, 220, 157, 85, 86, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 0, 0, 84, 0,
+	0, 287, 288, 289, 0, 0, 132, 131, 133, 130,
+	219, 134, 271, 0, 0, 0, 0, 0, 0, 0,
+	165, 0, 0, 0, 189, 0, 191, 0, 0, 248,
+	204, 0, 0, 0, 0, 0, 0, 176, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 81, 0, 932,
+	90, 0, 0, 0, 0, 0, 0, 148, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 253, 267, 149, 244,
+	282, 153, 251, 145, 218, 240, 141, 265, 250, 201,
+	183, 184, 140, 0, 235, 163, 175, 160, 216, 0,
+	0, 159, 285, 0, 275, 143, 144, 274, 215, 262,
+	266, 202, 196, 142, 264, 200, 195, 187, 167, 179,
+	228, 194, 229, 180, 206, 205, 207, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 277, 0, 0, 0, 0, 0, 0, 252, 0,
+	0, 188, 0, 0, 0, 0, 0, 238, 221, 0,
+	0, 226, 236, 192, 263, 230, 268, 254, 276, 0,
+	231, 135, 255, 162, 203, 146, 147, 158, 164, 166,
+	168, 169, 212, 213, 224, 243, 256, 257, 258, 161,
+	154, 237, 155, 177, 156, 136, 245, 157, 137, 225,
+	261, 0, 174, 233, 199, 138, 198, 227, 260, 259,
+	286, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 272, 0, 217, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 241, 0,
+	0, 0, 0, 0, 182, 223, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	249, 270, 284, 273, 0, 0, 0, 283, 0, 0,
+	0, 0, 0, 0, 208, 209, 210, 211, 0, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 0, 178, 151, 222, 173, 280, 185, 281,
+	214, 181, 246, 186, 193, 234, 279, 220, 239, 150,
+	269, 247, 197, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	190, 278, 232, 170, 93, 94, 95, 96, 97, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 207, 0, 274, 275, 276, 259, 0, 0,
-	0, 0, 152, 0, 0, 0, 177, 0, 179, 0,
-	0, 236, 192, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 82, 620, 0, 0, 0, 0, 0, 135, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 126, 241, 255, 136, 232, 269,
-	140, 239, 132, 206, 228, 128, 253, 238, 189, 171,
-	172, 127, 0, 223, 150, 163, 147, 204, 0, 0,
-	146, 272, 0, 263, 130, 131, 262, 203, 250, 254,
-	190, 184, 129, 252, 188, 183, 175, 154, 167, 216,
-	182, 217, 168, 194, 193, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 0, 0, 0, 0, 0, 0, 240, 0, 0,
-	176, 0, 0, 0, 0, 0, 226, 209, 0, 0,
-	214, 224, 180, 251, 218, 256, 242, 264, 0, 219,
-	122, 243, 149, 191, 133, 134, 145, 151, 153, 155,
-	156, 200, 201, 212, 231, 244, 245, 246, 148, 141,
-	225, 142, 165, 143, 123, 233, 144, 124, 213, 249,
-	0, 162, 221, 187, 125, 186, 215, 248, 247, 273,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 159,
-	0, 260, 0, 205, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 170, 211, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	258, 271, 261, 0, 0, 0, 270, 0, 0, 0,
-	0, 0, 0, 196, 197, 198, 199, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
-	164, 0, 166, 138, 210, 161, 268, 173, 202, 169,
-	234, 174, 181, 222, 267, 208, 227, 137, 257, 235,
-	185, 160, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 121, 0, 178,
-	266, 220, 157, 85, 86, 87, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	0, 0, 0, 0, 0, 287, 288, 289, 0, 0,
+	132, 131, 133, 130, 0, 134, 271, 219, 0, 808,
+	0, 0, 0, 0, 0, 0, 0, 165, 0, 0,
+	0, 189, 0, 191, 0, 0, 248, 204, 0, 0,
+	0, 0, 0, 0, 176, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 339, 0, 0,
+	340, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 253, 267, 149, 244, 282, 153, 251,
+	145, 218, 240, 141, 265, 250, 201, 183, 184, 140,
+	0, 235, 163, 175, 160, 216, 0, 0, 159, 285,
+	0, 275, 143, 144, 274, 215, 262, 266, 202, 196,
+	142, 264, 200, 195, 187, 167, 179, 228, 194, 229,
+	180, 206, 205, 207, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 277, 0,
+	0, 0, 0, 0, 0, 252, 0, 0, 188, 0,
+	0, 0, 0, 0, 238, 221, 0, 0, 226, 236,
+	192, 263, 230, 268, 254, 276, 0, 231, 135, 255,
+	162, 203, 146, 147, 158, 164, 166, 168, 169, 212,
+	213, 224, 243, 256, 257, 258, 161, 154, 237, 155,
+	177, 156, 136, 245, 157, 137, 225, 261, 0, 174,
+	233, 199, 138, 198, 227, 260, 259, 286, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 272,
+	0, 217, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 182, 223, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 270, 284,
+	273, 0, 0, 0, 283, 0, 0, 0, 0, 807,
+	0, 208, 209, 210, 211, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 171, 0,
+	178, 151, 222, 173, 280, 185, 281, 214, 181, 246,
+	186, 193, 234, 279, 220, 239, 150, 269, 247, 197,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 0, 190, 278, 232,
+	170, 93, 94, 95, 96, 97, 98, 99, 100, 101,
 	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 207,
-	0, 274, 275, 276, 259, 0, 0, 0, 0, 152,
-	0, 0, 0, 177, 0, 179, 0, 0, 236, 192,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1545, 0, 0, 82, 0,
-	0, 0, 0, 0, 0, 135, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 126, 241, 255, 136, 232, 269, 140, 239, 132,
-	206, 228, 128, 253, 238, 189, 171, 172, 127, 0,
-	223, 150, 163, 147, 204, 0, 0, 146, 272, 0,
-	263, 130, 131, 262, 203, 250, 254, 190, 184, 129,
-	252, 188, 183, 175, 154, 167, 216, 182, 217, 168,
-	194, 193, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 0, 0,
-	0, 0, 0, 0, 240, 0, 0, 176, 0, 0,
-	0, 0, 0, 226, 209, 0, 0, 214, 224, 180,
-	251, 218, 256, 242, 264, 0, 219, 122, 243, 149,
-	191, 133, 134, 145, 151, 153, 155, 156, 200, 201,
-	212, 231, 244, 245, 246, 148, 141, 225, 142, 165,
-	143, 123, 233, 144, 124, 213, 249, 0, 162, 221,
-	187, 125, 186, 215, 248, 247, 273, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 159, 0, 260, 0,
-	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	170, 211, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 258, 271, 261,
-	0, 0, 0, 270, 0, 0, 0, 0, 0, 0,
-	196, 197, 198, 199, 0, 139, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 158, 164, 0, 166,
-	138, 210, 161, 268, 173, 202, 169, 234, 174, 181,
-	222, 267, 208, 227, 137, 257, 235, 185, 160, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 121, 0, 178, 266, 220, 157,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 207, 0, 274, 275,
-	276, 259, 0, 0, 0, 0, 152, 0, 0, 0,
-	177, 0, 179, 0, 0, 236, 192, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 82, 0, 0, 697, 0,
-	0, 0, 135, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 126, 241,
-	255, 136, 232, 269, 140, 239, 132, 206, 228, 128,
-	253, 238, 189, 171, 172, 127, 0, 223, 150, 163,
-	147, 204, 0, 0, 146, 272, 0, 263, 130, 131,
-	262, 203, 250, 254, 190, 184, 129, 252, 188, 183,
-	175, 154, 167, 216, 182, 217, 168, 194, 193, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 0, 0, 0, 0, 0,
-	0, 240, 0, 0, 176, 0, 0, 0, 0, 0,
-	226, 209, 0, 0, 214, 224, 180, 251, 218, 256,
-	242, 264, 0, 219, 122, 243, 149, 191, 133, 134,
-	145, 151, 153, 155, 156, 200, 201, 212, 231, 244,
-	245, 246, 148, 141, 225, 142, 165, 143, 123, 233,
-	144, 124, 213, 249, 0, 162, 221, 187, 125, 186,
-	215, 248, 247, 273, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 159, 0, 260, 0, 205, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 170, 211, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 258, 271, 261, 0, 0, 0,
-	270, 0, 0, 0, 0, 0, 0, 196, 197, 198,
-	199, 0, 139, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 158, 164, 0, 166, 138, 210, 161,
-	268, 173, 202, 169, 234, 174, 181, 222, 267, 208,
-	227, 137, 257, 235, 185, 160, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 121, 0, 178, 266, 220, 157, 85, 86, 87,
-	88, 89, 90, 91, 92, 93, 94, 95, 96, 97,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 0, 0, 0,
+	0, 219, 287, 288, 289, 0, 0, 132, 131, 133,
+	130, 165, 134, 271, 0, 189, 0, 191, 0, 0,
+	248, 204, 0, 0, 0, 0, 0, 0, 176, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2094, 90, 670, 0, 0, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 253, 267, 149,
+	244, 282, 153, 251, 145, 218, 240, 141, 265, 250,
+	201, 183, 184, 140, 0, 235, 163, 175, 160, 216,
+	0, 0, 159, 285, 0, 275, 143, 144, 274, 215,
+	262, 266, 202, 196, 142, 264, 200, 195, 187, 167,
+	179, 228, 194, 229, 180, 206, 205, 207, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 0, 252,
+	0, 0, 188, 0, 0, 0, 0, 0, 238, 221,
+	0, 0, 226, 236, 192, 263, 230, 268, 254, 276,
+	0, 231, 135, 255, 162, 203, 146, 147, 158, 164,
+	166, 168, 169, 212, 213, 224, 243, 256, 257, 258,
+	161, 154, 237, 155, 177, 156, 136, 245, 157, 137,
+	225, 261, 0, 174, 233, 199, 138, 198, 227, 260,
+	259, 286, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 272, 0, 217, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 182, 223, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 249, 270, 284, 273, 0, 0, 0, 283, 0,
+	0, 0, 0, 0, 0, 208, 209, 210, 211, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 178, 151, 222, 173, 280, 185,
+	281, 214, 181, 246, 186, 193, 234, 279, 220, 239,
+	150, 269, 247, 197, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	0, 190, 278, 232, 170, 93, 94, 95, 96, 97,
 	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
 	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	118, 119, 120, 207, 0, 274, 275, 276, 259, 0,
-	0, 0, 0, 152, 0, 0, 0, 177, 0, 179,
-	0, 0, 236, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 82, 0, 0, 0, 0, 0, 0, 135,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1377, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 126, 241, 255, 136, 232,
-	269, 140, 239, 132, 206, 228, 128, 253, 238, 189,
-	171, 172, 127, 0, 223, 150, 163, 147, 204, 0,
-	0, 146, 272, 0, 263, 130, 131, 262, 203, 250,
-	254, 190, 184, 129, 252, 188, 183, 175, 154, 167,
-	216, 182, 217, 168, 194, 193, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 0, 0, 0, 0, 0, 0, 240, 0,
-	0, 176, 0, 0, 0, 0, 0, 226, 209, 0,
-	0, 214, 224, 180, 251, 218, 256, 242, 264, 0,
-	219, 122, 243, 149, 191, 133, 134, 145, 151, 153,
-	155, 156, 200, 201, 212, 231, 244, 245, 246, 148,
-	141, 225, 142, 165, 143, 123, 233, 144, 124, 213,
-	249, 0, 162, 221, 187, 125, 186, 215, 248, 247,
-	273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 260, 0, 205, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 170, 211, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	237, 258, 271, 261, 0, 0, 0, 270, 0, 0,
-	0, 0, 0, 0, 196, 197, 198, 199, 0, 139,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	158, 164, 0, 166, 138, 210, 161, 268, 173, 202,
-	169, 234, 174, 181, 222, 267, 208, 227, 137, 257,
-	235, 185, 160, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 121, 0,
-	178, 266, 220, 157, 85, 86, 87, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	207, 0, 274, 275, 276, 259, 0, 0, 0, 0,
-	152, 0, 0, 0, 177, 0, 179, 0, 0, 236,
-	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 295, 0, 0, 82,
-	0, 0, 0, 0, 0, 0, 135, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 126, 241, 255, 136, 232, 269, 140, 239,
-	132, 206, 228, 128, 253, 238, 189, 171, 172, 127,
-	0, 223, 150, 163, 147, 204, 0, 0, 146, 272,
-	0, 263, 130, 131, 262, 203, 250, 254, 190, 184,
-	129, 252, 188, 183, 175, 154, 167, 216, 182, 217,
-	168, 194, 193, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 0,
-	0, 0, 0, 0, 0, 240, 0, 0, 176, 0,
-	0, 0, 0, 0, 226, 209, 0, 0, 214, 224,
-	180, 251, 218, 256, 242, 264, 0, 219, 122, 243,
-	149, 191, 133, 134, 145, 151, 153, 155, 156, 200,
-	201, 212, 231, 244, 245, 246, 148, 141, 225, 142,
-	165, 143, 123, 233, 144, 124, 213, 249, 0, 162,
-	221, 187, 125, 186, 215, 248, 247, 273, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 159, 0, 260,
-	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 170, 211, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 258, 271,
-	261, 0, 0, 0, 270, 0, 0, 0, 0, 0,
-	0, 196, 197, 198, 199, 0, 139, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 158, 164, 0,
-	166, 138, 210, 161, 268, 173, 202, 169, 234, 174,
-	181, 222, 267, 208, 227, 137, 257, 235, 185, 160,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 121, 0, 178, 266, 220,
-	157, 85, 86, 87, 88, 89, 90, 91, 92, 93,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 0, 0, 0, 0, 219, 287, 288, 289, 0,
+	0, 132, 131, 133, 130, 165, 134, 271, 0, 189,
+	0, 191, 0, 0, 248, 204, 0, 0, 0, 0,
+	0, 0, 176, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 90, 0, 0, 751, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 253, 267, 149, 244, 282, 153, 251, 145, 218,
+	240, 141, 265, 250, 201, 183, 184, 140, 0, 235,
+	163, 175, 160, 216, 0, 0, 159, 285, 0, 275,
+	143, 144, 274, 215, 262, 266, 202, 196, 142, 264,
+	200, 195, 187, 167, 179, 228, 194, 229, 180, 206,
+	205, 207, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 0, 252, 0, 0, 188, 0, 0, 0,
+	0, 0, 238, 221, 0, 0, 226, 236, 192, 263,
+	230, 268, 254, 276, 0, 231, 135, 255, 162, 203,
+	146, 147, 158, 164, 166, 168, 169, 212, 213, 224,
+	243, 256, 257, 258, 161, 154, 237, 155, 177, 156,
+	136, 245, 157, 137, 225, 261, 0, 174, 233, 199,
+	138, 198, 227, 260, 259, 286, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 272, 0, 217,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 241, 0, 0, 0, 0, 0, 182,
+	223, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 270, 284, 273, 0,
+	0, 0, 283, 0, 0, 0, 0, 0, 1458, 208,
+	209, 210, 211, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 0, 178, 151,
+	222, 173, 280, 185, 281, 214, 181, 246, 186, 193,
+	234, 279, 220, 239, 150, 269, 247, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 0, 190, 278, 232, 170, 93,
 	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
 	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 207, 0, 274,
-	275, 276, 259, 0, 0, 0, 0, 152, 0, 0,
-	0, 177, 0, 179, 0, 0, 236, 192, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 0, 0, 0,
-	0, 0, 0, 135, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1107, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
-	241, 255, 136, 232, 269, 140, 239, 132, 206, 228,
-	128, 253, 238, 189, 171, 172, 127, 0, 223, 150,
-	163, 147, 204, 0, 0, 146, 272, 0, 263, 130,
-	131, 262, 203, 250, 254, 190, 184, 129, 252, 188,
-	183, 175, 154, 167, 216, 182, 217, 168, 194, 193,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 240, 0, 0, 176, 0, 0, 0, 0,
-	0, 226, 209, 0, 0, 214, 224, 180, 251, 218,
-	256, 242, 264, 0, 219, 122, 243, 149, 191, 133,
-	134, 145, 151, 153, 155, 156, 200, 201, 212, 231,
-	244, 245, 246, 148, 141, 225, 142, 165, 143, 123,
-	233, 144, 124, 213, 249, 0, 162, 221, 187, 125,
-	186, 215, 248, 247, 273, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 159, 0, 260, 0, 205, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 170, 211,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 258, 271, 261, 0, 0,
-	0, 270, 0, 0, 0, 0, 0, 0, 196, 197,
-	198, 199, 0, 139, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 158, 164, 0, 166, 138, 210,
-	161, 268, 173, 202, 169, 234, 174, 181, 222, 267,
-	208, 227, 137, 257, 235, 185, 160, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 121, 0, 178, 266, 220, 157, 85, 86,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 207, 0, 274, 275, 276, 259,
-	0, 0, 0, 0, 152, 0, 0, 0, 177, 0,
-	179, 0, 0, 236, 192, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 326, 0, 0, 327, 0, 0, 0,
-	135, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 126, 241, 255, 136,
-	232, 269, 140, 239, 132, 206, 228, 128, 253, 238,
-	189, 171, 172, 127, 0, 223, 150, 163, 147, 204,
-	0, 0, 146, 272, 0, 263, 130, 131, 262, 203,
-	250, 254, 190, 184, 129, 252, 188, 183, 175, 154,
-	167, 216, 182, 217, 168, 194, 193, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 0, 0, 240,
-	0, 0, 176, 0, 0, 0, 0, 0, 226, 209,
-	0, 0, 214, 224, 180, 251, 218, 256, 242, 264,
-	0, 219, 122, 243, 149, 191, 133, 134, 145, 151,
-	153, 155, 156, 200, 201, 212, 231, 244, 245, 246,
-	148, 141, 225, 142, 165, 143, 123, 233, 144, 124,
-	213, 249, 0, 162, 221, 187, 125, 186, 215, 248,
-	247, 273, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 0, 260, 0, 205, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 170, 211, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 258, 271, 261, 0, 0, 0, 270, 0,
-	0, 0, 0, 0, 0, 196, 197, 198, 199, 0,
-	139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 158, 164, 0, 166, 138, 210, 161, 268, 173,
-	202, 169, 234, 174, 181, 222, 267, 208, 227, 137,
-	257, 235, 185, 160, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 121,
-	0, 178, 266, 220, 157, 85, 86, 87, 88, 89,
-	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
-	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 207, 0, 274, 275, 276, 259, 0, 0, 0,
-	0, 152, 0, 0, 0, 177, 0, 179, 0, 0,
-	236, 192, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 0, 0, 697, 0, 0, 0, 135, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 126, 241, 255, 136, 232, 269, 140,
-	239, 132, 206, 228, 128, 253, 238, 189, 171, 172,
-	127, 0, 223, 150, 163, 147, 204, 0, 0, 146,
-	272, 0, 263, 130, 131, 262, 203, 250, 254, 190,
-	184, 129, 252, 188, 183, 175, 154, 167, 216, 182,
-	217, 168, 194, 193, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	0, 0, 0, 0, 0, 0, 240, 0, 0, 176,
-	0, 0, 0, 0, 0, 226, 209, 0, 0, 214,
-	224, 180, 251, 218, 256, 242, 264, 0, 219, 122,
-	243, 149, 191, 133, 134, 145, 151, 153, 155, 156,
-	200, 201, 212, 231, 244, 245, 246, 148, 141, 225,
-	142, 165, 143, 123, 233, 144, 124, 213, 249, 0,
-	162, 221, 187, 125, 186, 215, 248, 247, 273, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 159, 0,
-	260, 0, 205, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 170, 211, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 258,
-	271, 735, 0, 0, 0, 270, 0, 0, 0, 0,
-	0, 0, 196, 197, 198, 199, 0, 139, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 158, 164,
-	0, 166, 138, 210, 161, 268, 173, 202, 169, 234,
-	174, 181, 222, 267, 208, 227, 137, 257, 235, 185,
-	160, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 121, 0, 178, 266,
-	220, 157, 85, 86, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 207, 0,
-	274, 275, 276, 259, 0, 0, 0, 79, 152, 0,
-	0, 0, 177, 0, 179, 0, 0, 236, 192, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 0, 0,
-	0, 0, 0, 0, 135, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	126, 241, 255, 136, 232, 269, 140, 239, 132, 206,
-	228, 128, 253, 238, 189, 171, 172, 127, 0, 223,
-	150, 163, 147, 204, 0, 0, 146, 272, 0, 263,
-	130, 131, 262, 203, 250, 254, 190, 184, 129, 252,
-	188, 183, 175, 154, 167, 216, 182, 217, 168, 194,
-	193, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 0, 0, 0,
-	0, 0, 0, 240, 0, 0, 176, 0, 0, 0,
-	0, 0, 226, 209, 0, 0, 214, 224, 180, 251,
-	218, 256, 242, 264, 0, 219, 122, 243, 149, 191,
-	133, 134, 145, 151, 153, 155, 156, 200, 201, 212,
-	231, 244, 245, 246, 148, 141, 225, 142, 165, 143,
-	123, 233, 144, 124, 213, 249, 0, 162, 221, 187,
-	125, 186, 215, 248, 247, 273, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 159, 0, 260, 0, 205,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 170,
-	211, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 258, 271, 261, 0,
-	0, 0, 270, 0, 0, 0, 0, 0, 0, 196,
-	197, 198, 199, 0, 139, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 158, 164, 0, 166, 138,
-	210, 161, 268, 173, 202, 169, 234, 174, 181, 222,
-	267, 208, 227, 137, 257, 235, 185, 160, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 121, 0, 178, 266, 220, 157, 85,
-	86, 87, 88, 89, 90, 91, 92, 93, 94, 95,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 0, 0, 0, 0, 0,
+	287, 288, 289, 219, 0, 132, 131, 133, 130, 0,
+	134, 271, 0, 165, 1190, 0, 0, 189, 0, 191,
+	0, 0, 248, 204, 0, 0, 0, 0, 0, 0,
+	176, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 0, 0, 751, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 253,
+	267, 149, 244, 282, 153, 251, 145, 218, 240, 141,
+	265, 250, 201, 183, 184, 140, 0, 235, 163, 175,
+	160, 216, 0, 0, 159, 285, 0, 275, 143, 144,
+	274, 215, 262, 266, 202, 196, 142, 264, 200, 195,
+	187, 167, 179, 228, 194, 229, 180, 206, 205, 207,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 252, 0, 0, 188, 0, 0, 0, 0, 0,
+	238, 221, 0, 0, 226, 236, 192, 263, 230, 268,
+	254, 276, 0, 231, 135, 255, 162, 203, 146, 147,
+	158, 164, 166, 168, 169, 212, 213, 224, 243, 256,
+	257, 258, 161, 154, 237, 155, 177, 156, 136, 245,
+	157, 137, 225, 261, 0, 174, 233, 199, 138, 198,
+	227, 260, 259, 286, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 272, 0, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 182, 223, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 249, 270, 284, 273, 0, 0, 0,
+	283, 0, 0, 0, 0, 0, 0, 208, 209, 210,
+	211, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 0, 178, 151, 222, 173,
+	280, 185, 281, 214, 181, 246, 186, 193, 234, 279,
+	220, 239, 150, 269, 247, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 0, 190, 278, 232, 170, 93, 94, 95,
 	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
 	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 207, 0, 274, 275, 276,
-	259, 0, 0, 0, 0, 152, 0, 0, 0, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 82, 0, 0, 0, 0, 0,
-	0, 135, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 0, 0, 146, 272, 0, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 0, 0, 0, 0, 219, 287, 288,
+	289, 0, 0, 132, 131, 133, 130, 165, 134, 271,
+	0, 189, 0, 191, 0, 0, 248, 204, 0, 0,
+	0, 0, 0, 0, 176, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 670, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 253, 267, 149, 244, 282, 153, 251,
+	145, 218, 240, 141, 265, 250, 201, 183, 184, 140,
+	0, 235, 163, 175, 160, 216, 0, 0, 159, 285,
+	0, 275, 143, 144, 274, 215, 262, 266, 202, 196,
+	142, 264, 200, 195, 187, 167, 179, 228, 194, 229,
+	180, 206, 205, 207, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 277, 0,
+	0, 0, 0, 0, 0, 252, 0, 0, 188, 0,
+	0, 0, 0, 0, 238, 221, 0, 0, 226, 236,
+	192, 263, 230, 268, 254, 276, 0, 231, 135, 255,
+	162, 203, 146, 147, 158, 164, 166, 168, 169, 212,
+	213, 224, 243, 256, 257, 258, 161, 154, 237, 155,
+	177, 156, 136, 245, 157, 137, 225, 261, 0, 174,
+	233, 199, 138, 198, 227, 260, 259, 286, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 272,
+	0, 217, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 182, 223, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 270, 284,
+	273, 0, 0, 0, 283, 0, 0, 0, 0, 0,
+	0, 208, 209, 210, 211, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 171, 0,
+	178, 151, 222, 173, 280, 185, 281, 214, 181, 246,
+	186, 193, 234, 279, 220, 239, 150, 269, 247, 197,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 0, 190, 278, 232,
+	170, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 0, 0, 0,
+	0, 219, 287, 288, 289, 0, 0, 132, 131, 133,
+	130, 165, 134, 271, 0, 189, 0, 191, 0, 0,
+	248, 204, 0, 0, 0, 0, 0, 0, 176, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1767, 0,
+	0, 90, 0, 0, 0, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 253, 267, 149,
+	244, 282, 153, 251, 145, 218, 240, 141, 265, 250,
+	201, 183, 184, 140, 0, 235, 163, 175, 160, 216,
+	0, 0, 159, 285, 0, 275, 143, 144, 274, 215,
+	262, 266, 202, 196, 142, 264, 200, 195, 187, 167,
+	179, 228, 194, 229, 180, 206, 205, 207, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 0, 252,
+	0, 0, 188, 0, 0, 0, 0, 0, 238, 221,
+	0, 0, 226, 236, 192, 263, 230, 268, 254, 276,
+	0, 231, 135, 255, 162, 203, 146, 147, 158, 164,
+	166, 168, 169, 212, 213, 224, 243, 256, 257, 258,
+	161, 154, 237, 155, 177, 156, 136, 245, 157, 137,
+	225, 261, 0, 174, 233, 199, 138, 198, 227, 260,
+	259, 286, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 272, 0, 217, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 182, 223, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 249, 270, 284, 273, 0, 0, 0, 283, 0,
+	0, 0, 0, 0, 0, 208, 209, 210, 211, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 178, 151, 222, 173, 280, 185,
+	281, 214, 181, 246, 186, 193, 234, 279, 220, 239,
+	150, 269, 247, 197, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	0, 190, 278, 232, 170, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 0, 0, 0, 0, 219, 287, 288, 289, 0,
+	0, 132, 131, 133, 130, 165, 134, 271, 0, 189,
+	0, 191, 0, 0, 248, 204, 0, 0, 0, 0,
+	0, 0, 176, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 90, 0, 0, 751, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 253, 267, 149, 244, 282, 153, 251, 145, 218,
+	240, 141, 265, 250, 201, 183, 184, 140, 0, 235,
+	163, 175, 160, 216, 0, 0, 159, 285, 0, 275,
+	143, 144, 274, 215, 262, 266, 202, 196, 142, 264,
+	200, 195, 187, 167, 179, 228, 194, 229, 180, 206,
+	205, 207, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 0, 252, 0, 0, 188, 0, 0, 0,
+	0, 0, 238, 221, 0, 0, 226, 236, 192, 263,
+	230, 268, 254, 276, 0, 231, 135, 255, 162, 203,
+	146, 147, 158, 164, 166, 168, 169, 212, 213, 224,
+	243, 256, 257, 258, 161, 154, 237, 155, 177, 156,
+	136, 245, 157, 137, 225, 261, 0, 174, 233, 199,
+	138, 198, 227, 260, 259, 286, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 272, 0, 217,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 241, 0, 0, 0, 0, 0, 182,
+	223, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 270, 284, 273, 0,
+	0, 0, 283, 0, 0, 0, 0, 0, 0, 208,
+	209, 210, 211, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 0, 178, 151,
+	222, 173, 280, 185, 281, 214, 181, 246, 186, 193,
+	234, 279, 220, 239, 150, 269, 247, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 0, 190, 278, 232, 170, 93,
+	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 0, 0, 0, 0, 219,
+	287, 288, 289, 0, 0, 132, 131, 133, 130, 165,
+	134, 271, 0, 189, 0, 191, 0, 0, 248, 204,
+	0, 0, 0, 0, 0, 0, 176, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 90,
+	0, 0, 0, 0, 0, 0, 148, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 176, 0, 0, 0, 0, 0, 226,
-	209, 0, 0, 214, 224, 180, 251, 218, 256, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 159, 0, 260, 0, 205, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 170, 211, 0, 230,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 258, 271, 261, 0, 0, 0, 270,
-	0, 0, 0, 0, 0, 0, 196, 197, 198, 199,
-	0, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 0, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	121, 0, 178, 266, 220, 157, 85, 86, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 0, 207, 274, 275, 276, 259, 436, 0,
-	0, 0, 0, 152, 0, 0, 0, 177, 0, 179,
-	0, 0, 236, 192, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 441, 442, 443, 438, 0, 0, 0, 135,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 126, 241, 255, 136, 232,
-	269, 140, 239, 132, 206, 228, 128, 253, 238, 189,
-	171, 172, 127, 0, 223, 150, 163, 147, 204, 0,
-	0, 146, 272, 0, 263, 130, 131, 262, 203, 250,
-	254, 190, 184, 129, 252, 188, 183, 175, 154, 167,
-	216, 182, 217, 168, 194, 193, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 0, 0, 0, 0, 0, 0, 240, 0,
-	0, 176, 0, 0, 0, 0, 0, 226, 209, 0,
-	0, 214, 224, 180, 251, 218, 256, 242, 264, 0,
-	219, 122, 243, 149, 191, 133, 134, 145, 151, 153,
-	155, 156, 200, 201, 212, 231, 244, 245, 246, 148,
-	141, 225, 142, 165, 143, 123, 233, 144, 124, 213,
-	249, 0, 162, 221, 187, 125, 186, 215, 248, 247,
-	273, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	159, 0, 260, 0, 205, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 170, 211, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	237, 258, 271, 261, 0, 0, 0, 270, 0, 0,
-	0, 0, 0, 0, 196, 197, 198, 199, 0, 139,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	158, 164, 0, 166, 138, 210, 161, 268, 173, 202,
-	169, 234, 174, 181, 222, 267, 208, 227, 137, 257,
-	235, 185, 160, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 207, 0, 0, 0, 121, 0,
-	178, 266, 220, 157, 152, 0, 0, 0, 177, 0,
-	179, 0, 0, 236, 192, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 441, 442, 443, 438, 0, 0, 0,
-	135, 0, 274, 275, 276, 259, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 126, 241, 255, 136,
-	232, 269, 140, 239, 132, 206, 228, 128, 253, 238,
-	189, 171, 172, 127, 0, 223, 150, 163, 147, 204,
-	0, 0, 146, 272, 0, 263, 130, 131, 262, 203,
-	250, 254, 190, 184, 129, 252, 188, 183, 175, 154,
-	167, 216, 182, 217, 168, 194, 193, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 0, 0, 0, 0, 0, 0, 240,
-	0, 0, 176, 0, 0, 0, 0, 0, 226, 209,
-	0, 0, 214, 224, 180, 251, 218, 256, 242, 264,
-	0, 219, 122, 243, 149, 191, 133, 134, 145, 151,
-	153, 155, 156, 200, 201, 212, 231, 244, 245, 246,
-	148, 141, 225, 142, 165, 143, 123, 233, 144, 124,
-	213, 249, 0, 162, 221, 187, 125, 186, 215, 248,
-	247, 273, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 159, 0, 260, 0, 205, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 170, 211, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 258, 271, 261, 0, 0, 0, 270, 0,
-	0, 0, 0, 0, 0, 196, 197, 198, 199, 0,
-	139, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 158, 164, 0, 166, 138, 210, 161, 268, 173,
-	202, 169, 234, 174, 181, 222, 267, 208, 227, 137,
-	257, 235, 185, 160, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 207, 0, 0, 0, 121,
-	0, 178, 266, 220, 157, 152, 0, 0, 0, 177,
-	0, 179, 0, 0, 236, 192, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 441, 442, 443, 0, 0, 0,
-	0, 135, 0, 274, 275, 276, 259, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 126, 241, 255,
-	136, 232, 269, 140, 239, 132, 206, 228, 128, 253,
-	238, 189, 171, 172, 127, 0, 223, 150, 163, 147,
-	204, 0, 0, 146, 272, 0, 263, 130, 131, 262,
-	203, 250, 254, 190, 184, 129, 252, 188, 183, 175,
-	154, 167, 216, 182, 217, 168, 194, 193, 195, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 0, 0, 0, 0, 0, 0,
-	240, 0, 0, 176, 0, 0, 0, 0, 0, 226,
-	209, 0, 0, 214, 224, 180, 251, 218, 256, 242,
-	264, 0, 219, 122, 243, 149, 191, 133, 134, 145,
-	151, 153, 155, 156, 200, 201, 212, 231, 244, 245,
-	246, 148, 141, 225, 142, 165, 143, 123, 233, 144,
-	124, 213, 249, 0, 162, 221, 187, 125, 186, 215,
-	248, 247, 273, 0, 0, 0, 0, 0, 0, 0,
-	0, 1571, 159, 0, 260, 0, 205, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 1067, 170, 211, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 258, 271, 261, 0, 0, 0, 270,
-	1571, 1634, 0, 0, 0, 0, 196, 197, 198, 199,
-	1553, 139, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 158, 164, 1067, 166, 138, 210, 161, 268,
-	173, 202, 169, 234, 174, 181, 222, 267, 208, 227,
-	137, 257, 235, 185, 160, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1553,
-	121, 0, 178, 266, 220, 157, 0, 76, 0, 23,
-	39, 24, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 64, 0, 0,
-	0, 71, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 274, 275, 276, 259, 0, 0,
-	40, 0, 0, 0, 0, 73, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1557, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1561, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1550, 0, 0, 0, 1552, 1554, 1556, 0,
-	1558, 1559, 1560, 1562, 1563, 1564, 1566, 1567, 1568, 1569,
-	1557, 67, 68, 0, 69, 70, 0, 0, 0, 0,
-	0, 1561, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1572, 0, 0, 0, 0, 0, 0, 0,
-	0, 1550, 0, 0, 0, 1552, 1554, 1556, 0, 1558,
-	1559, 1560, 1562, 1563, 1564, 1566, 1567, 1568, 1569, 0,
-	0, 0, 1570, 0, 0, 0, 0, 0, 56, 66,
-	74, 0, 38, 0, 0, 0, 0, 0, 0, 1549,
-	0, 1572, 0, 0, 0, 0, 0, 0, 65, 63,
-	62, 0, 0, 0, 1565, 0, 0, 0, 0, 0,
-	1555, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 1570, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1549, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1565, 0, 0, 0, 0, 0, 1555,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 48, 0, 0, 0, 0, 0,
-	49, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 50,
+	0, 0, 0, 0, 139, 253, 267, 149, 244, 282,
+	153, 251, 145, 218, 240, 141, 265, 250, 201, 183,
+	184, 140, 0, 235, 163, 175, 160, 216, 0, 0,
+	159, 285, 0, 275, 143, 144, 274, 215, 262, 266,
+	202, 196, 142, 264, 200, 195, 187, 167, 179, 228,
+	194, 229, 180, 206, 205, 207, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	277, 0, 0, 0, 0, 0, 0, 252, 0, 0,
+	188, 0, 0, 0, 0, 0, 238, 221, 0, 0,
+	226, 236, 192, 263, 230, 268, 254, 276, 0, 231,
+	135, 255, 162, 203, 146, 147, 158, 164, 166, 168,
+	169, 212, 213, 224, 243, 256, 257, 258, 161, 154,
+	237, 155, 177, 156, 136, 245, 157, 137, 225, 261,
+	0, 174, 233, 199, 138, 198, 227, 260, 259, 286,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 272, 0, 217, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 241, 0, 0,
+	0, 0, 0, 182, 223, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 249,
+	270, 284, 273, 0, 0, 0, 283, 0, 0, 0,
+	0, 0, 0, 208, 209, 210, 211, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 0, 178, 151, 222, 173, 280, 185, 281, 214,
+	181, 246, 186, 193, 234, 279, 220, 239, 150, 269,
+	247, 197, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 0, 190,
+	278, 232, 170, 93, 94, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 0,
+	0, 0, 0, 219, 287, 288, 289, 0, 0, 132,
+	131, 133, 130, 165, 134, 271, 0, 189, 0, 191,
+	0, 0, 248, 204, 0, 0, 0, 0, 0, 0,
+	176, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	308, 0, 0, 90, 0, 0, 0, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 253,
+	267, 149, 244, 282, 153, 251, 145, 218, 240, 141,
+	265, 250, 201, 183, 184, 140, 0, 235, 163, 175,
+	160, 216, 0, 0, 159, 285, 0, 275, 143, 144,
+	274, 215, 262, 266, 202, 196, 142, 264, 200, 195,
+	187, 167, 179, 228, 194, 229, 180, 206, 205, 207,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 252, 0, 0, 188, 0, 0, 0, 0, 0,
+	238, 221, 0, 0, 226, 236, 192, 263, 230, 268,
+	254, 276, 0, 231, 135, 255, 162, 203, 146, 147,
+	158, 164, 166, 168, 169, 212, 213, 224, 243, 256,
+	257, 258, 161, 154, 237, 155, 177, 156, 136, 245,
+	157, 137, 225, 261, 0, 174, 233, 199, 138, 198,
+	227, 260, 259, 286, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 272, 0, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 182, 223, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 249, 270, 284, 273, 0, 0, 0,
+	283, 0, 0, 0, 0, 0, 0, 208, 209, 210,
+	211, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 0, 178, 151, 222, 173,
+	280, 185, 281, 214, 181, 246, 186, 193, 234, 279,
+	220, 239, 150, 269, 247, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 0, 190, 278, 232, 170, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 0, 0, 0, 0, 219, 287, 288,
+	289, 0, 0, 132, 131, 133, 130, 165, 134, 271,
+	0, 189, 0, 191, 0, 0, 248, 204, 0, 0,
+	0, 0, 0, 0, 176, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1202,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 253, 267, 149, 244, 282, 153, 251,
+	145, 218, 240, 141, 265, 250, 201, 183, 184, 140,
+	0, 235, 163, 175, 160, 216, 0, 0, 159, 285,
+	0, 275, 143, 144, 274, 215, 262, 266, 202, 196,
+	142, 264, 200, 195, 187, 167, 179, 228, 194, 229,
+	180, 206, 205, 207, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 277, 0,
+	0, 0, 0, 0, 0, 252, 0, 0, 188, 0,
+	0, 0, 0, 0, 238, 221, 0, 0, 226, 236,
+	192, 263, 230, 268, 254, 276, 0, 231, 135, 255,
+	162, 203, 146, 147, 158, 164, 166, 168, 169, 212,
+	213, 224, 243, 256, 257, 258, 161, 154, 237, 155,
+	177, 156, 136, 245, 157, 137, 225, 261, 0, 174,
+	233, 199, 138, 198, 227, 260, 259, 286, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 272,
+	0, 217, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 182, 223, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 270, 284,
+	273, 0, 0, 0, 283, 0, 0, 0, 0, 0,
+	0, 208, 209, 210, 211, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 171, 0,
+	178, 151, 222, 173, 280, 185, 281, 214, 181, 246,
+	186, 193, 234, 279, 220, 239, 150, 269, 247, 197,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 0, 190, 278, 232,
+	170, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 0, 0, 0,
+	0, 219, 287, 288, 289, 0, 0, 132, 131, 133,
+	130, 165, 134, 271, 0, 189, 0, 191, 0, 0,
+	248, 204, 0, 0, 0, 0, 0, 0, 176, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 339, 0, 0, 340, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 253, 267, 149,
+	244, 282, 153, 251, 145, 218, 240, 141, 265, 250,
+	201, 183, 184, 140, 0, 235, 163, 175, 160, 216,
+	0, 0, 159, 285, 0, 275, 143, 144, 274, 215,
+	262, 266, 202, 196, 142, 264, 200, 195, 187, 167,
+	179, 228, 194, 229, 180, 206, 205, 207, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 0, 252,
+	0, 0, 188, 0, 0, 0, 0, 0, 238, 221,
+	0, 0, 226, 236, 192, 263, 230, 268, 254, 276,
+	0, 231, 135, 255, 162, 203, 146, 147, 158, 164,
+	166, 168, 169, 212, 213, 224, 243, 256, 257, 258,
+	161, 154, 237, 155, 177, 156, 136, 245, 157, 137,
+	225, 261, 0, 174, 233, 199, 138, 198, 227, 260,
+	259, 286, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 272, 0, 217, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 182, 223, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 249, 270, 284, 273, 0, 0, 0, 283, 0,
+	0, 0, 0, 0, 0, 208, 209, 210, 211, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 178, 151, 222, 173, 280, 185,
+	281, 214, 181, 246, 186, 193, 234, 279, 220, 239,
+	150, 269, 247, 197, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	0, 190, 278, 232, 170, 93, 94, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
+	128, 0, 0, 0, 0, 219, 287, 288, 289, 0,
+	0, 132, 131, 133, 130, 165, 134, 271, 0, 189,
+	0, 191, 0, 0, 248, 204, 0, 0, 0, 0,
+	0, 0, 176, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 90, 0, 0, 751, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 253, 267, 149, 244, 282, 153, 251, 145, 218,
+	240, 141, 265, 250, 201, 183, 184, 140, 0, 235,
+	163, 175, 160, 216, 0, 0, 159, 285, 0, 275,
+	143, 144, 274, 215, 262, 266, 202, 196, 142, 264,
+	200, 195, 187, 167, 179, 228, 194, 229, 180, 206,
+	205, 207, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 0, 252, 0, 0, 188, 0, 0, 0,
+	0, 0, 238, 221, 0, 0, 226, 236, 192, 263,
+	230, 268, 254, 276, 0, 231, 135, 255, 162, 203,
+	146, 147, 158, 164, 166, 168, 169, 212, 213, 224,
+	243, 256, 257, 258, 161, 154, 237, 155, 177, 156,
+	136, 245, 157, 137, 225, 261, 0, 174, 233, 199,
+	138, 198, 227, 260, 259, 286, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 272, 0, 217,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 241, 0, 0, 0, 0, 0, 182,
+	223, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 270, 284, 798, 0,
+	0, 0, 283, 0, 0, 0, 0, 0, 0, 208,
+	209, 210, 211, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 0, 178, 151,
+	222, 173, 280, 185, 281, 214, 181, 246, 186, 193,
+	234, 279, 220, 239, 150, 269, 247, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 0, 190, 278, 232, 170, 93,
+	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 125, 126, 127, 128, 0, 0, 0, 0, 0,
+	287, 288, 289, 219, 0, 132, 131, 133, 130, 0,
+	134, 271, 87, 165, 0, 0, 0, 189, 0, 191,
+	0, 0, 248, 204, 0, 0, 0, 0, 0, 0,
+	176, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 90, 0, 0, 0, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 253,
+	267, 149, 244, 282, 153, 251, 145, 218, 240, 141,
+	265, 250, 201, 183, 184, 140, 0, 235, 163, 175,
+	160, 216, 0, 0, 159, 285, 0, 275, 143, 144,
+	274, 215, 262, 266, 202, 196, 142, 264, 200, 195,
+	187, 167, 179, 228, 194, 229, 180, 206, 205, 207,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 252, 0, 0, 188, 0, 0, 0, 0, 0,
+	238, 221, 0, 0, 226, 236, 192, 263, 230, 268,
+	254, 276, 0, 231, 135, 255, 162, 203, 146, 147,
+	158, 164, 166, 168, 169, 212, 213, 224, 243, 256,
+	257, 258, 161, 154, 237, 155, 177, 156, 136, 245,
+	157, 137, 225, 261, 0, 174, 233, 199, 138, 198,
+	227, 260, 259, 286, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 272, 0, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 182, 223, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 249, 270, 284, 273, 0, 0, 0,
+	283, 0, 0, 0, 0, 0, 0, 208, 209, 210,
+	211, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 0, 178, 151, 222, 173,
+	280, 185, 281, 214, 181, 246, 186, 193, 234, 279,
+	220, 239, 150, 269, 247, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 0, 190, 278, 232, 170, 93, 94, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
+	126, 127, 128, 0, 0, 0, 0, 219, 287, 288,
+	289, 0, 0, 132, 131, 133, 130, 165, 134, 271,
+	0, 189, 0, 191, 0, 0, 248, 204, 0, 0,
+	0, 0, 0, 0, 176, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 253, 267, 149, 244, 282, 153, 251,
+	145, 218, 240, 141, 265, 250, 201, 183, 184, 140,
+	0, 235, 163, 175, 160, 216, 0, 0, 159, 285,
+	0, 275, 143, 144, 274, 215, 262, 266, 202, 196,
+	142, 264, 200, 195, 187, 167, 179, 228, 194, 229,
+	180, 206, 205, 207, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 277, 0,
+	0, 0, 0, 0, 0, 252, 0, 0, 188, 0,
+	0, 0, 0, 0, 238, 221, 0, 0, 226, 236,
+	192, 263, 230, 268, 254, 276, 0, 231, 135, 255,
+	162, 203, 146, 147, 158, 164, 166, 168, 169, 212,
+	213, 224, 243, 256, 257, 258, 161, 154, 237, 155,
+	177, 156, 136, 245, 157, 137, 225, 261, 0, 174,
+	233, 199, 138, 198, 227, 260, 259, 286, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 272,
+	0, 217, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 182, 223, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 249, 270, 284,
+	273, 0, 0, 0, 283, 0, 0, 0, 0, 0,
+	0, 208, 209, 210, 211, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 171, 0,
+	178, 151, 222, 173, 280, 185, 281, 214, 181, 246,
+	186, 193, 234, 279, 220, 239, 150, 269, 247, 197,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 0, 190, 278, 232,
+	170, 93, 94, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 125, 126, 127, 128, 0, 0, 0,
+	0, 219, 287, 288, 289, 0, 0, 132, 131, 133,
+	130, 165, 134, 271, 0, 189, 0, 191, 0, 0,
+	248, 204, 0, 0, 0, 0, 0, 0, 176, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 465, 466, 467, 462, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 253, 267, 149,
+	244, 282, 153, 251, 145, 218, 240, 141, 265, 250,
+	201, 183, 184, 140, 0, 235, 163, 175, 160, 216,
+	0, 0, 159, 285, 0, 275, 143, 144, 274, 215,
+	262, 266, 202, 196, 142, 264, 200, 195, 187, 167,
+	179, 228, 194, 229, 180, 206, 205, 207, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 277, 0, 0, 0, 0, 0, 0, 252,
+	0, 0, 188, 0, 0, 0, 0, 0, 238, 221,
+	0, 0, 226, 236, 192, 263, 230, 268, 254, 276,
+	0, 231, 135, 255, 162, 203, 146, 147, 158, 164,
+	166, 168, 169, 212, 213, 224, 243, 256, 257, 258,
+	161, 154, 237, 155, 177, 156, 136, 245, 157, 137,
+	225, 261, 0, 174, 233, 199, 138, 198, 227, 260,
+	259, 286, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 272, 0, 217, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 0, 0, 0, 182, 223, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 249, 270, 284, 273, 0, 0, 0, 283, 0,
+	0, 0, 0, 0, 0, 208, 209, 210, 211, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 178, 151, 222, 173, 280, 185,
+	281, 214, 181, 246, 186, 193, 234, 279, 220, 239,
+	150, 269, 247, 197, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 219, 0, 0, 0, 129,
+	0, 190, 278, 232, 170, 165, 0, 0, 0, 189,
+	0, 191, 0, 0, 248, 204, 0, 0, 0, 0,
+	0, 0, 176, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 465, 466, 467, 462, 0,
+	0, 0, 148, 0, 0, 0, 287, 288, 289, 0,
+	0, 132, 131, 133, 130, 736, 134, 271, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 253, 267, 149, 244, 282, 153, 251, 145, 218,
+	240, 141, 265, 250, 201, 183, 184, 140, 0, 235,
+	163, 175, 160, 216, 0, 0, 159, 285, 0, 275,
+	143, 144, 274, 215, 262, 266, 202, 196, 142, 264,
+	200, 195, 187, 167, 179, 228, 194, 229, 180, 206,
+	205, 207, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 277, 0, 0, 0,
+	0, 0, 0, 252, 0, 0, 188, 0, 0, 0,
+	0, 0, 238, 221, 0, 0, 226, 236, 192, 263,
+	230, 268, 254, 276, 0, 231, 135, 255, 162, 203,
+	146, 147, 158, 164, 166, 168, 169, 212, 213, 224,
+	243, 256, 257, 258, 161, 154, 237, 155, 177, 156,
+	136, 245, 157, 137, 225, 261, 0, 174, 233, 199,
+	138, 198, 227, 260, 259, 286, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 272, 0, 217,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 241, 0, 0, 0, 0, 0, 182,
+	223, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 249, 270, 284, 273, 0,
+	0, 0, 283, 0, 0, 0, 0, 0, 0, 208,
+	209, 210, 211, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 0, 178, 151,
+	222, 173, 280, 185, 281, 214, 181, 246, 186, 193,
+	234, 279, 220, 239, 150, 269, 247, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 219,
+	0, 0, 0, 129, 1312, 190, 278, 232, 170, 165,
+	0, 0, 0, 189, 0, 191, 0, 0, 248, 204,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 465,
+	466, 467, 462, 0, 0, 0, 148, 0, 0, 0,
+	287, 288, 289, 0, 0, 132, 131, 133, 130, 0,
+	134, 271, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 139, 253, 267, 149, 244, 282,
+	153, 251, 145, 218, 240, 141, 265, 250, 201, 183,
+	184, 140, 0, 235, 163, 175, 160, 216, 0, 0,
+	159, 285, 0, 275, 143, 144, 274, 215, 262, 266,
+	202, 196, 142, 264, 200, 195, 187, 167, 179, 228,
+	194, 229, 180, 206, 205, 207, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	277, 0, 0, 0, 0, 0, 0, 252, 0, 0,
+	188, 0, 0, 0, 0, 0, 238, 221, 0, 0,
+	226, 236, 192, 263, 230, 268, 254, 276, 0, 231,
+	135, 255, 162, 203, 146, 147, 158, 164, 166, 168,
+	169, 212, 213, 224, 243, 256, 257, 258, 161, 154,
+	237, 155, 177, 156, 136, 245, 157, 137, 225, 261,
+	0, 174, 233, 199, 138, 198, 227, 260, 259, 286,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 272, 0, 217, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 241, 0, 0,
+	0, 0, 0, 182, 223, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 249,
+	270, 284, 273, 0, 0, 0, 283, 0, 0, 0,
+	0, 0, 0, 208, 209, 210, 211, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 0, 178, 151, 222, 173, 280, 185, 281, 214,
+	181, 246, 186, 193, 234, 279, 220, 239, 150, 269,
+	247, 197, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 219, 0, 0, 0, 129, 459, 190,
+	278, 232, 170, 165, 0, 0, 0, 189, 0, 191,
+	0, 0, 248, 204, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 465, 466, 467, 462, 0, 0, 0,
+	148, 0, 0, 0, 287, 288, 289, 0, 0, 132,
+	131, 133, 130, 0, 134, 271, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 253,
+	267, 149, 244, 282, 153, 251, 145, 218, 240, 141,
+	265, 250, 201, 183, 184, 140, 0, 235, 163, 175,
+	160, 216, 0, 0, 159, 285, 0, 275, 143, 144,
+	274, 215, 262, 266, 202, 196, 142, 264, 200, 195,
+	187, 167, 179, 228, 194, 229, 180, 206, 205, 207,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 0, 0, 0, 0, 0,
+	0, 252, 0, 0, 188, 0, 0, 0, 0, 0,
+	238, 221, 0, 0, 226, 236, 192, 263, 230, 268,
+	254, 276, 0, 231, 135, 255, 162, 203, 146, 147,
+	158, 164, 166, 168, 169, 212, 213, 224, 243, 256,
+	257, 258, 161, 154, 237, 155, 177, 156, 136, 245,
+	157, 137, 225, 261, 0, 174, 233, 199, 138, 198,
+	227, 260, 259, 286, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 272, 0, 217, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 182, 223, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 249, 270, 284, 273, 0, 0, 0,
+	283, 0, 0, 0, 0, 0, 0, 208, 209, 210,
+	211, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 0, 178, 151, 222, 173,
+	280, 185, 281, 214, 181, 246, 186, 193, 234, 279,
+	220, 239, 150, 269, 247, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 219, 0, 0,
+	0, 129, 0, 190, 278, 232, 170, 165, 0, 0,
+	0, 189, 0, 191, 0, 0, 248, 204, 0, 0,
+	0, 0, 0, 0, 176, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 465, 466, 467,
+	0, 0, 0, 0, 148, 0, 0, 0, 287, 288,
+	289, 0, 0, 132, 131, 133, 130, 0, 134, 271,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 253, 267, 149, 244, 282, 153, 251,
+	145, 218, 240, 141, 265, 250, 201, 183, 184, 140,
+	0, 235, 163, 175, 160, 216, 0, 0, 159, 285,
+	0, 275, 143, 144, 274, 215, 262, 266, 202, 196,
+	142, 264, 200, 195, 187, 167, 179, 228, 194, 229,
+	180, 206, 205, 207, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 277, 0,
+	0, 0, 0, 0, 0, 252, 0, 0, 188, 0,
+	0, 0, 0, 0, 238, 221, 0, 0, 226, 236,
+	192, 263, 230, 268, 254, 276, 0, 231, 135, 255,
+	162, 203, 146, 147, 158, 164, 166, 168, 169, 212,
+	213, 224, 243, 256, 257, 258, 161, 154, 237, 155,
+	177, 156, 136, 245, 157, 137, 225, 261, 0, 174,
+	233, 199, 138, 198, 227, 260, 259, 286, 0, 0,
+	0, 326, 0, 325, 329, 321, 0, 172, 0, 272,
+	0, 217, 0, 0, 0, 317, 84, 1793, 26, 44,
+	27, 0, 0, 0, 0, 241, 336, 0, 0, 0,
+	0, 182, 223, 0, 242, 0, 72, 0, 0, 0,
+	79, 0, 0, 1154, 0, 0, 0, 249, 270, 284,
+	273, 0, 0, 0, 283, 0, 0, 0, 0, 0,
+	45, 208, 209, 210, 211, 81, 152, 0, 0, 0,
+	1854, 0, 0, 0, 0, 0, 0, 0, 171, 1775,
+	178, 151, 222, 173, 280, 185, 281, 214, 181, 246,
+	186, 193, 234, 279, 220, 239, 150, 269, 247, 197,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 0, 190, 278, 232,
+	170, 0, 0, 0, 0, 0, 1793, 0, 0, 0,
+	0, 0, 0, 75, 76, 0, 77, 78, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1154, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 287, 288, 289, 0, 0, 132, 131, 133,
+	130, 0, 134, 271, 0, 319, 318, 322, 0, 0,
+	0, 0, 0, 324, 0, 0, 0, 0, 1775, 0,
+	64, 74, 82, 57, 43, 328, 0, 0, 0, 0,
+	0, 0, 1779, 0, 0, 0, 0, 0, 0, 744,
+	73, 71, 70, 1783, 0, 0, 58, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1772, 0, 0, 0, 1774, 1776, 1778,
+	0, 1780, 1781, 1782, 1784, 1785, 1786, 1788, 1789, 1790,
+	1791, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1794, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 323, 327, 745, 0, 331,
+	746, 0, 0, 333, 334, 335, 53, 0, 337, 338,
+	0, 0, 54, 1792, 0, 0, 0, 0, 0, 0,
+	0, 1779, 0, 0, 0, 0, 0, 0, 0, 0,
+	1771, 0, 1783, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1787, 0, 0, 56, 55,
+	0, 1777, 1772, 0, 0, 0, 1774, 1776, 1778, 0,
+	1780, 1781, 1782, 1784, 1785, 1786, 1788, 1789, 1790, 1791,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1794, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1792, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1771,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1787, 0, 0, 0, 0, 0,
+	1777,
 }
 
 var yyPact = [...]int{
-	15911, -1000, -297, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	17428, -1000, -296, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 14933, 1827, -1000, 7613,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 200, 13313, 15337, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 6780, 6351, 102, -166, 198, 197, -1000,
+	1733, -1000, -1000, -1000, 106, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 771, 71, 290, 295, 289, 289, 8021,
+	1772, 1444, 2, -1000, 1731, 17428, 137, 15337, -1000, 356,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 14040, 1513, -1000, 6869, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 155, 12452,
-	14437, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 6057, 5642,
-	76, -1000, 1443, -1000, -1000, -1000, 70, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 372, -88, 232, 236, 263,
-	263, 7266, 1501, 1236, -32, -1000, 1452, 15911, 106, 14437,
-	-1000, 279, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2610,67 +2824,72 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	13313, 15337, -88, 465, -1000, 1515, 354, -1000, -1000, -1000,
+	-1000, 15337, 1642, -1000, -1000, -1000, 1732, 16803, 1444, -1000,
+	1352, 1390, -1000, -1000, 1592, -1000, 87, -7, -42, 61,
+	-1000, -1000, 122, -1000, -1000, -1000, -1000, -1000, 31, -1000,
+	-17, -1000, -23, -1000, -1000, -1000, -127, -1000, -1000, -1000,
+	-1000, -1000, 1307, 309, 1619, -175, 836, -1000, -1000, 15337,
+	15337, -1000, 1722, 1739, 1444, -265, 1777, 1747, 1745, 1743,
+	163, 163, 166, 163, 196, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1735, 472, 121, -1000, -1000, -135, 1628, 400, 1628,
+	4, -1000, -1000, -1000, -1000, -1000, -1000, 15337, 164, -1000,
+	-179, -1000, 280, -1000, 272, -1000, 9246, 116, 1396, 562,
+	-1000, 525, 15337, 15337, 15337, 525, 637, 584, 353, -1000,
+	-1000, -1000, 1692, 1701, 1739, 1444, -1000, 1243, 1502, 164,
+	164, 164, 164, 164, 4680, -1000, -1000, -1000, -1000, -1000,
+	1557, 1590, -1000, 15337, 1491, -1000, 348, 820, 1016, -1000,
+	15337, 1585, 15337, 13313, 13313, 13313, 13313, 13313, -1000, 1664,
+	1655, -1000, 1653, 1651, 1634, 1660, 17157, -1000, -1000, 15741,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1235, 1772,
+	92, 17413, 12505, 14121, 15337, 12505, -1000, -1000, -1000, -1000,
+	-1000, -128, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 92, 12505, 12505, -98, -1000, -1000, 183, 1391,
+	-1000, -1000, 1722, 5094, -1000, -1000, 1015, 5094, -1000, -1000,
+	-1000, -1000, -1000, -1000, 12505, 524, 14121, 902, 15337, 163,
+	12505, 15337, -1000, -1000, 400, 400, -1000, 472, 472, -1000,
+	-1000, -134, 1805, 5508, -133, 15337, 163, 195, 14525, 1721,
+	-153, 286, 274, 282, -1000, -1000, 1835, -1000, -1000, 1375,
+	10077, 8829, 184, 12505, 3024, -1000, -1000, 525, 525, 525,
+	3024, 316, -1000, -1000, -1000, -1000, -1000, -1000, 15337, -1000,
+	-1000, 1722, -1000, -1000, -1000, -1000, -1000, 12505, 14121, 15337,
+	15337, 17157, 1279, -1000, -1000, 8425, 345, 5094, 728, 1584,
+	-1000, 1583, 1579, 1578, 1576, 1575, 1574, 1572, 1571, 1525,
+	1568, 1567, 1565, 1563, 1562, -1000, 1560, 1525, 1556, -1000,
+	-1000, -1000, 1555, -1000, -1000, 1554, 1525, 1540, -1000, -1000,
+	1538, 1537, -1000, -1000, 1042, -1000, 409, -1000, -1000, 4266,
+	5508, 5508, 5508, 5508, -1000, -1000, 1536, 5094, 1534, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 12452, 14437, -121,
-	354, -1000, 1098, 276, -1000, -1000, -1000, -1000, 14437, 1420,
-	-1000, -1000, -1000, 1446, 14835, 1236, -1000, 1140, 1175, -1000,
-	-1000, 1334, -1000, 73, -51, -73, 36, -1000, -1000, 92,
-	-1000, -1000, -1000, -1000, -1000, -7, -1000, -58, -1000, -66,
-	-1000, -1000, -1000, -158, -1000, -1000, -1000, -1000, -1000, 1115,
-	295, 1359, -206, -1000, 1424, 1462, 1236, -283, 1491, 1461,
-	120, 120, 136, 120, 146, -1000, -1000, -1000, -1000, -1000,
-	-1000, 420, 91, -1000, -1000, -178, -172, 305, -172, -36,
-	-1000, -1000, -1000, -1000, -1000, -1000, 125, -1000, -210, -1000,
-	223, -1000, 218, -1000, 8464, 82, 1189, 447, -1000, 344,
-	14437, 14437, 14437, 344, 612, 566, 272, -1000, -1000, -1000,
-	1411, 1412, 1462, 1236, -1000, 1099, 1031, 125, 125, 125,
-	125, 125, 4006, -1000, -1000, -1000, -1000, -1000, 1072, 1331,
-	-1000, 14437, 1348, -1000, 271, 721, 898, -1000, 14437, 1330,
-	14437, 12452, 12452, 12452, 12452, -1000, 1386, 1385, -1000, 1373,
-	1371, 1389, 15537, -1000, -1000, -1000, 15186, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1097, 1501, 52, 731, 11658, 13246,
-	14437, 11658, -1000, -1000, -1000, -1000, -1000, -162, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 52, 11658,
-	11658, -130, -1000, -1000, 1424, 4413, -1000, -1000, 889, 4413,
-	-1000, -1000, 11658, 373, 13246, 749, 14437, 120, 14437, -1000,
-	-1000, 305, 305, -1000, 420, 420, -1000, -1000, -165, 1502,
-	4820, -171, 14437, 120, 13643, 1433, -196, 230, 220, 226,
-	-1000, -1000, -208, -1000, -1000, 1178, 9276, 8061, 149, 11658,
-	2370, -1000, -1000, 344, 344, 344, 2370, 261, -1000, -1000,
-	-1000, -1000, -1000, -1000, 14437, -1000, -1000, 1424, -1000, -1000,
-	-1000, -1000, -1000, 11658, 13246, 14437, 14437, 15537, 1161, -1000,
-	-1000, 7664, 258, 4413, 781, 1329, -1000, 1328, 1326, 1325,
-	1324, 1323, 1317, 1316, 1286, 1313, 1310, -1000, -1000, -1000,
-	1298, 1297, 1286, 1296, 1295, 1293, -1000, -1000, 785, -1000,
-	-1000, -1000, -1000, 3599, 4820, 4820, 4820, 4820, -1000, -1000,
-	1292, 1289, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 5227, -1000, 1288, 1287, 1286,
-	1271, 881, 878, 876, 1270, 1269, 1268, 4820, 1266, -1000,
+	-1000, -1000, -1000, 5922, -1000, 1533, 1531, 1525, 1523, 1014,
+	1013, 1001, 1522, 1521, 1520, 5508, 1519, 1518, 1517, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -281, -1000, 8873, 14437, 14437, -1000,
-	1474, 4413, 1967, -1000, 1082, 255, 14437, 1127, -1000, 349,
-	1347, 1358, 1347, -1000, -1000, -1000, -1000, 1372, -1000, 1370,
-	-1000, -1000, -1000, -1000, -1000, 427, -1000, -1000, -1000, -1000,
-	-1000, -58, -66, 1168, -1000, -90, 69, -1000, -1000, 1165,
-	-1000, -1000, -1000, 427, 1168, 132, 875, -1000, 645, 254,
-	-180, 1185, -1000, 710, 159, 1428, 1178, 1350, 1414, 14437,
-	1502, 1502, 1502, 305, 15537, 420, 14437, 420, -1000, -1000,
-	420, -1000, 253, 14437, 159, 1264, -1000, -1000, -1000, 228,
-	217, 216, 13246, 131, -1000, -1000, 1178, -1000, -1000, -1000,
-	1260, 328, -1000, -1000, 4820, -1000, 623, -1000, 2370, 2370,
-	2370, -1000, 10467, -1000, -1000, 1168, 1178, 1356, 1182, -1000,
-	-1000, -1000, -1000, 1502, 4006, -1000, 12452, -1000, 4413, 4413,
-	4413, -1000, 14437, 12849, -1000, 460, 4820, -1000, -1000, -1000,
-	-1000, -1000, -1000, 4413, 1455, 1455, 1455, 4413, 379, 4413,
-	4413, -1000, 691, 1455, 1455, 1455, 1455, -1000, 1455, 1455,
-	1455, 4820, 4820, 4820, 4820, 4820, 4820, 4820, 4820, 4820,
-	4820, 4820, 4820, 1241, 582, 4820, 4820, 4820, 1031, 1003,
-	1181, -1000, -1000, -1000, -1000, -1000, 4413, 178, 4413, -1000,
-	1081, -1000, -1000, 4413, -1000, -1000, -1000, 4413, 4820, 4413,
-	-1000, 1455, 1153, -1000, 1257, -1000, 1163, 1401, -1000, 252,
-	1180, -1000, 325, 1159, -1000, 1462, 623, -1000, 251, -1000,
+	-1000, -1000, -1000, -1000, -262, -1000, 9660, 15337, 15337, -1000,
+	1744, 5094, 2186, -1000, 1503, 334, 15337, 1305, -1000, 464,
+	1596, 1617, 1596, 1596, -1000, -1000, -1000, -1000, 1652, -1000,
+	1639, -1000, 1635, -1000, -1000, -1000, -8, -1000, -1000, 470,
+	-1000, -1000, -1000, -1000, -1000, -17, -23, 1314, -1000, -55,
+	86, -1000, -1000, 1383, -1000, -1000, -1000, 470, 1314, 181,
+	999, 15337, 15337, -1000, 899, 330, -146, 1393, -1000, 699,
+	192, 1720, 1375, 1504, 1711, 15337, -1000, 1805, 1805, 1805,
+	400, 17157, 472, 15337, 472, -1000, -1000, 472, -1000, 327,
+	15337, 1392, -1000, 157, 157, 431, 157, 192, 1516, -1000,
+	-1000, -1000, 284, 271, 266, 14121, 179, -1000, -1000, 1375,
+	-1000, -1000, -1000, 1510, 458, -1000, -1000, 5508, -1000, 705,
+	-1000, 3024, 3024, 3024, -1000, 11293, -1000, -1000, 1314, 1375,
+	1613, 1391, -1000, -1000, -1000, 1805, 4680, -1000, 13313, -1000,
+	5094, 5094, 5094, -1000, 15337, 13717, -1000, 587, 5508, -1000,
+	-1000, -1000, -1000, -1000, -1000, 5094, 1741, 1741, 1741, 5094,
+	578, 5094, 5094, 1218, -1000, 864, 5094, 5094, 180, 1741,
+	1741, 1741, -1000, 5508, 1741, 1741, -1000, 2610, 1741, 1741,
+	5508, 5508, 5508, 5508, 5508, 5508, 5508, 5508, 5508, 5508,
+	5508, 5508, 1496, 659, 5508, 5508, 5508, 986, 975, 1502,
+	1408, 1386, -1000, -1000, -1000, -1000, -1000, 496, 705, 5094,
+	383, 5094, -1000, 1213, -1000, -1000, 5094, -1000, -1000, -1000,
+	5094, 5508, 5094, -1000, 5094, 1741, 1741, 1295, -1000, 1505,
+	-1000, 1359, 1680, -1000, 326, 1385, -1000, 448, 1338, -1000,
+	1739, 705, -1000, 325, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2685,141 +2904,156 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -123, -1000,
-	14437, 1157, -1000, 1474, 14437, 4413, -1000, -1000, 4413, 1243,
-	-1000, 4413, -1000, -1000, -1000, 1504, 250, 248, 11658, -1000,
-	121, 11658, -1000, -1000, 14437, 130, 11658, -41, 4413, 4413,
-	14437, -151, -140, 4413, -1000, -1000, -1000, -235, -1000, -106,
-	-1000, 1355, 20, -1000, 1414, -1000, 330, -1000, 1242, -1000,
-	-1000, -1000, 1502, -1000, 305, -1000, 305, 420, 14437, -1000,
-	-1000, -235, 1073, -1000, -1000, -1000, 203, 1178, 11658, 797,
-	149, -1000, -1000, -1000, -1000, -1000, 14437, 14437, 1499, -1000,
-	1176, 1314, -1000, 458, 376, -1000, 247, -1000, -1000, 489,
-	-1000, 1068, 1133, 623, 4413, -1000, -1000, 4413, 4413, 500,
-	4413, 1064, 1155, 1151, -1000, 1044, -1000, 4413, 4413, 4413,
-	4413, 4413, 4413, 4413, 505, 1503, -1000, 586, 586, 273,
-	273, 273, 273, 273, 525, 525, -1000, -1000, -1000, 3599,
-	1241, 4820, 4820, 4820, 112, 1198, 1760, -1000, 4413, 801,
-	-1000, -1000, 1030, -1000, 960, 1024, 2249, 1007, 4413, -281,
-	3184, 1171, 14437, -281, 14437, 14437, 3184, -1000, 14437, -1000,
-	1967, 717, -1000, -1000, 14437, 1462, -1000, 623, 623, 14437,
-	623, 11658, 294, 369, -1000, 10070, 11658, -1000, -1000, 11658,
-	83, 1422, -1000, -1000, 623, 623, 246, -285, -134, 1489,
-	1486, -1000, -1000, -122, -1000, -1000, -1000, 151, -1000, 872,
-	871, 864, 846, 14437, -1000, -1000, -1000, -1000, -1000, 321,
-	321, 321, 1411, 6454, -1000, 1502, 1502, 305, -1000, -64,
-	-91, -1000, 1168, 1004, -1000, -1000, -1000, -1000, 1497, 1485,
-	12452, 12055, -1000, -1000, 4413, 997, 992, 977, 101, 1147,
-	-1000, -1000, -1000, -1000, 922, 884, 877, 874, 867, 847,
-	842, 1143, -1000, 112, 1198, 1711, -1000, 4820, 4820, 822,
-	101, 302, -1000, -1000, 302, -1000, 4820, -1000, 738, -1000,
-	987, 1174, -1000, -281, -1000, -1000, 1153, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1138, 1168,
-	-1000, -1000, -1000, -1000, 11658, 1437, 159, -1000, -56, 145,
-	14437, -288, 839, -1000, 1482, 825, 617, -122, -1000, 716,
-	711, 705, 703, -98, -1000, -1000, -1000, -1000, -1000, 1240,
-	302, -1000, 377, 809, 984, 1167, -1000, -1000, -1000, 835,
-	194, -1000, 14437, 474, 243, 120, 243, 455, 1239, -1000,
-	-1000, -1000, -1000, 1502, -1000, -64, -1000, 213, 210, -28,
-	1481, -1000, -1000, 4413, 4413, 1314, -1000, -1000, 623, -1000,
-	-1000, -1000, 981, -1000, 1229, 1234, -1000, 1229, 1229, 1229,
-	206, 206, 1235, 1238, 1235, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 4820, -1000, -1000, -1000, 968,
-	932, 930, 1569, -1000, -1000, 3184, 1153, -1000, -1000, 11658,
-	11658, -240, -60, 14437, -291, 699, -1000, 807, -138, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 11261, -1000, -1000,
-	-1000, -1000, -1000, -1000, 15845, 6454, 988, -81, -1000, -1000,
-	-1000, 1229, -1000, 1234, 1229, 1229, 1229, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1233, 1232, -1000, 1229,
-	1229, 1229, 1229, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -90, -1000,
+	15337, 1316, -1000, 1744, 15337, 5094, -1000, -1000, 5094, 1499,
+	-1000, 5094, -1000, -1000, -1000, -1000, -1000, 16449, 1341, 1341,
+	1825, 318, 314, 12505, -1000, 153, 12505, -1000, -1000, 15337,
+	177, 12505, -11, -1000, -1000, 5094, 5094, 15337, -111, -104,
+	5094, -1000, -1000, -1000, -207, -1000, -73, -1000, 1612, 30,
+	-1000, 1711, -1000, 519, -1000, 1497, -1000, -1000, -1000, 1805,
+	-1000, 400, -1000, 400, 472, 15337, -1000, -1000, 195, 15337,
+	-1000, 15337, 15337, 15337, -1000, -1000, 15337, -207, 1207, -1000,
+	-1000, -1000, 259, 1375, 12505, 946, 184, -1000, -1000, -1000,
+	-1000, -1000, 15337, 1788, -1000, 1373, 1684, -1000, 569, 543,
+	-1000, 313, -1000, -1000, 607, -1000, 1199, 1282, 705, 5094,
+	-1000, -1000, 5094, 5094, 696, 5094, 1182, 1285, 1270, -1000,
+	-1000, 1179, -1000, 1163, 1130, 1809, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 5094, 5094, 5094, 2468, 5094,
+	5094, 779, 5094, -1000, -1000, -1000, 5094, 5094, 1313, 658,
+	-1000, 598, 598, 322, 322, 322, 322, 322, 761, 761,
+	-1000, -1000, -1000, 4266, 1496, 5508, 5508, 5508, 143, 2592,
+	2482, -1000, -1000, -1000, 5094, 517, -1000, 5094, 873, -1000,
+	1168, -1000, 1078, 1150, 1972, 1133, 786, 5094, 5094, -262,
+	3852, 1400, 15337, -262, 15337, 15337, 3852, -1000, 15337, -1000,
+	2186, 819, -1000, -1000, 15337, 1739, -1000, 705, 705, 15337,
+	705, -1000, 16095, -1000, -1000, 12505, 369, 449, -1000, 10885,
+	12505, -1000, -1000, 12505, 112, 1719, -1000, -1000, 705, 705,
+	308, -269, -100, 1776, 1775, -1000, -1000, -89, -1000, -1000,
+	-1000, 172, -1000, 974, 973, 971, 969, 15337, -1000, -1000,
+	-1000, -1000, -1000, 424, 424, 424, 1692, 7184, -1000, 1805,
+	1805, 400, -1000, -1000, -1000, 906, -1000, 171, -1000, 386,
+	-20, -56, -1000, 1314, 1119, -1000, -1000, -1000, 1782, 1774,
+	13313, 12909, -1000, -277, 5094, 1394, 1388, 1379, 119, 1264,
+	-277, -1000, -1000, -1000, 5094, 5094, 5094, 1246, 1319, 1288,
+	5094, 1250, 1244, -1000, 5094, 939, 1232, 1205, 1259, -1000,
+	143, 2592, 1816, -1000, 5508, 5508, 1170, 485, -1000, 5094,
+	560, 119, 677, -1000, -1000, 677, -1000, 5508, -1000, 5094,
+	5094, 1166, 1149, -1000, 1117, 1326, -1000, -262, -1000, -1000,
+	1295, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1248, 1314, -1000, -1000, -1000, -1000, 12505, 1727,
+	192, -1000, -15, 194, 15337, -271, 968, -1000, 1773, 956,
+	854, -89, -1000, 816, 815, 813, 812, -62, -1000, -1000,
+	-1000, -1000, -1000, 1494, 677, -1000, 693, 954, 1112, 1298,
+	-1000, -1000, -1000, 492, -1000, 15337, 597, 312, 163, 312,
+	596, 1492, -1000, -1000, -1000, -1000, 1805, 1401, -37, -1000,
+	-1000, -1000, 1463, -1000, 1474, 1463, 1463, 1463, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1489, 1488, -1000,
+	1463, 1463, 1463, 1463, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1483,
+	1487, 1487, 1487, 1483, 15337, 1710, 1705, -1000, -20, -1000,
+	257, 254, 14, 1770, -1000, -1000, 5094, 5094, 1684, -1000,
+	-1000, -1000, 1475, 705, -1000, -1000, -1000, 1109, -1000, -1000,
+	1463, 1474, -1000, 1463, 1463, 1463, 255, 255, -277, -1000,
+	1141, 1137, 1116, -192, -277, -277, 1108, -1000, -277, 1101,
+	5094, -1000, -277, -1000, -1000, 5508, -1000, -1000, -1000, -1000,
+	705, 5094, 1072, 1070, 1066, 1717, 749, 769, -1000, -1000,
+	-1000, 3852, 1295, -1000, -1000, 12505, 12505, -208, -18, 15337,
+	-275, 797, -1000, 953, -103, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 12101, -1000, -1000, -1000, -1000, -1000, -1000,
+	17541, 7184, -1000, -1000, 15337, 15337, -1000, 15337, 15337, 163,
+	5094, -1000, -1000, 1401, -1000, -1000, 606, 5508, -1000, -1000,
+	952, 693, 305, 371, 1473, -1000, 70, 589, 549, -1000,
+	15337, -1000, -51, -1000, -1000, -1000, -1000, 790, -1000, 789,
+	-1000, -1000, -1000, 948, 948, -1000, -1000, -1000, -1000, -1000,
+	774, -1000, 773, -1000, -1000, -1000, -1000, 5508, -1000, -1000,
+	-1000, -1000, 768, -1000, -1000, -1000, 946, 705, 1282, 136,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	14437, 14437, -1000, 14437, 14437, 120, 4413, -1000, -1000, -1000,
-	-1000, 695, -1000, -1000, -1000, 797, 623, 1133, -1000, -1000,
-	-1000, 694, -1000, 692, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 685, -1000, 684, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -171, -1000, 1230, -1000,
-	-1000, 1480, 1113, -1000, 1229, 4413, 105, 15796, -1000, 321,
-	321, 265, 321, 321, 321, 321, 74, 63, 321, 321,
-	321, 321, 321, 321, 321, 321, 321, 321, 321, 321,
-	321, 321, 1227, -1000, -1000, 988, -1000, -1000, 483, 4820,
-	-1000, -1000, 795, 377, 266, 303, 1223, -1000, 31, 424,
-	391, -1000, 14437, -1000, -84, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 794, 794, -1000, -1000, -1000, -1000, 1222, 1228,
-	-9, 1221, -1000, 1220, 1219, 14437, 674, -33, -1000, -1000,
-	921, 917, 1108, 1106, -152, -144, 14437, 617, -1000, 11261,
-	1419, 631, -1000, 1477, 15845, -1000, 661, 657, 321, 321,
-	654, 788, 786, 783, 321, 321, 651, 762, 15186, 639,
-	611, 608, 709, 761, 278, 704, 677, 540, 14437, 1218,
-	744, -1000, -1000, 1198, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 595, 1215, -1000, -1000, 1214,
-	-1000, -1000, 1102, -1000, 1092, 11261, 30, 30, 11261, 11261,
-	11261, 1212, 192, -1000, -1000, -1000, 574, -1000, 563, 128,
-	-143, -144, -1000, 1472, -139, 1471, 1470, 1079, -1000, -1000,
-	79, -1000, -1000, 1419, 18, -1000, -1000, -1000, 302, 302,
-	-1000, -1000, -1000, -1000, 759, 755, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1062, 944, -1000, -1000, -1000, -1000,
+	-1000, 1082, -1000, -1000, 705, -1000, -1000, -1000, -1000, -1000,
+	5094, -1000, 5094, -1000, -1000, -1000, -1000, -1000, -133, -1000,
+	1465, -1000, -1000, 1769, 1242, -1000, 1463, 5094, 135, 17432,
+	-1000, 424, 424, 315, 424, 424, 424, 424, 100, 99,
+	424, 424, 424, 424, 424, 424, 424, 424, 424, 424,
+	424, 424, 424, 424, 1453, -1000, 1447, 1495, 28, 1445,
+	-1000, 1443, 1441, 15337, 1021, -1000, -1000, 2592, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 767,
+	1435, -1000, -1000, 1430, -1000, -1000, 1056, 1054, 1239, -1000,
+	1231, 1293, 1225, 2592, 9, -1000, -1000, 1744, 1768, -1000,
+	-1000, -1000, 947, 931, -118, -110, 15337, 854, -1000, 12101,
+	1716, 886, -1000, 1765, 17541, -1000, 766, 751, 424, 424,
+	745, 928, 916, 915, 424, 424, 733, 914, 16095, 732,
+	725, 683, 822, 912, 324, 818, 798, 665, 15337, 1429,
+	883, 12101, 43, 43, 12101, 12101, 12101, 1427, 246, 1041,
+	5094, -200, 12101, -1000, -1000, -1000, 910, -1000, 682, -1000,
+	678, -1000, -107, 5094, -1000, -1000, 161, -109, -110, -1000,
+	1764, -105, 1763, 1762, 1223, -1000, -1000, 98, -1000, -1000,
+	1716, 63, -1000, -1000, -1000, 677, 677, -1000, -1000, -1000,
+	-1000, 907, 897, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 85, 14437,
-	1063, -1000, 322, 909, 4413, -227, 11261, -1000, 752, -1000,
-	1061, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1059, 1056,
-	1054, 11261, -1000, -1000, -1000, 27, 905, 903, 1207, 555,
-	-134, 1469, -1000, 617, 1468, 617, 617, -1000, 14437, -1000,
-	321, 751, -19, -1000, -1000, -1000, 7, 108, 103, -1000,
-	166, -1000, -1000, -1000, -1000, -1000, -1000, 81, 1040, -1000,
-	744, 736, -1000, 606, 1354, -1000, -71, 1038, -1000, -1000,
-	-1000, -1000, -1000, 1029, -1000, -1000, -1000, 1409, 9673, -154,
-	-1000, 735, -1000, 617, -1000, -1000, -1000, 534, -1000, 749,
-	2, 516, 4820, 1202, 4820, 1196, 11, 1195, -1000, -1000,
-	-1000, -1000, -1000, 192, -1000, -1000, 1352, 1349, 1512, -1000,
-	-1000, -1000, -1000, 79, 79, 79, 79, -62, -1000, 14437,
-	-1000, 1018, -1000, -1000, -1000, 245, -1000, -1000, -1000, -1000,
-	-1000, 1194, 1467, -1000, 1473, 14437, 1226, 14437, 1193, 320,
-	4820, -1000, -1000, 1514, -1000, 1516, 257, 257, -1000, 879,
-	-1000, 319, -1000, 10864, 14437, -1000, 104, 9, -1000, 1012,
-	-1000, 994, 14437, 508, 544, -1000, -1000, -1000, 531, 42,
-	-1000, 14437, 2777, -1000, 244, 974, -1000, 887, -3, -1000,
-	-1000, 958, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 623,
-	14437, -1000, 104, 1398, -1000, 502, -1000, -1000, -1000, 1340,
-	100, -1000, -1000, 1340, -1, -1000, 96, -1000, -1000, 950,
-	-1000, 880, 1192, -1000, -1, 15845, 4413, -1000, 15845, 948,
-	-1000,
+	-1000, -1000, -1000, -1000, -1000, 104, 15337, 1217, -1000, 440,
+	1211, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1203, 1198,
+	1196, 12101, -1000, -1000, -1000, 66, -1000, 867, 1610, -1000,
+	293, 1147, -1000, 951, 877, 868, 94, -1000, -1000, 1282,
+	1425, 638, -100, 1756, -1000, 854, 1755, 854, 854, -1000,
+	15337, -1000, 424, 896, 25, -1000, -1000, -1000, 32, 162,
+	160, -1000, 226, -1000, -1000, -1000, -1000, -1000, -1000, 110,
+	1144, -1000, 883, 874, -1000, -1000, -1000, -1000, 1136, -1000,
+	246, -1000, -1000, 1606, 1600, 1824, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 126, -281, -263, -287, 1690, 10481,
+	-120, -1000, 871, -1000, 854, -1000, -1000, -1000, 621, -1000,
+	902, 55, 620, 5508, 1422, 5508, 1421, 65, 1415, -1000,
+	-1000, -1000, -1000, -1000, 98, 98, 98, 98, -27, -1000,
+	-1000, 1826, -1000, 1778, 299, 299, 541, -1000, -1000, -1000,
+	-1000, -1000, -1000, 15337, -1000, 1128, -1000, -1000, -1000, 307,
+	-1000, -1000, -1000, -1000, -1000, 1406, 1753, -1000, 1493, 15337,
+	982, 15337, 1399, 418, 5508, -1000, -1000, -1000, -1000, 710,
+	75, -1000, 126, 1276, -1000, 391, -1000, 11697, 15337, -1000,
+	134, 58, -1000, 1122, -1000, 1103, 15337, 616, 888, -1000,
+	-1000, -1000, -1000, 15337, 3438, -1000, 302, 1087, -1000, 870,
+	34, -1000, -1000, 1050, -1000, -1000, -1000, -1000, 705, 15337,
+	-1000, 134, 1676, -1000, 615, -1000, -1000, -1000, 1718, 131,
+	-1000, -1000, 1718, 42, -1000, 129, -1000, -1000, 1048, -1000,
+	736, 1389, -1000, 42, 17541, 5094, -1000, 17541, 1023, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 543, 1892, 1891, 766, 731, 1890, 1889, 1887, 1884,
-	1883, 1882, 1881, 1880, 1879, 1875, 1874, 1859, 1858, 1855,
-	1853, 1852, 1851, 1850, 1849, 1844, 1842, 1841, 1839, 1838,
-	1837, 1836, 586, 1835, 1834, 1832, 1831, 1830, 1828, 114,
-	1827, 1826, 1808, 1806, 1805, 1804, 1803, 1802, 1800, 123,
-	85, 91, 1799, 194, 135, 1798, 101, 1797, 75, 140,
-	1796, 1795, 31, 96, 1794, 104, 97, 77, 175, 89,
-	81, 1793, 1792, 1790, 112, 1784, 1783, 1780, 1779, 54,
-	1777, 64, 35, 28, 1776, 73, 1775, 1774, 1773, 1771,
-	1770, 66, 1769, 62, 44, 1768, 1766, 1765, 1764, 1763,
-	34, 1762, 45, 1761, 1760, 1759, 1758, 1756, 1755, 1752,
-	16, 18, 21, 1751, 1749, 17, 2, 1748, 1747, 76,
-	1746, 1744, 1743, 554, 1742, 1741, 1740, 126, 1739, 108,
-	1738, 1737, 1736, 1735, 9, 1733, 42, 1732, 1731, 1730,
-	46, 1729, 1728, 84, 36, 142, 83, 1727, 1726, 1725,
-	117, 20, 57, 0, 116, 38, 1724, 113, 105, 1723,
-	79, 151, 109, 48, 1722, 43, 63, 1717, 1716, 1700,
-	60, 11, 1699, 103, 12, 74, 1694, 88, 100, 1,
-	90, 1688, 119, 1684, 1683, 95, 1682, 1668, 52, 94,
-	1666, 1665, 1663, 29, 1661, 37, 25, 1660, 121, 125,
-	1657, 1656, 1654, 99, 78, 70, 1653, 1652, 67, 1650,
-	92, 69, 98, 1649, 597, 1648, 93, 58, 19, 1647,
-	122, 1645, 144, 118, 102, 1644, 1642, 129, 1368, 120,
-	1641, 110, 10, 1640, 1639, 13, 1638, 26, 1636, 1635,
-	1634, 1633, 6, 1632, 1631, 1624, 3, 5, 1623, 4,
-	87, 1622, 1621, 47, 56, 53, 61, 1618, 1617, 1603,
-	1602, 1601, 192, 1600, 1599, 1597, 1595, 1594, 1590, 1589,
-	71, 1588, 1587, 1586, 1559, 55, 1557, 1555, 1553, 1552,
-	1551, 32, 1549, 1547, 23, 1544, 30, 1543, 1542, 1541,
-	14, 1540, 1539, 15, 1538, 1537, 7, 8, 1535, 1534,
-	51, 39, 33, 65, 68, 1533, 22, 1532, 82, 1530,
-	1528, 1527, 111, 1513,
-}
-
-//line mysql_sql.y:5946
+	0, 648, 2176, 2174, 729, 718, 2173, 2172, 2171, 2170,
+	2169, 2167, 2166, 2165, 2164, 2163, 2162, 2161, 2159, 2158,
+	2157, 2156, 2155, 2154, 2153, 2150, 2149, 2148, 2145, 2143,
+	2140, 2136, 2135, 703, 2134, 2133, 2132, 2131, 2130, 2127,
+	125, 2125, 2124, 2123, 2118, 2117, 2116, 2115, 2114, 2113,
+	2111, 2109, 2108, 2107, 104, 2106, 116, 2105, 129, 100,
+	90, 2104, 102, 183, 2102, 114, 2100, 85, 145, 2099,
+	2098, 41, 109, 2096, 118, 36, 84, 195, 105, 81,
+	2095, 2094, 2093, 124, 2092, 2091, 2076, 2073, 52, 2072,
+	69, 40, 31, 97, 75, 2071, 2070, 2069, 2068, 2062,
+	79, 2059, 60, 50, 2058, 2057, 2054, 2053, 2051, 32,
+	2049, 44, 2048, 2045, 2044, 2043, 2042, 2040, 2039, 17,
+	20, 22, 2038, 2037, 18, 2, 2034, 2033, 76, 2032,
+	2031, 2029, 667, 2028, 2025, 2024, 140, 2023, 2022, 113,
+	2021, 2020, 2016, 2014, 73, 2013, 2012, 2011, 16, 2010,
+	9, 2008, 42, 2007, 2006, 2005, 47, 2004, 2003, 1999,
+	1998, 92, 51, 24, 82, 1996, 1995, 110, 130, 29,
+	99, 0, 134, 37, 1994, 131, 122, 1993, 78, 175,
+	106, 43, 1992, 49, 62, 1991, 1990, 15, 57, 11,
+	1987, 91, 98, 77, 1986, 95, 1985, 1984, 1983, 86,
+	112, 1, 87, 1982, 127, 1981, 1980, 108, 1978, 1977,
+	48, 107, 1976, 1975, 1974, 28, 1973, 38, 39, 1971,
+	123, 139, 1970, 136, 1969, 119, 83, 72, 1968, 1967,
+	70, 1966, 96, 71, 111, 1965, 716, 1964, 93, 56,
+	21, 1963, 133, 1961, 215, 135, 120, 1960, 1946, 138,
+	1657, 137, 1941, 121, 10, 1940, 1938, 12, 1937, 25,
+	1936, 1935, 1934, 1917, 6, 1916, 1912, 1911, 3, 5,
+	1909, 4, 94, 115, 1907, 53, 61, 65, 64, 63,
+	1904, 1903, 1902, 1901, 214, 1900, 1897, 1896, 1895, 1894,
+	1891, 1881, 74, 1880, 1878, 1877, 1876, 1875, 1873, 58,
+	1872, 1871, 1870, 1869, 1868, 1865, 33, 1864, 1863, 19,
+	1862, 26, 1861, 1860, 1859, 13, 1858, 1856, 14, 1855,
+	1854, 7, 8, 1853, 1852, 59, 35, 34, 68, 66,
+	1848, 23, 1846, 89, 1845, 1844, 1843, 126, 1842,
+}
+
+//line mysql_sql.y:6556
 type yySymType struct {
 	union interface{}
 	id    int
@@ -2833,6 +3067,21 @@ func (st *yySymType) aliasedTableExprUnion() *tree.AliasedTableExpr {
 	return v
 }
 
+func (st *yySymType) alterTableOptionUnion() tree.AlterTableOption {
+	v, _ := st.union.(tree.AlterTableOption)
+	return v
+}
+
+func (st *yySymType) alterTableOptionsUnion() tree.AlterTableOptions {
+	v, _ := st.union.(tree.AlterTableOptions)
+	return v
+}
+
+func (st *yySymType) asOfUnion() *tree.AsOfClause {
+	v, _ := st.union.(*tree.AsOfClause)
+	return v
+}
+
 func (st *yySymType) assignmentUnion() *tree.Assignment {
 	v, _ := st.union.(*tree.Assignment)
 	return v
@@ -2948,6 +3197,21 @@ func (st *yySymType) fieldsListUnion() []*tree.Fields {
 	return v
 }
 
+func (st *yySymType) frameBoundUnion() *tree.FrameBound {
+	v, _ := st.union.(*tree.FrameBound)
+	return v
+}
+
+func (st *yySymType) frameClauseUnion() *tree.FrameClause {
+	v, _ := st.union.(*tree.FrameClause)
+	return v
+}
+
+func (st *yySymType) frameTypeUnion() tree.FrameType {
+	v, _ := st.union.(tree.FrameType)
+	return v
+}
+
 func (st *yySymType) fromUnion() *tree.From {
 	v, _ := st.union.(*tree.From)
 	return v
@@ -3373,242 +3637,277 @@ func (st *yySymType) varExprUnion() *tree.VarExpr {
 	return v
 }
 
+func (st *yySymType) whenUnion() *tree.When {
+	v, _ := st.union.(*tree.When)
+	return v
+}
+
+func (st *yySymType) whensUnion() []*tree.When {
+	v, _ := st.union.([]*tree.When)
+	return v
+}
+
 func (st *yySymType) whereUnion() *tree.Where {
 	v, _ := st.union.(*tree.Where)
 	return v
 }
 
+func (st *yySymType) windowSpecUnion() *tree.WindowSpec {
+	v, _ := st.union.(*tree.WindowSpec)
+	return v
+}
+
 func (st *yySymType) zeroFillOptUnion() bool {
 	v, _ := st.union.(bool)
 	return v
 }
 
 var yyR1 = [...]int{
-	0, 310, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 335, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 47, 299, 299, 298, 298, 297, 297, 296, 296,
-	296, 295, 295, 295, 294, 294, 293, 293, 291, 291,
-	292, 290, 289, 289, 287, 287, 285, 285, 286, 286,
-	280, 280, 283, 283, 281, 281, 281, 281, 284, 279,
-	279, 279, 278, 278, 46, 46, 46, 217, 217, 45,
-	45, 231, 231, 231, 231, 231, 229, 229, 229, 229,
-	228, 228, 227, 227, 232, 232, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
-	230, 230, 230, 230, 230, 230, 230, 230, 230, 40,
-	40, 40, 40, 43, 44, 225, 225, 225, 225, 225,
-	226, 226, 226, 41, 42, 42, 216, 216, 221, 221,
-	220, 220, 220, 220, 220, 220, 220, 220, 220, 220,
-	220, 215, 215, 224, 224, 224, 223, 223, 222, 222,
-	34, 34, 34, 37, 36, 214, 214, 214, 214, 214,
-	214, 214, 214, 35, 35, 35, 35, 35, 35, 33,
-	33, 32, 213, 213, 212, 39, 39, 39, 39, 38,
-	38, 38, 38, 38, 38, 38, 156, 156, 156, 48,
-	7, 31, 31, 262, 262, 167, 167, 168, 168, 166,
-	166, 166, 166, 166, 166, 265, 266, 163, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 30, 311,
-	311, 311, 28, 29, 261, 261, 261, 27, 26, 25,
-	24, 24, 23, 22, 22, 160, 160, 162, 162, 158,
-	312, 312, 237, 237, 161, 161, 21, 21, 159, 159,
-	141, 157, 157, 157, 6, 8, 8, 8, 8, 8,
-	13, 12, 11, 10, 9, 5, 4, 269, 269, 269,
-	269, 269, 269, 307, 307, 307, 308, 73, 73, 69,
-	69, 270, 270, 180, 309, 309, 277, 277, 276, 276,
-	275, 275, 71, 71, 72, 72, 61, 61, 49, 49,
-	282, 282, 282, 282, 288, 288, 259, 259, 107, 107,
-	137, 137, 138, 138, 50, 50, 51, 51, 51, 67,
-	67, 68, 68, 68, 66, 66, 65, 64, 64, 63,
-	62, 62, 62, 53, 53, 52, 52, 52, 52, 52,
-	123, 123, 123, 54, 263, 263, 263, 268, 268, 120,
-	120, 121, 121, 119, 119, 55, 55, 56, 56, 56,
-	56, 118, 118, 117, 57, 57, 58, 58, 60, 60,
-	60, 60, 128, 128, 127, 127, 127, 127, 76, 76,
-	126, 125, 125, 125, 75, 75, 74, 74, 70, 70,
-	59, 59, 124, 313, 313, 122, 149, 149, 149, 155,
-	155, 148, 148, 148, 154, 154, 150, 150, 151, 151,
-	151, 3, 3, 3, 16, 16, 16, 14, 210, 210,
-	209, 209, 211, 211, 211, 211, 205, 205, 206, 206,
-	206, 206, 207, 207, 207, 208, 208, 208, 208, 204,
-	204, 203, 201, 201, 201, 202, 202, 202, 202, 202,
-	202, 152, 152, 15, 198, 198, 199, 199, 199, 200,
-	200, 192, 192, 192, 192, 19, 196, 196, 197, 197,
-	197, 197, 197, 193, 193, 195, 195, 191, 191, 191,
-	191, 191, 18, 190, 190, 188, 188, 186, 186, 187,
-	187, 185, 185, 185, 189, 189, 17, 264, 264, 233,
-	233, 236, 236, 243, 243, 244, 244, 242, 242, 249,
-	249, 248, 248, 247, 247, 246, 246, 245, 245, 240,
-	240, 239, 239, 234, 234, 234, 234, 234, 235, 235,
-	238, 238, 241, 241, 98, 98, 99, 99, 99, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 305, 305,
-	306, 101, 101, 101, 105, 105, 105, 105, 105, 105,
-	100, 100, 100, 102, 102, 102, 83, 83, 82, 82,
-	77, 77, 78, 78, 79, 79, 80, 80, 81, 81,
-	81, 81, 81, 81, 219, 219, 303, 303, 304, 304,
-	300, 300, 300, 302, 302, 302, 302, 302, 301, 301,
-	84, 135, 135, 135, 153, 153, 153, 134, 134, 134,
-	97, 97, 96, 96, 94, 94, 94, 94, 94, 94,
-	94, 94, 94, 94, 94, 94, 94, 218, 218, 164,
-	164, 165, 165, 115, 113, 113, 114, 114, 114, 114,
-	111, 112, 110, 110, 110, 110, 110, 109, 109, 108,
-	108, 108, 194, 194, 106, 106, 104, 104, 104, 103,
-	103, 103, 250, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 173, 173, 173, 173,
-	173, 173, 173, 173, 173, 173, 173, 173, 173, 173,
-	173, 173, 173, 173, 173, 85, 85, 85, 85, 85,
-	85, 85, 85, 85, 93, 93, 93, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
-	133, 133, 274, 274, 274, 130, 132, 132, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	181, 181, 182, 182, 271, 271, 271, 271, 271, 271,
-	272, 272, 273, 273, 273, 273, 267, 267, 267, 267,
-	267, 267, 267, 267, 267, 267, 267, 267, 267, 267,
-	267, 267, 267, 267, 267, 267, 267, 267, 267, 267,
-	267, 267, 267, 267, 172, 129, 129, 129, 251, 183,
-	178, 178, 179, 179, 174, 174, 174, 174, 174, 176,
-	176, 176, 176, 170, 170, 170, 170, 170, 170, 170,
-	170, 170, 175, 175, 177, 177, 184, 184, 184, 184,
-	184, 184, 95, 95, 95, 95, 252, 169, 169, 169,
-	169, 169, 169, 169, 86, 86, 86, 86, 90, 90,
-	92, 92, 92, 92, 92, 92, 92, 92, 92, 92,
-	92, 92, 92, 92, 91, 91, 91, 89, 89, 89,
-	89, 89, 87, 87, 87, 87, 87, 87, 87, 87,
-	87, 87, 87, 87, 87, 87, 87, 88, 136, 136,
-	253, 253, 254, 254, 255, 256, 256, 257, 257, 257,
-	258, 258, 258, 260, 260, 140, 140, 140, 145, 145,
-	139, 139, 146, 146, 147, 147, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
+	1, 1, 1, 1, 50, 303, 303, 303, 48, 324,
+	324, 323, 323, 322, 322, 321, 321, 321, 320, 320,
+	320, 319, 319, 318, 318, 316, 316, 317, 315, 314,
+	314, 312, 312, 310, 310, 311, 311, 305, 305, 308,
+	308, 306, 306, 306, 306, 309, 304, 304, 304, 302,
+	302, 47, 47, 47, 239, 239, 46, 46, 253, 253,
+	253, 253, 253, 251, 251, 251, 251, 250, 250, 249,
+	249, 254, 254, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
+	252, 252, 252, 252, 252, 252, 41, 41, 41, 41,
+	44, 45, 247, 247, 247, 247, 247, 248, 248, 248,
+	42, 43, 43, 238, 238, 243, 243, 242, 242, 242,
+	242, 242, 242, 242, 242, 242, 242, 242, 237, 237,
+	246, 246, 246, 245, 245, 244, 244, 35, 35, 35,
+	38, 37, 236, 236, 236, 236, 236, 236, 236, 236,
+	36, 36, 36, 36, 36, 36, 34, 34, 33, 235,
+	235, 234, 40, 40, 40, 40, 39, 39, 39, 39,
+	39, 39, 39, 174, 174, 174, 49, 7, 7, 51,
+	55, 55, 54, 54, 54, 54, 54, 54, 56, 56,
+	57, 57, 57, 52, 53, 32, 32, 284, 284, 185,
+	185, 186, 186, 184, 184, 184, 184, 184, 184, 287,
+	288, 181, 20, 20, 20, 20, 20, 20, 20, 20,
+	20, 20, 20, 31, 31, 30, 336, 336, 336, 28,
+	29, 283, 283, 283, 27, 26, 25, 24, 24, 23,
+	22, 22, 178, 178, 180, 180, 176, 337, 337, 259,
+	259, 179, 179, 21, 21, 177, 177, 157, 175, 175,
+	175, 6, 8, 8, 8, 8, 8, 13, 12, 11,
+	10, 9, 5, 4, 291, 291, 291, 291, 291, 291,
+	332, 332, 332, 333, 82, 82, 78, 78, 292, 292,
+	202, 334, 334, 301, 301, 300, 300, 299, 299, 80,
+	80, 81, 81, 70, 70, 58, 58, 307, 307, 307,
+	307, 313, 313, 281, 281, 116, 116, 153, 153, 154,
+	154, 59, 59, 60, 60, 60, 76, 76, 77, 77,
+	77, 75, 75, 74, 73, 73, 72, 71, 71, 71,
+	62, 62, 61, 61, 61, 61, 61, 132, 132, 132,
+	132, 132, 132, 132, 132, 132, 63, 285, 285, 285,
+	290, 290, 129, 129, 130, 130, 128, 128, 64, 64,
+	65, 65, 65, 65, 127, 127, 126, 66, 66, 67,
+	67, 69, 69, 69, 69, 69, 138, 138, 136, 136,
+	136, 136, 137, 137, 85, 85, 135, 134, 134, 134,
+	84, 84, 83, 83, 79, 79, 68, 68, 133, 338,
+	338, 131, 131, 149, 149, 167, 167, 167, 173, 173,
+	166, 166, 166, 172, 172, 168, 168, 169, 169, 169,
+	3, 3, 3, 16, 16, 16, 14, 232, 232, 231,
+	231, 233, 233, 233, 233, 227, 227, 228, 228, 228,
+	228, 229, 229, 229, 230, 230, 230, 230, 226, 226,
+	225, 223, 223, 223, 224, 224, 224, 224, 224, 224,
+	170, 170, 15, 220, 220, 221, 221, 221, 222, 222,
+	214, 214, 214, 214, 19, 218, 218, 219, 219, 219,
+	219, 219, 215, 215, 217, 217, 213, 213, 213, 213,
+	213, 18, 212, 212, 210, 210, 208, 208, 209, 209,
+	207, 207, 207, 211, 211, 17, 286, 286, 255, 255,
+	258, 258, 265, 265, 266, 266, 264, 264, 271, 271,
+	270, 270, 269, 269, 268, 268, 267, 267, 262, 262,
+	261, 261, 256, 256, 256, 256, 256, 257, 257, 260,
+	260, 263, 263, 107, 107, 108, 108, 108, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 330, 330, 331,
+	110, 110, 110, 114, 114, 114, 114, 114, 114, 109,
+	109, 109, 111, 111, 111, 92, 92, 91, 91, 86,
+	86, 87, 87, 88, 88, 89, 89, 90, 90, 90,
+	90, 90, 90, 241, 241, 328, 328, 329, 329, 325,
+	325, 325, 327, 327, 327, 327, 327, 326, 326, 93,
+	151, 151, 151, 171, 171, 171, 150, 150, 150, 106,
+	106, 105, 105, 103, 103, 103, 103, 103, 103, 103,
+	103, 103, 103, 103, 103, 103, 240, 240, 182, 182,
+	183, 183, 124, 122, 122, 123, 123, 123, 123, 120,
+	121, 119, 119, 119, 119, 119, 118, 118, 117, 117,
+	117, 216, 216, 115, 115, 113, 113, 113, 112, 112,
+	112, 272, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
+	191, 191, 191, 191, 191, 191, 191, 196, 196, 198,
+	198, 199, 197, 197, 94, 94, 94, 94, 94, 94,
+	94, 94, 94, 94, 102, 102, 102, 143, 143, 143,
 	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 142, 142, 142, 142,
-	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
-	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
-	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
-	142, 142,
+	143, 143, 143, 144, 144, 145, 145, 146, 146, 146,
+	147, 147, 148, 148, 148, 148, 148, 296, 296, 296,
+	297, 297, 298, 298, 140, 142, 142, 142, 142, 142,
+	142, 142, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 159, 159, 159, 160, 160, 160, 203, 203,
+	204, 204, 293, 293, 293, 293, 293, 293, 294, 294,
+	295, 295, 295, 295, 289, 289, 289, 289, 289, 289,
+	289, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	289, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	289, 289, 190, 139, 139, 139, 273, 273, 273, 273,
+	273, 273, 273, 273, 273, 205, 200, 200, 201, 201,
+	192, 192, 192, 192, 192, 194, 194, 194, 194, 188,
+	188, 188, 188, 188, 188, 188, 188, 188, 193, 193,
+	195, 195, 206, 206, 206, 206, 206, 206, 104, 104,
+	104, 104, 274, 187, 187, 187, 187, 187, 187, 187,
+	187, 95, 95, 95, 95, 99, 99, 101, 101, 101,
+	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
+	101, 100, 100, 100, 100, 100, 98, 98, 98, 98,
+	98, 96, 96, 96, 96, 96, 96, 96, 96, 96,
+	96, 96, 96, 96, 96, 96, 97, 152, 152, 275,
+	275, 276, 276, 277, 278, 278, 279, 279, 279, 280,
+	280, 280, 282, 282, 156, 156, 156, 163, 163, 155,
+	155, 164, 164, 165, 165, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 161, 161, 161, 161, 161, 161,
+	161, 161, 161, 161, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 162, 162, 162, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 14, 0, 2, 1, 3, 3, 3, 1, 3,
-	5, 0, 2, 3, 1, 3, 1, 1, 1, 1,
-	1, 1, 0, 3, 0, 3, 0, 3, 0, 3,
-	0, 2, 1, 2, 3, 4, 3, 3, 1, 0,
-	1, 1, 0, 1, 9, 4, 7, 0, 3, 7,
-	4, 1, 3, 3, 3, 1, 0, 1, 1, 1,
-	1, 3, 1, 4, 1, 3, 1, 2, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 1, 2, 1, 2, 2, 1, 1, 1, 3,
-	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 3, 6, 3, 1, 1, 1, 1,
-	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
-	3, 4, 4, 4, 3, 2, 4, 4, 2, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	1, 1, 1, 2, 2, 0, 4, 2, 4, 1,
-	5, 3, 2, 1, 2, 2, 4, 4, 5, 2,
-	1, 7, 1, 3, 3, 1, 1, 1, 1, 2,
-	3, 4, 7, 2, 5, 3, 1, 1, 1, 6,
-	1, 7, 9, 0, 2, 0, 1, 1, 2, 2,
-	2, 1, 4, 2, 2, 3, 0, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 5, 1,
-	1, 1, 5, 5, 0, 1, 1, 2, 2, 3,
-	6, 7, 4, 7, 8, 0, 2, 0, 2, 2,
-	1, 1, 1, 1, 0, 1, 4, 5, 1, 3,
-	1, 1, 3, 5, 1, 1, 1, 1, 1, 1,
-	4, 4, 6, 4, 4, 6, 4, 2, 1, 5,
-	4, 4, 2, 0, 1, 3, 3, 1, 3, 1,
-	3, 1, 3, 4, 0, 1, 0, 1, 1, 3,
-	1, 1, 0, 4, 1, 3, 2, 1, 0, 8,
-	0, 4, 7, 4, 0, 2, 0, 2, 0, 2,
-	0, 4, 1, 3, 1, 2, 4, 3, 4, 0,
-	1, 2, 4, 4, 0, 1, 3, 1, 3, 2,
-	0, 1, 1, 3, 3, 1, 3, 3, 3, 3,
-	1, 2, 2, 7, 0, 1, 1, 1, 1, 0,
-	2, 0, 3, 0, 2, 1, 3, 1, 2, 3,
-	5, 0, 1, 2, 1, 3, 1, 1, 4, 4,
-	4, 3, 2, 2, 2, 3, 2, 3, 0, 2,
-	1, 1, 2, 2, 0, 1, 2, 4, 1, 3,
-	1, 3, 3, 0, 1, 2, 0, 1, 2, 1,
-	1, 0, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 6, 0, 2,
-	1, 2, 2, 2, 2, 2, 0, 1, 2, 2,
-	2, 2, 1, 3, 2, 2, 2, 2, 2, 1,
-	3, 2, 1, 3, 2, 0, 3, 3, 5, 5,
-	4, 1, 1, 4, 1, 3, 1, 3, 2, 1,
-	1, 0, 1, 1, 1, 11, 0, 2, 3, 2,
-	3, 1, 1, 1, 3, 3, 4, 0, 2, 2,
-	2, 2, 5, 1, 1, 0, 3, 0, 1, 1,
-	2, 4, 4, 4, 0, 1, 10, 0, 1, 0,
-	6, 0, 4, 0, 3, 1, 3, 4, 5, 0,
-	3, 1, 3, 2, 3, 1, 2, 0, 6, 0,
-	2, 0, 2, 4, 5, 4, 5, 1, 6, 5,
-	0, 3, 0, 1, 0, 1, 1, 3, 2, 3,
-	3, 4, 4, 3, 3, 3, 3, 4, 4, 3,
+	1, 1, 1, 0, 3, 0, 1, 1, 14, 0,
+	2, 1, 3, 3, 3, 1, 3, 5, 0, 2,
+	3, 1, 3, 1, 1, 1, 1, 1, 1, 0,
+	3, 0, 3, 0, 3, 0, 3, 0, 2, 1,
+	2, 3, 4, 3, 3, 1, 0, 1, 1, 0,
+	1, 9, 4, 7, 0, 3, 7, 4, 1, 3,
+	3, 3, 1, 0, 1, 1, 1, 1, 3, 1,
+	4, 1, 3, 1, 2, 1, 1, 2, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 1, 2,
+	1, 2, 2, 1, 1, 1, 3, 2, 2, 2,
+	2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
+	3, 6, 3, 1, 1, 1, 1, 1, 1, 1,
+	2, 4, 6, 1, 4, 1, 3, 3, 4, 4,
+	4, 3, 2, 4, 4, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
+	2, 2, 0, 4, 2, 4, 1, 5, 3, 2,
+	1, 2, 2, 4, 4, 5, 2, 1, 7, 1,
+	3, 3, 1, 1, 1, 1, 2, 3, 4, 7,
+	2, 5, 3, 1, 1, 1, 6, 1, 1, 4,
+	1, 3, 3, 3, 5, 6, 5, 3, 0, 1,
+	0, 1, 1, 5, 3, 7, 9, 0, 2, 0,
+	1, 1, 2, 2, 2, 1, 4, 2, 2, 3,
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 4, 5, 1, 1, 1, 5,
+	5, 0, 1, 1, 2, 2, 3, 6, 7, 4,
+	7, 8, 0, 2, 0, 2, 2, 1, 1, 1,
+	1, 0, 1, 4, 5, 1, 3, 1, 1, 3,
+	5, 1, 1, 1, 1, 1, 1, 4, 4, 6,
+	4, 4, 6, 4, 2, 1, 5, 4, 4, 2,
+	0, 1, 3, 3, 1, 3, 1, 3, 1, 3,
+	4, 0, 1, 0, 1, 1, 3, 1, 1, 0,
+	4, 1, 3, 2, 1, 0, 8, 0, 4, 7,
+	4, 0, 2, 0, 2, 0, 2, 0, 4, 1,
+	3, 1, 2, 4, 3, 4, 0, 1, 2, 4,
+	4, 0, 1, 3, 1, 3, 2, 0, 1, 1,
+	3, 3, 1, 3, 3, 3, 3, 1, 2, 2,
+	1, 2, 2, 1, 2, 2, 7, 0, 1, 1,
+	1, 1, 0, 2, 0, 3, 0, 2, 1, 3,
+	1, 2, 3, 5, 0, 1, 2, 1, 3, 1,
+	1, 4, 4, 4, 4, 3, 2, 2, 2, 3,
+	2, 3, 2, 3, 0, 2, 1, 1, 2, 2,
+	0, 1, 2, 4, 1, 3, 1, 3, 3, 0,
+	1, 2, 5, 2, 2, 0, 1, 2, 1, 1,
+	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 6, 0, 2, 1,
+	2, 2, 2, 2, 2, 0, 1, 2, 2, 2,
+	2, 1, 3, 2, 2, 2, 2, 2, 1, 3,
+	2, 1, 3, 2, 0, 3, 3, 5, 5, 4,
+	1, 1, 4, 1, 3, 1, 3, 2, 1, 1,
+	0, 1, 1, 1, 11, 0, 2, 3, 2, 3,
+	1, 1, 1, 3, 3, 4, 0, 2, 2, 2,
+	2, 5, 1, 1, 0, 3, 0, 1, 1, 2,
+	4, 4, 4, 0, 1, 10, 0, 1, 0, 6,
+	0, 4, 0, 3, 1, 3, 4, 5, 0, 3,
+	1, 3, 2, 3, 1, 2, 0, 6, 0, 2,
+	0, 2, 4, 5, 4, 5, 1, 6, 5, 0,
+	3, 0, 1, 0, 1, 1, 3, 2, 3, 3,
+	4, 4, 3, 3, 3, 3, 4, 4, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 4, 5, 4, 1, 3,
-	3, 0, 2, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 1, 3,
-	0, 1, 1, 3, 1, 1, 2, 1, 7, 7,
-	7, 7, 8, 5, 0, 1, 0, 1, 1, 1,
-	1, 3, 3, 1, 1, 1, 1, 1, 0, 1,
-	3, 1, 3, 5, 1, 1, 1, 1, 3, 5,
-	0, 1, 1, 2, 1, 2, 2, 1, 1, 2,
-	2, 2, 2, 2, 1, 5, 6, 1, 2, 0,
-	1, 1, 2, 5, 0, 1, 1, 1, 2, 2,
-	3, 3, 1, 1, 2, 2, 2, 0, 1, 2,
-	2, 2, 0, 3, 0, 3, 1, 1, 1, 1,
-	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 1, 1, 1, 1, 3,
-	5, 2, 2, 2, 2, 1, 1, 2, 6, 6,
-	6, 1, 1, 1, 1, 1, 2, 2, 1, 2,
-	2, 2, 2, 2, 0, 1, 1, 5, 4, 4,
-	5, 5, 5, 5, 4, 5, 5, 5, 5, 5,
-	5, 5, 1, 1, 1, 4, 2, 2, 4, 2,
-	2, 4, 6, 2, 2, 2, 4, 6, 4, 2,
-	0, 1, 2, 3, 1, 1, 1, 1, 1, 1,
-	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 3, 3, 3, 4, 5, 4, 1, 3, 3,
+	0, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 1, 3, 0,
+	1, 1, 3, 1, 1, 2, 1, 7, 7, 7,
+	7, 8, 5, 0, 1, 0, 1, 1, 1, 1,
+	3, 3, 1, 1, 1, 1, 1, 0, 1, 3,
+	1, 3, 5, 1, 1, 1, 1, 3, 5, 0,
+	1, 1, 2, 1, 2, 2, 1, 1, 2, 2,
+	2, 2, 2, 1, 5, 6, 1, 2, 0, 1,
+	1, 2, 5, 0, 1, 1, 1, 2, 2, 3,
+	3, 1, 1, 2, 2, 2, 0, 1, 2, 2,
+	2, 0, 3, 0, 3, 1, 1, 1, 1, 1,
+	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 1, 1, 1, 1, 3, 5,
+	3, 3, 2, 2, 2, 2, 1, 1, 2, 5,
+	6, 6, 6, 1, 1, 1, 1, 0, 1, 1,
+	2, 4, 0, 2, 1, 1, 2, 2, 1, 2,
+	2, 2, 2, 2, 0, 1, 1, 6, 4, 4,
+	5, 5, 5, 6, 5, 6, 6, 6, 5, 5,
+	7, 5, 5, 0, 6, 0, 3, 0, 2, 5,
+	1, 1, 2, 2, 2, 2, 2, 1, 1, 1,
+	1, 1, 0, 2, 5, 2, 3, 6, 6, 6,
+	2, 2, 4, 2, 2, 4, 6, 2, 2, 2,
+	4, 6, 4, 2, 6, 8, 6, 8, 4, 6,
+	7, 6, 1, 1, 1, 1, 1, 1, 0, 1,
+	2, 3, 1, 1, 1, 1, 1, 1, 0, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 0, 1, 1, 1, 3,
-	0, 1, 1, 3, 3, 3, 3, 2, 1, 3,
-	4, 3, 1, 3, 4, 4, 5, 3, 4, 5,
-	6, 1, 0, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 1, 1, 1, 2, 1,
+	1, 1, 3, 0, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 0, 1, 1, 3,
+	3, 3, 3, 2, 1, 3, 4, 3, 1, 3,
+	4, 4, 5, 3, 4, 5, 6, 1, 0, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 1, 2, 2,
-	2, 2, 2, 2, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 4, 4, 1, 1, 3,
-	0, 1, 0, 3, 3, 0, 5, 0, 3, 5,
-	0, 1, 1, 0, 1, 1, 2, 2, 0, 1,
+	1, 3, 1, 1, 1, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 2, 2, 2, 1, 2, 2, 2,
+	2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 4, 4, 1, 1, 3, 0,
+	1, 0, 3, 3, 0, 5, 0, 3, 5, 0,
+	1, 1, 0, 1, 1, 2, 2, 0, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3642,425 +3941,469 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1,
 }
 
 var yyChk = [...]int{
-	-1000, -310, -2, -1, -3, -4, -5, -6, -38, -20,
-	-7, -48, -32, -33, -34, -40, -45, -46, -47, -50,
-	-16, -15, -14, 8, 10, -8, -156, -21, -22, -23,
-	-24, -25, -26, -27, -28, -29, -30, -31, 181, 9,
-	49, -35, -36, -37, -41, -42, -43, -44, 283, 289,
-	325, -51, -53, -17, -18, -19, 177, -9, -10, -11,
-	-12, -13, 199, 198, 26, 197, 178, 120, 121, 123,
-	124, 30, -52, 54, 179, -54, 6, 421, -61, 27,
-	-82, -153, 57, -142, -144, 379, 380, 381, 382, 383,
-	384, 385, 386, 387, 388, 389, 390, 391, 392, 393,
-	394, 395, 396, 397, 398, 399, 400, 401, 402, 403,
-	404, 405, 406, 407, 408, 409, 410, 411, 412, 413,
-	414, 373, 216, 240, 243, 250, 120, 137, 131, 158,
-	150, 151, 128, 220, 221, 64, 123, 353, 339, 324,
-	126, 235, 237, 239, 242, 222, 146, 142, 234, 218,
-	140, 223, 28, 224, 163, 225, 226, 378, 335, 265,
-	357, 341, 247, 141, 336, 238, 338, 164, 168, 345,
-	289, 135, 136, 343, 347, 162, 196, 32, 375, 34,
-	208, 348, 166, 161, 157, 356, 251, 249, 160, 134,
-	156, 219, 38, 170, 169, 171, 319, 320, 321, 322,
-	227, 228, 344, 153, 143, 269, 129, 18, 351, 203,
-	340, 290, 229, 244, 206, 252, 165, 167, 210, 215,
-	377, 248, 349, 139, 207, 236, 202, 352, 130, 283,
-	292, 230, 124, 241, 346, 355, 37, 305, 133, 127,
-	193, 121, 212, 217, 231, 232, 233, 254, 253, 245,
-	154, 209, 159, 132, 155, 122, 211, 354, 306, 420,
-	267, 308, 152, 149, 213, 186, 376, 350, 342, 125,
-	312, 307, 147, 255, 417, 418, 419, 11, -157, 19,
-	323, -39, 181, -153, -5, -4, -32, -50, 184, -58,
-	-59, -60, -122, -124, -82, 54, -153, -228, -198, -227,
-	-199, -230, -200, -152, 20, 178, 177, 211, 10, 179,
-	287, 185, 8, 6, 288, 197, 9, 289, 291, 292,
-	295, 296, 297, 31, 300, 301, 57, 60, -153, -228,
-	-198, 215, 222, -49, -65, -66, -123, 27, 15, 5,
-	308, 214, -192, -190, -264, 194, 193, 76, 359, 183,
-	298, -311, -261, 342, 341, -161, 340, 333, 335, 177,
-	185, 343, 32, 345, 346, 336, 308, 125, 122, -214,
-	80, 130, 129, -214, 214, 29, -221, 318, -220, -222,
-	345, 346, 356, -215, 344, -140, -153, 58, 59, 75,
-	151, 148, -66, -123, -65, -51, -53, 308, 214, 185,
-	184, 359, -263, 20, -268, 21, 22, -1, -71, 206,
-	-82, 119, -58, -134, -153, 324, 89, -39, 119, -82,
-	30, -125, -126, -127, -128, 41, 45, 47, 42, 43,
-	44, 48, -313, 23, -149, -155, 23, -150, 60, -151,
-	-144, 57, 58, 59, -51, -53, 51, 55, 11, 55,
-	54, 422, 58, 285, 299, 308, 286, 298, 186, 214,
-	299, 214, 333, 186, 290, 293, 294, 334, 51, 187,
-	51, -278, 356, -49, -68, 17, -54, -53, 416, 16,
-	20, 21, -188, 189, -188, 185, -188, 184, -312, 11,
-	99, 213, 212, 337, 334, -237, 338, 339, -161, -160,
-	97, -161, 184, 359, -262, 189, 349, 376, 128, 129,
-	130, -225, 20, 29, 317, -198, 214, 55, 89, 19,
-	-223, 89, 100, -222, -222, -222, -223, -100, 29, -151,
-	60, 116, -100, 29, 119, 30, 30, -67, -68, -54,
-	-53, 56, 56, -262, -262, -262, -262, -262, -55, -56,
-	107, -174, -153, 81, -176, 57, -170, 380, 381, 382,
-	383, 384, 385, 386, 388, 393, 395, 399, 400, 401,
-	402, 406, 407, 412, 413, 414, 308, 147, -171, -173,
-	-296, -291, -169, 54, 105, 106, 113, 82, -172, -250,
-	24, 367, -130, -131, -132, -133, -292, -290, 60, 65,
-	69, 71, 72, 70, 67, 118, -53, -267, -273, -271,
-	148, 200, 144, 145, 8, 111, 318, 116, -274, 59,
-	58, 271, 75, 272, 273, 359, 268, 274, 189, 323,
-	43, 275, 276, 277, 278, 279, 366, 280, 44, 281,
-	270, 204, 282, 370, 369, 371, 363, 360, 358, 361,
-	362, 364, 365, -269, 33, -50, 54, 30, 54, -153,
-	-119, 12, 119, 65, 60, -153, 54, -213, -212, -134,
-	-59, -59, -59, -59, 41, 41, 41, 46, 41, 46,
-	41, -127, -150, -155, 56, -229, 184, 284, 210, -227,
-	211, 289, 292, -204, -203, -201, -152, 60, -199, -232,
-	-134, -152, 334, -229, -204, -203, 326, -49, -174, -153,
-	60, -64, -63, -174, -204, 81, -198, -151, -153, -188,
-	-82, -160, -160, -162, -312, -158, -312, 334, -119, -173,
-	-237, -159, -153, -188, -204, 308, 24, 350, 351, 126,
-	129, 128, 357, -226, 317, 20, -198, -220, -216, 60,
-	318, -203, -224, 51, 116, -275, -174, 29, -223, -223,
-	-223, -224, 115, -153, -49, -204, -198, -153, -83, -82,
-	-154, -151, -144, -118, 55, -117, 11, -148, 80, 78,
-	79, -153, 23, 119, -174, 96, -184, 89, 90, 91,
-	92, 93, 94, 54, 54, 54, 54, 54, 54, 54,
-	54, -182, 54, 54, 54, 54, 54, -182, 54, 54,
-	54, 102, 101, 112, 105, 106, 107, 108, 109, 110,
-	111, 103, 104, 99, 81, 97, 98, 83, -53, -174,
-	-179, -173, -173, -173, -173, -250, 54, -174, 54, -272,
-	54, -181, -182, 54, 60, 60, 60, 54, 54, 54,
-	-173, 54, -270, -180, -309, 415, -73, 56, -69, -153,
-	-307, -308, -69, -72, -153, -66, -174, -146, -147, -139,
-	-143, -150, -151, -144, 266, 182, 20, 80, 23, 25,
-	271, 303, 83, 116, 16, 84, 148, 115, 273, 367,
-	272, 177, 47, 75, 369, 371, 370, 360, 358, 310,
-	314, 316, 313, 359, 333, 29, 10, 26, 198, 21,
-	22, 109, 179, 200, 87, 88, 201, 24, 199, 72,
-	19, 50, 11, 323, 13, 14, 274, 309, 189, 188,
-	99, 326, 185, 45, 8, 118, 27, 96, 311, 41,
-	77, 43, 97, 17, 361, 362, 31, 325, 372, 205,
-	111, 275, 276, 277, 48, 81, 317, 70, 51, 78,
-	15, 46, 98, 180, 366, 44, 214, 315, 279, 281,
-	280, 183, 6, 270, 368, 30, 197, 42, 184, 334,
-	86, 187, 71, 204, 144, 145, 5, 76, 9, 49,
-	52, 363, 364, 365, 33, 85, 12, 282, 278, 318,
-	327, 328, 329, 330, 331, 332, 172, 173, 174, 175,
-	176, 246, 192, 190, 194, 195, 415, 416, 19, -39,
-	119, -70, -153, -119, 55, 89, -75, -74, 51, 52,
-	-76, 51, -74, 41, 41, -231, 107, 57, 55, -202,
-	309, 422, 58, 56, 55, -231, 187, 60, 55, 18,
-	119, -282, 338, 55, -62, 25, 26, -205, -206, 315,
-	24, -191, 52, -186, -187, -185, -189, 29, -82, -119,
-	-119, -119, -160, -154, -162, -157, -162, -158, 119, -141,
-	-153, -205, 54, 127, 130, 130, 129, -198, 187, 54,
-	89, -224, -224, -224, 29, -152, 51, 55, -119, -56,
-	-57, -58, -174, -174, -174, -153, -153, 107, 70, 81,
-	-170, -178, -179, -174, -129, 21, 20, -129, -129, -174,
-	-129, 107, -179, -179, 56, -252, 65, -129, -129, -129,
-	-129, -129, -129, -129, -171, -171, -171, -171, -171, -171,
-	-171, -171, -171, -171, -171, -171, -177, -183, -250, 54,
-	99, 97, 98, 83, -173, -171, -171, 56, 55, -174,
-	-251, 270, -178, 56, -179, -178, -171, -178, -129, 55,
-	54, 56, 55, 33, 119, 55, 89, 56, 55, -67,
-	119, 324, -153, 56, 55, -66, -212, -174, -174, 54,
-	-174, 11, 119, 119, -203, 16, 376, -152, -134, 187,
-	-204, -279, 188, 366, -174, -174, -153, -288, 332, 327,
-	329, -63, -210, 376, 317, 316, 312, -207, -208, 311,
-	313, 310, 314, 51, 260, 261, 262, 263, -185, -140,
-	115, 225, 151, 54, -119, -160, -160, -162, -153, -210,
-	56, 130, -204, -163, 60, -216, -82, -82, -121, 13,
-	55, 119, 70, 56, 55, -174, -174, -174, 23, -179,
-	56, 56, 56, 56, -174, -174, -174, -174, -174, -174,
-	-174, -179, -177, -173, -171, -171, -175, 201, 80, -174,
-	55, 52, 56, 56, 52, 56, 55, 56, -174, -180,
-	-277, -276, -275, 33, -50, -69, -270, -153, -308, -275,
-	-153, -146, -143, -151, -144, 65, -153, -67, -70, -204,
-	107, 107, 57, -152, 318, -152, -204, -217, 376, 27,
-	119, -259, 417, -286, 327, 16, 16, -209, -211, 319,
-	320, 321, 322, 80, -208, 60, 60, 60, 60, -82,
-	-145, 89, -145, -145, -77, -78, -79, -84, -80, -134,
-	-165, -81, 192, 190, 194, -304, 76, 195, 246, 77,
-	185, -119, -119, -160, -167, -168, -166, 266, -265, 318,
-	309, 56, -120, 14, 16, -58, -153, 107, -174, 56,
-	56, 56, -85, -91, 116, 148, 200, 147, 146, 144,
-	305, 306, 140, 141, 139, 56, 56, 56, 56, 56,
-	56, 56, 56, 56, -175, 80, -173, -170, 56, -85,
-	-100, -100, -171, 56, 56, 55, -270, 56, -152, 16,
-	23, -205, 289, 184, -107, 418, 60, 16, 60, -284,
-	60, -211, 65, 65, 65, 65, -208, 54, -100, -102,
-	-151, 60, 116, 60, 56, 55, -86, -90, -87, -89,
-	-88, -92, -91, 148, 149, 116, 152, 154, 155, 156,
-	157, 158, 159, 160, 161, 162, 163, 30, 200, 144,
-	145, 146, 147, 164, 131, 150, 374, 172, 132, 173,
-	133, 174, 134, 175, 135, 136, 176, 137, -81, -153,
-	77, -303, -304, -188, -303, 77, 54, -119, -166, 267,
-	31, 118, 269, 29, 265, 16, -174, -179, 56, -253,
-	-255, 54, -254, 54, -253, -253, -253, -93, 136, 135,
-	-93, -256, 54, -257, 54, -256, -170, 56, 56, 56,
-	56, -275, -152, -152, -217, 290, -82, -137, 419, 65,
-	60, 329, -193, -195, -134, 54, -98, -99, -116, 303,
-	216, -189, 220, 64, 221, 324, 222, 185, 224, 225,
-	226, 196, 227, 228, 229, 318, 230, 231, 232, 233,
-	286, 5, 256, -79, -97, -96, -94, 70, 81, 29,
-	303, -95, 64, 115, 239, 217, 240, -115, -164, 190,
-	76, 77, 291, -165, -258, 306, 305, -253, -254, -255,
-	-253, -253, 54, 54, -253, -253, -253, -253, -300, -301,
-	-153, -301, -153, -300, -300, -188, -174, 65, -266, -163,
-	65, 65, 65, 65, -280, -237, 54, 16, 56, 55,
-	-253, -174, -233, 206, 55, -116, -145, -145, -140, 115,
-	-145, -145, -145, -145, 223, 223, -145, -145, -145, -145,
-	-145, -145, -145, -145, -145, -145, -145, -145, -145, -145,
-	54, -94, 70, -171, 60, -102, -103, 29, 238, 234,
-	-104, 29, 218, 219, -106, 54, 246, 77, 77, -82,
-	-260, 307, -136, 60, -136, 54, 52, 255, 54, 54,
-	54, -301, 56, 268, 56, 56, 55, 56, 55, -287,
-	332, -283, -281, 327, 328, 329, 330, -138, -153, -284,
-	-196, -195, -62, 56, 16, -116, 65, 65, -145, -145,
-	65, 60, 60, 60, -145, -145, 65, 60, -155, 65,
-	65, 65, 65, 29, 60, -105, 29, 234, 238, 235,
-	236, 237, 65, 29, 65, 29, 65, 29, -153, 54,
-	-305, -306, 60, 65, 54, -194, 54, 56, 55, 56,
-	-193, -302, 260, 261, 262, 264, 263, -302, -193, -193,
-	-193, 54, -219, -218, 247, 81, 65, 65, -289, 188,
-	-285, 331, -281, 16, 329, 16, 16, 56, 55, -197,
-	196, 64, 376, 258, 259, -62, -234, 248, 249, -235,
-	-241, 251, -100, -100, 60, 60, -101, 217, -83, 56,
-	55, 89, 56, -174, -109, -108, 372, -193, 60, 56,
-	56, 56, 56, -193, 247, 56, 56, -295, 54, 65,
-	-286, 16, -284, 16, -284, -284, -153, -145, 60, 257,
-	-239, 252, 54, -237, 54, -237, 77, 261, 218, 219,
-	56, -306, 60, 56, -113, -114, -111, -112, 51, 336,
-	244, 245, 56, -196, -196, -196, -196, 56, -299, 30,
-	56, -294, -293, -135, -290, -153, 332, 60, -284, 65,
-	-151, -236, 253, 65, -171, 54, -171, 54, -238, 250,
-	54, -218, -112, 51, -111, 51, 10, 9, -115, -298,
-	-297, -296, 56, 55, 119, -243, 54, 16, 56, -232,
-	56, -232, 54, 89, -171, -110, 241, 242, 30, 129,
-	-110, 55, 89, -293, -153, -244, -242, 206, -235, 56,
-	56, -232, 65, 56, 70, 29, 243, -297, 29, -174,
-	119, 56, 55, 57, -240, 254, 56, -153, -242, -245,
-	33, 65, -249, -246, 54, -116, 208, -249, -116, -248,
-	-247, 253, 209, 56, 55, 57, 54, -247, -246, -179,
-	56,
+	-1000, -335, -2, -1, -3, -4, -5, -6, -39, -20,
+	-7, -49, -33, -34, -35, -41, -46, -47, -48, -50,
+	-52, -53, -59, -16, -15, -14, 10, 12, -8, -174,
+	-21, -22, -23, -24, -25, -26, -27, -28, -29, -30,
+	-31, -32, -51, 186, 11, 52, -36, -37, -38, -42,
+	-43, -44, -45, 288, 294, 331, 330, 185, 208, -60,
+	-62, -17, -18, -19, 182, -9, -10, -11, -12, -13,
+	204, 203, 28, 202, 183, 125, 126, 128, 129, 32,
+	-61, 57, 184, -63, 8, 437, -70, 29, -91, -171,
+	60, -158, -162, 384, 385, 386, 387, 388, 389, 390,
+	391, 392, 393, 394, 395, 396, 397, 398, 399, 400,
+	401, 402, 403, 404, 405, 406, 407, 408, 409, 410,
+	411, 412, 413, 414, 415, 416, 417, 418, 419, 378,
+	433, 431, 430, 432, 435, 221, 245, 248, 255, 125,
+	142, 136, 163, 155, 156, 133, 225, 226, 67, 128,
+	359, 344, 329, 131, 240, 242, 244, 247, 227, 151,
+	147, 239, 223, 145, 228, 30, 229, 168, 230, 231,
+	383, 341, 270, 346, 252, 146, 47, 243, 343, 169,
+	173, 351, 294, 140, 141, 348, 353, 167, 201, 34,
+	380, 36, 213, 354, 171, 166, 162, 362, 256, 254,
+	165, 139, 161, 224, 40, 175, 174, 176, 324, 325,
+	326, 327, 232, 233, 350, 158, 148, 274, 134, 20,
+	357, 208, 345, 295, 234, 249, 211, 257, 170, 172,
+	215, 220, 382, 253, 355, 144, 212, 241, 207, 358,
+	135, 288, 297, 235, 129, 246, 352, 361, 39, 310,
+	138, 132, 198, 126, 217, 222, 236, 237, 238, 259,
+	258, 250, 159, 214, 164, 137, 160, 127, 216, 360,
+	311, 436, 272, 313, 157, 154, 218, 191, 381, 356,
+	347, 349, 130, 317, 312, 152, 260, 425, 426, 427,
+	13, -175, 21, 328, -40, 186, -171, -5, -4, -33,
+	-59, 189, -67, -68, -69, -131, -133, -91, 57, -171,
+	-250, -220, -249, -221, -252, -222, -170, 22, 183, 182,
+	216, 12, 184, 292, 190, 10, 8, 293, 202, 11,
+	294, 296, 297, 300, 301, 302, 33, 305, 306, 60,
+	63, -171, -250, -220, 220, 227, -303, 329, 382, 189,
+	189, -58, -74, -75, -132, 29, 17, 5, 7, 6,
+	313, 219, -214, -212, -286, 199, 198, 79, 364, 188,
+	303, 349, -336, -283, 347, 346, -179, 345, 339, 341,
+	182, 190, 348, 34, 351, 352, 47, 189, 313, 130,
+	127, -236, 83, 135, 134, -236, 219, 31, -243, 323,
+	-242, -244, 351, 352, 362, -237, 350, -156, -171, 61,
+	62, 78, 156, 153, -75, -132, -74, -60, -62, 313,
+	219, 190, 189, 364, -285, 22, -290, 23, 24, -1,
+	-80, 211, -91, 124, -67, -150, -171, 329, 92, -40,
+	124, -91, 32, -134, -135, -136, -137, -138, 43, 48,
+	50, 44, 45, 46, 47, 51, -338, 25, -167, 25,
+	-173, -168, 63, -169, -162, 60, 61, 62, -60, -62,
+	54, 58, 13, 58, 57, 438, 61, 290, 304, 313,
+	291, 303, 191, 219, 304, 219, 339, 191, 295, 298,
+	299, 340, 54, 192, 54, -302, 362, 68, -91, -92,
+	-91, -58, -77, 19, -63, -62, 424, 18, 22, 23,
+	22, 23, 22, 23, -210, 194, -210, 190, -210, 189,
+	21, -337, 13, 102, 218, 217, 342, 340, -259, 343,
+	344, -179, -178, 100, -179, 189, 364, -91, -284, 194,
+	355, 381, 133, 134, 135, -247, 22, 31, 322, -220,
+	219, 58, 92, 21, -245, 92, 103, -244, -244, -244,
+	-245, -109, 31, -169, 63, 121, -109, 31, 124, 32,
+	32, -76, -77, -63, -62, 59, 59, -284, -284, -284,
+	-284, -284, -64, -65, 112, -192, -171, 84, -194, 60,
+	-188, 385, 386, 387, 388, 389, 390, 391, 392, 393,
+	394, 395, 396, 397, 398, 399, 400, 401, 402, 404,
+	405, 406, 407, 409, 410, 411, 412, 415, 416, 417,
+	418, 419, 313, 152, -189, -191, -321, -316, -187, 57,
+	110, 111, 118, 85, -190, -272, 26, 87, 372, -140,
+	-141, -142, -143, -317, -315, 63, 68, 72, 74, 75,
+	73, 70, 64, 123, -62, -289, -295, -293, 153, 205,
+	149, 150, 10, 116, 323, 121, -159, -296, -297, 62,
+	61, 276, 78, 277, 278, 364, 273, 279, 194, 328,
+	45, 280, 281, 282, 283, 284, 371, 285, 46, 286,
+	275, 209, 287, 375, 374, 376, 368, 365, 363, 366,
+	367, 369, 370, -291, 35, -59, 57, 32, 57, -171,
+	-128, 14, 124, 68, 63, -171, 57, -235, -234, -150,
+	-68, -68, -68, -68, -68, 43, 43, 43, 49, 43,
+	49, 43, 49, 43, -136, -168, 434, -173, 59, -251,
+	189, 289, 215, -249, 216, 294, 297, -226, -225, -223,
+	-170, 63, -221, -254, -150, -170, 340, -251, -226, -225,
+	332, 192, 58, -58, -192, -171, 63, -73, -72, -192,
+	-226, 84, -220, -169, -171, -210, -223, -91, -178, -178,
+	-180, -337, -176, -337, 340, -128, -191, -259, -177, -171,
+	-210, -55, -54, 187, 184, 185, 183, -226, 313, 26,
+	356, 357, 131, 134, 133, 6, -248, 322, 22, -220,
+	-242, -238, 63, 323, -225, -246, 54, 121, -299, -192,
+	31, -245, -245, -245, -246, 120, -171, -58, -226, -220,
+	-171, -92, -172, -169, -162, -127, 58, -126, 13, -166,
+	83, 81, 82, -171, 25, 124, -192, 99, -206, 92,
+	93, 94, 95, 96, 97, 57, 57, 57, 57, 57,
+	57, 57, 57, 57, -204, 57, 57, 57, 57, 57,
+	57, 57, -204, 57, 57, 57, -204, 57, 57, 57,
+	107, 106, 117, 110, 111, 112, 113, 114, 115, 116,
+	108, 109, 102, 84, 100, 101, 86, 104, 105, -62,
+	-192, -201, -191, -191, -191, -191, -272, -196, -192, 57,
+	-192, 57, -294, 57, -203, -204, 57, 63, 63, 63,
+	57, 57, 57, -191, 57, 57, 57, -292, -202, -334,
+	423, -82, 59, -78, -171, -332, -333, -78, -81, -171,
+	-75, -192, -164, -165, -155, -161, -168, -169, -162, 271,
+	187, 22, 83, 25, 27, 276, 308, 86, 121, 18,
+	87, 153, 120, 278, 372, 277, 182, 50, 78, 374,
+	376, 375, 365, 363, 315, 319, 321, 318, 364, 339,
+	31, 12, 28, 203, 23, 24, 114, 184, 205, 90,
+	91, 206, 6, 26, 204, 75, 21, 53, 13, 328,
+	15, 16, 279, 314, 194, 193, 102, 332, 190, 48,
+	10, 7, 123, 29, 99, 316, 43, 80, 330, 45,
+	100, 19, 366, 367, 33, 331, 377, 210, 116, 280,
+	281, 282, 51, 84, 322, 73, 54, 81, 17, 49,
+	101, 185, 371, 46, 219, 320, 284, 286, 285, 188,
+	8, 275, 373, 32, 202, 44, 189, 340, 89, 192,
+	74, 209, 149, 150, 5, 79, 11, 52, 55, 368,
+	369, 370, 35, 88, 14, 287, 283, 323, 333, 334,
+	335, 336, 337, 338, 177, 178, 179, 180, 181, 251,
+	197, 195, 199, 200, 423, 424, 428, 429, 21, -40,
+	124, -79, -171, -128, 58, 92, -84, -83, 54, 55,
+	-85, 54, -83, -83, 43, 43, 43, -149, 150, 435,
+	-253, 112, 60, 58, -224, 314, 438, 61, 59, 58,
+	-253, 192, 63, -91, -91, 58, 20, 124, -307, 343,
+	58, -71, 27, 28, -227, -228, 320, 26, -213, 55,
+	-208, -209, -207, -211, 31, -91, -128, -128, -128, -178,
+	-172, -180, -175, -180, -176, 124, -157, -171, 58, -56,
+	196, -56, 196, -57, 192, 25, -56, -227, 57, 132,
+	135, 135, 134, -220, 192, 57, 92, -246, -246, -246,
+	31, -170, 54, -128, -65, -66, -67, -192, -192, -192,
+	-171, -171, 112, 73, 84, -188, -200, -201, -192, -139,
+	23, 22, -139, -139, -192, -139, 112, -201, -201, 59,
+	59, -274, 68, -192, -192, -273, 280, 275, 281, 279,
+	273, 287, 282, 283, 152, -139, -139, -139, -189, -139,
+	-139, -192, -160, 422, 420, 421, -139, -139, -189, -189,
+	-189, -189, -189, -189, -189, -189, -189, -189, -189, -189,
+	-195, -205, -272, 57, 102, 100, 101, 86, -191, -189,
+	-189, 63, 63, 59, 58, -198, -199, 88, -192, -273,
+	-200, 59, -201, -200, -189, -200, -192, -139, -139, 58,
+	57, 59, 58, 35, 124, 58, 92, 59, 58, -76,
+	124, 329, -171, 59, 58, -75, -234, -192, -192, 57,
+	-192, -167, 25, -187, -187, 13, 124, 124, -225, 18,
+	381, -170, -150, 192, -226, -304, 193, 371, -192, -192,
+	-171, -313, 338, 333, 335, -72, -232, 381, 322, 321,
+	317, -229, -230, 316, 318, 315, 319, 54, 265, 266,
+	267, 268, -207, -156, 120, 230, 156, 57, -128, -178,
+	-178, -180, -171, -54, -93, -150, -171, -171, -91, -171,
+	-232, 59, 135, -226, -181, 63, -238, -91, -130, 15,
+	58, 124, 73, 59, 58, -192, -192, -192, 25, -201,
+	59, 59, 59, 59, 58, 58, 13, -201, -192, -192,
+	102, -192, -192, 59, 13, -192, -192, -192, -201, -195,
+	-191, -189, -189, -193, 206, 83, -192, -197, -199, 90,
+	-192, 58, 55, 59, 59, 55, 59, 58, 59, 58,
+	13, -192, -192, -202, -301, -300, -299, 35, -59, -78,
+	-292, -171, -333, -299, -171, -164, -161, -169, -162, 68,
+	-171, -76, -79, -226, 112, 112, 60, -170, 323, -170,
+	-226, -239, 381, 29, 124, -281, 425, -311, 333, 18,
+	18, -231, -233, 324, 325, 326, 327, 83, -230, 63,
+	63, 63, 63, -91, -163, 92, -163, -163, -86, -87,
+	-88, -93, -89, -183, -90, 197, 195, 199, -329, 79,
+	200, 251, 80, 190, -128, -128, -178, -95, -99, -96,
+	-98, -97, -101, -100, 153, 154, 121, 157, 159, 160,
+	161, 162, 163, 164, 165, 166, 167, 168, 32, 205,
+	149, 150, 151, 152, 169, 136, 155, 379, 177, 137,
+	178, 138, 179, 139, 180, 140, 141, 181, 142, 145,
+	146, 147, 148, 144, 192, 32, 184, -185, -186, -184,
+	271, -287, 323, 314, 59, -129, 16, 18, -67, -171,
+	112, -144, 428, -192, 59, 59, 59, -94, -100, 167,
+	121, 153, 205, 152, 151, 149, 310, 311, 59, -144,
+	-192, -192, -192, -75, 59, 59, -192, 59, 59, -192,
+	13, 59, 59, 59, -193, 83, -191, -188, 59, 91,
+	-192, 89, -94, -109, -109, -189, -192, -192, 59, 59,
+	59, 58, -292, 59, -170, 18, 25, -227, 294, 189,
+	-116, 426, 63, 18, 63, -309, 63, -233, 68, 68,
+	68, 68, -230, 57, -109, -111, -169, 63, 121, 63,
+	59, 58, -90, -171, 80, -328, -329, -210, -328, 80,
+	57, -128, -106, -105, -103, 73, 84, 31, 308, -104,
+	67, 120, 244, 222, 245, -124, -182, 195, 79, 80,
+	296, -183, -280, 311, 310, -275, -277, 57, -276, 57,
+	-277, -275, -275, 57, 57, -275, -275, -275, -275, -278,
+	57, -279, 57, -279, -279, -278, -171, 31, 31, -184,
+	272, 33, 123, 274, 31, 270, 18, -192, -201, 57,
+	59, -275, -276, -275, -275, -275, -102, 141, 140, -102,
+	-144, 59, 59, 59, -298, 373, -144, -144, 59, -144,
+	59, -192, -144, -188, -192, 59, 59, 59, 59, 59,
+	58, 59, 21, -299, -170, -170, -239, 295, -91, -153,
+	427, 68, 63, 335, -215, -217, -150, 57, -107, -108,
+	-125, 308, 221, -211, 225, 67, 226, 329, 227, 190,
+	229, 230, 231, 201, 232, 233, 234, 323, 235, 236,
+	237, 238, 291, 5, 261, -88, -325, -326, -171, -326,
+	-171, -325, -325, -210, -192, -103, 73, -189, 63, -111,
+	-112, 31, 243, 239, -113, 31, 223, 224, -115, 57,
+	251, 80, 80, -91, -282, 312, 68, 68, -152, 63,
+	-152, 68, 68, -189, 68, -288, -181, -145, 211, 59,
+	63, 59, -192, -192, -305, -259, 57, 18, 59, 58,
+	-275, -192, -255, 211, 58, -125, -163, -163, -156, 120,
+	-163, -163, -163, -163, 228, 228, -163, -163, -163, -163,
+	-163, -163, -163, -163, -163, -163, -163, -163, -163, -163,
+	57, 57, 55, 260, 57, 57, 57, -326, 59, 68,
+	57, -216, 57, 59, 59, 59, 58, 59, 58, 59,
+	58, 273, -75, 18, 59, 59, -312, 338, -308, -306,
+	333, 334, 335, 336, -154, -171, -309, -218, -217, -71,
+	59, 18, -125, 68, 68, -163, -163, 68, 63, 63,
+	63, -163, -163, 68, 63, -173, 68, 68, 68, 68,
+	31, 63, -114, 31, 239, 243, 240, 241, 242, 68,
+	31, 68, 31, 68, 31, -171, 57, -330, -331, 63,
+	-215, -327, 265, 266, 267, 269, 268, -327, -215, -215,
+	-215, 57, -241, -240, 252, 84, 59, -192, -118, -117,
+	377, -215, 63, 68, 68, -146, -147, 429, 253, -201,
+	-314, 193, -310, 337, -306, 18, 335, 18, 18, 59,
+	58, -219, 201, 67, 381, 263, 264, -71, -256, 253,
+	254, -257, -263, 256, -109, -109, 63, 63, -110, 222,
+	-92, 59, 58, 92, 59, 59, 59, 59, -215, 252,
+	59, -122, -123, -120, -121, 54, 47, 249, 250, 59,
+	59, 59, 59, -148, 86, 432, 433, -187, -320, 57,
+	68, -311, 18, -309, 18, -309, -309, -171, -163, 63,
+	262, -261, 257, 57, -259, 57, -259, 80, 266, 223,
+	224, 59, -331, 63, -218, -218, -218, -218, 59, -240,
+	-121, 54, -120, 54, 12, 11, -148, 430, 431, 423,
+	430, 431, -324, 32, 59, -319, -318, -151, -315, -171,
+	338, 63, -309, 68, -169, -258, 258, 68, -189, 57,
+	-189, 57, -260, 255, 57, -124, -119, 246, 247, 32,
+	134, -119, 83, -323, -322, -321, 59, 58, 124, -265,
+	57, 18, 59, -254, 59, -254, 57, 92, -189, 73,
+	31, 248, -148, 58, 92, -318, -171, -266, -264, 211,
+	-257, 59, 59, -254, 68, 59, -322, 31, -192, 124,
+	59, 58, 60, -262, 259, 59, -171, -264, -267, 35,
+	68, -271, -268, 57, -125, 213, -271, -125, -270, -269,
+	258, 214, 59, 58, 60, 57, -269, -268, -201, 59,
 }
 
 var yyDef = [...]int{
-	20, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	23, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	421, 422, 423, 0, 0, 264, 0, 218, 219, 220,
-	221, 222, 223, 224, 225, 226, 227, 200, 0, 0,
-	180, 160, 161, 162, 119, 120, 121, 122, 0, 0,
-	0, 324, -2, 424, 425, 426, -2, 265, 266, 267,
-	268, 269, 196, 197, 198, -2, 0, 173, 0, 165,
-	165, 0, 334, 0, 0, 345, 354, 20, 302, 0,
-	307, 598, 634, 635, 636, 1236, 1237, 1238, 1239, 1240,
-	1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250,
-	1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260,
-	1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270,
-	1271, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088,
-	1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098,
-	1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108,
-	1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118,
-	1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128,
-	1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138,
-	1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148,
-	1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158,
-	1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168,
-	1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178,
-	1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188,
-	1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198,
-	1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208,
-	1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218,
-	1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228,
-	1229, 1230, 1231, 1232, 1233, 1234, 1235, 0, 189, 0,
-	0, 193, 0, 261, 185, 186, 187, 188, 0, 0,
-	376, 377, 400, 403, 406, 0, 179, 0, 0, 80,
-	464, 82, 466, 0, 86, 88, 89, -2, 93, 94,
-	95, 96, 97, 98, 99, 0, 101, 1129, 103, 1189,
-	106, 107, 108, 0, 117, 118, -2, -2, 461, 0,
-	0, 1178, 62, 325, -2, 0, 0, 0, 0, 350,
-	495, 495, 0, 495, 0, 472, 473, 474, 493, 494,
-	508, 0, 0, 237, 238, 0, 254, 245, 254, 0,
-	229, 230, 231, 235, 236, 255, 203, 174, 175, 164,
-	0, 169, 0, 163, 0, 0, 133, 0, 138, 0,
-	1128, 1193, 1144, 0, 1161, 0, 158, 151, 152, 925,
-	1090, 0, 329, 0, 335, 0, 334, 203, 203, 203,
-	203, 203, 0, 355, 356, 357, 358, 3, 0, 0,
-	306, 0, 363, 190, 637, 0, 0, 195, 0, 0,
-	0, 0, 0, 0, 0, 391, 0, 0, 390, 0,
-	0, 0, 0, 404, 405, 407, 0, 409, 410, 416,
-	417, 418, 419, 420, 0, 334, 76, 0, 0, 0,
-	0, 0, 468, 87, 116, 90, 91, 0, 111, 113,
-	115, 114, 100, 112, 102, 104, 105, 110, 76, 0,
-	0, 0, 63, 327, 308, 0, 347, 349, 0, 0,
-	351, 352, 0, 0, 0, 0, 0, 495, 0, 250,
-	251, 245, 245, 239, 247, 0, 252, 253, 0, 363,
-	0, 0, 0, 495, 0, 0, 0, 0, 167, 0,
-	172, 123, 128, 126, 127, 129, 0, 0, 0, 0,
-	0, 156, 157, 0, 0, 0, 0, 145, 148, 590,
-	591, 592, 149, 150, 0, 926, 927, 308, 330, 346,
-	348, 343, 344, 0, 0, 0, 0, 0, 371, 365,
-	367, 411, 28, 0, 828, 634, 832, 1237, 1238, 1239,
-	1240, 1241, 1242, 1243, 1245, 1250, 1252, -2, -2, -2,
-	1259, 1263, 1264, 1269, 1270, 1271, -2, -2, 841, 705,
-	706, 707, 708, 0, 0, 0, 0, 0, 715, 716,
-	0, 0, 721, 722, 723, 724, 38, 39, 857, 858,
-	859, 860, 861, 862, 863, 795, 692, 0, 780, 770,
-	0, 790, 808, 809, 0, 0, 0, 0, 0, 40,
-	41, 786, 787, 788, 789, 791, 792, 793, 794, 796,
-	797, 798, 799, 800, 801, 802, 803, 804, 805, 806,
-	807, 810, 812, 782, 783, 784, 785, 774, 775, 776,
-	777, 778, 779, 276, 294, 278, 0, 283, 0, 599,
-	334, 0, 0, 191, 0, 262, 0, 363, 182, 0,
-	394, 388, 0, 381, 392, 393, 384, 0, 386, 0,
-	382, 383, 401, 408, 402, 0, 77, 78, 79, 81,
-	92, 0, 0, 70, 449, 455, 452, 462, 465, 0,
-	84, 467, 109, 0, 65, 0, 0, 328, 331, 28,
-	310, 336, 337, 340, 436, 0, 463, 487, -2, 0,
-	363, 363, 363, 245, 0, 247, 0, 247, 242, 246,
-	0, 256, 258, 0, 436, 1220, 204, 176, 177, 0,
-	0, 171, 0, 0, 130, 131, 132, 139, 134, 136,
-	0, 0, 140, 153, 154, 155, 300, 301, 0, 0,
-	0, 144, 0, 159, 326, 270, 271, 0, 273, 596,
-	274, 414, 415, 363, 0, 372, 0, 368, 0, 0,
-	0, 412, 0, 0, 827, 0, 0, 846, 847, 848,
-	849, 850, 851, 820, 815, 815, 815, 0, 815, 0,
-	0, 756, 0, 815, 815, 815, 815, 757, 815, 815,
-	815, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, -2, 822,
-	0, 711, 712, 713, 714, 717, 0, 0, 820, 759,
-	0, 760, 771, 0, 763, 764, 765, 820, 0, 820,
-	769, 815, 277, 291, 0, 295, 0, 0, 287, 289,
-	282, 284, 0, 0, 304, 329, 364, 638, 0, 932,
-	-2, 934, -2, -2, 936, 937, 938, 939, 940, 941,
-	942, 943, 944, 945, 946, 947, 948, 949, 950, 951,
-	952, 953, 954, 955, 956, 957, 958, 959, 960, 961,
-	962, 963, 964, 965, 966, 967, 968, 969, 970, 971,
-	972, 973, 974, 975, 976, 977, 978, 979, 980, 981,
-	982, 983, 984, 985, 986, 987, 988, 989, 990, 991,
-	992, 993, 994, 995, 996, 997, 998, 999, 1000, 1001,
-	1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011,
-	1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021,
-	1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031,
-	1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041,
-	1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051,
-	1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061,
-	1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071,
-	1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 0, 194,
-	0, 0, 398, 334, 0, 0, 378, 395, 0, 0,
-	379, 0, 380, 385, 387, 0, 71, 75, 0, 451,
-	0, 0, 454, 83, 0, 0, 0, 59, 0, 0,
-	0, 314, 0, 0, 339, 341, 342, 428, 437, 0,
-	496, 0, 0, 492, -2, 499, 0, 505, 0, 228,
-	232, 233, 363, 248, 245, 249, 245, 247, 0, 257,
-	260, 428, 0, 178, 166, 168, 0, 125, 0, 0,
-	0, 141, 142, 143, 146, 147, 0, 0, 361, 366,
-	373, 374, 824, 825, 826, 413, 29, 369, 829, 0,
-	831, 0, 821, 822, 0, 816, 817, 0, 0, 0,
-	0, 0, 0, 0, 772, 0, 856, 0, 0, 0,
-	0, 0, 0, 0, 693, 694, 695, 696, 697, 698,
-	699, 700, 701, 702, 703, 704, 833, 844, 845, 0,
-	0, 0, 0, 0, 842, 837, 0, 709, 0, 0,
-	814, 818, 0, 781, 0, 0, 0, 0, 0, 294,
-	296, 0, 0, 294, 0, 0, 0, 303, 0, 275,
-	0, 0, 263, 199, 0, 329, 183, 184, 396, 0,
-	389, 0, 0, 0, 450, 0, 0, 453, 85, 0,
-	67, 0, 60, 61, 332, 333, 29, 316, 48, 0,
-	0, 338, 427, 0, 438, 439, 440, 441, 442, 0,
-	0, 0, 0, 0, 488, 489, 490, 491, 500, 928,
-	928, 928, 0, 600, 240, 363, 363, 245, 259, 205,
-	0, 170, 124, 0, 217, 135, 272, 597, 359, 0,
-	0, 0, 830, 755, 0, 0, 0, 0, 0, 0,
-	744, 738, 739, 773, 0, 0, 0, 0, 0, 0,
-	0, 0, 834, 842, 838, 0, 835, 0, 0, 823,
-	0, 0, 758, 761, 0, 766, 0, 768, 0, 292,
-	0, 297, 298, 294, 281, 288, 280, 290, 285, 286,
-	305, 639, 933, 930, 931, 192, 399, 181, 0, 69,
-	72, 73, 74, 456, 0, 457, 436, 66, 0, 0,
-	0, 318, 0, 315, 0, 0, 0, 429, 430, 0,
-	0, 0, 0, 0, 444, 445, 446, 447, 448, 0,
-	0, 929, 0, 0, 0, 601, 602, 604, 605, 0,
-	0, 607, 661, 0, 616, 495, 616, 0, 0, 618,
-	619, 243, 241, 363, 201, 206, 207, 0, 211, 0,
-	0, 137, 353, 0, 0, 375, 30, 370, 823, 740,
-	741, 742, 0, 725, 910, 912, 728, 910, 910, 910,
-	734, 734, 915, 917, 915, 743, 745, 746, 749, 747,
-	750, 751, 737, 819, 836, 0, 843, 839, 710, 0,
-	0, 0, 0, 748, 293, 0, 279, 397, 460, 0,
-	0, 67, 0, 0, 320, 0, 317, 0, 311, 313,
-	58, 431, 432, 433, 434, 435, 443, 0, 501, 502,
-	593, 594, 595, 503, -2, 0, -2, 920, 865, 866,
-	867, 910, 869, 912, 0, 910, 910, 896, 897, 898,
-	899, 900, 901, 902, 903, 904, 0, 0, 887, 910,
-	910, 910, 910, 907, 870, 871, 872, 873, 874, 875,
-	876, 877, 878, 879, 880, 881, 882, 883, 606, 662,
-	628, 628, 617, 628, 628, 495, 0, 244, 208, 209,
-	210, 0, 213, 214, 216, 0, 360, 362, 718, 726,
-	911, 0, 727, 0, 729, 730, 731, 732, 735, 736,
-	733, 884, 0, 885, 0, 886, 840, 719, 720, 762,
-	767, 299, 458, 459, 64, 68, 50, 309, 0, 319,
-	49, 0, 0, 483, 910, 0, 509, -2, 546, 928,
-	928, 0, 928, 928, 928, 928, 0, 0, 928, 928,
-	928, 928, 928, 928, 928, 928, 928, 928, 928, 928,
-	928, 928, 0, 603, 630, -2, 642, 644, 0, 0,
-	647, 648, 0, 0, 0, 0, 684, 654, 0, 0,
-	854, 855, 0, 660, 923, 921, 922, 868, 892, 893,
-	894, 895, 0, 0, 888, 889, 890, 891, 0, 620,
-	629, 0, 629, 0, 0, 628, 0, 0, 215, 202,
-	0, 0, 0, 0, 44, 0, 0, 0, 476, 0,
-	340, 0, 506, 0, 504, 548, 0, 0, 928, 928,
-	0, 0, 0, 0, 928, 928, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 643, 645, 646, 649, 650, 651, 689, 690, 691,
-	652, 686, 687, 688, 653, 0, 0, 852, 853, 682,
-	864, 924, 0, 908, 0, 0, 0, 0, 0, 0,
-	0, 0, 614, 212, 914, 913, 0, 918, 0, 42,
-	46, 51, 52, 0, 0, 0, 0, 0, 322, 312,
-	475, 484, 485, 340, 542, 547, 549, 550, 0, 0,
-	553, 554, 555, 556, 0, 0, 559, 560, 561, 562,
-	563, 564, 565, 566, 567, 568, 584, 585, 586, 587,
-	588, 589, 569, 570, 571, 572, 573, 574, 581, 0,
-	0, 578, 0, 0, 0, 677, 0, 905, 0, 906,
-	0, 621, 623, 624, 625, 626, 627, 622, 0, 0,
-	0, 0, 613, 615, 657, 0, 0, 0, 31, 0,
-	48, 0, 53, 0, 0, 0, 0, 321, 0, 477,
-	928, 0, 0, 481, 482, 486, 531, 0, 0, 537,
-	0, 543, 551, 552, 557, 558, 575, 0, 0, 577,
-	0, 0, 685, 0, 664, 678, 0, 0, 909, 476,
-	476, 476, 476, 0, 658, 916, 919, 22, 0, 0,
-	45, 0, 54, 0, 56, 57, 323, 0, 479, 0,
-	511, 0, 0, 0, 0, 0, 540, 0, 582, 583,
-	576, 579, 580, 655, 663, 665, 666, 667, 0, 679,
-	680, 681, 683, 608, 609, 610, 611, 0, 21, 0,
-	32, 0, 34, 36, 37, 631, 43, 47, 55, 478,
-	480, 513, 0, 532, 0, 0, 0, 0, 0, 0,
-	0, 656, 668, 0, 669, 0, 0, 0, 612, 23,
-	24, 0, 33, 0, 0, 510, 0, 542, 533, 0,
-	535, 0, 0, 0, 0, 670, 672, 673, 0, 0,
-	671, 0, 0, 35, 632, 0, 515, 0, 529, 534,
-	536, 0, 541, 539, 674, 676, 675, 25, 26, 27,
-	0, 514, 0, 527, 512, 0, 538, 633, 516, -2,
-	0, 530, 517, -2, 0, 525, 0, 518, 526, 0,
-	521, 0, 0, 520, 0, -2, 0, 522, -2, 0,
-	528,
+	20, 21, 22, 460, 461, 462, 0, 0, 291, 0,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 251,
+	252, 207, 208, 0, 0, 187, 167, 168, 169, 126,
+	127, 128, 129, 0, 0, 0, 25, 0, 0, 351,
+	-2, 463, 464, 465, -2, 292, 293, 294, 295, 296,
+	203, 204, 205, -2, 0, 180, 0, 172, 172, 0,
+	361, 0, 0, 372, 387, 23, 329, 0, 334, 637,
+	673, 674, 675, 1345, 1346, 1347, 1348, 1349, 1350, 1351,
+	1352, 1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361,
+	1362, 1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370, 1371,
+	1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1184,
+	1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194,
+	1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204,
+	1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214,
+	1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224,
+	1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234,
+	1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244,
+	1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254,
+	1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264,
+	1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274,
+	1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284,
+	1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294,
+	1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304,
+	1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314,
+	1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324,
+	1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334,
+	1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344,
+	0, 196, 0, 0, 200, 0, 288, 192, 193, 194,
+	195, 0, 0, 409, 410, 436, 439, 445, 0, 186,
+	0, 0, 87, 503, 89, 505, 0, 93, 95, 96,
+	-2, 100, 101, 102, 103, 104, 105, 106, 0, 108,
+	1237, 110, 1297, 113, 114, 115, 0, 124, 125, -2,
+	-2, 500, 0, 0, 1286, 69, 0, 26, 27, 0,
+	0, 352, -2, 0, 0, 0, 0, 377, 380, 383,
+	534, 534, 0, 534, 0, 511, 512, 513, 532, 533,
+	547, 253, 0, 0, 264, 265, 0, 281, 272, 281,
+	0, 256, 257, 258, 262, 263, 282, 0, 227, 181,
+	182, 171, 0, 176, 0, 170, 0, 0, 140, 0,
+	145, 0, 1236, 1301, 1252, 0, 1269, 0, 165, 158,
+	159, 1024, 1199, 0, 356, 0, 362, 0, 361, 227,
+	227, 227, 227, 227, 0, 388, 389, 390, 391, 3,
+	0, 0, 333, 0, 396, 197, 676, 0, 0, 202,
+	0, 0, 0, 0, 0, 0, 0, 0, 427, 0,
+	0, 426, 0, 0, 0, 0, 0, 440, 441, 0,
+	446, 448, 449, 455, 456, 457, 458, 459, 0, 361,
+	83, 0, 0, 0, 0, 0, 507, 94, 123, 97,
+	98, 0, 118, 120, 122, 121, 107, 119, 109, 111,
+	112, 117, 83, 0, 0, 0, 70, 24, 0, 224,
+	635, 354, 335, 0, 374, 376, 0, 0, 378, 379,
+	381, 382, 384, 385, 0, 0, 0, 0, 0, 534,
+	0, 0, 277, 278, 272, 272, 266, 274, 0, 279,
+	280, 0, 396, 0, 0, 0, 534, 0, 0, 0,
+	0, 0, 174, 0, 179, 130, 135, 133, 134, 136,
+	0, 0, 0, 0, 0, 163, 164, 0, 0, 0,
+	0, 152, 155, 629, 630, 631, 156, 157, 0, 1025,
+	1026, 335, 357, 373, 375, 370, 371, 0, 0, 0,
+	0, 0, 404, 398, 400, 450, 35, 0, 924, 673,
+	928, 1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354,
+	1355, 1356, 1357, 1358, 1359, -2, 1361, 1362, 1363, -2,
+	-2, -2, 1368, -2, -2, 1372, 1373, 1376, -2, -2,
+	1379, 1380, -2, -2, 937, 744, 745, 746, 747, 0,
+	0, 0, 0, 0, 756, 757, 0, 767, 0, 763,
+	764, 765, 766, 45, 46, 953, 954, 955, 956, 957,
+	958, 959, 960, 883, 731, 0, 868, 858, 0, 878,
+	896, 897, 0, 0, 0, 0, 0, 0, 0, 47,
+	48, 874, 875, 876, 877, 879, 880, 881, 882, 884,
+	885, 886, 887, 888, 889, 890, 891, 892, 893, 894,
+	895, 898, 900, 870, 871, 872, 873, 862, 863, 864,
+	865, 866, 867, 303, 321, 305, 0, 310, 0, 638,
+	361, 0, 0, 198, 0, 289, 0, 396, 189, 0,
+	430, 424, 0, 0, 415, 428, 429, 418, 0, 420,
+	0, 422, 0, 416, 417, 437, 0, 447, 438, 0,
+	84, 85, 86, 88, 99, 0, 0, 77, 488, 494,
+	491, 501, 504, 0, 91, 506, 116, 0, 72, 0,
+	0, 0, 0, 355, 358, 35, 337, 363, 364, 367,
+	475, 0, 502, 526, -2, 0, 254, 396, 396, 396,
+	272, 0, 274, 0, 274, 269, 273, 0, 283, 285,
+	0, 209, 210, 218, 218, 220, 218, 475, 1328, 228,
+	183, 184, 0, 0, 178, 0, 0, 137, 138, 139,
+	146, 141, 143, 0, 0, 147, 160, 161, 162, 327,
+	328, 0, 0, 0, 151, 0, 166, 353, 297, 298,
+	0, 300, 301, 453, 454, 396, 0, 405, 0, 401,
+	0, 0, 0, 451, 0, 0, 923, 0, 0, 942,
+	943, 944, 945, 946, 947, 916, 903, 903, 903, 0,
+	903, 0, 0, 0, 830, 0, 0, 0, 0, 903,
+	903, 903, 825, 0, 903, 903, 831, 0, 903, 903,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, -2,
+	918, 0, 752, 753, 754, 755, 758, 0, 768, 0,
+	0, 916, 833, 0, 834, 859, 0, 837, 838, 839,
+	916, 0, 916, 843, 0, 903, 903, 304, 318, 0,
+	322, 0, 0, 314, 316, 309, 311, 0, 0, 331,
+	356, 397, 677, 0, 1031, -2, 1033, -2, -2, 1035,
+	1036, 1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045,
+	1046, 1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055,
+	1056, 1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065,
+	1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075,
+	1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085,
+	1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095,
+	1096, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105,
+	1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115,
+	1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125,
+	1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135,
+	1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145,
+	1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155,
+	1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165,
+	1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175,
+	1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 0, 201,
+	0, 0, 434, 361, 0, 0, 411, 431, 0, 0,
+	412, 0, 413, 414, 419, 421, 423, 445, 0, 0,
+	0, 78, 82, 0, 490, 0, 0, 493, 90, 0,
+	0, 0, 66, 223, 636, 0, 0, 0, 341, 0,
+	0, 366, 368, 369, 467, 476, 0, 535, 0, 0,
+	531, -2, 538, 0, 544, 0, 255, 259, 260, 396,
+	275, 272, 276, 272, 274, 0, 284, 287, 0, 0,
+	219, 0, 0, 0, 221, 222, 0, 467, 0, 185,
+	173, 175, 0, 132, 0, 0, 0, 148, 149, 150,
+	153, 154, 0, 394, 399, 406, 407, 920, 921, 922,
+	452, 36, 402, 925, 0, 927, 0, 917, 918, 0,
+	904, 905, 0, 0, 0, 0, 0, 0, 0, 826,
+	860, 0, 952, 0, 0, 0, 906, 907, 908, 909,
+	910, 911, 912, 913, 914, 0, 0, 0, 0, 0,
+	0, 0, 0, 855, 856, 857, 0, 0, 732, 733,
+	734, 735, 736, 737, 738, 739, 740, 741, 742, 743,
+	929, 940, 941, 0, 0, 0, 0, 0, 938, 933,
+	0, 750, 751, 748, 0, 772, 769, 0, 0, 902,
+	0, 869, 0, 0, 0, 0, 0, 0, 0, 321,
+	323, 0, 0, 321, 0, 0, 0, 330, 0, 302,
+	0, 0, 290, 206, 0, 356, 190, 191, 432, 0,
+	425, 442, 0, 443, 444, 0, 0, 0, 489, 0,
+	0, 492, 92, 0, 74, 0, 67, 68, 359, 360,
+	36, 343, 55, 0, 0, 365, 466, 0, 477, 478,
+	479, 480, 481, 0, 0, 0, 0, 0, 527, 528,
+	529, 530, 539, 1027, 1027, 1027, 0, 639, 267, 396,
+	396, 272, 286, 211, 212, 0, 213, 0, 217, 0,
+	229, 0, 177, 131, 0, 241, 142, 299, 392, 0,
+	0, 0, 926, 803, 0, 0, 0, 0, 0, 0,
+	803, 788, 789, 861, 0, 0, 0, 361, 0, 0,
+	0, 0, 0, 848, 0, 0, 0, 0, 0, 930,
+	938, 934, 0, 931, 0, 0, 919, 0, 770, 0,
+	0, 0, 0, 832, 835, 0, 840, 0, 842, 0,
+	0, 0, 0, 319, 0, 324, 325, 321, 308, 315,
+	307, 317, 312, 313, 332, 678, 1032, 1029, 1030, 199,
+	435, 188, 0, 76, 79, 80, 81, 495, 0, 496,
+	475, 73, 0, 0, 0, 345, 0, 342, 0, 0,
+	0, 468, 469, 0, 0, 0, 0, 0, 483, 484,
+	485, 486, 487, 0, 0, 1028, 0, 0, 0, 640,
+	641, 643, 644, 0, 646, 700, 0, 655, 534, 655,
+	0, 0, 657, 658, 270, 268, 396, -2, 1019, 962,
+	963, 964, 1009, 966, 1011, 0, 1009, 1009, 995, 996,
+	997, 998, 999, 1000, 1001, 1002, 1003, 0, 0, 986,
+	1009, 1009, 1009, 1009, 1006, 967, 968, 969, 970, 971,
+	972, 973, 974, 975, 976, 977, 978, 979, 980, 1014,
+	1016, 1016, 1016, 1014, 0, 0, 0, 225, 230, 231,
+	0, 235, 0, 0, 144, 386, 0, 0, 408, 37,
+	403, 824, 0, 919, 790, 791, 792, 0, 774, 775,
+	1009, 1011, 778, 1009, 1009, 1009, 784, 784, 803, 794,
+	0, 0, 0, 822, 803, 803, 0, 799, 803, 0,
+	0, 802, 803, 915, 932, 0, 939, 935, 749, 759,
+	773, 0, 0, 0, 0, 0, 0, 0, 798, 801,
+	320, 0, 306, 433, 499, 0, 0, 74, 0, 0,
+	347, 0, 344, 0, 338, 340, 65, 470, 471, 472,
+	473, 474, 482, 0, 540, 541, 632, 633, 634, 542,
+	-2, 0, 645, 701, 667, 667, 656, 667, 667, 534,
+	0, 271, 669, -2, 681, 683, 0, 0, 686, 687,
+	0, 0, 0, 0, 723, 693, 0, 0, 950, 951,
+	0, 699, 1022, 1020, 1021, 965, 1010, 0, 991, 0,
+	992, 993, 994, 0, 0, 987, 988, 989, 990, 981,
+	0, 982, 0, 983, 984, 985, 214, 0, 216, 232,
+	233, 234, 0, 237, 238, 240, 0, 393, 395, 805,
+	760, 776, 777, 779, 780, 781, 782, 785, 786, 783,
+	793, 827, 828, 829, 0, 0, 795, 796, 851, 797,
+	849, 0, 787, 936, 771, 761, 762, 836, 841, 844,
+	0, 846, 0, 326, 497, 498, 71, 75, 57, 336,
+	0, 346, 56, 0, 0, 522, 1009, 0, 548, -2,
+	585, 1027, 1027, 0, 1027, 1027, 1027, 1027, 0, 0,
+	1027, 1027, 1027, 1027, 1027, 1027, 1027, 1027, 1027, 1027,
+	1027, 1027, 1027, 1027, 0, 642, 0, 659, 668, 0,
+	668, 0, 0, 667, 0, 682, 684, 685, 688, 689,
+	690, 728, 729, 730, 691, 725, 726, 727, 692, 0,
+	0, 948, 949, 721, 961, 1023, 0, 0, 0, 1007,
+	0, 0, 0, 215, 0, 239, 226, 361, 0, 800,
+	823, 850, 0, 0, 51, 0, 0, 0, 515, 0,
+	367, 0, 545, 0, 543, 587, 0, 0, 1027, 1027,
+	0, 0, 0, 0, 1027, 1027, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 653, 0,
+	0, 716, 0, 1013, 1012, 1004, 0, 1005, 0, 1017,
+	0, 236, 807, 0, 845, 847, 49, 53, 58, 59,
+	0, 0, 0, 0, 0, 349, 339, 514, 523, 524,
+	367, 581, 586, 588, 589, 0, 0, 592, 593, 594,
+	595, 0, 0, 598, 599, 600, 601, 602, 603, 604,
+	605, 606, 607, 623, 624, 625, 626, 627, 628, 608,
+	609, 610, 611, 612, 613, 620, 0, 0, 617, 0,
+	0, 660, 662, 663, 664, 665, 666, 661, 0, 0,
+	0, 0, 652, 654, 696, 0, 724, 0, 703, 717,
+	0, 0, 1008, 0, 0, 0, 0, 810, 811, 806,
+	38, 0, 55, 0, 60, 0, 0, 0, 0, 348,
+	0, 516, 1027, 0, 0, 520, 521, 525, 570, 0,
+	0, 576, 0, 582, 590, 591, 596, 597, 614, 0,
+	0, 616, 0, 0, 515, 515, 515, 515, 0, 697,
+	694, 702, 704, 705, 706, 0, 718, 719, 720, 722,
+	1015, 1018, 804, 808, 0, 0, 0, 0, 29, 0,
+	0, 52, 0, 61, 0, 63, 64, 350, 0, 518,
+	0, 550, 0, 0, 0, 0, 0, 579, 0, 621,
+	622, 615, 618, 619, 647, 648, 649, 650, 0, 695,
+	707, 0, 708, 0, 0, 0, 0, 812, 813, 814,
+	815, 816, 28, 0, 39, 0, 41, 43, 44, 670,
+	50, 54, 62, 517, 519, 552, 0, 571, 0, 0,
+	0, 0, 0, 0, 0, 651, 709, 711, 712, 0,
+	0, 710, 0, 30, 31, 0, 40, 0, 0, 549,
+	0, 581, 572, 0, 574, 0, 0, 0, 0, 713,
+	715, 714, 809, 0, 0, 42, 671, 0, 554, 0,
+	568, 573, 575, 0, 580, 578, 32, 33, 34, 0,
+	553, 0, 566, 551, 0, 577, 672, 555, -2, 0,
+	569, 556, -2, 0, 564, 0, 557, 565, 0, 560,
+	0, 0, 559, 0, -2, 0, 561, -2, 0, 567,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 110, 102, 3,
-	54, 56, 107, 105, 55, 106, 119, 108, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 421,
-	90, 89, 91, 3, 422, 3, 3, 3, 3, 3,
+	3, 3, 3, 85, 3, 3, 3, 115, 107, 3,
+	57, 59, 112, 110, 58, 111, 124, 113, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 437,
+	93, 92, 94, 3, 438, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 112, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 117, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 101, 3, 113,
+	3, 3, 3, 3, 106, 3, 118,
 }
 
 var yyTok2 = [...]int{
@@ -4069,12 +4412,12 @@ var yyTok2 = [...]int{
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 57, 58, 59, 60, 61, 62, 63, 64,
+	52, 53, 54, 55, 56, 60, 61, 62, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 83, 84, 85,
-	86, 87, 88, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 103, 104, 109, 111, 114, 115, 116, 117,
-	118, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	86, 87, 88, 89, 90, 91, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 108, 109, 114,
+	116, 119, 120, 121, 122, 123, 125, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
@@ -4122,7 +4465,10 @@ var yyTok3 = [...]int{
 	57730, 405, 57731, 406, 57732, 407, 57733, 408, 57734, 409,
 	57735, 410, 57736, 411, 57737, 412, 57738, 413, 57739, 414,
 	57740, 415, 57741, 416, 57742, 417, 57743, 418, 57744, 419,
-	57745, 420, 0,
+	57745, 420, 57746, 421, 57747, 422, 57748, 423, 57749, 424,
+	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
+	57755, 430, 57756, 431, 57757, 432, 57758, 433, 57759, 434,
+	57760, 435, 57761, 436, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -4472,7 +4818,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:474
+//line mysql_sql.y:510
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -4480,32 +4826,64 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:480
+//line mysql_sql.y:516
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 19:
+	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:503
+//line mysql_sql.y:542
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 20:
+	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:507
+//line mysql_sql.y:546
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 21:
+	case 24:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:552
+		{
+			yyLOCAL = &tree.Kill{Query: yyDollar[2].boolValUnion(), ConnectionId: uint64(yyDollar[3].item.(int64))}
+		}
+		yyVAL.union = yyLOCAL
+	case 25:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:557
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 26:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:561
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 27:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:565
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 28:
 		yyDollar = yyS[yypt-14 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:513
+//line mysql_sql.y:571
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -4520,42 +4898,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 22:
+	case 29:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:528
+//line mysql_sql.y:586
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 23:
+	case 30:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:532
+//line mysql_sql.y:590
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 24:
+	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:538
+//line mysql_sql.y:596
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 25:
+	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:542
+//line mysql_sql.y:600
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 26:
+	case 33:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:548
+//line mysql_sql.y:606
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4563,10 +4941,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 27:
+	case 34:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:555
+//line mysql_sql.y:613
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4574,58 +4952,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 28:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:564
+//line mysql_sql.y:622
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 29:
+	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:568
+//line mysql_sql.y:626
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 30:
+	case 37:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:572
+//line mysql_sql.y:630
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 31:
+	case 38:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:577
+//line mysql_sql.y:635
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 32:
+	case 39:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:581
+//line mysql_sql.y:639
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 40:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:585
+//line mysql_sql.y:643
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:591
+//line mysql_sql.y:649
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4635,10 +5013,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:600
+//line mysql_sql.y:658
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4648,42 +5026,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:611
+//line mysql_sql.y:669
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:615
+//line mysql_sql.y:673
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:621
+//line mysql_sql.y:679
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:625
+//line mysql_sql.y:683
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:631
+//line mysql_sql.y:689
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -4706,10 +5084,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:655
+//line mysql_sql.y:713
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var r string
@@ -4728,34 +5106,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 49:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:674
+//line mysql_sql.y:732
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:678
+//line mysql_sql.y:736
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 51:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:683
+//line mysql_sql.y:741
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:687
+//line mysql_sql.y:745
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -4763,42 +5141,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 53:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:695
+//line mysql_sql.y:753
 		{
 			yyVAL.str = ""
 		}
-	case 47:
+	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:699
+//line mysql_sql.y:757
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 48:
+	case 55:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:704
+//line mysql_sql.y:762
 		{
 			yyVAL.str = "\n"
 		}
-	case 49:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:708
+//line mysql_sql.y:766
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 50:
+	case 57:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:713
+//line mysql_sql.y:771
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 58:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:717
+//line mysql_sql.y:775
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -4821,36 +5199,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:741
+//line mysql_sql.y:799
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 60:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:745
+//line mysql_sql.y:803
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 61:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:751
+//line mysql_sql.y:809
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 62:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:757
+//line mysql_sql.y:815
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -4869,10 +5247,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:775
+//line mysql_sql.y:833
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -4890,10 +5268,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 64:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:792
+//line mysql_sql.y:850
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -4911,50 +5289,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 66:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:815
+//line mysql_sql.y:873
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:819
+//line mysql_sql.y:877
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:823
+//line mysql_sql.y:881
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 69:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:828
+//line mysql_sql.y:886
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:832
+//line mysql_sql.y:890
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 71:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:838
+//line mysql_sql.y:896
 		{
 			yyLOCAL = &tree.Grant{
 				Privileges:  yyDollar[2].privilegesUnion(),
@@ -4965,10 +5343,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 72:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:848
+//line mysql_sql.y:906
 		{
 			yyLOCAL = &tree.Grant{
 				IsGrantRole:      true,
@@ -4977,10 +5355,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 73:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:856
+//line mysql_sql.y:914
 		{
 			yyLOCAL = &tree.Grant{
 				IsProxy:     true,
@@ -4990,26 +5368,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 74:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:866
+//line mysql_sql.y:924
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 75:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:870
+//line mysql_sql.y:928
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 76:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:880
+//line mysql_sql.y:938
 		{
 			yyLOCAL = &tree.Revoke{
 				Privileges: yyDollar[2].privilegesUnion(),
@@ -5020,10 +5398,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 77:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:890
+//line mysql_sql.y:948
 		{
 			yyLOCAL = &tree.Revoke{
 				IsRevokeRole:      true,
@@ -5032,30 +5410,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:900
+//line mysql_sql.y:958
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:906
+//line mysql_sql.y:964
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:912
+//line mysql_sql.y:970
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -5063,10 +5441,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 81:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:919
+//line mysql_sql.y:977
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5075,10 +5453,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:927
+//line mysql_sql.y:985
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5086,58 +5464,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 83:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:935
+//line mysql_sql.y:993
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:939
+//line mysql_sql.y:997
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:943
+//line mysql_sql.y:1001
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:947
+//line mysql_sql.y:1005
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:953
+//line mysql_sql.y:1011
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:957
+//line mysql_sql.y:1015
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:963
+//line mysql_sql.y:1021
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5145,10 +5523,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 90:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:970
+//line mysql_sql.y:1028
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5156,418 +5534,418 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:979
+//line mysql_sql.y:1037
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 92:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:983
+//line mysql_sql.y:1041
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:989
+//line mysql_sql.y:1047
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:993
+//line mysql_sql.y:1051
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:997
+//line mysql_sql.y:1055
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1001
+//line mysql_sql.y:1059
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1005
+//line mysql_sql.y:1063
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1009
+//line mysql_sql.y:1067
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1013
+//line mysql_sql.y:1071
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1017
+//line mysql_sql.y:1075
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1021
+//line mysql_sql.y:1079
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1025
+//line mysql_sql.y:1083
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1029
+//line mysql_sql.y:1087
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1033
+//line mysql_sql.y:1091
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1037
+//line mysql_sql.y:1095
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1041
+//line mysql_sql.y:1099
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 107:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1045
+//line mysql_sql.y:1103
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1049
+//line mysql_sql.y:1107
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 109:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1053
+//line mysql_sql.y:1111
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1057
+//line mysql_sql.y:1115
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1061
+//line mysql_sql.y:1119
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1065
+//line mysql_sql.y:1123
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1069
+//line mysql_sql.y:1127
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1073
+//line mysql_sql.y:1131
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1077
+//line mysql_sql.y:1135
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 116:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1081
+//line mysql_sql.y:1139
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1085
+//line mysql_sql.y:1143
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1089
+//line mysql_sql.y:1147
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1093
+//line mysql_sql.y:1151
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1097
+//line mysql_sql.y:1155
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1101
+//line mysql_sql.y:1159
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1105
+//line mysql_sql.y:1163
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1109
+//line mysql_sql.y:1167
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1113
+//line mysql_sql.y:1171
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1117
+//line mysql_sql.y:1175
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1129
+//line mysql_sql.y:1187
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 131:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1135
+//line mysql_sql.y:1193
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1143
+//line mysql_sql.y:1201
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1147
+//line mysql_sql.y:1205
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1151
+//line mysql_sql.y:1209
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1155
+//line mysql_sql.y:1213
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1159
+//line mysql_sql.y:1217
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1165
+//line mysql_sql.y:1223
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1169
+//line mysql_sql.y:1227
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1173
+//line mysql_sql.y:1231
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 140:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1179
+//line mysql_sql.y:1237
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 141:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1185
+//line mysql_sql.y:1243
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 142:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1189
+//line mysql_sql.y:1247
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 144:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1196
+//line mysql_sql.y:1254
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 138:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1202
+//line mysql_sql.y:1260
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1206
+//line mysql_sql.y:1264
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1212
+//line mysql_sql.y:1270
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5576,10 +5954,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1220
+//line mysql_sql.y:1278
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5589,10 +5967,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 149:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1229
+//line mysql_sql.y:1287
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5601,10 +5979,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 150:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1237
+//line mysql_sql.y:1295
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5613,10 +5991,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1245
+//line mysql_sql.y:1303
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -5640,10 +6018,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1268
+//line mysql_sql.y:1326
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5651,10 +6029,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 153:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1275
+//line mysql_sql.y:1333
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5662,10 +6040,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 154:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1282
+//line mysql_sql.y:1340
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     yyDollar[1].str,
@@ -5674,10 +6052,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 155:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1290
+//line mysql_sql.y:1348
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5685,10 +6063,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1297
+//line mysql_sql.y:1355
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5696,10 +6074,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1304
+//line mysql_sql.y:1362
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5707,198 +6085,198 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1317
+//line mysql_sql.y:1375
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1321
+//line mysql_sql.y:1379
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1325
+//line mysql_sql.y:1383
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1331
+//line mysql_sql.y:1389
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 157:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1335
+//line mysql_sql.y:1393
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 159:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1342
+//line mysql_sql.y:1400
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
-	case 163:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1353
+//line mysql_sql.y:1411
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1359
+//line mysql_sql.y:1417
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 172:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1364
+//line mysql_sql.y:1422
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 173:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1368
+//line mysql_sql.y:1426
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1372
+//line mysql_sql.y:1430
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 175:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1376
+//line mysql_sql.y:1434
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1380
+//line mysql_sql.y:1438
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 177:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1384
+//line mysql_sql.y:1442
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1388
+//line mysql_sql.y:1446
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1392
+//line mysql_sql.y:1450
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1398
+//line mysql_sql.y:1456
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1402
+//line mysql_sql.y:1460
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1406
+//line mysql_sql.y:1464
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 183:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1410
+//line mysql_sql.y:1468
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1415
+//line mysql_sql.y:1473
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 185:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1420
+//line mysql_sql.y:1478
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1426
+//line mysql_sql.y:1484
 		{
 			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1430
+//line mysql_sql.y:1488
 		{
 			yyLOCAL = &tree.Use{}
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 188:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1436
+//line mysql_sql.y:1494
 		{
 			yyLOCAL = &tree.Update{
 				Table:   yyDollar[2].tableExprUnion(),
@@ -5909,123 +6287,223 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1449
+//line mysql_sql.y:1507
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1453
+//line mysql_sql.y:1511
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1459
+//line mysql_sql.y:1517
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1468
+//line mysql_sql.y:1526
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1474
+//line mysql_sql.y:1532
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 197:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1479
+//line mysql_sql.y:1537
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 198:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1484
+//line mysql_sql.y:1542
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 199:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1488
+//line mysql_sql.y:1546
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1492
+//line mysql_sql.y:1550
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "row")
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 201:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1496
+//line mysql_sql.y:1554
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[5].statementUnion(), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1500
+//line mysql_sql.y:1558
 		{
 			yyLOCAL = tree.NewExplainAnalyze(yyDollar[3].statementUnion(), "")
 		}
 		yyVAL.union = yyLOCAL
-	case 199:
+	case 206:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1511
+//line mysql_sql.y:1569
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 209:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1521
+//line mysql_sql.y:1580
 		{
-			yyLOCAL = &tree.AlterUser{
-				IfExists:   yyDollar[3].boolValUnion(),
-				IsUserFunc: false,
-				Users:      yyDollar[4].usersUnion(),
-				TlsOpts:    yyDollar[5].tlsOptionsUnion(),
+			yyLOCAL = tree.NewAlterTable(*yyDollar[3].tableNameUnion(), yyDollar[4].alterTableOptionsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 210:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:1586
+		{
+			yyLOCAL = tree.AlterTableOptions{yyDollar[1].alterTableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 211:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:1590
+		{
+			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 212:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1596
+		{
+			yyLOCAL = &tree.AlterTableAddColumn{Column: yyDollar[3].columnTableDefUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 213:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1600
+		{
+			yyLOCAL = &tree.AlterTableDropColumn{Name: tree.Identifier(yyDollar[3].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 214:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1604
+		{
+			yyLOCAL = &tree.AlterTableRenameColumn{Name: tree.Identifier(yyDollar[3].str), NewName: tree.Identifier(yyDollar[5].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 215:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1608
+		{
+			yyLOCAL = &tree.AlterTableAlterColumn{Name: tree.Identifier(yyDollar[3].str), Default: yyDollar[6].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 216:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1612
+		{
+			yyLOCAL = &tree.AlterTableAlterColumn{Name: tree.Identifier(yyDollar[3].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 217:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1616
+		{
+			yyLOCAL = &tree.AlterTableRename{Name: *yyDollar[3].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 218:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:1621
+		{
+			yyVAL.str = ""
+		}
+	case 220:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:1627
+		{
+			yyVAL.str = ""
+		}
+	case 223:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1635
+		{
+			yyLOCAL = tree.NewAlterTable(*yyDollar[3].tableNameUnion(), tree.AlterTableOptions{&tree.AlterTableRename{Name: *yyDollar[5].tableNameUnion()}})
+		}
+		yyVAL.union = yyLOCAL
+	case 224:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1641
+		{
+			yyLOCAL = tree.NewOptimizeTable(yyDollar[3].tableNamesUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 225:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1647
+		{
+			yyLOCAL = &tree.AlterUser{
+				IfExists:   yyDollar[3].boolValUnion(),
+				IsUserFunc: false,
+				Users:      yyDollar[4].usersUnion(),
+				TlsOpts:    yyDollar[5].tlsOptionsUnion(),
 				ResOpts:    yyDollar[6].resourceOptionsUnion(),
 				MiscOpts:   yyDollar[7].userMiscOptionsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 226:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1532
+//line mysql_sql.y:1658
 		{
 			auth := &tree.User{
 				AuthString: yyDollar[9].str,
@@ -6038,118 +6516,134 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 227:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1545
+//line mysql_sql.y:1671
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1549
+//line mysql_sql.y:1675
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 229:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1554
+//line mysql_sql.y:1680
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 206:
+	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1558
+//line mysql_sql.y:1684
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 207:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1564
+//line mysql_sql.y:1690
 		{
 			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 208:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1568
+//line mysql_sql.y:1694
 		{
 			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 209:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1574
+//line mysql_sql.y:1700
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1578
+//line mysql_sql.y:1704
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1582
+//line mysql_sql.y:1708
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 236:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1586
+//line mysql_sql.y:1712
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 237:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1590
+//line mysql_sql.y:1716
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 238:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1594
+//line mysql_sql.y:1720
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 239:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1600
+//line mysql_sql.y:1726
 		{
 			yyVAL.item = nil
 		}
-	case 216:
+	case 240:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1605
+//line mysql_sql.y:1731
 		{
 			yyVAL.item = nil
 		}
-	case 228:
+	case 253:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1753
+		{
+			yyLOCAL = &tree.ShowGrants{}
+		}
+		yyVAL.union = yyLOCAL
+	case 254:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1757
+		{
+			yyLOCAL = &tree.ShowGrants{Username: yyDollar[4].usernameRecordUnion().Username, Hostname: yyDollar[4].usernameRecordUnion().Hostname}
+		}
+		yyVAL.union = yyLOCAL
+	case 255:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1626
+//line mysql_sql.y:1763
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -6157,10 +6651,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 232:
+	case 259:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1640
+//line mysql_sql.y:1777
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -6169,10 +6663,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 233:
+	case 260:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1650
+//line mysql_sql.y:1787
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -6181,58 +6675,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 234:
+	case 261:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1659
+//line mysql_sql.y:1796
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1663
+//line mysql_sql.y:1800
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1667
+//line mysql_sql.y:1804
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1673
+//line mysql_sql.y:1810
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 238:
+	case 265:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1679
+//line mysql_sql.y:1816
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 239:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1685
+//line mysql_sql.y:1822
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 240:
+	case 267:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1691
+//line mysql_sql.y:1828
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -6243,10 +6737,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 268:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1701
+//line mysql_sql.y:1838
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -6257,18 +6751,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1713
+//line mysql_sql.y:1850
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 270:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1719
+//line mysql_sql.y:1856
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -6281,10 +6775,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 271:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1731
+//line mysql_sql.y:1868
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -6297,118 +6791,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 272:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1744
+//line mysql_sql.y:1881
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 273:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1748
+//line mysql_sql.y:1885
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 274:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1753
+//line mysql_sql.y:1890
 		{
 			yyVAL.str = ""
 		}
-	case 248:
+	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:1757
+//line mysql_sql.y:1894
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 249:
+	case 276:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1763
+//line mysql_sql.y:1900
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 281:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1776
+//line mysql_sql.y:1913
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 282:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1780
+//line mysql_sql.y:1917
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1786
+//line mysql_sql.y:1923
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 284:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1790
+//line mysql_sql.y:1927
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 285:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1796
+//line mysql_sql.y:1933
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 259:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1800
+//line mysql_sql.y:1937
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1809
+//line mysql_sql.y:1946
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1813
+//line mysql_sql.y:1950
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 290:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1817
+//line mysql_sql.y:1954
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 297:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1833
+//line mysql_sql.y:1970
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6416,10 +6910,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 298:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1842
+//line mysql_sql.y:1979
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6427,10 +6921,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 299:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1851
+//line mysql_sql.y:1988
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -6439,26 +6933,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 300:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1861
+//line mysql_sql.y:1998
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 301:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1867
+//line mysql_sql.y:2004
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 302:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1873
+//line mysql_sql.y:2010
 		{
 			yyLOCAL = &tree.Delete{
 				Table:   yyDollar[3].tableExprUnion(),
@@ -6468,10 +6962,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 276:
+	case 303:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1888
+//line mysql_sql.y:2025
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -6479,10 +6973,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 277:
+	case 304:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1897
+//line mysql_sql.y:2034
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6490,20 +6984,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 278:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1904
+//line mysql_sql.y:2041
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: tree.NewSelect(yyDollar[1].selectUnion(), nil, nil),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 306:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1910
+//line mysql_sql.y:2047
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6512,10 +7006,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 307:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1918
+//line mysql_sql.y:2055
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6523,10 +7017,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 308:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1925
+//line mysql_sql.y:2062
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -6534,10 +7028,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1932
+//line mysql_sql.y:2069
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -6556,34 +7050,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 310:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:1951
+//line mysql_sql.y:2088
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 311:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:1955
+//line mysql_sql.y:2092
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:1959
+//line mysql_sql.y:2096
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:1965
+//line mysql_sql.y:2102
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -6591,155 +7085,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 314:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:1974
+//line mysql_sql.y:2111
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 315:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:1978
+//line mysql_sql.y:2115
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1984
+//line mysql_sql.y:2121
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 290:
+	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1988
+//line mysql_sql.y:2125
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 291:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:1994
+//line mysql_sql.y:2131
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 319:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:1998
+//line mysql_sql.y:2135
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 320:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2004
+//line mysql_sql.y:2141
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 321:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2009
+//line mysql_sql.y:2146
 		{
 		}
-	case 296:
+	case 323:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2013
+//line mysql_sql.y:2150
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2020
+//line mysql_sql.y:2157
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 326:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2024
+//line mysql_sql.y:2161
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2031
+//line mysql_sql.y:2168
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 329:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2036
+//line mysql_sql.y:2173
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 330:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2040
+//line mysql_sql.y:2177
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2046
+//line mysql_sql.y:2183
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2050
+//line mysql_sql.y:2187
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 306:
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2056
+//line mysql_sql.y:2193
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2060
+//line mysql_sql.y:2197
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 335:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2065
+//line mysql_sql.y:2202
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
+	case 336:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2069
+//line mysql_sql.y:2206
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -6752,10 +7246,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 337:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2082
+//line mysql_sql.y:2219
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -6763,10 +7257,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 338:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2089
+//line mysql_sql.y:2226
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -6774,10 +7268,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 339:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2096
+//line mysql_sql.y:2233
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -6796,10 +7290,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 340:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2114
+//line mysql_sql.y:2251
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -6818,38 +7312,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2133
+//line mysql_sql.y:2270
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2139
+//line mysql_sql.y:2276
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 343:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2146
+//line mysql_sql.y:2283
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 344:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2150
+//line mysql_sql.y:2287
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -6862,227 +7356,227 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 345:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2163
+//line mysql_sql.y:2300
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2167
+//line mysql_sql.y:2304
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 347:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2172
+//line mysql_sql.y:2309
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 348:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2176
+//line mysql_sql.y:2313
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 349:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2183
+//line mysql_sql.y:2320
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 350:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2188
+//line mysql_sql.y:2325
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 352:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2195
+//line mysql_sql.y:2332
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), Ep: yyDollar[2].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 353:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2201
+//line mysql_sql.y:2338
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2205
+//line mysql_sql.y:2342
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 355:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2209
+//line mysql_sql.y:2346
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2214
+//line mysql_sql.y:2351
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2218
+//line mysql_sql.y:2355
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2224
+//line mysql_sql.y:2361
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 359:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2228
+//line mysql_sql.y:2365
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 360:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2232
+//line mysql_sql.y:2369
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 361:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2237
+//line mysql_sql.y:2374
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2241
+//line mysql_sql.y:2378
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2247
+//line mysql_sql.y:2384
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2253
+//line mysql_sql.y:2390
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2257
+//line mysql_sql.y:2394
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 366:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2263
+//line mysql_sql.y:2400
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 367:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2268
+//line mysql_sql.y:2405
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2272
+//line mysql_sql.y:2409
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2276
+//line mysql_sql.y:2413
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2283
+//line mysql_sql.y:2420
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2287
+//line mysql_sql.y:2424
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2293
+//line mysql_sql.y:2430
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2297
+//line mysql_sql.y:2434
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7093,10 +7587,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2307
+//line mysql_sql.y:2444
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7107,10 +7601,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2317
+//line mysql_sql.y:2454
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7121,10 +7615,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 376:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2327
+//line mysql_sql.y:2464
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7135,10 +7629,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2339
+//line mysql_sql.y:2476
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7147,10 +7641,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 378:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2347
+//line mysql_sql.y:2484
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7159,10 +7653,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 379:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2355
+//line mysql_sql.y:2492
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7171,10 +7665,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 380:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2500
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 381:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2508
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 382:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2516
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 383:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2524
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 384:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2532
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 385:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2540
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 386:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2365
+//line mysql_sql.y:2550
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -7186,130 +7752,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 387:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2377
+//line mysql_sql.y:2562
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 388:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2381
+//line mysql_sql.y:2566
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2385
+//line mysql_sql.y:2570
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 392:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2394
+//line mysql_sql.y:2579
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 393:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2398
+//line mysql_sql.y:2583
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 394:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2403
+//line mysql_sql.y:2588
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2407
+//line mysql_sql.y:2592
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 396:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2412
+//line mysql_sql.y:2597
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 397:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2416
+//line mysql_sql.y:2601
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2422
+//line mysql_sql.y:2607
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2426
+//line mysql_sql.y:2611
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2432
+//line mysql_sql.y:2617
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 401:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2436
+//line mysql_sql.y:2621
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2440
+//line mysql_sql.y:2625
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 403:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2444
+//line mysql_sql.y:2629
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 404:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2450
+//line mysql_sql.y:2635
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier("dual"), prefix)
@@ -7318,44 +7884,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2458
+//line mysql_sql.y:2643
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 406:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2464
+//line mysql_sql.y:2649
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2472
+//line mysql_sql.y:2657
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2476
+//line mysql_sql.y:2661
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 411:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2486
+//line mysql_sql.y:2671
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7365,10 +7931,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 412:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2495
+//line mysql_sql.y:2680
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7378,10 +7944,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 413:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2505
+//line mysql_sql.y:2690
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7391,10 +7957,23 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 414:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:2700
+		{
+			yyLOCAL = &tree.JoinTableExpr{
+				Left:     yyDollar[1].tableExprUnion(),
+				JoinType: yyDollar[2].str,
+				Right:    yyDollar[3].tableExprUnion(),
+				Cond:     yyDollar[4].joinCondUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2514
+//line mysql_sql.y:2709
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7403,15 +7982,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 416:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2524
+//line mysql_sql.y:2719
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 383:
+	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2528
+//line mysql_sql.y:2723
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -7419,130 +7998,142 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 384:
+	case 418:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2538
+//line mysql_sql.y:2733
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 385:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2542
+//line mysql_sql.y:2737
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 386:
+	case 420:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2546
+//line mysql_sql.y:2741
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 387:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2550
+//line mysql_sql.y:2745
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 388:
+	case 422:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:2751
+		{
+			yyVAL.str = tree.JOIN_TYPE_FULL
+		}
+	case 423:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line mysql_sql.y:2755
+		{
+			yyVAL.str = tree.JOIN_TYPE_FULL
+		}
+	case 424:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2556
+//line mysql_sql.y:2761
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 425:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2560
+//line mysql_sql.y:2765
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2566
+//line mysql_sql.y:2771
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 391:
+	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2572
+//line mysql_sql.y:2777
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 392:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2576
+//line mysql_sql.y:2781
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 393:
+	case 429:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2580
+//line mysql_sql.y:2785
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 394:
+	case 430:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2586
+//line mysql_sql.y:2791
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2590
+//line mysql_sql.y:2795
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2596
+//line mysql_sql.y:2801
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 433:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2600
+//line mysql_sql.y:2805
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 434:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2606
+//line mysql_sql.y:2811
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2610
+//line mysql_sql.y:2815
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2616
+//line mysql_sql.y:2821
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2620
+//line mysql_sql.y:2825
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -7552,28 +8143,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 438:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:2632
+//line mysql_sql.y:2837
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 439:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2637
+//line mysql_sql.y:2842
 		{
 		}
-	case 404:
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2638
+//line mysql_sql.y:2843
 		{
 		}
-	case 405:
+	case 441:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:2642
+//line mysql_sql.y:2847
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -7583,46 +8174,76 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2654
+	case 442:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.AliasedTableExpr
+//line mysql_sql.y:2856
 		{
-			yyVAL.str = ""
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableNameUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[5].str),
+				},
+				AsOf: yyDollar[4].asOfUnion(),
+			}
 		}
-	case 407:
+		yyVAL.union = yyLOCAL
+	case 443:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.AsOfClause
+//line mysql_sql.y:2870
+		{
+			yyLOCAL = tree.NewAsOfClause(tree.AS_OF_TIMESTAMP, yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 444:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.AsOfClause
+//line mysql_sql.y:2874
+		{
+			yyLOCAL = tree.NewAsOfClause(tree.AS_OF_EPOCH, yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 445:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:2881
+		{
+			yyVAL.str = ""
+		}
+	case 446:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2658
+//line mysql_sql.y:2885
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 408:
+	case 447:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2662
+//line mysql_sql.y:2889
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 411:
+	case 450:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2671
+//line mysql_sql.y:2898
 		{
 			yyVAL.str = ""
 		}
-	case 412:
+	case 451:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2675
+//line mysql_sql.y:2902
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 413:
+	case 452:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2679
+//line mysql_sql.y:2906
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 427:
+	case 466:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2708
+//line mysql_sql.y:2935
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -7632,189 +8253,189 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 467:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2718
+//line mysql_sql.y:2945
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 468:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2722
+//line mysql_sql.y:2949
 		{
 			yyLOCAL = yyDollar[2].resourceOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2728
+//line mysql_sql.y:2955
 		{
 			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 470:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2732
+//line mysql_sql.y:2959
 		{
 			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 471:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2738
+//line mysql_sql.y:2965
 		{
 			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 472:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2742
+//line mysql_sql.y:2969
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 473:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2746
+//line mysql_sql.y:2973
 		{
 			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 474:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2750
+//line mysql_sql.y:2977
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 475:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2756
+//line mysql_sql.y:2983
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 477:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2763
+//line mysql_sql.y:2990
 		{
 			t := &tree.TlsOptionNone{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 478:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2768
+//line mysql_sql.y:2995
 		{
 			t := &tree.TlsOptionSSL{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 479:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2773
+//line mysql_sql.y:3000
 		{
 			t := &tree.TlsOptionX509{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2778
+//line mysql_sql.y:3005
 		{
 			yyLOCAL = yyDollar[2].tlsOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2784
+//line mysql_sql.y:3011
 		{
 			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2788
+//line mysql_sql.y:3015
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 483:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2792
+//line mysql_sql.y:3019
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2798
+//line mysql_sql.y:3025
 		{
 			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2802
+//line mysql_sql.y:3029
 		{
 			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2806
+//line mysql_sql.y:3033
 		{
 			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 487:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2810
+//line mysql_sql.y:3037
 		{
 			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 488:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2816
+//line mysql_sql.y:3043
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 489:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2820
+//line mysql_sql.y:3047
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2826
+//line mysql_sql.y:3053
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -7826,42 +8447,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2839
+//line mysql_sql.y:3066
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2843
+//line mysql_sql.y:3070
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2847
+//line mysql_sql.y:3074
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 494:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2852
+//line mysql_sql.y:3079
 		{
 			yyLOCAL = &tree.AuthRecord{}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2856
+//line mysql_sql.y:3083
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthString: yyDollar[3].str,
@@ -7869,20 +8490,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 496:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2863
+//line mysql_sql.y:3090
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 497:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2869
+//line mysql_sql.y:3096
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -7891,10 +8512,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 498:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2877
+//line mysql_sql.y:3104
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -7902,20 +8523,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 499:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2884
+//line mysql_sql.y:3111
 		{
 			yyLOCAL = &tree.AuthRecord{
 				HashString: yyDollar[4].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 502:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2896
+//line mysql_sql.y:3123
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -7923,82 +8544,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 503:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2905
+//line mysql_sql.y:3132
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 504:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2909
+//line mysql_sql.y:3136
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2915
+//line mysql_sql.y:3142
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2919
+//line mysql_sql.y:3146
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2923
+//line mysql_sql.y:3150
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 510:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2932
+//line mysql_sql.y:3159
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2936
+//line mysql_sql.y:3163
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2940
+//line mysql_sql.y:3167
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:2944
+//line mysql_sql.y:3171
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 514:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2950
+//line mysql_sql.y:3177
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -8017,18 +8638,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 515:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2969
+//line mysql_sql.y:3196
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2973
+//line mysql_sql.y:3200
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -8049,123 +8670,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 517:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2995
+//line mysql_sql.y:3222
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:2999
+//line mysql_sql.y:3226
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 519:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3003
+//line mysql_sql.y:3230
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 520:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3007
+//line mysql_sql.y:3234
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3011
+//line mysql_sql.y:3238
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 522:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3017
+//line mysql_sql.y:3244
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 523:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3021
+//line mysql_sql.y:3248
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 524:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3027
+//line mysql_sql.y:3254
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 525:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3032
+//line mysql_sql.y:3259
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 526:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3037
+//line mysql_sql.y:3264
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3041
+//line mysql_sql.y:3268
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3045
+//line mysql_sql.y:3272
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3049
+//line mysql_sql.y:3276
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3053
+//line mysql_sql.y:3280
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 531:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3059
+//line mysql_sql.y:3286
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -8174,98 +8795,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 534:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3073
+//line mysql_sql.y:3300
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 535:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3077
+//line mysql_sql.y:3304
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 536:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3082
+//line mysql_sql.y:3309
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 537:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3086
+//line mysql_sql.y:3313
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 538:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3092
+//line mysql_sql.y:3319
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 539:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3096
+//line mysql_sql.y:3323
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 540:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3102
+//line mysql_sql.y:3329
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 541:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3106
+//line mysql_sql.y:3333
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 542:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3110
+//line mysql_sql.y:3337
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 543:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3115
+//line mysql_sql.y:3342
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 544:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3119
+//line mysql_sql.y:3346
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 545:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3125
+//line mysql_sql.y:3352
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -8277,34 +8898,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 546:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3137
+//line mysql_sql.y:3364
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3141
+//line mysql_sql.y:3368
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 548:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3146
+//line mysql_sql.y:3373
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 549:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3150
+//line mysql_sql.y:3377
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -8314,18 +8935,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 550:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3160
+//line mysql_sql.y:3387
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 551:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3164
+//line mysql_sql.y:3391
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -8334,42 +8955,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 552:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3173
+//line mysql_sql.y:3400
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 553:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3177
+//line mysql_sql.y:3404
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3183
+//line mysql_sql.y:3410
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 555:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3187
+//line mysql_sql.y:3414
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 556:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3193
+//line mysql_sql.y:3420
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8379,10 +9000,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 557:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3202
+//line mysql_sql.y:3429
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8392,42 +9013,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 558:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3212
+//line mysql_sql.y:3439
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 559:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3216
+//line mysql_sql.y:3443
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3222
+//line mysql_sql.y:3449
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 561:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3226
+//line mysql_sql.y:3453
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 562:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3232
+//line mysql_sql.y:3459
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8435,10 +9056,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3239
+//line mysql_sql.y:3466
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8446,50 +9067,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3248
+//line mysql_sql.y:3475
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3252
+//line mysql_sql.y:3479
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 566:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3257
+//line mysql_sql.y:3484
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 567:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3261
+//line mysql_sql.y:3488
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 568:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3266
+//line mysql_sql.y:3493
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 569:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3270
+//line mysql_sql.y:3497
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8499,18 +9120,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 570:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3280
+//line mysql_sql.y:3507
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3284
+//line mysql_sql.y:3511
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8520,10 +9141,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 572:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3295
+//line mysql_sql.y:3522
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -8532,10 +9153,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 573:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3303
+//line mysql_sql.y:3530
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -8544,10 +9165,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 574:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3311
+//line mysql_sql.y:3538
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -8556,10 +9177,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 575:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3319
+//line mysql_sql.y:3546
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -8568,10 +9189,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 577:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3330
+//line mysql_sql.y:3557
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -8582,10 +9203,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 578:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3340
+//line mysql_sql.y:3567
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -8595,478 +9216,478 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 579:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3350
+//line mysql_sql.y:3577
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3354
+//line mysql_sql.y:3581
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 581:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3359
+//line mysql_sql.y:3586
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3363
+//line mysql_sql.y:3590
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 583:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3368
+//line mysql_sql.y:3595
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3372
+//line mysql_sql.y:3599
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3378
+//line mysql_sql.y:3605
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3382
+//line mysql_sql.y:3609
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 587:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3386
+//line mysql_sql.y:3613
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3392
+//line mysql_sql.y:3619
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 589:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3396
+//line mysql_sql.y:3623
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 590:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3400
+//line mysql_sql.y:3627
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 591:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3404
+//line mysql_sql.y:3631
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3408
+//line mysql_sql.y:3635
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 593:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3412
+//line mysql_sql.y:3639
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 594:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3416
+//line mysql_sql.y:3643
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3420
+//line mysql_sql.y:3647
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 596:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3424
+//line mysql_sql.y:3651
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 597:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3428
+//line mysql_sql.y:3655
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3432
+//line mysql_sql.y:3659
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3436
+//line mysql_sql.y:3663
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 600:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3440
+//line mysql_sql.y:3667
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 601:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3444
+//line mysql_sql.y:3671
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3448
+//line mysql_sql.y:3675
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3452
+//line mysql_sql.y:3679
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3456
+//line mysql_sql.y:3683
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3460
+//line mysql_sql.y:3687
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3464
+//line mysql_sql.y:3691
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3468
+//line mysql_sql.y:3695
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3472
+//line mysql_sql.y:3699
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3476
+//line mysql_sql.y:3703
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3480
+//line mysql_sql.y:3707
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3484
+//line mysql_sql.y:3711
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3488
+//line mysql_sql.y:3715
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3492
+//line mysql_sql.y:3719
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 614:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3496
+//line mysql_sql.y:3723
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 615:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3500
+//line mysql_sql.y:3727
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 616:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3504
+//line mysql_sql.y:3731
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3511
+//line mysql_sql.y:3738
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3515
+//line mysql_sql.y:3742
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:3521
+//line mysql_sql.y:3748
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 620:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3526
+//line mysql_sql.y:3753
 		{
 			yyVAL.str = ""
 		}
-	case 582:
+	case 621:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3530
+//line mysql_sql.y:3757
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 583:
+	case 622:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3534
+//line mysql_sql.y:3761
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 584:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3540
+//line mysql_sql.y:3767
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3544
+//line mysql_sql.y:3771
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3548
+//line mysql_sql.y:3775
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3552
+//line mysql_sql.y:3779
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3556
+//line mysql_sql.y:3783
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3560
+//line mysql_sql.y:3787
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3576
+//line mysql_sql.y:3803
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 636:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3580
+//line mysql_sql.y:3807
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3589
+//line mysql_sql.y:3816
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3594
+//line mysql_sql.y:3821
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 639:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3600
+//line mysql_sql.y:3827
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3607
+//line mysql_sql.y:3834
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3611
+//line mysql_sql.y:3838
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3617
+//line mysql_sql.y:3844
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 644:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3621
+//line mysql_sql.y:3848
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3627
+//line mysql_sql.y:3854
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -9077,18 +9698,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3637
+//line mysql_sql.y:3864
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 647:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3643
+//line mysql_sql.y:3870
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9098,10 +9719,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 648:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3652
+//line mysql_sql.y:3879
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9111,10 +9732,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 649:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3661
+//line mysql_sql.y:3888
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -9138,10 +9759,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 650:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3684
+//line mysql_sql.y:3911
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9151,10 +9772,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 651:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3693
+//line mysql_sql.y:3920
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -9165,10 +9786,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 652:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3703
+//line mysql_sql.y:3930
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -9176,298 +9797,298 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 653:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3711
+//line mysql_sql.y:3938
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 655:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3717
+//line mysql_sql.y:3944
 		{
 			yyVAL.str = ""
 		}
-	case 617:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3721
+//line mysql_sql.y:3948
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 620:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3731
+//line mysql_sql.y:3958
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 660:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3737
+//line mysql_sql.y:3964
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 661:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3743
+//line mysql_sql.y:3970
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 667:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3757
+//line mysql_sql.y:3984
 		{
 			yyVAL.str = ""
 		}
-	case 630:
+	case 669:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:3764
+//line mysql_sql.y:3991
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3770
+//line mysql_sql.y:3997
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 671:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3774
+//line mysql_sql.y:4001
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 672:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3778
+//line mysql_sql.y:4005
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3789
+//line mysql_sql.y:4016
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3793
+//line mysql_sql.y:4020
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 678:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3797
+//line mysql_sql.y:4024
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 679:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3802
+//line mysql_sql.y:4029
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3806
+//line mysql_sql.y:4033
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3812
+//line mysql_sql.y:4039
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 682:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3816
+//line mysql_sql.y:4043
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3822
+//line mysql_sql.y:4049
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 684:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3826
+//line mysql_sql.y:4053
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 685:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3830
+//line mysql_sql.y:4057
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3834
+//line mysql_sql.y:4061
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3838
+//line mysql_sql.y:4065
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3842
+//line mysql_sql.y:4069
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false))
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 689:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3846
+//line mysql_sql.y:4073
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 690:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3850
+//line mysql_sql.y:4077
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 691:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3854
+//line mysql_sql.y:4081
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 692:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3858
+//line mysql_sql.y:4085
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3862
+//line mysql_sql.y:4089
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 694:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3866
+//line mysql_sql.y:4093
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 695:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3870
+//line mysql_sql.y:4097
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3880
+//line mysql_sql.y:4107
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3884
+//line mysql_sql.y:4111
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 698:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3889
+//line mysql_sql.y:4116
 		{
 			yyVAL.str = ""
 		}
-	case 660:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3893
+//line mysql_sql.y:4120
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 661:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3899
+//line mysql_sql.y:4126
 		{
 			yyVAL.str = ""
 		}
-	case 662:
+	case 701:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3903
+//line mysql_sql.y:4130
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 663:
+	case 702:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:3909
+//line mysql_sql.y:4136
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -9478,10 +10099,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 703:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3920
+//line mysql_sql.y:4147
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9489,10 +10110,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3930
+//line mysql_sql.y:4157
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9500,10 +10121,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3937
+//line mysql_sql.y:4164
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9511,10 +10132,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 707:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3944
+//line mysql_sql.y:4171
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9522,10 +10143,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 708:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:3951
+//line mysql_sql.y:4178
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -9533,355 +10154,394 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 709:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3960
+//line mysql_sql.y:4187
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 710:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3966
+//line mysql_sql.y:4193
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 711:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3972
+//line mysql_sql.y:4199
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3976
+//line mysql_sql.y:4203
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 713:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3980
+//line mysql_sql.y:4207
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 714:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3984
+//line mysql_sql.y:4211
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:3988
+//line mysql_sql.y:4215
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 716:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:3993
+//line mysql_sql.y:4220
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 718:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4000
+//line mysql_sql.y:4227
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 719:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4004
+//line mysql_sql.y:4231
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 720:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4008
+//line mysql_sql.y:4235
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 721:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4013
+//line mysql_sql.y:4240
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 722:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4017
+//line mysql_sql.y:4244
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 723:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4022
+//line mysql_sql.y:4249
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 724:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4026
+//line mysql_sql.y:4253
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 731:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4042
+//line mysql_sql.y:4269
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 732:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4048
+//line mysql_sql.y:4275
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 733:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4052
+//line mysql_sql.y:4279
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4056
+//line mysql_sql.y:4283
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 735:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4060
+//line mysql_sql.y:4287
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4064
+//line mysql_sql.y:4291
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 737:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4068
+//line mysql_sql.y:4295
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 738:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4072
+//line mysql_sql.y:4299
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 739:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4076
+//line mysql_sql.y:4303
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4080
+//line mysql_sql.y:4307
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 741:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4084
+//line mysql_sql.y:4311
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4088
+//line mysql_sql.y:4315
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4092
+//line mysql_sql.y:4319
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 744:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4096
+//line mysql_sql.y:4323
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 745:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4102
+//line mysql_sql.y:4329
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 746:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4106
+//line mysql_sql.y:4333
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 747:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4110
+//line mysql_sql.y:4337
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4114
+//line mysql_sql.y:4341
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 749:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4118
+//line mysql_sql.y:4345
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 750:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4349
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			path := tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), path},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 751:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4358
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			path := tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)
+			extract := &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), path},
+			}
+			name = tree.SetUnresolvedName("json_unquote")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{extract},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 752:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4122
+//line mysql_sql.y:4372
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 753:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4126
+//line mysql_sql.y:4376
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 754:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4130
+//line mysql_sql.y:4380
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 755:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4134
+//line mysql_sql.y:4384
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 756:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4138
+//line mysql_sql.y:4388
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 757:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4142
+//line mysql_sql.y:4392
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 758:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4146
+//line mysql_sql.y:4396
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 759:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4401
+		{
+			yyLOCAL = tree.NewCaseExpr(yyDollar[2].exprUnion(), yyDollar[3].whensUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 760:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4151
+//line mysql_sql.y:4405
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 761:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4155
+//line mysql_sql.y:4409
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 762:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4159
+//line mysql_sql.y:4413
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
@@ -9891,42 +10551,114 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 763:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4168
+//line mysql_sql.y:4422
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 764:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4172
+//line mysql_sql.y:4426
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 765:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4176
+//line mysql_sql.y:4430
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 766:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4180
+//line mysql_sql.y:4434
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 767:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4439
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 768:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4443
+		{
+			yyLOCAL = yyDollar[1].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 769:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.When
+//line mysql_sql.y:4449
+		{
+			yyLOCAL = []*tree.When{yyDollar[1].whenUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 770:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []*tree.When
+//line mysql_sql.y:4453
+		{
+			yyLOCAL = append(yyDollar[1].whensUnion(), yyDollar[2].whenUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 771:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.When
+//line mysql_sql.y:4459
+		{
+			yyLOCAL = tree.NewWhen(yyDollar[2].exprUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 772:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4464
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 773:
 		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4468
+		{
+			yyLOCAL = yyDollar[2].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 775:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4187
+//line mysql_sql.y:4475
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.JsonFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_JSON),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 776:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:4487
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9940,10 +10672,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 777:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4200
+//line mysql_sql.y:4500
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9957,10 +10689,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 778:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4213
+//line mysql_sql.y:4513
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9973,10 +10705,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 779:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4225
+//line mysql_sql.y:4525
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -9991,10 +10723,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 780:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4239
+//line mysql_sql.y:4539
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10010,10 +10742,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 781:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4254
+//line mysql_sql.y:4554
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10029,10 +10761,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 782:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4269
+//line mysql_sql.y:4569
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10046,10 +10778,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 783:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4282
+//line mysql_sql.y:4582
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10064,28 +10796,29 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 784:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4297
+//line mysql_sql.y:4597
 		{
 		}
-	case 737:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 787:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4303
+//line mysql_sql.y:4603
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      tree.Exprs{yyDollar[4].exprUnion()},
+				Type:       yyDollar[3].funcTypeUnion(),
+				WindowSpec: yyDollar[6].windowSpecUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 788:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4312
+//line mysql_sql.y:4613
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10094,10 +10827,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 789:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4320
+//line mysql_sql.y:4621
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10106,10 +10839,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 790:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4328
+//line mysql_sql.y:4629
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10119,10 +10852,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 791:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4337
+//line mysql_sql.y:4638
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10132,10 +10865,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 792:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4346
+//line mysql_sql.y:4647
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10145,36 +10878,80 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 793:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4355
+//line mysql_sql.y:4656
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: yyDollar[4].exprsUnion(),
-				Type:  yyDollar[3].funcTypeUnion(),
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[4].exprsUnion(),
+				Type:       yyDollar[3].funcTypeUnion(),
+				WindowSpec: yyDollar[6].windowSpecUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 794:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4364
+//line mysql_sql.y:4666
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumVal(constant.MakeString("*"), "*", false)
 			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{es},
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      tree.Exprs{es},
+				WindowSpec: yyDollar[5].windowSpecUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 795:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4676
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      tree.Exprs{yyDollar[4].exprUnion()},
+				Type:       yyDollar[3].funcTypeUnion(),
+				WindowSpec: yyDollar[6].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 796:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4686
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      tree.Exprs{yyDollar[4].exprUnion()},
+				Type:       yyDollar[3].funcTypeUnion(),
+				WindowSpec: yyDollar[6].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 797:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4696
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      tree.Exprs{yyDollar[4].exprUnion()},
+				Type:       yyDollar[3].funcTypeUnion(),
+				WindowSpec: yyDollar[6].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 798:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4373
+//line mysql_sql.y:4706
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10184,10 +10961,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 799:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4382
+//line mysql_sql.y:4715
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10197,10 +10974,25 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 800:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4724
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:      tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:     yyDollar[4].exprsUnion(),
+				Type:      yyDollar[3].funcTypeUnion(),
+				OrderBy:   yyDollar[5].orderByUnion(),
+				Separator: yyDollar[6].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 801:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4391
+//line mysql_sql.y:4735
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10210,10 +11002,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 802:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4400
+//line mysql_sql.y:4744
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10223,61 +11015,217 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 803:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line mysql_sql.y:4754
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 804:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line mysql_sql.y:4758
+		{
+			yyLOCAL = tree.NewWindowSpec(yyDollar[3].exprsUnion(), yyDollar[4].orderByUnion(), yyDollar[5].frameClauseUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 805:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4763
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 806:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4767
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 807:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4772
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 808:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4776
+		{
+			yyLOCAL = tree.NewFrameClause(yyDollar[1].frameTypeUnion(), yyDollar[2].frameBoundUnion(), tree.NewFrameBound(tree.CURRENT_ROW, nil))
+		}
+		yyVAL.union = yyLOCAL
+	case 809:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4780
+		{
+			yyLOCAL = tree.NewFrameClause(yyDollar[1].frameTypeUnion(), yyDollar[3].frameBoundUnion(), yyDollar[5].frameBoundUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 810:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line mysql_sql.y:4786
+		{
+			yyLOCAL = tree.FRAME_ROWS
+		}
+		yyVAL.union = yyLOCAL
+	case 811:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line mysql_sql.y:4790
+		{
+			yyLOCAL = tree.FRAME_RANGE
+		}
+		yyVAL.union = yyLOCAL
+	case 812:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4796
+		{
+			yyLOCAL = tree.NewFrameBound(tree.UNBOUNDED_PRECEDING, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 813:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4800
+		{
+			yyLOCAL = tree.NewFrameBound(tree.UNBOUNDED_FOLLOWING, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 814:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4804
+		{
+			yyLOCAL = tree.NewFrameBound(tree.CURRENT_ROW, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 815:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4808
+		{
+			yyLOCAL = tree.NewFrameBound(tree.PRECEDING, yyDollar[1].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 816:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4812
+		{
+			yyLOCAL = tree.NewFrameBound(tree.FOLLOWING, yyDollar[1].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 822:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4826
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 823:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4830
+		{
+			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
+		}
+		yyVAL.union = yyLOCAL
+	case 824:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4409
+//line mysql_sql.y:4836
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[3].exprsUnion(),
+				WindowSpec: yyDollar[5].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 825:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4855
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			var es tree.Exprs = nil
+			if yyDollar[2].exprUnion() != nil {
+				es = append(es, yyDollar[2].exprUnion())
+			}
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
+				Exprs: es,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 826:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4867
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func: tree.FuncName2ResolvableFunctionReference(name),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 827:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4418
+//line mysql_sql.y:4874
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 828:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4427
+//line mysql_sql.y:4882
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 829:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4443
+//line mysql_sql.y:4890
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			typ := yyDollar[3].intervalTypeUnion()
+			str := typ.ToString()
+			unit := tree.NewNumVal(constant.MakeString(str), str, false)
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: yyDollar[3].exprsUnion(),
+				Exprs: tree.Exprs{unit, yyDollar[5].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 830:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4461
+//line mysql_sql.y:4901
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10290,10 +11238,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 831:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4473
+//line mysql_sql.y:4913
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10306,10 +11254,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 832:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4487
+//line mysql_sql.y:4927
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10318,10 +11266,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 833:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4495
+//line mysql_sql.y:4935
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10329,10 +11277,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 834:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4502
+//line mysql_sql.y:4942
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10345,10 +11293,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 835:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4514
+//line mysql_sql.y:4954
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -10357,10 +11305,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 836:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4522
+//line mysql_sql.y:4962
 		{
 			cn := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
 			es := yyDollar[3].exprsUnion()
@@ -10372,10 +11320,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 837:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4533
+//line mysql_sql.y:4973
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("date")
@@ -10385,10 +11333,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 838:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4542
+//line mysql_sql.y:4982
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("time")
@@ -10398,115 +11346,229 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 839:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4551
+//line mysql_sql.y:4991
+		{
+			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
+			name := tree.SetUnresolvedName("timestamp")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{val},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 840:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5000
+		{
+			name := tree.SetUnresolvedName("insert")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 841:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5008
+		{
+			es := tree.Exprs{yyDollar[3].exprUnion()}
+			es = append(es, yyDollar[5].exprUnion())
+			name := tree.SetUnresolvedName("mod")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: es,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 842:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5018
+		{
+			name := tree.SetUnresolvedName("password")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 843:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5026
+		{
+			name := tree.SetUnresolvedName("binary")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[2].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 844:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5034
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 845:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5042
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion(), yyDollar[7].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 846:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5050
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 847:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5058
 		{
-			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
-			name := tree.SetUnresolvedName("timestamp")
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{val},
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion(), yyDollar[7].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 848:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4560
+//line mysql_sql.y:5066
 		{
-			name := tree.SetUnresolvedName("insert")
+			name := tree.SetUnresolvedName("trim")
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: yyDollar[3].exprsUnion(),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 849:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4568
+//line mysql_sql.y:5074
 		{
-			es := tree.Exprs{yyDollar[3].exprUnion()}
-			es = append(es, yyDollar[5].exprUnion())
-			name := tree.SetUnresolvedName("mod")
+			name := tree.SetUnresolvedName("trim")
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: es,
+				Exprs: tree.Exprs{yyDollar[5].exprUnion(), yyDollar[3].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 850:
+		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4578
+//line mysql_sql.y:5082
 		{
-			name := tree.SetUnresolvedName("password")
+			name := tree.SetUnresolvedName(yyDollar[3].str)
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: yyDollar[3].exprsUnion(),
+				Exprs: tree.Exprs{yyDollar[6].exprUnion(), yyDollar[4].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 851:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4586
+//line mysql_sql.y:5090
 		{
-			name := tree.SetUnresolvedName("binary")
+			name := tree.SetUnresolvedName("locate")
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[2].exprUnion()},
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 855:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:5105
+		{
+			yyVAL.str = "trim"
+		}
+	case 856:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:5109
+		{
+			yyVAL.str = "ltrim"
+		}
+	case 857:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:5113
+		{
+			yyVAL.str = "rtrim"
+		}
+	case 858:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4595
+//line mysql_sql.y:5118
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 859:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4599
+//line mysql_sql.y:5122
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 860:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4605
+//line mysql_sql.y:5128
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 861:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4609
+//line mysql_sql.y:5132
 		{
 			yyLOCAL = yyDollar[2].numValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 868:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4622
+//line mysql_sql.y:5145
 		{
 		}
-	case 781:
+	case 869:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4624
+//line mysql_sql.y:5147
 		{
 		}
-	case 814:
+	case 902:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4664
+//line mysql_sql.y:5187
 		{
 			yyLOCAL = &tree.IntervalExpr{
 				Expr: yyDollar[2].exprUnion(),
@@ -10514,322 +11576,386 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 903:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4672
+//line mysql_sql.y:5195
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 904:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4676
+//line mysql_sql.y:5199
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 905:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4680
+//line mysql_sql.y:5203
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 906:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IntervalType
-//line mysql_sql.y:4686
+//line mysql_sql.y:5209
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_MICROSECOND
+		}
+		yyVAL.union = yyLOCAL
+	case 907:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5213
 		{
 			yyLOCAL = tree.INTERVAL_TYPE_SECOND
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 908:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5217
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_MINUTE
+		}
+		yyVAL.union = yyLOCAL
+	case 909:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5221
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_HOUR
+		}
+		yyVAL.union = yyLOCAL
+	case 910:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5225
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_DAY
+		}
+		yyVAL.union = yyLOCAL
+	case 911:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5229
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_WEEK
+		}
+		yyVAL.union = yyLOCAL
+	case 912:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5233
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_MONTH
+		}
+		yyVAL.union = yyLOCAL
+	case 913:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5237
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_QUARTER
+		}
+		yyVAL.union = yyLOCAL
+	case 914:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IntervalType
+//line mysql_sql.y:5241
+		{
+			yyLOCAL = tree.INTERVAL_TYPE_YEAR
+		}
+		yyVAL.union = yyLOCAL
+	case 915:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:4692
+//line mysql_sql.y:5247
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 916:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4697
+//line mysql_sql.y:5252
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 917:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4701
+//line mysql_sql.y:5256
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4707
+//line mysql_sql.y:5262
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 919:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4711
+//line mysql_sql.y:5266
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 920:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4718
+//line mysql_sql.y:5273
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 921:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4722
+//line mysql_sql.y:5277
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 922:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4726
+//line mysql_sql.y:5281
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 923:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4730
+//line mysql_sql.y:5285
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 924:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4734
+//line mysql_sql.y:5289
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 925:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4740
+//line mysql_sql.y:5295
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 926:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4744
+//line mysql_sql.y:5299
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 927:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4748
+//line mysql_sql.y:5303
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 929:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4755
+//line mysql_sql.y:5310
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 930:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4759
+//line mysql_sql.y:5314
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 931:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4763
+//line mysql_sql.y:5318
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 932:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4767
+//line mysql_sql.y:5322
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 933:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4771
+//line mysql_sql.y:5326
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 934:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4775
+//line mysql_sql.y:5330
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 935:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4779
+//line mysql_sql.y:5334
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 936:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4783
+//line mysql_sql.y:5338
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 938:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4789
+//line mysql_sql.y:5344
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 939:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4793
+//line mysql_sql.y:5348
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4799
+//line mysql_sql.y:5354
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4803
+//line mysql_sql.y:5358
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 942:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4810
+//line mysql_sql.y:5365
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4814
+//line mysql_sql.y:5369
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 944:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4818
+//line mysql_sql.y:5373
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4822
+//line mysql_sql.y:5377
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4826
+//line mysql_sql.y:5381
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 947:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:4830
+//line mysql_sql.y:5385
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 948:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4837
+//line mysql_sql.y:5392
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 949:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4841
+//line mysql_sql.y:5396
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 950:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4845
+//line mysql_sql.y:5400
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 951:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4849
+//line mysql_sql.y:5404
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.NumVal
-//line mysql_sql.y:4855
+//line mysql_sql.y:5410
 		{
 			ival, errStr := util.GetInt64(yyDollar[1].item)
 			if errStr != "" {
@@ -10843,96 +11969,104 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 953:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4870
+//line mysql_sql.y:5425
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4874
+//line mysql_sql.y:5429
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 955:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4879
+//line mysql_sql.y:5434
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, fval)
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 956:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4884
+//line mysql_sql.y:5439
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(true), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4888
+//line mysql_sql.y:5443
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(false), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4892
+//line mysql_sql.y:5447
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeUnknown(), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4896
+//line mysql_sql.y:5451
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 960:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5456
+		{
+			yyLOCAL = yylex.(*Lexer).paramExpr(yyDollar[1].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 961:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4906
+//line mysql_sql.y:5464
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 965:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4917
+//line mysql_sql.y:5475
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4922
+//line mysql_sql.y:5480
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4928
+//line mysql_sql.y:5486
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10945,10 +12079,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 968:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4940
+//line mysql_sql.y:5498
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10961,10 +12095,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 969:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4952
+//line mysql_sql.y:5510
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10977,10 +12111,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4964
+//line mysql_sql.y:5522
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10994,10 +12128,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 971:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4977
+//line mysql_sql.y:5535
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11011,10 +12145,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 972:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4990
+//line mysql_sql.y:5548
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11028,10 +12162,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5003
+//line mysql_sql.y:5561
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11045,10 +12179,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5016
+//line mysql_sql.y:5574
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11062,10 +12196,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5029
+//line mysql_sql.y:5587
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11079,10 +12213,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5042
+//line mysql_sql.y:5600
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11096,10 +12230,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 977:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5055
+//line mysql_sql.y:5613
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11113,10 +12247,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5068
+//line mysql_sql.y:5626
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11130,10 +12264,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 979:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5081
+//line mysql_sql.y:5639
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11147,10 +12281,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 980:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5094
+//line mysql_sql.y:5652
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11164,10 +12298,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 981:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5109
+//line mysql_sql.y:5667
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -11191,10 +12325,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 982:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5132
+//line mysql_sql.y:5690
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11231,10 +12365,72 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 983:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:5726
+		{
+			locale := ""
+			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 38 {
+				yylex.Error("Too-big precision specified for decimal. Maximum is 38.")
+				return 1
+			}
+			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
+				yylex.Error("For float(M,D), double(M,D) or decimal(M,D), M must be >= D (column 'a'))")
+				return 1
+			}
+			width := int32(64)
+			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 18 {
+				width = 128
+			}
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.DecimalFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        width,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DECIMAL),
+					DisplayWith:  yyDollar[2].lengthScaleOptUnion().DisplayWith,
+					Precision:    yyDollar[2].lengthScaleOptUnion().Precision,
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 984:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:5753
+		{
+			locale := ""
+			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 38 {
+				yylex.Error("Too-big precision specified for decimal. Maximum is 38.")
+				return 1
+			}
+			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
+				yylex.Error("For float(M,D), double(M,D) or decimal(M,D), M must be >= D (column 'a'))")
+				return 1
+			}
+			width := int32(64)
+			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 18 {
+				width = 128
+			}
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.DecimalFamily,
+					FamilyString: yyDollar[1].str,
+					Width:        width,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DECIMAL),
+					DisplayWith:  yyDollar[2].lengthScaleOptUnion().DisplayWith,
+					Precision:    yyDollar[2].lengthScaleOptUnion().Precision,
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 985:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5180
+//line mysql_sql.y:5780
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11250,10 +12446,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5197
+//line mysql_sql.y:5797
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11266,10 +12462,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 987:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5209
+//line mysql_sql.y:5809
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11285,10 +12481,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 988:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5224
+//line mysql_sql.y:5824
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11304,10 +12500,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 989:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5239
+//line mysql_sql.y:5839
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11323,10 +12519,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 990:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5254
+//line mysql_sql.y:5854
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11341,10 +12537,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 991:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5270
+//line mysql_sql.y:5870
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11358,10 +12554,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 992:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5283
+//line mysql_sql.y:5883
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11375,10 +12571,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 993:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5296
+//line mysql_sql.y:5896
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11392,10 +12588,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 994:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5309
+//line mysql_sql.y:5909
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11409,10 +12605,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5322
+//line mysql_sql.y:5922
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11425,10 +12621,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 996:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5334
+//line mysql_sql.y:5934
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11441,10 +12637,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5346
+//line mysql_sql.y:5946
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11457,10 +12653,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 998:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5358
+//line mysql_sql.y:5958
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11473,10 +12669,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 999:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5370
+//line mysql_sql.y:5970
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11489,10 +12685,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 1000:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5382
+//line mysql_sql.y:5982
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11505,10 +12701,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 1001:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5394
+//line mysql_sql.y:5994
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11521,10 +12717,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 1002:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5406
+//line mysql_sql.y:6006
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11537,10 +12733,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 1003:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5418
+//line mysql_sql.y:6018
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11553,10 +12749,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 1004:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5430
+//line mysql_sql.y:6030
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11570,10 +12766,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 1005:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5443
+//line mysql_sql.y:6043
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11587,10 +12783,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5458
+//line mysql_sql.y:6058
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11603,59 +12799,59 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 1007:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5481
+//line mysql_sql.y:6081
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 1008:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5486
+//line mysql_sql.y:6086
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 1009:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5492
+//line mysql_sql.y:6092
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 1011:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5498
+//line mysql_sql.y:6098
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 1012:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5502
+//line mysql_sql.y:6102
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 1013:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5508
+//line mysql_sql.y:6108
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 1014:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5514
+//line mysql_sql.y:6114
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -11663,10 +12859,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 1015:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5521
+//line mysql_sql.y:6121
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -11674,10 +12870,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 1016:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5530
+//line mysql_sql.y:6130
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -11685,10 +12881,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 1017:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5537
+//line mysql_sql.y:6137
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -11696,10 +12892,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 1018:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5544
+//line mysql_sql.y:6144
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -11707,52 +12903,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 1019:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5553
+//line mysql_sql.y:6153
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 1020:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5557
+//line mysql_sql.y:6157
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 1021:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5561
+//line mysql_sql.y:6161
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 1022:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5567
+//line mysql_sql.y:6167
 		{
 		}
-	case 924:
+	case 1023:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5569
+//line mysql_sql.y:6169
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 1027:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5579
+//line mysql_sql.y:6179
 		{
 			yyVAL.str = ""
 		}
-	case 929:
+	case 1028:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5583
+//line mysql_sql.y:6183
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
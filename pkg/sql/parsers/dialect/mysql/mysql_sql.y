@@ -40,6 +40,8 @@ import (
     tableName *tree.TableName
     tableNames tree.TableNames
     columnTableDef *tree.ColumnTableDef
+    alterTableOption tree.AlterTableOption
+    alterTableOptions tree.AlterTableOptions
     tableOption tree.TableOption
     tableOptions []tree.TableOption
     tableExprs tree.TableExprs
@@ -120,6 +122,13 @@ import (
     subquery *tree.Subquery
     intervalType tree.IntervalType
     funcExpr *tree.FuncExpr
+    whens []*tree.When
+    when *tree.When
+    windowSpec *tree.WindowSpec
+    frameClause *tree.FrameClause
+    frameBound *tree.FrameBound
+    frameType tree.FrameType
+    asOf *tree.AsOfClause
 
     roles []*tree.Role
     role *tree.Role
@@ -157,13 +166,14 @@ import (
 }
 
 %token LEX_ERROR
-%left <str> UNION
+%left <str> UNION EXCEPT
+%left <str> INTERSECT
 %token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
 %token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK KEYS
 %token <str> VALUES LAST_INSERT_ID
 %token <str> NEXT VALUE SHARE MODE
 %token <str> SQL_NO_CACHE SQL_CACHE
-%left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
+%left <str> JOIN STRAIGHT_JOIN LEFT RIGHT FULL INNER OUTER CROSS NATURAL USE FORCE
 %left <str> ON USING
 %left <str> SUBQUERY_AS_EXPR
 %left <str> '(' ',' ')'
@@ -180,6 +190,7 @@ import (
 %right <str> NOT '!'
 %left <str> BETWEEN CASE WHEN THEN ELSE END
 %left <str> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN ASSIGNMENT
+%left <str> JSON_EXTRACT_OP JSON_UNQUOTE_EXTRACT_OP
 %left <str> '|'
 %left <str> '&'
 %left <str> SHIFT_LEFT SHIFT_RIGHT
@@ -247,15 +258,18 @@ import (
 // Explain
 %token <str> FORMAT CONNECTION
 
+// Kill
+%token <str> KILL
+
 // Load
 %token <str> LOAD INFILE TERMINATED OPTIONALLY ENCLOSED ESCAPED STARTING LINES
 
 // Supported SHOW tokens
-%token <str> DATABASES TABLES EXTENDED FULL PROCESSLIST FIELDS COLUMNS OPEN ERRORS WARNINGS INDEXES
+%token <str> DATABASES TABLES EXTENDED PROCESSLIST FIELDS COLUMNS OPEN ERRORS WARNINGS INDEXES GRANTS
 
 // SET tokens
 %token <str> NAMES GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
-%token <str> LOCAL EXCEPT
+%token <str> LOCAL
 
 // Functions
 %token <str> CURRENT_TIMESTAMP DATABASE
@@ -274,10 +288,17 @@ import (
 %token <str> GROUP_CONCAT MAX MID MIN NOW POSITION SESSION_USER STD STDDEV
 %token <str> STDDEV_POP STDDEV_SAMP SUBDATE SUBSTR SUBSTRING SUM SYSDATE
 %token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG
+%token <str> LEADING TRAILING BOTH
 
 // Insert
 %token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE
 
+// Window function
+%token <str> OVER ROWS PRECEDING FOLLOWING UNBOUNDED CURRENT
+
+// Time travel
+%token <str> OF EPOCH
+
 %token <str> UNUSED
 
 %type <statement> stmt
@@ -289,7 +310,7 @@ import (
 %type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt
 %type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt
 %type <statement> show_tables_stmt show_process_stmt show_errors_stmt show_warnings_stmt
-%type <statement> show_variables_stmt show_status_stmt show_index_stmt
+%type <statement> show_variables_stmt show_status_stmt show_index_stmt show_grants_stmt
 %type <statement> alter_user_stmt update_stmt use_stmt
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
 %type <statement> explain_stmt explainable_stmt
@@ -297,6 +318,11 @@ import (
 %type <statement> revoke_stmt grant_stmt
 %type <statement> load_data_stmt
 %type <statement> analyze_stmt
+%type <statement> kill_stmt
+%type <statement> alter_table_stmt rename_stmt optimize_stmt
+%type <alterTableOption> alter_table_option
+%type <alterTableOptions> alter_table_option_list
+%type <str> column_keyword_opt to_or_as_opt
 %type <exportParm> export_data_param_opt
 
 %type <select> select_stmt select_no_parens
@@ -339,17 +365,23 @@ import (
 %type <aliasedTableExpr> aliased_table_name
 %type <unionTypeRecord> union_op
 %type <parenTableExpr> derived_table
-%type <str> inner_join straight_join outer_join natural_join
+%type <str> inner_join straight_join outer_join full_join natural_join
 %type <funcType> func_type_opt
 %type <funcExpr> function_call_generic
 %type <funcExpr> function_call_keyword
 %type <funcExpr> function_call_nonkeyword
 %type <funcExpr> function_call_aggregate
+%type <windowSpec> window_spec_opt
+%type <exprs> window_partition_by_opt
+%type <frameClause> window_frame_opt
+%type <frameType> window_frame_units
+%type <frameBound> window_frame_bound
+%type <asOf> as_of_clause
 
 %type <unresolvedName> column_name column_name_unresolved
 %type <strs> enum_values force_quote_opt force_quote_list
 %type <str> sql_id charset_keyword db_name
-%type <str> not_keyword
+%type <str> not_keyword substr_name trim_direction
 %type <str> reserved_keyword non_reserved_keyword
 %type <str> equal_opt reserved_sql_id reserved_table_id
 %type <str> as_name_opt as_opt_id table_id id_or_var name_string ident
@@ -367,6 +399,9 @@ import (
 %type <expr> bit_expr interval_expr
 %type <expr> simple_expr
 %type <expr> expression like_escape_opt boolean_primary col_tuple
+%type <expr> case_operand_opt case_else_opt
+%type <whens> when_clause_list
+%type <when> when_clause
 %type <exprs> expression_list_opt
 %type <exprs> expression_list row_value
 %type <expr> datatime_precision_opt datatime_precision
@@ -436,11 +471,12 @@ import (
 %type <insert> insert_data
 %type <rowsExprs> values_list
 %type <str> name_datatime_precision braces_opt name_braces
-%type <str> std_dev_pop
+%type <str> std_dev_pop var_pop
+%type <expr> separator_opt
 %type <expr> expr_or_default
 %type <exprs> data_values data_opt row_value
 
-%type <boolVal> local_opt
+%type <boolVal> local_opt kill_query_opt
 %type <duplicateKey> duplicate_opt
 %type <fields> load_fields field_item export_fields
 %type <fieldsList> field_item_list
@@ -499,6 +535,9 @@ stmt:
 |   revoke_stmt
 |   grant_stmt
 |   load_data_stmt
+|   kill_stmt
+|   rename_stmt
+|   optimize_stmt
 |   select_stmt
     {
         $$ = $1
@@ -508,6 +547,25 @@ stmt:
         $$ = tree.Statement(nil)
     }
 
+kill_stmt:
+    KILL kill_query_opt INTEGRAL
+    {
+        $$ = &tree.Kill{Query: $2, ConnectionId: uint64($3.(int64))}
+    }
+
+kill_query_opt:
+    {
+        $$ = false
+    }
+|   CONNECTION
+    {
+        $$ = false
+    }
+|   QUERY
+    {
+        $$ = true
+    }
+
 load_data_stmt:
     LOAD DATA local_opt INFILE STRING duplicate_opt INTO TABLE table_name load_fields load_lines ignore_lines columns_or_variable_list_opt load_set_spec_opt
     {
@@ -1514,8 +1572,76 @@ analyze_stmt:
 
 alter_stmt:
     alter_user_stmt
+|   alter_table_stmt
 // |    alter_ddl_stmt
 
+alter_table_stmt:
+    ALTER TABLE table_name alter_table_option_list
+    {
+        $$ = tree.NewAlterTable(*$3, $4)
+    }
+
+alter_table_option_list:
+    alter_table_option
+    {
+        $$ = tree.AlterTableOptions{$1}
+    }
+|   alter_table_option_list ',' alter_table_option
+    {
+        $$ = append($1, $3)
+    }
+
+alter_table_option:
+    ADD column_keyword_opt column_def
+    {
+        $$ = &tree.AlterTableAddColumn{Column: $3}
+    }
+|   DROP column_keyword_opt ident
+    {
+        $$ = &tree.AlterTableDropColumn{Name: tree.Identifier($3)}
+    }
+|   RENAME COLUMN ident TO ident
+    {
+        $$ = &tree.AlterTableRenameColumn{Name: tree.Identifier($3), NewName: tree.Identifier($5)}
+    }
+|   ALTER column_keyword_opt ident SET DEFAULT bit_expr
+    {
+        $$ = &tree.AlterTableAlterColumn{Name: tree.Identifier($3), Default: $6}
+    }
+|   ALTER column_keyword_opt ident DROP DEFAULT
+    {
+        $$ = &tree.AlterTableAlterColumn{Name: tree.Identifier($3)}
+    }
+|   RENAME to_or_as_opt table_name
+    {
+        $$ = &tree.AlterTableRename{Name: *$3}
+    }
+
+column_keyword_opt:
+    {
+        $$ = ""
+    }
+|   COLUMN
+
+to_or_as_opt:
+    {
+        $$ = ""
+    }
+|   TO
+|   AS
+
+rename_stmt:
+    RENAME TABLE table_name TO table_name
+    {
+        $$ = tree.NewAlterTable(*$3, tree.AlterTableOptions{&tree.AlterTableRename{Name: *$5}})
+    }
+
+optimize_stmt:
+    OPTIMIZE TABLE table_name_list
+    {
+        $$ = tree.NewOptimizeTable($3)
+    }
+
 alter_user_stmt:
     ALTER USER exists_opt user_spec_list require_clause_opt conn_options pwd_or_lck_opt
     {
@@ -1620,6 +1746,17 @@ show_stmt:
 |   show_variables_stmt
 |   show_status_stmt
 |   show_index_stmt
+|   show_grants_stmt
+
+show_grants_stmt:
+    SHOW GRANTS
+    {
+        $$ = &tree.ShowGrants{}
+    }
+|   SHOW GRANTS FOR user_name
+    {
+        $$ = &tree.ShowGrants{Username: $4.Username, Hostname: $4.Hostname}
+    }
 
 show_index_stmt:
     SHOW index_kwd from_or_in table_name where_expression_opt
@@ -2359,6 +2496,54 @@ union_op:
             Distinct: true,
         }
     }
+|   INTERSECT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: false,
+            Distinct: false,
+        }
+    }
+|   INTERSECT ALL
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: true,
+            Distinct: false,
+        }
+    }
+|   INTERSECT DISTINCT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: false,
+            Distinct: true,
+        }
+    }
+|   EXCEPT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: false,
+            Distinct: false,
+        }
+    }
+|   EXCEPT ALL
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: true,
+            Distinct: false,
+        }
+    }
+|   EXCEPT DISTINCT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: false,
+            Distinct: true,
+        }
+    }
 
 simple_select_clause:
     SELECT distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
@@ -2510,6 +2695,16 @@ join_table:
             Cond: $4,
         }
     }
+// full: table_reference
+|   table_reference full_join table_factor join_condition
+    {
+        $$ = &tree.JoinTableExpr{
+            Left: $1,
+            JoinType: $2,
+            Right: $3,
+            Cond: $4,
+        }
+    }
 |   table_reference natural_join table_factor
     {
         $$ = &tree.JoinTableExpr{
@@ -2551,6 +2746,16 @@ outer_join:
         $$ = tree.JOIN_TYPE_RIGHT
     }
 
+full_join:
+    FULL JOIN
+    {
+        $$ = tree.JOIN_TYPE_FULL
+    }
+|   FULL OUTER JOIN
+    {
+        $$ = tree.JOIN_TYPE_FULL
+    }
+
 on_expression_opt:
     %prec JOIN
     {
@@ -2647,10 +2852,32 @@ aliased_table_name:
             },
         }
     }
+|   table_name AS OF as_of_clause as_opt_id
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($5),
+            },
+            AsOf: $4,
+        }
+    }
 // |   table_name PARTITION '(' partition_id_list ')' as_opt_id index_hint_list
 
 
+as_of_clause:
+    TIMESTAMP literal
+    {
+        $$ = tree.NewAsOfClause(tree.AS_OF_TIMESTAMP, $2)
+    }
+|   EPOCH literal
+    {
+        $$ = tree.NewAsOfClause(tree.AS_OF_EPOCH, $2)
+    }
+
+// FULL after a table is the full join rather than its alias
 as_opt_id:
+    %prec JOIN
     {
         $$ = ""
     }
@@ -4118,6 +4345,29 @@ simple_expr:
     {
         $$ = tree.NewTuple(append($2, $4))
     }
+|   normal_ident JSON_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        path := tree.NewNumVal(constant.MakeString($3), $3, false)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, path},
+        }
+    }
+|   normal_ident JSON_UNQUOTE_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        path := tree.NewNumVal(constant.MakeString($3), $3, false)
+        extract := &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, path},
+        }
+        name = tree.SetUnresolvedName("json_unquote")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{extract},
+        }
+    }
 |   '+'  simple_expr %prec UNARY
     {
         $$ = tree.NewUnaryExpr(tree.UNARY_PLUS, $2)
@@ -4147,6 +4397,10 @@ simple_expr:
         $2.Exists = true
         $$ = $2
     }
+|   CASE case_operand_opt when_clause_list case_else_opt END
+    {
+        $$ = tree.NewCaseExpr($2, $3, $4)
+    }
 |   CAST '(' expression AS cast_type ')' 
     {
         $$ = tree.NewCastExpr($3, $5)
@@ -4181,8 +4435,54 @@ simple_expr:
         $$ = $1
     }
 
+case_operand_opt:
+    {
+        $$ = nil
+    }
+|   expression
+    {
+        $$ = $1
+    }
+
+when_clause_list:
+    when_clause
+    {
+        $$ = []*tree.When{$1}
+    }
+|   when_clause_list when_clause
+    {
+        $$ = append($1, $2)
+    }
+
+when_clause:
+    WHEN expression THEN expression
+    {
+        $$ = tree.NewWhen($2, $4)
+    }
+
+case_else_opt:
+    {
+        $$ = nil
+    }
+|   ELSE expression
+    {
+        $$ = $2
+    }
+
 cast_type:
     decimal_type
+|   JSON
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+		        Family: tree.JsonFamily,
+                FamilyString: $1,
+		        Locale: &locale,
+		        Oid:    uint32(defines.MYSQL_TYPE_JSON),
+	        },
+        }
+    }
 |   BINARY length_opt
     {
         locale := ""
@@ -4299,13 +4599,14 @@ integer_opt:
 |	INT
 
 function_call_aggregate:
-    AVG '(' func_type_opt expression  ')'
+    AVG '(' func_type_opt expression ')' window_spec_opt
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
         $$ = &tree.FuncExpr{
             Func: tree.FuncName2ResolvableFunctionReference(name),
             Exprs: tree.Exprs{$4},
             Type: $3,
+            WindowSpec: $6,
         }
     }
 |   APPROX_COUNT_DISTINCT '(' expression_list ')'
@@ -4351,49 +4652,54 @@ function_call_aggregate:
             Type: $3,
         }
     }
-|   COUNT '(' func_type_opt expression_list ')'
+|   COUNT '(' func_type_opt expression_list ')' window_spec_opt
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
         $$ = &tree.FuncExpr{
             Func: tree.FuncName2ResolvableFunctionReference(name),
             Exprs: $4,
             Type: $3,
+            WindowSpec: $6,
         }
     }
-|   COUNT '(' '*' ')'
+|   COUNT '(' '*' ')' window_spec_opt
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
         es := tree.NewNumVal(constant.MakeString("*"), "*", false)
         $$ = &tree.FuncExpr{
             Func: tree.FuncName2ResolvableFunctionReference(name),
             Exprs: tree.Exprs{es},
+            WindowSpec: $5,
         }
     }
-|   MAX '(' func_type_opt expression ')'
+|   MAX '(' func_type_opt expression ')' window_spec_opt
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
         $$ = &tree.FuncExpr{
             Func: tree.FuncName2ResolvableFunctionReference(name),
             Exprs: tree.Exprs{$4},
             Type: $3,
+            WindowSpec: $6,
         }
     }
-|   MIN '(' func_type_opt expression ')'
+|   MIN '(' func_type_opt expression ')' window_spec_opt
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
         $$ = &tree.FuncExpr{
             Func: tree.FuncName2ResolvableFunctionReference(name),
             Exprs: tree.Exprs{$4},
             Type: $3,
+            WindowSpec: $6,
         }
     }
-|   SUM '(' func_type_opt expression ')'
+|   SUM '(' func_type_opt expression ')' window_spec_opt
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
         $$ = &tree.FuncExpr{
             Func: tree.FuncName2ResolvableFunctionReference(name),
             Exprs: tree.Exprs{$4},
             Type: $3,
+            WindowSpec: $6,
         }
     }
 |   std_dev_pop '(' func_type_opt expression ')'
@@ -4414,7 +4720,18 @@ function_call_aggregate:
             Type: $3,
         }
     }
-|   VAR_POP '(' func_type_opt expression ')'
+|   GROUP_CONCAT '(' func_type_opt expression_list order_by_opt separator_opt ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $4,
+            Type: $3,
+            OrderBy: $5,
+            Separator: $6,
+        }
+    }
+|   var_pop '(' func_type_opt expression ')'
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
         $$ = &tree.FuncExpr{
@@ -4433,18 +4750,95 @@ function_call_aggregate:
         }
     }
 
+window_spec_opt:
+    {
+        $$ = nil
+    }
+|   OVER '(' window_partition_by_opt order_by_opt window_frame_opt ')'
+    {
+        $$ = tree.NewWindowSpec($3, $4, $5)
+    }
+
+window_partition_by_opt:
+    {
+        $$ = nil
+    }
+|   PARTITION BY expression_list
+    {
+        $$ = $3
+    }
+
+window_frame_opt:
+    {
+        $$ = nil
+    }
+|   window_frame_units window_frame_bound
+    {
+        $$ = tree.NewFrameClause($1, $2, tree.NewFrameBound(tree.CURRENT_ROW, nil))
+    }
+|   window_frame_units BETWEEN window_frame_bound AND window_frame_bound
+    {
+        $$ = tree.NewFrameClause($1, $3, $5)
+    }
+
+window_frame_units:
+    ROWS
+    {
+        $$ = tree.FRAME_ROWS
+    }
+|   RANGE
+    {
+        $$ = tree.FRAME_RANGE
+    }
+
+window_frame_bound:
+    UNBOUNDED PRECEDING
+    {
+        $$ = tree.NewFrameBound(tree.UNBOUNDED_PRECEDING, nil)
+    }
+|   UNBOUNDED FOLLOWING
+    {
+        $$ = tree.NewFrameBound(tree.UNBOUNDED_FOLLOWING, nil)
+    }
+|   CURRENT ROW
+    {
+        $$ = tree.NewFrameBound(tree.CURRENT_ROW, nil)
+    }
+|   literal PRECEDING
+    {
+        $$ = tree.NewFrameBound(tree.PRECEDING, $1)
+    }
+|   literal FOLLOWING
+    {
+        $$ = tree.NewFrameBound(tree.FOLLOWING, $1)
+    }
+
 std_dev_pop:
     STD
 |   STDDEV
 |   STDDEV_POP
 
+var_pop:
+    VAR_POP
+|   VARIANCE
+
+separator_opt:
+    {
+        $$ = nil
+    }
+|   SEPARATOR STRING
+    {
+        $$ = tree.NewNumVal(constant.MakeString($2), $2, false)
+    }
+
 function_call_generic:
-    ID '(' expression_list_opt ')'
+    ID '(' expression_list_opt ')' window_spec_opt
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
         $$ = &tree.FuncExpr{
             Func: tree.FuncName2ResolvableFunctionReference(name),
             Exprs: $3,
+            WindowSpec: $5,
         }
     }
 // |   identifier '.' identifier '(' expression_list_opt ')'
@@ -4457,7 +4851,53 @@ function_call_generic:
 //     }
 
 function_call_nonkeyword:
-    CURTIME datatime_precision
+    NOW datatime_precision
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        var es tree.Exprs = nil
+        if $2 != nil {
+            es = append(es, $2)
+        }
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: es,
+        }
+    }
+|   CURDATE '(' ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+        }
+    }
+|   DATE_ADD '(' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5},
+        }
+    }
+|   DATE_SUB '(' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5},
+        }
+    }
+|   EXTRACT '(' interval_type FROM expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        typ := $3
+        str := typ.ToString()
+        unit := tree.NewNumVal(constant.MakeString(str), str, false)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{unit, $5},
+        }
+    }
+|   CURTIME datatime_precision
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
         var es tree.Exprs = nil
@@ -4590,6 +5030,89 @@ function_call_keyword:
             Exprs: tree.Exprs{$2},
         }
     }
+|   substr_name '(' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5},
+        }
+    }
+|   substr_name '(' expression ',' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5, $7},
+        }
+    }
+|   substr_name '(' expression FROM expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5},
+        }
+    }
+|   substr_name '(' expression FROM expression FOR expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5, $7},
+        }
+    }
+|   TRIM '(' expression ')'
+    {
+        name := tree.SetUnresolvedName("trim")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3},
+        }
+    }
+|   TRIM '(' expression FROM expression ')'
+    {
+        name := tree.SetUnresolvedName("trim")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$5, $3},
+        }
+    }
+|   TRIM '(' trim_direction expression FROM expression ')'
+    {
+        name := tree.SetUnresolvedName($3)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$6, $4},
+        }
+    }
+|   POSITION '(' bit_expr IN expression ')'
+    {
+        name := tree.SetUnresolvedName("locate")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5},
+        }
+    }
+
+substr_name:
+    SUBSTRING
+|   SUBSTR
+|   MID
+
+trim_direction:
+    BOTH
+    {
+        $$ = "trim"
+    }
+|   LEADING
+    {
+        $$ = "ltrim"
+    }
+|   TRAILING
+    {
+        $$ = "rtrim"
+    }
 
 datatime_precision_opt:
     {
@@ -4682,10 +5205,42 @@ func_type_opt:
     }
 
 interval_type:
-    SECOND
+    MICROSECOND
+    {
+        $$ = tree.INTERVAL_TYPE_MICROSECOND
+    }
+|   SECOND
     {
         $$ = tree.INTERVAL_TYPE_SECOND
     }
+|   MINUTE
+    {
+        $$ = tree.INTERVAL_TYPE_MINUTE
+    }
+|   HOUR
+    {
+        $$ = tree.INTERVAL_TYPE_HOUR
+    }
+|   DAY
+    {
+        $$ = tree.INTERVAL_TYPE_DAY
+    }
+|   WEEK
+    {
+        $$ = tree.INTERVAL_TYPE_WEEK
+    }
+|   MONTH
+    {
+        $$ = tree.INTERVAL_TYPE_MONTH
+    }
+|   QUARTER
+    {
+        $$ = tree.INTERVAL_TYPE_QUARTER
+    }
+|   YEAR
+    {
+        $$ = tree.INTERVAL_TYPE_YEAR
+    }
 
 tuple_expression:
     '(' expression_list ')'
@@ -4897,9 +5452,12 @@ literal:
 		ival := util.GetUint64($1)
 		$$ = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 	}
+|   VALUE_ARG
+    {
+        $$ = yylex.(*Lexer).paramExpr($1)
+    }
 // |   HEX
 // |   BIT_LITERAL
-// |   VALUE_ARG
 
 column_type:
     numeric_type unsigned_opt zero_fill_opt
@@ -5164,18 +5722,60 @@ decimal_type:
                 }
         }
     }
-// |   DECIMAL decimal_length_opt
-//     {
-//         $$ = tree.TYPE_DOUBLE
-//         $$.InternalType.DisplayWith = $2.DisplayWith
-//         $$.InternalType.Precision = $2.Precision
-//     }
-// |   NUMERIC decimal_length_opt
-//     {
-//         $$ = tree.TYPE_DOUBLE
-//         $$.InternalType.DisplayWith = $2.DisplayWith
-//         $$.InternalType.Precision = $2.Precision
-//     }
+|   DECIMAL decimal_length_opt
+    {
+        locale := ""
+        if $2.DisplayWith > 38 {
+            yylex.Error("Too-big precision specified for decimal. Maximum is 38.")
+            return 1
+        }
+        if $2.Precision != tree.NotDefineDec && $2.Precision > $2.DisplayWith {
+            yylex.Error("For float(M,D), double(M,D) or decimal(M,D), M must be >= D (column 'a'))")
+            return 1
+        }
+        width := int32(64)
+        if $2.DisplayWith > 18 {
+            width = 128
+        }
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.DecimalFamily,
+                FamilyString: $1,
+                Width:  width,
+                Locale: &locale,
+                Oid:    uint32(defines.MYSQL_TYPE_DECIMAL),
+                DisplayWith: $2.DisplayWith,
+                Precision: $2.Precision,
+            },
+        }
+    }
+|   NUMERIC decimal_length_opt
+    {
+        locale := ""
+        if $2.DisplayWith > 38 {
+            yylex.Error("Too-big precision specified for decimal. Maximum is 38.")
+            return 1
+        }
+        if $2.Precision != tree.NotDefineDec && $2.Precision > $2.DisplayWith {
+            yylex.Error("For float(M,D), double(M,D) or decimal(M,D), M must be >= D (column 'a'))")
+            return 1
+        }
+        width := int32(64)
+        if $2.DisplayWith > 18 {
+            width = 128
+        }
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.DecimalFamily,
+                FamilyString: $1,
+                Width:  width,
+                Locale: &locale,
+                Oid:    uint32(defines.MYSQL_TYPE_DECIMAL),
+                DisplayWith: $2.DisplayWith,
+                Precision: $2.Precision,
+            },
+        }
+    }
 |   REAL float_length_opt
     {
         locale := ""
@@ -5640,6 +6240,7 @@ reserved_keyword:
 |   ELSE
 |   END
 |   ESCAPE
+|   EXCEPT
 |   EXISTS
 |   EXPLAIN
 |   FALSE
@@ -5658,12 +6259,14 @@ reserved_keyword:
 |   INDEX
 |   INNER
 |   INSERT
+|   INTERSECT
 |   INTERVAL
 |   INTO
 |   IS
 |   ISSUER
 |   JOIN
 |   KEY
+|   KILL
 |   LEFT
 |   LIKE
 |   LIMIT
@@ -5741,9 +6344,16 @@ reserved_keyword:
 |   FOREIGN
 |	ROW
 |   OUTFILE
+|   OVER
+|   ROWS
 
 non_reserved_keyword:
     AGAINST
+|   CURRENT
+|   FOLLOWING
+|   PRECEDING
+|   UNBOUNDED
+|   EPOCH
 |   AVG_ROW_LENGTH
 |   AUTO_RANDOM
 |   ACTION
@@ -5782,7 +6392,6 @@ non_reserved_keyword:
 |   EXPANSION
 |   EXTENDED
 |   EXPIRE
-|   EXCEPT
 |   ERRORS
 |   ENFORCED
 |   FLOAT_TYPE
@@ -5891,6 +6500,7 @@ non_reserved_keyword:
 |   WITH
 |   WRITE
 |   WARNINGS
+|   GRANTS
 |   WORK
 |   X509
 |   ZEROFILL
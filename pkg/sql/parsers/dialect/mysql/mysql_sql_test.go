@@ -15,6 +15,7 @@
 package mysql
 
 import (
+	"go/constant"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -58,6 +59,67 @@ var (
 	}{{
 		input:  "select * from R join S on R.uid = S.uid",
 		output: "select * from R inner join S on R.uid = S.uid",
+	}, {
+		input:  "select substring(a, 2), substr(a, 2, 3), mid(a from 1 for 2), substring(a from -2) from t",
+		output: "select substring(a, 2), substr(a, 2, 3), mid(a, 1, 2), substring(a, -2) from t",
+	}, {
+		input:  "select trim(a), trim('x' from a), trim(leading 'x' from a), trim(trailing 'x' from a), trim(both 'x' from a) from t",
+		output: "select trim(a), trim(a, x), ltrim(a, x), rtrim(a, x), trim(a, x) from t",
+	}, {
+		input:  "select position('a' in b), locate('a', b, 2) from t where b regexp '^a.*'",
+		output: "select locate(a, b), locate(a, b, 2) from t where b reg_match ^a.*",
+	}, {
+		input: "select a, row_number() over (partition by b order by c desc) from t",
+	}, {
+		input: "select rank() over (order by a), dense_rank() over (order by a), lag(a, 2) over (order by a), lead(a) over () from t",
+	}, {
+		input: "select sum(a) over (partition by b order by c rows between 2 preceding and current row), count(*) over (partition by b) from t",
+	}, {
+		input:  "select avg(a) over (order by b rows unbounded preceding), max(a) over (order by b range between current row and unbounded following) from t",
+		output: "select avg(a) over (order by b rows between unbounded preceding and current row), max(a) over (order by b range between current row and unbounded following) from t",
+	}, {
+		input: "select current, preceding, following from t",
+	}, {
+		input:  "select a from t as of timestamp '2021-10-01 12:00:00' where b > 1",
+		output: "select a from t as of timestamp 2021-10-01 12:00:00 where b > 1",
+	}, {
+		input:  "select t.a, s.b from t as of epoch 1633089600 t join s on t.a = s.a",
+		output: "select t.a, s.b from t as of epoch 1633089600 as t inner join s on t.a = s.a",
+	}, {
+		input: "select epoch from t",
+	}, {
+		input:  "select case a when 1 then 'x' when 2 then 'y' else 'z' end, case when a > 1 then b end from t",
+		output: "select case a when 1 then x when 2 then y else z end, case when a > 1 then b end from t",
+	}, {
+		input: "select if(a > 1, b, c), ifnull(a, 0), coalesce(a, b, 1), nullif(a, b) from t where a in (1, 2) and b is not null",
+	}, {
+		input:  "select group_concat(distinct a, b order by b desc, a separator ';'), group_concat(a) from t",
+		output: "select group_concat(distinct a, b order by b desc, a separator ;), group_concat(a) from t",
+	}, {
+		input: "select variance(a), var_samp(a), std(a), stddev_samp(a), bit_xor(a), median(a), percentile_cont(a, 0.9) from t",
+	}, {
+		input:  "alter table t add c int default 1, drop column b",
+		output: "alter table t add column c int default 1, drop column b",
+	}, {
+		input:  "alter table db.t rename column a to b, alter column c set default 'x', alter column d drop default",
+		output: "alter table db.t rename column a to b, alter column c set default x, alter column d drop default",
+	}, {
+		input:  "alter table t rename as t2",
+		output: "alter table t rename to t2",
+	}, {
+		input:  "rename table t to db.t2",
+		output: "alter table t rename to db.t2",
+	}, {
+		input: "optimize table t",
+	}, {
+		input:  "optimize table db.t1,t2",
+		output: "optimize table db.t1, t2",
+	}, {
+		input: "select a from t1 union select b from t2 union all select c from t3 order by a limit 2",
+	}, {
+		input: "select a from t1 intersect select b from t2 except all select c from t3",
+	}, {
+		input: "select a from t1 intersect all (select b from t2 except distinct select c from t3)",
 	}, {
 		input:  "create table t (a int, b char, key idx1 type zonemap (a, b))",
 		output: "create table t (a int, b char, index idx1 using zonemap (a, b))",
@@ -236,6 +298,10 @@ var (
 		input: "insert into numtable values (3, 1.234567, 1.234567)",
 	}, {
 		input: "create table numtable (id int, fl float, dl double)",
+	}, {
+		input: "create table numtable (a decimal, b decimal(20), c numeric(10, 2))",
+	}, {
+		input: "select cast(a as decimal(10, 2)) from t1",
 	}, {
 		input: "drop table if exists numtable",
 	}, {
@@ -296,6 +362,16 @@ var (
 		input: "select sum(distinct s) from tbl where 1",
 	}, {
 		input: "select u.a, interval 1 second from t",
+	}, {
+		input: "select now(), now(3), curdate() from t",
+	}, {
+		input: "select date_add(a, interval 1 day), date_sub(b, interval 2 month) from t",
+	}, {
+		input:  "select a + interval 3 hour, extract(year from a) from t",
+		output: "select a + interval 3 hour, extract(year, a) from t",
+	}, {
+		input:  "select a->'$.b', a->>'$.c[0]', cast(b as json) from t where json_contains(a, '1', '$.d')",
+		output: "select json_extract(a, $.b), json_unquote(json_extract(a, $.c[0])), cast(b as json) from t where json_contains(a, 1, $.d)",
 	}, {
 		input: "select u.a, (select t.a from sa.t, u) from t where (u.a, u.b, u.c) in (select * from t)",
 	}, {
@@ -471,6 +547,20 @@ var (
 		input: "show full processlist",
 	}, {
 		input: "show processlist",
+	}, {
+		input: "kill 12",
+	}, {
+		input:  "kill connection 12",
+		output: "kill 12",
+	}, {
+		input: "kill query 12",
+	}, {
+		input: "show grants",
+	}, {
+		input:  "show grants for 'u1'@'localhost'",
+		output: "show grants for u1@localhost",
+	}, {
+		input: "show grants for u1",
 	}, {
 		input:  "show full tables from db1 like 'a%' where a != 0",
 		output: "show full tables from db1 like a% where a != 0",
@@ -642,6 +732,13 @@ var (
 	}, {
 		input:  "select * from t1 right outer join t2 on 123",
 		output: "select * from t1 right join t2 on 123",
+	}, {
+		input:  "select * from t1 full outer join t2 on t1.a = t2.a",
+		output: "select * from t1 full join t2 on t1.a = t2.a",
+	}, {
+		input: "select * from t1 full join t2 using (a) left join t3 on 1",
+	}, {
+		input: "select * from t1 as full full join t2 on 1",
 	}, {
 		input: "select * from t1 natural left join t2",
 	}, {
@@ -673,6 +770,11 @@ var (
 	}, {
 		input: "select $ from t into outfile '/Users/tmp/test' FIELDS TERMINATED BY ',' LINES TERMINATED BY '\n' header 'FALSE' MAX_FILE_SIZE 100 FORCE_QUOTE (a, b)",
 		output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header false max_file_size 102400 force_quote a, b",
+	}, {
+		input: "select a from t where b = ? and c > ? limit ?",
+	}, {
+		input:  "insert into t values (?, ?, 'a')",
+		output: "insert into t values (?, ?, a)",
 	}}
 )
 
@@ -730,3 +832,55 @@ func TestMulti(t *testing.T) {
 		}
 	}
 }
+
+var (
+	prepareSQL = []struct {
+		input  string
+		count  int
+		output string
+	}{{
+		input:  "select a from t where b = ? and c in (?, ?)",
+		count:  3,
+		output: "select a from t where b = 1 and c in (-2, abc)",
+	}, {
+		input:  "update t set a = ? where b = ?",
+		count:  2,
+		output: "update t set a = 1 where b = -2",
+	}, {
+		input:  "select a from t",
+		count:  0,
+		output: "select a from t",
+	}}
+)
+
+func TestPrepare(t *testing.T) {
+	params := []tree.Expr{
+		tree.NewNumVal(constant.MakeInt64(1), "1", false),
+		tree.NewNumVal(constant.MakeInt64(2), "-2", true),
+		tree.NewNumVal(constant.MakeString("abc"), "abc", false),
+	}
+	for _, tcase := range prepareSQL {
+		ast, count, err := Prepare(tcase.input)
+		if err != nil {
+			t.Errorf("Prepare(%q) err: %v", tcase.input, err)
+			continue
+		}
+		if count != tcase.count {
+			t.Errorf("Prepare(%q) count of params: expected %d, got %d", tcase.input, tcase.count, count)
+		}
+		if out := tree.String(ast, dialect.MYSQL); out != tcase.input {
+			t.Errorf("Prepare failed. \nExpected/Got:\n%s\n%s", tcase.input, out)
+		}
+		asts, err := ParseWithParams(tcase.input, params[:count])
+		if err != nil {
+			t.Errorf("ParseWithParams(%q) err: %v", tcase.input, err)
+			continue
+		}
+		if out := tree.String(asts[0], dialect.MYSQL); out != tcase.output {
+			t.Errorf("ParseWithParams failed. \nExpected/Got:\n%s\n%s", tcase.output, out)
+		}
+	}
+	if _, err := Parse("select a from t where b = :name"); err == nil {
+		t.Errorf("named bind variable should not be supported")
+	}
+}
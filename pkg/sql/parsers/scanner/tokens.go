@@ -50,6 +50,10 @@ func initTokens(dialectType dialect.DialectType) {
 		ADDDATE = MYSQL_ADDDATE
 		COUNT = MYSQL_COUNT
 		APPROX_COUNT_DISTINCT = MYSQL_APPROX_COUNT_DISTINCT
+		APPROX_PERCENTILE = MYSQL_APPROX_PERCENTILE
+		BIT_AND = MYSQL_BIT_AND
+		BIT_OR = MYSQL_BIT_OR
+		BIT_XOR = MYSQL_BIT_XOR
 		CURDATE = MYSQL_CURDATE
 		DATE_ADD = MYSQL_DATE_ADD
 		DATE_SUB = MYSQL_DATE_SUB
@@ -194,6 +198,7 @@ func initTokens(dialectType dialect.DialectType) {
 		HASH = MYSQL_HASH
 		RTREE = MYSQL_RTREE
 		UNION = MYSQL_UNION
+		INTERSECT = MYSQL_INTERSECT
 		SELECT = MYSQL_SELECT
 		STREAM = MYSQL_STREAM
 		INSERT = MYSQL_INSERT
@@ -217,6 +222,9 @@ func initTokens(dialectType dialect.DialectType) {
 		INTO = MYSQL_INTO
 		DUPLICATE = MYSQL_DUPLICATE
 		KEY = MYSQL_KEY
+		KILL = MYSQL_KILL
+		OF = MYSQL_OF
+		EPOCH = MYSQL_EPOCH
 		DEFAULT = MYSQL_DEFAULT
 		SET = MYSQL_SET
 		LOCK = MYSQL_LOCK
@@ -269,6 +277,8 @@ func initTokens(dialectType dialect.DialectType) {
 		GE = MYSQL_GE
 		NE = MYSQL_NE
 		NULL_SAFE_EQUAL = MYSQL_NULL_SAFE_EQUAL
+		JSON_EXTRACT_OP = MYSQL_JSON_EXTRACT_OP
+		JSON_UNQUOTE_EXTRACT_OP = MYSQL_JSON_UNQUOTE_EXTRACT_OP
 		IS = MYSQL_IS
 		LIKE = MYSQL_LIKE
 		REGEXP = MYSQL_REGEXP
@@ -377,6 +387,7 @@ func initTokens(dialectType dialect.DialectType) {
 		EXTENDED = MYSQL_EXTENDED
 		FULL = MYSQL_FULL
 		PROCESSLIST = MYSQL_PROCESSLIST
+		GRANTS = MYSQL_GRANTS
 		NAMES = MYSQL_NAMES
 		CHARSET = MYSQL_CHARSET
 		GLOBAL = MYSQL_GLOBAL
@@ -404,6 +415,9 @@ func initTokens(dialectType dialect.DialectType) {
 		CAST = MYSQL_CAST
 		SUBSTR = MYSQL_SUBSTR
 		SUBSTRING = MYSQL_SUBSTRING
+		LEADING = MYSQL_LEADING
+		TRAILING = MYSQL_TRAILING
+		BOTH = MYSQL_BOTH
 		GROUP_CONCAT = MYSQL_GROUP_CONCAT
 		SEPARATOR = MYSQL_SEPARATOR
 		MATCH = MYSQL_MATCH
@@ -413,6 +427,12 @@ func initTokens(dialectType dialect.DialectType) {
 		WITH = MYSQL_WITH
 		QUERY = MYSQL_QUERY
 		EXPANSION = MYSQL_EXPANSION
+		OVER = MYSQL_OVER
+		ROWS = MYSQL_ROWS
+		PRECEDING = MYSQL_PRECEDING
+		FOLLOWING = MYSQL_FOLLOWING
+		UNBOUNDED = MYSQL_UNBOUNDED
+		CURRENT = MYSQL_CURRENT
 		UNUSED = MYSQL_UNUSED
 
 	case dialect.POSTGRESQL:
@@ -454,7 +474,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"blob":                     BLOB,
 		"bool":                     BOOL,
 		"boolean":                  BOOLEAN,
-		"both":                     UNUSED,
+		"both":                     BOTH,
 		"by":                       BY,
 		"btree":                    BTREE,
 		"call":                     UNUSED,
@@ -542,6 +562,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"end":                      END,
 		"enum":                     ENUM,
 		"enforced":                 ENFORCED,
+		"epoch":                    EPOCH,
 		"escape":                   ESCAPE,
 		"escaped":                  ESCAPED,
 		"exists":                   EXISTS,
@@ -614,12 +635,12 @@ func initTokens(dialectType dialect.DialectType) {
 		"join":                     JOIN,
 		"json":                     JSON,
 		"key":                      KEY,
+		"kill":                     KILL,
 		"keys":                     KEYS,
 		"key_block_size":           KEY_BLOCK_SIZE,
-		"kill":                     UNUSED,
 		"language":                 LANGUAGE,
 		"last_insert_id":           LAST_INSERT_ID,
-		"leading":                  UNUSED,
+		"leading":                  LEADING,
 		"leave":                    UNUSED,
 		"left":                     LEFT,
 		"less":                     LESS,
@@ -676,6 +697,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"null":                     NULL,
 		"numeric":                  NUMERIC,
 		"none":                     NONE,
+		"of":                       OF,
 		"offset":                   OFFSET,
 		"on":                       ON,
 		"only":                     ONLY,
@@ -689,6 +711,12 @@ func initTokens(dialectType dialect.DialectType) {
 		"out":                      UNUSED,
 		"outer":                    OUTER,
 		"outfile":                  OUTFILE,
+		"over":                     OVER,
+		"rows":                     ROWS,
+		"preceding":                PRECEDING,
+		"following":                FOLLOWING,
+		"unbounded":                UNBOUNDED,
+		"current":                  CURRENT,
 		"header":					HEADER,
 		"max_file_size":			MAX_FILE_SIZE,
 		"force_quote":				FORCE_QUOTE,
@@ -703,6 +731,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"precision":                UNUSED,
 		"primary":                  PRIMARY,
 		"processlist":              PROCESSLIST,
+		"grants":                   GRANTS,
 		"procedure":                PROCEDURE,
 		"proxy":                    PROXY,
 		"properties":				PROPERTIES,
@@ -796,7 +825,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"tinyint":                  TINYINT,
 		"tinytext":                 TINYTEXT,
 		"to":                       TO,
-		"trailing":                 UNUSED,
+		"trailing":                 TRAILING,
 		"transaction":              TRANSACTION,
 		"trigger":                  TRIGGER,
 		"true":                     TRUE,
@@ -804,6 +833,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"uncommitted":              UNCOMMITTED,
 		"undo":                     UNUSED,
 		"union":                    UNION,
+		"intersect":                INTERSECT,
 		"unique":                   UNIQUE,
 		"unlock":                   UNLOCK,
 		"unsigned":                 UNSIGNED,
@@ -839,6 +869,9 @@ func initTokens(dialectType dialect.DialectType) {
 		"zerofill":                  ZEROFILL,
 		"zonemap":					ZONEMAP,
 		"adddate":                  ADDDATE,
+		"bit_and":                  BIT_AND,
+		"bit_or":                   BIT_OR,
+		"bit_xor":                  BIT_XOR,
 		"count":                    COUNT,
 		"approx_count_distinct":    APPROX_COUNT_DISTINCT,
 		"approx_percentile":        APPROX_PERCENTILE,
@@ -882,6 +915,9 @@ var (
 	COUNT                    int
 	APPROX_COUNT_DISTINCT    int
 	APPROX_PERCENTILE        int
+	BIT_AND                  int
+	BIT_OR                   int
+	BIT_XOR                  int
 	CURDATE                  int
 	DATE_ADD                 int
 	DATE_SUB                 int
@@ -1023,6 +1059,7 @@ var (
 	AT_ID                    int
 	AT_AT_ID                 int
 	UNION                    int
+	INTERSECT                int
 	SELECT                   int
 	STREAM                   int
 	INSERT                   int
@@ -1046,6 +1083,9 @@ var (
 	INTO                     int
 	DUPLICATE                int
 	KEY                      int
+	KILL                     int
+	OF                       int
+	EPOCH                    int
 	DEFAULT                  int
 	SET                      int
 	LOCK                     int
@@ -1098,6 +1138,8 @@ var (
 	GE                       int
 	NE                       int
 	NULL_SAFE_EQUAL          int
+	JSON_EXTRACT_OP          int
+	JSON_UNQUOTE_EXTRACT_OP  int
 	IS                       int
 	LIKE                     int
 	REGEXP                   int
@@ -1206,6 +1248,7 @@ var (
 	DATABASES                int
 	TABLES                   int
 	PROCESSLIST              int
+	GRANTS                   int
 	NAMES                    int
 	CHARSET                  int
 	GLOBAL                   int
@@ -1233,6 +1276,9 @@ var (
 	CAST                     int
 	SUBSTR                   int
 	SUBSTRING                int
+	LEADING                  int
+	TRAILING                 int
+	BOTH                     int
 	GROUP_CONCAT             int
 	SEPARATOR                int
 	MATCH                    int
@@ -1256,6 +1302,12 @@ var (
 	FORCE_QUOTE				 int
 	STARTING                 int
 	LINES                    int
+	OVER                     int
+	ROWS                     int
+	PRECEDING                int
+	FOLLOWING                int
+	UNBOUNDED                int
+	CURRENT                  int
 	UNUSED                   int
 	INT1                     int
 	INT2                     int
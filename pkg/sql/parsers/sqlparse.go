@@ -44,3 +44,23 @@ func ParseOne(dialectType dialect.DialectType, sql string) (tree.Statement, erro
 		return nil, errors.New("type of dialect error")
 	}
 }
+
+// ParseWithParams parses the sql and binds the params to its placeholders in order.
+func ParseWithParams(dialectType dialect.DialectType, sql string, params []tree.Expr) ([]tree.Statement, error) {
+	switch dialectType {
+	case dialect.MYSQL:
+		return mysql.ParseWithParams(sql, params)
+	default:
+		return nil, errors.New("type of dialect error")
+	}
+}
+
+// Prepare parses a statement to be prepared and returns the number of its placeholders.
+func Prepare(dialectType dialect.DialectType, sql string) (tree.Statement, int, error) {
+	switch dialectType {
+	case dialect.MYSQL:
+		return mysql.Prepare(sql)
+	default:
+		return nil, 0, errors.New("type of dialect error")
+	}
+}
@@ -82,3 +82,104 @@ func NewAlterUser(ife bool, iuf bool, uf *User, u []*User, r []*Role, t []TlsOpt
 		MiscOpts:   m,
 	}
 }
+
+// ALTER TABLE statement
+type AlterTable struct {
+	statementImpl
+	Table   TableName
+	Options AlterTableOptions
+}
+
+func (node *AlterTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter table ")
+	node.Table.Format(ctx)
+	prefix := " "
+	for _, opt := range node.Options {
+		ctx.WriteString(prefix)
+		opt.Format(ctx)
+		prefix = ", "
+	}
+}
+
+func NewAlterTable(t TableName, o AlterTableOptions) *AlterTable {
+	return &AlterTable{
+		Table:   t,
+		Options: o,
+	}
+}
+
+// the list of alter table options
+type AlterTableOptions []AlterTableOption
+
+type AlterTableOption interface {
+	NodeFormatter
+}
+
+type alterTableOptionImpl struct {
+	AlterTableOption
+}
+
+// ADD COLUMN option
+type AlterTableAddColumn struct {
+	alterTableOptionImpl
+	Column *ColumnTableDef
+}
+
+func (node *AlterTableAddColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("add column ")
+	node.Column.Format(ctx)
+}
+
+// DROP COLUMN option
+type AlterTableDropColumn struct {
+	alterTableOptionImpl
+	Name Identifier
+}
+
+func (node *AlterTableDropColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop column ")
+	ctx.WriteString(string(node.Name))
+}
+
+// RENAME COLUMN option
+type AlterTableRenameColumn struct {
+	alterTableOptionImpl
+	Name    Identifier
+	NewName Identifier
+}
+
+func (node *AlterTableRenameColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename column ")
+	ctx.WriteString(string(node.Name))
+	ctx.WriteString(" to ")
+	ctx.WriteString(string(node.NewName))
+}
+
+// ALTER COLUMN ... SET DEFAULT / DROP DEFAULT option
+type AlterTableAlterColumn struct {
+	alterTableOptionImpl
+	Name    Identifier
+	Default Expr //nil means DROP DEFAULT
+}
+
+func (node *AlterTableAlterColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter column ")
+	ctx.WriteString(string(node.Name))
+	if node.Default == nil {
+		ctx.WriteString(" drop default")
+		return
+	}
+	ctx.WriteString(" set default ")
+	node.Default.Format(ctx)
+}
+
+// RENAME [TO] option
+type AlterTableRename struct {
+	alterTableOptionImpl
+	Name TableName
+}
+
+func (node *AlterTableRename) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename to ")
+	node.Name.Format(ctx)
+}
@@ -26,6 +26,11 @@ type explainImpl struct {
 	Format    string
 }
 
+//ExplainFormat returns the output format of the explain statement
+func (node *explainImpl) ExplainFormat() string {
+	return node.Format
+}
+
 //EXPLAIN stmt statement
 type ExplainStmt struct {
 	explainImpl
@@ -528,6 +528,12 @@ type FuncExpr struct {
 
 	//aggregations which specify an order.
 	OrderBy OrderBy
+
+	//the separator of group_concat, nil if it is not specified.
+	Separator Expr
+
+	//the window of a window function, nil if it is not a window function.
+	WindowSpec *WindowSpec
 }
 
 func (node *FuncExpr) Format(ctx *FmtCtx) {
@@ -539,7 +545,20 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 	}
 	node.Exprs.Format(ctx)
+	if len(node.OrderBy) > 0 {
+		ctx.WriteByte(' ')
+		node.OrderBy.Format(ctx)
+	}
+	if node.Separator != nil {
+		ctx.WriteString(" separator ")
+		node.Separator.Format(ctx)
+	}
 	ctx.WriteByte(')')
+	if node.WindowSpec != nil {
+		ctx.WriteString(" over (")
+		node.WindowSpec.Format(ctx)
+		ctx.WriteByte(')')
+	}
 }
 
 func NewFuncExpr(ft FuncType, name *UnresolvedName, e Exprs, order OrderBy) *FuncExpr {
@@ -635,6 +654,23 @@ type CaseExpr struct {
 	Else  Expr
 }
 
+func (node *CaseExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString("case")
+	if node.Expr != nil {
+		ctx.WriteByte(' ')
+		node.Expr.Format(ctx)
+	}
+	for _, w := range node.Whens {
+		ctx.WriteByte(' ')
+		w.Format(ctx)
+	}
+	if node.Else != nil {
+		ctx.WriteString(" else ")
+		node.Else.Format(ctx)
+	}
+	ctx.WriteString(" end")
+}
+
 func NewCaseExpr(e Expr, w []*When, el Expr) *CaseExpr {
 	return &CaseExpr{
 		Expr:  e,
@@ -649,6 +685,13 @@ type When struct {
 	Val  Expr
 }
 
+func (node *When) Format(ctx *FmtCtx) {
+	ctx.WriteString("when ")
+	node.Cond.Format(ctx)
+	ctx.WriteString(" then ")
+	node.Val.Format(ctx)
+}
+
 func NewWhen(c, v Expr) *When {
 	return &When{
 		Cond: c,
@@ -661,8 +704,24 @@ type IntervalType int
 
 func (node *IntervalType) ToString() string {
 	switch *node {
+	case INTERVAL_TYPE_MICROSECOND:
+		return "microsecond"
 	case INTERVAL_TYPE_SECOND:
 		return "second"
+	case INTERVAL_TYPE_MINUTE:
+		return "minute"
+	case INTERVAL_TYPE_HOUR:
+		return "hour"
+	case INTERVAL_TYPE_DAY:
+		return "day"
+	case INTERVAL_TYPE_WEEK:
+		return "week"
+	case INTERVAL_TYPE_MONTH:
+		return "month"
+	case INTERVAL_TYPE_QUARTER:
+		return "quarter"
+	case INTERVAL_TYPE_YEAR:
+		return "year"
 	default:
 		return "Unknown IntervalType"
 	}
@@ -805,3 +864,20 @@ func NewVarExpr(n string, s bool, g bool, e Expr) *VarExpr {
 		Expr:   e,
 	}
 }
+
+// the placeholder '?' of a prepared statement.
+type ParamExpr struct {
+	exprImpl
+	// Offset is the position of the placeholder, starting from 1
+	Offset int
+}
+
+func (node *ParamExpr) Format(ctx *FmtCtx) {
+	ctx.WriteByte('?')
+}
+
+func NewParamExpr(offset int) *ParamExpr {
+	return &ParamExpr{
+		Offset: offset,
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strconv"
+
+//Kill statement
+type Kill struct {
+	statementImpl
+	//Query is true if only the statement of the connection is killed
+	Query        bool
+	ConnectionId uint64
+}
+
+func (node *Kill) Format(ctx *FmtCtx) {
+	ctx.WriteString("kill")
+	if node.Query {
+		ctx.WriteString(" query")
+	}
+	ctx.WriteByte(' ')
+	ctx.WriteString(strconv.FormatUint(node.ConnectionId, 10))
+}
+
+func NewKill(q bool, id uint64) *Kill {
+	return &Kill{Query: q, ConnectionId: id}
+}
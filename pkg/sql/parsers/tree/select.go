@@ -386,15 +386,62 @@ func (node *AliasClause) Format(ctx *FmtCtx) {
 	}
 }
 
+// AsOfType specifies how the point in time of an AS OF clause is given.
+type AsOfType int
+
+const (
+	AS_OF_TIMESTAMP AsOfType = iota
+	AS_OF_EPOCH
+)
+
+func (node AsOfType) ToString() string {
+	switch node {
+	case AS_OF_TIMESTAMP:
+		return "timestamp"
+	case AS_OF_EPOCH:
+		return "epoch"
+	default:
+		return "Unknown AsOfType"
+	}
+}
+
+//the point in time a table is read as of.
+//AS OF {TIMESTAMP 'yyyy-mm-dd hh:mm:ss' | EPOCH seconds}
+type AsOfClause struct {
+	NodeFormatter
+	Type AsOfType
+	Expr Expr
+}
+
+func (node *AsOfClause) Format(ctx *FmtCtx) {
+	ctx.WriteString("as of ")
+	ctx.WriteString(node.Type.ToString())
+	ctx.WriteByte(' ')
+	node.Expr.Format(ctx)
+}
+
+func NewAsOfClause(t AsOfType, e Expr) *AsOfClause {
+	return &AsOfClause{
+		Type: t,
+		Expr: e,
+	}
+}
+
 //the table expression coupled with an optional alias.
 type AliasedTableExpr struct {
 	TableExpr
 	Expr TableExpr
 	As   AliasClause
+	// AsOf is not nil if the table is read as of a past time
+	AsOf *AsOfClause
 }
 
 func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
 	node.Expr.Format(ctx)
+	if node.AsOf != nil {
+		ctx.WriteByte(' ')
+		node.AsOf.Format(ctx)
+	}
 	if node.As.Alias != "" {
 		ctx.WriteString(" as ")
 		node.As.Format(ctx)
@@ -220,6 +220,25 @@ func NewShowWarnings() *ShowWarnings {
 	return &ShowWarnings{}
 }
 
+//SHOW GRANTS statement
+type ShowGrants struct {
+	showImpl
+	Username string
+	Hostname string
+}
+
+func (node *ShowGrants) Format(ctx *FmtCtx) {
+	ctx.WriteString("show grants")
+	if node.Username != "" {
+		ctx.WriteString(" for ")
+		ctx.WriteString(node.Username)
+		if node.Hostname != "" && node.Hostname != "%" {
+			ctx.WriteByte('@')
+			ctx.WriteString(node.Hostname)
+		}
+	}
+}
+
 //SHOW VARIABLES statement
 //System Variables
 type ShowVariables struct {
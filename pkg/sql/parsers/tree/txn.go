@@ -37,6 +37,10 @@ func (node *TransactionModes) Format(ctx *FmtCtx) {
 	}
 }
 
+func (node *TransactionModes) ReadWriteMode() ReadWriteMode {
+	return node.rwMode
+}
+
 func MakeTransactionModes(rwm ReadWriteMode) TransactionModes {
 	return TransactionModes{rwMode: rwm}
 }
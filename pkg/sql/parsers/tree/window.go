@@ -0,0 +1,134 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+//the window of a window function.
+//OVER ([PARTITION BY expr, ...] [ORDER BY expr, ...] [frame_clause])
+type WindowSpec struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+func (node *WindowSpec) Format(ctx *FmtCtx) {
+	prefix := ""
+	if len(node.PartitionBy) > 0 {
+		ctx.WriteString("partition by ")
+		node.PartitionBy.Format(ctx)
+		prefix = " "
+	}
+	if len(node.OrderBy) > 0 {
+		ctx.WriteString(prefix)
+		node.OrderBy.Format(ctx)
+		prefix = " "
+	}
+	if node.Frame != nil {
+		ctx.WriteString(prefix)
+		node.Frame.Format(ctx)
+	}
+}
+
+func NewWindowSpec(partitionBy Exprs, orderBy OrderBy, frame *FrameClause) *WindowSpec {
+	return &WindowSpec{
+		PartitionBy: partitionBy,
+		OrderBy:     orderBy,
+		Frame:       frame,
+	}
+}
+
+// FrameType specifies the unit of a frame.
+type FrameType int
+
+const (
+	FRAME_ROWS FrameType = iota
+	FRAME_RANGE
+)
+
+func (node FrameType) ToString() string {
+	switch node {
+	case FRAME_ROWS:
+		return "rows"
+	case FRAME_RANGE:
+		return "range"
+	default:
+		return "Unknown FrameType"
+	}
+}
+
+// FrameBoundType specifies the type of a frame bound.
+type FrameBoundType int
+
+const (
+	UNBOUNDED_PRECEDING FrameBoundType = iota
+	PRECEDING
+	CURRENT_ROW
+	FOLLOWING
+	UNBOUNDED_FOLLOWING
+)
+
+//the start or end of a frame.
+type FrameBound struct {
+	Type FrameBoundType
+	//the offset of PRECEDING and FOLLOWING.
+	Expr Expr
+}
+
+func (node *FrameBound) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case UNBOUNDED_PRECEDING:
+		ctx.WriteString("unbounded preceding")
+	case PRECEDING:
+		node.Expr.Format(ctx)
+		ctx.WriteString(" preceding")
+	case CURRENT_ROW:
+		ctx.WriteString("current row")
+	case FOLLOWING:
+		node.Expr.Format(ctx)
+		ctx.WriteString(" following")
+	case UNBOUNDED_FOLLOWING:
+		ctx.WriteString("unbounded following")
+	}
+}
+
+func NewFrameBound(t FrameBoundType, e Expr) *FrameBound {
+	return &FrameBound{
+		Type: t,
+		Expr: e,
+	}
+}
+
+//the frame of a window.
+//{ROWS | RANGE} {frame_start | BETWEEN frame_start AND frame_end}
+type FrameClause struct {
+	Type  FrameType
+	Start *FrameBound
+	End   *FrameBound
+}
+
+func (node *FrameClause) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Type.ToString())
+	ctx.WriteString(" between ")
+	node.Start.Format(ctx)
+	ctx.WriteString(" and ")
+	node.End.Format(ctx)
+}
+
+func NewFrameClause(t FrameType, start, end *FrameBound) *FrameClause {
+	return &FrameClause{
+		Type:  t,
+		Start: start,
+		End:   end,
+	}
+}
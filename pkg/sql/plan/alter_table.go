@@ -0,0 +1,161 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// BuildAlterTable checks every option of ALTER TABLE against the definition
+// of the relation as the previous options leave it.
+func (b *build) BuildAlterTable(stmt *tree.AlterTable, plan *AlterTable) error {
+	dbName, tblName, r, err := b.tableName(&stmt.Table)
+	if err != nil {
+		return err
+	}
+	db, err := b.e.Database(dbName)
+	if err != nil {
+		r.Close()
+		return errors.New(errno.InvalidSchemaName, err.Error())
+	}
+	opts, err := b.buildAlterTableOptions(stmt.Options, dbName, tblName, db, r.TableDefs())
+	if err != nil {
+		r.Close()
+		return err
+	}
+	plan.Db = dbName
+	plan.Id = tblName
+	plan.Database = db
+	plan.Relation = r
+	plan.Options = opts
+	return nil
+}
+
+func (b *build) buildAlterTableOptions(options tree.AlterTableOptions, dbName, tblName string, db engine.Database, defs []engine.TableDef) ([]AlterTableOption, error) {
+	var attrs []engine.Attribute
+	keys := make(map[string]struct{}) // names of the primary key and indexed attributes
+	for _, def := range defs {
+		switch t := def.(type) {
+		case *engine.AttributeDef:
+			attrs = append(attrs, t.Attr)
+			if t.Attr.Primary {
+				keys[t.Attr.Name] = struct{}{}
+			}
+		case *engine.PrimaryIndexDef:
+			for _, name := range t.Names {
+				keys[name] = struct{}{}
+			}
+		case *engine.IndexTableDef:
+			for _, name := range t.ColNames {
+				keys[name] = struct{}{}
+			}
+		}
+	}
+	opts := make([]AlterTableOption, 0, len(options))
+	for _, option := range options {
+		switch n := option.(type) {
+		case *tree.AlterTableAddColumn:
+			def, pks, err := b.getTableDef(n.Column)
+			if err != nil {
+				return nil, err
+			}
+			if pks != nil {
+				return nil, errors.New(errno.FeatureNotSupported, "adding a primary key column is not supported")
+			}
+			attr := def.(*engine.AttributeDef).Attr
+			if findAttribute(attrs, attr.Name) >= 0 {
+				return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", attr.Name))
+			}
+			attrs = append(attrs, attr)
+			opts = append(opts, AlterTableOption{Type: AddColumn, Attr: attr})
+		case *tree.AlterTableDropColumn:
+			name := string(n.Name)
+			i := findAttribute(attrs, name)
+			if i < 0 {
+				return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Can't DROP '%s'; check that column/key exists", name))
+			}
+			if _, ok := keys[name]; ok {
+				return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("dropping the key column '%s' is not supported", name))
+			}
+			if len(attrs) == 1 {
+				return nil, errors.New(errno.InvalidTableDefinition, "You can't delete all columns with ALTER TABLE; use DROP TABLE instead")
+			}
+			attrs = append(attrs[:i], attrs[i+1:]...)
+			opts = append(opts, AlterTableOption{Type: DropColumn, Name: name})
+		case *tree.AlterTableRenameColumn:
+			name, newName := string(n.Name), string(n.NewName)
+			i := findAttribute(attrs, name)
+			if i < 0 {
+				return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in '%s'", name, tblName))
+			}
+			if findAttribute(attrs, newName) >= 0 {
+				return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", newName))
+			}
+			if _, ok := keys[name]; ok {
+				delete(keys, name)
+				keys[newName] = struct{}{}
+			}
+			attrs[i].Name = newName
+			opts = append(opts, AlterTableOption{Type: RenameColumn, Name: name, NewName: newName})
+		case *tree.AlterTableAlterColumn:
+			name := string(n.Name)
+			i := findAttribute(attrs, name)
+			if i < 0 {
+				return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in '%s'", name, tblName))
+			}
+			def := engine.EmptyDefaultExpr
+			if n.Default != nil {
+				col := tree.NewColumnTableDef(tree.SetUnresolvedName(name), nil, []tree.ColumnAttribute{tree.NewAttributeDefault(n.Default)})
+				if !attrs[i].Default.Exist { // not null without default
+					col.Attributes = append(col.Attributes, tree.NewAttributeNull(false))
+				}
+				var err error
+				if def, err = getDefaultExprFromColumnDef(col, &attrs[i].Type); err != nil {
+					return nil, err
+				}
+			}
+			attrs[i].Default = def
+			opts = append(opts, AlterTableOption{Type: ChangeDefault, Attr: attrs[i]})
+		case *tree.AlterTableRename:
+			if len(n.Name.SchemaName) > 0 && string(n.Name.SchemaName) != dbName {
+				return nil, errors.New(errno.FeatureNotSupported, "renaming a table to another database is not supported")
+			}
+			newName := string(n.Name.ObjectName)
+			if r, err := db.Relation(newName); err == nil {
+				r.Close()
+				return nil, errors.New(errno.DuplicateTable, fmt.Sprintf("Table '%s' already exists", newName))
+			}
+			tblName = newName
+			opts = append(opts, AlterTableOption{Type: RenameTable, NewName: newName})
+		default:
+			return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport alter table option: '%v'", option))
+		}
+	}
+	return opts, nil
+}
+
+func findAttribute(attrs []engine.Attribute, name string) int {
+	for i, attr := range attrs {
+		if attr.Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
@@ -30,12 +31,23 @@ func New(db string, sql string, e engine.Engine) *build {
 		db:  db,
 		sql: sql,
 		flg: true,
+		loc: time.Local,
 	}
 }
 
+// SetTimeZone sets the session time zone in which timestamp constants are interpreted.
+func (b *build) SetTimeZone(loc *time.Location) {
+	b.loc = loc
+}
+
 func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 	switch stmt := stmt.(type) {
 	case *tree.Select:
+		if wrapped, orderBy, fetch, err := unwrapSelect(stmt); err == nil {
+			if u, ok := wrapped.(*tree.UnionClause); ok {
+				return b.buildUnion(u, orderBy, fetch)
+			}
+		}
 		qry := &Query{
 			Limit:   -1,
 			Offset:  -1,
@@ -47,6 +59,11 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 		qry.backFill()
 		return qry, nil
 	case *tree.ParenSelect:
+		if wrapped, orderBy, fetch, err := unwrapSelect(stmt.Select); err == nil {
+			if u, ok := wrapped.(*tree.UnionClause); ok {
+				return b.buildUnion(u, orderBy, fetch)
+			}
+		}
 		qry := &Query{
 			Limit:   -1,
 			Offset:  -1,
@@ -63,6 +80,34 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 			return nil, err
 		}
 		return plan, nil
+	case *tree.Delete:
+		plan := &Delete{}
+		if err := b.BuildDelete(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.Update:
+		plan := &Update{}
+		if err := b.BuildUpdate(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.ExplainStmt:
+		// EXPLAIN tbl is a synonym of SHOW COLUMNS
+		if _, ok := stmt.Statement.(*tree.ShowColumns); ok {
+			return b.BuildStatement(stmt.Statement)
+		}
+		plan := &Explain{}
+		if err := b.BuildExplain(stmt.Statement, stmt.ExplainFormat(), plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.ExplainAnalyze:
+		plan := &Explain{Analyze: true}
+		if err := b.BuildExplain(stmt.Statement, stmt.ExplainFormat(), plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
 	case *tree.CreateDatabase:
 		plan := &CreateDatabase{E: b.e}
 		if err := b.BuildCreateDatabase(stmt, plan); err != nil {
@@ -99,6 +144,18 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 			return nil, err
 		}
 		return plan, nil
+	case *tree.AlterTable:
+		plan := &AlterTable{}
+		if err := b.BuildAlterTable(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	case *tree.OptimizeTable:
+		plan := &OptimizeTable{}
+		if err := b.BuildOptimizeTable(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
 	case *tree.ShowDatabases:
 		plan := &ShowDatabases{}
 		if err := b.BuildShowDatabases(stmt, plan); err != nil {
@@ -17,6 +17,8 @@ package plan
 import (
 	"fmt"
 	"log"
+	"reflect"
+	"strings"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -57,6 +59,32 @@ var querys = []string{
 	"select * from t1 where spID>2 AND userID <2 || userID >=2 OR userID < 2 limit 3;",
 	"select * from t1 where (spID >2  or spID <= 2) && score <> 1 AND userID/2>2;",
 	"select * from t1 where spID >2  || spID <= 2 && score !=1 limit 3;",
+	"select R.uid, S.price from R left join S on R.uid = S.uid where S.price > 3;",
+	"select R.uid from R right join S using(uid);",
+	"select R.uid, S.uid from R full outer join S on R.uid = S.uid where S.price > 3;",
+	"select count(S.price) from R join S on R.price < S.price;",
+	"select * from R, S where R.uid < S.uid;",
+	"select count(distinct uid), sum(distinct price) from R;",
+	"select uid % 2, max(price) from R group by uid % 2 having count(uid) > 1 and min(price) > 0;",
+	"select * from R where uid in (select uid from S where price > 10);",
+	"select * from R where uid not in (select uid from S);",
+	"select orderId from R where exists (select * from S where S.uid = R.uid and S.price > R.price);",
+	"select R.uid, (select max(price) from S where S.uid = R.uid) from R;",
+	"select uid, row_number() over (partition by uid order by price desc) from R;",
+	"select uid, rank() over (order by uid) as r, lag(price, 2) over (partition by uid order by price) from R order by r;",
+	"select uid, sum(price) over (partition by uid order by price rows between 1 preceding and current row) from R;",
+	"select R.uid, count(*) over (partition by R.uid), avg(S.price) over (order by S.price) from R join S on R.uid = S.uid;",
+	"select uid from R union select uid from S order by uid limit 2;",
+	"select uid, price from R intersect all select price, uid from S;",
+	"select uid from R union all select uid from S except select uid from R intersect select uid from S;",
+	"(select uid from R limit 1) union (select uid from S);",
+	"delete from t1 where userID > 2;",
+	"delete from t1 as t where t.spID = 1;",
+	"update t1 set score = score + 1 where userID between 2 and 3;",
+	"update t1 set spID = 1, score = CAST(userID AS SIGNED);",
+	"explain select userID, count(score) from t1 group by userID;",
+	"explain analyze select userID from t1 where userID > 2;",
+	"explain t1;",
 
 	`select
 		sum(lo_revenue) as revenue
@@ -187,6 +215,127 @@ func TestBuild(t *testing.T) {
 	}
 }
 
+func TestBuildJoin(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	kases := []struct {
+		query         string
+		conds         []string // join conditions
+		joins         []string
+		restrictConds []string // join restrict conditions
+	}{
+		{"select * from R join S on R.uid = S.uid;", []string{"R.uid = S.uid"}, nil, nil},
+		{"select R.uid, S.price from R left join S on R.uid = S.uid where S.price > 3;",
+			nil, []string{"left join S on R.uid = S.uid"}, []string{"S.price > 3"}},
+		{"select R.uid from R right join S using(uid);", nil, []string{"right join S on R.uid = S.uid"}, nil},
+		{"select count(S.price) from R join S on R.price < S.price;", nil, []string{"join S on R.price < S.price"}, nil},
+		{"select * from R, S where R.uid < S.uid;", nil, []string{"join S on R.uid < S.uid"}, nil},
+	}
+	for _, kase := range kases {
+		stmts, err := parsers.Parse(dialect.MYSQL, kase.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pn, err := New("test", kase.query, e).BuildStatement(stmts[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		qry := pn.(*Query)
+		if conds := stringsOf(qry.Conds); !reflect.DeepEqual(conds, kase.conds) {
+			t.Errorf("%s: expected join conditions %q, got %q", kase.query, kase.conds, conds)
+		}
+		if joins := stringsOf(qry.Joins); !reflect.DeepEqual(joins, kase.joins) {
+			t.Errorf("%s: expected joins %q, got %q", kase.query, kase.joins, joins)
+		}
+		if conds := stringsOf(qry.JoinRestrictConds); !reflect.DeepEqual(conds, kase.restrictConds) {
+			t.Errorf("%s: expected join restrict conditions %q, got %q", kase.query, kase.restrictConds, conds)
+		}
+	}
+}
+
+func TestBuildWindowError(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	for _, query := range []string{
+		"select uid from R where row_number() over () > 1;",
+		"select uid, count(*) over (partition by uid) from R group by uid;",
+		"select sum(price) over (order by uid range between 1 preceding and current row) from R;",
+		"select lag(price, -1) over (order by uid) from R;",
+		"select rank(uid) over (order by uid) from R;",
+	} {
+		stmts, err := parsers.Parse(dialect.MYSQL, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := New("test", query, e).BuildStatement(stmts[0]); err == nil {
+			t.Errorf("%s: expected error", query)
+		}
+	}
+}
+
+func TestBuildUnionError(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	for _, query := range []string{
+		"select uid from R union select uid, price from S;",
+		"select uid from R union select uid from S order by R.uid;",
+		"select uid from R except select uid from S order by price;",
+	} {
+		stmts, err := parsers.Parse(dialect.MYSQL, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := New("test", query, e).BuildStatement(stmts[0]); err == nil {
+			t.Errorf("%s: expected error", query)
+		}
+	}
+}
+
+func TestBuildExplainError(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	for _, query := range []string{
+		"explain analyze delete from t1 where userID > 2;",
+		"explain analyze update t1 set score = 1;",
+	} {
+		stmts, err := parsers.Parse(dialect.MYSQL, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := New("test", query, e).BuildStatement(stmts[0]); err == nil {
+			t.Errorf("%s: expected error", query)
+		}
+	}
+}
+
+func TestBuildSubqueryError(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	kases := []struct {
+		query string
+		msg   string
+	}{
+		{"select uid from R where price > 1 or exists (select * from S);", "'exists (select * from S)' is not support now"},
+		{"select uid from R where price > 1 or uid in (select uid from S);", "'uid in (select uid from S)' is not support now"},
+		{"select uid from R where price > 1 or uid not in (select uid from S);", "'uid not in (select uid from S)' is not support now"},
+	}
+	for _, kase := range kases {
+		stmts, err := parsers.Parse(dialect.MYSQL, kase.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = New("test", kase.query, e).BuildStatement(stmts[0]); err == nil || !strings.Contains(err.Error(), kase.msg) {
+			t.Errorf("%s: expected error %q, got %v", kase.query, kase.msg, err)
+		}
+	}
+}
+
+// stringsOf returns the strings of the elements of the slice xs
+func stringsOf(xs interface{}) []string {
+	var ss []string
+
+	v := reflect.ValueOf(xs)
+	for i := 0; i < v.Len(); i++ {
+		ss = append(ss, fmt.Sprint(v.Index(i).Interface()))
+	}
+	return ss
+}
+
 func processQuery(query string, e engine.Engine) {
 	stmts, err := parsers.Parse(dialect.MYSQL, query)
 	if err != nil {
@@ -0,0 +1,314 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"go/constant"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildCondFunc builds the conditional functions if, ifnull, coalesce and nullif,
+// it returns false if e is not one of them.
+func (b *build) buildCondFunc(name string, e *tree.FuncExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, bool, error) {
+	switch name {
+	case "if":
+		if len(e.Exprs) != 3 {
+			return nil, true, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("wrong parameters for function '%s'", name))
+		}
+		ext, err := b.buildCaseExtend(e.Exprs[:1], e.Exprs[1:2], e.Exprs[2], e, qry, fn)
+		return ext, true, err
+	case "ifnull", "coalesce":
+		if len(e.Exprs) == 0 || (name == "ifnull" && len(e.Exprs) != 2) {
+			return nil, true, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("wrong parameters for function '%s'", name))
+		}
+		ext, err := b.buildCoalesce(e, qry, fn)
+		return ext, true, err
+	case "nullif":
+		if len(e.Exprs) != 2 {
+			return nil, true, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("wrong parameters for function '%s'", name))
+		}
+		// nullif(a, b) is case when a = b then null else a end
+		cond := tree.NewComparisonExpr(tree.EQUAL, e.Exprs[0], e.Exprs[1])
+		ext, err := b.buildCaseExtend([]tree.Expr{cond}, []tree.Expr{nil}, e.Exprs[0], e, qry, fn)
+		return ext, true, err
+	}
+	return nil, false, nil
+}
+
+// buildCase builds a case expression, the simple form case x when v ... is
+// built as case when x = v ...
+func (b *build) buildCase(e *tree.CaseExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	conds := make([]tree.Expr, len(e.Whens))
+	vals := make([]tree.Expr, len(e.Whens))
+	for i, w := range e.Whens {
+		if e.Expr != nil {
+			conds[i] = tree.NewComparisonExpr(tree.EQUAL, e.Expr, w.Cond)
+		} else {
+			conds[i] = w.Cond
+		}
+		vals[i] = w.Val
+	}
+	return b.buildCaseExtend(conds, vals, e.Else, e, qry, fn)
+}
+
+// buildCaseExtend returns the case extend of conditions and values, a nil or
+// null value is null.
+func (b *build) buildCaseExtend(conds, vals []tree.Expr, els tree.Expr, n tree.Expr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	var ts []types.T
+
+	e := &extend.CaseExtend{
+		Conds: make([]extend.Extend, len(conds)),
+		Vals:  make([]extend.Extend, len(vals)),
+	}
+	for i := range conds {
+		cond, err := b.buildCondition(conds[i], qry, fn)
+		if err != nil {
+			return nil, err
+		}
+		val, err := b.buildBranch(vals[i], qry, fn)
+		if err != nil {
+			return nil, err
+		}
+		if val != nil {
+			ts = append(ts, val.ReturnType())
+		}
+		e.Conds[i], e.Vals[i] = cond, val
+	}
+	val, err := b.buildBranch(els, qry, fn)
+	if err != nil {
+		return nil, err
+	}
+	if val != nil {
+		ts = append(ts, val.ReturnType())
+	}
+	if len(ts) == 0 {
+		return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("can not determine the type of '%s'", tree.String(n, dialect.MYSQL)))
+	}
+	e.Else, e.Type = val, extend.CommonType(ts)
+	return e, nil
+}
+
+// buildCoalesce returns the coalesce extend of the arguments of e, the null
+// arguments are skipped.
+func (b *build) buildCoalesce(e *tree.FuncExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	var ts []types.T
+	var args []extend.Extend
+
+	for _, expr := range e.Exprs {
+		arg, err := b.buildBranch(expr, qry, fn)
+		if err != nil {
+			return nil, err
+		}
+		if arg != nil {
+			args = append(args, arg)
+			ts = append(ts, arg.ReturnType())
+		}
+	}
+	if len(args) == 0 {
+		return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("can not determine the type of '%s'", tree.String(e, dialect.MYSQL)))
+	}
+	return &extend.CoalesceExtend{Type: extend.CommonType(ts), Args: args}, nil
+}
+
+// buildIsNull returns x is null, or x is not null if not is set.
+func (b *build) buildIsNull(not bool, n tree.Expr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	if isNullValue(n) {
+		if not {
+			return buildInt8Value(0), nil
+		}
+		return buildInt8Value(1), nil
+	}
+	e, err := b.buildBranch(n, qry, fn)
+	if err != nil {
+		return nil, err
+	}
+	return &extend.IsNullExtend{Not: not, E: e}, nil
+}
+
+// buildIn returns x in (...) or x not in (...), it is evaluated as a set of
+// keys if all items of the list are constants, and as comparisons otherwise.
+// A null item is never equal to x, so x in a list with a null is null rather
+// than false if x is none of the other items, and x not in it is never true.
+func (b *build) buildIn(e *tree.ComparisonExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	var items []tree.Expr
+
+	tuple, ok := e.Right.(*tree.Tuple)
+	if !ok {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", tree.String(e, dialect.MYSQL)))
+	}
+	not, null := e.Op == tree.NOT_IN, false
+	for _, item := range tuple.Exprs {
+		if isNullValue(item) {
+			null = true
+			continue
+		}
+		items = append(items, item)
+	}
+	left, err := fn(e.Left, qry)
+	if err != nil {
+		return nil, err
+	}
+	isConst := true
+	args := make([]extend.Extend, len(items))
+	for i, item := range items {
+		if args[i], err = fn(item, qry); err != nil {
+			return nil, err
+		}
+		if args[i], err = b.pruneExtend(args[i], false); err != nil {
+			return nil, err
+		}
+		if _, ok := args[i].(*extend.ValueExtend); !ok {
+			isConst = false
+		}
+	}
+	if isConst {
+		return &extend.InExtend{Not: not, Null: null, E: left, Args: args}, nil
+	}
+	op, lop := overload.EQ, overload.Or
+	if not {
+		op, lop = overload.NE, overload.And
+	}
+	var r extend.Extend
+	for i, arg := range args {
+		if i > 0 {
+			if left, err = fn(e.Left, qry); err != nil {
+				return nil, err
+			}
+		}
+		cmp := &extend.BinaryExtend{Op: op, Left: left, Right: arg}
+		if r == nil {
+			r = cmp
+		} else {
+			r = &extend.BinaryExtend{Op: lop, Left: r, Right: cmp}
+		}
+	}
+	if null {
+		// the null item is compared as a list of no other items
+		if left, err = fn(e.Left, qry); err != nil {
+			return nil, err
+		}
+		r = &extend.BinaryExtend{Op: lop, Left: r, Right: &extend.InExtend{Not: not, Null: true, E: left}}
+	}
+	return &extend.ParenExtend{E: r}, nil
+}
+
+// buildCondition returns the condition of a branch, a value which is not
+// a condition is true if it is not zero, and null is false.
+func (b *build) buildCondition(n tree.Expr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	if isNullValue(n) {
+		return buildInt8Value(0), nil
+	}
+	e, err := fn(n, qry)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := e.(*extend.ValueExtend); ok || e.IsLogical() {
+		return e, nil
+	}
+	zero, err := buildValue(constant.MakeInt64(0))
+	if err != nil {
+		return nil, err
+	}
+	return &extend.BinaryExtend{Op: overload.NE, Left: e, Right: zero}, nil
+}
+
+// buildBranch returns the value of a branch, it is nil if n is nil or null.
+func (b *build) buildBranch(n tree.Expr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	if n == nil || isNullValue(n) {
+		return nil, nil
+	}
+	e, err := fn(n, qry)
+	if err != nil {
+		return nil, err
+	}
+	if e.IsLogical() {
+		return b.buildLogicalValue(e, n, qry, fn)
+	}
+	return e, nil
+}
+
+// logicalValue returns the value of a condition which is 1 if it is true and 0 otherwise.
+func logicalValue(e extend.Extend) extend.Extend {
+	return &extend.CaseExtend{
+		Type:  types.T_int8,
+		Conds: []extend.Extend{e},
+		Vals:  []extend.Extend{buildInt8Value(1)},
+		Else:  buildInt8Value(0),
+	}
+}
+
+// buildLogicalValue returns the value of the condition e built from n, it is
+// the logicalValue of e except for an in list, which is null if neither it nor
+// its inverse is true, as it is for a null x or for an x which is none of a
+// list with a null. The inverse is built from n again so that the attributes
+// of x are referred to as many times as they are used.
+func (b *build) buildLogicalValue(e extend.Extend, n tree.Expr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	for {
+		v, ok := n.(*tree.ParenExpr)
+		if !ok {
+			break
+		}
+		n = v.Expr
+	}
+	in, ok := e.(*extend.InExtend)
+	cmp, isCmp := n.(*tree.ComparisonExpr)
+	if !ok || !isCmp {
+		return logicalValue(e), nil
+	}
+	op := tree.NOT_IN
+	if in.Not {
+		op = tree.IN
+	}
+	not, err := fn(tree.NewComparisonExpr(op, cmp.Left, cmp.Right), qry)
+	if err != nil {
+		return nil, err
+	}
+	if not, err = b.pruneExtend(not, false); err != nil {
+		return nil, err
+	}
+	return &extend.CaseExtend{
+		Type:  types.T_int8,
+		Conds: []extend.Extend{e, not},
+		Vals:  []extend.Extend{buildInt8Value(1), buildInt8Value(0)},
+	}, nil
+}
+
+func buildInt8Value(v int8) *extend.ValueExtend {
+	vec := vector.New(types.Type{Oid: types.T_int8, Size: 1})
+	vec.Ref = 1
+	vec.Col = []int8{v}
+	return &extend.ValueExtend{V: vec}
+}
+
+// isNullValue returns true if n is the null literal
+func isNullValue(n tree.Expr) bool {
+	for {
+		e, ok := n.(*tree.ParenExpr)
+		if !ok {
+			break
+		}
+		n = e.Expr
+	}
+	return isNullExpr(n)
+}
@@ -213,6 +213,9 @@ func (b *build) getTableDefType(typ tree.ResolvableTypeReference) (*types.Type,
 			return &types.Type{Oid: types.T_float32, Size: 4, Width: n.InternalType.Width}, nil
 		case defines.MYSQL_TYPE_DOUBLE:
 			return &types.Type{Oid: types.T_float64, Size: 8, Width: n.InternalType.Width}, nil
+		case defines.MYSQL_TYPE_DECIMAL:
+			typ := getDecimalType(n.InternalType)
+			return &typ, nil
 		case defines.MYSQL_TYPE_STRING:
 			return &types.Type{Oid: types.T_char, Size: 24, Width: n.InternalType.DisplayWith}, nil
 		case defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_VARCHAR:
@@ -224,11 +227,30 @@ func (b *build) getTableDefType(typ tree.ResolvableTypeReference) (*types.Type,
 			return &types.Type{Oid: types.T_date, Size: 4}, nil
 		case defines.MYSQL_TYPE_DATETIME:
 			return &types.Type{Oid: types.T_datetime, Size: 8}, nil
+		case defines.MYSQL_TYPE_TIME:
+			return &types.Type{Oid: types.T_time, Size: 8}, nil
+		case defines.MYSQL_TYPE_TIMESTAMP:
+			return &types.Type{Oid: types.T_timestamp, Size: 8}, nil
+		case defines.MYSQL_TYPE_JSON:
+			return &types.Type{Oid: types.T_json, Size: 24}, nil
 		}
 	}
 	return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport type: '%v'", typ))
 }
 
+// getDecimalType returns the decimal type of DECIMAL(M, D),
+// M defaults to 10 and D defaults to 0 as mysql does.
+func getDecimalType(it tree.InternalType) types.Type {
+	width, scale := it.DisplayWith, it.Precision
+	if width <= 0 {
+		width = types.DefaultDecimalWidth
+	}
+	if scale < 0 {
+		scale = 0
+	}
+	return types.DecimalType(width, scale)
+}
+
 // getDefaultExprFromColumnDef returns
 // has default expr or not / column default expr string / is null expression / error msg
 // from column definition when create table.
@@ -268,6 +290,20 @@ func getDefaultExprFromColumnDef(column *tree.ColumnTableDef, typ *types.Type) (
 			if _, err = rangeCheck(value, *typ, "", 0); err != nil { // value out of range
 				return engine.EmptyDefaultExpr, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Invalid default value for '%s'", column.Name.Parts[0]))
 			}
+			// decimal default value is kept as its literal, the scale lives in the column type,
+			// and so is a timestamp whose value depends on the time zone
+			switch v := value.(type) {
+			case types.Decimal64:
+				value = v.ToString(typ.Precision)
+			case types.Decimal128:
+				value = v.ToString(typ.Precision)
+			case types.Time:
+				value = v.String()
+			case types.Datetime:
+				if typ.Oid == types.T_timestamp {
+					value = v.String()
+				}
+			}
 			return engine.MakeDefaultExpr(true, value, false), nil
 		}
 	}
@@ -354,8 +390,18 @@ func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber
 			return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 		}
 		return nil, errors.New(errno.DataException, fmt.Sprintf("Data too long for column '%s' at row %d", columnName, rowNumber))
-	case types.Date, types.Datetime:
+	case types.Date, types.Datetime, types.Time:
 		return v, nil
+	case types.Decimal64: // width has been checked while parsing
+		if typ.Oid == types.T_decimal64 {
+			return v, nil
+		}
+		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
+	case types.Decimal128:
+		if typ.Oid == types.T_decimal128 {
+			return v, nil
+		}
+		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 	default:
 		return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 	}
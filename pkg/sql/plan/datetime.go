@@ -0,0 +1,161 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"go/constant"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// currentTimeFuncs are the functions of the current time, they are evaluated once
+// while building the plan as the same as mysql, so all rows see the same time.
+var currentTimeFuncs = map[string]types.T{
+	"now":               types.T_datetime,
+	"current_timestamp": types.T_datetime,
+	"localtime":         types.T_datetime,
+	"localtimestamp":    types.T_datetime,
+	"sysdate":           types.T_datetime,
+	"utc_timestamp":     types.T_datetime,
+	"curdate":           types.T_date,
+	"current_date":      types.T_date,
+	"utc_date":          types.T_date,
+	"curtime":           types.T_time,
+	"current_time":      types.T_time,
+	"utc_time":          types.T_time,
+	"unix_timestamp":    types.T_int64,
+}
+
+// buildDatetimeFunc builds the functions of the current time as constants, and
+// date_add / date_sub whose interval is turned into the arguments of value and unit.
+// It returns false if e is not one of them.
+func (b *build) buildDatetimeFunc(name string, e *tree.FuncExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, bool, error) {
+	if _, ok := currentTimeFuncs[name]; ok {
+		if name == "unix_timestamp" && len(e.Exprs) > 0 {
+			return nil, false, nil
+		}
+		ext, err := b.buildCurrentTime(name, e.Exprs)
+		return ext, true, err
+	}
+	switch name {
+	case "date_add", "date_sub":
+		if len(e.Exprs) != 2 {
+			return nil, true, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("wrong parameters for function '%s'", name))
+		}
+		ext, err := b.buildDateAdd(name, e.Exprs[0], e.Exprs[1], qry, fn)
+		return ext, true, err
+	}
+	return nil, false, nil
+}
+
+// buildDateAdd returns date_add(x, n, unit) or date_sub(x, n, unit) of x and INTERVAL n unit,
+// an interval without unit is days.
+func (b *build) buildDateAdd(name string, x, interval tree.Expr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	unit := "day"
+	if iv, ok := interval.(*tree.IntervalExpr); ok {
+		if iv.Type == tree.INTERVAL_TYPE_INVALID {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", iv))
+		}
+		unit, interval = iv.Type.ToString(), iv.Expr
+	}
+	left, err := fn(x, qry)
+	if err != nil {
+		return nil, err
+	}
+	n, err := fn(interval, qry)
+	if err != nil {
+		return nil, err
+	}
+	u, err := buildValue(constant.MakeString(unit))
+	if err != nil {
+		return nil, err
+	}
+	return buildFunctionExtend(&extend.FuncExtend{Name: name, Args: []extend.Extend{left, n, u}})
+}
+
+// buildCurrentTime returns the value of a function of the current time in the
+// session time zone, the fractional seconds are kept only if a precision is given.
+func (b *build) buildCurrentTime(name string, args tree.Exprs) (extend.Extend, error) {
+	if len(args) > 1 || (len(args) == 1 && name == "unix_timestamp") {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("wrong parameters for function '%s'", name))
+	}
+	loc := b.loc
+	switch name {
+	case "utc_timestamp", "utc_date", "utc_time":
+		loc = time.UTC
+	}
+	ts := types.CurrentTimestamp()
+	if len(args) == 0 {
+		ts -= ts % 1000000
+	}
+	dt := ts.ToDatetime(loc)
+
+	vec := vector.New(currentTimeFuncs[name].ToType())
+	vec.Ref = 1
+	switch vec.Typ.Oid {
+	case types.T_date:
+		vec.Col = []types.Date{dt.ToDate()}
+	case types.T_time:
+		vec.Col = []types.Time{dt.TimeOfDay()}
+	case types.T_int64:
+		vec.Col = []int64{ts.Unix()}
+	default:
+		vec.Col = []types.Datetime{dt}
+	}
+	return &extend.ValueExtend{V: vec}, nil
+}
+
+// currentTimeLiteral replaces a function of the current time with its literal in the
+// session time zone, so that it can be inserted as a constant.
+func (b *build) currentTimeLiteral(e tree.Expr) tree.Expr {
+	f, ok := e.(*tree.FuncExpr)
+	if !ok {
+		return e
+	}
+	name, ok := f.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return e
+	}
+	funcName := strings.ToLower(name.Parts[0])
+	if _, ok := currentTimeFuncs[funcName]; !ok || (funcName == "unix_timestamp" && len(f.Exprs) > 0) {
+		return e
+	}
+	ext, err := b.buildCurrentTime(funcName, f.Exprs)
+	if err != nil {
+		return e
+	}
+	var s string
+	switch v := ext.(*extend.ValueExtend).V.Col.(type) {
+	case []int64:
+		s = strconv.FormatInt(v[0], 10)
+		return tree.NewNumVal(constant.MakeInt64(v[0]), s, false)
+	case []types.Date:
+		s = v[0].String()
+	case []types.Time:
+		s = v[0].String()
+	case []types.Datetime:
+		s = v[0].String()
+	}
+	return tree.NewNumVal(constant.MakeString(s), s, false)
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func (b *build) BuildDelete(stmt *tree.Delete, plan *Delete) error {
+	if len(stmt.OrderBy) > 0 || stmt.Limit != nil {
+		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport clause: '%s'", tree.String(stmt, dialect.MYSQL)))
+	}
+	tbl, err := getTableName(stmt.Table)
+	if err != nil {
+		return err
+	}
+	db, id, r, err := b.tableName(tbl)
+	if err != nil {
+		return err
+	}
+	plan.Id = id
+	plan.Db = db
+	plan.Relation = r
+	if plan.Qry, err = b.buildTargetQuery(stmt.Table, stmt.Where, r); err != nil {
+		return err
+	}
+	return nil
+}
+
+// buildTargetQuery builds the query which fetches all the attributes
+// of the tuples that will be modified by a delete or an update statement,
+// and their row ids if the relation r has row ids.
+func (b *build) buildTargetQuery(tbl tree.TableExpr, where *tree.Where, r engine.Relation) (*Query, error) {
+	qry := &Query{
+		Limit:   -1,
+		Offset:  -1,
+		RelsMap: make(map[string]*Relation),
+	}
+	exprs := tree.SelectExprs{tree.SelectExpr{Expr: tree.UnqualifiedStar{}}}
+	if _, ok := engine.Unwrap(r).(engine.RowIdRelation); ok {
+		exprs = append(exprs, tree.SelectExpr{Expr: tree.SetUnresolvedName(engine.RowId)})
+	}
+	stmt := &tree.Select{
+		Select: &tree.SelectClause{
+			Exprs: exprs,
+			From:  &tree.From{Tables: tree.TableExprs{tbl}},
+			Where: where,
+		},
+	}
+	if err := b.buildSelect(stmt, qry); err != nil {
+		return nil, err
+	}
+	qry.backFill()
+	return qry, nil
+}
+
+func getTableName(tbl tree.TableExpr) (*tree.TableName, error) {
+	switch t := tbl.(type) {
+	case *tree.TableName:
+		return t, nil
+	case *tree.AliasedTableExpr:
+		if n, ok := t.Expr.(*tree.TableName); ok && t.AsOf == nil {
+			return n, nil
+		}
+	}
+	return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table: '%v'", tree.String(tbl, dialect.MYSQL)))
+}
@@ -0,0 +1,47 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func (b *build) BuildExplain(stmt tree.Statement, format string, plan *Explain) error {
+	switch strings.ToLower(format) {
+	case "", "row", "traditional":
+	default:
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("explain format '%s' not support now", format))
+	}
+	pn, err := b.BuildStatement(stmt)
+	if err != nil {
+		return err
+	}
+	// EXPLAIN ANALYZE runs the statement, it is not allowed to change anything
+	if plan.Analyze {
+		switch pn.(type) {
+		case *Query, *SetQuery:
+		default:
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("explain analyze '%s' not support now", tree.String(stmt, dialect.MYSQL)))
+		}
+	}
+	plan.Plan = pn
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
@@ -188,9 +189,26 @@ func (b *build) buildCast(e *tree.CastExpr, qry *Query, fn func(tree.Expr, *Quer
 	case defines.MYSQL_TYPE_DOUBLE:
 		typ.Size = 8
 		typ.Oid = types.T_float64
+	case defines.MYSQL_TYPE_DECIMAL:
+		typ = getDecimalType(e.Type.(*tree.T).InternalType)
 	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING:
 		typ.Size = 24
 		typ.Oid = types.T_varchar
+	case defines.MYSQL_TYPE_DATE:
+		typ.Size = 4
+		typ.Oid = types.T_date
+	case defines.MYSQL_TYPE_DATETIME:
+		typ.Size = 8
+		typ.Oid = types.T_datetime
+	case defines.MYSQL_TYPE_TIME:
+		typ.Size = 8
+		typ.Oid = types.T_time
+	case defines.MYSQL_TYPE_TIMESTAMP:
+		typ.Size = 8
+		typ.Oid = types.T_timestamp
+	case defines.MYSQL_TYPE_JSON:
+		typ.Size = 24
+		typ.Oid = types.T_json
 	default:
 		return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("'%v' is not support now", e))
 	}
@@ -222,6 +240,18 @@ func (b *build) buildFunc(flg bool, e *tree.FuncExpr, qry *Query, fn func(tree.E
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
 	}
 	funcName := strings.ToLower(name.Parts[0])
+	if e.WindowSpec != nil {
+		if !flg {
+			return nil, errors.New(errno.WindowingError, fmt.Sprintf("You cannot use the window function '%s' in this context", funcName))
+		}
+		return b.buildWindow(funcName, e, qry)
+	}
+	if ext, ok, err := b.buildDatetimeFunc(funcName, e, qry, fn); ok {
+		return ext, err
+	}
+	if ext, ok, err := b.buildCondFunc(funcName, e, qry, fn); ok {
+		return ext, err
+	}
 	if !flg {
 		if _, ok := transformer.TransformerNamesMap[funcName]; ok {
 			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Invalid use of group function"))
@@ -239,10 +269,11 @@ func (b *build) buildFunc(flg bool, e *tree.FuncExpr, qry *Query, fn func(tree.E
 		return buildFunctionExtend(&extend.FuncExtend{Name: funcName, Args: args})
 	}
 	if op, ok := transformer.TransformerNamesMap[funcName]; ok {
-		if len(e.Exprs) > 1 {
-			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Illegal function call '%s'", e))
+		arg, args, err := aggregationArg(op, e)
+		if err != nil {
+			return nil, err
 		}
-		return b.buildAggregation(op, funcName, e.Exprs[0], qry, fn)
+		return b.buildAggregation(op, funcName, e.Type == tree.FUNC_TYPE_DISTINCT, arg, args, qry, fn)
 	}
 	args := make([]extend.Extend, len(e.Exprs))
 	{
@@ -264,13 +295,23 @@ func (b *build) buildHavingFunc(e *tree.FuncExpr, qry *Query, fn func(tree.Expr,
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
 	}
 	funcName := strings.ToLower(name.Parts[0])
+	if e.WindowSpec != nil {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("You cannot use the window function '%s' in this context", funcName))
+	}
+	if ext, ok, err := b.buildDatetimeFunc(funcName, e, qry, fn); ok {
+		return ext, err
+	}
+	if ext, ok, err := b.buildCondFunc(funcName, e, qry, fn); ok {
+		return ext, err
+	}
 	if op, ok := transformer.TransformerNamesMap[funcName]; ok {
-		if len(e.Exprs) > 1 {
-			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Illegal function call '%s'", e))
+		arg, args, err := aggregationArg(op, e)
+		if err != nil {
+			return nil, err
 		}
 		b.flg = false
 		defer func() { b.flg = true }()
-		return b.buildHavingAggregation(op, funcName, e.Exprs[0], qry, fn)
+		return b.buildHavingAggregation(op, funcName, e.Type == tree.FUNC_TYPE_DISTINCT, arg, args, qry, fn)
 	}
 	args := make([]extend.Extend, len(e.Exprs))
 	{
@@ -286,6 +327,16 @@ func (b *build) buildHavingFunc(e *tree.FuncExpr, qry *Query, fn func(tree.Expr,
 }
 
 func (b *build) buildBinary(e *tree.BinaryExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+	// x + INTERVAL n unit and x - INTERVAL n unit are date_add and date_sub
+	if iv, ok := e.Right.(*tree.IntervalExpr); ok && (e.Op == tree.PLUS || e.Op == tree.MINUS) {
+		if e.Op == tree.PLUS {
+			return b.buildDateAdd("date_add", e.Left, iv, qry, fn)
+		}
+		return b.buildDateAdd("date_sub", e.Left, iv, qry, fn)
+	}
+	if iv, ok := e.Left.(*tree.IntervalExpr); ok && e.Op == tree.PLUS {
+		return b.buildDateAdd("date_add", e.Right, iv, qry, fn)
+	}
 	switch e.Op {
 	case tree.PLUS:
 		left, err := fn(e.Left, qry)
@@ -423,6 +474,18 @@ func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree
 			return nil, err
 		}
 		return &extend.BinaryExtend{Op: overload.Like, Left: left, Right: right}, nil
+	case tree.REG_MATCH:
+		left, err := fn(e.Left, qry)
+		if err != nil {
+			return nil, err
+		}
+		right, err := fn(e.Right, qry)
+		if err != nil {
+			return nil, err
+		}
+		return buildFunctionExtend(&extend.FuncExtend{Name: "regexp_like", Args: []extend.Extend{left, right}})
+	case tree.IN, tree.NOT_IN:
+		return b.buildIn(e, qry, fn)
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
 }
@@ -523,16 +586,20 @@ func (b *build) buildAttribute2(flg bool, e *tree.UnresolvedName, qry *Query) (e
 	return &extend.Attribute{Name: name, Type: typ.Oid}, nil
 }
 
-func (b *build) buildAggregation(op int, name string, n tree.Expr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+func (b *build) buildAggregation(op int, name string, distinct bool, n tree.Expr, args []string, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
 	var rel string
 
 	if _, ok := n.(*tree.NumVal); ok && op == transformer.StarCount { // count(*)
-		qry.RelsMap[qry.Rels[0]].AddAggregation(&Aggregation{
-			Ref:   1,
-			Op:    op,
-			Alias: "count(*)",
-			Type:  types.T_int64,
-		})
+		if i := qry.RelsMap[qry.Rels[0]].ExistAggregation("count(*)"); i >= 0 {
+			qry.RelsMap[qry.Rels[0]].Aggregations[i].IncRef()
+		} else {
+			qry.RelsMap[qry.Rels[0]].AddAggregation(&Aggregation{
+				Ref:   1,
+				Op:    op,
+				Alias: "count(*)",
+				Type:  types.T_int64,
+			})
+		}
 		return &extend.Attribute{
 			Name: "count(*)",
 			Type: transformer.ReturnType(op, types.T_any),
@@ -562,21 +629,35 @@ func (b *build) buildAggregation(op int, name string, n tree.Expr, qry *Query, f
 	if len(mp) > 1 {
 		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("attributes involved in the aggregation must belong to the same relation"))
 	}
-	alias := fmt.Sprintf("%s(%s)", name, e)
+	alias := aggregationAlias(name, distinct, e, args)
+	if i := qry.RelsMap[rel].ExistAggregation(alias); i >= 0 {
+		qry.RelsMap[rel].Aggregations[i].IncRef()
+		return &extend.Attribute{
+			Name: alias,
+			Type: qry.RelsMap[rel].Aggregations[i].Type,
+		}, nil
+	}
 	e = pruneExtendAttribute(e)
 	if _, ok := e.(*extend.Attribute); !ok {
-		qry.RelsMap[rel].AddProjection(&ProjectionExtend{
-			Ref:   1,
-			E:     e,
-			Alias: e.String(),
-		})
+		if i := qry.RelsMap[rel].ExistProjection(e.String()); i >= 0 {
+			qry.RelsMap[rel].ProjectionExtends[i].IncRef()
+		} else {
+			qry.RelsMap[rel].AddProjection(&ProjectionExtend{
+				Ref:   1,
+				E:     e,
+				Alias: e.String(),
+			})
+		}
 	}
 	qry.RelsMap[rel].AddAggregation(&Aggregation{
-		Ref:   1,
-		Op:    op,
-		Name:  e.String(),
-		Alias: alias,
-		Type:  transformer.ReturnType(op, e.ReturnType()),
+		Ref:  1,
+		Op:   op,
+		Name: e.String(),
+		// the distinct values make no difference to max and min
+		Distinct: distinct && transformer.IsDistinctSensitive(op),
+		Alias:    alias,
+		Args:     args,
+		Type:     transformer.ReturnType(op, e.ReturnType()),
 	})
 	return &extend.Attribute{
 		Name: alias,
@@ -584,24 +665,24 @@ func (b *build) buildAggregation(op int, name string, n tree.Expr, qry *Query, f
 	}, nil
 }
 
-func (b *build) buildHavingAggregation(op int, name string, n tree.Expr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
+// buildHavingAggregation looks up the aggregation built by the projection, an
+// aggregation which only appears in the having clause is built here.
+func (b *build) buildHavingAggregation(op int, name string, distinct bool, n tree.Expr, args []string, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
 	if _, ok := n.(*tree.NumVal); ok && op == transformer.StarCount { // count(*)
-		return &extend.Attribute{
-			Name: "count(*)",
-			Type: transformer.ReturnType(op, types.T_any),
-		}, nil
+		// the count(*) of the projection is referenced once more by the having clause
+		return b.buildAggregation(op, name, false, n, nil, qry, fn)
 	}
 	e, err := fn(n, qry)
 	if err != nil {
 		return nil, err
 	}
-	col := fmt.Sprintf("%s(%s)", name, e)
+	col := aggregationAlias(name, distinct, e, args)
 	rels, typ, err := qry.getAttribute2(true, col)
 	if err != nil {
 		return nil, err
 	}
 	if len(rels) == 0 {
-		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("Illegal expression '%s' in having clause", e))
+		return b.buildAggregation(op, name, distinct, n, args, qry, b.buildWhereExpr)
 	}
 	if len(rels) > 1 {
 		return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Column '%s' in having clause is ambiguous", col))
@@ -612,6 +693,95 @@ func (b *build) buildHavingAggregation(op int, name string, n tree.Expr, qry *Qu
 	}, nil
 }
 
+// aggregationArg returns the argument aggregated by op and the constant arguments of op,
+// json_arrayagg(x) aggregates json_array(x) and json_objectagg(k, v) aggregates json_object(k, v).
+// group_concat aggregates the json array of its values and the keys of order by, and
+// percentile_cont(x, p) and approx_percentile(x, p) aggregate x with the percentile p.
+func aggregationArg(op int, e *tree.FuncExpr) (tree.Expr, []string, error) {
+	if op != transformer.GroupConcat && (len(e.OrderBy) > 0 || e.Separator != nil) {
+		return nil, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Illegal function call '%s'", e))
+	}
+	switch {
+	case op == transformer.JsonArrayAgg && len(e.Exprs) == 1:
+		return &tree.FuncExpr{
+			Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_array")),
+			Exprs: e.Exprs,
+		}, nil, nil
+	case op == transformer.JsonObjectAgg && len(e.Exprs) == 2:
+		return &tree.FuncExpr{
+			Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_object")),
+			Exprs: e.Exprs,
+		}, nil, nil
+	case op == transformer.GroupConcat && len(e.Exprs) > 0:
+		return groupConcatArg(e)
+	case (op == transformer.PercentileCont || op == transformer.ApproxPercentile) && len(e.Exprs) == 2:
+		p, err := buildConstant(types.Type{Oid: types.T_float64, Size: 8}, e.Exprs[1])
+		if err != nil {
+			return nil, nil, err
+		}
+		if v, ok := p.(float64); ok && v >= 0 && v <= 1 {
+			return e.Exprs[0], transformer.PercentileArgs(v), nil
+		}
+		return nil, nil, errors.New(errno.DataException, fmt.Sprintf("the percentile of '%s' must be a constant between 0 and 1", e))
+	case op == transformer.Count && e.Type == tree.FUNC_TYPE_DISTINCT && len(e.Exprs) > 1:
+		return distinctTupleArg(e.Exprs), nil, nil
+	case op != transformer.JsonArrayAgg && op != transformer.JsonObjectAgg && op != transformer.GroupConcat &&
+		op != transformer.PercentileCont && op != transformer.ApproxPercentile && len(e.Exprs) == 1:
+		return e.Exprs[0], nil, nil
+	}
+	return nil, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Illegal function call '%s'", e))
+}
+
+// groupConcatArg returns the json array of the values and the keys of order by of group_concat,
+// the separator is a comma by default.
+func groupConcatArg(e *tree.FuncExpr) (tree.Expr, []string, error) {
+	sep := ","
+	if e.Separator != nil {
+		num, ok := e.Separator.(*tree.NumVal)
+		if !ok || num.Value.Kind() != constant.String {
+			return nil, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("the separator of '%s' must be a string", e))
+		}
+		sep = constant.StringVal(num.Value)
+	}
+	exprs := append([]tree.Expr{}, e.Exprs...)
+	descs := make([]bool, len(e.OrderBy))
+	for i, order := range e.OrderBy {
+		exprs = append(exprs, order.Expr)
+		descs[i] = order.Direction == tree.Descending
+	}
+	return &tree.FuncExpr{
+		Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_array")),
+		Exprs: exprs,
+	}, transformer.GroupConcatArgs(len(e.Exprs), sep, descs), nil
+}
+
+// distinctTupleArg returns the argument counted by count(distinct x, y, ...), the json array
+// of the values, which is null if any of the values is null.
+func distinctTupleArg(exprs []tree.Expr) tree.Expr {
+	var cond tree.Expr = tree.NewIsNullExpr(exprs[0])
+	for _, expr := range exprs[1:] {
+		cond = tree.NewOrExpr(cond, tree.NewIsNullExpr(expr))
+	}
+	return &tree.FuncExpr{
+		Func: tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("if")),
+		Exprs: tree.Exprs{cond, tree.NewNumVal(constant.MakeUnknown(), "", false), &tree.FuncExpr{
+			Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("json_array")),
+			Exprs: exprs,
+		}},
+	}
+}
+
+func aggregationAlias(name string, distinct bool, e extend.Extend, args []string) string {
+	arg := e.String()
+	for _, v := range args {
+		arg += ", " + strconv.Quote(v)
+	}
+	if distinct {
+		return fmt.Sprintf("%s(distinct %s)", name, arg)
+	}
+	return fmt.Sprintf("%s(%s)", name, arg)
+}
+
 func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 	switch e := n.(type) {
 	case *tree.ParenExpr:
@@ -643,6 +813,10 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 				return val * -1, nil
 			case float64:
 				return val * -1, nil
+			case types.Decimal64:
+				return val * -1, nil
+			case types.Decimal128:
+				return val.Neg(), nil
 			}
 			return v, nil
 		}
@@ -753,6 +927,10 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 			return float32(floatResult), nil
 		case types.T_float64:
 			return floatResult, nil
+		case types.T_decimal64:
+			return types.Decimal64FromFloat64(floatResult, typ.Width, typ.Precision)
+		case types.T_decimal128:
+			return types.Decimal128FromFloat64(floatResult, typ.Width, typ.Precision)
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("unexpected return type '%v' for binary expression '%v'", typ, e.Op))
 		}
@@ -764,6 +942,23 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 	val := num.Value
 	str := num.String()
 
+	if val.Kind() == constant.String {
+		str = constant.StringVal(val)
+	}
+	// decimal is parsed from the literal directly to keep all its digits
+	switch typ.Oid {
+	case types.T_decimal64:
+		if val.Kind() == constant.Unknown {
+			return nil, nil
+		}
+		return types.ParseStringToDecimal64(str, typ.Width, typ.Precision)
+	case types.T_decimal128:
+		if val.Kind() == constant.Unknown {
+			return nil, nil
+		}
+		return types.ParseStringToDecimal128(str, typ.Width, typ.Precision)
+	}
+
 	switch val.Kind() {
 	case constant.Unknown:
 		return nil, nil
@@ -807,10 +1002,14 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 			if !num.Negative() {
 				return types.ParseDate(str)
 			}
-		case types.T_datetime:
+		case types.T_datetime, types.T_timestamp:
 			if !num.Negative() {
 				return types.ParseDatetime(str)
 			}
+		case types.T_time:
+			if !num.Negative() {
+				return types.ParseTime(str)
+			}
 		}
 	case constant.Float:
 		switch typ.Oid {
@@ -865,8 +1064,10 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 				return float64(-v), nil
 			}
 			return float64(v), nil
-		case types.T_datetime:
+		case types.T_datetime, types.T_timestamp:
 			return types.ParseDatetime(str)
+		case types.T_time:
+			return types.ParseTime(str)
 		}
 	case constant.String:
 		if !num.Negative() {
@@ -875,8 +1076,13 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 				return constant.StringVal(val), nil
 			case types.T_date:
 				return types.ParseDate(constant.StringVal(val))
-			case types.T_datetime:
+			case types.T_datetime, types.T_timestamp:
+				// a timestamp constant is the datetime in the session time zone
 				return types.ParseDatetime(constant.StringVal(val))
+			case types.T_time:
+				return types.ParseTime(constant.StringVal(val))
+			case types.T_json:
+				return bytejson.Parse([]byte(constant.StringVal(val)))
 			}
 		}
 	}
@@ -904,6 +1110,11 @@ func buildFunctionExtend(fe *extend.FuncExtend) (extend.Extend, error) {
 		}
 		return &extend.BinaryExtend{Op: op, Left: fe.Args[0], Right: fe.Args[1]}, nil
 	default:
+		if os, ok := overload.MultiOps[op]; ok && len(os) > 0 {
+			if len(fe.Args) < os[0].Min || (os[0].Max >= 0 && len(fe.Args) > os[0].Max) {
+				return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("wrong parameters for function '%s'", fe.Name))
+			}
+		}
 		return &extend.MultiExtend{Op: op, Args: fe.Args}, nil
 	}
 }
@@ -933,6 +1144,24 @@ func pruneExtendAttribute(e extend.Extend) extend.Extend {
 	case *extend.BinaryExtend:
 		v.Left = pruneExtendAttribute(v.Left)
 		v.Right = pruneExtendAttribute(v.Right)
+	case *extend.CaseExtend:
+		for i, cond := range v.Conds {
+			v.Conds[i] = pruneExtendAttribute(cond)
+			if v.Vals[i] != nil {
+				v.Vals[i] = pruneExtendAttribute(v.Vals[i])
+			}
+		}
+		if v.Else != nil {
+			v.Else = pruneExtendAttribute(v.Else)
+		}
+	case *extend.CoalesceExtend:
+		for i, arg := range v.Args {
+			v.Args[i] = pruneExtendAttribute(arg)
+		}
+	case *extend.IsNullExtend:
+		v.E = pruneExtendAttribute(v.E)
+	case *extend.InExtend:
+		v.E = pruneExtendAttribute(v.E)
 	}
 	return e
 }
@@ -949,6 +1178,24 @@ func pruneExtend(e extend.Extend) extend.Extend {
 	case *extend.BinaryExtend:
 		v.Left = pruneExtend(v.Left)
 		v.Right = pruneExtend(v.Right)
+	case *extend.CaseExtend:
+		for i, cond := range v.Conds {
+			v.Conds[i] = pruneExtend(cond)
+			if v.Vals[i] != nil {
+				v.Vals[i] = pruneExtend(v.Vals[i])
+			}
+		}
+		if v.Else != nil {
+			v.Else = pruneExtend(v.Else)
+		}
+	case *extend.CoalesceExtend:
+		for i, arg := range v.Args {
+			v.Args[i] = pruneExtend(arg)
+		}
+	case *extend.IsNullExtend:
+		v.E = pruneExtend(v.E)
+	case *extend.InExtend:
+		v.E = pruneExtend(v.E)
 	case *extend.MultiExtend:
 		for i, arg := range v.Args {
 			v.Args[i] = pruneExtend(arg)
@@ -957,38 +1204,17 @@ func pruneExtend(e extend.Extend) extend.Extend {
 	return e
 }
 
-func andExtends(qry *Query, e extend.Extend, es []extend.Extend) []extend.Extend {
-	if extendRelations(qry, e) == 1 {
-		return append(es, e)
-	}
+// splitAndExtend returns the conjuncts of e
+func splitAndExtend(e extend.Extend, es []extend.Extend) []extend.Extend {
 	switch v := e.(type) {
-	case *extend.UnaryExtend:
-		return nil
 	case *extend.ParenExtend:
-		return andExtends(qry, v.E, es)
-	case *extend.Attribute:
-		return es
-	case *extend.ValueExtend:
-		return es
+		return splitAndExtend(v.E, es)
 	case *extend.BinaryExtend:
-		switch v.Op {
-		case overload.EQ:
-			return append(es, v)
-		case overload.NE:
-			return append(es, v)
-		case overload.LT:
-			return append(es, v)
-		case overload.LE:
-			return append(es, v)
-		case overload.GT:
-			return append(es, v)
-		case overload.GE:
-			return append(es, v)
-		case overload.And:
-			return append(andExtends(qry, v.Left, es), andExtends(qry, v.Right, es)...)
-		}
-	}
-	return nil
+		if v.Op == overload.And {
+			return splitAndExtend(v.Right, splitAndExtend(v.Left, es))
+		}
+	}
+	return append(es, e)
 }
 
 func extendsToAndExtend(es []extend.Extend) extend.Extend {
@@ -1002,14 +1228,21 @@ func extendsToAndExtend(es []extend.Extend) extend.Extend {
 	}
 }
 
-func extendRelations(qry *Query, e extend.Extend) int {
-	attrs := e.Attributes()
-	mp := make(map[string]uint8)
-	for _, attr := range attrs {
-		rns, _, _ := qry.getAttribute0(false, attr)
-		for _, rn := range rns {
-			mp[rn]++
+// extendRelationNames returns the relations referenced by e in the order of qry.Rels
+func extendRelationNames(qry *Query, e extend.Extend) []string {
+	var rns []string
+
+	mp := make(map[string]struct{})
+	for _, attr := range e.Attributes() {
+		names, _, _ := qry.getAttribute0(false, attr)
+		for _, name := range names {
+			mp[name] = struct{}{}
+		}
+	}
+	for _, rn := range qry.Rels {
+		if _, ok := mp[rn]; ok {
+			rns = append(rns, rn)
 		}
 	}
-	return len(mp)
+	return rns
 }
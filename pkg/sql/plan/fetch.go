@@ -85,6 +85,12 @@ func (b *build) buildFetchExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
 		return b.buildCast(e, qry, b.buildFetchExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildFetchExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, b.buildFetchExpr)
+	case *tree.IsNullExpr:
+		return b.buildIsNull(false, e.Expr, qry, b.buildFetchExpr)
+	case *tree.IsNotNullExpr:
+		return b.buildIsNull(true, e.Expr, qry, b.buildFetchExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute0(false, e, qry)
 	}
@@ -16,6 +16,8 @@ package plan
 
 import (
 	"fmt"
+	"go/constant"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
@@ -52,11 +54,60 @@ func (b *build) buildFromTable(tbl tree.TableExpr, alias string, qry *Query) ([]
 	case *tree.ParenTableExpr:
 		return b.buildFromTable(tbl.Expr, alias, qry)
 	case *tree.AliasedTableExpr:
+		if tbl.AsOf != nil {
+			return b.buildAsOfTable(tbl, qry)
+		}
 		return b.buildFromTable(tbl.Expr, string(tbl.As.Alias), qry)
 	}
 	return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("Unknown table expr: %T", tbl))
 }
 
+func (b *build) buildAsOfTable(tbl *tree.AliasedTableExpr, qry *Query) ([]string, error) {
+	if _, ok := tbl.Expr.(*tree.TableName); !ok {
+		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("'%s' cannot be read as of a past time", tree.String(tbl.Expr, dialect.MYSQL)))
+	}
+	ts, err := buildAsOf(tbl.AsOf)
+	if err != nil {
+		return nil, err
+	}
+	names, err := b.buildFromTable(tbl.Expr, string(tbl.As.Alias), qry)
+	if err != nil {
+		return nil, err
+	}
+	qry.RelsMap[names[0]].AsOf = ts
+	return names, nil
+}
+
+// buildAsOf returns the unix time in nanoseconds of an as of clause,
+// the timestamps are in the local time zone.
+func buildAsOf(clause *tree.AsOfClause) (int64, error) {
+	v, ok := clause.Expr.(*tree.NumVal)
+	if !ok {
+		return 0, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport as of: '%s'", tree.String(clause, dialect.MYSQL)))
+	}
+	switch clause.Type {
+	case tree.AS_OF_TIMESTAMP:
+		if v.Value.Kind() != constant.String {
+			break
+		}
+		s := constant.StringVal(v.Value)
+		for _, layout := range []string{"2006-01-02 15:04:05.999999999", "2006-01-02"} {
+			if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+				return t.UnixNano(), nil
+			}
+		}
+		return 0, errors.New(errno.DataException, fmt.Sprintf("Incorrect datetime value: '%s'", s))
+	case tree.AS_OF_EPOCH:
+		if v.Value.Kind() != constant.Int {
+			break
+		}
+		if sec, ok := constant.Int64Val(v.Value); ok && sec > 0 {
+			return time.Unix(sec, 0).UnixNano(), nil
+		}
+	}
+	return 0, errors.New(errno.DataException, fmt.Sprintf("incorrect as of %s value: '%s'", clause.Type.ToString(), tree.String(clause.Expr, dialect.MYSQL)))
+}
+
 func (b *build) buildTable(tbl *tree.TableName, qry *Query) (string, error) {
 	var r Relation
 
@@ -91,16 +142,25 @@ func (b *build) buildJoin(stmt *tree.JoinTableExpr, qry *Query) ([]string, error
 	if err != nil {
 		return nil, err
 	}
+	typ := InnerJoin
 	{
 		switch stmt.JoinType {
 		case tree.JOIN_TYPE_FULL:
-			return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join type '%v'", stmt.JoinType))
+			typ = FullJoin
 		case tree.JOIN_TYPE_LEFT:
-			return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join type '%v'", stmt.JoinType))
+			typ = LeftJoin
 		case tree.JOIN_TYPE_RIGHT:
+			typ = RightJoin
+		case tree.JOIN_TYPE_NATURAL, tree.JOIN_TYPE_NATURAL_LEFT, tree.JOIN_TYPE_NATURAL_RIGHT:
 			return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join type '%v'", stmt.JoinType))
 		}
 	}
+	if typ != InnerJoin {
+		return append(lefts, rights...), b.buildOuterJoin(typ, stmt, lefts, rights, qry)
+	}
+	if len(rights) > 1 && qry.existOuterJoin(rights) {
+		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("'%v' is not support now", tree.String(stmt, dialect.MYSQL)))
+	}
 	if stmt.Cond == nil {
 		return append(lefts, rights...), nil
 	}
@@ -119,6 +179,40 @@ func (b *build) buildJoin(stmt *tree.JoinTableExpr, qry *Query) ([]string, error
 	return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join condition '%v'", tree.String(stmt.Cond, dialect.MYSQL)))
 }
 
+// buildOuterJoin only supports outer joins whose right side is a single relation,
+// the relations are joined from left to right, so the left side of right join and
+// full join must contain all relations in front of it.
+func (b *build) buildOuterJoin(typ int, stmt *tree.JoinTableExpr, lefts, rights []string, qry *Query) error {
+	if len(rights) > 1 || (typ != LeftJoin && len(lefts)+len(rights) != len(qry.Rels)) {
+		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("'%v' is not support now", tree.String(stmt, dialect.MYSQL)))
+	}
+	j := &Join{Type: typ, R: rights[0]}
+	switch cond := stmt.Cond.(type) {
+	case nil:
+	case *tree.OnJoinCond:
+		if existSubquery(cond.Expr) {
+			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("subquery in join condition is not support now"))
+		}
+		e, err := b.buildWhereExpr(cond.Expr, qry)
+		if err != nil {
+			return err
+		}
+		if j.Cond, err = b.pruneExtend(e, false); err != nil {
+			return err
+		}
+	case *tree.UsingJoinCond:
+		e, err := b.buildUsingJoinExtend(cond.Cols, lefts, rights, qry)
+		if err != nil {
+			return err
+		}
+		j.Cond = e
+	default:
+		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport join condition '%v'", tree.String(stmt.Cond, dialect.MYSQL)))
+	}
+	qry.Joins = append(qry.Joins, j)
+	return nil
+}
+
 func (b *build) renameRelation(old, new string, qry *Query) error {
 	v, ok := qry.RelsMap[old]
 	if !ok {
@@ -159,5 +253,12 @@ func (b *build) getAttributeInfo(schema string, name string) ([]string, map[stri
 			attrs = append(attrs, v.Attr.Name)
 		}
 	}
+	if _, ok := engine.Unwrap(r).(engine.RowIdRelation); ok {
+		// the row ids are hidden from the star
+		mp[engine.RowId] = &Attribute{
+			Name: engine.RowId,
+			Type: engine.RowIdType,
+		}
+	}
 	return attrs, mp, nil
 }
@@ -110,6 +110,12 @@ func (b *build) buildGroupByExpr(n tree.Expr, qry *Query) (extend.Extend, error)
 		return b.buildCast(e, qry, b.buildGroupByExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildGroupByExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, b.buildGroupByExpr)
+	case *tree.IsNullExpr:
+		return b.buildIsNull(false, e.Expr, qry, b.buildGroupByExpr)
+	case *tree.IsNotNullExpr:
+		return b.buildIsNull(true, e.Expr, qry, b.buildGroupByExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute1(false, e, qry)
 	}
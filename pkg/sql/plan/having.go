@@ -61,6 +61,12 @@ func (b *build) buildHavingExpr(n tree.Expr, qry *Query) (extend.Extend, error)
 		return b.buildCast(e, qry, b.buildHavingExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildHavingExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, b.buildHavingExpr)
+	case *tree.IsNullExpr:
+		return b.buildIsNull(false, e.Expr, qry, b.buildHavingExpr)
+	case *tree.IsNotNullExpr:
+		return b.buildIsNull(true, e.Expr, qry, b.buildHavingExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute2(b.flg, e, qry)
 	}
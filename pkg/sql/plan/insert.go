@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -92,6 +93,9 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 		if len(attrs) != len(rows) {
 			return errors.New(errno.InvalidColumnReference, fmt.Sprintf("Column count doesn't match value count at row '%v'", i))
 		}
+		for j := range rows {
+			rows[j] = b.currentTimeLiteral(rows[j])
+		}
 	}
 
 	bat = batch.New(true, attrs)
@@ -332,6 +336,50 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
+		case types.T_decimal64:
+			vs := make([]types.Decimal64, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := rangeCheck(v.(types.Decimal64), vec.Typ, bat.Attrs[i], j+1); err != nil {
+							return err
+						} else {
+							vs[j] = vv.(types.Decimal64)
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
+		case types.T_decimal128:
+			vs := make([]types.Decimal128, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						if vv, err := rangeCheck(v.(types.Decimal128), vec.Typ, bat.Attrs[i], j+1); err != nil {
+							return err
+						} else {
+							vs[j] = vv.(types.Decimal128)
+						}
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
 		case types.T_char, types.T_varchar:
 			vs := make([][]byte, len(rows.Rows))
 			{
@@ -398,6 +446,63 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
+		case types.T_time:
+			vs := make([]types.Time, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						vs[j] = v.(types.Time)
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
+		case types.T_timestamp:
+			vs := make([]types.Timestamp, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						vs[j] = v.(types.Datetime).ToTimestamp(b.loc)
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
+		case types.T_json:
+			vs := make([][]byte, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						if err == bytejson.ErrInvalidJson {
+							return errors.New(errno.DataException, fmt.Sprintf("Invalid JSON text for column '%s' at row %d", bat.Attrs[i], j+1))
+						}
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						vs[j] = v.(bytejson.ByteJson)
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
 		default:
 			return errors.New(errno.DatatypeMismatch, fmt.Sprintf("insert for type '%v' not implement now", vec.Typ))
 		}
@@ -430,7 +535,11 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			vec.Col = make([]float32, len(rows.Rows))
 		case types.T_float64:
 			vec.Col = make([]float64, len(rows.Rows))
-		case types.T_char, types.T_varchar:
+		case types.T_decimal64:
+			vec.Col = make([]types.Decimal64, len(rows.Rows))
+		case types.T_decimal128:
+			vec.Col = make([]types.Decimal128, len(rows.Rows))
+		case types.T_char, types.T_varchar, types.T_json:
 			col := &types.Bytes{}
 			if err = col.Append(make([][]byte, len(rows.Rows))); err != nil {
 				return err
@@ -440,6 +549,10 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			vec.Col = make([]types.Date, len(rows.Rows))
 		case types.T_datetime:
 			vec.Col = make([]types.Datetime, len(rows.Rows))
+		case types.T_time:
+			vec.Col = make([]types.Time, len(rows.Rows))
+		case types.T_timestamp:
+			vec.Col = make([]types.Timestamp, len(rows.Rows))
 		default:
 			return errors.New(errno.DatatypeMismatch, fmt.Sprintf("insert for type '%v' not implement now", vec.Typ))
 		}
@@ -470,7 +583,7 @@ func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 		res := value.(float64)
 		str := strconv.FormatFloat(res, 'f', 10, 64)
 		return tree.NewNumVal(constant.MakeFloat64(res), str, res < 0)
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_decimal64, types.T_decimal128, types.T_time, types.T_timestamp:
 		res := value.(string)
 		return tree.NewNumVal(constant.MakeString(res), res, false)
 	case types.T_date:
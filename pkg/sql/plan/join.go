@@ -18,40 +18,38 @@ import (
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
-	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
 func (b *build) buildJoinCond(expr tree.Expr, rs, ss []string, qry *Query) error {
-	switch e := expr.(type) {
-	case *tree.AndExpr:
-		if err := b.buildJoinCond(e.Left, rs, ss, qry); err != nil {
-			return err
-		}
-		return b.buildJoinCond(e.Right, rs, ss, qry)
-	case *tree.ComparisonExpr:
-		if e.Op != tree.EQUAL {
-			return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition '%v'", tree.String(expr, dialect.MYSQL)))
-		}
-		left, ok := getColumnName(e.Left)
-		if !ok {
-			return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition '%v'", tree.String(expr, dialect.MYSQL)))
-		}
-		right, ok := getColumnName(e.Right)
-		if !ok {
-			return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition '%v'", tree.String(expr, dialect.MYSQL)))
-		}
-		r, rattr, err := qry.getJoinAttribute(true, append(rs, ss...), left)
+	if existSubquery(expr) {
+		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("subquery in join condition is not support now"))
+	}
+	e, err := b.buildWhereExpr(expr, qry)
+	if err != nil {
+		return err
+	}
+	if e, err = b.pruneExtend(e, false); err != nil {
+		return err
+	}
+	return b.buildRestrict(e, ss[len(ss)-1], qry)
+}
+
+func (b *build) buildUsingJoinCond(cols tree.IdentifierList, rs, ss []string, qry *Query) error {
+	for _, col := range cols {
+		r, rattr, err := qry.getJoinAttribute(true, rs, string(col))
 		if err != nil {
 			return err
 		}
-		s, sattr, err := qry.getJoinAttribute(true, append(rs, ss...), right)
+		s, sattr, err := qry.getJoinAttribute(true, ss, string(col))
 		if err != nil {
 			return err
 		}
 		if qry.RelsMap[r].AttrsMap[rattr].Type.Oid != qry.RelsMap[s].AttrsMap[sattr].Type.Oid {
-			return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition '%v'", tree.String(expr, dialect.MYSQL)))
+			return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition 'using(%v)'", col))
 		}
 		qry.Conds = append(qry.Conds, &JoinCondition{
 			R:     r,
@@ -59,30 +57,152 @@ func (b *build) buildJoinCond(expr tree.Expr, rs, ss []string, qry *Query) error
 			Rattr: rattr,
 			Sattr: sattr,
 		})
-		return nil
 	}
-	return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition '%v'", tree.String(expr, dialect.MYSQL)))
+	return nil
 }
 
-func (b *build) buildUsingJoinCond(cols tree.IdentifierList, rs, ss []string, qry *Query) error {
+func (b *build) buildUsingJoinExtend(cols tree.IdentifierList, rs, ss []string, qry *Query) (extend.Extend, error) {
+	var es []extend.Extend
+
 	for _, col := range cols {
 		r, rattr, err := qry.getJoinAttribute(true, rs, string(col))
 		if err != nil {
-			return err
+			return nil, err
 		}
 		s, sattr, err := qry.getJoinAttribute(true, ss, string(col))
 		if err != nil {
-			return err
+			return nil, err
 		}
-		if qry.RelsMap[r].AttrsMap[rattr].Type.Oid != qry.RelsMap[s].AttrsMap[sattr].Type.Oid {
-			return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition 'using(%v)'", col))
+		typ := qry.RelsMap[r].AttrsMap[rattr].Type.Oid
+		if typ != qry.RelsMap[s].AttrsMap[sattr].Type.Oid {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition 'using(%v)'", col))
 		}
-		qry.Conds = append(qry.Conds, &JoinCondition{
-			R:     r,
-			S:     s,
-			Rattr: rattr,
-			Sattr: sattr,
+		es = append(es, &extend.BinaryExtend{
+			Op:    overload.EQ,
+			Left:  &extend.Attribute{Name: r + "." + rattr, Type: typ},
+			Right: &extend.Attribute{Name: s + "." + sattr, Type: typ},
 		})
 	}
+	return extendsToAndExtend(es), nil
+}
+
+// buildRestrict places each conjunct of e, rn is the last relation of the inner join
+// which e belongs to, and it is empty if e is the where clause.
+//
+//	. conjunct without relation is evaluated at last
+//	. conjunct on the null-supplying relations of outer joins is evaluated after the joins
+//	. conjunct on one relation is pushed down to the relation
+//	. equi-join on two relations is converted to join condition
+//	. others are evaluated when the last relation of them is joined
+func (b *build) buildRestrict(e extend.Extend, rn string, qry *Query) error {
+	for _, e := range splitAndExtend(e, nil) {
+		rns := extendRelationNames(qry, e)
+		switch {
+		case len(rns) == 0:
+			qry.RestrictConds = append(qry.RestrictConds, e)
+		case qry.isNullable(rns):
+			if len(rn) == 0 {
+				qry.JoinRestrictConds = append(qry.JoinRestrictConds, e)
+			} else {
+				qry.addJoin(rn, e)
+			}
+		case len(rns) == 1:
+			qry.RelsMap[rns[0]].AddRestrict(pruneExtend(e))
+		default:
+			if cond, ok := qry.getJoinCondition(e); ok {
+				qry.Conds = append(qry.Conds, cond)
+			} else {
+				qry.addJoin(rns[len(rns)-1], e)
+			}
+		}
+	}
 	return nil
 }
+
+// getJoinCondition converts R.Rattr = S.Sattr to join condition
+func (qry *Query) getJoinCondition(e extend.Extend) (*JoinCondition, bool) {
+	left, right, ok := stripEqual(e)
+	if !ok {
+		return nil, false
+	}
+	r, rattr, err := qry.getJoinAttribute(false, qry.Rels, left)
+	if err != nil {
+		return nil, false
+	}
+	s, sattr, err := qry.getJoinAttribute(false, qry.Rels, right)
+	if err != nil {
+		return nil, false
+	}
+	if r == s || qry.RelsMap[r].AttrsMap[rattr].Type.Oid != qry.RelsMap[s].AttrsMap[sattr].Type.Oid {
+		return nil, false
+	}
+	return &JoinCondition{
+		R:     r,
+		S:     s,
+		Rattr: rattr,
+		Sattr: sattr,
+	}, true
+}
+
+// addJoin adds e to the condition of the join of relation rn
+func (qry *Query) addJoin(rn string, e extend.Extend) {
+	for _, j := range qry.Joins {
+		if j.R == rn {
+			if j.Cond == nil {
+				j.Cond = e
+			} else {
+				j.Cond = &extend.BinaryExtend{Op: overload.And, Left: j.Cond, Right: e}
+			}
+			return
+		}
+	}
+	qry.Joins = append(qry.Joins, &Join{Type: InnerJoin, R: rn, Cond: e})
+}
+
+// existOuterJoin returns true if any of the relations is joined by outer join
+func (qry *Query) existOuterJoin(rns []string) bool {
+	for _, j := range qry.Joins {
+		if j.Type == InnerJoin {
+			continue
+		}
+		for _, rn := range rns {
+			if j.R == rn {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isNullable returns true if any of the relations may be padded with nulls by outer joins
+func (qry *Query) isNullable(rns []string) bool {
+	for _, j := range qry.Joins {
+		idx := qry.relationIndex(j.R)
+		for _, rn := range rns {
+			switch j.Type {
+			case LeftJoin, SingleJoin:
+				if rn == j.R {
+					return true
+				}
+			case RightJoin:
+				if qry.relationIndex(rn) < idx {
+					return true
+				}
+			case FullJoin:
+				if qry.relationIndex(rn) <= idx {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (qry *Query) relationIndex(rn string) int {
+	for i, name := range qry.Rels {
+		if name == rn {
+			return i
+		}
+	}
+	return -1
+}
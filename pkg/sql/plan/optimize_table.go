@@ -0,0 +1,35 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func (b *build) BuildOptimizeTable(stmt *tree.OptimizeTable, plan *OptimizeTable) error {
+	for _, tbl := range stmt.Tables {
+		dbName, tblName, r, err := b.tableName(tbl)
+		if err != nil {
+			for _, r := range plan.Relations {
+				r.Close()
+			}
+			return err
+		}
+		plan.Dbs = append(plan.Dbs, dbName)
+		plan.Ids = append(plan.Ids, tblName)
+		plan.Relations = append(plan.Relations, r)
+	}
+	return nil
+}
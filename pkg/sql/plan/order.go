@@ -151,6 +151,12 @@ func (b *build) buildOrderByExpr(n tree.Expr, qry *Query) (extend.Extend, error)
 		return b.buildCast(e, qry, b.buildOrderByExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildOrderByExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, b.buildOrderByExpr)
+	case *tree.IsNullExpr:
+		return b.buildIsNull(false, e.Expr, qry, b.buildOrderByExpr)
+	case *tree.IsNotNullExpr:
+		return b.buildIsNull(true, e.Expr, qry, b.buildOrderByExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute2(false, e, qry)
 	}
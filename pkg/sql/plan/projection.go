@@ -59,6 +59,12 @@ func (b *build) buildProjection(exprs tree.SelectExprs, qry *Query) error {
 		if e, err = b.pruneExtend(e, true); err != nil {
 			return err
 		}
+		name := e.String()
+		if e.IsLogical() || e.ReturnType() == types.T_sel {
+			if e, err = b.buildLogicalValue(e, expr.Expr, qry, b.buildProjectionExpr); err != nil {
+				return err
+			}
+		}
 		{
 			if len(expr.As) > 0 {
 				qry.ResultAttributes = append(qry.ResultAttributes, &Attribute{
@@ -67,7 +73,7 @@ func (b *build) buildProjection(exprs tree.SelectExprs, qry *Query) error {
 				})
 			} else {
 				qry.ResultAttributes = append(qry.ResultAttributes, &Attribute{
-					Name: e.String(),
+					Name: name,
 					Type: types.Type{Oid: e.ReturnType()},
 				})
 
@@ -83,7 +89,7 @@ func (b *build) buildProjection(exprs tree.SelectExprs, qry *Query) error {
 			es = append(es, &ProjectionExtend{
 				Ref:   1,
 				E:     e,
-				Alias: e.String(),
+				Alias: name,
 			})
 		}
 	}
@@ -99,6 +105,9 @@ func (b *build) buildProjection(exprs tree.SelectExprs, qry *Query) error {
 		}
 	}
 	for i := 0; i < len(es); i++ {
+		if qry.windowAttributes(es[i].E.Attributes()) {
+			continue
+		}
 		{
 			var rn string
 
@@ -154,8 +163,16 @@ func (b *build) buildProjectionExpr(n tree.Expr, qry *Query) (extend.Extend, err
 		return b.buildCast(e, qry, b.buildProjectionExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildProjectionExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, b.buildProjectionExpr)
+	case *tree.IsNullExpr:
+		return b.buildIsNull(false, e.Expr, qry, b.buildProjectionExpr)
+	case *tree.IsNotNullExpr:
+		return b.buildIsNull(true, e.Expr, qry, b.buildProjectionExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute0(true, e, qry)
+	case *tree.Subquery:
+		return b.buildScalarSubquery(e, qry)
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", tree.String(n, dialect.MYSQL)))
 }
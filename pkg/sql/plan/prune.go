@@ -48,6 +48,17 @@ func (b *build) pruneExtend(e extend.Extend, isProjection bool) (extend.Extend,
 		if n.Op == overload.UnaryMinus {
 			return b.pruneUnaryMinus(n)
 		}
+		if n.E, err = b.pruneExtend(n.E, false); err != nil {
+			return nil, err
+		}
+		return n, nil
+	case *extend.MultiExtend:
+		for i := range n.Args {
+			if n.Args[i], err = b.pruneExtend(n.Args[i], false); err != nil {
+				return nil, err
+			}
+		}
+		return n, nil
 	case *extend.ParenExtend:
 		if n.E, err = b.pruneExtend(n.E, false); err != nil {
 			return nil, err
@@ -90,6 +101,41 @@ func (b *build) pruneExtend(e extend.Extend, isProjection bool) (extend.Extend,
 		case overload.Like:
 			return b.pruneLike(n)
 		}
+	case *extend.CaseExtend:
+		for i := range n.Conds {
+			if n.Conds[i], err = b.pruneExtend(n.Conds[i], false); err != nil {
+				return nil, err
+			}
+			if n.Vals[i] == nil {
+				continue
+			}
+			if n.Vals[i], err = b.pruneExtend(n.Vals[i], false); err != nil {
+				return nil, err
+			}
+		}
+		if n.Else != nil {
+			if n.Else, err = b.pruneExtend(n.Else, false); err != nil {
+				return nil, err
+			}
+		}
+		return n, nil
+	case *extend.CoalesceExtend:
+		for i := range n.Args {
+			if n.Args[i], err = b.pruneExtend(n.Args[i], false); err != nil {
+				return nil, err
+			}
+		}
+		return n, nil
+	case *extend.IsNullExtend:
+		if n.E, err = b.pruneExtend(n.E, false); err != nil {
+			return nil, err
+		}
+		return n, nil
+	case *extend.InExtend:
+		if n.E, err = b.pruneExtend(n.E, false); err != nil {
+			return nil, err
+		}
+		return n, nil
 	}
 	return e, nil
 }
@@ -170,6 +216,10 @@ func logicInverse(e extend.Extend) extend.Extend {
 		return &extend.ParenExtend{E: logicInverse(v.E)}
 	case *extend.BinaryExtend:
 		return splitNotBinary(v)
+	case *extend.IsNullExtend:
+		return &extend.IsNullExtend{Not: !v.Not, E: v.E}
+	case *extend.InExtend:
+		return &extend.InExtend{Not: !v.Not, Null: v.Null, E: v.E, Args: v.Args}
 	case *extend.UnaryExtend:
 		if v.Op == overload.Not {
 			return logicInverse(v.E)
@@ -921,6 +971,8 @@ func (b *build) pruneEq(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_time:
+		case types.T_timestamp:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -976,6 +1028,8 @@ func (b *build) pruneEq(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_time:
+		case types.T_timestamp:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1037,6 +1091,8 @@ func (b *build) pruneNe(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_time:
+		case types.T_timestamp:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1092,6 +1148,8 @@ func (b *build) pruneNe(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_time:
+		case types.T_timestamp:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1153,6 +1211,8 @@ func (b *build) pruneLt(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_time:
+		case types.T_timestamp:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1208,6 +1268,8 @@ func (b *build) pruneLt(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_time:
+		case types.T_timestamp:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1269,6 +1331,8 @@ func (b *build) pruneLe(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_time:
+		case types.T_timestamp:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1324,6 +1388,8 @@ func (b *build) pruneLe(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_time:
+		case types.T_timestamp:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1385,6 +1451,8 @@ func (b *build) pruneGt(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_time:
+		case types.T_timestamp:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1440,6 +1508,8 @@ func (b *build) pruneGt(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_time:
+		case types.T_timestamp:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1501,6 +1571,8 @@ func (b *build) pruneGe(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_time:
+		case types.T_timestamp:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -1556,6 +1628,8 @@ func (b *build) pruneGe(e *extend.BinaryExtend) (extend.Extend, error) {
 		case types.T_varchar:
 		case types.T_date:
 		case types.T_datetime:
+		case types.T_time:
+		case types.T_timestamp:
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("illegal expression '%s'", e))
 		}
@@ -32,6 +32,15 @@ func (b *build) buildSelectStatement(stmt tree.SelectStatement, qry *Query) erro
 }
 
 func (b *build) buildSelect(stmt *tree.Select, qry *Query) error {
+	wrapped, orderBy, fetch, err := unwrapSelect(stmt)
+	if err != nil {
+		return err
+	}
+	return b.buildSelectWithoutParens(wrapped, orderBy, fetch, qry)
+}
+
+// unwrapSelect returns the select statement enclosed in parentheses with its ORDER BY and LIMIT
+func unwrapSelect(stmt *tree.Select) (tree.SelectStatement, tree.OrderBy, *tree.Limit, error) {
 	fetch := stmt.Limit
 	wrapped := stmt.Select
 	orderBy := stmt.OrderBy
@@ -40,18 +49,18 @@ func (b *build) buildSelect(stmt *tree.Select, qry *Query) error {
 		wrapped = stmt.Select
 		if stmt.OrderBy != nil {
 			if orderBy != nil {
-				return errors.New(errno.SyntaxErrororAccessRuleViolation, "multiple ORDER BY clauses not allowed")
+				return nil, nil, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "multiple ORDER BY clauses not allowed")
 			}
 			orderBy = stmt.OrderBy
 		}
 		if stmt.Limit != nil {
 			if fetch != nil {
-				return errors.New(errno.SyntaxErrororAccessRuleViolation, "multiple LIMIT clauses not allowed")
+				return nil, nil, nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "multiple LIMIT clauses not allowed")
 			}
 			fetch = stmt.Limit
 		}
 	}
-	return b.buildSelectWithoutParens(wrapped, orderBy, fetch, qry)
+	return wrapped, orderBy, fetch, nil
 }
 
 func (b *build) buildSelectWithoutParens(stmt tree.SelectStatement, orderBy tree.OrderBy, fetch *tree.Limit, qry *Query) error {
@@ -114,5 +123,17 @@ func (b *build) buildSelectClause(stmt *tree.SelectClause, qry *Query) error {
 			return err
 		}
 	}
+
+	// window functions are evaluated on the rows of relations
+	if len(qry.Windows) > 0 {
+		if len(stmt.GroupBy) > 0 || stmt.Having != nil {
+			return errors.New(errno.FeatureNotSupported, "window function in aggregate query is not support now")
+		}
+		for _, rel := range qry.RelsMap {
+			if len(rel.Aggregations) > 0 {
+				return errors.New(errno.FeatureNotSupported, "window function in aggregate query is not support now")
+			}
+		}
+	}
 	return nil
 }
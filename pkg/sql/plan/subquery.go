@@ -0,0 +1,458 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+)
+
+// kinds of subquery
+const (
+	existsSubquery = iota
+	inSubquery
+	scalarSubquery
+)
+
+// subquery is a subquery of where clause or projection, it is decorrelated
+// into a relation which is joined with the relations of outer query.
+type subquery struct {
+	alias string       // alias of the relation
+	attrs []string     // attributes of the relation
+	stmt  *tree.Select // decorrelated statement
+	// correlated conditions whose attributes of subquery have been
+	// replaced by the attributes of the relation
+	conds []tree.Expr
+}
+
+// buildSubqueryPredicate builds the EXISTS, NOT EXISTS, IN and NOT IN
+// predicate of where clause, the relation of subquery is semi-joined or
+// anti-joined with all relations in front of it.
+func (b *build) buildSubqueryPredicate(e tree.Expr, qry *Query) error {
+	var err error
+
+	sq, left, typ, _ := subqueryPredicate(e)
+	kind := existsSubquery
+	if left != nil {
+		kind = inSubquery
+	}
+	s, err := b.decorrelate(sq, kind, qry)
+	if err != nil {
+		return err
+	}
+	if err := b.buildSubqueryRelation(s, qry); err != nil {
+		return err
+	}
+	conds := s.conds
+	if left != nil {
+		conds = append([]tree.Expr{tree.NewComparisonExpr(tree.EQUAL, left, s.name(0))}, conds...)
+	}
+	j := &Join{Type: typ, R: s.alias, NullAware: typ == AntiJoin && left != nil}
+	if j.Cond, err = b.buildSubqueryCond(conds, qry); err != nil {
+		return err
+	}
+	if j.NullAware { // the hash join is required by the null of NOT IN
+		if left, right, ok := stripEqual(splitAndExtend(j.Cond, nil)[0]); !ok ||
+			qry.attributeType(left) != qry.attributeType(right) {
+			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("'%v' is not support now", tree.String(e, dialect.MYSQL)))
+		}
+	}
+	qry.Joins = append(qry.Joins, j)
+	return nil
+}
+
+// buildScalarSubquery builds the subquery which returns a single value, the
+// relation of subquery is left joined with all relations in front of it, and
+// it is an error if a row is joined with more than one row of subquery.
+func (b *build) buildScalarSubquery(sq *tree.Subquery, qry *Query) (extend.Extend, error) {
+	var err error
+
+	if sq.Exists {
+		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("'%v' is not support now", tree.String(sq, dialect.MYSQL)))
+	}
+	s, err := b.decorrelate(sq, scalarSubquery, qry)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.buildSubqueryRelation(s, qry); err != nil {
+		return nil, err
+	}
+	j := &Join{Type: SingleJoin, R: s.alias}
+	if j.Cond, err = b.buildSubqueryCond(s.conds, qry); err != nil {
+		return nil, err
+	}
+	qry.Joins = append(qry.Joins, j)
+	return b.buildAttribute0(true, s.name(0), qry)
+}
+
+// decorrelate pulls the correlated conditions out of the where clause of subquery,
+// and the attributes of subquery used by them are added to the projection of
+// subquery. The aggregation of scalar subquery is grouped by these attributes.
+func (b *build) decorrelate(sq *tree.Subquery, kind int, qry *Query) (*subquery, error) {
+	stmt, clause, err := unwrapSubquery(sq)
+	if err != nil {
+		return nil, err
+	}
+	if clause.From == nil {
+		return nil, errors.New(errno.SQLStatementNotYetComplete, "need from clause")
+	}
+	s := &subquery{alias: fmt.Sprintf("#subquery%d", qry.subqueryCount()+1)}
+	inner := &Query{ // relations of subquery which are used to find the correlated attributes
+		Limit:   -1,
+		Offset:  -1,
+		RelsMap: make(map[string]*Relation),
+	}
+	if err := b.buildFrom(clause.From.Tables, inner); err != nil {
+		return nil, err
+	}
+	var where []tree.Expr
+	if clause.Where != nil {
+		for _, e := range splitAndExpr(clause.Where.Expr, nil) {
+			if isCorrelated(e, inner, qry) {
+				s.conds = append(s.conds, e)
+			} else {
+				where = append(where, e)
+			}
+		}
+	}
+	aggregated := len(clause.GroupBy) > 0 || clause.Having != nil || existAggregation(clause.Exprs)
+	if len(s.conds) > 0 {
+		if stmt.Limit != nil || len(clause.GroupBy) > 0 || clause.Having != nil || (aggregated && kind != scalarSubquery) {
+			return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("correlated subquery '%v' is not support now", tree.String(sq, dialect.MYSQL)))
+		}
+		if aggregated {
+			for _, cond := range s.conds {
+				if e, ok := cond.(*tree.ComparisonExpr); !ok || e.Op != tree.EQUAL {
+					return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("correlated condition '%v' of aggregation is not support now", tree.String(cond, dialect.MYSQL)))
+				}
+			}
+			if existCount(clause.Exprs) { // count of the rows without matched rows is not 0 but null
+				return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("correlated subquery '%v' is not support now", tree.String(sq, dialect.MYSQL)))
+			}
+		}
+	}
+	c := *clause
+	c.Where = nil
+	if len(where) > 0 {
+		c.Where = tree.NewWhere(andExprs(where))
+	}
+	if kind == existsSubquery { // the projection of EXISTS is useless
+		c.Exprs = nil
+	} else {
+		if len(clause.Exprs) != 1 {
+			return nil, errors.New(errno.CardinalityViolation, "Operand should contain 1 column(s)")
+		}
+		if name, ok := clause.Exprs[0].Expr.(*tree.UnresolvedName); ok && name.Star {
+			return nil, errors.New(errno.CardinalityViolation, "Operand should contain 1 column(s)")
+		}
+		c.Exprs = tree.SelectExprs{s.addAttribute(clause.Exprs[0].Expr)}
+	}
+	{ // replace the attributes of subquery in the correlated conditions
+		mp := make(map[string]*tree.UnresolvedName)
+		for _, cond := range s.conds {
+			walkExpr(cond, func(e tree.Expr) {
+				n, ok := e.(*tree.UnresolvedName)
+				if !ok || !isAttributeOf(n, inner) {
+					return
+				}
+				name := attributeName(n)
+				if _, ok := mp[name]; !ok {
+					v := *n
+					c.Exprs = append(c.Exprs, s.addAttribute(&v))
+					if aggregated {
+						c.GroupBy = append(c.GroupBy, &v)
+					}
+					mp[name] = s.name(len(s.attrs) - 1)
+				}
+				*n = *mp[name]
+			})
+		}
+	}
+	if len(c.Exprs) == 0 {
+		c.Exprs = clause.Exprs
+	}
+	s.stmt = &tree.Select{Select: &c, OrderBy: stmt.OrderBy, Limit: stmt.Limit}
+	if len(s.conds) > 0 { // order is useless without limit
+		s.stmt.OrderBy = nil
+	}
+	return s, nil
+}
+
+// buildSubqueryRelation builds the query of subquery, and adds its relation to the outer query.
+func (b *build) buildSubqueryRelation(s *subquery, qry *Query) error {
+	sub := &Query{
+		Limit:   -1,
+		Offset:  -1,
+		RelsMap: make(map[string]*Relation),
+	}
+	if err := b.buildSelect(s.stmt, sub); err != nil {
+		return err
+	}
+	sub.backFill()
+	rel := &Relation{
+		Alias:    s.alias,
+		Query:    sub,
+		AttrsMap: make(map[string]*Attribute),
+	}
+	for _, attr := range sub.ResultAttributes {
+		for _, name := range s.attrs {
+			if attr.Name == name {
+				rel.Attrs = append(rel.Attrs, name)
+				rel.AttrsMap[name] = &Attribute{Name: name, Type: attr.Type}
+			}
+		}
+	}
+	qry.Rels = append(qry.Rels, s.alias)
+	qry.RelsMap[s.alias] = rel
+	return nil
+}
+
+func (b *build) buildSubqueryCond(conds []tree.Expr, qry *Query) (extend.Extend, error) {
+	if len(conds) == 0 {
+		return nil, nil
+	}
+	e, err := b.buildWhereExpr(andExprs(conds), qry)
+	if err != nil {
+		return nil, err
+	}
+	return b.pruneExtend(e, false)
+}
+
+// addAttribute adds an attribute of relation which is the alias of e
+func (s *subquery) addAttribute(e tree.Expr) tree.SelectExpr {
+	name := fmt.Sprintf("%s_%d", s.alias, len(s.attrs))
+	s.attrs = append(s.attrs, name)
+	return tree.SelectExpr{Expr: e, As: tree.UnrestrictedIdentifier(name)}
+}
+
+// name returns the name of the i-th attribute of relation
+func (s *subquery) name(i int) *tree.UnresolvedName {
+	return tree.SetUnresolvedName(s.alias, s.attrs[i])
+}
+
+// subqueryPredicate returns the subquery of e, the left operand of IN and the
+// join type if e is EXISTS, NOT EXISTS, IN or NOT IN predicate of subquery.
+func subqueryPredicate(e tree.Expr) (*tree.Subquery, tree.Expr, int, bool) {
+	switch e := e.(type) {
+	case *tree.ParenExpr:
+		return subqueryPredicate(e.Expr)
+	case *tree.Subquery:
+		if e.Exists {
+			return e, nil, SemiJoin, true
+		}
+	case *tree.NotExpr:
+		if sq, left, typ, ok := subqueryPredicate(e.Expr); ok {
+			if typ == SemiJoin {
+				return sq, left, AntiJoin, true
+			}
+			return sq, left, SemiJoin, true
+		}
+	case *tree.ComparisonExpr:
+		if sq, ok := e.Right.(*tree.Subquery); ok && !sq.Exists {
+			switch e.Op {
+			case tree.IN:
+				return sq, e.Left, SemiJoin, true
+			case tree.NOT_IN:
+				return sq, e.Left, AntiJoin, true
+			}
+		}
+	}
+	return nil, nil, 0, false
+}
+
+func unwrapSubquery(sq *tree.Subquery) (*tree.Select, *tree.SelectClause, error) {
+	if stmt, ok := sq.Select.(*tree.ParenSelect); ok {
+		for {
+			switch clause := stmt.Select.Select.(type) {
+			case *tree.SelectClause:
+				return stmt.Select, clause, nil
+			case *tree.ParenSelect:
+				if stmt.Select.OrderBy == nil && stmt.Select.Limit == nil {
+					stmt = clause
+					continue
+				}
+			}
+			break
+		}
+	}
+	return nil, nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("subquery '%v' is not support now", tree.String(sq, dialect.MYSQL)))
+}
+
+func existSubquery(e tree.Expr) bool {
+	var ok bool
+
+	walkExpr(e, func(e tree.Expr) {
+		if _, yes := e.(*tree.Subquery); yes {
+			ok = true
+		}
+	})
+	return ok
+}
+
+// isCorrelated returns true if e references the attributes of outer query
+func isCorrelated(e tree.Expr, inner, outer *Query) bool {
+	var ok bool
+
+	walkExpr(e, func(e tree.Expr) {
+		if n, yes := e.(*tree.UnresolvedName); yes && !n.Star && !isAttributeOf(n, inner) && isAttributeOf(n, outer) {
+			ok = true
+		}
+	})
+	return ok
+}
+
+func isAttributeOf(n *tree.UnresolvedName, qry *Query) bool {
+	rns, _, _ := qry.getAttribute0(false, attributeName(n))
+	return len(rns) > 0
+}
+
+func attributeName(n *tree.UnresolvedName) string {
+	parts := make([]string, n.NumParts)
+	for i := range parts {
+		parts[i] = n.Parts[n.NumParts-1-i]
+	}
+	return strings.Join(parts, ".")
+}
+
+func existAggregation(exprs tree.SelectExprs) bool {
+	return existFunc(exprs, func(name string) bool {
+		_, ok := transformer.TransformerNamesMap[name]
+		return ok
+	})
+}
+
+func existCount(exprs tree.SelectExprs) bool {
+	return existFunc(exprs, func(name string) bool {
+		return name == transformer.TransformerNames[transformer.Count] ||
+			name == transformer.TransformerNames[transformer.StarCount]
+	})
+}
+
+func existFunc(exprs tree.SelectExprs, fn func(string) bool) bool {
+	var ok bool
+
+	for _, expr := range exprs {
+		walkExpr(expr.Expr, func(e tree.Expr) {
+			if f, yes := e.(*tree.FuncExpr); yes {
+				if name, yes := f.Func.FunctionReference.(*tree.UnresolvedName); yes && fn(strings.ToLower(name.Parts[0])) {
+					ok = true
+				}
+			}
+		})
+	}
+	return ok
+}
+
+// walkExpr calls fn for e and its sub expressions, the expressions of subqueries are not visited
+func walkExpr(e tree.Expr, fn func(tree.Expr)) {
+	fn(e)
+	switch e := e.(type) {
+	case *tree.ParenExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.AndExpr:
+		walkExpr(e.Left, fn)
+		walkExpr(e.Right, fn)
+	case *tree.OrExpr:
+		walkExpr(e.Left, fn)
+		walkExpr(e.Right, fn)
+	case *tree.NotExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.UnaryExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.BinaryExpr:
+		walkExpr(e.Left, fn)
+		walkExpr(e.Right, fn)
+	case *tree.ComparisonExpr:
+		walkExpr(e.Left, fn)
+		walkExpr(e.Right, fn)
+	case *tree.RangeCond:
+		walkExpr(e.Left, fn)
+		walkExpr(e.From, fn)
+		walkExpr(e.To, fn)
+	case *tree.CastExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.IntervalExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.IsNullExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.IsNotNullExpr:
+		walkExpr(e.Expr, fn)
+	case *tree.CaseExpr:
+		if e.Expr != nil {
+			walkExpr(e.Expr, fn)
+		}
+		for _, w := range e.Whens {
+			walkExpr(w.Cond, fn)
+			walkExpr(w.Val, fn)
+		}
+		if e.Else != nil {
+			walkExpr(e.Else, fn)
+		}
+	case *tree.FuncExpr:
+		for _, arg := range e.Exprs {
+			walkExpr(arg, fn)
+		}
+	case *tree.Tuple:
+		for _, arg := range e.Exprs {
+			walkExpr(arg, fn)
+		}
+	}
+}
+
+// splitAndExpr returns the conjuncts of e
+func splitAndExpr(e tree.Expr, es []tree.Expr) []tree.Expr {
+	switch v := e.(type) {
+	case *tree.ParenExpr:
+		if _, ok := v.Expr.(*tree.AndExpr); ok {
+			return splitAndExpr(v.Expr, es)
+		}
+	case *tree.AndExpr:
+		return splitAndExpr(v.Right, splitAndExpr(v.Left, es))
+	}
+	return append(es, e)
+}
+
+func andExprs(es []tree.Expr) tree.Expr {
+	if len(es) == 1 {
+		return es[0]
+	}
+	return tree.NewAndExpr(es[0], andExprs(es[1:]))
+}
+
+func (qry *Query) subqueryCount() int {
+	var cnt int
+
+	for _, rn := range qry.Rels {
+		if qry.RelsMap[rn].Query != nil {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+func (qry *Query) attributeType(name string) int {
+	_, typ, _ := qry.getAttribute0(false, name)
+	if typ == nil {
+		return -1
+	}
+	return int(typ.Oid)
+}
@@ -20,8 +20,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"time"
 )
 
 type Plan interface {
@@ -40,11 +42,26 @@ const (
 )
 
 type Aggregation struct {
-	Ref   int     // reference count
-	Op    int     // opcode of aggregation function
-	Type  types.T // return type of aggregation function
-	Name  string  // name of attribute
-	Alias string
+	Ref      int     // reference count
+	Op       int     // opcode of aggregation function
+	Type     types.T // return type of aggregation function
+	Name     string  // name of attribute
+	Alias    string
+	Distinct bool     // only the distinct values are aggregated
+	Args     []string // constant arguments of aggregation function
+}
+
+type Window struct {
+	Ref        int     // reference count
+	Kind       int     // kind of window function, such as window.Rank
+	Op         int     // opcode of aggregation function
+	N          int64   // offset of lag and lead
+	Type       types.T // return type of window function
+	Name       string  // name of argument
+	Alias      string
+	Partitions []string
+	Fields     []*Field
+	Frame      window.Frame
 }
 
 type ProjectionExtend struct {
@@ -69,6 +86,7 @@ type Relation struct {
 	Aggregations      []*Aggregation
 	RestrictConds     []extend.Extend
 	ProjectionExtends []*ProjectionExtend
+	AsOf              int64 // unix time in nanoseconds the table is read as of, 0 means now
 }
 
 type Field struct {
@@ -84,6 +102,28 @@ type JoinCondition struct {
 	Sattr string
 }
 
+// Join types.
+const (
+	InnerJoin = iota
+	LeftJoin
+	RightJoin
+	FullJoin
+	SemiJoin   // EXISTS and IN subqueries
+	AntiJoin   // NOT EXISTS and NOT IN subqueries
+	SingleJoin // scalar subqueries
+)
+
+type Join struct {
+	// R is joined with all relations in front of it on condition Cond,
+	// and a nil Cond means product
+	Type int
+	R    string
+	Cond extend.Extend
+	// NullAware is set for the anti join of NOT IN, the first conjunct
+	// of Cond is the equality of NOT IN whose null matches any row
+	NullAware bool
+}
+
 type Query struct {
 	Distinct          bool
 	Limit             int64
@@ -94,9 +134,32 @@ type Query struct {
 	Fields            []*Field
 	RestrictConds     []extend.Extend
 	Conds             []*JoinCondition
+	Joins             []*Join         // joins which can not be expressed by join condition
+	JoinRestrictConds []extend.Extend // conditions evaluated on the result of joins
 	ProjectionExtends []*ProjectionExtend
 	ResultAttributes  []*Attribute
 	VarsMap           map[string]int
+	Windows           []*Window // window functions evaluated before the projection
+}
+
+// Set operations.
+const (
+	Union = iota
+	Intersect
+	Except
+)
+
+// SetQuery is the set operation of two queries, the attributes of its result
+// are named after the attributes of the left query.
+type SetQuery struct {
+	Type             int
+	All              bool // keep the duplicate tuples
+	Left             Plan // *Query or *SetQuery
+	Right            Plan // *Query or *SetQuery
+	Limit            int64
+	Offset           int64
+	Fields           []*Field
+	ResultAttributes []*Attribute
 }
 
 type CreateDatabase struct {
@@ -141,6 +204,38 @@ type DropIndex struct {
 	Relation    engine.Relation
 }
 
+const (
+	AddColumn = iota
+	DropColumn
+	RenameColumn
+	ChangeDefault
+	RenameTable
+)
+
+// AlterTable changes the definition of a relation, its options are
+// all checked against the definition before any of them is applied.
+type AlterTable struct {
+	Db       string
+	Id       string
+	Database engine.Database
+	Relation engine.Relation
+	Options  []AlterTableOption
+}
+
+type AlterTableOption struct {
+	Type    int
+	Attr    engine.Attribute // the attribute added, or the attribute whose default is changed
+	Name    string           // the attribute dropped or renamed
+	NewName string           // the new name of the attribute or of the relation
+}
+
+// OptimizeTable compacts the storage of the relations one by one.
+type OptimizeTable struct {
+	Ids       []string
+	Dbs       []string
+	Relations []engine.Relation
+}
+
 type ShowDatabases struct {
 	E    engine.Engine
 	Like []byte
@@ -175,11 +270,33 @@ type Insert struct {
 	Relation engine.Relation
 }
 
+type Delete struct {
+	Id       string
+	Db       string
+	Relation engine.Relation
+	Qry      *Query // query of the tuples to be deleted
+}
+
+type Update struct {
+	Id       string
+	Db       string
+	Relation engine.Relation
+	Qry      *Query          // query of the tuples to be updated
+	Attrs    []string        // updated attributes
+	Extends  []extend.Extend // new value of each updated attribute
+}
+
+type Explain struct {
+	Analyze bool // run the statement and report its runtime statistics
+	Plan    Plan // plan of the explained statement
+}
+
 type build struct {
 	flg bool   // use for having clause
 	db  string // name of schema
 	sql string
 	e   engine.Engine
+	loc *time.Location // time zone of the session
 }
 
 func (qry *Query) ResultColumns() []*Attribute {
@@ -232,10 +349,28 @@ func (qry *Query) String() string {
 	for _, cond := range qry.Conds {
 		buf.WriteString(fmt.Sprintf("\t%s\n", cond))
 	}
+	if len(qry.Joins) > 0 {
+		buf.WriteString("joins\n")
+		for _, j := range qry.Joins {
+			buf.WriteString(fmt.Sprintf("\t%s\n", j))
+		}
+	}
+	if len(qry.JoinRestrictConds) > 0 {
+		buf.WriteString("join restrict conditions\n")
+		for _, cond := range qry.JoinRestrictConds {
+			buf.WriteString(fmt.Sprintf("\t%s\n", cond))
+		}
+	}
 	buf.WriteString(fmt.Sprintf("restrict conditions\n"))
 	for _, cond := range qry.RestrictConds {
 		buf.WriteString(fmt.Sprintf("\t%s\n", cond))
 	}
+	if len(qry.Windows) > 0 {
+		buf.WriteString("window functions\n")
+		for _, w := range qry.Windows {
+			buf.WriteString(fmt.Sprintf("\t%s\n", w))
+		}
+	}
 	buf.WriteString("extend projection\n")
 	for _, e := range qry.ProjectionExtends {
 		buf.WriteString(fmt.Sprintf("\t%s\n", e))
@@ -247,6 +382,42 @@ func (qry *Query) String() string {
 	return buf.String()
 }
 
+func (qry *SetQuery) ResultColumns() []*Attribute {
+	return qry.ResultAttributes
+}
+
+var setOperationName = [...]string{
+	Union:     "union",
+	Intersect: "intersect",
+	Except:    "except",
+}
+
+func (qry *SetQuery) String() string {
+	var buf bytes.Buffer
+
+	buf.WriteString(fmt.Sprintf("result attributes: %v\n", qry.ResultAttributes))
+	buf.WriteString(setOperationName[qry.Type])
+	if qry.All {
+		buf.WriteString(" all")
+	}
+	buf.WriteString("\n")
+	switch {
+	case qry.Limit != -1 && qry.Offset != -1:
+		buf.WriteString(fmt.Sprintf("Limit %v, %v\n", qry.Offset, qry.Limit))
+	case qry.Limit != -1 && qry.Offset == -1:
+		buf.WriteString(fmt.Sprintf("Limit %v\n", qry.Limit))
+	}
+	buf.WriteString("order by\n")
+	for _, f := range qry.Fields {
+		buf.WriteString(fmt.Sprintf("\t%s\n", f))
+	}
+	buf.WriteString("left\n")
+	buf.WriteString(qry.Left.String())
+	buf.WriteString("right\n")
+	buf.WriteString(qry.Right.String())
+	return buf.String()
+}
+
 func (rel *Relation) ExistProjection(name string) int {
 	for i, e := range rel.ProjectionExtends {
 		if e.Alias == name {
@@ -338,6 +509,34 @@ func (cond *JoinCondition) String() string {
 	return fmt.Sprintf("%s.%s = %s.%s", cond.R, cond.Rattr, cond.S, cond.Sattr)
 }
 
+func (j *Join) String() string {
+	var typ string
+
+	switch j.Type {
+	case LeftJoin:
+		typ = "left join"
+	case RightJoin:
+		typ = "right join"
+	case FullJoin:
+		typ = "full join"
+	case SemiJoin:
+		typ = "semi join"
+	case AntiJoin:
+		typ = "anti join"
+		if j.NullAware {
+			typ = "null aware anti join"
+		}
+	case SingleJoin:
+		typ = "single join"
+	default:
+		typ = "join"
+	}
+	if j.Cond == nil {
+		return fmt.Sprintf("%s %s", typ, j.R)
+	}
+	return fmt.Sprintf("%s %s on %s", typ, j.R, j.Cond)
+}
+
 func (e *ProjectionExtend) IncRef() {
 	e.Ref++
 }
@@ -359,9 +558,20 @@ func (agg *Aggregation) DecRef() {
 }
 
 func (agg *Aggregation) String() string {
+	if agg.Distinct {
+		return fmt.Sprintf("'%s(distinct %s)' = %v -> %v", transformer.TransformerNames[agg.Op], agg.Name, agg.Ref, agg.Alias)
+	}
 	return fmt.Sprintf("'%s(%s)' = %v -> %v", transformer.TransformerNames[agg.Op], agg.Name, agg.Ref, agg.Alias)
 }
 
+func (w *Window) IncRef() {
+	w.Ref++
+}
+
+func (w *Window) String() string {
+	return fmt.Sprintf("'%s' = %v", w.Alias, w.Ref)
+}
+
 func (n *Field) String() string {
 	s := n.Attr
 	if n.Type != DefaultDirection {
@@ -486,6 +696,60 @@ func (d DropIndex) ResultColumns() []*Attribute {
 	return nil
 }
 
+func (a AlterTable) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("alter table %s.%s", a.Db, a.Id))
+	for i, opt := range a.Options {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		switch opt.Type {
+		case AddColumn:
+			buf.WriteString(fmt.Sprintf(" add column %s", opt.Attr.Name))
+		case DropColumn:
+			buf.WriteString(fmt.Sprintf(" drop column %s", opt.Name))
+		case RenameColumn:
+			buf.WriteString(fmt.Sprintf(" rename column %s to %s", opt.Name, opt.NewName))
+		case ChangeDefault:
+			buf.WriteString(fmt.Sprintf(" alter column %s", opt.Attr.Name))
+		case RenameTable:
+			buf.WriteString(fmt.Sprintf(" rename to %s", opt.NewName))
+		}
+	}
+	return buf.String()
+}
+
+func (a AlterTable) ResultColumns() []*Attribute {
+	return nil
+}
+
+func (o OptimizeTable) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("optimize table ")
+	for i := 0; i < len(o.Dbs); i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(o.Dbs[i] + "." + o.Ids[i])
+	}
+	return buf.String()
+}
+
+func (o OptimizeTable) ResultColumns() []*Attribute {
+	attrs := make([]*Attribute, 4)
+	for i, name := range []string{"Table", "Op", "Msg_type", "Msg_text"} {
+		attrs[i] = &Attribute{
+			Ref:  1,
+			Name: name,
+			Type: types.Type{
+				Oid:  types.T_varchar,
+				Size: 24,
+			},
+		}
+	}
+	return attrs
+}
+
 func (s ShowDatabases) String() string {
 	var buf bytes.Buffer
 	buf.WriteString("show databases")
@@ -591,3 +855,49 @@ func (i Insert) String() string {
 func (i Insert) ResultColumns() []*Attribute {
 	return nil
 }
+
+func (d Delete) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("delete from %s", d.Id))
+	return buf.String()
+}
+
+func (d Delete) ResultColumns() []*Attribute {
+	return nil
+}
+
+func (u Update) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("update %s set ", u.Id))
+	for i, attr := range u.Attrs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("%s = %s", attr, u.Extends[i]))
+	}
+	return buf.String()
+}
+
+func (u Update) ResultColumns() []*Attribute {
+	return nil
+}
+
+func (e Explain) String() string {
+	if e.Analyze {
+		return fmt.Sprintf("explain analyze %s", e.Plan)
+	}
+	return fmt.Sprintf("explain %s", e.Plan)
+}
+
+func (e Explain) ResultColumns() []*Attribute {
+	return []*Attribute{
+		&Attribute{
+			Ref:  1,
+			Name: "QUERY PLAN",
+			Type: types.Type{
+				Oid:  types.T_varchar,
+				Size: 24,
+			},
+		},
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+var setOperations = map[tree.UnionType]int{
+	tree.UNION:     Union,
+	tree.INTERSECT: Intersect,
+	tree.EXCEPT:    Except,
+}
+
+// buildUnion builds the set operations of stmt, INTERSECT binds tighter than
+// UNION and EXCEPT which are evaluated from left to right.
+func (b *build) buildUnion(stmt *tree.UnionClause, orderBy tree.OrderBy, fetch *tree.Limit) (*SetQuery, error) {
+	var ops []*tree.UnionClause
+	var ss []tree.SelectStatement
+
+	ss, ops = flattenUnion(stmt, ss, ops)
+	ps := make([]Plan, len(ss))
+	for i, s := range ss {
+		p, err := b.buildSetOperand(s)
+		if err != nil {
+			return nil, err
+		}
+		ps[i] = p
+	}
+	var err error
+	var terms []Plan
+	var termOps []*tree.UnionClause
+
+	terms = append(terms, ps[0])
+	for i, op := range ops {
+		if op.Type == tree.INTERSECT {
+			if terms[len(terms)-1], err = newSetQuery(op, terms[len(terms)-1], ps[i+1]); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		terms = append(terms, ps[i+1])
+		termOps = append(termOps, op)
+	}
+	p := terms[0]
+	for i, op := range termOps {
+		if p, err = newSetQuery(op, p, terms[i+1]); err != nil {
+			return nil, err
+		}
+	}
+	qry := p.(*SetQuery)
+	for _, order := range orderBy {
+		attr, err := qry.getAttribute(order.Expr)
+		if err != nil {
+			return nil, err
+		}
+		qry.Fields = append(qry.Fields, &Field{
+			Attr: attr,
+			Type: Direction(order.Direction),
+		})
+	}
+	if fetch != nil {
+		q := &Query{Limit: -1, Offset: -1, RelsMap: make(map[string]*Relation)}
+		if err := b.buildFetch(fetch, q); err != nil {
+			return nil, err
+		}
+		qry.Limit, qry.Offset = q.Limit, q.Offset
+	}
+	return qry, nil
+}
+
+// flattenUnion returns the operands and operators of the set operations
+// which are not enclosed in parentheses.
+func flattenUnion(stmt tree.SelectStatement, ss []tree.SelectStatement, ops []*tree.UnionClause) ([]tree.SelectStatement, []*tree.UnionClause) {
+	u, ok := stmt.(*tree.UnionClause)
+	if !ok {
+		return append(ss, stmt), ops
+	}
+	ss, ops = flattenUnion(u.Left, ss, ops)
+	return flattenUnion(u.Right, ss, append(ops, u))
+}
+
+func (b *build) buildSetOperand(stmt tree.SelectStatement) (Plan, error) {
+	switch stmt := stmt.(type) {
+	case *tree.ParenSelect:
+		return b.BuildStatement(stmt.Select)
+	case *tree.SelectClause:
+		return b.BuildStatement(&tree.Select{Select: stmt})
+	}
+	return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unknown select statement: %T", stmt))
+}
+
+func newSetQuery(op *tree.UnionClause, left, right Plan) (*SetQuery, error) {
+	lattrs, rattrs := left.ResultColumns(), right.ResultColumns()
+	if len(lattrs) != len(rattrs) {
+		return nil, errors.New(errno.CardinalityViolation, "The used SELECT statements have a different number of columns")
+	}
+	qry := &SetQuery{
+		Type:             setOperations[op.Type],
+		All:              op.All,
+		Left:             left,
+		Right:            right,
+		Limit:            -1,
+		Offset:           -1,
+		ResultAttributes: make([]*Attribute, len(lattrs)),
+	}
+	for i := range lattrs {
+		typ, ok := unionType(lattrs[i].Type.Oid, rattrs[i].Type.Oid)
+		if !ok {
+			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("column '%s' of %s has incompatible types %s and %s",
+				lattrs[i].Name, op.Type, lattrs[i].Type.Oid, rattrs[i].Type.Oid))
+		}
+		qry.ResultAttributes[i] = &Attribute{
+			Name: lattrs[i].Name,
+			Type: typ.ToType(),
+		}
+	}
+	return qry, nil
+}
+
+// unionType returns the type of result attribute whose types of left and right are x and y.
+func unionType(x, y types.T) (types.T, bool) {
+	switch {
+	case x == y:
+		return x, true
+	case isStringType(x) && isStringType(y):
+		return types.T_varchar, true
+	case isIntegerType(x) && isIntegerType(y):
+		if isUnsignedType(x) && isUnsignedType(y) {
+			return types.T_uint64, true
+		}
+		return types.T_int64, true
+	case isNumericType(x) && isNumericType(y):
+		return types.T_float64, true
+	}
+	return 0, false
+}
+
+func isStringType(t types.T) bool {
+	return t == types.T_char || t == types.T_varchar
+}
+
+func isUnsignedType(t types.T) bool {
+	switch t {
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true
+	}
+	return false
+}
+
+func isIntegerType(t types.T) bool {
+	switch t {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		return true
+	}
+	return isUnsignedType(t)
+}
+
+func isNumericType(t types.T) bool {
+	return isIntegerType(t) || t == types.T_float32 || t == types.T_float64
+}
+
+// getAttribute returns the result attribute of ORDER BY, which must be the name of it
+func (qry *SetQuery) getAttribute(n tree.Expr) (string, error) {
+	if name, ok := n.(*tree.UnresolvedName); ok && !name.Star {
+		if name.NumParts > 1 {
+			return "", errors.New(errno.InvalidColumnReference, fmt.Sprintf("Table '%s' from one of the SELECTs cannot be used in global ORDER clause", name.Parts[1]))
+		}
+		for _, attr := range qry.ResultAttributes {
+			if attr.Name == name.Parts[0] {
+				return attr.Name, nil
+			}
+		}
+		return "", errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in 'order clause'", name.Parts[0]))
+	}
+	return "", errors.New(errno.FeatureNotSupported, fmt.Sprintf("ORDER BY '%s' of set operation is not support now", tree.String(n, dialect.MYSQL)))
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func (b *build) BuildUpdate(stmt *tree.Update, plan *Update) error {
+	if len(stmt.OrderBy) > 0 || stmt.Limit != nil || len(stmt.From) > 0 {
+		return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport clause: '%s'", tree.String(stmt, dialect.MYSQL)))
+	}
+	tbl, err := getTableName(stmt.Table)
+	if err != nil {
+		return err
+	}
+	db, id, r, err := b.tableName(tbl)
+	if err != nil {
+		return err
+	}
+	plan.Id = id
+	plan.Db = db
+	plan.Relation = r
+	if plan.Qry, err = b.buildTargetQuery(stmt.Table, stmt.Where, r); err != nil {
+		return err
+	}
+
+	attrType := make(map[string]types.Type) // Map from relation's attribute name to its type
+	for _, def := range r.TableDefs() {
+		if v, ok := def.(*engine.AttributeDef); ok {
+			attrType[v.Attr.Name] = v.Attr.Type
+		}
+	}
+	for _, expr := range stmt.Exprs {
+		if expr.Tuple || len(expr.Names) != 1 {
+			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport update expression: '%s'", tree.String(expr, dialect.MYSQL)))
+		}
+		name := expr.Names[0].Parts[0]
+		typ, ok := attrType[name]
+		if !ok {
+			return errors.New(errno.UndefinedColumn, fmt.Sprintf("unknown column '%s' in 'field list'", name))
+		}
+		for _, attr := range plan.Attrs {
+			if attr == name {
+				return errors.New(errno.DuplicateColumn, fmt.Sprintf("column '%s' specified twice", name))
+			}
+		}
+		e, err := b.buildProjectionExpr(expr.Expr, plan.Qry)
+		if err != nil {
+			return err
+		}
+		if e, err = b.pruneExtend(e, true); err != nil {
+			return err
+		}
+		e = pruneExtend(e)
+		// the scale of a decimal is not a part of its oid, so it is always cast
+		if e.ReturnType() != typ.Oid || typ.Oid == types.T_decimal64 || typ.Oid == types.T_decimal128 {
+			e = &extend.BinaryExtend{
+				Op:    overload.Typecast,
+				Left:  e,
+				Right: &extend.ValueExtend{V: vector.New(typ)},
+			}
+		}
+		plan.Attrs = append(plan.Attrs, name)
+		plan.Extends = append(plan.Extends, e)
+	}
+	return nil
+}
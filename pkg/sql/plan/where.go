@@ -19,61 +19,38 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
 func (b *build) buildWhere(stmt *tree.Where, qry *Query) error {
-	e, err := b.buildWhereExpr(stmt.Expr, qry)
-	if err != nil {
-		return err
-	}
-	if e, err = b.pruneExtend(e, false); err != nil {
-		return err
+	var es, preds []tree.Expr
+
+	for _, e := range splitAndExpr(stmt.Expr, nil) {
+		if _, _, _, ok := subqueryPredicate(e); ok {
+			preds = append(preds, e)
+		} else {
+			es = append(es, e)
+		}
 	}
-	if es := extend.AndExtends(e, nil); len(es) > 0 { // push down join condition
-		for i := 0; i < len(es); i++ { // extracting join information
-			if left, right, ok := stripEqual(es[i]); ok {
-				r, rattr, err := qry.getJoinAttribute(false, qry.Rels, left)
-				if err != nil {
-					return err
-				}
-				s, sattr, err := qry.getJoinAttribute(false, qry.Rels, right)
-				if err != nil {
-					return err
-				}
-				if r != s {
-					if qry.RelsMap[r].AttrsMap[rattr].Type.Oid != qry.RelsMap[s].AttrsMap[sattr].Type.Oid {
-						return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("unsupport join condition '%v'", es[i]))
-					}
-					qry.Conds = append(qry.Conds, &JoinCondition{
-						R:     r,
-						S:     s,
-						Rattr: rattr,
-						Sattr: sattr,
-					})
-					es = append(es[:i], es[i+1:]...)
-					i--
-				}
-			}
+	if len(es) > 0 {
+		e, err := b.buildWhereExpr(andExprs(es), qry)
+		if err != nil {
+			return err
 		}
-		if len(es) == 0 {
-			return nil
+		if e, err = b.pruneExtend(e, false); err != nil {
+			return err
 		}
-		e = extendsToAndExtend(es)
-	}
-	if es := andExtends(qry, e, nil); len(es) > 0 { // push down restrict
-		for i := 0; i < len(es); i++ {
-			if ok := b.pushDownRestrict(es[i], qry); ok {
-				es = append(es[:i], es[i+1:]...)
-				i--
-			}
+		if err := b.buildRestrict(e, "", qry); err != nil {
+			return err
 		}
-		if len(es) > 0 {
-			qry.RestrictConds = append(qry.RestrictConds, extendsToAndExtend(es))
+	}
+	// the relations of subqueries are joined after all relations of from clause
+	for _, pred := range preds {
+		if err := b.buildSubqueryPredicate(pred, qry); err != nil {
+			return err
 		}
-		return nil
 	}
-	qry.RestrictConds = append(qry.RestrictConds, e)
 	return nil
 }
 
@@ -101,32 +78,16 @@ func (b *build) buildWhereExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
 		return b.buildCast(e, qry, b.buildWhereExpr)
 	case *tree.RangeCond:
 		return b.buildBetween(e, qry, b.buildWhereExpr)
+	case *tree.CaseExpr:
+		return b.buildCase(e, qry, b.buildWhereExpr)
+	case *tree.IsNullExpr:
+		return b.buildIsNull(false, e.Expr, qry, b.buildWhereExpr)
+	case *tree.IsNotNullExpr:
+		return b.buildIsNull(true, e.Expr, qry, b.buildWhereExpr)
 	case *tree.UnresolvedName:
 		return b.buildAttribute0(true, e, qry)
+	case *tree.Subquery:
+		return b.buildScalarSubquery(e, qry)
 	}
-	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", n))
-}
-
-func (b *build) pushDownRestrict(e extend.Extend, qry *Query) bool {
-	var name string
-
-	attrs := e.Attributes()
-	mp := make(map[string]int)
-	for _, attr := range attrs {
-		if names, _, err := qry.getAttribute0(false, attr); err != nil {
-			return false
-		} else {
-			for i := 0; i < len(names); i++ {
-				mp[names[i]]++
-				if len(name) == 0 {
-					name = names[i]
-				}
-			}
-		}
-	}
-	if len(mp) == 1 {
-		qry.RelsMap[name].AddRestrict(pruneExtend(e))
-		return true
-	}
-	return false
+	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", tree.String(n, dialect.MYSQL)))
 }
@@ -0,0 +1,240 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+)
+
+var windowKinds = map[string]int{
+	"row_number": window.RowNumber,
+	"rank":       window.Rank,
+	"dense_rank": window.DenseRank,
+	"lag":        window.Lag,
+	"lead":       window.Lead,
+}
+
+// buildWindow builds the window function e whose name is name, the result of e
+// is an attribute appended by the window operator before the projection.
+func (b *build) buildWindow(name string, e *tree.FuncExpr, qry *Query) (extend.Extend, error) {
+	alias := tree.String(e, dialect.MYSQL)
+	for _, w := range qry.Windows {
+		if w.Alias == alias {
+			w.IncRef()
+			return &extend.Attribute{Name: alias, Type: w.Type}, nil
+		}
+	}
+	if e.Type == tree.FUNC_TYPE_DISTINCT {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("'%s' is not support now", alias))
+	}
+	w := &Window{Ref: 1, Alias: alias}
+	if kind, ok := windowKinds[name]; ok {
+		w.Kind = kind
+		switch kind {
+		case window.Lag, window.Lead:
+			if len(e.Exprs) == 0 || len(e.Exprs) > 2 {
+				return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Incorrect parameter count in the call to native function '%s'", name))
+			}
+			attr, typ, err := b.buildWindowAttribute(e.Exprs[0], qry)
+			if err != nil {
+				return nil, err
+			}
+			w.Name, w.Type, w.N = attr, typ, 1
+			if len(e.Exprs) == 2 {
+				if w.N, err = buildWindowOffset(e.Exprs[1]); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			if len(e.Exprs) > 0 {
+				return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Incorrect parameter count in the call to native function '%s'", name))
+			}
+			w.Type = types.T_int64
+		}
+	} else {
+		op, ok := transformer.TransformerNamesMap[name]
+		if !ok || op == transformer.ApproxCountDistinct || op == transformer.JsonArrayAgg || op == transformer.JsonObjectAgg ||
+			op == transformer.GroupConcat || op == transformer.PercentileCont || op == transformer.ApproxPercentile {
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("window function '%s' is not support now", name))
+		}
+		if len(e.Exprs) != 1 {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Illegal function call '%s'", alias))
+		}
+		if _, ok := e.Exprs[0].(*tree.NumVal); ok && op == transformer.Count {
+			op = transformer.StarCount
+		}
+		w.Kind, w.Op = window.Aggregate, op
+		if op == transformer.StarCount {
+			w.Type = types.T_int64
+		} else {
+			attr, typ, err := b.buildWindowAttribute(e.Exprs[0], qry)
+			if err != nil {
+				return nil, err
+			}
+			w.Name, w.Type = attr, transformer.ReturnType(op, typ)
+		}
+	}
+	for _, expr := range e.WindowSpec.PartitionBy {
+		attr, _, err := b.buildWindowAttribute(expr, qry)
+		if err != nil {
+			return nil, err
+		}
+		w.Partitions = append(w.Partitions, attr)
+	}
+	for _, order := range e.WindowSpec.OrderBy {
+		attr, _, err := b.buildWindowAttribute(order.Expr, qry)
+		if err != nil {
+			return nil, err
+		}
+		w.Fields = append(w.Fields, &Field{Attr: attr, Type: Direction(order.Direction)})
+	}
+	frame, err := buildWindowFrame(e.WindowSpec, alias)
+	if err != nil {
+		return nil, err
+	}
+	w.Frame = frame
+	qry.Windows = append(qry.Windows, w)
+	return &extend.Attribute{Name: alias, Type: w.Type}, nil
+}
+
+// buildWindowAttribute returns the attribute of an argument, a partition or an order of
+// window function, an expression is computed by the relation which it belongs to.
+func (b *build) buildWindowAttribute(n tree.Expr, qry *Query) (string, types.T, error) {
+	e, err := b.buildOrderByExpr(n, qry)
+	if err != nil {
+		return "", 0, err
+	}
+	if e, err = b.pruneExtend(e, false); err != nil {
+		return "", 0, err
+	}
+	if attr, ok := e.(*extend.Attribute); ok {
+		qry.getAttribute2(true, attr.Name)
+		return attr.Name, attr.Type, nil
+	}
+	var rel string
+
+	mp := make(map[string]int) // relations map
+	for _, attr := range e.Attributes() {
+		rels, _, err := qry.getAttribute2(false, attr)
+		if err != nil {
+			return "", 0, err
+		}
+		for i := range rels {
+			if len(rel) == 0 {
+				rel = rels[i]
+			}
+			mp[rels[i]]++
+		}
+	}
+	if len(mp) != 1 {
+		return "", 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("'%s' in window function is not support now", e))
+	}
+	if err := b.stripOrderByRelation(rel, e, qry); err != nil {
+		return "", 0, err
+	}
+	return e.String(), e.ReturnType(), nil
+}
+
+func buildWindowFrame(spec *tree.WindowSpec, alias string) (window.Frame, error) {
+	var err error
+	var frame window.Frame
+
+	if spec.Frame == nil {
+		// the frame is the whole partition without order by, otherwise it is
+		// from the start of partition to the last peer of current row
+		frame.Type = window.Range
+		frame.Start.Type = window.UnboundedPreceding
+		frame.End.Type = window.UnboundedFollowing
+		if len(spec.OrderBy) > 0 {
+			frame.End.Type = window.CurrentRow
+		}
+		return frame, nil
+	}
+	frame.Type = window.Rows
+	if spec.Frame.Type == tree.FRAME_RANGE {
+		frame.Type = window.Range
+	}
+	if frame.Start, err = buildWindowBound(spec.Frame.Start); err != nil {
+		return frame, err
+	}
+	if frame.End, err = buildWindowBound(spec.Frame.End); err != nil {
+		return frame, err
+	}
+	if frame.Start.Type == window.UnboundedFollowing || frame.End.Type == window.UnboundedPreceding {
+		return frame, errors.New(errno.WindowingError, fmt.Sprintf("Window '%s': frame start or end is negative, NULL or of non-integral type", alias))
+	}
+	if frame.Type == window.Range && (frame.Start.N > 0 || frame.End.N > 0 ||
+		frame.Start.Type == window.Preceding || frame.Start.Type == window.Following ||
+		frame.End.Type == window.Preceding || frame.End.Type == window.Following) {
+		return frame, errors.New(errno.FeatureNotSupported, fmt.Sprintf("Window '%s': range frame with offset is not support now", alias))
+	}
+	return frame, nil
+}
+
+func buildWindowBound(bound *tree.FrameBound) (window.Bound, error) {
+	var err error
+	var b window.Bound
+
+	switch bound.Type {
+	case tree.UNBOUNDED_PRECEDING:
+		b.Type = window.UnboundedPreceding
+	case tree.PRECEDING:
+		b.Type = window.Preceding
+		b.N, err = buildWindowOffset(bound.Expr)
+	case tree.CURRENT_ROW:
+		b.Type = window.CurrentRow
+	case tree.FOLLOWING:
+		b.Type = window.Following
+		b.N, err = buildWindowOffset(bound.Expr)
+	case tree.UNBOUNDED_FOLLOWING:
+		b.Type = window.UnboundedFollowing
+	}
+	return b, err
+}
+
+// buildWindowOffset returns the offset of lag, lead and frame bound, which must be a non-negative integer
+func buildWindowOffset(n tree.Expr) (int64, error) {
+	if v, ok := n.(*tree.NumVal); ok {
+		e, err := buildValue(v.Value)
+		if err != nil {
+			return 0, err
+		}
+		if v, ok := e.(*extend.ValueExtend); ok && v.V.Typ.Oid == types.T_int64 && v.V.Col.([]int64)[0] >= 0 {
+			return v.V.Col.([]int64)[0], nil
+		}
+	}
+	return 0, errors.New(errno.WindowingError, fmt.Sprintf("Incorrect arguments to '%s'", tree.String(n, dialect.MYSQL)))
+}
+
+// windowAttributes returns true if any of attrs is the result of window function
+func (qry *Query) windowAttributes(attrs []string) bool {
+	for _, attr := range attrs {
+		for _, w := range qry.Windows {
+			if w.Alias == attr {
+				return true
+			}
+		}
+	}
+	return false
+}
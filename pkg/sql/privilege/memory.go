@@ -0,0 +1,83 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privilege
+
+import (
+	"sort"
+	"sync"
+)
+
+// MemCatalog is the catalog which keeps the accounts in memory,
+// it is used by the server running without the cluster catalog.
+type MemCatalog struct {
+	sync.RWMutex
+	accounts map[string]Account
+}
+
+func NewMemCatalog() *MemCatalog {
+	return &MemCatalog{accounts: make(map[string]Account)}
+}
+
+func (c *MemCatalog) CreateAccount(a Account) error {
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.accounts[a.Name]; ok {
+		return ErrAccountExists
+	}
+	c.accounts[a.Name] = a.clone()
+	return nil
+}
+
+func (c *MemCatalog) DropAccount(name string) error {
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.accounts[name]; !ok {
+		return ErrAccountNotExists
+	}
+	delete(c.accounts, name)
+	return nil
+}
+
+func (c *MemCatalog) GetAccount(name string) (*Account, error) {
+	c.RLock()
+	defer c.RUnlock()
+	a, ok := c.accounts[name]
+	if !ok {
+		return nil, ErrAccountNotExists
+	}
+	b := a.clone()
+	return &b, nil
+}
+
+func (c *MemCatalog) UpdateAccount(a Account) error {
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.accounts[a.Name]; !ok {
+		return ErrAccountNotExists
+	}
+	c.accounts[a.Name] = a.clone()
+	return nil
+}
+
+func (c *MemCatalog) ListAccounts() ([]Account, error) {
+	c.RLock()
+	defer c.RUnlock()
+	as := make([]Account, 0, len(c.accounts))
+	for _, a := range c.accounts {
+		as = append(as, a.clone())
+	}
+	sort.Slice(as, func(i, j int) bool { return as[i].Name < as[j].Name })
+	return as, nil
+}
@@ -0,0 +1,201 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privilege
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// names of privileges in the order of SHOW GRANTS.
+var names = []struct {
+	typ  Type
+	name string
+}{
+	{Select, "SELECT"},
+	{Insert, "INSERT"},
+	{Update, "UPDATE"},
+	{Delete, "DELETE"},
+	{Create, "CREATE"},
+	{Drop, "DROP"},
+	{Index, "INDEX"},
+	{Alter, "ALTER"},
+	{CreateUser, "CREATE USER"},
+	{Super, "SUPER"},
+}
+
+var treeTypes = map[tree.PrivilegeType]Type{
+	tree.PRIVILEGE_TYPE_STATIC_ALL:          All,
+	tree.PRIVILEGE_TYPE_STATIC_USAGE:        0,
+	tree.PRIVILEGE_TYPE_STATIC_SELECT:       Select,
+	tree.PRIVILEGE_TYPE_STATIC_INSERT:       Insert,
+	tree.PRIVILEGE_TYPE_STATIC_UPDATE:       Update,
+	tree.PRIVILEGE_TYPE_STATIC_DELETE:       Delete,
+	tree.PRIVILEGE_TYPE_STATIC_CREATE:       Create,
+	tree.PRIVILEGE_TYPE_STATIC_DROP:         Drop,
+	tree.PRIVILEGE_TYPE_STATIC_INDEX:        Index,
+	tree.PRIVILEGE_TYPE_STATIC_ALTER:        Alter,
+	tree.PRIVILEGE_TYPE_STATIC_CREATE_USER:  CreateUser,
+	tree.PRIVILEGE_TYPE_STATIC_SUPER:        Super,
+	tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION: GrantOption,
+}
+
+// TypeOf returns the privilege of the privilege type of GRANT and REVOKE.
+func TypeOf(p *tree.Privilege) (Type, error) {
+	if len(p.ColumnList) > 0 {
+		return 0, fmt.Errorf("column privilege is not support now")
+	}
+	typ, ok := treeTypes[p.Type]
+	if !ok {
+		return 0, fmt.Errorf("privilege '%s' is not support now", p.Type.ToString())
+	}
+	return typ, nil
+}
+
+func (t Type) String() string {
+	switch {
+	case t&All == All:
+		return "ALL PRIVILEGES"
+	case t&All == 0:
+		return "USAGE"
+	}
+	var ss []string
+	for _, n := range names {
+		if t&n.typ != 0 {
+			ss = append(ss, n.name)
+		}
+	}
+	return strings.Join(ss, ", ")
+}
+
+// HashPassword returns the hash of the password stored in the account.
+func HashPassword(password string) []byte {
+	if len(password) == 0 {
+		return nil
+	}
+	hash1 := sha1.Sum([]byte(password))
+	hash2 := sha1.Sum(hash1[:])
+	return hash2[:]
+}
+
+// Grant adds the privileges on the database and table to the account.
+func (a *Account) Grant(db, tbl string, typ Type) {
+	for i, g := range a.Grants {
+		if g.Database == db && g.Table == tbl {
+			a.Grants[i].Privileges |= typ
+			return
+		}
+	}
+	a.Grants = append(a.Grants, Grant{Database: db, Table: tbl, Privileges: typ})
+}
+
+// Revoke removes the privileges on the database and table from the account.
+func (a *Account) Revoke(db, tbl string, typ Type) {
+	for i, g := range a.Grants {
+		if g.Database == db && g.Table == tbl {
+			if a.Grants[i].Privileges &^= typ; a.Grants[i].Privileges == 0 {
+				a.Grants = append(a.Grants[:i], a.Grants[i+1:]...)
+			}
+			return
+		}
+	}
+}
+
+// GrantRole grants the role to the account.
+func (a *Account) GrantRole(role string) {
+	for _, r := range a.Roles {
+		if r == role {
+			return
+		}
+	}
+	a.Roles = append(a.Roles, role)
+}
+
+// RevokeRole revokes the role from the account.
+func (a *Account) RevokeRole(role string) {
+	for i, r := range a.Roles {
+		if r == role {
+			a.Roles = append(a.Roles[:i], a.Roles[i+1:]...)
+			return
+		}
+	}
+}
+
+// Resolve returns the privileges of the account, including the ones of roles
+// granted to it directly or through other roles.
+func Resolve(c Catalog, name string) (*Privileges, error) {
+	p := new(Privileges)
+	visited := map[string]struct{}{name: {}}
+	for queue := []string{name}; len(queue) > 0; queue = queue[1:] {
+		a, err := c.GetAccount(queue[0])
+		if err != nil {
+			if err == ErrAccountNotExists && queue[0] != name {
+				continue // the role has been dropped
+			}
+			return nil, err
+		}
+		p.Grants = append(p.Grants, a.Grants...)
+		for _, r := range a.Roles {
+			if _, ok := visited[r]; !ok {
+				visited[r] = struct{}{}
+				queue = append(queue, r)
+			}
+		}
+	}
+	return p, nil
+}
+
+// Check returns true if the privileges include typ on the table of database, the
+// table is Any for the privileges on database, and the database is Any for the global ones.
+func (p *Privileges) Check(db, tbl string, typ Type) bool {
+	var has Type
+
+	for _, g := range p.Grants {
+		if match(g.Database, db) && match(g.Table, tbl) {
+			has |= g.Privileges
+		}
+	}
+	return has&typ == typ
+}
+
+func match(pattern, name string) bool {
+	return pattern == Any || pattern == name
+}
+
+// Level returns the level of grant in GRANT statement.
+func (g Grant) Level() string {
+	if g.Database == Any {
+		return "*.*"
+	}
+	return fmt.Sprintf("`%s`.%s", g.Database, quote(g.Table))
+}
+
+func quote(name string) string {
+	if name == Any {
+		return name
+	}
+	return "`" + name + "`"
+}
+
+func (a *Account) clone() Account {
+	b := *a
+	b.Password = append([]byte{}, a.Password...)
+	b.Roles = append([]string{}, a.Roles...)
+	b.Grants = append([]Grant{}, a.Grants...)
+	return b
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privilege
+
+import "errors"
+
+var (
+	// ErrAccountExists is the error for creating an existing user or role.
+	ErrAccountExists = errors.New("account already exists")
+	// ErrAccountNotExists is the error for a user or role which does not exist.
+	ErrAccountNotExists = errors.New("account not exist")
+)
+
+// Type is a set of privileges, each privilege is a bit of it.
+type Type uint64
+
+const (
+	Select Type = 1 << iota
+	Insert
+	Update
+	Delete
+	Create
+	Drop
+	Index
+	Alter
+	CreateUser
+	Super
+	GrantOption
+)
+
+// All is the privileges of ALL PRIVILEGES, which does not include GRANT OPTION.
+const All = Select | Insert | Update | Delete | Create | Drop | Index | Alter | CreateUser | Super
+
+// Any is the name which matches all databases or tables.
+const Any = "*"
+
+// Grant is the privileges on the tables of a database, both of Database and Table
+// are Any for the global privileges, and Table is Any for the privileges of database.
+type Grant struct {
+	Database   string
+	Table      string
+	Privileges Type
+}
+
+// Account is a user or a role.
+type Account struct {
+	Name   string
+	IsRole bool
+	// Password is SHA1(SHA1(password)) checked by mysql_native_password,
+	// it is empty if the account has no password.
+	Password []byte
+	// Roles are the names of roles granted to the account.
+	Roles  []string
+	Grants []Grant
+}
+
+// Catalog stores the users and roles.
+type Catalog interface {
+	// CreateAccount creates an account, it returns ErrAccountExists if the name has been used.
+	CreateAccount(Account) error
+	// DropAccount drops the account with the name.
+	DropAccount(string) error
+	// GetAccount returns the account with the name, or ErrAccountNotExists.
+	GetAccount(string) (*Account, error)
+	// UpdateAccount replaces the account with the same name.
+	UpdateAccount(Account) error
+	// ListAccounts returns all accounts.
+	ListAccounts() ([]Account, error)
+}
+
+// Privileges are the grants of a user and the roles granted to it.
+type Privileges struct {
+	Grants []Grant
+}
@@ -23,11 +23,16 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/bitagg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/distinct"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/groupconcat"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/max"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/min"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/percentile"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/starcount"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/sum"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/variance"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
@@ -42,6 +47,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/oplus"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/plus"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/times"
@@ -77,6 +83,11 @@ func init() {
 
 	gob.Register(types.Date(0))
 	gob.Register(types.Datetime(0))
+	gob.Register(types.Time(0))
+	gob.Register(types.Timestamp(0))
+
+	spill.EncodeBatch = EncodeBatch
+	spill.DecodeBatch = DecodeBatchWithProcess
 }
 
 func EncodeScope(s Scope, buf *bytes.Buffer) error {
@@ -688,10 +699,56 @@ func EncodeExtend(e extend.Extend, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Name))))
 		buf.WriteString(v.Name)
 		return nil
+	case *extend.CaseExtend:
+		buf.WriteByte(Case)
+		buf.Write(encoding.EncodeUint16(uint16(v.Type)))
+		if err := encodeExtends(v.Conds, buf); err != nil {
+			return err
+		}
+		if err := encodeExtends(v.Vals, buf); err != nil {
+			return err
+		}
+		return EncodeExtend(v.Else, buf)
+	case *extend.CoalesceExtend:
+		buf.WriteByte(Coalesce)
+		buf.Write(encoding.EncodeUint16(uint16(v.Type)))
+		return encodeExtends(v.Args, buf)
+	case *extend.IsNullExtend:
+		buf.WriteByte(IsNull)
+		buf.WriteByte(encodeBool(v.Not))
+		return EncodeExtend(v.E, buf)
+	case *extend.InExtend:
+		buf.WriteByte(In)
+		buf.WriteByte(encodeBool(v.Not))
+		buf.WriteByte(encodeBool(v.Null))
+		if err := EncodeExtend(v.E, buf); err != nil {
+			return err
+		}
+		return encodeExtends(v.Args, buf)
+	case nil: // the null branch of a case extend
+		buf.WriteByte(Null)
+		return nil
 	}
 	return fmt.Errorf("'%v' not yet support", e)
 }
 
+func encodeExtends(es []extend.Extend, buf *bytes.Buffer) error {
+	buf.Write(encoding.EncodeUint32(uint32(len(es))))
+	for _, e := range es {
+		if err := EncodeExtend(e, buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func encodeBool(v bool) byte {
+	if v {
+		return 1
+	}
+	return 0
+}
+
 func DecodeExtend(data []byte) (extend.Extend, []byte, error) {
 	switch data[0] {
 	case Unary:
@@ -797,10 +854,78 @@ func DecodeExtend(data []byte) (extend.Extend, []byte, error) {
 			data = data[4:]
 		}
 		return e, data, nil
+	case Case:
+		var err error
+
+		e := new(extend.CaseExtend)
+		data = data[1:]
+		e.Type = types.T(encoding.DecodeUint16(data[:2]))
+		data = data[2:]
+		if e.Conds, data, err = decodeExtends(data); err != nil {
+			return nil, nil, err
+		}
+		if e.Vals, data, err = decodeExtends(data); err != nil {
+			return nil, nil, err
+		}
+		if e.Else, data, err = DecodeExtend(data); err != nil {
+			return nil, nil, err
+		}
+		return e, data, nil
+	case Coalesce:
+		var err error
+
+		e := new(extend.CoalesceExtend)
+		data = data[1:]
+		e.Type = types.T(encoding.DecodeUint16(data[:2]))
+		data = data[2:]
+		if e.Args, data, err = decodeExtends(data); err != nil {
+			return nil, nil, err
+		}
+		return e, data, nil
+	case IsNull:
+		var err error
+
+		e := new(extend.IsNullExtend)
+		e.Not = data[1] == 1
+		data = data[2:]
+		if e.E, data, err = DecodeExtend(data); err != nil {
+			return nil, nil, err
+		}
+		return e, data, nil
+	case In:
+		var err error
+
+		e := new(extend.InExtend)
+		e.Not = data[1] == 1
+		e.Null = data[2] == 1
+		data = data[3:]
+		if e.E, data, err = DecodeExtend(data); err != nil {
+			return nil, nil, err
+		}
+		if e.Args, data, err = decodeExtends(data); err != nil {
+			return nil, nil, err
+		}
+		return e, data, nil
+	case Null:
+		return nil, data[1:], nil
 	}
 	return nil, nil, fmt.Errorf("'%v' extend not yet support", data[0])
 }
 
+func decodeExtends(data []byte) ([]extend.Extend, []byte, error) {
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	es := make([]extend.Extend, n)
+	for i := range es {
+		e, d, err := DecodeExtend(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		es[i], data = e, d
+	}
+	return es, data, nil
+}
+
 func EncodeBatch(bat *batch.Batch, buf *bytes.Buffer) error {
 	// SelsData
 	buf.Write(encoding.EncodeUint32(uint32(len(bat.SelsData))))
@@ -1080,6 +1205,21 @@ func EncodeRing(r ring.Ring, buf *bytes.Buffer) error {
 	case *approxcd.ApproxCountDistinctRing:
 		buf.WriteByte(ApproxCountDistinctRing)
 		return v.Marshal(buf)
+	case *variance.VarianceRing:
+		buf.WriteByte(VarianceRing)
+		return v.Marshal(buf)
+	case *bitagg.BitRing:
+		buf.WriteByte(BitRing)
+		return v.Marshal(buf)
+	case *percentile.PercentileRing:
+		buf.WriteByte(PercentileRing)
+		return v.Marshal(buf)
+	case *percentile.TDigestRing:
+		buf.WriteByte(TDigestRing)
+		return v.Marshal(buf)
+	case *groupconcat.GroupConcatRing:
+		buf.WriteByte(GroupConcatRing)
+		return v.Marshal(buf)
 	case *max.Int8Ring:
 		buf.WriteByte(MaxInt8Ring)
 		// Ns
@@ -1580,6 +1720,131 @@ func EncodeRing(r ring.Ring, buf *bytes.Buffer) error {
 		// Typ
 		buf.Write(encoding.EncodeType(v.Typ))
 		return nil
+	case *max.Decimal64Ring:
+		buf.WriteByte(MaxDecimal64Ring)
+		// Ns
+		n := len(v.Ns)
+		buf.Write(encoding.EncodeUint32(uint32(n)))
+		if n > 0 {
+			buf.Write(encoding.EncodeInt64Slice(v.Ns))
+		}
+		// Vs
+		da := encoding.EncodeDecimal64Slice(v.Vs)
+		n = len(da)
+		buf.Write(encoding.EncodeUint32(uint32(n)))
+		if n > 0 {
+			buf.Write(da)
+		}
+		// Typ
+		buf.Write(encoding.EncodeType(v.Typ))
+		return nil
+	case *max.Decimal128Ring:
+		buf.WriteByte(MaxDecimal128Ring)
+		// Ns
+		n := len(v.Ns)
+		buf.Write(encoding.EncodeUint32(uint32(n)))
+		if n > 0 {
+			buf.Write(encoding.EncodeInt64Slice(v.Ns))
+		}
+		// Vs
+		da := encoding.EncodeDecimal128Slice(v.Vs)
+		n = len(da)
+		buf.Write(encoding.EncodeUint32(uint32(n)))
+		if n > 0 {
+			buf.Write(da)
+		}
+		// Typ
+		buf.Write(encoding.EncodeType(v.Typ))
+		return nil
+	case *min.Decimal64Ring:
+		buf.WriteByte(MinDecimal64Ring)
+		// Ns
+		n := len(v.Ns)
+		buf.Write(encoding.EncodeUint32(uint32(n)))
+		if n > 0 {
+			buf.Write(encoding.EncodeInt64Slice(v.Ns))
+		}
+		// Vs
+		da := encoding.EncodeDecimal64Slice(v.Vs)
+		n = len(da)
+		buf.Write(encoding.EncodeUint32(uint32(n)))
+		if n > 0 {
+			buf.Write(da)
+		}
+		// Typ
+		buf.Write(encoding.EncodeType(v.Typ))
+		return nil
+	case *min.Decimal128Ring:
+		buf.WriteByte(MinDecimal128Ring)
+		// Ns
+		n := len(v.Ns)
+		buf.Write(encoding.EncodeUint32(uint32(n)))
+		if n > 0 {
+			buf.Write(encoding.EncodeInt64Slice(v.Ns))
+		}
+		// Vs
+		da := encoding.EncodeDecimal128Slice(v.Vs)
+		n = len(da)
+		buf.Write(encoding.EncodeUint32(uint32(n)))
+		if n > 0 {
+			buf.Write(da)
+		}
+		// Typ
+		buf.Write(encoding.EncodeType(v.Typ))
+		return nil
+	case *sum.DecimalRing:
+		buf.WriteByte(SumDecimalRing)
+		// Ns
+		n := len(v.Ns)
+		buf.Write(encoding.EncodeUint32(uint32(n)))
+		if n > 0 {
+			buf.Write(encoding.EncodeInt64Slice(v.Ns))
+		}
+		// Vs
+		da := encoding.EncodeDecimal128Slice(v.Vs)
+		n = len(da)
+		buf.Write(encoding.EncodeUint32(uint32(n)))
+		if n > 0 {
+			buf.Write(da)
+		}
+		// Typ
+		buf.Write(encoding.EncodeType(v.Typ))
+		return nil
+	case *avg.DecimalAvgRing:
+		buf.WriteByte(AvgDecimalRing)
+		// Ns
+		n := len(v.Ns)
+		buf.Write(encoding.EncodeUint32(uint32(n)))
+		if n > 0 {
+			buf.Write(encoding.EncodeInt64Slice(v.Ns))
+		}
+		// Vs
+		da := encoding.EncodeDecimal128Slice(v.Vs)
+		n = len(da)
+		buf.Write(encoding.EncodeUint32(uint32(n)))
+		if n > 0 {
+			buf.Write(da)
+		}
+		// Typ
+		buf.Write(encoding.EncodeType(v.Typ))
+		return nil
+	case *distinct.DistinctRing:
+		buf.WriteByte(DistinctRing)
+		if err := EncodeRing(v.R, buf); err != nil {
+			return err
+		}
+		if err := EncodeVector(v.Vec, buf); err != nil {
+			return err
+		}
+		// Ss
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Ss))))
+		for _, sels := range v.Ss {
+			buf.Write(encoding.EncodeUint32(uint32(len(sels))))
+			if len(sels) > 0 {
+				buf.Write(encoding.EncodeInt64Slice(sels))
+			}
+		}
+		return nil
 	}
 	return fmt.Errorf("'%v' ring not yet support", r)
 }
@@ -1663,6 +1928,26 @@ func DecodeRing(data []byte) (ring.Ring, []byte, error) {
 		r := approxcd.NewApproxCountDistinct(types.Type{})
 		data, err := r.Unmarshal(data)
 		return r, data, err
+	case VarianceRing:
+		r := new(variance.VarianceRing)
+		data, err := r.Unmarshal(data[1:])
+		return r, data, err
+	case BitRing:
+		r := new(bitagg.BitRing)
+		data, err := r.Unmarshal(data[1:])
+		return r, data, err
+	case PercentileRing:
+		r := new(percentile.PercentileRing)
+		data, err := r.Unmarshal(data[1:])
+		return r, data, err
+	case TDigestRing:
+		r := new(percentile.TDigestRing)
+		data, err := r.Unmarshal(data[1:])
+		return r, data, err
+	case GroupConcatRing:
+		r := new(groupconcat.GroupConcatRing)
+		data, err := r.Unmarshal(data[1:])
+		return r, data, err
 	case MaxInt8Ring:
 		r := new(max.Int8Ring)
 		data = data[1:]
@@ -2313,188 +2598,359 @@ func DecodeRing(data []byte) (ring.Ring, []byte, error) {
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
-	}
-	return nil, nil, fmt.Errorf("type '%v' ring not yet support", data[0])
-}
-
-func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byte, error) {
-	switch data[0] {
-	case AvgRing:
-		r := new(avg.AvgRing)
+	case MaxDecimal64Ring:
+		r := new(max.Decimal64Ring)
 		data = data[1:]
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
 		data = data[4:]
 		if n > 0 {
-			r.Ns = make([]int64, n)
-			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			r.Ns = encoding.DecodeInt64Slice(data[:n*8])
 			data = data[n*8:]
 		}
 		// Da
 		n = encoding.DecodeUint32(data[:4])
 		data = data[4:]
 		if n > 0 {
-			var err error
-			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
-			if err != nil {
-				return nil, nil, err
-			}
-			copy(r.Da, data[:n])
+			r.Da = data[:n]
 			data = data[n:]
 		}
 		// Vs
-		r.Vs = encoding.DecodeFloat64Slice(r.Da)
+		r.Vs = encoding.DecodeDecimal64Slice(r.Da)
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
-	case CountRing:
-		r := new(count.CountRing)
+	case MaxDecimal128Ring:
+		r := new(max.Decimal128Ring)
 		data = data[1:]
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
 		data = data[4:]
 		if n > 0 {
-			r.Ns = make([]int64, n)
-			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			r.Ns = encoding.DecodeInt64Slice(data[:n*8])
 			data = data[n*8:]
 		}
 		// Da
 		n = encoding.DecodeUint32(data[:4])
 		data = data[4:]
 		if n > 0 {
-			var err error
-			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
-			if err != nil {
-				return nil, nil, err
-			}
-			copy(r.Da, data[:n])
+			r.Da = data[:n]
 			data = data[n:]
 		}
 		// Vs
-		r.Vs = encoding.DecodeInt64Slice(r.Da)
+		r.Vs = encoding.DecodeDecimal128Slice(r.Da)
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
-	case StarCountRing:
-		r := new(starcount.CountRing)
+	case MinDecimal64Ring:
+		r := new(min.Decimal64Ring)
 		data = data[1:]
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
 		data = data[4:]
 		if n > 0 {
-			r.Ns = make([]int64, n)
-			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			r.Ns = encoding.DecodeInt64Slice(data[:n*8])
 			data = data[n*8:]
 		}
 		// Da
 		n = encoding.DecodeUint32(data[:4])
 		data = data[4:]
 		if n > 0 {
-			var err error
-			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
-			if err != nil {
-				return nil, nil, err
-			}
-			copy(r.Da, data[:n])
+			r.Da = data[:n]
 			data = data[n:]
 		}
 		// Vs
-		r.Vs = encoding.DecodeInt64Slice(r.Da)
+		r.Vs = encoding.DecodeDecimal64Slice(r.Da)
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
-	case ApproxCountDistinctRing:
-		data = data[1:]
-		r := approxcd.NewApproxCountDistinct(types.Type{})
-		data, err := r.UnmarshalWithProc(data, proc)
-		return r, data, err
-	case MaxInt8Ring:
-		r := new(max.Int8Ring)
+	case MinDecimal128Ring:
+		r := new(min.Decimal128Ring)
 		data = data[1:]
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
 		data = data[4:]
 		if n > 0 {
-			r.Ns = make([]int64, n)
-			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			r.Ns = encoding.DecodeInt64Slice(data[:n*8])
 			data = data[n*8:]
 		}
 		// Da
 		n = encoding.DecodeUint32(data[:4])
 		data = data[4:]
 		if n > 0 {
-			var err error
-			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
-			if err != nil {
-				return nil, nil, err
-			}
-			copy(r.Da, data[:n])
+			r.Da = data[:n]
 			data = data[n:]
 		}
 		// Vs
-		r.Vs = encoding.DecodeInt8Slice(r.Da)
+		r.Vs = encoding.DecodeDecimal128Slice(r.Da)
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
-	case MaxInt16Ring:
-		r := new(max.Int16Ring)
+	case SumDecimalRing:
+		r := new(sum.DecimalRing)
 		data = data[1:]
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
 		data = data[4:]
 		if n > 0 {
-			r.Ns = make([]int64, n)
-			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			r.Ns = encoding.DecodeInt64Slice(data[:n*8])
 			data = data[n*8:]
 		}
 		// Da
 		n = encoding.DecodeUint32(data[:4])
 		data = data[4:]
 		if n > 0 {
-			var err error
-			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
-			if err != nil {
-				return nil, nil, err
-			}
-			copy(r.Da, data[:n])
+			r.Da = data[:n]
 			data = data[n:]
 		}
 		// Vs
-		r.Vs = encoding.DecodeInt16Slice(r.Da)
+		r.Vs = encoding.DecodeDecimal128Slice(r.Da)
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
-	case MaxInt32Ring:
-		r := new(max.Int32Ring)
+	case AvgDecimalRing:
+		r := new(avg.DecimalAvgRing)
 		data = data[1:]
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
 		data = data[4:]
 		if n > 0 {
-			r.Ns = make([]int64, n)
-			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			r.Ns = encoding.DecodeInt64Slice(data[:n*8])
 			data = data[n*8:]
 		}
 		// Da
 		n = encoding.DecodeUint32(data[:4])
 		data = data[4:]
 		if n > 0 {
-			var err error
-			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
-			if err != nil {
-				return nil, nil, err
-			}
-			copy(r.Da, data[:n])
+			r.Da = data[:n]
+			data = data[n:]
+		}
+		// Vs
+		r.Vs = encoding.DecodeDecimal128Slice(r.Da)
+		// Typ
+		typ := encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		r.Typ = typ
+		return r, data, nil
+	case DistinctRing:
+		r, data, err := DecodeRing(data[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeDistinctRing(r, data)
+	}
+	return nil, nil, fmt.Errorf("type '%v' ring not yet support", data[0])
+}
+
+func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byte, error) {
+	switch data[0] {
+	case AvgRing:
+		r := new(avg.AvgRing)
+		data = data[1:]
+		// Ns
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Ns = make([]int64, n)
+			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			data = data[n*8:]
+		}
+		// Da
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			var err error
+			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
+			if err != nil {
+				return nil, nil, err
+			}
+			copy(r.Da, data[:n])
+			data = data[n:]
+		}
+		// Vs
+		r.Vs = encoding.DecodeFloat64Slice(r.Da)
+		// Typ
+		typ := encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		r.Typ = typ
+		return r, data, nil
+	case CountRing:
+		r := new(count.CountRing)
+		data = data[1:]
+		// Ns
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Ns = make([]int64, n)
+			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			data = data[n*8:]
+		}
+		// Da
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			var err error
+			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
+			if err != nil {
+				return nil, nil, err
+			}
+			copy(r.Da, data[:n])
+			data = data[n:]
+		}
+		// Vs
+		r.Vs = encoding.DecodeInt64Slice(r.Da)
+		// Typ
+		typ := encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		r.Typ = typ
+		return r, data, nil
+	case StarCountRing:
+		r := new(starcount.CountRing)
+		data = data[1:]
+		// Ns
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Ns = make([]int64, n)
+			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			data = data[n*8:]
+		}
+		// Da
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			var err error
+			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
+			if err != nil {
+				return nil, nil, err
+			}
+			copy(r.Da, data[:n])
+			data = data[n:]
+		}
+		// Vs
+		r.Vs = encoding.DecodeInt64Slice(r.Da)
+		// Typ
+		typ := encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		r.Typ = typ
+		return r, data, nil
+	case ApproxCountDistinctRing:
+		data = data[1:]
+		r := approxcd.NewApproxCountDistinct(types.Type{})
+		data, err := r.UnmarshalWithProc(data, proc)
+		return r, data, err
+	case VarianceRing:
+		r := new(variance.VarianceRing)
+		data, err := r.UnmarshalWithProc(data[1:], proc)
+		return r, data, err
+	case BitRing:
+		r := new(bitagg.BitRing)
+		data, err := r.UnmarshalWithProc(data[1:], proc)
+		return r, data, err
+	case PercentileRing:
+		r := new(percentile.PercentileRing)
+		data, err := r.UnmarshalWithProc(data[1:], proc)
+		return r, data, err
+	case TDigestRing:
+		r := new(percentile.TDigestRing)
+		data, err := r.UnmarshalWithProc(data[1:], proc)
+		return r, data, err
+	case GroupConcatRing:
+		// the rows of group_concat are always copied
+		r := new(groupconcat.GroupConcatRing)
+		data, err := r.Unmarshal(data[1:])
+		return r, data, err
+	case MaxInt8Ring:
+		r := new(max.Int8Ring)
+		data = data[1:]
+		// Ns
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Ns = make([]int64, n)
+			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			data = data[n*8:]
+		}
+		// Da
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			var err error
+			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
+			if err != nil {
+				return nil, nil, err
+			}
+			copy(r.Da, data[:n])
+			data = data[n:]
+		}
+		// Vs
+		r.Vs = encoding.DecodeInt8Slice(r.Da)
+		// Typ
+		typ := encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		r.Typ = typ
+		return r, data, nil
+	case MaxInt16Ring:
+		r := new(max.Int16Ring)
+		data = data[1:]
+		// Ns
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Ns = make([]int64, n)
+			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			data = data[n*8:]
+		}
+		// Da
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			var err error
+			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
+			if err != nil {
+				return nil, nil, err
+			}
+			copy(r.Da, data[:n])
+			data = data[n:]
+		}
+		// Vs
+		r.Vs = encoding.DecodeInt16Slice(r.Da)
+		// Typ
+		typ := encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		r.Typ = typ
+		return r, data, nil
+	case MaxInt32Ring:
+		r := new(max.Int32Ring)
+		data = data[1:]
+		// Ns
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Ns = make([]int64, n)
+			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			data = data[n*8:]
+		}
+		// Da
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			var err error
+			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
+			if err != nil {
+				return nil, nil, err
+			}
+			copy(r.Da, data[:n])
 			data = data[n:]
 		}
 		// Vs
@@ -2946,8 +3402,210 @@ func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byt
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
-	case MinUInt32Ring:
-		r := new(min.UInt32Ring)
+	case MinUInt32Ring:
+		r := new(min.UInt32Ring)
+		data = data[1:]
+		// Ns
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Ns = make([]int64, n)
+			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			data = data[n*8:]
+		}
+		// Da
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			var err error
+			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
+			if err != nil {
+				return nil, nil, err
+			}
+			copy(r.Da, data[:n])
+			data = data[n:]
+		}
+		// Vs
+		r.Vs = encoding.DecodeUint32Slice(r.Da)
+		// Typ
+		typ := encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		r.Typ = typ
+		return r, data, nil
+	case MinUInt64Ring:
+		r := new(min.UInt64Ring)
+		data = data[1:]
+		// Ns
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Ns = make([]int64, n)
+			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			data = data[n*8:]
+		}
+		// Da
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			var err error
+			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
+			if err != nil {
+				return nil, nil, err
+			}
+			copy(r.Da, data[:n])
+			data = data[n:]
+		}
+		// Vs
+		r.Vs = encoding.DecodeUint64Slice(r.Da)
+		// Typ
+		typ := encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		r.Typ = typ
+		return r, data, nil
+	case MinFloat32Ring:
+		r := new(min.Float32Ring)
+		data = data[1:]
+		// IsE
+		/*
+			isE := encoding.DecodeUint8(data[:1])
+			if isE > 0 {
+				r.IsE = true
+			}
+			data = data[1:]
+		*/
+		// Ns
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Ns = make([]int64, n)
+			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			data = data[n*8:]
+		}
+		// Da
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			var err error
+			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
+			if err != nil {
+				return nil, nil, err
+			}
+			copy(r.Da, data[:n])
+			data = data[n:]
+		}
+		// Vs
+		r.Vs = encoding.DecodeFloat32Slice(r.Da)
+		// Typ
+		typ := encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		r.Typ = typ
+		return r, data, nil
+	case MinFloat64Ring:
+		r := new(min.Float64Ring)
+		data = data[1:]
+		// IsE
+		/*
+			isE := encoding.DecodeUint8(data[:1])
+			if isE > 0 {
+				r.IsE = true
+			}
+			data = data[1:]
+		*/
+		// Ns
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Ns = make([]int64, n)
+			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			data = data[n*8:]
+		}
+		// Da
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			var err error
+			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
+			if err != nil {
+				return nil, nil, err
+			}
+			copy(r.Da, data[:n])
+			data = data[n:]
+		}
+		// Vs
+		r.Vs = encoding.DecodeFloat64Slice(r.Da)
+		// Typ
+		typ := encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		r.Typ = typ
+		return r, data, nil
+	case MinStrRing:
+		r := new(min.StrRing)
+		data = data[1:]
+		// Ns
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Ns = make([]int64, n)
+			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			data = data[n*8:]
+		}
+		// Vs
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Vs = make([][]byte, n)
+			for i := uint32(0); i < n; i++ {
+				m := encoding.DecodeUint32(data[:4])
+				data = data[4:]
+				if m > 0 {
+					var err error
+					r.Vs[i], err = mheap.Alloc(proc.Mp, int64(m))
+					if err != nil {
+						return nil, nil, err
+					}
+					copy(r.Vs[i], data[:m])
+					data = data[m:]
+				}
+			}
+		}
+		// Typ
+		typ := encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		r.Typ = typ
+		r.Mp = proc.Mp
+		return r, data, nil
+	case SumIntRing:
+		r := new(sum.IntRing)
+		data = data[1:]
+		// Ns
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			r.Ns = make([]int64, n)
+			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
+			data = data[n*8:]
+		}
+		// Da
+		n = encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if n > 0 {
+			var err error
+			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
+			if err != nil {
+				return nil, nil, err
+			}
+			copy(r.Da, data[:n])
+			data = data[n:]
+		}
+		// Vs
+		r.Vs = encoding.DecodeInt64Slice(r.Da)
+		// Typ
+		typ := encoding.DecodeType(data[:encoding.TypeSize])
+		data = data[encoding.TypeSize:]
+		r.Typ = typ
+		return r, data, nil
+	case SumUIntRing:
+		r := new(sum.UIntRing)
 		data = data[1:]
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
@@ -2970,15 +3628,23 @@ func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byt
 			data = data[n:]
 		}
 		// Vs
-		r.Vs = encoding.DecodeUint32Slice(r.Da)
+		r.Vs = encoding.DecodeUint64Slice(r.Da)
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
-	case MinUInt64Ring:
-		r := new(min.UInt64Ring)
+	case SumFloatRing:
+		r := new(sum.FloatRing)
 		data = data[1:]
+		// IsE
+		/*
+			isE := encoding.DecodeUint8(data[:1])
+			if isE > 0 {
+				r.IsE = true
+			}
+			data = data[1:]
+		*/
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
 		data = data[4:]
@@ -3000,23 +3666,15 @@ func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byt
 			data = data[n:]
 		}
 		// Vs
-		r.Vs = encoding.DecodeUint64Slice(r.Da)
+		r.Vs = encoding.DecodeFloat64Slice(r.Da)
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
-	case MinFloat32Ring:
-		r := new(min.Float32Ring)
+	case MaxDecimal64Ring:
+		r := new(max.Decimal64Ring)
 		data = data[1:]
-		// IsE
-		/*
-			isE := encoding.DecodeUint8(data[:1])
-			if isE > 0 {
-				r.IsE = true
-			}
-			data = data[1:]
-		*/
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
 		data = data[4:]
@@ -3038,23 +3696,15 @@ func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byt
 			data = data[n:]
 		}
 		// Vs
-		r.Vs = encoding.DecodeFloat32Slice(r.Da)
+		r.Vs = encoding.DecodeDecimal64Slice(r.Da)
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
-	case MinFloat64Ring:
-		r := new(min.Float64Ring)
+	case MaxDecimal128Ring:
+		r := new(max.Decimal128Ring)
 		data = data[1:]
-		// IsE
-		/*
-			isE := encoding.DecodeUint8(data[:1])
-			if isE > 0 {
-				r.IsE = true
-			}
-			data = data[1:]
-		*/
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
 		data = data[4:]
@@ -3076,14 +3726,14 @@ func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byt
 			data = data[n:]
 		}
 		// Vs
-		r.Vs = encoding.DecodeFloat64Slice(r.Da)
+		r.Vs = encoding.DecodeDecimal128Slice(r.Da)
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
-	case MinStrRing:
-		r := new(min.StrRing)
+	case MinDecimal64Ring:
+		r := new(min.Decimal64Ring)
 		data = data[1:]
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
@@ -3093,33 +3743,27 @@ func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byt
 			copy(r.Ns, encoding.DecodeInt64Slice(data[:n*8]))
 			data = data[n*8:]
 		}
-		// Vs
+		// Da
 		n = encoding.DecodeUint32(data[:4])
 		data = data[4:]
 		if n > 0 {
-			r.Vs = make([][]byte, n)
-			for i := uint32(0); i < n; i++ {
-				m := encoding.DecodeUint32(data[:4])
-				data = data[4:]
-				if m > 0 {
-					var err error
-					r.Vs[i], err = mheap.Alloc(proc.Mp, int64(m))
-					if err != nil {
-						return nil, nil, err
-					}
-					copy(r.Vs[i], data[:m])
-					data = data[m:]
-				}
+			var err error
+			r.Da, err = mheap.Alloc(proc.Mp, int64(n))
+			if err != nil {
+				return nil, nil, err
 			}
+			copy(r.Da, data[:n])
+			data = data[n:]
 		}
+		// Vs
+		r.Vs = encoding.DecodeDecimal64Slice(r.Da)
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
-		r.Mp = proc.Mp
 		return r, data, nil
-	case SumIntRing:
-		r := new(sum.IntRing)
+	case MinDecimal128Ring:
+		r := new(min.Decimal128Ring)
 		data = data[1:]
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
@@ -3142,14 +3786,14 @@ func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byt
 			data = data[n:]
 		}
 		// Vs
-		r.Vs = encoding.DecodeInt64Slice(r.Da)
+		r.Vs = encoding.DecodeDecimal128Slice(r.Da)
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
-	case SumUIntRing:
-		r := new(sum.UIntRing)
+	case SumDecimalRing:
+		r := new(sum.DecimalRing)
 		data = data[1:]
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
@@ -3172,23 +3816,15 @@ func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byt
 			data = data[n:]
 		}
 		// Vs
-		r.Vs = encoding.DecodeUint64Slice(r.Da)
+		r.Vs = encoding.DecodeDecimal128Slice(r.Da)
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
-	case SumFloatRing:
-		r := new(sum.FloatRing)
+	case AvgDecimalRing:
+		r := new(avg.DecimalAvgRing)
 		data = data[1:]
-		// IsE
-		/*
-			isE := encoding.DecodeUint8(data[:1])
-			if isE > 0 {
-				r.IsE = true
-			}
-			data = data[1:]
-		*/
 		// Ns
 		n := encoding.DecodeUint32(data[:4])
 		data = data[4:]
@@ -3210,16 +3846,44 @@ func DecodeRingWithProcess(data []byte, proc *process.Process) (ring.Ring, []byt
 			data = data[n:]
 		}
 		// Vs
-		r.Vs = encoding.DecodeFloat64Slice(r.Da)
+		r.Vs = encoding.DecodeDecimal128Slice(r.Da)
 		// Typ
 		typ := encoding.DecodeType(data[:encoding.TypeSize])
 		data = data[encoding.TypeSize:]
 		r.Typ = typ
 		return r, data, nil
+	case DistinctRing:
+		r, data, err := DecodeRingWithProcess(data[1:], proc)
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeDistinctRing(r, data)
 	}
 	return nil, nil, fmt.Errorf("type '%v' ring not yet support", data[0])
 }
 
+// decodeDistinctRing decodes the distinct values and groups of a distinct ring
+// whose inner ring r has been decoded, the values are copied into the new ring.
+func decodeDistinctRing(r ring.Ring, data []byte) (ring.Ring, []byte, error) {
+	vec, data, err := DecodeVector(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	n := encoding.DecodeUint32(data[:4])
+	data = data[4:]
+	ss := make([][]int64, n)
+	for i := range ss {
+		m := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		if m > 0 {
+			ss[i] = make([]int64, m)
+			copy(ss[i], encoding.DecodeInt64Slice(data[:m*8]))
+			data = data[m*8:]
+		}
+	}
+	return distinct.Rebuild(r, vec, ss), data, nil
+}
+
 func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 	switch v.Typ.Oid {
 	case types.T_int8:
@@ -3392,7 +4056,41 @@ func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint64(v.Link))
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
 		buf.Write(v.Data)
-	case types.T_char, types.T_varchar:
+	case types.T_decimal64:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.Decimal64)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeDecimal64Slice(vs))
+		buf.Write(encoding.EncodeUint64(v.Link))
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
+		buf.Write(v.Data)
+	case types.T_decimal128:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.Decimal128)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeDecimal128Slice(vs))
+		buf.Write(encoding.EncodeUint64(v.Link))
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
+		buf.Write(v.Data)
+	case types.T_char, types.T_varchar, types.T_json:
 		buf.Write(encoding.EncodeType(v.Typ))
 		buf.Write(encoding.EncodeUint64(v.Ref))
 		nb, err := v.Nsp.Show()
@@ -3478,6 +4176,40 @@ func EncodeVector(v *vector.Vector, buf *bytes.Buffer) error {
 		buf.Write(encoding.EncodeUint64(v.Link))
 		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
 		buf.Write(v.Data)
+	case types.T_time:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.Time)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeTimeSlice(vs))
+		buf.Write(encoding.EncodeUint64(v.Link))
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
+		buf.Write(v.Data)
+	case types.T_timestamp:
+		buf.Write(encoding.EncodeType(v.Typ))
+		buf.Write(encoding.EncodeUint64(v.Ref))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		vs := v.Col.([]types.Timestamp)
+		buf.Write(encoding.EncodeUint32(uint32(len(vs))))
+		buf.Write(encoding.EncodeTimestampSlice(vs))
+		buf.Write(encoding.EncodeUint64(v.Link))
+		buf.Write(encoding.EncodeUint32(uint32(len(v.Data))))
+		buf.Write(v.Data)
 	default:
 		return fmt.Errorf("unsupport vector type '%s'", v.Typ)
 	}
@@ -3768,7 +4500,63 @@ func DecodeVector(data []byte) (*vector.Vector, []byte, error) {
 		v.Data = data[:n]
 		data = data[n:]
 		return v, data, nil
-	case types.T_char, types.T_varchar:
+	case types.T_decimal64:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeDecimal64Slice(data[:n*8])
+			data = data[n*8:]
+		} else {
+			data = data[4:]
+		}
+		v.Link = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		v.Data = data[:n]
+		data = data[n:]
+		return v, data, nil
+	case types.T_decimal128:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeDecimal128Slice(data[:n*16])
+			data = data[n*16:]
+		} else {
+			data = data[4:]
+		}
+		v.Link = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		v.Data = data[:n]
+		data = data[n:]
+		return v, data, nil
+	case types.T_char, types.T_varchar, types.T_json:
 		v := vector.New(typ)
 		v.Or = true
 		v.Ref = encoding.DecodeUint64(data[:8])
@@ -3898,6 +4686,62 @@ func DecodeVector(data []byte) (*vector.Vector, []byte, error) {
 		v.Data = data[:n]
 		data = data[n:]
 		return v, data, nil
+	case types.T_time:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeTimeSlice(data[:n*8])
+			data = data[n*8:]
+		} else {
+			data = data[4:]
+		}
+		v.Link = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		v.Data = data[:n]
+		data = data[n:]
+		return v, data, nil
+	case types.T_timestamp:
+		v := vector.New(typ)
+		v.Or = true
+		v.Ref = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:n]); err != nil {
+				return nil, nil, err
+			}
+			data = data[n:]
+		} else {
+			data = data[4:]
+		}
+		if n := encoding.DecodeUint32(data[:4]); n > 0 {
+			data = data[4:]
+			v.Col = encoding.DecodeTimestampSlice(data[:n*8])
+			data = data[n*8:]
+		} else {
+			data = data[4:]
+		}
+		v.Link = encoding.DecodeUint64(data[:8])
+		data = data[8:]
+		n := encoding.DecodeUint32(data[:4])
+		data = data[4:]
+		v.Data = data[:n]
+		data = data[n:]
+		return v, data, nil
 	}
 	return nil, nil, fmt.Errorf("unsupport vector type '%s'", typ)
 }
@@ -21,10 +21,13 @@ import (
 
 	"github.com/axiomhq/hyperloglog"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/distinct"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/max"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/min"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/starcount"
@@ -50,6 +53,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/untransform"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 )
 
@@ -579,6 +586,35 @@ func TestExtend(t *testing.T) {
 	}
 }
 
+func TestConditionExtend(t *testing.T) {
+	attr := &extend.Attribute{Name: "a", Type: types.T_int32}
+	extendArray := []extend.Extend{
+		&extend.CaseExtend{
+			Type: types.T_int32,
+			Conds: []extend.Extend{
+				&extend.IsNullExtend{E: attr},
+				&extend.InExtend{Not: true, E: attr, Args: []extend.Extend{&extend.ValueExtend{V: NewInt32Vector(1)}}},
+			},
+			Vals: []extend.Extend{nil, attr},
+		},
+		&extend.CoalesceExtend{
+			Type: types.T_int32,
+			Args: []extend.Extend{attr, &extend.ValueExtend{V: NewInt32Vector(2)}},
+		},
+		&extend.IsNullExtend{Not: true, E: attr},
+		&extend.InExtend{Null: true, E: attr, Args: []extend.Extend{&extend.ValueExtend{V: NewInt32Vector(3)}}},
+	}
+	for _, e := range extendArray {
+		var buf bytes.Buffer
+		require.NoError(t, EncodeExtend(e, &buf))
+		resultE, data, err := DecodeExtend(buf.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, 0, len(data))
+		require.Equal(t, e.String(), resultE.String())
+		require.Equal(t, e.ReturnType(), resultE.ReturnType())
+	}
+}
+
 func NewStrVector(v []byte) *vector.Vector {
 	vec := vector.New(types.Type{Oid: types.T(types.T_varchar), Size: 24})
 	vector.Append(vec, [][]byte{v, v, v})
@@ -778,7 +814,14 @@ func TestRing(t *testing.T) {
 	sk2 := hyperloglog.New()
 	sk2.Insert([]byte{4, 0, 0, 1})
 	sk2.Insert([]byte{0, 1, 0, 1})
+	dvec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	dvec.Col = []int64{3, 5, 3}
 	ringArray := []ring.Ring{
+		distinct.Rebuild(&count.CountRing{
+			Ns:  []int64{1, 0},
+			Vs:  []int64{2, 1},
+			Typ: types.Type{Oid: types.T_int64, Size: 8},
+		}, dvec, [][]int64{{0, 1}, {2}}),
 		&avg.AvgRing{
 			Ns:  []int64{123123123, 123123908950, 9089374534},
 			Vs:  []float64{123.123, 34534.345, 234123.345345},
@@ -939,6 +982,11 @@ func TestRing(t *testing.T) {
 		}
 
 		switch ExpectRing := resultRing.(type) {
+		case *distinct.DistinctRing:
+			oriRing := r.(*distinct.DistinctRing)
+			require.Equal(t, oriRing.Ss, ExpectRing.Ss)
+			require.Equal(t, oriRing.Vec.Col, ExpectRing.Vec.Col)
+			require.Equal(t, oriRing.R.(*count.CountRing).Vs, ExpectRing.R.(*count.CountRing).Vs)
 		case *avg.AvgRing:
 			oriRing := r.(*avg.AvgRing)
 			// Da
@@ -1618,3 +1666,61 @@ func TestBatch(t *testing.T) {
 		}
 	}
 }
+
+func TestAggregateRing(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	ivec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	ivec.Col = []int64{1, 2, 3, 4, 10}
+	nulls.Add(ivec.Nsp, 4)
+	jvec := vector.New(types.Type{Oid: types.T_json, Size: 24})
+	jvec.Col = &types.Bytes{}
+	for i, s := range []string{"b", "a", "c", "a"} {
+		row := bytejson.NewArray([]bytejson.ByteJson{bytejson.FromString([]byte(s)), bytejson.FromInt64(int64(i))})
+		jvec.Col.(*types.Bytes).Append([][]byte{row})
+	}
+	tests := []struct {
+		op       int
+		args     []string
+		distinct bool
+		vec      *vector.Vector
+	}{
+		{op: transformer.VarPop, vec: ivec},
+		{op: transformer.StdDevSamp, vec: ivec},
+		{op: transformer.BitXor, vec: ivec},
+		{op: transformer.Median, vec: ivec},
+		{op: transformer.PercentileCont, args: transformer.PercentileArgs(0.25), distinct: true, vec: ivec},
+		{op: transformer.ApproxPercentile, args: transformer.PercentileArgs(0.9), vec: ivec},
+		{op: transformer.GroupConcat, args: transformer.GroupConcatArgs(1, ";", []bool{true}), distinct: true, vec: jvec},
+	}
+	for _, tt := range tests {
+		var r ring.Ring
+		var err error
+
+		if tt.distinct {
+			r, err = transformer.NewDistinct(tt.op, tt.vec.Typ, tt.args...)
+		} else {
+			r, err = transformer.New(tt.op, tt.vec.Typ, tt.args...)
+		}
+		require.NoError(t, err)
+		require.NoError(t, r.Grows(2, proc.Mp))
+		n := vector.Length(tt.vec)
+		zs := make([]int64, n)
+		for i := range zs {
+			zs[i] = 1
+		}
+		r.BulkFill(0, zs, tt.vec)
+		r.Fill(1, 1, 3, tt.vec)
+		var buf bytes.Buffer
+		require.NoError(t, EncodeRing(r, &buf))
+		data := buf.Bytes()
+		pr, rest, err := DecodeRingWithProcess(data, proc)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(rest))
+		dr, rest, err := DecodeRing(data)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(rest))
+		expect := r.Eval([]int64{int64(n), 3})
+		require.Equal(t, expect.Col, dr.Eval([]int64{int64(n), 3}).Col, transformer.TransformerNames[tt.op])
+		require.Equal(t, expect.Col, pr.Eval([]int64{int64(n), 3}).Col, transformer.TransformerNames[tt.op])
+	}
+}
@@ -31,6 +31,11 @@ const (
 	Func
 	Star
 	Value
+	Case
+	Coalesce
+	IsNull
+	In
+	Null
 )
 
 const (
@@ -67,6 +72,19 @@ const (
 	SumIntRing
 	SumUIntRing
 	SumFloatRing
+	// Decimal
+	MaxDecimal64Ring
+	MaxDecimal128Ring
+	MinDecimal64Ring
+	MinDecimal128Ring
+	SumDecimalRing
+	AvgDecimalRing
+	DistinctRing
+	VarianceRing
+	BitRing
+	PercentileRing
+	TDigestRing
+	GroupConcatRing
 )
 
 // colexec
@@ -125,10 +143,12 @@ type PlusArgument struct {
 }
 
 type Transformer struct {
-	Op    int
-	Ref   int
-	Name  string
-	Alias string
+	Op       int
+	Ref      int
+	Name     string
+	Alias    string
+	Distinct bool
+	Args     []string
 }
 
 type TransformArgument struct {
@@ -170,6 +190,8 @@ func TransferTransformArg(arg *transform.Argument) TransformArgument {
 			bv[i].Ref = b.Ref
 			bv[i].Name = b.Name
 			bv[i].Alias = b.Alias
+			bv[i].Distinct = b.Distinct
+			bv[i].Args = b.Args
 		}
 	}
 	return TransformArgument{
@@ -210,6 +232,8 @@ func UntransferTransformArg(arg TransformArgument) *transform.Argument {
 			bv[i].Ref = b.Ref
 			bv[i].Name = b.Name
 			bv[i].Alias = b.Alias
+			bv[i].Distinct = b.Distinct
+			bv[i].Args = b.Args
 		}
 	}
 	return &transform.Argument{
@@ -242,6 +266,7 @@ type Source struct {
 	IsMerge      bool
 	SchemaName   string
 	RelationName string
+	AsOf         int64
 	RefCounts    []uint64
 	Attributes   []string
 }
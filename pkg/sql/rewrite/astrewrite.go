@@ -54,21 +54,21 @@ func AstRewrite(stmt tree.Statement) tree.Statement {
 	switch st := stmt.(type) {
 	case *tree.Select:
 		switch t := st.Select.(type) {
-		case *tree.UnionClause:
-			t.Left, t.Right = AstRewrite(t.Left), AstRewrite(t.Right)
-		case *tree.SelectClause:
-			if t.Where != nil {
-				t.Where.Expr = rewriteFilterCondition(t.Where.Expr)
-			}
-			if t.From != nil { // deal with sub-query
-				for i := range t.From.Tables {
-					t.From.Tables[i] = subTableRewrite(t.From.Tables[i])
-				}
-			}
 		case *tree.Subquery:
 			t.Select = AstRewrite(t.Select)
+		default:
+			st.Select = selectStatementRewrite(st.Select)
 		}
 		return st
+	case *tree.ParenSelect:
+		AstRewrite(st.Select)
+		return st
+	case *tree.ExplainStmt:
+		st.Statement = AstRewrite(st.Statement)
+		return st
+	case *tree.ExplainAnalyze:
+		st.Statement = AstRewrite(st.Statement)
+		return st
 	}
 	// rewrite insert statement.
 	// rewrite update statement.
@@ -76,6 +76,27 @@ func AstRewrite(stmt tree.Statement) tree.Statement {
 	return stmt
 }
 
+// selectStatementRewrite rewrites the filter conditions of select clause, and
+// the select clauses of set operation.
+func selectStatementRewrite(stmt tree.SelectStatement) tree.SelectStatement {
+	switch t := stmt.(type) {
+	case *tree.UnionClause:
+		t.Left, t.Right = selectStatementRewrite(t.Left), selectStatementRewrite(t.Right)
+	case *tree.ParenSelect:
+		AstRewrite(t.Select)
+	case *tree.SelectClause:
+		if t.Where != nil {
+			t.Where.Expr = rewriteFilterCondition(t.Where.Expr)
+		}
+		if t.From != nil { // deal with sub-query
+			for i := range t.From.Tables {
+				t.From.Tables[i] = subTableRewrite(t.From.Tables[i])
+			}
+		}
+	}
+	return stmt
+}
+
 func rewriteFilterCondition(expr tree.Expr) tree.Expr {
 	if expr == nil {
 		return nil
@@ -105,6 +126,9 @@ func rewriteFilterCondition(expr tree.Expr) tree.Expr {
 		if notExpr, ok := t.Expr.(*tree.NotExpr); ok {
 			return tree.NewNotExpr(rewriteFilterCondition(notExpr))
 		}
+		if subquery, ok := t.Expr.(*tree.Subquery); ok && subquery.Exists {
+			return tree.NewNotExpr(rewriteFilterCondition(subquery))
+		}
 		return tree.NewComparisonExpr(tree.EQUAL, t.Expr, tree.NewNumVal(constant.MakeInt64(0), "0", false))
 	// rewrite to != 0
 	case *tree.UnresolvedName, *tree.NumVal, *tree.CastExpr:
@@ -113,40 +137,23 @@ func rewriteFilterCondition(expr tree.Expr) tree.Expr {
 		if !isLogicalBinaryOp(t.Op) {
 			return tree.NewComparisonExpr(tree.NOT_EQUAL, t, tree.NewNumVal(constant.MakeInt64(0), "0", false))
 		}
-		// rewrite in operator
-		// where a in (1, 2)		----> where a = 1 or a = 2
-		// where a not in (1, 2)	----> where a != 1 and a != 2
+	case *tree.Subquery:
+		// rewrite the filter condition of subquery
+		subqueryRewrite(t)
 	case *tree.ComparisonExpr:
-		if t.Op == tree.IN {
-			if tuple, ok := t.Right.(*tree.Tuple); ok {
-				if len(tuple.Exprs) == 1 {
-					return tree.NewComparisonExpr(tree.EQUAL, t.Left, tuple.Exprs[0])
-				} else {
-					left := tree.NewComparisonExpr(tree.EQUAL, t.Left, tuple.Exprs[0])
-					right := tree.NewComparisonExpr(tree.IN, t.Left, &tree.Tuple{
-						Exprs: tuple.Exprs[1:],
-					})
-					return tree.NewOrExpr(left, rewriteFilterCondition(right))
-				}
-			}
-		}
-		if t.Op == tree.NOT_IN {
-			if tuple, ok := t.Right.(*tree.Tuple); ok {
-				if len(tuple.Exprs) == 1 {
-					return tree.NewComparisonExpr(tree.NOT_EQUAL, t.Left, tuple.Exprs[0])
-				} else {
-					left := tree.NewComparisonExpr(tree.NOT_EQUAL, t.Left, tuple.Exprs[0])
-					right := tree.NewComparisonExpr(tree.NOT_IN, t.Left, &tree.Tuple{
-						Exprs: tuple.Exprs[1:],
-					})
-					return tree.NewAndExpr(left, rewriteFilterCondition(right))
-				}
-			}
+		if subquery, ok := t.Right.(*tree.Subquery); ok {
+			subqueryRewrite(subquery)
 		}
 	}
 	return expr
 }
 
+func subqueryRewrite(t *tree.Subquery) {
+	if stmt, ok := t.Select.(*tree.ParenSelect); ok {
+		AstRewrite(stmt.Select)
+	}
+}
+
 func isLogicalBinaryOp(op tree.BinaryOp) bool {
 	_, ok := logicalBinaryOps[op]
 	return ok
@@ -73,6 +73,11 @@ func rewriteExpr(n tree.Expr) tree.Expr {
 	case *tree.CastExpr:
 		e.Expr = rewriteExpr(e.Expr)
 		return e
+	case *tree.Subquery:
+		if stmt, ok := e.Select.(*tree.ParenSelect); ok {
+			stmt.Select = rewriteSelect(stmt.Select)
+		}
+		return e
 	}
 	return n
 }
@@ -23,6 +23,12 @@ func Rewrite(stmt tree.Statement) tree.Statement {
 	case *tree.ParenSelect:
 		stmt.Select = rewriteSelect(stmt.Select)
 		return stmt
+	case *tree.ExplainStmt:
+		stmt.Statement = Rewrite(stmt.Statement)
+		return stmt
+	case *tree.ExplainAnalyze:
+		stmt.Statement = Rewrite(stmt.Statement)
+		return stmt
 	}
 	return stmt
 }
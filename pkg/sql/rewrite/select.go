@@ -17,14 +17,31 @@ package rewrite
 import "github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 
 func rewriteSelect(stmt *tree.Select) *tree.Select {
-	if sel, ok := stmt.Select.(*tree.SelectClause); ok {
-		stmt.Select = rewriteSelectClause(sel)
+	stmt.Select = rewriteSelectStatement(stmt.Select)
+	return stmt
+}
+
+func rewriteSelectStatement(stmt tree.SelectStatement) tree.SelectStatement {
+	switch stmt := stmt.(type) {
+	case *tree.SelectClause:
+		return rewriteSelectClause(stmt)
+	case *tree.ParenSelect:
+		stmt.Select = rewriteSelect(stmt.Select)
+	case *tree.UnionClause:
+		stmt.Left = rewriteSelectStatement(stmt.Left)
+		stmt.Right = rewriteSelectStatement(stmt.Right)
 	}
 	return stmt
 }
 
 func rewriteSelectClause(stmt *tree.SelectClause) *tree.SelectClause {
 	stmt.Exprs = rewriteProjection(stmt.Exprs)
+	if stmt.Where != nil { // rewrite the subqueries of where clause
+		stmt.Where.Expr = rewriteExpr(stmt.Where.Expr)
+	}
+	if stmt.Having != nil {
+		stmt.Having.Expr = rewriteExpr(stmt.Having.Expr)
+	}
 	if stmt.From != nil && len(stmt.From.Tables) > 0 {
 		stmt.From.Tables = rewriteFrom(stmt.From.Tables)
 	}
@@ -53,7 +70,7 @@ func rewriteTable(stmt tree.TableExpr) tree.TableExpr {
 		stmt.Expr = rewriteTable(stmt.Expr)
 		return stmt
 	case *tree.AliasedTableExpr:
-		if len(stmt.As.Alias) == 0 {
+		if len(stmt.As.Alias) == 0 && stmt.AsOf == nil {
 			return rewriteTable(stmt.Expr)
 		}
 		stmt.Expr = rewriteTable(stmt.Expr)
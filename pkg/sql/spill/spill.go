@@ -0,0 +1,336 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"bufio"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// InitPath sets the directory of the spill files and removes the spill
+// files left in it by the last run of the server. Nothing else is removed,
+// in case the directory is shared or misconfigured.
+func InitPath(path string) error {
+	Path = path
+	names, err := filepath.Glob(filepath.Join(path, filePrefix+"*"))
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := os.Remove(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Create returns an empty spill file under Path, the system temporary
+// directory is used if Path is not set.
+func Create() (*File, error) {
+	dir := Path
+	if len(dir) == 0 {
+		dir = os.TempDir()
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.New(errno.SystemError, fmt.Sprintf("create spill directory failed: %v", err))
+	}
+	f, err := os.CreateTemp(dir, filePrefix)
+	if err != nil {
+		return nil, errors.New(errno.SystemError, fmt.Sprintf("create spill file failed: %v", err))
+	}
+	return &File{f: f, w: bufio.NewWriter(f)}, nil
+}
+
+// Write appends the batch to the file
+func (f *File) Write(bat *batch.Batch) error {
+	if f.reading {
+		return errors.New(errno.InternalError, "write a spill file which is being read")
+	}
+	f.buf.Reset()
+	if err := EncodeBatch(bat, &f.buf); err != nil {
+		return err
+	}
+	if _, err := f.w.Write(encoding.EncodeUint32(uint32(f.buf.Len()))); err != nil {
+		return errors.New(errno.SystemError, fmt.Sprintf("write spill file failed: %v", err))
+	}
+	if _, err := f.w.Write(f.buf.Bytes()); err != nil {
+		return errors.New(errno.SystemError, fmt.Sprintf("write spill file failed: %v", err))
+	}
+	return nil
+}
+
+// Read returns the next batch of the file, the memory of the batch is allocated
+// from the process. It returns nil if all the batches have been read.
+func (f *File) Read(proc *process.Process) (*batch.Batch, error) {
+	if !f.reading {
+		if err := f.w.Flush(); err != nil {
+			return nil, errors.New(errno.SystemError, fmt.Sprintf("write spill file failed: %v", err))
+		}
+		if _, err := f.f.Seek(0, io.SeekStart); err != nil {
+			return nil, errors.New(errno.SystemError, fmt.Sprintf("read spill file failed: %v", err))
+		}
+		f.r = bufio.NewReader(f.f)
+		f.reading = true
+	}
+	var size [4]byte
+	if _, err := io.ReadFull(f.r, size[:]); err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, errors.New(errno.SystemError, fmt.Sprintf("read spill file failed: %v", err))
+	}
+	data := make([]byte, encoding.DecodeUint32(size[:]))
+	if _, err := io.ReadFull(f.r, data); err != nil {
+		return nil, errors.New(errno.SystemError, fmt.Sprintf("read spill file failed: %v", err))
+	}
+	bat, _, err := DecodeBatch(data, proc)
+	if err != nil {
+		return nil, err
+	}
+	// the batches are written without sels
+	bat.Sels, bat.SelsData = nil, nil
+	return bat, nil
+}
+
+// Close closes the file and removes it
+func (f *File) Close() error {
+	if err := f.f.Close(); err != nil {
+		return err
+	}
+	return os.Remove(f.f.Name())
+}
+
+// Slice returns a copy of the cnt rows of the batch starting from offset
+func Slice(bat *batch.Batch, offset int64, cnt int, proc *process.Process) (*batch.Batch, error) {
+	flags := make([]uint8, cnt)
+	for i := range flags {
+		flags[i] = 1
+	}
+	return copyRows(bat, offset, flags, cnt, proc)
+}
+
+// Partition splits the batch into Partitions batches by the hash of the values of its
+// vectors, the rows with the same values are in the same partition. The batch of a
+// partition is nil if there is no row in it.
+func Partition(bat *batch.Batch, proc *process.Process) ([]*batch.Batch, error) {
+	return partition(bat, 0, len(bat.Zs), rowPartition(bat), proc)
+}
+
+// WritePartitions partitions the batch and appends the partitions to the files, the files
+// are created if fs is nil. The batch is freed.
+func WritePartitions(fs []*File, bat *batch.Batch, proc *process.Process) ([]*File, error) {
+	return WritePartitionsBy(fs, bat, rowPartition(bat), proc)
+}
+
+// WritePartitionsBy works like WritePartitions, but the partition of a row is
+// returned by part, e.g. the hash of its join key.
+func WritePartitionsBy(fs []*File, bat *batch.Batch, part func(int64) (int, error), proc *process.Process) ([]*File, error) {
+	defer batch.Clean(bat, proc.Mp)
+	if fs == nil {
+		fs = make([]*File, 0, Partitions)
+		for i := 0; i < Partitions; i++ {
+			f, err := Create()
+			if err != nil {
+				CloseFiles(fs)
+				return nil, err
+			}
+			fs = append(fs, f)
+		}
+	}
+	// the batch is partitioned BatchRows rows at a time to bound the memory of the copies
+	for offset := 0; offset < len(bat.Zs); offset += BatchRows {
+		n := len(bat.Zs) - offset
+		if n > BatchRows {
+			n = BatchRows
+		}
+		bats, err := partition(bat, int64(offset), n, part, proc)
+		if err != nil {
+			return fs, err
+		}
+		for i, pbat := range bats {
+			if pbat == nil {
+				continue
+			}
+			if err == nil {
+				err = fs[i].Write(pbat)
+			}
+			batch.Clean(pbat, proc.Mp)
+		}
+		if err != nil {
+			return fs, err
+		}
+	}
+	return fs, nil
+}
+
+// CloseFiles closes the files and removes them
+func CloseFiles(fs []*File) {
+	for _, f := range fs {
+		f.Close()
+	}
+}
+
+// ReadAll returns all the batches of the file in one batch, it returns nil if
+// the file has no batch.
+func ReadAll(f *File, proc *process.Process) (*batch.Batch, error) {
+	bat, err := f.Read(proc)
+	if err != nil || bat == nil {
+		return nil, err
+	}
+	for {
+		rbat, err := f.Read(proc)
+		if err != nil {
+			batch.Clean(bat, proc.Mp)
+			return nil, err
+		}
+		if rbat == nil {
+			return bat, nil
+		}
+		err = appendRows(bat, rbat, proc)
+		batch.Clean(rbat, proc.Mp)
+		if err != nil {
+			batch.Clean(bat, proc.Mp)
+			return nil, err
+		}
+	}
+}
+
+// rowPartition returns the partition of a row of the batch by the hash of all its values
+func rowPartition(bat *batch.Batch) func(int64) (int, error) {
+	var key []byte
+
+	h := fnv.New64a()
+	return func(row int64) (int, error) {
+		var err error
+
+		if key, err = batch.RowKey(key[:0], bat, row); err != nil {
+			return 0, err
+		}
+		h.Reset()
+		h.Write(key)
+		return int(h.Sum64() % Partitions), nil
+	}
+}
+
+// partition splits the n rows of the batch starting from offset into Partitions batches
+func partition(bat *batch.Batch, offset int64, n int, part func(int64) (int, error), proc *process.Process) ([]*batch.Batch, error) {
+	var err error
+
+	ps := make([]int, n)
+	for i := range ps {
+		if ps[i], err = part(offset + int64(i)); err != nil {
+			return nil, err
+		}
+	}
+	bats := make([]*batch.Batch, Partitions)
+	flags := make([]uint8, n)
+	for p := range bats {
+		cnt := 0
+		for i := range flags {
+			flags[i] = 0
+			if ps[i] == p {
+				flags[i] = 1
+				cnt++
+			}
+		}
+		if cnt == 0 {
+			continue
+		}
+		pbat, err := copyRows(bat, offset, flags, cnt, proc)
+		if err != nil {
+			for _, b := range bats {
+				if b != nil {
+					batch.Clean(b, proc.Mp)
+				}
+			}
+			return nil, err
+		}
+		bats[p] = pbat
+	}
+	return bats, nil
+}
+
+// copyRows returns a copy of the rows of the batch whose flags are set,
+// flags[i] is the flag of the row offset+i and cnt is the number of the rows.
+func copyRows(bat *batch.Batch, offset int64, flags []uint8, cnt int, proc *process.Process) (*batch.Batch, error) {
+	rbat := batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.New(vec.Typ)
+		if err := vector.UnionBatch(rbat.Vecs[i], vec, offset, cnt, flags, proc.Mp); err != nil {
+			batch.Clean(rbat, proc.Mp)
+			return nil, err
+		}
+		rbat.Vecs[i].Ref = vec.Ref
+	}
+	rbat.Zs = make([]int64, 0, cnt)
+	for i, flg := range flags {
+		if flg > 0 {
+			rbat.Zs = append(rbat.Zs, bat.Zs[offset+int64(i)])
+		}
+	}
+	rbat.As = append(rbat.As, bat.As...)
+	rbat.Refs = append(rbat.Refs, bat.Refs...)
+	for _, r := range bat.Rs {
+		rr := r.Dup()
+		rbat.Rs = append(rbat.Rs, rr)
+		if err := rr.Grows(cnt, proc.Mp); err != nil {
+			batch.Clean(rbat, proc.Mp)
+			return nil, err
+		}
+		for i, j := 0, int64(0); i < len(flags); i++ {
+			if flags[i] > 0 {
+				rr.Add(r, j, offset+int64(i))
+				j++
+			}
+		}
+	}
+	return rbat, nil
+}
+
+// appendRows appends the rows of src to bat, they have the same attributes in the same order
+func appendRows(bat, src *batch.Batch, proc *process.Process) error {
+	cnt := len(src.Zs)
+	flags := make([]uint8, cnt)
+	for i := range flags {
+		flags[i] = 1
+	}
+	for i, vec := range bat.Vecs {
+		if err := vector.UnionBatch(vec, src.Vecs[i], 0, cnt, flags, proc.Mp); err != nil {
+			return err
+		}
+	}
+	for i, r := range bat.Rs {
+		j := int64(len(bat.Zs))
+		if err := r.Grows(cnt, proc.Mp); err != nil {
+			return err
+		}
+		for k := 0; k < cnt; k++ {
+			r.Add(src.Rs[i], j+int64(k), int64(k))
+		}
+	}
+	bat.Zs = append(bat.Zs, src.Zs...)
+	return nil
+}
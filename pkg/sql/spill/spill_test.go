@@ -0,0 +1,44 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInitPath(t *testing.T) {
+	dir := t.TempDir()
+	stale, other := filepath.Join(dir, filePrefix+"1"), filepath.Join(dir, "data")
+	for _, name := range []string{stale, other} {
+		if err := os.WriteFile(name, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// only the spill files left by the last run are removed
+	if err := InitPath(dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("the stale spill file is left: %v", err)
+	}
+	if _, err := os.Stat(other); err != nil {
+		t.Errorf("the other file is removed: %v", err)
+	}
+	if err := InitPath(filepath.Join(dir, "none")); err != nil {
+		t.Errorf("a missing directory fails: %v", err)
+	}
+}
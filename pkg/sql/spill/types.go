@@ -0,0 +1,54 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// BatchRows, max rows of a batch written to a spill file
+	BatchRows = 8192
+	// Partitions, number of the partitions of a hash aggregation or a hash join which spills
+	Partitions = 16
+
+	// filePrefix, the prefix of the names of the spill files
+	filePrefix = "spill-"
+)
+
+var (
+	// Path, the directory of the spill files
+	Path string
+
+	// EncodeBatch and DecodeBatch serialize the batches of the spill files,
+	// they are set by the protocol package which knows all kinds of rings.
+	EncodeBatch func(*batch.Batch, *bytes.Buffer) error
+	DecodeBatch func([]byte, *process.Process) (*batch.Batch, []byte, error)
+)
+
+// File is a temporary file of batches, the batches are
+// written one by one and then read back in the same order.
+type File struct {
+	f       *os.File
+	w       *bufio.Writer
+	r       *bufio.Reader
+	buf     bytes.Buffer
+	reading bool
+}
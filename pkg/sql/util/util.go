@@ -25,6 +25,9 @@ func SplitTableAndColumn(name string) (string, string) {
 			return "", name
 		}
 	}
+	if strings.Contains(name, " ") { // an expression such as 't.a + 1'
+		return "", name
+	}
 	xs := strings.Split(name, ".")
 	for i := 0; i < len(xs)-1; i++ {
 		if i > 0 {
@@ -0,0 +1,651 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package join
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	for i, r := range n.Rs {
+		if i > 0 {
+			buf.WriteString(fmt.Sprintf(" %s", JoinNames[r.Typ]))
+			if r.Cond != nil {
+				buf.WriteString(fmt.Sprintf("(%s)", r.Cond))
+			}
+			buf.WriteString(" ")
+		}
+		buf.WriteString(r.Alias)
+	}
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(Container)
+	n.ctr.state = Build
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := n.ctr
+	switch ctr.state {
+	case Build:
+		ctr.state = End
+		if err := ctr.build(n, proc); err != nil {
+			return true, err
+		}
+		proc.Reg.InputBatch = ctr.bat
+		ctr.bat = nil
+		return false, nil
+	default:
+		proc.Reg.InputBatch = nil
+		return true, nil
+	}
+}
+
+// build receives all relations and joins them from left to right
+func (ctr *Container) build(n *Argument, proc *process.Process) error {
+	var err error
+
+	for i := range n.Rs {
+		bat, rerr := receive(proc.Reg.MergeReceivers[i], &n.Rs[i], proc)
+		if err != nil || rerr != nil {
+			if err == nil {
+				err = rerr
+			}
+			if bat != nil {
+				batch.Clean(bat, proc.Mp)
+			}
+			continue
+		}
+		if i == 0 {
+			ctr.bat = bat
+			continue
+		}
+		if ctr.bat, err = join(ctr.bat, bat, &n.Rs[i], proc); err != nil {
+			ctr.bat = nil
+		}
+	}
+	if err != nil && ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+	return err
+}
+
+// receive collects all batches of a relation, all batches are
+// received even if an error occurs so that the senders can finish
+// unless the query is aborted.
+func receive(reg *process.WaitRegister, r *Relation, proc *process.Process) (*batch.Batch, error) {
+	var err error
+
+	rbat := batch.New(false, make([]string, len(r.Vars)))
+	for i, v := range r.Vars {
+		rbat.Attrs[i] = r.Alias + "." + v
+		rbat.Vecs[i] = vector.New(r.Types[i])
+	}
+	for {
+		bat, rerr := process.Receive(proc, reg)
+		if rerr != nil {
+			return rbat, rerr
+		}
+		if bat == nil {
+			break
+		}
+		if err == nil && len(bat.Zs) > 0 {
+			err = appendBatch(rbat, bat, r.Vars, proc)
+		}
+		batch.Clean(bat, proc.Mp)
+	}
+	for i, vec := range rbat.Vecs {
+		vec.Ref = r.Refs[i]
+	}
+	return rbat, err
+}
+
+func appendBatch(rbat, bat *batch.Batch, vars []string, proc *process.Process) error {
+	flags := make([]uint8, len(bat.Zs))
+	for i := range flags {
+		flags[i] = 1
+	}
+	for i, v := range vars {
+		vec := batch.GetVector(bat, v)
+		if vec == nil {
+			return errors.New(errno.InternalError, fmt.Sprintf("unknown attribute '%s' of join", v))
+		}
+		if err := vector.UnionBatch(rbat.Vecs[i], vec, 0, len(bat.Zs), flags, proc.Mp); err != nil {
+			return err
+		}
+	}
+	rbat.Zs = append(rbat.Zs, bat.Zs...)
+	return nil
+}
+
+// join joins the result of the relations in front of r with r, the equi-join
+// conditions are evaluated by hash, and the remaining conditions are evaluated
+// on the candidate pairs.
+func join(l, r *batch.Batch, rel *Relation, proc *process.Process) (*batch.Batch, error) {
+	defer batch.Clean(l, proc.Mp)
+	defer batch.Clean(r, proc.Mp)
+	jn, err := newJoiner(l, r, rel, proc)
+	if err != nil {
+		return nil, err
+	}
+	if err = jn.process(rel.Cond, proc); err != nil {
+		batch.Clean(jn.bat, proc.Mp)
+		return nil, err
+	}
+	for i, vec := range l.Vecs {
+		jn.bat.Vecs[i].Ref = vec.Ref
+	}
+	if len(jn.bat.Vecs) > len(l.Vecs) {
+		for i, vec := range r.Vecs {
+			jn.bat.Vecs[len(l.Vecs)+i].Ref = vec.Ref
+		}
+	}
+	if rel.Cond != nil {
+		batch.Reduce(jn.bat, rel.Cond.Attributes(), proc.Mp)
+	}
+	return jn.bat, nil
+}
+
+func newJoiner(l, r *batch.Batch, rel *Relation, proc *process.Process) (*joiner, error) {
+	var err error
+
+	jn := &joiner{
+		l:        l,
+		r:        r,
+		typ:      rel.Typ,
+		na:       rel.NullAware,
+		lmatched: make([]bool, len(l.Zs)),
+		rmatched: make([]bool, len(r.Zs)),
+	}
+	attrs := make([]string, 0, len(l.Attrs)+len(r.Attrs))
+	attrs = append(attrs, l.Attrs...)
+	if jn.typ != Semi && jn.typ != Anti { // only rows of left are returned by semi join and anti join
+		attrs = append(attrs, r.Attrs...)
+	}
+	jn.bat = batch.New(false, attrs)
+	for i, vec := range l.Vecs {
+		jn.bat.Vecs[i] = vector.New(vec.Typ)
+	}
+	for i := len(l.Vecs); i < len(attrs); i++ {
+		jn.bat.Vecs[i] = vector.New(r.Vecs[i-len(l.Vecs)].Typ)
+	}
+	if jn.typ == Right || jn.typ == Full {
+		if jn.lnulls, err = nullVectors(l.Vecs); err != nil {
+			return nil, err
+		}
+	}
+	if jn.typ == Left || jn.typ == Full || jn.typ == Single {
+		if jn.rnulls, err = nullVectors(r.Vecs); err != nil {
+			return nil, err
+		}
+	}
+	return jn, nil
+}
+
+func (jn *joiner) process(cond extend.Extend, proc *process.Process) error {
+	var es []extend.Extend
+	var lkeys, rkeys []int
+
+	if cond != nil {
+		for i, e := range splitAndExtend(cond, nil) {
+			if li, ri, ok := jn.equiJoinKey(e); ok {
+				lkeys = append(lkeys, li)
+				rkeys = append(rkeys, ri)
+				continue
+			}
+			if i == 0 && jn.na {
+				return errors.New(errno.InternalError, fmt.Sprintf("null aware join condition '%s' is not an equi-join condition", e))
+			}
+			if v, ok := e.(*extend.ValueExtend); ok {
+				if !isTrue(v.V) {
+					return jn.pad(proc)
+				}
+				continue
+			}
+			es = append(es, e)
+		}
+	}
+	if len(es) > 0 {
+		jn.cond = es[0]
+		for _, e := range es[1:] {
+			jn.cond = &extend.BinaryExtend{Op: overload.And, Left: jn.cond, Right: e}
+		}
+		jn.attrs = dedupAttributes(jn.cond.Attributes())
+	}
+	if len(lkeys) > 0 {
+		if err := jn.hashJoin(lkeys, rkeys, proc); err != nil {
+			return err
+		}
+	} else {
+		for i := range jn.l.Zs {
+			for j := range jn.r.Zs {
+				if err := jn.add(int64(i), int64(j), proc); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if err := jn.flush(proc); err != nil {
+		return err
+	}
+	return jn.pad(proc)
+}
+
+func (jn *joiner) hashJoin(lkeys, rkeys []int, proc *process.Process) error {
+	var err error
+	var key []byte
+
+	if jn.na {
+		return jn.nullAwareHashJoin(lkeys, rkeys, proc)
+	}
+	mp := make(map[string][]int64)
+	for j := range jn.r.Zs {
+		if key, err = rowKey(key[:0], jn.r, rkeys, int64(j)); err != nil {
+			return err
+		}
+		if key != nil {
+			mp[string(key)] = append(mp[string(key)], int64(j))
+		}
+	}
+	for i := range jn.l.Zs {
+		if key, err = rowKey(key[:0], jn.l, lkeys, int64(i)); err != nil {
+			return err
+		}
+		if key == nil {
+			continue
+		}
+		for _, j := range mp[string(key)] {
+			if err := jn.add(int64(i), j, proc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// nullAwareHashJoin is the hash join of null aware anti join. The first key is
+// the equi-join condition whose null matches any row, so a row with null of the
+// first key is paired with all rows which have the same remaining keys.
+func (jn *joiner) nullAwareHashJoin(lkeys, rkeys []int, proc *process.Process) error {
+	buf := make([]byte, 0, 64)
+	mp := make(map[string][]int64)  // rows of right by all keys
+	nmp := make(map[string][]int64) // rows of right whose first key is null by remaining keys
+	amp := make(map[string][]int64) // rows of right by remaining keys
+	for j := range jn.r.Zs {
+		key, err := rowKey(buf[:0], jn.r, rkeys[1:], int64(j))
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			continue
+		}
+		amp[string(key)] = append(amp[string(key)], int64(j))
+		if nulls.Contains(jn.r.Vecs[rkeys[0]].Nsp, uint64(j)) {
+			nmp[string(key)] = append(nmp[string(key)], int64(j))
+			continue
+		}
+		if key, err = vector.AppendKey(key, jn.r.Vecs[rkeys[0]], int64(j)); err != nil {
+			return err
+		}
+		mp[string(key)] = append(mp[string(key)], int64(j))
+	}
+	for i := range jn.l.Zs {
+		key, err := rowKey(buf[:0], jn.l, lkeys[1:], int64(i))
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			continue
+		}
+		rows := amp[string(key)]
+		if !nulls.Contains(jn.l.Vecs[lkeys[0]].Nsp, uint64(i)) {
+			rows = nmp[string(key)]
+			if key, err = vector.AppendKey(key, jn.l.Vecs[lkeys[0]], int64(i)); err != nil {
+				return err
+			}
+			rows = append(rows[:len(rows):len(rows)], mp[string(key)]...)
+		}
+		for _, j := range rows {
+			if err := jn.add(int64(i), j, proc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// add adds a candidate pair, and evaluates the candidate pairs if there are enough
+func (jn *joiner) add(i, j int64, proc *process.Process) error {
+	if (jn.typ == Semi || jn.typ == Anti) && jn.lmatched[i] {
+		return nil
+	}
+	jn.lsels = append(jn.lsels, i)
+	jn.rsels = append(jn.rsels, j)
+	if len(jn.lsels) >= UnitLimit {
+		return jn.flush(proc)
+	}
+	return nil
+}
+
+// flush evaluates the condition on the candidate pairs and appends the matched pairs
+func (jn *joiner) flush(proc *process.Process) error {
+	if len(jn.lsels) == 0 {
+		return nil
+	}
+	defer func() {
+		jn.lsels = jn.lsels[:0]
+		jn.rsels = jn.rsels[:0]
+	}()
+	if jn.cond == nil {
+		for k := range jn.lsels {
+			if err := jn.appendPair(jn.lsels[k], jn.rsels[k], proc); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	bat, err := jn.candidates(proc)
+	if err != nil {
+		return err
+	}
+	defer batch.Clean(bat, proc.Mp)
+	vec, typ, err := jn.cond.Eval(bat, proc)
+	if err != nil {
+		return err
+	}
+	if typ != types.T_sel {
+		return errors.New(errno.DatatypeMismatch, fmt.Sprintf("join condition '%s' is not a logical expression", jn.cond))
+	}
+	defer process.Put(proc, vec)
+	for _, k := range vec.Col.([]int64) {
+		if err := jn.appendPair(jn.lsels[k], jn.rsels[k], proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// candidates constructs the batch of the candidate pairs which only contains
+// the attributes of the condition.
+func (jn *joiner) candidates(proc *process.Process) (*batch.Batch, error) {
+	bat := batch.New(false, jn.attrs)
+	for i, attr := range jn.attrs {
+		vec, sels := batch.GetVector(jn.l, attr), jn.lsels
+		if vec == nil {
+			vec, sels = batch.GetVector(jn.r, attr), jn.rsels
+		}
+		if vec == nil {
+			bat.Vecs = bat.Vecs[:i]
+			batch.Clean(bat, proc.Mp)
+			return nil, errors.New(errno.InternalError, fmt.Sprintf("unknown attribute '%s' of join condition", attr))
+		}
+		bat.Vecs[i] = vector.New(vec.Typ)
+		for _, sel := range sels {
+			if err := vector.UnionOne(bat.Vecs[i], vec, sel, proc.Mp); err != nil {
+				bat.Vecs = bat.Vecs[:i+1]
+				batch.Clean(bat, proc.Mp)
+				return nil, err
+			}
+		}
+		bat.Vecs[i].Ref = 2 // never reused by the evaluation of condition
+	}
+	bat.InitZsOne(len(jn.lsels))
+	return bat, nil
+}
+
+// pad appends the unmatched rows padded with nulls for outer joins,
+// and the unmatched rows of left for anti join
+func (jn *joiner) pad(proc *process.Process) error {
+	if jn.typ == Left || jn.typ == Full || jn.typ == Single || jn.typ == Anti {
+		for i, ok := range jn.lmatched {
+			if !ok {
+				if err := jn.appendRow(int64(i), -1, proc); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if jn.typ == Right || jn.typ == Full {
+		for j, ok := range jn.rmatched {
+			if !ok {
+				if err := jn.appendRow(-1, int64(j), proc); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (jn *joiner) appendPair(i, j int64, proc *process.Process) error {
+	switch jn.typ {
+	case Semi:
+		if jn.lmatched[i] {
+			return nil
+		}
+		jn.lmatched[i] = true
+		return jn.appendRow(i, -1, proc)
+	case Anti:
+		jn.lmatched[i] = true
+		return nil
+	case Single:
+		if jn.lmatched[i] || jn.r.Zs[j] > 1 {
+			return errors.New(errno.CardinalityViolation, "Subquery returns more than 1 row")
+		}
+	}
+	jn.lmatched[i] = true
+	jn.rmatched[j] = true
+	return jn.appendRow(i, j, proc)
+}
+
+// appendRow appends the i-th row of left and the j-th row of right,
+// and a negative number means a row of nulls. The row of right is not
+// appended for semi join and anti join.
+func (jn *joiner) appendRow(i, j int64, proc *process.Process) error {
+	var z int64 = 1
+
+	n := len(jn.l.Vecs)
+	for k, vec := range jn.l.Vecs {
+		w, sel := vec, i
+		if i < 0 {
+			w, sel = jn.lnulls[k], 0
+		}
+		if err := vector.UnionOne(jn.bat.Vecs[k], w, sel, proc.Mp); err != nil {
+			return err
+		}
+	}
+	for k := n; k < len(jn.bat.Vecs); k++ {
+		vec := jn.r.Vecs[k-n]
+		w, sel := vec, j
+		if j < 0 {
+			w, sel = jn.rnulls[k-n], 0
+		}
+		if err := vector.UnionOne(jn.bat.Vecs[k], w, sel, proc.Mp); err != nil {
+			return err
+		}
+	}
+	if i >= 0 {
+		z *= jn.l.Zs[i]
+	}
+	if j >= 0 {
+		z *= jn.r.Zs[j]
+	}
+	jn.bat.Zs = append(jn.bat.Zs, z)
+	return nil
+}
+
+// equiJoinKey returns the index of attributes if e is l.attr = r.attr
+func (jn *joiner) equiJoinKey(e extend.Extend) (int, int, bool) {
+	be, ok := e.(*extend.BinaryExtend)
+	if !ok || be.Op != overload.EQ {
+		return 0, 0, false
+	}
+	left, ok := be.Left.(*extend.Attribute)
+	if !ok {
+		return 0, 0, false
+	}
+	right, ok := be.Right.(*extend.Attribute)
+	if !ok {
+		return 0, 0, false
+	}
+	li, ri := batch.GetVectorIndex(jn.l, left.Name), batch.GetVectorIndex(jn.r, right.Name)
+	if li < 0 || ri < 0 {
+		li, ri = batch.GetVectorIndex(jn.l, right.Name), batch.GetVectorIndex(jn.r, left.Name)
+	}
+	if li < 0 || ri < 0 || jn.l.Vecs[li].Typ.Oid != jn.r.Vecs[ri].Typ.Oid {
+		return 0, 0, false
+	}
+	return li, ri, true
+}
+
+// rowKey returns nil if any attribute of the key is null
+func rowKey(key []byte, bat *batch.Batch, is []int, row int64) ([]byte, error) {
+	var err error
+
+	for _, i := range is {
+		if nulls.Contains(bat.Vecs[i].Nsp, uint64(row)) {
+			return nil, nil
+		}
+		if key, err = vector.AppendKey(key, bat.Vecs[i], row); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+func splitAndExtend(e extend.Extend, es []extend.Extend) []extend.Extend {
+	switch v := e.(type) {
+	case *extend.ParenExtend:
+		return splitAndExtend(v.E, es)
+	case *extend.BinaryExtend:
+		if v.Op == overload.And {
+			return splitAndExtend(v.Right, splitAndExtend(v.Left, es))
+		}
+	}
+	return append(es, e)
+}
+
+func dedupAttributes(attrs []string) []string {
+	var rs []string
+
+	mp := make(map[string]struct{})
+	for _, attr := range attrs {
+		if _, ok := mp[attr]; !ok {
+			mp[attr] = struct{}{}
+			rs = append(rs, attr)
+		}
+	}
+	return rs
+}
+
+func nullVectors(vecs []*vector.Vector) ([]*vector.Vector, error) {
+	rs := make([]*vector.Vector, len(vecs))
+	for i, vec := range vecs {
+		rs[i] = vector.New(vec.Typ)
+		if err := vector.Append(rs[i], zeroValue(vec.Typ)); err != nil {
+			return nil, err
+		}
+		nulls.Add(rs[i].Nsp, 0)
+	}
+	return rs, nil
+}
+
+func zeroValue(typ types.Type) interface{} {
+	switch typ.Oid {
+	case types.T_int8:
+		return []int8{0}
+	case types.T_int16:
+		return []int16{0}
+	case types.T_int32:
+		return []int32{0}
+	case types.T_int64:
+		return []int64{0}
+	case types.T_uint8:
+		return []uint8{0}
+	case types.T_uint16:
+		return []uint16{0}
+	case types.T_uint32:
+		return []uint32{0}
+	case types.T_uint64:
+		return []uint64{0}
+	case types.T_decimal64:
+		return []types.Decimal64{0}
+	case types.T_decimal128:
+		return []types.Decimal128{{}}
+	case types.T_float32:
+		return []float32{0}
+	case types.T_float64:
+		return []float64{0}
+	case types.T_date:
+		return []types.Date{0}
+	case types.T_datetime:
+		return []types.Datetime{0}
+	case types.T_time:
+		return []types.Time{0}
+	case types.T_timestamp:
+		return []types.Timestamp{0}
+	case types.T_char, types.T_varchar, types.T_json:
+		return [][]byte{{}}
+	}
+	return nil
+}
+
+func isTrue(v *vector.Vector) bool {
+	switch vs := v.Col.(type) {
+	case []int8:
+		return vs[0] != 0
+	case []int16:
+		return vs[0] != 0
+	case []int32:
+		return vs[0] != 0
+	case []int64:
+		return vs[0] != 0
+	case []uint8:
+		return vs[0] != 0
+	case []uint16:
+		return vs[0] != 0
+	case []uint32:
+		return vs[0] != 0
+	case []uint64:
+		return vs[0] != 0
+	case []float32:
+		return vs[0] != 0
+	case []float64:
+		return vs[0] != 0
+	case []types.Decimal64:
+		return vs[0] != 0
+	case []types.Decimal128:
+		return vs[0] != types.Decimal128{}
+	case *types.Bytes:
+		return len(vs.Data) > 0
+	}
+	return false
+}
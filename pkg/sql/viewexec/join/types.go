@@ -0,0 +1,92 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package join
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+)
+
+const (
+	Build = iota
+	End
+)
+
+const (
+	UnitLimit = 1024
+)
+
+// join types
+const (
+	Inner = iota
+	Left
+	Right
+	Full
+	Semi   // rows of left which have matched rows of right
+	Anti   // rows of left which have no matched rows of right
+	Single // left join whose row of left has at most one matched row of right
+)
+
+var JoinNames = [...]string{
+	Inner:  "⋈",
+	Left:   "⟕",
+	Right:  "⟖",
+	Full:   "⟗",
+	Semi:   "⋉",
+	Anti:   "▷",
+	Single: "⟕¹",
+}
+
+type Relation struct {
+	Typ   int           // type of the join with the relations in front of it
+	Alias string        // alias of relation
+	Cond  extend.Extend // join condition, nil means product
+	// NullAware is only used by anti join, the first conjunct of Cond is an
+	// equi-join condition whose null matches any row, such as NOT IN.
+	NullAware bool
+	Vars      []string     // attributes of relation
+	Refs      []uint64     // reference count of attributes
+	Types     []types.Type // type of attributes
+}
+
+type Container struct {
+	state int
+	bat   *batch.Batch
+}
+
+// joiner joins the result of the relations in front of a relation with it
+type joiner struct {
+	typ      int
+	na       bool // null aware
+	l, r     *batch.Batch
+	bat      *batch.Batch
+	cond     extend.Extend // condition evaluated on candidate pairs
+	attrs    []string      // attributes of cond
+	lsels    []int64       // candidate pairs
+	rsels    []int64
+	lmatched []bool
+	rmatched []bool
+	lnulls   []*vector.Vector // one row vectors of null
+	rnulls   []*vector.Vector
+}
+
+// Argument joins the relations one by one, and the i-th relation
+// is received from the i-th merge receiver.
+type Argument struct {
+	Rs  []Relation
+	ctr *Container
+}
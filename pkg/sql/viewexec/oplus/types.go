@@ -17,6 +17,7 @@ package oplus
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 )
 
 const (
@@ -77,6 +78,11 @@ type Container struct {
 		keys [][]byte
 	}
 	bat *batch.Batch
+
+	// parts are the partitions spilled to disk when the memory is under pressure,
+	// and part is the next partition to evaluate
+	part  int
+	parts []*spill.File
 }
 
 type Argument struct {
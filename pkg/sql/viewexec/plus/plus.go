@@ -20,8 +20,10 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -54,7 +56,16 @@ func (ctr *Container) processBoundVars(proc *process.Process) (bool, error) {
 		switch ctr.state {
 		case Fill:
 			for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-				bat := <-proc.Reg.MergeReceivers[i].Ch
+				bat, err := process.Receive(proc, proc.Reg.MergeReceivers[i])
+				if err != nil {
+					if ctr.bat != nil {
+						batch.Clean(ctr.bat, proc.Mp)
+						ctr.bat = nil
+					}
+					proc.Reg.InputBatch = nil
+					ctr.state = Eval
+					return true, err
+				}
 				if bat == nil {
 					continue
 				}
@@ -89,7 +100,7 @@ func (ctr *Container) processFreeVars(proc *process.Process) (bool, error) {
 		switch ctr.state {
 		case Fill:
 			if err := ctr.fill(proc); err != nil {
-				batch.Clean(ctr.bat, proc.Mp)
+				ctr.clean(proc)
 				proc.Reg.InputBatch = nil
 				ctr.state = Eval
 				return true, err
@@ -97,10 +108,27 @@ func (ctr *Container) processFreeVars(proc *process.Process) (bool, error) {
 			ctr.state = Eval
 		case Eval:
 			if ctr.bat != nil {
+				// the batch grouped from a partition has no hash table yet
+				switch ctr.typ {
+				case H8:
+					ctr.bat.Ht = ctr.intHashMap
+				default:
+					ctr.bat.Ht = ctr.strHashMap
+				}
 				proc.Reg.InputBatch = ctr.bat
 				ctr.bat = nil
 				return false, nil
 			}
+			// the partitions spilled are evaluated one by one
+			if ctr.part < len(ctr.parts) {
+				if err := ctr.fillPartition(proc); err != nil {
+					ctr.clean(proc)
+					proc.Reg.InputBatch = nil
+					return true, err
+				}
+				continue
+			}
+			ctr.clean(proc)
 			return true, nil
 		}
 	}
@@ -108,56 +136,114 @@ func (ctr *Container) processFreeVars(proc *process.Process) (bool, error) {
 
 func (ctr *Container) fill(proc *process.Process) error {
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-		bat := <-proc.Reg.MergeReceivers[i].Ch
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[i])
+		if err != nil {
+			return err
+		}
 		if bat == nil {
+			proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
+			i--
 			continue
 		}
 		if len(bat.Zs) == 0 {
 			i--
 			continue
 		}
+		i--
+		if ctr.parts != nil {
+			if ctr.parts, err = spill.WritePartitions(ctr.parts, bat, proc); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := ctr.fillBatch(bat, proc); err != nil {
 			return err
 		}
+		// the groups are spilled to the partitions if the memory is under pressure,
+		// and the batches received later go to the partitions directly
+		if len(ctr.bat.Zs) >= spill.BatchRows && proc.Mp.Gm.Pressure() {
+			if err := ctr.spill(proc); err != nil {
+				return err
+			}
+		}
+	}
+	if ctr.parts != nil && ctr.bat != nil {
+		return ctr.spill(proc)
 	}
 	return nil
 }
 
+// spill writes the groups to the partitions and resets the hash table
+func (ctr *Container) spill(proc *process.Process) error {
+	bat := ctr.bat
+	ctr.bat = nil
+	parts, err := spill.WritePartitions(ctr.parts, bat, proc)
+	ctr.parts = parts
+	ctr.reset()
+	return err
+}
+
+// fillPartition reads the next partition and groups its batches
+func (ctr *Container) fillPartition(proc *process.Process) error {
+	f := ctr.parts[ctr.part]
+	ctr.part++
+	ctr.reset()
+	for {
+		bat, err := f.Read(proc)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			return nil
+		}
+		if err := ctr.fillBatch(bat, proc); err != nil {
+			return err
+		}
+	}
+}
+
+// reset clears the hash table but keeps the state and the partitions
+func (ctr *Container) reset() {
+	*ctr = Container{
+		state: ctr.state,
+		part:  ctr.part,
+		parts: ctr.parts,
+	}
+}
+
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		batch.Clean(ctr.bat, proc.Mp)
+		ctr.bat = nil
+	}
+	spill.CloseFiles(ctr.parts)
+	ctr.parts = nil
+}
+
 func (ctr *Container) fillBatch(bat *batch.Batch, proc *process.Process) error {
 	if len(ctr.vars) == 0 {
 		ctr.vars = append(ctr.vars, bat.Attrs...)
 		size := 0
 		for _, vec := range bat.Vecs {
+			// the size must be the same as the one of transform which built the hash table
+			nullable := 0
+			if nulls.Any(vec.Nsp) {
+				nullable = 1
+			}
 			switch vec.Typ.Oid {
-			case types.T_int8:
-				size += 1
-			case types.T_int16:
-				size += 2
-			case types.T_int32:
-				size += 4
-			case types.T_int64:
-				size += 8
-			case types.T_uint8:
-				size += 1
-			case types.T_uint16:
-				size += 2
-			case types.T_uint32:
-				size += 4
-			case types.T_uint64:
-				size += 8
-			case types.T_float32:
-				size += 4
-			case types.T_float64:
-				size += 8
-			case types.T_char:
-				if width := vec.Typ.Width; width > 0 {
-					size += int(width)
-				} else {
-					size = 128
-				}
-			case types.T_varchar:
+			case types.T_int8, types.T_uint8:
+				size += 1 + nullable
+			case types.T_int16, types.T_uint16:
+				size += 2 + nullable
+			case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
+				size += 4 + nullable
+			case types.T_int64, types.T_uint64, types.T_float64, types.T_decimal64, types.T_datetime, types.T_time, types.T_timestamp:
+				size += 8 + nullable
+			case types.T_decimal128:
+				size += 16 + nullable
+			case types.T_char, types.T_varchar:
 				if width := vec.Typ.Width; width > 0 {
-					size += int(width)
+					size += int(width) + nullable
 				} else {
 					size = 128
 				}
@@ -201,6 +287,16 @@ func (ctr *Container) fillBatch(bat *batch.Batch, proc *process.Process) error {
 	} else {
 		batch.Reorder(bat, ctr.vars)
 	}
+	// the batches without hash table, such as the ones read from the spill files,
+	// are grouped into an empty batch
+	if ctr.bat == nil && bat.Ht == nil {
+		rbat, err := spill.Slice(bat, 0, 0, proc)
+		if err != nil {
+			batch.Clean(bat, proc.Mp)
+			return err
+		}
+		ctr.bat = rbat
+	}
 	switch ctr.typ {
 	case H8:
 		return ctr.fillH8(bat, proc)
@@ -300,12 +396,30 @@ func (ctr *Container) fillH8(bat *batch.Batch, proc *process.Process) error {
 					*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				for k := int64(0); k < n; k++ {
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -438,12 +552,36 @@ func (ctr *Container) fillH24(bat *batch.Batch, proc *process.Process) error {
 					*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				for k := int64(0); k < n; k++ {
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
@@ -573,12 +711,36 @@ func (ctr *Container) fillH32(bat *batch.Batch, proc *process.Process) error {
 					*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				for k := int64(0); k < n; k++ {
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
@@ -708,12 +870,36 @@ func (ctr *Container) fillH40(bat *batch.Batch, proc *process.Process) error {
 					*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				for k := int64(0); k < n; k++ {
+					*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+				}
+				add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				for k := int64(0); k < n; k++ {
 					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 				}
 				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				for k := int64(0); k < n; k++ {
+					*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+				}
+				add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
@@ -840,12 +1026,36 @@ func (ctr *Container) fillHStr(bat *batch.Batch, proc *process.Process) error {
 				for k := int64(0); k < n; k++ {
 					ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				for k := int64(0); k < n; k++ {
+					ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				for k := int64(0); k < n; k++ {
+					ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
 				for k := int64(0); k < n; k++ {
 					ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				for k := int64(0); k < n; k++ {
+					ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				for k := int64(0); k < n; k++ {
+					ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				for k := int64(0); k < n; k++ {
@@ -16,12 +16,18 @@ package times
 
 import (
 	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
 	"unsafe"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
@@ -68,8 +74,9 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			return true, err
 		}
 		n.ctr.isB = true
-		for _, v := range n.ctr.views {
-			if !v.isB {
+		for i, v := range n.ctr.views {
+			// the view which spilled is filled one partition at a time
+			if !v.isB && (n.Parts == nil || i != n.PartView) {
 				n.ctr.isB = false
 			}
 		}
@@ -78,46 +85,267 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		}
 		n.ctr.state = Probe
 	}
+	if n.ctr.state == Join {
+		return n.ctr.join(n, proc)
+	}
 	if _, err := transform.Call(proc, n.Arg); err != nil {
+		n.ctr.clean(n, proc)
 		n.ctr.state = End
 		proc.Reg.InputBatch = nil
 		return false, err
 	}
 	bat := proc.Reg.InputBatch
 	if bat == nil {
+		if n.Parts != nil {
+			n.ctr.state = Join
+			n.ctr.part = -1
+			if n.ctr.parts == nil {
+				n.ctr.part = spill.Partitions
+			}
+			return n.ctr.join(n, proc)
+		}
 		n.ctr.state = End
 		if n.ctr.pctr == nil {
 			return true, nil
 		}
-		switch n.ctr.pctr.typ {
-		case H8:
-			n.ctr.bat.Ht = n.ctr.pctr.intHashMap
-		case H24:
-			n.ctr.bat.Ht = n.ctr.pctr.strHashMap
-		case H32:
-			n.ctr.bat.Ht = n.ctr.pctr.strHashMap
-		case H40:
-			n.ctr.bat.Ht = n.ctr.pctr.strHashMap
-		default:
-			n.ctr.bat.Ht = n.ctr.pctr.strHashMap
-		}
-		proc.Reg.InputBatch = n.ctr.bat
-		n.ctr.bat = nil
+		proc.Reg.InputBatch = n.ctr.flush()
 		return true, nil
 	}
 	if len(bat.Zs) == 0 {
 		proc.Reg.InputBatch = &batch.Batch{}
 		return false, nil
 	}
+	// the batches are probed once all of them are partitioned if a view spilled
+	if n.Parts != nil {
+		var err error
+
+		// the rings of the probed batches are empty, their values are filled
+		// from the vectors by probe, so they are kept aside instead of spilled
+		if n.ctr.rs == nil {
+			n.ctr.rs, n.ctr.as, n.ctr.refs = bat.Rs, bat.As, bat.Refs
+		} else {
+			for _, r := range bat.Rs {
+				r.Free(proc.Mp)
+			}
+		}
+		bat.Rs, bat.As, bat.Refs = nil, nil, nil
+		part := KeyPartition(batch.GetVector(bat, n.Rvars[n.PartView]))
+		if n.ctr.parts, err = spill.WritePartitionsBy(n.ctr.parts, bat, part, proc); err != nil {
+			n.ctr.clean(n, proc)
+			proc.Reg.InputBatch = nil
+			n.ctr.state = End
+			return true, err
+		}
+		proc.Reg.InputBatch = &batch.Batch{}
+		return false, nil
+	}
 	if err := n.ctr.probe(n.Arg.Ctr.Is, n.FreeVars, bat, n, proc); err != nil {
 		proc.Reg.InputBatch = nil
 		n.ctr.state = End
 		return true, err
 	}
+	// the groups are pushed to plus early if the memory is under pressure,
+	// plus merges them with the ones pushed later and spills them if necessary
+	if len(n.FreeVars) > 0 && len(n.ctr.bat.Zs) >= spill.BatchRows && proc.Mp.Gm.Pressure() {
+		proc.Reg.InputBatch = n.ctr.flush()
+		return false, nil
+	}
 	proc.Reg.InputBatch = &batch.Batch{}
 	return false, nil
 }
 
+// flush returns the groups with their hash table and resets the probe container,
+// the probe container is constructed again by the next probe
+func (ctr *Container) flush() *batch.Batch {
+	bat := ctr.bat
+	switch ctr.pctr.typ {
+	case H8:
+		bat.Ht = ctr.pctr.intHashMap
+	case H24:
+		bat.Ht = ctr.pctr.strHashMap
+	case H32:
+		bat.Ht = ctr.pctr.strHashMap
+	case H40:
+		bat.Ht = ctr.pctr.strHashMap
+	default:
+		bat.Ht = ctr.pctr.strHashMap
+	}
+	ctr.bat = nil
+	ctr.pctr = nil
+	for _, v := range ctr.views {
+		v.ris = v.ris[:0]
+	}
+	return bat
+}
+
+// join probes the next batch of the partition being joined, the partitions of the
+// probed batches are joined one by one with the partitions of the view which spilled.
+// It returns true once all of them are joined.
+func (ctr *Container) join(arg *Argument, proc *process.Process) (bool, error) {
+	for ctr.part < spill.Partitions {
+		var err error
+		var bat *batch.Batch
+
+		if ctr.part >= 0 {
+			if bat, err = ctr.parts[ctr.part].Read(proc); err != nil {
+				ctr.clean(arg, proc)
+				proc.Reg.InputBatch = nil
+				ctr.state = End
+				return true, err
+			}
+		}
+		if bat == nil {
+			if err = ctr.nextPartition(arg, proc); err != nil {
+				ctr.clean(arg, proc)
+				proc.Reg.InputBatch = nil
+				ctr.state = End
+				return true, err
+			}
+			continue
+		}
+		for _, r := range ctr.rs {
+			bat.Rs = append(bat.Rs, r.Dup())
+		}
+		bat.As, bat.Refs = ctr.as, ctr.refs
+		if err = ctr.probe(arg.Arg.Ctr.Is, arg.FreeVars, bat, arg, proc); err != nil {
+			ctr.clean(arg, proc)
+			proc.Reg.InputBatch = nil
+			ctr.state = End
+			return true, err
+		}
+		if len(arg.FreeVars) > 0 && len(ctr.bat.Zs) >= spill.BatchRows && proc.Mp.Gm.Pressure() {
+			proc.Reg.InputBatch = ctr.flush()
+			return false, nil
+		}
+		proc.Reg.InputBatch = &batch.Batch{}
+		return false, nil
+	}
+	ctr.clean(arg, proc)
+	ctr.state = End
+	if ctr.pctr == nil {
+		proc.Reg.InputBatch = nil
+		return true, nil
+	}
+	proc.Reg.InputBatch = ctr.flush()
+	return true, nil
+}
+
+// nextPartition fills the view which spilled with its next partition which is not empty,
+// the rows of the other partitions join no row of the view.
+func (ctr *Container) nextPartition(arg *Argument, proc *process.Process) error {
+	v := ctr.views[arg.PartView]
+	if v.bat != nil {
+		batch.Clean(v.bat, proc.Mp)
+		v.bat = nil
+	}
+	for ctr.part++; ctr.part < spill.Partitions; ctr.part++ {
+		bat, err := spill.ReadAll(arg.Parts[ctr.part], proc)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			continue
+		}
+		v.sels = v.sels[:0]
+		return ctr.fillBatch(v, bat, proc)
+	}
+	return nil
+}
+
+// clean removes the partitions of the probed batches and frees the partition of the view
+func (ctr *Container) clean(arg *Argument, proc *process.Process) {
+	spill.CloseFiles(ctr.parts)
+	ctr.parts = nil
+	for _, r := range ctr.rs {
+		r.Free(proc.Mp)
+	}
+	ctr.rs = nil
+	if arg.Parts != nil {
+		if v := ctr.views[arg.PartView]; v.bat != nil {
+			batch.Clean(v.bat, proc.Mp)
+			v.bat = nil
+		}
+	}
+}
+
+// KeyPartition returns the partition of the rows by their values of the join key vec.
+// The values are hashed as the hash tables of the views key them, so that a probed
+// row goes to the partition of the row of the view it joins.
+func KeyPartition(vec *vector.Vector) func(int64) (int, error) {
+	var buf [8]byte
+	var key func(int64) []byte
+
+	uint64Key := func(v uint64) []byte {
+		binary.LittleEndian.PutUint64(buf[:], v)
+		return buf[:]
+	}
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		vs := vec.Col.([]int8)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_int16:
+		vs := vec.Col.([]int16)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_int32:
+		vs := vec.Col.([]int32)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_int64:
+		vs := vec.Col.([]int64)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_uint8:
+		vs := vec.Col.([]uint8)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_uint16:
+		vs := vec.Col.([]uint16)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_uint32:
+		vs := vec.Col.([]uint32)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_uint64:
+		vs := vec.Col.([]uint64)
+		key = func(i int64) []byte { return uint64Key(vs[i]) }
+	case types.T_float32:
+		vs := vec.Col.([]float32)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_float64:
+		vs := vec.Col.([]float64)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_date:
+		vs := vec.Col.([]types.Date)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_datetime:
+		vs := vec.Col.([]types.Datetime)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_time:
+		vs := vec.Col.([]types.Time)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_timestamp:
+		vs := vec.Col.([]types.Timestamp)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_decimal64:
+		vs := vec.Col.([]types.Decimal64)
+		key = func(i int64) []byte { return uint64Key(uint64(vs[i])) }
+	case types.T_decimal128:
+		vs := vec.Col.([]types.Decimal128)
+		key = func(i int64) []byte {
+			return unsafe.Slice((*byte)(unsafe.Pointer(&vs[i])), 16)
+		}
+	case types.T_char, types.T_varchar:
+		vs := vec.Col.(*types.Bytes)
+		key = func(i int64) []byte { return vs.Get(i) }
+	default:
+		return func(_ int64) (int, error) {
+			return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("join key of type '%s' cannot spill", vec.Typ))
+		}
+	}
+	h := fnv.New64a()
+	return func(i int64) (int, error) {
+		h.Reset()
+		h.Write(key(i))
+		return int(h.Sum64() % spill.Partitions), nil
+	}
+}
+
 func (ctr *Container) fill(bats []*batch.Batch, proc *process.Process) error {
 	for i := 0; i < len(bats); i++ {
 		bat := bats[i]
@@ -234,6 +462,20 @@ func (ctr *Container) probeH8(is []int, arg *Argument, bat *batch.Batch, proc *p
 				}
 				ctr.hashes[0] = 0
 				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_time:
+				vs := vecs[vi].Col.([]types.Time)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_timestamp:
+				vs := vecs[vi].Col.([]types.Timestamp)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
 			case types.T_uint8:
 				vs := vecs[vi].Col.([]uint8)
 				for k := int64(0); k < n; k++ {
@@ -276,6 +518,20 @@ func (ctr *Container) probeH8(is []int, arg *Argument, bat *batch.Batch, proc *p
 				}
 				ctr.hashes[0] = 0
 				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_decimal64:
+				vs := vecs[vi].Col.([]types.Decimal64)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_decimal128:
+				vs := vecs[vi].Col.([]types.Decimal128)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				for k := int64(0); k < n; k++ {
+					strKeys[k] = data[(i+k)*16 : (i+k+1)*16]
+				}
+				v.strHashMap.FindStringBatch(ctr.strHashStates, strKeys[:n], values[vi])
 			case types.T_char, types.T_varchar:
 				vs := vecs[vi].Col.(*types.Bytes)
 				var padded int
@@ -410,6 +666,16 @@ func (ctr *Container) probeH8(is []int, arg *Argument, bat *batch.Batch, proc *p
 							}
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal64:
+						vs := gvecs[j].Col.([]types.Decimal64)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[0]
+							} else {
+								*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[vp-1]
+							}
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_datetime:
 						vs := gvecs[j].Col.([]types.Datetime)
 						for k := int64(0); k < n; k++ {
@@ -420,6 +686,26 @@ func (ctr *Container) probeH8(is []int, arg *Argument, bat *batch.Batch, proc *p
 							}
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_time:
+						vs := gvecs[j].Col.([]types.Time)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[0])
+							} else {
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[vp-1])
+							}
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_timestamp:
+						vs := gvecs[j].Col.([]types.Timestamp)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[0])
+							} else {
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[vp-1])
+							}
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_char, types.T_varchar:
 						vs := gvecs[j].Col.(*types.Bytes)
 						for k := int64(0); k < n; k++ {
@@ -502,12 +788,30 @@ func (ctr *Container) probeH8(is []int, arg *Argument, bat *batch.Batch, proc *p
 							*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal64:
+						vs := gvecs[j].Col.([]types.Decimal64)
+						for k := int64(0); k < n; k++ {
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_datetime:
 						vs := gvecs[j].Col.([]types.Datetime)
 						for k := int64(0); k < n; k++ {
 							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_time:
+						vs := gvecs[j].Col.([]types.Time)
+						for k := int64(0); k < n; k++ {
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_timestamp:
+						vs := gvecs[j].Col.([]types.Timestamp)
+						for k := int64(0); k < n; k++ {
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_char, types.T_varchar:
 						vs := gvecs[j].Col.(*types.Bytes)
 						for k := int64(0); k < n; k++ {
@@ -695,6 +999,20 @@ func (ctr *Container) probeH24(is []int, arg *Argument, bat *batch.Batch, proc *
 				}
 				ctr.hashes[0] = 0
 				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_decimal64:
+				vs := vecs[vi].Col.([]types.Decimal64)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_decimal128:
+				vs := vecs[vi].Col.([]types.Decimal128)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				for k := int64(0); k < n; k++ {
+					strKeys[k] = data[(i+k)*16 : (i+k+1)*16]
+				}
+				v.strHashMap.FindStringBatch(ctr.strHashStates, strKeys[:n], values[vi])
 			case types.T_datetime:
 				vs := vecs[vi].Col.([]types.Datetime)
 				for k := int64(0); k < n; k++ {
@@ -702,6 +1020,20 @@ func (ctr *Container) probeH24(is []int, arg *Argument, bat *batch.Batch, proc *
 				}
 				ctr.hashes[0] = 0
 				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_time:
+				vs := vecs[vi].Col.([]types.Time)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_timestamp:
+				vs := vecs[vi].Col.([]types.Timestamp)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
 			case types.T_char, types.T_varchar:
 				vs := vecs[vi].Col.(*types.Bytes)
 				var padded int
@@ -836,6 +1168,26 @@ func (ctr *Container) probeH24(is []int, arg *Argument, bat *batch.Batch, proc *
 							}
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal64:
+						vs := gvecs[j].Col.([]types.Decimal64)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[0]
+							} else {
+								*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[vp-1]
+							}
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal128:
+						vs := gvecs[j].Col.([]types.Decimal128)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[0]
+							} else {
+								*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[vp-1]
+							}
+						}
+						add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_datetime:
 						vs := gvecs[j].Col.([]types.Datetime)
 						for k := int64(0); k < n; k++ {
@@ -846,6 +1198,26 @@ func (ctr *Container) probeH24(is []int, arg *Argument, bat *batch.Batch, proc *
 							}
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_time:
+						vs := gvecs[j].Col.([]types.Time)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[0])
+							} else {
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[vp-1])
+							}
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_timestamp:
+						vs := gvecs[j].Col.([]types.Timestamp)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[0])
+							} else {
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[vp-1])
+							}
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_char, types.T_varchar:
 						vs := gvecs[j].Col.(*types.Bytes)
 						for k := int64(0); k < n; k++ {
@@ -928,12 +1300,36 @@ func (ctr *Container) probeH24(is []int, arg *Argument, bat *batch.Batch, proc *
 							*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal64:
+						vs := gvecs[j].Col.([]types.Decimal64)
+						for k := int64(0); k < n; k++ {
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal128:
+						vs := gvecs[j].Col.([]types.Decimal128)
+						for k := int64(0); k < n; k++ {
+							*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+						}
+						add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_datetime:
 						vs := gvecs[j].Col.([]types.Datetime)
 						for k := int64(0); k < n; k++ {
 							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_time:
+						vs := gvecs[j].Col.([]types.Time)
+						for k := int64(0); k < n; k++ {
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_timestamp:
+						vs := gvecs[j].Col.([]types.Timestamp)
+						for k := int64(0); k < n; k++ {
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_char, types.T_varchar:
 						vs := gvecs[j].Col.(*types.Bytes)
 						for k := int64(0); k < n; k++ {
@@ -1120,6 +1516,20 @@ func (ctr *Container) probeH32(is []int, arg *Argument, bat *batch.Batch, proc *
 				}
 				ctr.hashes[0] = 0
 				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_decimal64:
+				vs := vecs[vi].Col.([]types.Decimal64)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_decimal128:
+				vs := vecs[vi].Col.([]types.Decimal128)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				for k := int64(0); k < n; k++ {
+					strKeys[k] = data[(i+k)*16 : (i+k+1)*16]
+				}
+				v.strHashMap.FindStringBatch(ctr.strHashStates, strKeys[:n], values[vi])
 			case types.T_datetime:
 				vs := vecs[vi].Col.([]types.Datetime)
 				for k := int64(0); k < n; k++ {
@@ -1127,6 +1537,20 @@ func (ctr *Container) probeH32(is []int, arg *Argument, bat *batch.Batch, proc *
 				}
 				ctr.hashes[0] = 0
 				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_time:
+				vs := vecs[vi].Col.([]types.Time)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_timestamp:
+				vs := vecs[vi].Col.([]types.Timestamp)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
 			case types.T_char, types.T_varchar:
 				vs := vecs[vi].Col.(*types.Bytes)
 				var padded int
@@ -1261,6 +1685,26 @@ func (ctr *Container) probeH32(is []int, arg *Argument, bat *batch.Batch, proc *
 							}
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal64:
+						vs := gvecs[j].Col.([]types.Decimal64)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[0]
+							} else {
+								*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[vp-1]
+							}
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal128:
+						vs := gvecs[j].Col.([]types.Decimal128)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[0]
+							} else {
+								*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[vp-1]
+							}
+						}
+						add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_datetime:
 						vs := gvecs[j].Col.([]types.Datetime)
 						for k := int64(0); k < n; k++ {
@@ -1271,6 +1715,26 @@ func (ctr *Container) probeH32(is []int, arg *Argument, bat *batch.Batch, proc *
 							}
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_time:
+						vs := gvecs[j].Col.([]types.Time)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[0])
+							} else {
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[vp-1])
+							}
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_timestamp:
+						vs := gvecs[j].Col.([]types.Timestamp)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[0])
+							} else {
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[vp-1])
+							}
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_char, types.T_varchar:
 						vs := gvecs[j].Col.(*types.Bytes)
 						for k := int64(0); k < n; k++ {
@@ -1353,12 +1817,36 @@ func (ctr *Container) probeH32(is []int, arg *Argument, bat *batch.Batch, proc *
 							*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal64:
+						vs := gvecs[j].Col.([]types.Decimal64)
+						for k := int64(0); k < n; k++ {
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal128:
+						vs := gvecs[j].Col.([]types.Decimal128)
+						for k := int64(0); k < n; k++ {
+							*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+						}
+						add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_datetime:
 						vs := gvecs[j].Col.([]types.Datetime)
 						for k := int64(0); k < n; k++ {
 							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_time:
+						vs := gvecs[j].Col.([]types.Time)
+						for k := int64(0); k < n; k++ {
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_timestamp:
+						vs := gvecs[j].Col.([]types.Timestamp)
+						for k := int64(0); k < n; k++ {
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_char, types.T_varchar:
 						vs := gvecs[j].Col.(*types.Bytes)
 						for k := int64(0); k < n; k++ {
@@ -1545,6 +2033,20 @@ func (ctr *Container) probeH40(is []int, arg *Argument, bat *batch.Batch, proc *
 				}
 				ctr.hashes[0] = 0
 				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_decimal64:
+				vs := vecs[vi].Col.([]types.Decimal64)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_decimal128:
+				vs := vecs[vi].Col.([]types.Decimal128)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				for k := int64(0); k < n; k++ {
+					strKeys[k] = data[(i+k)*16 : (i+k+1)*16]
+				}
+				v.strHashMap.FindStringBatch(ctr.strHashStates, strKeys[:n], values[vi])
 			case types.T_datetime:
 				vs := vecs[vi].Col.([]types.Datetime)
 				for k := int64(0); k < n; k++ {
@@ -1552,6 +2054,20 @@ func (ctr *Container) probeH40(is []int, arg *Argument, bat *batch.Batch, proc *
 				}
 				ctr.hashes[0] = 0
 				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_time:
+				vs := vecs[vi].Col.([]types.Time)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_timestamp:
+				vs := vecs[vi].Col.([]types.Timestamp)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
 			case types.T_char, types.T_varchar:
 				vs := vecs[vi].Col.(*types.Bytes)
 				var padded int
@@ -1660,34 +2176,74 @@ func (ctr *Container) probeH40(is []int, arg *Argument, bat *batch.Batch, proc *
 						vs := gvecs[j].Col.([]int64)
 						for k := int64(0); k < n; k++ {
 							if vp := vps[k]; vp == 0 {
-								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[0]
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[0]
+							} else {
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[vp-1]
+							}
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_uint64:
+						vs := gvecs[j].Col.([]uint64)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*uint64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[0]
+							} else {
+								*(*uint64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[vp-1]
+							}
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_float64:
+						vs := gvecs[j].Col.([]float64)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[0]
+							} else {
+								*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[vp-1]
+							}
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal64:
+						vs := gvecs[j].Col.([]types.Decimal64)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[0]
+							} else {
+								*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[vp-1]
+							}
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal128:
+						vs := gvecs[j].Col.([]types.Decimal128)
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[0]
 							} else {
-								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[vp-1]
+								*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[vp-1]
 							}
 						}
-						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-					case types.T_uint64:
-						vs := gvecs[j].Col.([]uint64)
+						add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_datetime:
+						vs := gvecs[j].Col.([]types.Datetime)
 						for k := int64(0); k < n; k++ {
 							if vp := vps[k]; vp == 0 {
-								*(*uint64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[0]
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[0])
 							} else {
-								*(*uint64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[vp-1]
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[vp-1])
 							}
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-					case types.T_float64:
-						vs := gvecs[j].Col.([]float64)
+					case types.T_time:
+						vs := gvecs[j].Col.([]types.Time)
 						for k := int64(0); k < n; k++ {
 							if vp := vps[k]; vp == 0 {
-								*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[0]
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[0])
 							} else {
-								*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[vp-1]
+								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[vp-1])
 							}
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
-					case types.T_datetime:
-						vs := gvecs[j].Col.([]types.Datetime)
+					case types.T_timestamp:
+						vs := gvecs[j].Col.([]types.Timestamp)
 						for k := int64(0); k < n; k++ {
 							if vp := vps[k]; vp == 0 {
 								*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[0])
@@ -1778,12 +2334,36 @@ func (ctr *Container) probeH40(is []int, arg *Argument, bat *batch.Batch, proc *
 							*(*float64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal64:
+						vs := gvecs[j].Col.([]types.Decimal64)
+						for k := int64(0); k < n; k++ {
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_decimal128:
+						vs := gvecs[j].Col.([]types.Decimal128)
+						for k := int64(0); k < n; k++ {
+							*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+						}
+						add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_datetime:
 						vs := gvecs[j].Col.([]types.Datetime)
 						for k := int64(0); k < n; k++ {
 							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
 						}
 						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_time:
+						vs := gvecs[j].Col.([]types.Time)
+						for k := int64(0); k < n; k++ {
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+					case types.T_timestamp:
+						vs := gvecs[j].Col.([]types.Timestamp)
+						for k := int64(0); k < n; k++ {
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+						}
+						add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
 					case types.T_char, types.T_varchar:
 						vs := gvecs[j].Col.(*types.Bytes)
 						for k := int64(0); k < n; k++ {
@@ -1970,6 +2550,20 @@ func (ctr *Container) probeHstr(is []int, arg *Argument, bat *batch.Batch, proc
 				}
 				ctr.hashes[0] = 0
 				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_decimal64:
+				vs := vecs[vi].Col.([]types.Decimal64)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_decimal128:
+				vs := vecs[vi].Col.([]types.Decimal128)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				for k := int64(0); k < n; k++ {
+					strKeys[k] = data[(i+k)*16 : (i+k+1)*16]
+				}
+				v.strHashMap.FindStringBatch(ctr.strHashStates, strKeys[:n], values[vi])
 			case types.T_datetime:
 				vs := vecs[vi].Col.([]types.Datetime)
 				for k := int64(0); k < n; k++ {
@@ -1977,6 +2571,20 @@ func (ctr *Container) probeHstr(is []int, arg *Argument, bat *batch.Batch, proc
 				}
 				ctr.hashes[0] = 0
 				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_time:
+				vs := vecs[vi].Col.([]types.Time)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
+			case types.T_timestamp:
+				vs := vecs[vi].Col.([]types.Timestamp)
+				for k := int64(0); k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[i+k])
+				}
+				ctr.hashes[0] = 0
+				v.intHashMap.FindBatch(int(n), ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), values[vi])
 			case types.T_char, types.T_varchar:
 				vs := vecs[vi].Col.(*types.Bytes)
 				var padded int
@@ -2099,6 +2707,26 @@ func (ctr *Container) probeHstr(is []int, arg *Argument, bat *batch.Batch, proc
 								ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[(vp-1)*8:vp*8]...)
 							}
 						}
+					case types.T_time:
+						vs := gvecs[j].Col.([]types.Time)
+						data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[0:8]...)
+							} else {
+								ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[(vp-1)*8:vp*8]...)
+							}
+						}
+					case types.T_timestamp:
+						vs := gvecs[j].Col.([]types.Timestamp)
+						data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[0:8]...)
+							} else {
+								ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[(vp-1)*8:vp*8]...)
+							}
+						}
 					case types.T_uint64:
 						vs := gvecs[j].Col.([]uint64)
 						data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
@@ -2119,6 +2747,26 @@ func (ctr *Container) probeHstr(is []int, arg *Argument, bat *batch.Batch, proc
 								ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[(vp-1)*8:vp*8]...)
 							}
 						}
+					case types.T_decimal64:
+						vs := gvecs[j].Col.([]types.Decimal64)
+						data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[0:8]...)
+							} else {
+								ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[(vp-1)*8:vp*8]...)
+							}
+						}
+					case types.T_decimal128:
+						vs := gvecs[j].Col.([]types.Decimal128)
+						data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+						for k := int64(0); k < n; k++ {
+							if vp := vps[k]; vp == 0 {
+								ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[0:16]...)
+							} else {
+								ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[(vp-1)*16:vp*16]...)
+							}
+						}
 					case types.T_char, types.T_varchar:
 						vs := gvecs[j].Col.(*types.Bytes)
 						for k := int64(0); k < n; k++ {
@@ -2197,12 +2845,36 @@ func (ctr *Container) probeHstr(is []int, arg *Argument, bat *batch.Batch, proc
 						for k := int64(0); k < n; k++ {
 							ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 						}
+					case types.T_decimal64:
+						vs := vecs[j].Col.([]types.Decimal64)
+						data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+						for k := int64(0); k < n; k++ {
+							ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+						}
+					case types.T_decimal128:
+						vs := vecs[j].Col.([]types.Decimal128)
+						data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+						for k := int64(0); k < n; k++ {
+							ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+						}
 					case types.T_datetime:
 						vs := vecs[j].Col.([]types.Datetime)
 						data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
 						for k := int64(0); k < n; k++ {
 							ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
 						}
+					case types.T_time:
+						vs := vecs[j].Col.([]types.Time)
+						data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+						for k := int64(0); k < n; k++ {
+							ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+						}
+					case types.T_timestamp:
+						vs := vecs[j].Col.([]types.Timestamp)
+						data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+						for k := int64(0); k < n; k++ {
+							ctr.pctr.hstr.keys[k] = append(ctr.pctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+						}
 					case types.T_char, types.T_varchar:
 						vs := vecs[j].Col.(*types.Bytes)
 						for k := int64(0); k < n; k++ {
@@ -2506,6 +3178,94 @@ func (ctr *Container) fillBatch(v *view, bat *batch.Batch, proc *process.Process
 		if flg { // reinsert
 			v.isB = true
 		}
+	case types.T_time:
+		if v.bat.Ht != nil {
+			v.isB = true
+			v.isOne = true
+			for _, z := range v.bat.Zs {
+				if z > 1 {
+					v.isOne = false
+				}
+			}
+			v.intHashMap = v.bat.Ht.(*hashtable.Int64HashMap)
+			return nil
+		}
+		flg := true
+		v.intHashMap = &hashtable.Int64HashMap{}
+		v.intHashMap.Init()
+		vs := vec.Col.([]types.Time)
+		count := int64(len(bat.Zs))
+		for i := int64(0); i < count; i += UnitLimit {
+			n := int(count - i)
+			if n > UnitLimit {
+				n = UnitLimit
+			}
+			{
+				for k := 0; k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[int(i)+k])
+				}
+			}
+			ctr.hashes[0] = 0
+			v.intHashMap.InsertBatch(n, ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), ctr.values)
+			for k, vv := range ctr.values[:n] {
+				if vv > v.rows {
+					v.rows++
+					v.sels = append(v.sels, make([]int64, 0, 8))
+				}
+				ai := int64(vv) - 1
+				v.sels[ai] = append(v.sels[ai], i+int64(k))
+				if len(v.sels[ai]) > 1 {
+					flg = false
+				}
+			}
+		}
+		if flg { // reinsert
+			v.isB = true
+		}
+	case types.T_timestamp:
+		if v.bat.Ht != nil {
+			v.isB = true
+			v.isOne = true
+			for _, z := range v.bat.Zs {
+				if z > 1 {
+					v.isOne = false
+				}
+			}
+			v.intHashMap = v.bat.Ht.(*hashtable.Int64HashMap)
+			return nil
+		}
+		flg := true
+		v.intHashMap = &hashtable.Int64HashMap{}
+		v.intHashMap.Init()
+		vs := vec.Col.([]types.Timestamp)
+		count := int64(len(bat.Zs))
+		for i := int64(0); i < count; i += UnitLimit {
+			n := int(count - i)
+			if n > UnitLimit {
+				n = UnitLimit
+			}
+			{
+				for k := 0; k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[int(i)+k])
+				}
+			}
+			ctr.hashes[0] = 0
+			v.intHashMap.InsertBatch(n, ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), ctr.values)
+			for k, vv := range ctr.values[:n] {
+				if vv > v.rows {
+					v.rows++
+					v.sels = append(v.sels, make([]int64, 0, 8))
+				}
+				ai := int64(vv) - 1
+				v.sels[ai] = append(v.sels[ai], i+int64(k))
+				if len(v.sels[ai]) > 1 {
+					flg = false
+				}
+			}
+		}
+		if flg { // reinsert
+			v.isB = true
+		}
 	case types.T_int64:
 		if v.bat.Ht != nil {
 			v.isB = true
@@ -2815,6 +3575,85 @@ func (ctr *Container) fillBatch(v *view, bat *batch.Batch, proc *process.Process
 		if flg { // reinsert
 			v.isB = true
 		}
+	case types.T_decimal64:
+		if v.bat.Ht != nil {
+			v.isB = true
+			v.isOne = true
+			for _, z := range v.bat.Zs {
+				if z > 1 {
+					v.isOne = false
+				}
+			}
+			v.intHashMap = v.bat.Ht.(*hashtable.Int64HashMap)
+			return nil
+		}
+		flg := true
+		v.intHashMap = &hashtable.Int64HashMap{}
+		v.intHashMap.Init()
+		vs := vec.Col.([]types.Decimal64)
+		count := int64(len(bat.Zs))
+		for i := int64(0); i < count; i += UnitLimit {
+			n := int(count - i)
+			if n > UnitLimit {
+				n = UnitLimit
+			}
+			{
+				for k := 0; k < n; k++ {
+					ctr.h8.keys[k] = uint64(vs[int(i)+k])
+				}
+			}
+			ctr.hashes[0] = 0
+			v.intHashMap.InsertBatch(n, ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), ctr.values)
+			for k, vv := range ctr.values[:n] {
+				if vv > v.rows {
+					v.rows++
+					v.sels = append(v.sels, make([]int64, 0, 8))
+				}
+				ai := int64(vv) - 1
+				v.sels[ai] = append(v.sels[ai], i+int64(k))
+				if len(v.sels[ai]) > 1 {
+					flg = false
+				}
+			}
+		}
+		if flg { // reinsert
+			v.isB = true
+		}
+	case types.T_decimal128:
+		flg := true
+		v.strHashMap = &hashtable.StringHashMap{}
+		v.strHashMap.Init()
+		vs := vec.Col.([]types.Decimal128)
+		data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+		count := int64(len(bat.Zs))
+		var strKeys [UnitLimit][]byte
+		for i := int64(0); i < count; i += UnitLimit {
+			n := int(count - i)
+			if n > UnitLimit {
+				n = UnitLimit
+			}
+			{
+				for k := 0; k < n; k++ {
+					strKeys[k] = data[(i+int64(k))*16 : (i+int64(k)+1)*16]
+				}
+			}
+			ctr.hashes[0] = 0
+			v.strHashMap.InsertStringBatch(ctr.strHashStates, strKeys[:n], ctr.values)
+			for k, vv := range ctr.values[:n] {
+				if vv > v.rows {
+					v.rows++
+					v.sels = append(v.sels, make([]int64, 0, 8))
+				}
+				ai := int64(vv) - 1
+				v.sels[ai] = append(v.sels[ai], i+int64(k))
+				if len(v.sels[ai]) > 1 {
+					flg = false
+				}
+			}
+		}
+		if flg { // reinsert
+			v.isB = true
+		}
 	case types.T_char, types.T_varchar:
 		if v.bat.Ht != nil {
 			v.isB = true
@@ -2912,6 +3751,10 @@ func (ctr *Container) constructBatch(rn string, varsMap map[string]int, freeVars
 					size += 4
 				case types.T_float64:
 					size += 8
+				case types.T_decimal64:
+					size += 8
+				case types.T_decimal128:
+					size += 16
 				case types.T_char, types.T_varchar:
 					if width := vec.Typ.Width; width > 0 {
 						size += int(width)
@@ -2948,6 +3791,10 @@ func (ctr *Container) constructBatch(rn string, varsMap map[string]int, freeVars
 							size += 4
 						case types.T_float64:
 							size += 8
+						case types.T_decimal64:
+							size += 8
+						case types.T_decimal128:
+							size += 16
 						case types.T_char, types.T_varchar:
 							if width := vec.Typ.Width; width > 0 {
 								size += int(width)
@@ -2989,6 +3836,10 @@ func (ctr *Container) constructBatch(rn string, varsMap map[string]int, freeVars
 						size += 4
 					case types.T_float64:
 						size += 8
+					case types.T_decimal64:
+						size += 8
+					case types.T_decimal128:
+						size += 16
 					case types.T_char, types.T_varchar:
 						if width := vec.Typ.Width; width > 0 {
 							size += int(width)
@@ -3026,6 +3877,10 @@ func (ctr *Container) constructBatch(rn string, varsMap map[string]int, freeVars
 						size += 4
 					case types.T_float64:
 						size += 8
+					case types.T_decimal64:
+						size += 8
+					case types.T_decimal128:
+						size += 16
 					case types.T_char, types.T_varchar:
 						if width := vec.Typ.Width; width > 0 {
 							size += int(width)
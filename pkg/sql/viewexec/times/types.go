@@ -17,7 +17,9 @@ package times
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
 )
 
@@ -25,6 +27,7 @@ const (
 	End = iota
 	Fill
 	Probe
+	Join
 )
 
 const (
@@ -106,6 +109,15 @@ type Container struct {
 	pctr     *probeContainer
 	varsMap  map[string]uint8
 	fvarsMap map[string]uint8
+
+	// part is the partition being joined if a view spilled,
+	// parts store the partitions of the probed batches and
+	// rs, as and refs store their rings
+	part  int
+	parts []*spill.File
+	rs    []ring.Ring
+	as    []string
+	refs  []uint64
 }
 
 type Argument struct {
@@ -119,4 +131,9 @@ type Argument struct {
 	VarsMap  map[string]int
 	Bats     []*batch.Batch
 	Arg      *transform.Argument
+	// Parts store the partitions of the view PartView if it is too large to be
+	// kept in memory, its batch is nil then. The probed batches are partitioned
+	// the same way and joined with the view one partition at a time.
+	Parts    []*spill.File
+	PartView int
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -54,7 +55,11 @@ func String(arg interface{}, buf *bytes.Buffer) {
 		if i > 0 {
 			buf.WriteString(", ")
 		}
-		buf.WriteString(fmt.Sprintf("%s <- %s(%s)", bvar.Alias, transformer.TransformerNames[bvar.Op], bvar.Name))
+		if bvar.Distinct {
+			buf.WriteString(fmt.Sprintf("%s <- %s(distinct %s)", bvar.Alias, transformer.TransformerNames[bvar.Op], bvar.Name))
+		} else {
+			buf.WriteString(fmt.Sprintf("%s <- %s(%s)", bvar.Alias, transformer.TransformerNames[bvar.Op], bvar.Name))
+		}
 	}
 	buf.WriteString("])")
 }
@@ -113,7 +118,7 @@ func (ctr *Container) processBoundVars(proc *process.Process, arg *Argument) (bo
 		for i, bvar := range arg.BoundVars {
 			ctr.bat.As[i] = bvar.Alias
 			ctr.bat.Refs[i] = uint64(bvar.Ref)
-			if ctr.bat.Rs[i], err = transformer.New(bvar.Op, bat.Vecs[ctr.Is[i]].Typ); err != nil {
+			if ctr.bat.Rs[i], err = newRing(bvar, bat.Vecs[ctr.Is[i]].Typ); err != nil {
 				ctr.bat.Rs = ctr.bat.Rs[:i]
 				batch.Clean(ctr.bat, proc.Mp)
 				ctr.bat = nil
@@ -145,19 +150,11 @@ func (ctr *Container) processFreeVars(proc *process.Process, arg *Argument) (boo
 	bat := proc.Reg.InputBatch
 	if bat == nil { // begin eval
 		if ctr.bat != nil {
-			switch ctr.typ {
-			case H8:
-				ctr.bat.Ht = ctr.intHashMap
-			case H24:
-				ctr.bat.Ht = ctr.strHashMap
-			case H32:
-				ctr.bat.Ht = ctr.strHashMap
-			case H40:
-				ctr.bat.Ht = ctr.strHashMap
-			default:
-				ctr.bat.Ht = ctr.strHashMap
+			if len(ctr.bat.Zs) > 0 {
+				proc.Reg.InputBatch = ctr.flush()
+			} else {
+				batch.Clean(ctr.bat, proc.Mp)
 			}
-			proc.Reg.InputBatch = ctr.bat
 			ctr.bat = nil
 		}
 		return true, nil
@@ -194,8 +191,10 @@ func (ctr *Container) processFreeVars(proc *process.Process, arg *Argument) (boo
 					size += 2 + nullable
 				case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
 					size += 4 + nullable
-				case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime:
+				case types.T_int64, types.T_uint64, types.T_float64, types.T_decimal64, types.T_datetime, types.T_time, types.T_timestamp:
 					size += 8 + nullable
+				case types.T_decimal128:
+					size += 16 + nullable
 				case types.T_char, types.T_varchar:
 					if width := bat.Vecs[i].Typ.Width; width > 0 {
 						size += int(width) + nullable
@@ -246,7 +245,7 @@ func (ctr *Container) processFreeVars(proc *process.Process, arg *Argument) (boo
 		for i, bvar := range arg.BoundVars {
 			ctr.bat.As[i] = bvar.Alias
 			ctr.bat.Refs[i] = uint64(bvar.Ref)
-			if ctr.bat.Rs[i], err = transformer.New(bvar.Op, bat.Vecs[ctr.Is[i]].Typ); err != nil {
+			if ctr.bat.Rs[i], err = newRing(bvar, bat.Vecs[ctr.Is[i]].Typ); err != nil {
 				ctr.bat.Rs = ctr.bat.Rs[:i]
 				batch.Clean(ctr.bat, proc.Mp)
 				ctr.bat = nil
@@ -273,9 +272,52 @@ func (ctr *Container) processFreeVars(proc *process.Process, arg *Argument) (boo
 		ctr.bat = nil
 		return false, err
 	}
+	// the groups are pushed to the next operator early if the memory is under pressure,
+	// the next operator merges them with the groups pushed later
+	if len(ctr.bat.Zs) >= spill.BatchRows && proc.Mp.Gm.Pressure() {
+		proc.Reg.InputBatch = ctr.flush()
+	}
 	return false, err
 }
 
+// flush returns the groups with their hash table, and resets the
+// container to an empty batch and an empty hash table
+func (ctr *Container) flush() *batch.Batch {
+	bat := ctr.bat
+	switch ctr.typ {
+	case H8:
+		bat.Ht = ctr.intHashMap
+	case H24:
+		bat.Ht = ctr.strHashMap
+	case H32:
+		bat.Ht = ctr.strHashMap
+	case H40:
+		bat.Ht = ctr.strHashMap
+	default:
+		bat.Ht = ctr.strHashMap
+	}
+	ctr.rows = 0
+	ctr.bat = batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		ctr.bat.Vecs[i] = vector.New(vec.Typ)
+		ctr.bat.Vecs[i].Ref = vec.Ref
+	}
+	ctr.bat.As = append([]string{}, bat.As...)
+	ctr.bat.Refs = append([]uint64{}, bat.Refs...)
+	ctr.bat.Rs = make([]ring.Ring, len(bat.Rs))
+	for i, r := range bat.Rs {
+		ctr.bat.Rs[i] = r.Dup()
+	}
+	if ctr.typ == H8 {
+		ctr.intHashMap = &hashtable.Int64HashMap{}
+		ctr.intHashMap.Init()
+	} else {
+		ctr.strHashMap = &hashtable.StringHashMap{}
+		ctr.strHashMap.Init()
+	}
+	return bat
+}
+
 func (ctr *Container) processFreeVarsUnit(proc *process.Process, arg *Argument) (bool, error) {
 	var err error
 
@@ -304,7 +346,7 @@ func (ctr *Container) processFreeVarsUnit(proc *process.Process, arg *Argument)
 		for i, bvar := range arg.BoundVars {
 			bat.As[i] = bvar.Alias
 			bat.Refs[i] = uint64(bvar.Ref)
-			if bat.Rs[i], err = transformer.New(bvar.Op, bat.Vecs[ctr.Is[i]].Typ); err != nil {
+			if bat.Rs[i], err = newRing(bvar, bat.Vecs[ctr.Is[i]].Typ); err != nil {
 				bat.Rs = bat.Rs[:i]
 				batch.Clean(bat, proc.Mp)
 				return false, err
@@ -549,6 +591,25 @@ func (ctr *Container) processH8(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -568,6 +629,44 @@ func (ctr *Container) processH8(bat *batch.Batch, proc *process.Process) error {
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -849,6 +948,44 @@ func (ctr *Container) processH24(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -868,6 +1005,44 @@ func (ctr *Container) processH24(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1147,6 +1322,44 @@ func (ctr *Container) processH32(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1166,6 +1379,44 @@ func (ctr *Container) processH32(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1445,6 +1696,44 @@ func (ctr *Container) processH40(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1464,6 +1753,44 @@ func (ctr *Container) processH40(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1718,6 +2045,40 @@ func (ctr *Container) processHStr(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+						} else {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+						}
+					}
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+						} else {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
@@ -1735,6 +2096,40 @@ func (ctr *Container) processHStr(bat *batch.Batch, proc *process.Process) error
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+						} else {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+						} else {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1828,3 +2223,10 @@ func (ctr *Container) constructContainer(n *Argument, bat *batch.Batch) {
 		ctr.Is = append(ctr.Is, i)
 	}
 }
+
+func newRing(bvar transformer.Transformer, typ types.Type) (ring.Ring, error) {
+	if bvar.Distinct {
+		return transformer.NewDistinct(bvar.Op, typ, bvar.Args...)
+	}
+	return transformer.New(bvar.Op, typ, bvar.Args...)
+}
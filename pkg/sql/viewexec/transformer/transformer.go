@@ -17,15 +17,22 @@ package transformer
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/bitagg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/distinct"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/groupconcat"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/jsonagg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/max"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/min"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/percentile"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/starcount"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/sum"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/variance"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
 
@@ -34,6 +41,9 @@ func init() {
 	for i := range TransformerNames {
 		TransformerNamesMap[TransformerNames[i]] = i
 	}
+	for name, op := range transformerAliases {
+		TransformerNamesMap[name] = op
+	}
 }
 
 var sumReturnTypes = map[types.T]types.T{
@@ -47,11 +57,17 @@ var sumReturnTypes = map[types.T]types.T{
 	types.T_uint64:  types.T_uint64,
 	types.T_float32: types.T_float64,
 	types.T_float64: types.T_float64,
+
+	types.T_decimal64:  types.T_decimal128,
+	types.T_decimal128: types.T_decimal128,
 }
 
 func ReturnType(op int, typ types.T) types.T {
 	switch op {
 	case Avg:
+		if typ == types.T_decimal64 || typ == types.T_decimal128 {
+			return types.T_decimal128
+		}
 		return types.T_float64
 	case Max:
 		return typ
@@ -63,15 +79,27 @@ func ReturnType(op int, typ types.T) types.T {
 		return types.T_int64
 	case ApproxCountDistinct:
 		return types.T_uint64
+	case JsonArrayAgg, JsonObjectAgg:
+		return types.T_json
+	case VarPop, VarSamp, StdDevPop, StdDevSamp, Median, PercentileCont, ApproxPercentile:
+		return types.T_float64
+	case BitAnd, BitOr, BitXor:
+		return types.T_uint64
+	case GroupConcat:
+		return types.T_varchar
 	}
 	return 0
 }
 
-func New(op int, typ types.Type) (ring.Ring, error) {
+// New returns the ring of op, args are the constant arguments of op
+func New(op int, typ types.Type, args ...string) (ring.Ring, error) {
 	switch op {
 	case Sum:
 		return NewSum(typ)
 	case Avg:
+		if typ.Oid == types.T_decimal64 || typ.Oid == types.T_decimal128 {
+			return avg.NewDecimalAvg(typ), nil
+		}
 		return avg.NewAvg(typ), nil
 	case Max:
 		return NewMax(typ)
@@ -83,10 +111,137 @@ func New(op int, typ types.Type) (ring.Ring, error) {
 		return starcount.NewCount(typ), nil
 	case ApproxCountDistinct:
 		return approxcd.NewApproxCountDistinct(typ), nil
+	case JsonArrayAgg:
+		return jsonagg.NewArray(typ), nil
+	case JsonObjectAgg:
+		return jsonagg.NewObject(typ), nil
+	case VarPop, VarSamp, StdDevPop, StdDevSamp:
+		return NewVariance(op, typ)
+	case BitAnd, BitOr, BitXor:
+		return NewBit(op, typ)
+	case GroupConcat:
+		return NewGroupConcat(false, typ, args)
+	case Median, PercentileCont, ApproxPercentile:
+		return NewPercentile(op, typ, args)
 	}
 	return nil, nil
 }
 
+// NewDistinct returns a ring which aggregates the distinct values only
+func NewDistinct(op int, typ types.Type, args ...string) (ring.Ring, error) {
+	if op == GroupConcat {
+		// the rows of group_concat are made of values and keys of order by,
+		// so the distinct values are found by the ring itself
+		return NewGroupConcat(true, typ, args)
+	}
+	r, err := New(op, typ, args...)
+	if err != nil {
+		return nil, err
+	}
+	if IsDistinctSensitive(op) {
+		return distinct.NewDistinct(r, typ), nil
+	}
+	return r, nil
+}
+
+// IsDistinctSensitive returns true if the result of op over the distinct
+// values differs from that over all the values
+func IsDistinctSensitive(op int) bool {
+	switch op {
+	case Sum, Avg, Count, VarPop, VarSamp, StdDevPop, StdDevSamp, BitXor,
+		GroupConcat, Median, PercentileCont, ApproxPercentile:
+		return true
+	}
+	return false
+}
+
+// PercentileArgs returns the arguments of the percentile p
+func PercentileArgs(p float64) []string {
+	return []string{strconv.FormatFloat(p, 'g', -1, 64)}
+}
+
+// GroupConcatArgs returns the arguments of group_concat whose rows are made of
+// n values and the keys of order by whose directions are descs.
+func GroupConcatArgs(n int, sep string, descs []bool) []string {
+	args := []string{sep, strconv.Itoa(n)}
+	for _, desc := range descs {
+		args = append(args, strconv.FormatBool(desc))
+	}
+	return args
+}
+
+func NewVariance(op int, typ types.Type) (ring.Ring, error) {
+	if !ring.IsNumeric(typ.Oid) {
+		return nil, errors.New(fmt.Sprintf("'%v' not support %s", typ, TransformerNames[op]))
+	}
+	switch op {
+	case VarPop:
+		return variance.NewVariance(variance.VarPop, typ), nil
+	case VarSamp:
+		return variance.NewVariance(variance.VarSamp, typ), nil
+	case StdDevPop:
+		return variance.NewVariance(variance.StdDevPop, typ), nil
+	}
+	return variance.NewVariance(variance.StdDevSamp, typ), nil
+}
+
+func NewBit(op int, typ types.Type) (ring.Ring, error) {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+	default:
+		return nil, errors.New(fmt.Sprintf("'%v' not support %s", typ, TransformerNames[op]))
+	}
+	switch op {
+	case BitAnd:
+		return bitagg.NewBit(bitagg.And, typ), nil
+	case BitOr:
+		return bitagg.NewBit(bitagg.Or, typ), nil
+	}
+	return bitagg.NewBit(bitagg.Xor, typ), nil
+}
+
+func NewPercentile(op int, typ types.Type, args []string) (ring.Ring, error) {
+	if !ring.IsNumeric(typ.Oid) {
+		return nil, errors.New(fmt.Sprintf("'%v' not support %s", typ, TransformerNames[op]))
+	}
+	p := 0.5
+	if op != Median {
+		if len(args) != 1 {
+			return nil, errors.New(fmt.Sprintf("%s needs a percentile", TransformerNames[op]))
+		}
+		v, err := strconv.ParseFloat(args[0], 64)
+		if err != nil || v < 0 || v > 1 {
+			return nil, errors.New(fmt.Sprintf("the percentile of %s must be between 0 and 1", TransformerNames[op]))
+		}
+		p = v
+	}
+	if op == ApproxPercentile {
+		return percentile.NewTDigest(p, typ), nil
+	}
+	return percentile.NewPercentile(p, typ), nil
+}
+
+func NewGroupConcat(distinct bool, typ types.Type, args []string) (ring.Ring, error) {
+	if typ.Oid != types.T_json {
+		return nil, errors.New(fmt.Sprintf("'%v' not support group_concat", typ))
+	}
+	if len(args) < 2 {
+		return nil, errors.New(fmt.Sprintf("wrong arguments of group_concat: %v", args))
+	}
+	n, err := strconv.Atoi(args[1])
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("wrong arguments of group_concat: %v", args))
+	}
+	descs := make([]bool, len(args)-2)
+	for i, arg := range args[2:] {
+		if descs[i], err = strconv.ParseBool(arg); err != nil {
+			return nil, errors.New(fmt.Sprintf("wrong arguments of group_concat: %v", args))
+		}
+	}
+	return groupconcat.NewGroupConcat(n, distinct, descs, args[0], typ), nil
+}
+
 func NewSum(typ types.Type) (ring.Ring, error) {
 	switch typ.Oid {
 	case types.T_float32, types.T_float64:
@@ -95,6 +250,8 @@ func NewSum(typ types.Type) (ring.Ring, error) {
 		return sum.NewInt(typ), nil
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 		return sum.NewUint(typ), nil
+	case types.T_decimal64, types.T_decimal128:
+		return sum.NewDecimal(typ), nil
 	}
 	return nil, errors.New(fmt.Sprintf("'%v' not support Sum", typ))
 }
@@ -105,6 +262,8 @@ func NewAvg(typ types.Type) (ring.Ring, error) {
 		return avg.NewAvg(typ), nil
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 		return avg.NewAvg(typ), nil
+	case types.T_decimal64, types.T_decimal128:
+		return avg.NewDecimalAvg(typ), nil
 	}
 	return nil, errors.New(fmt.Sprintf("'%v' not support Avg", typ))
 }
@@ -131,12 +290,20 @@ func NewMax(typ types.Type) (ring.Ring, error) {
 		return max.NewFloat32(typ), nil
 	case types.T_float64:
 		return max.NewFloat64(typ), nil
+	case types.T_decimal64:
+		return max.NewDecimal64(typ), nil
+	case types.T_decimal128:
+		return max.NewDecimal128(typ), nil
 	case types.T_char, types.T_varchar:
 		return max.NewStr(typ), nil
 	case types.T_date:
 		return max.NewDate(typ), nil
 	case types.T_datetime:
 		return max.NewDatetime(typ), nil
+	case types.T_time:
+		return max.NewTime(typ), nil
+	case types.T_timestamp:
+		return max.NewTimestamp(typ), nil
 	}
 	return nil, errors.New(fmt.Sprintf("'%v' not support Max", typ))
 }
@@ -163,12 +330,20 @@ func NewMin(typ types.Type) (ring.Ring, error) {
 		return min.NewFloat32(typ), nil
 	case types.T_float64:
 		return min.NewFloat64(typ), nil
+	case types.T_decimal64:
+		return min.NewDecimal64(typ), nil
+	case types.T_decimal128:
+		return min.NewDecimal128(typ), nil
 	case types.T_char, types.T_varchar:
 		return min.NewStr(typ), nil
 	case types.T_date:
 		return min.NewDate(typ), nil
 	case types.T_datetime:
 		return min.NewDatetime(typ), nil
+	case types.T_time:
+		return min.NewTime(typ), nil
+	case types.T_timestamp:
+		return min.NewTimestamp(typ), nil
 	}
 	return nil, errors.New(fmt.Sprintf("'%v' not support Min", typ))
 }
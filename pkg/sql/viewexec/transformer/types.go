@@ -22,6 +22,19 @@ const (
 	Count
 	StarCount
 	ApproxCountDistinct
+	JsonArrayAgg
+	JsonObjectAgg
+	VarPop
+	VarSamp
+	StdDevPop
+	StdDevSamp
+	BitAnd
+	BitOr
+	BitXor
+	GroupConcat
+	Median
+	PercentileCont
+	ApproxPercentile
 )
 
 var TransformerNames = [...]string{
@@ -32,13 +45,37 @@ var TransformerNames = [...]string{
 	Count:               "count",
 	StarCount:           "starcount",
 	ApproxCountDistinct: "approx_count_distinct",
+	JsonArrayAgg:        "json_arrayagg",
+	JsonObjectAgg:       "json_objectagg",
+	VarPop:              "var_pop",
+	VarSamp:             "var_samp",
+	StdDevPop:           "stddev_pop",
+	StdDevSamp:          "stddev_samp",
+	BitAnd:              "bit_and",
+	BitOr:               "bit_or",
+	BitXor:              "bit_xor",
+	GroupConcat:         "group_concat",
+	Median:              "median",
+	PercentileCont:      "percentile_cont",
+	ApproxPercentile:    "approx_percentile",
+}
+
+// the other names of the aggregations
+var transformerAliases = map[string]int{
+	"variance": VarPop,
+	"std":      StdDevPop,
+	"stddev":   StdDevPop,
 }
 
 var TransformerNamesMap map[string]int
 
 type Transformer struct {
-	Op    int
-	Ref   int
-	Name  string
-	Alias string
+	Op       int
+	Ref      int
+	Name     string
+	Alias    string
+	Distinct bool // aggregate the distinct values only, such as count(distinct x)
+	// Args are the constant arguments of the aggregation, such as the percentile
+	// of percentile_cont and the separator of group_concat
+	Args []string
 }
@@ -21,8 +21,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/spill"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -63,7 +65,10 @@ func (ctr *Container) processBare(fvars []string, proc *process.Process) (bool,
 	}
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
 		reg := proc.Reg.MergeReceivers[i]
-		bat := <-reg.Ch
+		bat, err := process.Receive(proc, reg)
+		if err != nil {
+			return true, err
+		}
 		if bat == nil {
 			proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
 			i--
@@ -73,13 +78,10 @@ func (ctr *Container) processBare(fvars []string, proc *process.Process) (bool,
 			i--
 			continue
 		}
-		for i, r := range bat.Rs {
-			bat.Attrs = append(bat.Attrs, bat.As[i])
-			vec := r.Eval(bat.Zs)
-			vec.Ref = bat.Refs[i]
-			bat.Vecs = append(bat.Vecs, vec)
+		if err := evalRings(bat, proc); err != nil {
+			batch.Clean(bat, proc.Mp)
+			return true, err
 		}
-		bat.Rs = nil
 		if len(fvars) > 0 {
 			batch.Reduce(bat, fvars, proc.Mp)
 		}
@@ -94,7 +96,16 @@ func (ctr *Container) processBoundVars(proc *process.Process) (bool, error) {
 		switch ctr.state {
 		case Fill:
 			for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-				bat := <-proc.Reg.MergeReceivers[i].Ch
+				bat, err := process.Receive(proc, proc.Reg.MergeReceivers[i])
+				if err != nil {
+					if ctr.bat != nil {
+						batch.Clean(ctr.bat, proc.Mp)
+						ctr.bat = nil
+					}
+					proc.Reg.InputBatch = nil
+					ctr.state = Eval
+					return true, err
+				}
 				if bat == nil {
 					continue
 				}
@@ -127,15 +138,10 @@ func (ctr *Container) processBoundVars(proc *process.Process) (bool, error) {
 			ctr.state = Eval
 		case Eval:
 			if ctr.bat != nil {
-				for i, r := range ctr.bat.Rs {
-					ctr.bat.Attrs = append(ctr.bat.Attrs, ctr.bat.As[i])
-					vec := r.Eval(ctr.bat.Zs)
-					vec.Ref = ctr.bat.Refs[i]
-					ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
-				}
-				ctr.bat.Rs = nil
-				for i := range ctr.bat.Zs {
-					ctr.bat.Zs[i] = 1
+				if err := eval(ctr.bat, proc); err != nil {
+					batch.Clean(ctr.bat, proc.Mp)
+					ctr.bat = nil
+					return true, err
 				}
 				proc.Reg.InputBatch = ctr.bat
 				ctr.bat = nil
@@ -151,7 +157,10 @@ func (ctr *Container) processFreeVars(fvars []string, proc *process.Process) (bo
 		switch ctr.state {
 		case Fill:
 			if err := ctr.fill(fvars, proc); err != nil {
-				batch.Clean(ctr.bat, proc.Mp)
+				if ctr.bat != nil {
+					batch.Clean(ctr.bat, proc.Mp)
+					ctr.bat = nil
+				}
 				proc.Reg.InputBatch = nil
 				ctr.state = Eval
 				return true, err
@@ -159,15 +168,10 @@ func (ctr *Container) processFreeVars(fvars []string, proc *process.Process) (bo
 			ctr.state = Eval
 		case Eval:
 			if ctr.bat != nil {
-				for i, r := range ctr.bat.Rs {
-					ctr.bat.Attrs = append(ctr.bat.Attrs, ctr.bat.As[i])
-					vec := r.Eval(ctr.bat.Zs)
-					vec.Ref = ctr.bat.Refs[i]
-					ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
-				}
-				ctr.bat.Rs = nil
-				for i := range ctr.bat.Zs {
-					ctr.bat.Zs[i] = 1
+				if err := eval(ctr.bat, proc); err != nil {
+					batch.Clean(ctr.bat, proc.Mp)
+					ctr.bat = nil
+					return true, err
 				}
 				if len(fvars) > 0 {
 					batch.Reduce(ctr.bat, fvars, proc.Mp)
@@ -182,21 +186,51 @@ func (ctr *Container) processFreeVars(fvars []string, proc *process.Process) (bo
 
 func (ctr *Container) fill(fvars []string, proc *process.Process) error {
 	if len(proc.Reg.MergeReceivers) == 1 {
+		var concated bool
+
 		for {
-			bat := <-proc.Reg.MergeReceivers[0].Ch
+			bat, err := process.Receive(proc, proc.Reg.MergeReceivers[0])
+			if err != nil {
+				return err
+			}
 			if bat == nil {
+				proc.Reg.MergeReceivers = nil
 				return nil
 			}
 			if len(bat.Zs) == 0 {
 				continue
 			}
-			ctr.bat = bat
-			return nil
+			if ctr.bat == nil {
+				ctr.bat = bat
+				continue
+			}
+			// the batches of a single receiver have different groups, such as the partitions
+			// spilled by oplus, so they are evaluated and concatenated
+			if !concated {
+				if err := eval(ctr.bat, proc); err != nil {
+					return err
+				}
+				rbat, err := spill.Slice(ctr.bat, 0, len(ctr.bat.Zs), proc)
+				batch.Clean(ctr.bat, proc.Mp)
+				ctr.bat = rbat
+				if err != nil {
+					return err
+				}
+				concated = true
+			}
+			if err := ctr.concat(bat, proc); err != nil {
+				return err
+			}
 		}
 	}
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
-		bat := <-proc.Reg.MergeReceivers[i].Ch
+		bat, err := process.Receive(proc, proc.Reg.MergeReceivers[i])
+		if err != nil {
+			return err
+		}
 		if bat == nil {
+			proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
+			i--
 			continue
 		}
 		if len(bat.Zs) == 0 {
@@ -206,7 +240,55 @@ func (ctr *Container) fill(fvars []string, proc *process.Process) error {
 		if err := ctr.fillBatch(fvars, bat, proc); err != nil {
 			return err
 		}
+		i--
+	}
+	return nil
+}
+
+// concat evaluates the aggregations of the batch and appends it to the result
+func (ctr *Container) concat(bat *batch.Batch, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	if err := eval(bat, proc); err != nil {
+		return err
+	}
+	batch.Reorder(bat, ctr.bat.Attrs)
+	n := len(bat.Zs)
+	flags := make([]uint8, n)
+	for i := range flags {
+		flags[i] = 1
+	}
+	for i, vec := range ctr.bat.Vecs {
+		if err := vector.UnionBatch(vec, bat.Vecs[i], 0, n, flags, proc.Mp); err != nil {
+			return err
+		}
 	}
+	ctr.bat.Zs = append(ctr.bat.Zs, bat.Zs...)
+	return nil
+}
+
+// eval replaces the rings of the batch with their results
+func eval(bat *batch.Batch, proc *process.Process) error {
+	if err := evalRings(bat, proc); err != nil {
+		return err
+	}
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return nil
+}
+
+// evalRings appends the results of the rings to the vectors of the batch
+func evalRings(bat *batch.Batch, proc *process.Process) error {
+	for i, r := range bat.Rs {
+		vec, err := ring.Eval(r, bat.Zs, proc.Mp)
+		if err != nil {
+			return err
+		}
+		bat.Attrs = append(bat.Attrs, bat.As[i])
+		vec.Ref = bat.Refs[i]
+		bat.Vecs = append(bat.Vecs, vec)
+	}
+	bat.Rs = nil
 	return nil
 }
 
@@ -229,8 +311,10 @@ func (ctr *Container) fillBatch(fvars []string, bat *batch.Batch, proc *process.
 				size += 2 + nullable
 			case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
 				size += 4 + nullable
-			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime:
+			case types.T_int64, types.T_uint64, types.T_float64, types.T_decimal64, types.T_datetime, types.T_time, types.T_timestamp:
 				size += 8 + nullable
+			case types.T_decimal128:
+				size += 16 + nullable
 			case types.T_char, types.T_varchar:
 				if width := vec.Typ.Width; width > 0 {
 					size += int(width) + nullable
@@ -518,6 +602,25 @@ func (ctr *Container) processH8(fvars []string, bat *batch.Batch, proc *process.
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -537,6 +640,44 @@ func (ctr *Container) processH8(fvars []string, bat *batch.Batch, proc *process.
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h8.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -819,6 +960,44 @@ func (ctr *Container) processH24(fvars []string, bat *batch.Batch, proc *process
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -838,6 +1017,44 @@ func (ctr *Container) processH24(fvars []string, bat *batch.Batch, proc *process
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h24.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -1119,6 +1336,44 @@ func (ctr *Container) processH32(fvars []string, bat *batch.Batch, proc *process
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1138,6 +1393,44 @@ func (ctr *Container) processH32(fvars []string, bat *batch.Batch, proc *process
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h32.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -1419,6 +1712,44 @@ func (ctr *Container) processH40(fvars []string, bat *batch.Batch, proc *process
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = vs[i+k]
+					}
+					add.Uint32AddScalar(16, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*types.Decimal128)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = vs[i+k]
+							ctr.keyOffs[k] += 17
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				if !nulls.Any(vecs[j].Nsp) {
@@ -1438,6 +1769,44 @@ func (ctr *Container) processH40(fvars []string, bat *batch.Batch, proc *process
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = int64(vs[i+k])
+					}
+					add.Uint32AddScalar(8, ctr.keyOffs[:n], ctr.keyOffs[:n])
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 1
+							ctr.keyOffs[k]++
+						} else {
+							*(*int8)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k])) = 0
+							*(*int64)(unsafe.Add(unsafe.Pointer(&ctr.h40.keys[k]), ctr.keyOffs[k]+1)) = int64(vs[i+k])
+							ctr.keyOffs[k] += 9
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				vData := vs.Data
@@ -1694,6 +2063,40 @@ func (ctr *Container) processHStr(fvars []string, bat *batch.Batch, proc *proces
 						}
 					}
 				}
+			case types.T_decimal64:
+				vs := vecs[j].Col.([]types.Decimal64)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+						} else {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+						}
+					}
+				}
+			case types.T_decimal128:
+				vs := vecs[j].Col.([]types.Decimal128)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+						} else {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*16:(i+k+1)*16]...)
+						}
+					}
+				}
 			case types.T_datetime:
 				vs := vecs[j].Col.([]types.Datetime)
 				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
@@ -1711,6 +2114,40 @@ func (ctr *Container) processHStr(fvars []string, bat *batch.Batch, proc *proces
 						}
 					}
 				}
+			case types.T_time:
+				vs := vecs[j].Col.([]types.Time)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+						} else {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+						}
+					}
+				}
+			case types.T_timestamp:
+				vs := vecs[j].Col.([]types.Timestamp)
+				data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+				if !nulls.Any(vecs[j].Nsp) {
+					for k := int64(0); k < n; k++ {
+						ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+					}
+				} else {
+					for k := int64(0); k < n; k++ {
+						if vecs[j].Nsp.Np.Contains(uint64(i + k)) {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(1))
+						} else {
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], byte(0))
+							ctr.hstr.keys[k] = append(ctr.hstr.keys[k], data[(i+k)*8:(i+k+1)*8]...)
+						}
+					}
+				}
 			case types.T_char, types.T_varchar:
 				vs := vecs[j].Col.(*types.Bytes)
 				if !nulls.Any(vecs[j].Nsp) {
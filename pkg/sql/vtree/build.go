@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/sql/ftree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 )
@@ -52,6 +53,9 @@ func constructViewTree(vs []*View, ft *ftree.FTree, qry *plan.Query) *ViewTree {
 	if len(qry.ProjectionExtends) > 0 {
 		vt.Projection = constructProjection(qry.ProjectionExtends)
 	}
+	if len(qry.Windows) > 0 {
+		vt.Window = constructWindow(qry.Windows)
+	}
 	if qry.Limit > 0 && qry.Offset == -1 && len(qry.Fields) > 0 { // top
 		vt.Top = constructTop(qry.Fields, qry.Limit)
 	} else {
@@ -86,6 +90,31 @@ func constructOrder(fs []*plan.Field) *order.Argument {
 	return arg
 }
 
+func constructWindow(ws []*plan.Window) *window.Argument {
+	arg := &window.Argument{
+		Fs: make([]window.Function, len(ws)),
+	}
+	for i, w := range ws {
+		arg.Fs[i] = window.Function{
+			Kind:       w.Kind,
+			Op:         w.Op,
+			N:          w.N,
+			Ref:        uint64(w.Ref),
+			Attr:       w.Name,
+			Name:       w.Alias,
+			Partitions: w.Partitions,
+			Frame:      w.Frame,
+		}
+		for _, f := range w.Fields {
+			arg.Fs[i].Fs = append(arg.Fs[i].Fs, order.Field{
+				Attr: f.Attr,
+				Type: order.Direction(f.Type),
+			})
+		}
+	}
+	return arg
+}
+
 func constructTop(fs []*plan.Field, limit int64) *top.Argument {
 	arg := &top.Argument{
 		Limit: limit,
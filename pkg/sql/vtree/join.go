@@ -0,0 +1,295 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vtree
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/ftree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/join"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+)
+
+// BuildJoin builds the view tree which joins the relations of query one by one,
+// it is used for the queries can not be factorized, such as queries with outer
+// joins or non-equi join conditions. The attributes of relations are renamed
+// to relation.attribute after joining.
+func (b *build) BuildJoin(qry *plan.Query) *ViewTree {
+	var bvars []transformer.Transformer
+
+	if qry.Limit == 0 {
+		return nil
+	}
+	rename := newRenamer(qry)
+	vs := make([]*View, len(qry.Rels))
+	arg := &join.Argument{Rs: make([]join.Relation, len(qry.Rels))}
+	for i, rn := range qry.Rels {
+		rel := qry.RelsMap[rn]
+		vs[i] = constructJoinView(rel)
+		arg.Rs[i] = constructJoinRelation(rel)
+		for _, agg := range rel.Aggregations {
+			bvars = append(bvars, transformer.Transformer{
+				Op:       agg.Op,
+				Ref:      agg.Ref,
+				Name:     rn + "." + agg.Name,
+				Alias:    agg.Alias,
+				Distinct: agg.Distinct,
+				Args:     agg.Args,
+			})
+		}
+	}
+	conds := make([][]extend.Extend, len(qry.Rels))
+	for _, cond := range qry.Conds {
+		i, j := relationIndex(qry.Rels, cond.R), relationIndex(qry.Rels, cond.S)
+		if j > i {
+			i = j
+		}
+		typ := qry.RelsMap[cond.R].AttrsMap[cond.Rattr].Type.Oid
+		conds[i] = append(conds[i], &extend.BinaryExtend{
+			Op:    overload.EQ,
+			Left:  &extend.Attribute{Name: cond.R + "." + cond.Rattr, Type: typ},
+			Right: &extend.Attribute{Name: cond.S + "." + cond.Sattr, Type: typ},
+		})
+	}
+	for _, j := range qry.Joins {
+		i := relationIndex(qry.Rels, j.R)
+		arg.Rs[i].Typ = joinTypes[j.Type]
+		arg.Rs[i].NullAware = j.NullAware
+		if j.Cond != nil {
+			conds[i] = append(conds[i], renameExtend(j.Cond, rename))
+		}
+	}
+	for i := range conds {
+		if len(conds[i]) > 0 {
+			arg.Rs[i].Cond = constructRestrict(conds[i])
+		}
+	}
+	fvars := renameAttributes(qry.FreeAttrs, rename)
+	v := &View{
+		Name:     constructViewRelationName(qry.Rels),
+		Children: vs,
+		FreeVars: fvars,
+		Join:     arg,
+		Arg: &transform.Argument{
+			FreeVars:  fvars,
+			BoundVars: bvars,
+		},
+	}
+	if len(qry.JoinRestrictConds) > 0 {
+		v.Arg.Restrict = &restrict.Argument{
+			E: renameExtend(constructRestrict(qry.JoinRestrictConds), rename),
+		}
+	}
+	vt := constructViewTree([]*View{v}, &ftree.FTree{FreeVars: fvars}, qry)
+	if vt.Restrict != nil {
+		vt.Restrict.E = renameExtend(vt.Restrict.E, rename)
+	}
+	if vt.Window != nil {
+		for i := range vt.Window.Fs {
+			f := &vt.Window.Fs[i]
+			if len(f.Attr) > 0 {
+				f.Attr = rename(f.Attr)
+			}
+			f.Partitions = renameAttributes(f.Partitions, rename)
+			for j := range f.Fs {
+				f.Fs[j].Attr = rename(f.Fs[j].Attr)
+			}
+		}
+	}
+	if vt.Projection != nil {
+		for i, e := range vt.Projection.Es {
+			vt.Projection.Es[i] = renameExtend(e, rename)
+		}
+	}
+	if vt.Top != nil {
+		for i := range vt.Top.Fs {
+			vt.Top.Fs[i].Attr = rename(vt.Top.Fs[i].Attr)
+		}
+	}
+	if vt.Order != nil {
+		for i := range vt.Order.Fs {
+			vt.Order.Fs[i].Attr = rename(vt.Order.Fs[i].Attr)
+		}
+	}
+	for _, rv := range vt.ResultVariables {
+		rv.Name = rename(rv.Name)
+	}
+	return vt
+}
+
+var joinTypes = [...]int{
+	plan.InnerJoin:  join.Inner,
+	plan.LeftJoin:   join.Left,
+	plan.RightJoin:  join.Right,
+	plan.FullJoin:   join.Full,
+	plan.SemiJoin:   join.Semi,
+	plan.AntiJoin:   join.Anti,
+	plan.SingleJoin: join.Single,
+}
+
+func constructJoinView(rel *plan.Relation) *View {
+	v := &View{
+		Name: rel.Alias,
+		Rel:  constructViewRelation(rel),
+		Arg:  &transform.Argument{},
+	}
+	if len(rel.RestrictConds) > 0 {
+		v.Arg.Restrict = &restrict.Argument{
+			E: constructRestrict(rel.RestrictConds),
+		}
+	}
+	if len(rel.ProjectionExtends) > 0 {
+		v.Arg.Projection = constructProjection(rel.ProjectionExtends)
+	}
+	return v
+}
+
+// constructJoinRelation returns the attributes of relation which are still
+// referenced after the restrict and projection of relation.
+func constructJoinRelation(rel *plan.Relation) join.Relation {
+	r := join.Relation{Alias: rel.Alias}
+	cnts := make(map[string]int)
+	for _, e := range rel.RestrictConds {
+		for _, attr := range e.Attributes() {
+			cnts[attr]++
+		}
+	}
+	for _, e := range rel.ProjectionExtends {
+		for _, attr := range e.E.Attributes() {
+			cnts[attr]++
+		}
+	}
+	for _, name := range rel.Attrs {
+		attr := rel.AttrsMap[name]
+		if ref := attr.Ref - cnts[name]; ref > 0 {
+			r.Vars = append(r.Vars, name)
+			r.Refs = append(r.Refs, uint64(ref))
+			r.Types = append(r.Types, attr.Type)
+		}
+	}
+	for _, e := range rel.ProjectionExtends {
+		if e.Ref > 0 {
+			r.Vars = append(r.Vars, e.Alias)
+			r.Refs = append(r.Refs, uint64(e.Ref))
+			r.Types = append(r.Types, e.E.ReturnType().ToType())
+		}
+	}
+	return r
+}
+
+// newRenamer returns the function renaming the attributes and the projections
+// of relations to relation.name, aliases of the query are not renamed.
+func newRenamer(qry *plan.Query) func(string) string {
+	mp := make(map[string]string)
+	for _, rn := range qry.Rels {
+		rel := qry.RelsMap[rn]
+		for _, attr := range rel.Attrs {
+			if _, ok := mp[attr]; !ok {
+				mp[attr] = rn + "." + attr
+			}
+		}
+		for _, e := range rel.ProjectionExtends {
+			if _, ok := mp[e.Alias]; !ok {
+				mp[e.Alias] = rn + "." + e.Alias
+			}
+		}
+	}
+	for _, rn := range qry.Rels {
+		for _, agg := range qry.RelsMap[rn].Aggregations {
+			delete(mp, agg.Alias)
+		}
+	}
+	for _, e := range qry.ProjectionExtends {
+		delete(mp, e.Alias)
+	}
+	for _, w := range qry.Windows {
+		delete(mp, w.Alias)
+	}
+	return func(name string) string {
+		if v, ok := mp[name]; ok {
+			return v
+		}
+		return name
+	}
+}
+
+func renameAttributes(attrs []string, rename func(string) string) []string {
+	rs := make([]string, len(attrs))
+	for i, attr := range attrs {
+		rs[i] = rename(attr)
+	}
+	return rs
+}
+
+// renameExtend returns a copy of e whose attributes are renamed
+func renameExtend(e extend.Extend, rename func(string) string) extend.Extend {
+	switch v := e.(type) {
+	case *extend.Attribute:
+		return &extend.Attribute{Name: rename(v.Name), Type: v.Type}
+	case *extend.UnaryExtend:
+		return &extend.UnaryExtend{Op: v.Op, E: renameExtend(v.E, rename)}
+	case *extend.ParenExtend:
+		return &extend.ParenExtend{E: renameExtend(v.E, rename)}
+	case *extend.BinaryExtend:
+		return &extend.BinaryExtend{
+			Op:    v.Op,
+			Left:  renameExtend(v.Left, rename),
+			Right: renameExtend(v.Right, rename),
+		}
+	case *extend.MultiExtend:
+		args := make([]extend.Extend, len(v.Args))
+		for i, arg := range v.Args {
+			args[i] = renameExtend(arg, rename)
+		}
+		return &extend.MultiExtend{Op: v.Op, Args: args}
+	case *extend.FuncExtend:
+		args := make([]extend.Extend, len(v.Args))
+		for i, arg := range v.Args {
+			args[i] = renameExtend(arg, rename)
+		}
+		return &extend.FuncExtend{Name: v.Name, Args: args}
+	case *extend.CaseExtend:
+		conds := make([]extend.Extend, len(v.Conds))
+		vals := make([]extend.Extend, len(v.Vals))
+		for i := range v.Conds {
+			conds[i] = renameExtend(v.Conds[i], rename)
+			vals[i] = renameExtend(v.Vals[i], rename)
+		}
+		return &extend.CaseExtend{Type: v.Type, Conds: conds, Vals: vals, Else: renameExtend(v.Else, rename)}
+	case *extend.CoalesceExtend:
+		args := make([]extend.Extend, len(v.Args))
+		for i, arg := range v.Args {
+			args[i] = renameExtend(arg, rename)
+		}
+		return &extend.CoalesceExtend{Type: v.Type, Args: args}
+	case *extend.IsNullExtend:
+		return &extend.IsNullExtend{Not: v.Not, E: renameExtend(v.E, rename)}
+	case *extend.InExtend:
+		return &extend.InExtend{Not: v.Not, Null: v.Null, E: renameExtend(v.E, rename), Args: v.Args}
+	}
+	return e
+}
+
+func relationIndex(rns []string, rn string) int {
+	for i := range rns {
+		if rns[i] == rn {
+			return i
+		}
+	}
+	return -1
+}
@@ -25,6 +25,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
 )
 
@@ -36,9 +39,11 @@ type Variable struct {
 
 type Relation struct {
 	Alias  string
-	Name   string // table name
-	Schema string // schema name
+	Name   string      // table name
+	Schema string      // schema name
+	Query  *plan.Query // relation is a subquery
 	Vars   []*Variable
+	AsOf   int64 // unix time in nanoseconds the relation is read as of
 }
 
 type View struct {
@@ -48,6 +53,7 @@ type View struct {
 	Var      *Variable
 	Rel      *Relation
 	Arg      *transform.Argument // arguments for constructing a view
+	Join     *join.Argument      // arguments for joining children, nil if the view is factorized
 }
 
 type ViewTree struct {
@@ -61,6 +67,7 @@ type ViewTree struct {
 	Offset          *offset.Argument
 	Restrict        *restrict.Argument
 	Projection      *projection.Argument
+	Window          *window.Argument
 }
 
 type node struct {
@@ -92,6 +99,10 @@ func (vt *ViewTree) String() string {
 
 	buf.WriteString(fmt.Sprintf("result variables: %v\n", vt.ResultVariables))
 	buf.WriteString("View Tree -> ")
+	if vt.Window != nil {
+		window.String(vt.Window, &buf)
+		buf.WriteString(" -> ")
+	}
 	if vt.Restrict != nil {
 		restrict.String(vt.Restrict, &buf)
 		buf.WriteString(" -> ")
@@ -134,6 +145,11 @@ func (v *View) String() string {
 		buf.WriteString(fmt.Sprintf("V{%s}<%v> <- ", v.Name, v.FreeVars))
 		buf.WriteString(fmt.Sprintf("(%s <- %s.%s[%v]) -> ", v.Rel.Alias, v.Rel.Schema, v.Rel.Name, v.Rel.Vars))
 		transform.String(v.Arg, &buf)
+	} else if v.Join != nil {
+		buf.WriteString(fmt.Sprintf("V{%s}<%v> <- (", v.Name, v.FreeVars))
+		join.String(v.Join, &buf)
+		buf.WriteString(") -> ")
+		transform.String(v.Arg, &buf)
 	} else {
 		buf.WriteString(fmt.Sprintf("V{%s}[%s]<%v>", v.Name, v.Var.Name, v.FreeVars))
 	}
@@ -65,7 +65,7 @@ func constructViewByVariable(n *node, fvar *ftree.Variable) *View {
 func constructViewByRelation(n *node, frel *ftree.Relation) *View {
 	v := &View{
 		FreeVars: n.freeVars,
-		Rel:      constructViewRelation(frel),
+		Rel:      constructViewRelation(frel.Rel),
 		Name:     constructViewRelationName(n.rns),
 	}
 	arg := &transform.Argument{
@@ -98,10 +98,10 @@ func constructViewRelationName(rns []string) string {
 	return buf.String()
 }
 
-func constructViewRelation(frel *ftree.Relation) *Relation {
-	vars := make([]*Variable, len(frel.Rel.Attrs))
-	for i, name := range frel.Rel.Attrs {
-		attr := frel.Rel.AttrsMap[name]
+func constructViewRelation(rel *plan.Relation) *Relation {
+	vars := make([]*Variable, len(rel.Attrs))
+	for i, name := range rel.Attrs {
+		attr := rel.AttrsMap[name]
 		vars[i] = &Variable{
 			Ref:  attr.Ref,
 			Name: attr.Name,
@@ -109,9 +109,11 @@ func constructViewRelation(frel *ftree.Relation) *Relation {
 	}
 	return &Relation{
 		Vars:   vars,
-		Alias:  frel.Rel.Alias,
-		Name:   frel.Rel.Name,
-		Schema: frel.Rel.Schema,
+		Alias:  rel.Alias,
+		Name:   rel.Name,
+		Schema: rel.Schema,
+		Query:  rel.Query,
+		AsOf:   rel.AsOf,
 	}
 }
 
@@ -122,6 +124,8 @@ func constructAggregation(aggs []*plan.Aggregation) []transformer.Transformer {
 		bvars[i].Ref = aggs[i].Ref
 		bvars[i].Name = aggs[i].Name
 		bvars[i].Alias = aggs[i].Alias
+		bvars[i].Distinct = aggs[i].Distinct
+		bvars[i].Args = aggs[i].Args
 	}
 	return bvars
 }
@@ -0,0 +1,38 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package add
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+var (
+	Decimal128Add func([]types.Decimal128, []types.Decimal128, []types.Decimal128) ([]types.Decimal128, error)
+)
+
+func init() {
+	Decimal128Add = decimal128Add
+}
+
+// decimal128Add adds decimals of the same scale, an error is returned if the
+// sum has more than 38 digits.
+func decimal128Add(xs, ys, rs []types.Decimal128) ([]types.Decimal128, error) {
+	for i, x := range xs {
+		r, err := x.Add(ys[i])
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
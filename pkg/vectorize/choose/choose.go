@@ -0,0 +1,291 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package choose
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	i8Choose         func([][]int8, []int32, []int64, []int8) []int8
+	i16Choose        func([][]int16, []int32, []int64, []int16) []int16
+	i32Choose        func([][]int32, []int32, []int64, []int32) []int32
+	i64Choose        func([][]int64, []int32, []int64, []int64) []int64
+	ui8Choose        func([][]uint8, []int32, []int64, []uint8) []uint8
+	ui16Choose       func([][]uint16, []int32, []int64, []uint16) []uint16
+	ui32Choose       func([][]uint32, []int32, []int64, []uint32) []uint32
+	ui64Choose       func([][]uint64, []int32, []int64, []uint64) []uint64
+	float32Choose    func([][]float32, []int32, []int64, []float32) []float32
+	float64Choose    func([][]float64, []int32, []int64, []float64) []float64
+	decimal64Choose  func([][]types.Decimal64, []int32, []int64, []types.Decimal64) []types.Decimal64
+	decimal128Choose func([][]types.Decimal128, []int32, []int64, []types.Decimal128) []types.Decimal128
+	dateChoose       func([][]types.Date, []int32, []int64, []types.Date) []types.Date
+	timeChoose       func([][]types.Time, []int32, []int64, []types.Time) []types.Time
+	datetimeChoose   func([][]types.Datetime, []int32, []int64, []types.Datetime) []types.Datetime
+	timestampChoose  func([][]types.Timestamp, []int32, []int64, []types.Timestamp) []types.Timestamp
+
+	bytesChoose func([]*types.Bytes, []int32, []int64, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	i8Choose = i8ChoosePure
+	i16Choose = i16ChoosePure
+	i32Choose = i32ChoosePure
+	i64Choose = i64ChoosePure
+	ui8Choose = ui8ChoosePure
+	ui16Choose = ui16ChoosePure
+	ui32Choose = ui32ChoosePure
+	ui64Choose = ui64ChoosePure
+	float32Choose = float32ChoosePure
+	float64Choose = float64ChoosePure
+	decimal64Choose = decimal64ChoosePure
+	decimal128Choose = decimal128ChoosePure
+	dateChoose = dateChoosePure
+	timeChoose = timeChoosePure
+	datetimeChoose = datetimeChoosePure
+	timestampChoose = timestampChoosePure
+
+	bytesChoose = bytesChoosePure
+}
+
+// I8Choose sets rs[i] to be the ps[i]th value of xs[ks[i]] for each i, rs[i] is
+// left unchanged if ks[i] is negative, which means a null
+func I8Choose(xs [][]int8, ks []int32, ps []int64, rs []int8) []int8 {
+	return i8Choose(xs, ks, ps, rs)
+}
+
+func I16Choose(xs [][]int16, ks []int32, ps []int64, rs []int16) []int16 {
+	return i16Choose(xs, ks, ps, rs)
+}
+
+func I32Choose(xs [][]int32, ks []int32, ps []int64, rs []int32) []int32 {
+	return i32Choose(xs, ks, ps, rs)
+}
+
+func I64Choose(xs [][]int64, ks []int32, ps []int64, rs []int64) []int64 {
+	return i64Choose(xs, ks, ps, rs)
+}
+
+func Ui8Choose(xs [][]uint8, ks []int32, ps []int64, rs []uint8) []uint8 {
+	return ui8Choose(xs, ks, ps, rs)
+}
+
+func Ui16Choose(xs [][]uint16, ks []int32, ps []int64, rs []uint16) []uint16 {
+	return ui16Choose(xs, ks, ps, rs)
+}
+
+func Ui32Choose(xs [][]uint32, ks []int32, ps []int64, rs []uint32) []uint32 {
+	return ui32Choose(xs, ks, ps, rs)
+}
+
+func Ui64Choose(xs [][]uint64, ks []int32, ps []int64, rs []uint64) []uint64 {
+	return ui64Choose(xs, ks, ps, rs)
+}
+
+func Float32Choose(xs [][]float32, ks []int32, ps []int64, rs []float32) []float32 {
+	return float32Choose(xs, ks, ps, rs)
+}
+
+func Float64Choose(xs [][]float64, ks []int32, ps []int64, rs []float64) []float64 {
+	return float64Choose(xs, ks, ps, rs)
+}
+
+func Decimal64Choose(xs [][]types.Decimal64, ks []int32, ps []int64, rs []types.Decimal64) []types.Decimal64 {
+	return decimal64Choose(xs, ks, ps, rs)
+}
+
+func Decimal128Choose(xs [][]types.Decimal128, ks []int32, ps []int64, rs []types.Decimal128) []types.Decimal128 {
+	return decimal128Choose(xs, ks, ps, rs)
+}
+
+func DateChoose(xs [][]types.Date, ks []int32, ps []int64, rs []types.Date) []types.Date {
+	return dateChoose(xs, ks, ps, rs)
+}
+
+func TimeChoose(xs [][]types.Time, ks []int32, ps []int64, rs []types.Time) []types.Time {
+	return timeChoose(xs, ks, ps, rs)
+}
+
+func DatetimeChoose(xs [][]types.Datetime, ks []int32, ps []int64, rs []types.Datetime) []types.Datetime {
+	return datetimeChoose(xs, ks, ps, rs)
+}
+
+func TimestampChoose(xs [][]types.Timestamp, ks []int32, ps []int64, rs []types.Timestamp) []types.Timestamp {
+	return timestampChoose(xs, ks, ps, rs)
+}
+
+// BytesChoose appends the ps[i]th string of xs[ks[i]] to rs for each i, an empty
+// string is appended if ks[i] is negative
+func BytesChoose(xs []*types.Bytes, ks []int32, ps []int64, rs *types.Bytes) *types.Bytes {
+	return bytesChoose(xs, ks, ps, rs)
+}
+
+func i8ChoosePure(xs [][]int8, ks []int32, ps []int64, rs []int8) []int8 {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func i16ChoosePure(xs [][]int16, ks []int32, ps []int64, rs []int16) []int16 {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func i32ChoosePure(xs [][]int32, ks []int32, ps []int64, rs []int32) []int32 {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func i64ChoosePure(xs [][]int64, ks []int32, ps []int64, rs []int64) []int64 {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func ui8ChoosePure(xs [][]uint8, ks []int32, ps []int64, rs []uint8) []uint8 {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func ui16ChoosePure(xs [][]uint16, ks []int32, ps []int64, rs []uint16) []uint16 {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func ui32ChoosePure(xs [][]uint32, ks []int32, ps []int64, rs []uint32) []uint32 {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func ui64ChoosePure(xs [][]uint64, ks []int32, ps []int64, rs []uint64) []uint64 {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func float32ChoosePure(xs [][]float32, ks []int32, ps []int64, rs []float32) []float32 {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func float64ChoosePure(xs [][]float64, ks []int32, ps []int64, rs []float64) []float64 {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func decimal64ChoosePure(xs [][]types.Decimal64, ks []int32, ps []int64, rs []types.Decimal64) []types.Decimal64 {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func decimal128ChoosePure(xs [][]types.Decimal128, ks []int32, ps []int64, rs []types.Decimal128) []types.Decimal128 {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func dateChoosePure(xs [][]types.Date, ks []int32, ps []int64, rs []types.Date) []types.Date {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func timeChoosePure(xs [][]types.Time, ks []int32, ps []int64, rs []types.Time) []types.Time {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func datetimeChoosePure(xs [][]types.Datetime, ks []int32, ps []int64, rs []types.Datetime) []types.Datetime {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func timestampChoosePure(xs [][]types.Timestamp, ks []int32, ps []int64, rs []types.Timestamp) []types.Timestamp {
+	for i, k := range ks {
+		if k >= 0 {
+			rs[i] = xs[k][ps[i]]
+		}
+	}
+	return rs
+}
+
+func bytesChoosePure(xs []*types.Bytes, ks []int32, ps []int64, rs *types.Bytes) *types.Bytes {
+	for i, k := range ks {
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		if k < 0 {
+			rs.Lengths = append(rs.Lengths, 0)
+			continue
+		}
+		data := xs[k].Get(ps[i])
+		rs.Lengths = append(rs.Lengths, uint32(len(data)))
+		rs.Data = append(rs.Data, data...)
+	}
+	return rs
+}
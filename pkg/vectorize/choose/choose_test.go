@@ -0,0 +1,45 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package choose
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestI64Choose(t *testing.T) {
+	xs := [][]int64{{1, 2, 3, 4}, {10}}
+	ks := []int32{0, 1, -1, 0}
+	ps := []int64{0, 0, 0, 3}
+	rs := I64Choose(xs, ks, ps, make([]int64, len(ks)))
+	require.Equal(t, []int64{1, 10, 0, 4}, rs)
+}
+
+func TestBytesChoose(t *testing.T) {
+	xs := []*types.Bytes{
+		{Data: []byte("abcd"), Offsets: []uint32{0, 2}, Lengths: []uint32{2, 2}},
+		{Data: []byte("x"), Offsets: []uint32{0}, Lengths: []uint32{1}},
+	}
+	ks := []int32{0, 1, -1, 0}
+	ps := []int64{1, 0, 0, 0}
+	rs := BytesChoose(xs, ks, ps, &types.Bytes{})
+	var vs []string
+	for i := range rs.Offsets {
+		vs = append(vs, string(rs.Get(int64(i))))
+	}
+	require.Equal(t, []string{"cd", "x", "", "ab"}, vs)
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concat
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// vectorize concat and concat_ws function, the constant arguments flagged
+// by cs are read from their first row.
+var (
+	concat   func([]*types.Bytes, []bool, int, *types.Bytes) *types.Bytes
+	concatWs func([]*types.Bytes, []bool, []*nulls.Nulls, int, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	concat = concatPure
+	concatWs = concatWsPure
+}
+
+// Concat appends to rs the n concatenations of the rows of xs.
+func Concat(xs []*types.Bytes, cs []bool, n int, rs *types.Bytes) *types.Bytes {
+	return concat(xs, cs, n, rs)
+}
+
+// ConcatWs appends to rs the n concatenations of the rows of xs[1:]
+// separated by xs[0], the null rows given by nsps are skipped.
+func ConcatWs(xs []*types.Bytes, cs []bool, nsps []*nulls.Nulls, n int, rs *types.Bytes) *types.Bytes {
+	return concatWs(xs, cs, nsps, n, rs)
+}
+
+func concatPure(xs []*types.Bytes, cs []bool, n int, rs *types.Bytes) *types.Bytes {
+	for i := 0; i < n; i++ {
+		o := uint32(len(rs.Data))
+		for j, x := range xs {
+			if cs[j] {
+				rs.Data = append(rs.Data, x.Get(0)...)
+			} else {
+				rs.Data = append(rs.Data, x.Get(int64(i))...)
+			}
+		}
+		rs.Offsets = append(rs.Offsets, o)
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-o)
+	}
+	return rs
+}
+
+func concatWsPure(xs []*types.Bytes, cs []bool, nsps []*nulls.Nulls, n int, rs *types.Bytes) *types.Bytes {
+	for i := 0; i < n; i++ {
+		var sep []byte
+		if cs[0] {
+			sep = xs[0].Get(0)
+		} else {
+			sep = xs[0].Get(int64(i))
+		}
+		o := uint32(len(rs.Data))
+		first := true
+		for j := 1; j < len(xs); j++ {
+			var v []byte
+			if cs[j] {
+				v = xs[j].Get(0)
+			} else {
+				if nulls.Contains(nsps[j], uint64(i)) {
+					continue
+				}
+				v = xs[j].Get(int64(i))
+			}
+			if !first {
+				rs.Data = append(rs.Data, sep...)
+			}
+			rs.Data = append(rs.Data, v...)
+			first = false
+		}
+		rs.Offsets = append(rs.Offsets, o)
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-o)
+	}
+	return rs
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dateadd
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/extract"
+)
+
+// vectorize DATE_ADD and DATE_SUB function, DATE_SUB adds the negative intervals.
+var (
+	dateAdd func([]types.Datetime, []int64, string, []types.Datetime) ([]types.Datetime, error)
+)
+
+func init() {
+	dateAdd = dateAddPure
+}
+
+// DateAdd adds ns intervals of the unit to xs, the day is clipped to the last day
+// of the month if the result month is shorter.
+func DateAdd(xs []types.Datetime, ns []int64, unit string, rs []types.Datetime) ([]types.Datetime, error) {
+	return dateAdd(xs, ns, unit, rs)
+}
+
+func dateAddPure(xs []types.Datetime, ns []int64, unit string, rs []types.Datetime) ([]types.Datetime, error) {
+	unit, err := extract.CheckUnit(unit)
+	if err != nil {
+		return nil, err
+	}
+	for i, x := range xs {
+		rs[i] = Add(x, ns[i], unit)
+	}
+	return rs, nil
+}
+
+// Add adds n intervals of a known unit to x.
+func Add(x types.Datetime, n int64, unit string) types.Datetime {
+	t := x.GoTime()
+	switch unit {
+	case extract.Microsecond:
+		return types.FromGoTime(t.Add(time.Duration(n) * time.Microsecond))
+	case extract.Second:
+		return types.FromGoTime(t.Add(time.Duration(n) * time.Second))
+	case extract.Minute:
+		return types.FromGoTime(t.Add(time.Duration(n) * time.Minute))
+	case extract.Hour:
+		return types.FromGoTime(t.Add(time.Duration(n) * time.Hour))
+	case extract.Day:
+		return types.FromGoTime(t.AddDate(0, 0, int(n)))
+	case extract.Week:
+		return types.FromGoTime(t.AddDate(0, 0, 7*int(n)))
+	case extract.Quarter:
+		n *= 3
+	case extract.Year:
+		n *= 12
+	}
+	months := int64(t.Year())*12 + int64(t.Month()) - 1 + n
+	year, month := int(months/12), time.Month(months%12+1)
+	day := t.Day()
+	// the day 0 of the next month is the last day of this month
+	if last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > last {
+		day = last
+	}
+	return types.FromGoTime(time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC))
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dateadd
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateAdd(t *testing.T) {
+	kases := []struct {
+		x    string
+		n    int64
+		unit string
+		want string
+	}{
+		{"2021-01-31 10:00:00", 1, "month", "2021-02-28 10:00:00"},
+		{"2020-02-29 00:00:00", 1, "year", "2021-02-28 00:00:00"},
+		{"2021-11-30 23:59:59", 2, "quarter", "2022-05-30 23:59:59"},
+		{"2021-01-01 00:00:00", -1, "day", "2020-12-31 00:00:00"},
+		{"2021-01-01 00:00:00", 2, "week", "2021-01-15 00:00:00"},
+		{"2021-12-31 23:59:59", 1, "SECOND", "2022-01-01 00:00:00"},
+		{"2021-12-31 23:00:00", -90, "minute", "2021-12-31 21:30:00"},
+		{"2021-03-01 00:00:00", -1, "month", "2021-02-01 00:00:00"},
+	}
+	for _, kase := range kases {
+		x, err := types.ParseDatetime(kase.x)
+		require.NoError(t, err)
+		rs, err := DateAdd([]types.Datetime{x}, []int64{kase.n}, kase.unit, make([]types.Datetime, 1))
+		require.NoError(t, err)
+		require.Equal(t, kase.want, rs[0].String(), kase.x)
+	}
+	_, err := DateAdd([]types.Datetime{0}, []int64{1}, "decade", make([]types.Datetime, 1))
+	require.Error(t, err)
+}
@@ -0,0 +1,38 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datediff
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+// vectorize DATEDIFF function
+var (
+	dateDiff func([]types.Date, []types.Date, []int64) []int64
+)
+
+func init() {
+	dateDiff = dateDiffPure
+}
+
+// DateDiff returns the days from ys to xs.
+func DateDiff(xs, ys []types.Date, rs []int64) []int64 {
+	return dateDiff(xs, ys, rs)
+}
+
+func dateDiffPure(xs, ys []types.Date, rs []int64) []int64 {
+	for i, x := range xs {
+		rs[i] = int64(x) - int64(ys[i])
+	}
+	return rs
+}
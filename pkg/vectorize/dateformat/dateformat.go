@@ -0,0 +1,310 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dateformat
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/extract"
+)
+
+// vectorize DATE_FORMAT and STR_TO_DATE function, the format specifiers are
+// the ones of mysql.
+var (
+	dateFormat func([]types.Datetime, string, *types.Bytes) *types.Bytes
+	strToDate  func(*types.Bytes, string, *nulls.Nulls, []types.Datetime) []types.Datetime
+)
+
+func init() {
+	dateFormat = dateFormatPure
+	strToDate = strToDatePure
+}
+
+// DateFormat appends to rs xs formatted in the format.
+func DateFormat(xs []types.Datetime, format string, rs *types.Bytes) *types.Bytes {
+	return dateFormat(xs, format, rs)
+}
+
+// StrToDate parses xs in the format, the rows which can not be parsed are
+// added into nsp.
+func StrToDate(xs *types.Bytes, format string, nsp *nulls.Nulls, rs []types.Datetime) []types.Datetime {
+	return strToDate(xs, format, nsp, rs)
+}
+
+func dateFormatPure(xs []types.Datetime, format string, rs *types.Bytes) *types.Bytes {
+	var buf strings.Builder
+
+	for _, x := range xs {
+		buf.Reset()
+		Format(&buf, x, format)
+		o := uint32(len(rs.Data))
+		rs.Data = append(rs.Data, buf.String()...)
+		rs.Offsets = append(rs.Offsets, o)
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-o)
+	}
+	return rs
+}
+
+func strToDatePure(xs *types.Bytes, format string, nsp *nulls.Nulls, rs []types.Datetime) []types.Datetime {
+	for i := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		r, ok := Parse(string(xs.Get(int64(i))), format)
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		rs[i] = r
+	}
+	return rs
+}
+
+// Format writes x formatted in the format into buf.
+func Format(buf *strings.Builder, x types.Datetime, format string) {
+	t := x.GoTime()
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			buf.WriteByte(format[i])
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'a':
+			buf.WriteString(t.Weekday().String()[:3])
+		case 'b':
+			buf.WriteString(t.Month().String()[:3])
+		case 'c':
+			buf.WriteString(strconv.Itoa(int(t.Month())))
+		case 'D':
+			buf.WriteString(strconv.Itoa(t.Day()))
+			buf.WriteString(ordinalSuffix(t.Day()))
+		case 'd':
+			fmt.Fprintf(buf, "%02d", t.Day())
+		case 'e':
+			buf.WriteString(strconv.Itoa(t.Day()))
+		case 'f':
+			fmt.Fprintf(buf, "%06d", t.Nanosecond()/1000)
+		case 'H':
+			fmt.Fprintf(buf, "%02d", t.Hour())
+		case 'h', 'I':
+			fmt.Fprintf(buf, "%02d", hour12(t.Hour()))
+		case 'i':
+			fmt.Fprintf(buf, "%02d", t.Minute())
+		case 'j':
+			fmt.Fprintf(buf, "%03d", t.YearDay())
+		case 'k':
+			buf.WriteString(strconv.Itoa(t.Hour()))
+		case 'l':
+			buf.WriteString(strconv.Itoa(hour12(t.Hour())))
+		case 'M':
+			buf.WriteString(t.Month().String())
+		case 'm':
+			fmt.Fprintf(buf, "%02d", int(t.Month()))
+		case 'p':
+			buf.WriteString(meridiem(t.Hour()))
+		case 'r':
+			fmt.Fprintf(buf, "%02d:%02d:%02d %s", hour12(t.Hour()), t.Minute(), t.Second(), meridiem(t.Hour()))
+		case 'S', 's':
+			fmt.Fprintf(buf, "%02d", t.Second())
+		case 'T':
+			fmt.Fprintf(buf, "%02d:%02d:%02d", t.Hour(), t.Minute(), t.Second())
+		case 'U':
+			fmt.Fprintf(buf, "%02d", extract.Part(extract.Week, x))
+		case 'W':
+			buf.WriteString(t.Weekday().String())
+		case 'w':
+			buf.WriteString(strconv.Itoa(int(t.Weekday())))
+		case 'Y':
+			fmt.Fprintf(buf, "%04d", t.Year())
+		case 'y':
+			fmt.Fprintf(buf, "%02d", t.Year()%100)
+		default:
+			buf.WriteByte(format[i])
+		}
+	}
+}
+
+// Parse parses s in the format, the fields missing in the format are zero.
+func Parse(s, format string) (types.Datetime, bool) {
+	var ok, pm, hasMeridiem bool
+
+	year, month, day := 0, 0, 0
+	hour, min, sec, usec := 0, 0, 0, 0
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			if format[i] == ' ' {
+				s = strings.TrimLeft(s, " ")
+				continue
+			}
+			if len(s) == 0 || s[0] != format[i] {
+				return 0, false
+			}
+			s = s[1:]
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'Y':
+			if year, s, ok = number(s, 4); !ok {
+				return 0, false
+			}
+		case 'y':
+			if year, s, ok = number(s, 2); !ok {
+				return 0, false
+			}
+			// the same as mysql, 00-69 are 2000-2069 and 70-99 are 1970-1999
+			if year < 70 {
+				year += 2000
+			} else {
+				year += 1900
+			}
+		case 'm', 'c':
+			if month, s, ok = number(s, 2); !ok {
+				return 0, false
+			}
+		case 'd', 'e':
+			if day, s, ok = number(s, 2); !ok {
+				return 0, false
+			}
+		case 'H', 'k', 'h', 'I', 'l':
+			if hour, s, ok = number(s, 2); !ok {
+				return 0, false
+			}
+		case 'i':
+			if min, s, ok = number(s, 2); !ok {
+				return 0, false
+			}
+		case 'S', 's':
+			if sec, s, ok = number(s, 2); !ok {
+				return 0, false
+			}
+		case 'f':
+			var n int
+			digits := len(s)
+			if usec, s, ok = number(s, 6); !ok {
+				return 0, false
+			}
+			for n = digits - len(s); n < 6; n++ {
+				usec *= 10
+			}
+		case 'T':
+			if hour, s, ok = number(s, 2); !ok || len(s) == 0 || s[0] != ':' {
+				return 0, false
+			}
+			if min, s, ok = number(s[1:], 2); !ok || len(s) == 0 || s[0] != ':' {
+				return 0, false
+			}
+			if sec, s, ok = number(s[1:], 2); !ok {
+				return 0, false
+			}
+		case 'p':
+			if len(s) < 2 {
+				return 0, false
+			}
+			switch strings.ToUpper(s[:2]) {
+			case "AM":
+			case "PM":
+				pm = true
+			default:
+				return 0, false
+			}
+			hasMeridiem = true
+			s = s[2:]
+		case 'M', 'b':
+			if month, s, ok = monthName(s, format[i] == 'b'); !ok {
+				return 0, false
+			}
+		default:
+			if len(s) == 0 || s[0] != format[i] {
+				return 0, false
+			}
+			s = s[1:]
+		}
+	}
+	if hasMeridiem {
+		if hour < 1 || hour > 12 {
+			return 0, false
+		}
+		hour %= 12
+		if pm {
+			hour += 12
+		}
+	}
+	if month < 1 || month > 12 || day < 1 || day > 31 || hour > 23 || min > 59 || sec > 59 {
+		return 0, false
+	}
+	t := time.Date(year, time.Month(month), day, hour, min, sec, usec*1000, time.UTC)
+	if t.Day() != day {
+		return 0, false
+	}
+	return types.FromGoTime(t), true
+}
+
+// number parses at most n digits at the beginning of s
+func number(s string, n int) (int, string, bool) {
+	i, v := 0, 0
+	for ; i < n && i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+		v = v*10 + int(s[i]-'0')
+	}
+	return v, s[i:], i > 0
+}
+
+func monthName(s string, abbr bool) (int, string, bool) {
+	for m := time.January; m <= time.December; m++ {
+		name := m.String()
+		if abbr {
+			name = name[:3]
+		}
+		if len(s) >= len(name) && strings.EqualFold(s[:len(name)], name) {
+			return int(m), s[len(name):], true
+		}
+	}
+	return 0, s, false
+}
+
+func hour12(hour int) int {
+	if hour%12 == 0 {
+		return 12
+	}
+	return hour % 12
+}
+
+func meridiem(hour int) string {
+	if hour < 12 {
+		return "AM"
+	}
+	return "PM"
+}
+
+func ordinalSuffix(day int) string {
+	if day >= 11 && day <= 13 {
+		return "th"
+	}
+	switch day % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dateformat
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateFormat(t *testing.T) {
+	x, err := types.ParseDatetime("2021-11-03 15:04:05.000123")
+	require.NoError(t, err)
+	kases := []struct {
+		format string
+		want   string
+	}{
+		{"%Y-%m-%d %H:%i:%s", "2021-11-03 15:04:05"},
+		{"%W %M %D %y", "Wednesday November 3rd 21"},
+		{"%a %b %e %c %j", "Wed Nov 3 11 307"},
+		{"%r %T.%f", "03:04:05 PM 15:04:05.000123"},
+		{"%h %l %k %p %w %U %% %x", "03 3 15 PM 3 44 % x"},
+	}
+	for _, kase := range kases {
+		rs := DateFormat([]types.Datetime{x}, kase.format, &types.Bytes{})
+		require.Equal(t, kase.want, string(rs.Get(0)), kase.format)
+	}
+}
+
+func TestStrToDate(t *testing.T) {
+	kases := []struct {
+		s, format string
+		want      string
+		ok        bool
+	}{
+		{"2021-11-03 15:04:05", "%Y-%m-%d %H:%i:%s", "2021-11-03 15:04:05", true},
+		{"3/11/21 3:04 pm", "%d/%m/%y %h:%i %p", "2021-11-03 15:04:00", true},
+		{"Nov 3 2021", "%b %e %Y", "2021-11-03 00:00:00", true},
+		{"1999-02-31", "%Y-%m-%d", "", false},
+		{"2021-13-01", "%Y-%m-%d", "", false},
+		{"20211103", "%Y%m%d", "2021-11-03 00:00:00", true},
+	}
+	for _, kase := range kases {
+		x, ok := Parse(kase.s, kase.format)
+		require.Equal(t, kase.ok, ok, kase.s)
+		if ok {
+			require.Equal(t, kase.want, x.String(), kase.s)
+		}
+	}
+	xs := &types.Bytes{Data: []byte("2021-11-03bad"), Offsets: []uint32{0, 10}, Lengths: []uint32{10, 3}}
+	nsp := new(nulls.Nulls)
+	rs := StrToDate(xs, "%Y-%m-%d", nsp, make([]types.Datetime, 2))
+	require.Equal(t, "2021-11-03 00:00:00", rs[0].String())
+	require.True(t, nulls.Contains(nsp, 1))
+	require.False(t, nulls.Contains(nsp, 0))
+}
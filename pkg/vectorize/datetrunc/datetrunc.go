@@ -0,0 +1,73 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datetrunc
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/extract"
+)
+
+// vectorize DATE_TRUNC function
+var (
+	dateTrunc func(string, []types.Datetime, []types.Datetime) ([]types.Datetime, error)
+)
+
+func init() {
+	dateTrunc = dateTruncPure
+}
+
+// DateTrunc truncates xs to the precision of the unit, a week starts on Monday.
+func DateTrunc(unit string, xs []types.Datetime, rs []types.Datetime) ([]types.Datetime, error) {
+	return dateTrunc(unit, xs, rs)
+}
+
+func dateTruncPure(unit string, xs []types.Datetime, rs []types.Datetime) ([]types.Datetime, error) {
+	unit, err := extract.CheckUnit(unit)
+	if err != nil {
+		return nil, err
+	}
+	for i, x := range xs {
+		rs[i] = Trunc(unit, x)
+	}
+	return rs, nil
+}
+
+// Trunc truncates x to the precision of a known unit.
+func Trunc(unit string, x types.Datetime) types.Datetime {
+	t := x.GoTime()
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	switch unit {
+	case extract.Microsecond:
+		return x
+	case extract.Second:
+		return types.FromGoTime(time.Date(year, month, day, hour, min, sec, 0, time.UTC))
+	case extract.Minute:
+		return types.FromGoTime(time.Date(year, month, day, hour, min, 0, 0, time.UTC))
+	case extract.Hour:
+		return types.FromGoTime(time.Date(year, month, day, hour, 0, 0, 0, time.UTC))
+	case extract.Day:
+		return types.FromGoTime(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+	case extract.Week:
+		return types.FromGoTime(time.Date(year, month, day-(int(t.Weekday())+6)%7, 0, 0, 0, 0, time.UTC))
+	case extract.Month:
+		return types.FromGoTime(time.Date(year, month, 1, 0, 0, 0, 0, time.UTC))
+	case extract.Quarter:
+		return types.FromGoTime(time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, time.UTC))
+	}
+	return types.FromGoTime(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC))
+}
@@ -0,0 +1,48 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datetrunc
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateTrunc(t *testing.T) {
+	x, err := types.ParseDatetime("2021-11-18 13:45:06.123")
+	require.NoError(t, err)
+	kases := []struct {
+		unit string
+		want string
+	}{
+		{"second", "2021-11-18 13:45:06"},
+		{"minute", "2021-11-18 13:45:00"},
+		{"hour", "2021-11-18 13:00:00"},
+		{"day", "2021-11-18 00:00:00"},
+		{"week", "2021-11-15 00:00:00"},
+		{"month", "2021-11-01 00:00:00"},
+		{"quarter", "2021-10-01 00:00:00"},
+		{"YEAR", "2021-01-01 00:00:00"},
+	}
+	for _, kase := range kases {
+		rs, err := DateTrunc(kase.unit, []types.Datetime{x}, make([]types.Datetime, 1))
+		require.NoError(t, err)
+		require.Equal(t, kase.want, rs[0].String(), kase.unit)
+	}
+	rs, err := DateTrunc("second", []types.Datetime{x}, make([]types.Datetime, 1))
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), rs[0].Microsec())
+}
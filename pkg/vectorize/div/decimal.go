@@ -0,0 +1,41 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package div
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+var (
+	Decimal128Div func([]types.Decimal128, []types.Decimal128, []types.Decimal128, int32) ([]types.Decimal128, error)
+)
+
+func init() {
+	Decimal128Div = decimal128Div
+}
+
+// decimal128Div returns xs * 10^n / ys rounded half away from zero,
+// so the scale of the quotients is scale(xs) + n - scale(ys). ys must not contain zero.
+func decimal128Div(xs, ys, rs []types.Decimal128, n int32) ([]types.Decimal128, error) {
+	for i, x := range xs {
+		r, err := x.Div(ys[i], n)
+		if err != nil {
+			return nil, err
+		}
+		if err = types.CheckDecimal128Width(r, types.MaxDecimal128Precision); err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
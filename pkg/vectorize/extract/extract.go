@@ -0,0 +1,129 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extract
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// the units of extract, DATE_ADD, DATE_SUB and DATE_TRUNC
+const (
+	Microsecond = "microsecond"
+	Second      = "second"
+	Minute      = "minute"
+	Hour        = "hour"
+	Day         = "day"
+	Week        = "week"
+	Month       = "month"
+	Quarter     = "quarter"
+	Year        = "year"
+)
+
+// ErrUnit is reported for an unknown unit
+var ErrUnit = errors.New("unknown time unit")
+
+// vectorize extract function, and the month, day, hour, minute, second and
+// weekday functions which are the shortcuts of it.
+var (
+	extract func(string, []types.Datetime, []int64) ([]int64, error)
+	weekday func([]types.Datetime, []int64) []int64
+)
+
+func init() {
+	extract = extractPure
+	weekday = weekdayPure
+}
+
+// CheckUnit returns the unit in lower case, or an error if it is unknown.
+func CheckUnit(unit string) (string, error) {
+	switch u := strings.ToLower(unit); u {
+	case Microsecond, Second, Minute, Hour, Day, Week, Month, Quarter, Year:
+		return u, nil
+	}
+	return "", fmt.Errorf("%w '%s'", ErrUnit, unit)
+}
+
+// Extract returns the part of the unit of xs.
+func Extract(unit string, xs []types.Datetime, rs []int64) ([]int64, error) {
+	return extract(unit, xs, rs)
+}
+
+// Weekday returns the weekday indexes of xs, 0 is Monday and 6 is Sunday.
+func Weekday(xs []types.Datetime, rs []int64) []int64 {
+	return weekday(xs, rs)
+}
+
+func extractPure(unit string, xs []types.Datetime, rs []int64) ([]int64, error) {
+	unit, err := CheckUnit(unit)
+	if err != nil {
+		return nil, err
+	}
+	for i, x := range xs {
+		rs[i] = Part(unit, x)
+	}
+	return rs, nil
+}
+
+func weekdayPure(xs []types.Datetime, rs []int64) []int64 {
+	for i, x := range xs {
+		rs[i] = (int64(x.ToDate().DayOfWeek()) + 6) % 7
+	}
+	return rs
+}
+
+// Part returns the part of x of a known unit.
+func Part(unit string, x types.Datetime) int64 {
+	switch unit {
+	case Microsecond:
+		return int64(x.Microsec())
+	case Second, Minute, Hour:
+		hour, min, sec := x.Clock()
+		switch unit {
+		case Second:
+			return int64(sec)
+		case Minute:
+			return int64(min)
+		}
+		return int64(hour)
+	case Week:
+		return week(x.ToDate())
+	}
+	y, m, d, _ := x.ToDate().Calendar(true)
+	switch unit {
+	case Day:
+		return int64(d)
+	case Month:
+		return int64(m)
+	case Quarter:
+		return int64(m+2) / 3
+	}
+	return int64(y)
+}
+
+// week returns the week of d in the mode 0 of mysql, weeks start with Sunday
+// and the days before the first Sunday of a year are in the week 0.
+func week(d types.Date) int64 {
+	yday := int64(d.DayOfYear())
+	jan1 := types.Date(int64(d) - yday + 1).DayOfWeek()
+	firstSunday := 1 + (7-int64(jan1))%7
+	if yday < firstSunday {
+		return 0
+	}
+	return (yday-firstSunday)/7 + 1
+}
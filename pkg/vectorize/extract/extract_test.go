@@ -0,0 +1,51 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extract
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtract(t *testing.T) {
+	x, err := types.ParseDatetime("2021-11-07 13:45:06.000789")
+	require.NoError(t, err)
+	xs := []types.Datetime{x}
+	rs := make([]int64, 1)
+	kases := []struct {
+		unit string
+		want int64
+	}{
+		{"microsecond", 789},
+		{"second", 6},
+		{"MINUTE", 45},
+		{"hour", 13},
+		{"day", 7},
+		{"week", 45},
+		{"month", 11},
+		{"quarter", 4},
+		{"year", 2021},
+	}
+	for _, kase := range kases {
+		_, err := Extract(kase.unit, xs, rs)
+		require.NoError(t, err)
+		require.Equal(t, kase.want, rs[0], kase.unit)
+	}
+	_, err = Extract("fortnight", xs, rs)
+	require.Error(t, err)
+	require.Equal(t, []int64{6}, Weekday(xs, rs))
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gt
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+
+	roaring "github.com/RoaringBitmap/roaring/roaring64"
+)
+
+var (
+	Decimal128Gt         func([]types.Decimal128, []types.Decimal128, []int64) []int64
+	Decimal128GtNullable func([]types.Decimal128, []types.Decimal128, *roaring.Bitmap, []int64) []int64
+)
+
+func init() {
+	Decimal128Gt = decimal128Gt
+	Decimal128GtNullable = decimal128GtNullable
+}
+
+// decimal128Gt returns the rows whose x is greater than y, xs and ys must have the same scale.
+func decimal128Gt(xs, ys []types.Decimal128, rs []int64) []int64 {
+	rsi := 0
+	for i, x := range xs {
+		if x.Compare(ys[i]) > 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
+
+func decimal128GtNullable(xs, ys []types.Decimal128, nulls *roaring.Bitmap, rs []int64) []int64 {
+	rsi := 0
+	for i, x := range xs {
+		if nulls.Contains(uint64(i)) {
+			continue
+		}
+		if x.Compare(ys[i]) > 0 {
+			rs[rsi] = int64(i)
+			rsi++
+		}
+	}
+	return rs[:rsi]
+}
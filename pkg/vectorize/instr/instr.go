@@ -0,0 +1,120 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instr
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// vectorize instr and locate function, the positions are counted in
+// characters from 1 and 0 means not found.
+var (
+	instr       func(*types.Bytes, []byte, []int64) []int64
+	instrSlice  func(*types.Bytes, *types.Bytes, []int64) []int64
+	locate      func([]byte, *types.Bytes, int64, []int64) []int64
+	locateSlice func(*types.Bytes, *types.Bytes, []int64, []int64) []int64
+)
+
+func init() {
+	instr = instrPure
+	instrSlice = instrSlicePure
+	locate = locatePure
+	locateSlice = locateSlicePure
+}
+
+// Instr returns the positions of the first occurrence of sub in the strings
+// of xs.
+func Instr(xs *types.Bytes, sub []byte, rs []int64) []int64 {
+	return instr(xs, sub, rs)
+}
+
+// InstrSlice is Instr with a sub per row.
+func InstrSlice(xs, subs *types.Bytes, rs []int64) []int64 {
+	return instrSlice(xs, subs, rs)
+}
+
+// Locate returns the positions of the first occurrence of sub in the
+// strings of xs from the pos character.
+func Locate(sub []byte, xs *types.Bytes, pos int64, rs []int64) []int64 {
+	return locate(sub, xs, pos, rs)
+}
+
+// LocateSlice is Locate with a sub and a pos per row.
+func LocateSlice(subs, xs *types.Bytes, poss []int64, rs []int64) []int64 {
+	return locateSlice(subs, xs, poss, rs)
+}
+
+func instrPure(xs *types.Bytes, sub []byte, rs []int64) []int64 {
+	for i := range xs.Offsets {
+		rs[i] = position(sub, xs.Get(int64(i)), 1)
+	}
+	return rs
+}
+
+func instrSlicePure(xs, subs *types.Bytes, rs []int64) []int64 {
+	for i := range xs.Offsets {
+		rs[i] = position(subs.Get(int64(i)), xs.Get(int64(i)), 1)
+	}
+	return rs
+}
+
+func locatePure(sub []byte, xs *types.Bytes, pos int64, rs []int64) []int64 {
+	for i := range xs.Offsets {
+		rs[i] = position(sub, xs.Get(int64(i)), pos)
+	}
+	return rs
+}
+
+func locateSlicePure(subs, xs *types.Bytes, poss []int64, rs []int64) []int64 {
+	for i := range xs.Offsets {
+		rs[i] = position(subs.Get(int64(i)), xs.Get(int64(i)), poss[i])
+	}
+	return rs
+}
+
+func position(sub, s []byte, pos int64) int64 {
+	if pos < 1 {
+		return 0
+	}
+	off := charOffset(s, pos-1)
+	if off == len(s) && int64(utf8.RuneCount(s)) < pos-1 {
+		return 0
+	}
+	if len(sub) == 0 {
+		return pos
+	}
+	j := bytes.Index(s[off:], sub)
+	if j < 0 {
+		return 0
+	}
+	return pos + int64(utf8.RuneCount(s[off:off+j]))
+}
+
+// charOffset returns the offset of the k-th character of s.
+func charOffset(s []byte, k int64) int {
+	off := 0
+	for ; k > 0 && off < len(s); k-- {
+		if s[off] < utf8.RuneSelf {
+			off++
+		} else {
+			_, w := utf8.DecodeRune(s[off:])
+			off += w
+		}
+	}
+	return off
+}
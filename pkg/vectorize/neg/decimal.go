@@ -0,0 +1,41 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neg
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+var (
+	Decimal64Neg  func([]types.Decimal64, []types.Decimal64) []types.Decimal64
+	Decimal128Neg func([]types.Decimal128, []types.Decimal128) []types.Decimal128
+)
+
+func init() {
+	Decimal64Neg = decimal64Neg
+	Decimal128Neg = decimal128Neg
+}
+
+func decimal64Neg(xs, rs []types.Decimal64) []types.Decimal64 {
+	for i, x := range xs {
+		rs[i] = -x
+	}
+	return rs
+}
+
+func decimal128Neg(xs, rs []types.Decimal128) []types.Decimal128 {
+	for i, x := range xs {
+		rs[i] = x.Neg()
+	}
+	return rs
+}
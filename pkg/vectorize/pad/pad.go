@@ -0,0 +1,160 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pad
+
+import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// MaxLength is the maximum number of characters of a padded string, the
+// longer ones are null like the results over max_allowed_packet in MySQL.
+const MaxLength = 64 << 20
+
+// PadResult is the padded strings and the rows which are null because of
+// a negative length or an empty pad.
+type PadResult struct {
+	Result *types.Bytes
+	Nsp    *nulls.Nulls
+}
+
+// vectorize lpad and rpad function, the lengths are counted in characters.
+var (
+	lpad      func(*types.Bytes, int64, []byte, *types.Bytes) PadResult
+	lpadSlice func(*types.Bytes, []int64, *types.Bytes, *types.Bytes) PadResult
+	rpad      func(*types.Bytes, int64, []byte, *types.Bytes) PadResult
+	rpadSlice func(*types.Bytes, []int64, *types.Bytes, *types.Bytes) PadResult
+)
+
+func init() {
+	lpad = lpadPure
+	lpadSlice = lpadSlicePure
+	rpad = rpadPure
+	rpadSlice = rpadSlicePure
+}
+
+// Lpad appends to rs the strings of xs left-padded with pad to n characters,
+// the strings longer than n characters are cut to their n first ones.
+func Lpad(xs *types.Bytes, n int64, pad []byte, rs *types.Bytes) PadResult {
+	return lpad(xs, n, pad, rs)
+}
+
+// LpadSlice is Lpad with a length and a pad per row.
+func LpadSlice(xs *types.Bytes, ns []int64, pads *types.Bytes, rs *types.Bytes) PadResult {
+	return lpadSlice(xs, ns, pads, rs)
+}
+
+// Rpad appends to rs the strings of xs right-padded with pad to n characters,
+// the strings longer than n characters are cut to their n first ones.
+func Rpad(xs *types.Bytes, n int64, pad []byte, rs *types.Bytes) PadResult {
+	return rpad(xs, n, pad, rs)
+}
+
+// RpadSlice is Rpad with a length and a pad per row.
+func RpadSlice(xs *types.Bytes, ns []int64, pads *types.Bytes, rs *types.Bytes) PadResult {
+	return rpadSlice(xs, ns, pads, rs)
+}
+
+func lpadPure(xs *types.Bytes, n int64, pad []byte, rs *types.Bytes) PadResult {
+	result := PadResult{Result: rs, Nsp: new(nulls.Nulls)}
+	for i := range xs.Offsets {
+		if !appendPad(rs, xs.Get(int64(i)), n, pad, true) {
+			nulls.Add(result.Nsp, uint64(i))
+		}
+	}
+	return result
+}
+
+func lpadSlicePure(xs *types.Bytes, ns []int64, pads *types.Bytes, rs *types.Bytes) PadResult {
+	result := PadResult{Result: rs, Nsp: new(nulls.Nulls)}
+	for i := range xs.Offsets {
+		if !appendPad(rs, xs.Get(int64(i)), ns[i], pads.Get(int64(i)), true) {
+			nulls.Add(result.Nsp, uint64(i))
+		}
+	}
+	return result
+}
+
+func rpadPure(xs *types.Bytes, n int64, pad []byte, rs *types.Bytes) PadResult {
+	result := PadResult{Result: rs, Nsp: new(nulls.Nulls)}
+	for i := range xs.Offsets {
+		if !appendPad(rs, xs.Get(int64(i)), n, pad, false) {
+			nulls.Add(result.Nsp, uint64(i))
+		}
+	}
+	return result
+}
+
+func rpadSlicePure(xs *types.Bytes, ns []int64, pads *types.Bytes, rs *types.Bytes) PadResult {
+	result := PadResult{Result: rs, Nsp: new(nulls.Nulls)}
+	for i := range xs.Offsets {
+		if !appendPad(rs, xs.Get(int64(i)), ns[i], pads.Get(int64(i)), false) {
+			nulls.Add(result.Nsp, uint64(i))
+		}
+	}
+	return result
+}
+
+// appendPad appends the padded s to rs, it appends an empty string and
+// returns false if the result is null.
+func appendPad(rs *types.Bytes, s []byte, n int64, pad []byte, isLeft bool) bool {
+	o := uint32(len(rs.Data))
+	ok := padString(rs, s, n, pad, isLeft)
+	rs.Offsets = append(rs.Offsets, o)
+	rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-o)
+	return ok
+}
+
+func padString(rs *types.Bytes, s []byte, n int64, pad []byte, isLeft bool) bool {
+	if n < 0 || n > MaxLength {
+		return false
+	}
+	cnt := int64(utf8.RuneCount(s))
+	if n <= cnt {
+		rs.Data = append(rs.Data, s[:charOffset(s, n)]...)
+		return true
+	}
+	if len(pad) == 0 {
+		return false
+	}
+	if !isLeft {
+		rs.Data = append(rs.Data, s...)
+	}
+	need, padCnt := n-cnt, int64(utf8.RuneCount(pad))
+	for ; need >= padCnt; need -= padCnt {
+		rs.Data = append(rs.Data, pad...)
+	}
+	rs.Data = append(rs.Data, pad[:charOffset(pad, need)]...)
+	if isLeft {
+		rs.Data = append(rs.Data, s...)
+	}
+	return true
+}
+
+// charOffset returns the offset of the k-th character of s.
+func charOffset(s []byte, k int64) int {
+	off := 0
+	for ; k > 0 && off < len(s); k-- {
+		if s[off] < utf8.RuneSelf {
+			off++
+		} else {
+			_, w := utf8.DecodeRune(s[off:])
+			off += w
+		}
+	}
+	return off
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package pad
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func toStrings(xs *types.Bytes) []string {
+	rs := make([]string, len(xs.Offsets))
+	for i := range rs {
+		rs[i] = string(xs.Get(int64(i)))
+	}
+	return rs
+}
+
+func TestLpad(t *testing.T) {
+	xs := testutil.MakeBytes([]string{"hi", "", "matrixone", "数据"})
+	r := Lpad(xs, 5, []byte("ab"), &types.Bytes{})
+	require.Equal(t, []string{"abahi", "ababa", "matri", "aba数据"}, toStrings(r.Result))
+	require.False(t, nulls.Any(r.Nsp))
+
+	r = Lpad(xs, -1, []byte("ab"), &types.Bytes{})
+	require.Equal(t, []string{"", "", "", ""}, toStrings(r.Result))
+	require.Equal(t, 4, nulls.Length(r.Nsp))
+
+	r = Lpad(xs, 3, nil, &types.Bytes{})
+	require.Equal(t, []string{"", "", "mat", ""}, toStrings(r.Result))
+	require.True(t, nulls.Contains(r.Nsp, 0))
+	require.False(t, nulls.Contains(r.Nsp, 2))
+}
+
+func TestRpad(t *testing.T) {
+	xs := testutil.MakeBytes([]string{"hi", "数据"})
+	r := Rpad(xs, 5, []byte("库"), &types.Bytes{})
+	require.Equal(t, []string{"hi库库库", "数据库库库"}, toStrings(r.Result))
+
+	r = RpadSlice(xs, []int64{1, 4}, testutil.MakeBytes([]string{"x", "yz"}), &types.Bytes{})
+	require.Equal(t, []string{"h", "数据yz"}, toStrings(r.Result))
+	require.False(t, nulls.Any(r.Nsp))
+}
@@ -0,0 +1,138 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regexp
+
+import (
+	"regexp"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// vectorize regexp_like and regexp_replace function, the patterns use the
+// RE2 syntax.
+var (
+	like         func(*types.Bytes, []byte, *nulls.Nulls, []int64) ([]int64, error)
+	likeSlice    func(*types.Bytes, *types.Bytes, *nulls.Nulls, []int64) ([]int64, error)
+	replace      func(*types.Bytes, []byte, []byte, *types.Bytes) (*types.Bytes, error)
+	replaceSlice func(*types.Bytes, *types.Bytes, *types.Bytes, *types.Bytes) (*types.Bytes, error)
+)
+
+func init() {
+	like = likePure
+	likeSlice = likeSlicePure
+	replace = replacePure
+	replaceSlice = replaceSlicePure
+}
+
+// Like returns the rows of xs matching pattern, the null rows given by ns
+// never match.
+func Like(xs *types.Bytes, pattern []byte, ns *nulls.Nulls, rs []int64) ([]int64, error) {
+	return like(xs, pattern, ns, rs)
+}
+
+// LikeSlice is Like with a pattern per row.
+func LikeSlice(xs, patterns *types.Bytes, ns *nulls.Nulls, rs []int64) ([]int64, error) {
+	return likeSlice(xs, patterns, ns, rs)
+}
+
+// Replace appends to rs the strings of xs with all the matches of pattern
+// replaced by repl, $1 in repl stands for the first submatch.
+func Replace(xs *types.Bytes, pattern, repl []byte, rs *types.Bytes) (*types.Bytes, error) {
+	return replace(xs, pattern, repl, rs)
+}
+
+// ReplaceSlice is Replace with a pattern and a repl per row.
+func ReplaceSlice(xs, patterns, repls *types.Bytes, rs *types.Bytes) (*types.Bytes, error) {
+	return replaceSlice(xs, patterns, repls, rs)
+}
+
+func likePure(xs *types.Bytes, pattern []byte, ns *nulls.Nulls, rs []int64) ([]int64, error) {
+	reg, err := regexp.Compile(string(pattern))
+	if err != nil {
+		return nil, err
+	}
+	rs = rs[:0]
+	for i := range xs.Offsets {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		if reg.Match(xs.Get(int64(i))) {
+			rs = append(rs, int64(i))
+		}
+	}
+	return rs, nil
+}
+
+func likeSlicePure(xs, patterns *types.Bytes, ns *nulls.Nulls, rs []int64) ([]int64, error) {
+	regs := make(map[string]*regexp.Regexp)
+	rs = rs[:0]
+	for i := range xs.Offsets {
+		if nulls.Contains(ns, uint64(i)) {
+			continue
+		}
+		reg, err := compile(regs, patterns.Get(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+		if reg.Match(xs.Get(int64(i))) {
+			rs = append(rs, int64(i))
+		}
+	}
+	return rs, nil
+}
+
+func replacePure(xs *types.Bytes, pattern, repl []byte, rs *types.Bytes) (*types.Bytes, error) {
+	reg, err := regexp.Compile(string(pattern))
+	if err != nil {
+		return nil, err
+	}
+	for i := range xs.Offsets {
+		appendBytes(rs, reg.ReplaceAll(xs.Get(int64(i)), repl))
+	}
+	return rs, nil
+}
+
+func replaceSlicePure(xs, patterns, repls *types.Bytes, rs *types.Bytes) (*types.Bytes, error) {
+	regs := make(map[string]*regexp.Regexp)
+	for i := range xs.Offsets {
+		reg, err := compile(regs, patterns.Get(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+		appendBytes(rs, reg.ReplaceAll(xs.Get(int64(i)), repls.Get(int64(i))))
+	}
+	return rs, nil
+}
+
+// compile returns the compiled pattern, regs caches the patterns already
+// compiled.
+func compile(regs map[string]*regexp.Regexp, pattern []byte) (*regexp.Regexp, error) {
+	if reg, ok := regs[string(pattern)]; ok {
+		return reg, nil
+	}
+	reg, err := regexp.Compile(string(pattern))
+	if err != nil {
+		return nil, err
+	}
+	regs[string(pattern)] = reg
+	return reg, nil
+}
+
+func appendBytes(rs *types.Bytes, v []byte) {
+	rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+	rs.Data = append(rs.Data, v...)
+	rs.Lengths = append(rs.Lengths, uint32(len(v)))
+}
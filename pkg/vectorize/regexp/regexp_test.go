@@ -0,0 +1,52 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package regexp
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLike(t *testing.T) {
+	xs := testutil.MakeBytes([]string{"abc", "xabc", "ab", "abcd"})
+	ns := new(nulls.Nulls)
+	nulls.Add(ns, 3)
+	rs, err := Like(xs, []byte("^ab"), ns, make([]int64, 4))
+	require.NoError(t, err)
+	require.Equal(t, []int64{0, 2}, rs)
+
+	rs, err = LikeSlice(xs, testutil.MakeBytes([]string{"c$", "^a", "b", "d"}), new(nulls.Nulls), make([]int64, 4))
+	require.NoError(t, err)
+	require.Equal(t, []int64{0, 2, 3}, rs)
+
+	_, err = Like(xs, []byte("("), ns, make([]int64, 4))
+	require.Error(t, err)
+}
+
+func TestReplace(t *testing.T) {
+	xs := testutil.MakeBytes([]string{"a1b22c", "abc"})
+	rs, err := Replace(xs, []byte("[0-9]+"), []byte("#"), &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, "a#b#c", string(rs.Get(0)))
+	require.Equal(t, "abc", string(rs.Get(1)))
+
+	rs, err = ReplaceSlice(xs, testutil.MakeBytes([]string{"b", "(a)(b)"}), testutil.MakeBytes([]string{"", "${2}${1}"}), &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, "a122c", string(rs.Get(0)))
+	require.Equal(t, "bac", string(rs.Get(1)))
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replace
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// vectorize replace function
+var (
+	replace      func(*types.Bytes, []byte, []byte, *types.Bytes) *types.Bytes
+	replaceSlice func(*types.Bytes, *types.Bytes, *types.Bytes, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	replace = replacePure
+	replaceSlice = replaceSlicePure
+}
+
+// Replace appends to rs the strings of xs with all the occurrences of from
+// replaced by to.
+func Replace(xs *types.Bytes, from, to []byte, rs *types.Bytes) *types.Bytes {
+	return replace(xs, from, to, rs)
+}
+
+// ReplaceSlice is Replace with a from and a to per row.
+func ReplaceSlice(xs, froms, tos *types.Bytes, rs *types.Bytes) *types.Bytes {
+	return replaceSlice(xs, froms, tos, rs)
+}
+
+func replacePure(xs *types.Bytes, from, to []byte, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendReplace(rs, xs.Get(int64(i)), from, to)
+	}
+	return rs
+}
+
+func replaceSlicePure(xs, froms, tos *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendReplace(rs, xs.Get(int64(i)), froms.Get(int64(i)), tos.Get(int64(i)))
+	}
+	return rs
+}
+
+func appendReplace(rs *types.Bytes, s, from, to []byte) {
+	o := uint32(len(rs.Data))
+	if len(from) > 0 {
+		for {
+			j := bytes.Index(s, from)
+			if j < 0 {
+				break
+			}
+			rs.Data = append(rs.Data, s[:j]...)
+			rs.Data = append(rs.Data, to...)
+			s = s[j+len(from):]
+		}
+	}
+	rs.Data = append(rs.Data, s...)
+	rs.Offsets = append(rs.Offsets, o)
+	rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-o)
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reverse
+
+import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// vectorize reverse function
+var (
+	reverse func(*types.Bytes, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	reverse = reversePure
+}
+
+// Reverse appends to rs the strings of xs with their characters in reverse
+// order.
+func Reverse(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	return reverse(xs, rs)
+}
+
+func reversePure(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		x := xs.Get(int64(i))
+		o := uint32(len(rs.Data))
+		for j := len(x); j > 0; {
+			if x[j-1] < utf8.RuneSelf {
+				rs.Data = append(rs.Data, x[j-1])
+				j--
+				continue
+			}
+			_, w := utf8.DecodeLastRune(x[:j])
+			rs.Data = append(rs.Data, x[j-w:j]...)
+			j -= w
+		}
+		rs.Offsets = append(rs.Offsets, o)
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-o)
+	}
+	return rs
+}
@@ -32,10 +32,13 @@ var (
 	float32Shuffle func([]float32, []float32, []int64) []float32
 	float64Shuffle func([]float64, []float64, []int64) []float64
 
-	decimalShuffle func([]types.Decimal, []int64) []types.Decimal
+	decimal64Shuffle  func([]types.Decimal64, []types.Decimal64, []int64) []types.Decimal64
+	decimal128Shuffle func([]types.Decimal128, []types.Decimal128, []int64) []types.Decimal128
 
-	dateShuffle     func([]types.Date, []types.Date, []int64) []types.Date
-	datetimeShuffle func([]types.Datetime, []types.Datetime, []int64) []types.Datetime
+	dateShuffle      func([]types.Date, []types.Date, []int64) []types.Date
+	timeShuffle      func([]types.Time, []types.Time, []int64) []types.Time
+	datetimeShuffle  func([]types.Datetime, []types.Datetime, []int64) []types.Datetime
+	timestampShuffle func([]types.Timestamp, []types.Timestamp, []int64) []types.Timestamp
 
 	tupleShuffle func([][]interface{}, [][]interface{}, []int64) [][]interface{}
 
@@ -56,10 +59,13 @@ func init() {
 	float32Shuffle = float32ShufflePure
 	float64Shuffle = float64ShufflePure
 
-	decimalShuffle = decimalShufflePure
+	decimal64Shuffle = decimal64ShufflePure
+	decimal128Shuffle = decimal128ShufflePure
 
 	dateShuffle = dateShufflePure
+	timeShuffle = timeShufflePure
 	datetimeShuffle = datetimeShufflePure
+	timestampShuffle = timestampShufflePure
 
 	tupleShuffle = tupleShufflePure
 
@@ -106,18 +112,30 @@ func Float64Shuffle(vs, ws []float64, sels []int64) []float64 {
 	return float64Shuffle(vs, ws, sels)
 }
 
-func DecimalShuffle(vs []types.Decimal, sels []int64) []types.Decimal {
-	return decimalShuffle(vs, sels)
+func Decimal64Shuffle(vs, ws []types.Decimal64, sels []int64) []types.Decimal64 {
+	return decimal64Shuffle(vs, ws, sels)
+}
+
+func Decimal128Shuffle(vs, ws []types.Decimal128, sels []int64) []types.Decimal128 {
+	return decimal128Shuffle(vs, ws, sels)
 }
 
 func DateShuffle(vs []types.Date, ws []types.Date, sels []int64) []types.Date {
 	return dateShuffle(vs, ws, sels)
 }
 
+func TimeShuffle(vs []types.Time, ws []types.Time, sels []int64) []types.Time {
+	return timeShuffle(vs, ws, sels)
+}
+
 func DatetimeShuffle(vs []types.Datetime, ws []types.Datetime, sels []int64) []types.Datetime {
 	return datetimeShuffle(vs, ws, sels)
 }
 
+func TimestampShuffle(vs []types.Timestamp, ws []types.Timestamp, sels []int64) []types.Timestamp {
+	return timestampShuffle(vs, ws, sels)
+}
+
 func TupleShuffle(vs, ws [][]interface{}, sels []int64) [][]interface{} {
 	return tupleShuffle(vs, ws, sels)
 }
@@ -206,10 +224,19 @@ func float64ShufflePure(vs, ws []float64, sels []int64) []float64 {
 	return vs[:len(sels)]
 }
 
-func decimalShufflePure(vs []types.Decimal, sels []int64) []types.Decimal {
+func decimal64ShufflePure(vs, ws []types.Decimal64, sels []int64) []types.Decimal64 {
 	for i, sel := range sels {
-		vs[i] = vs[sel]
+		ws[i] = vs[sel]
 	}
+	copy(vs, ws)
+	return vs[:len(sels)]
+}
+
+func decimal128ShufflePure(vs, ws []types.Decimal128, sels []int64) []types.Decimal128 {
+	for i, sel := range sels {
+		ws[i] = vs[sel]
+	}
+	copy(vs, ws)
 	return vs[:len(sels)]
 }
 
@@ -221,6 +248,14 @@ func dateShufflePure(vs []types.Date, ws []types.Date, sels []int64) []types.Dat
 	return vs[:len(sels)]
 }
 
+func timeShufflePure(vs []types.Time, ws []types.Time, sels []int64) []types.Time {
+	for i, sel := range sels {
+		ws[i] = vs[sel]
+	}
+	copy(vs, ws)
+	return vs[:len(sels)]
+}
+
 func datetimeShufflePure(vs []types.Datetime, ws []types.Datetime, sels []int64) []types.Datetime {
 	for i, sel := range sels {
 		ws[i] = vs[sel]
@@ -229,6 +264,14 @@ func datetimeShufflePure(vs []types.Datetime, ws []types.Datetime, sels []int64)
 	return vs[:len(sels)]
 }
 
+func timestampShufflePure(vs []types.Timestamp, ws []types.Timestamp, sels []int64) []types.Timestamp {
+	for i, sel := range sels {
+		ws[i] = vs[sel]
+	}
+	copy(vs, ws)
+	return vs[:len(sels)]
+}
+
 func tupleShufflePure(vs, ws [][]interface{}, sels []int64) [][]interface{} {
 	for i, sel := range sels {
 		ws[i] = vs[sel]
@@ -0,0 +1,111 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splitpart
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// ErrFieldPosition is reported when asking for the field 0.
+var ErrFieldPosition = errors.New("field position must not be zero")
+
+// vectorize split_part function, the fields are counted from 1, and from
+// the end of the string when negative.
+var (
+	splitPart      func(*types.Bytes, []byte, int64, *types.Bytes) (*types.Bytes, error)
+	splitPartSlice func(*types.Bytes, *types.Bytes, []int64, *types.Bytes) (*types.Bytes, error)
+)
+
+func init() {
+	splitPart = splitPartPure
+	splitPartSlice = splitPartSlicePure
+}
+
+// SplitPart appends to rs the n-th field of the strings of xs split on
+// delim, a missing field is an empty string.
+func SplitPart(xs *types.Bytes, delim []byte, n int64, rs *types.Bytes) (*types.Bytes, error) {
+	return splitPart(xs, delim, n, rs)
+}
+
+// SplitPartSlice is SplitPart with a delim and a field per row.
+func SplitPartSlice(xs, delims *types.Bytes, ns []int64, rs *types.Bytes) (*types.Bytes, error) {
+	return splitPartSlice(xs, delims, ns, rs)
+}
+
+func splitPartPure(xs *types.Bytes, delim []byte, n int64, rs *types.Bytes) (*types.Bytes, error) {
+	if n == 0 {
+		return nil, ErrFieldPosition
+	}
+	for i := range xs.Offsets {
+		appendBytes(rs, field(xs.Get(int64(i)), delim, n))
+	}
+	return rs, nil
+}
+
+func splitPartSlicePure(xs, delims *types.Bytes, ns []int64, rs *types.Bytes) (*types.Bytes, error) {
+	for i := range xs.Offsets {
+		if ns[i] == 0 {
+			return nil, ErrFieldPosition
+		}
+		appendBytes(rs, field(xs.Get(int64(i)), delims.Get(int64(i)), ns[i]))
+	}
+	return rs, nil
+}
+
+func field(s, delim []byte, n int64) []byte {
+	if len(delim) == 0 {
+		if n == 1 || n == -1 {
+			return s
+		}
+		return nil
+	}
+	if n > 0 {
+		for k := int64(1); ; k++ {
+			j := bytes.Index(s, delim)
+			if k == n {
+				if j < 0 {
+					return s
+				}
+				return s[:j]
+			}
+			if j < 0 {
+				return nil
+			}
+			s = s[j+len(delim):]
+		}
+	}
+	for k := int64(-1); ; k-- {
+		j := bytes.LastIndex(s, delim)
+		if k == n {
+			if j < 0 {
+				return s
+			}
+			return s[j+len(delim):]
+		}
+		if j < 0 {
+			return nil
+		}
+		s = s[:j]
+	}
+}
+
+func appendBytes(rs *types.Bytes, v []byte) {
+	rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+	rs.Data = append(rs.Data, v...)
+	rs.Lengths = append(rs.Lengths, uint32(len(v)))
+}
@@ -0,0 +1,59 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package splitpart
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func toStrings(xs *types.Bytes) []string {
+	rs := make([]string, len(xs.Offsets))
+	for i := range rs {
+		rs[i] = string(xs.Get(int64(i)))
+	}
+	return rs
+}
+
+func TestSplitPart(t *testing.T) {
+	xs := testutil.MakeBytes([]string{"a,b,c", "abc", ""})
+	rs, err := SplitPart(xs, []byte(","), 2, &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "", ""}, toStrings(rs))
+
+	rs, err = SplitPart(xs, []byte(","), -1, &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "abc", ""}, toStrings(rs))
+
+	rs, err = SplitPart(xs, []byte(","), 4, &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"", "", ""}, toStrings(rs))
+
+	_, err = SplitPart(xs, []byte(","), 0, &types.Bytes{})
+	require.Equal(t, ErrFieldPosition, err)
+}
+
+func TestSplitPartSlice(t *testing.T) {
+	xs := testutil.MakeBytes([]string{"a::b::c", "a-b", "abc"})
+	delims := testutil.MakeBytes([]string{"::", "-", ""})
+	rs, err := SplitPartSlice(xs, delims, []int64{3, -2, 1}, &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "a", "abc"}, toStrings(rs))
+
+	_, err = SplitPartSlice(xs, delims, []int64{1, 0, 1}, &types.Bytes{})
+	require.Equal(t, ErrFieldPosition, err)
+}
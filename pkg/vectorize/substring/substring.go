@@ -0,0 +1,178 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package substring
+
+import (
+	"math"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// vectorize substring, left and right function, the positions and the
+// lengths are counted in characters.
+var (
+	substring      func(*types.Bytes, int64, int64, *types.Bytes) *types.Bytes
+	substringSlice func(*types.Bytes, []int64, []int64, *types.Bytes) *types.Bytes
+	left           func(*types.Bytes, int64, *types.Bytes) *types.Bytes
+	leftSlice      func(*types.Bytes, []int64, *types.Bytes) *types.Bytes
+	right          func(*types.Bytes, int64, *types.Bytes) *types.Bytes
+	rightSlice     func(*types.Bytes, []int64, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	substring = substringPure
+	substringSlice = substringSlicePure
+	left = leftPure
+	leftSlice = leftSlicePure
+	right = rightPure
+	rightSlice = rightSlicePure
+}
+
+// Substring appends to rs the substrings of xs of length characters from the
+// start character, a negative start counts from the end of the string.
+func Substring(xs *types.Bytes, start, length int64, rs *types.Bytes) *types.Bytes {
+	return substring(xs, start, length, rs)
+}
+
+// SubstringSlice is Substring with a start and a length per row, a nil
+// lengths takes the substrings up to the end of the strings.
+func SubstringSlice(xs *types.Bytes, starts, lengths []int64, rs *types.Bytes) *types.Bytes {
+	return substringSlice(xs, starts, lengths, rs)
+}
+
+// Left appends to rs the n leftmost characters of xs.
+func Left(xs *types.Bytes, n int64, rs *types.Bytes) *types.Bytes {
+	return left(xs, n, rs)
+}
+
+// LeftSlice is Left with a number of characters per row.
+func LeftSlice(xs *types.Bytes, ns []int64, rs *types.Bytes) *types.Bytes {
+	return leftSlice(xs, ns, rs)
+}
+
+// Right appends to rs the n rightmost characters of xs.
+func Right(xs *types.Bytes, n int64, rs *types.Bytes) *types.Bytes {
+	return right(xs, n, rs)
+}
+
+// RightSlice is Right with a number of characters per row.
+func RightSlice(xs *types.Bytes, ns []int64, rs *types.Bytes) *types.Bytes {
+	return rightSlice(xs, ns, rs)
+}
+
+func substringPure(xs *types.Bytes, start, length int64, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendBytes(rs, substr(xs.Get(int64(i)), start, length))
+	}
+	return rs
+}
+
+func substringSlicePure(xs *types.Bytes, starts, lengths []int64, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		length := int64(math.MaxInt64)
+		if lengths != nil {
+			length = lengths[i]
+		}
+		appendBytes(rs, substr(xs.Get(int64(i)), starts[i], length))
+	}
+	return rs
+}
+
+func leftPure(xs *types.Bytes, n int64, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendBytes(rs, leftChars(xs.Get(int64(i)), n))
+	}
+	return rs
+}
+
+func leftSlicePure(xs *types.Bytes, ns []int64, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendBytes(rs, leftChars(xs.Get(int64(i)), ns[i]))
+	}
+	return rs
+}
+
+func rightPure(xs *types.Bytes, n int64, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendBytes(rs, rightChars(xs.Get(int64(i)), n))
+	}
+	return rs
+}
+
+func rightSlicePure(xs *types.Bytes, ns []int64, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendBytes(rs, rightChars(xs.Get(int64(i)), ns[i]))
+	}
+	return rs
+}
+
+func substr(s []byte, start, length int64) []byte {
+	if start == 0 || length <= 0 {
+		return nil
+	}
+	n := int64(utf8.RuneCount(s))
+	if start < 0 {
+		if start += n + 1; start < 1 {
+			return nil
+		}
+	}
+	if start > n {
+		return nil
+	}
+	s = s[charOffset(s, start-1):]
+	if length > n-start {
+		return s
+	}
+	return s[:charOffset(s, length)]
+}
+
+func leftChars(s []byte, n int64) []byte {
+	if n <= 0 {
+		return nil
+	}
+	return s[:charOffset(s, n)]
+}
+
+func rightChars(s []byte, n int64) []byte {
+	if n <= 0 {
+		return nil
+	}
+	cnt := int64(utf8.RuneCount(s))
+	if n >= cnt {
+		return s
+	}
+	return s[charOffset(s, cnt-n):]
+}
+
+// charOffset returns the offset of the k-th character of s.
+func charOffset(s []byte, k int64) int {
+	off := 0
+	for ; k > 0 && off < len(s); k-- {
+		if s[off] < utf8.RuneSelf {
+			off++
+		} else {
+			_, w := utf8.DecodeRune(s[off:])
+			off += w
+		}
+	}
+	return off
+}
+
+func appendBytes(rs *types.Bytes, v []byte) {
+	rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+	rs.Data = append(rs.Data, v...)
+	rs.Lengths = append(rs.Lengths, uint32(len(v)))
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package substring
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func toStrings(xs *types.Bytes) []string {
+	rs := make([]string, len(xs.Offsets))
+	for i := range rs {
+		rs[i] = string(xs.Get(int64(i)))
+	}
+	return rs
+}
+
+func TestSubstring(t *testing.T) {
+	xs := testutil.MakeBytes([]string{"matrixone", "", "数据库abc"})
+	require.Equal(t, []string{"atrixone", "", "据库abc"}, toStrings(Substring(xs, 2, 1<<62, &types.Bytes{})))
+	require.Equal(t, []string{"atr", "", "据库a"}, toStrings(Substring(xs, 2, 3, &types.Bytes{})))
+	require.Equal(t, []string{"on", "", "ab"}, toStrings(Substring(xs, -3, 2, &types.Bytes{})))
+	require.Equal(t, []string{"", "", ""}, toStrings(Substring(xs, 0, 2, &types.Bytes{})))
+	require.Equal(t, []string{"", "", ""}, toStrings(Substring(xs, 1, -1, &types.Bytes{})))
+	require.Equal(t, []string{"", "", ""}, toStrings(Substring(xs, 20, 2, &types.Bytes{})))
+}
+
+func TestSubstringSlice(t *testing.T) {
+	xs := testutil.MakeBytes([]string{"matrixone", "abc", "数据库"})
+	require.Equal(t, []string{"matrixone", "bc", "库"}, toStrings(SubstringSlice(xs, []int64{1, 2, 3}, nil, &types.Bytes{})))
+	require.Equal(t, []string{"m", "bc", ""}, toStrings(SubstringSlice(xs, []int64{1, 2, 3}, []int64{1, 5, 0}, &types.Bytes{})))
+}
+
+func TestLeftRight(t *testing.T) {
+	xs := testutil.MakeBytes([]string{"matrixone", "ab", "数据库"})
+	require.Equal(t, []string{"mat", "ab", "数据库"}, toStrings(Left(xs, 3, &types.Bytes{})))
+	require.Equal(t, []string{"one", "ab", "数据库"}, toStrings(Right(xs, 3, &types.Bytes{})))
+	require.Equal(t, []string{"", "a", "数据"}, toStrings(LeftSlice(xs, []int64{-1, 1, 2}, &types.Bytes{})))
+	require.Equal(t, []string{"", "b", "据库"}, toStrings(RightSlice(xs, []int64{0, 1, 2}, &types.Bytes{})))
+}
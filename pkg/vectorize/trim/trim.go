@@ -0,0 +1,141 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trim
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// vectorize trim, ltrim and rtrim function, they remove all the leading
+// or trailing occurrences of a cut string.
+var (
+	trim       func(*types.Bytes, []byte, *types.Bytes) *types.Bytes
+	trimSlice  func(*types.Bytes, *types.Bytes, *types.Bytes) *types.Bytes
+	ltrim      func(*types.Bytes, []byte, *types.Bytes) *types.Bytes
+	ltrimSlice func(*types.Bytes, *types.Bytes, *types.Bytes) *types.Bytes
+	rtrim      func(*types.Bytes, []byte, *types.Bytes) *types.Bytes
+	rtrimSlice func(*types.Bytes, *types.Bytes, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	trim = trimPure
+	trimSlice = trimSlicePure
+	ltrim = ltrimPure
+	ltrimSlice = ltrimSlicePure
+	rtrim = rtrimPure
+	rtrimSlice = rtrimSlicePure
+}
+
+// Trim appends to rs the strings of xs without their leading and trailing
+// cuts.
+func Trim(xs *types.Bytes, cut []byte, rs *types.Bytes) *types.Bytes {
+	return trim(xs, cut, rs)
+}
+
+// TrimSlice is Trim with a cut per row.
+func TrimSlice(xs, cuts *types.Bytes, rs *types.Bytes) *types.Bytes {
+	return trimSlice(xs, cuts, rs)
+}
+
+// Ltrim appends to rs the strings of xs without their leading cuts.
+func Ltrim(xs *types.Bytes, cut []byte, rs *types.Bytes) *types.Bytes {
+	return ltrim(xs, cut, rs)
+}
+
+// LtrimSlice is Ltrim with a cut per row.
+func LtrimSlice(xs, cuts *types.Bytes, rs *types.Bytes) *types.Bytes {
+	return ltrimSlice(xs, cuts, rs)
+}
+
+// Rtrim appends to rs the strings of xs without their trailing cuts.
+func Rtrim(xs *types.Bytes, cut []byte, rs *types.Bytes) *types.Bytes {
+	return rtrim(xs, cut, rs)
+}
+
+// RtrimSlice is Rtrim with a cut per row.
+func RtrimSlice(xs, cuts *types.Bytes, rs *types.Bytes) *types.Bytes {
+	return rtrimSlice(xs, cuts, rs)
+}
+
+func trimPure(xs *types.Bytes, cut []byte, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendBytes(rs, trimRight(trimLeft(xs.Get(int64(i)), cut), cut))
+	}
+	return rs
+}
+
+func trimSlicePure(xs, cuts *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		cut := cuts.Get(int64(i))
+		appendBytes(rs, trimRight(trimLeft(xs.Get(int64(i)), cut), cut))
+	}
+	return rs
+}
+
+func ltrimPure(xs *types.Bytes, cut []byte, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendBytes(rs, trimLeft(xs.Get(int64(i)), cut))
+	}
+	return rs
+}
+
+func ltrimSlicePure(xs, cuts *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendBytes(rs, trimLeft(xs.Get(int64(i)), cuts.Get(int64(i))))
+	}
+	return rs
+}
+
+func rtrimPure(xs *types.Bytes, cut []byte, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendBytes(rs, trimRight(xs.Get(int64(i)), cut))
+	}
+	return rs
+}
+
+func rtrimSlicePure(xs, cuts *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		appendBytes(rs, trimRight(xs.Get(int64(i)), cuts.Get(int64(i))))
+	}
+	return rs
+}
+
+func trimLeft(s, cut []byte) []byte {
+	if len(cut) == 0 {
+		return s
+	}
+	for bytes.HasPrefix(s, cut) {
+		s = s[len(cut):]
+	}
+	return s
+}
+
+func trimRight(s, cut []byte) []byte {
+	if len(cut) == 0 {
+		return s
+	}
+	for bytes.HasSuffix(s, cut) {
+		s = s[:len(s)-len(cut)]
+	}
+	return s
+}
+
+func appendBytes(rs *types.Bytes, v []byte) {
+	rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+	rs.Data = append(rs.Data, v...)
+	rs.Lengths = append(rs.Lengths, uint32(len(v)))
+}
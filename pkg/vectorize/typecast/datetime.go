@@ -0,0 +1,199 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typecast
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	bytesToTime         func(*types.Bytes, []types.Time) ([]types.Time, error)
+	bytesToTimestamp    func(*types.Bytes, *time.Location, []types.Timestamp) ([]types.Timestamp, error)
+	dateToDatetime      func([]types.Date, []types.Datetime) []types.Datetime
+	datetimeToDate      func([]types.Datetime, []types.Date) []types.Date
+	datetimeToTime      func([]types.Datetime, []types.Time) []types.Time
+	datetimeToTimestamp func([]types.Datetime, *time.Location, []types.Timestamp) []types.Timestamp
+	timestampToDatetime func([]types.Timestamp, *time.Location, []types.Datetime) []types.Datetime
+	dateToBytes         func([]types.Date, *types.Bytes) *types.Bytes
+	datetimeToBytes     func([]types.Datetime, *types.Bytes) *types.Bytes
+	timeToBytes         func([]types.Time, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	bytesToTime = bytesToTimePure
+	bytesToTimestamp = bytesToTimestampPure
+	dateToDatetime = dateToDatetimePure
+	datetimeToDate = datetimeToDatePure
+	datetimeToTime = datetimeToTimePure
+	datetimeToTimestamp = datetimeToTimestampPure
+	timestampToDatetime = timestampToDatetimePure
+	dateToBytes = dateToBytesPure
+	datetimeToBytes = datetimeToBytesPure
+	timeToBytes = timeToBytesPure
+}
+
+// BytesToTime parses xs to be times, an empty string is parsed as zero
+func BytesToTime(xs *types.Bytes, rs []types.Time) ([]types.Time, error) {
+	return bytesToTime(xs, rs)
+}
+
+func bytesToTimePure(xs *types.Bytes, rs []types.Time) ([]types.Time, error) {
+	for i, o := range xs.Offsets {
+		if xs.Lengths[i] == 0 {
+			rs[i] = 0
+			continue
+		}
+		r, err := types.ParseTime(string(xs.Data[o : o+xs.Lengths[i]]))
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+// BytesToTimestamp parses xs in the time zone loc to be timestamps,
+// an empty string is parsed as zero
+func BytesToTimestamp(xs *types.Bytes, loc *time.Location, rs []types.Timestamp) ([]types.Timestamp, error) {
+	return bytesToTimestamp(xs, loc, rs)
+}
+
+func bytesToTimestampPure(xs *types.Bytes, loc *time.Location, rs []types.Timestamp) ([]types.Timestamp, error) {
+	for i, o := range xs.Offsets {
+		if xs.Lengths[i] == 0 {
+			rs[i] = 0
+			continue
+		}
+		r, err := types.ParseTimestamp(string(xs.Data[o:o+xs.Lengths[i]]), loc)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+// DateToDatetime returns the midnights of xs
+func DateToDatetime(xs []types.Date, rs []types.Datetime) []types.Datetime {
+	return dateToDatetime(xs, rs)
+}
+
+func dateToDatetimePure(xs []types.Date, rs []types.Datetime) []types.Datetime {
+	for i, x := range xs {
+		rs[i] = x.ToTime()
+	}
+	return rs
+}
+
+// DatetimeToDate truncates the clocks of xs
+func DatetimeToDate(xs []types.Datetime, rs []types.Date) []types.Date {
+	return datetimeToDate(xs, rs)
+}
+
+func datetimeToDatePure(xs []types.Datetime, rs []types.Date) []types.Date {
+	for i, x := range xs {
+		rs[i] = x.ToDate()
+	}
+	return rs
+}
+
+// DatetimeToTime returns the clocks of xs
+func DatetimeToTime(xs []types.Datetime, rs []types.Time) []types.Time {
+	return datetimeToTime(xs, rs)
+}
+
+func datetimeToTimePure(xs []types.Datetime, rs []types.Time) []types.Time {
+	for i, x := range xs {
+		rs[i] = x.TimeOfDay()
+	}
+	return rs
+}
+
+// DatetimeToTimestamp converts xs in the time zone loc to be timestamps
+func DatetimeToTimestamp(xs []types.Datetime, loc *time.Location, rs []types.Timestamp) []types.Timestamp {
+	return datetimeToTimestamp(xs, loc, rs)
+}
+
+func datetimeToTimestampPure(xs []types.Datetime, loc *time.Location, rs []types.Timestamp) []types.Timestamp {
+	for i, x := range xs {
+		rs[i] = x.ToTimestamp(loc)
+	}
+	return rs
+}
+
+// TimestampToDatetime returns the calendars and clocks of xs in the time zone loc
+func TimestampToDatetime(xs []types.Timestamp, loc *time.Location, rs []types.Datetime) []types.Datetime {
+	return timestampToDatetime(xs, loc, rs)
+}
+
+func timestampToDatetimePure(xs []types.Timestamp, loc *time.Location, rs []types.Datetime) []types.Datetime {
+	for i, x := range xs {
+		rs[i] = x.ToDatetime(loc)
+	}
+	return rs
+}
+
+// DateToBytes formats xs as yyyy-mm-dd
+func DateToBytes(xs []types.Date, rs *types.Bytes) *types.Bytes {
+	return dateToBytes(xs, rs)
+}
+
+func dateToBytesPure(xs []types.Date, rs *types.Bytes) *types.Bytes {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = append(rs.Data, x.String()...)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs
+}
+
+// DatetimeToBytes formats xs as yyyy-mm-dd hh:mm:ss
+func DatetimeToBytes(xs []types.Datetime, rs *types.Bytes) *types.Bytes {
+	return datetimeToBytes(xs, rs)
+}
+
+func datetimeToBytesPure(xs []types.Datetime, rs *types.Bytes) *types.Bytes {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = append(rs.Data, x.String()...)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs
+}
+
+// TimeToBytes formats xs as hh:mm:ss
+func TimeToBytes(xs []types.Time, rs *types.Bytes) *types.Bytes {
+	return timeToBytes(xs, rs)
+}
+
+func timeToBytesPure(xs []types.Time, rs *types.Bytes) *types.Bytes {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = append(rs.Data, x.String()...)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs
+}
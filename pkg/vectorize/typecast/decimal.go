@@ -0,0 +1,206 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typecast
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	int64ToDecimal128      func([]int64, int32, int32, []types.Decimal128) ([]types.Decimal128, error)
+	uint64ToDecimal128     func([]uint64, int32, int32, []types.Decimal128) ([]types.Decimal128, error)
+	float64ToDecimal128    func([]float64, int32, int32, []types.Decimal128) ([]types.Decimal128, error)
+	bytesToDecimal128      func(*types.Bytes, int32, int32, []types.Decimal128) ([]types.Decimal128, error)
+	decimal128ToDecimal128 func([]types.Decimal128, int32, int32, int32, []types.Decimal128) ([]types.Decimal128, error)
+	decimal64ToDecimal128  func([]types.Decimal64, []types.Decimal128) []types.Decimal128
+	decimal128ToDecimal64  func([]types.Decimal128, []types.Decimal64) ([]types.Decimal64, error)
+	decimal128ToInt64      func([]types.Decimal128, int32, []int64) ([]int64, error)
+	decimal128ToFloat64    func([]types.Decimal128, int32, []float64) []float64
+	decimal128ToBytes      func([]types.Decimal128, int32, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	int64ToDecimal128 = int64ToDecimal128Pure
+	uint64ToDecimal128 = uint64ToDecimal128Pure
+	float64ToDecimal128 = float64ToDecimal128Pure
+	bytesToDecimal128 = bytesToDecimal128Pure
+	decimal128ToDecimal128 = decimal128ToDecimal128Pure
+	decimal64ToDecimal128 = decimal64ToDecimal128Pure
+	decimal128ToDecimal64 = decimal128ToDecimal64Pure
+	decimal128ToInt64 = decimal128ToInt64Pure
+	decimal128ToFloat64 = decimal128ToFloat64Pure
+	decimal128ToBytes = decimal128ToBytesPure
+}
+
+// Int64ToDecimal128 converts xs to be decimals of the given width and scale
+func Int64ToDecimal128(xs []int64, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	return int64ToDecimal128(xs, width, scale, rs)
+}
+
+func int64ToDecimal128Pure(xs []int64, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	for i, x := range xs {
+		r, err := types.Decimal128FromInt64(x, width, scale)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+// Uint64ToDecimal128 converts xs to be decimals of the given width and scale
+func Uint64ToDecimal128(xs []uint64, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	return uint64ToDecimal128(xs, width, scale, rs)
+}
+
+func uint64ToDecimal128Pure(xs []uint64, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	for i, x := range xs {
+		r, err := types.Decimal128FromUint64(x, width, scale)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+// Float64ToDecimal128 converts xs to be decimals of the given width and scale
+func Float64ToDecimal128(xs []float64, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	return float64ToDecimal128(xs, width, scale, rs)
+}
+
+func float64ToDecimal128Pure(xs []float64, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	for i, x := range xs {
+		r, err := types.Decimal128FromFloat64(x, width, scale)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+// BytesToDecimal128 parses xs to be decimals of the given width and scale,
+// an empty string is treated as zero.
+func BytesToDecimal128(xs *types.Bytes, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	return bytesToDecimal128(xs, width, scale, rs)
+}
+
+func bytesToDecimal128Pure(xs *types.Bytes, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	for i, o := range xs.Offsets {
+		if xs.Lengths[i] == 0 {
+			rs[i] = types.Decimal128{}
+			continue
+		}
+		s := string(xs.Data[o : o+xs.Lengths[i]])
+		r, err := types.ParseStringToDecimal128(s, width, scale)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+// Decimal128ToDecimal128 rescales xs from fromScale to scale and checks that
+// the results fit in width digits.
+func Decimal128ToDecimal128(xs []types.Decimal128, fromScale, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	return decimal128ToDecimal128(xs, fromScale, width, scale, rs)
+}
+
+func decimal128ToDecimal128Pure(xs []types.Decimal128, fromScale, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	for i, x := range xs {
+		r, err := x.Scale(scale - fromScale)
+		if err != nil {
+			return nil, err
+		}
+		if err = types.CheckDecimal128Width(r, width); err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+// Decimal64ToDecimal128 widens xs, the scale is not changed
+func Decimal64ToDecimal128(xs []types.Decimal64, rs []types.Decimal128) []types.Decimal128 {
+	return decimal64ToDecimal128(xs, rs)
+}
+
+func decimal64ToDecimal128Pure(xs []types.Decimal64, rs []types.Decimal128) []types.Decimal128 {
+	for i, x := range xs {
+		rs[i] = x.ToDecimal128()
+	}
+	return rs
+}
+
+// Decimal128ToDecimal64 narrows xs, the scale is not changed
+func Decimal128ToDecimal64(xs []types.Decimal128, rs []types.Decimal64) ([]types.Decimal64, error) {
+	return decimal128ToDecimal64(xs, rs)
+}
+
+func decimal128ToDecimal64Pure(xs []types.Decimal128, rs []types.Decimal64) ([]types.Decimal64, error) {
+	for i, x := range xs {
+		r, err := x.ToDecimal64()
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+// Decimal128ToInt64 rounds xs of the given scale to integers
+func Decimal128ToInt64(xs []types.Decimal128, scale int32, rs []int64) ([]int64, error) {
+	return decimal128ToInt64(xs, scale, rs)
+}
+
+func decimal128ToInt64Pure(xs []types.Decimal128, scale int32, rs []int64) ([]int64, error) {
+	for i, x := range xs {
+		r, err := x.ToInt64(scale)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
+
+func Decimal128ToFloat64(xs []types.Decimal128, scale int32, rs []float64) []float64 {
+	return decimal128ToFloat64(xs, scale, rs)
+}
+
+func decimal128ToFloat64Pure(xs []types.Decimal128, scale int32, rs []float64) []float64 {
+	for i, x := range xs {
+		rs[i] = x.ToFloat64(scale)
+	}
+	return rs
+}
+
+func Decimal128ToBytes(xs []types.Decimal128, scale int32, rs *types.Bytes) *types.Bytes {
+	return decimal128ToBytes(xs, scale, rs)
+}
+
+func decimal128ToBytesPure(xs []types.Decimal128, scale int32, rs *types.Bytes) *types.Bytes {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = append(rs.Data, x.ToString(scale)...)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs
+}
@@ -0,0 +1,73 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typecast
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	bytesToJson func(*types.Bytes, *nulls.Nulls, *types.Bytes) (*types.Bytes, error)
+	jsonToBytes func(*types.Bytes, *nulls.Nulls, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	bytesToJson = bytesToJsonPure
+	jsonToBytes = jsonToBytesPure
+}
+
+// BytesToJson parses the json texts of xs to be the binary json, the null rows are kept empty
+func BytesToJson(xs *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	return bytesToJson(xs, nsp, rs)
+}
+
+func bytesToJsonPure(xs *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	oldLen := uint32(0)
+	for i := range xs.Offsets {
+		if !nulls.Contains(nsp, uint64(i)) {
+			bj, err := bytejson.Parse(xs.Get(int64(i)))
+			if err != nil {
+				return nil, err
+			}
+			rs.Data = append(rs.Data, bj...)
+		}
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs, nil
+}
+
+// JsonToBytes formats the binary json of xs to be json texts, the null rows are kept empty
+func JsonToBytes(xs *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) *types.Bytes {
+	return jsonToBytes(xs, nsp, rs)
+}
+
+func jsonToBytesPure(xs *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) *types.Bytes {
+	oldLen := uint32(0)
+	for i := range xs.Offsets {
+		if !nulls.Contains(nsp, uint64(i)) {
+			rs.Data = bytejson.ByteJson(xs.Get(int64(i))).Format(rs.Data)
+		}
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs
+}
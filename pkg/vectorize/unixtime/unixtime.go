@@ -0,0 +1,52 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unixtime
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+// vectorize UNIX_TIMESTAMP and FROM_UNIXTIME function
+var (
+	unixTimestamp func([]types.Timestamp, []int64) []int64
+	fromUnixtime  func([]int64, []types.Timestamp) []types.Timestamp
+)
+
+func init() {
+	unixTimestamp = unixTimestampPure
+	fromUnixtime = fromUnixtimePure
+}
+
+// UnixTimestamp returns the seconds since 1970-01-01 00:00:00 UTC of xs.
+func UnixTimestamp(xs []types.Timestamp, rs []int64) []int64 {
+	return unixTimestamp(xs, rs)
+}
+
+// FromUnixtime returns the timestamps of the seconds since 1970-01-01 00:00:00 UTC.
+func FromUnixtime(xs []int64, rs []types.Timestamp) []types.Timestamp {
+	return fromUnixtime(xs, rs)
+}
+
+func unixTimestampPure(xs []types.Timestamp, rs []int64) []int64 {
+	for i, x := range xs {
+		rs[i] = x.Unix()
+	}
+	return rs
+}
+
+func fromUnixtimePure(xs []int64, rs []types.Timestamp) []types.Timestamp {
+	for i, x := range xs {
+		rs[i] = types.UnixToTimestamp(x)
+	}
+	return rs
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upper
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// vectorize upper function
+var (
+	upper func(*types.Bytes, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	upper = upperPure
+}
+
+// Upper appends to rs the strings of xs mapped to their upper case.
+func Upper(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	return upper(xs, rs)
+}
+
+func upperPure(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range xs.Offsets {
+		x := xs.Get(int64(i))
+		o := uint32(len(rs.Data))
+		if isASCII(x) {
+			for _, c := range x {
+				if 'a' <= c && c <= 'z' {
+					c -= 'a' - 'A'
+				}
+				rs.Data = append(rs.Data, c)
+			}
+		} else {
+			rs.Data = append(rs.Data, bytes.ToUpper(x)...)
+		}
+		rs.Offsets = append(rs.Offsets, o)
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-o)
+	}
+	return rs
+}
+
+func isASCII(s []byte) bool {
+	for _, c := range s {
+		if c >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -166,6 +166,77 @@ func (s *Storage) dropIndex(index uint64, offset int, batchsize int, shardId uin
 	return writtenBytes, changedBytes, nil
 }
 
+//alterTable replaces the columns of the table with those of the table info.
+func (s *Storage) alterTable(index uint64, offset int, batchsize int, shardId uint64, cmd []byte, key []byte) (uint64, int64, []byte) {
+	if err := s.DB.Closed.Load(); err != nil {
+		panic(err)
+	}
+	if offset >= batchsize {
+		panic(fmt.Sprintf("bad index %d: offset %d, size %d", index, offset, batchsize))
+	}
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("[S-%d|logIndex:%d,%d]alterTable handler cost %d ms", shardId, index, offset, time.Since(t0).Milliseconds())
+	}()
+	customReq := &pb.AlterTabletRequest{}
+	protoc.MustUnmarshal(customReq, cmd)
+
+	tblInfo, err := helper.DecodeTable(customReq.TableInfo)
+	if err != nil {
+		return 0, 0, errDriver.ErrorResp(err)
+	}
+	schema, err := adaptor.TableInfoToAlterSchema(s.DB.Store.Catalog, &tblInfo)
+	if err != nil {
+		return 0, 0, errDriver.ErrorResp(err)
+	}
+	schema.Name = customReq.TableName
+	ctx := aoedb.AlterTableCtx{
+		DBMutationCtx: aoedb.DBMutationCtx{
+			Id:     index,
+			Offset: offset,
+			Size:   batchsize,
+			DB:     aoedb.IdToNameFactory.Encode(shardId),
+		},
+		Table:  customReq.TableName,
+		Schema: schema,
+	}
+	if err = s.DB.AlterTable(&ctx); err != nil {
+		return 0, 0, errDriver.ErrorResp(err)
+	}
+	writtenBytes := uint64(len(key) + len(customReq.TableInfo))
+	changedBytes := int64(writtenBytes)
+	return writtenBytes, changedBytes, nil
+}
+
+func (s *Storage) optimizeTable(index uint64, offset int, batchsize int, shardId uint64, cmd []byte, key []byte) (uint64, int64, []byte) {
+	if err := s.DB.Closed.Load(); err != nil {
+		panic(err)
+	}
+	if offset >= batchsize {
+		panic(fmt.Sprintf("bad index %d: offset %d, size %d", index, offset, batchsize))
+	}
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("[S-%d|logIndex:%d,%d]optimizeTable handler cost %d ms", shardId, index, offset, time.Since(t0).Milliseconds())
+	}()
+	customReq := &pb.OptimizeTabletRequest{}
+	protoc.MustUnmarshal(customReq, cmd)
+	ctx := aoedb.OptimizeTableCtx{
+		DBMutationCtx: aoedb.DBMutationCtx{
+			Id:     index,
+			Offset: offset,
+			Size:   batchsize,
+			DB:     aoedb.IdToNameFactory.Encode(shardId),
+		},
+		Table: customReq.TableName,
+	}
+	if err := s.DB.OptimizeTable(&ctx); err != nil {
+		return 0, 0, errDriver.ErrorResp(err)
+	}
+	writtenBytes := uint64(len(key) + len(cmd))
+	return writtenBytes, 0, nil
+}
+
 //Append appends batch in the table
 func (s *Storage) Append(index uint64, offset int, batchSize int, shardId uint64, cmd []byte, key []byte) (uint64, int64, []byte) {
 	if err := s.DB.Closed.Load(); err != nil {
@@ -202,6 +273,7 @@ func (s *Storage) Append(index uint64, offset int, batchSize int, shardId uint64
 			Table: customReq.TabletName,
 		},
 		Data: bat,
+		Ts:   customReq.Ts,
 	}
 	err = s.DB.Append(&ctx)
 	if err != nil {
@@ -213,6 +285,49 @@ func (s *Storage) Append(index uint64, offset int, batchSize int, shardId uint64
 	return writtenBytes, changedBytes, nil
 }
 
+//deleteRows deletes rows of the table and appends their replacement
+func (s *Storage) deleteRows(index uint64, offset int, batchSize int, shardId uint64, cmd []byte, key []byte) (uint64, int64, []byte) {
+	if err := s.DB.Closed.Load(); err != nil {
+		panic(err)
+	}
+	if offset >= batchSize {
+		panic(fmt.Sprintf("bad index %d: offset %d, size %d", index, offset, batchSize))
+	}
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("[S-%d|logIndex:%d,%d]deleteRows handler cost %d ms", shardId, index, offset, time.Since(t0).Milliseconds())
+	}()
+	customReq := &pb.DeleteRowsRequest{}
+	protoc.MustUnmarshal(customReq, cmd)
+	bat, _, err := protocol.DecodeBatch(customReq.Data)
+	if err != nil {
+		return 0, 0, errDriver.ErrorResp(err)
+	}
+	ctx := aoedb.DeleteCtx{
+		TableMutationCtx: aoedb.TableMutationCtx{
+			DBMutationCtx: aoedb.DBMutationCtx{
+				Id:     index,
+				Offset: offset,
+				Size:   batchSize,
+				DB:     aoedb.IdToNameFactory.Encode(shardId),
+			},
+			Table: customReq.TabletName,
+		},
+		Data: bat,
+		Ts:   customReq.Ts,
+	}
+	if len(customReq.NewData) != 0 {
+		if ctx.NewData, _, err = protocol.DecodeBatch(customReq.NewData); err != nil {
+			return 0, 0, errDriver.ErrorResp(err)
+		}
+	}
+	if err = s.DB.Delete(&ctx); err != nil {
+		return 0, 0, errDriver.ErrorResp(err)
+	}
+	writtenBytes := uint64(len(key) + len(customReq.Data) + len(customReq.NewData))
+	return writtenBytes, int64(writtenBytes), nil
+}
+
 //Relation  returns a relation of the db and the table
 func (s *Storage) Relation(dbname, tabletName string) (*aoedb.Relation, error) {
 	return s.DB.Relation(dbname, tabletName)
@@ -507,10 +622,16 @@ func (s *Storage) Write(ctx storage.WriteContext) error {
 			writtenBytes, changedBytes, rep = s.dropTable(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		case uint64(pb.Append):
 			writtenBytes, changedBytes, rep = s.Append(batch.Index, idx, batchSize, shard.ID, cmd, key)
+		case uint64(pb.DeleteRows):
+			writtenBytes, changedBytes, rep = s.deleteRows(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		case uint64(pb.CreateIndex):
 			writtenBytes, changedBytes, rep = s.createIndex(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		case uint64(pb.DropIndex):
 			writtenBytes, changedBytes, rep = s.dropIndex(batch.Index, idx, batchSize, shard.ID, cmd, key)
+		case uint64(pb.AlterTablet):
+			writtenBytes, changedBytes, rep = s.alterTable(batch.Index, idx, batchSize, shard.ID, cmd, key)
+		case uint64(pb.OptimizeTablet):
+			writtenBytes, changedBytes, rep = s.optimizeTable(batch.Index, idx, batchSize, shard.ID, cmd, key)
 		}
 		ctx.AppendResponse(rep)
 		totalWrittenBytes += writtenBytes
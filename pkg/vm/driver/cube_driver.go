@@ -101,8 +101,13 @@ type CubeDriver interface {
 	AllocID([]byte, uint64) (uint64, error)
 	// AsyncAllocID async alloc id.
 	AsyncAllocID([]byte, uint64, func(server.CustomRequest, []byte, error), interface{})
-	// Append appends the data in the table
-	Append(string, uint64, []byte) error
+	// Append appends the data in the table, stamped with the unix time in
+	// nanoseconds ts, the current time if it is 0.
+	Append(name string, shardId uint64, data []byte, ts int64) error
+	// DeleteRows deletes the rows of the table by the row ids of the data
+	// and appends the rows of the new data, if any, in their place. The
+	// changes are stamped with ts like those of Append.
+	DeleteRows(name string, shardId uint64, data, newData []byte, ts int64) error
 	//GetSnapshot gets the snapshot from the table.
 	//If there's no segment, it returns an empty snapshot.
 	GetSnapshot(dbi.GetSnapshotCtx) (*handle.Snapshot, error)
@@ -118,6 +123,11 @@ type CubeDriver interface {
 	CreateIndex(tableName string, indexInfo *aoe.IndexInfo, toShard uint64) error
 	//DropIndex drops an index
 	DropIndex(tableName, indexName string, toShard uint64) error
+	//AlterTablet replaces the columns of the table in the storage with
+	//the version of the schema of tbl, unless the table is already at it.
+	AlterTablet(tableName string, tbl *aoe.TableInfo, toShard uint64) error
+	//OptimizeTablet merge-sorts the closed segments of the table in the storage.
+	OptimizeTablet(tableName string, toShard uint64) error
 	// TabletIDs returns the ids of all the tables in the storage.
 	TabletIDs() ([]uint64, error)
 	// TabletNames returns the names of all the tables in the storage.
@@ -618,7 +628,7 @@ func (h *driver) AsyncAllocID(idkey []byte, batch uint64, cb func(server.CustomR
 	h.AsyncExecWithGroup(req, pb.KVGroup, cb, param)
 }
 
-func (h *driver) Append(name string, shardId uint64, data []byte) error {
+func (h *driver) Append(name string, shardId uint64, data []byte, ts int64) error {
 	req := pb.Request{
 		Type:  pb.Append,
 		Group: pb.AOEGroup,
@@ -626,6 +636,7 @@ func (h *driver) Append(name string, shardId uint64, data []byte) error {
 		Append: pb.AppendRequest{
 			Data:       data,
 			TabletName: name,
+			Ts:         stampOf(ts),
 		},
 	}
 	rsp, err := h.ExecWithGroup(req, pb.AOEGroup)
@@ -635,6 +646,35 @@ func (h *driver) Append(name string, shardId uint64, data []byte) error {
 	return err
 }
 
+func (h *driver) DeleteRows(name string, shardId uint64, data, newData []byte, ts int64) error {
+	req := pb.Request{
+		Type:  pb.DeleteRows,
+		Group: pb.AOEGroup,
+		Shard: shardId,
+		DeleteRows: pb.DeleteRowsRequest{
+			TabletName: name,
+			Data:       data,
+			NewData:    newData,
+			Ts:         stampOf(ts),
+		},
+	}
+	rsp, err := h.ExecWithGroup(req, pb.AOEGroup)
+	if len(rsp) != 0 {
+		err = errors.New(string(rsp))
+	}
+	return err
+}
+
+// stampOf returns the stamp of a change, the current time if ts is 0.
+// The stamp is taken before the change is proposed, so that every
+// replica applies it with the same one.
+func stampOf(ts int64) int64 {
+	if ts == 0 {
+		return time.Now().UnixNano()
+	}
+	return ts
+}
+
 func (h *driver) GetSnapshot(ctx dbi.GetSnapshotCtx) (*handle.Snapshot, error) {
 	ctxStr, err := json.Marshal(ctx)
 	req := pb.Request{
@@ -764,6 +804,43 @@ func (h *driver) DropIndex(tableName, indexName string, toShard uint64) error {
 	return err
 }
 
+func (h *driver) AlterTablet(tableName string, tbl *aoe.TableInfo, toShard uint64) error {
+	info, err := helper.EncodeTable(*tbl)
+	if err != nil {
+		return err
+	}
+	req := pb.Request{
+		Shard: toShard,
+		Type:  pb.AlterTablet,
+		Group: pb.AOEGroup,
+		AlterTablet: pb.AlterTabletRequest{
+			TableName: tableName,
+			TableInfo: info,
+		},
+	}
+	rsp, err := h.ExecWithGroup(req, pb.AOEGroup)
+	if len(rsp) != 0 {
+		err = errors.New(string(rsp))
+	}
+	return err
+}
+
+func (h *driver) OptimizeTablet(tableName string, toShard uint64) error {
+	req := pb.Request{
+		Shard: toShard,
+		Type:  pb.OptimizeTablet,
+		Group: pb.AOEGroup,
+		OptimizeTablet: pb.OptimizeTabletRequest{
+			TableName: tableName,
+		},
+	}
+	rsp, err := h.ExecWithGroup(req, pb.AOEGroup)
+	if len(rsp) != 0 {
+		err = errors.New(string(rsp))
+	}
+	return err
+}
+
 func (h *driver) TabletIDs() ([]uint64, error) {
 	req := pb.Request{
 		Type:      pb.TabletIds,
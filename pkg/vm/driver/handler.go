@@ -105,6 +105,12 @@ func (h *driver) BuildRequest(req *server.CustomRequest, cmd interface{}) error
 		req.CustomType = uint64(pb.Append)
 		req.Write = true
 		req.Cmd = protoc.MustMarshal(&msg)
+	case pb.DeleteRows:
+		msg := customReq.DeleteRows
+		req.Group = uint64(customReq.Group)
+		req.CustomType = uint64(pb.DeleteRows)
+		req.Write = true
+		req.Cmd = protoc.MustMarshal(&msg)
 	case pb.CreateIndex:
 		msg := customReq.CreateIndex
 		req.Group = uint64(customReq.Group)
@@ -117,6 +123,18 @@ func (h *driver) BuildRequest(req *server.CustomRequest, cmd interface{}) error
 		req.CustomType = uint64(pb.DropIndex)
 		req.Write = true
 		req.Cmd = protoc.MustMarshal(&msg)
+	case pb.AlterTablet:
+		msg := customReq.AlterTablet
+		req.Group = uint64(customReq.Group)
+		req.CustomType = uint64(pb.AlterTablet)
+		req.Write = true
+		req.Cmd = protoc.MustMarshal(&msg)
+	case pb.OptimizeTablet:
+		msg := customReq.OptimizeTablet
+		req.Group = uint64(customReq.Group)
+		req.CustomType = uint64(pb.OptimizeTablet)
+		req.Write = true
+		req.Cmd = protoc.MustMarshal(&msg)
 	case pb.TabletNames:
 		msg := customReq.TabletIds
 		req.Group = uint64(customReq.Group)
@@ -46,6 +46,9 @@ const (
 	GetSegmentedId Type = 108
 	CreateIndex    Type = 109
 	DropIndex      Type = 110
+	AlterTablet    Type = 111
+	OptimizeTablet Type = 112
+	DeleteRows     Type = 113
 )
 
 var Type_name = map[int32]string{
@@ -69,6 +72,9 @@ var Type_name = map[int32]string{
 	108: "GetSegmentedId",
 	109: "CreateIndex",
 	110: "DropIndex",
+	111: "AlterTablet",
+	112: "OptimizeTablet",
+	113: "DeleteRows",
 }
 
 var Type_value = map[string]int32{
@@ -92,6 +98,9 @@ var Type_value = map[string]int32{
 	"GetSegmentedId": 108,
 	"CreateIndex":    109,
 	"DropIndex":      110,
+	"AlterTablet":    111,
+	"OptimizeTablet": 112,
+	"DeleteRows":     113,
 }
 
 func (x Type) String() string {
@@ -123,6 +132,9 @@ type Request struct {
 	GetSegmentedId       GetSegmentedIdRequest `protobuf:"bytes,106,opt,name=getSegmentedId,proto3" json:"getSegmentedId"`
 	CreateIndex          CreateIndexRequest    `protobuf:"bytes,107,opt,name=createIndex,proto3" json:"createIndex"`
 	DropIndex            DropIndexRequest      `protobuf:"bytes,108,opt,name=dropIndex,proto3" json:"dropIndex"`
+	AlterTablet          AlterTabletRequest    `protobuf:"bytes,109,opt,name=alterTablet,proto3" json:"alterTablet"`
+	OptimizeTablet       OptimizeTabletRequest `protobuf:"bytes,110,opt,name=optimizeTablet,proto3" json:"optimizeTablet"`
+	DeleteRows           DeleteRowsRequest     `protobuf:"bytes,111,opt,name=deleteRows,proto3" json:"deleteRows"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -301,6 +313,27 @@ func (m *Request) GetDropIndex() DropIndexRequest {
 	return DropIndexRequest{}
 }
 
+func (m *Request) GetAlterTablet() AlterTabletRequest {
+	if m != nil {
+		return m.AlterTablet
+	}
+	return AlterTabletRequest{}
+}
+
+func (m *Request) GetOptimizeTablet() OptimizeTabletRequest {
+	if m != nil {
+		return m.OptimizeTablet
+	}
+	return OptimizeTabletRequest{}
+}
+
+func (m *Request) GetDeleteRows() DeleteRowsRequest {
+	if m != nil {
+		return m.DeleteRows
+	}
+	return DeleteRowsRequest{}
+}
+
 type Response struct {
 	ID                   uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type                 Type               `protobuf:"varint,2,opt,name=type,proto3,enum=pb.Type" json:"type,omitempty"`
@@ -824,6 +857,7 @@ func (m *TpePrefixScanRequest) GetLimit() uint64 {
 type AppendRequest struct {
 	TabletName           string   `protobuf:"bytes,1,opt,name=tabletName,proto3" json:"tabletName,omitempty"`
 	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Ts                   int64    `protobuf:"varint,3,opt,name=ts,proto3" json:"ts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -876,6 +910,13 @@ func (m *AppendRequest) GetData() []byte {
 	return nil
 }
 
+func (m *AppendRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
 //GetSnapshotRequest gets a snapshot for the table.
 type GetSnapshotRequest struct {
 	Ctx                  []byte   `protobuf:"bytes,1,opt,name=ctx,proto3" json:"ctx,omitempty"`
@@ -1668,6 +1709,182 @@ func (m *Uint32Response) GetValue() uint32 {
 	return 0
 }
 
+//AlterTabletRequest alters the columns of the tablet.
+type AlterTabletRequest struct {
+	TableName            string   `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	TableInfo            []byte   `protobuf:"bytes,2,opt,name=tableInfo,proto3" json:"tableInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTabletRequest) Reset()         { *m = AlterTabletRequest{} }
+func (m *AlterTabletRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterTabletRequest) ProtoMessage()    {}
+func (*AlterTabletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{26}
+}
+func (m *AlterTabletRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTabletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTabletRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTabletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTabletRequest.Merge(m, src)
+}
+func (m *AlterTabletRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AlterTabletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTabletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTabletRequest proto.InternalMessageInfo
+
+func (m *AlterTabletRequest) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+func (m *AlterTabletRequest) GetTableInfo() []byte {
+	if m != nil {
+		return m.TableInfo
+	}
+	return nil
+}
+
+//OptimizeTabletRequest compacts the closed segments of the tablet.
+type OptimizeTabletRequest struct {
+	TableName            string   `protobuf:"bytes,1,opt,name=tableName,proto3" json:"tableName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OptimizeTabletRequest) Reset()         { *m = OptimizeTabletRequest{} }
+func (m *OptimizeTabletRequest) String() string { return proto.CompactTextString(m) }
+func (*OptimizeTabletRequest) ProtoMessage()    {}
+func (*OptimizeTabletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{27}
+}
+func (m *OptimizeTabletRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptimizeTabletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptimizeTabletRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OptimizeTabletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptimizeTabletRequest.Merge(m, src)
+}
+func (m *OptimizeTabletRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptimizeTabletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptimizeTabletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptimizeTabletRequest proto.InternalMessageInfo
+
+func (m *OptimizeTabletRequest) GetTableName() string {
+	if m != nil {
+		return m.TableName
+	}
+	return ""
+}
+
+//DeleteRowsRequest deletes rows of the tablet and appends their replacement.
+type DeleteRowsRequest struct {
+	TabletName           string   `protobuf:"bytes,1,opt,name=tabletName,proto3" json:"tabletName,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	NewData              []byte   `protobuf:"bytes,3,opt,name=newData,proto3" json:"newData,omitempty"`
+	Ts                   int64    `protobuf:"varint,4,opt,name=ts,proto3" json:"ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRowsRequest) Reset()         { *m = DeleteRowsRequest{} }
+func (m *DeleteRowsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRowsRequest) ProtoMessage()    {}
+func (*DeleteRowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{28}
+}
+func (m *DeleteRowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteRowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRowsRequest.Merge(m, src)
+}
+func (m *DeleteRowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRowsRequest proto.InternalMessageInfo
+
+func (m *DeleteRowsRequest) GetTabletName() string {
+	if m != nil {
+		return m.TabletName
+	}
+	return ""
+}
+
+func (m *DeleteRowsRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DeleteRowsRequest) GetNewData() []byte {
+	if m != nil {
+		return m.NewData
+	}
+	return nil
+}
+
+func (m *DeleteRowsRequest) GetTs() int64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("pb.Type", Type_name, Type_value)
 	proto.RegisterType((*Request)(nil), "pb.Request")
@@ -1696,90 +1913,101 @@ func init() {
 	proto.RegisterType((*Uint64Response)(nil), "pb.Uint64Response")
 	proto.RegisterType((*BytesSliceResponse)(nil), "pb.BytesSliceResponse")
 	proto.RegisterType((*Uint32Response)(nil), "pb.Uint32Response")
+	proto.RegisterType((*AlterTabletRequest)(nil), "pb.AlterTabletRequest")
+	proto.RegisterType((*OptimizeTabletRequest)(nil), "pb.OptimizeTabletRequest")
+	proto.RegisterType((*DeleteRowsRequest)(nil), "pb.DeleteRowsRequest")
 }
 
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 1241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xed, 0x6e, 0xe3, 0x44,
-	0x14, 0xdd, 0x24, 0x6e, 0x3e, 0x6e, 0x93, 0xec, 0x74, 0xe8, 0x2e, 0xde, 0xd5, 0x2a, 0x2d, 0x16,
-	0x94, 0x52, 0x69, 0x5b, 0xd1, 0x05, 0x54, 0x09, 0x09, 0xa9, 0x69, 0xaa, 0x28, 0xda, 0xaa, 0x8b,
-	0x9c, 0xc2, 0x3f, 0x90, 0x9c, 0x78, 0xe2, 0x78, 0xeb, 0xd8, 0xc6, 0x9e, 0xa0, 0x46, 0xe2, 0x15,
-	0x78, 0x19, 0x9e, 0x62, 0x7f, 0xa1, 0x7d, 0x82, 0x0a, 0xfa, 0x24, 0xe8, 0xce, 0xd8, 0x19, 0x4f,
-	0x92, 0x05, 0x89, 0x7f, 0x73, 0xcf, 0x9c, 0x73, 0x67, 0xe6, 0x5e, 0xcf, 0x99, 0x04, 0x1a, 0x49,
-	0x3c, 0x3e, 0x8e, 0x93, 0x88, 0x47, 0xb4, 0x1c, 0x8f, 0x9e, 0xbf, 0xf4, 0x7c, 0x3e, 0x9d, 0x8f,
-	0x8e, 0xc7, 0xd1, 0xec, 0xc4, 0x8b, 0xbc, 0xe8, 0x44, 0x4c, 0x8d, 0xe6, 0x13, 0x11, 0x89, 0x40,
-	0x8c, 0xa4, 0xe4, 0x39, 0xcc, 0x18, 0x77, 0xe4, 0xd8, 0xfa, 0xbd, 0x0e, 0x35, 0x9b, 0xfd, 0x32,
-	0x67, 0x29, 0xa7, 0x4f, 0xa1, 0xec, 0xbb, 0x66, 0x69, 0xbf, 0x74, 0x68, 0x74, 0xab, 0x0f, 0xf7,
-	0x7b, 0xe5, 0x41, 0xcf, 0x2e, 0xfb, 0x2e, 0x7d, 0x01, 0x06, 0x5f, 0xc4, 0xcc, 0x2c, 0xef, 0x97,
-	0x0e, 0xdb, 0xa7, 0xf5, 0xe3, 0x78, 0x74, 0x7c, 0xb3, 0x88, 0x99, 0x2d, 0x50, 0xba, 0x07, 0x5b,
-	0x5e, 0x12, 0xcd, 0x63, 0xb3, 0x22, 0xa6, 0x1b, 0x38, 0xdd, 0x47, 0xc0, 0x96, 0x38, 0xdd, 0x85,
-	0xad, 0x74, 0xea, 0x24, 0xae, 0x69, 0x60, 0x66, 0x5b, 0x06, 0xf4, 0x00, 0x2a, 0x29, 0xe3, 0xe6,
-	0xd6, 0x7e, 0xe9, 0x70, 0xfb, 0xb4, 0x8d, 0xa2, 0x21, 0xe3, 0xd9, 0x4e, 0xba, 0xc6, 0xbb, 0xfb,
-	0xbd, 0x47, 0x36, 0x12, 0x90, 0xe7, 0x31, 0x6e, 0x56, 0x15, 0xaf, 0xbf, 0xc6, 0xf3, 0x18, 0xa7,
-	0x27, 0x50, 0x75, 0x59, 0xc0, 0x38, 0x33, 0x6b, 0x82, 0xba, 0x83, 0xd4, 0x9e, 0x40, 0x74, 0x76,
-	0x46, 0xa3, 0x5f, 0x80, 0x91, 0x8e, 0x9d, 0xd0, 0xac, 0x0b, 0xfa, 0x63, 0xb1, 0x83, 0xb1, 0x13,
-	0xea, 0x64, 0x41, 0xa1, 0xdf, 0x02, 0xc4, 0x09, 0x9b, 0xf8, 0x77, 0x48, 0x30, 0x1b, 0x42, 0xf0,
-	0x04, 0x05, 0xdf, 0x2f, 0x51, 0x5d, 0x56, 0xa0, 0xd3, 0x53, 0xa8, 0x39, 0x41, 0x10, 0x8d, 0x07,
-	0x3d, 0x13, 0x84, 0x92, 0xa2, 0xf2, 0x5c, 0x42, 0xba, 0x2c, 0x27, 0xd2, 0x1e, 0xb4, 0x78, 0xcc,
-	0x54, 0x76, 0x73, 0x5b, 0x28, 0x4d, 0x51, 0xfa, 0xe2, 0x84, 0xae, 0xd7, 0x45, 0x58, 0x12, 0x27,
-	0x8e, 0x59, 0xe8, 0x9a, 0xae, 0x2a, 0xc9, 0xb9, 0x40, 0x56, 0x4a, 0x22, 0x69, 0xf4, 0x3b, 0xd8,
-	0xf6, 0x18, 0x1f, 0x86, 0x4e, 0x9c, 0x4e, 0x23, 0x6e, 0x32, 0xa1, 0x7a, 0x9a, 0xd5, 0x3c, 0x87,
-	0x75, 0x69, 0x51, 0x40, 0xcf, 0xa0, 0xc1, 0x9d, 0x51, 0xc0, 0xf8, 0xc0, 0x4d, 0xcd, 0x89, 0x50,
-	0xef, 0x8a, 0x2d, 0x4b, 0xb0, 0x97, 0xea, 0x5a, 0x45, 0xa6, 0xe7, 0xd0, 0x1c, 0x27, 0xcc, 0xe1,
-	0x4c, 0x52, 0x4d, 0x4f, 0x88, 0x3f, 0x46, 0xf1, 0x45, 0x01, 0xd7, 0xf5, 0x9a, 0x04, 0x9b, 0xe4,
-	0x26, 0x51, 0x9c, 0x25, 0x98, 0xaa, 0x26, 0xf5, 0x96, 0xe8, 0x4a, 0x93, 0x14, 0x1d, 0x0b, 0x8e,
-	0x07, 0x61, 0xde, 0x8c, 0x85, 0x62, 0xf7, 0xbe, 0x2a, 0x78, 0xbf, 0x38, 0xb1, 0x52, 0x70, 0x4d,
-	0x44, 0xfb, 0xd0, 0x56, 0x00, 0x73, 0x07, 0xae, 0xf9, 0x56, 0xa4, 0x79, 0xa6, 0xa7, 0xc1, 0x19,
-	0x3d, 0xcf, 0x8a, 0x0c, 0x1b, 0x21, 0xcf, 0x36, 0x08, 0x5d, 0x76, 0x67, 0xde, 0xaa, 0x46, 0x5c,
-	0x28, 0x78, 0xa5, 0x11, 0x05, 0x01, 0x36, 0x02, 0x0f, 0x27, 0xd5, 0x81, 0x6a, 0x44, 0x2f, 0x07,
-	0x57, 0x1a, 0xb1, 0x24, 0x5b, 0x7f, 0x56, 0xa0, 0x6e, 0xb3, 0x34, 0x8e, 0xc2, 0x94, 0xfd, 0x4f,
-	0x43, 0x78, 0x09, 0x5b, 0x2c, 0x49, 0xa2, 0x44, 0x18, 0x42, 0xf6, 0xd5, 0x5d, 0x22, 0x90, 0xe7,
-	0xcd, 0x56, 0x95, 0x2c, 0xfa, 0x35, 0x34, 0x46, 0x0b, 0xce, 0x52, 0x9c, 0x15, 0x16, 0x91, 0x49,
-	0xba, 0x39, 0x58, 0x90, 0x28, 0x26, 0x3d, 0x85, 0xfa, 0x28, 0x8a, 0x02, 0xa1, 0x92, 0x26, 0x42,
-	0x84, 0x2a, 0xc3, 0x0a, 0xa2, 0x25, 0x8f, 0x9e, 0x01, 0xcc, 0xfd, 0x90, 0x7f, 0xf3, 0x95, 0x50,
-	0x55, 0xd5, 0x6d, 0xfc, 0x61, 0x89, 0x16, 0x74, 0x05, 0x6e, 0xae, 0x7c, 0x75, 0x2a, 0x94, 0x35,
-	0x5d, 0x29, 0xd1, 0x55, 0xa5, 0x44, 0x69, 0x0f, 0xda, 0x62, 0xd3, 0xc3, 0xc0, 0x1f, 0x33, 0xa1,
-	0xae, 0xab, 0x6e, 0x76, 0xb5, 0x99, 0x42, 0x86, 0x15, 0x0d, 0xae, 0x9f, 0xf2, 0xc4, 0x0f, 0x3d,
-	0x91, 0xa1, 0xa1, 0xd6, 0x1f, 0x2e, 0xd1, 0xe2, 0xfa, 0x8a, 0x6b, 0xbd, 0x01, 0x50, 0xc6, 0x4a,
-	0x09, 0x54, 0x6e, 0xd9, 0x42, 0xb4, 0xb4, 0x69, 0xe3, 0x10, 0xdd, 0xf9, 0x57, 0x27, 0x98, 0xcb,
-	0x66, 0x36, 0x6d, 0x19, 0xd0, 0x67, 0x50, 0xe1, 0x3c, 0x10, 0x1d, 0xac, 0x74, 0x6b, 0x0f, 0xf7,
-	0x7b, 0x95, 0x9b, 0x9b, 0x2b, 0x1b, 0x31, 0xab, 0x03, 0xd0, 0xff, 0x97, 0x84, 0xd6, 0x27, 0xd0,
-	0xd2, 0x6c, 0x77, 0x03, 0xe5, 0x0c, 0xda, 0xba, 0xff, 0x6d, 0xde, 0xd7, 0xc8, 0xe1, 0xe3, 0xa9,
-	0xd8, 0x97, 0x61, 0xcb, 0xc0, 0x7a, 0x0d, 0xdb, 0x05, 0xdb, 0x13, 0x4f, 0x0b, 0x77, 0x12, 0x9e,
-	0x09, 0x65, 0x80, 0xc9, 0xd0, 0xf4, 0xe4, 0x81, 0x70, 0x88, 0xbc, 0xc0, 0x9f, 0xf9, 0x5c, 0x1c,
-	0xc8, 0xb0, 0x65, 0x60, 0xfd, 0x04, 0x3b, 0x6b, 0x4e, 0x4a, 0x9f, 0x42, 0x55, 0x9a, 0x77, 0x96,
-	0x33, 0x8b, 0xe8, 0x73, 0xa8, 0x8b, 0xec, 0xaf, 0xd9, 0x22, 0xcb, 0xbc, 0x8c, 0x3f, 0x90, 0xfe,
-	0x37, 0xd8, 0xdd, 0xe4, 0xd5, 0xf4, 0x08, 0x88, 0xcc, 0xf9, 0x26, 0x19, 0xe6, 0x19, 0xe5, 0x5a,
-	0x6b, 0x38, 0xb5, 0xa0, 0x29, 0xb1, 0x2b, 0x16, 0x7a, 0x5c, 0x16, 0xa3, 0x62, 0x6b, 0xd8, 0x07,
-	0x56, 0xbf, 0x80, 0x96, 0x66, 0xf5, 0xb4, 0x03, 0x20, 0xfd, 0xf6, 0xda, 0x99, 0x31, 0xb1, 0x60,
-	0xc3, 0x2e, 0x20, 0x94, 0x82, 0xe1, 0x3a, 0xdc, 0xc9, 0x0e, 0x27, 0xc6, 0xd6, 0x01, 0xd0, 0x75,
-	0xe7, 0xc7, 0xfa, 0x8e, 0x79, 0x5e, 0x1f, 0x1c, 0x5a, 0x47, 0xb0, 0xbb, 0xc9, 0x25, 0x31, 0x67,
-	0xa8, 0x56, 0x13, 0x63, 0xeb, 0x4b, 0x78, 0xb2, 0xd1, 0x0a, 0xa9, 0x09, 0x35, 0xf1, 0xd3, 0x60,
-	0x90, 0x59, 0x8e, 0x9d, 0x87, 0xd6, 0x15, 0xd0, 0x75, 0xdf, 0xa3, 0x2f, 0xb2, 0xd7, 0xa6, 0x70,
-	0x1e, 0x05, 0x60, 0x36, 0x3f, 0x74, 0xfd, 0x31, 0x4b, 0xb3, 0x13, 0xe5, 0xa1, 0x75, 0x0d, 0x64,
-	0xd5, 0x07, 0xff, 0x23, 0xd7, 0x0b, 0x68, 0xf8, 0xc8, 0x16, 0xb3, 0x65, 0x39, 0xbb, 0x04, 0x2c,
-	0x0a, 0x64, 0xf5, 0x81, 0xb3, 0xfa, 0xf0, 0xd1, 0x86, 0x77, 0x6b, 0x53, 0x3d, 0x96, 0x4b, 0x0f,
-	0xc2, 0x49, 0x94, 0x6d, 0x55, 0x01, 0xd6, 0xe7, 0xb0, 0xb3, 0xf6, 0x7e, 0x6d, 0x2c, 0xeb, 0x67,
-	0xd0, 0xd2, 0x4c, 0x16, 0x3f, 0x0b, 0x69, 0xc3, 0x92, 0x25, 0x03, 0xeb, 0x31, 0xb4, 0x2e, 0x67,
-	0x31, 0x5f, 0xe4, 0x34, 0xeb, 0x00, 0xda, 0xba, 0x87, 0x28, 0x47, 0xc8, 0x84, 0x22, 0xc0, 0xfc,
-	0x9a, 0x23, 0xeb, 0xb4, 0xdc, 0x38, 0xac, 0x4f, 0xa1, 0x59, 0xb4, 0x60, 0x9d, 0x55, 0xcf, 0x59,
-	0x07, 0xd0, 0xd6, 0x2d, 0x57, 0xe7, 0x19, 0x39, 0xef, 0x67, 0xa0, 0xeb, 0x16, 0x89, 0xc7, 0xbf,
-	0x65, 0x8b, 0xd4, 0x2c, 0xed, 0x57, 0xf0, 0x4b, 0xc5, 0x31, 0x5e, 0x5b, 0x21, 0xc1, 0x6e, 0x23,
-	0x9a, 0x45, 0x58, 0xdd, 0xc0, 0x49, 0xf9, 0x8f, 0x22, 0xb7, 0xbc, 0x20, 0x0a, 0xc8, 0xf7, 0xa1,
-	0x0c, 0x5c, 0xdf, 0x47, 0x2b, 0xdb, 0xc7, 0xd1, 0x1f, 0x65, 0x30, 0xf0, 0x85, 0xa3, 0x35, 0xa8,
-	0x0c, 0x19, 0x27, 0x8f, 0x70, 0xd0, 0x63, 0x01, 0x29, 0xe1, 0xa0, 0xcf, 0x38, 0x29, 0xd3, 0x36,
-	0x80, 0xba, 0xeb, 0xa4, 0x42, 0xeb, 0x60, 0x88, 0x91, 0x81, 0xa3, 0x41, 0x38, 0x4e, 0xc8, 0x16,
-	0xdd, 0x81, 0xd6, 0x90, 0xf1, 0xc1, 0xe4, 0x3a, 0xe2, 0x97, 0x77, 0x7e, 0xca, 0x49, 0x15, 0xa1,
-	0x1e, 0x0b, 0x0a, 0x50, 0x0d, 0x21, 0xcd, 0x38, 0x48, 0x9d, 0x02, 0x54, 0xe5, 0x6d, 0x26, 0x2e,
-	0x7d, 0x0c, 0xdb, 0x85, 0x4b, 0x49, 0x18, 0x25, 0xd0, 0x2c, 0x7e, 0x6c, 0x64, 0x82, 0x7b, 0x51,
-	0x5f, 0x0d, 0xf1, 0x68, 0x13, 0x1f, 0xf5, 0xc0, 0xe1, 0x7e, 0x14, 0x92, 0x29, 0x6d, 0x41, 0xe3,
-	0x26, 0xff, 0xe5, 0x45, 0x7c, 0xcc, 0x77, 0xb3, 0xb4, 0x81, 0x94, 0xbc, 0xc5, 0xf5, 0xb5, 0xdb,
-	0x4c, 0x6e, 0x29, 0x85, 0xb6, 0x7e, 0x69, 0x49, 0x80, 0xba, 0xc2, 0xad, 0x24, 0x33, 0xcc, 0xbb,
-	0xbc, 0x58, 0x24, 0xec, 0x92, 0xf7, 0x7f, 0x77, 0x1e, 0xbd, 0x7b, 0xe8, 0x94, 0xde, 0x3f, 0x74,
-	0x4a, 0x7f, 0x3d, 0x74, 0x4a, 0xa3, 0xaa, 0xf8, 0xcf, 0xf1, 0xea, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x71, 0x30, 0x32, 0x75, 0xbf, 0x0c, 0x00, 0x00,
+	// 1361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x5f, 0x6f, 0xdb, 0xb6,
+	0x17, 0xad, 0x2d, 0xc5, 0x7f, 0x6e, 0x6c, 0x97, 0xe1, 0x2f, 0xed, 0x8f, 0x2d, 0x8a, 0x24, 0x13,
+	0xb6, 0xac, 0x2b, 0xd0, 0x14, 0x73, 0xd6, 0xa1, 0xc0, 0x80, 0x01, 0x49, 0x5d, 0x18, 0x46, 0x8b,
+	0xb6, 0x90, 0xb3, 0xbd, 0x6d, 0x80, 0x6c, 0x31, 0x8e, 0x1a, 0x59, 0x52, 0x25, 0x66, 0xad, 0x87,
+	0x7d, 0xc0, 0x3e, 0x0d, 0x05, 0xf6, 0x5e, 0x6c, 0x79, 0xd9, 0xd7, 0x18, 0x2e, 0x29, 0x89, 0xa2,
+	0xed, 0xa6, 0x40, 0xdf, 0xc8, 0xc3, 0x73, 0x2e, 0xc9, 0x7b, 0xc5, 0x73, 0x6d, 0x68, 0xa7, 0xc9,
+	0xf4, 0x20, 0x49, 0x63, 0x11, 0xd3, 0x7a, 0x32, 0xb9, 0x7d, 0x7f, 0x16, 0x88, 0xb3, 0x8b, 0xc9,
+	0xc1, 0x34, 0x9e, 0x3f, 0x98, 0xc5, 0xb3, 0xf8, 0x81, 0x5c, 0x9a, 0x5c, 0x9c, 0xca, 0x99, 0x9c,
+	0xc8, 0x91, 0x92, 0xdc, 0x86, 0x39, 0x17, 0x9e, 0x1a, 0x3b, 0x7f, 0xb5, 0xa1, 0xe9, 0xf2, 0xd7,
+	0x17, 0x3c, 0x13, 0xf4, 0x26, 0xd4, 0x03, 0x9f, 0xd5, 0xf6, 0x6a, 0x77, 0xed, 0xe3, 0xc6, 0xe5,
+	0x87, 0xdd, 0xfa, 0x68, 0xe0, 0xd6, 0x03, 0x9f, 0xde, 0x01, 0x5b, 0x2c, 0x12, 0xce, 0xea, 0x7b,
+	0xb5, 0xbb, 0xbd, 0x7e, 0xeb, 0x20, 0x99, 0x1c, 0x9c, 0x2c, 0x12, 0xee, 0x4a, 0x94, 0xee, 0xc2,
+	0xc6, 0x2c, 0x8d, 0x2f, 0x12, 0x66, 0xc9, 0xe5, 0x36, 0x2e, 0x0f, 0x11, 0x70, 0x15, 0x4e, 0xb7,
+	0x61, 0x23, 0x3b, 0xf3, 0x52, 0x9f, 0xd9, 0x18, 0xd9, 0x55, 0x13, 0xba, 0x0f, 0x56, 0xc6, 0x05,
+	0xdb, 0xd8, 0xab, 0xdd, 0xdd, 0xec, 0xf7, 0x50, 0x34, 0xe6, 0x22, 0x3f, 0xc9, 0xb1, 0xfd, 0xee,
+	0xc3, 0xee, 0x35, 0x17, 0x09, 0xc8, 0x9b, 0x71, 0xc1, 0x1a, 0x9a, 0x37, 0x5c, 0xe1, 0xcd, 0xb8,
+	0xa0, 0x0f, 0xa0, 0xe1, 0xf3, 0x90, 0x0b, 0xce, 0x9a, 0x92, 0xba, 0x85, 0xd4, 0x81, 0x44, 0x4c,
+	0x76, 0x4e, 0xa3, 0xdf, 0x80, 0x9d, 0x4d, 0xbd, 0x88, 0xb5, 0x24, 0xfd, 0xba, 0x3c, 0xc1, 0xd4,
+	0x8b, 0x4c, 0xb2, 0xa4, 0xd0, 0x1f, 0x00, 0x92, 0x94, 0x9f, 0x06, 0x6f, 0x91, 0xc0, 0xda, 0x52,
+	0x70, 0x03, 0x05, 0x2f, 0x4b, 0xd4, 0x94, 0x55, 0xe8, 0xb4, 0x0f, 0x4d, 0x2f, 0x0c, 0xe3, 0xe9,
+	0x68, 0xc0, 0x40, 0x2a, 0x29, 0x2a, 0x8f, 0x14, 0x64, 0xca, 0x0a, 0x22, 0x1d, 0x40, 0x57, 0x24,
+	0x5c, 0x47, 0x67, 0x9b, 0x52, 0xc9, 0x64, 0xea, 0xab, 0x0b, 0xa6, 0xde, 0x14, 0x61, 0x4a, 0xbc,
+	0x24, 0xe1, 0x91, 0xcf, 0x7c, 0x9d, 0x92, 0x23, 0x89, 0x2c, 0xa5, 0x44, 0xd1, 0xe8, 0x8f, 0xb0,
+	0x39, 0xe3, 0x62, 0x1c, 0x79, 0x49, 0x76, 0x16, 0x0b, 0xc6, 0xa5, 0xea, 0x66, 0x9e, 0xf3, 0x02,
+	0x36, 0xa5, 0x55, 0x01, 0x7d, 0x04, 0x6d, 0xe1, 0x4d, 0x42, 0x2e, 0x46, 0x7e, 0xc6, 0x4e, 0xa5,
+	0x7a, 0x5b, 0x1e, 0x59, 0x81, 0x83, 0xcc, 0xd4, 0x6a, 0x32, 0x3d, 0x82, 0xce, 0x34, 0xe5, 0x9e,
+	0xe0, 0x8a, 0xca, 0x66, 0x52, 0xfc, 0x7f, 0x14, 0x3f, 0xae, 0xe0, 0xa6, 0xde, 0x90, 0x60, 0x91,
+	0xfc, 0x34, 0x4e, 0xf2, 0x00, 0x67, 0xba, 0x48, 0x83, 0x12, 0x5d, 0x2a, 0x92, 0xa6, 0x63, 0xc2,
+	0xf1, 0x22, 0x7c, 0x36, 0xe7, 0x91, 0x3c, 0x7d, 0xa0, 0x13, 0x3e, 0xac, 0x2e, 0x2c, 0x25, 0xdc,
+	0x10, 0xd1, 0x21, 0xf4, 0x34, 0xc0, 0xfd, 0x91, 0xcf, 0x5e, 0xc9, 0x30, 0xb7, 0xcc, 0x30, 0xb8,
+	0x62, 0xc6, 0x59, 0x92, 0x61, 0x21, 0xd4, 0xdd, 0x46, 0x91, 0xcf, 0xdf, 0xb2, 0x73, 0x5d, 0x88,
+	0xc7, 0x1a, 0x5e, 0x2a, 0x44, 0x45, 0x80, 0x85, 0xc0, 0xcb, 0x29, 0x75, 0xa8, 0x0b, 0x31, 0x28,
+	0xc0, 0xa5, 0x42, 0x94, 0x64, 0xdc, 0xd9, 0x0b, 0x05, 0x4f, 0xf3, 0x34, 0xce, 0xf5, 0xce, 0x47,
+	0x1a, 0x5e, 0xda, 0xb9, 0x22, 0xc0, 0x14, 0xc4, 0x89, 0x08, 0xe6, 0xc1, 0xef, 0x45, 0x29, 0x23,
+	0x9d, 0x82, 0x17, 0xc6, 0xca, 0x52, 0x0a, 0x4c, 0x99, 0x2c, 0xa7, 0x7a, 0xbd, 0xf1, 0x9b, 0x8c,
+	0xc5, 0x95, 0x72, 0x96, 0xe8, 0x72, 0x39, 0xcb, 0x05, 0xe7, 0x4f, 0x0b, 0x5a, 0x2e, 0xcf, 0x92,
+	0x38, 0xca, 0xf8, 0x67, 0xda, 0xda, 0x7d, 0xd8, 0xe0, 0x69, 0x1a, 0xa7, 0xcc, 0xd2, 0x6f, 0xe7,
+	0x09, 0x02, 0x45, 0xdc, 0x7c, 0x5b, 0xc5, 0xa2, 0x0f, 0xa1, 0x3d, 0x59, 0x08, 0x9e, 0xe1, 0x2a,
+	0xb3, 0xb5, 0xe4, 0xb8, 0x00, 0x2b, 0x12, 0xcd, 0xa4, 0x7d, 0x68, 0x4d, 0xe2, 0x38, 0x94, 0x2a,
+	0x65, 0x85, 0x44, 0xaa, 0x72, 0xac, 0x22, 0x2a, 0x79, 0xf4, 0x11, 0xc0, 0x45, 0x10, 0x89, 0xef,
+	0xbf, 0x93, 0xaa, 0x86, 0xf6, 0x94, 0x9f, 0x4a, 0xb4, 0xa2, 0xab, 0x70, 0x0b, 0xe5, 0x61, 0x5f,
+	0x2a, 0x9b, 0xa6, 0xf2, 0xb0, 0xbf, 0x4e, 0xa9, 0x50, 0x3a, 0x80, 0x9e, 0x3c, 0xf4, 0x38, 0x0c,
+	0xa6, 0x5c, 0xaa, 0x5b, 0xfa, 0xcb, 0x38, 0x36, 0x56, 0x2a, 0x11, 0x96, 0x34, 0xb8, 0x7f, 0x26,
+	0xd2, 0x20, 0x9a, 0xc9, 0x08, 0x6d, 0xbd, 0xff, 0xb8, 0x44, 0xab, 0xfb, 0x6b, 0xae, 0xf3, 0x02,
+	0x40, 0xb7, 0x07, 0x4a, 0xc0, 0x3a, 0xe7, 0x0b, 0x59, 0xd2, 0x8e, 0x8b, 0x43, 0xec, 0x31, 0xbf,
+	0x79, 0xe1, 0x85, 0x2a, 0x66, 0xc7, 0x55, 0x13, 0x7a, 0x0b, 0x2c, 0x21, 0x42, 0x59, 0x41, 0xeb,
+	0xb8, 0x79, 0xf9, 0x61, 0xd7, 0x3a, 0x39, 0x79, 0xe6, 0x22, 0xe6, 0xec, 0x00, 0x0c, 0xaf, 0x08,
+	0xe8, 0x7c, 0x01, 0x5d, 0xa3, 0x79, 0xac, 0xa1, 0x3c, 0x82, 0x9e, 0xe9, 0xe2, 0xeb, 0xcf, 0x35,
+	0xf1, 0xc4, 0xf4, 0x4c, 0x9e, 0xcb, 0x76, 0xd5, 0xc4, 0x79, 0x0a, 0x9b, 0x15, 0xf3, 0x46, 0x52,
+	0x26, 0xbc, 0x54, 0xe4, 0x42, 0x35, 0xc1, 0x60, 0x68, 0xdd, 0xea, 0x42, 0x38, 0x44, 0x5e, 0x18,
+	0xcc, 0x03, 0x21, 0x2f, 0x64, 0xbb, 0x6a, 0xe2, 0xfc, 0x02, 0x5b, 0x2b, 0xfd, 0x80, 0xde, 0x84,
+	0x86, 0x6a, 0x41, 0x79, 0xcc, 0x7c, 0x46, 0x6f, 0x43, 0x4b, 0x46, 0x7f, 0xca, 0x17, 0x79, 0xe4,
+	0x72, 0xfe, 0x91, 0xf0, 0x7f, 0xc0, 0xf6, 0xba, 0x8e, 0x43, 0xef, 0x01, 0x51, 0x31, 0x5f, 0xa4,
+	0xe3, 0x22, 0xa2, 0xda, 0x6b, 0x05, 0xa7, 0x0e, 0x74, 0x14, 0xf6, 0x8c, 0x47, 0x33, 0xa1, 0x92,
+	0x61, 0xb9, 0x06, 0xf6, 0x91, 0xdd, 0xc7, 0xd0, 0x35, 0x1a, 0x16, 0xdd, 0x01, 0x50, 0x5d, 0xe3,
+	0xb9, 0x37, 0xe7, 0x72, 0xc3, 0xb6, 0x5b, 0x41, 0x28, 0x05, 0xdb, 0xf7, 0x84, 0x97, 0x5f, 0x4e,
+	0x8e, 0x69, 0x0f, 0xea, 0x22, 0x53, 0x5f, 0x81, 0x5b, 0x17, 0x99, 0xb3, 0x0f, 0x74, 0xb5, 0x9f,
+	0x61, 0xbe, 0xa7, 0xa2, 0xc8, 0x17, 0x0e, 0x9d, 0x7b, 0xb0, 0xbd, 0xce, 0xfb, 0x71, 0x8f, 0x48,
+	0xef, 0x2e, 0xc7, 0xce, 0xb7, 0x70, 0x63, 0xad, 0xc1, 0x53, 0x06, 0x4d, 0xf9, 0x83, 0x67, 0x94,
+	0x5b, 0x90, 0x5b, 0x4c, 0x9d, 0x67, 0x40, 0x57, 0xdd, 0x9c, 0xde, 0xc9, 0x7b, 0x68, 0xe5, 0x7e,
+	0x1a, 0xc0, 0x68, 0x41, 0xe4, 0x07, 0x53, 0x9e, 0xe5, 0x37, 0x2c, 0xa6, 0xce, 0x73, 0x20, 0xcb,
+	0xee, 0xfe, 0x89, 0x58, 0x77, 0xa0, 0x1d, 0x20, 0x5b, 0xae, 0xd6, 0xd5, 0x6a, 0x09, 0x38, 0x14,
+	0xc8, 0x72, 0xdb, 0x76, 0x86, 0xf0, 0xbf, 0x35, 0xdd, 0x78, 0x5d, 0x3e, 0xca, 0xad, 0x47, 0xd1,
+	0x69, 0x9c, 0x1f, 0x55, 0x03, 0xce, 0xd7, 0xb0, 0xb5, 0xd2, 0x95, 0xd7, 0xa6, 0xf5, 0x2b, 0xe8,
+	0x1a, 0xa6, 0x8b, 0x9f, 0x89, 0xb2, 0x65, 0xc5, 0x52, 0x13, 0xe7, 0x3a, 0x74, 0x9f, 0xcc, 0x13,
+	0xb1, 0x28, 0x68, 0xce, 0x3e, 0xf4, 0x4c, 0x4f, 0xd1, 0x0e, 0x91, 0x0b, 0xe5, 0x04, 0xe3, 0x1b,
+	0x0e, 0x6d, 0xd2, 0x0a, 0x23, 0x71, 0xbe, 0x84, 0x4e, 0xd5, 0x92, 0x4d, 0x56, 0xab, 0x60, 0xed,
+	0x43, 0xcf, 0xb4, 0x60, 0x93, 0x67, 0x17, 0xbc, 0x5f, 0x81, 0xae, 0x5a, 0x26, 0x5e, 0xff, 0x9c,
+	0x2f, 0x32, 0x56, 0xdb, 0xb3, 0xf0, 0xcb, 0xc5, 0x31, 0x3e, 0x63, 0x29, 0xc1, 0x6a, 0x23, 0x9a,
+	0xcf, 0x30, 0xbb, 0xa1, 0x97, 0x89, 0x9f, 0x65, 0x6c, 0xf5, 0x60, 0x34, 0x50, 0x9c, 0xe3, 0xb0,
+	0xbf, 0xfe, 0x1c, 0xdd, 0xe2, 0x1c, 0x2f, 0x81, 0xae, 0x36, 0xf5, 0x4f, 0x7f, 0x34, 0x57, 0xd4,
+	0xf5, 0x21, 0xdc, 0x58, 0xdb, 0xe3, 0xaf, 0x0e, 0xea, 0xbc, 0x86, 0xad, 0x95, 0xae, 0xfe, 0x59,
+	0x2f, 0x9d, 0x41, 0x33, 0xe2, 0x6f, 0x06, 0x08, 0x5b, 0xea, 0x79, 0xe4, 0xd3, 0xdc, 0x03, 0xec,
+	0xc2, 0x03, 0xee, 0xfd, 0x5b, 0x07, 0x1b, 0xbb, 0x3d, 0x6d, 0x82, 0x35, 0xe6, 0x82, 0x5c, 0xc3,
+	0xc1, 0x80, 0x87, 0xa4, 0x86, 0x83, 0x21, 0x17, 0xa4, 0x4e, 0x7b, 0x00, 0xda, 0xf7, 0x88, 0x45,
+	0x5b, 0x60, 0xcb, 0x91, 0x8d, 0xa3, 0x51, 0x34, 0x4d, 0xc9, 0x06, 0xdd, 0x82, 0xee, 0x98, 0x8b,
+	0xd1, 0xe9, 0xf3, 0x58, 0x3c, 0x79, 0x1b, 0x64, 0x82, 0x34, 0x10, 0x1a, 0xf0, 0xb0, 0x02, 0x35,
+	0x11, 0x32, 0x4c, 0x94, 0xb4, 0x28, 0x40, 0x43, 0x39, 0x1b, 0xf1, 0xe9, 0x75, 0xd8, 0xac, 0x18,
+	0x12, 0xe1, 0x94, 0x40, 0xa7, 0xfa, 0xd0, 0xc8, 0x29, 0x9e, 0x45, 0xbf, 0x18, 0x32, 0xa3, 0x1d,
+	0xfc, 0x81, 0x13, 0x7a, 0x22, 0x88, 0x23, 0x72, 0x46, 0xbb, 0xd0, 0x3e, 0x29, 0x7e, 0x4b, 0x93,
+	0x00, 0xe3, 0x9d, 0x94, 0x89, 0xca, 0xc8, 0x2b, 0xdc, 0xdf, 0x70, 0x32, 0x72, 0x4e, 0x29, 0xf4,
+	0x4c, 0xc3, 0x22, 0x21, 0xea, 0x2a, 0x8e, 0x44, 0xe6, 0x18, 0xb7, 0x34, 0x15, 0x12, 0xe1, 0x7a,
+	0xe5, 0x83, 0x21, 0x31, 0x06, 0x31, 0xeb, 0x4d, 0x12, 0x79, 0xd2, 0xb2, 0x98, 0xe4, 0xf5, 0x31,
+	0x79, 0xff, 0xcf, 0xce, 0xb5, 0x77, 0x97, 0x3b, 0xb5, 0xf7, 0x97, 0x3b, 0xb5, 0xbf, 0x2f, 0x77,
+	0x6a, 0x93, 0x86, 0xfc, 0xeb, 0x79, 0xf8, 0xdf, 0x00, 0x84, 0x44, 0xd7, 0xe7, 0xc6, 0x0e, 0x00,
+	0x00,
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
@@ -1806,6 +2034,42 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	{
+		size, err := m.DeleteRows.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6
+	i--
+	dAtA[i] = 0xfa
+	{
+		size, err := m.OptimizeTablet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6
+	i--
+	dAtA[i] = 0xf2
+	{
+		size, err := m.AlterTablet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRpc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6
+	i--
+	dAtA[i] = 0xea
 	{
 		size, err := m.DropIndex.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2427,6 +2691,11 @@ func (m *AppendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Ts != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Ts))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -3008,75 +3277,209 @@ func (m *Uint32Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRpc(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRpc(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AlterTabletRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AlterTabletRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTabletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ID != 0 {
-		n += 1 + sovRpc(uint64(m.ID))
-	}
-	if m.Type != 0 {
-		n += 1 + sovRpc(uint64(m.Type))
-	}
-	if m.Group != 0 {
-		n += 1 + sovRpc(uint64(m.Group))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Shard != 0 {
-		n += 1 + sovRpc(uint64(m.Shard))
+	if len(m.TableInfo) > 0 {
+		i -= len(m.TableInfo)
+		copy(dAtA[i:], m.TableInfo)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.TableInfo)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Set.Size()
-	n += 1 + l + sovRpc(uint64(l))
-	l = m.Get.Size()
-	n += 1 + l + sovRpc(uint64(l))
-	l = m.Delete.Size()
-	n += 1 + l + sovRpc(uint64(l))
-	l = m.Scan.Size()
-	n += 1 + l + sovRpc(uint64(l))
-	l = m.PrefixScan.Size()
-	n += 1 + l + sovRpc(uint64(l))
-	l = m.AllocID.Size()
-	n += 1 + l + sovRpc(uint64(l))
-	l = m.TpePrefixScan.Size()
-	n += 1 + l + sovRpc(uint64(l))
-	l = m.Append.Size()
-	n += 2 + l + sovRpc(uint64(l))
-	l = m.GetSnapshot.Size()
-	n += 2 + l + sovRpc(uint64(l))
-	l = m.TabletIds.Size()
-	n += 2 + l + sovRpc(uint64(l))
-	l = m.CreateTablet.Size()
-	n += 2 + l + sovRpc(uint64(l))
-	l = m.DropTablet.Size()
-	n += 2 + l + sovRpc(uint64(l))
-	l = m.GetSegmentIds.Size()
-	n += 2 + l + sovRpc(uint64(l))
-	l = m.GetSegmentedId.Size()
-	n += 2 + l + sovRpc(uint64(l))
-	l = m.CreateIndex.Size()
-	n += 2 + l + sovRpc(uint64(l))
-	l = m.DropIndex.Size()
-	n += 2 + l + sovRpc(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.TableName) > 0 {
+		i -= len(m.TableName)
+		copy(dAtA[i:], m.TableName)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.TableName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Response) Size() (n int) {
-	if m == nil {
+func (m *OptimizeTabletRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptimizeTabletRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptimizeTabletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TableName) > 0 {
+		i -= len(m.TableName)
+		copy(dAtA[i:], m.TableName)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.TableName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteRowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ts != 0 {
+		i = encodeVarintRpc(dAtA, i, uint64(m.Ts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewData) > 0 {
+		i -= len(m.NewData)
+		copy(dAtA[i:], m.NewData)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.NewData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TabletName) > 0 {
+		i -= len(m.TabletName)
+		copy(dAtA[i:], m.TabletName)
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.TabletName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRpc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRpc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRpc(uint64(m.ID))
+	}
+	if m.Type != 0 {
+		n += 1 + sovRpc(uint64(m.Type))
+	}
+	if m.Group != 0 {
+		n += 1 + sovRpc(uint64(m.Group))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovRpc(uint64(m.Shard))
+	}
+	l = m.Set.Size()
+	n += 1 + l + sovRpc(uint64(l))
+	l = m.Get.Size()
+	n += 1 + l + sovRpc(uint64(l))
+	l = m.Delete.Size()
+	n += 1 + l + sovRpc(uint64(l))
+	l = m.Scan.Size()
+	n += 1 + l + sovRpc(uint64(l))
+	l = m.PrefixScan.Size()
+	n += 1 + l + sovRpc(uint64(l))
+	l = m.AllocID.Size()
+	n += 1 + l + sovRpc(uint64(l))
+	l = m.TpePrefixScan.Size()
+	n += 1 + l + sovRpc(uint64(l))
+	l = m.Append.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.GetSnapshot.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.TabletIds.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.CreateTablet.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.DropTablet.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.GetSegmentIds.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.GetSegmentedId.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.CreateIndex.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.DropIndex.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.AlterTablet.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.OptimizeTablet.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	l = m.DeleteRows.Size()
+	n += 2 + l + sovRpc(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -3263,6 +3666,9 @@ func (m *AppendRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpc(uint64(l))
 	}
+	if m.Ts != 0 {
+		n += 1 + sovRpc(uint64(m.Ts))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3536,6 +3942,69 @@ func (m *Uint32Response) Size() (n int) {
 	return n
 }
 
+func (m *AlterTabletRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	l = len(m.TableInfo)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OptimizeTabletRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TableName)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TabletName)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	l = len(m.NewData)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.Ts != 0 {
+		n += 1 + sovRpc(uint64(m.Ts))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRpc(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4175,43 +4644,142 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterTablet", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRpc
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Response) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRpc
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
+			if err := m.AlterTablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimizeTablet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OptimizeTablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 111:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeleteRows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
@@ -5423,6 +5991,25 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+			}
+			m.Ts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -6813,6 +7400,376 @@ func (m *Uint32Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AlterTabletRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTabletRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTabletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableInfo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableInfo = append(m.TableInfo[:0], dAtA[iNdEx:postIndex]...)
+			if m.TableInfo == nil {
+				m.TableInfo = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptimizeTabletRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptimizeTabletRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptimizeTabletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabletName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TabletName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewData = append(m.NewData[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewData == nil {
+				m.NewData = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+			}
+			m.Ts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRpc(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
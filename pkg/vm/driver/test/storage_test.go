@@ -297,7 +297,7 @@ func TestSnapshot(t *testing.T) {
 		err = protocol.EncodeBatch(batch, &buf)
 		require.Nil(t, err)
 		for {
-			err = d0.Append(tbl.Name, shard.ShardID, buf.Bytes())
+			err = d0.Append(tbl.Name, shard.ShardID, buf.Bytes(), 0)
 			if err == nil {
 				stdLog.Printf(" append %v\n", i)
 				break
@@ -449,7 +449,7 @@ func TestSplit(t *testing.T) {
 		var buf bytes.Buffer
 		err = protocol.EncodeBatch(batch, &buf)
 		require.Nil(t, err)
-		err = d0.Append(catalog.EncodeTabletName(sids[0], tid), sids[0], buf.Bytes())
+		err = d0.Append(catalog.EncodeTabletName(sids[0], tid), sids[0], buf.Bytes(), 0)
 		if err == nil {
 			totalRowsBeforeSplit += 10000
 		}
@@ -698,7 +698,7 @@ func TestAOEStorage(t *testing.T) {
 	err = protocol.EncodeBatch(ibat, &buf)
 	require.NoError(t, err)
 	for i := 0; i < blockCnt; i++ {
-		err = driver.Append(codec.Bytes2String(codec.EncodeKey(toShard, tableInfo.Id)), toShard, buf.Bytes())
+		err = driver.Append(codec.Bytes2String(codec.EncodeKey(toShard, tableInfo.Id)), toShard, buf.Bytes(), 0)
 		if err != nil {
 			stdLog.Printf("%v", err)
 		}
@@ -17,6 +17,9 @@ package helper
 import (
 	"bytes"
 	"encoding/gob"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -53,6 +56,15 @@ func Transfer(sid, tid, typ uint64, name string,
 	}
 	tbl.Indices = IndexDefs(sid, tid, mp, defs)
 	tbl.Properties, _ = PropertyDef(defs)
+	if _, err := Retention(tbl.Properties); err != nil {
+		return tbl, err
+	}
+	if _, _, err := Layout(tbl.Properties); err != nil {
+		return tbl, err
+	}
+	if _, _, err := Compaction(tbl.Properties); err != nil {
+		return tbl, err
+	}
 	data, err := PartitionDef(defs)
 	if err != nil {
 		return tbl, err
@@ -76,6 +88,9 @@ func UnTransfer(tbl aoe.TableInfo) (uint64, uint64, uint64, string, []engine.Tab
 		defs = append(defs, pdef)
 	}
 	for _, col := range tbl.Columns {
+		if col.Dropped {
+			continue
+		}
 		defs = append(defs, &engine.AttributeDef{
 			Attr: engine.Attribute{
 				Alg:     compress.T(col.Alg),
@@ -242,6 +257,67 @@ func PropertyDef(defs []engine.TableDef) ([]aoe.Property, error) {
 	return nil, nil
 }
 
+// Retention returns how many seconds the history of the rows of a table
+// with the given properties is retained, 0 if it is not.
+func Retention(properties []aoe.Property) (uint64, error) {
+	for _, property := range properties {
+		if strings.ToLower(property.Key) == aoe.HistoryRetention {
+			seconds, err := strconv.ParseUint(property.Value, 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid %s '%s'", aoe.HistoryRetention, property.Value)
+			}
+			return seconds, nil
+		}
+	}
+	return 0, nil
+}
+
+// Layout returns the rows of a block and the blocks of a segment of a
+// table with the given properties, 0 if it follows the storage.
+func Layout(properties []aoe.Property) (uint64, uint64, error) {
+	var blockRows, segmentBlocks uint64
+	for _, property := range properties {
+		key := strings.ToLower(property.Key)
+		if key != aoe.BlockMaxRows && key != aoe.SegmentMaxBlocks {
+			continue
+		}
+		n, err := strconv.ParseUint(property.Value, 10, 64)
+		if err != nil || n == 0 {
+			return 0, 0, fmt.Errorf("invalid %s '%s'", key, property.Value)
+		}
+		if key == aoe.BlockMaxRows {
+			blockRows = n
+		} else {
+			segmentBlocks = n
+		}
+	}
+	return blockRows, segmentBlocks, nil
+}
+
+// Compaction returns the compaction policy of a table with the given
+// properties and how many seconds its window is, an empty policy and 0
+// if they follow the storage.
+func Compaction(properties []aoe.Property) (string, uint64, error) {
+	var policy string
+	var window uint64
+	for _, property := range properties {
+		switch key := strings.ToLower(property.Key); key {
+		case aoe.Compaction:
+			policy = strings.ToLower(property.Value)
+			if policy != aoe.SizeTieredCompaction && policy != aoe.TimeWindowCompaction {
+				return "", 0, fmt.Errorf("invalid %s '%s'", key, property.Value)
+			}
+		case aoe.CompactionWindow:
+			seconds, err := strconv.ParseUint(property.Value, 10, 64)
+			if err != nil || seconds == 0 {
+				return "", 0, fmt.Errorf("invalid %s '%s'", key, property.Value)
+			}
+			window = seconds
+		}
+	}
+	return policy, window, nil
+}
+
 func Index(tbl aoe.TableInfo) []*engine.IndexTableDef {
 	defs := make([]*engine.IndexTableDef, len(tbl.Indices))
 	for i, idx := range tbl.Indices {
@@ -255,14 +331,17 @@ func Index(tbl aoe.TableInfo) []*engine.IndexTableDef {
 }
 
 func Attribute(tbl aoe.TableInfo) []engine.Attribute {
-	attrs := make([]engine.Attribute, len(tbl.Columns))
-	for i, col := range tbl.Columns {
-		attrs[i] = engine.Attribute{
+	attrs := make([]engine.Attribute, 0, len(tbl.Columns))
+	for _, col := range tbl.Columns {
+		if col.Dropped {
+			continue
+		}
+		attrs = append(attrs, engine.Attribute{
 			Alg:     compress.T(col.Alg),
 			Name:    col.Name,
 			Type:    col.Type,
 			Default: col.Default,
-		}
+		})
 	}
 	return attrs
 }
@@ -59,6 +59,11 @@ func (db *database) Create(epoch uint64, name string, defs []engine.TableDef) er
 	return err
 }
 
+//Rename renames the table.
+func (db *database) Rename(epoch uint64, name, newName string) error {
+	return db.catalog.RenameTable(epoch, db.id, name, newName)
+}
+
 //Relations returns names of all the tables in the database.
 func (db *database) Relations() []string {
 	t0 := time.Now()
@@ -20,6 +20,7 @@ import (
 	stdLog "log"
 
 	catalog2 "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	aoe3 "github.com/matrixorigin/matrixone/pkg/vm/driver/aoe"
@@ -254,6 +255,35 @@ func TestAOEEngine(t *testing.T) {
 	}
 	num := tb.NewReader(15)
 	require.Equal(t, 15, len(num))
+
+	// the tuples are updated and deleted by the row ids read along with
+	// them, the segments are only read by the node leading the tablet
+	var names []string
+	for _, attr := range attrs {
+		names = append(names, attr.Name)
+	}
+	refs := make([]uint64, len(names)+1)
+	for i := range refs {
+		refs[i] = 1
+	}
+	var rbat *batch.Batch
+	for _, c := range catalogs {
+		rdb, err := New(c, &EngineConfig{}).Database(testDBName)
+		require.NoError(t, err)
+		rtb, err := rdb.Relation(mockTbl.Name)
+		require.NoError(t, err)
+		rbat, err = rtb.NewReader(1)[0].Read(refs, append(names, vengine.RowId))
+		require.NoError(t, err)
+		rtb.Close()
+		if rbat != nil {
+			break
+		}
+	}
+	require.NotNil(t, rbat)
+	nbat := &batch.Batch{Attrs: names, Vecs: rbat.Vecs[:len(names)]}
+	require.NoError(t, tb.Update(4, rbat, nbat))
+	require.NoError(t, tb.Delete(4, rbat))
+	require.Equal(t, errNoRowIds, tb.Delete(4, ibat))
 	tb.Close()
 
 	err = db.Delete(5, mockTbl.Name)
@@ -0,0 +1,58 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+var errHistoryReadOnly = errors.New("a table read as of a past time cannot be modified")
+
+//NewReader returns the readers of the blocks as they were at r.ts.
+func (r *historyRelation) NewReader(num int) []engine.Reader {
+	return r.newReader(num, r.ts)
+}
+
+func (r *historyRelation) Write(_ uint64, _ *batch.Batch) error {
+	return errHistoryReadOnly
+}
+
+func (r *historyRelation) Delete(_ uint64, _ *batch.Batch) error {
+	return errHistoryReadOnly
+}
+
+func (r *historyRelation) Update(_ uint64, _, _ *batch.Batch) error {
+	return errHistoryReadOnly
+}
+
+func (r *stampedRelation) Write(_ uint64, bat *batch.Batch) error {
+	return r.write(bat, r.ts)
+}
+
+func (r *stampedRelation) Delete(_ uint64, bat *batch.Batch) error {
+	return r.deleteRows(bat, nil, r.ts)
+}
+
+func (r *stampedRelation) Update(_ uint64, oldBat, newBat *batch.Batch) error {
+	return r.deleteRows(oldBat, newBat, r.ts)
+}
+
+//At returns the relation itself, which cannot be modified anyway.
+func (r *historyRelation) At(_ int64) engine.Relation {
+	return r
+}
@@ -34,6 +34,9 @@ func (a *aoeReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error
 	}
 	a.prv = bat
 	if bat == nil {
+		if err := a.reader.err; err != nil {
+			return nil, err
+		}
 		logutil.Infof("readerid: %d, dequeue latency: %d, enqueue latency: %d , workerid: %d",
 			a.id, a.dequeue, a.enqueue, a.workerid)
 		return nil, nil
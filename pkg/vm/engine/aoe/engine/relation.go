@@ -18,11 +18,14 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"math/rand"
 
 	"github.com/matrixorigin/matrixcube/raftstore"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	log "github.com/sirupsen/logrus"
@@ -32,6 +35,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
 	adb "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v1"
 	aoedbName "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
 
 	"time"
 
@@ -40,6 +44,8 @@ import (
 
 const defaultRetryTimes = 5
 
+var errNoRowIds = errors.New("the tuples to delete have no row ids")
+
 //Close closes the relation. It closes all relations of the tablet in the aoe store.
 func (r *relation) Close() {
 	for _, v := range r.mp {
@@ -78,6 +84,11 @@ func (r *relation) Attribute() []engine.Attribute {
 
 //Attribute writes the batch into the table.
 func (r *relation) Write(_ uint64, bat *batch.Batch) error {
+	return r.write(bat, 0)
+}
+
+//write appends the batch to a tablet of the table, stamped with ts.
+func (r *relation) write(bat *batch.Batch, ts int64) error {
 	t0 := time.Now()
 	defer func() {
 		logutil.Debugf("time cost %d ms", time.Since(t0).Milliseconds())
@@ -97,7 +108,7 @@ func (r *relation) Write(_ uint64, bat *batch.Batch) error {
 		r.mu.Lock()
 		targetTbl := r.tablets[rand.Intn(len(r.tablets))]
 		r.mu.Unlock()
-		err = r.catalog.Driver.Append(targetTbl.Name, targetTbl.ShardId, buf.Bytes())
+		err = r.catalog.Driver.Append(targetTbl.Name, targetTbl.ShardId, buf.Bytes(), ts)
 		if err == nil {
 			break
 		}
@@ -113,6 +124,133 @@ func (r *relation) Write(_ uint64, bat *batch.Batch) error {
 	return err
 }
 
+//RowIds marks the relation as one whose tuples are deleted by their row ids.
+func (r *relation) RowIds() {}
+
+//Delete deletes the tuples of the batch by their row ids.
+func (r *relation) Delete(_ uint64, bat *batch.Batch) error {
+	return r.deleteRows(bat, nil, 0)
+}
+
+//Update replaces the tuples of oldBat, taken by their row ids, by the
+//tuples at the same positions in newBat. The tuples of a tablet are
+//replaced by a single raft entry, so they are either all replaced or not
+//at all.
+func (r *relation) Update(_ uint64, oldBat, newBat *batch.Batch) error {
+	return r.deleteRows(oldBat, newBat, 0)
+}
+
+//deleteRows sends the row ids of the tuples of bat, and their replacement
+//in newBat if any, to the tablets holding them. The tablets are changed
+//one by one, so if one of them fails, the error tells how many tuples of
+//the others have been changed.
+func (r *relation) deleteRows(bat, newBat *batch.Batch, ts int64) error {
+	t0 := time.Now()
+	defer func() {
+		logutil.Debugf("time cost %d ms", time.Since(t0).Milliseconds())
+	}()
+	vec := batch.GetVector(bat, engine.RowId)
+	if vec == nil {
+		return errNoRowIds
+	}
+	ids := vec.Col.(*types.Bytes)
+	var shards []uint64
+	sels := make(map[uint64][]int64)
+	for i := range ids.Offsets {
+		shardId, _, _, err := metadata.DecodeRowId(ids.Get(int64(i)))
+		if err != nil {
+			return err
+		}
+		if _, ok := sels[shardId]; !ok {
+			shards = append(shards, shardId)
+		}
+		sels[shardId] = append(sels[shardId], int64(i))
+	}
+	rows := &batch.Batch{Attrs: []string{engine.RowId}, Vecs: []*vector.Vector{vec}}
+	changed := 0
+	for _, shardId := range shards {
+		data, newData := rows, newBat
+		if len(shards) > 1 {
+			var err error
+			if data, err = selectRows(rows, sels[shardId]); err != nil {
+				return err
+			}
+			if newData != nil {
+				if newData, err = selectRows(newBat, sels[shardId]); err != nil {
+					return err
+				}
+			}
+		}
+		if err := r.deleteTabletRows(shardId, data, newData, ts); err != nil {
+			if changed > 0 {
+				return fmt.Errorf("%w, %d of the %d tuples have been changed", err, changed, len(ids.Offsets))
+			}
+			return err
+		}
+		changed += len(sels[shardId])
+	}
+	return nil
+}
+
+//deleteTabletRows deletes the rows of bat from the tablet of the shard
+//and appends the rows of newBat, if any, in their place.
+func (r *relation) deleteTabletRows(shardId uint64, bat, newBat *batch.Batch, ts int64) error {
+	var buf, newBuf bytes.Buffer
+	if err := protocol.EncodeBatch(bat, &buf); err != nil {
+		return err
+	}
+	if newBat != nil {
+		if err := protocol.EncodeBatch(newBat, &newBuf); err != nil {
+			return err
+		}
+	}
+	var err error
+	for i := 0; i < defaultRetryTimes; i++ {
+		name, ok := r.tabletName(shardId)
+		if !ok {
+			return fmt.Errorf("tablet of shard %d of table '%s' not found", shardId, r.tbl.Name)
+		}
+		err = r.catalog.Driver.DeleteRows(name, shardId, buf.Bytes(), newBuf.Bytes(), ts)
+		if err == nil || !raftstore.IsShardUnavailableErr(err) {
+			break
+		}
+		if err = r.update(); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+//tabletName returns the name of the tablet of the shard.
+func (r *relation) tabletName(shardId uint64) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, tbl := range r.tablets {
+		if tbl.ShardId == shardId {
+			return tbl.Name, true
+		}
+	}
+	return "", false
+}
+
+//selectRows returns a copy of the tuples of bat at sels in go memory.
+func selectRows(bat *batch.Batch, sels []int64) (*batch.Batch, error) {
+	rbat := batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		data, err := vec.Show()
+		if err != nil {
+			return nil, err
+		}
+		rbat.Vecs[i] = vector.New(vec.Typ)
+		if err := rbat.Vecs[i].Read(data); err != nil {
+			return nil, err
+		}
+		rbat.Vecs[i].Or = true
+		vector.Shrink(rbat.Vecs[i], sels)
+	}
+	return rbat, nil
+}
+
 func (r *relation) update() error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -236,15 +374,69 @@ func (r *relation) TableDefs() []engine.TableDef {
 	return defs
 }
 
-func (r *relation) AddTableDef(u uint64, def engine.TableDef) error {
-	return nil
+//AddTableDef adds an attribute to the table, the rows written before
+//read its default value.
+func (r *relation) AddTableDef(epoch uint64, def engine.TableDef) error {
+	if _, ok := def.(*engine.AttributeDef); !ok {
+		return nil
+	}
+	cols := helper.ColumnDefs(r.pid, r.tbl.Id, []engine.TableDef{def})
+	return r.catalog.AddColumn(epoch, r.pid, r.tbl.Id, cols[0])
 }
 
-func (r *relation) DelTableDef(u uint64, def engine.TableDef) error {
-	return nil
+//DelTableDef drops an attribute of the table.
+func (r *relation) DelTableDef(epoch uint64, def engine.TableDef) error {
+	attr, ok := def.(*engine.AttributeDef)
+	if !ok {
+		return nil
+	}
+	return r.catalog.DropColumn(epoch, r.pid, r.tbl.Id, attr.Attr.Name)
+}
+
+//RenameAttribute renames an attribute of the table.
+func (r *relation) RenameAttribute(epoch uint64, name, newName string) error {
+	return r.catalog.RenameColumn(epoch, r.pid, r.tbl.Id, name, newName)
+}
+
+//ChangeDefault changes the default value of an attribute for the rows inserted from now on.
+func (r *relation) ChangeDefault(epoch uint64, name string, def engine.DefaultExpr) error {
+	return r.catalog.ChangeDefault(epoch, r.pid, r.tbl.Id, name, def)
+}
+
+//Alter applies the changes of the attributes of the table at once, the
+//tablets are altered to the resulting attributes only once.
+func (r *relation) Alter(epoch uint64, opts []engine.AlterOption) error {
+	return r.catalog.AlterTable(epoch, r.pid, r.tbl.Id, opts)
+}
+
+//Optimize merge-sorts the closed segments of the relation in all its tablets.
+func (r *relation) Optimize() error {
+	return r.catalog.OptimizeTable(r.pid, r.tbl.Id)
 }
 
 func (r *relation) NewReader(num int) []engine.Reader {
+	return r.newReader(num, 0)
+}
+
+//AsOf returns the relation as it was at the given unix time in nanoseconds,
+//which must not be older than the history horizon of any local tablet.
+func (r *relation) AsOf(ts int64) (engine.Relation, error) {
+	for _, v := range r.mp {
+		if horizon := v.HistoryHorizon(); ts < horizon {
+			return nil, fmt.Errorf("the history of table '%s' is not retained before %s",
+				r.tbl.Name, time.Unix(0, horizon).Format("2006-01-02 15:04:05"))
+		}
+	}
+	return &historyRelation{relation: r, ts: ts}, nil
+}
+
+//At returns the relation whose writes are stamped with the given unix time
+//in nanoseconds, e.g. the time at which a transaction commits them.
+func (r *relation) At(ts int64) engine.Relation {
+	return &stampedRelation{relation: r, ts: ts}
+}
+
+func (r *relation) newReader(num int, asOf int64) []engine.Reader {
 	iodepth := num / int(r.cfg.QueueMaxReaderCount)
 	if num%int(r.cfg.QueueMaxReaderCount) > 0 {
 		iodepth++
@@ -254,6 +446,7 @@ func (r *relation) NewReader(num int) []engine.Reader {
 		start:   false,
 		readers: make([]engine.Reader, num),
 		rel:     r,
+		asOf:    asOf,
 	}
 	readStore.rhs = make([]chan *batData, readStore.iodepth)
 	readStore.chs = make([]chan *batData, readStore.iodepth)
@@ -25,6 +25,8 @@ const (
 	FileterGt
 	FileterGe
 	FileterBtw
+	FileterIn
+	FileterNotIn
 )
 
 func NewAoeSparseFilter(s *store, reader *aoeReader) *AoeSparseFilter {
@@ -100,3 +102,23 @@ func (a AoeSparseFilter) Btw(s string, i interface{}, i2 interface{}) (engine.Re
 	})
 	return a.reader, nil
 }
+
+func (a AoeSparseFilter) In(s string, vs []interface{}) (engine.Reader, error) {
+	a.reader.filter = append(a.reader.filter, filterContext{
+		filterType: FileterIn,
+		attr: s,
+		param1: vs,
+		param2: nil,
+	})
+	return a.reader, nil
+}
+
+func (a AoeSparseFilter) NotIn(s string, vs []interface{}) (engine.Reader, error) {
+	a.reader.filter = append(a.reader.filter, filterContext{
+		filterType: FileterNotIn,
+		attr: s,
+		param1: vs,
+		param2: nil,
+	})
+	return a.reader, nil
+}
@@ -15,6 +15,8 @@
 package engine
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 )
 
@@ -31,8 +33,12 @@ func (s *store) GetBatch(refCount []uint64, attrs []string, reader *aoeReader) *
 		}
 		s.start = true
 		s.mutex.Unlock()
-		for _, filter := range reader.filter{
-			s.sparseFilter(&filter)
+		for _, filter := range reader.filter {
+			if err := s.sparseFilter(&filter); err != nil {
+				s.err = err
+				s.SetBlocks(nil)
+				break
+			}
 		}
 		s.ReadStart(refCount, attrs)
 	}
@@ -115,102 +121,49 @@ func (s *store) ReadStart(refCount []uint64, attrs []string) {
 	}
 }
 
-func (s *store) sparseFilter(filter *filterContext)  {
-	switch filter.filterType {
-	case FileterEq:
-		blocks := make([]aoe.Block, 0)
-		for _, sid := range s.rel.segments {
-			segment := s.rel.Segment(sid)
-			ids, _ := segment.NewSparseFilter().Eq(filter.attr, filter.param1)
-			for _, id := range ids {
-				if  blockExist(s.blocks, id){
-					blocks = append(blocks, segment.Block(id))
-				}
-			}
+// sparseFilter keeps the blocks which may hold the tuples qualified by filter.
+func (s *store) sparseFilter(filter *filterContext) error {
+	blocks := make([]aoe.Block, 0)
+	for _, sid := range s.rel.segments {
+		segment := s.rel.Segment(sid)
+		ids, err := filter.eval(segment.NewSparseFilter())
+		if err != nil {
+			return err
 		}
-		s.SetBlocks(blocks)
-		break
-	case FileterNe:
-		blocks := make([]aoe.Block, 0)
-		for _, sid := range s.rel.segments {
-			segment := s.rel.Segment(sid)
-			ids, _ := segment.NewSparseFilter().Ne(filter.attr, filter.param1)
-			for _, id := range ids {
-				if  blockExist(s.blocks, id){
-					blocks = append(blocks, segment.Block(id))
-				}
+		for _, id := range ids {
+			if blockExist(s.blocks, id) {
+				blocks = append(blocks, segment.Block(id))
 			}
 		}
-		s.SetBlocks(blocks)
-		break
+	}
+	s.SetBlocks(blocks)
+	return nil
+}
+
+// eval returns the ids of the blocks of a segment which may hold the tuples
+// qualified by the filter.
+func (filter *filterContext) eval(f aoe.SparseFilter) ([]string, error) {
+	switch filter.filterType {
+	case FileterEq:
+		return f.Eq(filter.attr, filter.param1)
+	case FileterNe:
+		return f.Ne(filter.attr, filter.param1)
 	case FileterLt:
-		blocks := make([]aoe.Block, 0)
-		for _, sid := range s.rel.segments {
-			segment := s.rel.Segment(sid)
-			ids, _ := segment.NewSparseFilter().Lt(filter.attr, filter.param1)
-			for _, id := range ids {
-				if  blockExist(s.blocks, id){
-					blocks = append(blocks, segment.Block(id))
-				}
-			}
-		}
-		s.SetBlocks(blocks)
-		break
+		return f.Lt(filter.attr, filter.param1)
 	case FileterLe:
-		blocks := make([]aoe.Block, 0)
-		for _, sid := range s.rel.segments {
-			segment := s.rel.Segment(sid)
-			ids, _ := segment.NewSparseFilter().Le(filter.attr, filter.param1)
-			for _, id := range ids {
-				if  blockExist(s.blocks, id){
-					blocks = append(blocks, segment.Block(id))
-				}
-			}
-		}
-		s.SetBlocks(blocks)
-		break
+		return f.Le(filter.attr, filter.param1)
 	case FileterGt:
-		blocks := make([]aoe.Block, 0)
-		for _, sid := range s.rel.segments {
-			segment := s.rel.Segment(sid)
-			ids, _ := segment.NewSparseFilter().Gt(filter.attr, filter.param1)
-			for _, id := range ids {
-				if  blockExist(s.blocks, id){
-					blocks = append(blocks, segment.Block(id))
-				}
-			}
-		}
-		s.SetBlocks(blocks)
-		break
+		return f.Gt(filter.attr, filter.param1)
 	case FileterGe:
-		blocks := make([]aoe.Block, 0)
-		for _, sid := range s.rel.segments {
-			segment := s.rel.Segment(sid)
-			ids, _ := segment.NewSparseFilter().Ge(filter.attr, filter.param1)
-			for _, id := range ids {
-				if  blockExist(s.blocks, id){
-					blocks = append(blocks, segment.Block(id))
-				}
-			}
-		}
-		s.SetBlocks(blocks)
-		break
+		return f.Ge(filter.attr, filter.param1)
 	case FileterBtw:
-		blocks := make([]aoe.Block, 0)
-		for _, sid := range s.rel.segments {
-			segment := s.rel.Segment(sid)
-			ids, _ := segment.NewSparseFilter().Btw(filter.attr, filter.param1, filter.param2)
-			for _, id := range ids {
-				if  blockExist(s.blocks, id){
-					blocks = append(blocks, segment.Block(id))
-				}
-			}
-		}
-		s.SetBlocks(blocks)
-		break
-	default:
-		panic("No Support")
+		return f.Btw(filter.attr, filter.param1, filter.param2)
+	case FileterIn:
+		return f.In(filter.attr, filter.param1.([]interface{}))
+	case FileterNotIn:
+		return f.NotIn(filter.attr, filter.param1.([]interface{}))
 	}
+	return nil, fmt.Errorf("unsupported sparse filter %v", filter.filterType)
 }
 
 func blockExist(blocks []aoe.Block, iter string) bool {
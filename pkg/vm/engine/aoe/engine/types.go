@@ -25,6 +25,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/aoedb/v1"
 )
 
+var _ engine.RenameDatabase = &database{}
+var _ engine.AlterRelation = &relation{}
+var _ engine.AtomicAlterRelation = &relation{}
+var _ engine.OptimizeRelation = &relation{}
+var _ engine.RowIdRelation = &relation{}
+
 // aoe engine
 type aoeEngine struct {
 	catalog *catalog3.Catalog
@@ -70,6 +76,8 @@ type store struct {
 	start   bool
 	mutex   sync.RWMutex
 	iodepth int
+	asOf    int64 //unix time in nanoseconds the blocks are read as of, 0 for now
+	err     error //error of the sparse filters, set before any batch is sent
 }
 
 type batData struct {
@@ -104,6 +112,18 @@ type database struct {
 	cfg     *EngineConfig
 }
 
+//historyRelation is a relation read as it was at a past time
+type historyRelation struct {
+	*relation
+	ts int64 //unix time in nanoseconds
+}
+
+//stampedRelation is a relation whose writes are stamped with a given time
+type stampedRelation struct {
+	*relation
+	ts int64 //unix time in nanoseconds
+}
+
 type relation struct {
 	mu       sync.Mutex
 	pid      uint64            //database id
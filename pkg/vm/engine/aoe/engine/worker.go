@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 )
@@ -67,7 +68,13 @@ func (w *worker) Start(refCount []uint64, attrs []string) {
 		data := w.alloc(attrs)
 		w.allocLatency += time.Since(t).Milliseconds()
 		now := time.Now()
-		bat, err := w.blocks[i].Read(refCount, attrs, data.cds, data.dds)
+		var bat *batch.Batch
+		var err error
+		if w.storeReader.asOf != 0 {
+			bat, err = w.blocks[i].ReadAsOf(w.storeReader.asOf, refCount, attrs, data.cds, data.dds)
+		} else {
+			bat, err = w.blocks[i].Read(refCount, attrs, data.cds, data.dds)
+		}
 		w.readLatency += time.Since(now).Milliseconds()
 		if err != nil {
 			panic("error")
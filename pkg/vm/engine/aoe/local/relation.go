@@ -99,6 +99,14 @@ func (r *localRoRelation) Write(_ uint64, _ *batch.Batch) error {
 	panic("not supported")
 }
 
+func (r *localRoRelation) Delete(_ uint64, _ *batch.Batch) error {
+	panic("not supported")
+}
+
+func (r *localRoRelation) Update(_ uint64, _, _ *batch.Batch) error {
+	panic("not supported")
+}
+
 func (r *localRoRelation) AddAttribute(_ uint64, _ engine.TableDef) error {
 	panic("not supported")
 }
@@ -19,12 +19,16 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/dates"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/datetimes"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/decimal128s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/decimal64s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/float32s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/float64s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/int16s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/int32s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/int64s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/int8s"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/times"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/timestamps"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/uint16s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/uint32s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/mergesort/uint64s"
@@ -33,36 +37,14 @@ import (
 )
 
 func SortBlockColumns(cols []*vector.Vector, pk int) error {
-	sortedIdx := make([]uint32, vector.Length(cols[pk]))
+	_, err := SortBlockColumnsWithIndex(cols, pk)
+	return err
+}
 
-	switch cols[pk].Typ.Oid {
-	case types.T_int8:
-		int8s.Sort(cols[pk], sortedIdx)
-	case types.T_int16:
-		int16s.Sort(cols[pk], sortedIdx)
-	case types.T_int32:
-		int32s.Sort(cols[pk], sortedIdx)
-	case types.T_int64:
-		int64s.Sort(cols[pk], sortedIdx)
-	case types.T_uint8:
-		uint8s.Sort(cols[pk], sortedIdx)
-	case types.T_uint16:
-		uint16s.Sort(cols[pk], sortedIdx)
-	case types.T_uint32:
-		uint32s.Sort(cols[pk], sortedIdx)
-	case types.T_uint64:
-		uint64s.Sort(cols[pk], sortedIdx)
-	case types.T_float32:
-		float32s.Sort(cols[pk], sortedIdx)
-	case types.T_float64:
-		float64s.Sort(cols[pk], sortedIdx)
-	case types.T_date:
-		dates.Sort(cols[pk], sortedIdx)
-	case types.T_datetime:
-		datetimes.Sort(cols[pk], sortedIdx)
-	case types.T_char, types.T_json, types.T_varchar:
-		varchar.Sort(cols[pk], sortedIdx)
-	}
+// SortBlockColumnsWithIndex sorts cols on the pk-th one like
+// SortBlockColumns and returns the sorted index as SortColumn does.
+func SortBlockColumnsWithIndex(cols []*vector.Vector, pk int) ([]uint32, error) {
+	sortedIdx := SortColumn(cols[pk])
 
 	for i := 0; i < len(cols); i++ {
 		if i == pk {
@@ -89,16 +71,69 @@ func SortBlockColumns(cols []*vector.Vector, pk int) error {
 			float32s.Shuffle(cols[i], sortedIdx)
 		case types.T_float64:
 			float64s.Shuffle(cols[i], sortedIdx)
+		case types.T_decimal64:
+			decimal64s.Shuffle(cols[i], sortedIdx)
+		case types.T_decimal128:
+			decimal128s.Shuffle(cols[i], sortedIdx)
 		case types.T_date:
 			dates.Shuffle(cols[i], sortedIdx)
 		case types.T_datetime:
 			datetimes.Shuffle(cols[i], sortedIdx)
+		case types.T_time:
+			times.Shuffle(cols[i], sortedIdx)
+		case types.T_timestamp:
+			timestamps.Shuffle(cols[i], sortedIdx)
 		case types.T_char, types.T_json, types.T_varchar:
 			varchar.Shuffle(cols[i], sortedIdx)
 		}
 	}
 
-	return nil
+	return sortedIdx, nil
+}
+
+// SortColumn sorts col in place and returns the sorted index, the i-th
+// row of the sorted column is the sortedIdx[i]-th row of the original one.
+func SortColumn(col *vector.Vector) []uint32 {
+	sortedIdx := make([]uint32, vector.Length(col))
+
+	switch col.Typ.Oid {
+	case types.T_int8:
+		int8s.Sort(col, sortedIdx)
+	case types.T_int16:
+		int16s.Sort(col, sortedIdx)
+	case types.T_int32:
+		int32s.Sort(col, sortedIdx)
+	case types.T_int64:
+		int64s.Sort(col, sortedIdx)
+	case types.T_uint8:
+		uint8s.Sort(col, sortedIdx)
+	case types.T_uint16:
+		uint16s.Sort(col, sortedIdx)
+	case types.T_uint32:
+		uint32s.Sort(col, sortedIdx)
+	case types.T_uint64:
+		uint64s.Sort(col, sortedIdx)
+	case types.T_float32:
+		float32s.Sort(col, sortedIdx)
+	case types.T_float64:
+		float64s.Sort(col, sortedIdx)
+	case types.T_decimal64:
+		decimal64s.Sort(col, sortedIdx)
+	case types.T_decimal128:
+		decimal128s.Sort(col, sortedIdx)
+	case types.T_date:
+		dates.Sort(col, sortedIdx)
+	case types.T_datetime:
+		datetimes.Sort(col, sortedIdx)
+	case types.T_time:
+		times.Sort(col, sortedIdx)
+	case types.T_timestamp:
+		timestamps.Sort(col, sortedIdx)
+	case types.T_char, types.T_json, types.T_varchar:
+		varchar.Sort(col, sortedIdx)
+	}
+
+	return sortedIdx
 }
 
 func MergeSortedColumn(column []*vector.Vector, sortedIdx *[]uint16) error {
@@ -123,10 +158,18 @@ func MergeSortedColumn(column []*vector.Vector, sortedIdx *[]uint16) error {
 		float32s.Merge(column, sortedIdx)
 	case types.T_float64:
 		float64s.Merge(column, sortedIdx)
+	case types.T_decimal64:
+		decimal64s.Merge(column, sortedIdx)
+	case types.T_decimal128:
+		decimal128s.Merge(column, sortedIdx)
 	case types.T_date:
 		dates.Merge(column, sortedIdx)
 	case types.T_datetime:
 		datetimes.Merge(column, sortedIdx)
+	case types.T_time:
+		times.Merge(column, sortedIdx)
+	case types.T_timestamp:
+		timestamps.Merge(column, sortedIdx)
 	case types.T_char, types.T_json, types.T_varchar:
 		varchar.Merge(column, sortedIdx)
 	}
@@ -155,10 +198,18 @@ func ShuffleColumn(column []*vector.Vector, sortedIdx []uint16) error {
 		float32s.Multiplex(column, sortedIdx)
 	case types.T_float64:
 		float64s.Multiplex(column, sortedIdx)
+	case types.T_decimal64:
+		decimal64s.Multiplex(column, sortedIdx)
+	case types.T_decimal128:
+		decimal128s.Multiplex(column, sortedIdx)
 	case types.T_date:
 		dates.Multiplex(column, sortedIdx)
 	case types.T_datetime:
 		datetimes.Multiplex(column, sortedIdx)
+	case types.T_time:
+		times.Multiplex(column, sortedIdx)
+	case types.T_timestamp:
+		timestamps.Multiplex(column, sortedIdx)
 	case types.T_char, types.T_json, types.T_varchar:
 		varchar.Multiplex(column, sortedIdx)
 	}
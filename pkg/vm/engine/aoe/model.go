@@ -63,6 +63,9 @@ type TableInfo struct {
 	Partition  []byte       `json:"partition"`
 	Properties []Property
 	Epoch      uint64 `json:"epoch"`
+	// SchemaVersion is increased by every alter of the columns, the
+	// tablets are altered to the version of the columns as well
+	SchemaVersion uint32 `json:"schema_version,omitempty"`
 }
 
 type Property struct {
@@ -70,6 +73,28 @@ type Property struct {
 	Value string
 }
 
+// HistoryRetention is the property holding how many seconds the history
+// of the rows of a table is retained for reading it as of a past time.
+const HistoryRetention = "history_retention"
+
+// The properties overriding how the data of a table is laid out and
+// compacted by the storage.
+const (
+	// BlockMaxRows is the property holding the rows of a block
+	BlockMaxRows = "block_max_rows"
+	// SegmentMaxBlocks is the property holding the blocks of a segment
+	SegmentMaxBlocks = "segment_max_blocks"
+	// Compaction is the property holding the compaction policy, which is
+	// SizeTieredCompaction or TimeWindowCompaction
+	Compaction = "compaction"
+	// CompactionWindow is the property holding how many seconds the time
+	// window compaction waits for
+	CompactionWindow = "compaction_window"
+
+	SizeTieredCompaction = "size_tiered"
+	TimeWindowCompaction = "time_window"
+)
+
 type TabletInfo struct {
 	Name    string
 	ShardId uint64
@@ -88,6 +113,9 @@ type ColumnInfo struct {
 	Epoch       uint64             `json:"epoch"`
 	PrimaryKey  bool               `json:"primary_key"` // PrimaryKey is the name of the column of the primary key
 	NullAbility bool               `json:"nullability"`
+	// Dropped column keeps its place in the table, so that the columns
+	// of the tablets are never moved.
+	Dropped bool `json:"dropped,omitempty"`
 }
 
 type IndexInfo struct {
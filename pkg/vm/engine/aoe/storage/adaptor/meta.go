@@ -20,7 +20,9 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/dbi"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
@@ -69,17 +71,31 @@ func TableInfoToSchema(catalog *metadata.Catalog, info *aoe.TableInfo) (*db.Tabl
 	schema := metadata.NewEmptySchema(info.Name)
 	for idx, colInfo := range info.Columns {
 		newInfo := &metadata.ColDef{
-			Name: colInfo.Name,
-			Idx:  idx,
-			Type: colInfo.Type,
+			Name:    colInfo.Name,
+			Idx:     idx,
+			Type:    colInfo.Type,
+			Dropped: colInfo.Dropped,
 		}
 		if colInfo.PrimaryKey {
 			schema.PrimaryKey = idx
 			logutil.Debugf("Table to schema, schema.PrimaryKey is %d, its name is %v.", schema.PrimaryKey, colInfo.Name)
 		}
-		schema.NameIndex[newInfo.Name] = len(schema.ColDefs)
+		if !newInfo.Dropped {
+			schema.NameIndex[newInfo.Name] = len(schema.ColDefs)
+		}
 		schema.ColDefs = append(schema.ColDefs, newInfo)
 	}
+	schema.Retention, _ = helper.Retention(info.Properties)
+	schema.BlockMaxRows, schema.SegmentMaxBlocks, _ = helper.Layout(info.Properties)
+	policy, window, _ := helper.Compaction(info.Properties)
+	switch policy {
+	case aoe.SizeTieredCompaction:
+		schema.Compaction = metadata.SizeTieredCompaction
+	case aoe.TimeWindowCompaction:
+		schema.Compaction = metadata.TimeWindowCompaction
+	}
+	schema.CompactionWindow = window
+	schema.Version = info.SchemaVersion
 	indice := metadata.NewIndexSchema()
 	cols := make([]int, 0)
 	var err error
@@ -98,6 +114,31 @@ func TableInfoToSchema(catalog *metadata.Catalog, info *aoe.TableInfo) (*db.Tabl
 	return schema, indice
 }
 
+// TableInfoToAlterSchema is TableInfoToSchema with the encoded default
+// values of the columns, which the added columns read for the rows
+// appended before them.
+func TableInfoToAlterSchema(catalog *metadata.Catalog, info *aoe.TableInfo) (*db.TableSchema, error) {
+	schema, _ := TableInfoToSchema(catalog, info)
+	for idx, colInfo := range info.Columns {
+		if colInfo.Dropped {
+			continue
+		}
+		attr := engine.Attribute{
+			Name:    colInfo.Name,
+			Type:    colInfo.Type,
+			Default: colInfo.Default,
+		}
+		vec, err := engine.DefaultVector(attr, 1)
+		if err != nil {
+			return nil, err
+		}
+		if schema.ColDefs[idx].Default, err = vec.Show(); err != nil {
+			return nil, err
+		}
+	}
+	return schema, nil
+}
+
 func IndiceInfoToIndiceSchema(info *aoe.IndexInfo) *db.IndexSchema {
 	columns := make([]int, len(info.Columns))
 	for _, col := range info.Columns {
@@ -80,9 +80,35 @@ type DropIndexCtx struct {
 	IndexNames []string
 }
 
+type AlterTableCtx struct {
+	DBMutationCtx
+	Table  string
+	Schema *db.TableSchema
+}
+
+type OptimizeTableCtx struct {
+	DBMutationCtx
+	Table string
+}
+
 type AppendCtx struct {
 	TableMutationCtx
 	Data *batch.Batch
+	// Ts is the unix time in nanoseconds of the append, it is carried by
+	// the log entry so that every replica keeps the same history, the
+	// current time if zero
+	Ts int64
+}
+
+type DeleteCtx struct {
+	TableMutationCtx
+	// Data is the rows to delete, every row equal to one of them is deleted
+	Data *batch.Batch
+	// NewData is the rows replacing those of Data row by row, nil if none
+	NewData *batch.Batch
+	// Ts is the unix time in nanoseconds of the delete, the current time
+	// if zero
+	Ts int64
 }
 
 func (ctx *DBMutationCtx) ToLogIndex(database *metadata.Database) *db.LogIndex {
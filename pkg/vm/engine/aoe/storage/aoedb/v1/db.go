@@ -15,7 +15,15 @@
 package aoedb
 
 import (
+	"errors"
 	"path/filepath"
+	"time"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/util"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
@@ -305,6 +313,88 @@ func (d *DB) DropIndex(ctx *DropIndexCtx) error {
 	return nil
 }
 
+// AlterTable replaces the schema of a table with ctx.Schema, which may add,
+// drop or rename columns. The blocks written before keep their schema, the
+// added columns are filled with their default values when they are read.
+// It does nothing if the table is already at the version of ctx.Schema,
+// so that an alter can be resent to all the tablets of a table.
+func (d *DB) AlterTable(ctx *AlterTableCtx) error {
+	if err := d.Closed.Load(); err != nil {
+		panic(err)
+	}
+	database, err := d.Store.Catalog.SimpleGetDatabaseByName(ctx.DB)
+	if err != nil {
+		return err
+	}
+	index := ctx.ToLogIndex(database)
+	if err = d.Wal.SyncLog(index); err != nil {
+		return err
+	}
+	defer d.Wal.Checkpoint(index)
+
+	if database.InReplaying(index) {
+		if idx, ok := database.ConsumeIdempotentIndex(index); !ok {
+			err = db.ErrIdempotence
+			return err
+		} else if idx != nil {
+			if idx.IsApplied() {
+				err = db.ErrIdempotence
+				return err
+			}
+		}
+	}
+	meta := database.SimpleGetTableByName(ctx.Table)
+	if meta == nil {
+		err = metadata.TableNotFoundErr
+		return err
+	}
+	if err = meta.SimpleAlter(ctx.Schema, index); err == metadata.IdempotenceErr {
+		err = nil
+	}
+	return err
+}
+
+// OptimizeTable closes the partial segment of the table and merge-sorts
+// its closed segments, whatever its compaction policy is. The merge sort
+// is asynchronous, and a segment closed with an open block is merge-sorted
+// once the block is full.
+func (d *DB) OptimizeTable(ctx *OptimizeTableCtx) error {
+	if err := d.Closed.Load(); err != nil {
+		panic(err)
+	}
+	database, err := d.Store.Catalog.SimpleGetDatabaseByName(ctx.DB)
+	if err != nil {
+		return err
+	}
+	index := ctx.ToLogIndex(database)
+	if err = d.Wal.SyncLog(index); err != nil {
+		return err
+	}
+	defer d.Wal.Checkpoint(index)
+
+	if database.InReplaying(index) {
+		if idx, ok := database.ConsumeIdempotentIndex(index); !ok {
+			err = db.ErrIdempotence
+			return err
+		} else if idx != nil {
+			if idx.IsApplied() {
+				err = db.ErrIdempotence
+				return err
+			}
+		}
+	}
+	meta := database.SimpleGetTableByName(ctx.Table)
+	if meta == nil {
+		err = metadata.TableNotFoundErr
+		return err
+	}
+	if err = d.DoCloseSegment(meta, index); err != nil {
+		return err
+	}
+	d.Opts.Scheduler.CompactTable(meta.Id)
+	return nil
+}
+
 func (d *DB) Append(ctx *AppendCtx) (err error) {
 	if err := d.Closed.Load(); err != nil {
 		panic(err)
@@ -342,10 +432,173 @@ func (d *DB) Append(ctx *AppendCtx) (err error) {
 			d.Wal.Checkpoint(index)
 		}
 	}()
-	err = d.DoAppend(meta, ctx.Data, index.AsSlice())
+	err = d.DoAppend(meta, ctx.Data, index.AsSlice(), stampOf(ctx.Ts))
 	return err
 }
 
+// Delete deletes the rows of the table whose row ids are in the
+// engine.RowId column of ctx.Data and appends the rows of ctx.NewData at
+// the same positions, so that an update is replicated as a single log
+// entry. The rows already deleted are skipped along with their
+// replacement. The deleted rows are kept by the segment metadata, so
+// they are checkpointed and replayed along with it.
+func (d *DB) Delete(ctx *DeleteCtx) (err error) {
+	if err := d.Closed.Load(); err != nil {
+		panic(err)
+	}
+	database, err := d.Store.Catalog.SimpleGetDatabaseByName(ctx.DB)
+	if err != nil {
+		return err
+	}
+	index, ts := ctx.ToLogIndex(database), stampOf(ctx.Ts)
+	var meta *metadata.Table
+	replaying, deleted := database.InReplaying(index), false
+	if replaying {
+		meta, err = database.GetTableByNameAndLogIndex(ctx.Table, index)
+		if err != nil {
+			return
+		}
+		if meta.IsDeleted() {
+			return metadata.TableNotFoundErr
+		}
+		// The rows were deleted if the table has committed the index or
+		// a newer one, only their replacement may be missing
+		latest := meta.LatestLogIndex()
+		deleted = latest != nil && latest.ShardId == index.ShardId && latest.CompareID(index) >= 0
+		if deleted && ctx.NewData == nil {
+			return db.ErrIdempotence
+		}
+	} else {
+		meta = database.SimpleGetTableByName(ctx.Table)
+		if meta == nil {
+			return metadata.TableNotFoundErr
+		}
+	}
+	matched, err := d.matchRows(meta, ctx.Data, index, deleted)
+	if err != nil {
+		return
+	}
+	appended := ctx.NewData == nil || len(matched) == 0
+	if !appended {
+		index.Capacity = uint64(len(matched))
+		if replaying {
+			index, err = d.TableIdempotenceCheckAndIndexRewrite(meta, index)
+			if err == metadata.IdempotenceErr {
+				return
+			}
+		}
+	}
+	if err = d.Wal.SyncLog(index); err != nil {
+		return
+	}
+	defer func() {
+		if err != nil || appended {
+			index.Count = index.Capacity - index.Start
+			d.Wal.Checkpoint(index)
+		}
+	}()
+	if !deleted {
+		rows := make(map[uint64]*roaring64.Bitmap)
+		for _, row := range matched {
+			if rows[row.segment] == nil {
+				rows[row.segment] = roaring64.New()
+			}
+			rows[row.segment].Add(row.origin)
+		}
+		exIndex := *index
+		exIndex.Capacity = 0
+		if err = meta.SimpleDeleteRows(rows, ts, &exIndex); err != nil {
+			return
+		}
+	}
+	if appended {
+		return
+	}
+	err = d.DoAppend(meta, selectRows(ctx.NewData, matched), index.AsSlice(), ts)
+	return
+}
+
+// stampOf returns the unix time in nanoseconds of a mutation, the
+// current time if its log entry has none.
+func stampOf(ts int64) int64 {
+	if ts == 0 {
+		return time.Now().UnixNano()
+	}
+	return ts
+}
+
+// matchedRow is the row of a table whose row id is the sel-th one to
+// delete.
+type matchedRow struct {
+	segment uint64
+	origin  uint64
+	sel     int64
+}
+
+// matchRows returns the rows of the table whose row ids are in bat and
+// which are not deleted yet, or those which were deleted by the operation
+// of index if deleted is true. The rows are in the order of bat, so they
+// are matched in the same order on every replica.
+func (d *DB) matchRows(meta *metadata.Table, bat *batch.Batch, index *db.LogIndex, deleted bool) ([]matchedRow, error) {
+	vec := batch.GetVector(bat, engine.RowId)
+	if vec == nil {
+		return nil, errors.New("the rows to delete have no row ids")
+	}
+	masks := make(map[uint64]*roaring64.Bitmap)
+	maskOf := func(id uint64) *roaring64.Bitmap {
+		mask, ok := masks[id]
+		if ok {
+			return mask
+		}
+		var deletes *metadata.SegmentDeletes
+		if segment := meta.SimpleGetSegment(id); segment != nil {
+			deletes = segment.GetDeletes()
+		}
+		if deletes != nil && !deleted {
+			mask = deletes.Rows()
+		} else if deletes != nil {
+			mask = deletes.RowsOf(index)
+		}
+		if mask == nil {
+			mask = roaring64.New()
+		}
+		masks[id] = mask
+		return mask
+	}
+	ids := vec.Col.(*types.Bytes)
+	matched := make([]matchedRow, 0, len(ids.Offsets))
+	for i := range ids.Offsets {
+		_, segment, origin, err := metadata.DecodeRowId(ids.Get(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+		if meta.SimpleGetSegment(segment) == nil || maskOf(segment).Contains(origin) != deleted {
+			continue
+		}
+		mask := masks[segment]
+		if !deleted {
+			// a row id given twice is deleted once
+			mask.Add(origin)
+		} else {
+			mask.Remove(origin)
+		}
+		matched = append(matched, matchedRow{segment: segment, origin: origin, sel: int64(i)})
+	}
+	return matched, nil
+}
+
+// selectRows shrinks bat to the rows replacing the matched ones.
+func selectRows(bat *batch.Batch, matched []matchedRow) *batch.Batch {
+	sels := make([]int64, len(matched))
+	for i, row := range matched {
+		sels[i] = row.sel
+	}
+	for _, vec := range bat.Vecs {
+		vector.Shrink(vec, sels)
+	}
+	return bat
+}
+
 func (d *DB) CreateSnapshot(ctx *CreateSnapshotCtx) (uint64, error) {
 	return d.Impl.CreateSnapshot(ctx.DB, ctx.Path, ctx.Sync)
 }
@@ -23,6 +23,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -36,6 +37,7 @@ import (
 	roaring "github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/sched"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 
@@ -1667,6 +1669,75 @@ func TestFilterUnclosedSegment(t *testing.T) {
 	inst.Close()
 }
 
+func TestSparseFilterInList(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB3(t)
+	defer inst.Close()
+	inst.Store.Catalog.Cfg.BlockMaxRows = uint64(10)
+	inst.Store.Catalog.Cfg.SegmentMaxBlocks = uint64(4)
+
+	schema := metadata.MockSchema(1)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	// block 1 holds only 0, block k > 1 holds [10 * (k - 1), 10 * k), and
+	// block 5 in the next segment closes the first one
+	for k := 0; k < 5; k++ {
+		vs := make([]int32, 10)
+		for i := range vs {
+			if k > 0 {
+				vs[i] = int32(k*10 + i)
+			}
+		}
+		bat := batch.New(true, []string{"mock_0"})
+		bat.Vecs[0] = vector.New(schema.ColDefs[0].Type)
+		assert.Nil(t, vector.Append(bat.Vecs[0], vs))
+		assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, bat)))
+	}
+
+	tblData, err := inst.Store.DataTables.WeakRefTable(tblMeta.Id)
+	assert.Nil(t, err)
+	segId := tblData.SegmentIds()[0]
+	check := func() {
+		segment := &db.Segment{
+			Data: tblData.WeakRefSegment(segId),
+			Ids:  new(atomic.Value),
+		}
+		sparseFilter := segment.NewSparseFilter()
+		res, err := sparseFilter.In("mock_0", []interface{}{int32(15), int32(35)})
+		assert.Nil(t, err)
+		assert.True(t, matchStringArray(decodeBlockIds(res), []string{"2", "4"}), decodeBlockIds(res))
+		res, err = sparseFilter.In("mock_0", []interface{}{int32(5), int32(40)})
+		assert.Nil(t, err)
+		assert.Empty(t, res, decodeBlockIds(res))
+		res, err = sparseFilter.NotIn("mock_0", []interface{}{int32(0)})
+		assert.Nil(t, err)
+		assert.True(t, matchStringArray(decodeBlockIds(res), []string{"2", "3", "4"}), decodeBlockIds(res))
+		res, err = sparseFilter.NotIn("mock_0", []interface{}{int32(15)})
+		assert.Nil(t, err)
+		assert.True(t, matchStringArray(decodeBlockIds(res), []string{"1", "2", "3", "4"}), decodeBlockIds(res))
+	}
+
+	testutils.WaitExpect(2000, func() bool {
+		return tblData.WeakRefSegment(segId).CanUpgrade()
+	})
+	check()
+
+	testutils.WaitExpect(2000, func() bool {
+		assert.Nil(t, inst.OptimizeTable(&OptimizeTableCtx{
+			DBMutationCtx: *CreateDBMutationCtx(database, gen),
+			Table:         schema.Name,
+		}))
+		return tblData.WeakRefSegment(segId).GetType() == base.SORTED_SEG
+	})
+	assert.Equal(t, base.SORTED_SEG, tblData.WeakRefSegment(segId).GetType())
+	check()
+}
+
 func TestFilter(t *testing.T) {
 	waitTime := time.Duration(100) * time.Millisecond
 	if invariants.RaceEnabled {
@@ -3076,6 +3147,436 @@ func TestRepeatCreateAndDropIndex(t *testing.T) {
 	inst.Close()
 }
 
+// selectRowIds returns a batch of the row ids of the rows of the table
+// for which pick returns true, the rows are numbered in the order they
+// were appended, deleted or not.
+func selectRowIds(t *testing.T, inst *DB, meta *metadata.Table, pick func(int64) bool) *batch.Batch {
+	type rowId struct {
+		segment, origin uint64
+	}
+	var ids []rowId
+	attrs := []string{meta.Schema.ColDefs[0].Name}
+	assert.Nil(t, inst.ScanRows(meta, attrs, func(segment, origin uint64, _ *batch.Batch, _ int64) error {
+		ids = append(ids, rowId{segment: segment, origin: origin})
+		return nil
+	}))
+	sort.Slice(ids, func(i, j int) bool {
+		if ids[i].segment != ids[j].segment {
+			return ids[i].segment < ids[j].segment
+		}
+		return ids[i].origin < ids[j].origin
+	})
+	shardId, _ := IdToNameFactory.Decode(meta.Database.Name)
+	var picked [][]byte
+	for i, id := range ids {
+		if pick(int64(i)) {
+			picked = append(picked, metadata.EncodeRowId(nil, shardId.(uint64), id.segment, id.origin))
+		}
+	}
+	bat := batch.New(true, []string{engine.RowId})
+	bat.Vecs[0] = vector.New(engine.RowIdType)
+	assert.Nil(t, vector.Append(bat.Vecs[0], picked))
+	return bat
+}
+
+func TestDelete(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB1(t)
+
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+	segCnt := 2
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows * inst.Store.Catalog.Cfg.SegmentMaxBlocks * uint64(segCnt)
+	ck := mock.MockBatch(tblMeta.Schema.Types(), rows)
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+	assert.Nil(t, inst.FlushTable(database.Name, schema.Name))
+
+	selected := func(pick func(int64) bool) *batch.Batch {
+		bat := mock.MockBatch(tblMeta.Schema.Types(), rows)
+		var sels []int64
+		for i := int64(0); i < int64(rows); i++ {
+			if pick(i) {
+				sels = append(sels, i)
+			}
+		}
+		for _, vec := range bat.Vecs {
+			vector.Shrink(vec, sels)
+		}
+		return bat
+	}
+	count := func(inst *DB) int64 {
+		rel, err := inst.Relation(database.Name, schema.Name)
+		assert.Nil(t, err)
+		defer rel.Close()
+		n := int64(0)
+		for _, segId := range rel.SegmentIds().Ids {
+			seg := rel.Segment(segId)
+			for _, id := range seg.Blocks() {
+				rows, err := seg.Block(id).Rows()
+				assert.Nil(t, err)
+				n += rows
+			}
+		}
+		return n
+	}
+
+	// the even rows are deleted from the first segment while it is upgraded
+	deleteCtx := CreateDeleteCtx(database, gen, schema.Name, selectRowIds(t, inst, tblMeta, func(i int64) bool {
+		return i%2 == 0
+	}), nil)
+	assert.Nil(t, inst.Delete(deleteCtx))
+	assert.Equal(t, int64(rows/2), count(inst))
+	sorted := func() bool {
+		segMeta := tblMeta.SimpleGetSegment(tblMeta.SimpleGetSegmentIds()[0])
+		segMeta.RLock()
+		defer segMeta.RUnlock()
+		return segMeta.IsSortedLocked()
+	}
+	testutils.WaitExpect(400, sorted)
+	assert.True(t, sorted())
+	assert.Equal(t, int64(rows/2), count(inst))
+
+	// deleting them again is a no-op
+	assert.Nil(t, inst.Delete(CreateDeleteCtx(database, gen, schema.Name, deleteCtx.Data, nil)))
+	assert.Equal(t, int64(rows/2), count(inst))
+
+	// the rows 1 and 3 are replaced by the rows 0 and 2, the mock values
+	// repeat every 5000 rows but the equal rows are left alone
+	updateCtx := CreateDeleteCtx(database, gen, schema.Name, selectRowIds(t, inst, tblMeta, func(i int64) bool {
+		return i == 1 || i == 3
+	}), selected(func(i int64) bool {
+		return i == 0 || i == 2
+	}))
+	assert.Nil(t, inst.Delete(updateCtx))
+	assert.Equal(t, int64(rows/2), count(inst))
+	assert.Equal(t, 2, vector.Length(updateCtx.NewData.Vecs[0]))
+
+	// the replacement of the rows deleted already is not appended
+	assert.Nil(t, inst.Delete(CreateDeleteCtx(database, gen, schema.Name, selectRowIds(t, inst, tblMeta, func(i int64) bool {
+		return i == 1 || i == 5
+	}), selected(func(i int64) bool {
+		return i == 0 || i == 2
+	}))))
+	assert.Equal(t, int64(rows/2), count(inst))
+	assert.Nil(t, inst.FlushTable(database.Name, schema.Name))
+	inst.Close()
+
+	// the deletes survive a restart and are not applied twice
+	inst, _, _ = initTestDB2(t)
+	defer inst.Close()
+	assert.Equal(t, int64(rows/2), count(inst))
+	assert.Equal(t, db.ErrIdempotence, inst.Delete(deleteCtx))
+	assert.Equal(t, int64(rows/2), count(inst))
+
+	// the row ids read from the blocks delete all the rows
+	database, err = inst.Store.Catalog.SimpleGetDatabaseByName(database.Name)
+	assert.Nil(t, err)
+	rel, err := inst.Relation(database.Name, schema.Name)
+	assert.Nil(t, err)
+	for _, segId := range rel.SegmentIds().Ids {
+		seg := rel.Segment(segId)
+		for _, id := range seg.Blocks() {
+			cds := []*bytes.Buffer{bytes.NewBuffer(make([]byte, 0))}
+			dds := []*bytes.Buffer{bytes.NewBuffer(make([]byte, 0))}
+			bat, err := seg.Block(id).Read([]uint64{1}, []string{engine.RowId}, cds, dds)
+			assert.Nil(t, err)
+			assert.Nil(t, inst.Delete(CreateDeleteCtx(database, gen, schema.Name, bat, nil)))
+		}
+	}
+	rel.Close()
+	assert.Equal(t, int64(0), count(inst))
+}
+
+func TestHistory(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB1(t)
+
+	schema := metadata.MockSchema(2)
+	schema.Retention = 3600
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+	rows := inst.Store.Catalog.Cfg.BlockMaxRows * 2
+	ck := mock.MockBatch(tblMeta.Schema.Types(), rows)
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+	time.Sleep(time.Millisecond)
+	ts := time.Now().UnixNano()
+	time.Sleep(time.Millisecond)
+	ck = mock.MockBatch(tblMeta.Schema.Types(), inst.Store.Catalog.Cfg.BlockMaxRows)
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+	assert.Nil(t, inst.FlushTable(database.Name, schema.Name))
+
+	attrs := []string{}
+	for _, colDef := range tblMeta.Schema.ColDefs {
+		attrs = append(attrs, colDef.Name)
+	}
+	refs := make([]uint64, len(attrs))
+	newBuffers := func() ([]*bytes.Buffer, []*bytes.Buffer) {
+		cds := make([]*bytes.Buffer, len(attrs))
+		dds := make([]*bytes.Buffer, len(attrs))
+		for i := range cds {
+			cds[i] = bytes.NewBuffer(make([]byte, 0))
+			dds[i] = bytes.NewBuffer(make([]byte, 0))
+		}
+		return cds, dds
+	}
+
+	rel, err := inst.Relation(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.True(t, rel.HistoryHorizon() < ts)
+	bat := selectRowIds(t, inst, tblMeta, func(i int64) bool {
+		return i < int64(rows) && i%2 == 0
+	})
+	assert.Nil(t, inst.Delete(CreateDeleteCtx(database, gen, schema.Name, bat, nil)))
+
+	count := func(ts int64) int64 {
+		n := int64(0)
+		for _, segId := range rel.SegmentIds().Ids {
+			seg := rel.Segment(segId)
+			for _, id := range seg.Blocks() {
+				cds, dds := newBuffers()
+				bat, err := seg.Block(id).ReadAsOf(ts, refs, attrs, cds, dds)
+				assert.Nil(t, err)
+				n += int64(vector.Length(bat.Vecs[0]))
+			}
+		}
+		return n
+	}
+	left := int64(0)
+	for _, segId := range rel.SegmentIds().Ids {
+		seg := rel.Segment(segId)
+		for _, id := range seg.Blocks() {
+			rows, err := seg.Block(id).Rows()
+			assert.Nil(t, err)
+			left += rows
+		}
+	}
+	assert.True(t, left < rel.Rows())
+	assert.Equal(t, int64(rows), count(ts))
+	assert.Equal(t, left, count(time.Now().UnixNano()))
+	rel.Close()
+	inst.Close()
+}
+
+func TestHistoryStamps(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB1(t)
+
+	schema := metadata.MockSchema(2)
+	schema.Retention = 3600
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+	blockRows := inst.Store.Catalog.Cfg.BlockMaxRows
+
+	// the first rows are appended before the history horizon, the
+	// others after it and the last block is left open
+	now := time.Now()
+	t1 := now.Add(-2 * time.Hour).UnixNano()
+	t2 := now.Add(-30 * time.Minute).UnixNano()
+	t3 := now.Add(-20 * time.Minute).UnixNano()
+	appendCtx := CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(tblMeta.Schema.Types(), blockRows*2))
+	appendCtx.Ts = t1
+	assert.Nil(t, inst.Append(appendCtx))
+	appendCtx = CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(tblMeta.Schema.Types(), blockRows*3/2))
+	appendCtx.Ts = t2
+	assert.Nil(t, inst.Append(appendCtx))
+
+	// the deleted rows were only appended by the first append
+	bat := selectRowIds(t, inst, tblMeta, func(i int64) bool {
+		return i >= int64(blockRows*3/2) && i < int64(blockRows*2)
+	})
+	deleteCtx := CreateDeleteCtx(database, gen, schema.Name, bat, nil)
+	deleteCtx.Ts = t3
+	assert.Nil(t, inst.Delete(deleteCtx))
+	assert.Nil(t, inst.FlushTable(database.Name, schema.Name))
+	testutils.WaitExpect(200, func() bool {
+		return database.GetCheckpointId() == gen.Get(database.GetShardId())
+	})
+
+	attrs := []string{}
+	for _, colDef := range tblMeta.Schema.ColDefs {
+		attrs = append(attrs, colDef.Name)
+	}
+	refs := make([]uint64, len(attrs))
+	count := func(inst *DB, ts int64) int64 {
+		rel, err := inst.Relation(database.Name, schema.Name)
+		assert.Nil(t, err)
+		defer rel.Close()
+		n := int64(0)
+		for _, segId := range rel.SegmentIds().Ids {
+			seg := rel.Segment(segId)
+			for _, id := range seg.Blocks() {
+				cds := make([]*bytes.Buffer, len(attrs))
+				dds := make([]*bytes.Buffer, len(attrs))
+				for i := range cds {
+					cds[i] = bytes.NewBuffer(make([]byte, 0))
+					dds[i] = bytes.NewBuffer(make([]byte, 0))
+				}
+				bat, err := seg.Block(id).ReadAsOf(ts, refs, attrs, cds, dds)
+				assert.Nil(t, err)
+				n += int64(vector.Length(bat.Vecs[0]))
+			}
+		}
+		return n
+	}
+	check := func(inst *DB) {
+		rel, err := inst.Relation(database.Name, schema.Name)
+		assert.Nil(t, err)
+		horizon := rel.HistoryHorizon()
+		rel.Close()
+		assert.True(t, t1 < horizon && horizon < t2)
+		assert.Equal(t, int64(blockRows*2), count(inst, t2-1))
+		assert.Equal(t, int64(blockRows*7/2), count(inst, t2))
+		assert.Equal(t, int64(blockRows*7/2), count(inst, t3-1))
+		assert.Equal(t, int64(blockRows*3), count(inst, t3))
+		assert.Equal(t, int64(blockRows*3), count(inst, time.Now().UnixNano()))
+	}
+	check(inst)
+
+	// the rows appended before the horizon are read as of any time once
+	// their stamps are pruned
+	rel, err := inst.Relation(database.Name, schema.Name)
+	assert.Nil(t, err)
+	rel.Data.PruneHistory()
+	rel.Close()
+	assert.Equal(t, int64(blockRows*2), count(inst, t1-1))
+	check(inst)
+	inst.Close()
+
+	// the stamps are replayed with the blocks
+	inst, _, _ = initTestDB2(t)
+	defer inst.Close()
+	database, err = inst.Store.Catalog.SimpleGetDatabaseByName(database.Name)
+	assert.Nil(t, err)
+	check(inst)
+}
+
+func TestAlterTable(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB1(t)
+	shardId := database.GetShardId()
+
+	schema := metadata.MockSchema(2)
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+	blockRows := inst.Store.Catalog.Cfg.BlockMaxRows
+	typ := types.Type{Oid: types.T_int32, Size: 4, Width: 4}
+	column := func(v int32, rows uint64) *vector.Vector {
+		vec := vector.New(typ)
+		vals := make([]int32, rows)
+		for i := range vals {
+			vals[i] = v
+		}
+		assert.Nil(t, vector.Append(vec, vals))
+		return vec
+	}
+
+	// the second block is left open by the alter
+	rows1 := blockRows * 3 / 2
+	ck := mock.MockBatch(tblMeta.Schema.Types(), rows1)
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+
+	alterCtx := &AlterTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Table:         schema.Name,
+		Schema:        metadata.MockSchema(3),
+	}
+	alterCtx.Schema.ColDefs[1].Name = "m1"
+	def, err := column(7, 1).Show()
+	assert.Nil(t, err)
+	alterCtx.Schema.ColDefs[2].Default = def
+	assert.Nil(t, inst.AlterTable(alterCtx))
+	assert.Equal(t, uint32(1), tblMeta.Schema.Version)
+
+	rows2 := blockRows
+	ck = mock.MockBatch(alterCtx.Schema.Types(), rows2)
+	ck.Attrs = []string{"mock_0", "m1", "mock_2"}
+	ck.Vecs[2] = column(-1, rows2)
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+
+	// the table takes the version of the schema if it is given
+	alterCtx.Id = gen.Alloc(shardId)
+	alterCtx.Schema.ColDefs[1].Dropped = true
+	alterCtx.Schema.Version = 3
+	assert.Nil(t, inst.AlterTable(alterCtx))
+	assert.Equal(t, uint32(3), tblMeta.Schema.Version)
+
+	// an alter resent to the table at its version is skipped
+	alterCtx.Id = gen.Alloc(shardId)
+	alterCtx.Schema.ColDefs[1].Name = "m2"
+	assert.Nil(t, inst.AlterTable(alterCtx))
+	assert.Equal(t, uint32(3), tblMeta.Schema.Version)
+	assert.Equal(t, "m1", tblMeta.Schema.ColDefs[1].Name)
+
+	rows3 := blockRows / 2
+	ck = mock.MockBatch([]types.Type{typ, typ}, rows3)
+	ck.Attrs = []string{"mock_0", "mock_2"}
+	ck.Vecs[1] = column(-1, rows3)
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+	assert.Nil(t, inst.FlushTable(database.Name, schema.Name))
+
+	attrs := []string{"mock_0", "mock_2"}
+	refs := make([]uint64, len(attrs))
+	check := func() {
+		rel, err := inst.Relation(database.Name, schema.Name)
+		assert.Nil(t, err)
+		defer rel.Close()
+		assert.Equal(t, 2, len(rel.Attribute()))
+		assert.Equal(t, int64(rows1+rows2+rows3), rel.Rows())
+		defaults, values := uint64(0), uint64(0)
+		for _, segId := range rel.SegmentIds().Ids {
+			seg := rel.Segment(segId)
+			for _, id := range seg.Blocks() {
+				cds := []*bytes.Buffer{bytes.NewBuffer(nil), bytes.NewBuffer(nil)}
+				dds := []*bytes.Buffer{bytes.NewBuffer(nil), bytes.NewBuffer(nil)}
+				bat, err := seg.Block(id).Read(refs, attrs, cds, dds)
+				assert.Nil(t, err)
+				vec := batch.GetVector(bat, "mock_2")
+				assert.Equal(t, vector.Length(batch.GetVector(bat, "mock_0")), vector.Length(vec))
+				for _, v := range vec.Col.([]int32) {
+					switch v {
+					case 7:
+						defaults++
+					case -1:
+						values++
+					}
+				}
+			}
+		}
+		assert.Equal(t, rows1, defaults)
+		assert.Equal(t, rows2+rows3, values)
+	}
+	check()
+
+	testutils.WaitExpect(200, func() bool {
+		return gen.Get(shardId) == database.GetCheckpointId()
+	})
+	inst.Close()
+
+	inst, _, _ = initTestDB2(t)
+	tblMeta, err = inst.Opts.Meta.Catalog.SimpleGetTableByName(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), tblMeta.Schema.Version)
+	check()
+	inst.Close()
+}
+
 func matchStringArray(a, b []string) bool {
 	if len(a) != len(b) {
 		return false
@@ -3100,3 +3601,164 @@ func matchStringArray(a, b []string) bool {
 	}
 	return true
 }
+
+func TestOptimizeTable(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB1(t)
+
+	// the table overrides the layout and delays its compaction for ages
+	schema := metadata.MockSchema(2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.Compaction = metadata.TimeWindowCompaction
+	schema.CompactionWindow = 100 * 365 * 24 * 3600
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), tblMeta.Schema.BlockMaxRows)
+	assert.Equal(t, uint64(2), tblMeta.Schema.SegmentMaxBlocks)
+
+	rows := uint64(30)
+	ck := mock.MockBatch(schema.Types(), rows)
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, ck)))
+
+	tblData, err := inst.Store.DataTables.WeakRefTable(tblMeta.Id)
+	assert.Nil(t, err)
+	segIds := tblData.SegmentIds()
+	assert.Equal(t, 2, len(segIds))
+	segType := func() base.SegmentType {
+		return tblData.WeakRefSegment(segIds[0]).GetType()
+	}
+	testutils.WaitExpect(2000, func() bool {
+		return tblData.WeakRefSegment(segIds[0]).CanUpgrade()
+	})
+	assert.True(t, tblData.WeakRefSegment(segIds[0]).CanUpgrade())
+	time.Sleep(time.Duration(100) * time.Millisecond)
+	assert.Equal(t, base.UNSORTED_SEG, segType())
+
+	// the partial segment is closed and both are merge-sorted
+	err = inst.OptimizeTable(&OptimizeTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Table:         schema.Name,
+	})
+	assert.Nil(t, err)
+	sorted := func(id uint64) bool {
+		return tblData.WeakRefSegment(id).GetType() == base.SORTED_SEG
+	}
+	testutils.WaitExpect(2000, func() bool {
+		return sorted(segIds[0]) && sorted(segIds[1])
+	})
+	assert.True(t, sorted(segIds[0]))
+	assert.True(t, sorted(segIds[1]))
+	assert.Equal(t, 1, tblMeta.SimpleGetSegment(segIds[1]).MaxBlockCount())
+
+	// the next rows go to a new segment
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), 10))))
+	rows += 10
+	segIds = tblData.SegmentIds()
+	assert.Equal(t, 3, len(segIds))
+	if len(segIds) != 3 {
+		return
+	}
+
+	rel, err := inst.Relation(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(rows), rel.Rows())
+	rel.Close()
+
+	err = inst.OptimizeTable(&OptimizeTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Table:         "xxxx",
+	})
+	assert.Equal(t, metadata.TableNotFoundErr, err)
+	assert.Nil(t, inst.FlushTable(database.Name, schema.Name))
+	testutils.WaitExpect(200, func() bool {
+		return database.GetCheckpointId() == gen.Get(database.GetShardId())
+	})
+	inst.Close()
+
+	// the closed segment is replayed with its blocks
+	inst, _, _ = initTestDB2(t)
+	defer inst.Close()
+	database, err = inst.Store.Catalog.SimpleGetDatabaseByName(database.Name)
+	assert.Nil(t, err)
+	tblMeta = database.SimpleGetTableByName(schema.Name)
+	assert.Equal(t, 1, tblMeta.SimpleGetSegment(segIds[1]).MaxBlockCount())
+	assert.Equal(t, 2, tblMeta.SimpleGetSegment(segIds[2]).MaxBlockCount())
+	rel, err = inst.Relation(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(rows), rel.Rows())
+	rel.Close()
+}
+
+func TestSizeTieredCompaction(t *testing.T) {
+	initTestEnv(t)
+	inst, gen, database := initTestDB1(t)
+
+	schema := metadata.MockSchema(2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 4
+	schema.Compaction = metadata.SizeTieredCompaction
+	createCtx := &CreateTableCtx{
+		DBMutationCtx: *CreateDBMutationCtx(database, gen),
+		Schema:        schema,
+	}
+	tblMeta, err := inst.CreateTable(createCtx)
+	assert.Nil(t, err)
+
+	// the segments hold 1, 2, 4 and then 4 blocks
+	rows := uint64(10*(1+2+4+4) + 5)
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), rows))))
+	tiers := []int{1, 2, 4, 4, 4}
+	segIds := tblMeta.SimpleGetSegmentIds()
+	assert.Equal(t, len(tiers), len(segIds))
+	if len(segIds) != len(tiers) {
+		return
+	}
+	for i, id := range segIds {
+		assert.Equal(t, tiers[i], tblMeta.SimpleGetSegment(id).MaxBlockCount())
+	}
+
+	// every segment is merge-sorted once it has got the blocks of its tier
+	tblData, err := inst.Store.DataTables.WeakRefTable(tblMeta.Id)
+	assert.Nil(t, err)
+	sorted := func() bool {
+		for _, id := range segIds[:len(segIds)-1] {
+			if tblData.WeakRefSegment(id).GetType() != base.SORTED_SEG {
+				return false
+			}
+		}
+		return true
+	}
+	testutils.WaitExpect(2000, sorted)
+	assert.True(t, sorted())
+	rel, err := inst.Relation(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(rows), rel.Rows())
+	rel.Close()
+	assert.Nil(t, inst.FlushTable(database.Name, schema.Name))
+	testutils.WaitExpect(200, func() bool {
+		return database.GetCheckpointId() == gen.Get(database.GetShardId())
+	})
+	inst.Close()
+
+	// the tiers are replayed and the last segment gets the next rows
+	inst, _, _ = initTestDB2(t)
+	defer inst.Close()
+	database, err = inst.Store.Catalog.SimpleGetDatabaseByName(database.Name)
+	assert.Nil(t, err)
+	tblMeta = database.SimpleGetTableByName(schema.Name)
+	for i, id := range segIds {
+		assert.Equal(t, tiers[i], tblMeta.SimpleGetSegment(id).MaxBlockCount())
+	}
+	assert.Nil(t, inst.Append(CreateAppendCtx(database, gen, schema.Name, mock.MockBatch(schema.Types(), 10))))
+	rows += 10
+	assert.Equal(t, segIds, tblMeta.SimpleGetSegmentIds())
+	rel, err = inst.Relation(database.Name, schema.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(rows), rel.Rows())
+	rel.Close()
+}
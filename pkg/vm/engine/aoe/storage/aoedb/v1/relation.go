@@ -71,6 +71,12 @@ func (r *Relation) Close() error {
 	return nil
 }
 
+// HistoryHorizon returns the oldest unix time in nanoseconds the
+// relation can be read as of.
+func (r *Relation) HistoryHorizon() int64 {
+	return r.Data.HistoryHorizon()
+}
+
 func (r *Relation) SegmentIds() dbi.IDS {
 	return dbi.IDS{Ids: r.Data.SegmentIds()}
 }
@@ -81,11 +87,12 @@ func (r *Relation) Index() []*engine.IndexTableDef {
 
 func (r *Relation) Attribute() []engine.Attribute {
 	meta := r.Data.GetMeta()
-	attrs := make([]engine.Attribute, len(meta.Schema.ColDefs))
-	for idx, attr := range attrs {
-		attr.Name = meta.Schema.ColDefs[idx].Name
-		attr.Type = meta.Schema.ColDefs[idx].Type
-		attrs[idx] = attr
+	attrs := make([]engine.Attribute, 0, len(meta.Schema.ColDefs))
+	for _, colDef := range meta.Schema.ColDefs {
+		if colDef.Dropped {
+			continue
+		}
+		attrs = append(attrs, engine.Attribute{Name: colDef.Name, Type: colDef.Type})
 	}
 	return attrs
 }
@@ -104,9 +111,11 @@ func (r *Relation) Segment(id uint64) aoe.Segment {
 		r.tree.Unlock()
 		return seg
 	}
+	shardId, _ := IdToNameFactory.Decode(r.Meta.Database.Name)
 	seg = &db.Segment{
-		Ids:  new(atomic.Value),
-		Data: r.Data.StrongRefSegment(id),
+		Ids:     new(atomic.Value),
+		Data:    r.Data.StrongRefSegment(id),
+		ShardId: shardId.(uint64),
 	}
 	r.tree.Segments[id] = seg
 	r.tree.Unlock()
@@ -119,6 +119,14 @@ func CreateAppendCtx(database *metadata.Database, gen *shard.MockIndexAllocator,
 	}
 }
 
+func CreateDeleteCtx(database *metadata.Database, gen *shard.MockIndexAllocator, name string, data, newData *batch.Batch) *DeleteCtx {
+	return &DeleteCtx{
+		TableMutationCtx: *CreateTableMutationCtx(database, gen, name),
+		Data:             data,
+		NewData:          newData,
+	}
+}
+
 // func CreateDBMutationCtx(database *metadata.Database, gen *shard.MockIndexAllocator) *DBMutationCtx {
 // 	ctx := &DBMutationCtx{
 // 		DB:     database.Name,
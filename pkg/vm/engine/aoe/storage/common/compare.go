@@ -57,6 +57,12 @@ func CompareInterface(a, b interface{}) int64 {
 	if av, ok := a.(types.Datetime); ok {
 		return int64(av - b.(types.Datetime))
 	}
+	if av, ok := a.(types.Time); ok {
+		return int64(av - b.(types.Time))
+	}
+	if av, ok := a.(types.Timestamp); ok {
+		return int64(av - b.(types.Timestamp))
+	}
 	if av, ok := a.([]byte); ok {
 		return int64(bytes.Compare(av, b.([]byte)))
 	}
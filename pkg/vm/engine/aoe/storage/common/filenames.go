@@ -41,6 +41,8 @@ const (
 	NodeSuffix = ".nod"
 	BSISuffix = ".bsi"
 	BBSISuffix = ".bbsi"
+	OrdSuffix = ".ord"
+	StpSuffix = ".stp"
 
 	SpillDirName = "spill"
 	TempDirName  = "temp"
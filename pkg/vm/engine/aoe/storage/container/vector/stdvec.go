@@ -162,7 +162,10 @@ func (v *StdVector) GetValue(idx int) (interface{}, error) {
 		return encoding.DecodeFloat32(data), nil
 	case types.T_float64:
 		return encoding.DecodeFloat64(data), nil
-	// case types.T_decimal:
+	case types.T_decimal64:
+		return encoding.DecodeDecimal64(data), nil
+	case types.T_decimal128:
+		return encoding.DecodeDecimal128(data), nil
 	case types.T_date:
 		return encoding.DecodeDate(data), nil
 	case types.T_datetime:
@@ -213,12 +216,14 @@ func (v *StdVector) appendWithOffset(offset, n int, vals interface{}) error {
 	case types.T_uint64:
 		data = encoding.EncodeUint64Slice(vals.([]uint64)[offset : offset+n])
 
-	case types.T_decimal:
-		data = encoding.EncodeDecimalSlice(vals.([]types.Decimal)[offset : offset+n])
 	case types.T_float32:
 		data = encoding.EncodeFloat32Slice(vals.([]float32)[offset : offset+n])
 	case types.T_float64:
 		data = encoding.EncodeFloat64Slice(vals.([]float64)[offset : offset+n])
+	case types.T_decimal64:
+		data = encoding.EncodeDecimal64Slice(vals.([]types.Decimal64)[offset : offset+n])
+	case types.T_decimal128:
+		data = encoding.EncodeDecimal128Slice(vals.([]types.Decimal128)[offset : offset+n])
 	case types.T_date:
 		data = encoding.EncodeDateSlice(vals.([]types.Date)[offset : offset+n])
 	case types.T_datetime:
@@ -443,12 +448,6 @@ func (v *StdVector) CopyToVector() (*ro.Vector, error) {
 		vec.Col = col
 		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
 
-	case types.T_decimal:
-		col := make([]types.Decimal, length)
-		curCol := encoding.DecodeDecimalSlice(v.Data)
-		copy(col, curCol[:length])
-		vec.Col = col
-		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
 	case types.T_float32:
 		col := make([]float32, length)
 		curCol := encoding.DecodeFloat32Slice(v.Data)
@@ -461,6 +460,18 @@ func (v *StdVector) CopyToVector() (*ro.Vector, error) {
 		copy(col[0:], curCol[:length])
 		vec.Col = col
 		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
+	case types.T_decimal64:
+		col := make([]types.Decimal64, length)
+		curCol := encoding.DecodeDecimal64Slice(v.Data)
+		copy(col[0:], curCol[:length])
+		vec.Col = col
+		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
+	case types.T_decimal128:
+		col := make([]types.Decimal128, length)
+		curCol := encoding.DecodeDecimal128Slice(v.Data)
+		copy(col[0:], curCol[:length])
+		vec.Col = col
+		vec.Nsp = nulls.Range(v.VMask, uint64(0), uint64(length), &nulls.Nulls{})
 	case types.T_date:
 		col := make([]types.Date, length)
 		curCol := encoding.DecodeDateSlice(v.Data)
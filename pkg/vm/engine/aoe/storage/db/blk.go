@@ -18,9 +18,14 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
 )
 
 // Block is a high-level wrapper of the block type in memory. It
@@ -35,15 +40,21 @@ type Block struct {
 }
 
 // Rows returns how many rows this block contains currently.
-func (blk *Block) Rows() int64 {
+func (blk *Block) Rows() (int64, error) {
 	data := blk.Host.Data.StrongRefBlock(blk.Id)
 	if data == nil {
-		// TODO: returns error
-		logutil.Warnf("specified blk %d not found", blk.Id)
-		return -1
+		return 0, errors.New(fmt.Sprintf("specified blk %d not found", blk.Id))
 	}
 	defer data.Unref()
-	return int64(data.GetRowCount())
+	rows := int64(data.GetRowCount())
+	mask, err := data.GetDeleteMask()
+	if err != nil {
+		return 0, err
+	}
+	if mask != nil {
+		rows -= int64(mask.GetCardinality())
+	}
+	return rows, nil
 }
 
 // Size returns the memory usage of the certain column in a block.
@@ -75,6 +86,9 @@ func (blk *Block) Prefetch(attrs []string) {
 	}
 	defer data.Unref()
 	for _, attr := range attrs {
+		if attr == engine.RowId {
+			continue
+		}
 		if err := data.Prefetch(attr); err != nil {
 			// TODO
 			panic(err)
@@ -92,16 +106,140 @@ func (blk *Block) Read(cs []uint64, attrs []string, compressed []*bytes.Buffer,
 		return nil, errors.New(fmt.Sprintf("specified blk %d not found", blk.Id))
 	}
 	defer data.Unref()
+	mask, err := data.GetDeleteMask()
+	if err != nil {
+		return nil, err
+	}
+	bat, err := readBatch(data, blk.Host.ShardId, cs, attrs, compressed, deCompressed)
+	if err != nil {
+		return nil, err
+	}
+	if mask != nil {
+		shrinkBatch(bat, mask)
+	}
+	return bat, nil
+}
+
+// ReadAsOf works like Read but reads the rows which were visible at
+// the given unix time in nanoseconds, which must not be older than the
+// history horizon of the table.
+func (blk *Block) ReadAsOf(ts int64, cs []uint64, attrs []string, compressed []*bytes.Buffer, deCompressed []*bytes.Buffer) (*batch.Batch, error) {
+	data := blk.Host.Data.StrongRefBlock(blk.Id)
+	if data == nil {
+		return nil, errors.New(fmt.Sprintf("specified blk %d not found", blk.Id))
+	}
+	defer data.Unref()
+	bat, err := readBatch(data, blk.Host.ShardId, cs, attrs, compressed, deCompressed)
+	if err != nil {
+		return nil, err
+	}
+	mask, err := data.GetDeleteMaskAsOf(ts, uint64(vector.Length(bat.Vecs[0])))
+	if err != nil {
+		return nil, err
+	}
+	if !mask.IsEmpty() {
+		shrinkBatch(bat, mask)
+	}
+	return bat, nil
+}
+
+// scanRows reads the given columns of the block data and calls fn
+// with the origin of each row, deleted or not, until fn returns an
+// error.
+func scanRows(data iface.IBlock, attrs []string, compressed []*bytes.Buffer, deCompressed []*bytes.Buffer,
+	fn func(uint64, *batch.Batch, int64) error) error {
+	cs := make([]uint64, len(attrs))
+	for i := range cs {
+		cs[i] = 1
+	}
+	bat, err := readBatch(data, 0, cs, attrs, compressed, deCompressed)
+	if err != nil {
+		return err
+	}
+	origins, err := data.GetRowOrigins()
+	if err != nil {
+		return err
+	}
+	n := vector.Length(bat.Vecs[0])
+	if len(origins) < n {
+		return errors.New(fmt.Sprintf("blk %d has %d rows but %d origins", data.GetMeta().Id, n, len(origins)))
+	}
+	for i := 0; i < n; i++ {
+		if err = fn(origins[i], bat, int64(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func shrinkBatch(bat *batch.Batch, mask *roaring64.Bitmap) {
+	sels := make([]int64, 0, vector.Length(bat.Vecs[0]))
+	for i, n := uint64(0), uint64(vector.Length(bat.Vecs[0])); i < n; i++ {
+		if !mask.Contains(i) {
+			sels = append(sels, int64(i))
+		}
+	}
+	for _, vec := range bat.Vecs {
+		vector.Shrink(vec, sels)
+	}
+}
+
+// readBatch reads the given columns of the block data, engine.RowId is
+// read as the row ids of the rows in the tablet of the shard.
+func readBatch(data iface.IBlock, shardId uint64, cs []uint64, attrs []string, compressed []*bytes.Buffer, deCompressed []*bytes.Buffer) (*batch.Batch, error) {
 	bat := batch.New(true, attrs)
 	bat.Vecs = make([]*vector.Vector, len(attrs))
+	rows := -1
 	for i, attr := range attrs {
+		if attr == engine.RowId {
+			continue
+		}
 		vec, err := data.GetVectorCopy(attr, compressed[i], deCompressed[i])
 		if err != nil {
 			return nil, err
 		}
 		vec.Ref = cs[i]
 		bat.Vecs[i] = vec
+		rows = vector.Length(vec)
+	}
+	for i, attr := range attrs {
+		if attr != engine.RowId {
+			continue
+		}
+		vec, err := readRowIds(data, shardId, rows)
+		if err != nil {
+			return nil, err
+		}
+		vec.Ref = cs[i]
+		bat.Vecs[i] = vec
 	}
 	return bat, nil
 }
 
+// readRowIds returns the row ids of the first rows rows of the block
+// data, or of all its rows if rows is negative.
+func readRowIds(data iface.IBlock, shardId uint64, rows int) (*vector.Vector, error) {
+	origins, err := data.GetRowOrigins()
+	if err != nil {
+		return nil, err
+	}
+	if rows < 0 {
+		rows = len(origins)
+	}
+	if len(origins) < rows {
+		return nil, errors.New(fmt.Sprintf("blk %d has %d rows but %d origins", data.GetMeta().Id, rows, len(origins)))
+	}
+	meta := data.GetMeta().Segment
+	buf := make([]byte, 0, rows*metadata.RowIdSize)
+	ids := make([][]byte, rows)
+	for i, origin := range origins[:rows] {
+		buf = metadata.EncodeRowId(buf, shardId, meta.Id, origin)
+		ids[i] = buf[i*metadata.RowIdSize:]
+	}
+	vec := vector.New(engine.RowIdType)
+	if err := vector.Append(vec, ids); err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
+
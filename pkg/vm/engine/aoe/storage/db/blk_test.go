@@ -78,7 +78,9 @@ func TestBlock(t *testing.T) {
 	assert.NotNil(t, blk1)
 	assert.Equal(t, string(encoding.EncodeUint64(uint64(1))), blk1.ID())
 
-	assert.Equal(t, int64(0), blk1.Rows())
+	rows, err := blk1.Rows()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), rows)
 	assert.Equal(t, int64(rowCount*typeSize), blk1.Size("mock_0"))
 	assert.NotPanics(t, func() {
 		blk1.Prefetch([]string{"mock_1"})
@@ -99,5 +101,6 @@ func TestBlock(t *testing.T) {
 		blk1.Prefetch([]string{"mock_1"})
 	})
 	assert.Equal(t, int64(-1), blk1.Size("mock_1"))
-	assert.Equal(t, int64(-1), blk1.Rows())
+	_, err = blk1.Rows()
+	assert.NotNil(t, err)
 }
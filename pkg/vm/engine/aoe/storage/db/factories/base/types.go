@@ -25,6 +25,6 @@ type MutFactory interface {
 }
 
 type NodeFactory interface {
-	CreateNode(base.ISegmentFile, *metadata.Block, *mb.MockSize) bb.INode
+	CreateNode(base.ISegmentFile, *metadata.Block, *mb.MockSize) (bb.INode, error)
 	GetManager() bb.INodeManager
 }
@@ -83,7 +83,9 @@ func TestMutBlockNodeFactory(t *testing.T) {
 	nodeFactory := factory.GetNodeFactroy(tabledata)
 
 	mockSize := mb.NewMockSize(uint64(0))
-	node1 := nodeFactory.CreateNode(segfile, meta1, mockSize).(*mutation.MutableBlockNode)
+	n1, err := nodeFactory.CreateNode(segfile, meta1, mockSize)
+	assert.Nil(t, err)
+	node1 := n1.(*mutation.MutableBlockNode)
 
 	h1 := mgr.Pin(node1)
 	assert.NotNil(t, h1)
@@ -114,7 +116,9 @@ func TestMutBlockNodeFactory(t *testing.T) {
 	t.Logf("length=%d", node1.Data.Length())
 	assert.Equal(t, rows*factor*2, mgr.Total())
 
-	node2 := nodeFactory.CreateNode(segfile, meta2, mockSize).(*mutation.MutableBlockNode)
+	n2, err := nodeFactory.CreateNode(segfile, meta2, mockSize)
+	assert.Nil(t, err)
+	node2 := n2.(*mutation.MutableBlockNode)
 	h2 := mgr.Pin(node2)
 	assert.NotNil(t, h2)
 
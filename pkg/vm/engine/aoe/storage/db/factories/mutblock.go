@@ -40,10 +40,10 @@ func (f *mutNodeFactory) GetManager() bb.INodeManager {
 	return f.host.mgr
 }
 
-func (f *mutNodeFactory) CreateNode(segfile base.ISegmentFile, meta *metadata.Block, mockSize *mb.MockSize) bb.INode {
+func (f *mutNodeFactory) CreateNode(segfile base.ISegmentFile, meta *metadata.Block, mockSize *mb.MockSize) (bb.INode, error) {
 	bf, err := segfile.RegisterTBlock(*meta.AsCommonID())
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	blkfile := bf.(*dataio.TransientBlockFile)
 	nodeSize := uint64(0)
@@ -52,7 +52,10 @@ func (f *mutNodeFactory) CreateNode(segfile base.ISegmentFile, meta *metadata.Bl
 	} else {
 		nodeSize = metadata.EstimateBlockSize(meta)
 	}
-	n := mutation.NewMutableBlockNode(f.host.mgr, blkfile, f.tdata, meta, f.host.flusher, nodeSize)
+	n, err := mutation.NewMutableBlockNode(f.host.mgr, blkfile, f.tdata, meta, f.host.flusher, nodeSize)
+	if err != nil {
+		return nil, err
+	}
 	f.host.mgr.RegisterNode(n)
-	return n
+	return n, nil
 }
@@ -0,0 +1,77 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcreqs
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/gc"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/table/v1/iface"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/ops"
+)
+
+// historyPruneRequest periodically drops the history of the rows which
+// is older than the retention of their table.
+type historyPruneRequest struct {
+	gc.BaseRequest
+	tables     *table.Tables
+	interval   time.Duration
+	lastExecTS int64
+}
+
+func NewHistoryPruneRequest(tables *table.Tables, interval time.Duration) *historyPruneRequest {
+	req := new(historyPruneRequest)
+	req.tables = tables
+	req.interval = interval
+	req.Op = ops.Op{
+		Impl:   req,
+		ErrorC: make(chan error),
+	}
+	return req
+}
+
+func (req *historyPruneRequest) IncIteration() {}
+
+func (req *historyPruneRequest) updateExecTS() { req.lastExecTS = time.Now().UnixMilli() }
+func (req *historyPruneRequest) checkInterval() bool {
+	now := time.Now().UnixMilli()
+	return now-req.lastExecTS >= req.interval.Milliseconds()
+}
+
+func (req *historyPruneRequest) Execute() error {
+	req.Next = req
+	if !req.checkInterval() {
+		return nil
+	}
+	return req.DoRun()
+}
+
+func (req *historyPruneRequest) DoRun() error {
+	var tables []iface.ITableData
+	req.tables.ForTables(func(td iface.ITableData) error {
+		td.Ref()
+		tables = append(tables, td)
+		return nil
+	})
+	for _, td := range tables {
+		if td.GetMeta().Schema.Retention > 0 {
+			td.PruneHistory()
+		}
+		td.Unref()
+	}
+	req.updateExecTS()
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcreqs
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db/sched/iface"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/gc"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/ops"
+)
+
+// segmentCompactionRequest periodically merge-sorts the closed segments
+// whose compaction was delayed by the policy of their table.
+type segmentCompactionRequest struct {
+	gc.BaseRequest
+	scheduler  iface.DBScheduler
+	interval   time.Duration
+	lastExecTS int64
+}
+
+func NewSegmentCompactionRequest(scheduler iface.DBScheduler, interval time.Duration) *segmentCompactionRequest {
+	req := new(segmentCompactionRequest)
+	req.scheduler = scheduler
+	req.interval = interval
+	req.Op = ops.Op{
+		Impl:   req,
+		ErrorC: make(chan error),
+	}
+	return req
+}
+
+func (req *segmentCompactionRequest) IncIteration() {}
+
+func (req *segmentCompactionRequest) updateExecTS() { req.lastExecTS = time.Now().UnixMilli() }
+func (req *segmentCompactionRequest) checkInterval() bool {
+	now := time.Now().UnixMilli()
+	return now-req.lastExecTS >= req.interval.Milliseconds()
+}
+
+func (req *segmentCompactionRequest) Execute() error {
+	req.Next = req
+	if !req.checkInterval() {
+		return nil
+	}
+	return req.DoRun()
+}
+
+func (req *segmentCompactionRequest) DoRun() error {
+	req.scheduler.CompactSegments()
+	req.updateExecTS()
+	return nil
+}
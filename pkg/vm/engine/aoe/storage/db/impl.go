@@ -15,6 +15,7 @@
 package db
 
 import (
+	"bytes"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -81,6 +82,15 @@ func (d *DB) DoFlushTable(meta *metadata.Table) error {
 	return handle.Flush()
 }
 
+func (d *DB) DoCloseSegment(meta *metadata.Table, index *metadata.LogIndex) error {
+	handle, err := d.MakeMutationHandle(meta)
+	if err != nil {
+		return err
+	}
+	defer handle.Close()
+	return handle.CloseSegment(index)
+}
+
 func (d *DB) DoCreateSnapshot(database *metadata.Database, path string, forcesync bool) (uint64, error) {
 	var err error
 	if forcesync {
@@ -133,13 +143,13 @@ func (d *DB) TableIdempotenceCheckAndIndexRewrite(meta *metadata.Table, index *L
 	return index, nil
 }
 
-func (d *DB) DoAppend(meta *metadata.Table, data *batch.Batch, index *shard.SliceIndex) error {
+func (d *DB) DoAppend(meta *metadata.Table, data *batch.Batch, index *shard.SliceIndex, ts int64) error {
 	handle, err := d.MakeMutationHandle(meta)
 	if err != nil {
 		return err
 	}
 	defer handle.Close()
-	return handle.Append(data, index)
+	return handle.Append(data, index, ts)
 }
 
 func (d *DB) MakeMutationHandle(meta *metadata.Table) (iface.MutationHandle, error) {
@@ -169,6 +179,45 @@ func (d *DB) GetTableData(meta *metadata.Table) (tiface.ITableData, error) {
 	return data, nil
 }
 
+// ScanRows reads the given columns of the table and calls fn with the
+// segment id and the origin of each row, deleted or not, until fn
+// returns an error.
+func (d *DB) ScanRows(meta *metadata.Table, attrs []string, fn func(uint64, uint64, *batch.Batch, int64) error) error {
+	data, err := d.GetTableData(meta)
+	if err != nil {
+		return err
+	}
+	defer data.Unref()
+	compressed := make([]*bytes.Buffer, len(attrs))
+	deCompressed := make([]*bytes.Buffer, len(attrs))
+	for i := range attrs {
+		compressed[i] = bytes.NewBuffer(make([]byte, 0, 1024))
+		deCompressed[i] = bytes.NewBuffer(make([]byte, 0, 1024))
+	}
+	for _, segId := range data.SegmentIds() {
+		seg := data.StrongRefSegment(segId)
+		if seg == nil {
+			continue
+		}
+		for _, blkId := range seg.BlockIds() {
+			blk := seg.StrongRefBlock(blkId)
+			if blk == nil {
+				continue
+			}
+			err = scanRows(blk, attrs, compressed, deCompressed, func(origin uint64, bat *batch.Batch, i int64) error {
+				return fn(segId, origin, bat, i)
+			})
+			blk.Unref()
+			if err != nil {
+				seg.Unref()
+				return err
+			}
+		}
+		seg.Unref()
+	}
+	return nil
+}
+
 func (d *DB) ScheduleGCDatabase(database *metadata.Database) {
 	gcReq := gcreqs.NewDropDBRequest(d.Opts, database, d.Store.DataTables)
 	d.Opts.GC.Acceptor.Accept(gcReq)
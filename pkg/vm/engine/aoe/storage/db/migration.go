@@ -45,8 +45,10 @@ func CopyTBlockFileToDestDir(file, srcDir, destDir string, idMapFn func(*common.
 	src := filepath.Join(srcDir, file)
 	dest := dataio.MakeTblockFileName(destDir, tag, count, *nid, false)
 	logutil.Infof("Copy \"%s\" to \"%s\"", src, dest)
-	err = CopyFileFn(src, dest)
-	return err
+	if err = CopyFileFn(src, dest); err != nil {
+		return err
+	}
+	return copySidecarFile(dataio.MakeRowStampsFileName(src), dataio.MakeRowStampsFileName(dest))
 }
 
 func CopyBlockFileToDestDir(file, srcDir, destDir string, idMapFn func(*common.ID) (*common.ID, error)) error {
@@ -62,8 +64,10 @@ func CopyBlockFileToDestDir(file, srcDir, destDir string, idMapFn func(*common.I
 	src := filepath.Join(srcDir, file)
 	dest := common.MakeBlockFileName(destDir, nid.ToBlockFileName(), nid.TableID, false)
 	logutil.Infof("Copy \"%s\" to \"%s\"", src, dest)
-	err = CopyFileFn(src, dest)
-	return err
+	if err = CopyFileFn(src, dest); err != nil {
+		return err
+	}
+	return copySidecarFile(dataio.MakeRowOrderFileName(src), dataio.MakeRowOrderFileName(dest))
 }
 
 func CopySegmentFileToDestDir(file, srcDir, destDir string, idMapFn func(*common.ID) (*common.ID, error)) error {
@@ -79,8 +83,19 @@ func CopySegmentFileToDestDir(file, srcDir, destDir string, idMapFn func(*common
 	src := filepath.Join(srcDir, file)
 	dest := common.MakeSegmentFileName(destDir, nid.ToSegmentFileName(), nid.TableID, false)
 	logutil.Infof("Copy \"%s\" to \"%s\"", src, dest)
-	err = CopyFileFn(src, dest)
-	return err
+	if err = CopyFileFn(src, dest); err != nil {
+		return err
+	}
+	return copySidecarFile(dataio.MakeRowOrderFileName(src), dataio.MakeRowOrderFileName(dest))
+}
+
+// copySidecarFile copies the file src written along with a data file
+// if any, such as its order file.
+func copySidecarFile(src, dest string) error {
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return nil
+	}
+	return CopyFileFn(src, dest)
 }
 
 func ScanMigrationDir(path string) (metas []string, tblks []string, blks []string, segs []string, err error) {
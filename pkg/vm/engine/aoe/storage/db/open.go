@@ -125,6 +125,8 @@ func Open(dirname string, opts *storage.Options) (db *DB, err error) {
 	replayHandle.ScheduleEvents(db.Opts, db.Store.DataTables)
 
 	db.Opts.GC.Acceptor.Accept(gcreqs.NewCatalogCompactionRequest(db.Store.Catalog, db.Opts.MetaCleanerCfg.Interval))
+	db.Opts.GC.Acceptor.Accept(gcreqs.NewHistoryPruneRequest(db.Store.DataTables, db.Opts.MetaCleanerCfg.Interval))
+	db.Opts.GC.Acceptor.Accept(gcreqs.NewSegmentCompactionRequest(db.Opts.Scheduler, db.Opts.MetaCleanerCfg.Interval))
 	os.RemoveAll(db.GetTempDir())
 	os.MkdirAll(db.GetTempDir(), os.FileMode(0755))
 	return db, err
@@ -21,6 +21,7 @@ import (
 	"os"
 	"path"
 	"sort"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
@@ -612,6 +613,18 @@ func (h *replayHandle) addDataFile(fname string) {
 	if _, ok := common.ParseBitSlicedIndexFileName(fname); ok {
 		return
 	}
+	if name := strings.TrimSuffix(fname, common.OrdSuffix); name != fname {
+		// The order file is removed along with its data file
+		if _, err := os.Stat(path.Join(h.dataDir, name)); err == nil {
+			return
+		}
+	}
+	if name := strings.TrimSuffix(fname, common.StpSuffix); name != fname {
+		// So is the stamp file
+		if _, err := os.Stat(path.Join(h.dataDir, name)); err == nil {
+			return
+		}
+	}
 	h.others = append(h.others, path.Join(h.dataDir, fname))
 }
 
@@ -705,7 +718,7 @@ func (h *replayHandle) rebuildTable(meta *metadata.Table) error {
 			// log.Info(segMeta.String())
 			unsorted.tryCleanBlocks(h, segMeta)
 		}
-		if !unsorted.isfull(int(meta.Schema.SegmentMaxBlocks)) {
+		if !unsorted.isfull(segMeta.MaxBlockCount()) {
 			unclosedSegFiles = append(unclosedSegFiles, unsorted)
 			continue
 		}
@@ -806,6 +819,8 @@ func (h *replayHandle) Replay() error {
 
 func (h *replayHandle) doRemove(name string) {
 	os.Remove(name)
+	dataio.RemoveRowOrder(name)
+	dataio.RemoveRowStamps(name)
 	if h.observer != nil {
 		h.observer.OnRemove(name)
 	}
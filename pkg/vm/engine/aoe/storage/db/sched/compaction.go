@@ -0,0 +1,84 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sched
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+)
+
+// CompactionPolicy decides when a closed segment, which has got all its
+// blocks, is merge-sorted into a sorted segment. How many blocks a segment
+// gets is decided by the metadata when it is created, from the tiers of a
+// size-tiered table, or when it is closed early by OPTIMIZE TABLE, so that
+// every replica lays the rows out the same way. A policy only decides when
+// the merge sort runs on a replica.
+type CompactionPolicy interface {
+	// Due returns true if a segment closed at closedAt is to be merge-sorted
+	// at now.
+	Due(closedAt, now time.Time) bool
+}
+
+// sizeTieredPolicy merge-sorts a segment as soon as it has got the blocks
+// of its tier. The segments of a size-tiered table hold 1, 2, 4... blocks
+// up to the max, so that a small table is merge-sorted as it grows instead
+// of staying in a partial segment.
+type sizeTieredPolicy struct{}
+
+func (p sizeTieredPolicy) Due(closedAt, now time.Time) bool {
+	return true
+}
+
+// timeWindowPolicy merge-sorts the segments closed in the same time window
+// together once the window is over, which keeps the merge sorts away from
+// a burst of appends.
+type timeWindowPolicy struct {
+	window time.Duration
+}
+
+func (p timeWindowPolicy) Due(closedAt, now time.Time) bool {
+	return now.Truncate(p.window).After(closedAt)
+}
+
+// NewCompactionPolicy returns the policy of the given type, window is only
+// used by the time window compaction.
+func NewCompactionPolicy(typ metadata.CompactionT, window time.Duration) CompactionPolicy {
+	if typ == metadata.TimeWindowCompaction && window > 0 {
+		return timeWindowPolicy{window: window}
+	}
+	return sizeTieredPolicy{}
+}
+
+// compactionPolicy returns the policy of the table, it falls back to the
+// policy of the scheduler for what the table doesn't override.
+func compactionPolicy(cfg *storage.SchedulerCfg, schema *metadata.Schema) CompactionPolicy {
+	typ, window := cfg.Compaction, cfg.CompactionWindow
+	if schema.Compaction != metadata.DefaultCompaction {
+		typ = schema.Compaction
+	}
+	if schema.CompactionWindow > 0 {
+		window = time.Duration(schema.CompactionWindow) * time.Second
+	}
+	return NewCompactionPolicy(typ, window)
+}
+
+// closedSegment is a closed segment waiting for its merge sort, or a
+// segment closed by OPTIMIZE TABLE waiting for its blocks to be full.
+type closedSegment struct {
+	tableId  uint64
+	closedAt time.Time
+}
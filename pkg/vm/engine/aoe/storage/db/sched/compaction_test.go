@@ -0,0 +1,53 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sched
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactionPolicy(t *testing.T) {
+	now := time.Now()
+	policy := NewCompactionPolicy(metadata.SizeTieredCompaction, time.Hour)
+	assert.True(t, policy.Due(now, now))
+
+	policy = NewCompactionPolicy(metadata.TimeWindowCompaction, time.Hour)
+	closedAt := now.Truncate(time.Hour).Add(time.Minute)
+	assert.False(t, policy.Due(closedAt, closedAt))
+	assert.False(t, policy.Due(closedAt, closedAt.Add(58*time.Minute)))
+	assert.True(t, policy.Due(closedAt, closedAt.Add(59*time.Minute)))
+
+	// a window of 0 merges at once
+	policy = NewCompactionPolicy(metadata.TimeWindowCompaction, 0)
+	assert.True(t, policy.Due(now, now))
+
+	cfg := &storage.SchedulerCfg{
+		Compaction:       metadata.TimeWindowCompaction,
+		CompactionWindow: time.Hour,
+	}
+	schema := metadata.MockSchema(1)
+	assert.False(t, compactionPolicy(cfg, schema).Due(closedAt, closedAt))
+	schema.Compaction = metadata.SizeTieredCompaction
+	assert.True(t, compactionPolicy(cfg, schema).Due(closedAt, closedAt))
+	schema.Compaction = metadata.DefaultCompaction
+	schema.CompactionWindow = 60
+	assert.True(t, compactionPolicy(cfg, schema).Due(closedAt, closedAt.Add(time.Minute)))
+}
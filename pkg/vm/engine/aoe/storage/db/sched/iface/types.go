@@ -10,4 +10,9 @@ type DBScheduler interface {
 	sched.Scheduler
 	InstallBlock(meta *metadata.Block, table iface.ITableData) (iface.IBlock, error)
 	AsyncFlushBlock(block iface.IMutBlock)
+	// CompactSegments merge-sorts the closed segments which are due under
+	// the compaction policy of their tables.
+	CompactSegments()
+	// CompactTable merge-sorts all the closed segments of the table now.
+	CompactTable(id uint64)
 }
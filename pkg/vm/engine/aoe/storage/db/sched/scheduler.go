@@ -16,6 +16,7 @@ package sched
 
 import (
 	"sync"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 
@@ -156,6 +157,14 @@ type scheduler struct {
 		mu     sync.RWMutex
 		blkmap map[uint64]*metablkCommiter
 	}
+	// closed segments whose merge sort is delayed by the compaction policy,
+	// segments forced by OPTIMIZE TABLE and segments being merge-sorted
+	compactions struct {
+		mu       sync.Mutex
+		closed   map[uint64]closedSegment
+		forced   map[uint64]closedSegment
+		flushing map[uint64]bool
+	}
 }
 
 func NewScheduler(opts *storage.Options, tables *table.Tables) *scheduler {
@@ -166,6 +175,9 @@ func NewScheduler(opts *storage.Options, tables *table.Tables) *scheduler {
 		Controller:    NewController(),
 	}
 	s.commiters.blkmap = make(map[uint64]*metablkCommiter)
+	s.compactions.closed = make(map[uint64]closedSegment)
+	s.compactions.forced = make(map[uint64]closedSegment)
+	s.compactions.flushing = make(map[uint64]bool)
 
 	// Start different type of handlers
 	dispatcher := sched.NewBaseDispatcher()
@@ -293,15 +305,139 @@ func (s *scheduler) onUpgradeBlkDone(e sched.Event) {
 		logutil.Warn("[Scheduler] Flush Segment Is Turned-Off")
 		return
 	}
-	segment := event.TableData.StrongRefSegment(event.Meta.Segment.Id)
+	meta := event.Meta.Segment
+	now := time.Now()
+	if !s.removeForcedSegment(meta.Id) && !compactionPolicy(s.opts.SchedulerCfg, meta.Table.Schema).Due(now, now) {
+		s.compactions.mu.Lock()
+		s.compactions.closed[meta.Id] = closedSegment{tableId: meta.Table.Id, closedAt: now}
+		s.compactions.mu.Unlock()
+		return
+	}
+	s.flushSegment(event.TableData, meta.Id)
+}
+
+// flushSegment starts a new flush segment event, which merge-sorts the
+// blocks of the closed segment, unless it is being merge-sorted.
+func (s *scheduler) flushSegment(tableData tif.ITableData, id uint64) {
+	s.compactions.mu.Lock()
+	flushing := s.compactions.flushing[id]
+	s.compactions.flushing[id] = true
+	s.compactions.mu.Unlock()
+	if flushing {
+		return
+	}
+	segment := tableData.StrongRefSegment(id)
 	if segment == nil {
-		logutil.Warnf("Probably table %d is dropped", event.Meta.Segment.Table.Id)
+		logutil.Warnf("Probably table %d is dropped", tableData.GetID())
+		s.onSegmentFlushed(id)
 		return
 	}
-	logutil.Infof(" %s | Segment %d | FlushSegEvent | Started", sched.EventPrefix, event.Meta.Segment.Id)
+	logutil.Infof(" %s | Segment %d | FlushSegEvent | Started", sched.EventPrefix, id)
 	flushCtx := &Context{Opts: s.opts}
 	flushEvent := NewFlushSegEvent(flushCtx, segment)
-	s.Schedule(flushEvent)
+	if err := s.Schedule(flushEvent); err != nil {
+		s.onSegmentFlushed(id)
+		segment.Unref()
+	}
+}
+
+// onSegmentFlushed records that the merge sort of the segment is over,
+// whether it succeeded or not.
+func (s *scheduler) onSegmentFlushed(id uint64) {
+	s.compactions.mu.Lock()
+	delete(s.compactions.flushing, id)
+	s.compactions.mu.Unlock()
+}
+
+// CompactSegments schedules the merge sort of the closed segments which
+// are due under the compaction policy of their tables.
+func (s *scheduler) CompactSegments() {
+	now := time.Now()
+	s.compactSegments(func(tableData tif.ITableData, seg closedSegment) bool {
+		return compactionPolicy(s.opts.SchedulerCfg, tableData.GetMeta().Schema).Due(seg.closedAt, now)
+	})
+}
+
+// CompactTable schedules the merge sort of all the closed segments of the
+// table, whatever its compaction policy is. The closed segments whose
+// blocks are not all full yet are merge-sorted as soon as they are.
+func (s *scheduler) CompactTable(id uint64) {
+	if !s.IsOn(FlushSegMask) {
+		return
+	}
+	tableData, err := s.tables.StrongRefTable(id)
+	if err != nil {
+		logutil.Warnf("Probably table %d is dropped", id)
+		return
+	}
+	defer tableData.Unref()
+	now := time.Now()
+	for _, segId := range tableData.SegmentIds() {
+		segment := tableData.StrongRefSegment(segId)
+		if segment == nil {
+			continue
+		}
+		meta := segment.GetMeta()
+		meta.RLock()
+		closed := meta.HasMaxBlocks()
+		meta.RUnlock()
+		if segment.CanUpgrade() {
+			s.removeClosedSegment(segId)
+			s.flushSegment(tableData, segId)
+		} else if closed && segment.GetType() == base.UNSORTED_SEG {
+			s.compactions.mu.Lock()
+			if !s.compactions.flushing[segId] {
+				s.compactions.forced[segId] = closedSegment{tableId: id, closedAt: now}
+			}
+			s.compactions.mu.Unlock()
+		}
+		segment.Unref()
+	}
+}
+
+func (s *scheduler) compactSegments(due func(tif.ITableData, closedSegment) bool) {
+	if !s.IsOn(FlushSegMask) {
+		return
+	}
+	s.compactions.mu.Lock()
+	for id, seg := range s.compactions.forced {
+		if _, err := s.tables.WeakRefTable(seg.tableId); err != nil {
+			delete(s.compactions.forced, id)
+		}
+	}
+	closed := make(map[uint64]closedSegment, len(s.compactions.closed))
+	for id, seg := range s.compactions.closed {
+		closed[id] = seg
+	}
+	s.compactions.mu.Unlock()
+	for id, seg := range closed {
+		tableData, err := s.tables.StrongRefTable(seg.tableId)
+		if err != nil {
+			logutil.Warnf("Probably table %d is dropped", seg.tableId)
+			s.removeClosedSegment(id)
+			continue
+		}
+		if due(tableData, seg) && s.removeClosedSegment(id) {
+			s.flushSegment(tableData, id)
+		}
+		tableData.Unref()
+	}
+}
+
+func (s *scheduler) removeForcedSegment(id uint64) bool {
+	s.compactions.mu.Lock()
+	defer s.compactions.mu.Unlock()
+	_, ok := s.compactions.forced[id]
+	delete(s.compactions.forced, id)
+	return ok
+}
+
+func (s *scheduler) removeClosedSegment(id uint64) bool {
+	s.compactions.mu.Lock()
+	defer s.compactions.mu.Unlock()
+	_, ok := s.compactions.closed[id]
+	delete(s.compactions.closed, id)
+	return ok
 }
 
 // onFlushSegDone handles the finished flush segment event, generates a new
@@ -309,11 +445,13 @@ func (s *scheduler) onUpgradeBlkDone(e sched.Event) {
 func (s *scheduler) onFlushSegDone(e sched.Event) {
 	event := e.(*flushSegEvent)
 	if err := e.GetError(); err != nil {
+		s.onSegmentFlushed(event.Segment.GetMeta().Id)
 		event.Segment.Unref()
 		return
 	}
 	if !s.IsOn(UpgradeSegMask) {
 		logutil.Warn("[Scheduler] Upgrade Segment Is Turned-Off")
+		s.onSegmentFlushed(event.Segment.GetMeta().Id)
 		event.Segment.Unref()
 		return
 	}
@@ -322,6 +460,7 @@ func (s *scheduler) onFlushSegDone(e sched.Event) {
 	meta := event.Segment.GetMeta()
 	td, err := s.tables.StrongRefTable(meta.Table.Id)
 	if err != nil {
+		s.onSegmentFlushed(meta.Id)
 		event.Segment.Unref()
 		event.Rollback("Rollback-TableNotExist")
 		return
@@ -337,6 +476,7 @@ func (s *scheduler) onUpgradeSegDone(e sched.Event) {
 	event := e.(*upgradeSegEvent)
 	defer event.TableData.Unref()
 	defer event.OldSegment.Unref()
+	s.onSegmentFlushed(event.OldSegment.GetMeta().Id)
 	if err := e.GetError(); err != nil {
 		s.opts.EventListener.OnBackgroundError(err)
 		return
@@ -383,6 +523,13 @@ func (s *scheduler) preprocess(e sched.Event) {
 	switch e.Type() {
 	case FlushBlkTask:
 		s.onPreScheduleFlushBlkTask(e)
+	case FlushSegTask:
+		// The segments merge-sorted on replay or on split do not go
+		// through flushSegment
+		id := e.(*flushSegEvent).Segment.GetMeta().Id
+		s.compactions.mu.Lock()
+		s.compactions.flushing[id] = true
+		s.compactions.mu.Unlock()
 	}
 	e.AddObserver(s)
 }
@@ -15,6 +15,9 @@
 package db
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -28,6 +31,9 @@ import (
 type Segment struct {
 	Data iface.ISegment
 	Ids  *atomic.Value
+	// ShardId is the id of the shard of the tablet, which is a part of
+	// the row ids read from the segment
+	ShardId uint64
 }
 
 // ID returns the string representation of this segment's id.
@@ -91,3 +97,20 @@ func (seg *Segment) Rows() int64 {
 func (seg *Segment) Size(attr string) int64 {
 	return int64(seg.Data.Size(attr))
 }
+
+// colIdx returns the index of the given column. The filters and the
+// summarizers work on the indices of the blocks, so the column must be
+// stored by all the blocks of the segment.
+func (seg *Segment) colIdx(attr string) (int, error) {
+	meta := seg.Data.GetMeta()
+	colIdx := meta.Table.Schema.GetColIdx(attr)
+	if colIdx == -1 {
+		return -1, errors.New(fmt.Sprintf("column %s not found", attr))
+	}
+	for _, blk := range meta.BlockSet {
+		if colIdx >= len(blk.Schema().ColDefs) {
+			return -1, errors.New(fmt.Sprintf("column %s is added after segment %d", attr, meta.Id))
+		}
+	}
+	return colIdx, nil
+}
@@ -15,8 +15,6 @@
 package db
 
 import (
-	"errors"
-	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/index"
@@ -38,9 +36,9 @@ func NewSegmentFilter(s *Segment) engine.Filter {
 }
 
 func (f *SegmentFilter) Eq(attr string, val interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	bmRes := roaring.NewBitmap()
 	bmRes.AddRange(0, uint64(f.segment.Rows()))
@@ -50,7 +48,7 @@ func (f *SegmentFilter) Eq(attr string, val interface{}) (*roaring64.Bitmap, err
 		BMRes: bmRes,
 		BsiRequired: true,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		// maybe a bsi not found error
 		return roaring64.NewBitmap(), err
@@ -76,9 +74,9 @@ func (f *SegmentFilter) Eq(attr string, val interface{}) (*roaring64.Bitmap, err
 }
 
 func (f *SegmentFilter) Ne(attr string, val interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	bmRes := roaring.NewBitmap()
 	bmRes.AddRange(0, uint64(f.segment.Rows()))
@@ -88,7 +86,7 @@ func (f *SegmentFilter) Ne(attr string, val interface{}) (*roaring64.Bitmap, err
 		BMRes: bmRes,
 		BsiRequired: true,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		// maybe a bsi not found error
 		return roaring64.NewBitmap(), err
@@ -111,9 +109,9 @@ func (f *SegmentFilter) Ne(attr string, val interface{}) (*roaring64.Bitmap, err
 }
 
 func (f *SegmentFilter) Lt(attr string, val interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	bmRes := roaring.NewBitmap()
 	bmRes.AddRange(0, uint64(f.segment.Rows()))
@@ -123,7 +121,7 @@ func (f *SegmentFilter) Lt(attr string, val interface{}) (*roaring64.Bitmap, err
 		BMRes: bmRes,
 		BsiRequired: true,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		// maybe a bsi not found error
 		return roaring64.NewBitmap(), err
@@ -149,9 +147,9 @@ func (f *SegmentFilter) Lt(attr string, val interface{}) (*roaring64.Bitmap, err
 }
 
 func (f *SegmentFilter) Le(attr string, val interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	bmRes := roaring.NewBitmap()
 	bmRes.AddRange(0, uint64(f.segment.Rows()))
@@ -161,7 +159,7 @@ func (f *SegmentFilter) Le(attr string, val interface{}) (*roaring64.Bitmap, err
 		BMRes: bmRes,
 		BsiRequired: true,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		// maybe a bsi not found error
 		return roaring64.NewBitmap(), err
@@ -187,9 +185,9 @@ func (f *SegmentFilter) Le(attr string, val interface{}) (*roaring64.Bitmap, err
 }
 
 func (f *SegmentFilter) Gt(attr string, val interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	bmRes := roaring.NewBitmap()
 	bmRes.AddRange(0, uint64(f.segment.Rows()))
@@ -199,7 +197,7 @@ func (f *SegmentFilter) Gt(attr string, val interface{}) (*roaring64.Bitmap, err
 		BMRes: bmRes,
 		BsiRequired: true,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		// maybe a bsi not found error
 		return roaring64.NewBitmap(), err
@@ -225,9 +223,9 @@ func (f *SegmentFilter) Gt(attr string, val interface{}) (*roaring64.Bitmap, err
 }
 
 func (f *SegmentFilter) Ge(attr string, val interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	bmRes := roaring.NewBitmap()
 	bmRes.AddRange(0, uint64(f.segment.Rows()))
@@ -237,7 +235,7 @@ func (f *SegmentFilter) Ge(attr string, val interface{}) (*roaring64.Bitmap, err
 		BMRes: bmRes,
 		BsiRequired: true,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		// maybe a bsi not found error
 		return roaring64.NewBitmap(), err
@@ -263,9 +261,9 @@ func (f *SegmentFilter) Ge(attr string, val interface{}) (*roaring64.Bitmap, err
 }
 
 func (f *SegmentFilter) Btw(attr string, minv interface{}, maxv interface{}) (*roaring64.Bitmap, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	bmRes := roaring.NewBitmap()
 	bmRes.AddRange(0, uint64(f.segment.Rows()))
@@ -276,7 +274,7 @@ func (f *SegmentFilter) Btw(attr string, minv interface{}, maxv interface{}) (*r
 		BMRes:  bmRes,
 		BsiRequired: true,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		// maybe a bsi not found error
 		return roaring64.NewBitmap(), err
@@ -16,8 +16,6 @@ package db
 
 import (
 	"bytes"
-	"errors"
-	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
@@ -38,15 +36,15 @@ func NewSegmentSparseFilter(s *Segment) aoe.SparseFilter {
 }
 
 func (f *SegmentSparseFilter) Eq(attr string, val interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	ctx := index.FilterCtx{
 		Op:      index.OpEq,
 		Val:     val,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -93,15 +91,15 @@ func (f *SegmentSparseFilter) Eq(attr string, val interface{}) ([]string, error)
 }
 
 func (f *SegmentSparseFilter) Ne(attr string, val interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	ctx := index.FilterCtx{
 		Op:      index.OpNe,
 		Val:     val,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -147,15 +145,15 @@ func (f *SegmentSparseFilter) Ne(attr string, val interface{}) ([]string, error)
 }
 
 func (f *SegmentSparseFilter) Lt(attr string, val interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	ctx := index.FilterCtx{
 		Op:      index.OpLt,
 		Val:     val,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -201,15 +199,15 @@ func (f *SegmentSparseFilter) Lt(attr string, val interface{}) ([]string, error)
 }
 
 func (f *SegmentSparseFilter) Le(attr string, val interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	ctx := index.FilterCtx{
 		Op:      index.OpLe,
 		Val:     val,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -255,15 +253,15 @@ func (f *SegmentSparseFilter) Le(attr string, val interface{}) ([]string, error)
 }
 
 func (f *SegmentSparseFilter) Gt(attr string, val interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	ctx := index.FilterCtx{
 		Op:      index.OpGt,
 		Val:     val,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -309,15 +307,15 @@ func (f *SegmentSparseFilter) Gt(attr string, val interface{}) ([]string, error)
 }
 
 func (f *SegmentSparseFilter) Ge(attr string, val interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	ctx := index.FilterCtx{
 		Op:      index.OpGe,
 		Val:     val,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -363,16 +361,16 @@ func (f *SegmentSparseFilter) Ge(attr string, val interface{}) ([]string, error)
 }
 
 func (f *SegmentSparseFilter) Btw(attr string, minv interface{}, maxv interface{}) ([]string, error) {
-	colIdx := f.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
 	}
 	ctx := index.FilterCtx{
 		Op:      index.OpIn,
 		ValMin: minv,
 		ValMax: maxv,
 	}
-	err := f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -417,6 +415,126 @@ func (f *SegmentSparseFilter) Btw(attr string, minv interface{}, maxv interface{
 	return res, nil
 }
 
+func (f *SegmentSparseFilter) In(attr string, vals []interface{}) ([]string, error) {
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
+	}
+	ctx := index.FilterCtx{
+		Op:      index.OpIn,
+	}
+	ctx.SetVals(vals)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ctx.BoolRes && f.segment.Data.GetType() == base.SORTED_SEG {
+		return []string{}, nil
+	}
+	if ctx.BlockSet != nil {
+		// filtering unclosed segment
+		res := make([]string, 0)
+		excluded := make(map[uint64]bool)
+		for _, blkId := range ctx.BlockSet {
+			strId := string(encoding.EncodeUint64(blkId))
+			res = append(res, strId)
+			excluded[blkId] = true
+		}
+		// transient block
+		for _, blk := range f.segment.Data.GetMeta().BlockSet {
+			if _, ok := excluded[blk.Id]; ok {
+				continue
+			}
+			if !blk.IsFull() {
+				res = append(res, string(encoding.EncodeUint64(blk.Id)))
+			}
+		}
+		return res, nil
+	}
+	blkCnt := len(f.segment.Blocks())
+	var res []string
+	blkMin, blkMax, err := f.segment.Data.GetIndexHolder().CollectMinMax(colIdx)
+	if err != nil {
+		return nil, err
+	}
+	for idx := 0; idx < blkCnt; idx++ {
+		strID := f.segment.Blocks()[idx]
+		typ := f.segment.Data.GetMeta().Table.Schema.ColDefs[colIdx].Type
+		for _, val := range vals {
+			if compare(blkMin[idx], val, typ) <= 0 && compare(blkMax[idx], val, typ) >= 0 {
+				res = append(res, strID)
+				break
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (f *SegmentSparseFilter) NotIn(attr string, vals []interface{}) ([]string, error) {
+	colIdx, err := f.segment.colIdx(attr)
+	if err != nil {
+		return nil, err
+	}
+	ctx := index.FilterCtx{
+		Op:      index.OpOut,
+	}
+	ctx.SetVals(vals)
+	err = f.segment.Data.GetIndexHolder().EvalFilter(colIdx, &ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ctx.BoolRes && f.segment.Data.GetType() == base.SORTED_SEG {
+		return []string{}, nil
+	}
+	if ctx.BlockSet != nil {
+		// filtering unclosed segment
+		res := make([]string, 0)
+		excluded := make(map[uint64]bool)
+		for _, blkId := range ctx.BlockSet {
+			strId := string(encoding.EncodeUint64(blkId))
+			res = append(res, strId)
+			excluded[blkId] = true
+		}
+		// transient block
+		for _, blk := range f.segment.Data.GetMeta().BlockSet {
+			if _, ok := excluded[blk.Id]; ok {
+				continue
+			}
+			if !blk.IsFull() {
+				res = append(res, string(encoding.EncodeUint64(blk.Id)))
+			}
+		}
+		return res, nil
+	}
+	blkCnt := len(f.segment.Blocks())
+	var res []string
+	blkMin, blkMax, err := f.segment.Data.GetIndexHolder().CollectMinMax(colIdx)
+	if err != nil {
+		return nil, err
+	}
+	for idx := 0; idx < blkCnt; idx++ {
+		strID := f.segment.Blocks()[idx]
+		typ := f.segment.Data.GetMeta().Table.Schema.ColDefs[colIdx].Type
+		// only a block whose values are all the same one can be skipped
+		if compare(blkMin[idx], blkMax[idx], typ) == 0 && inValues(blkMin[idx], vals, typ) {
+			continue
+		}
+		res = append(res, strID)
+	}
+
+	return res, nil
+}
+
+func inValues(val interface{}, vals []interface{}, typ types.Type) bool {
+	for _, v := range vals {
+		if compare(val, v, typ) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func compare(val1, val2 interface{}, typ types.Type) int {
 	switch typ.Oid {
 	case types.T_int8:
@@ -439,10 +557,18 @@ func compare(val1, val2 interface{}, typ types.Type) int {
 		return int(val1.(float32) - val2.(float32))
 	case types.T_float64:
 		return int(val1.(float64) - val2.(float64))
+	case types.T_decimal64:
+		return val1.(types.Decimal64).Compare(val2.(types.Decimal64))
+	case types.T_decimal128:
+		return val1.(types.Decimal128).Compare(val2.(types.Decimal128))
 	case types.T_char, types.T_json, types.T_varchar:
 		return bytes.Compare(val1.([]byte), val2.([]byte))
 	case types.T_datetime:
 		return int(val1.(types.Datetime) - val2.(types.Datetime))
+	case types.T_time:
+		return int(val1.(types.Time) - val2.(types.Time))
+	case types.T_timestamp:
+		return int(val1.(types.Timestamp) - val2.(types.Timestamp))
 	case types.T_date:
 		return int(val1.(types.Date) - val2.(types.Date))
 	}
@@ -15,8 +15,6 @@
 package db
 
 import (
-	"errors"
-	"fmt"
 	roaring "github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
@@ -39,9 +37,9 @@ func NewSegmentSummarizer(s *Segment) engine.Summarizer {
 }
 
 func (s *SegmentSummarizer) Count(attr string, filter *roaring.Bitmap) (uint64, error) {
-	colIdx := s.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := s.segment.colIdx(attr)
+	if err != nil {
+		return 0, err
 	}
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().Count(colIdx, filter)
@@ -79,9 +77,9 @@ func (s *SegmentSummarizer) Count(attr string, filter *roaring.Bitmap) (uint64,
 }
 
 func (s *SegmentSummarizer) NullCount(attr string, filter *roaring.Bitmap) (uint64, error) {
-	colIdx := s.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := s.segment.colIdx(attr)
+	if err != nil {
+		return 0, err
 	}
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().NullCount(colIdx, 0, filter)
@@ -123,9 +121,9 @@ func (s *SegmentSummarizer) NullCount(attr string, filter *roaring.Bitmap) (uint
 }
 
 func (s *SegmentSummarizer) Max(attr string, filter *roaring.Bitmap) (interface{}, error) {
-	colIdx := s.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := s.segment.colIdx(attr)
+	if err != nil {
+		return 0, err
 	}
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().Max(colIdx, filter)
@@ -159,9 +157,9 @@ func (s *SegmentSummarizer) Max(attr string, filter *roaring.Bitmap) (interface{
 }
 
 func (s *SegmentSummarizer) Min(attr string, filter *roaring.Bitmap) (interface{}, error) {
-	colIdx := s.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return 0, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := s.segment.colIdx(attr)
+	if err != nil {
+		return 0, err
 	}
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().Min(colIdx, filter)
@@ -195,9 +193,9 @@ func (s *SegmentSummarizer) Min(attr string, filter *roaring.Bitmap) (interface{
 }
 
 func (s *SegmentSummarizer) Sum(attr string, filter *roaring.Bitmap) (int64, uint64, error) {
-	colIdx := s.segment.Data.GetMeta().Table.Schema.GetColIdx(attr)
-	if colIdx == -1 {
-		return 0, 0, errors.New(fmt.Sprintf("column %s not found", attr))
+	colIdx, err := s.segment.colIdx(attr)
+	if err != nil {
+		return 0, 0, err
 	}
 	if s.segment.Data.GetType() == base.SORTED_SEG {
 		return s.segment.Data.GetIndexHolder().Sum(colIdx, filter)
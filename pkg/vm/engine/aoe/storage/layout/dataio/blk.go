@@ -51,6 +51,7 @@ type BlockFile struct {
 	PrevIdx     *metadata.LogIndex
 	Range       *metadata.LogRange
 	Count       uint64
+	Cols        int
 }
 
 func blockFileNameFactory(dir string, id common.ID) string {
@@ -104,6 +105,8 @@ func (bf *BlockFile) close() {
 func (bf *BlockFile) Destory() {
 	name := bf.Name()
 	logutil.Infof(" %s | BlockFile | Destorying", name)
+	RemoveRowOrder(name)
+	RemoveRowStamps(name)
 	err := os.Remove(name)
 	if err != nil {
 		panic(err)
@@ -168,6 +171,7 @@ func (bf *BlockFile) initPointers(id common.ID) {
 	if err = bf.Idx.UnMarshal(buf); err != nil {
 		panic(fmt.Sprintf("unexpect error: %s", err))
 	}
+	bf.Cols = int(cols)
 	headSize := 8 + int(sz+sz_) + 24 + 3 + 8 + 2*8*int(cols)
 	currOffset := headSize + int(offset)
 	for i := uint16(0); i < cols; i++ {
@@ -269,8 +273,13 @@ func (bf *BlockFile) PrefetchPart(colIdx uint64, id common.ID) error {
 func (bf *BlockFile) CopyTo(dir string) error {
 	name := filepath.Base(bf.Name())
 	dest := filepath.Join(dir, name)
-	_, err := CopyFile(bf.Name(), dest)
-	return err
+	if _, err := CopyFile(bf.Name(), dest); err != nil {
+		return err
+	}
+	if err := copyRowOrder(bf.Name(), dir); err != nil {
+		return err
+	}
+	return copyRowStamps(bf.Name(), dir)
 }
 
 // func (bf *BlockFile) Link(dir string, id common.ID) error {
@@ -287,5 +296,11 @@ func (bf *BlockFile) CopyTo(dir string) error {
 func (bf *BlockFile) LinkTo(dir string) error {
 	name := filepath.Base(bf.Name())
 	dest := filepath.Join(dir, name)
-	return os.Link(bf.Name(), dest)
+	if err := os.Link(bf.Name(), dest); err != nil {
+		return err
+	}
+	if err := linkRowOrder(bf.Name(), dir); err != nil {
+		return err
+	}
+	return linkRowStamps(bf.Name(), dir)
 }
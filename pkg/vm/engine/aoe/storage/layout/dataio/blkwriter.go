@@ -67,6 +67,10 @@ type BlockWriter struct {
 	// preprocessor preprocess data before writing, such as SORT
 	preprocessor func([]*gvector.Vector, *metadata.Block) error
 
+	// sortedIdx is the sorted index of data if sorted by preprocessor,
+	// it is committed as the order file of the block file
+	sortedIdx []uint32
+
 	// indexSerializer flush indices that pre-defined in meta
 	indexSerializer vecsIndexSerializer
 
@@ -154,13 +158,31 @@ func (bw *BlockWriter) createIOWriter(dir string, meta *metadata.Block) (*os.Fil
 }
 
 func (bw *BlockWriter) defaultPreprocessor(data []*gvector.Vector, meta *metadata.Block) error {
-	err := mergesort.SortBlockColumns(data,meta.Segment.Table.Schema.PrimaryKey)
+	sortedIdx, err := mergesort.SortBlockColumnsWithIndex(data,meta.Segment.Table.Schema.PrimaryKey)
+	bw.sortedIdx = sortedIdx
 	return err
 }
 
+// commitRowOrder commits the order file of the block file name before
+// the block file itself, so that a committed block file always has one.
+func (bw *BlockWriter) commitRowOrder(fname string) error {
+	if bw.sortedIdx == nil {
+		return nil
+	}
+	name, err := common.FilenameFromTmpfile(fname)
+	if err != nil {
+		return err
+	}
+	origins := make([]uint64, len(bw.sortedIdx))
+	for i, idx := range bw.sortedIdx {
+		origins[i] = metadata.MakeRowOrigin(bw.meta.Idx, uint64(idx))
+	}
+	return WriteRowOrder(name, origins)
+}
+
 func (bw *BlockWriter) flushIndices(w *os.File, data []*gvector.Vector, meta *metadata.Block) error {
 	var indices []index.Index
-	for idx, colDef := range meta.Segment.Table.Schema.ColDefs {
+	for idx, colDef := range meta.Segment.Table.Schema.ColDefs[:len(data)] {
 		typ := colDef.Type
 		isPrimary := idx == meta.Segment.Table.Schema.PrimaryKey
 		zmi, err := index.BuildBlockZoneMapIndex(data[idx], typ, int16(idx), isPrimary)
@@ -207,7 +229,7 @@ func (bw *BlockWriter) executeIVecs() error {
 	if bw.preExecutor != nil {
 		bw.preExecutor()
 	}
-	data := make([]vector.IVectorNode, len(bw.meta.Segment.Table.Schema.ColDefs))
+	data := make([]vector.IVectorNode, len(bw.idata.GetAttrs()))
 	for i := 0; i < len(data); i++ {
 		ivec, err := bw.idata.GetVectorByAttr(i)
 		if err != nil {
@@ -236,7 +258,8 @@ func (bw *BlockWriter) executeIVecs() error {
 // 2. Create a temp block file.
 // 3. Flush indices.
 // 4. Compress column data and flush them.
-// 5. Rename .tmp file to .blk file.
+// 5. Commit the order file of the block file.
+// 6. Rename .tmp file to .blk file.
 func (bw *BlockWriter) executeVecs() error {
 	if bw.preprocessor != nil {
 		if err := bw.preprocessor(bw.data, bw.meta); err != nil {
@@ -268,6 +291,9 @@ func (bw *BlockWriter) executeVecs() error {
 	closeFunc()
 	stat, _ := os.Stat(filename)
 	bw.size = stat.Size()
+	if err = bw.commitRowOrder(filename); err != nil {
+		return err
+	}
 	return bw.fileCommiter(filename)
 }
 
@@ -280,7 +306,7 @@ func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block
 	if err = binary.Write(&buf, binary.BigEndian, uint8(algo)); err != nil {
 		return err
 	}
-	colCnt := len(meta.Segment.Table.Schema.ColDefs)
+	colCnt := len(data)
 	if err = binary.Write(&buf, binary.BigEndian, uint16(colCnt)); err != nil {
 		return err
 	}
@@ -349,7 +375,7 @@ func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block
 	}
 	// flush indices
 	var indices []index.Index
-	for idx, colDef := range meta.Segment.Table.Schema.ColDefs {
+	for idx, colDef := range meta.Segment.Table.Schema.ColDefs[:len(data)] {
 		typ := colDef.Type
 		isPrimary := idx == meta.Segment.Table.Schema.PrimaryKey
 		zmi, err := index.BuildBlockZoneMapIndex(data[idx], typ, int16(idx), isPrimary)
@@ -375,7 +401,7 @@ func noCompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block)
 	if err = binary.Write(&buf, binary.BigEndian, uint8(algo)); err != nil {
 		return err
 	}
-	colCnt := len(meta.Segment.Table.Schema.ColDefs)
+	colCnt := len(data)
 	if err = binary.Write(&buf, binary.BigEndian, uint16(colCnt)); err != nil {
 		return err
 	}
@@ -419,7 +445,7 @@ func lz4CompressionIVecs(w *os.File, data []vector.IVectorNode, meta *metadata.B
 	if err = binary.Write(&buf, binary.BigEndian, uint8(algo)); err != nil {
 		return err
 	}
-	colCnt := len(meta.Segment.Table.Schema.ColDefs)
+	colCnt := len(data)
 	if err = binary.Write(&buf, binary.BigEndian, uint16(colCnt)); err != nil {
 		return err
 	}
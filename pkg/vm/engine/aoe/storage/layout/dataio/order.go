@@ -0,0 +1,124 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataio
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+)
+
+// The rows of a block file and of a segment file are sorted on the
+// primary key, so each of them is written along with an order file
+// keeping the origins of its rows, see metadata.MakeRowOrigin. The
+// order file is committed before its data file and removed with it.
+
+// MakeRowOrderFileName returns the name of the order file of the data
+// file name.
+func MakeRowOrderFileName(name string) string {
+	return name + common.OrdSuffix
+}
+
+// WriteRowOrder commits the origins of the rows of the data file name.
+func WriteRowOrder(name string, origins []uint64) error {
+	buf := make([]byte, 8*len(origins))
+	for i, origin := range origins {
+		binary.BigEndian.PutUint64(buf[8*i:], origin)
+	}
+	fname := MakeRowOrderFileName(name) + common.TmpSuffix
+	w, err := os.Create(fname)
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(buf); err != nil {
+		w.Close()
+		return err
+	}
+	if err = w.Sync(); err != nil {
+		w.Close()
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return os.Rename(fname, MakeRowOrderFileName(name))
+}
+
+// ReadRowOrder returns the origins of the rows of the data file name.
+func ReadRowOrder(name string) ([]uint64, error) {
+	buf, err := ioutil.ReadFile(MakeRowOrderFileName(name))
+	if err != nil {
+		return nil, err
+	}
+	return decodeRowOrder(buf), nil
+}
+
+func decodeRowOrder(buf []byte) []uint64 {
+	origins := make([]uint64, len(buf)/8)
+	for i := range origins {
+		origins[i] = binary.BigEndian.Uint64(buf[8*i:])
+	}
+	return origins
+}
+
+// ReadRowOrderAt returns the origins of the n rows of the data file name
+// starting at row start.
+func ReadRowOrderAt(name string, start, n uint64) ([]uint64, error) {
+	f, err := os.Open(MakeRowOrderFileName(name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	buf := make([]byte, 8*n)
+	if _, err = f.ReadAt(buf, int64(8*start)); err != nil {
+		return nil, err
+	}
+	return decodeRowOrder(buf), nil
+}
+
+// RemoveRowOrder removes the order file of the data file name if any.
+func RemoveRowOrder(name string) {
+	os.Remove(MakeRowOrderFileName(name))
+}
+
+func copyRowOrder(name, dir string) error {
+	return copySidecar(MakeRowOrderFileName(name), dir)
+}
+
+func linkRowOrder(name, dir string) error {
+	return linkSidecar(MakeRowOrderFileName(name), dir)
+}
+
+// copySidecar copies the file src written along with a data file into
+// dir if it exists.
+func copySidecar(src, dir string) error {
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return nil
+	}
+	_, err := CopyFile(src, filepath.Join(dir, filepath.Base(src)))
+	return err
+}
+
+// linkSidecar links the file src written along with a data file into
+// dir if it exists.
+func linkSidecar(src, dir string) error {
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return nil
+	}
+	return os.Link(src, filepath.Join(dir, filepath.Base(src)))
+}
@@ -78,7 +78,7 @@ type SegmentWriter struct {
 	// flusher is completed
 	fileCommiter func(string) (string, error)
 	//indexFlusher func(*os.File, []*batch.Batch, *metadata.Segment) error
-	flusher      func(*os.File, iface.BlockIterator, *metadata.Segment) error
+	flusher      func(*os.File, iface.BlockIterator, *metadata.Segment) ([]uint16, error)
 	preExecutor  func()
 	postExecutor func()
 }
@@ -119,7 +119,7 @@ func (sw *SegmentWriter) GetDestoryer() FileDestoryer {
 //	sw.indexFlusher = f
 //}
 
-func (sw *SegmentWriter) SetFlusher(f func(*os.File, iface.BlockIterator, *metadata.Segment) error) {
+func (sw *SegmentWriter) SetFlusher(f func(*os.File, iface.BlockIterator, *metadata.Segment) ([]uint16, error)) {
 	sw.flusher = f
 }
 
@@ -156,7 +156,7 @@ func (sw *SegmentWriter) flushIndices(w *os.File, data []*batch.Batch, meta *met
 	var indices []index.Index
 
 	// ZoneMapIndex
-	for idx, colDef := range meta.Table.Schema.ColDefs {
+	for idx, colDef := range meta.Schema().ColDefs {
 		columns := make([]*vector.Vector, 0)
 		typ := colDef.Type
 		isPrimary := idx == meta.Table.Schema.PrimaryKey
@@ -184,7 +184,8 @@ func (sw *SegmentWriter) flushIndices(w *os.File, data []*batch.Batch, meta *met
 // 1. Create a temp segment file.
 // 3. Flush indices.
 // 4. Compress column data and flush them.
-// 5. Rename .tmp file to .seg file.
+// 5. Commit the order file of the segment file.
+// 6. Rename .tmp file to .seg file.
 func (sw *SegmentWriter) Execute() error {
 	w, err := sw.fileGetter(sw.dir, sw.meta)
 	if err != nil {
@@ -194,7 +195,8 @@ func (sw *SegmentWriter) Execute() error {
 	if sw.preExecutor != nil {
 		sw.preExecutor()
 	}
-	if err = sw.flusher(w, sw.data, sw.meta); err != nil {
+	sortedIdx, err := sw.flusher(w, sw.data, sw.meta)
+	if err != nil {
 		w.Close()
 		return err
 	}
@@ -210,80 +212,116 @@ func (sw *SegmentWriter) Execute() error {
 	w.Close()
 	stat, _ := os.Stat(filename)
 	sw.size = stat.Size()
+	if err = sw.commitRowOrder(filename, sortedIdx); err != nil {
+		return err
+	}
 	name, err := sw.fileCommiter(filename)
 	sw.destoryer = func(reason string) error {
 		logutil.Infof("SegmentFile | \"%s\" | Removed | Reason: \"%s\"", name, reason)
+		RemoveRowOrder(name)
 		return os.Remove(name)
 	}
 	return err
 }
 
+// commitRowOrder commits the order file of the segment file fname from
+// the order files of the merged block files, sortedIdx[i] is the block
+// the i-th row of the segment file was merged from.
+func (sw *SegmentWriter) commitRowOrder(fname string, sortedIdx []uint16) error {
+	name, err := common.FilenameFromTmpfile(fname)
+	if err != nil {
+		return err
+	}
+	blkOrigins := make([][]uint64, len(sw.meta.BlockSet))
+	for i, blk := range sw.meta.BlockSet {
+		id := blk.AsCommonID()
+		blkName := common.MakeBlockFileName(sw.dir, id.ToBlockFileName(), id.TableID, false)
+		if blkOrigins[i], err = ReadRowOrder(blkName); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if blkOrigins[i] == nil {
+			// The block file was written without an order file
+			blkOrigins[i] = make([]uint64, blk.Count)
+			for j := range blkOrigins[i] {
+				blkOrigins[i][j] = metadata.MakeRowOrigin(blk.Idx, uint64(j))
+			}
+		}
+	}
+	cursors := make([]int, len(blkOrigins))
+	origins := make([]uint64, len(sortedIdx))
+	for i, src := range sortedIdx {
+		origins[i] = blkOrigins[src][cursors[src]]
+		cursors[src]++
+	}
+	return WriteRowOrder(name, origins)
+}
+
 func (sw *SegmentWriter) GetSize() int64 {
 	return sw.size
 }
 
 // flush metadata, columns data, indices, and other related infos
 // for the segment.
-func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
+func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) ([]uint16, error) {
 	var metaBuf bytes.Buffer
 	blkCnt := iter.BlockCount()
 	header := make([]byte, 32)
 	copy(header, encoding.EncodeUint64(Version))
 	err := binary.Write(&metaBuf, binary.BigEndian, header)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	reserved := make([]byte, 64)
 	err = binary.Write(&metaBuf, binary.BigEndian, reserved)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = binary.Write(&metaBuf, binary.BigEndian, uint8(compress.Lz4))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	err = binary.Write(&metaBuf, binary.BigEndian, blkCnt)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	colDefs := meta.Table.Schema.ColDefs
+	colDefs := meta.Schema().ColDefs
 	colCnt := len(colDefs)
 	if err = binary.Write(&metaBuf, binary.BigEndian, uint32(colCnt)); err != nil {
-		return err
+		return nil, err
 	}
 	for _, blk := range meta.BlockSet {
 		if err = binary.Write(&metaBuf, binary.BigEndian, blk.Count); err != nil {
-			return err
+			return nil, err
 		}
 
 		rangeBuf, _ := meta.CommitInfo.LogRange.Marshal()
 		if err = binary.Write(&metaBuf, binary.BigEndian, rangeBuf); err != nil {
-			return err
+			return nil, err
 		}
 
 		var preIdx []byte
 		if blk.CommitInfo.PrevIndex != nil {
 			preIdx, err = blk.CommitInfo.PrevIndex.Marshal()
 			if err != nil {
-				return err
+				return nil, err
 			}
 		} else {
 			preIdx = make([]byte, blkIdxSize)
 		}
 		if err = binary.Write(&metaBuf, binary.BigEndian, preIdx); err != nil {
-			return err
+			return nil, err
 		}
 		var idx []byte
 		if blk.CommitInfo.LogIndex != nil {
 			idx, err = blk.CommitInfo.LogIndex.Marshal()
 			if err != nil {
-				return err
+				return nil, err
 			}
 		} else {
 			idx = make([]byte, blkIdxSize)
 		}
 		if err = binary.Write(&metaBuf, binary.BigEndian, idx); err != nil {
-			return err
+			return nil, err
 		}
 	}
 	metaSize := headerSize +
@@ -298,7 +336,7 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 		colCnt*colPosSize
 
 	if _, err = w.Seek(int64(metaSize), io.SeekStart); err != nil {
-		return err
+		return nil, err
 	}
 
 	colSizes := make([]int, colCnt)
@@ -307,7 +345,7 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 	var outputBuffer bytes.Buffer
 	var indices []index.Index
 	typs := make([]types.Type, 0)
-	for _, def := range meta.Table.Schema.ColDefs {
+	for _, def := range colDefs {
 		typs = append(typs, def.Type)
 	}
 
@@ -315,10 +353,10 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 	iter.Reset(uint16(pkIdx))
 	pkColumn, err := iter.FetchColumn()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if err = preprocessColumn(pkColumn, &sortedIdx, true); err != nil {
-		return err
+		return nil, err
 	}
 	// could safely release vectors' mem nodes here
 	iter.Reset(0)
@@ -328,17 +366,17 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 			// build zone map
 			zmi, err := index.BuildSegmentZoneMapIndex(pkColumn, typs[i], int16(i), true)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			indices = append(indices, zmi)
 
 			colSz, err := processColumn(pkColumn, &metaBuf, &outputBuffer)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			colSizes[i] = colSz
 			if _, err := w.Write(outputBuffer.Bytes()); err != nil {
-				return err
+				return nil, err
 			}
 			iter.Reset(uint16(i + 1))
 			outputBuffer.Reset()
@@ -347,23 +385,23 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 		}
 		column, err := iter.FetchColumn()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err = preprocessColumn(column, &sortedIdx, false); err != nil {
-			return err
+			return nil, err
 		}
 		zmi, err := index.BuildSegmentZoneMapIndex(column, typs[i], int16(i), false)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		indices = append(indices, zmi)
 		colSz, err := processColumn(column, &metaBuf, &outputBuffer)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		colSizes[i] = colSz
 		if _, err := w.Write(outputBuffer.Bytes()); err != nil {
-			return err
+			return nil, err
 		}
 		iter.Reset(uint16(i + 1))
 		outputBuffer.Reset()
@@ -372,15 +410,15 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 	// flush embedded indices
 	buf, err := index.DefaultRWHelper.WriteIndices(indices)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if _, err = w.Write(buf); err != nil {
-		return err
+		return nil, err
 	}
 
 	// back to start, flush metadata
 	if _, err = w.Seek(0, io.SeekStart); err != nil {
-		return err
+		return nil, err
 	}
 	startPos := int64(metaSize)
 	curPos := startPos
@@ -391,19 +429,19 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 	}
 	endPos := curPos
 	if err = binary.Write(&metaBuf, binary.BigEndian, startPos); err != nil {
-		return err
+		return nil, err
 	}
 	if err = binary.Write(&metaBuf, binary.BigEndian, endPos); err != nil {
-		return err
+		return nil, err
 	}
 	for _, colPos := range colPoses {
 		if err = binary.Write(&metaBuf, binary.BigEndian, colPos); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	if _, err = w.Write(metaBuf.Bytes()); err != nil {
-		return err
+		return nil, err
 	}
 
 	//if _, err = w.Write(dataBuf.Bytes()); err != nil {
@@ -411,7 +449,7 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 	//
 	//}
 
-	return nil
+	return sortedIdx, nil
 }
 
 func preprocessColumn(column []*vector.Vector, sortedIdx *[]uint16, isPrimary bool) error {
@@ -302,6 +302,7 @@ func (sf *SortedSegmentFile) GetBlockIndicesMeta(id common.ID) *base.IndicesMeta
 func (sf *SortedSegmentFile) Destory() {
 	name := sf.Name()
 	logutil.Infof(" %s | SegmentFile | Destorying", name)
+	RemoveRowOrder(name)
 	err := os.Remove(name)
 	if err != nil {
 		panic(err)
@@ -379,12 +380,17 @@ func (sf *SortedSegmentFile) PrefetchPart(colIdx uint64, id common.ID) error {
 func (sf *SortedSegmentFile) CopyTo(dir string) error {
 	name := filepath.Base(sf.Name())
 	dest := filepath.Join(dir, name)
-	_, err := CopyFile(sf.Name(), dest)
-	return err
+	if _, err := CopyFile(sf.Name(), dest); err != nil {
+		return err
+	}
+	return copyRowOrder(sf.Name(), dir)
 }
 
 func (sf *SortedSegmentFile) LinkTo(dir string) error {
 	name := filepath.Base(sf.Name())
 	dest := filepath.Join(dir, name)
-	return os.Link(sf.Name(), dest)
+	if err := os.Link(sf.Name(), dest); err != nil {
+		return err
+	}
+	return linkRowOrder(sf.Name(), dir)
 }
@@ -0,0 +1,103 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataio
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+)
+
+// The append stamps of the rows of a transient block are only logged
+// with its metadata once it is upgraded, so the transient block files
+// of the tables retaining their history are written along with a stamp
+// file keeping the stamps of their rows. The stamp file is committed
+// before its data file and removed with it.
+
+// MakeRowStampsFileName returns the name of the stamp file of the data
+// file name.
+func MakeRowStampsFileName(name string) string {
+	return name + common.StpSuffix
+}
+
+// WriteRowStamps commits the stamps of the first rows rows of the data
+// file name, it does nothing if there is none.
+func WriteRowStamps(name string, appends []*metadata.RowsAppend, rows uint64) error {
+	n := 0
+	for n < len(appends) && appends[n].Offset < rows {
+		n++
+	}
+	if n == 0 {
+		return nil
+	}
+	buf := make([]byte, 16*n)
+	for i, curr := range appends[:n] {
+		binary.BigEndian.PutUint64(buf[16*i:], curr.Offset)
+		binary.BigEndian.PutUint64(buf[16*i+8:], uint64(curr.Ts))
+	}
+	fname := MakeRowStampsFileName(name) + common.TmpSuffix
+	w, err := os.Create(fname)
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(buf); err != nil {
+		w.Close()
+		return err
+	}
+	if err = w.Sync(); err != nil {
+		w.Close()
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return os.Rename(fname, MakeRowStampsFileName(name))
+}
+
+// ReadRowStamps returns the stamps of the rows of the data file name,
+// nil if it was written without a stamp file.
+func ReadRowStamps(name string) ([]*metadata.RowsAppend, error) {
+	buf, err := ioutil.ReadFile(MakeRowStampsFileName(name))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	appends := make([]*metadata.RowsAppend, len(buf)/16)
+	for i := range appends {
+		appends[i] = &metadata.RowsAppend{
+			Offset: binary.BigEndian.Uint64(buf[16*i:]),
+			Ts:     int64(binary.BigEndian.Uint64(buf[16*i+8:])),
+		}
+	}
+	return appends, nil
+}
+
+// RemoveRowStamps removes the stamp file of the data file name if any.
+func RemoveRowStamps(name string) {
+	os.Remove(MakeRowStampsFileName(name))
+}
+
+func copyRowStamps(name, dir string) error {
+	return copySidecar(MakeRowStampsFileName(name), dir)
+}
+
+func linkRowStamps(name, dir string) error {
+	return linkSidecar(MakeRowStampsFileName(name), dir)
+}
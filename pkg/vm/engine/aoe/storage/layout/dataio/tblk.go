@@ -183,7 +183,7 @@ func (f *TransientBlockFile) PreSync(pos uint32) bool {
 	return ret
 }
 
-func (f *TransientBlockFile) InitMeta(meta *metadata.Block) {
+func (f *TransientBlockFile) InitMeta(meta *metadata.Block) error {
 	if len(f.files) > 0 {
 		meta.Lock()
 		defer meta.Unlock()
@@ -191,18 +191,25 @@ func (f *TransientBlockFile) InitMeta(meta *metadata.Block) {
 		idx := ParseLogIndex(f.files[0].tag)
 		idx.ShardId = meta.Segment.Table.Database.GetShardId()
 		meta.CommitInfo.LogIndex = idx
+		appends, err := ReadRowStamps(f.files[0].Name())
+		if err != nil {
+			return err
+		}
+		meta.Appends = appends
 	}
+	return nil
 }
 
 func (f *TransientBlockFile) LoadBatch(meta *metadata.Block) batch.IBatch {
+	schema := meta.Schema()
 	f.mu.RLock()
 	if len(f.files) == 0 {
 		f.mu.RUnlock()
-		colcnt := len(meta.Segment.Table.Schema.ColDefs)
+		colcnt := len(schema.ColDefs)
 		attrs := make([]int, colcnt)
 		vecs := make([]vector.IVector, colcnt)
-		for i, colDef := range meta.Segment.Table.Schema.ColDefs {
-			vec := vector.NewVector(colDef.Type, meta.Segment.Table.Schema.BlockMaxRows)
+		for i, colDef := range schema.ColDefs {
+			vec := vector.NewVector(colDef.Type, schema.BlockMaxRows)
 			vecs[i] = vec
 			attrs[i] = i
 		}
@@ -218,11 +225,29 @@ func (f *TransientBlockFile) LoadBatch(meta *metadata.Block) batch.IBatch {
 	f.mu.RUnlock()
 	defer file.Unref()
 	id := *meta.AsCommonID()
-	colcnt := len(meta.Segment.Table.Schema.ColDefs)
+	if file.Cols > len(schema.ColDefs) {
+		// the block was extended by an alter of the table before it
+		// was flushed
+		schema = meta.Segment.Table.Schema
+		meta.ExtendSchema(schema)
+	}
+	colcnt := len(schema.ColDefs)
 	vecs := make([]vector.IVector, colcnt)
 	cols := make([]int, colcnt)
-	for i, colDef := range meta.Segment.Table.Schema.ColDefs {
+	for i, colDef := range schema.ColDefs {
 		cols[i] = i
+		if i >= file.Cols {
+			vec := vector.NewVector(colDef.Type, schema.BlockMaxRows)
+			def, err := colDef.DefaultVector(vecs[0].Length())
+			if err != nil {
+				panic(err)
+			}
+			if _, err = vec.AppendVector(def, 0); err != nil {
+				panic(err)
+			}
+			vecs[i] = vec
+			continue
+		}
 		sz := file.PartSize(uint64(i), id, false)
 		osz := file.PartSize(uint64(i), id, true)
 		node := common.GPool.Alloc(uint64(sz))
@@ -236,7 +261,7 @@ func (f *TransientBlockFile) LoadBatch(meta *metadata.Block) batch.IBatch {
 		}
 		switch colDef.Type.Oid {
 		case types.T_char, types.T_varchar, types.T_json:
-			vec := vector.NewStrVector(colDef.Type, meta.Segment.Table.Schema.BlockMaxRows)
+			vec := vector.NewStrVector(colDef.Type, schema.BlockMaxRows)
 			err = vec.Unmarshal(obuf)
 			if err != nil {
 				panic(err)
@@ -244,7 +269,7 @@ func (f *TransientBlockFile) LoadBatch(meta *metadata.Block) batch.IBatch {
 			vec.ResetReadonly()
 			vecs[i] = vec
 		default:
-			vec := vector.NewStdVector(colDef.Type, meta.Segment.Table.Schema.BlockMaxRows)
+			vec := vector.NewStdVector(colDef.Type, schema.BlockMaxRows)
 			err = vec.Unmarshal(obuf)
 			if err != nil {
 				panic(err)
@@ -269,6 +294,10 @@ func (f *TransientBlockFile) Sync(data batch.IBatch, meta *metadata.Block) error
 	writer := NewIBatchWriter(data, meta, meta.Segment.Table.Database.Catalog.Cfg.Dir)
 	tag := meta.CommitInfo.LogIndex.Repr()
 	getter := tblkFileGetter{count: uint64(data.Length()), tag: tag}
+	name := getter.NameFactory(f.host.GetDir(), f.id)
+	if err := WriteRowStamps(name, meta.GetAppends(), uint64(data.Length())); err != nil {
+		return err
+	}
 	writer.SetFileGetter(getter.Getter)
 	writer.SetPreExecutor(func() {
 		logutil.Infof(" %s | TransientBlock | Flushing", writer.GetFileName())
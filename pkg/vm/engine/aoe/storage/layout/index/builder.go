@@ -16,6 +16,7 @@ package index
 
 import (
 	"bytes"
+	"errors"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -211,6 +212,59 @@ func BuildBitSlicedIndex(data []*vector.Vector, t types.Type, colIdx int16, star
 			}
 		}
 		return bsiIdx, nil
+	case types.T_time:
+		bsiIdx := NewNumericBsiIndex(t, 64, colIdx)
+		row := startPos
+		for _, part := range data {
+			column := part.Col.([]types.Time)
+			for _, val := range column {
+				if nulls.Contains(part.Nsp, uint64(row-startPos)) {
+					row++
+					continue
+				}
+				if err := bsiIdx.Set(uint64(row), int64(val)); err != nil {
+					return nil, err
+				}
+				row++
+			}
+		}
+		return bsiIdx, nil
+	case types.T_timestamp:
+		bsiIdx := NewNumericBsiIndex(t, 64, colIdx)
+		row := startPos
+		for _, part := range data {
+			column := part.Col.([]types.Timestamp)
+			for _, val := range column {
+				if nulls.Contains(part.Nsp, uint64(row-startPos)) {
+					row++
+					continue
+				}
+				if err := bsiIdx.Set(uint64(row), int64(val)); err != nil {
+					return nil, err
+				}
+				row++
+			}
+		}
+		return bsiIdx, nil
+	case types.T_decimal64:
+		bsiIdx := NewNumericBsiIndex(t, 64, colIdx)
+		row := startPos
+		for _, part := range data {
+			column := part.Col.([]types.Decimal64)
+			for _, val := range column {
+				if nulls.Contains(part.Nsp, uint64(row-startPos)) {
+					row++
+					continue
+				}
+				if err := bsiIdx.Set(uint64(row), int64(val)); err != nil {
+					return nil, err
+				}
+				row++
+			}
+		}
+		return bsiIdx, nil
+	case types.T_decimal128:
+		return nil, errors.New("bit sliced index is not supported by decimal128")
 	case types.T_date:
 		bsiIdx := NewNumericBsiIndex(t, 32, colIdx)
 		row := startPos
@@ -723,6 +777,100 @@ func BuildSegmentZoneMapIndex(data []*vector.Vector, t types.Type, colIdx int16,
 		}
 		zmi := NewSegmentZoneMap(t, globalMin, globalMax, colIdx, partMins, partMaxs)
 		return zmi, nil
+	case types.T_decimal64:
+		var globalMin, globalMax types.Decimal64
+		var partMins, partMaxs []interface{}
+		if isSorted {
+			for i, vec := range data {
+				part := vec.Col.([]types.Decimal64)
+				partMins = append(partMins, part[0])
+				partMaxs = append(partMaxs, part[len(part) - 1])
+				if i == 0 {
+					globalMin = part[0]
+				}
+				if i == len(data) - 1 {
+					globalMax = part[len(part) - 1]
+				}
+			}
+		} else {
+			var minv, maxv types.Decimal64
+			for i, vec := range data {
+				part := vec.Col.([]types.Decimal64)
+				if i == 0 {
+					globalMin = part[i]
+					globalMax = part[i]
+				}
+				for j, v := range part {
+					if j == 0 {
+						minv = v
+						maxv = v
+					}
+					if v > globalMax {
+						globalMax = v
+					}
+					if v < globalMin {
+						globalMin = v
+					}
+					if v > maxv {
+						maxv = v
+					}
+					if v < minv {
+						minv = v
+					}
+				}
+				partMaxs = append(partMaxs, maxv)
+				partMins = append(partMins, minv)
+			}
+		}
+		zmi := NewSegmentZoneMap(t, globalMin, globalMax, colIdx, partMins, partMaxs)
+		return zmi, nil
+	case types.T_decimal128:
+		var globalMin, globalMax types.Decimal128
+		var partMins, partMaxs []interface{}
+		if isSorted {
+			for i, vec := range data {
+				part := vec.Col.([]types.Decimal128)
+				partMins = append(partMins, part[0])
+				partMaxs = append(partMaxs, part[len(part) - 1])
+				if i == 0 {
+					globalMin = part[0]
+				}
+				if i == len(data) - 1 {
+					globalMax = part[len(part) - 1]
+				}
+			}
+		} else {
+			var minv, maxv types.Decimal128
+			for i, vec := range data {
+				part := vec.Col.([]types.Decimal128)
+				if i == 0 {
+					globalMin = part[i]
+					globalMax = part[i]
+				}
+				for j, v := range part {
+					if j == 0 {
+						minv = v
+						maxv = v
+					}
+					if v.Compare(globalMax) > 0 {
+						globalMax = v
+					}
+					if v.Compare(globalMin) < 0 {
+						globalMin = v
+					}
+					if v.Compare(maxv) > 0 {
+						maxv = v
+					}
+					if v.Compare(minv) < 0 {
+						minv = v
+					}
+				}
+				partMaxs = append(partMaxs, maxv)
+				partMins = append(partMins, minv)
+			}
+		}
+		zmi := NewSegmentZoneMap(t, globalMin, globalMax, colIdx, partMins, partMaxs)
+		return zmi, nil
 	case types.T_datetime:
 		var globalMin, globalMax types.Datetime
 		var partMins, partMaxs []interface{}
@@ -770,6 +918,100 @@ func BuildSegmentZoneMapIndex(data []*vector.Vector, t types.Type, colIdx int16,
 		}
 		zmi := NewSegmentZoneMap(t, globalMin, globalMax, colIdx, partMins, partMaxs)
 		return zmi, nil
+	case types.T_time:
+		var globalMin, globalMax types.Time
+		var partMins, partMaxs []interface{}
+		if isSorted {
+			for i, vec := range data {
+				part := vec.Col.([]types.Time)
+				partMins = append(partMins, part[0])
+				partMaxs = append(partMaxs, part[len(part) - 1])
+				if i == 0 {
+					globalMin = part[0]
+				}
+				if i == len(data) - 1 {
+					globalMax = part[len(part) - 1]
+				}
+			}
+		} else {
+			var minv, maxv types.Time
+			for i, vec := range data {
+				part := vec.Col.([]types.Time)
+				if i == 0 {
+					globalMin = part[i]
+					globalMax = part[i]
+				}
+				for j, v := range part {
+					if j == 0 {
+						minv = v
+						maxv = v
+					}
+					if v > globalMax {
+						globalMax = v
+					}
+					if v < globalMin {
+						globalMin = v
+					}
+					if v > maxv {
+						maxv = v
+					}
+					if v < minv {
+						minv = v
+					}
+				}
+				partMaxs = append(partMaxs, maxv)
+				partMins = append(partMins, minv)
+			}
+		}
+		zmi := NewSegmentZoneMap(t, globalMin, globalMax, colIdx, partMins, partMaxs)
+		return zmi, nil
+	case types.T_timestamp:
+		var globalMin, globalMax types.Timestamp
+		var partMins, partMaxs []interface{}
+		if isSorted {
+			for i, vec := range data {
+				part := vec.Col.([]types.Timestamp)
+				partMins = append(partMins, part[0])
+				partMaxs = append(partMaxs, part[len(part) - 1])
+				if i == 0 {
+					globalMin = part[0]
+				}
+				if i == len(data) - 1 {
+					globalMax = part[len(part) - 1]
+				}
+			}
+		} else {
+			var minv, maxv types.Timestamp
+			for i, vec := range data {
+				part := vec.Col.([]types.Timestamp)
+				if i == 0 {
+					globalMin = part[i]
+					globalMax = part[i]
+				}
+				for j, v := range part {
+					if j == 0 {
+						minv = v
+						maxv = v
+					}
+					if v > globalMax {
+						globalMax = v
+					}
+					if v < globalMin {
+						globalMin = v
+					}
+					if v > maxv {
+						maxv = v
+					}
+					if v < minv {
+						minv = v
+					}
+				}
+				partMaxs = append(partMaxs, maxv)
+				partMins = append(partMins, minv)
+			}
+		}
+		zmi := NewSegmentZoneMap(t, globalMin, globalMax, colIdx, partMins, partMaxs)
+		return zmi, nil
 	case types.T_date:
 		var globalMin, globalMax types.Date
 		var partMins, partMaxs []interface{}
@@ -1102,6 +1344,52 @@ func BuildBlockZoneMapIndex(data *vector.Vector, t types.Type, colIdx int16, isS
 		}
 		zmi := NewBlockZoneMap(t, min, max, colIdx)
 		return zmi, nil
+	case types.T_decimal64:
+		vec := data.Col.([]types.Decimal64)
+		var min, max types.Decimal64
+		if isSorted {
+			min = vec[0]
+			max = vec[len(vec)-1]
+		} else {
+			min = vec[0]
+			max = min
+			for i, e := range vec {
+				if nulls.Contains(data.Nsp, uint64(i)) {
+					continue
+				}
+				if e > max {
+					max = e
+				}
+				if e < min {
+					min = e
+				}
+			}
+		}
+		zmi := NewBlockZoneMap(t, min, max, colIdx)
+		return zmi, nil
+	case types.T_decimal128:
+		vec := data.Col.([]types.Decimal128)
+		var min, max types.Decimal128
+		if isSorted {
+			min = vec[0]
+			max = vec[len(vec)-1]
+		} else {
+			min = vec[0]
+			max = min
+			for i, e := range vec {
+				if nulls.Contains(data.Nsp, uint64(i)) {
+					continue
+				}
+				if e.Compare(max) > 0 {
+					max = e
+				}
+				if e.Compare(min) < 0 {
+					min = e
+				}
+			}
+		}
+		zmi := NewBlockZoneMap(t, min, max, colIdx)
+		return zmi, nil
 	case types.T_date:
 		vec := data.Col.([]types.Date)
 		var min, max types.Date
@@ -1148,6 +1436,52 @@ func BuildBlockZoneMapIndex(data *vector.Vector, t types.Type, colIdx int16, isS
 		}
 		zmi := NewBlockZoneMap(t, min, max, colIdx)
 		return zmi, nil
+	case types.T_time:
+		vec := data.Col.([]types.Time)
+		var min, max types.Time
+		if isSorted {
+			min = vec[0]
+			max = vec[len(vec)-1]
+		} else {
+			min = vec[0]
+			max = min
+			for i, e := range vec {
+				if nulls.Contains(data.Nsp, uint64(i)) {
+					continue
+				}
+				if e > max {
+					max = e
+				}
+				if e < min {
+					min = e
+				}
+			}
+		}
+		zmi := NewBlockZoneMap(t, min, max, colIdx)
+		return zmi, nil
+	case types.T_timestamp:
+		vec := data.Col.([]types.Timestamp)
+		var min, max types.Timestamp
+		if isSorted {
+			min = vec[0]
+			max = vec[len(vec)-1]
+		} else {
+			min = vec[0]
+			max = min
+			for i, e := range vec {
+				if nulls.Contains(data.Nsp, uint64(i)) {
+					continue
+				}
+				if e > max {
+					max = e
+				}
+				if e < min {
+					min = e
+				}
+			}
+		}
+		zmi := NewBlockZoneMap(t, min, max, colIdx)
+		return zmi, nil
 	case types.T_char, types.T_varchar, types.T_json:
 		vec := data.Col.(*types.Bytes)
 		var min, max []byte
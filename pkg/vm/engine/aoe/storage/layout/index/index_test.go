@@ -22,6 +22,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -262,6 +263,65 @@ func TestZM(t *testing.T) {
 	assert.False(t, ctx.BoolRes)
 }
 
+func TestValSet(t *testing.T) {
+	int32zm := NewBlockZoneMap(types.Type{Oid: types.T_int32, Size: 4}, int32(10), int32(100), int16(0))
+	ctx := NewFilterCtx(OpIn)
+	ctx.SetVals([]interface{}{int32(9), int32(101)})
+	ctx.Eval(int32zm)
+	assert.False(t, ctx.BoolRes)
+	ctx.SetVals([]interface{}{int32(50)})
+	ctx.Eval(int32zm)
+	assert.True(t, ctx.BoolRes)
+	ctx.Op = OpOut
+	ctx.Eval(int32zm)
+	assert.True(t, ctx.BoolRes)
+
+	// only a zone map of a single value is excluded by NOT IN
+	constzm := NewBlockZoneMap(types.Type{Oid: types.T_int32, Size: 4}, int32(7), int32(7), int16(0))
+	ctx.Reset()
+	ctx.Op = OpOut
+	ctx.SetVals([]interface{}{int32(8), int32(7)})
+	ctx.Eval(constzm)
+	assert.False(t, ctx.BoolRes)
+	ctx.Reset()
+	ctx.Op = OpOut
+	ctx.SetVals([]interface{}{int32(8)})
+	ctx.Eval(constzm)
+	assert.True(t, ctx.BoolRes)
+	segzm := NewSegmentZoneMap(types.Type{Oid: types.T_int32, Size: 4}, int32(7), int32(7), int16(0), nil, nil)
+	ctx.SetVals([]interface{}{int32(7)})
+	ctx.Eval(segzm)
+	assert.False(t, ctx.BoolRes)
+
+	strzm := NewBlockZoneMap(types.Type{Oid: types.T_varchar, Size: 24}, []byte("b"), []byte("d"), int16(0))
+	ctx.Reset()
+	ctx.Op = OpIn
+	ctx.SetVals([]interface{}{[]byte("a"), []byte("c")})
+	ctx.Eval(strzm)
+	assert.True(t, ctx.BoolRes)
+
+	bsiIdx := NewNumericBsiIndex(types.Type{Oid: types.T_int32, Size: 4}, 30, 0)
+	xs := []int32{10, 3, -7, 9, 0, 1, 9, -8, 2}
+	all := roaring.NewBitmap()
+	for i, x := range xs {
+		err := bsiIdx.Set(uint64(i), x)
+		assert.Nil(t, err)
+		all.Add(uint32(i))
+	}
+	ctx.Reset()
+	ctx.Op = OpIn
+	ctx.SetVals([]interface{}{int32(9), int32(-7), int32(5)})
+	ctx.BMRes = all.Clone()
+	err := ctx.Eval(bsiIdx)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{2, 3, 6}, ctx.BMRes.ToArray())
+	ctx.Op = OpOut
+	ctx.BMRes = all.Clone()
+	err = ctx.Eval(bsiIdx)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{0, 1, 4, 5, 7, 8}, ctx.BMRes.ToArray())
+}
+
 func TestRefs1(t *testing.T) {
 	capacity := uint64(1000)
 	bufMgr := bmgr.MockBufMgr(capacity)
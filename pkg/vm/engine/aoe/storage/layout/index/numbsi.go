@@ -51,7 +51,7 @@ func NewNumericBsiIndex(t types.Type, bitSize int, colIdx int16) *NumericBsiInde
 func getNumericBsi(t types.Type, bitSize int) *bsi.NumericBSI {
 	var bsiIdx bsi.BitSlicedIndex
 	switch t.Oid {
-	case types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_date, types.T_datetime:
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_date, types.T_datetime, types.T_time, types.T_timestamp, types.T_decimal64:
 		bsiIdx = bsi.NewNumericBSI(bitSize, bsi.SignedInt)
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 		bsiIdx = bsi.NewNumericBSI(bitSize, bsi.UnsignedInt)
@@ -79,6 +79,9 @@ func (i *NumericBsiIndex) Eval(ctx *FilterCtx) error {
 	if ctx.BMRes.IsEmpty() {
 		return nil
 	}
+	if len(ctx.ValSet) > 0 && (ctx.Op == OpIn || ctx.Op == OpOut) {
+		return evalValSet(i, ctx)
+	}
 	var err error
 	if v, ok := ctx.Val.(types.Date); ok {
 		ctx.Val = int32(v)
@@ -116,6 +119,60 @@ func (i *NumericBsiIndex) Eval(ctx *FilterCtx) error {
 			ctx.ValMax = v
 		}()
 	}
+	if v, ok := ctx.Val.(types.Time); ok {
+		ctx.Val = int64(v)
+		defer func() {
+			ctx.Val = v
+		}()
+	}
+	if v, ok := ctx.ValMin.(types.Time); ok {
+		ctx.ValMin = int64(v)
+		defer func() {
+			ctx.ValMin = v
+		}()
+	}
+	if v, ok := ctx.ValMax.(types.Time); ok {
+		ctx.ValMax = int64(v)
+		defer func() {
+			ctx.ValMax = v
+		}()
+	}
+	if v, ok := ctx.Val.(types.Timestamp); ok {
+		ctx.Val = int64(v)
+		defer func() {
+			ctx.Val = v
+		}()
+	}
+	if v, ok := ctx.ValMin.(types.Timestamp); ok {
+		ctx.ValMin = int64(v)
+		defer func() {
+			ctx.ValMin = v
+		}()
+	}
+	if v, ok := ctx.ValMax.(types.Timestamp); ok {
+		ctx.ValMax = int64(v)
+		defer func() {
+			ctx.ValMax = v
+		}()
+	}
+	if v, ok := ctx.Val.(types.Decimal64); ok {
+		ctx.Val = int64(v)
+		defer func() {
+			ctx.Val = v
+		}()
+	}
+	if v, ok := ctx.ValMin.(types.Decimal64); ok {
+		ctx.ValMin = int64(v)
+		defer func() {
+			ctx.ValMin = v
+		}()
+	}
+	if v, ok := ctx.ValMax.(types.Decimal64); ok {
+		ctx.ValMax = int64(v)
+		defer func() {
+			ctx.ValMax = v
+		}()
+	}
 	switch ctx.Op {
 	case OpEq:
 		ctx.BMRes, err = i.Eq(ctx.Val, ctx.BMRes)
@@ -64,6 +64,13 @@ func (i *SegmentZoneMapIndex) GetCol() int16 {
 }
 
 func (i *SegmentZoneMapIndex) Eval(ctx *FilterCtx) error {
+	if len(ctx.ValSet) > 0 && ctx.Op == OpOut {
+		ctx.BoolRes = !i.constantIn(ctx.Vals())
+		return nil
+	}
+	if len(ctx.ValSet) > 0 && ctx.Op == OpIn {
+		return evalValSet(i, ctx)
+	}
 	switch ctx.Op {
 	case OpEq:
 		ctx.BoolRes = i.Eq(ctx.Val)
@@ -300,6 +307,38 @@ func (i *SegmentZoneMapIndex) Unmarshal(data []byte) error {
 			buf = buf[8:]
 		}
 		return nil
+	case types.T_decimal64:
+		i.MinV = encoding.DecodeDecimal64(buf[:8])
+		buf = buf[8:]
+		i.MaxV = encoding.DecodeDecimal64(buf[:8])
+		buf = buf[8:]
+		len := encoding.DecodeInt32(buf[:4])
+		buf = buf[4:]
+		i.BlkMax = make([]interface{}, len)
+		i.BlkMin = make([]interface{}, len)
+		for j := 0; j < int(len); j++ {
+			i.BlkMin[j] = encoding.DecodeDecimal64(buf[:8])
+			buf = buf[8:]
+			i.BlkMax[j] = encoding.DecodeDecimal64(buf[:8])
+			buf = buf[8:]
+		}
+		return nil
+	case types.T_decimal128:
+		i.MinV = encoding.DecodeDecimal128(buf[:16])
+		buf = buf[16:]
+		i.MaxV = encoding.DecodeDecimal128(buf[:16])
+		buf = buf[16:]
+		len := encoding.DecodeInt32(buf[:4])
+		buf = buf[4:]
+		i.BlkMax = make([]interface{}, len)
+		i.BlkMin = make([]interface{}, len)
+		for j := 0; j < int(len); j++ {
+			i.BlkMin[j] = encoding.DecodeDecimal128(buf[:16])
+			buf = buf[16:]
+			i.BlkMax[j] = encoding.DecodeDecimal128(buf[:16])
+			buf = buf[16:]
+		}
+		return nil
 	case types.T_char, types.T_varchar, types.T_json:
 		lenminv := encoding.DecodeInt16(buf[:2])
 		buf = buf[2:]
@@ -350,6 +389,38 @@ func (i *SegmentZoneMapIndex) Unmarshal(data []byte) error {
 			buf = buf[8:]
 		}
 		return nil
+	case types.T_time:
+		i.MinV = encoding.DecodeTime(buf[:8])
+		buf = buf[8:]
+		i.MaxV = encoding.DecodeTime(buf[:8])
+		buf = buf[8:]
+		len := encoding.DecodeInt32(buf[:4])
+		buf = buf[4:]
+		i.BlkMax = make([]interface{}, len)
+		i.BlkMin = make([]interface{}, len)
+		for j := 0; j < int(len); j++ {
+			i.BlkMin[j] = encoding.DecodeTime(buf[:8])
+			buf = buf[8:]
+			i.BlkMax[j] = encoding.DecodeTime(buf[:8])
+			buf = buf[8:]
+		}
+		return nil
+	case types.T_timestamp:
+		i.MinV = encoding.DecodeTimestamp(buf[:8])
+		buf = buf[8:]
+		i.MaxV = encoding.DecodeTimestamp(buf[:8])
+		buf = buf[8:]
+		len := encoding.DecodeInt32(buf[:4])
+		buf = buf[4:]
+		i.BlkMax = make([]interface{}, len)
+		i.BlkMin = make([]interface{}, len)
+		for j := 0; j < int(len); j++ {
+			i.BlkMin[j] = encoding.DecodeTimestamp(buf[:8])
+			buf = buf[8:]
+			i.BlkMax[j] = encoding.DecodeTimestamp(buf[:8])
+			buf = buf[8:]
+		}
+		return nil
 	case types.T_date:
 		i.MinV = encoding.DecodeDate(buf[:4])
 		buf = buf[4:]
@@ -474,6 +545,26 @@ func (i *SegmentZoneMapIndex) Marshal() ([]byte, error) {
 			buf.Write(encoding.EncodeFloat64(i.BlkMax[j].(float64)))
 		}
 		return buf.Bytes(), nil
+	case types.T_decimal64:
+		buf.Write(encoding.EncodeType(i.T))
+		buf.Write(encoding.EncodeDecimal64(i.MinV.(types.Decimal64)))
+		buf.Write(encoding.EncodeDecimal64(i.MaxV.(types.Decimal64)))
+		buf.Write(encoding.EncodeInt32(int32(len(i.BlkMin))))
+		for j := 0; j < len(i.BlkMin); j++ {
+			buf.Write(encoding.EncodeDecimal64(i.BlkMin[j].(types.Decimal64)))
+			buf.Write(encoding.EncodeDecimal64(i.BlkMax[j].(types.Decimal64)))
+		}
+		return buf.Bytes(), nil
+	case types.T_decimal128:
+		buf.Write(encoding.EncodeType(i.T))
+		buf.Write(encoding.EncodeDecimal128(i.MinV.(types.Decimal128)))
+		buf.Write(encoding.EncodeDecimal128(i.MaxV.(types.Decimal128)))
+		buf.Write(encoding.EncodeInt32(int32(len(i.BlkMin))))
+		for j := 0; j < len(i.BlkMin); j++ {
+			buf.Write(encoding.EncodeDecimal128(i.BlkMin[j].(types.Decimal128)))
+			buf.Write(encoding.EncodeDecimal128(i.BlkMax[j].(types.Decimal128)))
+		}
+		return buf.Bytes(), nil
 	case types.T_char, types.T_varchar, types.T_json:
 		buf.Write(encoding.EncodeType(i.T))
 		minv := i.MinV.([]byte)
@@ -500,6 +591,26 @@ func (i *SegmentZoneMapIndex) Marshal() ([]byte, error) {
 			buf.Write(encoding.EncodeDatetime(i.BlkMax[j].(types.Datetime)))
 		}
 		return buf.Bytes(), nil
+	case types.T_time:
+		buf.Write(encoding.EncodeType(i.T))
+		buf.Write(encoding.EncodeTime(i.MinV.(types.Time)))
+		buf.Write(encoding.EncodeTime(i.MaxV.(types.Time)))
+		buf.Write(encoding.EncodeInt32(int32(len(i.BlkMin))))
+		for j := 0; j < len(i.BlkMin); j++ {
+			buf.Write(encoding.EncodeTime(i.BlkMin[j].(types.Time)))
+			buf.Write(encoding.EncodeTime(i.BlkMax[j].(types.Time)))
+		}
+		return buf.Bytes(), nil
+	case types.T_timestamp:
+		buf.Write(encoding.EncodeType(i.T))
+		buf.Write(encoding.EncodeTimestamp(i.MinV.(types.Timestamp)))
+		buf.Write(encoding.EncodeTimestamp(i.MaxV.(types.Timestamp)))
+		buf.Write(encoding.EncodeInt32(int32(len(i.BlkMin))))
+		for j := 0; j < len(i.BlkMin); j++ {
+			buf.Write(encoding.EncodeTimestamp(i.BlkMin[j].(types.Timestamp)))
+			buf.Write(encoding.EncodeTimestamp(i.BlkMax[j].(types.Timestamp)))
+		}
+		return buf.Bytes(), nil
 	case types.T_date:
 		buf.Write(encoding.EncodeType(i.T))
 		buf.Write(encoding.EncodeDate(i.MinV.(types.Date)))
@@ -536,16 +647,22 @@ func (i *SegmentZoneMapIndex) Eq(v interface{}) bool {
 		return v.(uint32) >= i.MinV.(uint32) && v.(uint32) <= i.MaxV.(uint32)
 	case types.T_uint64:
 		return v.(uint64) >= i.MinV.(uint64) && v.(uint64) <= i.MaxV.(uint64)
-	case types.T_decimal:
-		panic("not supported")
 	case types.T_float32:
 		return v.(float32) >= i.MinV.(float32) && v.(float32) <= i.MaxV.(float32)
 	case types.T_float64:
 		return v.(float64) >= i.MinV.(float64) && v.(float64) <= i.MaxV.(float64)
+	case types.T_decimal64:
+		return v.(types.Decimal64) >= i.MinV.(types.Decimal64) && v.(types.Decimal64) <= i.MaxV.(types.Decimal64)
+	case types.T_decimal128:
+		return v.(types.Decimal128).Compare(i.MinV.(types.Decimal128)) >= 0 && v.(types.Decimal128).Compare(i.MaxV.(types.Decimal128)) <= 0
 	case types.T_date:
 		return v.(types.Date) >= i.MinV.(types.Date) && v.(types.Date) <= i.MaxV.(types.Date)
 	case types.T_datetime:
 		return v.(types.Datetime) >= i.MinV.(types.Datetime) && v.(types.Datetime) <= i.MaxV.(types.Datetime)
+	case types.T_time:
+		return v.(types.Time) >= i.MinV.(types.Time) && v.(types.Time) <= i.MaxV.(types.Time)
+	case types.T_timestamp:
+		return v.(types.Timestamp) >= i.MinV.(types.Timestamp) && v.(types.Timestamp) <= i.MaxV.(types.Timestamp)
 	case types.T_sel:
 		return v.(int64) >= i.MinV.(int64) && v.(int64) <= i.MaxV.(int64)
 	case types.T_tuple:
@@ -562,6 +679,19 @@ func (i *SegmentZoneMapIndex) Eq(v interface{}) bool {
 	panic("not supported")
 }
 
+// constantIn returns true if all the values are the same one of vs.
+func (i *SegmentZoneMapIndex) constantIn(vs []interface{}) bool {
+	if i.Lt(i.MaxV) {
+		return false
+	}
+	for _, v := range vs {
+		if i.Eq(v) {
+			return true
+		}
+	}
+	return false
+}
+
 func (i *SegmentZoneMapIndex) Ne(v interface{}) bool {
 	return !i.Eq(v)
 }
@@ -584,16 +714,22 @@ func (i *SegmentZoneMapIndex) Lt(v interface{}) bool {
 		return v.(uint32) > i.MinV.(uint32)
 	case types.T_uint64:
 		return v.(uint64) > i.MinV.(uint64)
-	case types.T_decimal:
-		panic("not supported")
 	case types.T_float32:
 		return v.(float32) > i.MinV.(float32)
 	case types.T_float64:
 		return v.(float64) > i.MinV.(float64)
+	case types.T_decimal64:
+		return v.(types.Decimal64) > i.MinV.(types.Decimal64)
+	case types.T_decimal128:
+		return v.(types.Decimal128).Compare(i.MinV.(types.Decimal128)) > 0
 	case types.T_date:
 		return v.(types.Date) > i.MinV.(types.Date)
 	case types.T_datetime:
 		return v.(types.Datetime) > i.MinV.(types.Datetime)
+	case types.T_time:
+		return v.(types.Time) > i.MinV.(types.Time)
+	case types.T_timestamp:
+		return v.(types.Timestamp) > i.MinV.(types.Timestamp)
 	case types.T_sel:
 		return v.(int64) > i.MinV.(int64)
 	case types.T_tuple:
@@ -622,16 +758,22 @@ func (i *SegmentZoneMapIndex) Le(v interface{}) bool {
 		return v.(uint32) >= i.MinV.(uint32)
 	case types.T_uint64:
 		return v.(uint64) >= i.MinV.(uint64)
-	case types.T_decimal:
-		panic("not supported")
 	case types.T_float32:
 		return v.(float32) >= i.MinV.(float32)
 	case types.T_float64:
 		return v.(float64) >= i.MinV.(float64)
+	case types.T_decimal64:
+		return v.(types.Decimal64) >= i.MinV.(types.Decimal64)
+	case types.T_decimal128:
+		return v.(types.Decimal128).Compare(i.MinV.(types.Decimal128)) >= 0
 	case types.T_date:
 		return v.(types.Date) >= i.MinV.(types.Date)
 	case types.T_datetime:
 		return v.(types.Datetime) >= i.MinV.(types.Datetime)
+	case types.T_time:
+		return v.(types.Time) >= i.MinV.(types.Time)
+	case types.T_timestamp:
+		return v.(types.Timestamp) >= i.MinV.(types.Timestamp)
 	case types.T_sel:
 		return v.(int64) >= i.MinV.(int64)
 	case types.T_tuple:
@@ -660,16 +802,22 @@ func (i *SegmentZoneMapIndex) Gt(v interface{}) bool {
 		return v.(uint32) < i.MaxV.(uint32)
 	case types.T_uint64:
 		return v.(uint64) < i.MaxV.(uint64)
-	case types.T_decimal:
-		panic("not supported")
 	case types.T_float32:
 		return v.(float32) < i.MaxV.(float32)
 	case types.T_float64:
 		return v.(float64) < i.MaxV.(float64)
+	case types.T_decimal64:
+		return v.(types.Decimal64) < i.MaxV.(types.Decimal64)
+	case types.T_decimal128:
+		return v.(types.Decimal128).Compare(i.MaxV.(types.Decimal128)) < 0
 	case types.T_date:
 		return v.(types.Date) < i.MaxV.(types.Date)
 	case types.T_datetime:
 		return v.(types.Datetime) < i.MaxV.(types.Datetime)
+	case types.T_time:
+		return v.(types.Time) < i.MaxV.(types.Time)
+	case types.T_timestamp:
+		return v.(types.Timestamp) < i.MaxV.(types.Timestamp)
 	case types.T_sel:
 		return v.(int64) < i.MaxV.(int64)
 	case types.T_tuple:
@@ -698,16 +846,22 @@ func (i *SegmentZoneMapIndex) Ge(v interface{}) bool {
 		return v.(uint32) <= i.MaxV.(uint32)
 	case types.T_uint64:
 		return v.(uint64) <= i.MaxV.(uint64)
-	case types.T_decimal:
-		panic("not supported")
 	case types.T_float32:
 		return v.(float32) <= i.MaxV.(float32)
 	case types.T_float64:
 		return v.(float64) <= i.MaxV.(float64)
+	case types.T_decimal64:
+		return v.(types.Decimal64) <= i.MaxV.(types.Decimal64)
+	case types.T_decimal128:
+		return v.(types.Decimal128).Compare(i.MaxV.(types.Decimal128)) <= 0
 	case types.T_date:
 		return v.(types.Date) <= i.MaxV.(types.Date)
 	case types.T_datetime:
 		return v.(types.Datetime) <= i.MaxV.(types.Datetime)
+	case types.T_time:
+		return v.(types.Time) <= i.MaxV.(types.Time)
+	case types.T_timestamp:
+		return v.(types.Timestamp) <= i.MaxV.(types.Timestamp)
 	case types.T_sel:
 		return v.(int64) <= i.MaxV.(int64)
 	case types.T_tuple:
@@ -78,6 +78,9 @@ func (i *StringBsiIndex) Eval(ctx *FilterCtx) error {
 	if ctx.BMRes.IsEmpty() {
 		return nil
 	}
+	if len(ctx.ValSet) > 0 && (ctx.Op == OpIn || ctx.Op == OpOut) {
+		return evalValSet(i, ctx)
+	}
 	var err error
 	switch ctx.Op {
 	case OpEq:
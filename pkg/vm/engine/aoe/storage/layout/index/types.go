@@ -86,6 +86,77 @@ func (ctx *FilterCtx) Eval(i Index) error {
 	return i.Eval(ctx)
 }
 
+// SetVals fills the ValSet of an IN | NOT IN filter, a []byte value is
+// keyed by its string as slices are not comparable.
+func (ctx *FilterCtx) SetVals(vals []interface{}) {
+	if ctx.ValSet == nil {
+		ctx.ValSet = make(map[interface{}]bool)
+	}
+	for _, v := range vals {
+		if bs, ok := v.([]byte); ok {
+			v = string(bs)
+		}
+		ctx.ValSet[v] = true
+	}
+}
+
+// Vals returns the values of the ValSet, the keys of []byte values are
+// turned back to []byte.
+func (ctx *FilterCtx) Vals() []interface{} {
+	vals := make([]interface{}, 0, len(ctx.ValSet))
+	for v := range ctx.ValSet {
+		if s, ok := v.(string); ok {
+			vals = append(vals, []byte(s))
+		} else {
+			vals = append(vals, v)
+		}
+	}
+	return vals
+}
+
+// evalValSet evaluates an IN | NOT IN filter with a ValSet by the Eq | Ne
+// filters of its values, IN is the union of them and NOT IN is the
+// intersection. Without a bitmap nothing is known to be excluded by NOT IN,
+// so zone maps evaluate it by themselves.
+func evalValSet(i Index, ctx *FilterCtx) error {
+	op := OpEq
+	if ctx.Op == OpOut {
+		op = OpNe
+	}
+	bm := ctx.BMRes
+	if bm == nil && op == OpNe {
+		ctx.BoolRes = true
+		return nil
+	}
+	ctx.BoolRes = op == OpNe
+	if bm != nil && op == OpEq {
+		ctx.BMRes = roaring.NewBitmap()
+	}
+	for _, v := range ctx.Vals() {
+		subCtx := &FilterCtx{Op: op, Val: v}
+		if bm != nil {
+			if op == OpEq {
+				subCtx.BMRes = bm.Clone()
+			} else {
+				subCtx.BMRes = ctx.BMRes
+			}
+		}
+		if err := i.Eval(subCtx); err != nil {
+			return err
+		}
+		if op == OpEq {
+			ctx.BoolRes = ctx.BoolRes || subCtx.BoolRes
+			if bm != nil {
+				ctx.BMRes.Or(subCtx.BMRes)
+			}
+		} else {
+			ctx.BoolRes = ctx.BoolRes && subCtx.BoolRes
+			ctx.BMRes = subCtx.BMRes
+		}
+	}
+	return nil
+}
+
 type Index interface {
 	buf.IMemoryNode
 	Type() base.IndexType
@@ -70,7 +70,7 @@ func (holder *unsortedSegmentHolder) EvalFilter(colIdx int, ctx *FilterCtx) erro
 	res := roaring2.NewBitmap()
 	for _, blkHolder := range holder.tree.blockHolders {
 		if ctx.BsiRequired {
-			subCtx := &FilterCtx{Op: ctx.Op, Val: ctx.Val, ValMax: ctx.ValMax, ValMin: ctx.ValMin, BMRes: ctx.BMRes.Clone(), BsiRequired: true}
+			subCtx := &FilterCtx{Op: ctx.Op, Val: ctx.Val, ValSet: ctx.ValSet, ValMax: ctx.ValMax, ValMin: ctx.ValMin, BMRes: ctx.BMRes.Clone(), BsiRequired: true}
 			if err := blkHolder.EvalFilter(colIdx, subCtx); err != nil {
 				return err
 			}
@@ -79,7 +79,7 @@ func (holder *unsortedSegmentHolder) EvalFilter(colIdx int, ctx *FilterCtx) erro
 				res.Or(subCtx.BMRes)
 			}
 		} else {
-			subCtx := &FilterCtx{Op: ctx.Op, Val: ctx.Val, ValMax: ctx.ValMax, ValMin: ctx.ValMin}
+			subCtx := &FilterCtx{Op: ctx.Op, Val: ctx.Val, ValSet: ctx.ValSet, ValMax: ctx.ValMax, ValMin: ctx.ValMin}
 			if err := blkHolder.EvalFilter(colIdx, subCtx); err != nil {
 				return err
 			}
@@ -67,6 +67,26 @@ func (c *tableAppender) Flush() error {
 	return blk.Flush()
 }
 
+// CloseSegment closes the segment being appended to with the blocks it
+// has got and hands its last block to the scheduler if it is full, so
+// that the segment is merge-sorted without waiting for the next append.
+func (c *tableAppender) CloseSegment(index *metadata.LogIndex) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	segment := c.meta.SimpleGetCurrSegment()
+	if segment == nil {
+		return nil
+	}
+	if err := segment.SimpleClose(index); err != nil && err != metadata.UpgradeNotNeededErr {
+		return err
+	}
+	if c.blkAppender != nil && c.blkAppender.GetMeta().HasMaxRowsLocked() {
+		c.opts.Scheduler.AsyncFlushBlock(c.blkAppender)
+		c.blkAppender = nil
+	}
+	return nil
+}
+
 func (c *tableAppender) String() string {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -97,13 +117,16 @@ func (c *tableAppender) onImmut() {
 	c.onNoMut()
 }
 
-func (c *tableAppender) doAppend(mutblk mb.IMutableBlock, bat *batch.Batch, offset uint64, index *shard.SliceIndex) (n uint64, err error) {
+func (c *tableAppender) doAppend(mutblk mb.IMutableBlock, bat *batch.Batch, offset uint64, index *shard.SliceIndex, ts int64) (n uint64, err error) {
 	var na int
 	meta := mutblk.GetMeta()
 	data := mutblk.GetData()
+	schema := meta.Segment.Table.Schema
+	var missing []int
 	for idx, attr := range data.GetAttrs() {
+		found := false
 		for i, a := range bat.Attrs {
-			if a == meta.Segment.Table.Schema.ColDefs[idx].Name {
+			if a == schema.ColDefs[idx].Name && !schema.ColDefs[idx].Dropped {
 				vec, err := data.GetVectorByAttr(attr)
 				if err != nil {
 					return 0, err
@@ -111,8 +134,26 @@ func (c *tableAppender) doAppend(mutblk mb.IMutableBlock, bat *batch.Batch, offs
 				if na, err = vec.AppendVector(bat.Vecs[i], int(offset)); err != nil {
 					return n, err
 				}
+				found = true
 			}
 		}
+		if !found {
+			missing = append(missing, idx)
+		}
+	}
+	// the dropped columns are filled with zero values
+	for _, idx := range missing {
+		vec, err := data.GetVectorByAttr(data.GetAttrs()[idx])
+		if err != nil {
+			return 0, err
+		}
+		def, err := schema.ColDefs[idx].DefaultVector(na)
+		if err != nil {
+			return 0, err
+		}
+		if _, err = vec.AppendVector(def, 0); err != nil {
+			return 0, err
+		}
 	}
 	n = uint64(na)
 	index.Count = n
@@ -133,7 +174,7 @@ func (c *tableAppender) doAppend(mutblk mb.IMutableBlock, bat *batch.Batch, offs
 		return 0, err
 	}
 	// log.Infof("1. offset=%d, n=%d, cap=%d, index=%s, blkcnt=%d", offset, n, bat.Vecs[0].Length(), index.String(), mt.Meta.GetCount())
-	if _, err = meta.AddCountLocked(n); err != nil {
+	if _, err = meta.AddCountAtLocked(n, ts); err != nil {
 		return 0, err
 	}
 	c.data.AddRows(n)
@@ -141,7 +182,7 @@ func (c *tableAppender) doAppend(mutblk mb.IMutableBlock, bat *batch.Batch, offs
 	return n, nil
 }
 
-func (c *tableAppender) Append(bat *batch.Batch, index *shard.SliceIndex) (err error) {
+func (c *tableAppender) Append(bat *batch.Batch, index *shard.SliceIndex, ts int64) (err error) {
 	logutil.Infof("Append logindex: %s", index.String())
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -160,7 +201,11 @@ func (c *tableAppender) Append(bat *batch.Batch, index *shard.SliceIndex) (err e
 			blkHandle = c.blkAppender.MakeHandle()
 		}
 		blk := blkHandle.GetNode().(mb.IMutableBlock)
-		n, err := c.doAppend(blk, bat, offset, index)
+		if err = blk.Extend(); err != nil {
+			blkHandle.Close()
+			return err
+		}
+		n, err := c.doAppend(blk, bat, offset, index, ts)
 		if err != nil {
 			blkHandle.Close()
 			return err
@@ -15,6 +15,7 @@
 package table
 
 import (
+	"github.com/RoaringBitmap/roaring/roaring64"
 	bmgrif "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/buffer/manager/iface"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
@@ -31,6 +32,7 @@ type baseBlock struct {
 	host        iface.ISegment
 	typ         base.BlockType
 	indexholder *index.BlockIndexHolder
+	origins     *rowOrigins
 }
 
 func newBaseBlock(host iface.ISegment, meta *metadata.Block) *baseBlock {
@@ -38,6 +40,7 @@ func newBaseBlock(host iface.ISegment, meta *metadata.Block) *baseBlock {
 		host:    host,
 		meta:    meta,
 		sllnode: *common.NewSLLNode(nil),
+		origins: new(rowOrigins),
 	}
 	if meta.CommitInfo.Op < metadata.OpUpgradeFull {
 		blk.typ = base.TRANSIENT_BLK
@@ -73,6 +76,52 @@ func (blk *baseBlock) GetIndexHolder() *index.BlockIndexHolder {
 	return blk.indexholder
 }
 
+// GetRowOrigins returns the origins of the rows, see
+// metadata.MakeRowOrigin.
+func (blk *baseBlock) GetRowOrigins() ([]uint64, error) {
+	if blk.typ == base.TRANSIENT_BLK {
+		return appendOrigins(blk.meta, blk.GetRowCount()), nil
+	}
+	return blk.origins.get(blk)
+}
+
+// GetDeleteMask returns the offsets of the deleted rows, nil if no row
+// of the segment was ever deleted.
+func (blk *baseBlock) GetDeleteMask() (*roaring64.Bitmap, error) {
+	deletes := blk.meta.Segment.GetDeletes()
+	if deletes == nil {
+		return nil, nil
+	}
+	return blk.maskOf(deletes.Rows())
+}
+
+func (blk *baseBlock) maskOf(deleted *roaring64.Bitmap) (*roaring64.Bitmap, error) {
+	if deleted.IsEmpty() {
+		return roaring64.New(), nil
+	}
+	origins, err := blk.GetRowOrigins()
+	if err != nil {
+		return nil, err
+	}
+	return maskOrigins(origins, deleted), nil
+}
+
+// GetDeleteMaskAsOf returns the offsets of the first rows rows which
+// were not visible at the given unix time in nanoseconds: those deleted
+// at or before it, and those appended after it or being appended.
+func (blk *baseBlock) GetDeleteMaskAsOf(ts int64, rows uint64) (*roaring64.Bitmap, error) {
+	segment := blk.meta.Segment
+	hidden := segment.RowsAppendedAfter(ts)
+	if deletes := segment.GetDeletes(); deletes != nil {
+		hidden.Or(deletes.RowsAsOf(ts))
+	}
+	if blk.typ == base.TRANSIENT_BLK {
+		// the rows being appended are not counted yet
+		return maskOrigins(appendOrigins(blk.meta, rows), hidden), nil
+	}
+	return blk.maskOf(hidden)
+}
+
 func (blk *baseBlock) SetNext(next iface.IBlock) {
 	blk.sllnode.SetNextNode(next)
 }
@@ -109,6 +158,7 @@ func (blk *baseBlock) upgrade(host iface.ISegment, meta *metadata.Block) (*baseB
 		meta:    meta,
 		host:    host,
 		sllnode: *common.NewSLLNode(nil),
+		origins: new(rowOrigins),
 	}
 
 	switch blk.typ {
@@ -67,7 +67,7 @@ func newBlock(host iface.ISegment, meta *metadata.Block) (iface.IBlock, error) {
 
 func (blk *block) initColumns() error {
 	blk.data.sizes = make([]uint64, 0)
-	for idx, _ := range blk.meta.Segment.Table.Schema.ColDefs {
+	for idx, _ := range blk.meta.Schema().ColDefs {
 		blk.Ref()
 		colBlk := col.NewStdColumnBlock(blk, idx)
 		blk.data.cols = append(blk.data.cols, colBlk)
@@ -91,6 +91,9 @@ func (blk *block) Size(attr string) uint64 {
 	if idx < 0 {
 		panic(fmt.Sprintf("Specified attr %s not found", attr))
 	}
+	if idx >= len(blk.data.cols) {
+		return 0
+	}
 	if !blk.IsMutable() {
 		return blk.data.sizes[idx]
 	}
@@ -104,6 +107,13 @@ func (blk *block) cloneWithUpgradeColumns(upgraded *block) {
 		upgraded.data.cols[idx] = upgradedCol
 		upgraded.data.sizes = append(upgraded.data.sizes, upgradedCol.Size())
 	}
+	// the sorted segment stores the columns of its last block
+	for idx := len(blk.data.cols); idx < len(upgraded.meta.Segment.Schema().ColDefs); idx++ {
+		upgraded.Ref()
+		colBlk := col.NewStdColumnBlock(upgraded, idx)
+		upgraded.data.cols = append(upgraded.data.cols, colBlk)
+		upgraded.data.sizes = append(upgraded.data.sizes, colBlk.Size())
+	}
 }
 
 func (blk *block) CloneWithUpgrade(host iface.ISegment, meta *metadata.Block) (iface.IBlock, error) {
@@ -144,6 +154,16 @@ func (blk *block) String() string {
 }
 
 func (blk *block) GetVectorWrapper(attrid int) (*vector.VectorWrapper, error) {
+	if attrid >= len(blk.data.cols) {
+		blk.meta.RLock()
+		rows := blk.meta.GetCountLocked()
+		blk.meta.RUnlock()
+		vec, err := blk.meta.Segment.Table.Schema.ColDefs[attrid].DefaultVector(int(rows))
+		if err != nil {
+			return nil, err
+		}
+		return vector.NewVectorWrapper(vec), nil
+	}
 	vec, err := blk.data.cols[attrid].LoadVectorWrapper()
 	if err != nil {
 		return nil, err
@@ -156,6 +176,12 @@ func (blk *block) GetVectorCopy(attr string, compressed, deCompressed *bytes.Buf
 	if colIdx == -1 {
 		return nil, errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	if colIdx >= len(blk.data.cols) {
+		blk.meta.RLock()
+		rows := blk.meta.GetCountLocked()
+		blk.meta.RUnlock()
+		return blk.meta.Segment.Table.Schema.ColDefs[colIdx].DefaultVector(int(rows))
+	}
 	vec, err := blk.data.cols[colIdx].ForceLoad(compressed, deCompressed)
 	if err != nil {
 		return nil, err
@@ -168,6 +194,9 @@ func (blk *block) Prefetch(attr string) error {
 	if colIdx == -1 {
 		return errors.New(fmt.Sprintf("column %s not found", attr))
 	}
+	if colIdx >= len(blk.data.cols) {
+		return nil
+	}
 	return blk.data.cols[colIdx].Prefetch()
 }
 
@@ -243,10 +272,16 @@ func (blk *block) Sum(colIdx int, filter *roaring64.Bitmap) (int64, uint64) {
 			sum += int64(val.(float32))
 		case types.T_float64:
 			sum += int64(val.(float64))
+		case types.T_decimal64:
+			sum += int64(val.(types.Decimal64))
 		case types.T_date:
 			sum += int64(val.(types.Date))
 		case types.T_datetime:
 			sum += int64(val.(types.Datetime))
+		case types.T_time:
+			sum += int64(val.(types.Time))
+		case types.T_timestamp:
+			sum += int64(val.(types.Timestamp))
 		}
 	}
 	return sum, cnt
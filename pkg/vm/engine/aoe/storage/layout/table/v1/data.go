@@ -323,6 +323,14 @@ func (td *tableData) CopyTo(dir string) error {
 	return err
 }
 
+func (td *tableData) HistoryHorizon() int64 {
+	return td.meta.HistoryHorizon()
+}
+
+func (td *tableData) PruneHistory() {
+	td.meta.PruneAppends(td.meta.HistoryHorizon())
+}
+
 func (td *tableData) GetRowCount() uint64 {
 	return td.meta.GetRowCount()
 }
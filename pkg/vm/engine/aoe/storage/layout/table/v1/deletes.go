@@ -0,0 +1,114 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"os"
+	"sync"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/dataio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
+)
+
+// The rows deleted from a segment are kept by its metadata as origins,
+// see metadata.MakeRowOrigin, and mapped onto the rows of a block with
+// the origins of its rows: the offsets of a transient block are its
+// origins, while the origins of the rows of block and segment files are
+// committed along with them by the writers.
+
+// rowOrigins keeps the origins of the rows of a persistent block.
+type rowOrigins struct {
+	sync.Mutex
+	origins []uint64
+}
+
+// get returns the origins of the rows of blk, loading them on first use.
+func (o *rowOrigins) get(blk *baseBlock) ([]uint64, error) {
+	o.Lock()
+	defer o.Unlock()
+	if o.origins != nil {
+		return o.origins, nil
+	}
+	meta := blk.meta
+	rows := blk.GetRowCount()
+	dir := meta.Segment.Table.Database.Catalog.Cfg.Dir
+	var (
+		origins []uint64
+		err     error
+	)
+	switch blk.typ {
+	case base.PERSISTENT_BLK:
+		id := meta.AsCommonID()
+		name := common.MakeBlockFileName(dir, id.ToBlockFileName(), id.TableID, false)
+		origins, err = dataio.ReadRowOrder(name)
+	case base.PERSISTENT_SORTED_BLK:
+		id := meta.Segment.AsCommonID()
+		name := common.MakeSegmentFileName(dir, id.ToSegmentFileName(), id.TableID, false)
+		origins, err = dataio.ReadRowOrderAt(name, rowsBefore(meta), rows)
+	default:
+		panic("logic error")
+	}
+	if os.IsNotExist(err) {
+		// The file was written without an order file, no row of it
+		// could have been deleted yet
+		origins, err = appendOrigins(meta, rows), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	o.origins = origins
+	return origins, nil
+}
+
+// rowsBefore returns how many rows the blocks of the segment before
+// meta have.
+func rowsBefore(meta *metadata.Block) uint64 {
+	segment := meta.Segment
+	segment.RLock()
+	blks := segment.BlockSet[:meta.Idx]
+	segment.RUnlock()
+	start := uint64(0)
+	for _, blk := range blks {
+		blk.RLock()
+		start += blk.GetCountLocked()
+		blk.RUnlock()
+	}
+	return start
+}
+
+// appendOrigins returns the origins of the first rows rows of a block
+// in append order.
+func appendOrigins(meta *metadata.Block, rows uint64) []uint64 {
+	origins := make([]uint64, rows)
+	for i := range origins {
+		origins[i] = metadata.MakeRowOrigin(meta.Idx, uint64(i))
+	}
+	return origins
+}
+
+// maskOrigins returns the offsets of the rows of a block whose origins
+// are in deleted.
+func maskOrigins(origins []uint64, deleted *roaring64.Bitmap) *roaring64.Bitmap {
+	mask := roaring64.New()
+	for i, origin := range origins {
+		if deleted.Contains(origin) {
+			mask.Add(uint64(i))
+		}
+	}
+	return mask
+}
@@ -37,8 +37,12 @@ import (
 
 type MutationHandle interface {
 	io.Closer
-	Append(bat *gbat.Batch, index *shard.SliceIndex) (err error)
+	// Append appends bat at the given unix time in nanoseconds
+	Append(bat *gbat.Batch, index *shard.SliceIndex, ts int64) (err error)
 	Flush() error
+	// CloseSegment closes the segment being appended to with the blocks
+	// it has got
+	CloseSegment(index *metadata.LogIndex) error
 	String() string
 	GetMeta() *metadata.Table
 	RefCount() int64
@@ -130,6 +134,14 @@ type ITableData interface {
 
 	CopyTo(dir string) error
 	LinkTo(dir string) error
+
+	// HistoryHorizon returns the oldest unix time in nanoseconds the
+	// table can be read as of
+	HistoryHorizon() int64
+
+	// PruneHistory folds the append stamps of the rows older than the
+	// horizon, which are no longer needed to read the table as of
+	PruneHistory()
 }
 
 type ISegment interface {
@@ -238,6 +250,17 @@ type IBlock interface {
 	GetVectorCopy(attr string, compressed *bytes.Buffer, deCompressed *bytes.Buffer) (*vector.Vector, error)
 	Prefetch(attr string) error
 
+	// GetRowOrigins gets the origins of the rows of the Block, which
+	// identify them in the deletes of the segment metadata
+	GetRowOrigins() ([]uint64, error)
+
+	// GetDeleteMask gets the offsets of the deleted rows of the Block
+	GetDeleteMask() (*roaring64.Bitmap, error)
+
+	// GetDeleteMaskAsOf gets the offsets of the first rows of the Block
+	// which were not visible at the given unix time in nanoseconds
+	GetDeleteMaskAsOf(ts int64, rows uint64) (*roaring64.Bitmap, error)
+
 	Sum(int, *roaring64.Bitmap) (int64, uint64)
 	Max(int, *roaring64.Bitmap) interface{}
 	Min(int, *roaring64.Bitmap) interface{}
@@ -123,7 +123,7 @@ func (seg *segment) CanUpgrade() bool {
 	if seg.typ == base.SORTED_SEG {
 		return false
 	}
-	if len(seg.tree.blocks) < int(seg.meta.Table.Schema.SegmentMaxBlocks) {
+	if len(seg.tree.blocks) < seg.meta.MaxBlockCount() {
 		return false
 	}
 	for _, blk := range seg.tree.blocks {
@@ -39,18 +39,21 @@ type tblock struct {
 	baseBlock
 	node       mb.IMutableBlock
 	nodeMgr    bb.INodeManager
-	coarseSize map[string]uint64
+	coarseSize []uint64
 }
 
 func newTBlock(host iface.ISegment, meta *metadata.Block, factory fb.NodeFactory, mockSize *mb.MockSize) (*tblock, error) {
+	node, err := factory.CreateNode(host.GetSegmentFile(), meta, mockSize)
+	if err != nil {
+		return nil, err
+	}
 	blk := &tblock{
-		baseBlock:  *newBaseBlock(host, meta),
-		node:       factory.CreateNode(host.GetSegmentFile(), meta, mockSize).(mb.IMutableBlock),
-		nodeMgr:    factory.GetManager(),
-		coarseSize: make(map[string]uint64),
+		baseBlock: *newBaseBlock(host, meta),
+		node:      node.(mb.IMutableBlock),
+		nodeMgr:   factory.GetManager(),
 	}
-	for i, colDef := range meta.Segment.Table.Schema.ColDefs {
-		blk.coarseSize[colDef.Name] = metadata.EstimateColumnBlockSize(i, meta)
+	for i := range meta.Schema().ColDefs {
+		blk.coarseSize = append(blk.coarseSize, metadata.EstimateColumnBlockSize(i, meta))
 	}
 	blk.GetObject = func() interface{} { return blk }
 	blk.Pin = func(o interface{}) { o.(iface.IBlock).Ref() }
@@ -101,7 +104,11 @@ func (blk *tblock) ProcessData(fn func(batch.IBatch) error) error {
 }
 
 func (blk *tblock) Size(attr string) uint64 {
-	return blk.coarseSize[attr]
+	colIdx := blk.meta.Segment.Table.Schema.GetColIdx(attr)
+	if colIdx < 0 || colIdx >= len(blk.coarseSize) {
+		return 0
+	}
+	return blk.coarseSize[colIdx]
 }
 
 func (blk *tblock) CloneWithUpgrade(host iface.ISegment, meta *metadata.Block) (iface.IBlock, error) {
@@ -121,6 +128,9 @@ func (blk *tblock) GetVectorWrapper(attrid int) (*vector.VectorWrapper, error) {
 func (blk *tblock) getVectorCopyFactory(attr string, compressed, deCompressed *bytes.Buffer) func(batch.IBatch) (*gvec.Vector, error) {
 	return func(bat batch.IBatch) (*gvec.Vector, error) {
 		colIdx := blk.meta.Segment.Table.Schema.GetColIdx(attr)
+		if colIdx >= len(bat.GetAttrs()) {
+			return blk.meta.Segment.Table.Schema.ColDefs[colIdx].DefaultVector(bat.Length())
+		}
 		vec, err := bat.GetVectorByAttr(colIdx)
 		if err != nil {
 			return nil, err
@@ -215,10 +225,16 @@ func (blk *tblock) Sum(colIdx int, filter *roaring64.Bitmap) (int64, uint64) {
 			sum += int64(val.(float32))
 		case types.T_float64:
 			sum += int64(val.(float64))
+		case types.T_decimal64:
+			sum += int64(val.(types.Decimal64))
 		case types.T_date:
 			sum += int64(val.(types.Date))
 		case types.T_datetime:
 			sum += int64(val.(types.Datetime))
+		case types.T_time:
+			sum += int64(val.(types.Time))
+		case types.T_timestamp:
+			sum += int64(val.(types.Timestamp))
 		}
 	}
 	return sum, cnt
@@ -509,5 +525,3 @@ func (blk *tblock) Btw(colIdx int, offset uint64, min, max interface{}) *roaring
 	res.And(blk.Le(colIdx, offset, max))
 	return res
 }
-
-
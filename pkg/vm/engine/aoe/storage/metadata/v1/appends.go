@@ -0,0 +1,160 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"time"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+)
+
+// The blocks of the tables retaining their history keep the unix time
+// in nanoseconds at which their rows were appended, so that a table can
+// be read as it was at a past time along with the stamps of the deletes.
+// The stamps come with the log entries of the appends, so that every
+// replica keeps the same ones, and are logged and checkpointed with the
+// block metadata. A zero stamp means that the rows were appended before
+// the history horizon of the table.
+
+// RowsAppend is the rows appended to a block by an operation, from its
+// offset up to that of the next one.
+type RowsAppend struct {
+	Offset uint64 `json:"off"`
+	Ts     int64  `json:"ts"`
+}
+
+// stampAppends records that the rows from offset on were appended at
+// ts, dropping the stale stamps of the rows from offset on. The stamps
+// are never changed in place, as the views of the block share them.
+func stampAppends(appends []*RowsAppend, offset uint64, ts int64) []*RowsAppend {
+	n := len(appends)
+	for n > 0 && appends[n-1].Offset >= offset {
+		n--
+	}
+	if n > 0 && appends[n-1].Ts == ts {
+		return appends[:n:n]
+	}
+	stamped := make([]*RowsAppend, n, n+1)
+	copy(stamped, appends)
+	return append(stamped, &RowsAppend{Offset: offset, Ts: ts})
+}
+
+// foldAppends returns the stamps with those older than horizon zeroed
+// and merged, nil if all of them are. The rows before the first stamp
+// have a zero one.
+func foldAppends(appends []*RowsAppend, horizon int64) []*RowsAppend {
+	var folded []*RowsAppend
+	last := int64(0)
+	for _, curr := range appends {
+		ts := curr.Ts
+		if ts < horizon {
+			ts = 0
+		}
+		if ts == last {
+			continue
+		}
+		folded = append(folded, &RowsAppend{Offset: curr.Offset, Ts: ts})
+		last = ts
+	}
+	return folded
+}
+
+// AddCountAtLocked works like AddCountLocked and records that the rows
+// were appended at the given unix time in nanoseconds if the table
+// retains its history.
+func (e *Block) AddCountAtLocked(n uint64, ts int64) (uint64, error) {
+	offset := e.Count
+	count, err := e.AddCountLocked(n)
+	if err != nil || n == 0 || e.Segment.Table.Schema.Retention == 0 {
+		return count, err
+	}
+	e.Appends = stampAppends(e.Appends, offset, ts)
+	return count, nil
+}
+
+// GetAppends returns the stamps of the rows of the block.
+func (e *Block) GetAppends() []*RowsAppend {
+	e.RLock()
+	defer e.RUnlock()
+	return e.Appends
+}
+
+// SetAppends replaces the stamps of the rows of the block, which are
+// restored along with its rows.
+func (e *Block) SetAppends(appends []*RowsAppend) {
+	e.Lock()
+	defer e.Unlock()
+	e.Appends = appends
+}
+
+// rowsAppendedAfter adds to rows the origins of the rows of the block
+// appended after ts, and of those being appended which are not counted
+// yet.
+func (e *Block) rowsAppendedAfter(ts int64, rows *roaring64.Bitmap) {
+	e.RLock()
+	count, appends := e.GetCountLocked(), e.Appends
+	e.RUnlock()
+	for i, curr := range appends {
+		end := count
+		if i+1 < len(appends) {
+			end = appends[i+1].Offset
+		}
+		if curr.Ts > ts && curr.Offset < end {
+			rows.AddRange(MakeRowOrigin(e.Idx, curr.Offset), MakeRowOrigin(e.Idx, end))
+		}
+	}
+	rows.AddRange(MakeRowOrigin(e.Idx, count), MakeRowOrigin(e.Idx+1, 0))
+}
+
+// RowsAppendedAfter returns the origins of the rows of the segment
+// appended after the given unix time in nanoseconds, including the rows
+// being appended.
+func (e *Segment) RowsAppendedAfter(ts int64) *roaring64.Bitmap {
+	e.RLock()
+	blks := e.BlockSet
+	e.RUnlock()
+	rows := roaring64.New()
+	for _, blk := range blks {
+		blk.rowsAppendedAfter(ts, rows)
+	}
+	return rows
+}
+
+// HistoryHorizon returns the oldest unix time in nanoseconds the table
+// can be read as of, the current time if it does not retain its history.
+func (e *Table) HistoryHorizon() int64 {
+	retention := time.Duration(e.Schema.Retention) * time.Second
+	return time.Now().Add(-retention).UnixNano()
+}
+
+// PruneAppends folds the stamps of the rows of the table older than
+// horizon, which are no longer needed to read it as of.
+func (e *Table) PruneAppends(horizon int64) {
+	e.RLock()
+	segments := e.SegmentSet
+	e.RUnlock()
+	for _, segment := range segments {
+		segment.RLock()
+		blks := segment.BlockSet
+		segment.RUnlock()
+		for _, blk := range blks {
+			blk.Lock()
+			if blk.Appends != nil {
+				blk.Appends = foldAppends(blk.Appends, horizon)
+			}
+			blk.Unlock()
+		}
+	}
+}
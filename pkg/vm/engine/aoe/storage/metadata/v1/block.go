@@ -30,10 +30,12 @@ var (
 
 type blockLogEntry struct {
 	*BaseEntry
-	Catalog    *Catalog `json:"-"`
-	DatabaseId uint64
-	TableId    uint64
-	SegmentId  uint64
+	Catalog       *Catalog `json:"-"`
+	DatabaseId    uint64
+	TableId       uint64
+	SegmentId     uint64
+	SchemaVersion uint32        `json:",omitempty"`
+	Appends       []*RowsAppend `json:",omitempty"`
 }
 
 func (e *blockLogEntry) Marshal() ([]byte, error) {
@@ -83,11 +85,18 @@ type Block struct {
 	Idx         uint32      `json:"idx"`
 	Count       uint64      `json:"count"`
 	SegmentedId uint64      `json:"segmentedid"`
+	// SchemaVersion is the version of the table schema whose columns are
+	// stored by the block
+	SchemaVersion uint32 `json:"schemaver,omitempty"`
+	// Appends is the unix time in nanoseconds at which the rows were
+	// appended, nil if the table does not retain its history
+	Appends []*RowsAppend `json:"appends,omitempty"`
 }
 
 func newBlockEntry(segment *Segment, tranId uint64, exIndex *LogIndex) *Block {
 	e := &Block{
-		Segment: segment,
+		Segment:       segment,
+		SchemaVersion: segment.Table.Schema.Version,
 		BaseEntry: &BaseEntry{
 			Id: segment.Table.Database.Catalog.NextBlockId(),
 			CommitInfo: &CommitInfo{
@@ -157,15 +166,42 @@ func (e *Block) ConsumeSnippet(reset bool) *shard.SliceIndice {
 func (e *Block) View() (view *Block) {
 	e.RLock()
 	view = &Block{
-		BaseEntry:   &BaseEntry{Id: e.Id, CommitInfo: e.CommitInfo.Clone()},
-		Segment:     e.Segment,
-		Count:       e.Count,
-		SegmentedId: e.SegmentedId,
+		BaseEntry:     &BaseEntry{Id: e.Id, CommitInfo: e.CommitInfo.Clone()},
+		Segment:       e.Segment,
+		Count:         e.Count,
+		SegmentedId:   e.SegmentedId,
+		SchemaVersion: e.SchemaVersion,
+		Appends:       e.Appends,
 	}
 	e.RUnlock()
 	return
 }
 
+// Schema returns the columns stored by the block. The blocks of a
+// sorted segment store the columns of the segment.
+func (e *Block) Schema() *Schema {
+	e.Segment.RLock()
+	sorted, version := e.Segment.IsSortedLocked(), e.Segment.SchemaVersion
+	e.Segment.RUnlock()
+	if !sorted {
+		e.RLock()
+		version = e.SchemaVersion
+		e.RUnlock()
+	}
+	return e.Segment.Table.Schema.Truncate(version)
+}
+
+// ExtendSchema makes the block store the columns of schema, a version
+// of the schema of the table. It is only called on the block being
+// appended, which fills the added columns of its rows with the default
+// values.
+func (e *Block) ExtendSchema(schema *Schema) {
+	e.Lock()
+	e.SchemaVersion = schema.Version
+	e.Unlock()
+	e.Segment.onBlockSchema(schema.Version)
+}
+
 // Safe
 func (e *Block) Less(o *Block) bool {
 	if e == nil {
@@ -329,12 +365,14 @@ func (e *Block) toLogEntry(info *CommitInfo) *blockLogEntry {
 	}
 	return &blockLogEntry{
 		BaseEntry: &BaseEntry{
-			Id: e.Id,
+			Id:         e.Id,
 			CommitInfo: info.Clone()},
-		Catalog:    e.Segment.Table.Database.Catalog,
-		DatabaseId: e.Segment.Table.Database.Id,
-		TableId:    e.Segment.Table.Id,
-		SegmentId:  e.Segment.Id,
+		Catalog:       e.Segment.Table.Database.Catalog,
+		DatabaseId:    e.Segment.Table.Database.Id,
+		TableId:       e.Segment.Table.Id,
+		SegmentId:     e.Segment.Id,
+		SchemaVersion: e.SchemaVersion,
+		Appends:       e.Appends,
 	}
 }
 
@@ -472,6 +472,10 @@ func (catalog *Catalog) onReplayTableEntry(entry *tableCheckpoint) error {
 			}
 		}
 	}
+	if entry.NeedReplay && len(entry.LogEntry.Deletes) > 0 {
+		db := catalog.Databases[entry.LogEntry.DatabaseId]
+		db.TableSet[entry.LogEntry.Table.Id].onReplayDeletes(entry.LogEntry.Deletes)
+	}
 	return nil
 }
 
@@ -631,6 +635,7 @@ func (catalog *Catalog) ToCatalogLogEntry() *catalogLogEntry {
 		if info != nil {
 			tableCkp.NeedReplay = true
 			tableCkp.LogEntry = tb.ToTableLogEntry(info)
+			tableCkp.LogEntry.Deletes = tb.deletesIn(check)
 		}
 		tb.RUnlock()
 		catalogCkp.Databases[tb.Database.Id].
@@ -957,10 +962,30 @@ func (catalog *Catalog) onReplayTableOperation(entry *tableLogEntry) error {
 	return tbl.onCommit(entry.CommitInfo)
 }
 
+func (catalog *Catalog) onReplayAlterTable(entry *tableLogEntry) error {
+	db := catalog.Databases[entry.DatabaseId]
+	tbl := db.TableSet[entry.Id]
+	tbl.Schema = entry.Table.Schema
+	return tbl.onCommit(entry.CommitInfo)
+}
+
+func (catalog *Catalog) onReplayDeleteRows(entry *tableLogEntry) error {
+	db := catalog.Databases[entry.DatabaseId]
+	tbl := db.TableSet[entry.Id]
+	if err := tbl.onCommit(entry.CommitInfo); err != nil {
+		return err
+	}
+	tbl.onReplayDeletes(entry.Deletes)
+	return nil
+}
+
 func (catalog *Catalog) onReplayTableCheckpoint(entry *tableLogEntry) error {
 	db := catalog.Databases[entry.DatabaseId]
 	tbl, ok := db.TableSet[entry.Table.Id]
 	if ok {
+		if entry.Table.Schema != nil {
+			tbl.Schema = entry.Table.Schema
+		}
 		return tbl.onCommit(entry.Table.CommitInfo)
 	}
 	tbl = NewEmptyTableEntry(db)
@@ -974,6 +999,8 @@ func (catalog *Catalog) onReplayCreateSegment(entry *segmentLogEntry) error {
 	db := catalog.Databases[entry.DatabaseId]
 	tbl := db.TableSet[entry.TableId]
 	seg := newCommittedSegmentEntry(tbl, entry.BaseEntry)
+	seg.SchemaVersion = entry.SchemaVersion
+	seg.MaxBlocks = entry.MaxBlocks
 	tbl.onNewSegment(seg)
 	return nil
 }
@@ -983,6 +1010,10 @@ func (catalog *Catalog) onReplayUpgradeSegment(entry *segmentLogEntry) error {
 	tbl := db.TableSet[entry.TableId]
 	pos := tbl.IdIndex[entry.Id]
 	seg := tbl.SegmentSet[pos]
+	seg.onBlockSchemaLocked(entry.SchemaVersion)
+	if entry.MaxBlocks != 0 {
+		seg.MaxBlocks = entry.MaxBlocks
+	}
 	return seg.onCommit(entry.CommitInfo)
 }
 
@@ -992,9 +1023,15 @@ func (catalog *Catalog) onReplaySegmentCheckpoint(entry *segmentLogEntry) error
 	pos, ok := tbl.IdIndex[entry.Id]
 	if ok {
 		seg := tbl.SegmentSet[pos]
+		seg.onBlockSchemaLocked(entry.SchemaVersion)
+		if entry.MaxBlocks != 0 {
+			seg.MaxBlocks = entry.MaxBlocks
+		}
 		return seg.onCommit(entry.CommitInfo)
 	}
 	seg := newCommittedSegmentEntry(tbl, entry.BaseEntry)
+	seg.SchemaVersion = entry.SchemaVersion
+	seg.MaxBlocks = entry.MaxBlocks
 	catalog.TryUpdateSegmentId(seg.Id)
 	tbl.onNewSegment(seg)
 	return nil
@@ -1006,6 +1043,7 @@ func (catalog *Catalog) onReplayCreateBlock(entry *blockLogEntry) error {
 	segpos := tbl.IdIndex[entry.SegmentId]
 	seg := tbl.SegmentSet[segpos]
 	blk := newCommittedBlockEntry(seg, entry.BaseEntry)
+	blk.SchemaVersion = entry.SchemaVersion
 	seg.onNewBlock(blk)
 	return nil
 }
@@ -1018,6 +1056,9 @@ func (catalog *Catalog) onReplayUpgradeBlock(entry *blockLogEntry) error {
 	blkpos := seg.IdIndex[entry.Id]
 	blk := seg.BlockSet[blkpos]
 	blk.IndiceMemo = nil
+	blk.SchemaVersion = entry.SchemaVersion
+	blk.Appends = entry.Appends
+	seg.onBlockSchemaLocked(entry.SchemaVersion)
 	return blk.onCommit(entry.CommitInfo)
 }
 
@@ -1030,9 +1071,14 @@ func (catalog *Catalog) onReplayBlockCheckpoint(entry *blockLogEntry) error {
 	if ok {
 		blk := seg.BlockSet[blkpos]
 		blk.IndiceMemo = nil
+		blk.SchemaVersion = entry.SchemaVersion
+		blk.Appends = entry.Appends
+		seg.onBlockSchemaLocked(entry.SchemaVersion)
 		return blk.onCommit(entry.CommitInfo)
 	}
 	blk := newCommittedBlockEntry(seg, entry.BaseEntry)
+	blk.SchemaVersion = entry.SchemaVersion
+	blk.Appends = entry.Appends
 	catalog.TryUpdateBlockId(blk.Id)
 	seg.onNewBlock(blk)
 	return nil
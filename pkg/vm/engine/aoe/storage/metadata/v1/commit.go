@@ -55,10 +55,16 @@ func (p *commitPipeline) prepare(ctx interface{}) (LogEntry, error) {
 		return v.table.prepareAddIndice(v)
 	case *dropIndiceCtx:
 		return v.table.prepareDropIndice(v)
+	case *alterTableCtx:
+		return v.table.prepareAlter(v)
+	case *deleteRowsCtx:
+		return v.table.prepareDeleteRows(v)
 	case *createSegmentCtx:
 		return v.table.prepareCreateSegment(v)
 	case *upgradeSegmentCtx:
 		return v.segment.prepareUpgrade(v)
+	case *closeSegmentCtx:
+		return v.segment.prepareClose(v)
 	case *createBlockCtx:
 		return v.segment.prepareCreateBlock(v)
 	case *upgradeBlockCtx:
@@ -14,6 +14,8 @@
 
 package metadata
 
+import "github.com/RoaringBitmap/roaring/roaring64"
+
 type writeCtx struct {
 	exIndex *LogIndex
 	tranId  uint64
@@ -77,6 +79,19 @@ type dropIndiceCtx struct {
 	names []string
 }
 
+type alterTableCtx struct {
+	writeCtx
+	table  *Table
+	schema *Schema
+}
+
+type deleteRowsCtx struct {
+	writeCtx
+	table *Table
+	rows  map[uint64]*roaring64.Bitmap
+	ts    int64
+}
+
 type createSegmentCtx struct {
 	writeCtx
 	segment *Segment
@@ -90,6 +105,11 @@ type upgradeSegmentCtx struct {
 	size     int64
 }
 
+type closeSegmentCtx struct {
+	writeCtx
+	segment *Segment
+}
+
 type createBlockCtx struct {
 	writeCtx
 	segment *Segment
@@ -161,6 +181,16 @@ func newUpgradeSegmentCtx(segment *Segment, size int64, exIndice []*LogIndex, tr
 	}
 }
 
+func newCloseSegmentCtx(segment *Segment, exIndex *LogIndex, tranId uint64) *closeSegmentCtx {
+	return &closeSegmentCtx{
+		writeCtx: writeCtx{
+			exIndex: exIndex,
+			tranId:  tranId,
+		},
+		segment: segment,
+	}
+}
+
 func newCreateBlockCtx(segment *Segment, tranId uint64) *createBlockCtx {
 	return &createBlockCtx{
 		writeCtx: writeCtx{
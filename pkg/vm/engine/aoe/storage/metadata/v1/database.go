@@ -178,6 +178,11 @@ func (db *Database) DebugCheckReplayedState() {
 			maxIndex = table.GetIdempotentIndex()
 		}
 	}
+	// The latest commit of a table, e.g. a delete of rows, can be newer
+	// than its latest append
+	if maxIndex == nil || (maxDDLIndex != nil && maxIndex.Compare(maxDDLIndex) < 0) {
+		maxIndex = maxDDLIndex
+	}
 	db.InitIdempotentIndex(maxDDLIndex)
 	db.InitMaxIndex(maxIndex)
 }
@@ -686,10 +691,12 @@ func (db *Database) SplitCheck(size, index uint64) (coarseSize uint64, coarseCou
 				activeSize = int64(0)
 			}
 		} else {
-			for i, segment := range table.SegmentSet {
+			maxRow := uint64(0)
+			for _, segment := range table.SegmentSet {
 				activeSize += segment.GetCoarseSize()
 				rangeSpec.CoarseSize += segment.GetCoarseSize()
-				rangeSpec.Range.Right = uint64(i+1)*table.Schema.BlockMaxRows*table.Schema.SegmentMaxBlocks - 1
+				maxRow += segment.maxBlocksOf(table.Schema) * table.Schema.BlockMaxRows
+				rangeSpec.Range.Right = maxRow - 1
 				if activeSize >= partSize {
 					currGroup++
 					activeSize = int64(0)
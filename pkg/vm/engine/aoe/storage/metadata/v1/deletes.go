@@ -0,0 +1,253 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+)
+
+// The rows of a segment are identified by their origin, which is made
+// of the index of the block they were appended to and of their offset
+// in it. Unlike the offset of a row in a block file, the origin does
+// not change when the rows are reordered on upgrades, so the rows
+// deleted from a segment are kept as origins, grouped by the operations
+// which deleted them, and mapped onto the rows of a block when it is
+// read.
+
+// MakeRowOrigin returns the origin of the row appended at offset to the
+// idx-th block of a segment.
+func MakeRowOrigin(idx uint32, offset uint64) uint64 {
+	return uint64(idx)<<32 | offset
+}
+
+// RowIdSize is the size of a row id, which is made of the shard id of
+// the tablet, the segment id and the origin of the row.
+const RowIdSize = 24
+
+// ErrEmptyRowId is returned when decoding the empty row id of a row not
+// written yet.
+var ErrEmptyRowId = errors.New("the row is not written yet")
+
+// EncodeRowId appends the row id of a row to buf and returns it.
+func EncodeRowId(buf []byte, shardId, segmentId, origin uint64) []byte {
+	var id [RowIdSize]byte
+	binary.BigEndian.PutUint64(id[:], shardId)
+	binary.BigEndian.PutUint64(id[8:], segmentId)
+	binary.BigEndian.PutUint64(id[16:], origin)
+	return append(buf, id[:]...)
+}
+
+// DecodeRowId returns the shard id, the segment id and the origin of the
+// row of the given row id.
+func DecodeRowId(id []byte) (uint64, uint64, uint64, error) {
+	switch len(id) {
+	case 0:
+		return 0, 0, 0, ErrEmptyRowId
+	case RowIdSize:
+	default:
+		return 0, 0, 0, fmt.Errorf("bad row id of %d bytes", len(id))
+	}
+	return binary.BigEndian.Uint64(id), binary.BigEndian.Uint64(id[8:]), binary.BigEndian.Uint64(id[16:]), nil
+}
+
+// RowsDelete is the rows of a segment deleted by an operation.
+type RowsDelete struct {
+	CommitId uint64
+	LogIndex *LogIndex
+	// Ts is the unix time in nanoseconds of the delete, zero if it is
+	// older than the history horizon of the table
+	Ts   int64
+	Rows *roaring64.Bitmap
+}
+
+type rowsDeleteEntry struct {
+	CommitId uint64    `json:"cid"`
+	LogIndex *LogIndex `json:"idx,omitempty"`
+	Ts       int64     `json:"ts,omitempty"`
+	Rows     []byte    `json:"rows"`
+}
+
+func (d *RowsDelete) MarshalJSON() ([]byte, error) {
+	rows, err := d.Rows.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&rowsDeleteEntry{
+		CommitId: d.CommitId,
+		LogIndex: d.LogIndex,
+		Ts:       d.Ts,
+		Rows:     rows,
+	})
+}
+
+func (d *RowsDelete) UnmarshalJSON(buf []byte) error {
+	entry := new(rowsDeleteEntry)
+	if err := json.Unmarshal(buf, entry); err != nil {
+		return err
+	}
+	d.CommitId, d.LogIndex, d.Ts = entry.CommitId, entry.LogIndex, entry.Ts
+	d.Rows = roaring64.New()
+	return d.Rows.UnmarshalBinary(entry.Rows)
+}
+
+func (d *RowsDelete) commitInfo() *CommitInfo {
+	return &CommitInfo{
+		CommitId: d.CommitId,
+		LogIndex: d.LogIndex,
+		Op:       OpDeleteRows,
+	}
+}
+
+// SegmentDeletes keeps the rows deleted from a segment. The operations
+// are never changed once added, those which cannot be replayed or read
+// as of anymore are folded into the first one.
+type SegmentDeletes struct {
+	sync.RWMutex
+	Ops  []*RowsDelete
+	rows *roaring64.Bitmap
+}
+
+func (d *SegmentDeletes) MarshalJSON() ([]byte, error) {
+	d.RLock()
+	defer d.RUnlock()
+	return json.Marshal(d.Ops)
+}
+
+func (d *SegmentDeletes) UnmarshalJSON(buf []byte) error {
+	if err := json.Unmarshal(buf, &d.Ops); err != nil {
+		return err
+	}
+	d.rows = roaring64.New()
+	for _, op := range d.Ops {
+		d.rows.Or(op.Rows)
+	}
+	return nil
+}
+
+// add adds op unless it was already added, folding the operations for
+// which foldable returns true.
+func (d *SegmentDeletes) add(op *RowsDelete, foldable func(*RowsDelete) bool) {
+	d.Lock()
+	defer d.Unlock()
+	for _, curr := range d.Ops {
+		if curr.CommitId == op.CommitId && !IsTransientCommitId(op.CommitId) {
+			return
+		}
+	}
+	var folded *RowsDelete
+	ops := make([]*RowsDelete, 0, len(d.Ops)+1)
+	for _, curr := range d.Ops {
+		if !foldable(curr) {
+			ops = append(ops, curr)
+			continue
+		}
+		if folded == nil {
+			folded = &RowsDelete{Rows: roaring64.New()}
+		}
+		folded.Rows.Or(curr.Rows)
+		if curr.CommitId > folded.CommitId {
+			folded.CommitId, folded.LogIndex = curr.CommitId, curr.LogIndex
+		}
+	}
+	if folded != nil && len(d.Ops)-len(ops) > 1 {
+		ops = append([]*RowsDelete{folded}, ops...)
+	} else {
+		ops = d.Ops
+	}
+	d.Ops = append(ops, op)
+	if d.rows == nil {
+		d.rows = roaring64.New()
+	}
+	d.rows.Or(op.Rows)
+}
+
+// commit sets the commit id of op once it is known.
+func (d *SegmentDeletes) commit(op *RowsDelete, id uint64) {
+	d.Lock()
+	defer d.Unlock()
+	op.CommitId = id
+}
+
+// Rows returns a copy of the origins of the deleted rows.
+func (d *SegmentDeletes) Rows() *roaring64.Bitmap {
+	d.RLock()
+	defer d.RUnlock()
+	if d.rows == nil {
+		return roaring64.New()
+	}
+	return d.rows.Clone()
+}
+
+// RowsAsOf returns the origins of the rows deleted at or before the
+// given unix time in nanoseconds, including those deleted before the
+// history horizon.
+func (d *SegmentDeletes) RowsAsOf(ts int64) *roaring64.Bitmap {
+	d.RLock()
+	defer d.RUnlock()
+	rows := roaring64.New()
+	for _, op := range d.Ops {
+		if op.Ts <= ts {
+			rows.Or(op.Rows)
+		}
+	}
+	return rows
+}
+
+// RowsOf returns the origins of the rows deleted by the operation of
+// the given index, nil if there is no such operation.
+func (d *SegmentDeletes) RowsOf(index *LogIndex) *roaring64.Bitmap {
+	d.RLock()
+	defer d.RUnlock()
+	for _, op := range d.Ops {
+		if op.LogIndex != nil && op.LogIndex.ShardId == index.ShardId && op.LogIndex.CompareID(index) == 0 {
+			return op.Rows.Clone()
+		}
+	}
+	return nil
+}
+
+// opsIn returns the committed operations for which check returns true.
+func (d *SegmentDeletes) opsIn(check func(*CommitInfo) bool) []*RowsDelete {
+	d.RLock()
+	defer d.RUnlock()
+	var ops []*RowsDelete
+	for _, op := range d.Ops {
+		info := op.commitInfo()
+		if info.HasCommitted() && check(info) {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+func (d *SegmentDeletes) view(filter *commitFilter) *SegmentDeletes {
+	ops := d.opsIn(func(info *CommitInfo) bool {
+		return filter.Eval(info) && !filter.EvalStop(info)
+	})
+	if len(ops) == 0 {
+		return nil
+	}
+	view := &SegmentDeletes{Ops: ops, rows: roaring64.New()}
+	for _, op := range ops {
+		view.rows.Or(op.Rows)
+	}
+	return view
+}
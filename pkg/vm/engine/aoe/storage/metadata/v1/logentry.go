@@ -44,6 +44,8 @@ const (
 	ETDatabaseSnapshot
 	ETDatabaseReplaced
 	ETTransaction
+	ETAlterTable
+	ETDeleteRows
 )
 
 type IEntry interface {
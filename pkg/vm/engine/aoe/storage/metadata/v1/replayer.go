@@ -169,6 +169,10 @@ func (cache *replayCache) applyReplayEntry(entry *replayEntry, catalog *Catalog,
 		err = catalog.onReplayCreateTable(entry.tblEntry)
 	case ETAddIndice, ETDropIndice, ETSoftDeleteTable, ETHardDeleteTable:
 		err = catalog.onReplayTableOperation(entry.tblEntry)
+	case ETAlterTable:
+		err = catalog.onReplayAlterTable(entry.tblEntry)
+	case ETDeleteRows:
+		err = catalog.onReplayDeleteRows(entry.tblEntry)
 	case ETCreateSegment:
 		catalog.Sequence.TryUpdateSegmentId(entry.segEntry.Id)
 		err = catalog.onReplayCreateSegment(entry.segEntry)
@@ -458,7 +462,7 @@ func (replayer *catalogReplayer) onReplayEntry(entry LogEntry, observer logstore
 			tblEntry: tbl,
 			commitId: GetCommitIdFromLogEntry(entry),
 		})
-	case ETAddIndice, ETDropIndice, ETSoftDeleteTable, ETHardDeleteTable:
+	case ETAddIndice, ETDropIndice, ETSoftDeleteTable, ETHardDeleteTable, ETAlterTable, ETDeleteRows:
 		tbl := &tableLogEntry{}
 		tbl.Unmarshal(entry.GetPayload())
 		replayer.cache.Append(&replayEntry{
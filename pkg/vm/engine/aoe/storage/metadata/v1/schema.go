@@ -17,9 +17,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"reflect"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 )
 
 type IndexT uint16
@@ -30,6 +33,23 @@ const (
 	FixStrBsi
 )
 
+// CompactionT is the policy deciding when the closed segments of a table
+// are merge-sorted.
+type CompactionT uint8
+
+const (
+	// DefaultCompaction follows the policy of the scheduler to merge-sort
+	// the segments, which all hold the max blocks
+	DefaultCompaction CompactionT = iota
+	// SizeTieredCompaction lays the segments out in tiers of 1, 2, 4...
+	// blocks up to the max and merge-sorts a segment once it has got the
+	// blocks of its tier
+	SizeTieredCompaction
+	// TimeWindowCompaction merge-sorts the segments closed in a time window
+	// together after the window is over
+	TimeWindowCompaction
+)
+
 type IndexInfo struct {
 	Id      uint64   `json:"id"`
 	Name    string   `json:"name"`
@@ -53,6 +73,57 @@ type ColDef struct {
 	Name string     `json:"name"`
 	Idx  int        `json:"idx"`
 	Type types.Type `json:"type"`
+	// Since is the version of the schema which added the column, the
+	// segments created before it don't store the column
+	Since uint32 `json:"since,omitempty"`
+	// Default is the encoded one row vector of the value of the column
+	// for the rows appended before it was added
+	Default []byte `json:"default,omitempty"`
+	// Dropped column keeps its position and is filled with zero values
+	Dropped bool `json:"dropped,omitempty"`
+}
+
+// DefaultVector returns the column of rows rows which were appended
+// before it was added. A dropped column is filled with zero values.
+func (c *ColDef) DefaultVector(rows int) (*vector.Vector, error) {
+	def := vector.New(c.Type)
+	if len(c.Default) > 0 && !c.Dropped {
+		if err := def.Read(c.Default); err != nil {
+			return nil, err
+		}
+	}
+	vec := vector.New(c.Type)
+	switch c.Type.Oid {
+	case types.T_char, types.T_varchar, types.T_json:
+		var v []byte
+		if vector.Length(def) > 0 {
+			v = def.Col.(*types.Bytes).Get(0)
+		}
+		col := make([][]byte, rows)
+		for i := range col {
+			col[i] = v
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	default:
+		v := reflect.ValueOf(def.Col)
+		col := reflect.MakeSlice(v.Type(), rows, rows)
+		if v.Len() > 0 {
+			for i := 0; i < rows; i++ {
+				col.Index(i).Set(v.Index(0))
+			}
+		}
+		if err := vector.Append(vec, col.Interface()); err != nil {
+			return nil, err
+		}
+	}
+	if nulls.Contains(def.Nsp, 0) {
+		for i := 0; i < rows; i++ {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	return vec, nil
 }
 
 type IndexSchema struct {
@@ -166,6 +237,16 @@ type Schema struct {
 	BlockMaxRows     uint64         `json:"blkrows"`
 	PrimaryKey       int            `json:"primarykey"`
 	SegmentMaxBlocks uint64         `json:"segblocks"`
+	// Retention is how many seconds the history of the rows is kept
+	// for reading the table as of a past time, 0 if it is not kept
+	Retention uint64 `json:"retention,omitempty"`
+	// Version is increased by every alter of the columns
+	Version uint32 `json:"version,omitempty"`
+	// Compaction overrides the compaction policy of the scheduler
+	Compaction CompactionT `json:"compaction,omitempty"`
+	// CompactionWindow is how many seconds the time window compaction
+	// waits for, 0 if it follows the scheduler
+	CompactionWindow uint64 `json:"cwindow,omitempty"`
 }
 
 func NewEmptySchema(name string) *Schema {
@@ -212,6 +293,9 @@ func (s *Schema) Valid() bool {
 		if idx != colDef.Idx {
 			return false
 		}
+		if colDef.Dropped {
+			continue
+		}
 		_, ok := names[colDef.Name]
 		if ok {
 			return false
@@ -221,6 +305,76 @@ func (s *Schema) Valid() bool {
 	return true
 }
 
+// ValidAlter checks that the schema only appends columns to the old
+// one and drops, renames or changes the default of its columns.
+func (s *Schema) ValidAlter(old *Schema) bool {
+	if !s.Valid() || len(s.ColDefs) < len(old.ColDefs) {
+		return false
+	}
+	for idx, colDef := range old.ColDefs {
+		newDef := s.ColDefs[idx]
+		if newDef.Type != colDef.Type {
+			return false
+		}
+		if colDef.Dropped && !newDef.Dropped {
+			return false
+		}
+	}
+	if s.ColDefs[old.PrimaryKey].Dropped {
+		return false
+	}
+	return true
+}
+
+// Alter returns the next version of the schema, with the columns of
+// schema. The columns keep the version and the default value with
+// which they were added, the new columns are added by the next version.
+// The next version is the version of schema if it is given, so that
+// the tablets of a table share the versions of its schema.
+func (s *Schema) Alter(schema *Schema) *Schema {
+	next := *s
+	next.Version = s.Version + 1
+	if schema.Version > next.Version {
+		next.Version = schema.Version
+	}
+	next.ColDefs = make([]*ColDef, len(schema.ColDefs))
+	next.NameIndex = make(map[string]int)
+	for idx, colDef := range schema.ColDefs {
+		def := *colDef
+		def.Idx = idx
+		if idx < len(s.ColDefs) {
+			def.Since = s.ColDefs[idx].Since
+			def.Default = s.ColDefs[idx].Default
+		} else {
+			def.Since = next.Version
+		}
+		next.ColDefs[idx] = &def
+		if !def.Dropped {
+			next.NameIndex[def.Name] = idx
+		}
+	}
+	return &next
+}
+
+// Truncate returns the columns of the schema of the given version.
+func (s *Schema) Truncate(version uint32) *Schema {
+	if version >= s.Version {
+		return s
+	}
+	schema := *s
+	schema.NameIndex = make(map[string]int)
+	for idx, colDef := range s.ColDefs {
+		if colDef.Since > version {
+			schema.ColDefs = s.ColDefs[:idx]
+			break
+		}
+		if !colDef.Dropped {
+			schema.NameIndex[colDef.Name] = idx
+		}
+	}
+	return &schema
+}
+
 // GetColIdx returns column index for the given column name
 // if found, otherwise returns -1.
 func (s *Schema) GetColIdx(attr string) int {
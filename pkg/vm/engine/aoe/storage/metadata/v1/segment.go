@@ -30,9 +30,11 @@ var (
 
 type segmentLogEntry struct {
 	*BaseEntry
-	DatabaseId uint64
-	TableId    uint64
-	Catalog    *Catalog `json:"-"`
+	DatabaseId    uint64
+	TableId       uint64
+	SchemaVersion uint32   `json:",omitempty"`
+	MaxBlocks     uint64   `json:",omitempty"`
+	Catalog       *Catalog `json:"-"`
 }
 
 func (e *segmentLogEntry) Marshal() ([]byte, error) {
@@ -48,13 +50,22 @@ type Segment struct {
 	Table    *Table         `json:"-"`
 	IdIndex  map[uint64]int `json:"-"`
 	BlockSet []*Block       `json:"blocks"`
+	// SchemaVersion is the latest version of the table schema whose
+	// columns are stored by the blocks of the segment
+	SchemaVersion uint32 `json:"schemaver,omitempty"`
+	// Deletes is the rows deleted from the segment, nil if none
+	Deletes *SegmentDeletes `json:"deletes,omitempty"`
+	// MaxBlocks is how many blocks the segment holds once full, 0 if it
+	// holds as many as the schema allows
+	MaxBlocks uint64 `json:"maxblks,omitempty"`
 }
 
 func newSegmentEntry(table *Table, tranId uint64, exIndex *LogIndex) *Segment {
 	e := &Segment{
-		Table:    table,
-		BlockSet: make([]*Block, 0),
-		IdIndex:  make(map[uint64]int),
+		Table:         table,
+		BlockSet:      make([]*Block, 0),
+		IdIndex:       make(map[uint64]int),
+		SchemaVersion: table.Schema.Version,
 		BaseEntry: &BaseEntry{
 			Id: table.Database.Catalog.NextSegmentId(),
 			CommitInfo: &CommitInfo{
@@ -131,15 +142,21 @@ func (e *Segment) fillView(filter *Filter) *Segment {
 		return nil
 	}
 	view := &Segment{
-		BaseEntry: baseEntry,
-		BlockSet:  make([]*Block, 0),
+		BaseEntry:     baseEntry,
+		BlockSet:      make([]*Block, 0),
+		SchemaVersion: e.SchemaVersion,
 	}
 	e.RLock()
+	view.MaxBlocks = e.MaxBlocks
 	blks := make([]*Block, 0, len(e.BlockSet))
 	for _, blk := range e.BlockSet {
 		blks = append(blks, blk)
 	}
+	deletes := e.Deletes
 	e.RUnlock()
+	if deletes != nil {
+		view.Deletes = deletes.view(filter.tableFilter)
+	}
 	for _, blk := range blks {
 		blkView := blk.fillView(filter)
 		if blkView == nil {
@@ -165,11 +182,35 @@ func (e *Segment) toLogEntry(info *CommitInfo) *segmentLogEntry {
 		BaseEntry: &BaseEntry{
 			Id: e.Id,
 			CommitInfo: info.Clone()},
-		TableId:    e.Table.Id,
-		DatabaseId: e.Table.Database.Id,
+		TableId:       e.Table.Id,
+		DatabaseId:    e.Table.Database.Id,
+		SchemaVersion: e.SchemaVersion,
+		MaxBlocks:     e.MaxBlocks,
+	}
+}
+
+// Schema returns the columns stored by the segment, which are the
+// columns stored by its last block.
+func (e *Segment) Schema() *Schema {
+	e.RLock()
+	version := e.SchemaVersion
+	e.RUnlock()
+	return e.Table.Schema.Truncate(version)
+}
+
+func (e *Segment) onBlockSchema(version uint32) {
+	e.Lock()
+	defer e.Unlock()
+	e.onBlockSchemaLocked(version)
+}
+
+func (e *Segment) onBlockSchemaLocked(version uint32) {
+	if e.SchemaVersion < version {
+		e.SchemaVersion = version
 	}
 }
 
+
 func (e *Segment) Unmarshal(buf []byte) error {
 	return json.Unmarshal(buf, e)
 }
@@ -269,11 +310,29 @@ func (e *Segment) MaxLogIndex() *LogIndex {
 	return index
 }
 
+// Safe
+func (e *Segment) GetDeletes() *SegmentDeletes {
+	e.RLock()
+	defer e.RUnlock()
+	return e.Deletes
+}
+
+func (e *Segment) onDeleteRows(op *RowsDelete, foldable func(*RowsDelete) bool) {
+	e.Lock()
+	if e.Deletes == nil {
+		e.Deletes = new(SegmentDeletes)
+	}
+	deletes := e.Deletes
+	e.Unlock()
+	deletes.add(op, foldable)
+}
+
 func (e *Segment) onNewBlock(entry *Block) {
 	idx := len(e.BlockSet)
 	e.IdIndex[entry.Id] = idx
 	entry.Idx = uint32(idx)
 	e.BlockSet = append(e.BlockSet, entry)
+	e.onBlockSchemaLocked(entry.SchemaVersion)
 }
 
 // Safe
@@ -315,7 +374,7 @@ func (e *Segment) IsUpgradableLocked() bool {
 	if e.IsSortedLocked() {
 		return false
 	}
-	if len(e.BlockSet) != int(e.Table.Schema.SegmentMaxBlocks) {
+	if len(e.BlockSet) != e.MaxBlockCountLocked() {
 		return false
 	}
 	for _, block := range e.BlockSet {
@@ -328,12 +387,34 @@ func (e *Segment) IsUpgradableLocked() bool {
 
 // Not safe
 func (e *Segment) HasMaxBlocks() bool {
-	return e.IsSortedLocked() || len(e.BlockSet) == int(e.Table.Schema.SegmentMaxBlocks)
+	return e.IsSortedLocked() || len(e.BlockSet) == e.MaxBlockCountLocked()
+}
+
+// MaxBlockCount returns how many blocks the segment holds once full,
+// fewer than the schema allows if it is in a lower tier or was closed
+// early.
+func (e *Segment) MaxBlockCount() int {
+	e.RLock()
+	defer e.RUnlock()
+	return e.MaxBlockCountLocked()
+}
+
+func (e *Segment) MaxBlockCountLocked() int {
+	return int(e.maxBlocksOf(e.Table.Schema))
+}
+
+// maxBlocksOf works like MaxBlockCountLocked for the views of the
+// segment, which are not linked to their table.
+func (e *Segment) maxBlocksOf(schema *Schema) uint64 {
+	if e.MaxBlocks != 0 {
+		return e.MaxBlocks
+	}
+	return schema.SegmentMaxBlocks
 }
 
 func (e *Segment) GetCoarseCountLocked() int64 {
 	if e.IsSortedLocked() {
-		return int64(e.Table.Schema.SegmentMaxBlocks * e.Table.Schema.BlockMaxRows)
+		return int64(uint64(len(e.BlockSet)) * e.Table.Schema.BlockMaxRows)
 	}
 	count := int64(0)
 	for _, block := range e.BlockSet {
@@ -394,7 +475,7 @@ func (e *Segment) prepareUpgrade(ctx *upgradeSegmentCtx) (LogEntry, error) {
 	defer e.Unlock()
 	var newOp OpT
 	switch e.CommitInfo.Op {
-	case OpCreate:
+	case OpCreate, OpUpgradeClose:
 		newOp = OpUpgradeSorted
 	default:
 		return nil, UpgradeNotNeededErr
@@ -415,6 +496,35 @@ func (e *Segment) prepareUpgrade(ctx *upgradeSegmentCtx) (LogEntry, error) {
 	return logEntry, nil
 }
 
+// SimpleClose closes the segment with the blocks it has got, so that it
+// is merge-sorted once they are full instead of waiting for the blocks
+// it lacks. The next blocks are created in a new segment.
+func (e *Segment) SimpleClose(exIndex *LogIndex) error {
+	tranId := e.Table.Database.Catalog.NextUncommitId()
+	ctx := newCloseSegmentCtx(e, exIndex, tranId)
+	return e.Table.Database.Catalog.onCommitRequest(ctx, true)
+}
+
+func (e *Segment) prepareClose(ctx *closeSegmentCtx) (LogEntry, error) {
+	e.Lock()
+	defer e.Unlock()
+	if e.HasMaxBlocks() || len(e.BlockSet) == 0 {
+		return nil, UpgradeNotNeededErr
+	}
+	cInfo := &CommitInfo{
+		TranId:   ctx.tranId,
+		CommitId: ctx.tranId,
+		Op:       OpUpgradeClose,
+		LogIndex: ctx.exIndex,
+	}
+	if err := e.onCommit(cInfo); err != nil {
+		return nil, err
+	}
+	e.MaxBlocks = uint64(len(e.BlockSet))
+	logEntry := e.Table.Database.Catalog.prepareCommitEntry(e, ETUpgradeSegment, e)
+	return logEntry, nil
+}
+
 func (e *Segment) DryUpgrade(size int64) {
 	e.CommitInfo.Op = OpUpgradeSorted
 	e.CommitInfo.Size = size
@@ -468,8 +578,8 @@ func (e *Segment) GetRowCount() uint64 {
 }
 
 func (e *Segment) GetRowCountLocked() uint64 {
-	if e.CommitInfo.Op >= OpUpgradeClose {
-		return e.Table.Schema.BlockMaxRows * e.Table.Schema.SegmentMaxBlocks
+	if e.CommitInfo.Op >= OpUpgradeSorted {
+		return e.Table.Schema.BlockMaxRows * uint64(len(e.BlockSet))
 	}
 	var ret uint64
 	e.RLock()
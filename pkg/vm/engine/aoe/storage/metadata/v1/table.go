@@ -20,6 +20,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/logstore"
@@ -30,6 +31,8 @@ type tableLogEntry struct {
 	Table      *Table
 	DatabaseId uint64
 	*BaseEntry
+	// Deletes maps the id of a segment to the operations deleting its rows
+	Deletes map[uint64][]*RowsDelete `json:",omitempty"`
 }
 
 func (e *tableLogEntry) Marshal() ([]byte, error) {
@@ -52,8 +55,12 @@ type Table struct {
 }
 
 func NewTableEntry(db *Database, schema *Schema, indice *IndexSchema, tranId uint64, exIndex *LogIndex) *Table {
-	schema.BlockMaxRows = db.Catalog.Cfg.BlockMaxRows
-	schema.SegmentMaxBlocks = db.Catalog.Cfg.SegmentMaxBlocks
+	if schema.BlockMaxRows == 0 {
+		schema.BlockMaxRows = db.Catalog.Cfg.BlockMaxRows
+	}
+	if schema.SegmentMaxBlocks == 0 {
+		schema.SegmentMaxBlocks = db.Catalog.Cfg.SegmentMaxBlocks
+	}
 	if indice == nil {
 		indice = NewIndexSchema()
 	}
@@ -435,6 +442,189 @@ func (e *Table) prepareDropIndice(ctx *dropIndiceCtx) (LogEntry, error) {
 	return logEntry, nil
 }
 
+// SimpleAlter replaces the columns of the table with those of schema,
+// which can only append, drop or rename the columns. The existing
+// segments keep storing the columns of their version of the schema.
+// It fails with IdempotenceErr if the version of schema is given and
+// the table is already at that version.
+func (e *Table) SimpleAlter(schema *Schema, index *LogIndex) error {
+	tranId := e.Database.Catalog.NextUncommitId()
+	ctx := new(alterTableCtx)
+	ctx.tranId = tranId
+	ctx.table = e
+	ctx.schema = schema
+	ctx.exIndex = index
+	return e.Database.Catalog.onCommitRequest(ctx, true)
+}
+
+func (e *Table) prepareAlter(ctx *alterTableCtx) (LogEntry, error) {
+	cInfo := &CommitInfo{
+		TranId:   ctx.tranId,
+		CommitId: ctx.tranId,
+		LogIndex: ctx.exIndex,
+		Op:       OpAlterTable,
+		SSLLNode: *common.NewSSLLNode(),
+	}
+	e.Lock()
+	if e.IsDeletedInTxnLocked(ctx.txn) {
+		e.Unlock()
+		return nil, TableNotFoundErr
+	}
+	if ctx.schema.Version != 0 && ctx.schema.Version <= e.Schema.Version {
+		e.Unlock()
+		return nil, IdempotenceErr
+	}
+	if !ctx.schema.ValidAlter(e.Schema) {
+		e.Unlock()
+		return nil, InvalidSchemaErr
+	}
+	cInfo.Indice = e.CommitInfo.Indice
+	err := e.onCommit(cInfo)
+	if err == nil {
+		e.Schema = e.Schema.Alter(ctx.schema)
+	}
+	e.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	if ctx.inTran {
+		ctx.txn.AddEntry(e, ETAlterTable)
+		return nil, nil
+	}
+	logEntry := e.Database.Catalog.prepareCommitEntry(e, ETAlterTable, nil)
+	return logEntry, nil
+}
+
+// SimpleDeleteRows marks rows of the segments of the table as deleted at
+// the given unix time in nanoseconds, rows maps the id of a segment to
+// the origins of its deleted rows.
+func (e *Table) SimpleDeleteRows(rows map[uint64]*roaring64.Bitmap, ts int64, index *LogIndex) error {
+	tranId := e.Database.Catalog.NextUncommitId()
+	ctx := new(deleteRowsCtx)
+	ctx.tranId = tranId
+	ctx.table = e
+	ctx.rows = rows
+	ctx.ts = ts
+	ctx.exIndex = index
+	return e.Database.Catalog.onCommitRequest(ctx, true)
+}
+
+func (e *Table) prepareDeleteRows(ctx *deleteRowsCtx) (LogEntry, error) {
+	cInfo := &CommitInfo{
+		TranId:   ctx.tranId,
+		CommitId: ctx.tranId,
+		LogIndex: ctx.exIndex,
+		Op:       OpDeleteRows,
+		SSLLNode: *common.NewSSLLNode(),
+	}
+	e.Lock()
+	if e.IsDeletedLocked() {
+		e.Unlock()
+		return nil, TableNotFoundErr
+	}
+	for id := range ctx.rows {
+		if _, ok := e.IdIndex[id]; !ok {
+			e.Unlock()
+			return nil, SegmentNotFoundErr
+		}
+	}
+	cInfo.Indice = e.CommitInfo.Indice
+	err := e.onCommit(cInfo)
+	entry := &deleteRowsEntry{Table: e, ops: make(map[uint64]*RowsDelete)}
+	if err == nil {
+		for id, rows := range ctx.rows {
+			op := &RowsDelete{
+				CommitId: ctx.tranId,
+				LogIndex: ctx.exIndex,
+				Ts:       ctx.ts,
+				Rows:     rows,
+			}
+			e.SegmentSet[e.IdIndex[id]].onDeleteRows(op, e.foldableLocked)
+			entry.ops[id] = op
+		}
+	}
+	e.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	logEntry := e.Database.Catalog.prepareCommitEntry(entry, ETDeleteRows, nil)
+	return logEntry, nil
+}
+
+// foldableLocked returns true if the rows deleted by op can never be
+// replayed nor read as of again.
+func (e *Table) foldableLocked(op *RowsDelete) bool {
+	if !op.commitInfo().HasCommitted() {
+		return false
+	}
+	if e.Database.Catalog.IndexWal != nil && op.LogIndex != nil &&
+		op.LogIndex.Id.Id > e.Database.GetCheckpointId() {
+		return false
+	}
+	return e.Schema.Retention == 0 || op.Ts < e.HistoryHorizon()
+}
+
+// deletesIn returns the operations deleting the rows of the segments of
+// the table for which check returns true.
+func (e *Table) deletesIn(check func(*CommitInfo) bool) map[uint64][]*RowsDelete {
+	var deletes map[uint64][]*RowsDelete
+	for _, segment := range e.SegmentSet {
+		segDeletes := segment.GetDeletes()
+		if segDeletes == nil {
+			continue
+		}
+		if ops := segDeletes.opsIn(check); len(ops) > 0 {
+			if deletes == nil {
+				deletes = make(map[uint64][]*RowsDelete)
+			}
+			deletes[segment.Id] = ops
+		}
+	}
+	return deletes
+}
+
+func (e *Table) onReplayDeletes(deletes map[uint64][]*RowsDelete) {
+	e.RLock()
+	defer e.RUnlock()
+	for id, ops := range deletes {
+		pos, ok := e.IdIndex[id]
+		if !ok {
+			continue
+		}
+		for _, op := range ops {
+			e.SegmentSet[pos].onDeleteRows(op, e.foldableLocked)
+		}
+	}
+}
+
+// deleteRowsEntry logs the rows deleted from the segments of a table
+type deleteRowsEntry struct {
+	*Table
+	ops map[uint64]*RowsDelete
+}
+
+func (e *deleteRowsEntry) ToLogEntry(eType LogEntryType) LogEntry {
+	if eType != ETDeleteRows {
+		panic(fmt.Sprintf("not supported: %d", eType))
+	}
+	entry := tableLogEntry{
+		BaseEntry:  e.BaseEntry,
+		DatabaseId: e.Database.Id,
+		Deletes:    make(map[uint64][]*RowsDelete),
+	}
+	for id, op := range e.ops {
+		e.SegmentSet[e.IdIndex[id]].GetDeletes().commit(op, e.CommitInfo.CommitId)
+		entry.Deletes[id] = []*RowsDelete{op}
+	}
+	buf, _ := entry.Marshal()
+	logEntry := logstore.NewAsyncBaseEntry()
+	logEntry.Meta.SetType(eType)
+	logEntry.Unmarshal(buf)
+	return logEntry
+}
+
 // Not safe
 func (e *Table) Marshal() ([]byte, error) {
 	return json.Marshal(e)
@@ -481,6 +671,13 @@ func (e *Table) ToLogEntry(eType LogEntryType) LogEntry {
 			DatabaseId: e.Database.Id,
 		}
 		buf, _ = entry.Marshal()
+	case ETAlterTable:
+		entry := tableLogEntry{
+			BaseEntry:  e.BaseEntry,
+			DatabaseId: e.Database.Id,
+			Table:      &Table{Schema: e.Schema},
+		}
+		buf, _ = entry.Marshal()
 	case ETSoftDeleteTable:
 		if !e.IsSoftDeletedLocked() {
 			panic("logic error")
@@ -620,6 +817,9 @@ func (e *Table) SimpleGetSegmentCount() int {
 
 func (e *Table) prepareCreateSegment(ctx *createSegmentCtx) (LogEntry, error) {
 	se := newSegmentEntry(e, ctx.tranId, ctx.exIndex)
+	e.RLock()
+	se.MaxBlocks = e.nextSegmentTierLocked()
+	e.RUnlock()
 	logEntry := se.ToLogEntry(ETCreateSegment)
 	e.Lock()
 	e.onNewSegment(se)
@@ -629,6 +829,26 @@ func (e *Table) prepareCreateSegment(ctx *createSegmentCtx) (LogEntry, error) {
 	return logEntry, nil
 }
 
+// nextSegmentTierLocked returns how many blocks the next segment of the
+// table holds, 0 if as many as the schema allows. The segments of a
+// size-tiered table hold 1, 2, 4... blocks up to the max, so that a small
+// table gets merge-sorted segments without waiting for a full one. The
+// tiers only depend on the schema, so every replica lays the rows out
+// the same way.
+func (e *Table) nextSegmentTierLocked() uint64 {
+	if e.Schema.Compaction != SizeTieredCompaction {
+		return 0
+	}
+	tier := uint64(1)
+	for i := 0; i < len(e.SegmentSet) && tier < e.Schema.SegmentMaxBlocks; i++ {
+		tier <<= 1
+	}
+	if tier >= e.Schema.SegmentMaxBlocks {
+		return 0
+	}
+	return tier
+}
+
 func (e *Table) onNewSegment(entry *Segment) {
 	e.IdIndex[entry.Id] = len(e.SegmentSet)
 	e.SegmentSet = append(e.SegmentSet, entry)
@@ -682,12 +902,13 @@ func (e *Table) Splite(catalog *Catalog, tranId uint64, splitSpec *TableSplitSpe
 	}
 	idx := 0
 	spec := specs[idx]
-	for i, segment := range e.SegmentSet {
-		minRow := uint64(i) * e.Schema.BlockMaxRows * e.Schema.SegmentMaxBlocks
+	minRow := uint64(0)
+	for _, segment := range e.SegmentSet {
 		if spec.Range.LT(minRow) {
 			idx++
 			spec = specs[idx]
 		}
+		minRow += segment.maxBlocksOf(e.Schema) * e.Schema.BlockMaxRows
 		osid := &common.ID{
 			TableID:   e.Id,
 			SegmentID: segment.Id,
@@ -60,6 +60,8 @@ const (
 	OpUpgradeSorted
 	OpAddIndice
 	OpDropIndice
+	OpAlterTable
+	OpDeleteRows
 	OpSoftDelete
 	OpReplaced
 	OpHardDelete
@@ -75,6 +77,8 @@ var OpNames = map[OpT]string{
 	OpHardDelete:    "HardDelete",
 	OpAddIndice:     "AddIndice",
 	OpDropIndice:    "DropIndice",
+	OpAlterTable:    "AlterTable",
+	OpDeleteRows:    "DeleteRows",
 }
 
 func OpName(op OpT) string {
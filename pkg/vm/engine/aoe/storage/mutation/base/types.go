@@ -42,4 +42,5 @@ type IMutableBlock interface {
 	GetMeta() *metadata.Block
 	SetStale()
 	Flush() error
+	Extend() error
 }
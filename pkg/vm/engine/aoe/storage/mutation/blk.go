@@ -83,7 +83,7 @@ type MutableBlockNode struct {
 }
 
 func NewMutableBlockNode(mgr base.INodeManager, file *dataio.TransientBlockFile,
-	tabledata iface.ITableData, meta *metadata.Block, flusher mb.BlockFlusher, initSize uint64) *MutableBlockNode {
+	tabledata iface.ITableData, meta *metadata.Block, flusher mb.BlockFlusher, initSize uint64) (*MutableBlockNode, error) {
 	if flusher == nil {
 		t := blockFlusher{}
 		flusher = t.flush
@@ -96,12 +96,14 @@ func NewMutableBlockNode(mgr base.INodeManager, file *dataio.TransientBlockFile,
 		Stale:     new(atomic.Value),
 		flushCond: newFlushCond(),
 	}
-	n.File.InitMeta(meta)
+	if err := n.File.InitMeta(meta); err != nil {
+		return nil, err
+	}
 	n.Node = *buffer.NewNode(n, mgr, *meta.AsCommonID(), initSize)
 	n.UnloadFunc = n.unload
 	n.LoadFunc = n.load
 	n.DestroyFunc = n.destroy
-	return n
+	return n, nil
 }
 
 func (n *MutableBlockNode) SetStale() {
@@ -129,11 +131,11 @@ func (n *MutableBlockNode) Flush() error {
 		return nil
 	}
 	defer n.flushCond.Release(currSize)
-	cols := len(n.Meta.Segment.Table.Schema.ColDefs)
+	cols := len(n.Data.GetAttrs())
 	attrs := make([]int, cols)
 	vecs := make([]vector.IVector, cols)
 	var err error
-	for i, _ := range n.Meta.Segment.Table.Schema.ColDefs {
+	for i := 0; i < cols; i++ {
 		attrs[i] = i
 		vec, err := n.Data.GetVectorByAttr(i)
 		if err != nil {
@@ -198,6 +200,48 @@ func (n *MutableBlockNode) unload() {
 	}
 }
 
+// Extend adds the columns added to the table since the block was
+// created, the rows of the block get their default values.
+func (n *MutableBlockNode) Extend() error {
+	n.Lock()
+	defer n.Unlock()
+	schema := n.Meta.Segment.Table.Schema
+	attrs := n.Data.GetAttrs()
+	if len(attrs) >= len(schema.ColDefs) {
+		return nil
+	}
+	rows := n.Data.Length()
+	cols := make([]int, len(schema.ColDefs))
+	vecs := make([]vector.IVector, len(schema.ColDefs))
+	for i, colDef := range schema.ColDefs {
+		cols[i] = i
+		if i < len(attrs) {
+			vec, err := n.Data.GetVectorByAttr(i)
+			if err != nil {
+				return err
+			}
+			vecs[i] = vec
+			continue
+		}
+		def, err := colDef.DefaultVector(rows)
+		if err != nil {
+			return err
+		}
+		vec := vector.NewVector(colDef.Type, schema.BlockMaxRows)
+		if _, err = vec.AppendVector(def, 0); err != nil {
+			return err
+		}
+		vecs[i] = vec
+	}
+	data, err := batch.NewBatch(cols, vecs)
+	if err != nil {
+		return err
+	}
+	n.Meta.ExtendSchema(schema)
+	n.Data = data
+	return nil
+}
+
 func (n *MutableBlockNode) GetData() batch.IBatch {
 	return n.Data
 }
@@ -83,7 +83,8 @@ func TestMutableBlockNode(t *testing.T) {
 	maxsize := uint64(140)
 	evicter := bm.NewSimpleEvictHolder()
 	mgr := buffer.NewNodeManager(maxsize, evicter)
-	node1 := NewMutableBlockNode(mgr, tblkfile, tabledata, meta1, nil, uint64(0))
+	node1, err := NewMutableBlockNode(mgr, tblkfile, tabledata, meta1, nil, uint64(0))
+	assert.Nil(t, err)
 	mgr.RegisterNode(node1)
 	h1 := mgr.Pin(node1)
 	assert.NotNil(t, h1)
@@ -117,7 +118,8 @@ func TestMutableBlockNode(t *testing.T) {
 	blkmeta2, err := tablemeta.SimpleGetBlock(uint64(1), uint64(2))
 	assert.Nil(t, err)
 	tblkfile2 := dataio.NewTBlockFile(segfile, *meta2.AsCommonID())
-	node2 := NewMutableBlockNode(mgr, tblkfile2, tabledata, blkmeta2, nil, uint64(0))
+	node2, err := NewMutableBlockNode(mgr, tblkfile2, tabledata, blkmeta2, nil, uint64(0))
+	assert.Nil(t, err)
 	mgr.RegisterNode(node2)
 	h2 := mgr.Pin(node2)
 	assert.NotNil(t, h2)
@@ -40,6 +40,8 @@ const (
 	DefaultBlockWriters     = uint16(8)
 	DefaultSegmentWriters   = uint16(4)
 	DefaultStatelessWorkers = uint16(1)
+
+	DefaultCompactionWindow = 10 * time.Minute
 )
 
 type IterOptions struct {
@@ -65,6 +67,11 @@ type SchedulerCfg struct {
 	BlockWriters     uint16 `toml:"block-writers"`
 	SegmentWriters   uint16 `toml:"segment-writers"`
 	StatelessWorkers uint16 `toml:"stateless-workers"`
+	// Compaction is the policy deciding when the closed segments are
+	// merge-sorted, a table can override it
+	Compaction metadata.CompactionT `toml:"compaction"`
+	// CompactionWindow is the window of the time window compaction
+	CompactionWindow time.Duration `toml:"compaction-window"`
 }
 
 type MetaCleanerCfg struct {
@@ -113,6 +120,8 @@ func (o *Options) FillDefaults(dirname string) *Options {
 			BlockWriters:     DefaultBlockWriters,
 			SegmentWriters:   DefaultSegmentWriters,
 			StatelessWorkers: DefaultStatelessWorkers,
+			Compaction:       metadata.SizeTieredCompaction,
+			CompactionWindow: DefaultCompactionWindow,
 		}
 	} else {
 		if o.SchedulerCfg.BlockWriters == 0 {
@@ -124,6 +133,12 @@ func (o *Options) FillDefaults(dirname string) *Options {
 		if o.SchedulerCfg.StatelessWorkers == 0 {
 			o.SchedulerCfg.StatelessWorkers = DefaultStatelessWorkers
 		}
+		if o.SchedulerCfg.Compaction == metadata.DefaultCompaction {
+			o.SchedulerCfg.Compaction = metadata.SizeTieredCompaction
+		}
+		if o.SchedulerCfg.CompactionWindow == 0 {
+			o.SchedulerCfg.CompactionWindow = DefaultCompactionWindow
+		}
 	}
 
 	if o.Meta.Conf == nil {
@@ -16,11 +16,15 @@ type Segment interface {
 }
 
 type Block interface {
-	engine.Statistics
+	// Rows returns the number of the rows which are not deleted
+	Rows() (int64, error)
+	Size(string) int64
 
 	ID() string
 	Prefetch([]string)
 	Read([]uint64, []string, []*bytes.Buffer, []*bytes.Buffer) (*batch.Batch, error) // read only arguments
+	// ReadAsOf reads the block as it was at the given unix time in nanoseconds
+	ReadAsOf(int64, []uint64, []string, []*bytes.Buffer, []*bytes.Buffer) (*batch.Batch, error)
 }
 
 type Store interface {
@@ -36,4 +40,6 @@ type SparseFilter interface {
 	Gt(string, interface{}) ([]string, error)
 	Ge(string, interface{}) ([]string, error)
 	Btw(string, interface{}, interface{}) ([]string, error)
+	In(string, []interface{}) ([]string, error)
+	NotIn(string, []interface{}) ([]string, error)
 }
\ No newline at end of file
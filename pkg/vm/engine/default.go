@@ -0,0 +1,359 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// DefaultVector returns a vector of n times the default value of the
+// attribute, it materialises an added attribute for the tuples written
+// before it. An attribute without default value gets the zero value of
+// its type, as mysql does for a NOT NULL column.
+func DefaultVector(attr Attribute, n int) (*vector.Vector, error) {
+	vec := vector.New(attr.Type)
+	def := attr.Default
+	if def.Exist && def.IsNull {
+		for i := 0; i < n; i++ {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+	}
+	var v interface{}
+	if def.Exist && !def.IsNull {
+		v = def.Value
+	}
+	var err error
+	switch attr.Type.Oid {
+	case types.T_int8:
+		x, err := defaultInt(v)
+		if err != nil {
+			return nil, err
+		}
+		col := make([]int8, n)
+		for i := range col {
+			col[i] = int8(x)
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_int16:
+		x, err := defaultInt(v)
+		if err != nil {
+			return nil, err
+		}
+		col := make([]int16, n)
+		for i := range col {
+			col[i] = int16(x)
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_int32:
+		x, err := defaultInt(v)
+		if err != nil {
+			return nil, err
+		}
+		col := make([]int32, n)
+		for i := range col {
+			col[i] = int32(x)
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_int64:
+		x, err := defaultInt(v)
+		if err != nil {
+			return nil, err
+		}
+		col := make([]int64, n)
+		for i := range col {
+			col[i] = x
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_uint8:
+		x, err := defaultUint(v)
+		if err != nil {
+			return nil, err
+		}
+		col := make([]uint8, n)
+		for i := range col {
+			col[i] = uint8(x)
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_uint16:
+		x, err := defaultUint(v)
+		if err != nil {
+			return nil, err
+		}
+		col := make([]uint16, n)
+		for i := range col {
+			col[i] = uint16(x)
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_uint32:
+		x, err := defaultUint(v)
+		if err != nil {
+			return nil, err
+		}
+		col := make([]uint32, n)
+		for i := range col {
+			col[i] = uint32(x)
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_uint64:
+		x, err := defaultUint(v)
+		if err != nil {
+			return nil, err
+		}
+		col := make([]uint64, n)
+		for i := range col {
+			col[i] = x
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_float32:
+		x, err := defaultFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		col := make([]float32, n)
+		for i := range col {
+			col[i] = float32(x)
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_float64:
+		x, err := defaultFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		col := make([]float64, n)
+		for i := range col {
+			col[i] = x
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_decimal64:
+		var x types.Decimal64
+		switch s := v.(type) {
+		case string:
+			if x, err = types.ParseStringToDecimal64(s, attr.Type.Width, attr.Type.Precision); err != nil {
+				return nil, err
+			}
+		case types.Decimal64:
+			x = s
+		}
+		col := make([]types.Decimal64, n)
+		for i := range col {
+			col[i] = x
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_decimal128:
+		var x types.Decimal128
+		switch s := v.(type) {
+		case string:
+			if x, err = types.ParseStringToDecimal128(s, attr.Type.Width, attr.Type.Precision); err != nil {
+				return nil, err
+			}
+		case types.Decimal128:
+			x = s
+		}
+		col := make([]types.Decimal128, n)
+		for i := range col {
+			col[i] = x
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_date:
+		var x types.Date
+		if s, ok := v.(string); ok {
+			if x, err = types.ParseDate(s); err != nil {
+				return nil, err
+			}
+		} else {
+			y, err := defaultInt(v)
+			if err != nil {
+				return nil, err
+			}
+			x = types.Date(y)
+		}
+		col := make([]types.Date, n)
+		for i := range col {
+			col[i] = x
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_datetime:
+		var x types.Datetime
+		if s, ok := v.(string); ok {
+			if x, err = types.ParseDatetime(s); err != nil {
+				return nil, err
+			}
+		} else {
+			y, err := defaultInt(v)
+			if err != nil {
+				return nil, err
+			}
+			x = types.Datetime(y)
+		}
+		col := make([]types.Datetime, n)
+		for i := range col {
+			col[i] = x
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_time:
+		var x types.Time
+		if s, ok := v.(string); ok {
+			if x, err = types.ParseTime(s); err != nil {
+				return nil, err
+			}
+		} else {
+			y, err := defaultInt(v)
+			if err != nil {
+				return nil, err
+			}
+			x = types.Time(y)
+		}
+		col := make([]types.Time, n)
+		for i := range col {
+			col[i] = x
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_timestamp:
+		// the literal of a timestamp default is in the time zone of the server here
+		var x types.Timestamp
+		if s, ok := v.(string); ok {
+			if x, err = types.ParseTimestamp(s, time.Local); err != nil {
+				return nil, err
+			}
+		} else {
+			y, err := defaultInt(v)
+			if err != nil {
+				return nil, err
+			}
+			x = types.Timestamp(y)
+		}
+		col := make([]types.Timestamp, n)
+		for i := range col {
+			col[i] = x
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	case types.T_char, types.T_varchar, types.T_json:
+		var x []byte
+		if s, ok := v.(string); ok {
+			x = []byte(s)
+		}
+		col := make([][]byte, n)
+		for i := range col {
+			col[i] = x
+		}
+		if err := vector.Append(vec, col); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported default value of type %s", attr.Type)
+	}
+	return vec, nil
+}
+
+// the default values decoded from json are float64
+func defaultInt(v interface{}) (int64, error) {
+	switch x := v.(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		return x, nil
+	case uint64:
+		return int64(x), nil
+	case float64:
+		return int64(x), nil
+	case float32:
+		return int64(x), nil
+	case types.Date:
+		return int64(x), nil
+	case types.Datetime:
+		return int64(x), nil
+	case types.Time:
+		return int64(x), nil
+	case types.Timestamp:
+		return int64(x), nil
+	case string:
+		return strconv.ParseInt(x, 10, 64)
+	}
+	return 0, fmt.Errorf("unexpected default value %v", v)
+}
+
+func defaultUint(v interface{}) (uint64, error) {
+	switch x := v.(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		return uint64(x), nil
+	case uint64:
+		return x, nil
+	case float64:
+		return uint64(x), nil
+	case float32:
+		return uint64(x), nil
+	case string:
+		return strconv.ParseUint(x, 10, 64)
+	}
+	return 0, fmt.Errorf("unexpected default value %v", v)
+}
+
+func defaultFloat(v interface{}) (float64, error) {
+	switch x := v.(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		return float64(x), nil
+	case uint64:
+		return float64(x), nil
+	case float64:
+		return x, nil
+	case float32:
+		return float64(x), nil
+	case string:
+		return strconv.ParseFloat(x, 64)
+	}
+	return 0, fmt.Errorf("unexpected default value %v", v)
+}
@@ -31,14 +31,20 @@ func (node *Attribute) Format(buf *bytes.Buffer) {
 	buf.WriteString("`")
 
 	buf.WriteByte(' ')
-	buf.WriteString(node.Type.String())
+	switch node.Type.Oid {
+	case types.T_decimal64, types.T_decimal128:
+		buf.WriteString("DECIMAL")
+	default:
+		buf.WriteString(node.Type.String())
+	}
 
-	if node.Type.Width > 0 && node.Type.Precision > 0 {
+	if node.Type.Oid == types.T_decimal64 || node.Type.Oid == types.T_decimal128 || (node.Type.Width > 0 && node.Type.Precision > 0) {
 		buf.WriteString("(")
 		str := fmt.Sprintf("%d", node.Type.Width)
 		buf.WriteString(str)
 		buf.WriteString(", ")
 		str = fmt.Sprintf("%d", node.Type.Precision)
+		buf.WriteString(str)
 		buf.WriteByte(')')
 	} else if node.Type.Width > 0 {
 		buf.WriteString("(")
@@ -104,7 +110,7 @@ func makeVal2Str(typ types.Type, value interface{}, isNull bool) string {
 		res := value.(float64)
 		str := strconv.FormatFloat(res, 'f', 10, 64)
 		return str
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_decimal64, types.T_decimal128:
 		res := value.(string)
 		return res
 	case types.T_date:
@@ -47,3 +47,24 @@ func (d *database) Create(_ uint64, name string, defs []engine.TableDef) error {
 	}
 	return d.db.Set(name, data)
 }
+
+func (d *database) Rename(_ uint64, name, newName string) error {
+	var md meta.Metadata
+	var buf bytes.Buffer
+
+	data, err := d.db.Get(name, &buf)
+	if err != nil {
+		return err
+	}
+	if err := encoding.Decode(data, &md); err != nil {
+		return err
+	}
+	for i := 0; i < int(md.Segs); i++ {
+		for _, attr := range md.Attrs {
+			if err := move(d.db, sKey(i, name)+"."+attr.Name, sKey(i, newName)+"."+attr.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return move(d.db, name, newName)
+}
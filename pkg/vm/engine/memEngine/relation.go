@@ -1,11 +1,14 @@
 package memEngine
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/kv"
 
 	"github.com/pierrec/lz4"
 )
@@ -90,35 +93,104 @@ func (r *relation) NewReader(n int) []engine.Reader {
 }
 
 func (r *relation) Write(_ uint64, bat *batch.Batch) error {
-	key := sKey(int(r.md.Segs), r.id)
-	for i, attr := range bat.Attrs {
-		v, err := bat.Vecs[i].Show()
+	if err := r.writeSegment(sKey(int(r.md.Segs), r.id), bat); err != nil {
+		return err
+	}
+	r.md.Segs++
+	return r.saveMetadata()
+}
+
+// Delete rewrites every segment holding tuples equal to the ones of bat
+func (r *relation) Delete(_ uint64, bat *batch.Batch) error {
+	keys, err := batch.RowKeys(bat)
+	if err != nil {
+		return err
+	}
+	attrs := make([]string, len(r.md.Attrs))
+	mp := make(map[string]engine.Attribute)
+	for i, attr := range r.md.Attrs {
+		attrs[i] = attr.Name
+		mp[attr.Name] = attr
+	}
+	cs := make([]uint64, len(attrs))
+	for i := range cs {
+		cs[i] = 1
+	}
+	for i := 0; i < int(r.md.Segs); i++ {
+		key := sKey(i, r.id)
+		rd := &reader{db: r.db, attrs: mp, segs: []string{key}}
+		seg, err := rd.Read(cs, attrs)
 		if err != nil {
 			return err
 		}
-		if r.md.Attrs[i].Alg == compress.Lz4 {
-			data := make([]byte, lz4.CompressBlockBound(len(v)))
-			if data, err = compress.Compress(v, data, compress.Lz4); err != nil {
+		view := &batch.Batch{Attrs: bat.Attrs, Vecs: make([]*vector.Vector, len(bat.Attrs))}
+		for j, attr := range bat.Attrs {
+			view.Vecs[j] = batch.GetVector(seg, attr)
+		}
+		var row []byte
+
+		n := int64(vector.Length(seg.Vecs[0]))
+		sels := make([]int64, 0, n)
+		for j := int64(0); j < n; j++ {
+			if row, err = batch.RowKey(row[:0], view, j); err != nil {
 				return err
 			}
-			data = append(data, encoding.EncodeInt32(int32(len(v)))...)
-			v = data
+			if _, ok := keys[string(row)]; !ok {
+				sels = append(sels, j)
+			}
+		}
+		if int64(len(sels)) == n {
+			continue
 		}
-		if err := r.db.Set(key+"."+attr, v); err != nil {
+		for _, vec := range seg.Vecs {
+			vector.Shrink(vec, sels)
+		}
+		if err := r.writeSegment(key, seg); err != nil {
 			return err
 		}
 	}
-	{
-		r.md.Segs++
-		data, err := encoding.Encode(r.md)
-		if err != nil {
+	return nil
+}
+
+// Update deletes the tuples of oldBat and writes the ones of newBat
+func (r *relation) Update(u uint64, oldBat, newBat *batch.Batch) error {
+	if err := r.Delete(u, oldBat); err != nil {
+		return err
+	}
+	return r.Write(u, newBat)
+}
+
+func (r *relation) writeSegment(key string, bat *batch.Batch) error {
+	for i, attr := range bat.Attrs {
+		if err := r.writeVector(key+"."+attr, r.md.Attrs[i].Alg, bat.Vecs[i]); err != nil {
 			return err
 		}
-		if err := r.db.Set(r.id, data); err != nil {
+	}
+	return nil
+}
+
+func (r *relation) writeVector(key string, alg compress.T, vec *vector.Vector) error {
+	v, err := vec.Show()
+	if err != nil {
+		return err
+	}
+	if alg == compress.Lz4 {
+		data := make([]byte, lz4.CompressBlockBound(len(v)))
+		if data, err = compress.Compress(v, data, compress.Lz4); err != nil {
 			return err
 		}
+		data = append(data, encoding.EncodeInt32(int32(len(v)))...)
+		v = data
 	}
-	return nil
+	return r.db.Set(key, v)
+}
+
+func (r *relation) saveMetadata() error {
+	data, err := encoding.Encode(r.md)
+	if err != nil {
+		return err
+	}
+	return r.db.Set(r.id, data)
 }
 
 func (r *relation) CreateIndex(_ uint64, _ []engine.TableDef) error{
@@ -129,12 +201,105 @@ func (r *relation) DropIndex(epoch uint64, name string) error{
 	return nil
 }
 
-func (r *relation) AddTableDef(_ uint64, _ engine.TableDef) error {
-	return nil
+// AddTableDef adds an attribute, whose default value is written
+// into the segments at once.
+func (r *relation) AddTableDef(_ uint64, def engine.TableDef) error {
+	d, ok := def.(*engine.AttributeDef)
+	if !ok {
+		return nil
+	}
+	if r.attribute(d.Attr.Name) >= 0 {
+		return fmt.Errorf("attribute '%s' already exists", d.Attr.Name)
+	}
+	mp := map[string]engine.Attribute{r.md.Attrs[0].Name: r.md.Attrs[0]}
+	for i := 0; i < int(r.md.Segs); i++ {
+		key := sKey(i, r.id)
+		rd := &reader{db: r.db, attrs: mp, segs: []string{key}}
+		seg, err := rd.Read([]uint64{1}, []string{r.md.Attrs[0].Name})
+		if err != nil {
+			return err
+		}
+		vec, err := engine.DefaultVector(d.Attr, vector.Length(seg.Vecs[0]))
+		if err != nil {
+			return err
+		}
+		if err := r.writeVector(key+"."+d.Attr.Name, d.Attr.Alg, vec); err != nil {
+			return err
+		}
+	}
+	r.md.Attrs = append(r.md.Attrs, d.Attr)
+	return r.saveMetadata()
 }
 
-func (r *relation) DelTableDef(_ uint64, _ engine.TableDef) error {
-	return nil
+func (r *relation) DelTableDef(_ uint64, def engine.TableDef) error {
+	d, ok := def.(*engine.AttributeDef)
+	if !ok {
+		return nil
+	}
+	i := r.attribute(d.Attr.Name)
+	if i < 0 {
+		return fmt.Errorf("attribute '%s' not exist", d.Attr.Name)
+	}
+	for j := 0; j < int(r.md.Segs); j++ {
+		if err := r.db.Del(sKey(j, r.id) + "." + d.Attr.Name); err != nil {
+			return err
+		}
+	}
+	r.md.Attrs = append(r.md.Attrs[:i], r.md.Attrs[i+1:]...)
+	return r.saveMetadata()
+}
+
+func (r *relation) RenameAttribute(_ uint64, name, newName string) error {
+	i := r.attribute(name)
+	if i < 0 {
+		return fmt.Errorf("attribute '%s' not exist", name)
+	}
+	for j := 0; j < int(r.md.Segs); j++ {
+		key := sKey(j, r.id)
+		if err := move(r.db, key+"."+name, key+"."+newName); err != nil {
+			return err
+		}
+	}
+	r.md.Attrs[i].Name = newName
+	for j := range r.md.Index {
+		for k, col := range r.md.Index[j].ColNames {
+			if col == name {
+				r.md.Index[j].ColNames[k] = newName
+			}
+		}
+	}
+	return r.saveMetadata()
+}
+
+func (r *relation) ChangeDefault(_ uint64, name string, def engine.DefaultExpr) error {
+	i := r.attribute(name)
+	if i < 0 {
+		return fmt.Errorf("attribute '%s' not exist", name)
+	}
+	r.md.Attrs[i].Default = def
+	return r.saveMetadata()
+}
+
+func (r *relation) attribute(name string) int {
+	for i, attr := range r.md.Attrs {
+		if attr.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func move(db *kv.KV, key, newKey string) error {
+	var buf bytes.Buffer
+
+	data, err := db.Get(key, &buf)
+	if err != nil {
+		return err
+	}
+	if err := db.Set(newKey, data); err != nil {
+		return err
+	}
+	return db.Del(key)
 }
 
 func sKey(num int, id string) string {
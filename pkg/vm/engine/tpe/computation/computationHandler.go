@@ -38,7 +38,11 @@ type ComputationHandler interface {
 
 	GetTable(dbId uint64, name string) (*descriptor.RelationDesc, error)
 
+	AlterTable(epoch, dbId uint64, tableDesc *descriptor.RelationDesc) error
+
 	Read(readCtx interface{}) (*batch.Batch, error)
 
 	Write(writeCtx interface{}, bat *batch.Batch) error
+
+	Delete(deleteCtx interface{}, bat *batch.Batch) error
 }
\ No newline at end of file
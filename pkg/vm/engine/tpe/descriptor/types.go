@@ -131,6 +131,14 @@ type AttributeDesc struct {
 	References []ReferenceDesc `json:"references"`
 
 	Constrains []ConstrainDesc `json:"constrains"`
+
+	//the attribute has been dropped. It keeps its ID and its values
+	//are written as null.
+	Is_dropped bool `json:"is_dropped,string"`
+
+	//the default value when the attribute was added.
+	//It is the value of the rows written before the attribute.
+	Added_default engine.DefaultExpr `json:"added_default"`
 }
 
 type ReferenceDesc struct {
@@ -64,6 +64,16 @@ func (td * TpeDatabase) Delete(epoch uint64, name string) error {
 	return nil
 }
 
+//Rename renames the table in the database
+func (td * TpeDatabase) Rename(epoch uint64, name, newName string) error {
+	tableDesc, err := td.computeHandler.GetTable(td.id,name)
+	if err != nil {
+		return err
+	}
+	tableDesc.Name = newName
+	return td.computeHandler.AlterTable(epoch,td.id,tableDesc)
+}
+
 func (td * TpeDatabase) Create(epoch uint64,name string, defs []engine.TableDef) error {
 	//convert defs into desc
 	tableDesc := &descriptor.RelationDesc{}
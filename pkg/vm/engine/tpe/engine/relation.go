@@ -27,6 +27,7 @@ var (
 	errorNotHiddenPrimaryKey = errors.New("it is not hidden primary key")
 	errorDuplicateAttributeNameInBatch = errors.New("duplicate attribute name in the batch")
 	errorDoNotGetValidValueForTheAttribute = errors.New("can not get the value for the attribute")
+	errorAttributeDoNotExist = errors.New("attribute do not exist")
 )
 
 func (trel * TpeRelation) Rows() int64 {
@@ -67,7 +68,7 @@ func (trel * TpeRelation) TableDefs() []engine.TableDef {
 	var pkNames []string
 	for _, attr := range trel.desc.Attributes {
 		//skip hidden attribute ?
-		if !attr.Is_hidden {
+		if !attr.Is_hidden && !attr.Is_dropped {
 			if attr.Is_primarykey {
 				pkNames = append(pkNames,attr.Name)
 			}
@@ -96,10 +97,7 @@ func (trel * TpeRelation) TableDefs() []engine.TableDef {
 
 func (trel * TpeRelation) Write(_ uint64, batch *batch.Batch) error {
 	//attribute set
-	attrSet := make(map[string]uint32)
-	for _, attr := range trel.desc.Attributes {
-		attrSet[attr.Name] = attr.ID
-	}
+	attrSet := trel.attributeSet()
 
 	//check if the attribute in the batch exists in the relation or not.
 	var attrDescs []descriptor.AttributeDesc
@@ -111,8 +109,8 @@ func (trel * TpeRelation) Write(_ uint64, batch *batch.Batch) error {
 			batchAttrSet[batchAttrName] = posInBatch
 		}
 
-		if _,ok := attrSet[batchAttrName]; ok {
-			attrDescs = append(attrDescs,trel.desc.Attributes[posInBatch])
+		if attrID,ok := attrSet[batchAttrName]; ok {
+			attrDescs = append(attrDescs,trel.desc.Attributes[attrID])
 		}else{
 			return errorBatchAttributeDoNotExistInTheRelation
 		}
@@ -129,6 +127,12 @@ func (trel * TpeRelation) Write(_ uint64, batch *batch.Batch) error {
 		writeStates[attrIdx].AttrDesc = attrDesc
 		writeStates[attrIdx].PositionInBatch = -1
 		writeStates[attrIdx].NeedGenerated = false
+		if attrDesc.Is_dropped {
+			//the dropped attribute is written as null
+			writeStates[attrIdx].AttrDesc.Default = engine.EmptyDefaultExpr
+			writeStates[attrIdx].NeedGenerated = true
+			continue
+		}
 		//attribute not in the batch
 		if posInBatch,exist := batchAttrSet[attrDesc.Name]; !exist {
 			//hidden primary key
@@ -164,12 +168,116 @@ func (trel * TpeRelation) Write(_ uint64, batch *batch.Batch) error {
 	return nil
 }
 
-func (trel * TpeRelation) AddTableDef(u uint64, def engine.TableDef) error {
-	panic("implement me")
+func (trel * TpeRelation) Delete(_ uint64, batch *batch.Batch) error {
+	attrSet := trel.attributeSet()
+
+	//check if the attribute in the batch exists in the relation or not.
+	var deleteAttrs []*descriptor.AttributeDesc
+	for _, attr := range batch.Attrs {
+		if attrID,exist := attrSet[attr]; exist {
+			deleteAttrs = append(deleteAttrs,&trel.desc.Attributes[attrID])
+		}else{
+			return errorBatchAttributeDoNotExistInTheRelation
+		}
+	}
+
+	deleteCtx := &tuplecodec.DeleteContext{
+		DbDesc:               trel.dbDesc,
+		TableDesc:            trel.desc,
+		IndexDesc:            &trel.desc.Primary_index,
+		DeleteAttributeDescs: deleteAttrs,
+	}
+
+	err := trel.computeHandler.Delete(deleteCtx, batch)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-func (trel * TpeRelation) DelTableDef(u uint64, def engine.TableDef) error {
-	panic("implement me")
+func (trel * TpeRelation) Update(epoch uint64, oldBatch, newBatch *batch.Batch) error {
+	err := trel.Delete(epoch, oldBatch)
+	if err != nil {
+		return err
+	}
+	return trel.Write(epoch, newBatch)
+}
+
+//AddTableDef adds an attribute at the end of the relation.
+//The rows written before get the default value of the attribute.
+func (trel * TpeRelation) AddTableDef(epoch uint64, def engine.TableDef) error {
+	attrDef, ok := def.(*engine.AttributeDef)
+	if !ok {
+		return errorUnsupportedTableDef
+	}
+	if _, exist := trel.attributeSet()[attrDef.Attr.Name]; exist {
+		return errorDuplicateAttributeName
+	}
+	attr := attrDef.Attr
+	trel.desc.Attributes = append(trel.desc.Attributes, descriptor.AttributeDesc{
+		ID:            uint32(len(trel.desc.Attributes)),
+		Name:          attr.Name,
+		Ttype:         tuplecodec.EngineTypeToTpeType(&attr.Type),
+		TypesType:     attr.Type,
+		Default:       attr.Default,
+		Is_null:       true,
+		Added_default: attr.Default,
+	})
+	return trel.computeHandler.AlterTable(epoch, uint64(trel.dbDesc.ID), trel.desc)
+}
+
+//DelTableDef drops an attribute of the relation.
+//The attribute keeps its ID, so that the rows written before
+//can still be decoded.
+func (trel * TpeRelation) DelTableDef(epoch uint64, def engine.TableDef) error {
+	attrDef, ok := def.(*engine.AttributeDef)
+	if !ok {
+		return errorUnsupportedTableDef
+	}
+	attrID, exist := trel.attributeSet()[attrDef.Attr.Name]
+	if !exist {
+		return errorAttributeDoNotExist
+	}
+	trel.desc.Attributes[attrID].Is_dropped = true
+	return trel.computeHandler.AlterTable(epoch, uint64(trel.dbDesc.ID), trel.desc)
+}
+
+func (trel * TpeRelation) RenameAttribute(epoch uint64, name, newName string) error {
+	attrSet := trel.attributeSet()
+	attrID, exist := attrSet[name]
+	if !exist {
+		return errorAttributeDoNotExist
+	}
+	if _, exist = attrSet[newName]; exist {
+		return errorDuplicateAttributeName
+	}
+	trel.desc.Attributes[attrID].Name = newName
+	for i := range trel.desc.Primary_index.Attributes {
+		if trel.desc.Primary_index.Attributes[i].ID == attrID {
+			trel.desc.Primary_index.Attributes[i].Name = newName
+		}
+	}
+	return trel.computeHandler.AlterTable(epoch, uint64(trel.dbDesc.ID), trel.desc)
+}
+
+func (trel * TpeRelation) ChangeDefault(epoch uint64, name string, def engine.DefaultExpr) error {
+	attrID, exist := trel.attributeSet()[name]
+	if !exist {
+		return errorAttributeDoNotExist
+	}
+	trel.desc.Attributes[attrID].Default = def
+	return trel.computeHandler.AlterTable(epoch, uint64(trel.dbDesc.ID), trel.desc)
+}
+
+//attributeSet maps the names of the attributes not dropped to their IDs
+func (trel * TpeRelation) attributeSet() map[string]uint32 {
+	attrSet := make(map[string]uint32)
+	for _, attr := range trel.desc.Attributes {
+		if !attr.Is_dropped {
+			attrSet[attr.Name] = attr.ID
+		}
+	}
+	return attrSet
 }
 
 func (trel * TpeRelation) NewReader(cnt int) []engine.Reader {
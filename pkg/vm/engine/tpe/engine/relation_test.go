@@ -141,4 +141,107 @@ func TestTpeRelation_Write(t *testing.T) {
 		err = tableDesc.Write(0, bat)
 		convey.So(err,convey.ShouldBeNil)
 	})
-}
\ No newline at end of file
+}
+func TestTpeRelation_Alter(t *testing.T) {
+	convey.Convey("alter the attributes of the table",t, func() {
+		tpe, _ := NewTpeEngine(&TpeConfig{})
+		err := tpe.Create(0, "test", 0)
+		convey.So(err,convey.ShouldBeNil)
+
+		dbDesc, err := tpe.Database("test")
+		convey.So(err,convey.ShouldBeNil)
+
+		//(a,b)
+		//(uint64,uint64)
+		//primary key (a)
+		_,attrDefs := tuplecodec.MakeAttributes(types.T_uint64,types.T_uint64)
+
+		attrNames := []string{
+			"a","b",
+		}
+		var defs []engine.TableDef
+		var rawDefs []*engine.AttributeDef
+		for i, def := range attrDefs {
+			def.Attr.Name = attrNames[i]
+			defs = append(defs,def)
+			rawDefs = append(rawDefs,def)
+		}
+		defs[0].(*engine.AttributeDef).Attr.Primary = true
+		defs = append(defs,&engine.PrimaryIndexDef{Names: []string{"a"}})
+
+		err = dbDesc.Create(0,"A",defs)
+		convey.So(err,convey.ShouldBeNil)
+
+		tableDesc, err := dbDesc.Relation("A")
+		convey.So(err,convey.ShouldBeNil)
+
+		bat := tuplecodec.MakeBatch(10,attrNames, rawDefs)
+		vec0 := bat.Vecs[0].Col.([]uint64)
+		for i := 0; i < 10; i++ {
+			vec0[i] = uint64(i)
+		}
+		err = tableDesc.Write(0, bat)
+		convey.So(err,convey.ShouldBeNil)
+
+		//add c with default 7, drop b
+		_,newDefs := tuplecodec.MakeAttributes(types.T_uint64)
+		newDefs[0].Attr.Name = "c"
+		newDefs[0].Attr.Default = engine.MakeDefaultExpr(true, uint64(7), false)
+		alter := tableDesc.(engine.AlterRelation)
+		err = alter.AddTableDef(1, newDefs[0])
+		convey.So(err,convey.ShouldBeNil)
+		err = alter.AddTableDef(1, newDefs[0])
+		convey.So(err,convey.ShouldNotBeNil)
+		err = alter.DelTableDef(1, &engine.AttributeDef{Attr: engine.Attribute{Name: "b"}})
+		convey.So(err,convey.ShouldBeNil)
+
+		var names []string
+		for _, def := range tableDesc.TableDefs() {
+			if attr,ok := def.(*engine.AttributeDef); ok {
+				names = append(names,attr.Attr.Name)
+			}
+		}
+		convey.So(names,convey.ShouldResemble,[]string{"a","c"})
+
+		bat = tuplecodec.MakeBatch(10,[]string{"a","c"},[]*engine.AttributeDef{rawDefs[0],newDefs[0]})
+		vec0 = bat.Vecs[0].Col.([]uint64)
+		vec1 := bat.Vecs[1].Col.([]uint64)
+		for i := 0; i < 10; i++ {
+			vec0[i] = uint64(10+i)
+			vec1[i] = uint64(100+i)
+		}
+		err = tableDesc.Write(1, bat)
+		convey.So(err,convey.ShouldBeNil)
+
+		//rename c to d and the table to B
+		err = alter.RenameAttribute(2,"c","d")
+		convey.So(err,convey.ShouldBeNil)
+		err = dbDesc.(engine.RenameDatabase).Rename(2,"A","B")
+		convey.So(err,convey.ShouldBeNil)
+
+		_, err = dbDesc.Relation("A")
+		convey.So(err,convey.ShouldNotBeNil)
+		tableDesc, err = dbDesc.Relation("B")
+		convey.So(err,convey.ShouldBeNil)
+
+		var as,ds []uint64
+		reader := tableDesc.NewReader(1)[0]
+		for {
+			get, err := reader.Read([]uint64{1,1},[]string{"a","d"})
+			convey.So(err,convey.ShouldBeNil)
+			if get == nil {
+				break
+			}
+			as = append(as,get.Vecs[0].Col.([]uint64)...)
+			ds = append(ds,get.Vecs[1].Col.([]uint64)...)
+		}
+		convey.So(len(as),convey.ShouldEqual,20)
+		for i, a := range as {
+			if a < 10 {
+				convey.So(ds[i],convey.ShouldEqual,7)
+			}else{
+				convey.So(ds[i],convey.ShouldEqual,a+90)
+			}
+		}
+	})
+}
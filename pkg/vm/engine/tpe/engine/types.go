@@ -24,7 +24,9 @@ import (
 
 var _ engine.Engine = &TpeEngine{}
 var _ engine.Database = &TpeDatabase{}
+var _ engine.RenameDatabase = &TpeDatabase{}
 var _ engine.Relation = &TpeRelation{}
+var _ engine.AlterRelation = &TpeRelation{}
 var _ engine.Reader = &TpeReader{}
 
 type TpeConfig struct {
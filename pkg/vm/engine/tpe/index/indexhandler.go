@@ -27,7 +27,7 @@ type IndexHandler interface {
 
 	WriteIntoIndex(writeCtx interface{}, bat *batch.Batch) error
 
-	DeleteFromTable(table *descriptor.RelationDesc,bat *batch.Batch) error
+	DeleteFromTable(deleteCtx interface{}, bat *batch.Batch) error
 
 	DeleteFromIndex(index *descriptor.IndexDesc,attrs []descriptor.AttributeDesc,bat *batch.Batch) error
 }
\ No newline at end of file
@@ -66,6 +66,14 @@ func (chi *ComputationHandlerImpl) Write(writeCtx interface{}, bat *batch.Batch)
 	return nil
 }
 
+func (chi *ComputationHandlerImpl) Delete(deleteCtx interface{}, bat *batch.Batch) error {
+	err := chi.indexHandler.DeleteFromTable(deleteCtx, bat)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func NewComputationHandlerImpl(dh descriptor.DescriptorHandler, kv KVHandler, tch *TupleCodecHandler, serial ValueSerializer, ih index.IndexHandler) *ComputationHandlerImpl {
 	return &ComputationHandlerImpl{
 		dh: dh,
@@ -239,6 +247,30 @@ func (chi *ComputationHandlerImpl) CreateTable(epoch, dbId uint64, tableDesc *de
 	return id,nil
 }
 
+//AlterTable saves the altered descriptor of the table.
+//The new name of the table must not be taken by another table.
+func (chi *ComputationHandlerImpl) AlterTable(epoch, dbId uint64, tableDesc *descriptor.RelationDesc) error {
+	//1. check database exists
+	dbDesc, err := chi.dh.LoadDatabaseDescByID(dbId)
+	if err != nil {
+		return err
+	}
+
+	//2. check the name of the table
+	desc, err := chi.dh.LoadRelationDescByName(uint64(dbDesc.ID), tableDesc.Name)
+	if err != nil {
+		if err != errorDoNotFindTheDesc {
+			return err
+		}
+	}else if desc.ID != tableDesc.ID {
+		return errorTableExists
+	}
+
+	//3. save the descriptor
+	tableDesc.Max_access_epoch = epoch
+	return chi.dh.StoreRelationDescByID(dbId,uint64(tableDesc.ID),tableDesc)
+}
+
 // encodeFieldsIntoValue encodes the value(epoch,dbid,tableid)
 func (chi *ComputationHandlerImpl) encodeFieldsIntoValue(epoch,dbID,tableID uint64) (TupleValue,error) {
 	//serialize the value(epoch,dbid,tableid)
@@ -405,6 +437,16 @@ type WriteContext struct {
 	NodeID uint64
 }
 
+type DeleteContext struct {
+	//target database,table and index
+	DbDesc *descriptor.DatabaseDesc
+	TableDesc *descriptor.RelationDesc
+	IndexDesc *descriptor.IndexDesc
+
+	//the attributes of the tuples to be deleted
+	DeleteAttributeDescs []*descriptor.AttributeDesc
+}
+
 type ReadContext struct {
 	//target database,table and index
 	DbDesc *descriptor.DatabaseDesc
@@ -422,4 +464,7 @@ type ReadContext struct {
 
 	//the length of the prefix
 	LengthOfPrefixForScanKey int
+
+	//the values of the attributes added after the rows were written
+	addedValues []*orderedcodec.DecodedItem
 }
\ No newline at end of file
@@ -26,6 +26,7 @@ var (
 	errorRowIndexDifferentInKeyAndValue = errors.New("the rowIndexForkey != rowIndexForValue")
 	errorWriteContextIsInvalid = errors.New("the write context is invalid")
 	errorReadContextIsInvalid = errors.New("the read context is invalid")
+	errorDeleteContextIsInvalid = errors.New("the delete context is invalid")
 )
 
 var _ index.IndexHandler = &IndexHandlerImpl{}
@@ -64,6 +65,13 @@ func (ihi * IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch,
 	if !ok {
 		return nil, 0, errorReadContextIsInvalid
 	}
+	bat, _, rowRead, err := ihi.readFromIndex(indexReadCtx)
+	return bat, rowRead, err
+}
+
+//readFromIndex reads the next rows from the index and returns
+//the keys of these rows also.
+func (ihi * IndexHandlerImpl) readFromIndex(indexReadCtx *ReadContext) (*batch.Batch, []TupleKey, int, error) {
 
 	//check if we need the index key only.
 	//Attributes we want are in the index key only.
@@ -104,11 +112,12 @@ func (ihi * IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch,
 	//2.prefix read data from kv
 	//get keys with the prefix
 	var lastKey []byte
+	var readKeys []TupleKey
 	for rowRead < int(ihi.kvLimit) {
 		needRead := int(ihi.kvLimit) - rowRead
 		keys, values, err := ihi.kv.GetWithPrefix(indexReadCtx.PrefixForScanKey,indexReadCtx.LengthOfPrefixForScanKey, uint64(needRead))
 		if err != nil {
-			return nil, 0, err
+			return nil, nil, 0, err
 		}
 
 		rowRead += len(keys)
@@ -123,18 +132,19 @@ func (ihi * IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch,
 			indexKey := keys[i][indexReadCtx.LengthOfPrefixForScanKey:]
 			_, dis, err := tkd.DecodePrimaryIndexKey(indexKey, indexReadCtx.IndexDesc)
 			if err != nil {
-				return nil, 0, err
+				return nil, nil, 0, err
 			}
 
 			//pick wanted fields and save them in the batch
 			err = ihi.rcc.FillBatchFromDecodedIndexKey(indexReadCtx.IndexDesc,
 				0, dis, amForKey, bat, i)
 			if err != nil {
-				return nil, 0, err
+				return nil, nil, 0, err
 			}
 
 			lastKey = keys[i]
 		}
+		readKeys = append(readKeys, keys...)
 
 		//skip decoding the value
 		if !needKeyOnly {
@@ -146,14 +156,20 @@ func (ihi * IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch,
 				_,dis,err := tkd.DecodePrimaryIndexValue(data,
 					indexReadCtx.IndexDesc,0,ihi.serializer)
 				if err != nil {
-					return nil, 0, err
+					return nil, nil, 0, err
+				}
+
+				//the row is written before some attributes were added
+				dis, err = fillAddedAttributes(indexReadCtx, dis)
+				if err != nil {
+					return nil, nil, 0, err
 				}
 
 				//pick wanted fields and save them in the batch
 				err = ihi.rcc.FillBatchFromDecodedIndexValue(indexReadCtx.IndexDesc,
 					0, dis,amForValue, bat, i)
 				if err != nil {
-					return nil, 0, err
+					return nil, nil, 0, err
 				}
 			}
 		}
@@ -173,7 +189,30 @@ func (ihi * IndexHandlerImpl) ReadFromIndex(readCtx interface{}) (*batch.Batch,
 			bat = nil
 		}
 	}
-	return bat, rowRead, nil
+	return bat, readKeys, rowRead, nil
+}
+
+//fillAddedAttributes appends the values of the attributes which
+//are added after the row was written.
+func fillAddedAttributes(readCtx *ReadContext, dis []*orderedcodec.DecodedItem) ([]*orderedcodec.DecodedItem, error) {
+	attrs := readCtx.TableDesc.Attributes
+	if len(dis) >= len(attrs) {
+		return dis, nil
+	}
+	if readCtx.addedValues == nil {
+		readCtx.addedValues = make([]*orderedcodec.DecodedItem, len(attrs))
+	}
+	for id := len(dis); id < len(attrs); id++ {
+		if readCtx.addedValues[id] == nil {
+			value, err := AddedAttributeValue(&attrs[id])
+			if err != nil {
+				return nil, err
+			}
+			readCtx.addedValues[id] = value
+		}
+		dis = append(dis, readCtx.addedValues[id])
+	}
+	return dis, nil
 }
 
 func (ihi * IndexHandlerImpl) WriteIntoTable(table *descriptor.RelationDesc, writeCtx interface{}, bat *batch.Batch) error {
@@ -316,8 +355,49 @@ func (ihi * IndexHandlerImpl) WriteIntoIndex(writeCtx interface{}, bat *batch.Ba
 	return nil
 }
 
-func (ihi * IndexHandlerImpl) DeleteFromTable(table *descriptor.RelationDesc, bat *batch.Batch) error {
-	panic("implement me")
+//DeleteFromTable deletes the rows equal to the tuples of the batch.
+//It scans the primary index and removes the keys of the matched rows.
+func (ihi * IndexHandlerImpl) DeleteFromTable(deleteCtx interface{}, bat *batch.Batch) error {
+	indexDeleteCtx,ok := deleteCtx.(*DeleteContext)
+	if !ok {
+		return errorDeleteContextIsInvalid
+	}
+
+	readCtx := &ReadContext{
+		DbDesc:              indexDeleteCtx.DbDesc,
+		TableDesc:           indexDeleteCtx.TableDesc,
+		IndexDesc:           indexDeleteCtx.IndexDesc,
+		ReadAttributesNames: bat.Attrs,
+		ReadAttributeDescs:  indexDeleteCtx.DeleteAttributeDescs,
+	}
+
+	deleted, err := batch.RowKeys(bat)
+	if err != nil {
+		return err
+	}
+	var row []byte
+	for {
+		rows, keys, _, err := ihi.readFromIndex(readCtx)
+		if err != nil {
+			return err
+		}
+		//no data any more
+		if rows == nil {
+			break
+		}
+		for i, key := range keys {
+			if row, err = batch.RowKey(row[:0], rows, int64(i)); err != nil {
+				return err
+			}
+			if _, exist := deleted[string(row)]; exist {
+				err = ihi.kv.Delete(key)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
 }
 
 func (ihi * IndexHandlerImpl) DeleteFromIndex(index *descriptor.IndexDesc, attrs []descriptor.AttributeDesc, bat *batch.Batch) error {
@@ -17,6 +17,7 @@ package tuplecodec
 import (
 	"fmt"
 	"math/rand"
+	"reflect"
 	"strconv"
 	"sync"
 	"time"
@@ -486,6 +487,32 @@ func EngineTypeToTpeType(t * types.Type) orderedcodec.ValueType {
 	return vt
 }
 
+//AddedAttributeValue returns the value of the attribute in the rows
+//written before the attribute was added.
+func AddedAttributeValue(attr *descriptor.AttributeDesc) (*orderedcodec.DecodedItem, error) {
+	vec, err := engine.DefaultVector(engine.Attribute{
+		Name:    attr.Name,
+		Type:    attr.TypesType,
+		Default: attr.Added_default,
+	}, 1)
+	if err != nil {
+		return nil, err
+	}
+	if nulls.Contains(vec.Nsp, 0) {
+		return &orderedcodec.DecodedItem{ValueType: orderedcodec.VALUE_TYPE_NULL}, nil
+	}
+	if bytes, ok := vec.Col.(*types.Bytes); ok {
+		return &orderedcodec.DecodedItem{
+			Value:     bytes.Get(0),
+			ValueType: orderedcodec.VALUE_TYPE_BYTES,
+		}, nil
+	}
+	return &orderedcodec.DecodedItem{
+		Value:     reflect.ValueOf(vec.Col).Index(0).Interface(),
+		ValueType: attr.Ttype,
+	}, nil
+}
+
 //for rowid
 var startTime int64 = time.Date(2022,1,1,0,0,0,0,time.UTC).UnixNano()
 
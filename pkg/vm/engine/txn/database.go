@@ -0,0 +1,36 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func (db *database) Relation(name string) (engine.Relation, error) {
+	r, err := db.Database.Relation(name)
+	if err != nil {
+		return nil, err
+	}
+	rel := &relation{
+		Relation: r,
+		schema:   db.schema,
+		name:     name,
+		txn:      db.txn,
+	}
+	if rel.txn == nil {
+		rel.ts = stamp()
+	}
+	return rel, nil
+}
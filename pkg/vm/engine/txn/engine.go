@@ -0,0 +1,50 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package txn implements the multi-statement transactions of a single
+// node. The stamps of the transactions and of the changes of the tables are
+// taken from the local clock and kept in the memory of the process, so the
+// isolation only holds among the sessions of the same process, and the
+// changes of the same tables through other nodes are not seen as conflicts.
+//
+// A commit conflicts with the changes committed since the transaction began
+// if the transaction deletes from a changed table, or inserts a tuple whose
+// primary key has been inserted by one of them.
+package txn
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// NewEngine returns an engine which routes the writes to the transaction
+// returned by get. If there is no active transaction, the writes go to the
+// underlying engine and a relation is read as it was when it was opened.
+func NewEngine(e engine.Engine, get func() *Txn) engine.Engine {
+	return &txnEngine{
+		Engine: e,
+		get:    get,
+	}
+}
+
+func (e *txnEngine) Database(name string) (engine.Database, error) {
+	db, err := e.Engine.Database(name)
+	if err != nil {
+		return nil, err
+	}
+	return &database{
+		Database: db,
+		schema:   name,
+		txn:      e.get(),
+	}, nil
+}
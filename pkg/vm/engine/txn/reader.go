@@ -0,0 +1,130 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func (r *reader) NewFilter() engine.Filter {
+	return nil
+}
+
+func (r *reader) NewSummarizer() engine.Summarizer {
+	return nil
+}
+
+func (r *reader) NewSparseFilter() engine.SparseFilter {
+	return nil
+}
+
+func (r *reader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
+	for r.r != nil {
+		bat, err := r.readCommitted(cs, attrs)
+		if err != nil {
+			return nil, err
+		}
+		if bat == nil {
+			r.r = nil
+			break
+		}
+		if len(bat.Zs) > 0 {
+			return bat, nil
+		}
+	}
+	if len(r.inserts) == 0 {
+		return nil, nil
+	}
+	ins := r.inserts[0]
+	r.inserts = r.inserts[1:]
+	n := vector.Length(ins.Vecs[0])
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		if attr == engine.RowId {
+			// the inserted tuples have no row ids yet
+			bat.Vecs[i] = vector.New(engine.RowIdType)
+			bat.Vecs[i].Or = true // not allocated from the mempool
+			if err := vector.Append(bat.Vecs[i], make([][]byte, n)); err != nil {
+				return nil, err
+			}
+		} else if bat.Vecs[i] = batch.GetVector(ins, attr); bat.Vecs[i] == nil {
+			return nil, ErrNoAttribute
+		}
+		bat.Vecs[i].Ref = cs[i]
+	}
+	if n > cap(r.zs) {
+		r.zs = make([]int64, n)
+	}
+	bat.Zs = r.zs[:n]
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat, nil
+}
+
+// readCommitted reads a batch of the committed data and removes the tuples
+// deleted by the transaction, the attributes needed by the delete sets are
+// read together and dropped afterwards.
+func (r *reader) readCommitted(cs []uint64, attrs []string) (*batch.Batch, error) {
+	rattrs := append([]string{}, attrs...)
+	rcs := append([]uint64{}, cs...)
+	for _, set := range r.sets {
+		for _, attr := range set.attrs {
+			if !contains(rattrs, attr) {
+				rattrs = append(rattrs, attr)
+				rcs = append(rcs, 1)
+			}
+		}
+	}
+	bat, err := r.r.Read(rcs, rattrs)
+	if err != nil || bat == nil {
+		return bat, err
+	}
+	for _, set := range r.sets {
+		if err := filter(bat, set.attrs, set.keys); err != nil {
+			return nil, err
+		}
+	}
+	bat.Attrs = bat.Attrs[:len(attrs)]
+	bat.Vecs = bat.Vecs[:len(attrs)]
+	return bat, nil
+}
+
+func contains(attrs []string, attr string) bool {
+	for _, a := range attrs {
+		if a == attr {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *errReader) NewFilter() engine.Filter {
+	return nil
+}
+
+func (r *errReader) NewSummarizer() engine.Summarizer {
+	return nil
+}
+
+func (r *errReader) NewSparseFilter() engine.SparseFilter {
+	return nil
+}
+
+func (r *errReader) Read(_ []uint64, _ []string) (*batch.Batch, error) {
+	return nil, r.err
+}
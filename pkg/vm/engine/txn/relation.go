@@ -0,0 +1,94 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// Unwrap returns the relation of the underlying engine.
+func (r *relation) Unwrap() engine.Relation {
+	return r.Relation
+}
+
+func (r *relation) Write(ts uint64, bat *batch.Batch) error {
+	if r.txn == nil {
+		return r.Relation.Write(ts, bat)
+	}
+	return r.txn.write(r.schema, r.name, bat)
+}
+
+func (r *relation) Delete(ts uint64, bat *batch.Batch) error {
+	if r.txn == nil {
+		return r.Relation.Delete(ts, bat)
+	}
+	return r.txn.delete(r.schema, r.name, bat)
+}
+
+func (r *relation) Update(ts uint64, obat, nbat *batch.Batch) error {
+	if r.txn == nil {
+		return r.Relation.Update(ts, obat, nbat)
+	}
+	if err := r.Delete(ts, obat); err != nil {
+		return err
+	}
+	return r.Write(ts, nbat)
+}
+
+// NewReader returns readers of the snapshot of the transaction which skip
+// the tuples deleted by the transaction, the tuples inserted by the
+// transaction are returned by the first reader. If the snapshot cannot be
+// read, every reader fails with the error. Outside of the transactions,
+// the readers read the relation as it was when it was opened.
+func (r *relation) NewReader(n int) []engine.Reader {
+	var rds []engine.Reader
+	var sets []*deleteSet
+	var bats []*batch.Batch
+	var err error
+
+	if r.txn == nil {
+		rds, err = readers(r.Relation, r.schema, r.name, r.ts, false, n)
+	} else {
+		rds, sets, bats, err = r.txn.snapshot(r.schema, r.name, r.Relation, n)
+	}
+	if err != nil {
+		if n < 1 {
+			n = 1
+		}
+		rds = make([]engine.Reader, n)
+		for i := range rds {
+			rds[i] = &errReader{err: err}
+		}
+		return rds
+	}
+	if r.txn == nil {
+		return rds
+	}
+	if len(rds) == 0 {
+		rds = append(rds, nil)
+	}
+	for i := range rds {
+		rd := &reader{
+			r:    rds[i],
+			sets: sets,
+		}
+		if i == 0 {
+			rd.inserts = bats
+		}
+		rds[i] = rd
+	}
+	return rds
+}
@@ -0,0 +1,582 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+var (
+	ErrReadOnly    = errors.New("cannot execute statement in a READ ONLY transaction")
+	ErrNoAttribute = errors.New("attribute is not written by the transaction")
+	ErrConflict    = errors.New("transaction conflicts with a concurrent commit")
+)
+
+// tables is the state of every table changed or read through the package.
+var tables = &tableSet{states: make(map[string]*tableState)}
+
+// lastStamp is the last stamp handed out by stamp.
+var lastStamp int64
+
+// stamp returns the current unix time in nanoseconds, or the one after the
+// last stamp if the clock has not advanced since, so that the stamps of the
+// transactions, the commits and the reads are unique and ordered.
+func stamp() int64 {
+	for {
+		last := atomic.LoadInt64(&lastStamp)
+		ts := time.Now().UnixNano()
+		if ts <= last {
+			ts = last + 1
+		}
+		if atomic.CompareAndSwapInt64(&lastStamp, last, ts) {
+			return ts
+		}
+	}
+}
+
+// New begins a transaction, whose snapshot includes the changes committed
+// before it and none of the later ones.
+func New(readOnly bool) *Txn {
+	return &Txn{
+		readOnly: readOnly,
+		ts:       stamp(),
+		tblsMap:  make(map[string]*table),
+	}
+}
+
+func (t *Txn) ReadOnly() bool {
+	return t.readOnly
+}
+
+// Commit applies all the buffered writes to e. The changed tables are
+// locked in the order of their names and the commit fails if a table the
+// transaction deletes from has been changed since BEGIN, or if a tuple it
+// inserts has the primary key of a tuple inserted since. All the changes
+// are then stamped with the same commit stamp, and the readers wait until
+// they are applied, so that they see all or none of them. The deletes of a
+// table are applied before its inserts because the buffered inserts have
+// already been filtered by the later deletes. If the engine fails to apply
+// a change, the error tells the tables which may have been changed.
+func (t *Txn) Commit(e engine.Engine, ts uint64) error {
+	t.Lock()
+	defer t.Unlock()
+	defer t.reset()
+	var chgs []*change
+	for _, tbl := range t.tbls {
+		if len(tbl.deletes) == 0 && len(tbl.inserts) == 0 {
+			continue
+		}
+		chgs = append(chgs, &change{tbl: tbl, st: tables.get(tbl.schema, tbl.name)})
+	}
+	sort.Slice(chgs, func(i, j int) bool {
+		return chgs[i].tbl.key() < chgs[j].tbl.key()
+	})
+	for _, c := range chgs {
+		c.st.Lock()
+		defer c.st.Unlock()
+	}
+	for _, c := range chgs {
+		if len(c.tbl.deletes) > 0 && c.st.changedSince(t.ts) {
+			return fmt.Errorf("%w: table '%s' has been changed", ErrConflict, c.tbl.name)
+		}
+	}
+	defer func() {
+		for _, c := range chgs {
+			if c.r != nil {
+				c.r.Close()
+			}
+		}
+	}()
+	for _, c := range chgs {
+		r, err := openRelation(e, c.tbl.schema, c.tbl.name)
+		if err != nil {
+			return err
+		}
+		c.r = r
+	}
+	for _, c := range chgs {
+		if len(c.tbl.inserts) > 0 && c.st.changedSince(t.ts) {
+			if err := c.checkKeys(t.ts); err != nil {
+				return err
+			}
+		}
+	}
+	for _, c := range chgs {
+		c.st.applying.Lock()
+		defer c.st.applying.Unlock()
+	}
+	cts := stamp()
+	for i, c := range chgs {
+		c.st.setStamp(cts)
+		if err := c.apply(ts, cts); err != nil {
+			names := make([]string, i+1)
+			for j := range names {
+				names[j] = chgs[j].tbl.name
+			}
+			return fmt.Errorf("%w, the changes of table '%s' may have been committed partially",
+				err, strings.Join(names, "', '"))
+		}
+	}
+	return nil
+}
+
+// Rollback discards all the buffered writes.
+func (t *Txn) Rollback() {
+	t.Lock()
+	defer t.Unlock()
+	t.reset()
+}
+
+func (t *Txn) reset() {
+	t.tbls = nil
+	t.tblsMap = make(map[string]*table)
+}
+
+func (t *Txn) table(schema, name string) *table {
+	key := schema + "." + name
+	if tbl, ok := t.tblsMap[key]; ok {
+		return tbl
+	}
+	tbl := &table{
+		schema: schema,
+		name:   name,
+		sets:   make(map[string]*deleteSet),
+	}
+	t.tbls = append(t.tbls, tbl)
+	t.tblsMap[key] = tbl
+	return tbl
+}
+
+func (t *Txn) write(schema, name string, bat *batch.Batch) error {
+	if t.readOnly {
+		return ErrReadOnly
+	}
+	t.Lock()
+	defer t.Unlock()
+	rbat, err := dup(bat)
+	if err != nil {
+		return err
+	}
+	tbl := t.table(schema, name)
+	tbl.inserts = append(tbl.inserts, rbat)
+	return nil
+}
+
+// delete records the deleted tuples of bat, which are read from the snapshot
+// merged with the inserts of the transaction. The inserted tuples equal to
+// them are dropped at once. If bat has row ids, the inserted tuples are
+// those without a row id and the others are recorded by their row ids.
+func (t *Txn) delete(schema, name string, bat *batch.Batch) error {
+	if t.readOnly {
+		return ErrReadOnly
+	}
+	t.Lock()
+	defer t.Unlock()
+	rbat, err := dup(bat)
+	if err != nil {
+		return err
+	}
+	tbl := t.table(schema, name)
+	attrs := rbat.Attrs
+	if batch.GetVector(rbat, engine.RowId) != nil {
+		ibat, err := splitInserted(rbat)
+		if err != nil {
+			return err
+		}
+		if _, err := tbl.dropInserted(ibat, ibat.Attrs); err != nil {
+			return err
+		}
+		attrs = []string{engine.RowId}
+	}
+	if len(rbat.Vecs) > 0 && vector.Length(rbat.Vecs[0]) == 0 {
+		return nil
+	}
+	keys, err := tbl.dropInserted(rbat, attrs)
+	if err != nil {
+		return err
+	}
+	tbl.deletes = append(tbl.deletes, rbat)
+	sig := strings.Join(attrs, ",")
+	set, ok := tbl.sets[sig]
+	if !ok {
+		set = &deleteSet{
+			attrs: attrs,
+			keys:  make(map[string]struct{}),
+		}
+		tbl.sets[sig] = set
+	}
+	for k := range keys {
+		set.keys[k] = struct{}{}
+	}
+	return nil
+}
+
+// dropInserted drops the inserted tuples equal to the ones of bat over
+// attrs, and returns the keys of the tuples of bat.
+func (tbl *table) dropInserted(bat *batch.Batch, attrs []string) (map[string]struct{}, error) {
+	keys := make(map[string]struct{})
+	if err := walk(bat, attrs, func(_ int, key string, _ int64) {
+		keys[key] = struct{}{}
+	}); err != nil {
+		return nil, err
+	}
+	for _, ins := range tbl.inserts {
+		if err := filter(ins, attrs, keys); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// splitInserted removes the tuples without a row id from bat, and returns
+// them without the row ids.
+func splitInserted(bat *batch.Batch) (*batch.Batch, error) {
+	ibat, err := dup(bat)
+	if err != nil {
+		return nil, err
+	}
+	ids := batch.GetVector(bat, engine.RowId).Col.(*types.Bytes)
+	var sels, isels []int64
+	for i, n := range ids.Lengths {
+		if n == 0 {
+			isels = append(isels, int64(i))
+		} else {
+			sels = append(sels, int64(i))
+		}
+	}
+	shrink(bat, sels)
+	shrink(ibat, isels)
+	i := batch.GetVectorIndex(ibat, engine.RowId)
+	ibat.Attrs = append(ibat.Attrs[:i], ibat.Attrs[i+1:]...)
+	ibat.Vecs = append(ibat.Vecs[:i], ibat.Vecs[i+1:]...)
+	return ibat, nil
+}
+
+// snapshot returns the readers of the committed data of r as seen by the
+// transaction, together with the delete sets and copies of the inserted
+// tuples of the table.
+func (t *Txn) snapshot(schema, name string, r engine.Relation, n int) ([]engine.Reader, []*deleteSet, []*batch.Batch, error) {
+	t.Lock()
+	defer t.Unlock()
+	rds, err := readers(r, schema, name, t.ts, true, n)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	tbl, ok := t.tblsMap[schema+"."+name]
+	if !ok {
+		return rds, nil, nil, nil
+	}
+	sets := make([]*deleteSet, 0, len(tbl.sets))
+	for _, set := range tbl.sets {
+		keys := make(map[string]struct{}, len(set.keys))
+		for k := range set.keys {
+			keys[k] = struct{}{}
+		}
+		sets = append(sets, &deleteSet{attrs: set.attrs, keys: keys})
+	}
+	bats := make([]*batch.Batch, 0, len(tbl.inserts))
+	for _, ins := range tbl.inserts {
+		if len(ins.Vecs) == 0 || vector.Length(ins.Vecs[0]) == 0 {
+			continue
+		}
+		bat, err := dup(ins)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		bats = append(bats, bat)
+	}
+	return rds, sets, bats, nil
+}
+
+// Autocommit runs fn, which changes the relation schema.name outside of
+// the transactions. It takes turns with the commits and the other writes
+// of the relation, but not with those of other relations. The relation is
+// marked as changed before and after fn, so that the transactions which
+// began earlier and delete its tuples, or read it without its history,
+// conflict with the change.
+func Autocommit(schema, name string, fn func() error) error {
+	st := tables.get(schema, name)
+	st.Lock()
+	defer st.Unlock()
+	st.setStamp(stamp())
+	defer func() {
+		st.setStamp(stamp())
+	}()
+	return fn()
+}
+
+// readers returns n readers of the committed data of the relation r named
+// schema.name as of ts. They are made once the commit being applied to the
+// relation is done, so they see all or none of its changes. A relation
+// which does not keep its history that far is read as it is, which fails
+// if strict and the relation has been changed since ts.
+func readers(r engine.Relation, schema, name string, ts int64, strict bool, n int) ([]engine.Reader, error) {
+	if n < 1 {
+		n = 1
+	}
+	st := tables.get(schema, name)
+	st.applying.RLock()
+	defer st.applying.RUnlock()
+	if hrel, ok := engine.Unwrap(r).(engine.HistoryRelation); ok {
+		if hr, err := hrel.AsOf(ts); err == nil {
+			return hr.NewReader(n), nil
+		}
+	}
+	if strict && st.changedSince(ts) {
+		return nil, fmt.Errorf("%w: table '%s' has been changed since the transaction began", ErrConflict, name)
+	}
+	return r.NewReader(n), nil
+}
+
+// get returns the state of the table schema.name.
+func (s *tableSet) get(schema, name string) *tableState {
+	key := schema + "." + name
+	s.Lock()
+	defer s.Unlock()
+	st, ok := s.states[key]
+	if !ok {
+		st = new(tableState)
+		s.states[key] = st
+	}
+	return st
+}
+
+func (st *tableState) setStamp(ts int64) {
+	atomic.StoreInt64(&st.stamp, ts)
+}
+
+// changedSince returns true if the table has been changed after ts.
+func (st *tableState) changedSince(ts int64) bool {
+	return atomic.LoadInt64(&st.stamp) > ts
+}
+
+func (tbl *table) key() string {
+	return tbl.schema + "." + tbl.name
+}
+
+// apply applies the changes of the table with the commit stamp cts if the
+// relation keeps its history, ts is passed to the engine as the epoch.
+func (c *change) apply(ts uint64, cts int64) error {
+	r := c.r
+	if hrel, ok := engine.Unwrap(r).(engine.HistoryRelation); ok {
+		r = hrel.At(cts)
+	}
+	for _, bat := range c.tbl.deletes {
+		if err := r.Delete(ts, bat); err != nil {
+			return err
+		}
+	}
+	for _, bat := range c.tbl.inserts {
+		if len(bat.Vecs) == 0 || vector.Length(bat.Vecs[0]) == 0 {
+			continue
+		}
+		if err := r.Write(ts, bat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkKeys fails with ErrConflict if a tuple inserted by the transaction
+// has the primary key of a tuple committed since ts. A relation which does
+// not keep its history that far cannot tell the tuples committed since ts
+// from the older ones, so a tuple with the key conflicts whenever it was
+// committed.
+func (c *change) checkKeys(ts int64) error {
+	attrs := primaryKey(c.r.TableDefs())
+	if len(attrs) == 0 {
+		return nil
+	}
+	keys := make(map[string]struct{})
+	for _, ins := range c.tbl.inserts {
+		if err := walk(ins, attrs, func(_ int, key string, _ int64) {
+			keys[key] = struct{}{}
+		}); err != nil {
+			return err
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	dups, err := findKeys(c.r, attrs, keys)
+	if err != nil || len(dups) == 0 {
+		return err
+	}
+	if hrel, ok := engine.Unwrap(c.r).(engine.HistoryRelation); ok {
+		if hr, err := hrel.AsOf(ts); err == nil {
+			olds, err := findKeys(hr, attrs, dups)
+			if err != nil {
+				return err
+			}
+			for k := range olds {
+				delete(dups, k)
+			}
+		}
+	}
+	if len(dups) > 0 {
+		return fmt.Errorf("%w: duplicate entry for the primary key of table '%s'", ErrConflict, c.tbl.name)
+	}
+	return nil
+}
+
+// findKeys returns the keys over attrs of the tuples of r which are in keys.
+func findKeys(r engine.Relation, attrs []string, keys map[string]struct{}) (map[string]struct{}, error) {
+	cs := make([]uint64, len(attrs))
+	for i := range cs {
+		cs[i] = 1
+	}
+	rs := make(map[string]struct{})
+	for _, rd := range r.NewReader(1) {
+		for {
+			bat, err := rd.Read(cs, attrs)
+			if err != nil {
+				return nil, err
+			}
+			if bat == nil {
+				break
+			}
+			if err := walk(bat, attrs, func(_ int, key string, z int64) {
+				if _, ok := keys[key]; ok && z > 0 {
+					rs[key] = struct{}{}
+				}
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rs, nil
+}
+
+// primaryKey returns the attributes of the primary key of a relation whose
+// definitions are defs, none if it has no primary key.
+func primaryKey(defs []engine.TableDef) []string {
+	var attrs []string
+	for _, def := range defs {
+		switch d := def.(type) {
+		case *engine.PrimaryIndexDef:
+			return d.Names
+		case *engine.AttributeDef:
+			if d.Attr.Primary {
+				attrs = append(attrs, d.Attr.Name)
+			}
+		}
+	}
+	return attrs
+}
+
+func openRelation(e engine.Engine, schema, name string) (engine.Relation, error) {
+	db, err := e.Database(schema)
+	if err != nil {
+		return nil, err
+	}
+	return db.Relation(name)
+}
+
+// dup returns a copy of bat whose vectors are allocated from go memory.
+func dup(bat *batch.Batch) (*batch.Batch, error) {
+	rbat := batch.New(true, append([]string{}, bat.Attrs...))
+	for i, vec := range bat.Vecs {
+		data, err := vec.Show()
+		if err != nil {
+			return nil, err
+		}
+		rbat.Vecs[i] = vector.New(vec.Typ)
+		if err := rbat.Vecs[i].Read(data); err != nil {
+			return nil, err
+		}
+		rbat.Vecs[i].Or = true
+	}
+	if len(bat.Zs) > 0 {
+		rbat.Zs = append([]int64{}, bat.Zs...)
+	}
+	return rbat, nil
+}
+
+// filter removes the tuples of bat whose values over attrs are in keys.
+func filter(bat *batch.Batch, attrs []string, keys map[string]struct{}) error {
+	if len(bat.Vecs) == 0 || !hasAttrs(bat, attrs) {
+		return nil
+	}
+	n := vector.Length(bat.Vecs[0])
+	sels := make([]int64, 0, n)
+	if err := walk(bat, attrs, func(i int, key string, _ int64) {
+		if _, ok := keys[key]; !ok {
+			sels = append(sels, int64(i))
+		}
+	}); err != nil {
+		return err
+	}
+	if len(sels) < n {
+		shrink(bat, sels)
+	}
+	return nil
+}
+
+// walk calls fn with the index, the key over attrs and the multiplicity of
+// every tuple of bat, it does nothing if bat lacks any of attrs.
+func walk(bat *batch.Batch, attrs []string, fn func(int, string, int64)) error {
+	if len(bat.Vecs) == 0 || !hasAttrs(bat, attrs) {
+		return nil
+	}
+	view := &batch.Batch{Attrs: attrs, Vecs: make([]*vector.Vector, len(attrs))}
+	for i, attr := range attrs {
+		view.Vecs[i] = batch.GetVector(bat, attr)
+	}
+	var err error
+	var key []byte
+	for i, n := 0, vector.Length(bat.Vecs[0]); i < n; i++ {
+		if key, err = batch.RowKey(key[:0], view, int64(i)); err != nil {
+			return err
+		}
+		z := int64(1)
+		if len(bat.Zs) > 0 {
+			z = bat.Zs[i]
+		}
+		fn(i, string(key), z)
+	}
+	return nil
+}
+
+func hasAttrs(bat *batch.Batch, attrs []string) bool {
+	for _, attr := range attrs {
+		if batch.GetVector(bat, attr) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// shrink keeps the tuples of bat at sels.
+func shrink(bat *batch.Batch, sels []int64) {
+	for _, vec := range bat.Vecs {
+		vector.Shrink(vec, sels)
+	}
+	if len(bat.Zs) > 0 {
+		for i, sel := range sels {
+			bat.Zs[i] = bat.Zs[sel]
+		}
+		bat.Zs = bat.Zs[:len(sels)]
+	}
+}
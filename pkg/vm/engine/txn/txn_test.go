@@ -0,0 +1,674 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommit(t *testing.T) {
+	var txn *Txn
+
+	base := memEngine.NewTestEngine()
+	e := NewEngine(base, func() *Txn { return txn })
+	txn = New(false)
+	r := getRelation(t, e, "T")
+	require.NoError(t, r.Write(0, newBatch(t, 0, 4)))
+	require.NoError(t, r.Delete(0, newBatch(t, 1, 2)))
+	require.Equal(t, []string{"0", "2", "3"}, read(t, r))
+	require.Equal(t, []string(nil), read(t, getRelation(t, base, "T")))
+
+	require.NoError(t, txn.Commit(base, 1))
+	txn = nil
+	require.Equal(t, []string{"0", "2", "3"}, read(t, getRelation(t, base, "T")))
+
+	txn = New(false)
+	r = getRelation(t, e, "T")
+	require.NoError(t, r.Update(1, newBatch(t, 2, 3), newBatch(t, 5, 6)))
+	require.Equal(t, []string{"0", "3", "5"}, read(t, r))
+	require.Equal(t, []string{"0", "2", "3"}, read(t, getRelation(t, base, "T")))
+	require.NoError(t, txn.Commit(base, 2))
+	txn = nil
+	require.Equal(t, []string{"0", "3", "5"}, read(t, getRelation(t, base, "T")))
+}
+
+func TestRollback(t *testing.T) {
+	base := memEngine.NewTestEngine()
+	txn := New(false)
+	e := NewEngine(base, func() *Txn { return txn })
+	r := getRelation(t, e, "T")
+	require.NoError(t, r.Write(0, newBatch(t, 0, 2)))
+	require.Equal(t, []string{"0", "1"}, read(t, r))
+	txn.Rollback()
+	require.Equal(t, []string(nil), read(t, r))
+	require.NoError(t, txn.Commit(base, 1))
+	require.Equal(t, []string(nil), read(t, getRelation(t, base, "T")))
+}
+
+func TestReadOnly(t *testing.T) {
+	txn := New(true)
+	e := NewEngine(memEngine.NewTestEngine(), func() *Txn { return txn })
+	r := getRelation(t, e, "T")
+	require.Equal(t, ErrReadOnly, r.Write(0, newBatch(t, 0, 1)))
+	require.Equal(t, ErrReadOnly, r.Delete(0, newBatch(t, 0, 1)))
+}
+
+func TestSnapshot(t *testing.T) {
+	base := memEngine.NewTestEngine()
+	require.NoError(t, getRelation(t, base, "T").Write(0, newBatch(t, 0, 2)))
+	txn := New(false)
+	e := NewEngine(base, func() *Txn { return txn })
+	r := getRelation(t, e, "T")
+	require.Equal(t, []string{"0", "1"}, read(t, r))
+	require.NoError(t, r.Write(0, newBatch(t, 4, 5)))
+	require.Equal(t, []string{"0", "1", "4"}, read(t, r))
+
+	// the relation does not keep its history, so it cannot be read as of
+	// BEGIN once it is changed
+	require.NoError(t, Autocommit("test", "T", func() error {
+		return getRelation(t, base, "T").Write(1, newBatch(t, 2, 4))
+	}))
+	for _, rd := range r.NewReader(1) {
+		_, err := rd.Read([]uint64{1}, []string{"id"})
+		require.True(t, errors.Is(err, ErrConflict))
+	}
+
+	// the inserts of the transaction do not conflict
+	require.NoError(t, txn.Commit(base, 2))
+	require.Equal(t, []string{"0", "1", "2", "3", "4"}, sorted(read(t, getRelation(t, base, "T"))))
+}
+
+func TestConflict(t *testing.T) {
+	base := memEngine.NewTestEngine()
+	require.NoError(t, getRelation(t, base, "T").Write(0, newBatch(t, 0, 4)))
+	txn := New(false)
+	e := NewEngine(base, func() *Txn { return txn })
+	r := getRelation(t, e, "T")
+	require.NoError(t, r.Delete(0, newBatch(t, 1, 3)))
+	require.NoError(t, r.Write(0, newBatch(t, 5, 6)))
+	require.NoError(t, Autocommit("test", "T", func() error {
+		return getRelation(t, base, "T").Write(1, newBatch(t, 4, 5))
+	}))
+	require.True(t, errors.Is(txn.Commit(base, 2), ErrConflict))
+	require.Equal(t, []string{"0", "1", "2", "3", "4"}, sorted(read(t, getRelation(t, base, "T"))))
+
+	// a change committed before BEGIN does not conflict
+	txn = New(false)
+	r = getRelation(t, e, "T")
+	require.NoError(t, r.Delete(0, newBatch(t, 1, 2)))
+	require.NoError(t, txn.Commit(base, 4))
+	require.Equal(t, []string{"0", "2", "3", "4"}, sorted(read(t, getRelation(t, base, "T"))))
+}
+
+func TestKeyConflict(t *testing.T) {
+	base := memEngine.NewTestEngine()
+	db, err := base.Database("test")
+	require.NoError(t, err)
+	defs := getRelation(t, base, "T").TableDefs()
+	for _, def := range defs {
+		if d, ok := def.(*engine.AttributeDef); ok && d.Attr.Name == "id" {
+			d.Attr.Primary = true
+		}
+	}
+	require.NoError(t, db.Create(0, "P", defs))
+	require.NoError(t, getRelation(t, base, "P").Write(0, newBatch(t, 0, 2)))
+
+	// both transactions insert tuple 2, and the other tuples do not conflict
+	txns := []*Txn{New(false), New(false)}
+	for i, txn := range txns {
+		txn := txn
+		r := getRelation(t, NewEngine(base, func() *Txn { return txn }), "P")
+		require.NoError(t, r.Write(0, newBatch(t, 2, 3)))
+		require.NoError(t, r.Write(0, newBatch(t, 3+i, 4+i)))
+	}
+	require.NoError(t, txns[0].Commit(base, 1))
+	require.True(t, errors.Is(txns[1].Commit(base, 2), ErrConflict))
+	require.Equal(t, []string{"0", "1", "2", "3"}, sorted(read(t, getRelation(t, base, "P"))))
+
+	txn := New(false)
+	r := getRelation(t, NewEngine(base, func() *Txn { return txn }), "P")
+	require.NoError(t, r.Write(0, newBatch(t, 5, 6)))
+	require.NoError(t, Autocommit("test", "P", func() error {
+		return getRelation(t, base, "P").Write(3, newBatch(t, 6, 7))
+	}))
+	require.NoError(t, txn.Commit(base, 4))
+	require.Equal(t, []string{"0", "1", "2", "3", "5", "6"}, sorted(read(t, getRelation(t, base, "P"))))
+}
+
+func TestConcurrentCommit(t *testing.T) {
+	base := memEngine.NewTestEngine()
+	db, err := base.Database("test")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(0, "U", getRelation(t, base, "T").TableDefs()))
+	require.NoError(t, getRelation(t, base, "U").Write(0, newBatch(t, 0, 2)))
+
+	// both transactions update tuple 1
+	txns := []*Txn{New(false), New(false)}
+	for i, txn := range txns {
+		txn := txn
+		r := getRelation(t, NewEngine(base, func() *Txn { return txn }), "U")
+		require.Equal(t, []string{"0", "1"}, sorted(read(t, r)))
+		require.NoError(t, r.Update(0, newBatch(t, 1, 2), newBatch(t, 2+i, 3+i)))
+	}
+
+	// the second commit is validated after the first one is applied
+	applying := make(chan struct{})
+	slow := &failEngine{Engine: base, applying: applying}
+	errc := make(chan error)
+	go func() {
+		errc <- txns[0].Commit(slow, 1)
+	}()
+	<-applying
+	require.True(t, errors.Is(txns[1].Commit(base, 2), ErrConflict))
+	require.NoError(t, <-errc)
+	require.Equal(t, []string{"0", "2"}, sorted(read(t, getRelation(t, base, "U"))))
+}
+
+func TestCommitOtherTable(t *testing.T) {
+	base := memEngine.NewTestEngine()
+	db, err := base.Database("test")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(0, "U", getRelation(t, base, "T").TableDefs()))
+
+	// a write of table T does not wait for a commit of table U
+	applying := make(chan struct{})
+	slow := &failEngine{Engine: base, applying: applying}
+	txn := New(false)
+	r := getRelation(t, NewEngine(base, func() *Txn { return txn }), "U")
+	require.NoError(t, r.Delete(0, newBatch(t, 0, 1)))
+	errc := make(chan error)
+	go func() {
+		errc <- txn.Commit(slow, 1)
+	}()
+	<-applying
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		require.NoError(t, Autocommit("test", "T", func() error {
+			return getRelation(t, base, "T").Write(2, newBatch(t, 0, 1))
+		}))
+		New(false).Rollback()
+	}()
+	select {
+	case <-done:
+	case <-errc:
+		t.Fatal("the write of table T waited for the commit of table U")
+	}
+	require.NoError(t, <-errc)
+}
+
+func TestSnapshotAtBegin(t *testing.T) {
+	base := newHistoryEngine(t)
+	require.NoError(t, getRelation(t, base, "H").Write(0, newBatch(t, 0, 2)))
+	txn := New(true)
+	e := NewEngine(base, func() *Txn { return txn })
+
+	// the changes after BEGIN are not seen by the transaction
+	other := New(false)
+	r := getRelation(t, NewEngine(base, func() *Txn { return other }), "H")
+	require.NoError(t, r.Delete(0, readRows(t, r, "0")))
+	require.NoError(t, other.Commit(base, 1))
+	require.NoError(t, Autocommit("test", "H", func() error {
+		return getRelation(t, base, "H").Write(2, newBatch(t, 2, 3))
+	}))
+	require.Equal(t, []string{"1", "2"}, sorted(read(t, getRelation(t, base, "H"))))
+	require.Equal(t, []string{"0", "1"}, sorted(read(t, getRelation(t, e, "H"))))
+	txn.Rollback()
+
+	// a transaction which begins later sees them
+	txn = New(true)
+	require.Equal(t, []string{"1", "2"}, sorted(read(t, getRelation(t, e, "H"))))
+	txn.Rollback()
+}
+
+func TestCommitStamp(t *testing.T) {
+	base := newHistoryEngine(t)
+	require.NoError(t, getRelation(t, base, "H").Write(0, newBatch(t, 0, 3)))
+
+	// the changes of a commit are stamped with the same commit stamp
+	txn := New(false)
+	r := getRelation(t, NewEngine(base, func() *Txn { return txn }), "H")
+	require.NoError(t, r.Update(0, readRows(t, r, "0", "1"), newBatch(t, 5, 7)))
+	before := getRelation(t, NewEngine(base, func() *Txn { return nil }), "H")
+	require.NoError(t, txn.Commit(base, 1))
+	h := engine.Unwrap(getRelation(t, base, "H")).(*historyRelation)
+	cts := h.rows[len(h.rows)-1].ins
+	for _, row := range h.rows {
+		switch row.id {
+		case "0", "1":
+			require.Equal(t, cts, row.del)
+		case "5", "6":
+			require.Equal(t, cts, row.ins)
+		}
+	}
+
+	// a relation opened outside of the transactions is read as it was then
+	require.Equal(t, []string{"0", "1", "2"}, sorted(read(t, before)))
+	after := getRelation(t, NewEngine(base, func() *Txn { return nil }), "H")
+	require.Equal(t, []string{"2", "5", "6"}, sorted(read(t, after)))
+}
+
+func TestRowIds(t *testing.T) {
+	base := newHistoryEngine(t)
+	h := getRelation(t, base, "H")
+	require.NoError(t, h.Write(0, newBatch(t, 0, 2)))
+	require.NoError(t, h.Write(0, newBatch(t, 0, 1)))
+
+	// equal tuples are told apart by their row ids, the inserted tuples
+	// have none and are dropped at once
+	txn := New(false)
+	r := getRelation(t, NewEngine(base, func() *Txn { return txn }), "H")
+	require.NoError(t, r.Write(0, newBatch(t, 2, 4)))
+	bat := readRows(t, r, "0", "2")
+	ids := batch.GetVector(bat, engine.RowId).Col.(*types.Bytes)
+	require.Equal(t, []uint32{1, 1, 0}, ids.Lengths)
+	shrink(bat, []int64{1, 2})
+	require.NoError(t, r.Delete(0, bat))
+	require.Equal(t, []string{"0", "1", "3"}, sorted(read(t, r)))
+	require.Equal(t, []string{"0", "0", "1"}, sorted(read(t, h)))
+
+	require.NoError(t, txn.Commit(base, 1))
+	require.Equal(t, []string{"0", "1", "3"}, sorted(read(t, h)))
+	hr := engine.Unwrap(h).(*historyRelation)
+	require.Equal(t, int64(0), hr.rows[0].del)
+	require.NotEqual(t, int64(0), hr.rows[2].del)
+}
+
+func TestCommitFailure(t *testing.T) {
+	base := memEngine.NewTestEngine()
+	db, err := base.Database("test")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(0, "U", getRelation(t, base, "T").TableDefs()))
+	require.NoError(t, getRelation(t, base, "U").Write(0, newBatch(t, 0, 1)))
+
+	// the error tells the tables which may have been changed
+	txn := New(false)
+	e := NewEngine(base, func() *Txn { return txn })
+	require.NoError(t, getRelation(t, e, "T").Write(0, newBatch(t, 0, 1)))
+	require.NoError(t, getRelation(t, e, "U").Write(0, newBatch(t, 5, 6)))
+	err = txn.Commit(&failEngine{Engine: base, write: true}, 1)
+	require.True(t, errors.Is(err, errWrite))
+	require.Contains(t, err.Error(), "'T', 'U' may have been committed partially")
+	require.Equal(t, []string{"0"}, read(t, getRelation(t, base, "U")))
+
+	// the transactions which began before conflict with the changes
+	txn = New(false)
+	require.NoError(t, getRelation(t, e, "U").Delete(0, newBatch(t, 0, 1)))
+	require.NoError(t, Autocommit("test", "U", func() error { return nil }))
+	require.True(t, errors.Is(txn.Commit(base, 2), ErrConflict))
+}
+
+func TestReadError(t *testing.T) {
+	base := memEngine.NewTestEngine()
+	db, err := base.Database("test")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(0, "U", getRelation(t, base, "T").TableDefs()))
+	txn := New(false)
+	e := NewEngine(&failEngine{Engine: base, read: true}, func() *Txn { return txn })
+	for _, rd := range getRelation(t, e, "U").NewReader(2) {
+		_, err := rd.Read([]uint64{1}, []string{"id"})
+		require.Equal(t, errRead, err)
+	}
+}
+
+var (
+	errRead  = errors.New("read failed")
+	errWrite = errors.New("write failed")
+)
+
+// failEngine fails the reads or the writes of relation U, or holds up its
+// first delete for a while after closing applying
+type failEngine struct {
+	engine.Engine
+	read     bool
+	write    bool
+	applying chan struct{}
+	once     sync.Once
+}
+
+type failDatabase struct {
+	engine.Database
+	e *failEngine
+}
+
+type failRelation struct {
+	engine.Relation
+	e *failEngine
+}
+
+func (e *failEngine) Database(name string) (engine.Database, error) {
+	db, err := e.Engine.Database(name)
+	if err != nil {
+		return nil, err
+	}
+	return &failDatabase{Database: db, e: e}, nil
+}
+
+func (db *failDatabase) Relation(name string) (engine.Relation, error) {
+	r, err := db.Database.Relation(name)
+	if err != nil || name != "U" {
+		return r, err
+	}
+	return &failRelation{Relation: r, e: db.e}, nil
+}
+
+func (r *failRelation) Write(ts uint64, bat *batch.Batch) error {
+	if r.e.write {
+		return errWrite
+	}
+	return r.Relation.Write(ts, bat)
+}
+
+func (r *failRelation) Delete(ts uint64, bat *batch.Batch) error {
+	if r.e.applying != nil {
+		r.e.once.Do(func() {
+			close(r.e.applying)
+			time.Sleep(50 * time.Millisecond)
+		})
+	}
+	return r.Relation.Delete(ts, bat)
+}
+
+func (r *failRelation) NewReader(n int) []engine.Reader {
+	if !r.e.read {
+		return r.Relation.NewReader(n)
+	}
+	rds := make([]engine.Reader, n)
+	for i := range rds {
+		rds[i] = &errReader{err: errRead}
+	}
+	return rds
+}
+
+func getRelation(t *testing.T, e engine.Engine, name string) engine.Relation {
+	db, err := e.Database("test")
+	require.NoError(t, err)
+	r, err := db.Relation(name)
+	require.NoError(t, err)
+	return r
+}
+
+// newBatch returns the tuples of table T whose ids are in [start, end)
+func newBatch(t *testing.T, start, end int) *batch.Batch {
+	bat := batch.New(true, []string{"id", "price"})
+	ids := make([][]byte, 0, end-start)
+	prices := make([]float64, 0, end-start)
+	for i := start; i < end; i++ {
+		ids = append(ids, []byte(fmt.Sprintf("%v", i)))
+		prices = append(prices, float64(i))
+	}
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(bat.Vecs[0], ids))
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[1], prices))
+	return bat
+}
+
+// read returns the ids of all the tuples of r
+func read(t *testing.T, r engine.Relation) []string {
+	var ids []string
+
+	for _, rd := range r.NewReader(2) {
+		for {
+			bat, err := rd.Read([]uint64{1}, []string{"id"})
+			require.NoError(t, err)
+			if bat == nil {
+				break
+			}
+			vs := bat.Vecs[0].Col.(*types.Bytes)
+			for i := range vs.Offsets {
+				ids = append(ids, string(vs.Get(int64(i))))
+			}
+		}
+	}
+	return ids
+}
+
+// readRows returns the tuples of r whose ids are in ids along with their
+// row ids, in the order they are read
+func readRows(t *testing.T, r engine.Relation, ids ...string) *batch.Batch {
+	var rowIds, vs [][]byte
+	var prices []float64
+
+	for _, rd := range r.NewReader(2) {
+		for {
+			bat, err := rd.Read([]uint64{1, 1, 1}, []string{"id", "price", engine.RowId})
+			require.NoError(t, err)
+			if bat == nil {
+				break
+			}
+			col := bat.Vecs[0].Col.(*types.Bytes)
+			rcol := bat.Vecs[2].Col.(*types.Bytes)
+			for i := range col.Offsets {
+				for _, id := range ids {
+					if id == string(col.Get(int64(i))) {
+						vs = append(vs, col.Get(int64(i)))
+						prices = append(prices, bat.Vecs[1].Col.([]float64)[i])
+						rowIds = append(rowIds, rcol.Get(int64(i)))
+					}
+				}
+			}
+		}
+	}
+	bat := batch.New(true, []string{"id", "price", engine.RowId})
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(bat.Vecs[0], vs))
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[1], prices))
+	bat.Vecs[2] = vector.New(engine.RowIdType)
+	require.NoError(t, vector.Append(bat.Vecs[2], rowIds))
+	return bat
+}
+
+func sorted(ids []string) []string {
+	sort.Strings(ids)
+	return ids
+}
+
+// historyEngine is an engine whose relation H keeps the history of its
+// tuples, which have the attributes of table T and row ids.
+type historyEngine struct {
+	engine.Engine
+	h *historyRelation
+}
+
+type historyDatabase struct {
+	engine.Database
+	h *historyRelation
+}
+
+// historyRelation stores the tuples of H along with their stamps, it is
+// read as of asOf if it is not zero, and its writes are stamped with at
+// if it is not zero.
+type historyRelation struct {
+	engine.Relation
+	*historyStore
+	asOf int64
+	at   int64
+}
+
+type historyStore struct {
+	sync.Mutex
+	rows []historyRow
+}
+
+// historyRow is a tuple inserted at ins and deleted at del if it is not zero
+type historyRow struct {
+	id       string
+	price    float64
+	rowId    string
+	ins, del int64
+}
+
+type historyReader struct {
+	errReader
+	rows []historyRow
+}
+
+func newHistoryEngine(t *testing.T) *historyEngine {
+	base := memEngine.NewTestEngine()
+	return &historyEngine{
+		Engine: base,
+		h:      &historyRelation{Relation: getRelation(t, base, "T"), historyStore: new(historyStore)},
+	}
+}
+
+func (e *historyEngine) Database(name string) (engine.Database, error) {
+	db, err := e.Engine.Database(name)
+	if err != nil {
+		return nil, err
+	}
+	return &historyDatabase{Database: db, h: e.h}, nil
+}
+
+func (db *historyDatabase) Relation(name string) (engine.Relation, error) {
+	if name == "H" {
+		return db.h, nil
+	}
+	return db.Database.Relation(name)
+}
+
+func (r *historyRelation) AsOf(ts int64) (engine.Relation, error) {
+	return &historyRelation{Relation: r.Relation, historyStore: r.historyStore, asOf: ts}, nil
+}
+
+func (r *historyRelation) At(ts int64) engine.Relation {
+	return &historyRelation{Relation: r.Relation, historyStore: r.historyStore, at: ts}
+}
+
+func (r *historyRelation) RowIds() {}
+
+func (r *historyRelation) stamp() int64 {
+	if r.at != 0 {
+		return r.at
+	}
+	return time.Now().UnixNano()
+}
+
+func (r *historyRelation) Write(_ uint64, bat *batch.Batch) error {
+	r.Lock()
+	defer r.Unlock()
+	ts := r.stamp()
+	ids := bat.Vecs[0].Col.(*types.Bytes)
+	for i, price := range bat.Vecs[1].Col.([]float64) {
+		r.rows = append(r.rows, historyRow{
+			id:    string(ids.Get(int64(i))),
+			price: price,
+			rowId: fmt.Sprintf("%v", len(r.rows)),
+			ins:   ts,
+		})
+	}
+	return nil
+}
+
+func (r *historyRelation) Delete(_ uint64, bat *batch.Batch) error {
+	r.Lock()
+	defer r.Unlock()
+	ts := r.stamp()
+	ids := batch.GetVector(bat, engine.RowId).Col.(*types.Bytes)
+	for i := range ids.Offsets {
+		for j := range r.rows {
+			if r.rows[j].del == 0 && r.rows[j].rowId == string(ids.Get(int64(i))) {
+				r.rows[j].del = ts
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (r *historyRelation) Update(ts uint64, obat, nbat *batch.Batch) error {
+	if err := r.Delete(ts, obat); err != nil {
+		return err
+	}
+	return r.Write(ts, nbat)
+}
+
+func (r *historyRelation) NewReader(n int) []engine.Reader {
+	r.Lock()
+	defer r.Unlock()
+	rd := &historyReader{}
+	for _, row := range r.rows {
+		if r.asOf == 0 && row.del == 0 ||
+			r.asOf != 0 && row.ins <= r.asOf && (row.del == 0 || row.del > r.asOf) {
+			rd.rows = append(rd.rows, row)
+		}
+	}
+	rds := make([]engine.Reader, n)
+	for i := range rds {
+		rds[i] = &historyReader{}
+	}
+	rds[0] = rd
+	return rds
+}
+
+func (r *historyReader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
+	if len(r.rows) == 0 {
+		return nil, nil
+	}
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		switch attr {
+		case "id":
+			ids := make([][]byte, len(r.rows))
+			for j, row := range r.rows {
+				ids[j] = []byte(row.id)
+			}
+			bat.Vecs[i] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+			if err := vector.Append(bat.Vecs[i], ids); err != nil {
+				return nil, err
+			}
+		case engine.RowId:
+			ids := make([][]byte, len(r.rows))
+			for j, row := range r.rows {
+				ids[j] = []byte(row.rowId)
+			}
+			bat.Vecs[i] = vector.New(engine.RowIdType)
+			if err := vector.Append(bat.Vecs[i], ids); err != nil {
+				return nil, err
+			}
+		case "price":
+			prices := make([]float64, len(r.rows))
+			for j, row := range r.rows {
+				prices[j] = row.price
+			}
+			bat.Vecs[i] = vector.New(types.Type{Oid: types.T_float64, Size: 8})
+			if err := vector.Append(bat.Vecs[i], prices); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, ErrNoAttribute
+		}
+		bat.Vecs[i].Ref = cs[i]
+	}
+	bat.Zs = make([]int64, len(r.rows))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	r.rows = nil
+	return bat, nil
+}
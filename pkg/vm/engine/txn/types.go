@@ -0,0 +1,119 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txn
+
+import (
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// Txn buffers the writes of a multi-statement transaction. The writes are
+// invisible to other sessions until Commit applies them to the underlying
+// engine, and Rollback simply drops them.
+//
+// Reads inside a transaction see a snapshot of the committed data as it was
+// at BEGIN merged with the transaction's own writes. A relation which keeps
+// its history is read as of BEGIN, any other relation is read as it is,
+// which fails with ErrConflict if it has been changed since BEGIN. Commit
+// fails with ErrConflict if a table the transaction deletes from has been
+// changed since BEGIN, or if a tuple it inserts has the primary key of a
+// tuple inserted since, and stamps all its changes with the same commit
+// stamp, so that the readers see all or none of them.
+type Txn struct {
+	sync.Mutex
+	readOnly bool
+	ts       int64             // stamp of BEGIN
+	tbls     []*table          // tables in the order of their first access
+	tblsMap  map[string]*table // schema.table -> table
+}
+
+// tableSet holds the states of the tables changed or read through the
+// package, its lock only guards the map.
+type tableSet struct {
+	sync.Mutex
+	states map[string]*tableState // schema.table -> state
+}
+
+// tableState orders the changes of a table. Its lock is held by a commit
+// changing the table and by a write outside of the transactions, so the
+// commits of different tables do not wait for each other.
+type tableState struct {
+	sync.Mutex
+	// applying is held by a commit while it applies its changes to the
+	// table, the readers wait for it so that they see all or none of them.
+	applying sync.RWMutex
+	// stamp is the stamp of the last change of the table, read atomically
+	stamp int64
+}
+
+// table holds the pending changes of a relation.
+type table struct {
+	schema  string
+	name    string
+	inserts []*batch.Batch // inserted tuples which are not deleted yet
+	deletes []*batch.Batch // tuples to be deleted from the committed data
+	sets    map[string]*deleteSet
+}
+
+// deleteSet contains the keys of the deleted tuples over the same attributes.
+type deleteSet struct {
+	attrs []string
+	keys  map[string]struct{}
+}
+
+// change is a table whose pending changes are being committed.
+type change struct {
+	tbl *table
+	st  *tableState
+	r   engine.Relation
+}
+
+type txnEngine struct {
+	engine.Engine
+	get func() *Txn
+}
+
+// database opens the relations of a schema for the transaction txn,
+// or for a statement outside of the transactions if txn is nil.
+type database struct {
+	engine.Database
+	schema string
+	txn    *Txn
+}
+
+// relation buffers the writes of the transaction txn and reads its snapshot.
+// Outside of the transactions, txn is nil and the relation is read as it
+// was when it was opened.
+type relation struct {
+	engine.Relation
+	schema string
+	name   string
+	txn    *Txn
+	ts     int64 // stamp of the opening, used if txn is nil
+}
+
+type reader struct {
+	r       engine.Reader // reader of the committed data, may be nil
+	sets    []*deleteSet
+	inserts []*batch.Batch
+	zs      []int64
+}
+
+// errReader fails the reads of a relation whose snapshot cannot be read.
+type errReader struct {
+	err error
+}
@@ -143,6 +143,11 @@ type Relation interface {
 	TableDefs() []TableDef
 
 	Write(uint64, *batch.Batch) error
+	// Delete removes all tuples equal to one of the tuples of the batch
+	Delete(uint64, *batch.Batch) error
+	// Update replaces the tuples equal to one of the tuples of the first batch
+	// by the tuples of the second one
+	Update(uint64, *batch.Batch, *batch.Batch) error
 
 	AddTableDef(uint64, TableDef) error
 	DelTableDef(uint64, TableDef) error
@@ -150,6 +155,117 @@ type Relation interface {
 	NewReader(int) []Reader // first argument is the number of reader
 }
 
+// HistoryRelation is a Relation which retains the history of its tuples
+// for a while, so that it can be read as it was at a past time.
+type HistoryRelation interface {
+	Relation
+
+	// AsOf returns the relation as it was at the given unix time in
+	// nanoseconds, it fails if the history is not retained that far.
+	// The returned relation is closed along with the receiver.
+	AsOf(int64) (Relation, error)
+
+	// At returns the relation whose writes are stamped with the given
+	// unix time in nanoseconds instead of the time they are applied, so
+	// that the changes of a commit are seen as of the same time.
+	// The returned relation is closed along with the receiver.
+	At(int64) Relation
+}
+
+// RowId is the hidden attribute of a RowIdRelation, whose value identifies
+// a tuple of the relation. It is not a part of the table definitions and
+// is only read when asked for.
+const RowId = "__rowid"
+
+// RowIdType is the type of the values of RowId, the row ids of the tuples
+// inserted by a transaction and not committed yet are empty.
+var RowIdType = types.Type{Oid: types.T_char, Size: 24}
+
+// RowIdRelation is a Relation whose readers return the row ids of the
+// tuples. Its Delete removes the tuples of the batch by their row ids
+// instead of their values, so that equal tuples can be told apart, and
+// its Update replaces them by the tuples at the same positions in the
+// second batch, which has no row ids.
+type RowIdRelation interface {
+	Relation
+
+	// RowIds is a marker of the relations which support row ids.
+	RowIds()
+}
+
+// WrapRelation is a Relation which wraps another one, e.g. to buffer the
+// writes of a transaction. The optional interfaces of a relation, such as
+// AlterRelation, are implemented by the innermost one.
+type WrapRelation interface {
+	Relation
+
+	// Unwrap returns the wrapped relation.
+	Unwrap() Relation
+}
+
+// Unwrap returns the innermost relation wrapped by r, r itself if it
+// wraps none.
+func Unwrap(r Relation) Relation {
+	for {
+		w, ok := r.(WrapRelation)
+		if !ok {
+			return r
+		}
+		r = w.Unwrap()
+	}
+}
+
+// AlterRelation is a Relation whose attributes can be changed online,
+// its AddTableDef and DelTableDef add and drop attributes. The tuples
+// written before an attribute was added read its default value at that
+// time.
+type AlterRelation interface {
+	Relation
+
+	// RenameAttribute renames an attribute of the relation.
+	RenameAttribute(epoch uint64, name, newName string) error
+	// ChangeDefault changes the default value of an attribute for the
+	// tuples written from now on.
+	ChangeDefault(epoch uint64, name string, def DefaultExpr) error
+}
+
+// AlterT is the type of an AlterOption.
+type AlterT int
+
+const (
+	AlterAdd     AlterT = iota // adds Attr
+	AlterDrop                  // drops the attribute Name
+	AlterRename                // renames the attribute Name to NewName
+	AlterDefault               // changes the default value of Attr.Name
+)
+
+// AlterOption is a change of the attributes of an AlterRelation.
+type AlterOption struct {
+	Type    AlterT
+	Attr    Attribute // the attribute added, or the attribute whose default is changed
+	Name    string    // the attribute dropped or renamed
+	NewName string    // the new name of the attribute
+}
+
+// AtomicAlterRelation is an AlterRelation which applies several changes
+// of its attributes at once, so that either all or none of them are
+// applied.
+type AtomicAlterRelation interface {
+	AlterRelation
+
+	// Alter applies the changes in order as a single change.
+	Alter(epoch uint64, opts []AlterOption) error
+}
+
+// OptimizeRelation is a Relation whose storage can be compacted on
+// demand, whatever compaction policy it follows.
+type OptimizeRelation interface {
+	Relation
+
+	// Optimize merge-sorts the closed segments of the relation.
+	Optimize() error
+}
+
 type Reader interface {
 	NewFilter() Filter
 	NewSummarizer() Summarizer
@@ -184,6 +300,8 @@ type SparseFilter interface {
 	Gt(string, interface{}) (Reader, error)
 	Ge(string, interface{}) (Reader, error)
 	Btw(string, interface{}, interface{}) (Reader, error)
+	In(string, []interface{}) (Reader, error)
+	NotIn(string, []interface{}) (Reader, error)
 }
 
 type Database interface {
@@ -194,6 +312,13 @@ type Database interface {
 	Create(uint64, string, []TableDef) error // Create Table - (name, table define)
 }
 
+// RenameDatabase is a Database whose relations can be renamed.
+type RenameDatabase interface {
+	Database
+
+	Rename(epoch uint64, name, newName string) error
+}
+
 type Engine interface {
 	Delete(uint64, string) error
 	Create(uint64, string, int) error // Create Database - (name, engine type)
@@ -21,16 +21,19 @@ func HostSize(m *Mheap) int64 {
 }
 
 func Free(m *Mheap, data []byte) {
-	//m.Gm.Free(int64(cap(data)))
+	m.Gm.Free(int64(cap(data)))
 }
 
+// Alloc accounts the memory in the guest mmu before taking it from the mempool,
+// so a query fails with mmu.OutOfMemory when it exceeds max_query_memory.
+// The queries and the remote scopes allocate from a quota of the host which
+// is released when they are done, so the memory they do not free is given back.
 func Alloc(m *Mheap, size int64) ([]byte, error) {
+	// the mempool makes a buffer whose capacity is the size
+	if err := m.Gm.Alloc(size); err != nil {
+		return nil, err
+	}
 	data := mempool.Alloc(m.Mp, int(size))
-	/*
-		if err := m.Gm.Alloc(int64(cap(data))); err != nil {
-			return nil, err
-		}
-	*/
 	return data[:size], nil
 }
 
@@ -34,6 +34,13 @@ func (m *Mmu) HostSize() int64 {
 	return m.Mmu.Size()
 }
 
+// Pressure returns true if the memory in use reaches mmu.PressureRatio of the limit
+// of the guest or its host, the operators holding their working set spill it to disk then.
+func (m *Mmu) Pressure() bool {
+	return float64(m.size) >= float64(m.Limit)*mmu.PressureRatio ||
+		float64(m.Mmu.Size()) >= float64(m.Mmu.Limit())*mmu.PressureRatio
+}
+
 func (m *Mmu) Free(size int64) {
 	if size == 0 {
 		return
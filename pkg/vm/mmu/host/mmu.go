@@ -25,12 +25,32 @@ func New(limit int64) *Mmu {
 	}
 }
 
+// NewQuota returns a Mmu which allocates at most limit bytes from the parent,
+// e.g. the memory shared by all processes of a query.
+func NewQuota(limit int64, parent *Mmu) *Mmu {
+	return &Mmu{
+		limit:  limit,
+		parent: parent,
+	}
+}
+
 func (m *Mmu) Size() int64 {
 	return atomic.LoadInt64(&m.size)
 }
 
 func (m *Mmu) Free(size int64) {
 	atomic.AddInt64(&m.size, size*-1)
+	if m.parent != nil {
+		m.parent.Free(size)
+	}
+}
+
+// Release frees the memory which is still allocated from the parent,
+// it is called when the processes sharing the quota are done.
+func (m *Mmu) Release() {
+	if size := atomic.SwapInt64(&m.size, 0); size != 0 && m.parent != nil {
+		m.parent.Free(size)
+	}
 }
 
 func (m *Mmu) Alloc(size int64) error {
@@ -39,5 +59,15 @@ func (m *Mmu) Alloc(size int64) error {
 	}
 	for v := atomic.LoadInt64(&m.size); !atomic.CompareAndSwapInt64(&m.size, v, v+size); v = atomic.LoadInt64(&m.size) {
 	}
+	if m.parent != nil {
+		if err := m.parent.Alloc(size); err != nil {
+			atomic.AddInt64(&m.size, size*-1)
+			return err
+		}
+	}
 	return nil
 }
+
+func (m *Mmu) Limit() int64 {
+	return m.limit
+}
@@ -17,4 +17,6 @@ package host
 type Mmu struct {
 	size  int64
 	limit int64
+	// parent, the memory is also allocated from the parent if it is not nil
+	parent *Mmu
 }
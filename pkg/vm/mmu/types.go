@@ -21,3 +21,8 @@ import (
 var (
 	OutOfMemory = errors.New("out of memory")
 )
+
+// PressureRatio, the memory is under pressure if the ratio of the limit is in use,
+// the rest is left for the operators to build their results before they spill.
+// It is set by the system variable mmuPressureRatio when the server starts.
+var PressureRatio = 0.3
@@ -31,6 +31,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/union"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/oplus"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/plus"
 	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/times"
@@ -55,6 +58,9 @@ var stringFunc = [...]func(interface{}, *bytes.Buffer){
 	Transform:   transform.String,
 	Projection:  projection.String,
 	UnTransform: untransform.String,
+	Join:        join.String,
+	Window:      window.String,
+	Union:       union.String,
 
 	MergeDedup: mergededup.String,
 	MergeLimit: mergelimit.String,
@@ -79,6 +85,9 @@ var prepareFunc = [...]func(*process.Process, interface{}) error{
 	Transform:   transform.Prepare,
 	Projection:  projection.Prepare,
 	UnTransform: untransform.Prepare,
+	Join:        join.Prepare,
+	Window:      window.Prepare,
+	Union:       union.Prepare,
 
 	MergeDedup: mergededup.Prepare,
 	MergeLimit: mergelimit.Prepare,
@@ -103,6 +112,9 @@ var execFunc = [...]func(*process.Process, interface{}) (bool, error){
 	Transform:   transform.Call,
 	Projection:  projection.Call,
 	UnTransform: untransform.Call,
+	Join:        join.Call,
+	Window:      window.Call,
+	Union:       union.Call,
 
 	MergeDedup: mergededup.Call,
 	MergeLimit: mergelimit.Call,
@@ -51,34 +51,45 @@ func (p *Pipeline) String() string {
 	return buf.String()
 }
 
-func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (bool, error) {
-	var end bool // exist flag
-	var err error
+func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (end bool, err error) {
 	var bat *batch.Batch
 
 	defer func() {
 		if err != nil {
-			for i, in := range p.instructions {
-				if in.Op == vm.Connector {
-					arg := p.instructions[i].Arg.(*connector.Argument)
-					arg.Reg.Ch <- nil
-					break
-				}
-			}
+			// the other pipelines of the query stop with the error
+			process.Abort(proc, err)
+			sendEnd(p.instructions, proc)
+			process.FreeRegisters(proc)
 		} else {
+			// the operators holding their results push them at the end,
+			// and the query fails if they fail
 			proc.Reg.InputBatch = nil
-			vm.Run(p.instructions, proc)
+			if _, err = vm.Run(p.instructions, proc); err != nil {
+				process.Abort(proc, err)
+				sendEnd(p.instructions, proc)
+				process.FreeRegisters(proc)
+			}
 		}
 	}()
-	r = exportRestrict(r, p.instructions)
+	if r, err = exportRestrict(r, p.instructions); err != nil {
+		return false, err
+	}
 	if err = vm.Prepare(p.instructions, proc); err != nil {
 		return false, err
 	}
-	for {
-		// read data from storage engine
-		if bat, err = r.Read(p.refCnts, p.attrs); err != nil {
+	for eof := false; ; {
+		// stop reading if the query has been aborted
+		if err = process.Interrupted(proc); err != nil {
 			return false, err
 		}
+		// read data from storage engine, the operators which push their results
+		// batch by batch after the end of the data are called with nil then
+		if !eof {
+			if bat, err = r.Read(p.refCnts, p.attrs); err != nil {
+				return false, err
+			}
+			eof = bat == nil
+		}
 		// processing the batch according to the instructions
 		proc.Reg.InputBatch = bat
 		if end, err = vm.Run(p.instructions, proc); err != nil || end { // end is true means pipeline successfully completed
@@ -87,29 +98,32 @@ func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (bool, error) {
 	}
 }
 
-func (p *Pipeline) RunMerge(proc *process.Process) (bool, error) {
-	var end bool
-	var err error
-
+func (p *Pipeline) RunMerge(proc *process.Process) (end bool, err error) {
 	defer func() {
 		if err != nil {
-			for i, in := range p.instructions {
-				if in.Op == vm.Connector {
-					arg := p.instructions[i].Arg.(*connector.Argument)
-					arg.Reg.Ch <- nil
-					break
-				}
-			}
+			// the other pipelines of the query stop with the error
+			process.Abort(proc, err)
+			sendEnd(p.instructions, proc)
+			process.FreeRegisters(proc)
 		} else {
+			// the operators holding their results push them at the end,
+			// and the query fails if they fail
 			proc.Reg.InputBatch = nil
-			vm.Run(p.instructions, proc)
+			if _, err = vm.Run(p.instructions, proc); err != nil {
+				process.Abort(proc, err)
+				sendEnd(p.instructions, proc)
+				process.FreeRegisters(proc)
+			}
 		}
 		proc.Cancel()
 	}()
-	if err := vm.Prepare(p.instructions, proc); err != nil {
+	if err = vm.Prepare(p.instructions, proc); err != nil {
 		return false, err
 	}
 	for {
+		if err = process.Interrupted(proc); err != nil {
+			return false, err
+		}
 		proc.Reg.InputBatch = nil
 		if end, err = vm.Run(p.instructions, proc); err != nil || end {
 			return end, err
@@ -117,148 +131,266 @@ func (p *Pipeline) RunMerge(proc *process.Process) (bool, error) {
 	}
 }
 
-func exportRestrict(r engine.Reader, ins vm.Instructions) engine.Reader {
+// sendEnd tells the consumer of a failed pipeline that there is no more data,
+// it gives up if the consumer has stopped receiving or the query is aborted.
+func sendEnd(ins vm.Instructions, proc *process.Process) {
+	for i, in := range ins {
+		if in.Op == vm.Connector {
+			arg := ins[i].Arg.(*connector.Argument)
+			select {
+			case <-proc.Ctx.Done():
+			case <-arg.Reg.Ctx.Done():
+			case arg.Reg.Ch <- nil:
+			}
+			break
+		}
+	}
+}
+
+func exportRestrict(r engine.Reader, ins vm.Instructions) (engine.Reader, error) {
+	var err error
+
 	if ft := r.NewSparseFilter(); ft == nil {
-		return r
+		return r, nil
 	}
 
 	for i, in := range ins {
 		if in.Op == vm.Restrict {
 			arg := ins[i].Arg.(*restrict.Argument)
-			r = newReaderWithfilter(r, arg.E)
+			if r, err = newReaderWithfilter(r, arg.E); err != nil {
+				return nil, err
+			}
 		}
 		if in.Op == vm.Transform {
 			arg := ins[i].Arg.(*transform.Argument)
 			if arg.Restrict != nil {
-				r = newReaderWithfilter(r, arg.Restrict.E)
+				if r, err = newReaderWithfilter(r, arg.Restrict.E); err != nil {
+					return nil, err
+				}
 			}
 		}
 	}
-	return r
+	return r, nil
 }
 
-func newReaderWithfilter(r engine.Reader, e extend.Extend) engine.Reader {
+func newReaderWithfilter(r engine.Reader, e extend.Extend) (engine.Reader, error) {
+	var err error
 	var es []extend.Extend
 
 	es = extend.AndExtends(e, es)
 	if len(es) == 0 {
-		return r
+		return r, nil
 	}
 	for i := range es {
+		if v, ok := es[i].(*extend.InExtend); ok {
+			if r, err = newReaderWithIn(r, v); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		if v, ok := es[i].(*extend.BinaryExtend); ok {
+			if hasDecimalAttribute(v) {
+				continue
+			}
 			switch v.Op {
 			case overload.EQ:
-				r = newReaderWithEq(r, v)
+				r, err = newReaderWithEq(r, v)
 			case overload.NE:
-				r = newReaderWithNe(r, v)
+				r, err = newReaderWithNe(r, v)
 			case overload.LT:
-				r = newReaderWithLt(r, v)
+				r, err = newReaderWithLt(r, v)
 			case overload.LE:
-				r = newReaderWithLe(r, v)
+				r, err = newReaderWithLe(r, v)
 			case overload.GT:
-				r = newReaderWithGt(r, v)
+				r, err = newReaderWithGt(r, v)
 			case overload.GE:
-				r = newReaderWithGe(r, v)
+				r, err = newReaderWithGe(r, v)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return r, nil
+}
+
+// hasDecimalAttribute returns true if e compares a decimal attribute, the
+// scale of the attribute is unknown here so the value can't be converted
+// to the one stored in zonemap.
+func hasDecimalAttribute(e *extend.BinaryExtend) bool {
+	for _, arg := range []extend.Extend{e.Left, e.Right} {
+		if attr, ok := arg.(*extend.Attribute); ok {
+			if attr.Type == types.T_decimal64 || attr.Type == types.T_decimal128 {
+				return true
 			}
 		}
 	}
-	return r
+	return false
+}
+
+// newReaderWithIn pushes an in list of an attribute down to the reader, a not
+// in list is pushed only if its values are of the type of the attribute, as a
+// value converted to the type may be a different one, and if it has no null.
+func newReaderWithIn(r engine.Reader, e *extend.InExtend) (engine.Reader, error) {
+	attr, ok := e.E.(*extend.Attribute)
+	if !ok || attr.Type == types.T_decimal64 || attr.Type == types.T_decimal128 {
+		return r, nil
+	}
+	if len(e.Args) == 0 || e.Not && e.Null {
+		return r, nil
+	}
+	vs := make([]interface{}, 0, len(e.Args))
+	for _, arg := range e.Args {
+		val, ok := arg.(*extend.ValueExtend)
+		if !ok {
+			return r, nil
+		}
+		if e.Not && val.V.Typ.Oid != attr.Type {
+			return r, nil
+		}
+		v := cast(val.V, attr.Type)
+		if _, ok := v.([]byte); v == nil || ok && val.V.Typ.Oid != attr.Type {
+			return r, nil
+		}
+		vs = append(vs, v)
+	}
+	filter := r.NewSparseFilter()
+	if e.Not {
+		return filter.NotIn(attr.Name, vs)
+	}
+	return filter.In(attr.Name, vs)
 }
 
-func newReaderWithEq(r engine.Reader, e *extend.BinaryExtend) engine.Reader {
+func newReaderWithEq(r engine.Reader, e *extend.BinaryExtend) (engine.Reader, error) {
+	var err error
+
 	if attr, ok := e.Left.(*extend.Attribute); ok {
 		if val, ok := e.Right.(*extend.ValueExtend); ok {
 			filter := r.NewSparseFilter()
-			r, _ = filter.Eq(attr.Name, cast(val.V, attr.Type))
+			if r, err = filter.Eq(attr.Name, cast(val.V, attr.Type)); err != nil {
+				return nil, err
+			}
 		}
 	}
 	if attr, ok := e.Right.(*extend.Attribute); ok {
 		if val, ok := e.Left.(*extend.ValueExtend); ok {
 			filter := r.NewSparseFilter()
-			r, _ = filter.Eq(attr.Name, cast(val.V, attr.Type))
+			if r, err = filter.Eq(attr.Name, cast(val.V, attr.Type)); err != nil {
+				return nil, err
+			}
 		}
 	}
-	return r
+	return r, nil
 }
 
-func newReaderWithNe(r engine.Reader, e *extend.BinaryExtend) engine.Reader {
+func newReaderWithNe(r engine.Reader, e *extend.BinaryExtend) (engine.Reader, error) {
+	var err error
+
 	if attr, ok := e.Left.(*extend.Attribute); ok {
 		if val, ok := e.Right.(*extend.ValueExtend); ok {
 			filter := r.NewSparseFilter()
-			r, _ = filter.Ne(attr.Name, cast(val.V, attr.Type))
+			if r, err = filter.Ne(attr.Name, cast(val.V, attr.Type)); err != nil {
+				return nil, err
+			}
 		}
 	}
 	if attr, ok := e.Right.(*extend.Attribute); ok {
 		if val, ok := e.Left.(*extend.ValueExtend); ok {
 			filter := r.NewSparseFilter()
-			r, _ = filter.Ne(attr.Name, cast(val.V, attr.Type))
+			if r, err = filter.Ne(attr.Name, cast(val.V, attr.Type)); err != nil {
+				return nil, err
+			}
 		}
 	}
-	return r
+	return r, nil
 }
 
-func newReaderWithLt(r engine.Reader, e *extend.BinaryExtend) engine.Reader {
+func newReaderWithLt(r engine.Reader, e *extend.BinaryExtend) (engine.Reader, error) {
+	var err error
+
 	if attr, ok := e.Left.(*extend.Attribute); ok {
 		if val, ok := e.Right.(*extend.ValueExtend); ok {
 			filter := r.NewSparseFilter()
-			r, _ = filter.Lt(attr.Name, cast(val.V, attr.Type))
+			if r, err = filter.Lt(attr.Name, cast(val.V, attr.Type)); err != nil {
+				return nil, err
+			}
 		}
 	}
 	if attr, ok := e.Right.(*extend.Attribute); ok {
 		if val, ok := e.Left.(*extend.ValueExtend); ok {
 			filter := r.NewSparseFilter()
-			r, _ = filter.Lt(attr.Name, cast(val.V, attr.Type))
+			if r, err = filter.Lt(attr.Name, cast(val.V, attr.Type)); err != nil {
+				return nil, err
+			}
 		}
 	}
-	return r
+	return r, nil
 }
 
-func newReaderWithLe(r engine.Reader, e *extend.BinaryExtend) engine.Reader {
+func newReaderWithLe(r engine.Reader, e *extend.BinaryExtend) (engine.Reader, error) {
+	var err error
+
 	if attr, ok := e.Left.(*extend.Attribute); ok {
 		if val, ok := e.Right.(*extend.ValueExtend); ok {
 			filter := r.NewSparseFilter()
-			r, _ = filter.Le(attr.Name, cast(val.V, attr.Type))
+			if r, err = filter.Le(attr.Name, cast(val.V, attr.Type)); err != nil {
+				return nil, err
+			}
 		}
 	}
 	if attr, ok := e.Right.(*extend.Attribute); ok {
 		if val, ok := e.Left.(*extend.ValueExtend); ok {
 			filter := r.NewSparseFilter()
-			r, _ = filter.Le(attr.Name, cast(val.V, attr.Type))
+			if r, err = filter.Le(attr.Name, cast(val.V, attr.Type)); err != nil {
+				return nil, err
+			}
 		}
 	}
-	return r
+	return r, nil
 }
 
-func newReaderWithGt(r engine.Reader, e *extend.BinaryExtend) engine.Reader {
+func newReaderWithGt(r engine.Reader, e *extend.BinaryExtend) (engine.Reader, error) {
+	var err error
+
 	if attr, ok := e.Left.(*extend.Attribute); ok {
 		if val, ok := e.Right.(*extend.ValueExtend); ok {
 			filter := r.NewSparseFilter()
-			r, _ = filter.Gt(attr.Name, cast(val.V, attr.Type))
+			if r, err = filter.Gt(attr.Name, cast(val.V, attr.Type)); err != nil {
+				return nil, err
+			}
 		}
 	}
 	if attr, ok := e.Right.(*extend.Attribute); ok {
 		if val, ok := e.Left.(*extend.ValueExtend); ok {
 			filter := r.NewSparseFilter()
-			r, _ = filter.Gt(attr.Name, cast(val.V, attr.Type))
+			if r, err = filter.Gt(attr.Name, cast(val.V, attr.Type)); err != nil {
+				return nil, err
+			}
 		}
 	}
-	return r
+	return r, nil
 }
 
-func newReaderWithGe(r engine.Reader, e *extend.BinaryExtend) engine.Reader {
+func newReaderWithGe(r engine.Reader, e *extend.BinaryExtend) (engine.Reader, error) {
+	var err error
+
 	if attr, ok := e.Left.(*extend.Attribute); ok {
 		if val, ok := e.Right.(*extend.ValueExtend); ok {
 			filter := r.NewSparseFilter()
-			r, _ = filter.Ge(attr.Name, cast(val.V, attr.Type))
+			if r, err = filter.Ge(attr.Name, cast(val.V, attr.Type)); err != nil {
+				return nil, err
+			}
 		}
 	}
 	if attr, ok := e.Right.(*extend.Attribute); ok {
 		if val, ok := e.Left.(*extend.ValueExtend); ok {
 			filter := r.NewSparseFilter()
-			r, _ = filter.Ge(attr.Name, cast(val.V, attr.Type))
+			if r, err = filter.Ge(attr.Name, cast(val.V, attr.Type)); err != nil {
+				return nil, err
+			}
 		}
 	}
-	return r
+	return r, nil
 }
 
 func cast(vec *vector.Vector, typ types.T) interface{} {
@@ -587,16 +719,17 @@ func cast(vec *vector.Vector, typ types.T) interface{} {
 		case types.T_datetime:
 			return types.Datetime(vec.Col.([]types.Datetime)[0])
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_json:
+		data := vec.Col.(*types.Bytes).Get(0)
 		switch typ {
 		case types.T_date:
-			v, _ := types.ParseDate(string(vec.Data))
+			v, _ := types.ParseDate(string(data))
 			return v
 		case types.T_datetime:
-			v, _ := types.ParseDatetime(string(vec.Data))
+			v, _ := types.ParseDatetime(string(data))
 			return v
 		}
-		return vec.Data
+		return data
 	}
 	return nil
 }
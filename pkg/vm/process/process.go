@@ -15,6 +15,10 @@
 package process
 
 import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -25,10 +29,96 @@ import (
 // A process stores the execution context.
 func New(m *mheap.Mheap) *Process {
 	return &Process{
-		Mp: m,
+		Mp:  m,
+		Ctx: context.Background(),
+	}
+}
+
+// NewContext returns the context of a query and the function to abort the query.
+// The query is aborted with QueryTimeout if it runs longer than the timeout, and
+// the timeout is ignored if it is zero.
+// The abort function must be called with nil to release the context after the query.
+func NewContext(timeout time.Duration) (context.Context, func(error)) {
+	a := new(aborter)
+	ctx := context.WithValue(context.Background(), aborterKey{}, a)
+	if timeout > 0 {
+		ctx, a.cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, a.cancel = context.WithCancel(ctx)
+	}
+	return ctx, a.abort
+}
+
+// WithTimeZone returns a copy of ctx which carries the time zone of the session,
+// timestamps are converted from and to datetimes in it.
+func WithTimeZone(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, timeZoneKey{}, loc)
+}
+
+// TimeZone returns the session time zone of the process,
+// it is the local time zone of the server if the query carries none.
+func TimeZone(proc *Process) *time.Location {
+	if proc.Ctx != nil {
+		if loc, ok := proc.Ctx.Value(timeZoneKey{}).(*time.Location); ok && loc != nil {
+			return loc
+		}
+	}
+	return time.Local
+}
+
+// Abort stops all processes of the query with the error, the first error is kept.
+func Abort(proc *Process, err error) {
+	if proc.Ctx == nil {
+		return
+	}
+	if a, ok := proc.Ctx.Value(aborterKey{}).(*aborter); ok {
+		a.abort(err)
 	}
 }
 
+// Interrupted returns the error which aborts the query of the process,
+// it returns nil if the query is running.
+func Interrupted(proc *Process) error {
+	if proc.Ctx == nil || proc.Ctx.Err() == nil {
+		return nil
+	}
+	if a, ok := proc.Ctx.Value(aborterKey{}).(*aborter); ok {
+		if err := a.error(); err != nil {
+			return err
+		}
+	}
+	if proc.Ctx.Err() == context.DeadlineExceeded {
+		return QueryTimeout
+	}
+	return QueryInterrupted
+}
+
+// Receive receives a batch from the pipeline of the register,
+// it stops waiting if the query is aborted.
+func Receive(proc *Process, reg *WaitRegister) (*batch.Batch, error) {
+	select {
+	case <-proc.Ctx.Done():
+		return nil, Interrupted(proc)
+	case bat := <-reg.Ch:
+		return bat, nil
+	}
+}
+
+func (a *aborter) abort(err error) {
+	a.Lock()
+	if a.err == nil {
+		a.err = err
+	}
+	a.Unlock()
+	a.cancel()
+}
+
+func (a *aborter) error() error {
+	a.Lock()
+	defer a.Unlock()
+	return a.err
+}
+
 func GetSels(proc *Process) []int64 {
 	if len(proc.Reg.Ss) == 0 {
 		return make([]int64, 0, 16)
@@ -16,11 +16,35 @@ package process
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
 
+var (
+	// QueryInterrupted, the error returned by the pipelines of a killed query.
+	QueryInterrupted = errors.New("query execution was interrupted")
+	// QueryTimeout, the error returned by the pipelines of a query which runs out of its execution time.
+	QueryTimeout = errors.New("query execution was interrupted, maximum statement execution time exceeded")
+)
+
+// aborterKey is the key of the aborter in the context of a query
+type aborterKey struct{}
+
+// timeZoneKey is the key of the session time zone in the context of a query
+type timeZoneKey struct{}
+
+// aborter records the error which aborts a query
+type aborter struct {
+	sync.Mutex
+	err    error
+	cancel context.CancelFunc
+}
+
 // WaitRegister channel
 type WaitRegister struct {
 	Ctx context.Context
@@ -52,6 +76,8 @@ type Limitation struct {
 	BatchSize int64
 	// PartitionRows, max rows for partition.
 	PartitionRows int64
+	// ExecutionTime, max execution time, there is no limit if it is zero.
+	ExecutionTime time.Duration
 }
 
 // Process contains context used in query execution
@@ -63,6 +89,9 @@ type Process struct {
 	Reg Register
 	Lim Limitation
 	Mp  *mheap.Mheap
+	// Ctx, the context of the query shared by all processes of the query,
+	// it is done when the query is aborted.
+	Ctx context.Context
 
 	Cancel context.CancelFunc
 }
@@ -30,6 +30,9 @@ const (
 	Transform
 	Projection
 	UnTransform
+	Join
+	Window
+	Union
 
 	MergeDedup
 	MergeLimit
@@ -44,6 +47,19 @@ type Instruction struct {
 	Op int
 	// Arg contains the operand of this instruction.
 	Arg interface{}
+	// Stats collects the runtime statistics of this instruction,
+	// it is nil unless the query is run by EXPLAIN ANALYZE.
+	Stats *Stats
+}
+
+// Stats records what an instruction has done during execution.
+type Stats struct {
+	// InputRows, number of rows received by the instruction.
+	InputRows int64
+	// InputBatches, number of non-empty batches received by the instruction.
+	InputBatches int64
+	// Elapsed, time in nanoseconds spent in the instruction.
+	Elapsed int64
 }
 
 type Instructions []Instruction
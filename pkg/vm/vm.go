@@ -16,6 +16,9 @@ package vm
 
 import (
 	"bytes"
+	"sync/atomic"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -45,7 +48,12 @@ func Run(ins Instructions, proc *process.Process) (bool, error) {
 	var err error
 
 	for _, in := range ins {
-		if ok, err = execFunc[in.Op](proc, in.Arg); err != nil {
+		if in.Stats != nil {
+			ok, err = runWithStats(in, proc)
+		} else {
+			ok, err = execFunc[in.Op](proc, in.Arg)
+		}
+		if err != nil {
 			return ok || end, err
 		}
 		if ok { // ok is true shows that at least one operator has done its work
@@ -54,3 +62,17 @@ func Run(ins Instructions, proc *process.Process) (bool, error) {
 	}
 	return end, err
 }
+
+// runWithStats executes an instruction and records its input and elapsed time.
+// The counters are updated atomically because they may be read by another
+// goroutine once the result of the instruction has been passed on.
+func runWithStats(in Instruction, proc *process.Process) (bool, error) {
+	if bat := proc.Reg.InputBatch; bat != nil && len(bat.Zs) > 0 {
+		atomic.AddInt64(&in.Stats.InputRows, int64(len(bat.Zs)))
+		atomic.AddInt64(&in.Stats.InputBatches, 1)
+	}
+	t := time.Now()
+	ok, err := execFunc[in.Op](proc, in.Arg)
+	atomic.AddInt64(&in.Stats.Elapsed, int64(time.Since(t)))
+	return ok, err
+}